@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/codegangsta/cli"
+
+	"github.com/buptWYChen/gotty/client"
+	"github.com/buptWYChen/gotty/pkg/homedir"
+)
+
+var attachCommand = cli.Command{
+	Name:      "attach",
+	Usage:     "Attach to a session of a GoTTY server from this terminal",
+	ArgsUsage: "<url>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:   "credential, c",
+			Usage:  "Credential of the server, as user:pass",
+			EnvVar: "GOTTY_CREDENTIAL",
+		},
+		cli.StringSliceFlag{
+			Name:  "header",
+			Usage: "Header of the handshake, as Name: value",
+		},
+		cli.StringSliceFlag{
+			Name:  "tag",
+			Usage: "Tag of the session recorded in the audit trail, as name=value",
+		},
+		cli.StringFlag{
+			Name:  "tls-ca-crt",
+			Usage: "CA certificate file of the server, the system ones by default",
+		},
+		cli.BoolFlag{
+			Name:  "no-reconnect",
+			Usage: "Exit once the connection is lost, even when the server asks to reconnect",
+		},
+	},
+	Action: attach,
+}
+
+// attach runs the session of the URL given to c in the terminal,
+// exiting with the status of its command.
+func attach(c *cli.Context) {
+	if len(c.Args()) != 1 {
+		cli.ShowCommandHelp(c, "attach")
+		exit(fmt.Errorf("Error: No URL given."), 1)
+	}
+
+	var options []client.Option
+	if c.IsSet("credential") {
+		options = append(options, client.WithCredential(c.String("credential")))
+	}
+	for _, header := range c.StringSlice("header") {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			exit(fmt.Errorf("Error: Invalid header `%s`.", header), 1)
+		}
+		options = append(options, client.WithHeader(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])))
+	}
+	for _, tag := range c.StringSlice("tag") {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 {
+			exit(fmt.Errorf("Error: Invalid tag `%s`.", tag), 1)
+		}
+		options = append(options, client.WithTag(parts[0], parts[1]))
+	}
+	if caFile := c.String("tls-ca-crt"); caFile != "" {
+		caCert, err := ioutil.ReadFile(homedir.Expand(caFile))
+		if err != nil {
+			exit(err, 1)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			exit(fmt.Errorf("Error: No certificate in %s.", caFile), 1)
+		}
+		options = append(options, client.WithTLSConfig(&tls.Config{RootCAs: pool}))
+	}
+	if c.Bool("no-reconnect") {
+		options = append(options, client.WithoutReconnect())
+	}
+
+	cl, err := client.New(c.Args()[0], options...)
+	if err != nil {
+		exit(err, 1)
+	}
+	term, err := client.OpenStdTerminal()
+	if err != nil {
+		exit(err, 1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// in raw mode, ^C is sent to the command instead
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		<-sigChan
+		cancel()
+	}()
+
+	err = cl.Run(ctx, term)
+	term.Close()
+	switch err := err.(type) {
+	case nil:
+	case *client.ExitError:
+		if err.ExitCode > 0 {
+			os.Exit(err.ExitCode)
+		}
+		exit(err, 1)
+	default:
+		if err == context.Canceled {
+			os.Exit(1)
+		}
+		exit(err, 1)
+	}
+}
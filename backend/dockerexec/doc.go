@@ -0,0 +1,4 @@
+// Package dockerexec provides an implementation of webtty.Slave
+// that runs a command in a Docker container, or attaches to its main
+// process, through the Docker Engine API.
+package dockerexec
@@ -0,0 +1,318 @@
+package dockerexec
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+const (
+	DefaultDialTimeout = 10 * time.Second
+	DefaultTerm        = "xterm"
+)
+
+// DefaultCommand is run when Target.Command is empty.
+var DefaultCommand = []string{"/bin/sh"}
+
+var (
+	// ErrContainerNotAllowed is returned when a client selects a container
+	// that is not in the allowed list.
+	ErrContainerNotAllowed = errors.New("container not allowed")
+)
+
+// Target designates the container to connect to.
+type Target struct {
+	// Name or ID of the container
+	Container string
+	Command   []string
+	// User to run the command as, the one of the container when empty
+	User string
+	// Attach to the main process of the container instead of
+	// running Command
+	Attach bool
+}
+
+// DockerExec is a command run in a container with a TTY, or the main
+// process of a container attached to.
+type DockerExec struct {
+	target      Target
+	term        string
+	dialTimeout time.Duration
+	// names and IDs of the containers the target may be, any when nil
+	allowedContainers []string
+
+	engine      *engine
+	containerID string
+	name        string
+	// whether the output has a TTY, the streams are multiplexed otherwise
+	tty    bool
+	execID string
+
+	conn net.Conn
+	// stdout and stderr of the command
+	output io.Reader
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// containerInfo is the part of the inspection of a container used here.
+type containerInfo struct {
+	ID    string `json:"Id"`
+	Name  string `json:"Name"`
+	State struct {
+		Running  bool `json:"Running"`
+		ExitCode int  `json:"ExitCode"`
+	} `json:"State"`
+	Config struct {
+		Tty bool `json:"Tty"`
+	} `json:"Config"`
+}
+
+// New connects to target with the Docker Engine API at host, such as
+// unix:///var/run/docker.sock, using tlsConfig for a tcp:// host when set.
+func New(host string, tlsConfig *tls.Config, target Target, options ...Option) (*DockerExec, error) {
+	dexec := &DockerExec{
+		target:      target,
+		term:        DefaultTerm,
+		dialTimeout: DefaultDialTimeout,
+		closed:      make(chan struct{}),
+	}
+	if len(dexec.target.Command) == 0 {
+		dexec.target.Command = DefaultCommand
+	}
+
+	for _, option := range options {
+		option(dexec)
+	}
+
+	var err error
+	dexec.engine, err = newEngine(host, tlsConfig, dexec.dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var info containerInfo
+	err = dexec.engine.do("GET", "/containers/"+url.PathEscape(target.Container)+"/json", nil, nil, &info)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to inspect container `%s`", target.Container)
+	}
+	dexec.containerID = info.ID
+	dexec.name = strings.TrimPrefix(info.Name, "/")
+	// checked once resolved, Docker also accepts ID prefixes and names
+	if !dexec.containerAllowed() {
+		return nil, errors.Wrapf(ErrContainerNotAllowed, "failed to connect to container `%s`", target.Container)
+	}
+	if !info.State.Running {
+		return nil, errors.Errorf("container `%s` is not running", dexec.name)
+	}
+
+	if target.Attach {
+		dexec.tty = info.Config.Tty
+		err = dexec.attach()
+	} else {
+		dexec.tty = true
+		err = dexec.exec()
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to container `%s`", dexec.name)
+	}
+
+	return dexec, nil
+}
+
+// exec creates and starts the command with a TTY.
+func (dexec *DockerExec) exec() error {
+	config := map[string]interface{}{
+		"AttachStdin":  true,
+		"AttachStdout": true,
+		"AttachStderr": true,
+		"Tty":          true,
+		"Cmd":          dexec.target.Command,
+		"Env":          []string{"TERM=" + dexec.term},
+	}
+	if dexec.target.User != "" {
+		config["User"] = dexec.target.User
+	}
+	var created struct {
+		ID string `json:"Id"`
+	}
+	err := dexec.engine.do("POST", "/containers/"+dexec.containerID+"/exec", nil, config, &created)
+	if err != nil {
+		return err
+	}
+	dexec.execID = created.ID
+
+	conn, reader, err := dexec.engine.hijack("/exec/"+dexec.execID+"/start", nil, map[string]interface{}{
+		"Detach": false,
+		"Tty":    true,
+	})
+	if err != nil {
+		return err
+	}
+	dexec.conn = conn
+	dexec.output = reader
+	return nil
+}
+
+// attach connects to the streams of the main process of the container.
+func (dexec *DockerExec) attach() error {
+	query := url.Values{}
+	query.Set("stream", "1")
+	query.Set("stdin", "1")
+	query.Set("stdout", "1")
+	query.Set("stderr", "1")
+	conn, reader, err := dexec.engine.hijack("/containers/"+dexec.containerID+"/attach", query, nil)
+	if err != nil {
+		return err
+	}
+	dexec.conn = conn
+	dexec.output = reader
+	if !dexec.tty {
+		dexec.output = &demuxReader{reader: reader}
+	}
+	return nil
+}
+
+// containerAllowed reports whether the inspected container may be
+// connected to, by its name or its full ID.
+func (dexec *DockerExec) containerAllowed() bool {
+	if dexec.allowedContainers == nil {
+		return true
+	}
+	for _, allowed := range dexec.allowedContainers {
+		if allowed == dexec.name || allowed == dexec.containerID {
+			return true
+		}
+	}
+	return false
+}
+
+func (dexec *DockerExec) Read(p []byte) (n int, err error) {
+	n, err = dexec.output.Read(p)
+	if err != nil {
+		dexec.markClosed()
+	}
+	return n, err
+}
+
+func (dexec *DockerExec) Write(p []byte) (n int, err error) {
+	return dexec.conn.Write(p)
+}
+
+// Close closes the streams, which ends the command reading its input,
+// or detaches from the main process of the container, which keeps running.
+func (dexec *DockerExec) Close() error {
+	dexec.markClosed()
+	return dexec.conn.Close()
+}
+
+func (dexec *DockerExec) markClosed() {
+	dexec.closeOnce.Do(func() { close(dexec.closed) })
+}
+
+// ExitReason returns the exit status of the command, or of the main
+// process attached to, such as "exit status 1", or an empty string
+// when it is still running.
+func (dexec *DockerExec) ExitReason() string {
+	status, ok := dexec.ExitStatus()
+	if !ok {
+		return ""
+	}
+	return status.String()
+}
+
+// ExitStatus returns how the command, or the main process attached to,
+// ended, or false while it is still running or when the engine can't
+// be asked.
+func (dexec *DockerExec) ExitStatus() (webtty.ExitStatus, bool) {
+	select {
+	case <-dexec.closed:
+	case <-time.After(time.Second):
+		return webtty.ExitStatus{}, false
+	}
+
+	if dexec.execID == "" {
+		var info containerInfo
+		if dexec.engine.do("GET", "/containers/"+dexec.containerID+"/json", nil, nil, &info) != nil || info.State.Running {
+			return webtty.ExitStatus{}, false
+		}
+		return webtty.ExitStatus{Code: info.State.ExitCode}, true
+	}
+
+	var status struct {
+		Running  bool `json:"Running"`
+		ExitCode int  `json:"ExitCode"`
+	}
+	if dexec.engine.do("GET", "/exec/"+dexec.execID+"/json", nil, nil, &status) != nil || status.Running {
+		return webtty.ExitStatus{}, false
+	}
+	return webtty.ExitStatus{Code: status.ExitCode}, true
+}
+
+func (dexec *DockerExec) WindowTitleVariables() map[string]interface{} {
+	vars := map[string]interface{}{
+		"command":   dexec.target.Command[0],
+		"argv":      dexec.target.Command[1:],
+		"container": dexec.name,
+	}
+	if dexec.target.Attach {
+		vars["command"] = "docker attach"
+		vars["argv"] = []string{dexec.name}
+	}
+	return vars
+}
+
+func (dexec *DockerExec) ResizeTerminal(width int, height int) error {
+	if !dexec.tty {
+		// the main process has no TTY to resize
+		return nil
+	}
+	query := url.Values{}
+	query.Set("w", strconv.Itoa(width))
+	query.Set("h", strconv.Itoa(height))
+	path := "/containers/" + dexec.containerID + "/resize"
+	if dexec.execID != "" {
+		path = "/exec/" + dexec.execID + "/resize"
+	}
+	return dexec.engine.do("POST", path, query, nil, nil)
+}
+
+// demuxReader reads the stdout and stderr multiplexed by the engine for
+// the processes without a TTY, in frames of an 8 bytes header, holding
+// the stream and the size of the frame, followed by the data.
+type demuxReader struct {
+	reader *bufio.Reader
+	// bytes left in the current frame
+	remaining uint32
+}
+
+func (dr *demuxReader) Read(p []byte) (n int, err error) {
+	for dr.remaining == 0 {
+		var header [8]byte
+		if _, err := io.ReadFull(dr.reader, header[:]); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return 0, err
+		}
+		dr.remaining = binary.BigEndian.Uint32(header[4:])
+	}
+	if uint32(len(p)) > dr.remaining {
+		p = p[:dr.remaining]
+	}
+	n, err = dr.reader.Read(p)
+	dr.remaining -= uint32(n)
+	return n, err
+}
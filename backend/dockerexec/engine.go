@@ -0,0 +1,184 @@
+package dockerexec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultHost is the Docker Engine API used when neither the host
+// nor the DOCKER_HOST environment variable is set.
+const DefaultHost = "unix:///var/run/docker.sock"
+
+// engine is a client of the Docker Engine API, either on a unix socket
+// or on a TCP address, with TLS when tlsConfig is set.
+type engine struct {
+	network     string
+	address     string
+	tlsConfig   *tls.Config
+	dialTimeout time.Duration
+	client      *http.Client
+}
+
+// engineError is the body of the error responses of the API.
+type engineError struct {
+	Message string `json:"message"`
+}
+
+func newEngine(host string, tlsConfig *tls.Config, dialTimeout time.Duration) (*engine, error) {
+	if host == "" {
+		host = os.Getenv("DOCKER_HOST")
+	}
+	if host == "" {
+		host = DefaultHost
+	}
+	parts := strings.SplitN(host, "://", 2)
+	if len(parts) != 2 || (parts[0] != "unix" && parts[0] != "tcp") {
+		return nil, errors.Errorf("unknown docker host `%s`, expected unix:// or tcp://", host)
+	}
+
+	e := &engine{
+		network:     parts[0],
+		address:     parts[1],
+		tlsConfig:   tlsConfig,
+		dialTimeout: dialTimeout,
+	}
+	e.client = &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return e.dial()
+			},
+		},
+	}
+	return e, nil
+}
+
+func (e *engine) dial() (net.Conn, error) {
+	conn, err := net.DialTimeout(e.network, e.address, e.dialTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to docker engine")
+	}
+	if e.tlsConfig == nil || e.network != "tcp" {
+		return conn, nil
+	}
+
+	config := e.tlsConfig.Clone()
+	if config.ServerName == "" {
+		config.ServerName, _, _ = net.SplitHostPort(e.address)
+	}
+	tlsConn := tls.Client(conn, config)
+	tlsConn.SetDeadline(time.Now().Add(e.dialTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to connect to docker engine")
+	}
+	tlsConn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
+
+// request returns a request to path of the API with body encoded in JSON.
+// The host of the URL is ignored, requests are sent to the engine.
+func (e *engine) request(method string, path string, query url.Values, body interface{}) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	target := "http://docker" + path
+	if query != nil {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// do sends a request to path of the API, and decodes its response
+// into result unless nil.
+func (e *engine) do(method string, path string, query url.Values, body interface{}, result interface{}) error {
+	req, err := e.request(method, path, query, body)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), e.dialTimeout)
+	defer cancel()
+	resp, err := e.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return responseError(resp)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// hijack sends a request to path of the API upgrading the connection,
+// and returns the connection carrying the streams of the command.
+// Its reader has to be used for the reads, it may hold the first output.
+func (e *engine) hijack(path string, query url.Values, body interface{}) (net.Conn, *bufio.Reader, error) {
+	req, err := e.request("POST", path, query, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+
+	conn, err := e.dial()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Now().Add(e.dialTimeout))
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	// older engines answer 200 and stream over the same connection
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		err := responseError(resp)
+		conn.Close()
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, reader, nil
+}
+
+// responseError returns the error of a failed response of the API.
+func responseError(resp *http.Response) error {
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	var body engineError
+	if json.Unmarshal(data, &body) == nil && body.Message != "" {
+		return errors.Errorf("%s: %s", resp.Status, body.Message)
+	}
+	return errors.New(resp.Status)
+}
@@ -0,0 +1,89 @@
+package dockerexec
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/server"
+)
+
+type Factory struct {
+	options   *Options
+	tlsConfig *tls.Config
+	opts      []Option
+}
+
+// NewFactory returns a factory of terminals in containers configured with
+// options, extra options are applied after the ones derived from options.
+// The TLS certificates are read once, here.
+func NewFactory(options *Options, extra ...Option) (*Factory, error) {
+	if options.Mode != ModeExec && options.Mode != ModeAttach {
+		return nil, errors.Errorf("unknown docker mode `%s`", options.Mode)
+	}
+
+	var tlsConfig *tls.Config
+	if options.TLSCAFile != "" || options.TLSCertFile != "" {
+		tlsConfig = &tls.Config{}
+		if options.TLSCAFile != "" {
+			caCert, err := ioutil.ReadFile(homedir.Expand(options.TLSCAFile))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read docker ca file")
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, errors.New("failed to parse docker ca file")
+			}
+		}
+		if options.TLSCertFile != "" {
+			cert, err := tls.LoadX509KeyPair(homedir.Expand(options.TLSCertFile), homedir.Expand(options.TLSKeyFile))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load docker client certificate")
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	var opts []Option
+	if options.DialTimeout > 0 {
+		opts = append(opts, WithDialTimeout(time.Duration(options.DialTimeout)*time.Second))
+	}
+	opts = append(opts, extra...)
+
+	return &Factory{
+		options:   options,
+		tlsConfig: tlsConfig,
+		opts:      opts,
+	}, nil
+}
+
+func (factory *Factory) Name() string {
+	return "docker container"
+}
+
+func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	target := Target{
+		Container: factory.options.Container,
+		Command:   factory.options.Command,
+		User:      factory.options.User,
+		Attach:    factory.options.Mode == ModeAttach,
+	}
+	opts := factory.opts
+	if len(params["container"]) > 0 && params["container"][0] != target.Container {
+		target.Container = params["container"][0]
+		allowed := factory.options.AllowedContainers
+		if allowed == nil {
+			allowed = []string{}
+		}
+		opts = append(append([]Option(nil), opts...), WithAllowedContainers(allowed))
+	}
+	if target.Container == "" {
+		return nil, errors.New("container is required")
+	}
+
+	return New(factory.options.Host, factory.tlsConfig, target, opts...)
+}
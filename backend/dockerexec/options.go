@@ -0,0 +1,58 @@
+package dockerexec
+
+import (
+	"time"
+)
+
+// Modes of connection to the containers, see Options.Mode.
+const (
+	// A command is run in the container
+	ModeExec = "exec"
+	// The main process of the container is attached to
+	ModeAttach = "attach"
+)
+
+type Options struct {
+	Host        string `hcl:"docker_host" flagName:"docker-host" flagSName:"" flagDescribe:"Docker Engine API, such as unix:///var/run/docker.sock or tcp://host:2376, DOCKER_HOST by default" default:""`
+	Container   string `hcl:"docker_container" flagName:"docker-container" flagSName:"" flagDescribe:"Container to connect to, unless selected with the container URL parameter" default:""`
+	Mode        string `hcl:"docker_mode" flagName:"docker-mode" flagSName:"" flagDescribe:"How the terminals connect to the containers: exec runs a command, attach connects to their main process" default:"exec"`
+	User        string `hcl:"docker_user" flagName:"docker-user" flagSName:"" flagDescribe:"User to run the command as, the one of the container by default" default:""`
+	DialTimeout int    `hcl:"docker_dial_timeout" flagName:"docker-dial-timeout" flagSName:"" flagDescribe:"Seconds to wait for the Docker Engine API to answer" default:"10"`
+
+	// Certificates to connect to a tcp:// host with TLS,
+	// as the ones of DOCKER_CERT_PATH
+	TLSCAFile   string `hcl:"docker_tls_ca_file"`
+	TLSCertFile string `hcl:"docker_tls_cert_file"`
+	TLSKeyFile  string `hcl:"docker_tls_key_file"`
+
+	// Command run in the containers in the exec mode, a shell by default
+	Command []string `hcl:"docker_command"`
+	// Containers the clients may select with the container URL parameter,
+	// by name or full ID, none when nil
+	AllowedContainers []string `hcl:"docker_allowed_containers"`
+}
+
+type Option func(*DockerExec)
+
+// WithDialTimeout bounds the wait for the answers of the Docker Engine API.
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(dexec *DockerExec) {
+		dexec.dialTimeout = timeout
+	}
+}
+
+// WithTerm sets the TERM variable of the commands run in the exec mode.
+func WithTerm(term string) Option {
+	return func(dexec *DockerExec) {
+		dexec.term = term
+	}
+}
+
+// WithAllowedContainers restricts the containers connected to,
+// by name or full ID. An empty list rejects every container,
+// nil allows any container.
+func WithAllowedContainers(containers []string) Option {
+	return func(dexec *DockerExec) {
+		dexec.allowedContainers = containers
+	}
+}
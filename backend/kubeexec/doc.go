@@ -0,0 +1,4 @@
+// Package kubeexec provides an implementation of webtty.Slave
+// that runs a command in a container of a Kubernetes pod,
+// through the exec subresource of the API server.
+package kubeexec
@@ -0,0 +1,158 @@
+package kubeexec
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/server"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Files of the service account of pods, used when gotty runs in the cluster.
+const (
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+var (
+	// ErrNamespaceNotAllowed is returned when a client selects a namespace
+	// that is not in the allowed list.
+	ErrNamespaceNotAllowed = errors.New("namespace not allowed")
+)
+
+type Factory struct {
+	options   *Options
+	apiServer string
+	tokenFile string
+	tlsConfig *tls.Config
+	opts      []Option
+}
+
+// NewFactory returns a factory of commands run in pods configured with
+// options, extra options are applied after the ones derived from options.
+// Without an API server, the one of the cluster gotty runs in is used
+// with the service account of its pod.
+func NewFactory(options *Options, extra ...Option) (*Factory, error) {
+	apiServer, tokenFile, caFile := options.APIServer, options.TokenFile, options.CAFile
+	if apiServer == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, errors.New("kubernetes api server is required outside of a cluster")
+		}
+		apiServer = "https://" + net.JoinHostPort(host, port)
+		if tokenFile == "" {
+			tokenFile = serviceAccountTokenFile
+		}
+		if caFile == "" {
+			caFile = serviceAccountCAFile
+		}
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: options.SkipTLSVerify}
+	if caFile != "" {
+		caCert, err := ioutil.ReadFile(homedir.Expand(caFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read kubernetes ca file")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to parse kubernetes ca file")
+		}
+	}
+
+	var opts []Option
+	if options.DialTimeout > 0 {
+		opts = append(opts, WithDialTimeout(time.Duration(options.DialTimeout)*time.Second))
+	}
+	opts = append(opts, extra...)
+
+	return &Factory{
+		options:   options,
+		apiServer: apiServer,
+		tokenFile: tokenFile,
+		tlsConfig: tlsConfig,
+		opts:      opts,
+	}, nil
+}
+
+func (factory *Factory) Name() string {
+	return "kubernetes container"
+}
+
+func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	return factory.newExec(params, nil)
+}
+
+// NewFor runs the command as the user of identity when impersonation
+// is enabled, as the user of the credentials otherwise.
+func (factory *Factory) NewFor(identity webtty.Identity, params map[string][]string) (server.Slave, error) {
+	if !factory.options.Impersonate {
+		return factory.newExec(params, nil)
+	}
+	if identity.Target() == "" {
+		return nil, errors.New("no user to impersonate")
+	}
+	groups := identity.Groups
+	if identity.Impersonate != "" {
+		// the groups are the ones of the authenticated user
+		groups = nil
+	}
+	return factory.newExec(params, []Option{WithImpersonation(identity.Target(), groups)})
+}
+
+func (factory *Factory) newExec(params map[string][]string, extra []Option) (server.Slave, error) {
+	target := Target{
+		Namespace: factory.options.Namespace,
+		Pod:       factory.options.Pod,
+		Container: factory.options.Container,
+		Command:   factory.options.Command,
+	}
+	if len(params["namespace"]) > 0 {
+		target.Namespace = params["namespace"][0]
+	}
+	if len(params["pod"]) > 0 {
+		target.Pod = params["pod"][0]
+	}
+	if len(params["container"]) > 0 {
+		target.Container = params["container"][0]
+	}
+	if target.Pod == "" {
+		return nil, errors.New("pod is required")
+	}
+	if !factory.namespaceAllowed(target.Namespace) {
+		return nil, errors.Wrapf(ErrNamespaceNotAllowed, "failed to exec in `%s`", target.Namespace)
+	}
+
+	opts := append(append([]Option(nil), factory.opts...), extra...)
+	if factory.tokenFile != "" {
+		// read for each session, service account tokens are rotated
+		token, err := ioutil.ReadFile(homedir.Expand(factory.tokenFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read kubernetes token file")
+		}
+		opts = append([]Option{WithBearerToken(strings.TrimSpace(string(token)))}, opts...)
+	}
+
+	return New(factory.apiServer, factory.tlsConfig, target, opts...)
+}
+
+// namespaceAllowed reports whether the command may run in namespace.
+func (factory *Factory) namespaceAllowed(namespace string) bool {
+	if factory.options.AllowedNamespaces == nil || namespace == factory.options.Namespace {
+		return true
+	}
+	for _, allowed := range factory.options.AllowedNamespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,315 @@
+package kubeexec
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+const (
+	DefaultDialTimeout = 10 * time.Second
+)
+
+// DefaultCommand is run when Target.Command is empty.
+var DefaultCommand = []string{"/bin/sh"}
+
+// channelProtocol multiplexes the streams of the command in the messages,
+// each one starting with the number of its stream.
+const channelProtocol = "v4.channel.k8s.io"
+
+// Streams of channelProtocol.
+const (
+	stdinChannel  = 0
+	stdoutChannel = 1
+	stderrChannel = 2
+	errorChannel  = 3
+	resizeChannel = 4
+)
+
+// Target designates the container to run the command in.
+type Target struct {
+	Namespace string
+	Pod       string
+	// The only container of the pod when empty
+	Container string
+	Command   []string
+}
+
+// KubeExec is a command run in a container with a TTY.
+type KubeExec struct {
+	target      Target
+	token       string
+	dialTimeout time.Duration
+
+	impersonateUser   string
+	impersonateGroups []string
+
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+	// remainder of the output message being read
+	output io.Reader
+
+	statusMutex sync.Mutex
+	status      *execStatus
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// execStatus is the status the API server sends on the error channel
+// when the command exits.
+type execStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+	Details struct {
+		Causes []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"causes"`
+	} `json:"details"`
+}
+
+// New starts the command of target with the API server at apiServer.
+func New(apiServer string, tlsConfig *tls.Config, target Target, options ...Option) (*KubeExec, error) {
+	kexec := &KubeExec{
+		target:      target,
+		dialTimeout: DefaultDialTimeout,
+		closed:      make(chan struct{}),
+	}
+	if len(kexec.target.Command) == 0 {
+		kexec.target.Command = DefaultCommand
+	}
+
+	for _, option := range options {
+		option(kexec)
+	}
+
+	execURL, err := kexec.execURL(apiServer)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	if kexec.token != "" {
+		header.Set("Authorization", "Bearer "+kexec.token)
+	}
+	if kexec.impersonateUser != "" {
+		header.Set("Impersonate-User", kexec.impersonateUser)
+		for _, group := range kexec.impersonateGroups {
+			header.Add("Impersonate-Group", group)
+		}
+	}
+	dialer := &websocket.Dialer{
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: kexec.dialTimeout,
+		Subprotocols:     []string{channelProtocol},
+	}
+	conn, response, err := dialer.Dial(execURL, header)
+	if err != nil {
+		if response != nil {
+			err = errors.Errorf("%s: %s", err, response.Status)
+		}
+		return nil, errors.Wrapf(err, "failed to exec in pod `%s/%s`", target.Namespace, target.Pod)
+	}
+	if conn.Subprotocol() != channelProtocol {
+		conn.Close()
+		return nil, errors.Errorf("api server doesn't support the %s protocol", channelProtocol)
+	}
+	kexec.conn = conn
+
+	return kexec, nil
+}
+
+// execURL returns the URL of the exec subresource of the pod of the target.
+func (kexec *KubeExec) execURL(apiServer string) (string, error) {
+	server, err := url.Parse(apiServer)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse kubernetes api server")
+	}
+	switch server.Scheme {
+	case "https":
+		server.Scheme = "wss"
+	case "http":
+		server.Scheme = "ws"
+	default:
+		return "", errors.Errorf("unknown scheme of kubernetes api server `%s`", apiServer)
+	}
+
+	query := url.Values{}
+	for _, arg := range kexec.target.Command {
+		query.Add("command", arg)
+	}
+	if kexec.target.Container != "" {
+		query.Set("container", kexec.target.Container)
+	}
+	// the TTY merges stderr into stdout
+	query.Set("stdin", "true")
+	query.Set("stdout", "true")
+	query.Set("tty", "true")
+
+	server.Path = strings.TrimSuffix(server.Path, "/") + "/api/v1/namespaces/" +
+		url.PathEscape(kexec.target.Namespace) + "/pods/" + url.PathEscape(kexec.target.Pod) + "/exec"
+	server.RawQuery = query.Encode()
+	return server.String(), nil
+}
+
+func (kexec *KubeExec) Read(p []byte) (n int, err error) {
+	for {
+		if kexec.output != nil {
+			n, err := kexec.output.Read(p)
+			if err == io.EOF {
+				kexec.output = nil
+				if n == 0 {
+					continue
+				}
+				err = nil
+			}
+			return n, err
+		}
+
+		msgType, reader, err := kexec.conn.NextReader()
+		if err != nil {
+			kexec.markClosed()
+			if _, ok := err.(*websocket.CloseError); ok {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+		if msgType != websocket.BinaryMessage {
+			continue
+		}
+		var channel [1]byte
+		if _, err := io.ReadFull(reader, channel[:]); err != nil {
+			// the API server opens each stream with an empty message
+			continue
+		}
+
+		switch channel[0] {
+		case stdoutChannel, stderrChannel:
+			kexec.output = reader
+		case errorChannel:
+			var status execStatus
+			if json.NewDecoder(reader).Decode(&status) == nil {
+				kexec.statusMutex.Lock()
+				kexec.status = &status
+				kexec.statusMutex.Unlock()
+			}
+		}
+	}
+}
+
+func (kexec *KubeExec) Write(p []byte) (n int, err error) {
+	err = kexec.writeChannel(stdinChannel, p)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (kexec *KubeExec) writeChannel(channel byte, data []byte) error {
+	kexec.writeMutex.Lock()
+	defer kexec.writeMutex.Unlock()
+
+	message := make([]byte, 0, 1+len(data))
+	message = append(append(message, channel), data...)
+	return kexec.conn.WriteMessage(websocket.BinaryMessage, message)
+}
+
+// Close stops the command by closing the connection to the API server.
+func (kexec *KubeExec) Close() error {
+	kexec.markClosed()
+	return kexec.conn.Close()
+}
+
+func (kexec *KubeExec) markClosed() {
+	kexec.closeOnce.Do(func() { close(kexec.closed) })
+}
+
+// ExitReason returns the exit status of the command, such as
+// "exit status 1", or an empty string when it is still running.
+func (kexec *KubeExec) ExitReason() string {
+	if !kexec.waitClosed() {
+		return ""
+	}
+	status, message := kexec.exitStatus()
+	if status != nil {
+		return status.String()
+	}
+	return message
+}
+
+// ExitStatus returns how the command ended, or false while it is still
+// running or when the API server didn't tell its exit code.
+func (kexec *KubeExec) ExitStatus() (webtty.ExitStatus, bool) {
+	if !kexec.waitClosed() {
+		return webtty.ExitStatus{}, false
+	}
+	status, _ := kexec.exitStatus()
+	if status == nil {
+		return webtty.ExitStatus{}, false
+	}
+	return *status, true
+}
+
+// waitClosed waits a second for the streams to close,
+// and reports whether they did.
+func (kexec *KubeExec) waitClosed() bool {
+	select {
+	case <-kexec.closed:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+// exitStatus returns the exit status in the status sent by the API
+// server, or the message of the status when it has none.
+func (kexec *KubeExec) exitStatus() (*webtty.ExitStatus, string) {
+	kexec.statusMutex.Lock()
+	status := kexec.status
+	kexec.statusMutex.Unlock()
+	switch {
+	case status == nil:
+		return nil, ""
+	case status.Status == "Success":
+		return &webtty.ExitStatus{Code: 0}, ""
+	case status.Reason == "NonZeroExitCode":
+		for _, cause := range status.Details.Causes {
+			if cause.Reason != "ExitCode" {
+				continue
+			}
+			if code, err := strconv.Atoi(cause.Message); err == nil {
+				return &webtty.ExitStatus{Code: code}, ""
+			}
+		}
+	}
+	return nil, status.Message
+}
+
+func (kexec *KubeExec) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{
+		"command":   kexec.target.Command[0],
+		"argv":      kexec.target.Command[1:],
+		"namespace": kexec.target.Namespace,
+		"pod":       kexec.target.Pod,
+		"container": kexec.target.Container,
+	}
+}
+
+func (kexec *KubeExec) ResizeTerminal(width int, height int) error {
+	size, _ := json.Marshal(struct {
+		Width  int
+		Height int
+	}{width, height})
+	return kexec.writeChannel(resizeChannel, size)
+}
@@ -0,0 +1,47 @@
+package kubeexec
+
+import (
+	"time"
+)
+
+type Options struct {
+	APIServer     string `hcl:"kube_api_server" flagName:"kube-api-server" flagSName:"" flagDescribe:"URL of the Kubernetes API server, the one of the cluster gotty runs in by default" default:""`
+	TokenFile     string `hcl:"kube_token_file" flagName:"kube-token-file" flagSName:"" flagDescribe:"File of the bearer token to authenticate to the API server with" default:""`
+	CAFile        string `hcl:"kube_ca_file" flagName:"kube-ca-file" flagSName:"" flagDescribe:"CA certificate file to verify the API server with" default:""`
+	Namespace     string `hcl:"kube_namespace" flagName:"kube-namespace" flagSName:"" flagDescribe:"Namespace of the pods, unless selected with the namespace URL parameter" default:"default"`
+	Pod           string `hcl:"kube_pod" flagName:"kube-pod" flagSName:"" flagDescribe:"Pod to run the command in, unless selected with the pod URL parameter" default:""`
+	Container     string `hcl:"kube_container" flagName:"kube-container" flagSName:"" flagDescribe:"Container to run the command in, unless selected with the container URL parameter" default:""`
+	DialTimeout   int    `hcl:"kube_dial_timeout" flagName:"kube-dial-timeout" flagSName:"" flagDescribe:"Seconds to wait for the API server to start the command" default:"10"`
+	SkipTLSVerify bool   `hcl:"kube_skip_tls_verify"`
+	Impersonate   bool   `hcl:"kube_impersonate" flagName:"kube-impersonate" flagSName:"" flagDescribe:"Impersonate the users of the connections to the API server, with their groups" default:"false"`
+
+	// Command run in the container, a shell by default
+	Command []string `hcl:"kube_command"`
+	// Namespaces the clients may select, any namespace when nil
+	AllowedNamespaces []string `hcl:"kube_allowed_namespaces"`
+}
+
+type Option func(*KubeExec)
+
+// WithBearerToken authenticates to the API server with token.
+func WithBearerToken(token string) Option {
+	return func(kexec *KubeExec) {
+		kexec.token = token
+	}
+}
+
+// WithDialTimeout bounds the wait for the API server to start the command.
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(kexec *KubeExec) {
+		kexec.dialTimeout = timeout
+	}
+}
+
+// WithImpersonation runs the command as user, member of groups,
+// which the credentials have to be permitted to impersonate.
+func WithImpersonation(user string, groups []string) Option {
+	return func(kexec *KubeExec) {
+		kexec.impersonateUser = user
+		kexec.impersonateGroups = groups
+	}
+}
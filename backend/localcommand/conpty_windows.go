@@ -0,0 +1,200 @@
+//go:build windows
+// +build windows
+
+package localcommand
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	kernel32 = syscall.NewLazyDLL("kernel32.dll")
+
+	procCreatePseudoConsole               = kernel32.NewProc("CreatePseudoConsole")
+	procResizePseudoConsole               = kernel32.NewProc("ResizePseudoConsole")
+	procClosePseudoConsole                = kernel32.NewProc("ClosePseudoConsole")
+	procInitializeProcThreadAttributeList = kernel32.NewProc("InitializeProcThreadAttributeList")
+	procUpdateProcThreadAttribute         = kernel32.NewProc("UpdateProcThreadAttribute")
+	procDeleteProcThreadAttributeList     = kernel32.NewProc("DeleteProcThreadAttributeList")
+)
+
+const (
+	procThreadAttributePseudoConsole = 0x00020016
+	extendedStartupInfoPresent       = 0x00080000
+	createUnicodeEnvironment         = 0x00000400
+)
+
+// startupInfoEx is the STARTUPINFOEXW of CreateProcess,
+// given the pseudo console in its attribute list.
+type startupInfoEx struct {
+	syscall.StartupInfo
+	attributeList *byte
+}
+
+// pseudoConsole is a Windows pseudo console (ConPTY), the input and
+// the output of its processes in VT sequences through a pair of pipes.
+type pseudoConsole struct {
+	handle syscall.Handle
+	// write end of the input of the console
+	input *os.File
+	// read end of the output of the console
+	output *os.File
+
+	releaseOnce sync.Once
+}
+
+// newPseudoConsole creates a pseudo console of columns and rows.
+// It requires Windows 10 1809 or later.
+func newPseudoConsole(columns int, rows int) (*pseudoConsole, error) {
+	if err := procCreatePseudoConsole.Find(); err != nil {
+		return nil, errors.New("pseudo consoles require Windows 10 1809 or later")
+	}
+
+	var inputRead, inputWrite, outputRead, outputWrite syscall.Handle
+	if err := syscall.CreatePipe(&inputRead, &inputWrite, nil, 0); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the input pipe")
+	}
+	if err := syscall.CreatePipe(&outputRead, &outputWrite, nil, 0); err != nil {
+		syscall.CloseHandle(inputRead)
+		syscall.CloseHandle(inputWrite)
+		return nil, errors.Wrapf(err, "failed to create the output pipe")
+	}
+
+	var handle syscall.Handle
+	hr, _, _ := procCreatePseudoConsole.Call(
+		consoleSize(columns, rows),
+		uintptr(inputRead),
+		uintptr(outputWrite),
+		0,
+		uintptr(unsafe.Pointer(&handle)),
+	)
+	// the console keeps its own copies of its ends of the pipes
+	syscall.CloseHandle(inputRead)
+	syscall.CloseHandle(outputWrite)
+	if hr != 0 {
+		syscall.CloseHandle(inputWrite)
+		syscall.CloseHandle(outputRead)
+		return nil, errors.Errorf("failed to create pseudo console: HRESULT 0x%08x", hr)
+	}
+
+	return &pseudoConsole{
+		handle: handle,
+		input:  os.NewFile(uintptr(inputWrite), "conpty-input"),
+		output: os.NewFile(uintptr(outputRead), "conpty-output"),
+	}, nil
+}
+
+// consoleSize packs columns and rows into the COORD
+// given by value to the pseudo console functions.
+func consoleSize(columns int, rows int) uintptr {
+	if columns < 1 {
+		columns = 1
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return uintptr(uint16(columns)) | uintptr(uint16(rows))<<16
+}
+
+func (pc *pseudoConsole) resize(columns int, rows int) error {
+	hr, _, _ := procResizePseudoConsole.Call(uintptr(pc.handle), consoleSize(columns, rows))
+	if hr != 0 {
+		return errors.Errorf("failed to resize pseudo console: HRESULT 0x%08x", hr)
+	}
+	return nil
+}
+
+// start starts command with argv attached to the console, in dir with env
+// when they're set, in the working directory and the environment of gotty
+// otherwise.
+func (pc *pseudoConsole) start(command string, argv []string, env []string, dir string) (*os.Process, error) {
+	path, err := exec.LookPath(command)
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, len(argv)+1)
+	for _, arg := range append([]string{path}, argv...) {
+		args = append(args, syscall.EscapeArg(arg))
+	}
+	commandLine, err := syscall.UTF16PtrFromString(strings.Join(args, " "))
+	if err != nil {
+		return nil, err
+	}
+
+	var size uintptr
+	procInitializeProcThreadAttributeList.Call(0, 1, 0, uintptr(unsafe.Pointer(&size)))
+	attributeList := make([]byte, size)
+	ok, _, err := procInitializeProcThreadAttributeList.Call(uintptr(unsafe.Pointer(&attributeList[0])), 1, 0, uintptr(unsafe.Pointer(&size)))
+	if ok == 0 {
+		return nil, errors.Wrapf(err, "failed to initialize the process attributes")
+	}
+	defer procDeleteProcThreadAttributeList.Call(uintptr(unsafe.Pointer(&attributeList[0])))
+	ok, _, err = procUpdateProcThreadAttribute.Call(
+		uintptr(unsafe.Pointer(&attributeList[0])),
+		0,
+		procThreadAttributePseudoConsole,
+		uintptr(pc.handle),
+		unsafe.Sizeof(pc.handle),
+		0,
+		0,
+	)
+	if ok == 0 {
+		return nil, errors.Wrapf(err, "failed to attach the pseudo console")
+	}
+
+	flags := uint32(extendedStartupInfoPresent)
+	var envBlock *uint16
+	if env != nil {
+		envBlock = environmentBlock(env)
+		flags |= createUnicodeEnvironment
+	}
+	var currentDir *uint16
+	if dir != "" {
+		currentDir, err = syscall.UTF16PtrFromString(dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	si := &startupInfoEx{attributeList: &attributeList[0]}
+	si.Cb = uint32(unsafe.Sizeof(*si))
+	pi := new(syscall.ProcessInformation)
+	err = syscall.CreateProcess(nil, commandLine, nil, nil, false, flags, envBlock, currentDir, &si.StartupInfo, pi)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.CloseHandle(pi.Thread)
+	defer syscall.CloseHandle(pi.Process)
+
+	return os.FindProcess(int(pi.ProcessId))
+}
+
+// environmentBlock returns the environment block of CreateProcess
+// holding env, each KEY=value entry terminated by a NUL.
+func environmentBlock(env []string) *uint16 {
+	var block []uint16
+	for _, entry := range env {
+		block = append(block, utf16.Encode([]rune(entry))...)
+		block = append(block, 0)
+	}
+	block = append(block, 0)
+	return &block[0]
+}
+
+// release closes the console, which tells its processes to exit, and
+// the input. The output is left to be read to its end, unless it's closed
+// beforehand, as closing the console waits for its output to be read.
+func (pc *pseudoConsole) release() {
+	pc.releaseOnce.Do(func() {
+		procClosePseudoConsole.Call(uintptr(pc.handle))
+		pc.input.Close()
+	})
+}
@@ -1,3 +1,4 @@
 // Package localcommand provides an implementation of webtty.Slave
-// that launches a local command with a PTY.
+// that launches a local command with a PTY, or with a pseudo console
+// (ConPTY) on Windows.
 package localcommand
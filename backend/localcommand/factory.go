@@ -1,15 +1,30 @@
 package localcommand
 
 import (
+	"bytes"
+	"sort"
+	"strings"
 	"syscall"
+	"text/template"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/buptWYChen/gotty/server"
+	"github.com/buptWYChen/gotty/webtty"
 )
 
 type Options struct {
 	CloseSignal  int `hcl:"close_signal" flagName:"close-signal" flagSName:"" flagDescribe:"Signal sent to the command process when gotty close it (default: SIGHUP)" default:"1"`
 	CloseTimeout int `hcl:"close_timeout" flagName:"close-timeout" flagSName:"" flagDescribe:"Time in seconds to force kill process after client is disconnected (default: -1)" default:"-1"`
+
+	WorkingDir string `hcl:"working_dir" flagName:"working-dir" flagSName:"" flagDescribe:"Working directory of the command, a template of the connection such as /home/{{ .user }}" default:""`
+	RunAsUser  string `hcl:"run_as_user" flagName:"run-as-user" flagSName:"" flagDescribe:"OS user to run the command as, a template of the connection such as {{ .user }} (requires root)" default:""`
+
+	AllowedCommands []string `hcl:"allowed_commands"`
+	// Environment variables of the command by name,
+	// each a template of the connection
+	Env map[string]string `hcl:"env"`
 }
 
 type Factory struct {
@@ -17,20 +32,67 @@ type Factory struct {
 	argv    []string
 	options *Options
 	opts    []Option
+
+	// templates of the connections, nil when not set
+	workingDir *template.Template
+	runAsUser  *template.Template
+	env        map[string]*template.Template
+	envNames   []string
 }
 
-func NewFactory(command string, argv []string, options *Options) (*Factory, error) {
+// NewFactory returns a factory of local commands configured with options,
+// extra options are applied after the ones derived from options.
+func NewFactory(command string, argv []string, options *Options, extra ...Option) (*Factory, error) {
 	opts := []Option{WithCloseSignal(syscall.Signal(options.CloseSignal))}
 	if options.CloseTimeout >= 0 {
 		opts = append(opts, WithCloseTimeout(time.Duration(options.CloseTimeout)*time.Second))
 	}
+	if options.AllowedCommands != nil {
+		opts = append(opts, WithAllowedCommands(options.AllowedCommands))
+	}
+	opts = append(opts, extra...)
 
-	return &Factory{
+	factory := &Factory{
 		command: command,
 		argv:    argv,
 		options: options,
 		opts:    opts,
-	}, nil
+	}
+	var err error
+	if factory.workingDir, err = parseConnectionTemplate("working_dir", options.WorkingDir); err != nil {
+		return nil, err
+	}
+	if factory.runAsUser, err = parseConnectionTemplate("run_as_user", options.RunAsUser); err != nil {
+		return nil, err
+	}
+	if len(options.Env) > 0 {
+		factory.env = make(map[string]*template.Template, len(options.Env))
+		for name, value := range options.Env {
+			if name == "" || strings.ContainsAny(name, "=\x00") {
+				return nil, errors.Errorf("invalid environment variable name `%s`", name)
+			}
+			factory.env[name], err = parseConnectionTemplate("env "+name, value)
+			if err != nil {
+				return nil, err
+			}
+			factory.envNames = append(factory.envNames, name)
+		}
+		sort.Strings(factory.envNames)
+	}
+	return factory, nil
+}
+
+// parseConnectionTemplate parses the template of an option applied to each
+// connection, returning nil when value is empty.
+func parseConnectionTemplate(name string, value string) (*template.Template, error) {
+	if value == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse template of %s", name)
+	}
+	return tmpl, nil
 }
 
 func (factory *Factory) Name() string {
@@ -38,11 +100,75 @@ func (factory *Factory) Name() string {
 }
 
 func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	return factory.NewForCluster(webtty.Identity{}, "", params)
+}
+
+func (factory *Factory) NewFor(identity webtty.Identity, params map[string][]string) (server.Slave, error) {
+	return factory.NewForCluster(identity, "", params)
+}
+
+// NewForCluster starts the command with the working directory, the user
+// and the environment of the options filled in for the connection. Their
+// templates are executed with the user the connection acts as, its groups,
+// the address it comes from and the ID of its cluster, such as
+// KUBECONFIG=/etc/kube/{{ .cluster_id }}.conf.
+func (factory *Factory) NewForCluster(identity webtty.Identity, clusterID string, params map[string][]string) (server.Slave, error) {
 	argv := make([]string, len(factory.argv))
 	copy(argv, factory.argv)
 	if params["arg"] != nil && len(params["arg"]) > 0 {
 		argv = append(argv, params["arg"]...)
 	}
 
-	return New(factory.command, argv, factory.opts...)
+	vars := map[string]interface{}{
+		"user":        identity.Target(),
+		"groups":      identity.Groups,
+		"auth_method": identity.AuthMethod,
+		"source_ip":   identity.SourceIP,
+		"cluster_id":  clusterID,
+	}
+	opts := factory.opts
+	if factory.workingDir != nil || factory.runAsUser != nil || factory.env != nil {
+		opts = append([]Option(nil), factory.opts...)
+	}
+	if factory.workingDir != nil {
+		dir, err := executeConnectionTemplate(factory.workingDir, vars)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithWorkingDir(dir))
+	}
+	if factory.runAsUser != nil {
+		name, err := executeConnectionTemplate(factory.runAsUser, vars)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return nil, errors.New("no user to run the command as")
+		}
+		opts = append(opts, WithUser(name))
+	}
+	if factory.env != nil {
+		env := make([]string, 0, len(factory.envNames))
+		for _, name := range factory.envNames {
+			value, err := executeConnectionTemplate(factory.env[name], vars)
+			if err != nil {
+				return nil, err
+			}
+			env = append(env, name+"="+value)
+		}
+		opts = append(opts, WithEnv(env))
+	}
+
+	return New(factory.command, argv, opts...)
+}
+
+func executeConnectionTemplate(tmpl *template.Template, vars map[string]interface{}) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, vars); err != nil {
+		return "", errors.Wrapf(err, "failed to fill template of %s", tmpl.Name())
+	}
+	if strings.ContainsRune(buf.String(), 0) {
+		return "", errors.Errorf("template of %s filled with a NUL", tmpl.Name())
+	}
+	return buf.String(), nil
 }
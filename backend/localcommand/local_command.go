@@ -1,13 +1,11 @@
 package localcommand
 
 import (
-	"os"
+	"log"
 	"os/exec"
 	"syscall"
 	"time"
-	"unsafe"
 
-	"github.com/kr/pty"
 	"github.com/pkg/errors"
 )
 
@@ -16,111 +14,59 @@ const (
 	DefaultCloseTimeout = 10 * time.Second
 )
 
+// exitReasonTimeout bounds the wait for the command to be reaped
+// once its pty is closed.
+var exitReasonTimeout = time.Second
+
+var (
+	// ErrCommandNotAllowed is returned when the command is not in the allowed list.
+	ErrCommandNotAllowed = errors.New("command not allowed")
+)
+
 type LocalCommand struct {
 	command string
 	argv    []string
 
-	closeSignal  syscall.Signal
-	closeTimeout time.Duration
-
-	cmd       *exec.Cmd
-	pty       *os.File
-	ptyClosed chan struct{}
+	closeSignal     syscall.Signal
+	closeTimeout    time.Duration
+	allowedCommands []string
+	rejectionHook   func(command string, argv []string)
+	// environment added to the inherited one, working directory
+	// and OS user of the command, when set
+	env  []string
+	dir  string
+	user string
+
+	// the command and its terminal, a PTY or a pseudo console on Windows
+	terminal
 }
 
 func New(command string, argv []string, options ...Option) (*LocalCommand, error) {
-	cmd := exec.Command(command, argv...)
-
-	pty, err := pty.Start(cmd)
-	if err != nil {
-		// todo close cmd?
-		return nil, errors.Wrapf(err, "failed to start command `%s`", command)
-	}
-	ptyClosed := make(chan struct{})
-
 	lcmd := &LocalCommand{
 		command: command,
 		argv:    argv,
 
 		closeSignal:  DefaultCloseSignal,
 		closeTimeout: DefaultCloseTimeout,
-
-		cmd:       cmd,
-		pty:       pty,
-		ptyClosed: ptyClosed,
 	}
 
 	for _, option := range options {
 		option(lcmd)
 	}
 
-	// When the process is closed by the user,
-	// close pty so that Read() on the pty breaks with an EOF.
-	go func() {
-		defer func() {
-			lcmd.pty.Close()
-			close(lcmd.ptyClosed)
-		}()
-
-		lcmd.cmd.Wait()
-	}()
-
-	return lcmd, nil
-}
-
-func (lcmd *LocalCommand) Read(p []byte) (n int, err error) {
-	return lcmd.pty.Read(p)
-}
-
-func (lcmd *LocalCommand) Write(p []byte) (n int, err error) {
-	return lcmd.pty.Write(p)
-}
-
-func (lcmd *LocalCommand) Close() error {
-	if lcmd.cmd != nil && lcmd.cmd.Process != nil {
-		lcmd.cmd.Process.Signal(lcmd.closeSignal)
-	}
-	for {
-		select {
-		case <-lcmd.ptyClosed:
-			return nil
-		case <-lcmd.closeTimeoutC():
-			lcmd.cmd.Process.Signal(syscall.SIGKILL)
+	if !lcmd.commandAllowed() {
+		log.Printf("Rejected launching command not in the allowed list: %s", command)
+		if lcmd.rejectionHook != nil {
+			lcmd.rejectionHook(command, argv)
 		}
+		return nil, errors.Wrapf(ErrCommandNotAllowed, "failed to start command `%s`", command)
 	}
-}
 
-func (lcmd *LocalCommand) WindowTitleVariables() map[string]interface{} {
-	return map[string]interface{}{
-		"command": lcmd.command,
-		"argv":    lcmd.argv,
-		"pid":     lcmd.cmd.Process.Pid,
+	if err := lcmd.start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start command `%s`", command)
 	}
-}
 
-func (lcmd *LocalCommand) ResizeTerminal(width int, height int) error {
-	window := struct {
-		row uint16
-		col uint16
-		x   uint16
-		y   uint16
-	}{
-		uint16(height),
-		uint16(width),
-		0,
-		0,
-	}
-	_, _, errno := syscall.Syscall(
-		syscall.SYS_IOCTL,
-		lcmd.pty.Fd(),
-		syscall.TIOCSWINSZ,
-		uintptr(unsafe.Pointer(&window)),
-	)
-	if errno != 0 {
-		return errno
-	} else {
-		return nil
-	}
+	return lcmd, nil
 }
 
 func (lcmd *LocalCommand) closeTimeoutC() <-chan time.Time {
@@ -130,3 +76,25 @@ func (lcmd *LocalCommand) closeTimeoutC() <-chan time.Time {
 
 	return make(chan time.Time)
 }
+
+// commandAllowed reports whether the command may be launched.
+// Entries match the command as given or the same resolved executable.
+func (lcmd *LocalCommand) commandAllowed() bool {
+	if lcmd.allowedCommands == nil {
+		return true
+	}
+
+	resolved, err := exec.LookPath(lcmd.command)
+	for _, allowed := range lcmd.allowedCommands {
+		if allowed == lcmd.command {
+			return true
+		}
+		if err != nil {
+			continue
+		}
+		if path, err := exec.LookPath(allowed); err == nil && path == resolved {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+//go:build !windows
+// +build !windows
+
+package localcommand
+
+import (
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/kr/pty"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// terminal is the PTY the command is started with.
+type terminal struct {
+	cmd       *exec.Cmd
+	pty       *os.File
+	ptyClosed chan struct{}
+}
+
+func (lcmd *LocalCommand) start() error {
+	cmd := exec.Command(lcmd.command, lcmd.argv...)
+	cmd.Dir = lcmd.dir
+
+	var credential *syscall.Credential
+	env := os.Environ()
+	if lcmd.user != "" {
+		account, err := user.Lookup(lcmd.user)
+		if err != nil {
+			return errors.Wrapf(err, "failed to look up user `%s`", lcmd.user)
+		}
+		credential, err = userCredential(account)
+		if err != nil {
+			return errors.Wrapf(err, "failed to look up the IDs of user `%s`", lcmd.user)
+		}
+		env = append(env, "HOME="+account.HomeDir, "USER="+account.Username, "LOGNAME="+account.Username)
+	}
+	if lcmd.user != "" || lcmd.env != nil {
+		// the last value of a variable wins
+		cmd.Env = append(env, lcmd.env...)
+	}
+
+	pty, err := startPTY(cmd, credential)
+	if err != nil {
+		// todo close cmd?
+		return err
+	}
+
+	lcmd.cmd = cmd
+	lcmd.pty = pty
+	lcmd.ptyClosed = make(chan struct{})
+
+	// When the process is closed by the user,
+	// close pty so that Read() on the pty breaks with an EOF.
+	go func() {
+		defer func() {
+			lcmd.pty.Close()
+			close(lcmd.ptyClosed)
+		}()
+
+		lcmd.cmd.Wait()
+	}()
+
+	return nil
+}
+
+// startPTY starts cmd attached to a new PTY, like pty.Start,
+// as the user of credential when it's not nil.
+func startPTY(cmd *exec.Cmd, credential *syscall.Credential) (*os.File, error) {
+	ptmx, tty, err := pty.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer tty.Close()
+
+	if credential != nil {
+		// for the command to own its terminal, as with a login
+		if err := tty.Chown(int(credential.Uid), int(credential.Gid)); err != nil {
+			ptmx.Close()
+			return nil, errors.Wrapf(err, "failed to give the terminal to the user")
+		}
+	}
+	cmd.Stdin = tty
+	cmd.Stdout = tty
+	cmd.Stderr = tty
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setctty: true, Setsid: true, Credential: credential}
+	if err := cmd.Start(); err != nil {
+		ptmx.Close()
+		return nil, err
+	}
+	return ptmx, nil
+}
+
+// userCredential returns the user and group IDs of account,
+// along with its supplementary groups.
+func userCredential(account *user.User) (*syscall.Credential, error) {
+	uid, err := strconv.ParseUint(account.Uid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := strconv.ParseUint(account.Gid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	credential := &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}
+
+	groupIDs, err := account.GroupIds()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range groupIDs {
+		group, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		credential.Groups = append(credential.Groups, uint32(group))
+	}
+	return credential, nil
+}
+
+func (lcmd *LocalCommand) Read(p []byte) (n int, err error) {
+	return lcmd.pty.Read(p)
+}
+
+func (lcmd *LocalCommand) Write(p []byte) (n int, err error) {
+	return lcmd.pty.Write(p)
+}
+
+func (lcmd *LocalCommand) Close() error {
+	if lcmd.cmd != nil && lcmd.cmd.Process != nil {
+		lcmd.cmd.Process.Signal(lcmd.closeSignal)
+	}
+	for {
+		select {
+		case <-lcmd.ptyClosed:
+			return nil
+		case <-lcmd.closeTimeoutC():
+			lcmd.cmd.Process.Signal(syscall.SIGKILL)
+		}
+	}
+}
+
+// ExitReason returns the exit status of the command, such as "exit status 1",
+// or an empty string when the command is still running.
+func (lcmd *LocalCommand) ExitReason() string {
+	select {
+	case <-lcmd.ptyClosed:
+	case <-time.After(exitReasonTimeout):
+		return ""
+	}
+	if lcmd.cmd.ProcessState == nil {
+		return ""
+	}
+	return lcmd.cmd.ProcessState.String()
+}
+
+// ExitStatus returns how the command ended, with the signal which killed
+// it if any, or false while it is still running.
+func (lcmd *LocalCommand) ExitStatus() (webtty.ExitStatus, bool) {
+	select {
+	case <-lcmd.ptyClosed:
+	case <-time.After(exitReasonTimeout):
+		return webtty.ExitStatus{}, false
+	}
+	state := lcmd.cmd.ProcessState
+	if state == nil {
+		return webtty.ExitStatus{}, false
+	}
+	status := webtty.ExitStatus{Code: state.ExitCode()}
+	if wait, ok := state.Sys().(syscall.WaitStatus); ok && wait.Signaled() {
+		status.Signal = wait.Signal().String()
+	}
+	return status, true
+}
+
+func (lcmd *LocalCommand) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{
+		"command": lcmd.command,
+		"argv":    lcmd.argv,
+		"pid":     lcmd.cmd.Process.Pid,
+	}
+}
+
+func (lcmd *LocalCommand) ResizeTerminal(width int, height int) error {
+	window := struct {
+		row uint16
+		col uint16
+		x   uint16
+		y   uint16
+	}{
+		uint16(height),
+		uint16(width),
+		0,
+		0,
+	}
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL,
+		lcmd.pty.Fd(),
+		syscall.TIOCSWINSZ,
+		uintptr(unsafe.Pointer(&window)),
+	)
+	if errno != 0 {
+		return errno
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+//go:build windows
+// +build windows
+
+package localcommand
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// initialColumns and initialRows are the size of the pseudo console
+// until the client sends its own.
+const (
+	initialColumns = 80
+	initialRows    = 24
+)
+
+// terminal is the pseudo console the command is started with.
+type terminal struct {
+	console *pseudoConsole
+	process *os.Process
+	state   *os.ProcessState
+	exited  chan struct{}
+}
+
+func (lcmd *LocalCommand) start() error {
+	if lcmd.user != "" {
+		return errors.New("running the command as another user is not supported on Windows")
+	}
+	var env []string
+	if lcmd.env != nil {
+		env = append(os.Environ(), lcmd.env...)
+	}
+
+	console, err := newPseudoConsole(initialColumns, initialRows)
+	if err != nil {
+		return err
+	}
+	process, err := console.start(lcmd.command, lcmd.argv, env, lcmd.dir)
+	if err != nil {
+		console.output.Close()
+		console.release()
+		return err
+	}
+
+	lcmd.console = console
+	lcmd.process = process
+	lcmd.exited = make(chan struct{})
+
+	// When the process is closed by the user, close the console
+	// so that Read() on its output breaks with an EOF once read.
+	go func() {
+		lcmd.state, _ = lcmd.process.Wait()
+		close(lcmd.exited)
+		lcmd.console.release()
+	}()
+
+	return nil
+}
+
+func (lcmd *LocalCommand) Read(p []byte) (n int, err error) {
+	return lcmd.console.output.Read(p)
+}
+
+func (lcmd *LocalCommand) Write(p []byte) (n int, err error) {
+	return lcmd.console.input.Write(p)
+}
+
+// Close closes the pseudo console, which sends CTRL_CLOSE_EVENT to the
+// command in place of the close signal, and kills the command when it's
+// still running after the close timeout.
+func (lcmd *LocalCommand) Close() error {
+	// the output isn't read anymore, closing the console mustn't wait for it
+	lcmd.console.output.Close()
+	lcmd.console.release()
+	for {
+		select {
+		case <-lcmd.exited:
+			return nil
+		case <-lcmd.closeTimeoutC():
+			lcmd.process.Kill()
+		}
+	}
+}
+
+// ExitReason returns the exit status of the command, such as "exit status 1",
+// or an empty string when the command is still running.
+func (lcmd *LocalCommand) ExitReason() string {
+	select {
+	case <-lcmd.exited:
+	case <-time.After(exitReasonTimeout):
+		return ""
+	}
+	if lcmd.state == nil {
+		return ""
+	}
+	return lcmd.state.String()
+}
+
+// ExitStatus returns the exit code of the command, or false while it is
+// still running. Windows has no signals, a killed command exits with 1.
+func (lcmd *LocalCommand) ExitStatus() (webtty.ExitStatus, bool) {
+	select {
+	case <-lcmd.exited:
+	case <-time.After(exitReasonTimeout):
+		return webtty.ExitStatus{}, false
+	}
+	if lcmd.state == nil {
+		return webtty.ExitStatus{}, false
+	}
+	return webtty.ExitStatus{Code: lcmd.state.ExitCode()}, true
+}
+
+func (lcmd *LocalCommand) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{
+		"command": lcmd.command,
+		"argv":    lcmd.argv,
+		"pid":     lcmd.process.Pid,
+	}
+}
+
+func (lcmd *LocalCommand) ResizeTerminal(width int, height int) error {
+	return lcmd.console.resize(width, height)
+}
@@ -18,3 +18,45 @@ func WithCloseTimeout(timeout time.Duration) Option {
 		lcmd.closeTimeout = timeout
 	}
 }
+
+// WithAllowedCommands restricts the executables that can be launched.
+// An empty list rejects every command, nil allows any command.
+func WithAllowedCommands(commands []string) Option {
+	return func(lcmd *LocalCommand) {
+		lcmd.allowedCommands = commands
+	}
+}
+
+// WithRejectionHook sets a function called with each command
+// rejected because it is not in the allowed list, so that
+// the rejection can be recorded in the audit trail.
+func WithRejectionHook(hook func(command string, argv []string)) Option {
+	return func(lcmd *LocalCommand) {
+		lcmd.rejectionHook = hook
+	}
+}
+
+// WithEnv adds env, as KEY=value entries, to the environment the
+// command inherits from gotty. Entries override the inherited ones.
+func WithEnv(env []string) Option {
+	return func(lcmd *LocalCommand) {
+		lcmd.env = append(lcmd.env, env...)
+	}
+}
+
+// WithWorkingDir starts the command in dir,
+// the working directory of gotty when empty.
+func WithWorkingDir(dir string) Option {
+	return func(lcmd *LocalCommand) {
+		lcmd.dir = dir
+	}
+}
+
+// WithUser runs the command as the OS user of name, with its groups,
+// and its HOME, USER and LOGNAME in the environment. gotty must be
+// running as root to switch users, it's not supported on Windows.
+func WithUser(name string) Option {
+	return func(lcmd *LocalCommand) {
+		lcmd.user = name
+	}
+}
@@ -0,0 +1,6 @@
+// Package replay provides an implementation of webtty.Slave that plays
+// a recorded session back, for auditors to review the sessions in the
+// same browser UI as the live terminals. The recordings are asciicast
+// files, as the ones of the record directory of the server, or the
+// captures of webtty.WithSessionCapture.
+package replay
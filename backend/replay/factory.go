@@ -0,0 +1,65 @@
+package replay
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/server"
+)
+
+type Factory struct {
+	options *Options
+	opts    []Option
+}
+
+// NewFactory returns a factory of replays configured with options,
+// extra options are applied after the ones derived from options.
+func NewFactory(options *Options, extra ...Option) (*Factory, error) {
+	if options.IdleLimit < 0 {
+		return nil, errors.New("replay idle limit must not be negative")
+	}
+	if options.MaxSize < 0 {
+		return nil, errors.New("replay max size must not be negative")
+	}
+
+	opts := []Option{WithMaxSize(options.MaxSize)}
+	if options.IdleLimit > 0 {
+		opts = append(opts, WithIdleLimit(time.Duration(options.IdleLimit)*time.Second))
+	}
+	opts = append(opts, extra...)
+
+	return &Factory{
+		options: options,
+		opts:    opts,
+	}, nil
+}
+
+func (factory *Factory) Name() string {
+	return "recording replay"
+}
+
+func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	path := homedir.Expand(factory.options.Recording)
+	if len(params["recording"]) > 0 {
+		name := params["recording"][0]
+		if factory.options.Dir == "" {
+			return nil, errors.New("recordings can't be selected without a replay directory")
+		}
+		// keep the recordings inside the directory
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return nil, errors.Errorf("invalid recording name `%s`", name)
+		}
+		path = filepath.Join(homedir.Expand(factory.options.Dir), name)
+	} else if path != "" && factory.options.Dir != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(homedir.Expand(factory.options.Dir), path)
+	}
+	if path == "" {
+		return nil, errors.New("recording is required")
+	}
+
+	return New(path, factory.opts...)
+}
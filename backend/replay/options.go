@@ -0,0 +1,47 @@
+package replay
+
+import (
+	"time"
+)
+
+type Options struct {
+	Dir       string `hcl:"replay_dir" flagName:"replay-dir" flagSName:"" flagDescribe:"Directory of the recordings the clients select with the recording URL parameter, such as the record directory" default:""`
+	Recording string `hcl:"replay_recording" flagName:"replay-recording" flagSName:"" flagDescribe:"Recording to replay, unless selected with the recording URL parameter" default:""`
+	IdleLimit int    `hcl:"replay_idle_limit" flagName:"replay-idle-limit" flagSName:"" flagDescribe:"Seconds the pauses of the recordings are shortened to (0 to disable)" default:"0"`
+	MaxSize   int    `hcl:"replay_max_size" flagName:"replay-max-size" flagSName:"" flagDescribe:"Size in bytes of the largest recording replayed, as it's loaded in memory" default:"67108864"`
+}
+
+type Option func(*Replay)
+
+// WithSpeed sets the playback rate the replay starts at, 2 to play twice
+// as fast, between MinSpeed and MaxSpeed.
+func WithSpeed(speed float64) Option {
+	return func(replay *Replay) {
+		replay.speed = clampSpeed(speed)
+	}
+}
+
+// WithIdleLimit shortens the pauses of the recording to limit,
+// such as the time a user left the terminal idle.
+func WithIdleLimit(limit time.Duration) Option {
+	return func(replay *Replay) {
+		replay.idleLimit = limit
+	}
+}
+
+// WithMaxSize rejects the recordings larger than size bytes,
+// which are loaded in memory. Zero allows any size.
+func WithMaxSize(size int) Option {
+	return func(replay *Replay) {
+		replay.maxSize = size
+	}
+}
+
+// WithRawFrames tells the frames of a capture are sent as raw bytes,
+// as with webtty.EncodingRaw or the BinaryFrames feature, rather than
+// encoded with base64.
+func WithRawFrames() Option {
+	return func(replay *Replay) {
+		replay.rawFrames = true
+	}
+}
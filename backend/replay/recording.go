@@ -0,0 +1,163 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// event is output of the recording, at its offset from the start.
+type event struct {
+	at   time.Duration
+	data []byte
+}
+
+type castHeader struct {
+	Version int `json:"version"`
+	Width   int `json:"width"`
+	Height  int `json:"height"`
+}
+
+// load reads the output events of a recording, an asciicast v2 file or
+// a capture of webtty.WithSessionCapture, told apart by its first line.
+// The input events, the resizes and the frames other than output
+// are skipped.
+func (replay *Replay) load(reader io.Reader) error {
+	if replay.maxSize > 0 {
+		reader = io.LimitReader(reader, int64(replay.maxSize)+1)
+	}
+	buffered := bufio.NewReader(reader)
+
+	var parse func(line []byte) (*event, error)
+	size := 0
+	for number := 1; ; number++ {
+		line, err := buffered.ReadBytes('\n')
+		size += len(line)
+		if replay.maxSize > 0 && size > replay.maxSize {
+			return errors.Errorf("recording larger than %d bytes", replay.maxSize)
+		}
+		if err != nil && err != io.EOF {
+			return errors.Wrapf(err, "failed to read recording")
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && parse == nil {
+			var header castHeader
+			json.Unmarshal(line, &header)
+			switch header.Version {
+			case 2:
+				replay.columns, replay.rows = header.Width, header.Height
+				parse = parseCastEvent
+				line = nil
+			case 0:
+				parse = replay.parseCaptureRecord
+			default:
+				return errors.Errorf("unsupported asciicast version %d", header.Version)
+			}
+		}
+		if len(line) > 0 {
+			ev, perr := parse(line)
+			if perr != nil {
+				return errors.Wrapf(perr, "malformed line %d of recording", number)
+			}
+			if ev != nil {
+				replay.addEvent(*ev)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+	if parse == nil {
+		return errors.New("empty recording")
+	}
+	return nil
+}
+
+// addEvent appends ev, with its pause from the previous event shortened
+// to the idle limit.
+func (replay *Replay) addEvent(ev event) {
+	ev.at -= replay.skipped
+	if ev.at < replay.duration {
+		// events are expected in order
+		ev.at = replay.duration
+	}
+	if replay.idleLimit > 0 && ev.at-replay.duration > replay.idleLimit {
+		replay.skipped += ev.at - replay.duration - replay.idleLimit
+		ev.at = replay.duration + replay.idleLimit
+	}
+	replay.events = append(replay.events, ev)
+	replay.duration = ev.at
+}
+
+// parseCastEvent parses an event line of an asciicast,
+// such as [1.5, "o", "ls\r\n"], returning nil unless it's output.
+func parseCastEvent(line []byte) (*event, error) {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) != 3 {
+		return nil, errors.Errorf("event of %d fields", len(fields))
+	}
+	var seconds float64
+	var kind, data string
+	if err := json.Unmarshal(fields[0], &seconds); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(fields[1], &kind); err != nil {
+		return nil, err
+	}
+	if kind != "o" {
+		return nil, nil
+	}
+	if err := json.Unmarshal(fields[2], &data); err != nil {
+		return nil, err
+	}
+	return &event{at: time.Duration(seconds * float64(time.Second)), data: []byte(data)}, nil
+}
+
+// parseCaptureRecord parses a record of a capture, returning nil unless
+// it's an Output or a CompressedOutput frame written to the master.
+func (replay *Replay) parseCaptureRecord(line []byte) (*event, error) {
+	var record webtty.CaptureRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return nil, err
+	}
+	if record.Direction != webtty.SlaveToMaster || len(record.Frame) == 0 {
+		return nil, nil
+	}
+
+	kind, payload := record.Frame[0], record.Frame[1:]
+	if kind != webtty.Output && kind != webtty.CompressedOutput {
+		return nil, nil
+	}
+	if !replay.rawFrames {
+		decoded, err := base64.StdEncoding.DecodeString(string(payload))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode output frame")
+		}
+		payload = decoded
+	}
+	if kind == webtty.CompressedOutput {
+		reader, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress output frame")
+		}
+		payload, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress output frame")
+		}
+	}
+	return &event{at: record.Offset, data: payload}, nil
+}
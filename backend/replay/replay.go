@@ -0,0 +1,326 @@
+package replay
+
+import (
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Playback rates of a replay.
+const (
+	MinSpeed = 1.0 / 16
+	MaxSpeed = 16.0
+)
+
+// seekStep is how far the arrow keys seek.
+const seekStep = 5 * time.Second
+
+// resetTerminal is written before the output is replayed again from the
+// start, when seeking backward.
+const resetTerminal = "\x1bc"
+
+// seekKeys are the arrow keys seeking forward and backward.
+var seekKeys = map[string]time.Duration{
+	"\x1b[C": seekStep, "\x1bOC": seekStep,
+	"\x1b[D": -seekStep, "\x1bOD": -seekStep,
+}
+
+// Replay plays the output of a recording back with its original timing.
+// It's controlled with webtty.ControlReplay messages and, when the master
+// may write, with keys: space pauses and resumes, + and - double and halve
+// the speed, = restores it and the right and left arrows seek 5 seconds
+// forward and backward. At the end of the recording the replay pauses,
+// resuming it plays the recording again.
+type Replay struct {
+	name      string
+	maxSize   int
+	idleLimit time.Duration
+	rawFrames bool
+
+	events   []event
+	duration time.Duration
+	// time removed from the events by the idle limit while loading
+	skipped time.Duration
+	// size of the recorded terminal, zero when unknown
+	columns int
+	rows    int
+
+	mutex sync.Mutex
+	// index of the next event to play
+	next int
+	// playback position at since, or where the replay is paused
+	position time.Duration
+	since    time.Time
+	speed    float64
+	paused   bool
+	// output played but not read yet
+	pending []byte
+	changed chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// New loads the recording at path to replay it.
+func New(path string, options ...Option) (*Replay, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open recording `%s`", path)
+	}
+	defer file.Close()
+
+	return Load(filepath.Base(path), file, options...)
+}
+
+// Load reads a recording named name from reader to replay it,
+// such as one kept in an object storage.
+func Load(name string, reader io.Reader, options ...Option) (*Replay, error) {
+	replay := &Replay{
+		name:    name,
+		speed:   1,
+		changed: make(chan struct{}, 1),
+		closed:  make(chan struct{}),
+	}
+	for _, option := range options {
+		option(replay)
+	}
+
+	err := replay.load(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load recording `%s`", name)
+	}
+	replay.since = time.Now()
+	return replay, nil
+}
+
+// Read returns the output of the recording as it's played.
+func (replay *Replay) Read(p []byte) (int, error) {
+	for {
+		replay.mutex.Lock()
+		now := time.Now()
+		replay.playLocked(now)
+		if len(replay.pending) > 0 {
+			n := copy(p, replay.pending)
+			replay.pending = replay.pending[n:]
+			if len(replay.pending) == 0 {
+				replay.pending = nil
+			}
+			replay.mutex.Unlock()
+			return n, nil
+		}
+		var timer *time.Timer
+		var due <-chan time.Time
+		if !replay.paused {
+			wait := time.Duration(float64(replay.events[replay.next].at-replay.positionLocked(now)) / replay.speed)
+			timer = time.NewTimer(wait)
+			due = timer.C
+		}
+		replay.mutex.Unlock()
+
+		select {
+		case <-due:
+		case <-replay.changed:
+		case <-replay.closed:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		select {
+		case <-replay.closed:
+			return 0, io.EOF
+		default:
+		}
+	}
+}
+
+// Write applies the keys controlling the replay, others are ignored.
+func (replay *Replay) Write(p []byte) (int, error) {
+	replay.mutex.Lock()
+	defer replay.mutex.Unlock()
+
+	now := time.Now()
+	for keys := string(p); len(keys) > 0; keys = keys[1:] {
+		switch keys[0] {
+		case ' ':
+			if replay.paused {
+				replay.resumeLocked(now)
+			} else {
+				replay.pauseLocked(now)
+			}
+		case '+':
+			replay.setSpeedLocked(math.Min(replay.speed*2, MaxSpeed), now)
+		case '-':
+			replay.setSpeedLocked(math.Max(replay.speed/2, MinSpeed), now)
+		case '=':
+			replay.setSpeedLocked(1, now)
+		case 0x1b:
+			for key, step := range seekKeys {
+				if strings.HasPrefix(keys, key) {
+					replay.seekLocked(replay.positionLocked(now)+step, now)
+					keys = keys[len(key)-1:]
+					break
+				}
+			}
+		}
+	}
+	replay.signalLocked()
+	return len(p), nil
+}
+
+// ControlReplay applies a control sent by the master.
+func (replay *Replay) ControlReplay(control webtty.ReplayControl) error {
+	replay.mutex.Lock()
+	defer replay.mutex.Unlock()
+
+	now := time.Now()
+	switch control.Action {
+	case webtty.ReplayPause:
+		replay.pauseLocked(now)
+	case webtty.ReplayResume:
+		replay.resumeLocked(now)
+	case webtty.ReplaySpeed:
+		if control.Speed < MinSpeed || control.Speed > MaxSpeed {
+			return errors.Errorf("replay speed must be between %g and %g", MinSpeed, MaxSpeed)
+		}
+		replay.setSpeedLocked(control.Speed, now)
+	case webtty.ReplaySeek:
+		replay.seekLocked(time.Duration(control.Offset*float64(time.Second)), now)
+	default:
+		return errors.Errorf("unknown replay action `%s`", control.Action)
+	}
+	replay.signalLocked()
+	return nil
+}
+
+// Position returns how far the replay is in the recording,
+// and the duration of the recording.
+func (replay *Replay) Position() (time.Duration, time.Duration) {
+	replay.mutex.Lock()
+	defer replay.mutex.Unlock()
+
+	position := replay.positionLocked(time.Now())
+	if position > replay.duration {
+		position = replay.duration
+	}
+	return position, replay.duration
+}
+
+// Size returns the size of the recorded terminal, zero when the recording
+// doesn't tell it. The terminal of the master keeps its own size.
+func (replay *Replay) Size() (columns int, rows int) {
+	return replay.columns, replay.rows
+}
+
+// Close stops the replay, the pending and following reads return io.EOF.
+func (replay *Replay) Close() error {
+	replay.closeOnce.Do(func() {
+		close(replay.closed)
+	})
+	return nil
+}
+
+func (replay *Replay) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{
+		"command":   "replay",
+		"argv":      []string{replay.name},
+		"recording": replay.name,
+		"duration":  replay.duration.String(),
+	}
+}
+
+// ResizeTerminal does nothing, the output was laid out for the size
+// of the recorded terminal.
+func (replay *Replay) ResizeTerminal(columns int, rows int) error {
+	return nil
+}
+
+func (replay *Replay) positionLocked(now time.Time) time.Duration {
+	if replay.paused {
+		return replay.position
+	}
+	return replay.position + time.Duration(float64(now.Sub(replay.since))*replay.speed)
+}
+
+// playLocked moves the output of the events due by now to pending,
+// and pauses the replay at the end of the recording.
+func (replay *Replay) playLocked(now time.Time) {
+	position := replay.positionLocked(now)
+	for replay.next < len(replay.events) && replay.events[replay.next].at <= position {
+		replay.pending = append(replay.pending, replay.events[replay.next].data...)
+		replay.next++
+	}
+	if replay.next == len(replay.events) && !replay.paused {
+		replay.position = replay.duration
+		replay.paused = true
+	}
+}
+
+func (replay *Replay) pauseLocked(now time.Time) {
+	replay.position = replay.positionLocked(now)
+	replay.paused = true
+}
+
+func (replay *Replay) resumeLocked(now time.Time) {
+	if !replay.paused {
+		return
+	}
+	if replay.next == len(replay.events) {
+		replay.seekLocked(0, now)
+	}
+	replay.since = now
+	replay.paused = false
+}
+
+func (replay *Replay) setSpeedLocked(speed float64, now time.Time) {
+	replay.position = replay.positionLocked(now)
+	replay.since = now
+	replay.speed = speed
+}
+
+// seekLocked moves the replay to position, the output up to it is played
+// at once. Seeking backward resets the terminal of the master
+// and plays the recording from the start.
+func (replay *Replay) seekLocked(position time.Duration, now time.Time) {
+	if position < 0 {
+		position = 0
+	}
+	if position > replay.duration {
+		position = replay.duration
+	}
+	i := sort.Search(len(replay.events), func(i int) bool {
+		return replay.events[i].at > position
+	})
+
+	from := replay.next
+	if i < replay.next {
+		replay.pending = append(replay.pending[:0], resetTerminal...)
+		from = 0
+	}
+	for _, ev := range replay.events[from:i] {
+		replay.pending = append(replay.pending, ev.data...)
+	}
+	replay.next = i
+	replay.position = position
+	replay.since = now
+}
+
+// signalLocked wakes up a pending read to apply a change.
+func (replay *Replay) signalLocked() {
+	select {
+	case replay.changed <- struct{}{}:
+	default:
+	}
+}
+
+func clampSpeed(speed float64) float64 {
+	return math.Max(MinSpeed, math.Min(speed, MaxSpeed))
+}
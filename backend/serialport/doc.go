@@ -0,0 +1,10 @@
+// Package serialport provides an implementation of webtty.Slave
+// that opens a serial device, for gotty to serve as a web based
+// console server of the network appliances and the boards connected
+// to its host.
+//
+// It depends on github.com/jacobsa/go-serial, vendored in vendor/,
+// and is only built with the serialport build tag:
+//
+//	go build -tags serialport
+package serialport
@@ -0,0 +1,118 @@
+//go:build serialport
+// +build serialport
+
+package serialport
+
+import (
+	"sync"
+
+	"github.com/jacobsa/go-serial/serial"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/server"
+)
+
+var (
+	// ErrDeviceNotAllowed is returned when a client selects a device
+	// that is not in the allowed list.
+	ErrDeviceNotAllowed = errors.New("serial device not allowed")
+	// ErrDeviceInUse is returned when the device is opened by another
+	// session, whose output can be watched with session sharing.
+	ErrDeviceInUse = errors.New("serial device in use")
+)
+
+type Factory struct {
+	options *Options
+	opts    []Option
+
+	mutex sync.Mutex
+	inUse map[string]bool
+}
+
+// NewFactory returns a factory of serial consoles configured with options,
+// extra options are applied after the ones derived from options.
+// A device is opened by a single session at a time.
+func NewFactory(options *Options, extra ...Option) (*Factory, error) {
+	var parity serial.ParityMode
+	switch options.Parity {
+	case "none":
+		parity = serial.PARITY_NONE
+	case "odd":
+		parity = serial.PARITY_ODD
+	case "even":
+		parity = serial.PARITY_EVEN
+	default:
+		return nil, errors.Errorf("unknown serial parity `%s`", options.Parity)
+	}
+	if options.BaudRate <= 0 {
+		return nil, errors.New("serial baud rate must be positive")
+	}
+	if options.DataBits < 5 || options.DataBits > 8 {
+		return nil, errors.New("serial data bits must be 5 to 8")
+	}
+	if options.StopBits != 1 && options.StopBits != 2 {
+		return nil, errors.New("serial stop bits must be 1 or 2")
+	}
+
+	opts := []Option{WithLineSettings(options.BaudRate, options.DataBits, options.StopBits, parity)}
+	switch options.FlowControl {
+	case FlowControlNone:
+	case FlowControlRTSCTS:
+		opts = append(opts, WithHardwareFlowControl())
+	default:
+		return nil, errors.Errorf("unknown serial flow control `%s`", options.FlowControl)
+	}
+	opts = append(opts, extra...)
+
+	return &Factory{
+		options: options,
+		opts:    opts,
+		inUse:   make(map[string]bool),
+	}, nil
+}
+
+func (factory *Factory) Name() string {
+	return "serial device"
+}
+
+func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	device := factory.options.Device
+	if len(params["device"]) > 0 {
+		device = params["device"][0]
+	}
+	if device == "" {
+		return nil, errors.New("serial device is required")
+	}
+	if !factory.deviceAllowed(device) {
+		return nil, errors.Wrapf(ErrDeviceNotAllowed, "failed to open `%s`", device)
+	}
+
+	factory.mutex.Lock()
+	if factory.inUse[device] {
+		factory.mutex.Unlock()
+		return nil, errors.Wrapf(ErrDeviceInUse, "failed to open `%s`", device)
+	}
+	factory.inUse[device] = true
+	factory.mutex.Unlock()
+
+	release := func() {
+		factory.mutex.Lock()
+		delete(factory.inUse, device)
+		factory.mutex.Unlock()
+	}
+	opts := append(append([]Option(nil), factory.opts...), WithRelease(release))
+	return New(device, opts...)
+}
+
+// deviceAllowed reports whether device may be opened.
+func (factory *Factory) deviceAllowed(device string) bool {
+	if device == factory.options.Device {
+		return true
+	}
+	for _, allowed := range factory.options.AllowedDevices {
+		if allowed == device {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+//go:build serialport
+// +build serialport
+
+package serialport
+
+import (
+	"github.com/jacobsa/go-serial/serial"
+)
+
+// Flow controls, see Options.FlowControl.
+const (
+	// No flow control
+	FlowControlNone = "none"
+	// Hardware flow control with the RTS and CTS lines
+	FlowControlRTSCTS = "rtscts"
+)
+
+type Options struct {
+	Device      string `hcl:"serial_device" flagName:"serial-device" flagSName:"" flagDescribe:"Serial device of the console, such as /dev/ttyUSB0, unless selected with the device URL parameter" default:""`
+	BaudRate    int    `hcl:"serial_baud_rate" flagName:"serial-baud-rate" flagSName:"" flagDescribe:"Baud rate of the serial line" default:"115200"`
+	DataBits    int    `hcl:"serial_data_bits" flagName:"serial-data-bits" flagSName:"" flagDescribe:"Data bits of the characters, 5 to 8" default:"8"`
+	StopBits    int    `hcl:"serial_stop_bits" flagName:"serial-stop-bits" flagSName:"" flagDescribe:"Stop bits of the characters, 1 or 2" default:"1"`
+	Parity      string `hcl:"serial_parity" flagName:"serial-parity" flagSName:"" flagDescribe:"Parity of the characters: none, odd or even" default:"none"`
+	FlowControl string `hcl:"serial_flow_control" flagName:"serial-flow-control" flagSName:"" flagDescribe:"Flow control of the serial line: none or rtscts" default:"none"`
+
+	// Devices the clients may select with the device URL parameter,
+	// none when nil, as any file of the host could be opened otherwise
+	AllowedDevices []string `hcl:"serial_allowed_devices"`
+}
+
+type Option func(*SerialPort)
+
+// WithLineSettings sets the baud rate, the data bits, the stop bits
+// and the parity of the serial line.
+func WithLineSettings(baudRate int, dataBits int, stopBits int, parity serial.ParityMode) Option {
+	return func(port *SerialPort) {
+		port.options.BaudRate = uint(baudRate)
+		port.options.DataBits = uint(dataBits)
+		port.options.StopBits = uint(stopBits)
+		port.options.ParityMode = parity
+	}
+}
+
+// WithHardwareFlowControl enables the flow control with the RTS and CTS lines.
+func WithHardwareFlowControl() Option {
+	return func(port *SerialPort) {
+		port.options.RTSCTSFlowControl = true
+	}
+}
+
+// WithRelease sets a function called once the device is closed,
+// such as to let another session open it.
+func WithRelease(release func()) Option {
+	return func(port *SerialPort) {
+		port.release = release
+	}
+}
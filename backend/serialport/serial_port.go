@@ -0,0 +1,145 @@
+//go:build serialport
+// +build serialport
+
+package serialport
+
+import (
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/jacobsa/go-serial/serial"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBaudRate = 115200
+	DefaultDataBits = 8
+	DefaultStopBits = 1
+)
+
+// readTimeout is the time in milliseconds a read of the device waits
+// for a character, it's retried until the device is closed. Blocking
+// reads wouldn't end when the device is closed.
+const readTimeout = 100
+
+// SerialPort is the console of a device connected to a serial line.
+type SerialPort struct {
+	device  string
+	options serial.OpenOptions
+	release func()
+
+	port io.ReadWriteCloser
+
+	mutex   sync.Mutex
+	closed  bool
+	readErr error
+}
+
+// New opens device, a raw serial line of 115200 bauds with 8 data bits,
+// no parity and 1 stop bit unless set otherwise by options.
+func New(device string, options ...Option) (*SerialPort, error) {
+	port := &SerialPort{
+		device: device,
+		options: serial.OpenOptions{
+			PortName:   device,
+			BaudRate:   DefaultBaudRate,
+			DataBits:   DefaultDataBits,
+			StopBits:   DefaultStopBits,
+			ParityMode: serial.PARITY_NONE,
+
+			InterCharacterTimeout: readTimeout,
+			MinimumReadSize:       0,
+		},
+	}
+
+	for _, option := range options {
+		option(port)
+	}
+
+	var err error
+	port.port, err = serial.Open(port.options)
+	if err != nil {
+		if port.release != nil {
+			port.release()
+		}
+		return nil, errors.Wrapf(err, "failed to open serial device `%s`", device)
+	}
+
+	return port, nil
+}
+
+func (port *SerialPort) Read(p []byte) (n int, err error) {
+	for {
+		n, err = port.port.Read(p)
+		if n > 0 || (err != nil && err != io.EOF) {
+			break
+		}
+		// nothing came within the read timeout
+		if port.isClosed() {
+			return 0, io.EOF
+		}
+	}
+	if err != nil && err != io.EOF && !port.isClosed() {
+		port.mutex.Lock()
+		port.readErr = err
+		port.mutex.Unlock()
+	}
+	return n, err
+}
+
+func (port *SerialPort) Write(p []byte) (n int, err error) {
+	return port.port.Write(p)
+}
+
+// Close closes the device, for the next session to open it.
+func (port *SerialPort) Close() error {
+	port.mutex.Lock()
+	if port.closed {
+		port.mutex.Unlock()
+		return nil
+	}
+	port.closed = true
+	port.mutex.Unlock()
+
+	err := port.port.Close()
+	if port.release != nil {
+		port.release()
+	}
+	return err
+}
+
+// ExitReason returns the error which ended the reads of the device,
+// such as a disconnected adapter, or an empty string.
+func (port *SerialPort) ExitReason() string {
+	port.mutex.Lock()
+	defer port.mutex.Unlock()
+
+	if port.readErr == nil {
+		return ""
+	}
+	return "device error: " + port.readErr.Error()
+}
+
+func (port *SerialPort) WindowTitleVariables() map[string]interface{} {
+	baudRate := strconv.Itoa(int(port.options.BaudRate))
+	return map[string]interface{}{
+		"command":   "serial",
+		"argv":      []string{port.device, baudRate},
+		"device":    port.device,
+		"baud_rate": baudRate,
+	}
+}
+
+// ResizeTerminal does nothing, a serial line has no window size.
+// The size can be set on the device with stty, for instance.
+func (port *SerialPort) ResizeTerminal(width int, height int) error {
+	return nil
+}
+
+func (port *SerialPort) isClosed() bool {
+	port.mutex.Lock()
+	defer port.mutex.Unlock()
+
+	return port.closed
+}
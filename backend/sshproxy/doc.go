@@ -0,0 +1,9 @@
+// Package sshproxy provides an implementation of webtty.Slave
+// that opens a shell on a remote host over SSH, for gotty
+// to serve as a web based SSH gateway.
+//
+// It depends on golang.org/x/crypto/ssh, vendored in vendor/,
+// and is only built with the sshproxy build tag:
+//
+//	go build -tags sshproxy
+package sshproxy
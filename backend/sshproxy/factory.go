@@ -0,0 +1,155 @@
+//go:build sshproxy
+// +build sshproxy
+
+package sshproxy
+
+import (
+	"io/ioutil"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/server"
+)
+
+var (
+	// ErrHostNotAllowed is returned when a client selects a host
+	// that is not in the allowed list.
+	ErrHostNotAllowed = errors.New("ssh host not allowed")
+)
+
+type Factory struct {
+	options  *Options
+	auth     []ssh.AuthMethod
+	hostKeys ssh.HostKeyCallback
+	opts     []Option
+}
+
+// NewFactory returns a factory of SSH shells configured with options,
+// extra options are applied after the ones derived from options.
+// The key file and the known hosts file are read once, here.
+func NewFactory(options *Options, extra ...Option) (*Factory, error) {
+	var auth []ssh.AuthMethod
+	if options.KeyFile != "" {
+		key, err := ioutil.ReadFile(homedir.Expand(options.KeyFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read ssh key file")
+		}
+		var signer ssh.Signer
+		if options.KeyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(options.KeyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey(key)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse ssh key file")
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if options.Password != "" {
+		auth = append(auth, ssh.Password(options.Password))
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("ssh key file or password is required")
+	}
+
+	hostKeys, err := hostKeyCallback(options)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []Option{WithTerm(options.Term)}
+	if options.ConnectTimeout > 0 {
+		opts = append(opts, WithConnectTimeout(time.Duration(options.ConnectTimeout)*time.Second))
+	}
+	opts = append(opts, extra...)
+
+	return &Factory{
+		options:  options,
+		auth:     auth,
+		hostKeys: hostKeys,
+		opts:     opts,
+	}, nil
+}
+
+func (factory *Factory) Name() string {
+	return "ssh host"
+}
+
+func (factory *Factory) New(params map[string][]string) (server.Slave, error) {
+	host, user := factory.options.Host, factory.options.User
+	if factory.options.PermitTargetParams {
+		if len(params["host"]) > 0 {
+			host = params["host"][0]
+		}
+		if len(params["user"]) > 0 {
+			user = params["user"][0]
+		}
+	}
+	if host == "" || user == "" {
+		return nil, errors.New("ssh host and user are required")
+	}
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+	if !factory.hostAllowed(host) {
+		return nil, errors.Wrapf(ErrHostNotAllowed, "failed to connect to `%s`", host)
+	}
+
+	config := &ssh.ClientConfig{
+		User:            user,
+		Auth:            factory.auth,
+		HostKeyCallback: factory.hostKeys,
+	}
+	return New(host, config, factory.opts...)
+}
+
+// hostAllowed reports whether host may be connected to.
+// Entries match the host with or without its port.
+func (factory *Factory) hostAllowed(host string) bool {
+	if factory.options.AllowedHosts == nil || host == factory.options.Host {
+		return true
+	}
+
+	name, _, _ := net.SplitHostPort(host)
+	for _, allowed := range factory.options.AllowedHosts {
+		if allowed == host || allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hostKeyCallback returns the host key verification of the policy of options.
+func hostKeyCallback(options *Options) (ssh.HostKeyCallback, error) {
+	switch options.HostKeyPolicy {
+	case HostKeyKnownHosts:
+		callback, err := knownhosts.New(homedir.Expand(options.KnownHostsFile))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read known hosts file")
+		}
+		return callback, nil
+	case HostKeyFingerprint:
+		if len(options.HostKeyFingerprints) == 0 {
+			return nil, errors.New("fingerprint host key policy requires fingerprints")
+		}
+		fingerprints := options.HostKeyFingerprints
+		return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			fingerprint := ssh.FingerprintSHA256(key)
+			for _, trusted := range fingerprints {
+				if trusted == fingerprint {
+					return nil
+				}
+			}
+			return errors.Errorf("host key %s of `%s` is not trusted", fingerprint, hostname)
+		}, nil
+	case HostKeyInsecure:
+		return ssh.InsecureIgnoreHostKey(), nil
+	default:
+		return nil, errors.Errorf("unknown host key policy `%s`", options.HostKeyPolicy)
+	}
+}
@@ -0,0 +1,55 @@
+//go:build sshproxy
+// +build sshproxy
+
+package sshproxy
+
+import (
+	"time"
+)
+
+// Host key policies, see Options.HostKeyPolicy.
+const (
+	// Host keys must be listed in the known hosts file
+	HostKeyKnownHosts = "known_hosts"
+	// Host keys must have one of the fingerprints given
+	HostKeyFingerprint = "fingerprint"
+	// Any host key is accepted, for tests only
+	HostKeyInsecure = "insecure"
+)
+
+type Options struct {
+	Host           string `hcl:"ssh_host" flagName:"ssh-host" flagSName:"" flagDescribe:"Host to open the shells on, as host:port" default:""`
+	User           string `hcl:"ssh_user" flagName:"ssh-user" flagSName:"" flagDescribe:"User to log in as on the SSH host" default:""`
+	Password       string `hcl:"ssh_password"`
+	KeyFile        string `hcl:"ssh_key_file" flagName:"ssh-key-file" flagSName:"" flagDescribe:"Private key file to log in with" default:""`
+	KeyPassphrase  string `hcl:"ssh_key_passphrase"`
+	HostKeyPolicy  string `hcl:"ssh_host_key_policy" flagName:"ssh-host-key-policy" flagSName:"" flagDescribe:"How host keys are verified: known_hosts, fingerprint or insecure" default:"known_hosts"`
+	KnownHostsFile string `hcl:"ssh_known_hosts_file" flagName:"ssh-known-hosts-file" flagSName:"" flagDescribe:"Known hosts file for the known_hosts policy" default:"~/.ssh/known_hosts"`
+	ConnectTimeout int    `hcl:"ssh_connect_timeout" flagName:"ssh-connect-timeout" flagSName:"" flagDescribe:"Seconds to wait for the SSH host to accept the connection" default:"10"`
+	Term           string `hcl:"ssh_term" flagName:"ssh-term" flagSName:"" flagDescribe:"Terminal type requested for the remote shell" default:"xterm"`
+
+	// Fingerprints of the host keys accepted by the fingerprint policy,
+	// such as "SHA256:..." as printed by ssh-keygen -l
+	HostKeyFingerprints []string `hcl:"ssh_host_key_fingerprints"`
+	// Let clients select the host and the user with the host and user
+	// URL parameters. Hosts are restricted to AllowedHosts when set.
+	PermitTargetParams bool     `hcl:"ssh_permit_target_params"`
+	AllowedHosts       []string `hcl:"ssh_allowed_hosts"`
+}
+
+type Option func(*SSHProxy)
+
+// WithTerm sets the terminal type requested for the remote shell.
+func WithTerm(term string) Option {
+	return func(proxy *SSHProxy) {
+		proxy.term = term
+	}
+}
+
+// WithConnectTimeout bounds the wait for the SSH host to accept the
+// connection and complete the handshake.
+func WithConnectTimeout(timeout time.Duration) Option {
+	return func(proxy *SSHProxy) {
+		proxy.connectTimeout = timeout
+	}
+}
@@ -0,0 +1,182 @@
+//go:build sshproxy
+// +build sshproxy
+
+package sshproxy
+
+import (
+	"io"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+const (
+	DefaultTerm           = "xterm"
+	DefaultConnectTimeout = 10 * time.Second
+)
+
+// SSHProxy is a shell opened on a remote host with SSH.
+type SSHProxy struct {
+	host string
+	user string
+
+	term           string
+	connectTimeout time.Duration
+
+	client  *ssh.Client
+	session *ssh.Session
+	stdin   io.WriteCloser
+	stdout  io.Reader
+
+	// closed once the remote shell exited, waitErr is set before
+	closed  chan struct{}
+	waitErr error
+}
+
+// New connects to host with config and starts a shell with a PTY.
+func New(host string, config *ssh.ClientConfig, options ...Option) (*SSHProxy, error) {
+	proxy := &SSHProxy{
+		host: host,
+		user: config.User,
+
+		term:           DefaultTerm,
+		connectTimeout: DefaultConnectTimeout,
+	}
+
+	for _, option := range options {
+		option(proxy)
+	}
+
+	dialConfig := *config
+	dialConfig.Timeout = proxy.connectTimeout
+	client, err := ssh.Dial("tcp", host, &dialConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to `%s`", host)
+	}
+
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return nil, errors.Wrapf(err, "failed to open ssh session on `%s`", host)
+	}
+	proxy.client = client
+	proxy.session = session
+
+	err = proxy.startShell()
+	if err != nil {
+		session.Close()
+		client.Close()
+		return nil, errors.Wrapf(err, "failed to start shell on `%s`", host)
+	}
+
+	proxy.closed = make(chan struct{})
+	go func() {
+		proxy.waitErr = session.Wait()
+		close(proxy.closed)
+	}()
+
+	return proxy, nil
+}
+
+func (proxy *SSHProxy) startShell() error {
+	stdin, err := proxy.session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := proxy.session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	// the PTY merges stderr into stdout
+	proxy.stdin = stdin
+	proxy.stdout = stdout
+
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	// the size is set once the master resizes the terminal
+	err = proxy.session.RequestPty(proxy.term, 24, 80, modes)
+	if err != nil {
+		return err
+	}
+	return proxy.session.Shell()
+}
+
+func (proxy *SSHProxy) Read(p []byte) (n int, err error) {
+	return proxy.stdout.Read(p)
+}
+
+func (proxy *SSHProxy) Write(p []byte) (n int, err error) {
+	return proxy.stdin.Write(p)
+}
+
+// Close ends the remote shell and the connection to the host.
+func (proxy *SSHProxy) Close() error {
+	proxy.session.Close()
+	return proxy.client.Close()
+}
+
+// ExitReason returns the exit status of the remote shell, such as
+// "exit status 1", or an empty string when it is still running.
+func (proxy *SSHProxy) ExitReason() string {
+	if !proxy.waitClosed() {
+		return ""
+	}
+	if status, ok := proxy.exitStatus(); ok {
+		return status.String()
+	}
+	return proxy.waitErr.Error()
+}
+
+// ExitStatus returns how the remote shell ended, or false while it is
+// still running or when the connection was lost before it ended.
+func (proxy *SSHProxy) ExitStatus() (webtty.ExitStatus, bool) {
+	if !proxy.waitClosed() {
+		return webtty.ExitStatus{}, false
+	}
+	return proxy.exitStatus()
+}
+
+// waitClosed waits a second for the remote shell to exit,
+// and reports whether it did.
+func (proxy *SSHProxy) waitClosed() bool {
+	select {
+	case <-proxy.closed:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func (proxy *SSHProxy) exitStatus() (webtty.ExitStatus, bool) {
+	switch err := proxy.waitErr.(type) {
+	case nil:
+		return webtty.ExitStatus{Code: 0}, true
+	case *ssh.ExitError:
+		if err.Signal() != "" {
+			return webtty.ExitStatus{Code: -1, Signal: err.Signal()}, true
+		}
+		return webtty.ExitStatus{Code: err.ExitStatus()}, true
+	}
+	return webtty.ExitStatus{}, false
+}
+
+func (proxy *SSHProxy) WindowTitleVariables() map[string]interface{} {
+	hostname, _, _ := net.SplitHostPort(proxy.host)
+	return map[string]interface{}{
+		"command": "ssh",
+		"argv":    []string{proxy.user + "@" + hostname},
+		"host":    hostname,
+		"user":    proxy.user,
+	}
+}
+
+func (proxy *SSHProxy) ResizeTerminal(width int, height int) error {
+	return proxy.session.WindowChange(height, width)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Option is an option for Client.
+type Option func(*Client) error
+
+// WithCredential sets the credential of the server, as user:pass,
+// sent with basic authentication and as the auth token.
+// It replaces the credential of the URL.
+func WithCredential(credential string) Option {
+	return func(c *Client) error {
+		c.authToken = credential
+		c.header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credential)))
+		return nil
+	}
+}
+
+// WithHeader adds a header to the WebSocket handshake,
+// such as for a proxy in front of the server.
+func WithHeader(key string, value string) Option {
+	return func(c *Client) error {
+		c.header.Add(key, value)
+		return nil
+	}
+}
+
+// WithTag adds a tag to the session, recorded with its audit events,
+// such as the ticket ID of the work done in it.
+func WithTag(name string, value string) Option {
+	return func(c *Client) error {
+		if c.tags == nil {
+			c.tags = make(map[string]string)
+		}
+		c.tags[name] = value
+		return webtty.ValidateSessionTags(c.tags)
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of wss connections,
+// such as the CA or the client certificate of the server.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) error {
+		c.tlsConfig = config
+		return nil
+	}
+}
+
+// WithPingInterval sets the interval of the pings keeping the connection
+// alive. The default is DefaultPingInterval.
+func WithPingInterval(interval time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return errors.New("ping interval must be positive")
+		}
+		c.pingInterval = interval
+		return nil
+	}
+}
+
+// WithoutReconnect makes Run return once the connection is lost,
+// even when the server tells its clients to reconnect.
+func WithoutReconnect() Option {
+	return func(c *Client) error {
+		c.reconnect = false
+		return nil
+	}
+}
+
+// WithoutWindowTitle ignores the window title set by the server,
+// which is written to the terminal by default.
+func WithoutWindowTitle() Option {
+	return func(c *Client) error {
+		c.windowTitle = false
+		return nil
+	}
+}
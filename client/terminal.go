@@ -0,0 +1,15 @@
+package client
+
+import "io"
+
+// Terminal is the local end of a session: the input of the user is read
+// from it and the output of the command is written to it.
+type Terminal interface {
+	io.Reader
+	io.Writer
+
+	// Size returns the size of the terminal.
+	Size() (columns int, rows int, err error)
+	// Resized receives each time the terminal is resized.
+	Resized() <-chan struct{}
+}
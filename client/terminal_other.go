@@ -0,0 +1,23 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package client
+
+import (
+	"github.com/pkg/errors"
+)
+
+// StdTerminal is the terminal of the standard input and output of the
+// process, which can't be set in raw mode on this platform.
+type StdTerminal struct{}
+
+// OpenStdTerminal fails on this platform.
+func OpenStdTerminal() (*StdTerminal, error) {
+	return nil, errors.New("attaching from a terminal is not supported on this platform")
+}
+
+func (st *StdTerminal) Read(p []byte) (int, error)  { return 0, errors.New("not supported") }
+func (st *StdTerminal) Write(p []byte) (int, error) { return 0, errors.New("not supported") }
+func (st *StdTerminal) Size() (int, int, error)     { return 0, 0, errors.New("not supported") }
+func (st *StdTerminal) Resized() <-chan struct{}    { return nil }
+func (st *StdTerminal) Close() error                { return nil }
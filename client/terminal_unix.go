@@ -0,0 +1,106 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package client
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"unsafe"
+
+	"github.com/kr/pty"
+	"github.com/pkg/errors"
+)
+
+// StdTerminal is the terminal of the standard input and output of the
+// process. The standard input is set in raw mode when it's a terminal,
+// so that the keys reach the command as typed, until Close.
+type StdTerminal struct {
+	in      *os.File
+	out     *os.File
+	saved   *syscall.Termios
+	signals chan os.Signal
+	resized chan struct{}
+}
+
+// OpenStdTerminal returns the terminal of the standard input and output.
+// When they aren't terminals, such as in a script, the size of the
+// terminal is 80x24.
+func OpenStdTerminal() (*StdTerminal, error) {
+	st := &StdTerminal{
+		in:      os.Stdin,
+		out:     os.Stdout,
+		signals: make(chan os.Signal, 1),
+		resized: make(chan struct{}, 1),
+	}
+
+	var state syscall.Termios
+	if termios(st.in.Fd(), ioctlReadTermios, &state) == nil {
+		raw := state
+		raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+		raw.Oflag &^= syscall.OPOST
+		raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+		raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+		raw.Cflag |= syscall.CS8
+		raw.Cc[syscall.VMIN] = 1
+		raw.Cc[syscall.VTIME] = 0
+		if err := termios(st.in.Fd(), ioctlWriteTermios, &raw); err != nil {
+			return nil, errors.Wrapf(err, "failed to set raw mode")
+		}
+		st.saved = &state
+	}
+
+	signal.Notify(st.signals, syscall.SIGWINCH)
+	go func() {
+		for range st.signals {
+			select {
+			case st.resized <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return st, nil
+}
+
+func (st *StdTerminal) Read(p []byte) (int, error) {
+	return st.in.Read(p)
+}
+
+func (st *StdTerminal) Write(p []byte) (int, error) {
+	return st.out.Write(p)
+}
+
+// Size returns the size of the standard output, or of the standard input
+// when the output is redirected.
+func (st *StdTerminal) Size() (int, int, error) {
+	for _, file := range []*os.File{st.out, st.in} {
+		if rows, columns, err := pty.Getsize(file); err == nil && columns > 0 && rows > 0 {
+			return columns, rows, nil
+		}
+	}
+	return 80, 24, nil
+}
+
+// Resized receives on SIGWINCH.
+func (st *StdTerminal) Resized() <-chan struct{} {
+	return st.resized
+}
+
+// Close restores the mode of the standard input.
+func (st *StdTerminal) Close() error {
+	signal.Stop(st.signals)
+	close(st.signals)
+	if st.saved != nil {
+		return termios(st.in.Fd(), ioctlWriteTermios, st.saved)
+	}
+	return nil
+}
+
+func termios(fd uintptr, request uintptr, state *syscall.Termios) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(unsafe.Pointer(state)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -5112,98 +5112,392 @@
         }, e
     }();
     t.Hterm = o
-}, function (e, t, r) {
+}, function (module, exports, require) {
     "use strict";
-    Object.defineProperty(t, "__esModule", {value: !0});
-    var i = function () {
-        function e(e, t) {
-            this.url = e, this.protocols = t
-        }
-
-        return e.prototype.create = function () {
-            return new o(this.url, this.protocols)
-        }, e
-    }();
-    t.ConnectionFactory = i;
-    var o = function () {
-        function e(e, t) {
-            this.bare = new WebSocket(e, t)
-        }
-
-        return e.prototype.open = function () {
-        }, e.prototype.close = function () {
-            this.bare.close()
-        }, e.prototype.send = function (e) {
-            this.bare.send(e)
-        }, e.prototype.isOpen = function () {
-            return this.bare.readyState == WebSocket.CONNECTING || this.bare.readyState == WebSocket.OPEN
-        }, e.prototype.onOpen = function (e) {
-            this.bare.onopen = function (t) {
-                e()
+    var __defProp = Object.defineProperty;
+    var __getOwnPropDesc = Object.getOwnPropertyDescriptor;
+    var __getOwnPropNames = Object.getOwnPropertyNames;
+    var __hasOwnProp = Object.prototype.hasOwnProperty;
+    var __export = (target, all) => {
+      for (var name in all)
+        __defProp(target, name, { get: all[name], enumerable: true });
+    };
+    var __copyProps = (to, from, except, desc) => {
+      if (from && typeof from === "object" || typeof from === "function") {
+        for (let key of __getOwnPropNames(from))
+          if (!__hasOwnProp.call(to, key) && key !== except)
+            __defProp(to, key, { get: () => from[key], enumerable: !(desc = __getOwnPropDesc(from, key)) || desc.enumerable });
+      }
+      return to;
+    };
+    var __toCommonJS = (mod) => __copyProps(__defProp({}, "__esModule", { value: true }), mod);
+    var websocket_exports = {};
+    __export(websocket_exports, {
+      Connection: () => Connection,
+      ConnectionFactory: () => ConnectionFactory
+    });
+    module.exports = __toCommonJS(websocket_exports);
+    class ConnectionFactory {
+      constructor(url, protocols) {
+        this.url = url;
+        this.protocols = protocols;
+      }
+      create() {
+        return new Connection(this.url, this.protocols);
+      }
+    }
+    class Connection {
+      constructor(url, protocols) {
+        this.bare = new WebSocket(url, protocols);
+        this.bare.binaryType = "arraybuffer";
+      }
+      open() {
+      }
+      close() {
+        this.bare.close();
+      }
+      send(data) {
+        this.bare.send(data);
+      }
+      isOpen() {
+        if (this.bare.readyState == WebSocket.CONNECTING || this.bare.readyState == WebSocket.OPEN) {
+          return true;
+        }
+        return false;
+      }
+      onOpen(callback) {
+        this.bare.onopen = (event) => {
+          callback();
+        };
+      }
+      onReceive(callback) {
+        this.bare.onmessage = (event) => {
+          callback(event.data);
+        };
+      }
+      onClose(callback) {
+        this.bare.onclose = (event) => {
+          callback();
+        };
+      }
+    }
+}, function (module, exports, require) {
+    "use strict";
+    var __defProp = Object.defineProperty;
+    var __getOwnPropDesc = Object.getOwnPropertyDescriptor;
+    var __getOwnPropNames = Object.getOwnPropertyNames;
+    var __hasOwnProp = Object.prototype.hasOwnProperty;
+    var __export = (target, all) => {
+      for (var name in all)
+        __defProp(target, name, { get: all[name], enumerable: true });
+    };
+    var __copyProps = (to, from, except, desc) => {
+      if (from && typeof from === "object" || typeof from === "function") {
+        for (let key of __getOwnPropNames(from))
+          if (!__hasOwnProp.call(to, key) && key !== except)
+            __defProp(to, key, { get: () => from[key], enumerable: !(desc = __getOwnPropDesc(from, key)) || desc.enumerable });
+      }
+      return to;
+    };
+    var __toCommonJS = (mod) => __copyProps(__defProp({}, "__esModule", { value: true }), mod);
+    var webtty_exports = {};
+    __export(webtty_exports, {
+      WebTTY: () => WebTTY,
+      msgAcknowledgeOutput: () => msgAcknowledgeOutput,
+      msgCloseReason: () => msgCloseReason,
+      msgCompressedOutput: () => msgCompressedOutput,
+      msgInput: () => msgInput,
+      msgInputUnknown: () => msgInputUnknown,
+      msgKeepAlivePing: () => msgKeepAlivePing,
+      msgKeepAlivePong: () => msgKeepAlivePong,
+      msgLatencyReport: () => msgLatencyReport,
+      msgOutput: () => msgOutput,
+      msgPing: () => msgPing,
+      msgPong: () => msgPong,
+      msgResizeTerminal: () => msgResizeTerminal,
+      msgScreenLock: () => msgScreenLock,
+      msgSessionEnd: () => msgSessionEnd,
+      msgSetCompression: () => msgSetCompression,
+      msgSetPreferences: () => msgSetPreferences,
+      msgSetProtocolVersion: () => msgSetProtocolVersion,
+      msgSetReadOnly: () => msgSetReadOnly,
+      msgSetReattachToken: () => msgSetReattachToken,
+      msgSetReconnect: () => msgSetReconnect,
+      msgSetWindowTitle: () => msgSetWindowTitle,
+      msgUnknownOutput: () => msgUnknownOutput,
+      msgUnlock: () => msgUnlock,
+      protocolVersion: () => protocolVersion,
+      protocols: () => protocols
+    });
+    module.exports = __toCommonJS(webtty_exports);
+    const protocols = ["webtty"];
+    const protocolVersion = 2;
+    const msgInputUnknown = "0";
+    const msgInput = "1";
+    const msgPing = "2";
+    const msgResizeTerminal = "3";
+    const msgKeepAlivePong = "5";
+    const msgAcknowledgeOutput = "6";
+    const msgUnlock = "B";
+    const msgUnknownOutput = "0";
+    const msgOutput = "1";
+    const msgPong = "2";
+    const msgSetWindowTitle = "3";
+    const msgSetPreferences = "4";
+    const msgSetReconnect = "5";
+    const msgSetReadOnly = "6";
+    const msgSessionEnd = "A";
+    const msgKeepAlivePing = "B";
+    const msgCompressedOutput = "C";
+    const msgSetReattachToken = "D";
+    const msgCloseReason = "I";
+    const msgSetCompression = "J";
+    const msgScreenLock = "K";
+    const msgSetProtocolVersion = "L";
+    const msgLatencyReport = "M";
+    const binaryString = (bytes) => {
+      const chunks = [];
+      for (let i = 0; i < bytes.length; i += 8192) {
+        chunks.push(String.fromCharCode.apply(null, bytes.subarray(i, i + 8192)));
+      }
+      return chunks.join("");
+    };
+    const binaryBytes = (data) => {
+      const bytes = new Uint8Array(data.length);
+      for (let i = 0; i < data.length; i++) {
+        bytes[i] = data.charCodeAt(i);
+      }
+      return bytes;
+    };
+    const sessionTags = (query) => {
+      const tags = {};
+      query.replace(/^\?/, "").split("&").forEach((param) => {
+        const i = param.indexOf("=");
+        const name = decodeURIComponent(i < 0 ? param : param.substring(0, i));
+        if (name.indexOf("tag.") === 0) {
+          tags[name.substring(4)] = i < 0 ? "" : decodeURIComponent(param.substring(i + 1).replace(/\+/g, " "));
+        }
+      });
+      return tags;
+    };
+    const compressionSupported = typeof DecompressionStream !== "undefined";
+    const decompress = (bytes, method, callback) => {
+      const stream = new Blob([bytes]).stream().pipeThrough(new DecompressionStream(method));
+      new window.Response(stream).arrayBuffer().then(
+        (buffer) => callback(binaryString(new Uint8Array(buffer))),
+        (error) => {
+          console.log("Failed to decompress output: " + error);
+          callback("");
+        }
+      );
+    };
+    class WebTTY {
+      constructor(term, connectionFactory, args, authToken) {
+        this.term = term;
+        this.connectionFactory = connectionFactory;
+        this.args = args;
+        this.authToken = authToken;
+        this.reconnect = -1;
+        this.reattachToken = "";
+      }
+      open() {
+        let connection = this.connectionFactory.create();
+        let pingTimer;
+        let reconnectTimeout;
+        const setup = () => {
+          let sessionEnded = false;
+          let closedByServer = false;
+          let closeMessage = "";
+          let readOnly = false;
+          let localEcho = false;
+          let processed = 0;
+          let compression = "gzip";
+          const pendingOutputs = [];
+          const acknowledge = (bytes) => {
+            if (processed == 0) {
+              setTimeout(() => {
+                connection.send(msgAcknowledgeOutput + processed);
+                processed = 0;
+              }, 0);
             }
-        }, e.prototype.onReceive = function (e) {
-            this.bare.onmessage = function (t) {
-                e(t.data)
+            processed += bytes;
+          };
+          const writeOutputs = () => {
+            while (pendingOutputs.length > 0 && pendingOutputs[0].output != null) {
+              const output2 = pendingOutputs.shift().output;
+              this.term.output(output2);
+              acknowledge(output2.length);
             }
-        }, e.prototype.onClose = function (e) {
-            this.bare.onclose = function (t) {
-                e()
+          };
+          const output = (data) => {
+            pendingOutputs.push({ output: data });
+            writeOutputs();
+          };
+          const compressedOutput = (bytes) => {
+            const pending = { output: null };
+            pendingOutputs.push(pending);
+            decompress(bytes, compression, (data) => {
+              pending.output = data;
+              writeOutputs();
+            });
+          };
+          connection.onOpen(() => {
+            const termInfo = this.term.info();
+            connection.send(JSON.stringify(
+              {
+                Arguments: this.args,
+                AuthToken: this.authToken,
+                Features: { binaryFrames: true, flowControl: true, compression: compressionSupported, screenLock: true },
+                Locale: navigator.language,
+                ReattachToken: this.reattachToken,
+                ProtocolVersion: protocolVersion,
+                Tags: sessionTags(this.args)
+              }
+            ));
+            const resizeHandler = (colmuns, rows) => {
+              connection.send(
+                msgResizeTerminal + JSON.stringify(
+                  {
+                    columns: colmuns,
+                    rows
+                  }
+                )
+              );
+            };
+            this.term.onResize(resizeHandler);
+            resizeHandler(termInfo.columns, termInfo.rows);
+            this.term.onInput(
+              (input) => {
+                if (!readOnly) {
+                  connection.send(msgInput + input);
+                }
+              }
+            );
+            pingTimer = setInterval(() => {
+              connection.send(msgPing);
+            }, 30 * 1e3);
+          });
+          connection.onReceive((message) => {
+            if (message instanceof ArrayBuffer) {
+              const bytes = new Uint8Array(message);
+              switch (String.fromCharCode(bytes[0])) {
+                case msgOutput:
+                  output(binaryString(bytes.subarray(1)));
+                  break;
+                case msgCompressedOutput:
+                  compressedOutput(bytes.subarray(1));
+                  break;
+              }
+              return;
             }
-        }, e
-    }();
-    t.Connection = o
-}, function (e, t, r) {
-    "use strict";
-    Object.defineProperty(t, "__esModule", {value: !0}), t.protocols = ["webtty"], t.msgInputUnknown = "0", t.msgInput = "1", t.msgPing = "2", t.msgResizeTerminal = "3", t.msgUnknownOutput = "0", t.msgOutput = "1", t.msgPong = "2", t.msgSetWindowTitle = "3", t.msgSetPreferences = "4", t.msgSetReconnect = "5";
-    var i = function () {
-        function e(e, t, r, i) {
-            this.term = e, this.connectionFactory = t, this.args = r, this.authToken = i, this.reconnect = -1
-        }
-
-        return e.prototype.open = function () {
-            var e, r, i = this, o = this.connectionFactory.create(), s = function () {
-                o.onOpen(function () {
-                    var r = i.term.info();
-                    o.send(JSON.stringify({Arguments: i.args, AuthToken: i.authToken}));
-                    var s = function (e, r) {
-                        o.send(t.msgResizeTerminal + JSON.stringify({columns: e, rows: r}))
-                    };
-                    i.term.onResize(s), s(r.columns, r.rows), i.term.onInput(function (e) {
-                        o.send(t.msgInput + e)
-                    }), e = setInterval(function () {
-                        o.send(t.msgPing)
-                    }, 3e4)
-                }), o.onReceive(function (e) {
-                    var r = e.slice(1);
-                    switch (e[0]) {
-                        case t.msgOutput:
-                            i.term.output(atob(r));
-                            break;
-                        case t.msgPong:
-                            break;
-                        case t.msgSetWindowTitle:
-                            i.term.setWindowTitle(r);
-                            break;
-                        case t.msgSetPreferences:
-                            var o = JSON.parse(r);
-                            i.term.setPreferences(o);
-                            break;
-                        case t.msgSetReconnect:
-                            var s = JSON.parse(r);
-                            console.log("Enabling reconnect: " + s + " seconds"), i.reconnect = s
+            const data = message;
+            const payload = data.slice(1);
+            switch (data[0]) {
+              case msgOutput:
+                output(atob(payload));
+                break;
+              case msgCompressedOutput:
+                compressedOutput(binaryBytes(atob(payload)));
+                break;
+              case msgSetCompression:
+                compression = JSON.parse(payload).method;
+                break;
+              case msgPong:
+                break;
+              case msgSetWindowTitle:
+                this.term.setWindowTitle(payload);
+                break;
+              case msgSetPreferences:
+                const preferences = JSON.parse(payload);
+                this.term.setPreferences(preferences);
+                break;
+              case msgSetReconnect:
+                const autoReconnect = JSON.parse(payload);
+                console.log("Enabling reconnect: " + autoReconnect + " seconds");
+                this.reconnect = autoReconnect;
+                break;
+              case msgSetReadOnly:
+                readOnly = JSON.parse(payload);
+                if (readOnly) {
+                  this.term.showMessage("Read Only", 3e3);
+                }
+                break;
+              case msgSetReattachToken:
+                this.reattachToken = payload;
+                break;
+              case msgKeepAlivePing:
+                connection.send(msgKeepAlivePong);
+                break;
+              case msgSetProtocolVersion:
+                break;
+              case msgLatencyReport:
+                const latency = JSON.parse(payload);
+                if (latency.localEcho && !localEcho) {
+                  this.term.showMessage("Slow connection (" + latency.smoothedRttMs + " ms), echoing locally", 2e3);
+                }
+                localEcho = latency.localEcho;
+                break;
+              case msgScreenLock:
+                const lock = JSON.parse(payload);
+                if (lock.locked) {
+                  setTimeout(() => {
+                    const credential = window.prompt(
+                      (lock.error ? lock.error + ". " : "") + "This session is locked, enter your credential to resume it"
+                    );
+                    if (credential != null) {
+                      connection.send(msgUnlock + JSON.stringify({ credential }));
                     }
-                }), o.onClose(function () {
-                    clearInterval(e), i.term.deactivate(), i.term.showMessage("Connection Closed", 0), i.reconnect > 0 && (r = setTimeout(function () {
-                        o = i.connectionFactory.create(), i.term.reset(), s()
-                    }, 1e3 * i.reconnect))
-                }), o.open()
-            };
-            return s(), function () {
-                clearTimeout(r), o.close()
+                  }, 100);
+                }
+                break;
+              case msgCloseReason:
+                const reason = JSON.parse(payload);
+                if (reason.code != "slave_closed") {
+                  closeMessage = reason.message;
+                } else if (reason.signal || reason.exitCode) {
+                  closeMessage = "Process Exited (" + reason.message + ")";
+                }
+                if (reason.code != "master_timeout") {
+                  this.reattachToken = "";
+                  this.reconnect = -1;
+                }
+                break;
+              case msgSessionEnd:
+                sessionEnded = true;
+                this.reattachToken = "";
+                closedByServer = payload == "idle timeout" || payload == "session expired";
+                this.reconnect = -1;
+                break;
             }
-        }, e
-    }();
-    t.WebTTY = i
+          });
+          connection.onClose(() => {
+            clearInterval(pingTimer);
+            this.term.deactivate();
+            if (closeMessage != "") {
+              this.term.showMessage(closeMessage, 0);
+            } else if (closedByServer) {
+              this.term.showMessage("Session Closed", 0);
+            } else {
+              this.term.showMessage(sessionEnded ? "Process Exited" : "Connection Closed", 0);
+            }
+            if (this.reconnect > 0) {
+              reconnectTimeout = setTimeout(() => {
+                connection = this.connectionFactory.create();
+                this.term.reset();
+                setup();
+              }, this.reconnect * 1e3);
+            }
+          });
+          connection.open();
+        };
+        setup();
+        return () => {
+          clearTimeout(reconnectTimeout);
+          connection.close();
+        };
+      }
+    }
+    ;
 }, function (e, t, r) {
     "use strict";
     Object.defineProperty(t, "__esModule", {value: !0});
@@ -18,6 +18,7 @@ export class Connection {
 
     constructor(url: string, protocols: string[]) {
         this.bare = new WebSocket(url, protocols);
+        this.bare.binaryType = "arraybuffer";
     }
 
     open() {
@@ -46,7 +47,7 @@ export class Connection {
         }
     };
 
-    onReceive(callback: (data: string) => void) {
+    onReceive(callback: (data: string | ArrayBuffer) => void) {
         this.bare.onmessage = (event) => {
             callback(event.data);
         }
@@ -1,9 +1,16 @@
 export const protocols = ["webtty"];
 
+// protocolVersion is the latest version of the protocol the page speaks,
+// the server doesn't send it the message types of later versions.
+export const protocolVersion = 2;
+
 export const msgInputUnknown = '0';
 export const msgInput = '1';
 export const msgPing = '2';
 export const msgResizeTerminal = '3';
+export const msgKeepAlivePong = '5';
+export const msgAcknowledgeOutput = '6';
+export const msgUnlock = 'B';
 
 export const msgUnknownOutput = '0';
 export const msgOutput = '1';
@@ -11,7 +18,68 @@ export const msgPong = '2';
 export const msgSetWindowTitle = '3';
 export const msgSetPreferences = '4';
 export const msgSetReconnect = '5';
+export const msgSetReadOnly = '6';
+export const msgSessionEnd = 'A';
+export const msgKeepAlivePing = 'B';
+export const msgCompressedOutput = 'C';
+export const msgSetReattachToken = 'D';
+export const msgCloseReason = 'I';
+export const msgSetCompression = 'J';
+export const msgScreenLock = 'K';
+export const msgSetProtocolVersion = 'L';
+export const msgLatencyReport = 'M';
+
+declare var DecompressionStream: any;
+
+
+// binaryString returns the bytes as a string of one char per byte, like atob.
+const binaryString = (bytes: Uint8Array): string => {
+    const chunks: string[] = [];
+    // apply has a limit on its number of arguments
+    for (let i = 0; i < bytes.length; i += 8192) {
+        chunks.push(String.fromCharCode.apply(null, bytes.subarray(i, i + 8192)));
+    }
+    return chunks.join("");
+};
 
+// binaryBytes returns the bytes of a string of one char per byte.
+const binaryBytes = (data: string): Uint8Array => {
+    const bytes = new Uint8Array(data.length);
+    for (let i = 0; i < data.length; i++) {
+        bytes[i] = data.charCodeAt(i);
+    }
+    return bytes;
+};
+
+// sessionTags returns the tags of the session given as tag.<name>=<value>
+// in the query of the page, recorded with its audit events.
+const sessionTags = (query: string): { [name: string]: string } => {
+    const tags: { [name: string]: string } = {};
+    query.replace(/^\?/, "").split("&").forEach((param) => {
+        const i = param.indexOf("=");
+        const name = decodeURIComponent(i < 0 ? param : param.substring(0, i));
+        if (name.indexOf("tag.") === 0) {
+            tags[name.substring(4)] = i < 0 ? "" : decodeURIComponent(param.substring(i + 1).replace(/\+/g, " "));
+        }
+    });
+    return tags;
+};
+
+// compressed output is only asked for when the browser can decompress it
+const compressionSupported = typeof DecompressionStream !== "undefined";
+
+// decompress calls callback with the output carried by the payload of
+// a CompressedOutput message, compressed with method.
+const decompress = (bytes: Uint8Array, method: string, callback: (output: string) => void) => {
+    const stream = (new Blob([bytes]) as any).stream().pipeThrough(new DecompressionStream(method));
+    new (window as any).Response(stream).arrayBuffer().then(
+        (buffer: ArrayBuffer) => callback(binaryString(new Uint8Array(buffer))),
+        (error: any) => {
+            console.log("Failed to decompress output: " + error);
+            callback("");
+        }
+    );
+};
 
 export interface Terminal {
     info(): { columns: number, rows: number };
@@ -33,7 +101,7 @@ export interface Connection {
     send(data: string): void;
     isOpen(): boolean;
     onOpen(callback: () => void): void;
-    onReceive(callback: (data: string) => void): void;
+    onReceive(callback: (data: string | ArrayBuffer) => void): void;
     onClose(callback: () => void): void;
 }
 
@@ -48,6 +116,7 @@ export class WebTTY {
     args: string;
     authToken: string;
     reconnect: number;
+    reattachToken: string;
 
     constructor(term: Terminal, connectionFactory: ConnectionFactory, args: string, authToken: string) {
         this.term = term;
@@ -55,6 +124,7 @@ export class WebTTY {
         this.args = args;
         this.authToken = authToken;
         this.reconnect = -1;
+        this.reattachToken = "";
     };
 
     open() {
@@ -63,6 +133,49 @@ export class WebTTY {
         let reconnectTimeout: number;
 
         const setup = () => {
+            let sessionEnded = false;
+            let closedByServer = false;
+            let closeMessage = "";
+            let readOnly = false;
+            let localEcho = false;
+            let processed = 0;
+            let compression = "gzip";
+            // output in order, waiting for the compressed output before it
+            const pendingOutputs: { output: string | null }[] = [];
+
+            // acknowledges the output once the pending messages are handled
+            const acknowledge = (bytes: number) => {
+                if (processed == 0) {
+                    setTimeout(() => {
+                        connection.send(msgAcknowledgeOutput + processed);
+                        processed = 0;
+                    }, 0);
+                }
+                processed += bytes;
+            };
+
+            const writeOutputs = () => {
+                while (pendingOutputs.length > 0 && pendingOutputs[0].output != null) {
+                    const output = pendingOutputs.shift()!.output as string;
+                    this.term.output(output);
+                    acknowledge(output.length);
+                }
+            };
+
+            const output = (data: string) => {
+                pendingOutputs.push({ output: data });
+                writeOutputs();
+            };
+
+            const compressedOutput = (bytes: Uint8Array) => {
+                const pending: { output: string | null } = { output: null };
+                pendingOutputs.push(pending);
+                decompress(bytes, compression, (data: string) => {
+                    pending.output = data;
+                    writeOutputs();
+                });
+            };
+
             connection.onOpen(() => {
                 const termInfo = this.term.info();
 
@@ -70,6 +183,11 @@ export class WebTTY {
                     {
                         Arguments: this.args,
                         AuthToken: this.authToken,
+                        Features: { binaryFrames: true, flowControl: true, compression: compressionSupported, screenLock: true },
+                        Locale: navigator.language,
+                        ReattachToken: this.reattachToken,
+                        ProtocolVersion: protocolVersion,
+                        Tags: sessionTags(this.args),
                     }
                 ));
 
@@ -90,7 +208,9 @@ export class WebTTY {
 
                 this.term.onInput(
                     (input: string) => {
-                        connection.send(msgInput + input);
+                        if (!readOnly) {
+                            connection.send(msgInput + input);
+                        }
                     }
                 );
 
@@ -100,11 +220,31 @@ export class WebTTY {
 
             });
 
-            connection.onReceive((data) => {
+            connection.onReceive((message) => {
+                if (message instanceof ArrayBuffer) {
+                    // binary messages carry raw output
+                    const bytes = new Uint8Array(message);
+                    switch (String.fromCharCode(bytes[0])) {
+                        case msgOutput:
+                            output(binaryString(bytes.subarray(1)));
+                            break;
+                        case msgCompressedOutput:
+                            compressedOutput(bytes.subarray(1));
+                            break;
+                    }
+                    return;
+                }
+                const data = message;
                 const payload = data.slice(1);
                 switch (data[0]) {
                     case msgOutput:
-                        this.term.output(atob(payload));
+                        output(atob(payload));
+                        break;
+                    case msgCompressedOutput:
+                        compressedOutput(binaryBytes(atob(payload)));
+                        break;
+                    case msgSetCompression:
+                        compression = JSON.parse(payload).method;
                         break;
                     case msgPong:
                         break;
@@ -120,13 +260,77 @@ export class WebTTY {
                         console.log("Enabling reconnect: " + autoReconnect + " seconds")
                         this.reconnect = autoReconnect;
                         break;
+                    case msgSetReadOnly:
+                        readOnly = JSON.parse(payload);
+                        if (readOnly) {
+                            this.term.showMessage("Read Only", 3000);
+                        }
+                        break;
+                    case msgSetReattachToken:
+                        this.reattachToken = payload;
+                        break;
+                    case msgKeepAlivePing:
+                        connection.send(msgKeepAlivePong);
+                        break;
+                    case msgSetProtocolVersion:
+                        // the latest version both ends speak, the page speaks a single one
+                        break;
+                    case msgLatencyReport:
+                        const latency = JSON.parse(payload);
+                        if (latency.localEcho && !localEcho) {
+                            this.term.showMessage("Slow connection (" + latency.smoothedRttMs + " ms), echoing locally", 2000);
+                        }
+                        localEcho = latency.localEcho;
+                        break;
+                    case msgScreenLock:
+                        const lock = JSON.parse(payload);
+                        if (lock.locked) {
+                            // prompted once the notice on the terminal is drawn
+                            setTimeout(() => {
+                                const credential = window.prompt(
+                                    (lock.error ? lock.error + ". " : "") +
+                                    "This session is locked, enter your credential to resume it"
+                                );
+                                if (credential != null) {
+                                    connection.send(msgUnlock + JSON.stringify({ credential: credential }));
+                                }
+                            }, 100);
+                        }
+                        break;
+                    case msgCloseReason:
+                        const reason = JSON.parse(payload);
+                        if (reason.code != "slave_closed") {
+                            closeMessage = reason.message;
+                        } else if (reason.signal || reason.exitCode) {
+                            // tell a crash from a clean exit
+                            closeMessage = "Process Exited (" + reason.message + ")";
+                        }
+                        // a client whose master timed out may come back
+                        if (reason.code != "master_timeout") {
+                            this.reattachToken = "";
+                            this.reconnect = -1;
+                        }
+                        break;
+                    case msgSessionEnd:
+                        sessionEnded = true;
+                        this.reattachToken = "";
+                        // the server closes sessions that are idle or too long
+                        closedByServer = payload == "idle timeout" || payload == "session expired";
+                        this.reconnect = -1;
+                        break;
                 }
             });
 
             connection.onClose(() => {
                 clearInterval(pingTimer);
                 this.term.deactivate();
-                this.term.showMessage("Connection Closed", 0);
+                if (closeMessage != "") {
+                    this.term.showMessage(closeMessage, 0);
+                } else if (closedByServer) {
+                    this.term.showMessage("Session Closed", 0);
+                } else {
+                    this.term.showMessage(sessionEnded ? "Process Exited" : "Connection Closed", 0);
+                }
                 if (this.reconnect > 0) {
                     reconnectTimeout = setTimeout(() => {
                         connection = this.connectionFactory.create();
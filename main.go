@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/codegangsta/cli"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/buptWYChen/gotty/pkg/homedir"
 	"github.com/buptWYChen/gotty/server"
 	"github.com/buptWYChen/gotty/utils"
+	"github.com/buptWYChen/gotty/webtty"
 )
 
 func main() {
@@ -49,11 +51,12 @@ func main() {
 		},
 	)
 
+	app.Commands = []cli.Command{attachCommand}
+
 	app.Action = func(c *cli.Context) {
 		if len(c.Args()) == 0 {
-			msg := "Error: No command given."
 			cli.ShowAppHelp(c)
-			exit(fmt.Errorf(msg), 1)
+			exit(fmt.Errorf("Error: No command given."), 1)
 		}
 
 		configFile := c.String("config")
@@ -62,6 +65,7 @@ func main() {
 			if err := utils.ApplyConfigFile(configFile, appOptions, backendOptions); err != nil {
 				exit(err, 2)
 			}
+			appOptions.ConfigFile = configFile
 		}
 
 		utils.ApplyFlags(cliFlags, flagMappings, c, appOptions, backendOptions)
@@ -75,7 +79,25 @@ func main() {
 		}
 
 		args := c.Args()
-		factory, err := localcommand.NewFactory(args[0], args[1:], backendOptions)
+		var backendOpts []localcommand.Option
+		if appOptions.AuditFile != "" {
+			auditFile, err := webtty.OpenAuditFile(
+				homedir.Expand(appOptions.AuditFile),
+				appOptions.AuditFileMaxSize,
+				time.Duration(appOptions.AuditFileMaxAge)*time.Second,
+			)
+			if err != nil {
+				exit(err, 3)
+			}
+			if err := auditFile.SetRetention(appOptions.AuditRetention()); err != nil {
+				exit(err, 3)
+			}
+			backendOpts = append(backendOpts, localcommand.WithRejectionHook(func(command string, argv []string) {
+				entry := "[rejected-command] " + strings.Join(append([]string{command}, argv...), " ")
+				auditFile.Write([]byte(webtty.FormatAuditLine("", "", entry) + "\n"))
+			}))
+		}
+		factory, err := localcommand.NewFactory(args[0], args[1:], backendOptions, backendOpts...)
 		if err != nil {
 			exit(err, 3)
 		}
@@ -101,7 +123,23 @@ func main() {
 		go func() {
 			errs <- srv.Run(ctx, server.WithGracefullContext(gCtx))
 		}()
-		err = waitSignals(errs, cancel, gCancel)
+		var drain func()
+		if appOptions.DrainTimeout > 0 {
+			drain = func() {
+				dCtx, dCancel := context.WithTimeout(context.Background(), time.Duration(appOptions.DrainTimeout)*time.Second)
+				defer dCancel()
+				srv.Drain(dCtx)
+			}
+		}
+		var reload func()
+		if appOptions.ConfigReload {
+			reload = func() {
+				if err := srv.ReloadConfigFile(); err != nil {
+					log.Printf("Failed to reload the runtime config: %s", err)
+				}
+			}
+		}
+		err = waitSignals(errs, cancel, gCancel, drain, reload)
 
 		if err != nil && err != context.Canceled {
 			fmt.Printf("Error: %s\n", err)
@@ -119,34 +157,56 @@ func exit(err error, code int) {
 	os.Exit(code)
 }
 
-func waitSignals(errs chan error, cancel context.CancelFunc, gracefullCancel context.CancelFunc) error {
+func waitSignals(errs chan error, cancel context.CancelFunc, gracefullCancel context.CancelFunc, drain func(), reload func()) error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(
 		sigChan,
 		syscall.SIGINT,
 		syscall.SIGTERM,
 	)
+	reloadChan := make(chan os.Signal, 1)
+	if reload != nil {
+		signal.Notify(reloadChan, syscall.SIGHUP)
+	}
 
-	select {
-	case err := <-errs:
-		return err
-
-	case s := <-sigChan:
-		switch s {
-		case syscall.SIGINT:
-			gracefullCancel()
-			fmt.Println("C-C to force close")
-			select {
-			case err := <-errs:
-				return err
-			case <-sigChan:
-				fmt.Println("Force closing...")
+	for {
+		select {
+		case err := <-errs:
+			return err
+
+		case <-reloadChan:
+			reload()
+
+		case s := <-sigChan:
+			switch s {
+			case syscall.SIGINT:
+				gracefullCancel()
+				fmt.Println("C-C to force close")
+				select {
+				case err := <-errs:
+					return err
+				case <-sigChan:
+					fmt.Println("Force closing...")
+					cancel()
+					return <-errs
+				}
+			default:
+				if drain != nil {
+					fmt.Println("Draining the sessions, signal again to force close")
+					drained := make(chan struct{})
+					go func() {
+						drain()
+						close(drained)
+					}()
+					select {
+					case <-drained:
+					case <-sigChan:
+						fmt.Println("Force closing...")
+					}
+				}
 				cancel()
 				return <-errs
 			}
-		default:
-			cancel()
-			return <-errs
 		}
 	}
 }
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/randomstring"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// accessDeniedMarker prefixes the connections rejected by the access
+// control in the audit trail.
+const accessDeniedMarker = "[access-denied] "
+
+// connectionRateWindow is the window of the connection rate of each client.
+const connectionRateWindow = time.Minute
+
+// Rules of the access control, labeling their rejections in the metrics.
+const (
+	accessNetwork = "network"
+	accessCountry = "country"
+	accessRate    = "rate"
+)
+
+// accessControl rejects the clients by their address, before anything
+// else handles their requests, and limits the rate of their connections.
+type accessControl struct {
+	allowNetworks  []*net.IPNet
+	denyNetworks   []*net.IPNet
+	allowCountries map[string]bool
+	denyCountries  map[string]bool
+	// nil without country restrictions
+	countries CountryLookup
+
+	// connections per connectionRateWindow of each address, 0 for no limit
+	rate       int
+	mutex      sync.Mutex
+	windows    map[string]*rateWindow
+	lastPruned time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// newAccessControl returns the access control configured by options,
+// nil when there is none. There is always one with config_reload, whose
+// connection rate may be reloaded.
+func newAccessControl(options *Options) (*accessControl, error) {
+	if options.AllowNetworks == nil && options.DenyNetworks == nil &&
+		options.AllowCountries == nil && options.DenyCountries == nil &&
+		options.ConnectionRate == 0 && !options.ConfigReload {
+		return nil, nil
+	}
+
+	allowNetworks, err := parseNetworks(options.AllowNetworks)
+	if err != nil {
+		return nil, err
+	}
+	denyNetworks, err := parseNetworks(options.DenyNetworks)
+	if err != nil {
+		return nil, err
+	}
+	ac := &accessControl{
+		allowNetworks:  allowNetworks,
+		denyNetworks:   denyNetworks,
+		allowCountries: countrySet(options.AllowCountries),
+		denyCountries:  countrySet(options.DenyCountries),
+		rate:           options.ConnectionRate,
+		windows:        make(map[string]*rateWindow),
+	}
+
+	if ac.allowCountries != nil || ac.denyCountries != nil {
+		var lookups []CountryLookup
+		if options.CountryLookup != nil {
+			lookups = append(lookups, options.CountryLookup)
+		}
+		if options.GeoIPDatabase != "" {
+			db, err := loadGeoIPDatabase(options.GeoIPDatabase)
+			if err != nil {
+				return nil, err
+			}
+			log.Printf("Loaded %d networks of GeoIP database %s", len(db.ranges), options.GeoIPDatabase)
+			lookups = append(lookups, db)
+		}
+		if len(lookups) == 0 {
+			return nil, errors.New("country restrictions require a GeoIP database")
+		}
+		ac.countries = CountryLookupFunc(func(ip net.IP) string {
+			for _, lookup := range lookups {
+				if country := lookup.Country(ip); country != "" {
+					return country
+				}
+			}
+			return ""
+		})
+	}
+	return ac, nil
+}
+
+// parseNetworks parses CIDR networks, a bare address being a network
+// of its own.
+func parseNetworks(networks []string) ([]*net.IPNet, error) {
+	parsed := make([]*net.IPNet, 0, len(networks))
+	for _, network := range networks {
+		if !strings.Contains(network, "/") {
+			ip := net.ParseIP(network)
+			if ip == nil {
+				return nil, errors.Errorf("invalid network `%s`", network)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			parsed = append(parsed, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network `%s`", network)
+		}
+		parsed = append(parsed, ipNet)
+	}
+	return parsed, nil
+}
+
+func countrySet(countries []string) map[string]bool {
+	if countries == nil {
+		return nil
+	}
+	set := make(map[string]bool, len(countries))
+	for _, country := range countries {
+		set[strings.ToUpper(country)] = true
+	}
+	return set
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns the rule denying ip and why, or "" when it's allowed.
+// The denied networks are checked before the allowed ones, and the
+// countries after the networks.
+func (ac *accessControl) check(ip net.IP) (rule string, reason string) {
+	if containsIP(ac.denyNetworks, ip) {
+		return accessNetwork, "denied network"
+	}
+	if len(ac.allowNetworks) > 0 && !containsIP(ac.allowNetworks, ip) {
+		return accessNetwork, "network not allowed"
+	}
+	if ac.countries == nil {
+		return "", ""
+	}
+
+	country := ac.countries.Country(ip)
+	if ac.denyCountries[country] {
+		return accessCountry, "denied country " + country
+	}
+	if ac.allowCountries != nil && !ac.allowCountries[country] {
+		if country == "" {
+			return accessCountry, "unknown country"
+		}
+		return accessCountry, "country " + country + " not allowed"
+	}
+	return "", ""
+}
+
+// allowConnection counts a new connection of ip, it returns false when ip
+// opened the connection rate already in the current window.
+func (ac *accessControl) allowConnection(ip net.IP) bool {
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(ac.lastPruned) >= connectionRateWindow {
+		for key, window := range ac.windows {
+			if now.Sub(window.start) >= connectionRateWindow {
+				delete(ac.windows, key)
+			}
+		}
+		ac.lastPruned = now
+	}
+
+	if ac.rate == 0 {
+		return true
+	}
+	key := ip.String()
+	window, ok := ac.windows[key]
+	if !ok || now.Sub(window.start) >= connectionRateWindow {
+		window = &rateWindow{start: now}
+		ac.windows[key] = window
+	}
+	if window.count >= ac.rate {
+		return false
+	}
+	window.count++
+	return true
+}
+
+// filters returns whether the access control rejects clients by their
+// address, not only by their connection rate.
+func (ac *accessControl) filters() bool {
+	return len(ac.allowNetworks) > 0 || len(ac.denyNetworks) > 0 || ac.countries != nil
+}
+
+// setRate changes the connection rate, 0 for no limit.
+func (ac *accessControl) setRate(rate int) {
+	ac.mutex.Lock()
+	defer ac.mutex.Unlock()
+
+	ac.rate = rate
+}
+
+// clientIP returns the address of the client of r.
+func clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// wrapAccess rejects the requests of the clients the access control denies.
+func (server *Server) wrapAccess(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+		if ip == nil {
+			server.denyAccess(w, r, accessNetwork, "unknown address")
+			return
+		}
+		if rule, reason := server.access.check(ip); rule != "" {
+			server.denyAccess(w, r, rule, reason)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// wrapConnectionRate rejects the connections of the clients exceeding
+// the connection rate, before they're upgraded.
+func (server *Server) wrapConnectionRate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+		if ip != nil && !server.access.allowConnection(ip) {
+			server.denyAccess(w, r, accessRate, "connection rate exceeded")
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// denyAccess rejects r for reason by rule, recorded in the audit trail
+// of the audit URL and in the audit index.
+func (server *Server) denyAccess(w http.ResponseWriter, r *http.Request, rule string, reason string) {
+	log.Printf("Access denied: %s: %s", r.RemoteAddr, reason)
+	if server.metrics != nil {
+		server.metrics.observeAccessRejection(rule)
+	}
+	event := webtty.AuditEvent{
+		RemoteAddr: r.RemoteAddr,
+		Time:       time.Now(),
+		Command:    accessDeniedMarker + reason,
+		RequestID:  randomstring.Generate(16),
+	}
+	if server.auditLogger != nil {
+		server.auditLogger.Log(context.Background(), event)
+	}
+	if server.auditIndex != nil {
+		server.auditIndex.Log(context.Background(), event)
+	}
+	if rule == accessRate {
+		http.Error(w, "too many connections", http.StatusTooManyRequests)
+		return
+	}
+	http.Error(w, "access denied", http.StatusForbidden)
+}
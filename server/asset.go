@@ -194,7 +194,7 @@ func staticJsBundleJs() (*asset, error) {
 	return a, nil
 }
 
-var _staticJsGottyBundleJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xbd\x0b\x77\xdb\x38\x92\x30\x7a\xee\xfb\x9c\x3d\x67\xef\xfb\xfd\xac\x68\xe6\x4b\x4b\x6d\x5a\x16\x65\xc9\xaf\xc4\xed\x4f\xb1\xe4\x8e\xbf\x75\xec\xac\xed\x74\x4f\x5f\xc7\x93\xa1\x25\xd8\xe2\x84\x22\x35\x24\x64\xc7\x93\x64\xef\x5f\xbf\x07\x05\xe2\x0d\x4a\x74\xba\x67\x76\xcf\xd7\x19\x6b\x48\xb0\x00\x14\x0a\x85\x42\x55\xa1\x00\x3c\xbb\x5d\xa4\x63\x1a\x67\x29\x34\x49\x0b\x3e\xff\xe3\x3f\x00\x00\xc8\x34\xda\x8c\x65\x22\xfb\x2f\xbe\x85\x66\x7e\x15\x5f\xb7\x20\x27\x74\x91\xa7\xc0\x5e\xda\xe4\xd3\x3c\xcb\x69\xf1\x42\xc1\xdd\x47\x39\x64\xb0\x8f\x9f\x61\x1f\x3e\xc7\x7b\x10\x07\x90\xec\xc1\xb3\x30\x80\x12\x7c\x0f\x3e\x7f\xfd\xaa\xe5\x29\x4b\x24\xac\xc4\x71\x94\x24\xcd\x4c\x14\x1c\x40\x16\x80\xf6\x46\x5b\xec\x35\x81\x7d\x78\xd6\xd1\x3e\xf0\xa2\xbe\xfe\xe3\x3f\xf0\x07\x86\x43\xce\x2a\x17\x95\xd0\xf6\x0c\xf6\x81\x04\x40\xdb\x63\x86\x1b\x7b\x88\x61\x1f\x7c\x14\xd0\x11\x2a\xcb\x65\xe0\x13\x13\x3c\x60\x85\x98\x14\xa2\xed\x0c\xd3\x5b\xf0\xe5\x0b\x9c\xdd\xfc\x99\x8c\x69\x7b\x42\x6e\xe3\x94\xbc\xcd\xb3\x39\xc9\xe9\x63\x99\xed\xf3\x38\x4b\x6f\xe3\xbb\x45\x1e\xdd\x24\xa4\x24\x4c\xba\x98\x11\xf1\xde\x09\xe0\x8e\xd0\x3d\x88\xbf\xb6\x34\x04\xd2\x25\xf8\x8a\x06\x13\x78\xfe\x1c\x48\xfb\xc3\x07\x52\xbc\xc9\x26\x8b\x84\xc0\x81\x96\xc7\xc8\xa2\x37\x93\xa1\x19\x2d\x12\xaa\xbe\x7e\x85\xbd\x3a\x19\xb5\x0c\x6e\x77\xd2\xf6\xa4\x99\x07\xd0\x88\x1a\x8c\x28\x01\xe4\x5a\x63\x32\x9b\x9a\xd4\x47\xff\x92\x88\xf3\x3c\xa3\x19\x7d\x9c\x93\xf6\x34\x2a\xce\x1e\x52\x41\x4e\xce\x2b\x98\x59\x2b\x7a\x0e\xfb\xd0\x68\x04\x40\x9b\xb4\x5d\xc0\x3e\x74\x77\x5a\xff\xf8\x0f\x5f\x9b\x57\x66\x75\xd8\x4f\x65\x8d\x8d\x45\x41\xa0\xa0\x79\x3c\xa6\x8d\x17\x82\x87\x24\x78\xec\xa1\x35\x85\x7d\xa0\xd3\x58\xe7\x7b\x36\x3e\x9e\x35\x59\x22\xc4\x69\x41\xa3\x74\x4c\xb2\x5b\x88\x5b\x72\xbc\xa4\xe4\x01\xe2\x66\x94\xdf\x2d\x66\x24\xa5\xc5\x55\xe7\x3a\x00\xf5\x16\x1a\x6f\xdd\xeb\xd6\x0b\x9d\xb1\x6e\xf2\xec\xa1\x20\xac\x87\x2f\x90\x87\x59\xe9\x6c\x10\xc4\xe5\x63\x00\x8b\xf6\xe8\x9e\xa4\x74\x34\x8b\x29\x25\x39\xa7\x0c\x43\xa6\x15\x40\x23\x5d\xcc\x6e\x48\xde\x80\xfd\x7d\x60\x54\xcc\x6e\x39\x9f\x34\x09\x1b\x22\x66\xc7\x8e\xb3\xa4\xd8\x03\x03\x49\xab\xe7\xb3\x07\x03\x20\xb4\x01\xa6\x51\x3a\x49\x48\xbe\x67\xb4\x46\xe3\x93\x56\x00\x04\x39\xf5\xcb\x17\xf8\xfc\x35\x10\x7d\xfc\x91\x3c\x16\xcd\x58\x30\x62\xd1\x6a\xdf\x66\xf9\x28\x1a\x4f\x9b\xaa\xdb\x72\x87\x0d\xd3\x45\x92\xb0\x56\x91\xab\xfc\x9a\xb7\x88\x3d\x30\xb2\x64\x73\x96\xa7\xb8\xca\xaf\x03\x88\x59\xe2\xb3\x7d\x96\x2c\x8a\xb7\xe1\xaf\xf2\xeb\x56\x2b\x00\xca\x5f\x59\xaa\x89\xf1\x0e\xec\xb3\x6a\xda\xe3\x2c\xc9\xf2\xa2\x9d\x90\xf4\x8e\x4e\xe1\x40\xa6\x80\xf6\x71\x9c\xa5\xe3\x88\x36\xe3\xf6\x87\x32\xa1\x48\xe2\x31\x69\xee\xb4\x5a\xb0\x07\xe1\xd6\xaf\x2f\x29\xdc\xe2\x45\x75\x9e\x50\x14\xcf\xd9\x09\x60\xbd\xdb\xaa\xc4\x10\xbf\x06\x76\xa6\xf5\x2e\xaf\xce\x4f\x03\xa4\xe2\x53\x70\xaf\xae\x84\x75\xc0\x34\x2e\xdc\xc2\xca\xf4\xb2\x4f\x4b\x41\xce\x52\xe6\x51\x4e\x52\x8a\x90\x37\xd9\xe4\x91\xb1\x14\x11\x89\x5f\xbe\x40\x73\x00\x07\x30\x68\xdf\x11\x3a\x4a\x08\xb2\xe2\xab\xc7\xcb\xe8\xee\x34\x9a\x91\x66\x83\x65\x68\xb4\xae\x3a\xd7\xb0\x87\x7c\xa4\xd5\x2e\xeb\x2e\x78\x89\x77\x24\x9b\x11\x9a\x3f\xe2\x90\x45\x20\x36\x0a\x10\x08\x1f\x4c\xa0\x50\x00\x89\x14\xd8\x87\x2b\x59\xb4\x56\xc0\x35\xa3\x42\x39\x5c\x18\x1d\x79\x23\xd3\x66\x63\x12\x51\x26\x33\xe5\x47\x81\xda\xe3\x4d\x54\xb0\xb1\xd3\x11\xef\x93\xb8\x98\x6b\xef\x9f\xf4\x6f\xda\xf3\x78\x91\x17\x59\x7e\x41\x23\x4a\x9c\xd4\xd7\xf1\x64\x42\xd8\xd4\xc2\x66\xa1\x12\xcb\xf4\x9e\xe4\x74\x94\x25\x65\xc2\x5f\x16\x64\x41\x84\x50\x65\x09\xc5\x38\xcf\x92\xe4\x32\xd3\xeb\xe6\x69\xaf\x32\x4a\xb3\x59\x29\x1c\x39\x71\xd6\x41\x16\xbc\x28\x68\x36\xfb\x27\xf2\x88\x92\xea\x75\xd9\xf0\x7d\xa4\xbe\x81\xd3\xab\x24\x4e\x3f\x1e\xa7\x94\xe4\xf7\x51\x62\x02\x44\xf3\x79\x12\x8f\x23\xc6\x08\xff\x44\x1e\xe7\xd1\x44\xc7\x5c\xfb\x78\x88\x05\xe9\x1f\xb3\x3c\xbe\x8b\xd3\x37\xd9\x84\xe8\xa9\x71\x5a\x90\x9c\xda\xa9\x0f\x79\x34\x8f\xf2\x6c\x91\x4e\xc4\x17\xd1\xcc\x34\xcb\x67\x36\x4e\xe3\x69\x94\x17\x84\x9a\x89\x77\xfe\xd4\x84\xdc\xa3\xe0\xee\x98\x79\x19\x33\x5d\x31\x38\xc1\x3b\x13\x32\x3e\xc9\xc6\x11\xcd\x72\xd1\xb7\x61\xe7\x4d\xb6\x28\x04\xe7\xdf\xd3\x6e\xc7\x4a\xd8\x34\x13\x38\xa6\x7a\xca\x8c\x3d\x23\xed\x05\x17\x16\x24\x9d\x1c\x65\xe3\x85\x78\x5f\xd0\x5b\x3d\x43\x71\x97\xeb\xaf\x8b\xfc\xd3\x3d\xd5\x13\x08\x1f\x55\xb2\x29\x71\x32\xc9\x49\x2a\x38\x9c\xdc\xe6\xa4\x98\x5e\xd0\x28\xa7\x66\xd2\x28\x9d\x88\x0a\xa2\x7b\x32\xf9\x83\xfe\xf2\x8b\xfe\x72\xa8\x8d\x17\x12\x4d\x98\x76\xa4\xf7\xc5\x43\x1e\x53\x3b\x6d\x42\x6e\x07\x94\xb2\x8e\x0f\x37\xc3\x9d\x9e\xc6\xeb\x65\xb2\x0e\xa5\xa4\x48\x34\xc3\x1e\xb8\x56\xe0\x4c\x86\xbc\x65\x1f\xb4\xce\x9a\xe7\xe4\x36\xfe\xa4\x8f\x84\x79\x56\x50\x2b\x29\x4e\xe7\x0b\x9d\xb9\xc9\x03\xcc\xda\xc7\x5a\xa2\x98\x96\x45\xdd\x85\x84\x7b\x6c\xbf\xc5\xd7\xa6\x53\x10\x87\x6e\x49\x62\xa4\x13\x92\x13\xd9\x1c\xf9\xfe\xe5\x8b\xce\x6c\x05\x49\x08\x4e\x9d\x6f\xa2\x34\xba\x53\xe0\x4e\xba\x99\x8d\x0d\xbe\xf8\x36\x56\xf0\x2a\x81\x01\x92\x07\xf8\xd0\x3e\x11\x49\x5a\x57\x90\x57\x8b\xdb\x5b\xcc\x25\xe9\x88\xc9\xc7\x4c\x5f\xbb\xcb\x49\x51\xe8\x43\xec\x53\x76\x7b\x7b\x41\x52\x7a\x99\x1d\x46\x74\x3c\x7d\x37\x37\xc7\x5f\x4c\xc9\x05\xcd\xe6\x73\x62\x0c\xf0\x62\x91\xe7\xd9\x5d\x44\xc9\x87\x69\x7c\x37\xd5\xa9\x9e\xc4\x29\x29\x4a\x32\xde\xb6\x0f\xe3\x7c\xbc\x48\xa2\xfc\x24\x2e\x68\x53\x13\x4f\x37\xd1\xf8\xa3\xae\x58\xdd\x66\x39\x34\x85\xee\x2c\x45\xd6\x0b\xc8\xd7\xd7\x5f\xb4\xb4\x82\xdb\xf3\x45\x31\xe5\x05\xdd\x24\x51\xfa\xf1\x24\x4e\x49\xb3\x65\xa8\x68\x5e\xc2\x0a\xa1\x6e\x7f\x68\x17\x84\x72\x6a\x35\x55\x25\xa2\x77\x69\x74\xa3\xc6\x27\x5d\xcc\x19\x1d\x8a\xa6\xf8\xba\x28\x48\x7e\x81\x8d\x89\xd3\x3b\xa4\x8d\x65\xfb\x48\x7d\x29\x13\x7a\xae\x6d\xa7\x0c\xf2\x3c\x7a\x6c\xc7\x05\xfe\x3f\x53\x6e\xd9\x54\xc9\x46\xd1\x15\xb9\xc6\x99\xd9\x55\xbd\x88\xa3\x7a\x91\x76\x34\x99\xa0\x30\x61\x44\x26\x29\x6b\x0a\xaf\x8b\x15\xf7\x2c\x6c\xe9\x0a\x54\x15\x8a\x45\x15\x8a\xa4\x9d\x93\x59\x76\x4f\x56\x57\xe0\x16\x9a\xba\xe6\x84\xfc\x96\xbb\xa6\x8c\x98\xf3\x0a\x9a\x2f\xc6\x14\xe7\x0c\xdd\xac\x11\xc5\xa3\xee\xab\x2c\x11\xc6\x2f\xea\x8d\x11\x4d\xff\xc4\xb8\x30\xaf\xc2\x2f\x72\xf1\x93\xe6\x9b\x50\xf4\xdb\x71\xf1\x26\x1a\x23\xff\xb4\xa3\x84\xfe\x13\x79\x64\xcf\xcf\x68\x7b\x4c\xf3\x44\xbd\xcd\x08\x8d\xd8\x1b\x2a\x20\x5a\xd6\x8b\x9f\xe3\x74\xc2\x26\x5f\xab\x80\x8a\xfc\xae\xe9\xd6\xf8\x48\x1e\xe7\x6c\xc8\x72\xa3\xa1\x8d\xed\x3a\x80\x1c\xf6\x00\xb9\xba\xf9\x0c\x35\xf6\x43\x36\x3b\x7e\xf9\x02\xea\xe5\x07\xe8\x6d\x57\xf6\x4c\xe2\x58\x5e\xa0\x39\x0c\x08\xbc\x7c\xc9\xf4\xe3\x2f\x40\xd9\xd3\x0e\x7c\x81\xdc\xb2\xaf\x50\xdf\x7f\xb6\x0f\x49\xfb\xc3\x38\x1a\x4f\xc9\x55\xa6\xdc\x11\x5a\x9a\x6f\x84\x17\x01\xa4\x01\x44\x01\x4c\x03\x18\x07\xb0\x60\x33\x04\x6c\x30\xd1\xce\x24\xcc\x7a\x18\x00\x6a\x33\x2f\x60\x0e\x2f\x21\x6e\xdf\x1b\x5a\xee\x0b\x98\xaf\xad\x39\x9c\xc3\x30\x2a\xd0\xda\x28\xa1\xaf\xe6\xd7\x01\x30\x5d\xaa\x34\xee\xf0\x89\xa9\x83\x53\x7c\xea\x5e\x07\xc0\x75\xf6\xe6\x18\x58\x23\x26\x31\xb7\x13\xd5\x28\x28\x51\x6c\xb5\x9c\xca\xd8\x7f\x0c\xd3\xf9\x0b\x37\xfd\x26\x27\xd1\x47\x33\xf9\xab\x65\xd6\xc1\x4b\x58\x60\xc7\xb1\x86\x8f\x79\xa3\xe7\x2d\x83\xcf\x2d\x16\xd0\xe8\x09\xfb\x30\xa9\xea\xd3\xa9\xdf\x73\x22\xed\x1c\x8d\x4b\xc2\x6d\x4f\xda\x8e\x99\x56\x56\xb3\xf1\xfd\xb3\x7f\xfc\x07\xf8\x1e\x3e\x51\x92\xcf\xa0\x39\xa5\x74\x5e\xec\x6d\x6c\xa4\xf3\xd9\x9f\xd9\x60\x9d\x6d\xcc\xa3\xf1\xc7\xe8\x8e\x6c\x20\x40\x0b\x61\xff\x3d\x33\x24\xd2\x82\xc0\x9b\xe3\x4b\x9e\x70\x4f\xf2\x82\xe1\xd8\x6d\xef\xb4\x43\x4c\xda\xdf\xc7\x0c\x1b\x27\xc7\x87\xa3\xd3\x8b\xd1\xfe\x3e\xa6\x1e\x66\xf3\xc7\x3c\xbe\x9b\x52\x68\x8e\x5b\xd0\xed\x84\xbd\xf5\x6e\x87\x59\x2a\x17\xd9\x22\x1f\x93\x93\x28\xce\xe1\x6d\x1e\xdf\x33\xfd\xf9\x30\x9b\xcd\xa3\xf4\x51\xe1\xf4\xf0\xf0\xd0\x2e\x10\x2e\x89\xe2\x9c\x21\xd7\xf2\x17\xda\x65\x85\x6e\x06\x70\x38\xcd\xe3\x82\x66\xf3\x29\xc9\xe1\x3f\x90\xdb\xdb\x9c\x68\xa5\xdd\xc5\x74\xba\xb8\xc1\x26\x8e\xa7\x7f\xfe\xf3\x06\x96\x85\xe5\xbd\x25\xf9\x2c\x2e\xb0\x3d\x71\x01\x53\x92\x93\x9b\x47\xb8\xcb\xa3\x94\x92\x49\x00\xb7\x39\x21\x90\xdd\x02\xd3\x27\xef\x18\x3b\x65\xc0\xb0\x9c\x93\xbc\x60\x53\xc1\x0d\x8d\xe2\x94\x4d\x18\x11\x8c\xb3\xf9\x23\x16\x98\xdd\xa2\xec\x83\x22\xbb\xa5\x0f\x51\x4e\x20\x4a\x27\x10\x15\x45\x36\x8e\x23\x4a\x26\x30\xc9\xc6\x68\xbf\xa3\x3e\x0d\xb7\x71\x42\x0a\x68\xd2\x29\x81\xc6\x45\x99\xa3\xd1\xc2\x8a\x26\x24\x4a\xb0\xc4\x38\x05\xf6\x5d\x7c\x86\x87\x98\x4e\xb3\x05\x85\x9c\x70\xe7\x4a\x9c\xa5\x01\xc4\xe9\x38\x59\x4c\x18\x2e\xe2\x73\x12\xcf\xe2\xb2\x16\x96\x1d\x89\x56\x60\x81\x34\x03\xd4\x36\x19\xce\x01\xcc\xb2\x49\x7c\xcb\xfe\x9f\x60\x13\xe7\x8b\x9b\x24\x2e\xa6\x01\xb0\x61\x94\xc7\x37\x0b\x4a\x02\x28\x58\x22\xb2\x41\xc0\xda\xb3\x91\xe5\x50\x90\x84\xa3\x37\xce\xe6\x31\x29\x78\xbb\x15\x96\x08\xc7\x6a\x9a\x33\x02\xd3\x92\x64\x05\x4b\x79\x98\x66\x33\xb3\x45\x31\xc7\xeb\x76\x91\xa7\x71\x31\x25\x98\x6f\x92\x41\x91\x61\xcd\x7f\x26\x63\xca\x52\x58\x96\xdb\x2c\x49\xb2\x07\xd6\xcc\x71\x96\x4e\x62\xb4\x5c\xf7\x64\x67\x5e\x4e\x09\x44\x37\xd9\x3d\xc1\xa6\x71\x36\x49\x33\x1a\x8f\x79\x2f\x60\xbf\xcc\x55\x87\x97\x9f\x8a\x69\x94\x24\x70\x43\x4a\x1a\x92\x09\xc4\x29\x16\xc7\x92\x45\xeb\x72\x86\x09\x93\x2b\x34\x8e\x12\x98\x67\x39\x37\x9a\xad\x56\xb7\x15\x2a\xaf\x47\x70\x71\x76\x74\xf9\xf3\xe0\x7c\x04\xc7\x17\xf0\xf6\xfc\xec\xa7\xe3\xe1\x68\x08\x8d\xc1\x05\x1c\x5f\x34\x02\xf8\xf9\xf8\xf2\xf5\xd9\xbb\x4b\xf8\x79\x70\x7e\x3e\x38\xbd\xfc\x05\xce\x8e\x60\x70\xfa\x0b\xfc\xd3\xf1\xe9\x30\x80\xd1\x1f\xde\x9e\x8f\x2e\x2e\xe0\xec\x1c\x8b\x3b\x7e\xf3\xf6\xe4\x78\x34\x0c\xe0\xf8\xf4\xf0\xe4\xdd\xf0\xf8\xf4\x47\x78\xf5\xee\x12\x4e\xcf\x2e\xe1\xe4\xf8\xcd\xf1\xe5\x68\x08\x97\x67\x58\x69\x59\xdc\xf1\xe8\x82\x15\xf8\x66\x74\x7e\xf8\x7a\x70\x7a\x39\x78\x75\x7c\x72\x7c\xf9\x4b\x80\x85\x1d\x1d\x5f\x9e\xb2\xb2\x8f\xce\xce\x61\x00\x6f\x07\xe7\x97\xc7\x87\xef\x4e\x06\xe7\xf0\xf6\xdd\xf9\xdb\xb3\x8b\x11\x0c\x4e\x87\x70\x7a\x76\x7a\x7c\x7a\x74\x7e\x7c\xfa\xe3\xe8\xcd\xe8\xf4\xb2\x0d\xc7\xa7\x70\x7a\x06\xa3\x9f\x46\xa7\x97\x70\xf1\x7a\x70\x72\xc2\xaa\xc3\xf2\x06\xef\x2e\x5f\x9f\x9d\x33\x5c\xe1\xf0\xec\xed\x2f\xe7\xc7\x3f\xbe\xbe\x84\xd7\x67\x27\xc3\xd1\xf9\x05\xbc\x1a\xc1\xc9\xf1\xe0\xd5\xc9\x88\x57\x77\xfa\x0b\x1c\x9e\x0c\x8e\xdf\x04\x30\x1c\xbc\x19\xfc\x38\xc2\x5c\x67\x97\xaf\x47\xbc\x9d\x0c\x94\x63\x0a\x3f\xbf\x1e\xb1\x64\x56\xef\xe0\x14\x06\x87\x97\xc7\x67\xa7\xac\x49\x87\x67\xa7\x97\xe7\x83\xc3\xcb\x00\x2e\xcf\xce\x2f\x65\xf6\x9f\x8f\x2f\x46\x01\x0c\xce\x8f\x2f\x18\x71\x8e\xce\xcf\xde\xf0\xc6\x32\x12\x9f\x1d\x31\xb0\xe3\x53\x96\xf7\x74\xc4\x4b\x62\xe4\x37\x7b\xe9\xec\x1c\xdf\xdf\x5d\x8c\x64\xa1\x30\x1c\x0d\x4e\x8e\x4f\x7f\xbc\x80\xe3\x53\xa7\x5b\xb1\xb3\x37\xb8\x68\xf5\xfb\xa5\x69\x00\x0d\xe5\x34\x6e\x04\xf0\xf9\x3e\x4a\x16\xe8\x8b\xfe\x2a\xd4\x5c\x36\x8b\xa2\xf3\xbc\x19\xf6\x5b\x7c\x06\xcd\x9b\x61\x8b\xcf\x25\x79\xb3\xdb\x6d\xf1\x39\x34\x6f\x76\x37\x5b\x01\xdc\xf2\x47\x06\x7a\xc7\x1f\x5b\x01\xcc\x78\xa6\xad\x56\x00\x8f\xfc\x71\xa7\x15\xc0\x0d\x7f\xdc\x6d\x05\xf0\x81\x3f\x6e\xb7\x34\xaf\xe2\x21\xcf\xde\x69\x05\xf0\xc0\x1f\x7b\xad\x00\x2e\xf0\x91\xe5\xb9\xe7\x79\xd8\xf7\x01\xb3\x09\x16\x29\x6f\xdd\xa4\xc1\x74\x85\xd2\xe3\xf9\x80\x1a\x11\x1c\x94\x0f\x6d\x21\xdd\x4a\xef\x4f\xd9\xc6\xb4\x19\xdb\xae\xd4\x56\x00\xb1\xe6\x81\x26\x79\x54\x90\xd2\x96\xac\x70\x90\x97\x53\xe0\x7a\x3f\xec\xc2\x73\xd3\x2c\xfd\x02\xfd\x30\x14\x89\xa5\x51\x2a\x5d\xd7\x71\x9b\x46\xe9\x5d\x76\x28\xdc\x5f\x57\x8d\xdf\x75\xc9\x66\x6f\x73\xab\x11\x40\xe3\x77\xe3\x71\xa7\xd3\xe9\xe0\x63\x8f\xec\x46\x9d\x32\xb5\x17\x89\xd4\xcd\xde\x56\x3f\xea\xe1\xe3\x76\xbf\xdf\xd9\xbe\xc1\xc7\xce\xd6\xee\xce\x6e\x84\x8f\x93\xcd\xc9\xf6\xf8\x16\x1f\xfb\xfd\xfe\x76\x7f\x13\x1f\xc9\x6d\x77\xb7\xbb\x8b\x8f\x3b\x11\xe9\x6e\xf2\x12\x6e\xc7\x64\xb7\xc7\x61\xb7\xbb\xbb\xb7\x65\xb6\x68\xb2\x7d\x1b\xed\x94\xb5\x91\x2e\xe9\xf2\x12\xd8\x7f\xe3\xc6\x35\x6b\x81\xf4\xdd\x55\xd0\x46\x26\x13\xef\x12\x0a\xfb\x2f\xe3\xd6\x56\xe3\x77\x0d\x58\x03\xca\xd4\x0b\xf6\x7f\x39\xff\xbf\xb8\xd5\xaa\x50\xce\xb5\x85\x2b\xd7\x5a\x29\xbb\x04\xad\x1c\xd2\xa6\xd9\x05\xcd\xe3\xf4\x0e\x3d\xa9\xc2\x91\xf9\x12\xba\x70\x00\x8d\x0e\xab\x95\xc0\x5e\xa5\x11\x80\xba\x7a\x80\x1a\xab\xd1\x61\xa5\x23\xb3\x15\x00\xf6\x5d\x27\x80\xdd\x7e\x00\xe1\x26\xfb\xd9\xee\x07\xd0\x0d\xd9\x4f\xbf\xef\x68\xa7\x39\xd7\x3b\x73\x86\x41\xb8\xf5\x02\x72\xa6\x6a\x92\x66\x71\x95\xc3\x06\x6c\x6e\xc1\xbf\x03\xa6\x13\x33\x85\x92\x27\xd9\x29\xff\x0e\xb6\xae\x1d\xa3\x56\x2f\xb4\xa7\xca\x84\x7d\xd8\x81\x35\x08\x3b\xf0\x3d\x6b\x44\x11\x40\xd1\x72\xb5\xff\xac\x64\xc9\x26\xf2\xfe\x07\xd9\xa7\xb1\xe9\xb3\xc5\xaf\xf7\x2b\x7b\x5c\x68\xe0\x4c\x2b\x29\x9d\x03\xb9\x56\x18\x27\x65\xe7\x05\x64\x0c\xd7\xfe\xd6\x0b\xc8\x10\x59\xa6\xa0\x46\x79\x41\x8e\x53\xda\xcc\xaf\xb2\xeb\x36\x4e\x6b\xbc\xdb\x5a\x01\xa0\x0c\xa1\x9c\x55\xae\x08\xfc\xf0\x03\x53\x39\x9f\x33\x02\x07\x80\xaf\x3b\xe2\xad\xdb\xef\xc3\x73\x20\xd7\x9e\x86\x52\xa3\xa1\x62\x71\xc0\x5c\x15\xe1\x58\xee\x29\x7c\x75\xa3\x9f\xcc\xc8\x1e\x34\xca\x8c\x8d\x40\xcf\x25\x9c\xa8\xb8\xbe\xa7\xe5\x21\xf9\xec\x34\xc2\x6c\xa8\x8a\xea\x99\x84\xc3\x78\x0f\xae\x76\x3a\x01\x74\x7b\xfa\xda\x8a\xe6\x1a\xb5\x8a\x14\xee\xdd\xc7\x84\x95\x7a\x93\x64\xe3\x8f\x7a\xa9\xf7\x71\xb1\x88\x92\x57\x24\xb1\x51\x99\x67\xf3\xb3\xd4\x93\xae\x7c\x25\x7b\x10\x92\x4d\xf3\x0b\x21\xe9\x3f\x91\xc7\xc2\xca\x32\x21\x37\x8b\x3b\x1b\x2d\x5c\x99\xe2\xfe\x46\x1b\x3c\x2e\xa2\x9b\x84\x5c\xd0\x49\x9c\x5a\x9f\x16\x05\x39\x4a\xb2\x87\xc3\x2c\xa5\xb9\x4b\xbc\xe8\xe6\x82\x66\xf3\x9f\xe3\x09\x9d\xee\xc1\x8e\x26\x39\xd5\xda\xc0\x67\x7c\xbf\xcd\xc6\x8b\x42\xfa\x5e\xab\x97\xfe\xa0\xb4\xe3\xa4\xd3\xa3\xa5\x16\x45\x85\x1b\x04\x33\xf8\x6c\xca\x98\x33\x6e\x0c\x2f\x81\x48\x4b\x31\x66\xdc\x4b\xf9\x02\x5c\x1e\xe0\x9a\x76\x00\x71\x00\x44\x98\xc5\x95\x6e\x15\x86\x87\xb6\x1a\xd6\xb2\x56\x41\x71\x85\x8c\xb4\xac\xa5\x76\xca\x47\xd3\x95\x23\x56\x28\x53\xc7\x49\xeb\x09\x6b\xa8\xcc\x3e\xcc\x4b\x57\x97\x67\xb0\x88\x89\x4a\x5f\xa5\x0b\x2a\x57\xa4\xe3\x2b\xc2\x57\xe2\xe4\x92\x1b\xb9\xd6\x3a\xca\xf3\xb5\x2c\xde\x9a\x6f\x45\x47\x2e\x9f\x6b\xb9\xe3\x8c\x7c\xa2\x51\x4e\x22\x9e\xa7\xd9\xd2\xd8\x43\x95\x77\x47\xe8\x19\xa2\xb0\x7c\x01\x9a\xaf\xe6\x32\x4d\x5b\x43\xb2\xd5\x02\x3a\xcd\xb3\x07\xf4\xef\x8c\xf2\x3c\xcb\x9b\xdf\x9d\x66\xc0\x9b\x84\xe6\x0c\x7c\x24\x8f\xd0\xf8\x0e\xa7\x90\x35\xf8\xae\xf1\x9d\x87\x8e\xf7\x59\x3c\x81\x0e\x2e\x48\xea\xcb\x5a\x8c\x22\x07\x4e\xca\x1e\xa6\x28\xea\x98\x8d\x29\xfe\xf5\x1a\x53\x3c\xc4\x74\x3c\xf5\x79\x06\xc7\x51\x41\x1a\x4a\x86\x34\xf6\x5c\x87\x05\x43\x88\xc2\x4b\xe5\x72\xf5\xba\x3b\x40\xf3\x8c\x35\x2e\x08\xa5\xcc\x9a\x62\x46\x8c\x2a\x1c\x50\x4d\x85\x84\x14\x05\xd0\x69\xc4\x0d\x48\xbe\xdc\xcd\x2c\x1e\x5c\x80\x6a\x78\x3c\x26\x3a\x5b\xc3\xda\x3e\x34\x9a\xa8\x6c\xc8\x45\xab\x35\x68\xb4\x98\x99\x9d\x66\x94\x19\x57\xd9\x03\x99\xb4\x1b\xcc\x0e\x4d\x8b\x2c\x21\xed\x87\x28\x4f\x9b\x4c\x33\x14\xde\x57\xfd\xbf\xaf\x15\xed\xb5\x7a\x16\xfb\xbf\xb2\xdd\x32\x07\xf7\x3f\x97\x3a\xca\x0f\x4b\x72\x80\xe6\x4a\x73\x73\xae\x33\x41\x51\x88\x4f\x7c\xe1\x70\x1d\xa7\xdc\x4e\x05\x79\x40\xf8\x46\x79\x39\x34\x8f\x67\xb8\x96\xd3\xcc\xec\xe5\xc8\x37\x11\x9d\xb6\x67\xd1\xa7\xa6\x96\xba\x0e\x59\x00\x9d\x96\xb5\x50\x69\x41\x0a\x2c\x38\x64\x21\xdd\xe5\xe5\x3a\x51\xb3\x13\x98\xeb\x88\x2d\x3f\xa6\x1e\x82\x5b\xc8\xcf\xa2\x4f\x27\x9c\x0e\xfb\x20\x16\xa3\xee\x63\xf2\xc0\xac\xe3\x76\xf1\x98\x8e\xb9\x47\x7d\x90\x93\xa8\xe9\xa9\xe4\xab\xd7\x4f\x26\x46\x40\x39\x40\xb5\xa2\x0d\x19\x47\x99\xe8\xf7\x8e\x11\x6d\x46\xf7\x0d\x12\xb1\x02\x70\xa8\xc0\x98\xf2\x63\x88\x66\xf1\x1f\x7a\x00\x5f\x54\xd6\x81\xba\x41\x65\x1d\xe5\x32\x5e\x7b\x9c\x44\x45\x71\x12\x17\xb4\x4d\xb3\xbb\xbb\x84\x34\xb9\x86\xb2\xce\xcb\x58\x2f\x58\x21\xeb\xcc\xb8\xca\x19\x55\x99\xea\xaf\x5e\xd0\x8b\x47\x5b\xc1\x37\x94\x78\x13\xe5\xac\x2c\xf6\x7f\x65\x29\x4f\x69\xa0\xae\x12\x2c\xa3\xa2\x5c\x47\x71\xfa\xd2\x92\xa9\x39\x29\x18\x9b\x9b\x54\xaf\x9e\x80\xaa\x7a\x49\xe3\x03\x7d\x55\xdb\x3f\x29\x39\xf9\x97\x84\x7a\x49\xc9\x50\x93\xc8\x37\xc8\x5f\x8c\x09\xc5\xe2\x66\x42\xa2\xdc\xac\x4e\xac\xb5\x33\x3d\xd8\x65\xd6\x8a\x80\x27\xd9\x7a\xff\xa2\x7d\x41\xa8\x2c\x76\x49\x24\x19\x96\xf2\xa4\xb6\xac\x67\x69\xc3\x1a\xa4\x5f\x03\xd8\xea\x74\x56\x76\xed\x92\x96\xaf\xe8\x60\x17\x3f\xbe\x3e\x55\x89\xdf\x92\x78\x86\xe7\xcf\xa1\x89\x98\x48\xf2\x54\x81\x2e\x2b\x85\xab\xb6\x3a\x3b\xdd\xc4\xe5\x62\xfe\x12\xe6\xc9\x9a\x44\xaa\x49\x01\x34\x50\x51\x6a\xe8\x4a\x9c\x3b\xbf\x10\x15\x25\xc0\x83\x0b\xd9\x6b\xf3\xae\x7d\xd8\x69\x8f\x2e\x0e\xd9\x4c\x79\x75\xdc\xc0\x65\x43\x97\x48\xd1\x64\xd2\x2c\x2b\x41\x88\x62\x9a\x3d\x70\xfa\x23\xa7\xf9\xc7\x1a\x86\x71\x3c\x36\x49\x4b\xd7\xe3\x71\x89\x8d\xcc\x62\xda\x94\x48\x7f\x66\x94\x8f\xd3\x28\xd9\x03\xf2\xd5\xb7\xdc\x68\x76\xfe\x4d\xb2\x58\xed\xb3\x31\xf5\x48\x96\xa5\x69\x93\xf8\x95\x5d\xce\x52\x0a\xb3\x22\x56\x12\x58\xcc\x77\xa4\xfd\xc8\x9a\xf9\xc8\x69\xb5\x8a\xea\x67\x55\x54\x17\xac\xa9\x11\x7e\x09\xe7\x7b\xc9\xcd\xcd\x26\x41\xf1\xb2\x11\x4f\x24\x78\x9c\xc6\xf4\xc7\x24\xbb\x59\x36\xb8\xd0\x2f\x50\x8a\x96\xc0\x1b\x55\x89\x34\x67\x16\xa7\x08\x9b\xd0\x18\xdd\x4c\x62\x1d\x23\x52\xb2\xa6\x19\xa0\xd2\x18\x67\xf3\x47\xa3\x1f\xdc\x60\x42\xa2\x07\xc8\xc0\x97\x2f\x30\x6f\xb3\x5c\x22\x6a\x23\x47\xcb\x91\x38\x6b\xf9\x06\x21\x5e\xb8\x6b\xba\xcb\x16\xd1\x4b\xae\x9b\xb7\xe7\x51\x41\x89\xa8\x49\x0b\x68\x05\x2b\xb2\xb6\x6c\x97\xc6\x60\x98\xb3\x0c\xb0\x75\x5a\xad\x7f\xa4\xda\x02\xf1\x51\x9c\x93\xdb\xec\x13\x1c\xb8\x59\x90\x06\x93\xec\x21\x5d\xc1\xb5\x5d\xbe\x30\x7c\xb3\xa0\x34\x4b\x19\x8b\xce\xdb\xb8\x8c\x71\x98\xc4\xe3\x8f\x32\xd2\x85\x11\x4c\x21\xbb\x82\x78\xb0\xe7\xeb\xb7\x94\xe5\x9f\x91\x74\xb1\x02\xa1\x5f\x5f\xbf\x49\xa3\x93\x38\x5d\x7c\x62\x0d\x73\x70\x8a\x16\x9f\xc6\xac\x96\x15\x08\x85\x5c\x9b\x31\x48\xc4\x06\xe6\x25\xf9\x44\x99\xa6\xf9\x8e\x29\x4f\x18\x4e\x55\x8a\xc4\xa7\x61\x6b\x89\x25\x36\x44\x56\x88\x25\xd5\x82\x8f\xe4\xb1\x46\x17\x0f\xda\xd1\x98\xc6\xf7\xa4\x0c\x9e\x84\xd2\x60\xe5\xf2\xe8\x23\x79\x1c\x66\x0f\x69\xd3\xe0\xd4\x00\x9e\x75\x90\x0f\xad\xca\x78\x28\xc1\xaf\xab\xed\x2d\x2b\xa3\x5e\x75\x8b\xf9\x8a\xba\xa6\x2c\x0d\x83\x26\xb8\xab\x80\x54\x94\xaa\x8d\xb3\x7a\x24\x5b\x4d\x17\xb3\xc8\x3a\x84\x31\x5a\x2f\x8c\x17\x34\x7b\xf7\xa1\xd1\x58\x59\xcb\x38\x9b\xcd\xb3\x82\x2f\x40\xb2\xc9\xb6\xc1\x63\x6f\x65\xe2\x6b\x92\xcc\x49\xde\xb6\xc1\x90\xa9\x9a\x1e\xc8\xd6\xd2\x2a\x16\xf3\x49\x84\x22\x67\x45\x1d\x1c\xee\x1b\x2b\x21\xe9\x64\x75\x0d\x24\x9d\x2c\x2d\x9e\x60\xa8\x6d\x39\xf5\x56\x14\xc7\xb1\x3c\x54\xe9\x22\x8e\xf8\x49\x25\x2f\xef\x5c\x8c\xae\x2d\xe3\xe9\x78\xec\x6a\x93\xb6\x8b\x32\x6a\xb2\x4d\xd2\x49\x9d\x99\xb6\x20\x39\x3d\xcf\x1e\x56\xef\x68\x69\x64\xe8\x0e\xd4\x57\xc6\xd4\x5e\x80\x41\x7b\x9c\x93\x88\x8a\x21\xd8\x6c\x4c\xe2\xfb\x86\x0c\xcb\xce\xb9\x57\x36\x8a\x53\x92\x33\xa5\x81\xa4\x93\xc3\x69\x9c\x4c\x9a\xca\xb2\x28\x23\x40\xb9\x0f\x91\x25\x13\x2f\xbe\xd9\x9c\xac\xf0\x58\xe9\x31\x79\x01\xfa\x59\xf9\x53\x81\x1e\x57\x8f\x31\xa4\x22\xc1\x31\x1e\x49\x25\x05\xf0\x4c\x7b\x73\x7d\x5e\x8d\xcb\x52\xa9\x81\x9c\xfc\x65\x11\xe7\xa4\x80\x08\xca\xd2\x84\x6a\xd5\x70\xbc\xbf\x22\x68\x8c\xf1\xa7\x70\xaa\xf0\x4c\xed\xec\x21\x25\xf9\xb0\x5c\x5d\x14\xde\xb6\x9f\x62\xf2\x20\x22\x54\xc5\xc2\xe3\x92\x6c\x25\x28\xc6\xb4\xef\x9b\xd9\x56\x45\xb3\x9b\x16\xb8\x93\xdb\xd7\xc1\x95\x46\x3b\xaa\xef\x42\xe9\x5b\x05\xc7\x57\x33\xea\x00\xad\xe3\xba\xc9\xba\xf4\xb8\xe1\x6b\x2b\x78\xb2\x29\x6d\x55\x55\x10\x3a\xa0\x65\x20\x48\xb3\x41\x23\x36\x44\xc9\xa7\x86\xe6\x86\x12\x2e\x1f\x39\xc5\x54\x70\xbc\x1f\xdc\xdf\x16\x01\xe4\xb4\x5c\x1f\x21\xbe\xe2\xec\x5a\x94\x0b\xaa\x2e\x5e\x2a\x87\x1f\x35\xee\x26\x5d\x67\xf2\xb3\xb2\x4d\x95\x58\xaa\xc2\x5b\x76\x38\xb1\x94\x02\xab\x10\x75\x73\x54\x20\x2a\xe0\x56\x13\xd1\x2d\xd2\x27\x9e\x56\x21\x66\x88\x32\x2f\x4a\x4c\x0d\x5c\x8d\x8d\x5e\x8e\x2d\x04\xf5\x38\x68\x19\x41\xdd\x8e\x28\x8d\xc6\xd3\xcb\x6c\x98\xcd\x9a\x03\x2b\x87\x28\x61\x8a\x33\x49\xed\xc6\x58\xe0\xfe\xf6\x70\xa0\x1a\x4d\xb2\x4a\x93\x41\xc9\xe5\x44\xec\x43\x46\x7c\x6b\xd8\xc0\xcb\x50\x59\xaf\xce\x65\x0e\xe4\x68\x41\xb3\x71\x96\xe7\x6c\xd6\x0a\xa0\x91\xdd\xde\xd6\xca\x11\xcd\x63\x1a\x25\xf1\x5f\x49\xcd\x4c\xc5\x9c\x24\xc9\x78\x4a\x50\xad\x6f\xdc\x46\x49\x41\xdc\x2c\x34\xba\x39\x66\x42\x45\xdb\x13\x20\xbf\x39\xe1\xd0\x1e\xef\x8a\x1b\x42\x5a\xed\xd3\x88\xbf\xda\xc6\xc9\x8a\xea\x1c\x57\x43\x65\x6d\xae\x3d\xef\xaf\xcc\xe6\x2c\x87\x59\x04\x36\x6a\xef\x94\x54\x87\xd8\x8c\xb7\x8a\x73\x2d\x70\x9b\x5d\xb4\xcf\x28\x66\x7d\xf9\xb8\xd6\x55\x86\x5c\x8f\xdb\x87\xf6\x17\xdb\x5e\xf6\xd5\x6b\x6e\xae\x58\xd9\x68\x2b\x77\x4b\xdb\xc9\x73\x11\xff\x95\xa0\xaf\x7d\xc9\x0c\x83\x4e\xef\xca\xc1\xeb\xd6\xe6\x29\xb6\xf5\x02\x0a\x7d\xf5\xec\x05\x14\xb8\xe8\x3c\x95\x1b\x9a\xce\xb3\x87\x66\xab\x5a\x4f\x72\xab\x21\xe6\xa4\xc4\x6a\x7d\x43\xa2\x62\x91\x8b\x70\xf6\x87\xf6\xa1\x4a\x93\xa2\xab\x42\x5a\x68\xd9\x51\x17\xc6\x5d\x4e\xf1\x5f\xc9\x78\x1a\xa5\x77\x64\xb2\x8a\x4b\x4b\xb5\x5b\x6f\xb9\xe9\xb8\xf7\xd5\x33\x2b\x51\xb3\x67\xba\x12\xff\x49\xfb\xa7\x32\xa1\x59\x3a\x9b\x3c\xd3\x61\xe5\x04\xeb\xd6\xe7\xd9\x8b\xc3\xaa\xb9\x69\x9f\x97\x09\xc6\x36\x1f\xcf\x56\x1c\x06\x7d\xd8\xbe\xb0\x3e\xe8\xc8\xe1\xda\x95\x67\xc6\xaa\x46\xc6\xd9\x67\x52\x65\x88\x78\x5c\x51\xb2\x25\x72\xe7\x96\x28\xac\x49\x84\x3d\x42\x1c\x7b\x64\x59\xc5\x29\x79\x50\x33\xfb\xaa\xda\xa5\x60\x13\xa6\x2d\x09\xf4\xd4\x72\x49\xdf\x48\xe3\x85\x7b\x19\x83\x55\xcf\xd5\x9f\xe5\xbc\x26\xd6\x80\xdd\x06\x08\x9f\xac\xaf\x74\x47\x89\x72\x64\xf1\xdf\xae\xee\xca\xb5\x51\xb9\xfd\x4c\x78\x5d\x19\xb5\xca\x58\x83\x7d\xef\x5a\x33\x33\xb2\x98\x9a\xd2\xf8\x25\x5b\xc0\x24\x9e\xe0\x6a\xf7\x3c\xc2\xa5\x74\x02\x7f\x42\x92\xff\x49\x6e\xe3\x86\x38\x85\x3f\x09\x63\xc9\x32\xe6\x9a\xad\x3f\xb5\xdf\xa7\xf6\x42\x7b\x8a\x2b\xeb\x97\xbe\xb2\xd2\xec\x01\x64\xb8\x15\xcd\xe0\x4f\x34\x5f\x90\x3f\xc1\xcd\x82\x02\xb2\x9a\x08\x0f\xe7\x1b\x00\xda\x7f\x2e\x60\xb3\xdd\x81\x46\x50\x96\x19\x53\x78\x88\x93\x44\x14\x81\x25\xe0\x94\xfd\x27\x67\x95\x1e\xb5\x2a\xdc\x21\xe8\x5d\xc4\xa5\x72\xd1\x59\x72\x98\xeb\x83\x74\x9c\x7d\x5e\x52\x72\x0b\xfa\x8e\x50\x35\x70\xca\xba\x49\x3b\x2e\x0e\xb3\x24\x89\xe6\x05\x99\x58\x44\x62\xa6\x5b\x96\x90\x28\xd5\xb7\xe7\x53\x38\x80\x67\x14\xf6\xa0\x71\xce\x04\x26\x7e\x22\xb8\x09\x07\xdd\x56\xb1\x19\xe1\x62\xf0\x07\xb3\x7a\xd0\xa9\x28\x05\x21\x9f\xf3\x59\x37\x35\x74\xf7\x41\x92\x45\x93\xc1\x64\xb2\x2a\x76\x44\x70\x69\xb3\xbb\xdb\x6a\x36\xda\x1b\x8d\x32\x1c\xa4\xc1\x9f\xfc\x0e\x09\x9f\x00\x5f\xb1\xc2\xe6\x9b\xe7\x70\xa8\x33\xb1\xc7\xa7\xd2\x46\x9b\x2b\x90\x0f\xf1\x84\xac\x33\xf8\xcf\x0f\x18\x08\xc6\x10\xe9\xc2\xf7\xee\x94\x80\x9f\x19\xae\xf3\x4f\x2f\xbe\x96\x99\xf9\xb6\x58\x3b\x7b\xad\xac\x38\xd4\x7e\x80\x49\x7c\xff\x79\x4a\xe2\xbb\x29\xf5\x67\xe5\xdf\x44\xde\x86\x7f\x31\x4a\xf4\x52\xbd\x48\x59\xff\x92\x6c\x60\xec\x8f\x02\x19\x57\xb3\x0f\x29\x3a\x5f\xd8\x2c\x73\xcf\x18\xf2\x3c\x7a\x78\xf5\x48\xc9\x61\x96\xe5\x13\xdc\x72\x97\x58\xf3\x49\xa2\x37\x00\x5f\x71\xe7\x6d\xc2\x63\x73\x5a\x32\xd4\x21\xe6\xb1\x70\xe8\xe2\xba\x27\x79\x1e\x4f\xc8\xe5\xe3\x9c\x70\x57\x2a\x67\x4f\xcf\xf2\x2e\xae\xd3\xab\x75\x05\xcf\x22\x3d\xfb\x0f\x63\x33\x2a\x62\x51\x7c\xab\xff\x66\xc9\x8b\xf9\xb2\x72\x37\xbb\xd6\x7a\xb1\x21\x0b\x3c\x34\xf7\xc5\x10\x6b\xd1\x76\x53\x7b\x10\xd3\xdf\x8c\xd4\xcf\x9f\x43\xdc\xc4\x98\xa4\xcd\xae\xb5\x0e\xe4\xc5\x34\x77\x87\x2c\x94\x9c\x90\xc8\xfd\xdc\xde\x6e\x61\x20\xdd\x4e\x6f\x5b\xcc\x0e\x32\xee\x11\xbd\x77\x1d\x5f\x14\x06\x85\x97\x10\x76\xb7\xf1\x8c\x89\x32\x4e\x10\xf6\x58\xeb\x7f\x00\x2c\xe9\xf9\x73\x4e\x0a\xf6\x82\x5c\x82\x40\xe1\x6e\x17\x37\xf5\xfd\xf0\x03\x6c\x69\xa9\xdd\x1d\xf8\x02\x5b\x9b\xf0\x1c\x68\xcb\x5e\xcf\x07\x92\x14\xa4\x12\xe9\x7e\xff\x49\x38\xff\x80\x38\x0b\xdc\xc2\xae\x86\x1a\xb5\x2b\x5e\x45\xee\x78\x19\xb9\xd5\x1e\x7c\x2f\xc1\x09\x3c\xdf\x87\xcd\x00\x68\xfb\x13\xac\x97\xfd\xdb\x7e\xe4\x8f\x1e\xc4\x45\x84\xa9\xb1\xe8\xdb\xed\xf9\xc2\xd9\x58\xf5\xe5\x69\x20\x2d\x88\x71\x8a\x0c\x1b\x2f\x38\x11\xd9\xb7\xd0\xfc\xb6\xa9\x7f\xeb\x9a\xdf\xfa\xe2\xdb\x92\xc0\xb4\x4d\x91\x85\x93\xbf\x62\xd0\xf2\xf2\x3a\x8d\xa5\xf1\x54\xe2\xbf\xb2\x23\x79\x9e\x7f\xb9\x42\xc1\xda\xfe\xc4\x9a\x1c\xf0\xe7\x47\xf6\x7c\xfd\x9e\xd9\xad\xa8\xd4\x24\x7c\x45\x3c\xae\xee\x40\xd0\xf7\x32\xaa\xf3\x14\xe0\x00\x9a\xcb\x7a\x42\xec\xd1\x24\x8c\xcb\x19\x2f\xc3\x5e\xb9\x94\x27\x52\x7a\xb0\x07\x5d\x23\x65\x0b\xf6\xa0\x24\x8a\x74\xa6\x6f\xb6\x4c\x4c\x8d\x6e\x94\xb3\xe8\x0b\xf6\x24\x08\x0a\x07\x58\x76\xa7\x25\xbf\x94\xed\x7e\xa1\xd1\x83\xe7\xa0\xed\x79\x74\x87\x82\x97\x43\x3f\x7f\x68\xe0\x29\x2d\x89\x76\x4a\x03\x6b\xa9\xbf\x85\xb4\xfd\x69\x6d\x0d\x53\xd8\xff\xd5\xc4\x52\xaf\xbf\xc4\xeb\x8d\xa8\x54\x9c\x14\x51\x5d\x65\x65\x25\x2f\xb1\x3d\x48\x02\x46\x88\xe7\x8c\xaf\x0e\x60\xbd\xc7\x9e\x60\x8f\xbd\xad\xc3\x66\xb7\xb5\x04\x0d\x3b\x6f\x63\xd6\x60\xaa\x13\x43\x0e\xc5\x53\xde\x8c\x4b\x67\x1c\xce\x8a\xcd\x18\x09\xa0\x1e\x1f\x97\xf5\xd4\x1b\x56\x0f\xdf\x2e\xd2\xbe\xcd\xb3\x19\x53\x6a\x0e\xb3\x09\x29\xc3\x26\xf8\x97\x00\xe2\xd6\xea\x8d\x29\xe9\x92\x49\x05\x43\xc4\xb3\x00\xec\x00\x2b\x19\x66\xfb\xdb\x4f\xb8\xe2\x68\x12\x78\x86\x1b\xcf\xf9\x2a\xf5\x01\xac\xc9\xe7\x3d\xed\xf3\xc3\x34\x1e\xf3\xb3\x85\xf8\xd3\x3a\x84\xe5\x77\x36\x77\xe9\x5b\xcf\x8f\x47\x4a\xd4\x72\x31\x0d\x07\xd0\x81\x3d\xe8\xc9\x37\x96\xd5\x1b\xe5\x07\xbf\x72\xae\x67\x95\x6e\x7e\x4b\xb9\xc3\xb3\x37\xc8\xbf\xdc\xe2\x5f\x56\x3c\x69\x4f\x08\x8d\xe2\x04\x5e\x42\x07\x0e\x60\x8b\x0d\xd8\xad\xfe\xb7\xd4\xf9\x30\x25\x64\x45\x55\x08\x32\x24\x09\x8d\x7e\x81\x1f\xf4\xfa\xea\x08\x3d\x7e\xa0\x40\x31\x8d\x6f\x71\xfb\x7f\x29\x5b\xf8\x6a\x1a\x91\x47\x07\x1c\xc0\x0e\x4f\xce\xf8\xa1\x47\xe5\xf9\x00\x07\x10\x6e\xf1\x74\xa6\xb8\xe7\xf0\x05\x62\xf8\xc2\x18\x34\xd1\x8e\x9e\x81\x03\x28\x98\x24\xcd\x50\x06\x68\x07\xce\xe0\x89\x12\xb8\x56\x57\x5a\x40\x9b\x5d\x36\x4e\x0b\x78\xf9\x12\x70\x28\xd3\x8a\xb1\xc2\x86\x43\x24\x16\xad\xa4\x05\x96\xc8\xf5\x3f\xae\xcc\x19\x01\x31\x51\x65\xd0\x8a\x1b\xe2\xc3\xa7\x69\x2d\xcc\x47\x69\x10\x18\xce\x9d\x28\x2b\xd0\x6a\x67\x33\x37\x07\xe0\x3e\x48\x5e\x0c\x64\x66\xbe\xe3\xa5\x99\x73\xb1\x63\x52\x04\x03\x49\x12\xb9\x10\x27\x90\x9e\x65\xf7\xa4\xc1\x8f\x35\x4c\xe4\x19\x3f\x8c\x7c\x3e\x60\x33\xae\x21\x6f\xba\x7b\xe7\xb4\xee\x27\xcd\x18\x0b\xb5\x90\x28\x96\x23\xe1\xfb\x8c\xd5\x1a\x2d\x74\x66\x5d\xab\xf9\xa6\x5d\xca\xfb\x88\xb3\xfb\xf2\xd5\x70\xa7\x7f\xf0\x9c\x8a\xa6\x49\x19\x5d\xcf\xe2\xef\x6a\x72\x6f\x69\x1d\x4a\x75\xa4\x68\x4d\x9c\x5c\x81\x8a\x9b\x27\xfc\x4c\x93\xa8\x20\xf2\x2c\xfd\x99\x95\x85\x76\x97\xac\x94\x78\x2b\xa5\xd9\x62\x3c\x15\xa1\x18\xbf\x45\xcd\x97\xac\x40\x1e\x9b\x5f\xb7\xfa\xb2\xc7\x7f\xb3\xda\xdf\x64\xf7\x64\x59\xe5\x5e\x23\x78\x42\x0a\x9a\x67\x8f\x2b\x1c\x03\xfa\xc9\x4f\xa1\xe7\xe4\x27\x91\xf6\x81\x70\x8e\xe1\x3b\xc0\xb8\x77\x5a\x9e\xc2\x54\x51\xc1\x57\xfd\xbc\xa1\xd5\x60\x62\x51\x5d\xb8\x8c\xc4\x22\x1a\x77\xaa\x9f\x66\x13\xb2\xe4\x53\x19\x96\xe9\xf1\xb9\x7b\x89\x53\xfa\xf9\x96\x3b\x67\x4c\x0f\xb4\xda\x3e\x51\x3a\x72\x31\xb4\xe4\x5c\x04\x97\x1a\xc7\x64\xea\x55\xb9\x11\x28\xab\xb7\x13\x99\xcb\x9a\x2d\xf3\x24\x25\x82\x1b\x4f\x99\x39\xcf\x37\x9e\x5a\x6b\xa0\xfc\xe9\xf1\x3c\x7b\x68\xe6\x15\xf1\xf0\x32\x4e\x78\x95\xd7\x48\x3b\xd9\x8e\xcd\x39\x4e\xe2\xbe\x3c\x7d\x4e\x38\x4e\xf1\xe5\x51\x6c\x4c\x69\x55\x20\x80\x7a\xc0\x8a\x03\x57\xa9\x7d\xfe\x93\xb1\xe3\x66\x7f\x7f\xdf\xbf\x05\x05\xb5\xa3\xa9\x67\x63\x4d\x68\x6c\xac\x59\x5f\x0f\xf4\x5d\x62\x7c\xb3\x8c\xe8\x62\x7c\x5b\x5f\x37\x32\xa0\x46\x3f\x75\x8e\x88\x92\x64\x11\x9b\x70\x54\x8e\x96\x0a\xb9\x2d\xb7\xed\xac\x99\x4e\xe5\x80\xe9\x71\xeb\xd6\x69\x7e\xb0\x5e\xfa\xfc\xb5\x13\xff\x5a\x76\x73\xe5\x61\x94\x5a\x9a\xef\x10\x2d\xee\xa0\x66\x9a\x79\xab\xdc\xeb\x56\x02\x17\x73\xdc\x63\x4c\x03\xb9\xd2\xea\xcd\x64\xd0\x48\x9d\x4b\xc8\x68\xac\x13\x0f\x1b\x27\x09\x2f\xe9\xfb\x44\x72\xb5\x02\x1f\x86\x0e\xf5\x24\x1a\x01\x84\x32\x0f\x77\x89\xa2\x3b\xb7\x69\x42\xad\x80\x28\x09\x6c\x38\x73\xe5\xf2\x82\xc2\x74\x19\x1f\x0f\x79\x4b\x56\x0c\x69\xc2\x54\x5a\xef\x34\xd0\x51\xbd\xcb\xeb\xf2\x1b\xfe\x1e\x6a\x5a\x7e\x77\xee\x59\x90\x84\xe2\x24\xfe\xc1\xdf\x49\xee\x41\x67\xce\x79\x6b\x3c\xff\x9a\x3a\x0f\xb4\x2c\x50\x2f\xef\x00\x2a\x7a\x53\xb0\x1b\xff\xc2\xb4\x79\xc5\x1f\xe2\x5c\x4d\x1c\x20\x22\x9e\xac\x9a\xf4\xab\x96\x66\x96\x74\xcd\xdb\xe8\x8e\x2c\x8b\xdb\xd5\x18\x81\xf5\x62\x93\xc0\xf7\xd0\x34\x4b\x5f\x56\xfc\x65\xc6\x4f\xe9\x5c\xba\x79\x49\x95\xbe\x5e\x8f\x9f\x2e\x33\x79\xce\x67\xcd\x82\x35\xb2\xaf\xaf\x64\x5a\x77\x36\xae\xd8\x01\xa5\x9d\x3a\xa8\x42\x0e\xf9\xfa\x5f\x19\x2e\x66\xee\x26\xe7\xa7\x9e\x4d\xbd\xa7\x0f\x0a\xc9\xaa\x97\x29\x76\x5d\xee\x43\x5f\x31\x87\x72\x11\x0c\x0f\x37\x5b\xcb\x4e\x33\x64\xec\xf3\x4c\x15\xaa\x9d\x83\xb8\xac\x32\xcf\x18\xf4\x96\xc1\x2a\x78\xe1\x71\x26\xf8\x37\x6a\x15\x84\x5e\xc6\x33\x92\x2d\xe8\xea\xcd\x58\x71\x9a\x92\xfc\x67\x56\x9d\xbd\x19\xf2\xeb\xca\x2d\x56\x2a\x6f\x9d\x13\x21\xc4\x80\xd4\xe9\x50\x8a\xd5\x4e\x00\x9b\x9d\x4e\xeb\x85\xdc\x59\xcf\x28\xf3\xa2\x72\x6f\x5a\x69\xe8\x36\x6d\xaf\x02\x15\xfb\xf2\x3d\x14\xf5\x32\x81\x38\x54\x59\x1d\x6f\xac\x49\x3f\x4f\x7f\x79\xf9\x22\x5c\xca\x17\xa1\x25\x30\x70\xf6\x97\xb2\xc9\xfc\x36\x4a\x27\xf2\x8b\xa7\xb7\x73\x2d\x8a\xb4\x60\xa3\x80\xfd\x9f\xbb\x45\x54\x07\x29\x08\x45\xd5\xa8\x99\x57\x4e\x3c\x8f\xb6\x44\x73\xb0\x75\x90\xf4\x1f\x25\xe7\x1d\xa9\x4b\x18\x5d\xb8\xbc\x7f\x15\x03\xc7\xcb\x18\x38\x00\x63\x9b\x20\x9f\x90\xaa\xc6\x57\x58\x2d\x9e\x92\x65\xa7\xf1\xab\xf2\x9a\xe8\x3e\x7d\x9f\xbf\x37\xd7\x5e\x55\x61\x3c\x1e\xf0\x50\x9c\x8c\x3c\xc9\x1e\xd2\xd7\x1e\xab\xc5\x2c\xdf\x58\xe0\x6e\x54\x17\xd1\xc4\x3d\xec\xc3\xd1\xdb\xf3\xd1\xe1\xe0\x72\x34\xc4\xb3\xc5\x70\xe1\xfc\x86\x00\x37\x47\x26\x50\x64\x59\xda\x86\xb7\x09\x61\xc2\x99\x99\xd4\x56\x79\xfa\x61\xcd\xac\xc0\xb4\xa0\x24\x9a\xb4\x65\x04\xd3\x32\xf0\x8a\xe5\xe1\x25\x59\x56\x51\xb5\xea\x0c\x69\x7f\xa0\x79\x41\xe8\xeb\xc7\x39\xc9\x29\xf9\x44\x99\x81\xb3\xba\x12\xb4\x7a\x6d\xe3\xc6\x89\x19\x3f\x8c\x52\x3c\x19\x00\xdb\x01\x11\x4c\x45\x25\xc0\x72\x89\x23\xf0\xe1\x86\xdc\x66\x39\x01\x19\x34\x81\x71\xef\x71\x01\xe3\x28\x49\xc8\xa4\xe1\xe8\x33\xca\x44\xaa\x40\xbc\x49\xbe\x51\xd7\xd0\x8a\xfb\x29\x4a\xe2\x09\x3f\x23\x3b\x2a\x0f\x51\xf8\xdb\x91\xe3\x5e\x56\x86\x8d\xc6\xda\x7e\x1b\xaa\xb8\xcd\xf8\x56\xe2\xe4\xe4\x2e\x2e\x28\xc9\x19\x9d\xdf\x30\x29\x6d\x33\x48\xc5\x91\x31\x36\x59\x96\xc8\x32\xad\x0d\x9e\xda\x64\x0d\x2f\xbc\x7e\xdc\xe5\x4d\x0a\x20\x5e\x35\x27\x4f\xc8\xca\x26\xba\x03\x4d\x1d\xfa\x2c\x94\x15\xd5\x06\x6f\x81\xac\x90\x9a\x07\x38\x58\xf8\x4d\xa3\x42\x86\xcc\xd4\xdb\xa1\xeb\x44\x4c\xe9\x45\x78\xeb\xd0\xc3\x72\xbe\xb1\x0e\x99\x70\x49\x3e\x51\x6f\x25\xb8\xcb\xb6\x46\x35\xfe\xf2\xcd\xdc\x15\xc7\xd7\xf0\x5c\x83\x64\xd5\x86\xf5\x0a\xec\x07\x49\x52\x51\x70\xb9\x6f\xad\x8e\xda\xed\x97\xc1\x4c\xb7\x0e\x95\xaa\xe4\x05\x6a\x12\xe5\xa0\x7d\x16\xfa\xe2\x53\xbd\x3b\xc2\x9b\x52\x91\x76\xb7\x68\x95\xfb\xf2\xf8\x86\x2c\x3f\x4c\x4b\xaf\x56\xb3\x44\x2a\x7c\x2b\xa6\x8d\x83\x75\x87\xf6\xa1\x4b\x72\x89\xe2\x3e\x4a\x16\x11\x25\xac\x9d\xc5\x38\x9a\x93\x0b\xf2\x97\x05\xc1\x23\x7d\x7d\xc7\x8d\x31\x6c\x91\x44\xc2\x7e\x10\xe6\xa9\x7d\xe0\x79\x87\x59\xa7\x36\x74\xa8\x94\x4d\xe7\x80\x74\xdc\xbc\xaa\x59\xfb\x07\xd0\x74\x6c\x30\xed\x45\x06\xd0\x96\xce\x5a\xdc\x3a\xd8\x82\x3d\x78\xf6\x2c\x2a\x43\x50\xcb\x73\xc1\xe5\x65\x33\x82\x3c\x46\x16\xd6\x2f\x88\xa6\x74\x9b\x70\x2b\x59\xed\x96\x24\xa6\xe7\xe2\x23\x79\x64\xb6\x33\xcb\xa3\x7d\x33\xcf\x05\xc0\xa4\xa9\xd8\xc2\xcb\x40\xfd\xe8\xfa\x19\xb9\xb2\x4b\x56\x79\xf3\x60\x1f\x3e\xf3\x0a\xf8\x95\x4c\x1f\xc9\xe3\x1e\x08\x57\x93\x22\x9d\x48\xfb\x6a\x5f\x8c\x63\x2e\xb7\xbd\x7c\x09\x1d\xf8\x02\x44\x9c\xbe\xfd\xf2\x25\x84\xf8\x2e\x56\xd8\x5e\xbe\x84\x2e\x26\x88\x95\xb8\x97\x2f\x8d\xb5\x4b\xb5\xf2\x5b\x1e\x8d\xec\x3d\x44\x06\x76\x2a\x8e\x57\x52\xa8\x54\x9e\x18\x54\x32\x18\x67\xaf\x57\x17\xcb\xd6\x30\x6b\xc5\x70\x18\xe5\x0d\x47\x27\x4f\x38\xc6\x05\x76\x7f\xa3\x66\x88\x65\xfb\xff\xb7\xea\xd4\xa7\x6f\x6c\xce\xeb\x4b\xe3\xde\x25\xc7\xf4\x5e\xda\xb8\x70\xd3\x77\x30\x8d\x5e\xfc\xe1\xf9\xaf\x28\xbe\xbb\xbd\xaa\xf8\xd1\xc5\xe1\xaf\x28\x7f\xd3\x57\x7e\xce\x43\x3d\x3c\xa4\x0f\x79\x84\x4a\x0e\x6b\x10\x62\xd8\xc6\x50\x8c\x78\xd8\x77\x40\x37\x87\x0d\x2e\xd5\xca\x92\xb8\xa7\xd7\x38\x04\xff\xc0\xc8\x73\xd3\x80\x3d\xbb\x90\xfe\xb0\xa1\xdc\xc7\xee\x25\x30\x07\x5e\x16\x39\x1b\x36\x94\x90\x35\x4b\x1c\xfa\x98\xa7\x9a\x3c\x3e\xde\x7d\x02\x79\x0e\x97\x91\xe7\xf0\x89\xe4\xb9\xf5\x91\xe7\xf0\x5b\xc8\x73\x58\x49\x9e\xc3\xa7\x91\xc7\x27\xa1\x9e\x40\x9e\xc1\x32\xf2\x0c\x4c\xf2\xd8\x0d\x1f\x7c\x4b\xc3\x07\x95\x0d\x1f\x3c\xa9\xe1\xbd\xce\xaf\x6b\xf8\xab\x65\x0d\x7f\xb5\xbc\xe1\xaf\xbe\xa5\xe1\xaf\x2a\x1b\xfe\xea\x69\x0d\xef\x7b\x1a\xae\x4d\x8d\x5f\xf4\x69\x90\xeb\x18\x9e\x3a\xbb\xff\xd2\x78\xca\x41\x60\xd0\xdb\x5a\x22\x05\x73\x6b\x98\x5c\x6d\xda\xd4\xfe\x17\x67\xe0\x6c\xfe\xcb\xd3\xf8\xdc\x57\x7f\x15\xad\xdd\xde\x7e\xdd\x78\x72\x7f\xbd\xae\xec\xaf\xd7\x4f\xc3\xdc\xd7\x5f\xf5\x31\x3f\x7a\x3a\xe6\x47\x95\x98\x1f\x3d\x0d\x73\xdf\xc4\x6a\xc4\x3c\x51\x73\x09\x6c\xdd\x5a\x3b\xa9\x42\xa3\xff\xc4\xbe\xef\x3d\x11\x0f\x73\x41\xb5\x02\x89\xad\xa7\x21\x11\x86\xdd\xa7\x8c\x00\xa7\x1f\xdf\xda\x23\xe0\xec\xed\x13\xeb\x5f\xa6\xe6\xac\xae\xff\x9f\x9d\xfa\xff\xf9\x89\xf5\xfb\x7a\xa1\x7e\xfd\xe7\x4e\xfd\xe7\x4f\xac\xdf\x37\x8e\xea\xd7\x7f\xe1\xd4\x7f\xf1\xc4\xfa\x9f\x24\x01\xc3\xfe\x6a\x11\x18\x3e\x71\x1c\x84\xe1\x32\x55\xd4\x45\x61\xbb\x06\x0a\xdb\x4f\x45\xc1\xa7\x6f\x54\xa3\xb0\x53\x03\x85\x9d\xa7\xa2\xe0\xd3\x08\xab\x51\xd8\xad\x81\xc2\xee\x13\x51\xe8\xfa\x94\x8f\x4a\x14\xba\x9d\xd5\x28\x74\x3b\x4f\x45\x21\x7c\x12\x0a\xce\x78\xf0\xa0\x10\x3e\x15\x85\x27\x49\xc4\x6e\x0d\xa5\xa0\xfb\x44\xad\x20\xec\x3e\x49\x28\x76\x7b\x35\x50\xe8\xd5\x46\xa1\xdc\x1f\xe9\xc1\xe0\x99\xa1\x81\xd9\xca\x59\xe9\xb3\xf8\xf2\xc5\x08\x14\xf6\x18\x1f\x5f\xbe\xb0\x92\x74\x70\xa3\xd4\xa5\x79\x9f\x3f\xd7\xf2\xf2\x17\xed\x76\x32\x95\xf9\xf9\x73\xd8\xea\x5b\x37\x47\x99\x17\xeb\xa1\x57\x13\xf6\xb4\xef\x3f\xec\xb3\x3c\xe2\xe8\x30\x4c\x7a\xb9\x0f\xbb\x1d\xbf\x42\xe2\x09\xef\x57\x6e\x17\x58\xc3\xed\x07\x7b\x10\xee\x76\x2d\x2c\xfc\xda\xcd\x9f\x1a\x36\x2a\xbd\x1d\x07\x95\xfe\x76\x95\xfe\xae\xd5\xbc\x0e\x3d\x7e\x6b\xf0\x93\x1a\xb6\xbc\x35\xeb\xb0\xd5\x63\x45\x6e\x56\x37\xc6\x57\x40\xc7\x41\xa3\x1f\xba\x8d\xea\x3f\x01\x8d\x7e\x08\x6b\xd0\xdd\x66\xe5\xf6\xed\xcb\xc2\x96\x97\x12\xf2\x5c\xdd\x70\xf7\x49\xd9\xca\x5c\xdd\xce\xd3\x72\xed\xf0\x5c\xa1\xcb\x81\xcd\xa5\xf9\x76\x5b\x4b\xaf\x57\xf3\xaf\x20\x14\x84\xde\x9d\x94\x57\xc4\x2e\x5d\x9e\x91\x17\xc9\x12\xed\x34\x00\x7e\xdd\xac\xfe\xba\xec\xe4\xf8\xbb\x43\x99\x65\x55\x88\xa9\x56\x9a\x76\xcc\xb5\xc0\x41\x6e\x7b\xb2\x11\xa9\x5c\x69\x78\x5b\xae\xb0\xd7\x8d\xae\xfc\xbb\x2c\x3d\xc8\xc0\x65\x3c\x0b\xae\xec\xc7\x56\x19\x5d\x22\xde\xb5\x8d\x73\xf2\xf2\x71\xbe\x25\x46\x40\x96\x0c\xe2\xdf\x45\xa7\x6d\xd4\x2b\x37\xd2\x7c\xf9\x22\x43\x4e\x54\x9d\x3e\x3c\x41\xdb\x15\x12\x8f\xa7\xcb\x78\xeb\x59\xf3\x19\xbf\xff\x7a\xb5\x68\xc6\x35\x3b\xcd\xe7\xdf\x92\x9b\x77\x7c\x4c\x4d\x1d\x67\xbe\x38\xc0\x90\x56\x79\xfa\x6b\x7a\xf9\x5b\xe8\xd2\xaf\x60\x55\x8c\x81\x59\xe5\xbb\xb7\x62\x46\xb0\x78\x7e\x73\xf5\x97\x2f\x35\xa3\x47\xa8\xb6\xea\x80\x59\x71\x55\x45\x5d\x7f\xad\xd1\x3c\x50\x27\x2e\xf0\xef\x6b\x55\x31\x08\x37\x64\xd9\x62\x9d\xe4\x3e\x75\x4b\x4b\xe5\xe1\x02\x9e\xa8\x18\x4e\x6f\x71\x00\x1a\x7d\x4c\x48\xfb\x26\xcb\x27\x24\xc7\x3b\x89\x18\xd2\x0f\xd3\x18\xcf\x63\xac\x1f\x3f\x43\x96\x17\x68\xed\xe7\x64\x94\x90\x07\xac\xc9\x1b\x65\xec\x53\x15\x1c\x6e\xb5\x88\x94\x64\x4b\x8e\x58\x97\x34\xc2\x5b\x66\xd4\x42\x14\x3f\x7e\xaf\x5d\x46\xc3\x54\xa5\xb3\xb2\xab\xcf\x6b\xc0\xab\x70\x35\x5e\x4b\xe2\x31\xe1\x57\xa2\x88\xb3\x2a\x0a\x6f\x30\x95\xa7\x92\x72\x47\xa0\xef\xb3\xba\xfd\xa5\x9a\x04\x24\xcf\x97\x85\xbb\xff\x4a\x22\x60\xe9\x7f\x7b\x32\x60\x35\xbf\x92\x10\x39\x29\xe2\xbf\x92\x1a\x37\x9a\xc4\xc5\x69\x74\x5a\x86\x1d\x94\x2f\xd4\xbd\x38\x95\x8a\x78\x60\x79\xf3\x4b\x53\xbf\x9d\xa4\xa5\xe9\x92\xbe\xef\x81\xbb\x53\xb1\xbc\x01\xac\xdc\xb0\x19\x80\x1d\x07\xcd\x83\xa9\xe5\xea\xf2\x38\x4b\xf0\x38\x69\xaa\x92\x2a\xaf\x3c\x11\x71\xd8\xa1\xdc\x46\x8c\x62\x46\xa6\xd0\x32\xa5\x29\x6f\xf9\x60\xa5\xb7\xe0\x25\x90\x72\x0f\x06\x5e\x3e\x56\x32\x4a\x79\xc9\x79\x03\x1a\x01\x84\xd7\x81\x11\x8e\x42\xd4\xdd\xb6\x31\xde\x76\x8d\xb9\x5f\xe8\xdf\xef\x08\x6d\xc6\xda\xfd\x68\xc4\xbc\x13\xbb\xfc\x8c\x81\xb7\x0e\x77\x18\x2c\x8b\x14\x50\xaa\x8a\x76\x1b\x83\x6a\x42\xa0\x2e\x2e\x61\xd4\xe1\xf7\xe8\xe2\xb6\xc4\x97\xac\xef\xe5\xa5\x66\xba\xc4\xc3\xab\xc2\xd8\x77\x13\x31\x89\x30\x95\x81\xe6\x9e\xf8\x7f\xdc\x50\xa9\xc5\xb6\x68\x19\x7d\x5b\x22\x1e\x61\x0d\x52\x66\x95\xc9\xa5\x75\xb9\x8b\x20\x95\x3b\x2f\x44\xfc\x79\xc7\xd9\xa8\x61\x6e\x6e\xf0\x5f\x27\xee\x1c\xa3\xaa\x35\x44\x94\xa7\x1f\xef\xc5\x35\x8c\xb2\xdf\xb2\xf5\x75\xc6\xe8\x2f\x5a\xd5\x57\xc7\x54\x50\xc3\x06\xf3\x36\x3d\xb4\xb6\x72\x64\xf3\x26\x3f\xfa\x61\xea\x6c\x3f\xc1\x26\xaf\xad\x55\x36\x67\xd9\x25\x36\xfc\xa6\xa2\x5c\x69\xb1\x65\xd6\x32\xb8\xb7\xd5\x02\x26\x50\xe2\x74\x41\xaa\x2e\xf5\xa9\xda\x71\x95\x2f\xbf\xd7\xc5\x90\x6a\xe8\x98\x55\x7a\xee\x23\x6e\x51\xd0\xd8\x87\x7d\x2b\x83\xaf\x52\x3d\x79\x6d\x1f\xe4\x21\x8e\x9f\x58\x26\x8d\xca\x9f\xf0\x6c\x5a\xed\xa0\x26\xb5\x59\x45\x1d\x26\xa8\xef\xf8\x10\x95\xd4\x38\xea\x6c\xd5\x99\x50\x7c\x0b\xa8\x3c\xd6\xbf\x94\x86\xfc\xae\x39\x29\x2e\xf8\x39\x24\x32\xff\xb5\xa1\xcc\x71\x99\xdc\x08\x2a\xd7\xe0\xe5\x49\x82\xa8\x48\xfa\x81\x58\x0d\x7b\x40\x2a\xbe\xb2\x4a\xf7\xf4\x8d\xc1\xb2\x97\x96\x9e\xd1\x01\x55\xa7\x0f\x61\x3c\xf3\x12\xbd\x91\xc8\x13\xf4\xf4\x28\x6c\xd9\x5f\x56\x6c\x36\x5a\x05\x62\x74\x68\xb1\xd9\x36\x3c\x8f\xd7\xae\x88\x7b\x9d\x45\x9f\x5c\xac\x7c\x1b\xfa\x8c\xaa\x3b\x95\x11\xe1\xa2\x9f\xab\x2c\xbc\x52\xca\x2e\x21\x02\x0a\x0f\xb9\xcb\x5f\x8c\x71\x1a\xdd\xa0\xa9\x87\x26\x84\x8c\x30\xcf\xc9\x3d\x2b\xae\xc9\xb7\x6a\x35\x25\x64\xb9\xe3\x92\xe0\x86\x99\x17\x8a\xae\xac\xbb\xd9\xeb\xda\xbe\x33\xff\x1a\x97\xf6\xb4\x5a\x66\xad\x6a\xcf\x90\xd5\x22\x81\x41\xad\xf6\x18\x67\x71\xf0\x11\xd8\x7a\x51\x46\x91\x61\x4d\xeb\xeb\xe4\x1a\x3d\x18\xe5\xc6\x02\x37\x5c\x8b\xc8\xad\x49\x38\x81\x1d\x68\xcf\x7c\xd5\x86\x94\x87\x00\x74\xd4\x75\xa1\x16\xca\x29\xf9\x44\x7f\x3b\x94\xd7\xd6\x04\xca\x3a\x89\xff\x46\xa8\xe3\x45\xb7\xe7\x78\x94\x55\xed\xa3\xba\x35\xcd\xc0\x9c\x46\xec\xb3\x28\x5d\x55\xd4\xb8\x54\x91\x4b\x24\x79\xd5\x2e\x13\x74\xa5\x12\xe3\xf2\xd7\xda\x5a\x0b\xf2\xf2\x3a\x41\xef\x86\x34\x7d\x77\x43\x0d\x2d\x3c\x2a\xc8\x09\xb9\xfd\xfb\x34\xba\x46\x7b\x3d\x54\x22\x6b\x6b\x2f\x80\xa0\xd2\xf6\xdb\x35\x1c\xa3\x4f\x97\x9b\x1f\xce\x86\x4a\xf4\x5d\xa8\x34\xff\xbe\xa5\x72\xb3\x24\xa1\x72\x7a\xaa\xa2\x17\x2f\xc4\xdc\x64\x29\x36\xd3\xca\x79\x50\xee\xcd\xd3\xb7\xbe\xea\xef\xd6\x09\xf1\x60\x6f\x38\x0a\x35\x16\xe2\x87\xa7\x12\x7d\x8f\x72\xa5\x76\xe6\x23\xf1\xaa\x89\x77\xd5\x6e\xcd\x55\x7c\x18\xa7\xcb\x66\x30\xc5\x34\x38\x4c\x9b\x9d\xa0\x6a\xe2\x91\xed\xa8\xc7\xd5\x57\x72\x26\xd0\x58\x52\x68\xb0\x7e\xb3\xe2\xea\x5a\xdc\xe3\x6b\x49\x36\x3e\xa5\xc6\xed\xb8\xf8\x39\x8f\xca\x70\x55\xda\xe2\xb7\xfd\x6a\xc3\x18\xef\xfc\x8d\xaf\x32\xc6\xcd\xb9\x2b\xcf\x62\x3f\xcf\x4e\x57\x5f\x78\xc0\x9a\x52\x3d\xbc\x60\xaf\xc2\x58\xf2\xd6\x17\x2f\x9b\x4a\xcb\xfa\xb8\x33\x4f\x1c\x3b\xcc\xef\xfa\x85\x35\x68\x34\x5a\x6d\x3c\x8f\xfe\xec\xb6\x6a\x57\x8c\xef\xb4\x02\x4f\x87\x8b\xad\x8d\xfa\x1d\xba\xda\xae\xe5\xa7\x45\x36\x6b\x5c\x3a\x89\x68\x84\xf1\xba\xcb\xb0\xbb\x8c\x69\xb2\x9a\x25\xb1\x3c\xca\x40\x1b\x95\x2c\x19\x97\xe7\x68\x57\xc8\x9b\xb5\x35\xa1\x7a\x7b\x34\x63\xa5\x72\xcb\x4d\xdc\xfc\x7b\xb3\x65\xa8\xdf\x6a\xd2\x13\x14\xf8\xb4\xbe\x5e\xe1\x73\xb8\x27\x78\xd7\xf4\x52\xa4\x84\x80\x71\xf7\x9b\x1f\x18\xb6\x14\x5a\x2b\xe2\x92\x06\x69\x1c\x68\xf2\xca\x12\x17\x9a\x33\x51\x49\xcb\xea\x6c\x4a\x2a\x3d\xeb\xfc\x66\xfb\xcb\xe5\xf8\x7e\xac\x24\x91\xbd\x56\x50\xcd\x9b\xc2\x80\x52\xd6\xbc\xf1\xb9\xf4\x07\xa8\xe1\x6f\x8a\x20\xa9\xfe\x78\x1d\xf9\xda\x99\x05\x9e\x7b\xf1\x8c\xab\xc3\xd0\x81\x45\xd5\x81\xca\x65\xfd\xea\x5a\xbd\x8a\xbd\x64\x41\x65\xf1\x9a\x55\xb8\x4a\xf6\xaf\xb8\x5f\xd4\xa2\x2e\xd3\x88\x57\x92\x17\x95\x40\xce\xc7\x4b\xd4\xe4\x59\x44\xc7\x53\xe1\xae\xc5\xe3\xb9\x3e\x8c\xa3\xf1\x94\x94\x7a\x7a\xc2\x24\x07\x8d\x9c\x80\xf8\xc0\x3c\x87\xcc\x27\xdb\xf0\xfa\xd6\x79\xf6\xd0\xdc\xec\xc0\xf7\x4c\x4d\x5d\x87\xb8\x15\xf0\x13\x9d\xe4\xb7\xfe\x2e\x6e\x51\x87\x75\xc8\x9c\x6f\x61\xc8\xbe\xe5\xb0\x0e\x05\x7e\xd3\xf0\xc7\x3e\x18\xcd\x62\x4a\xb1\x0f\x16\x46\x42\x80\x9b\x3a\xa7\x24\x8f\xf1\x80\x97\x14\x2f\x92\x4e\x61\x9f\x61\x1a\xb7\xb3\xdb\x5b\xd8\x87\x22\xc0\x1e\x2f\x23\x9c\xeb\x1c\x5f\xa2\x5f\x50\x8e\x0e\x3a\xfd\x3c\xc9\x1c\x8f\x93\x19\xf2\x05\x74\x7e\x5b\x61\x41\xb3\xf9\xdb\x3c\x9b\x47\x77\x91\x38\xdd\x57\xdb\x22\x4a\xda\xe4\x13\xeb\x6f\xbc\x28\xff\x1f\xff\xe1\xeb\xb2\x5b\xc7\x1b\x8b\x82\x40\x41\xf3\x78\x4c\xc5\x52\x7e\x79\x4d\xf7\x84\xdc\xc6\x29\x11\x97\x73\x37\x69\x00\x8d\x0f\x1f\x48\xf1\x26\x9b\x2c\x50\x9a\x7e\xc6\xe3\xb1\xf7\xe0\x59\x47\x5e\x36\x27\x94\xc8\xd5\xe7\xc8\xfa\x15\x34\x75\x70\x8e\xf1\xfa\xe5\x0b\x7c\xae\x3a\x0e\x53\x23\x93\x3c\x06\x7a\xc5\xa1\x31\x76\x75\xe5\x52\xa2\x95\xf2\xe5\x8b\xba\x34\x43\x25\xbb\x67\x75\x22\xbd\xb5\xca\x91\x03\x56\xd4\x2e\xfb\x5d\x15\x6c\x9d\x5b\x63\x7d\xe5\x9a\x4d\x6e\xf9\x49\x51\x93\xbf\x8a\xaf\xcb\xd3\xec\xbe\x7c\x01\xf6\xd6\x4e\xca\xb3\xbf\xad\x93\x49\x71\xaf\x88\xdc\xd4\x1e\x07\xc6\x25\xc6\x56\x2b\xb8\xbb\x6a\x90\x24\xe2\x1c\xf1\x65\xea\x86\x8f\x9e\xcf\x9f\xc3\x84\x24\x84\x12\xfb\x4b\x35\xe1\x7c\x62\xc0\xb5\xcd\xd4\x91\x63\x5e\xcf\x9d\xe0\xc0\x6f\x59\x46\x00\x6b\xdb\x5d\x76\x7b\xcb\x90\xe0\x37\x16\xaa\x85\x84\x00\x3c\x27\x7d\x9a\x09\xf2\x3c\x75\xd5\x15\xea\x46\xe6\x94\x17\x5a\x49\x08\xa6\xb3\xac\xa0\xb6\x64\x15\x5a\xea\xbb\x39\xb7\x26\x72\x78\x29\x0f\x36\x57\x4e\x75\x7e\xf0\xd1\x15\x93\x75\x21\xe3\x74\x09\x71\x95\xdb\x46\xdd\x72\xc6\x8c\x7d\x8c\xc9\x55\x6d\x54\xa2\x63\x59\xa5\xd4\xa1\x0d\xba\x2d\x19\x36\x49\x4d\x3e\xd3\xfb\xc7\x1e\xac\x66\xe1\xa5\x34\x94\xc7\x30\x50\x5b\xae\xff\x5d\x25\xe3\xb3\x4a\xdf\x5f\xfb\xf4\xdd\x09\xec\x43\xe3\x7d\x07\x6f\x7e\xbb\x38\x7b\xcd\xde\xfe\x13\xfe\x72\xf9\x07\xf6\xf2\x9f\xe2\xcb\x88\xbf\xfc\x67\xfc\xe5\xec\x92\xbd\xfc\xe7\xfc\xe5\xf4\x9f\xd9\xcb\x7f\x81\x2f\x83\xc3\x7f\x62\x2f\xff\x25\xbe\xbc\x1a\x61\xd9\xff\x15\x7f\xb9\xc0\x7a\x6e\xf0\xe5\x35\xe6\x7f\xcf\xef\xe0\x3b\x39\xc2\x17\xdc\x24\xd7\xfe\x89\x7f\xb9\xc7\x97\x23\xfe\xe5\x16\x5f\x0e\xcf\xf1\x25\x2f\x11\x65\x2f\xff\x35\x7f\x3e\x66\xcf\xff\x0d\x3e\x0f\x4f\x46\xec\xe5\xbf\xe5\x2f\x87\x21\x7b\xf9\xef\xca\x97\x2e\x7b\xf9\xef\xcb\x97\x4d\xf6\xf2\x3f\x94\x2f\x3d\xf6\xf2\x3f\xe2\xcb\xe9\x00\x1b\xf0\x3f\xf1\x92\x7f\x39\x65\x2f\xff\x73\x49\x81\x57\xec\xe5\x7f\xe1\xc8\x0c\xf0\xcb\xff\xca\xbf\xbc\x61\xcf\xff\x1b\xcf\xf2\x0e\xa1\xfe\x77\xfe\xe1\xe2\x90\xbd\xfc\x1f\xbc\x31\x48\x80\xff\x13\x9f\x7f\xc4\xe7\xff\x0b\x9f\xcf\xf1\xf9\xff\xc6\xe7\x77\xf8\xfc\xff\xf0\x92\xde\xb2\x67\xe0\x28\x72\x4a\xfe\x7f\xe2\x10\xf5\x26\x6d\x1f\x76\xca\x2d\x03\x87\x1d\x54\x68\x98\xb9\x52\x9f\xa3\x36\xbe\x7f\xf6\x8f\xff\x00\xdf\x43\x12\xdf\x44\xf3\x79\x01\xcd\x29\xa5\xf3\x62\x6f\x63\x23\x9d\xcf\xfe\x8c\x5b\x47\x37\xe6\xd1\xf8\x63\x74\x47\x36\x4a\x90\x16\xc2\xff\x7b\x26\xc9\xd2\x82\xc0\xab\x8b\xe1\xfa\xe6\xfa\x61\x12\x2d\x0a\xc2\xbf\x30\xc3\x81\x55\x1c\xb6\xb7\x3b\xed\x0e\xa6\xed\xef\x97\x99\x37\x4e\x8e\x0f\x47\xa7\x17\xa3\xfd\x7d\x4c\xdf\xd8\x80\xc3\x6c\xfe\x88\xf7\x94\x42\x73\xdc\x82\x6e\xa7\xb3\xb5\xde\xed\x74\x76\xe1\x72\x4a\xe0\x70\x9a\x67\xb3\x78\x31\x83\xb3\x0b\x18\x2c\xe8\x34\xcb\x8b\x36\x0c\x92\x04\x30\x43\x01\x4c\x03\xcf\xef\xc9\xa4\x5d\x16\x26\xca\x3c\x27\x4c\xa3\xc3\x8b\x8c\x18\x22\x51\x3a\xc1\x73\x1c\xe2\x14\x8a\x6c\x91\x8f\x09\xa6\xdc\xc4\x69\x94\x3f\x32\xb1\x32\x2b\x02\x7e\xb7\x42\x96\xe3\xff\x67\x0b\x2a\x4a\x9a\x65\x13\x79\x06\x5c\x00\x51\x4e\x60\x4e\x72\x1c\xbb\x13\x98\xe7\xd9\x7d\x3c\x21\x13\xa0\xd3\x88\xe2\x0d\x11\xb7\x59\x92\x64\x0f\x71\x7a\x07\xe3\x2c\x9d\xf0\xab\x23\x59\x26\x59\x1a\xc1\xc8\x43\x1d\x55\x00\xf8\xde\xc2\xb7\x80\xec\x56\x20\x3a\xce\x26\x04\x66\x8b\x82\x32\x91\x13\xc5\x29\x56\x13\xdd\x64\xf7\xec\x53\x49\x38\x51\x54\x9a\xd1\x78\x5c\xaa\xea\xc0\x24\x19\x2b\x47\x47\x24\x9d\x58\x58\x4e\xe2\x62\x9c\x44\xf1\x8c\xe4\xed\xa5\xf8\xc4\xa9\x4e\x2d\x81\xcf\x3c\xcf\x26\x8b\x31\x51\x28\x89\x32\x24\x66\xbf\x0a\x25\x51\x5a\xd9\x68\x71\x06\x67\x24\xba\x74\x23\xcb\x21\xa3\x53\x92\xc3\x2c\xa2\x24\x8f\xa3\xa4\x50\x5d\x82\xdd\x49\xa7\x12\x25\xbd\x3d\x66\x5b\x4f\x49\x8c\x85\xb0\x3a\xd2\x68\x46\x18\x86\x3f\x66\xd9\x5d\x42\xe0\x38\x1d\xb7\x21\xcd\xd4\x37\xec\x99\x98\x16\xaa\xa1\x29\x2f\x35\xcb\x0b\x98\x45\x8f\x70\x83\x07\x86\x4c\x80\x66\x40\xd2\x49\x96\x17\x84\xf1\xd4\x3c\xcf\x66\x19\x25\xc0\x09\x46\x0b\x98\x90\x3c\xbe\x27\x13\xb8\xcd\xb3\x99\x28\x0b\x49\x54\x64\xb7\xf4\x81\x71\x59\xc9\x86\x50\xcc\xc9\x98\x31\x20\xcc\xf3\x98\x71\x67\xce\x58\x2f\xe5\x4c\x58\x14\x5a\x63\x44\x31\x97\xaf\x8f\x2f\xe0\xe2\xec\xe8\xf2\xe7\xc1\xf9\x08\x8e\x2f\xe0\xed\xf9\xd9\x4f\xc7\xc3\xd1\x10\x5e\xfd\x02\x97\xaf\x47\x70\x78\xf6\xf6\x97\xf3\xe3\x1f\x5f\x5f\xc2\xeb\xb3\x93\xe1\xe8\xfc\x02\x06\xa7\x43\x38\x3c\x3b\xbd\x3c\x3f\x7e\xf5\xee\xf2\xec\xfc\x42\x14\xd5\x18\x5c\xc0\xf1\x45\x03\xbf\x0f\x4e\x7f\x81\xd1\x1f\xde\x9e\x8f\x2e\x2e\xe0\xec\x1c\x8e\xdf\xbc\x3d\x39\x1e\x0d\xe1\xe7\xc1\xf9\xf9\xe0\xf4\xf2\x78\x74\x11\xc0\xf1\xe9\xe1\xc9\xbb\xe1\xf1\xe9\x8f\x01\xbc\x7a\x77\x09\xa7\x67\x97\xa2\xa0\x93\xe3\x37\xc7\x97\xa3\x21\x5c\x9e\x05\x88\x82\x9b\x1b\xce\x8e\xe0\xcd\xe8\xfc\xf0\xf5\xe0\xf4\x72\xf0\xea\xf8\xe4\xf8\xf2\x17\xac\xf6\xe8\xf8\xf2\x94\x55\x79\x74\x76\x2e\x0a\x1b\xc0\xdb\xc1\xf9\xe5\xf1\xe1\xbb\x93\xc1\x39\xbc\x7d\x77\xfe\xf6\xec\x62\x04\xac\xad\xc3\xe3\x8b\xc3\x93\xc1\xf1\x9b\xd1\xb0\x0d\xc7\xa7\x70\x7a\x06\xa3\x9f\x46\xa7\x97\x70\xf1\x7a\x70\x72\x62\x36\x5d\x94\x75\xf6\xf3\xe9\xe8\x9c\xb5\x47\x6f\x3e\xbc\x1a\xc1\xc9\xf1\xe0\xd5\xc9\x88\x55\x8b\x2d\x1f\x1e\x9f\x8f\x0e\x2f\x59\x13\xd5\xd3\xe1\xf1\x70\x74\x7a\x39\x38\x09\x44\x61\x17\x6f\x47\x87\xc7\x83\x93\x00\x46\x7f\x18\xbd\x79\x7b\x32\x38\xff\x25\x28\x8b\xbe\x18\xfd\xf3\xbb\xd1\xe9\xe5\xf1\xe0\x04\x86\x83\x37\x83\x1f\x47\x17\xd0\xac\x47\xad\xb7\xe7\x67\x87\xef\xce\x47\x6f\x58\x3b\xce\x8e\xe0\xe2\xdd\xab\x8b\xcb\xe3\xcb\x77\x97\x23\xf8\xf1\xec\x6c\x88\x5d\x71\x31\x3a\xff\xe9\xf8\x70\x74\xf1\x02\x4e\xce\x2e\x90\x90\xef\x2e\x46\x12\xa9\xe1\xe0\x72\x80\x68\xbc\x3d\x3f\x3b\x3a\xbe\xbc\x78\xc1\x9e\x5f\xbd\xbb\x38\x46\xb2\x1e\x9f\x5e\x8e\xce\xcf\xdf\xbd\xbd\x3c\x3e\x3b\x6d\xc1\xeb\xb3\x9f\x47\x3f\x8d\xce\xe1\x70\xf0\xee\x62\x34\x44\xfa\x9f\x9d\xb2\xf6\x2b\xde\x1a\x9d\x9d\xff\xc2\xaa\x60\xf4\xc1\x5e\x0a\xe0\xe7\xd7\xa3\xcb\xd7\xa3\x73\x46\x72\xa4\xe2\x80\x91\xe7\xe2\xf2\xfc\xf8\xf0\x52\x07\x3b\x3b\x87\xcb\xb3\x73\xd9\x48\xd5\x7e\x38\x1d\xfd\x78\x72\xfc\xe3\xe8\xf4\x70\xc4\x80\xce\x58\x61\x3f\x1f\x5f\x8c\x5a\x30\x38\x3f\xbe\x60\x00\xc7\x88\x04\xfc\x3c\xf8\x05\xce\xde\x21\x1d\x58\x67\xbe\xbb\x18\xc9\x6e\x3c\x32\xd9\x3e\xc0\x9e\x87\xe3\x23\x18\x0c\x7f\x3a\x66\x6d\x29\xf3\xbc\x3d\xbb\xb8\x38\x2e\xd9\x0b\xc9\x79\xf8\xba\xec\x11\x1c\x46\x1b\x7c\x7e\x64\xda\x67\x79\x4d\xd0\xb3\xfd\x7d\x88\xdd\xf3\x5d\xbe\x2b\x6f\x71\x6f\x24\xf1\x4d\x03\xf8\x05\xb2\x10\x25\x39\x89\x26\x8f\x40\x3e\xc5\x05\x2d\xda\xdf\xd9\xd6\xe8\x67\x71\x6d\x79\xdc\xce\x17\x29\x8d\x67\x64\x48\xe6\x24\x9d\x90\x74\x1c\x93\xe2\x43\xe9\x8c\x88\xf1\xbe\x22\x71\x82\x0b\x26\x33\xcd\x3a\x6e\xe7\x74\x42\x96\xad\x9a\x39\xe7\x6f\xe6\x8f\xae\x75\x64\x34\x43\x53\x54\x61\x1c\xf1\xad\xf5\xde\x05\x99\x72\x23\x3f\x8d\xc6\x1f\xd1\x6e\xa3\x4d\xa6\xa4\x39\xfe\x7f\xcd\x34\x84\x1f\xf6\x61\x13\x0e\x20\xbf\xea\x5e\xb7\x73\x32\x4f\xa2\x31\x69\x6e\xfc\xf1\x7d\xf1\x7d\x44\xdf\x17\x6b\x1b\x01\x34\x1a\x2d\xd8\x83\xfc\x2a\xb4\xbe\xdf\x71\xca\xb2\x79\xef\xdf\x73\x30\x67\x61\xc0\x30\x3d\x34\xbd\xdf\x35\x39\x50\xff\xf7\x34\xa8\x30\xcc\x8f\xcc\x67\x7e\x14\x78\xae\x53\x94\x8e\x49\x76\xcb\xcd\xb8\x96\xe8\x84\xd2\x98\x88\x03\x28\x5a\xd5\x57\x43\x88\xbb\xa4\xb8\x2b\xcc\xd3\xe1\x57\x85\x5d\x2f\xe0\x15\x51\x4c\x99\x5b\x95\x11\xb9\xa2\x15\x40\x5a\xe7\xd2\x0e\x10\x1e\x25\x92\x16\x8b\x9c\x9c\xfb\x99\xaf\xc2\x59\x22\xdc\x8f\xcf\x9c\x75\x0c\x6e\xf8\xc5\x69\x05\x3f\x5b\x9e\x04\x3f\xd0\x15\x2d\x4d\x37\x2a\x18\x0b\x8f\xd1\x62\xfd\xf3\x10\xa7\x93\xec\x81\x87\xaa\x26\xb7\x65\xf4\xd3\x0b\x48\xe1\x25\x64\xb2\x83\x53\x4f\x07\xf3\x88\x99\xec\x2a\xbd\x46\x85\xcf\x8d\x7f\x03\xfb\xc0\xb0\xef\xde\xb0\xb9\x34\xbd\x83\xc6\x77\xb0\x86\x51\x41\xdf\x35\x20\x2e\x20\x25\x84\xe9\x12\x37\x8f\xdf\x95\x77\xfc\xe0\xc1\x22\x55\x3b\x4d\x2a\xbb\x80\xfd\xc7\x5a\x54\x5c\x31\xa4\xae\xbd\xfc\x8c\x48\x13\xcf\x49\x52\x47\x51\x9c\x90\x09\x94\xc4\x83\x89\xa0\xde\x23\xf0\xab\x39\x75\x8f\xa1\x38\x83\xe8\x38\xb5\x4d\xf7\x8a\x3b\xac\x6c\x61\xc3\xb9\xe9\x8a\x81\x33\xee\xd2\x23\xf2\xe3\x95\x65\xaa\x9e\x36\x0b\xe5\x1d\xbc\xec\x7a\xc3\x5b\x68\x0a\xc1\x51\xe9\x4d\xc1\x43\xf7\x2b\x8e\x34\x04\x79\x6d\x59\xb3\xc1\xea\xde\x83\x06\xac\x41\x71\xd5\x61\x8d\x28\xae\xc2\xeb\x66\xdc\xbe\x6d\x47\x49\x94\xcf\x9a\x59\xc5\xcd\x3a\xc6\xc1\x78\x5f\xad\x75\xea\x86\xc0\xde\xb8\x25\xdc\xd3\x5b\x82\x91\xb2\x1c\xe2\x14\x8f\xfa\x92\x72\x66\x0f\xb2\x94\x75\x8c\x21\x37\x97\x8d\xc9\x66\xcb\x88\x51\xce\xd4\x41\x7d\x5f\x03\x11\x8b\xae\x85\x82\x44\x13\x1e\x1e\xc3\xc4\x47\xf5\xd7\x3a\x5c\xd1\x24\xf2\x84\x63\xd1\x29\x2f\xf7\x31\x36\x82\x97\xcb\xef\x71\x84\x3d\x39\x4b\x72\xbf\x9f\x88\xc6\x6c\x40\x43\x84\x06\x19\x47\x42\xe2\x28\xcf\xc9\x9c\x44\xb4\x49\x60\x43\x7d\x5d\x2b\x0f\x05\xe6\xde\x31\xbe\x68\x2b\xf7\x20\xf1\xca\x44\xc3\x5b\xfe\x96\x8f\xd2\x49\x65\xbb\x47\x76\xac\xfc\xbf\xad\x56\x1b\x85\xaf\x99\x44\xd0\x5a\x1d\xb7\xc7\x59\x92\xe5\x85\xd4\x12\xf8\x6b\x3b\x27\xa8\x38\xa8\xd6\x4c\xc9\xa7\x70\x6b\x0f\x36\x7e\xd7\xbc\x8a\xd6\x6f\x3b\xeb\xbb\xd7\x2d\xdf\xd3\x46\x1c\x18\x79\xba\x3d\x23\xcf\xe7\xee\xd7\x56\xf5\x8b\x91\x39\xbf\xbb\xd9\xc3\x21\x70\x4e\xee\x46\x9f\xe6\xcd\xc6\x1f\x37\x8a\xef\xf3\xbb\x9b\x8d\xe2\xfb\x8d\xe6\x46\xf1\x7d\x73\x63\xf2\x39\x0c\x36\xbf\xb6\x36\x8a\xef\x83\x15\xef\x1b\xec\xe7\xf7\x0d\xa5\x11\xbc\xdf\xd8\xb8\x0b\xa0\xf1\xfe\x3d\x23\x6e\x23\x6e\xb4\xcc\x8a\x23\x6f\xcd\xd1\xb7\x54\xdd\x3c\xd8\x2b\x93\xd6\x9a\x07\x7b\x1b\xed\x8d\xc9\x5a\xeb\x80\x7d\x68\x3d\x15\xa9\x4f\x7e\xa4\x0e\x7e\x5b\xac\x0e\x9e\x84\xd6\xa7\x30\xc4\x7e\x42\x15\x8b\x3d\xa9\xfe\x0c\x83\xde\xd7\xd6\xfb\x8d\x95\x09\xc5\xf7\xbf\x37\xfa\x9d\xd9\xc3\x7b\xb0\x71\x15\xad\xff\xf5\x9a\xfd\x74\xd6\x77\xdf\x17\xd7\x6b\x1b\xda\x9c\x21\x78\xf4\xee\xe6\x32\xfb\x43\x18\x2e\x0b\xf9\x5a\x76\xcd\x9c\x8c\x5b\xd8\x87\x66\xb7\xbf\x0d\xdf\xf3\xe5\xb3\x59\x8c\x2e\xec\x6e\xbf\xdf\x6a\xb5\x69\x56\x0e\xa4\x70\x0b\x47\xcb\x6d\xfb\xaf\xf3\x68\xc2\xbe\xf7\xaa\x6e\x21\x52\x9a\x2d\xae\x0f\x36\xf5\x31\xc5\x70\xfe\xe4\x39\xaf\x2c\x87\x03\x68\x30\xfa\xe1\x5d\x4b\x4d\xa6\xbe\xb6\xe4\xd5\x8f\xec\xbd\x6b\xbd\x6f\x5e\xb7\xca\x5b\x81\x5c\xba\x7c\x0a\xc3\xd7\xe4\xd3\x65\x76\x78\x71\xb1\xea\xc0\xc9\xf2\xbe\xd9\xe2\xe7\x98\x4e\x9b\x8d\xdf\x35\xd4\xce\x2d\x56\xb4\x35\x5b\x11\xae\xae\x2f\x6e\x0a\x9a\xe3\x11\xfa\xeb\x21\xec\xef\xc3\xd5\x66\x00\x5b\x01\xec\x06\x10\x76\xaf\x55\xe8\x85\x90\x76\x4b\x4b\x2c\x49\xb4\x71\xf5\xc7\x92\x29\x36\xe2\xca\x0c\xea\x00\xe2\x52\xd2\x6d\xc0\x66\x20\x6c\x08\x8e\x54\x87\x5f\xad\x92\xe9\x69\xb4\xbc\x6e\xc5\x48\x63\x54\xb4\xf6\x1b\x48\xd5\xa5\xa4\x62\xc4\x74\xf3\xcb\xec\xfc\xc7\x57\x83\xe6\x55\xce\x97\x6a\xaf\xdb\xb3\x68\xde\xac\xe1\xc0\x67\xa4\xc2\x53\x80\x8f\x53\xca\x98\x05\xb9\xa7\x0b\xe5\xad\x4c\xa4\xbc\xe8\xac\x7c\x79\xf9\x12\x2f\x0c\x22\xf0\xc3\x0f\xd0\x13\x4b\xba\x5d\xe3\x56\x8f\x96\xb7\x97\x57\x75\xb1\xa2\x98\x87\x13\xf9\xd0\xf5\x9d\x9d\xc7\x0f\xce\x51\xa7\x43\x87\xda\x3c\x61\x90\x85\xd6\x25\x47\x08\xfa\x49\xcf\x07\x1a\x69\x60\x0d\x38\x79\xca\x9b\xde\xfc\x50\x02\x82\xdf\x3f\xa6\x1f\x19\xdd\xe4\xfb\xc1\x44\xc7\x76\xd9\xd4\x87\xa3\x38\xcf\x16\xe9\xa4\x69\x17\xb1\x01\xdd\xfe\xb6\x79\x6b\x4e\xb9\xed\xd7\x1a\x07\x70\xe0\x1d\x4f\xac\x89\x7b\xea\x0b\x93\x55\x48\x0b\x33\xd2\xa8\xfc\x3a\x65\x99\xce\x7f\x7c\xf5\xad\xf2\xa9\xe7\x69\x2c\x4b\x10\x92\x39\xf7\x38\xe3\x03\x58\x7a\x55\x51\xe3\x77\x8d\xd2\x12\x61\x7f\x79\xf9\x17\xb3\x3f\x4b\x7b\x75\xc2\xf0\x6c\x66\xca\xaa\x0e\x51\x2d\x45\x59\x93\xd5\x24\xe9\x4f\xaf\xc2\x6b\xde\x05\x6b\xd0\x08\xc0\xfa\xd6\x5d\xf2\x6d\x53\x7d\x6b\x35\x0c\x99\x07\x55\x92\xd7\xe0\x73\x54\x5c\xb8\x4c\xb0\xd3\xbb\x3d\x47\xc2\xb9\xc6\xb9\xb4\x36\x0b\x6e\x28\x16\xf0\x52\x76\x4a\x79\x1d\x3c\xe1\x96\x33\x6d\xb2\x07\x61\xba\x13\x9e\xe2\x19\x60\xa4\x62\x2a\x7b\x6d\x45\x44\x3d\x81\x55\x44\xe7\xc8\x02\xc7\x79\x34\xfe\xc8\x19\x73\x49\x37\x21\x37\xc8\x49\xad\xa9\x51\xbd\x73\xdd\xc2\x83\x14\xb7\xe0\x8b\xd9\x89\x98\xbc\x63\xa6\x76\x79\x6a\xc7\x9e\x2e\xb7\x5a\xcb\xfb\x6b\x25\xc9\x73\x4e\xf2\xdc\x20\x79\xce\x49\x9e\x0b\x92\xe7\xdf\x46\x72\xbe\x55\x23\xfe\x2b\x59\x2e\x43\x65\x00\xc6\x12\x01\x21\x06\xba\x25\x1d\xc4\x7c\xdf\xa6\xa4\xc0\x4e\xe3\x92\xbf\xa6\xf4\xd0\x04\x6d\x2d\x11\x2f\xf7\xfc\x6c\xb2\x7a\xae\x36\xaf\xd9\x24\xe3\x52\x82\x0d\xcd\x08\xc7\x26\xb9\xea\x5c\xab\x11\x47\xae\x42\xe3\xad\xab\xbd\x51\x3e\xf8\x5c\x1c\x0b\x42\x07\xc9\x7c\x1a\xd5\x35\xe0\x97\x32\xa7\x50\x85\x18\xea\x18\x4f\xe0\x9d\x73\x72\x0f\xa9\x66\xf1\xa7\x95\xe7\x48\x1b\x8e\x3d\x1f\x26\x5c\x45\x70\xbf\xd0\x96\xe1\x23\xea\xbd\x80\xb5\xb5\xd4\x3b\x00\x23\xf4\xc5\xa4\xd7\xb0\x0e\xd9\x55\x6a\x3b\xe1\xd0\x71\xb4\xef\x9d\x9c\xd8\x27\x26\xe0\x22\xf8\xde\x8c\x9c\xa8\xa7\x9d\x64\x1e\x92\x08\xf4\x57\x28\x80\x26\x5b\x33\xd6\x68\xb8\x7e\x2d\x71\xd7\x75\x95\x42\x1b\x69\x67\xfe\x0a\x4f\x4a\x60\x5c\x3c\xe8\x75\x66\x2e\xd5\x4e\x2c\xd5\x44\xa2\xe1\xad\xa9\xbc\xe6\xd8\xaa\xd4\x21\x9e\xb1\x75\xb6\xd9\x38\xcc\x16\xc9\x24\xfd\x8e\x02\xd2\x8a\xfb\x76\x18\x1b\xf8\x95\x6a\x39\x58\xeb\x04\x42\xc7\x5a\x4f\xe1\xff\x9d\xe2\x22\xdf\x81\x2f\xf5\x8a\xb0\x91\x5a\x4e\xed\x34\x3b\xc9\x1e\x48\x7e\x18\x15\xa4\xd9\x6a\x7d\x7b\x39\xca\x78\x2b\xd6\xd0\x7a\x6b\x7c\x53\x69\x7e\x52\x14\x34\x1b\x7f\xc4\xb0\xc7\xb7\x51\x42\x28\x5e\x40\xe2\xca\xc2\xab\xc6\xef\x3a\xf8\x1f\x13\x22\xbf\x3b\x3c\x94\x8f\xbd\xd1\xee\xa0\xb3\xc5\x53\x7b\x03\x91\xba\xd9\xdb\xea\x0f\x7a\xf8\xb8\xdd\xef\x77\xb6\x5f\xe1\x63\x67\x6b\x77\x67\x77\x80\x8f\xc3\xcd\xe1\xf6\xe1\x11\x3e\xf6\xfb\xfd\xed\xfe\x26\x3e\x8e\x8e\xba\xbb\xdd\x5d\x0e\xdb\x79\x35\x08\x79\xea\xd1\xe1\x68\xb7\xc7\x61\xb7\xbb\xbb\x47\x65\xb6\xa3\x6e\xa7\x73\xf8\x4a\xc0\xf6\x5f\x0d\x79\x09\xec\xbf\xc3\x32\x55\x22\xc9\x1e\xfa\x47\xf2\x71\x67\x5b\x3e\x0e\x54\xea\x50\xa5\x1e\x89\xd4\xfe\x91\x2c\xa1\x7f\xd4\x57\xa9\xb2\x84\xfe\xd1\x40\xa5\x0e\x55\xaa\x2c\x61\x67\x5b\x96\xb0\xb3\xdd\x57\xa9\xb2\x84\x9d\xed\x81\x4a\x1d\xaa\x54\x59\xc2\x40\xe1\x30\x50\x38\x0c\x14\x0e\x03\x85\xc3\x40\xe1\x30\x50\x38\x0c\x15\x0e\x43\x85\xc3\x50\xe1\x30\x54\x38\x0c\x15\x0e\x43\x85\xc3\x91\xc2\xe1\x48\xe1\x70\xa4\x70\x38\x52\x38\x1c\x29\x1c\x8e\x04\x0e\x8c\x8e\x65\x09\xec\xb1\xaf\x52\xcb\x12\xd8\xe3\x40\xa5\x0e\x55\xaa\x2c\x41\xf6\x05\x7b\xec\xab\x54\x59\x82\xec\x0b\xf6\x38\x54\xa9\xb2\x04\xd9\x17\xec\xb1\xaf\x52\x65\x09\xb2\x2f\xd8\xe3\x50\xa5\xca\x12\x06\x0a\x87\x81\xc2\x61\xa0\x70\x18\x28\x1c\x06\x0a\x87\x81\xc2\x61\xa8\x70\x18\x2a\x1c\x86\x0a\x87\xa1\xc2\x61\xa8\x70\x18\x2a\x1c\x8e\x14\x0e\x47\x0a\x87\x23\x85\xc3\x91\xc2\xe1\x48\xe1\x20\xfb\x82\x51\xa1\x2c\x81\x3d\xf6\x55\x6a\x59\x02\x7b\x1c\xa8\xd4\xa1\x4a\x95\x25\xc8\xbe\x60\x8f\x7d\x95\x2a\x4b\x90\x7d\xc1\x1e\x87\x2a\x55\x96\x20\xfb\x82\x3d\xf6\x55\xaa\x2c\x41\xf6\x05\x7b\x1c\xaa\x54\x59\xc2\x40\xe1\x30\x50\x38\x0c\x14\x0e\x03\x85\xc3\x40\xe1\x30\x50\x38\x0c\x15\x0e\x43\x85\xc3\x50\xe1\x30\x54\x38\x0c\x15\x0e\x43\x85\xc3\x91\xc2\xe1\x48\xe1\x70\xa4\x70\x38\x52\x38\x1c\x29\x1c\x64\x5f\x0c\xd4\xb8\x18\xa8\x71\x31\x50\xe3\x62\xa0\xc6\xc5\x40\x8d\x8b\x81\x1a\x17\x03\x35\x2e\x06\x6a\x5c\x0c\xd4\xb8\x18\xa8\x71\x31\x50\xe3\x62\xa0\xc6\xc5\x40\x8d\x8b\x81\x1a\x17\x03\x35\x2e\x06\x6a\x5c\x0c\xd4\xb8\x18\xa8\x71\x31\x50\xe3\x62\xa0\xc6\xc5\x40\x8d\x8b\x81\x1a\x17\x03\x35\x2e\x06\x6a\x5c\x0c\xd4\xb8\x18\xa8\x71\x31\x50\xe3\x62\xa0\xc6\xc5\x40\x8d\x8b\x81\x1a\x17\x03\x35\x2e\x06\x6a\x5c\x0c\xd4\xb8\x18\xa8\x71\x31\x50\xe3\x62\xa0\xc6\xc5\x50\x8d\x8b\xa1\x1a\x17\x43\x35\x2e\x86\x6a\x5c\x0c\xd5\xb8\x18\xaa\x71\x31\x54\xe3\x62\xa8\xc6\xc5\x50\x8d\x8b\xa1\x1a\x17\x43\x35\x2e\x86\x6a\x5c\x0c\xd5\xb8\x18\xaa\x71\x31\x54\xe3\x62\xa8\xc6\xc5\x50\x8d\x8b\xa1\x1a\x17\x43\x35\x2e\x86\x6a\x5c\x0c\xd5\xb8\x18\xaa\x71\x31\x54\xe3\x62\xa8\xc6\xc5\x50\x8d\x8b\xa1\x1a\x17\x43\x35\x2e\x86\x6a\x5c\x0c\xd5\xb8\x18\xaa\x71\x31\x54\xe3\x62\xa8\xc6\xc5\x50\x8d\x8b\xa1\x1a\x17\x43\x35\x2e\x86\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x1c\xa9\x71\x71\xa4\xc6\xc5\x91\x1a\x17\x47\x6a\x5c\x74\x76\xd8\x3f\x7c\x0c\xbb\xec\x1f\x7f\x3c\x64\xff\xf0\xb1\xbb\xc5\xfe\xe1\xe3\x66\x87\xfd\xe3\x8f\x03\xf6\x0f\x1f\x7b\xf8\x1f\x7f\x1c\xb1\x7f\xf8\xd8\xdf\x61\xff\xf0\x11\x0b\xe0\xe5\x6e\x1d\xb2\x7f\xf8\xb8\xbd\xc5\xfe\x71\x91\x89\x48\xf0\xc7\x01\xfb\x87\x8f\xbb\x3d\xf6\x8f\x3f\x8e\xd8\x3f\x3e\xa4\x11\x02\x1f\x5f\x75\xd9\x3f\xfe\x78\xc8\xfe\xe1\x23\xd6\xc0\xcb\x1d\x76\xd8\x3f\xfe\x38\x60\xff\xf0\x11\x71\xe4\xe5\xa2\x3e\x39\x6a\x5c\xeb\x4e\xd3\x71\x85\xb2\xec\x68\xd2\x76\x1e\xae\xa1\x1b\x0b\x71\x51\x12\x8f\xc9\x0d\x06\x60\xa3\x9b\xad\xdb\xeb\x04\xd0\xed\xed\xf0\x15\x8b\x86\xb6\x92\x12\xa5\x34\xfe\xcb\x82\xe0\xb9\x54\x02\xb8\xcf\x80\x37\xfb\x01\x74\xc3\x6a\xe0\x50\x42\x33\xc0\xcd\x5d\x06\xbd\x5b\x09\xdd\x15\xd0\x9b\x0c\x87\xee\x66\x00\xdd\x4e\xaf\x12\x7a\x53\x40\x77\xfa\x01\x84\xbb\xdd\x00\xc2\xed\xad\x4a\xe8\x5e\x09\x1d\x32\x24\xc2\xcd\x30\x80\xb0\xdb\x31\xa1\xff\xb2\x88\x66\x51\x1e\xa7\xa2\x89\x61\x77\x1b\x49\xc1\x90\xee\x56\x80\x86\x4f\x80\x15\xcd\x0b\x43\xd6\x3c\xd6\xc6\x70\x77\xa7\x02\x56\x34\x2e\xec\x74\x19\x15\x58\x0b\xb7\xab\xd0\x15\x4d\xdb\xc2\x96\xb1\x9f\xd0\xa2\xc3\x5f\x17\xb9\xd9\xc9\x88\xa8\xdd\xc9\x0c\x2a\xac\x07\x26\x7b\xaa\xdb\x2b\x9b\xd2\xdd\xdc\x71\xc1\x64\x2b\x76\x37\xcb\x56\x74\x3b\x9e\xd2\x54\xdf\x84\xa2\x05\x9b\x26\x97\xdc\x90\xf8\x4e\xb5\x80\x95\x83\x3f\x56\x0f\xde\xc4\xc5\x5f\x14\x37\x23\xf2\x5d\xa4\xf2\x96\x07\x2c\xac\x09\x67\x32\x65\xb8\x19\x40\xb8\xb3\xe9\x81\x33\xd9\x71\x87\xc1\xf5\x77\x3c\x70\x26\x23\x76\x19\x70\x67\xdb\x84\x4b\xb8\x17\x81\x41\x75\xf0\x2e\xe5\x8e\xfd\x3d\x1d\x4f\xc9\x24\x4a\x66\x59\x3a\x31\x47\x98\x87\xc4\xda\x08\xe7\xa5\xd9\x3d\xca\x00\xc2\x95\x10\x5d\x13\xc2\xea\x6e\x06\xb1\x69\x42\x78\xd0\xe8\x19\x10\x4e\x27\x27\x0b\x72\x1f\x67\x09\xa1\x92\x44\x3b\x01\xf4\x18\xeb\x74\xad\x9e\xc9\xb3\x87\x54\x00\x6d\xf5\x03\xe8\x75\xd9\x9f\x0b\x63\x74\xf2\x56\x8f\xfd\xb9\x40\x46\x0f\xf7\x77\xd9\x9f\x0b\x64\x74\x6f\x3f\x64\x7f\x2e\x90\xd1\xb7\xac\x2f\x36\x2d\x1a\x2c\xf2\xe4\xf1\x21\xcb\x64\xa7\x75\x99\xd4\xda\xe9\x31\x5a\x54\x40\x9a\x6c\x1a\xb2\x01\x62\x77\x8e\x00\x35\xda\x11\xee\x6e\x07\x10\xf6\x2a\x40\x4d\x66\xdd\xee\x20\x27\xfa\x41\x4d\x7e\x0d\xfb\x01\xec\x98\x90\xe3\x68\x42\xa8\xc6\x64\xbb\x7d\x64\xfd\x00\xc2\xad\x8e\x1f\x50\x0a\xcd\x7e\x57\x8c\xe4\x7e\x45\x99\x52\x66\xb2\x1e\xee\x76\x77\x5d\xce\x93\xa0\x52\xd8\x20\x51\x59\xf3\x6d\x16\x94\xa0\xa2\x4d\x38\x4a\x37\x7b\x2e\x2b\x8e\xa7\x51\x4e\x73\xb2\x28\xdc\xb9\xa0\x53\x01\xe8\xce\x04\x55\x90\xee\x3c\x50\x05\xe9\xce\x02\x55\x90\xce\x1c\x60\x03\x66\xe3\x2c\x89\xd4\xec\x1d\xb2\x2e\x67\x05\x6e\x56\x00\x1a\x7c\x87\x6d\xda\xdc\xf2\x43\x9a\x6c\xc7\xda\xb4\xb9\xe9\x87\x34\xb9\x0e\xdb\x64\x93\xbd\x84\x34\x98\x0e\xdb\x64\x01\x66\x79\x94\x38\x18\xee\x74\x5c\x20\xb3\x1d\x61\x2f\x80\x9d\x2d\x17\xca\x6c\x43\x67\xcb\x5f\x96\x81\xff\x6e\xc8\x30\x73\x81\x4c\xd4\x99\x5c\xda\xb6\x81\xd2\xdb\x24\x7b\x20\xb9\x36\x66\xc2\x0e\xeb\x8f\x1e\xb2\xb7\x0b\x5e\xc4\xc9\x47\x43\x0c\xa0\x8e\xd6\x75\x30\x44\xc0\xb0\x36\xa4\x39\xb3\x6d\x76\x3d\x03\xa6\x84\x34\x1a\xde\x45\x5c\xb7\xfd\x68\x5a\x6a\xd6\x96\xab\x66\x8d\x1f\xa3\x54\x4d\x03\x5e\x15\x83\x81\x84\x35\x60\xb4\x29\xc9\xab\x83\x30\x18\x6d\x52\xf2\x2a\x20\x0c\x46\x9b\x96\xbc\xda\xc7\x24\xca\x3f\x3a\xb3\xa8\x0f\xc8\x6c\x5b\x65\x59\x77\x59\x32\x21\x69\x2e\xa7\x81\x72\x06\x60\x3f\x61\x35\xb0\xc9\xc9\x3b\x28\x65\xab\xa1\x4d\x8e\xde\x66\x82\xae\x57\x0d\x6d\x8e\xcc\x1e\x6a\x26\xd5\xd0\x66\x27\x77\xc2\x00\x76\x5c\xe0\x3c\x7a\x94\x33\x34\x03\x2b\x7f\x3c\x80\x84\xe8\x44\xeb\x38\xfa\x4e\x09\x55\xaf\xb8\x8f\xd3\xe8\x63\x2c\xe9\xba\x2b\x54\x31\x4b\xc5\x62\x90\xb3\xe8\x8e\xa4\x34\xd2\xdb\x52\xd1\xad\x59\x12\xdf\x13\x1d\xcf\x1d\xae\xb5\x39\x63\xdb\x04\x96\x1d\x86\x92\x8e\xcb\x1f\x97\xaa\x0a\x5e\xce\x0b\x3b\xd2\x3e\xe8\xb8\x7d\xa6\xe0\xe5\xec\xb0\x25\x66\x87\x5d\x97\x70\x0a\x5c\xf6\x5a\x28\x78\x73\xcb\xe5\xb6\x2c\x8f\xd2\x3b\x43\x85\x0e\x7b\xde\x0e\xe1\x80\xee\x2c\x51\x05\xe9\xce\x12\x55\x90\xee\x2c\x51\x05\xe9\xcc\x12\x3e\xc0\xf1\x34\x96\xe3\xac\xbf\x19\x00\xda\xad\x3e\xba\x22\xa4\x9c\xc5\x51\xbc\x77\x5d\xb9\xa3\x40\x65\x77\x6d\x33\xb5\xd1\x23\x7e\x14\xa8\xec\xa9\x7e\x4f\x20\x50\x55\xaa\x6c\x53\xa7\x17\x80\x4f\x35\x61\xa0\x39\x99\x58\x6c\xeb\xb6\xbc\x40\xf3\x40\x52\x1d\xed\x11\xd4\xf3\x5c\x16\x2c\x48\xa4\x73\x77\xd8\x43\x23\x87\xf5\x53\x6f\xb3\x12\x38\x34\x6c\x3b\xec\xff\xdd\x6a\x68\xc5\xdb\x42\x58\x87\x3b\x1e\x9c\x4b\x68\x45\xaf\xbe\xb0\x7e\x3d\xdd\x20\xa0\x15\xc9\xfa\x42\xe8\x7a\xc8\x5b\x30\xbd\x42\x93\xe2\xdb\x5d\x36\x00\xfc\xf4\x45\x58\x4d\x82\xf5\xb6\x03\xd8\xde\x65\x7f\xd5\xa0\x4a\xa9\x0d\x2b\xa6\x2c\x03\x5c\x29\xb6\x61\xc5\xec\x65\x80\x2b\xe5\x36\xac\x98\xc8\x0c\x70\xa9\xe0\x76\x97\x4c\x43\x25\x34\xa9\xd1\x4a\xba\xc8\xff\xb2\xc8\xe2\x82\xe8\xb3\xe9\x16\xfb\x71\x61\x4d\x13\x8e\x29\x20\x1d\xb4\x5c\x4c\x40\x72\x13\x47\xa9\xe2\xe3\x2e\x33\x2c\x98\x0a\x67\x83\x91\xf9\x3c\x4e\x4d\xed\x07\x55\xa5\x6d\x2f\x5c\x58\x17\xd0\x14\x47\xec\x6f\xd3\x0f\x68\x4a\xa3\x2d\x94\x5d\x5e\x40\x6b\x52\x74\x34\x48\x06\x57\x7c\x7c\x34\xf5\x08\x14\x33\x0e\x9f\x28\xc8\xb0\x3e\xa8\xa6\x0c\xa1\x44\x72\xd8\x49\x81\x6a\x3a\x11\x4a\x24\x87\x95\x14\xa8\xae\x1a\x75\x7c\xd2\x28\x9e\xe9\x33\x3d\x17\xd9\x7d\xcf\x08\x64\x70\xa4\x06\x5c\x36\xb9\x33\x34\xe3\x4d\xec\xc4\x9e\xa7\xe9\x12\x32\xac\x0f\x2a\xbb\x7d\xa7\x54\xb8\x1c\x2a\x49\x50\xd9\xf1\xa8\x96\x6d\x79\xa8\x24\x41\x65\xd7\x6f\x05\xb0\xbd\xe3\x12\xe9\x36\xce\xc9\x4d\x1e\x4b\xa7\x0e\xf6\xcf\x26\xca\x77\x3f\x9c\xc1\xc6\x6c\x04\xf5\x76\xfc\x80\x06\x1b\xb3\xa6\xf7\x2a\x4a\x34\xd8\x98\x01\x6f\x56\x94\x68\xb0\x71\x97\x35\xdb\x34\x97\x6e\x13\x66\xe4\x98\x9e\x60\x94\x75\xe8\x3b\x36\x59\xfe\x36\xcb\x49\x41\xf5\xa9\xa5\x9c\xd0\x9c\x96\xdf\x45\x71\x5a\xdc\x64\x79\x26\x1d\x23\x1d\xb4\x5b\x5c\xe3\xe5\x6e\x9a\x15\xd4\xa8\x1e\x4d\x1c\x9f\xd7\x9a\xe9\xab\xa6\xf7\xc4\x31\xa0\x19\x48\x58\x03\xc6\x34\x95\x98\xc6\xeb\xc2\x58\x3e\x95\x4d\x1f\x8c\xe5\x4c\x71\xf4\x25\xdb\x32\xe8\xa2\x68\xda\x62\x7d\xd6\xf5\x03\x9a\x1a\x18\x9b\x8b\x2d\x41\x56\x61\x11\xb0\x79\xd8\xee\x04\xbf\x35\x80\x64\xd9\xf5\x43\x5a\x42\x0f\x85\xae\x09\xa9\x09\x87\x5d\x94\x62\xfc\xc7\x01\xea\x18\xc6\x95\xfb\x5d\x0e\xf2\x00\xd8\xff\xdc\xef\xa2\x00\xce\xb3\x0e\xdf\x72\x98\x8e\xc9\xb3\x9e\x49\x1a\xe1\x42\x4d\x4c\xf0\x3f\x17\x46\x90\x73\x33\x0c\x80\xff\xb9\x30\x82\x90\x4c\xfb\xe2\x7f\x2e\x8c\x20\x21\xb3\x95\xf9\x9f\x0b\xd3\x17\x30\x3b\xde\xc1\x8b\x30\x5b\x62\x16\x0f\x03\xe0\x7f\x2e\xcc\xb6\x80\xd9\xe4\x7e\xd5\x9e\x07\x9f\x1d\x01\xb3\x15\x00\xff\x73\x61\x76\x05\xcc\x8e\x57\x3c\xe9\xfa\x4d\x3f\x00\xf6\x3f\xf7\xbb\xe8\x0a\xee\x4b\x75\xfc\xa9\x08\x23\xfa\x01\xd5\x66\xfc\x73\x61\x64\x45\x5b\x01\xf0\x3f\x17\x46\xf4\x03\x77\xee\x3a\x0e\x5e\x84\x91\x1e\xb4\x90\xeb\x85\x5b\x1e\x7c\x44\x3f\x70\x4f\xb2\xe3\x4d\x46\x18\xd1\x0f\x5b\x5b\x01\xf0\x3f\x17\x66\x5b\xf3\xd6\xf1\x3f\x17\x46\xf4\xc3\x76\x18\x00\xff\x73\x61\x44\x3f\x6c\xf7\x02\xe0\x7f\x0e\x8c\x68\xfa\x4e\x00\x3b\xb6\x9d\x8e\xdf\x45\x3f\x6c\x33\xed\x0f\xff\x5c\x18\xd1\x0f\x5c\x3b\x74\x34\x44\x84\xe9\x6a\x2a\x27\xff\x73\x61\x24\x32\xbd\x00\xf8\x9f\x0b\x23\x55\x57\xa6\x0d\xe2\x9f\x0b\x23\xfa\x81\xd9\xf7\xfc\xcf\x85\x11\xfd\xb0\xdb\x0d\x80\xff\xb9\x30\xa2\x1f\x76\x7b\x01\xf0\x3f\x17\x46\xf4\xc3\xee\x76\x00\xfc\xcf\x85\x11\xfd\xb0\xbb\x1b\x00\xff\x73\x60\x94\x91\xc2\xb5\xc3\xd0\x15\x71\xbd\x8e\x84\xe9\x96\x96\x6f\xd8\x71\xb1\xee\x85\x2b\xb5\x28\x04\x93\x16\x46\x67\xbb\xf4\x56\xd8\x5e\x10\x04\xdb\x34\xdc\x02\xe5\x8f\x0b\xa6\xbc\x07\x5d\xf4\x63\xb8\xce\x0c\x04\xeb\x4b\xb0\x7e\xb9\x5a\x10\x86\x1e\xdc\xb6\x24\xd8\x76\x39\x0f\x86\xa1\x07\xb7\x6d\x69\xf5\xa0\xe5\xda\x71\xbd\x89\x08\xb6\x23\xc1\xba\x68\xe0\xba\x56\x2e\x82\xed\x4a\xb0\xbe\x58\x79\xeb\xba\xb8\xc9\x16\xe0\xda\x00\xfb\x73\x41\x64\x47\x75\xb7\x4b\xb7\x47\xd8\x75\xd1\xef\xcb\x8e\x42\xcd\xb4\xfc\x71\xc1\x64\x47\xa1\x91\x5e\xfe\xb8\x60\xb2\xa3\x36\xd1\xcc\xed\xbb\x8b\x46\x08\xa6\xa6\x64\xd4\x44\xf9\x8f\x0b\x26\x9b\xd9\xeb\x94\x3e\x9e\xb0\xe7\xc1\x6d\xcb\xf0\x09\x94\x3f\x2e\x98\xec\xa8\x1e\x7a\x8c\xfa\xee\xd2\x13\x82\xed\xe8\x56\xa1\xf8\x71\xc1\x64\x47\xa1\xc3\xa2\xfc\x71\xc0\x24\x6a\xa8\xa4\x38\xde\x50\x04\xe9\xe8\x9e\x1f\xf1\xe3\x82\x29\xdb\x9d\x19\x78\xe5\x8f\x0b\x26\x3b\x0a\x57\xb7\xca\x1f\x17\x4c\xf9\xe5\x42\xa6\xbc\xf1\x1f\x17\x4c\x59\x0d\x0c\xad\xf2\xc7\x05\x93\x1d\xc5\x26\x13\xf1\xe3\x82\x49\x6a\x6c\xa1\xc6\xc8\x7f\x5c\x30\xd9\x51\x6c\x4a\x11\x3f\x2e\x98\xec\x28\x74\x1c\x97\x3f\x2e\x98\xec\xa8\xed\x2d\x8c\xbe\x70\x43\x30\x18\x98\xac\x53\x98\xda\x2e\x5e\xdb\xb2\xa3\xd8\xf4\x22\x7e\x5c\x30\xd9\x51\x3b\x0c\xf3\xf2\xc7\x05\x53\xde\xa6\x9e\x58\x5f\xf5\x4c\x35\xdb\xb2\xa3\x76\x18\xe6\xe5\x8f\x0b\x26\x3b\x8a\xbb\x93\xf9\x8f\x0b\x26\x3b\x8a\x19\xea\xe2\xc7\x05\x93\x1d\xc5\x26\x1c\xf1\xe3\x82\x49\xa2\xed\x6e\x61\x64\x82\x1b\x9e\x80\x60\xb2\xa3\x76\x71\x91\x8c\xff\xb8\x60\xbb\x52\xa3\x0f\x4b\xfb\xa5\xdb\x71\x71\xdb\x91\x50\xdc\x79\xe2\x91\xb5\x3b\x52\x83\xee\xa0\xe3\xa0\xe7\x3a\x53\x11\x4c\xf9\xbe\x71\x1d\x96\xff\xb8\x60\xd2\x26\xe9\xec\xa2\x33\xc9\xf5\x28\x21\x98\x34\x48\xd8\xbc\x23\x7e\x5c\xb0\x9e\x04\x63\x68\x95\x3f\x2e\x58\x5f\x82\x31\xb4\xca\x1f\x17\x6c\x4b\x82\xf1\xb0\x25\x37\x76\x09\xc1\xb6\xa5\xc9\x8a\x2b\xd4\xfc\xc7\x05\x93\xb4\xc5\x88\x99\xf2\xc7\x05\x93\x1d\x85\xab\xc8\xe5\x8f\x03\x26\xa1\x36\x03\xe0\x7f\x2e\x88\xec\x28\x5c\x34\x2f\x7f\x5c\x30\xd9\x51\xb8\xfe\x57\xfe\xb8\x60\xca\x78\x94\x61\x26\x9e\xc9\x67\x57\x76\xd4\xe6\x36\x2e\x65\xba\xeb\x99\x08\x26\x3b\x8a\x47\x9c\x79\x5c\x07\x08\x26\x3b\x0a\x17\xff\xcb\x1f\x17\x4c\x76\x94\x0a\x0c\xf2\x4c\x3e\xbb\xb2\xa3\x7a\x0c\xad\xf2\xc7\x05\x93\x1d\x85\x1e\x8d\xf2\xc7\x05\x93\x5d\x80\x31\x0c\xe5\x8f\x05\x66\x2c\x6c\x79\x42\x02\x0c\x3f\xfa\x12\x10\x7b\x9d\xd3\x03\x62\x2f\x73\x7a\x40\xec\x55\x4e\x0f\xc8\x23\x49\x92\xec\x41\x97\xfd\xdc\x03\x65\x93\x89\xd4\xb1\xe9\xc9\x0a\x9b\x9e\xac\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb4\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xac\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\xc9\x0a\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xd4\xb0\xe9\x49\x0d\x9b\x9e\xac\xb6\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\xc9\x6a\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xac\xb6\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\xc9\x6a\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xac\xb6\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\xc9\x6a\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x3d\x9b\x9e\xd4\xb3\xe9\x49\x1d\x9b\x7e\x9a\xa5\xe4\x71\x42\x1e\x8c\xb6\xf2\x40\xeb\x8e\x17\xd0\xb3\x9f\xaa\x02\xd2\xb3\xa5\xca\x62\x26\x01\xe9\xee\xaa\xb2\x23\xef\x04\xa4\x6f\x63\x55\x68\x41\x52\x3b\xbe\x8a\x6b\x9f\x3b\x36\x96\xd4\x89\xaf\xe2\x8a\xa5\x1f\xd0\x0d\xa8\x0f\xb7\xb6\x7d\x80\x66\x4c\xfd\x16\x46\xf0\xf8\xe0\x8c\x48\x03\x36\x4d\x5b\x5b\xe7\xe2\x74\x62\x06\x94\x61\x79\xae\x8d\x20\xe1\xcc\xa6\x20\x86\x9d\x2d\x3f\xa8\xd1\x18\x8f\x2d\x20\x01\x8d\xc6\xec\xb8\x5b\x65\x24\xa0\xd3\x1a\x4b\xe7\x88\xef\xb3\xfc\xd1\xf5\x6b\x58\xac\x83\x50\x61\x3d\x30\x2b\xd8\xdf\xc3\x5e\x08\x66\x45\xfa\x7b\x78\x0b\xc1\xac\x30\x7f\x0f\x63\xe9\x71\xd8\x9c\xf9\x37\x3d\xfa\x28\x42\x59\x3b\x16\xb6\x5c\x7d\x14\xc1\xac\x16\x74\x5c\x95\x1a\xc1\xac\x6d\xa1\x3b\xae\xb5\x82\x60\x56\x0b\x98\xa1\x6d\x76\x41\x12\xdd\x93\x74\x42\x72\x59\xab\x68\x83\x2d\x3a\x04\xe0\x4d\xb2\x28\xa6\x66\x53\x3a\xae\xd8\x32\xa0\xc3\xa7\x81\x5b\xfb\x63\x7b\xae\xf8\x37\xc0\x2d\x4a\x6c\xe2\xae\xa8\x6a\x70\xcf\x0e\x59\x2b\x40\x28\x89\x1e\x52\x23\x50\x18\x51\xe8\x3b\x11\xda\x09\x99\x65\xe9\x78\x1a\xdf\xde\xaa\x00\x64\x15\x24\x66\xd9\xb5\x3a\x70\xf8\x24\x68\x8b\x21\x36\x5d\xe5\x4c\x87\xb6\x38\x1b\x35\xf8\xea\xb2\x2d\x62\x6c\xbb\xa6\x71\x12\xdf\x4d\xf5\x6d\x6f\xdc\x5d\x8a\x81\x82\x96\x41\x28\x21\x8d\xe0\x72\xbe\x1b\xda\xf2\x54\x4a\x50\x23\xb8\x9c\x6f\x85\xb6\x2c\x3e\x09\x6a\x04\x97\xe3\x3e\x68\x87\x68\x02\xd4\x08\x2e\x17\x93\x82\x0b\x6a\xec\xae\x42\x33\x12\xe3\x25\xbb\x1e\x04\xb4\x1d\x2f\x9c\x21\x7d\xfe\x57\x09\x19\xd6\x07\x35\xb5\x48\x5f\x7c\xb4\x04\x55\x2a\x7f\xd5\x26\x1f\x09\xda\x33\xbc\x0a\xbe\xc0\x68\x04\x75\xc2\xf0\xf8\x80\x0b\x5d\x63\xdf\x04\xb7\xb6\xbb\x6f\xad\x2a\xde\x1a\xd0\x1e\x67\x82\x09\x6f\x8d\xe8\x4e\x05\x57\x56\x84\xe7\x31\x7d\xd1\x32\xe5\x4c\x70\xc3\xfd\xaf\xe9\x4a\xa1\x0f\x27\x15\xd8\x87\xbb\x52\xc5\x8f\x0f\x52\xdf\x5a\x20\x94\x1b\x7b\x9a\x17\xa0\x35\x0b\x75\x94\x16\x8c\x74\xb7\xa6\x2a\x09\x69\xce\xf5\xdb\x68\x3b\x7a\x38\xc4\x55\x5c\xb6\xba\xae\x95\x2c\x41\xad\x4d\x53\x7c\x07\x9f\x1f\xd4\xe8\x08\xdc\x20\xdb\xf1\x34\xca\xdc\xb2\x81\xb8\x6e\x79\xb6\x6c\x68\xb0\xe1\x53\x80\xcd\xa6\xe1\x26\xaf\xd0\xd3\x09\x1c\xd8\x6c\x1c\x63\x9d\x5d\xcf\xf0\xe3\xb0\x46\xeb\x76\xb6\x1d\xff\x38\x07\x35\x37\x99\x6c\x76\xcb\x38\x75\xfb\x5c\x03\x0e\x6c\x04\xca\x87\x7c\x5b\xfb\x96\x67\xee\xd5\x80\x43\xdd\x71\xd1\xc5\xe5\x22\x9f\x70\xb1\xc2\xe5\xc3\xad\x9e\xe0\x33\x9f\x7c\xb1\x22\xe6\x71\xb7\x06\xf2\x9a\x57\xc4\x58\x41\xf3\xa8\xce\x76\x37\x2b\xa4\x80\xbd\x21\x25\xdc\x14\x5e\x58\x3f\xde\xd6\x9e\x94\x30\x94\x3b\x2c\xfb\x1e\x06\xb5\x37\x77\xac\x02\xa7\x84\x24\xc6\x6c\x26\x5c\x14\xb6\xe5\x6b\x82\x9b\x7b\xdc\xba\x55\x12\x5d\xc2\x9b\x7b\xdc\x42\xcf\x49\x02\x26\xbc\xb1\xc7\x0d\x9d\x43\x7e\xc2\x0b\x78\x73\x93\x5b\x25\xed\x2d\x49\x27\x35\xe7\xae\x67\x3c\x70\x58\x8f\x9a\x5d\x0d\xec\x51\xb6\x3b\x95\x58\x78\x54\xee\x1d\x0f\x97\x73\x60\x8f\xe2\xed\xf4\xcf\xcc\xd8\xab\xd8\x17\x93\xa2\x33\x74\x52\x92\x1a\xb2\xbe\xd4\xd2\x0d\x28\x73\x93\x24\x5f\xa0\x76\xfb\xb8\x84\x0a\xeb\x81\x19\xd4\xf1\x70\x40\x09\x66\xd0\xc5\xa3\x0a\x96\x60\x06\x45\x3c\x1b\x38\x67\x51\x9e\x49\xa1\x8a\x6c\xdd\x63\x56\xdc\x96\x07\xc8\xc0\xbf\xdf\x75\xfd\x95\x1c\xcc\xdc\x74\xb1\xe3\x9a\xb6\x1c\xcc\xc0\x1f\xe5\x86\x35\xe5\x71\x30\x73\xc3\x85\x6b\xd8\xce\xc8\x24\x5e\xcc\xdc\xc3\x69\x2a\x0f\x86\xe1\x19\xdc\x53\x3f\x6c\xf2\x21\x98\xb9\x3f\x72\x67\x8b\x9b\xad\xf6\x94\xab\xc3\x1a\x3a\x5c\xd8\xf1\x88\x2b\x1d\xda\x50\xe3\x76\xfb\x9e\xce\xd6\x80\x0d\x45\xce\x27\x64\x75\x60\x43\x95\xeb\xf7\x3d\x1d\x8f\xc0\xf3\x45\x3e\x4f\x24\xd1\x7a\xdb\x42\xc4\x86\xd5\xc0\x6a\x22\x09\xcb\x25\x1e\x7f\x0b\x39\xb4\x5a\x40\xc0\xc1\xe8\x99\x48\x74\x68\xb5\xe0\xb3\x5d\xee\xa8\xf2\xb7\x91\x43\xcb\x89\x64\x93\xaf\x17\xfb\x9b\x68\xcd\xac\xa8\x05\xa0\xb7\xdd\x5a\xe6\x2a\xa1\xed\x69\x07\x85\x64\xc7\xb3\x17\xaa\x84\x9f\xe7\x71\x7a\xe7\x04\xb3\xf0\xbd\x63\x1e\x78\x7b\xab\xe0\x76\x57\x7a\x81\x7d\xe0\x7c\xb7\xa0\xb6\xa1\x75\x17\x9d\xb2\xae\x71\x3f\x8b\x27\xa9\x65\x72\xf1\xe9\xc6\x55\x82\x67\x71\x4a\xc7\x39\x89\x66\x86\x13\xb1\xb4\x29\x2d\xc8\x82\x3e\xe6\x59\xe1\x1e\x1a\xd4\xb5\x96\x11\x25\xa4\x7b\x6e\x50\x25\xa8\x7b\x74\x90\xad\x4a\x4b\x50\xcf\xe9\x41\xd6\x22\x88\x04\xf5\x1c\x20\x64\xf9\x8a\x67\xd9\x78\x1c\x15\x71\xea\x60\x6a\x97\x99\x46\xf7\xd1\x9f\x33\x77\x33\x57\xd7\xa3\xf6\x6a\xb0\xe1\x53\x80\xad\xad\x53\xdb\xee\xca\x99\x06\x6c\xed\xa1\xf2\xd8\x67\x1a\xb0\x45\x88\xd0\x59\xeb\x4f\xa3\xfb\x47\x43\x06\xda\x26\x2c\x03\x70\x0f\x76\xb0\x80\xb2\x64\x92\x44\x63\x45\xa0\x4d\xe1\x90\xb6\xa6\x4a\xdc\x69\x3f\xc9\xa3\x1b\x29\xa1\xf1\x04\x9e\xae\x73\x0a\x90\x04\x54\xfe\x00\x71\x40\xc0\x56\xd7\x0f\xa9\xdc\x01\xc2\x1a\xee\xdb\x48\x96\x90\x86\x37\xc0\x37\xef\x4b\x48\x77\xdb\xb4\xe5\xee\xf1\x9c\x05\xb0\xe5\x84\x96\xf9\xce\x01\xa8\x82\x32\x0d\x10\x86\xa0\x0f\xca\xe4\x81\x4d\x67\x1f\x9d\x67\xef\xff\xae\x13\x5e\xc6\x81\x34\x77\x30\x52\xd7\x89\x7a\x93\x60\x61\x4d\x38\xa3\x09\x5b\x15\x2d\xb0\x3d\xc1\x6e\x58\x9f\x84\x33\x4f\x8c\x72\xf6\x03\x1a\xd3\x33\xdf\x0c\xc8\x67\x2f\xbb\xa7\x8c\x99\x99\xcf\x86\xa1\x2b\xee\xac\x39\x19\xbb\x01\x57\xb7\x2c\x59\x6b\x4e\xc7\x5d\x19\x93\x62\x2f\x30\x5a\x33\x31\x6b\x03\x9f\xa6\x4c\x7d\x68\x1e\x25\xc4\xef\x52\xe1\x56\x60\xc7\x85\xd6\x7d\x06\x7c\x21\x06\x4f\xbe\xea\xfa\x21\x43\x83\xe9\xb1\xf9\xd6\xdc\x24\x41\xbb\x2b\x3d\x11\x12\x74\xd3\x70\x73\x72\x3b\xb8\x02\x54\x06\x38\x89\xfd\xad\x3b\x2e\xa0\x3d\x31\x86\xdb\xfd\x0a\xcf\x96\x01\xad\x96\xc7\xb7\x2b\x7c\x66\x06\xb8\x92\x14\x55\x27\xf3\x19\xe0\x4a\x5c\x54\xf9\xce\x0c\xf0\x9e\xae\x7d\x7a\xfd\x67\x0c\xdc\x9e\xd3\x71\xa1\x95\xc7\x17\xf5\x5c\xce\x90\xd0\x16\xff\x76\xdc\x38\x06\x03\xdc\x62\x63\xe4\x8f\x25\xb8\x58\xdc\xdc\xf3\xb3\xa9\x04\x77\x98\xda\xf2\x2d\xcd\xa3\x79\xf4\x18\x3d\x4c\xe3\xb9\xe9\xf3\x43\xed\xc5\x02\x25\xd1\x78\x3a\x5f\xdc\xde\x1a\x90\x3c\x6a\xa2\xef\x87\x0c\xeb\x83\x5a\xf3\xab\x27\xac\x43\x82\x5a\xb3\x6b\xdf\x75\x58\x49\x50\x6b\xa3\xf2\xae\xeb\xb1\x9a\x93\x7c\xe1\x48\x6a\x2b\xd8\xc5\xf1\xd2\x71\xef\xb4\x0b\x64\x9d\x6d\x14\xba\x4b\x15\x1e\xdf\xdc\xae\x1b\x02\xe2\x71\xcb\xf5\xdd\x88\x1e\xd7\x23\x87\xed\xb3\x80\x92\x85\xd4\x1f\x91\xb9\xb6\x70\x37\x7a\xe8\x00\x59\xb8\x6f\x7b\x06\x68\xb2\x98\x59\xe7\x31\x79\xd4\x6d\x06\x65\xed\xbc\xf6\x98\x6d\x0c\xca\xda\x75\xdd\xf5\x8c\xc2\xec\x61\x62\x1e\x30\xc6\x9d\x62\x3d\x57\x6d\x31\x0d\x24\xd6\x48\x0c\x18\xe8\xf9\xa0\x94\x9c\x2d\xcf\x25\x70\x5a\x6a\x9a\x44\x8c\xf8\x3d\x5f\x53\x4d\x5b\xa8\x5b\x9e\x49\xe0\xb4\xd5\x30\x82\xd0\x30\xf5\xb8\xd4\xad\x49\xde\x51\x05\x6c\xc9\xe2\x03\xb0\x5d\x12\x0e\x80\xed\x8c\x70\x00\x6c\x37\x84\x05\x90\x15\x8f\xc6\xa1\x95\xe5\xe9\x3a\xee\x52\xa7\x84\x74\x37\xf6\xdb\xee\x6d\x09\xea\xee\xec\xb7\x3d\x48\x12\xd4\xdd\xda\x6f\x9f\xac\x23\x41\xdd\xbd\xfd\x76\x48\x6a\x9e\x3d\x46\xba\xd3\x70\x4b\xea\x09\xdd\x0a\xc0\x50\x33\x0a\xf9\xf1\x87\x4e\xed\x25\xa4\x68\xd3\xd6\x76\x19\x68\x60\xf3\x90\x84\x94\x81\xe0\x3b\xa5\x87\xa1\x0a\x4b\x19\x95\xbf\xcb\x55\x37\x9b\x91\x8a\x68\x32\x49\x88\xd1\x4d\x15\xc7\x0f\x5a\x8e\x7a\xb9\x42\x66\xa9\x65\x3e\x1f\x7d\xaf\xe3\xd2\xd1\xe7\x9e\x67\xd3\x9f\xe5\x09\xf2\x39\xe6\xd9\xa4\xba\xe3\xab\xd5\x9c\xbf\xb6\x02\xe8\x6f\x5b\x50\xe9\xc4\x60\xc9\x2e\x1b\xa8\xe8\x0d\xb7\x7c\x63\x96\x7b\xa1\xb7\x25\x54\x9c\x6d\x2f\x58\xa8\xa9\x42\xa5\x2a\xb6\xeb\x05\x14\xed\xdd\x96\xa7\x9d\x59\x71\xa3\xf6\x69\x50\x5b\xdb\x52\x0d\xf3\x03\xf6\x56\xa3\x58\x4c\x49\x62\x1c\x1c\x59\x9a\x72\x4e\x81\x08\x18\xd6\x86\xb4\x56\xf2\x76\x5d\xbf\xb9\x80\xb4\xd6\xf0\xb6\xdd\x55\x6b\x01\xe9\x86\x28\xd8\xab\x83\x45\x4c\xd2\x34\xd2\x65\xf7\x4e\x37\x00\x2b\x84\x80\x03\xb9\xca\x95\xa5\x5b\x71\x30\x57\xa9\xb2\xd6\x72\x38\x98\xab\x4c\xd9\xec\x85\x60\x8e\x12\x65\x53\xaf\x6a\x99\xc7\xb2\x98\xed\x15\x1e\x6d\x3d\xc8\x0b\x28\x27\x20\x9c\x2f\x50\xe9\xf0\xd7\xac\x0e\x58\xdd\x29\x03\x71\x6d\xe9\x61\x2d\xe9\xe0\xda\x61\xe8\x99\x71\x1d\xb7\x1a\x43\x6f\xd7\x33\x81\x4b\x40\xd5\x1a\xa6\x5d\x84\x9e\xd3\x9d\x24\xa8\x6a\x0f\xee\x1f\xf0\x44\x03\x48\xd0\x4d\xdd\xaa\xdf\xd9\x5d\x52\xbf\x6c\x12\xeb\x64\x8f\xe3\xdc\x5d\x70\xea\x0a\x39\x67\x59\x4c\xee\x19\x68\xbb\x3b\x15\x4b\x70\xee\xf9\x67\x3b\xfd\x8a\xf5\x37\xf7\xec\x33\xf4\xb0\xfa\xfc\xc2\xce\xb9\x67\xbc\x3f\x7d\xcb\x3f\xee\xba\x58\x65\xab\x52\x7b\x85\xc8\x13\xfc\xc3\x80\x3c\x51\x2b\x1e\x28\x37\x5a\xc5\xb6\xba\x19\x94\x1b\xa5\x62\xdb\xdc\x0c\xca\x13\x9d\x62\xd9\x32\x15\x5e\xdb\xbe\xbb\xab\x41\x83\xb4\xb7\x22\x2e\x01\xb5\xb7\x24\xda\xa7\xa2\x69\xa0\xf6\xd6\x44\x7b\xff\x8b\x06\x6a\x6f\x51\xb4\xf6\x50\xd9\x8b\x94\xdb\x72\x0b\x86\xa5\xee\x38\xcb\x93\xbb\xbb\x65\xcc\xb8\xc3\x8f\xf6\xc2\x24\xbf\x47\xc0\x27\x31\xec\x25\x49\xf4\x4e\xf6\x3c\x6a\xab\xb3\x18\x89\x81\x39\x1d\xcf\x10\xa3\x2a\x78\xa6\x8c\xa5\x74\x43\xe4\x68\x64\xaf\xf2\xf7\x9d\xcd\x5a\x34\x4a\x5d\xdf\x9a\x65\x05\xd2\x28\x75\x3d\x6b\x5b\x0e\x8c\xe3\x57\xb3\xf4\x53\x3a\x8d\x0b\x9a\xa8\x73\xa4\xb7\xc4\xc9\x74\xd6\x9d\x03\x25\x9c\xe5\x2a\xf6\x78\x2f\x4a\x40\xcb\x57\xee\x51\xf8\x4a\x40\xcb\x53\xee\x59\x11\x2e\x01\x2d\xf7\xb0\x47\x16\xd0\x6c\x16\xd1\x4c\xc7\x70\x77\xd7\x99\x17\x39\x50\x58\x0b\xca\x0c\x14\xed\x3a\x93\x27\x87\x32\x5a\xc0\xb8\xc8\x9a\x3b\x39\x94\x19\x22\xda\x73\xe6\x4e\xdb\x9d\xb4\x25\x63\xd0\x3b\x15\x80\xfa\xf0\xf6\x1d\xba\xee\xf8\x90\x3a\x15\x47\xae\x3b\xde\xa3\x4e\xc5\x81\xeb\x8e\xdf\xa8\x53\x71\xde\xba\x71\x66\xa4\x52\x80\xed\x8a\x1d\xc7\x12\x13\xf3\xe8\x43\xb4\x64\xb7\xdf\xa7\x84\x31\x04\x96\x68\xf6\xbb\x93\x70\x1f\x50\xaf\x02\xd2\xf4\xed\x7a\x36\xb0\xf9\x9d\x48\xac\xdd\xd6\x06\xca\x87\x29\x89\x64\xab\x7b\x6a\x31\x65\xd7\x85\xb2\x42\xcb\x42\x77\x5f\x0b\x82\x59\xc3\x08\x77\xef\xf4\x5c\x30\x6b\x10\x6d\xb9\x74\x41\x30\x6b\x08\x6d\xb9\xc2\xdb\x73\x10\xa0\x87\xb3\x10\xaa\x98\x65\x1f\x3d\x77\x78\x58\xda\x6a\x45\x64\x46\xc7\x03\xe4\x86\x64\xf8\xa0\xdc\x58\x0c\x1f\x94\x1b\x84\xe1\x83\x72\xa3\x2f\x7c\x50\xa6\xf3\x5a\x5f\x86\xe1\x90\x78\x03\xe6\x2d\xec\xc3\x67\xfe\xd4\x1e\xe7\x24\xa2\x64\x94\x2e\x66\xab\xaf\x04\x4d\xc9\x03\x94\x37\x23\x1b\x77\xfd\xde\x8a\x9b\x3a\x7f\x8a\xf2\x62\xf9\x2d\xba\xf2\x22\x62\x79\xb7\xe7\xbf\x6b\x5e\x45\xeb\x7f\xbd\xfe\xbe\xf5\xbe\xd9\xbc\xfa\xe3\xfb\xd6\xf5\x5a\xeb\x7d\x6b\xe3\x2e\xb6\xae\x05\xcf\x03\xc8\xbc\x77\xba\xde\x67\xf1\x04\x3a\xb0\xbf\xbf\x0f\xf4\x2a\xbb\x6e\x01\x9d\xe6\xd9\x43\xe3\x5d\xfa\x31\xcd\x1e\x52\xb8\x8f\xf2\x38\xba\xc1\x49\x03\xd6\x20\xf3\x5c\x07\xce\x30\x66\x39\x3d\x17\xb5\xe6\xfc\xb6\x51\xa3\x7d\x6d\x81\x56\xd1\x2a\xef\xda\xad\xf8\x7c\x95\x5f\x37\x0b\x71\xa9\x33\x96\x66\xe3\x46\x8a\x71\x34\x27\xb2\x9d\x1c\xc5\xdc\x7f\xd3\x75\xa1\x52\xbf\x56\xd2\x5e\x55\x6e\xde\xb6\x44\xd2\x71\x36\x21\xef\xce\x8f\xf7\xd4\x63\xe0\xf9\x7c\x98\xcd\xe6\x59\x4a\x52\xba\xe7\x49\xd3\x33\x20\xe2\xaf\x2f\xdf\x9c\xec\x55\xf2\x0c\x68\x97\xe3\x7e\x6e\xbc\x6c\xec\x41\xe3\x79\x42\x5f\x34\x02\x68\xfc\x80\x2f\x77\xfc\xe5\x39\xbe\x44\xb3\x39\x7b\xfb\xae\xf1\x1d\x7b\xfb\xcb\x22\xe3\x1f\xbf\xc3\x8f\xbf\xdb\xdc\x7d\xd1\xf8\xea\xa7\x8b\xc6\x49\x57\x2f\x7f\x78\xfe\xbe\xf1\xfe\xbb\xeb\x8d\xbb\x60\x29\x5a\x5a\x76\x7a\x45\xae\xcd\x8f\x5f\xdd\xab\x8b\x4b\x42\xdf\x11\x3a\x18\x8f\xc9\x9c\x9e\x44\xe9\xdd\x22\xba\x23\xc5\xb2\x7b\x89\xbd\x19\xda\xe3\x69\x9e\xcd\xc8\xc5\x62\x3e\xcf\x72\x4a\x26\xcd\x16\x1c\x00\x4f\x6b\xc7\xe1\x4e\xea\xc9\xc2\xaf\xe3\x2e\x08\xbd\x8c\x67\x24\x5b\xd0\xa6\xaa\xd1\x69\x18\x69\x5e\xa5\xd1\x7d\x7c\x17\xd1\x2c\x6f\x27\x65\x09\x8a\x40\xeb\x78\x89\xee\x87\x46\xeb\x5a\x6f\x23\x13\x24\x2b\xda\x69\xa3\x6d\xb4\xdb\x37\xbe\x1f\xe2\x74\x92\x3d\x94\xd9\xe0\xf9\x73\xbd\x8d\x46\x5d\x78\x7b\xf4\x3f\x2f\x48\xfe\xb8\x84\x96\xe6\xfd\xce\x07\x8d\x16\x2b\xb1\xbc\x23\xb8\x58\xdc\x14\x34\x6f\x86\xc6\x4d\xff\xf2\x9a\x6c\x5a\x4a\xba\x9c\xc3\xce\x93\x98\x36\x1b\xcf\x1b\xad\x00\x62\x7e\x19\x76\x0c\x2f\x21\x97\x97\xb2\xc7\x6b\x6b\x5e\x1e\xce\x60\x1f\xf2\xab\xf8\x5a\x94\xb0\xdf\xb0\xaf\x75\xa6\x57\x13\x62\x0f\x98\x66\x76\xd5\xb9\x6e\x5d\xc3\x3e\x78\xbf\x85\xd7\x4b\x2f\xc9\xa6\x76\x9f\xbc\x3b\x3f\x59\x2d\xa2\x15\xec\x32\x56\xcb\x17\x29\x8d\x67\xa4\x84\xe4\x1c\x46\x3c\xf5\xfd\x66\xfd\x5e\x56\xe8\xa6\x94\x15\x19\x75\x8f\x93\x68\x36\x5f\x79\x15\xba\xbc\xa6\xfa\x25\x50\x38\x00\xca\x9a\x00\x3f\x40\x0e\x07\x90\x3b\xcd\xf9\xeb\x3c\x9a\xd4\x9a\x98\xe4\xf4\xd6\x9e\x47\x93\x0b\xc6\x75\x4d\x1a\x40\xa3\xd3\x70\x86\xc8\xcf\xa8\x57\xcc\xa3\x31\x59\x75\x3b\x39\xbc\xdc\x87\x8e\xbc\xf2\xbb\xd1\xd0\x58\x47\xf0\x28\x33\x1b\xcc\x42\x6d\x6e\xa6\xed\x07\x55\xe1\x97\x2f\x56\xc2\x3e\x34\x14\x2f\x36\xd8\xa4\x03\x3f\x80\x0e\x21\x58\xbc\x65\xa4\xc2\xda\xbe\xf1\xee\xde\x63\x6f\x94\x51\x8e\xb5\x4e\x00\xd6\xf4\x1f\x25\x51\x3e\xab\x77\x7d\x3e\x65\xc8\xf7\xc9\x66\x50\x5e\x61\x8f\xb4\xbc\xa0\xd1\xf8\x63\x33\xf4\xdc\xa3\xbf\x44\xd8\x09\xda\xd5\x13\x8d\x22\x07\x1b\xf7\x8d\x02\x7b\xb9\x01\x4c\x67\x78\x9c\x93\xec\x16\x08\x1c\x40\xcc\xd8\x06\xaf\x48\x7f\xe1\xe6\x65\xf9\x62\x38\x80\x06\x9f\xa5\x19\x6c\xa3\x11\xc8\xdb\xd8\x1f\xa2\x3c\x6d\x36\x92\xf8\x46\x50\x63\x0f\x28\x47\x0a\xc8\xa7\x79\xcc\xcd\x06\x36\xbb\xc3\x06\x84\x64\x13\xd6\xa0\x51\x60\x39\x2d\x55\x46\x92\xdd\x35\xb3\x56\x80\x8d\x52\x77\x96\x4b\x09\xc1\x06\x00\x97\x5b\x35\xe7\xb6\x15\xd4\xd0\x7b\x19\x05\xea\x38\x21\x51\x2e\x48\x49\x35\x44\x5a\x01\x90\x76\x34\x9f\x27\x8f\x4d\xf6\x1a\x40\x94\xdf\x2d\x66\x24\xa5\x45\xcb\x2d\xf6\xab\x85\xb6\x7f\xda\x5e\xd2\x05\xb1\x54\x2e\x31\x7f\xd3\x19\x79\xc8\x2d\x4b\xc8\x80\x8c\x51\xca\x7c\x38\x00\x02\x6b\xd0\x85\x3d\xe8\x6a\x98\xd0\xfc\xd1\x26\x09\xaa\x66\xa8\xe2\x8e\xf2\x3c\xcb\x35\x0c\x60\x1c\xd1\xf1\xd4\x47\x6c\x7e\xf1\x7f\xc1\xf0\x11\x53\xc3\xfb\xb4\xe1\x17\xeb\x72\x4e\x8a\xcb\x39\x09\x27\x95\x17\x90\x31\x01\x26\x67\xa0\x8c\xcd\x40\xf1\x55\x06\xeb\x90\x5f\x97\x8a\xa9\x9a\xc0\xff\xf8\xbe\xf8\x3e\xa2\xef\x8b\xb5\x0d\xbc\x0c\xdf\x1d\x2e\xb1\x41\xab\x62\x16\xe5\xf4\x28\xc9\xb2\x7c\x18\xdf\xc7\x13\x52\x6f\x84\x12\xd8\x60\xe4\x63\x68\xbe\x89\xe8\xb4\x3d\x26\x71\xd2\xcc\x7d\x95\x31\x24\xe1\x25\x84\x64\xbd\x57\xf6\x1d\x66\xb8\x65\x35\x36\x73\x4b\x4b\x8d\xd2\x49\x36\x3b\x4e\x69\x2d\x31\xac\x95\x83\x8f\x3c\x77\xb3\x05\xdf\x43\x93\xc2\x3a\xf6\x69\xd8\x6a\xc1\x9a\x21\xe7\xdf\x90\xa2\x88\xee\xc8\x9b\x28\x8d\xee\x48\xbe\x84\x43\x50\xdc\x0a\xfd\xa8\xf8\x80\xbd\x38\x8b\xe6\xcd\x65\x03\xcb\xd5\x37\xa5\x3a\xa5\xab\xe6\x01\xac\x87\xc8\xd4\x66\x15\xed\x38\x9d\x90\x4f\x67\xb7\xcd\x06\x49\xb9\xfe\x62\x03\x2c\xd2\x62\x1a\xdf\x52\x0e\x10\xf0\xcf\x33\xde\x20\xd4\xe6\xbf\x56\x36\xb4\x3d\xcf\x33\x9a\xb1\x51\xd4\x8e\x26\x93\x37\x2a\x4f\x55\x73\x34\xf5\x28\x4e\xc1\xaf\xb6\x23\x27\x5c\x51\xdb\x2a\xca\xdb\xd8\xf8\x69\x96\x4c\x48\x5e\xb0\x79\x57\xc7\xf3\x8a\x32\x9e\xcd\xc5\xbb\x22\xd5\xfb\xdf\x73\x2b\xef\xea\x8f\xef\x8b\xf7\xbf\x67\x26\xde\xef\x2d\x13\x2f\x0f\x20\x5e\x26\xcc\x18\x2e\x46\xdd\x57\x71\x9b\x66\x27\xd9\x03\xc9\x0f\xa3\x82\x34\x5b\xd7\xed\x71\x96\x52\x92\x52\x5b\x9d\x87\xbd\xa5\x48\xfa\xb5\xfd\x4a\x0a\xdf\xc6\xe9\x64\x90\x4e\x4e\xb2\xa8\x82\xd2\x36\x47\xcb\x4f\xb9\x87\xa9\x98\x7c\xe2\xf3\x20\xef\x7c\x54\xf5\xf5\x77\x86\x0b\x97\x0d\x70\x00\x29\x7e\xa7\xcd\x2c\x80\xc2\x14\x32\xe6\x28\x8e\xc1\xe5\xbf\x71\x96\x8e\x23\x2c\x90\xd5\x77\x75\x1d\x60\x3d\xec\xff\xd3\x6a\xad\x4e\x8d\x15\xad\xb9\x4d\x4c\x29\xbb\xf6\x9c\xdc\x92\x9c\xa4\x63\x66\xa7\x04\x38\x9b\xe5\xed\x9b\x38\x9d\x20\x50\x00\xcf\x3a\x4e\x4a\xd8\xd2\x51\x57\x9f\x74\x01\x93\x1a\x42\xbf\xb2\x2f\x92\x65\x9d\x60\xeb\x8b\x82\x30\x4c\xc2\xff\xe1\xcd\xc9\x6b\x4a\xe7\xe7\xe4\x2f\x0b\x52\xd0\x17\xba\xd5\x96\xa5\xac\x54\x92\x2e\xd1\x75\xd9\x7f\xdd\x4e\x87\x8d\xf2\x98\x09\x7f\xba\x60\x03\x81\x93\x45\x1b\x80\xcd\xff\x70\x71\x76\xca\x0d\x9c\x66\xdc\xce\x49\x31\xcf\xd2\x82\x5c\x92\x4f\xb4\xc5\xc6\x77\xb3\xc5\x3a\x33\x67\xa2\x20\x6f\x8a\x72\x2a\x48\xc3\xa8\x90\xcd\x49\xda\x6c\xfc\x38\xba\x6c\x30\xf5\x8b\xa5\x14\x24\x9d\x2c\x23\x94\xd3\x45\x4f\x75\xcd\xbc\xff\x7d\xf3\xfd\x64\xad\x65\x99\xd3\x36\x61\x41\xb7\xa6\x73\x58\x87\xf0\xba\xc2\x57\x51\xd9\x91\x3e\x4c\x57\x37\x67\x69\x91\x77\xc4\x9d\x69\xbc\x2c\xf1\xc2\xd6\xd6\xe3\xd4\x94\x17\x2d\x35\x9e\xa4\x08\x21\xd7\x9a\x5f\xc7\x30\x7a\xd0\xc4\x45\x75\x8a\xe5\xb2\x4c\xfb\x12\xdb\x26\x61\x1c\xf0\x2c\x96\x36\x81\xa9\x45\x0a\xf7\x50\x59\x1b\x57\x1c\x59\x97\x6b\xae\xae\x1c\x15\x9b\x3d\xc3\x5b\x24\x95\x39\xc6\x8c\x10\xa7\x05\x8d\xd2\x31\xd3\xac\x06\x79\x1e\x3d\x72\xa3\x81\x8d\x79\x7a\x2d\xe6\x17\x77\x10\xc7\x8c\x2b\x18\x63\xc6\x75\xfa\x6d\x9e\x67\x63\x52\x14\xc7\xe1\x4e\x3a\xa0\x34\x8f\x6f\x16\xb4\xee\xc4\xc3\xa6\xdc\xbf\x30\xbb\xff\x82\x24\x64\x4c\xb3\x7c\x90\x24\xcd\xc6\x15\xa3\xd4\x35\x9b\xff\x72\x6e\x9f\xe7\x86\x76\x94\x33\xed\x08\x31\xf7\xd5\xdc\xa4\x57\xf9\x75\x2d\x7e\xf3\xe5\x5e\xa5\x50\x22\xc6\x77\x84\xaa\xea\x1a\x0c\x59\x43\x0b\x63\xdc\x40\x5d\x09\xea\x2a\x9b\x50\x2a\x8f\x9a\x88\xa0\x96\x2e\x2d\xd5\x4e\x77\xb4\x81\x54\x57\x05\xe3\x10\xa6\xb2\x36\x1b\x87\x51\xfa\x1d\x05\x2c\x8f\x33\x4d\x9b\x46\x77\xa7\xd1\x8c\xa9\x4b\x8d\xdf\xf1\x94\x78\xc2\x5e\x38\x53\x31\x25\x3f\xb7\xaa\x35\x5f\x65\x8f\xa1\x2b\xd4\x6d\x1b\x68\x92\x35\xe7\x73\x0b\xeb\x05\x8f\x01\x95\x19\x4e\x9c\xfd\xd2\x89\x53\x66\x80\x7d\xf6\x5d\xb8\x72\x18\x7f\x9a\xe0\xbf\xd7\xc0\x57\x59\x3f\xb0\x5c\x59\x6b\xd3\xec\xdd\x7c\x2e\xb4\x06\x8f\xfd\xd2\xb4\xba\x59\x43\x8c\x29\x9c\x8d\x0f\x68\xc7\xb5\x6c\x0f\x10\xe8\xee\xe4\xd2\x9c\x6f\x66\x3e\x28\x56\xc2\x3e\xb7\x26\x49\x9b\x92\x4f\xf4\x90\x2b\x2e\xcc\x96\x45\x13\xb4\xd0\xab\x8f\xcd\xc9\xde\xea\x23\x5d\x67\x79\x9b\x8b\x71\xec\xd5\x80\x6d\x79\x8f\x48\x16\x34\xcb\xa3\x3b\x74\xa5\x05\x46\xca\xd9\x4d\x41\xf2\x7b\x92\x7f\x10\xcd\xc9\xd2\x0b\xfe\xe5\x70\x1a\xa5\x77\xe4\x83\x31\x3d\x21\x44\x5c\x0c\xc6\x34\xbe\x27\x2c\xcb\xb3\xb0\x4c\x8c\x58\x52\x44\x49\x53\x40\xd1\x9c\x3b\x2c\x9e\x85\x2f\x2a\xbc\x03\x8d\x0d\xdd\x45\x92\xb7\x49\x3a\x29\xd9\x60\xa3\xd1\x42\x31\x96\xc3\xda\x3e\x03\x13\x65\xce\x73\x72\x1b\x7f\xe2\x2c\x28\x13\xce\xc9\x38\xcb\x27\xa8\xdd\x33\x73\x8b\xf7\x49\x92\xdd\x44\x89\x68\x1b\x7e\x63\x2a\x10\x7e\x1b\x4f\xe3\x64\x72\x14\x31\x59\x14\x13\x33\x1b\x7e\x3a\x89\x0b\x5a\x26\x2f\x23\xba\x26\x66\x86\xa3\xa3\xc1\xbb\x93\xcb\x0f\x3f\x0d\x4e\xde\x8d\x4a\x6f\x87\x5a\x6f\x69\x36\xca\xef\xe8\x8e\xf4\x14\xc4\x1b\x50\xa3\x13\x53\x36\xc2\x55\x0f\x4e\xc8\x6d\xb4\x48\xe8\x4f\x51\xb2\x60\xc9\x54\x34\x62\x91\xe7\x24\x55\xc9\x2c\xcd\xc0\xb0\x84\xcb\x04\x75\x90\x38\x4b\x9b\xca\x31\x5c\xda\xe2\xda\xf4\xa9\x59\x43\x34\x99\x88\xee\x5b\x65\xe0\xc9\x86\xb4\xe7\x8b\x62\x6a\x2e\x59\xd5\xa8\x29\x27\xb3\xec\x9e\xd4\xa8\xcc\x70\xe2\xa9\x4a\x85\xc5\x47\x74\x19\x40\xe1\x87\x7d\xe8\x48\xfb\x4f\x41\x17\xf3\x24\x1e\x93\x26\x0d\x20\x7c\x22\x9e\xb6\xa6\xe3\x53\xea\x3c\x0c\xb0\xef\x63\x01\x38\x80\x8d\x3f\x36\xb9\x6f\xe6\x4b\xba\x98\xdd\x90\xbc\xf5\xfb\x8d\x36\x25\x05\x6d\x96\x6e\x1a\x87\xc5\x5a\xc2\x0a\x34\xf8\x6e\x0f\x1a\xd9\xcd\x9f\xc9\x98\xea\x2e\x1e\x17\xec\x40\x9f\x02\xf1\x91\x57\x1e\xdf\x3e\x36\xdd\x9a\xa4\x2d\x67\x55\xe5\x34\xaf\xe6\x00\x9d\x10\x21\x9e\xaa\x29\xc8\xe6\xf4\x67\xa6\x7c\x6b\xd9\xce\xa2\x66\xe3\x34\xa3\x20\xca\x9a\x18\x3a\x41\xb5\x68\x2c\x25\xad\xc5\x68\x4d\xaf\x14\x5e\xce\x13\xda\xf0\xa8\xd5\x9e\x95\xcd\x19\x24\x39\x89\x26\x8f\xb5\x9b\xd4\xb1\x9a\xa4\x8d\xd2\x5f\xd7\x1e\x86\xc6\x85\x9c\xa3\x2a\xf5\x4a\x91\x4e\x5d\x4b\x0d\xed\xb4\xf5\x75\x34\xb4\x70\x01\xc2\x98\xf5\x1d\xdb\x19\xf5\x4e\xee\xfd\x3a\x43\xfe\x6d\x7f\x24\x8f\xa5\xcd\xab\xcf\x2a\xad\xba\x0e\x23\x7d\x82\x92\xee\x2a\xb0\x0c\x3c\x87\xba\x7c\x96\x7c\xfe\xdc\x70\x0e\x37\x14\xa5\x0a\x60\x94\x29\xb5\x38\x55\x43\xcb\xea\x87\x3b\x42\x8f\x29\x99\xa1\x5e\xaf\x70\x75\x1d\x2d\x62\x91\x4b\x2b\x4a\x28\xdd\x15\xfa\x60\x81\x4b\xe3\x95\xfa\x60\xca\x84\xff\x55\x71\x1d\x40\xc4\xd4\x1a\xa1\x42\x65\xad\xc0\xaf\xb0\x25\xb0\x0f\x91\x34\xbd\xf4\xf9\xf6\xf9\x73\xb0\xe4\x42\xda\x82\x67\xfb\x76\xa2\xd3\x41\x57\xd1\xb5\x21\x12\x7c\x9a\xd8\xca\x4c\xb0\x0f\x69\x00\x09\xea\x9e\xf9\xda\x9a\xa0\xee\x63\x3a\x3e\x14\x28\x36\x23\x34\x99\x96\x6a\xd1\xc8\x83\x8a\x03\xb5\x15\x0b\x52\x61\xf1\xd7\x1d\x1f\x13\x72\x1b\xa7\x44\x41\xd4\xb2\x79\x8d\x9e\x96\x4d\x27\xba\xd6\x9e\x09\xa1\x3e\x46\x6d\x6f\xc8\xe5\x6d\xa9\x7f\xec\x55\x96\x50\xfa\x57\xaa\xe7\x2d\x5e\x42\xc0\x89\x91\x19\x72\x22\xff\xd6\x36\xd7\xb6\x38\x3b\x2f\x80\xc2\x4b\x90\x8b\x61\x40\xa5\x3d\x69\x97\xd9\x24\x57\xf4\xfa\xaa\x73\x1d\xa0\xe3\xf1\x2a\x14\x0f\xdd\xeb\xa7\x61\x89\x6c\x92\x93\xb4\x86\x16\xe7\xa2\x10\x28\xe5\x34\x4b\x25\xc3\x39\xfa\x77\x00\xe8\x4f\xf0\x29\xb1\xbc\x4b\xa8\xab\xc6\xf2\x0f\xb5\x35\x59\xad\x9f\x56\x78\x91\xb8\x0f\xe5\xf9\x73\x68\x08\xa0\x06\x1b\xad\x62\x8d\xc7\x33\xd7\x1c\xa7\xf7\x51\x12\x4f\x98\xd9\x1a\xcd\xf6\x80\xab\xe8\x85\x63\x57\x13\xa9\x34\xd9\x4a\xbc\x50\xef\x10\x95\x55\x06\x2b\x4e\xe7\x3c\xb0\xc7\x95\xe9\x1e\xec\x84\x33\x66\x2e\xa9\x53\x2e\xe4\x39\x11\x00\xce\x70\xc8\xaf\xcd\x79\x50\x79\x27\x60\x95\xe5\xa6\x91\x3e\xcd\x68\x7c\xfb\x38\x48\x92\xea\x29\x5d\xb6\x99\xf8\x9b\x55\x5a\x09\x58\x50\xc9\x3d\xab\xb4\x61\xbb\xfe\x32\x5b\x5d\x2d\xb8\x5a\xb0\x70\x75\xea\x09\x84\x26\xde\xc0\x0e\x34\x16\xd1\xc4\x36\x63\x39\xfc\x0c\x62\xc4\x77\x78\x41\xae\xe2\x6b\xee\xd9\xb4\x2b\xd3\xcb\xd6\xd4\x75\xb3\x44\xf5\x81\x95\x93\x07\xc2\x14\xab\x4b\x63\x6e\x14\xe2\x08\xaf\x27\xc0\x03\xd3\xcd\x40\xec\xc1\xe2\xfa\x8c\x58\x6a\xc6\x2d\xe4\xf5\x90\x8d\x48\x65\xa0\x64\x3e\xbe\x1f\x2e\x98\x41\xc2\x34\x49\x94\x1a\x65\x5f\x28\xd7\x51\xa6\xb3\x32\xf7\x85\x22\xbd\x5e\xc0\xb3\x8c\xd9\xe8\x6e\x25\x2f\x5a\x72\xc9\x5f\x2e\x06\x36\xc3\x00\xb6\xfa\xfd\xcd\x7e\xab\x4d\xb3\x32\xf6\x22\xdc\x6a\xa9\xe0\x80\xbf\xce\xa3\x49\x33\x0b\xa0\x87\xcb\xd1\xd2\x63\x84\x78\x70\x34\x2c\x2f\x42\x2a\xe8\xe2\x4a\xc2\x52\x58\x66\x9e\xa5\xcc\x54\xba\x25\x94\xf6\x15\x40\xda\xce\x49\x41\xe8\x20\x49\x9a\x2d\xaf\x08\xbd\xca\xae\xb9\x86\x50\x9a\x98\x99\xd4\xbf\x08\xce\x95\x45\x2b\x80\xb4\xb6\xa6\xcb\xac\x00\x3f\x0f\xb8\x93\xc5\x1d\xa1\x08\xca\x3f\x6b\x78\xfa\x9c\x2a\x8a\x4d\xf8\x58\xc9\x65\xaf\x50\x1d\x9e\x77\x59\xcc\x95\x1d\x61\x90\xc6\xcc\x20\x35\x5b\x95\xb3\xd9\x66\x42\x12\x42\x89\x97\x28\x74\xb9\xd3\xc0\xb0\x5c\xeb\xf1\x3c\x17\xda\xc4\xa7\x1f\x2e\x93\xd9\x08\x06\x49\x5c\x50\x8f\x28\x31\x16\xca\xcc\x16\xd8\xe2\x0a\xbd\xe9\x10\xb7\x56\xc5\x9f\xe6\x2e\x2e\xdf\x09\x5c\x1a\xdf\x95\xe3\xe7\xbb\x86\x18\x51\xdf\xa1\x07\xd6\x1f\x1f\x91\x3b\x13\x85\xf6\x31\x5e\x45\xe2\x49\x7c\x7b\x2b\xf5\x85\x55\xeb\x93\xba\x48\xb5\xda\x17\x4d\x26\x64\xb2\x07\x9f\xbf\x06\x36\x92\x8c\x57\x7d\x5f\xc6\xd9\x0c\x77\xa7\x7e\xd6\x7d\x94\x86\x84\x26\xa6\xec\xe4\x4c\x47\x95\xb7\xe4\x2a\xbe\x6e\xc1\x01\xe4\x6d\x5e\xd4\x15\x4b\xb8\x46\x1b\x13\xf6\x20\x6f\x23\x4a\x5a\xe2\x52\x79\x6d\x4a\xe9\xab\xf8\x9a\xf5\x63\x59\x06\x13\x52\x2a\x89\x57\xc6\x9d\x8b\xe5\x50\x9c\x5c\x51\x59\x8b\x47\x64\xe4\x35\x99\xdc\x30\x16\x9e\xd0\x15\x9d\x40\x69\xd9\x62\xf0\x16\x3e\xab\xd4\xe4\xdd\x16\x5f\xd0\x6d\xae\x66\x0b\xbe\x8c\x1c\x40\xa7\xf5\x02\x52\x78\x09\x99\x24\x57\x5a\x11\x06\xc9\x4c\xb9\xec\x2a\xbd\x0e\xd0\x5c\x53\xb2\x3d\xb2\x99\x98\x8d\x8b\x44\x7a\xba\xa4\x73\x2b\xe1\xb2\xe4\x99\x4f\x68\x44\xd7\x95\xb6\xe4\x74\xa5\x48\x77\x10\x90\x43\x77\x5a\xb9\x44\x60\xaf\xb9\x45\x37\x09\x01\x9a\x41\x4e\x98\xf1\x5c\x35\xed\x79\x6b\x2a\x8b\xa3\x71\xba\x20\x9e\x05\x05\x37\x69\xea\x9d\x6e\xfc\x64\x81\x7d\x98\x06\x80\xc6\xe7\x54\x77\x87\xac\x0a\x76\x03\xcb\xf7\x81\xb3\x27\xf5\xae\x78\xac\x5c\x5e\x00\xc1\x9c\x29\x1f\x5d\x8c\x5d\x0a\x93\x5d\x96\xcc\x07\xc5\x55\x7a\xad\xcb\xd4\x67\x0a\x1f\xcd\x12\xa6\xf5\x5d\x42\x85\xbd\xb6\xfb\xf7\x57\x44\x3d\x0e\xbc\x63\x4a\x66\x4d\xcb\xdb\xc3\x66\x4e\xd3\xa1\xf0\xcc\xef\x77\x65\x73\x2e\xad\xed\xa2\xb7\x55\xf9\x27\x91\x6e\xa9\x29\xc1\xad\x88\x7d\xb8\xba\xf6\x87\x53\xfb\x66\xe0\xaa\xf0\x21\x87\x17\x9c\x68\x22\x2d\x26\x8e\x49\xe1\x16\x0f\xb2\x56\xca\x4c\x35\x23\x72\x37\xc6\x12\x6b\xc7\xe8\xf8\xec\xba\x7e\x27\xac\xcc\xec\xef\x17\x22\x34\x40\x5b\xc7\xf0\xca\xec\xbf\xa3\x27\xd1\x65\xd2\xa2\x59\x60\x44\xe7\x6d\x96\x8f\xa2\xf1\xb4\xe9\xb2\xd4\xb7\x38\xa2\xe2\xdb\xdb\x5a\xf1\x25\x32\xda\x53\x79\x05\x70\xd5\xef\xd9\xc6\x1f\x9b\x8b\x94\x3b\x41\x26\x5f\x6e\xb2\x2c\x21\x51\x5a\xae\x41\x7c\xe1\x7e\x3e\x7b\x29\x82\xe0\x6a\x60\xd9\x9d\x75\x4d\x2d\xdd\x9f\x55\x33\x64\xb9\x86\x20\xf1\xa8\x7e\x4f\x10\x24\x8c\x78\xcd\xdc\x58\xd5\x40\x64\xb8\x26\x5e\x6f\xdd\xc6\xca\xaf\xb9\x7d\x1c\x69\x81\x8a\x94\x0d\x5c\xdb\x56\xd5\x09\xf8\xa4\x28\x43\x8f\x3f\x91\x72\xb7\x5a\xdd\xba\x1d\xc1\xff\xaf\xd2\x65\x32\xde\x81\xfb\x1f\xbc\x9d\x19\xcb\xfe\x5b\xda\xc1\x07\x6e\xff\xca\x6e\x13\x03\xb7\xe0\x3e\x7c\x7b\x6a\x11\x81\x3a\x9e\x02\xaa\xa6\x8d\x1a\xf3\x15\x53\x32\xb5\x39\x79\x89\x64\xe0\x51\x68\x9d\xda\x02\xc2\x09\xc8\xfa\xfb\x4f\xda\x72\x4f\x03\xf6\x5b\x4d\xbc\xc9\xa7\x79\x96\xd3\x41\xf1\x1f\x8a\x6c\x49\xa4\xa4\x98\x37\x3f\x7f\x5d\x3e\x6f\x9a\x33\x15\xae\xc5\xd5\x9a\x53\x09\x8f\x5a\xbd\xaa\x9c\x41\x0d\x43\x9f\x3e\x65\x83\x13\x58\x9b\x9c\x3c\x0a\x2e\x0f\xbe\x65\xf3\xdb\xe7\x78\xb2\x07\x59\x00\x7f\x2e\x98\x95\x67\xba\x22\x68\x00\x59\xcb\xa0\x57\xb3\xb5\x4c\xb7\xe4\x5e\x23\x8f\xf2\x50\x78\x19\xc1\xd1\x1e\x0a\x7b\x52\xaf\x9a\xd3\x4b\xe2\x99\xf0\x4b\x37\x5f\xd5\x5d\x40\x8e\x67\xac\xb1\x47\x79\x36\x73\xd8\x63\x85\x30\x5c\xd2\xf5\x46\x9f\x32\xdc\xbf\x75\xb7\x5a\x3c\xd1\xfc\x84\xa6\x22\xe6\xee\x77\x2d\xd0\xf8\x55\xe0\xca\x25\xc9\x3a\x96\xef\xfb\xc8\x50\x3e\x58\xad\x6e\x62\x5d\x8c\x21\x5c\x97\xa0\x74\x1b\x3d\x51\xd0\xbb\xcb\x1d\xab\x82\x3d\xcc\x35\xb9\xda\xde\x6d\x3b\xaa\xe9\xa9\x5d\xf8\x4c\x17\xa0\x5f\xbe\x70\x8b\x8b\xb6\x93\xa8\xa0\xc7\xc2\xc7\xa6\x20\x50\x5e\x56\xaa\xcb\x62\x91\xd4\x5d\x84\xf5\xd9\xd7\x15\x4b\x18\xcb\x02\xf4\x7c\x4b\x14\xdc\xcb\x80\x03\x3c\x25\x0f\xe5\xcc\xc4\xe3\x6f\xf5\xd1\xe2\x11\x0a\x05\xea\x22\xb1\x67\xbc\xb1\xec\xdc\x2d\x26\x3c\x87\x38\xff\xa1\xef\x95\x87\xc6\xda\x53\x96\xe6\x44\x43\xef\x31\x63\xb7\xf2\xed\xc0\xa9\x02\x05\x90\x47\xb3\xc9\x97\xac\xc2\xea\xab\x2d\x39\x29\xb2\x45\x8e\xe6\xf7\x67\xf1\x5c\x7c\xd8\x83\xcf\x5f\x8d\xcf\xed\x68\x52\xc3\x33\xa9\xc0\x55\x51\xe5\xe2\x1a\x63\xb0\x3d\x96\x25\x8d\x66\x64\x8f\xcd\xd8\x93\x88\x46\x7b\x90\xfb\x90\xf1\x06\x2c\x57\x39\x41\xbd\xb5\xae\x74\x4f\x52\x7b\xff\xb9\xc8\x5b\x4e\x95\x7e\x4f\x24\x5d\xea\x89\xac\x68\x7e\x45\x03\x87\x11\x8d\xfe\xe3\x69\x64\x9b\x75\xe7\x92\x96\xbe\xcb\x93\x7a\xda\xaa\x99\x95\xc3\xb9\x6a\x4b\x03\xb9\x07\xd7\x1e\xdb\x8c\xb5\x60\x0d\x1a\x01\x7f\xb5\x10\x51\x01\x33\x4c\x43\xe2\xc6\xa7\x96\xde\x3e\xe4\xdb\x73\x57\x49\x54\x0e\xfd\x41\x8b\x2c\xcc\xec\xc0\xc9\x32\xbe\x5d\x28\x96\x4c\x6c\xb3\xb1\x38\x61\x63\x87\x09\x62\x92\x8a\x00\x20\xf9\xa9\xca\xb8\x34\x91\xb3\xe6\x02\x9e\x73\x35\xeb\x98\xf8\xe0\xb4\xbf\x6f\xb6\xc6\x9a\x5e\x45\x88\xb9\xd9\x3a\x4f\xc0\xb9\xfa\x78\x95\x5f\x9b\xf3\x4b\x25\xe6\xdf\x12\xab\xf8\xc1\x13\xac\x58\x59\xc1\xaf\x0c\x51\xfc\xe0\x8f\x51\x2c\x5d\xf2\x6e\x90\xe2\x87\xaa\x28\xc5\x4a\x04\x71\x4b\x69\x5d\x46\xe3\xd0\xcd\x56\xe0\x46\xc9\x58\x67\x14\x54\xd6\x57\xc6\x3b\xd5\x0f\x7c\xfe\xa0\x46\xdc\x13\xca\x5f\xb1\xa4\xf2\x2b\x2a\x28\x2a\x1a\x50\xb5\x9b\xc9\xb0\x37\x62\x33\xdc\x43\x62\xc0\x14\xb0\x98\x95\xf0\x04\x0c\x9e\xd4\xc4\xe2\x29\x4d\x54\xd6\xe7\x53\xaa\xe0\xb9\xbe\xa5\x96\x27\xb5\x64\x45\x35\x27\xd9\x38\x5a\xe2\x3d\xf5\x0b\x49\x5b\x9a\x96\xfb\x85\x12\x56\x56\x59\x70\x20\x12\xa3\xc9\x64\x74\x4f\x52\x2a\x85\x67\xa3\xcc\xd8\x08\xac\x30\xfc\x55\x72\x14\x51\xf5\x69\xba\xcb\x74\x5c\x0c\xb4\x11\xb8\x0e\x72\x12\x79\xe4\x67\xc5\x36\x7c\xd2\xce\x92\x89\x27\xa8\x57\xa5\xe3\x29\x13\xf2\xcd\x13\x08\xc8\x4f\xe9\x10\x6a\xa8\x5d\x8c\x48\xe7\xc5\x28\x65\xd5\x19\x06\xe5\x50\x68\x7f\x24\x8f\xa8\x87\x89\x1a\xb9\x2e\x56\xe6\x63\x6a\x58\x95\x2d\x9d\xf1\x89\x21\x5b\x32\x31\x64\xbe\x89\x21\xbb\x6e\xc6\x15\x11\x3e\x55\xbd\xf2\x9b\x4c\x11\xd5\xc5\xff\x9b\x98\x20\xaa\xd1\xfb\x5b\x4c\x0f\xd5\xb5\xd5\x9a\x1c\x0c\x5f\x8a\x2e\xc3\xd1\x5d\xe6\x04\xda\x78\xce\x31\xc8\x5b\xbe\x1d\x5f\xb9\xb9\xdb\x2b\x37\x6c\x65\xef\x01\x03\x9a\x22\xaa\xbb\xe4\xf8\xb0\xe7\xf6\x78\xde\x7a\x52\xcb\x9f\x14\x09\x50\xae\xda\x8b\xe5\x7a\x58\x87\xf0\x05\xc4\xb8\xa8\xfb\x02\xe2\xf5\xf5\x4a\xd7\x03\xb9\x8a\xaf\x35\xbf\x83\x43\x43\x67\x4f\x54\x05\x1d\x0b\x2f\x1d\x91\x96\x3c\xee\x46\x23\xa7\xb3\x43\xaa\xfa\xcc\x06\xad\x80\xc2\xce\x84\x3e\x0b\x62\x84\xbe\xfc\x4d\xfa\xa3\xee\x2c\xef\xcc\xb2\x9c\x09\x03\x27\xf8\xb9\x25\x42\x6a\x35\xcc\xf2\x27\xe1\x52\x9f\x37\x34\x67\xbe\x83\x5a\xee\xa0\x46\xae\xf2\x6b\x73\x51\xca\x5e\xf7\xad\x87\xe6\xaf\xd1\x1a\xca\xa1\x1b\xfc\xfa\xba\x9f\x32\x82\x4a\xd3\x82\x78\x8c\x09\x2f\x7e\x8c\x50\x4f\xa7\xd3\x1b\x32\xcb\xac\xc3\xb3\xbe\x41\x25\x51\x81\xc0\x76\xc9\x7f\xbb\xb9\xca\x29\xff\xdf\xd8\x64\xe5\xe0\xb7\x6a\xb6\x52\x07\x8e\xf9\x23\x56\x85\xbf\x4e\xa9\x52\xf4\x2a\xb7\x35\x14\x1d\x00\xdd\x73\x4a\x61\xe1\x5e\x8e\xaf\x15\x0b\xbc\x62\xc3\x62\xbd\xe3\x90\xb4\x90\x61\xe4\x54\xb2\x44\xd7\x21\x3e\x5d\x87\x5c\x1b\x3b\x96\xcd\xd3\x0a\x3a\xf5\x27\x69\x87\xca\xdf\x3e\x4b\xbb\x4b\x45\xff\x36\x67\xe7\xaa\x26\xff\xab\x4e\xcf\xfe\x03\x30\xff\xe3\x9e\x96\x9d\x8e\x78\xaa\xf5\xbd\x84\xfd\xec\x4f\xe0\xee\x58\xb2\xd7\x94\x33\x5b\x76\x64\xa5\x23\x59\x89\x87\x58\x97\x07\xf4\xef\x3a\xda\xb3\xa5\xa3\xbd\xa6\xf2\x51\x45\xf1\x15\xac\xaf\xb8\xde\x27\x59\xe3\x52\x25\xc9\xaf\xe2\xa5\xd2\x34\x36\xa4\x29\x1b\x07\x5f\x03\x07\xc4\xed\x27\x8c\x48\x7d\xe1\xe5\xb2\xbf\x31\xc9\xf3\xaa\xcd\x61\xb5\x35\x99\x8a\x39\x76\xb5\x80\xd5\xc3\xf9\x34\x2e\xfe\x2d\x2a\xfe\xf5\x3a\x94\x1f\xb9\xab\xdc\x88\x32\x5c\x81\xe8\x25\x29\x68\xdd\x83\xc2\xb2\x3b\x3c\x16\xed\xcb\x97\x72\x73\x9b\x96\xb7\x7d\x92\xdd\x79\x0b\x75\x76\x77\xb0\x6f\xe7\x8b\x65\x6b\xc4\xda\x29\xcb\x66\x51\x65\x56\x19\x76\xb1\xaa\xc2\x2c\x2d\x73\x1c\x66\xb3\x39\x92\xca\x5f\x69\x75\x09\x94\x14\xf4\x6d\x4e\xa2\xd9\x4d\xb2\xe4\xd4\xb8\x15\xf9\xb3\x82\x3e\xb1\x80\x13\xa4\xb4\x06\x0c\xfb\x22\x4e\xd7\xa3\xe4\x47\xf7\x44\xdf\x4e\x3d\xe1\x8b\x4b\x8d\x86\x71\x28\xc4\x07\x37\x09\xcf\x0f\xfc\xc0\x43\xac\xf9\x5a\x37\xaf\xa2\x5c\xe9\xb8\x6a\x24\xd9\x5d\x23\x80\xc6\x84\xdc\x2c\xf0\x21\x4e\x6f\x33\xf6\xff\x0f\x51\x9e\xb2\xff\xc7\xd3\x56\x1a\xd7\x32\x80\x8e\xc0\xfe\x0f\xf6\xf8\x4f\x08\x45\x65\xd0\x38\xee\x13\xc5\xc7\x43\xbc\x64\x0a\x1c\x47\x05\x29\x2b\xde\xab\xf8\x8a\x58\x54\x7d\xe4\xa8\x79\xbe\x82\x3c\xae\xd0\x38\xff\xa4\x8c\x6c\xb6\x66\x5c\xf3\x95\x91\x87\x6a\x11\x9d\x25\xb5\xcc\xf9\x4e\x74\x4b\xe9\x82\xb6\x00\xff\x7f\xf6\xde\x6e\xb9\x6d\x24\x59\x18\xbc\xd8\x8b\xbd\xdb\xcb\xbd\x99\x8b\x2d\xe3\x9c\x69\x93\x23\x92\x22\xf8\x4f\xda\xb2\x8f\x2c\xc9\xdd\x3a\x96\x6d\x8d\x24\xdb\x73\x46\x52\xfb\x83\x88\xa2\x88\x23\x10\xe0\x00\xa0\x7e\xba\xdb\x73\x22\xf6\x2d\xbe\x88\x7d\x82\xef\x1d\x36\xf6\x5d\xbe\x17\xd8\x57\xd8\xc8\xcc\x2a\xa0\x0a\x28\x80\x94\xed\x9e\xb3\x5f\xc4\xd7\x31\x23\xb3\x50\x59\x55\x59\x59\x59\x99\x59\x7f\x99\x6c\x87\xd5\x6a\xad\x56\x8b\xd7\x0d\xa4\xd2\x47\x34\xbb\x33\x05\xe3\xb9\xb5\xa3\x0f\xe6\x16\xbe\xfd\xe1\xad\x7f\x0f\xbd\xa0\x66\x31\x0b\x3b\x70\x81\x1e\xf3\x22\xe1\xa2\x52\x6b\x3b\x9b\x2e\x69\xe7\x72\xee\xfa\xce\xad\xeb\x28\x5c\x2d\x61\x5c\xf1\xc7\x5e\xe8\xfb\xce\x32\xe6\xee\x9a\x01\x26\xaa\x24\xdf\x4c\x15\x74\xde\x6a\x95\xd2\x85\xd6\xad\x1b\x53\x87\x67\xf4\xc8\x4f\x02\xc6\xac\x56\xc4\x97\xdc\x49\x6a\x5b\x5b\x85\xe9\x50\x49\x25\xa5\x47\x25\xdd\x6e\x21\xed\x0e\x02\x37\x6f\x02\xc9\xef\xa5\x05\x90\x08\xa6\xee\x27\xb5\xea\x4e\x34\x9b\x55\x9d\xf8\x52\x22\x25\x4f\x57\x5e\xa5\xaf\x27\xc5\x95\x40\xc1\xec\x4b\x3b\x95\x64\x15\x7e\x56\x0f\x5c\xa0\xee\x77\xba\x33\x96\x98\x27\xab\xa5\xae\xc9\x55\x97\x03\xe9\x95\xb4\x7c\x59\x58\x6d\x03\xde\x78\x3c\x5c\xe8\x80\xcc\x05\x40\xd7\x8b\x9d\x2b\x9f\x57\x00\x2b\x10\x0d\xba\xfd\x56\x01\x2c\x72\x15\x40\xf1\x70\xa5\x02\x18\x20\xa0\x40\x22\x7e\xa7\xdb\x0c\x82\x52\xcb\xd4\x9b\x4e\xa6\x23\xb2\x6b\xd1\xa4\x1f\x6b\xa6\xfa\x53\x68\xba\x48\x0f\xbc\x17\xad\xa6\x49\x18\x99\xf1\x69\x18\x91\x8c\x57\x57\x53\xdf\x89\x63\x2e\x5e\xe9\xa1\x6b\xdb\x0a\xee\x50\x0a\x50\x3f\x2a\x06\x60\x93\xc7\xd0\x72\xdd\x2f\x69\x21\x6f\x28\x64\x4f\x2c\x21\x47\xdc\x12\xc9\x5f\x52\x90\x96\x90\xd9\x2a\x90\x26\x41\xee\xc6\x80\xd6\x1c\x09\x1a\xe9\x19\x29\x1d\x22\xa2\x45\x54\x35\x4d\x72\xac\xf5\xff\xc3\x8e\x6a\xde\x31\xf6\x09\x5b\x17\x5b\x11\xaf\xb4\x5b\xb3\x95\xef\xc3\xb4\xaa\xec\x67\x36\x23\xd6\xd8\x66\x79\xc2\x6e\x50\x69\xe1\xd9\x57\x65\xbd\x38\x34\x55\xb5\x6a\x4b\x27\xf3\x2d\xec\xea\xbd\xdb\x84\x96\x1a\xb4\xf3\x44\xbb\xb9\xec\x25\x03\xeb\x99\x4d\x70\x43\x6a\xe3\xd6\x57\xcb\x0d\xed\xca\x7c\xd9\xe5\xa3\x2d\xcb\x42\x0d\x8f\xb7\x2d\x8d\x4c\x6c\xde\x70\x87\xc6\xf6\x40\x00\x28\x72\x1c\x06\x47\x88\x67\x29\xee\x25\x6b\x89\x38\x04\x52\x7e\x6f\x31\xeb\xdc\x12\x86\x8a\x75\x69\x29\xe5\x5f\x8b\xb6\x41\x1a\x46\xe5\x58\xaa\xab\xa6\xca\x05\x83\xd1\x8b\x75\xbe\x2d\x61\x15\x09\x0c\x1b\xec\x9c\x37\x18\x19\xe8\x27\xab\xa0\x35\xbd\xbf\x34\xed\x75\x99\xfd\x26\x68\x4e\x25\x75\xbc\x4f\x78\xbc\xf2\x13\xfc\x24\x17\x1d\xd2\xbb\x65\xce\x1c\x82\x61\xf4\x82\xc4\x0f\x6a\x16\x0e\x49\xe4\x78\x31\x77\x99\x13\x30\x7e\x3f\xe5\xcb\x24\x0d\x84\x82\x62\x9a\x9c\x6b\x8b\xe7\x51\xf4\xbb\xe0\xd8\xf2\xa5\x52\xa7\x00\x12\xf6\x21\x18\x42\x74\x5c\x9c\xcb\xaf\xe3\x03\x98\xa9\xc0\x14\xf0\xf8\x5c\xe3\xad\xd7\xbb\x87\x47\x07\xfb\xe8\xa3\xb6\xb0\xbf\x64\x1a\xa8\xc2\x72\xce\x78\x18\x91\x68\x2b\x4d\xc9\x4f\x62\x51\x09\xff\xca\x55\xc8\xbd\x74\x80\x97\xfa\x9d\x9b\x39\x9e\xbf\x8a\xa4\x1a\x12\xc6\x90\xa2\x98\xe4\x12\xd8\x89\x92\x7d\x72\xfa\x44\x1b\x5e\x64\x21\xae\x22\x07\xf1\xd2\xbe\x8a\x8b\x97\x34\x5e\x7a\xd6\xc2\xb9\x7f\x9d\x35\xd8\x4e\xdb\x0b\xbc\xa9\xba\xce\x82\xfe\xfc\x79\xc5\x57\xfc\xb3\xe6\x20\xce\x48\x1e\x85\x95\x77\x8f\x8e\x3e\x9f\x1d\x9c\x9e\x9d\x1a\x1c\xe1\x3d\x77\x7c\xbf\x09\xf5\xc6\x2f\xac\xfa\xda\x9a\x62\xf4\x18\xba\x46\x60\xe7\x50\x2d\x1e\x43\x6c\xd2\x86\xc1\x58\xac\xdc\xae\xc0\xd1\xc3\x96\xd3\xde\x8a\xbb\xdb\xe2\x9a\xad\xaa\x14\x6a\xe2\x41\x6f\xfb\x19\x8b\xb5\xe7\xb7\x71\xc9\xbd\xee\x00\x9f\xdf\xc6\xe6\x40\x45\xe9\x93\xb6\xb4\xe5\x7a\x7a\x4d\x38\x9d\x2f\x27\xfc\xfa\xe0\x7e\x69\x5c\x78\xd2\x93\x18\x7a\x5e\x15\xa4\xd2\xae\x5e\x2f\x79\xd8\xfa\x25\x73\x7f\x9b\x41\xb3\x27\xf8\x06\x5e\x96\x30\x39\x48\xc9\xc6\xae\x16\xd4\x1b\xcc\xdb\xda\x32\xee\xe4\xe6\xdd\xd9\x6f\x36\x5c\xc7\x4e\x92\xf0\x68\xc3\x6b\xf8\x38\x28\xca\x1e\xd3\x1a\xcb\x51\x3f\xc4\x4b\x57\x5e\x0a\x9f\x18\x0d\xd8\xac\x06\x3c\xcc\x31\x3e\x47\x49\x99\xc6\x0f\xaf\xc5\x73\xe4\x77\x21\x1a\x31\x31\x5b\x80\x3c\xe6\x2e\xa3\x76\xa0\x47\xd3\xc8\x4b\x78\xe4\x39\x99\x5b\xe0\x12\x5b\xb6\x48\xa5\x30\xf8\x10\x4c\x9d\xd5\xf5\x3c\x39\x90\xa2\xb6\x78\xa7\xd2\xe4\x7b\x01\x6f\xb5\xf3\xcc\x87\xbd\xac\x86\xf9\xde\xd5\x3a\x35\x40\x2e\x3b\xe9\x14\x4e\xa9\x82\xfc\x5d\x4f\x18\x8a\x33\x17\xc4\xb4\x20\x87\xf6\xa4\x3f\x75\x56\xa7\x8b\x2d\x74\xa4\xf5\x64\x87\x65\x88\xa4\x7e\xd0\x34\xc0\x16\xbf\x5f\xf2\x69\xc2\x5d\x7c\x25\x24\xdc\x01\x9b\xaf\x30\x61\x5c\x10\x77\x85\xa7\x1d\x25\x77\x84\x8b\xd5\x0b\xe7\xdf\xf2\xe5\x89\x9e\x79\x7c\xf0\x6e\xff\xf0\xdd\x8f\xa9\x2f\x68\xcb\x99\x25\x3c\x4a\x57\xe6\x30\xd8\x5c\x3e\x5e\x12\xbd\x48\x35\xa0\x88\x31\xb2\xc5\x2c\x32\x99\xa7\x4e\xcc\x27\x65\x9d\x04\x88\xcc\xce\x2d\x54\x4f\x17\x85\x59\x6a\x92\xd0\x15\xe2\xba\x49\x1f\xe4\x34\xa2\x01\x40\x51\x91\xf0\x67\x53\x35\x59\xb5\x39\x59\x75\x1f\x8d\xa7\x8e\xd5\x55\x6d\xc6\xef\x18\xaa\xbb\x66\x4e\xff\x29\x5d\x97\xbb\x0b\x35\x95\x1e\x5e\x30\x0b\x6b\x8a\x12\x95\x67\x67\x40\x66\xe2\xc3\x46\x46\x63\xa9\x7b\x55\x20\xf8\xa6\x01\x2d\xe2\xb3\xf0\x94\x4f\xc3\xc0\x8d\x3f\xeb\x78\xa6\x1e\xb9\xe2\xd5\x62\xe1\x44\xde\x2f\xe8\x01\x57\xdc\xf2\x0b\x03\x1c\x1a\x5d\xfd\x2a\x76\x42\x91\x48\x62\xe3\x5f\x8f\x04\x26\x2e\x04\xe6\xe9\xa9\x1f\xce\xa0\x13\xfe\xcd\x07\x87\xc6\x79\xcd\xc6\x31\x5b\x63\x7c\xca\x06\xb4\x6d\xe0\x5a\xea\xe2\xe0\x1e\xad\xaf\x98\x6c\xfa\x62\x76\x72\xbf\xfe\xf8\x95\x29\x9b\xf3\xd9\x34\x92\xd3\x5d\x99\x48\x5e\xc0\xd2\x26\x68\x06\xd5\x44\xc4\x16\xb4\x1b\xe9\xd7\x44\xf1\xa0\x96\xda\x3b\x6d\xa3\x66\x92\x37\x24\xe5\xc4\xe7\xad\xe3\xdd\xd3\xd3\x83\x7d\xe9\x2f\x4f\x99\x77\xd2\xa7\x3f\x1a\xa0\x29\xff\xbe\x60\xfd\x76\xe6\x00\x36\x13\xf9\xa7\x7e\x78\x47\x93\x3d\x09\xc3\x9b\x12\x16\xe3\x45\xfe\xca\x73\x7b\x8a\x1c\x8a\x6c\x9a\xaf\xf5\x1c\x4b\x67\x0e\xd3\x94\x19\x2e\x8d\x1d\x60\xc9\x67\xc6\xb7\x79\x65\x7d\xaf\x6b\x22\x52\x1b\x91\xf4\x0d\x06\x88\x6d\x29\xf3\x85\x6b\x72\x55\x6c\x65\x1e\x36\x52\xb2\x15\xc6\xc3\xe8\x70\x4d\xd9\xd7\x29\x79\xd2\x87\x70\xd1\x2a\x78\xc7\xef\x13\x92\x6b\x8f\x9e\x0e\x27\xdc\x3c\x21\x8c\xf6\xbe\xd2\xff\x23\xf4\x9f\x25\x8b\x82\xf1\x91\x6d\x4d\x94\x12\xa0\x70\x2e\x9c\x3d\x68\xa6\xce\x1a\xaf\xb9\x44\xa9\xbb\x15\x4d\xc2\x89\x33\xf3\x48\xf1\xe2\x94\xe7\x82\x8d\xa9\xa1\xd2\x70\x03\xff\xb2\x34\x70\xbf\xfd\x26\xfc\xbd\x28\xb6\xb8\x78\xd4\xa6\x71\x4d\x51\x8c\xd5\x0a\x9e\xf5\xf3\x8b\x14\x39\x89\xf2\xa2\xfa\xc5\x4e\x61\x41\x53\xca\xa2\x6f\x9d\x7b\x6f\xb1\x5a\x30\x51\x07\x9b\x86\xab\x20\x61\x11\x77\xc0\xec\x6a\x30\xe7\x2a\x8c\x12\x2f\xb8\xa6\x99\x19\xad\x82\x56\xaa\xc0\xd7\x60\x2c\xcf\x7e\x73\x9d\x47\x9f\x92\xd9\xf6\xb9\x66\xd6\xbc\x34\xda\x18\x38\x2d\x27\x34\x2d\xab\xc5\x2f\xb9\x55\x51\xec\x7d\xae\xee\x65\x50\xfc\x07\x55\xf0\x18\x95\x24\x7e\xac\xa9\x25\xb3\xbd\x0d\x19\x13\x8c\xdf\x69\x55\xd7\xf2\x82\x3f\x13\xf4\x9b\x49\xf2\x92\xb1\x49\xad\x54\x46\x86\x19\xc3\x4d\xa7\x47\x4a\x71\x11\x36\xa3\x74\xc8\xcc\x32\xa3\x44\xb9\xa9\x04\x4a\xd7\x48\x65\x2b\xeb\xc2\x46\x26\x65\x89\xad\xcc\x84\x1e\xd0\x9b\x75\xa6\xd8\x20\x33\x58\x60\xaa\x0e\x4d\xd2\x9d\xb4\x75\x80\xb9\x09\x28\x82\x0b\x7d\xcb\xd8\x18\x95\xad\x18\x26\x9c\x2b\x12\xb5\x8d\x86\xcb\xc8\x8d\xbf\xd3\x18\xea\xd3\x2b\x5a\x05\x1b\x12\xe2\xd1\x14\x88\x56\xc1\xb7\x5a\x1c\x1b\x09\xe5\x35\x97\x3f\x53\xcb\xd7\x3a\x59\x05\x01\x60\x97\xda\x16\x05\x99\x9c\xae\x38\x6a\x71\xdd\x32\xd9\xab\x62\x08\x8a\xeb\xc8\x62\x38\x08\x6d\x5b\x4a\x98\xec\x8d\x6f\xd1\xc6\xaa\x25\xb4\x7e\xab\xbe\xc6\x29\xd4\x62\xbd\x95\x84\xaf\xbd\x7b\xee\xd6\x3a\x75\x0a\xbb\xb8\xf1\xa6\x82\xb4\xdc\x37\x50\x76\x39\x15\x54\x2f\xbf\xff\x93\x83\x54\x6f\xff\x28\xcc\x45\x76\xdb\xa4\xb8\x1c\x39\xe7\x97\xb9\x05\x9f\xe9\xdc\x47\x1f\xd4\xa2\xb1\x79\x26\xf8\x93\x79\x81\x34\x4f\x26\x1b\xb2\x05\xbb\xe3\x11\x67\x41\x28\xb5\xa1\x99\x9a\xa9\x14\xdc\x60\xbf\x3d\x49\xb7\x52\xb9\x7a\x90\xaa\xec\xb4\x27\xb4\xd7\x17\x7d\xcd\x86\xa7\xb4\x86\x29\x25\x16\xe5\x22\xcf\xb8\x3d\xa0\x45\xdf\x34\xf7\x4b\x61\x12\xb9\xca\xdf\x61\xd6\x92\x07\xae\x17\x5c\x5b\xeb\x8a\xd0\xd8\x42\x09\xfa\xb5\xb6\x80\x58\x61\x40\x13\xb4\x5b\x62\x2e\xa0\xee\xba\xac\xdb\x19\x8d\xe4\xf8\xf0\xca\x7e\xaa\x55\xaa\xd7\x7b\x84\xaf\xd6\xb5\xe7\x5a\xd6\x06\x1b\x44\x0a\xe3\x45\x86\x0d\x0d\xda\xbd\x50\x97\x0e\x2a\x28\x7d\xdf\x70\xac\xd6\xcb\xca\x0a\x99\x85\x48\x45\xe2\x16\x56\x5d\x67\xae\x27\x3a\x77\xa5\x1b\x7a\x22\x04\xec\x67\xb2\xbd\x50\xd6\x6b\x67\x1f\xae\xe7\xd2\x4c\x22\x6a\x39\xec\x16\x1f\xbe\x39\x81\xab\x64\x61\x8c\x3a\xb6\x08\x23\x8e\x21\x65\x33\x03\xd4\xb0\x59\xa3\x6c\xcf\x54\x4e\x4c\xdd\xbf\x0d\x9f\x26\xea\x0c\x58\xc7\x3b\x65\xb3\xa6\x9a\x95\xb4\x1d\x20\x49\x97\xea\xd1\x48\x32\x30\xc3\x84\xce\xd1\x5c\x71\xde\xa6\x92\x18\xea\x70\x59\xb8\x4a\xbe\x37\xdd\xc4\xc0\x40\x57\xd6\x1e\x43\xc8\x7e\xfc\xf0\x03\xd3\x83\xec\xaa\xb9\xa9\x9d\x96\xf5\xda\xb0\xd7\x23\x32\x35\x75\x5b\x76\xac\x51\xc0\x59\xeb\xf9\xfa\x13\xfd\x2a\x72\x7f\xc5\xbe\x5c\x2a\x89\xd5\x29\x05\x2a\x37\xbf\xeb\x94\x6d\x76\x19\xc1\xb2\xd5\xb8\xe2\x88\x2a\x0d\x49\x2c\x55\xd6\x73\xd6\xc1\x79\xa8\x3a\x8b\x5a\x2b\xe3\xa8\xe5\x0d\xa9\xe9\x44\x91\xf3\x70\xf0\xe7\x0d\x08\xf9\x84\xd3\x3a\x38\xdb\xe4\xc6\x9b\x5c\x4f\x92\xbc\x8f\x7b\x89\x3c\xba\xc4\xcd\x2d\x93\xb5\x80\x59\xd5\xb7\x64\xb7\xb6\x22\x3a\xfd\xc1\x63\xfd\x27\x14\x93\xcd\x5c\x51\xba\xeb\xbe\x69\xa7\xe3\x98\x47\xc9\xc1\x9f\xd7\xaa\xf6\x34\xd7\x33\xd8\xd2\xf8\xb6\x80\x7c\xfb\x69\x21\xa0\x53\x1c\xf3\x87\x47\xf2\xcc\x26\x8b\xcf\xae\xc4\x5b\x5e\xeb\x40\x51\xe9\x68\xfe\x72\x77\xe1\xde\x5b\xc9\xe6\x7f\xba\xb7\xc0\x6c\xf6\x12\xaf\xd8\x81\x26\x52\xce\x99\x81\x5b\x95\x64\xc9\x7d\x2f\xba\x28\x83\x87\x75\xc8\x01\xa6\x40\x8d\xd2\x5c\x93\xfc\x45\x3c\x65\x76\x1c\x84\xbe\x9e\x00\xa1\x73\x8a\x2e\x8e\x57\x0e\x30\x36\xb9\x69\xb7\x0c\x8c\xc8\x9a\x25\x87\x10\xfd\xa7\x2b\xfb\x4e\x73\xe1\xcc\xcb\xf1\x7d\x1e\x1d\x85\x53\x9c\xd7\x9f\x6b\x76\x3d\x83\xc1\xb1\x04\x63\x10\x7a\x40\x1f\xb4\xd8\x27\x25\xcb\x96\x12\x2e\xda\x60\xe2\xb4\xb1\xa5\xf2\x10\xc5\x49\xda\xf7\xe4\x11\x7d\x4f\x69\xb5\x79\xcf\x53\xc6\xfb\x8a\xde\x16\xab\x7e\xec\xbe\xfa\xba\xd0\xe4\xc5\x7b\x8a\x72\xc2\x44\xc5\xf0\xe4\xe7\x18\x11\xfd\xb2\x85\x67\x8b\xb5\xed\xda\xf9\xcf\xdb\x97\x5b\x93\x0b\x77\xab\x0e\x7f\x2e\xea\x2f\xff\x79\xbb\x6c\x16\xb0\x97\x2c\x39\xb7\x2f\x81\xc8\x2f\x5f\xbe\xb4\x1e\x4f\x0a\xa1\x9c\x37\x39\xb8\x07\x91\x9f\xae\x5d\x37\x56\x71\x30\xcc\x15\xb5\x17\xd4\x84\x9c\x6c\xd2\x6a\xe0\x9b\x58\x09\x65\x07\x2a\xc5\xee\xd2\x25\xa2\x2a\x23\xc7\xd0\x0e\xd9\xfb\xb9\x76\x3e\x9c\xbd\x1e\xed\xf3\x69\xe8\xe6\x1e\x2c\x14\x6b\xbc\x7a\x48\x78\x7c\xc4\x67\x89\x76\xc5\xdd\xe5\xc7\xa1\x17\xa8\xdf\xfc\xf0\x8e\x47\xaf\xc2\x15\xde\xc1\x6d\x1b\x5b\xd2\xe2\xd9\xc0\x97\x4d\xcf\xd6\x2d\xab\x51\xf1\x80\xa3\xe4\xfc\x15\x9d\x8b\x46\x7b\xa1\xcb\x77\x93\x5a\x21\xc0\x4a\x7a\x10\xad\x77\xb2\xce\x3c\xf6\x7c\x87\xd9\x9d\x21\x30\x27\xdb\x92\xd5\x60\x15\x6c\xc2\xec\x71\x07\xf2\x31\xac\x01\x42\x76\x3a\xdd\xd4\x7a\x51\xc9\xe2\xb1\x26\x00\x37\x8a\x44\xb4\x4d\x04\xb3\x3b\x23\xa8\xbe\xd3\xe9\xe5\xaa\xef\x8e\x4b\xab\xef\x74\x7a\x86\xea\x3b\xa6\xea\x3b\xed\x1e\xd5\xdf\x6b\xe7\xea\xef\x0d\xcb\xeb\xef\xb5\x0d\xf5\x77\x4d\xf5\x0f\xfa\xfd\xee\x80\x1a\x18\xe5\x1a\xe8\xdb\x15\x0d\x8c\x0c\x0d\xf4\xcc\x1d\x18\x0f\xed\x7e\x07\x9b\xe8\xe7\x87\xa0\x5f\x3e\x04\x9d\xbe\x69\x08\xfa\xc6\x3e\x0c\xed\xf6\x68\x34\xe8\xa1\xee\xc5\x90\xa0\xff\xef\xff\xf3\x7f\x5b\x4a\x64\x66\xbb\x93\xeb\x9b\x3d\xb6\x4b\x6f\xbf\xe8\x6d\x36\x9b\x86\x99\x93\xc7\xf8\xf9\x73\x36\x00\x1d\x51\x43\xde\xe9\x8c\xea\x0d\x66\xe4\x50\x43\x8b\x2c\x1f\x8b\x2b\xad\xb5\xc4\xc1\x7c\xea\x8d\x44\x21\xc1\x6f\xbf\xb1\x7e\xbf\x33\x1e\x40\xd7\x42\x8c\xa8\x05\xbf\xfa\xc3\x6e\xaf\x0b\x79\x21\x58\x2b\xb6\xdd\xb3\x6d\x5b\xce\x0d\xa4\x10\x06\xf0\x7a\x4e\x2c\x20\x33\x48\xbf\xb5\x66\x51\xb8\xd8\x13\x93\xb0\x16\xa2\x7f\xd9\x90\x35\x05\xbb\x34\xca\x41\x09\x8d\x2d\x80\x7e\xf1\xe2\x05\xb3\xdb\xec\x07\x66\xb7\x3b\xdd\x7a\x83\xf5\x07\xdd\x4e\x1b\xb2\x20\xcd\x7e\x60\x61\x3d\xef\xa7\x8f\x15\xdf\x9c\x48\xff\x91\x29\xce\x06\x9e\x68\x37\x58\xd4\x6c\x56\xdd\x1f\x52\x6e\xc6\x90\xf8\x02\xd1\xb6\xc1\xd6\x21\xad\x15\x14\x39\x58\x17\xe5\x35\x3d\xc4\x83\x0d\xaa\xfc\x47\x48\x45\xa2\xb0\xc6\xe8\xc8\x03\x14\xb9\x1c\x86\xae\xab\x4c\x8a\x94\x63\x14\xe8\x41\xd7\x1e\xcb\xfb\x62\x5b\xcc\x56\x70\xab\x74\x17\xab\xa3\x06\x25\x4d\xe1\x11\x52\xf4\xf2\x2c\xfa\x92\x2e\xc6\x10\x1f\x6e\xb1\x9a\xe4\x10\xaf\x0e\x33\xcb\x6e\xd7\x35\xae\x69\x10\x95\x26\x59\xa7\xcc\x57\xd2\xd2\x2e\x17\xc9\x19\x1b\x88\x27\xb5\x47\xbd\xa0\x3c\x9e\x29\x61\x8d\x48\x6c\xb5\x85\xec\x2d\x9b\x05\xa0\x6b\x7e\x63\x1e\x4e\x81\x81\xb8\xde\x67\x13\xca\xcf\x09\xab\x7e\x65\x79\x50\x26\xb2\xbc\xdd\x11\x15\xa0\x08\xad\x28\xd3\x6b\x67\x65\x46\xa2\x4c\xb7\xfe\x8c\xc5\xec\x05\x6b\x3f\xab\xb3\x18\x45\x59\x29\xca\x9d\x51\x86\x32\xfb\x13\x8b\xd9\x0f\x6c\xd0\xad\x74\x8e\xab\xcc\xaa\xbb\x29\x3d\xec\x80\x5f\xad\x60\xe5\x7f\xf2\xdc\x64\x8e\x53\x13\xbf\x4c\xc3\x20\x89\xc2\xfc\xd7\x88\x5f\x3b\x91\xbb\xf7\xef\x37\xbb\x8b\x2b\xef\x7a\x15\xe2\x9e\xc0\x13\x5b\x14\x51\x3e\xcb\x72\x1d\x59\xdb\xe2\xca\x0b\x68\xdb\xf1\xfc\x7c\x38\x18\x35\xd8\x68\x38\xbe\x6c\xb0\x73\xdb\xee\xf7\x1b\xcc\xb6\xfb\x23\x4a\x0d\xda\x90\x1a\xd8\x98\xea\x75\x20\xaf\x37\x20\xc8\xde\xd0\x86\xd4\x50\xe4\x0d\xbb\x98\xea\x89\xd4\x00\x53\x43\x91\x1a\x63\x8a\xca\xa1\xf8\xb3\xfb\x5d\x91\x02\x25\x65\xf7\xfb\x04\x39\xb0\xa1\xce\x41\xb7\x4d\x29\xd0\x91\xf0\x17\x53\xc3\x3e\xe0\x32\x1c\x50\x0b\xc3\xc1\x10\x53\x22\x6f\x88\x79\xc3\x2e\xa6\x46\x6d\xc8\x1b\xb5\x87\x22\x35\xc6\x14\xb5\x37\x02\xc5\x6e\x8f\x06\x03\x4c\x8d\xfb\xd0\xc2\x98\x6a\xe9\xb4\x3b\xc3\x06\xeb\xb4\xbb\x7d\x4c\x75\xdb\xfd\x06\xeb\x74\xdb\x03\x4a\x0d\x7a\x90\xa2\xd6\x3b\xdd\xc1\x18\x52\x43\x91\x37\xb2\x21\x35\xb2\x45\x0a\xcb\x8d\xa8\xce\x5e\xbb\xd3\x00\x6b\xa2\x4b\xa9\x6e\x17\x52\x5d\x91\x1a\x63\xde\xb8\x23\x52\xd0\x7a\xbf\x8d\x7d\xef\xf4\x01\x6b\xf8\x4b\xa9\x6e\x1b\x52\x5d\x6a\xa1\x3f\x80\xf6\xfa\x03\x2a\x37\xe8\x40\xde\xa0\xd3\x16\xa9\x3e\xa6\x08\xb3\x41\x17\x20\x07\x5d\x01\xd9\xc5\xbc\xee\x90\x52\x43\x68\x7d\x40\x34\xeb\x0c\x46\xd0\xde\x60\x4c\xb5\x0c\x81\xf2\xf0\x97\x52\x7d\xc0\x7a\x48\x63\xd4\x19\xf6\x01\x72\x38\x10\x90\x83\x3e\xa6\x88\x66\xc3\xd1\x00\x52\x23\x82\x1c\xd9\xd0\xa3\x91\x2d\x52\xc0\x13\xf0\x57\xa4\xc6\x98\xa2\xfe\x8d\x90\x82\xa3\x11\xe1\x32\x1a\x43\x7b\xa3\x31\xa5\xc6\x48\xc1\x71\x9b\xfa\x30\xee\x41\x2d\xe3\x1e\xd6\xd2\x6d\xb7\x47\x0d\x06\x7f\x29\xd5\xb1\x21\xd5\x41\x2a\x75\xed\x6e\xaf\xc1\xba\x76\x97\x20\xed\x5e\x07\x52\xbd\x9e\x48\x0d\x30\x35\xa6\x54\x7f\x08\x29\xe2\xf9\x6e\x07\x78\x1e\xfe\x8a\x54\x17\x53\x5d\x4a\x0d\x31\x6f\x28\xf2\xa0\x47\xdd\x0e\x71\x79\xb7\x33\x06\x5c\x3a\x63\xaa\xb3\x0b\x7d\xe8\x76\xc7\x48\x97\x6e\x0f\x78\x09\xfe\x62\x0a\x47\x13\xfe\x8a\x14\x94\xeb\xf7\x44\x0a\xf1\xec\xf7\xb0\xb7\xdd\x01\xf0\x0b\xfc\x15\x29\x68\x6f\x20\xf3\x60\xa6\x76\x07\xc4\x05\xdd\x21\xf0\x04\xfc\x15\xa9\x1e\xa6\x08\x97\xe1\x10\xf3\x86\x02\x72\x84\x79\x23\xca\x1b\x21\xe4\x88\xc6\xaf\x8b\x94\xef\x0a\xca\x77\x47\xe3\x3e\xa6\x64\xde\x10\x53\xd4\xdb\x31\xf0\x44\x77\x4c\xf3\xa8\x3b\x06\x11\xd2\x1d\x8b\x16\xc6\xc3\x1e\xa6\xa8\xdc\x18\xdb\x1b\x8f\x09\xb3\x31\xb4\xd0\x6b\x77\x90\xd6\xbd\x36\xf4\x1d\xfe\x62\xca\xee\xd9\x0d\xd6\x13\x63\xd4\xc3\x31\x82\xbf\x94\x82\xd9\xdf\xb3\xfb\xb6\x48\x75\x31\xd5\xa5\x14\xb4\xd0\xb3\x47\xd8\x5e\xaf\x07\xbc\xdb\x1b\xd0\xec\xef\x8d\x81\x5b\xe1\x2f\xa4\xfa\xe3\xf6\xa0\xc1\xfa\x63\xe2\x97\xfe\x18\x5a\xef\x8f\x89\xf2\xfd\x31\x8c\x6d\x7f\x4c\xf2\x6c\xd0\x06\xae\x1b\xb4\x69\xde\x0e\xda\xd0\xbf\x41\x9b\xe8\x39\x68\x03\x3d\x07\x6d\x1a\xf7\x41\x1b\x78\x1e\xfe\x8a\xd4\x18\x52\xc4\x05\x03\x1b\x66\x31\xfc\xa5\x14\x8c\xd8\xc0\xa6\x79\x34\xe8\xda\xdd\x06\x83\xbf\x98\xea\x75\xa1\xbd\x5e\xb7\x27\x52\x50\xae\x47\x98\x0d\x7a\xd0\x77\xf8\x2b\x52\x43\x4c\x51\x9d\x03\x98\x47\x83\xc1\x88\xf2\xc6\x36\xd4\x32\xb6\xfb\x94\x82\xb1\x85\xbf\x22\x05\x78\x8e\x87\x02\x72\x88\x90\x34\x62\x83\xf1\x70\x84\x29\xa4\xcb\xb0\x6d\x8f\x1b\x6c\xd8\xee\x20\x9e\xc3\x81\x3d\x68\xb0\xa1\x90\x28\xc3\x01\x40\x0e\x07\x34\x6f\x47\x9d\x76\xb7\xc1\x46\x1d\xa2\xf5\xa8\x03\x33\x6e\xd4\xa1\xd1\x1c\x75\x46\xa0\x56\x3a\x34\xee\x23\x9c\x1d\xa3\x2e\xd1\x73\xd4\x6b\xb7\x1b\x6c\xd4\x23\x79\x66\x77\xba\x30\x21\xe0\x9f\x3e\xa5\x7b\xc0\x08\xf0\x0f\x62\xd7\xeb\xb6\x6d\x18\x60\xf8\x47\xa6\xc7\x94\x1e\x8b\x74\xaf\x8f\x69\xe2\x95\x41\xaf\x83\x43\x02\xff\x60\xba\xdf\x86\xd5\xe2\xa0\xdf\x26\x9d\x33\xe8\xb7\xfb\x03\x4c\x0b\x1a\xf6\x3b\x48\x44\xf8\x87\xd2\xfd\xce\x98\xa2\x29\x12\x2f\x8c\xda\xc0\xfa\xf0\x0f\xe5\x8f\xec\x36\x30\xc0\xc8\x26\x99\x04\x3f\x46\x98\xb6\x05\xbc\x0d\x4a\x0d\xfe\xe9\xc9\xf4\x98\xd2\x42\xc7\x8e\xed\x1e\x68\x4b\xf8\xb7\x2f\xbf\x90\xde\x1d\xdb\x34\x3e\xf8\x4b\xc0\x48\xcd\x3c\xee\xd8\xa8\x8d\xc7\x1d\xe2\x19\xdb\x1e\x77\x07\x1d\xfc\x22\xf4\xd2\x18\x94\x64\xbf\xc1\xe8\x5f\xf9\x05\xa4\xc6\xd8\x1e\x0e\xa8\x37\x63\x7b\x38\x04\x09\x07\xf5\x8e\xc7\x97\x97\x64\x11\x38\x8a\x01\x71\x0e\x7a\x18\x14\x2f\x8d\x0e\x30\x91\x2d\xb4\x2e\x2a\x5d\xa9\x73\x51\xe5\x12\xb6\x30\xe1\xed\xa1\xd4\xcc\xa8\x6e\xf1\xf7\xa8\x83\xca\x96\x7e\xa3\xaa\xa5\x3a\xc7\xf8\x5b\x68\x5d\x50\x35\x34\x23\x3b\x36\x28\x13\x9b\x34\x44\x07\xc4\x7e\x47\xea\x62\x54\xc5\xf4\x1b\xbe\x77\xa5\x1e\x46\x35\x4c\xfa\xb3\x8d\x8a\x96\x7e\xa3\x62\x15\x5a\x96\xd4\x2a\x69\x4e\xf8\xde\x27\x4d\xd2\x07\x85\x4e\x23\xd4\xe9\x23\x8c\xd0\x6f\xa8\xec\xba\x42\xd7\xa1\xe2\x23\xad\x84\x4a\x49\xe8\x24\x28\x4b\x72\xb1\x33\x06\x45\x26\xa4\x3e\x8a\x79\x9a\x0d\x5d\x1b\x15\x0e\x49\x44\xe0\xd7\x6e\x87\x7e\x03\x2f\x76\x3b\xa4\x87\x3a\x20\x36\x69\x5c\xba\x28\xea\x85\xa4\x47\x95\x40\xdc\xda\xed\xa3\x7a\x18\x0b\x0d\x80\xea\x00\xb9\x1e\x86\xbe\x47\xd2\xbf\x07\x63\xd4\xa3\x31\xea\xc1\x34\xef\x91\x5c\xee\x0d\x50\x14\x92\x74\x85\xf1\xea\xd1\x78\xf5\x60\xf2\xf7\x68\xee\xf7\x60\xec\x84\xdd\x86\x66\x5b\x8f\x34\x74\x1f\x04\x75\x7f\x2c\xe4\x1f\x09\x35\x92\x0e\x20\x00\x68\xbc\x86\x60\xae\x0d\x89\xeb\x87\x20\xce\x86\x24\x79\x86\xa0\xf8\x87\xa4\xf7\x87\x60\x9e\x0c\xc9\x3a\x19\x42\x7f\x87\xd4\xdf\x21\xf4\x77\x48\xfd\x1d\x82\x61\x32\xec\x0a\x8e\xed\x36\xd8\xb8\x83\x6d\x8d\xc1\x7c\x19\xd3\xf8\x8e\x61\x8e\x8f\x89\x27\xc7\x40\x87\xb1\xb0\x43\xdb\x68\x95\xb6\x89\x53\xec\x36\xda\x77\x76\x5b\xcc\x90\x36\xce\x2a\x9a\x0b\x23\x64\xb5\x91\xe0\xb5\x51\x07\x90\x1f\x75\x48\xa6\x8c\x3a\x20\xe5\x46\x1d\x5b\x48\x32\x40\x7a\xd4\xe9\x08\xd9\xd5\x41\xb9\x46\x12\x70\xd4\xe9\x60\x2d\x5d\x91\xd7\x43\x48\xe2\xbb\x11\x32\xde\x48\x70\xde\xa8\x03\x28\xc3\x5f\x4a\xf5\xb1\xbd\xbe\x28\xd7\xc7\x3a\x89\xff\x46\xdd\x36\x49\x47\xc2\x0c\xd9\x05\xfe\x52\x0a\xac\x9a\x91\xe0\x98\x11\xda\xa1\x23\x31\xdf\x47\x3d\xac\xb3\x27\xea\xec\x81\x56\x84\xbf\x22\x35\xc4\x14\xf5\xa8\x37\xc0\xd4\x40\xa4\x86\x28\x7f\x89\x1b\x46\xbd\x11\xd6\x32\xea\x88\xd4\x00\x53\xd4\xdb\xde\x18\xf3\x84\x14\xef\xc3\x80\x8c\xfa\x64\x4f\x8e\xfa\xa0\xa5\x46\xc2\x56\x19\xf5\x7b\x80\x59\xbf\x4f\xbd\xed\x83\x8c\x19\xf5\x69\x8c\x46\x7d\xb0\x74\x47\x83\x36\xd5\x32\xe8\x62\x8a\xc6\x7e\x34\x00\x06\x87\xbf\x94\xc2\x72\x03\xb2\xbe\x46\xa8\xdd\x46\x52\xdb\x0c\xdb\xd0\xc2\xb0\xdd\x13\xa9\x01\xa6\xa8\x47\xc8\x8a\xa3\x21\xcd\xb7\xd1\x10\x04\xc9\x48\x70\xe3\x68\x88\xf4\x1c\x0a\x7a\x0e\x91\x9e\x43\x31\xb6\xc3\x0e\x42\x76\x04\x24\x08\x1a\xf8\x2b\x52\x5d\x4c\x0d\x44\x0a\x6b\xe9\x8a\x5a\x7a\x58\x4b\x4f\xd4\xd2\x43\x48\xb2\x97\x47\xb8\x52\x81\xbf\x22\x85\x78\xf6\x45\x39\x1c\x3f\x61\x2f\x8f\x86\x43\xcc\x1b\x8a\x16\x46\x58\x6e\x24\xca\xe1\x38\x0c\xc5\x38\x8c\x50\x63\x8e\x04\x05\x47\x48\x09\xb1\xc2\x19\x8d\x6c\xcc\xb3\x45\x9e\x8d\x79\xa2\xef\x23\xd4\xc9\xa3\xae\x4c\x8d\x30\x45\xb8\x8c\x90\x5f\x46\x82\x5f\x46\xc8\x2f\x23\xc1\x2f\x23\x58\xe1\xc0\x5f\x4a\x81\x25\x38\x12\x96\xe0\x08\xad\x85\x91\xb0\x16\xc6\x28\xe8\xc6\x3d\xb2\xa5\xc7\xc8\x91\xe3\x7e\x8f\xe6\x2c\xae\xee\xc6\x7d\x5a\x0f\x8c\x07\xd0\x87\xf1\x80\x30\x1b\x0f\xec\x11\xa4\x3a\x72\x46\x03\xa4\xe0\x89\x31\xae\x54\xc6\x83\x9e\xc8\xeb\x63\xb9\xbe\x4c\xf5\x30\x25\x6a\x41\x5d\x36\x90\x72\x61\x80\x79\x03\x91\x37\xc4\x3c\x92\x73\xe3\xc1\x10\xeb\x1c\xca\x3c\x6c\x7d\x24\xca\x81\x42\x1a\x0f\x89\xba\x63\xe4\xa5\xb1\x90\x6b\x63\xe4\x82\xf1\x90\x34\xce\x78\xd8\x1d\x62\x8a\x7a\x34\x84\xf9\x3e\x1e\xd2\x7c\x1f\xe3\xaa\x69\x3c\x14\x94\xc0\x71\x87\xbf\x22\x85\x79\xc4\xe5\xe3\xe1\x18\xcb\xd1\xca\x6f\x3c\x04\x75\x02\x7f\x31\x35\x02\x69\x33\x1e\x11\x47\x8e\x47\xb0\x26\x1d\x8f\x84\x3c\x1c\xa1\x40\x1c\x09\x5c\x46\xa8\xd9\x47\x02\x97\x11\xf0\xe7\x58\x8c\xad\xdd\x46\x9b\x08\xff\xa1\xb4\x0d\x06\xad\xdd\x16\x36\x4a\x7f\xd8\x85\xe9\x3a\xe8\x0a\xdc\x71\x9f\x87\x6c\x9e\xae\xa8\x8d\x24\x69\xbb\x37\xea\x8b\x65\x35\xfe\x04\xe5\x6e\xdb\x3d\x30\x69\x85\xe9\xe0\xc5\xa7\x4b\x0a\xc1\x59\xe9\xce\xb1\xa1\xc6\x62\xd5\x77\x21\x54\xcf\x7b\xf9\x03\xde\xe7\x39\xd8\xf3\xf6\xe5\x79\xfb\x92\xa2\xd5\xbd\xc8\xe7\x85\x97\xe7\x76\xc9\xe9\xbc\x08\xa9\x1d\xb2\x17\x3b\x2c\x7a\x26\xa3\x06\xed\xb0\xb7\x4e\x32\x6f\xcd\xfc\x30\x8c\x6a\xb8\xef\x16\xd6\xd9\x36\xeb\xa0\xd7\xc5\x42\xf5\x89\xa8\x5e\xc4\xcd\xb6\xcb\xdf\x76\x3c\x31\x61\x9e\x00\xe6\x25\xcf\xf3\x18\xee\x4b\x43\xbd\x4d\x56\x7c\x0b\xa6\x6c\x19\xc9\x97\x62\x5f\x24\xf1\x73\xbb\x3f\x8f\x1c\x83\xd4\xee\xdb\x60\x0c\x52\x58\xc3\x18\x64\x79\xbf\xcb\x18\x64\xd5\x7f\xd5\x18\x68\xc5\xbf\xfb\x18\x4c\xe7\x4e\x24\x37\xd8\xd6\xec\x87\x97\xed\xda\xbd\x64\x7a\x4d\x27\x08\x92\xe6\xd7\xd0\x55\xbb\x0e\xb2\xef\xc5\x51\x01\xca\x8c\x57\x11\x74\x3d\xa6\x14\x03\x86\x4b\xcc\xd2\x1d\xc9\x09\xde\xbf\xed\x76\xc4\xe8\xd3\x71\x21\xbe\x22\x7d\xce\xec\x41\x3b\xed\x89\xba\x5f\x49\x65\xe8\x5c\xd1\x96\x1d\x11\x62\x03\x5a\x7f\xc9\xda\x6c\xc2\x6c\xbc\xdf\x0d\x55\xf6\xba\xfd\x0e\x85\x1b\x63\xcf\x77\x58\xaf\xd7\x1b\x42\x6b\xe3\x76\xdb\xc6\xa7\x58\x22\xd1\x49\x13\x84\x87\x3d\x6e\xf7\x04\x26\x3b\xac\xd7\xb1\xc7\x36\xa4\xec\x4e\xb7\x4f\x2f\x66\x53\xc8\x5e\xaf\xdd\xed\xa4\x90\xfd\x7e\xa7\xdd\x4d\xf3\x40\x20\x66\xb5\x0c\x7a\x9d\x7e\x3f\xcb\xeb\xb7\x7b\xed\x2c\xaf\xdf\xee\x8d\xd5\xbc\x61\x47\xc9\xb3\xbb\x6a\xb9\xce\x48\x2d\xd7\x1d\x0e\x94\xbc\xbe\x82\xf5\xa0\xdf\xb7\xdb\x59\x8f\xba\xb6\x5a\xa9\x0d\xba\x2e\xab\x15\x93\xa3\x34\xb7\x33\xe8\xd8\x3d\xed\xce\x5d\x05\x3f\x6d\xc8\xa8\xba\x70\xa1\x91\x2a\xd9\x5a\x7e\x3c\x77\xc6\xc9\xda\x49\x93\x9d\xf8\x68\x82\x4b\x04\x14\x48\xcf\x7c\x8c\xe7\x3d\x31\x1d\xaa\xc8\xa3\xc0\xdd\xc4\xec\x2d\xbd\x96\xa4\xda\x48\xa2\x5e\x8b\xeb\x75\xf6\x9c\xb5\x53\x21\xd1\xb4\xf3\x77\x38\xf0\xd1\x3a\x20\xb3\xb5\xc3\x62\xf5\x40\x02\xb8\xbb\x53\x25\x3a\x22\x9d\x06\x18\xa6\x6c\x23\x27\xa9\x61\x83\xc5\x0d\x16\xe4\xa5\x69\x48\x64\x09\x8a\x64\xa1\x4b\x51\xb5\x00\x70\xcc\x75\x50\x3b\x6c\x0a\xeb\xf5\x3a\x7b\xc1\x92\xba\x08\xc5\x90\x93\xfe\x22\x20\x14\xf9\x23\x30\x79\xe6\x04\x42\x87\x29\x06\x71\x0e\x83\x00\xa8\x13\x3d\x63\x6b\xd1\x40\x5f\x9c\x71\x0e\x01\x85\x70\x01\x7b\x21\x5c\xa3\x36\x9b\xf4\xe6\xf5\x8a\xee\x9e\x51\xf0\xf5\x2a\x9a\x4b\xe0\xd4\xf1\xaa\x46\xfd\xfc\xe5\x6b\xd3\x00\xa8\x93\x42\xd4\x25\xc0\x58\x53\x09\x6f\xa2\x47\x3c\xab\x59\xbe\x77\xe5\x86\xc9\x36\x05\x6a\xf5\xc3\xeb\xed\x5b\x1e\xc5\x5e\x88\x9e\x0f\x13\x7e\x9f\x6c\x2f\x7d\xc7\xc3\x94\xdd\xb2\x07\xe8\xaf\x63\x4d\x05\xae\x93\xf0\x62\xe9\x4e\xdb\x1e\x36\xdb\xa3\xa6\xac\x23\x71\xf9\x12\x0b\x4b\x27\xa2\x96\x64\xfd\x82\x73\xdc\xb0\x45\x0f\x53\xce\xc8\x8f\x98\x08\x4e\xd8\xfa\x25\x0c\x17\x9f\x9c\x08\xcc\x96\xec\x2a\xb3\xf5\xd7\xf7\xef\xdf\x02\x27\xd8\xed\xf6\x1f\x2d\x00\x13\x51\xe3\xc2\xe5\x83\x02\xf5\xdf\xff\xaf\xff\x13\x33\x5d\x1e\xdf\x24\xe1\xf2\x1d\xc0\x78\x74\x5b\xeb\xcc\x4b\x7c\x82\xf9\xaf\xff\x8d\xfd\xb1\x96\x40\xb2\xce\xfe\xfb\x7f\xfd\x6f\x58\x20\xe1\x71\xb2\xcf\x97\xb8\x05\x65\xcd\x13\x1e\x2d\x5a\xa7\xd3\x28\xf4\xfd\xe3\x30\xa2\xcb\xa5\x31\xf4\x36\xcd\xe1\x3c\xc8\x7f\x3d\xe3\xd1\xc2\x0b\x1c\x3f\xff\xfd\xe3\x99\xe1\xcb\x9e\x13\x04\xdc\xa5\xef\x97\x14\x76\xec\xda\x8b\x13\x1e\x1d\x06\x5e\x52\x23\x38\xab\xb1\x91\x7f\xbb\xc2\xd4\xc8\xd1\x35\xc1\x38\x63\x8d\x12\x2f\xde\x2c\x7f\xb7\x31\x94\xf1\x6d\x65\xfc\xb1\xdf\x7e\x63\x35\xf1\x82\x88\x02\x73\xe1\x05\x68\x2d\x44\x57\xf1\x0b\x46\x72\x64\x2f\x8b\xb5\xc9\x17\x75\x7a\xc8\x9f\x9a\xa1\x34\x98\x1d\x6b\x8b\xa3\x93\x7f\xd3\x53\xdb\x27\x79\x53\xb2\xac\x0b\xd1\x2a\x48\x3c\xd3\x97\xd6\x35\x4f\xde\x3a\x81\x37\xe3\x71\x91\xc6\x52\xcc\x97\x17\xa9\x15\x6e\xdf\x61\xf0\x52\x67\xb9\x44\x49\x02\x3f\x5a\x57\xce\xf4\xe6\x3a\x0a\x57\x81\x6b\x96\x21\xec\x65\xa9\x37\x6c\x11\x97\xd0\x5a\x86\xcb\xd5\xd2\xfa\x82\x0e\x9c\xd9\x84\x95\xf5\x32\x71\xae\xc0\xd2\x53\x52\x78\x9f\x91\x1e\xd1\x29\xb7\x6e\x8b\x82\x16\x05\x9f\x5e\x6d\x5a\x0f\x7d\xa6\x68\xb5\x91\x48\x1d\xba\x32\xce\x68\x42\x17\x4f\x74\x6e\xb4\x82\x30\x5a\x38\xbe\x55\xe4\x47\xcd\xed\x63\xce\x45\x82\xa9\xe7\xa2\xa2\x2f\xaa\x5b\xf0\x3a\xcc\x65\xe8\x98\xef\xf1\x20\x39\xcd\xbf\xfa\x32\x9a\x1b\x38\x6a\x78\x13\xc7\x0b\xae\xa9\xe0\x09\x9f\xaa\xa1\x85\x95\x2a\x37\xb4\xb5\x4b\xeb\x6c\xdd\x41\x05\xa6\x9a\x7f\xe2\xde\xf5\x7c\x03\xe7\x75\xe5\x55\xcf\xb1\x06\xa5\xee\x69\xb8\x7c\x38\x95\x2e\x67\xce\xc2\x3d\xdf\x5b\x5e\x85\x4e\xe4\x3e\xee\xde\x2a\x6f\xf1\x7b\x3e\xdd\x0b\x17\x0b\x27\x70\x6b\x16\x54\x6a\x6d\xec\x59\x1f\xf1\x58\x3a\x71\xc2\x5f\x47\xe1\xe2\x2b\x31\x48\x7b\xae\x21\x82\xb5\x6e\x80\x09\x2b\x2e\x9e\xf2\x08\x92\x2e\xd9\x20\x28\x84\xb8\xdf\xbc\xf3\x82\xa5\xb6\x09\x2d\x54\x38\x9b\x30\xf5\xce\x97\x96\x0d\xb6\xc8\xca\xf7\x45\x0a\xe4\x29\x05\x99\x36\xc9\xad\x5f\xaf\x42\xf7\x61\xc2\x78\x0b\xfe\x6d\x30\x6f\x8a\x11\x9a\x6b\xbc\x05\xbf\x4a\xe2\x53\x0a\x7d\xb1\xed\x2d\x9c\x6b\x1e\x6f\x03\x64\x73\x3c\xb0\xea\xf5\x2f\xb9\xf0\x5c\xf8\x80\xae\xc6\x5b\xa8\xfc\xd2\x77\xa1\x6e\x38\xc5\xdb\xb4\xf4\x59\x73\x92\x9e\x46\x13\x16\x1a\xa9\x2e\xa3\xca\xce\x5a\x11\x5f\xfa\xce\x94\x7f\x74\xa2\xb8\x56\xae\x70\x61\xba\xc2\xbf\x13\x16\x17\xba\x2b\x5f\x9b\xa8\x85\x6a\x31\xd8\x3f\xc5\x97\x0d\x41\x2b\x0c\xa6\xbe\x37\xbd\x29\xbf\x35\x0b\xff\x89\x1e\xcd\xc2\xe9\x2a\x4e\x5f\x20\x4f\xfd\x30\xe6\xda\xf3\xe0\x06\x0b\x94\xd1\x2f\x8a\x09\xa3\x47\x88\x3b\x31\xf5\xe5\xbb\x97\xb9\x9c\xb0\x49\xae\x2e\xed\x79\x51\xfc\xfb\x55\x3e\xf5\xc3\xa0\xe2\x55\xab\xe2\x88\x9c\x8a\xd6\xb2\x96\xb4\x56\xea\xe5\x4d\x80\x0c\x0e\xd7\x5d\xf3\x4e\x71\x37\xd4\x8d\x9f\x0b\x82\x29\xd7\x0a\xff\xdb\xca\xf1\x37\x58\x18\xaa\xad\xa5\xcd\xa5\x77\xbf\x65\x83\x9b\xb4\xb8\xf9\xd3\xc7\x73\x61\xf2\x79\xbf\xa8\x4f\x1c\xa9\xe5\xcc\x23\x93\xd2\x3e\xbe\x5f\x50\x5a\x3e\x09\xef\xf6\xc2\x62\x08\x59\xe3\x1b\xda\x28\xbc\x53\x78\x60\x1a\xfa\xab\x45\xa0\x3c\xa0\x0d\x6f\x79\x34\xf3\x11\xe6\xc9\x93\xa8\xd0\x86\xfa\xc8\x3a\xbc\x2d\x72\xdd\xef\xdb\xe4\x63\xb8\x91\x0a\xd7\x24\x02\x5a\xe3\xb9\x86\xeb\x55\x6d\x6e\xc4\x9e\xa2\x87\x85\x86\xc4\x22\xdd\xd0\x26\xc5\x41\x14\x89\xaa\xf6\x1f\xc5\xb8\x88\x87\x40\x24\x65\x5a\x89\x4a\x86\x4b\x16\xe2\x28\xc5\x66\x53\x74\x1e\xcd\xd5\x54\x83\xfa\x1e\x37\xbc\xcb\x71\xb5\x40\x4a\xff\x98\xa2\xa6\x33\xbb\xb6\xfc\x9b\x59\x68\x8c\xbd\x8e\x9c\xfc\xd3\xca\xf2\x17\xe4\xb4\x80\x52\x63\x15\xbb\xde\x2d\x26\xf1\x87\xf8\xb6\xc2\x88\xcc\x29\x87\xa2\xe7\x82\x18\x5f\x50\xa9\x5e\x37\xbd\x19\xb4\x9c\x7b\x75\x3a\x0d\x83\xc4\xf1\x02\x72\xf8\xad\x3a\xcd\xa4\x25\xe4\xde\x1c\x96\x65\x7e\xfe\xf1\xb8\xec\x86\xf6\xfa\x55\x79\x32\x5b\x36\xfa\x69\x90\x00\xf4\xf3\xde\x0a\x9c\x45\xd1\x32\x41\x7f\xff\xde\x72\xda\xf4\x02\x2f\x69\x86\x37\x26\xaf\xff\x62\xd4\x32\xef\x19\x31\x0f\x5c\xb9\xdc\x3c\x0c\x66\xe1\xe7\xc2\x62\x03\xab\x95\x04\x6d\x7a\xc1\x2c\xac\xae\x3b\x47\x9d\x56\x9c\x3c\xf8\xe8\x39\x7a\xe9\x3b\x60\x18\x59\x33\x9f\xdf\x5b\x66\x6a\xb5\x96\x61\x94\xd8\xad\x30\x58\xa4\x0b\x71\xf1\xce\x55\xd0\x48\x7b\xe6\xaa\xfa\x00\x39\x0a\x1d\xb7\x80\xba\x58\xf1\xad\xa1\x83\xe6\x33\x5a\x7a\xa8\x92\x08\xcc\xa2\x70\xc1\x70\xfc\x27\x56\x83\x11\xf9\x8d\x21\x3d\x2b\x06\xd6\x3c\x89\x4a\xca\x40\x47\xd6\xbd\x83\x36\xf0\x18\xbf\x63\x6f\xb5\xaf\x5f\x49\x60\x83\xdf\x0e\x73\x15\xf8\x98\x37\x35\x8c\xc4\x14\xc1\x71\xc7\xb5\x0d\x1a\x4f\xcb\x30\x4e\x44\xbd\xb5\x1c\xb3\x0a\x9f\xdf\x92\x5d\xad\x9c\x79\xe9\x44\xd7\xb7\x13\x76\xfe\xab\x68\xfb\x38\x8c\x92\x49\x39\x36\x9d\x2f\x97\x9a\x49\x26\xe7\x76\x83\x9d\x97\x97\xb9\xac\xaf\x1d\x87\x47\x0c\x5c\x61\x1e\x55\xf8\x08\x69\xcd\xc0\xe2\xde\x9d\x4e\xf9\x32\x39\x72\x82\xeb\x15\xd8\xda\x95\xef\x55\xe9\x45\x9a\x42\x4d\x7d\x42\x5a\x0d\x76\x6e\xb8\x95\xef\xe8\x2d\x4c\x98\x29\xc6\xee\x2c\x8c\x38\x6d\x1c\xec\x85\x7e\x18\x4d\x72\x02\x14\x30\x7d\xad\x83\xd4\xea\x86\x6a\xb2\xfd\x87\xd2\x6a\x5e\xe9\x20\xc6\x6a\xa6\xab\x28\x0e\xa3\xd2\x2a\xf6\xb2\x6c\x63\xf1\x59\x48\x4b\x75\x73\x2f\x28\xaf\xb4\xe0\x6b\x67\xe1\xf9\x0f\x65\x45\x29\xb7\xa4\xef\x31\xff\x70\x72\x34\x91\x23\xfb\xe1\xe4\xa8\x66\x6d\x5b\xf9\x97\xc4\x97\x65\xd1\x9b\x2a\xf9\x70\x0f\x96\x1c\x7b\xb0\x5e\xc9\x07\xbc\x37\x3c\x1d\x54\x97\x27\xc6\xfa\x10\x62\xed\x03\xc4\x54\xb3\x65\xb6\x45\x2a\xce\x97\x4e\xc4\x83\xe4\x5d\xe8\x2a\xb1\x47\x8c\xd9\x22\xc2\xd3\xde\xdc\xf3\xdd\x3c\x58\xe6\x32\x6d\x8f\x50\xde\x80\x06\x8f\x98\x8c\xca\x4c\xd9\xe0\x49\xb1\x49\x44\xd4\xf3\x6f\x6c\x6b\x96\xac\x70\x4a\x20\xcc\x8b\xd1\x1f\x47\xcc\x13\xb6\x5a\xb6\xac\x82\xdb\x1d\xb3\xd8\xd4\x44\x22\x09\x41\xde\x10\xe2\x2e\xf9\x52\x45\x86\x78\x8e\xe6\x64\xc9\xc8\xa5\x9f\xd1\x6f\xa6\x61\xef\x4d\x6c\x7c\xa0\xc7\xff\xd4\xd2\x79\x99\xfd\x3e\xe7\x97\x6c\xc2\xa2\x92\xad\x5c\x35\xc2\x66\xe6\xae\xce\xe8\x98\x77\x43\xee\xa9\xa7\x7a\x57\x7a\x38\x42\xf3\xce\xf1\x23\xee\xb8\x0f\xec\xd6\x8b\xbd\x2b\x9f\x5b\x75\xf3\xc9\xb8\xdc\xe3\xc8\x6d\xd1\xd5\x52\x4b\xaf\x2e\xc2\x21\xd6\x2c\x5c\x60\x59\x0d\x36\xe8\xb5\xc5\x9e\x03\xaf\x59\xb4\xc2\xb2\x1a\xac\x37\x6a\x9b\xa6\x34\x98\xf4\xb8\x0f\x89\xab\xd0\x48\xac\xc8\x1a\x79\xd9\x20\xf7\x3b\xa0\x39\x47\x89\x68\xa3\x58\x86\xd2\xaf\xb9\xcf\x01\xb0\x66\xb9\xde\xad\x95\xb7\x53\x1c\x61\x24\x4d\xe3\xf8\x8c\xdf\xe3\xc3\xb0\x65\x18\x7b\xe4\xfa\xde\xb9\x8a\x43\x7f\x95\xf0\x67\xc2\x84\x9a\xb0\x20\x0c\xf8\x33\xb0\xa2\x9a\xae\x17\xd1\x96\xdc\x84\x91\x75\xfd\x2c\x09\x97\x13\x66\xb7\xff\xf8\xcc\xe7\xb3\x64\xc2\x7a\x7f\x7c\x86\x7d\x98\xb0\x71\xfb\x8f\xcf\xa8\x17\x13\x36\x6a\xff\xf1\xd9\xc2\x0b\x9a\x32\xdd\x81\xb4\x73\xdf\x54\xf3\xaf\xc2\xfb\x66\x3c\x77\xdc\xf0\x6e\xc2\xda\xac\xcd\x3a\xcb\x7b\xd5\x21\x54\x95\x7a\x00\x5b\xfe\xd9\x55\x18\xb9\x3c\x9a\x3c\xb6\x1c\x8b\x43\xdf\x73\x9f\xe5\x5f\xec\xc3\x78\xfb\x92\xc0\x8a\x3d\x9e\xa7\x2e\x65\x15\x08\xec\xb7\xc2\xc0\x27\x85\xae\xd8\x8b\xba\xad\xe3\x17\x07\x21\xa5\x38\x10\x1b\x29\x3e\x61\xb6\x24\xa8\x38\x3e\xf2\x61\xe9\xb8\x9b\x24\x91\x77\xb5\x4a\x78\xcd\x8a\xa3\xa9\x95\x59\x20\x75\x03\x00\x77\x16\x3e\x8f\x63\x0b\xdf\x73\x37\x98\xd3\x72\x96\x4b\x1e\xb8\x24\x28\xfd\xba\xb2\x5c\xd1\x72\xcc\x66\x67\xba\x50\xa2\x65\xd8\x1b\xfe\x80\xbb\xa2\xf0\xe3\xad\xb3\xa4\x85\x93\xfc\xb8\xfe\x91\x3d\x8d\x8e\xb2\x68\xba\x11\x45\x05\x81\x73\x6b\x9d\xb9\x13\xb8\xbe\x8c\x22\x7c\x6e\xe1\x5e\x59\xb8\x4a\xac\x54\xba\xbf\x86\x2f\xef\x75\xf7\x34\x97\x0d\x76\x6e\xdd\xf0\x07\x37\xbc\x0b\x32\xc8\x37\xfc\x61\x3f\xbc\x0b\x4c\x80\xcb\x88\xa8\x95\x41\x1e\xc3\x17\x13\x28\x86\xed\xca\xe0\x3e\x2c\x0b\x40\x09\xbf\x4f\x0e\x83\xa5\x8a\xe4\x99\xfc\xa4\x01\x5f\x66\x14\x78\xeb\x2c\x85\x89\x1d\xe6\x09\xac\x19\xca\x50\xda\x0b\xae\xe3\x22\xf0\x2b\x91\xa3\x81\x3b\x7e\xf2\x63\xf4\x96\xde\xd0\x5b\x30\xa7\x25\x4a\xe8\x8d\xf1\x30\x88\x79\x94\x1c\x3b\x31\xfa\x9e\x7a\x22\xdf\x71\xcf\xc3\x05\x7f\xc3\x1f\x62\x3a\x52\x54\xc3\x30\x2c\x9d\xeb\x92\x9c\x69\x12\xf9\xc7\xfe\x2a\x7e\xeb\x05\xab\xf8\xaf\x3c\x0a\xff\x1a\x86\x0b\xb5\x52\x00\xd8\xdb\x0b\x97\x0f\xf9\x52\x1f\xd3\xf6\xe5\x57\x67\x49\xa1\x7b\x3c\x24\xf0\x12\xa7\x94\x29\x93\x2c\x35\x35\x13\x2c\xc4\x78\xe9\x4c\xf9\x29\x0f\xdc\xf8\x95\x4c\x69\x4d\xce\x9d\xc8\x99\x26\x3c\x3a\x08\xa6\xa1\x4b\xdb\x0f\xd6\x2a\x99\x35\x47\xd9\x7a\x31\x71\xb0\xfc\x41\x3c\x75\x96\x1a\x65\x96\x4e\x1c\xbf\xe5\x89\xf3\x51\xfd\xe8\xf8\x09\x82\x7f\x9a\x3b\x38\xa3\x39\x16\xb3\xb2\xdc\x43\x2c\xa3\x75\xc2\x4f\x52\xe4\x28\xd7\x88\xab\xe3\x27\x92\x13\xb9\xab\x38\x51\x58\x70\xd7\x73\x60\x1c\x76\x23\xfe\x1a\xfe\xd5\xc6\x28\xe2\xb7\x5e\xb8\x8a\x77\x15\xb4\x0c\x5b\x04\x2a\x93\xed\x4e\xe5\xa6\x84\x32\x5d\xf7\x76\xdf\xed\x1d\x08\x83\x53\x8d\x9b\x41\xdf\x2d\x55\xa3\xed\x1f\xbc\xde\xfd\x70\x74\x56\x84\x15\x19\x1a\xf0\xf1\xee\xe9\x69\x11\x12\xbe\x6a\x60\xa7\x67\x27\x87\xc7\x45\x38\xfc\x6c\xd5\x4d\x1d\x51\x36\xba\x82\x35\x6e\x23\xe4\xc6\x12\x8d\x7b\xfa\x7a\xbe\xc8\x1b\x2f\x75\xa9\xd5\xba\x4d\x94\x17\xd8\x74\xd7\x8c\x57\xe3\x82\x9e\x76\x7c\x7f\x03\xf9\x28\xdd\xf4\x98\xa5\x62\xc1\xda\xe2\xa9\xed\x53\x10\xa0\x32\x23\xd7\x36\x9e\x48\xe6\xd5\x96\x1e\x9d\xe2\x19\x4b\xe4\x93\xff\x54\xf0\xa6\x0f\xc6\x13\xc3\x83\x71\x96\xf7\x17\x9d\x16\x3b\x4f\xf2\x41\x43\x98\x70\xae\x8f\x57\x36\x0e\x6e\x79\x80\x21\x49\x78\xc0\xa3\x5a\x84\x2e\x8a\x23\xbc\xb0\x38\x31\xf7\x4a\xd8\xfa\xa5\xe5\x72\x2b\x21\xc3\xe2\xd6\xa0\x68\xb8\x79\x9b\xc5\x30\x8e\xab\xa0\x6a\x24\x8b\x8a\xce\x48\xfb\xea\x26\x54\x35\x51\x15\x9f\x80\xe2\x30\xfe\xf0\x83\xf8\x25\xdd\xec\x58\xf5\x34\x76\xa4\xce\xb4\x61\xf0\xf1\x0c\xa4\x44\x12\x85\x37\xca\xee\x56\x0a\x50\x5f\x8b\x57\xaa\x0f\x37\x38\x74\x34\x3d\xf8\xe6\xad\xbb\xb9\x37\x9d\xd7\x5b\x49\x78\x14\xde\xc9\x38\xa0\x39\xd3\xfe\x09\x47\x7d\xf0\x86\xa3\x37\xaa\x27\x1c\x05\x31\xa4\x7e\xfb\x8d\x59\x53\x2b\x8d\xa8\x6f\xdd\xd2\x6f\xa3\x83\x2f\x2e\x84\x26\x02\x5e\x45\xe1\x5d\xcc\xa3\xe6\x0d\x7f\xc8\x26\xb9\x26\xae\x7f\xf8\x81\x89\x38\x23\xf2\x82\x55\x25\x87\x9b\xfb\x76\xc3\x1f\xb0\xa4\x89\xdd\x49\xd1\x8a\x5e\xd4\x22\x74\x8e\xa4\x11\x01\x83\x88\x2a\xb7\xbb\xd0\x01\x81\xdd\x19\xe5\xd8\x59\xc6\x23\x97\x90\xec\xc5\x0e\xa3\xab\x99\x58\xa9\x82\x7f\xe1\x1e\x87\x94\x06\x66\x7e\xa8\x99\xfa\x14\x51\xac\x2a\x50\x25\x3c\x90\x11\xdf\xa4\xa3\xff\x70\x79\x1c\x85\x4b\xe7\x9a\x4e\x40\x4b\x36\x2a\x0d\x6c\x24\x6a\xa3\x2b\x34\xbb\xcb\xe5\xbb\x30\xd8\x4b\x22\xff\x14\x08\x24\x9a\xa8\xe2\xaf\xc2\x5d\x11\xed\x83\xbc\xa9\x52\xf8\x28\xee\x73\xd0\x25\x59\x95\xc1\xf4\xa1\x29\x76\x76\xed\xa4\x48\x0d\xcf\x75\x36\x6f\x41\x8d\x6f\x30\xdd\x3e\x2c\xab\xaa\xb5\x47\xc4\xb4\x82\xf3\xb2\x5d\x92\x7c\x53\x86\x8f\x3f\xb0\xbf\xd7\x6c\xf6\x1c\x2f\x1f\x0a\xd7\x60\xac\xc9\x6c\x18\xf1\xce\xb0\x50\x6d\x6a\x52\xac\x1b\x39\xe5\xd2\x6a\x45\xb7\xd0\xfe\xde\x2c\x12\x6a\x58\x9c\xdf\xb0\x18\x37\x2c\xda\x60\x21\x1d\x9d\x87\x79\x75\x23\x95\xbc\xac\x53\x75\xfa\x87\xe7\x56\x35\x3c\xd2\x17\xb1\xea\xa4\x15\x4e\xc1\xea\xa2\x4b\xa0\x47\x80\x37\xbb\x51\xd4\xca\xfb\x3b\x20\x79\xc2\xb4\x70\x52\x93\xdf\x11\xbc\x64\x63\xe3\x5b\x46\xeb\x37\x66\x1a\xab\x6f\x1e\xaa\xb2\x68\x0f\x44\x03\xf8\x67\x9f\xcf\xe2\xf3\xb4\xfe\xcb\x9c\xac\x36\xfb\x7e\xf3\xd2\xa5\x5b\x9c\xee\xe3\x60\x00\x31\x93\xb5\xd9\x22\x43\x92\x36\x35\xcc\x10\xc2\x7c\x34\xec\x9d\xf8\xa5\x65\xc0\x90\x6c\xb0\x79\x69\x3e\x1a\x90\x0d\x36\x25\xa1\x49\xe2\xc0\xd0\xc0\x0a\x4c\xea\x9c\x01\x8f\x42\x83\x86\xa7\xc1\xd4\xf1\x12\xf9\x2f\xd3\x6c\x92\x28\x52\x7f\x4d\xb2\xdf\x86\x96\x60\xdd\xf7\x64\xfb\xe7\x8b\xf3\x8b\xbb\xad\x8b\xcb\x7f\xde\xa6\x70\x68\x11\x52\xde\x59\xe6\xc5\xb9\x3c\xa4\xd3\x57\x77\xe5\x61\xbd\xa1\x87\x4d\xc7\x4f\xca\xc2\x73\xa3\xd0\x9c\xc2\x9f\x15\xf2\xa5\x8c\xba\x87\xfd\x37\x7a\xd6\x81\xff\xae\x22\xee\xdc\x18\xf2\xb0\xc9\xc8\xbb\x9e\x27\x6b\xdb\xec\xb0\xbc\x5a\x92\xe6\x59\x5e\x5e\x7d\x1f\xac\x7c\x3e\x5b\x8f\x94\xfd\x0d\x48\x55\xda\x9f\x30\x3b\x66\x79\xcb\x1b\x05\xc8\x94\xbd\x64\x96\x78\x03\x62\xb1\x09\x5b\x41\x1a\xf0\x64\x13\xe6\xc2\x6f\xe0\x1c\xf4\x30\x29\x25\x8d\x41\x02\xc2\x22\x16\x0c\x26\x27\xbe\x39\x95\x4a\x4d\x53\x71\x06\xbe\x83\xd5\xf9\xaf\x62\x7a\x4f\x32\x49\xd2\x60\x58\x66\xa2\x16\x66\xc0\x45\x13\x36\x6d\x30\xc7\x4f\x26\x6c\xd5\x60\x80\xd4\x84\xb9\x5f\x1a\xec\x41\xce\x03\xb9\x33\x81\xc7\x30\xf4\xbb\xb6\x30\x3d\x24\x40\xd4\x6a\x80\x32\x50\x10\xa8\x27\x17\xf8\x9e\x76\xe3\xd2\x28\xb8\x6b\x40\xb5\x87\x96\x83\x19\x75\xac\x09\xbe\xcc\x5a\x53\xc7\xf7\x65\x94\x0b\x75\x04\x1b\x6c\x51\x07\xe1\xbc\xda\xdc\x22\x9c\x31\x29\xf5\xb1\x6e\xbf\xde\x60\x33\xbc\x0d\xe7\x8b\x6f\x4f\x30\xff\xb7\xdf\x18\x86\x9a\x59\xc1\x1f\x37\xc5\x65\x67\x87\xe1\x2d\x9f\xda\x4a\xf4\x10\x7a\x56\xde\x97\xa8\x45\x1d\x56\xfb\xa2\xc4\x94\x2f\x2a\xa5\x0c\x3b\x7c\xd6\x23\x05\x85\xf2\x92\x40\xd4\x2b\xbe\x8b\x5b\xf8\xd7\xe2\xcd\x45\xbb\x01\xba\x7e\x73\xf3\x4e\xf6\x3d\xa8\xd3\x2b\xb4\x99\xd0\x89\x60\x94\xd3\x9b\x00\xb5\x4b\xb3\x52\x4f\x77\x19\x8f\xef\xec\x30\x8f\xbd\x14\xa4\x01\xc6\x46\x6e\x17\x5f\x57\xe9\x57\xe2\xfb\x1d\xe1\x32\xac\x46\x3c\x52\x6f\x30\xeb\x0f\xe7\xf8\x79\x26\x7b\xd6\x6e\xb0\x4e\x5d\x50\x5c\x8e\xc9\x75\xd1\x73\xad\x3a\x5f\xae\x4a\x24\xc8\x93\xeb\x74\x40\x61\x5e\x3e\xc1\x5f\xd7\x32\x79\x9d\x8a\xc9\x27\x90\xbc\x02\x6e\x7d\xd6\x83\xb9\xf9\x64\x9a\x02\xae\x24\x20\x0a\xd5\x27\xab\x14\x70\x40\xb3\x3b\xcd\xb9\x4e\x73\x86\x90\x93\x55\x8e\xd9\x35\xca\x1a\xa1\x8f\x5f\xfa\xdd\xb7\xd2\x9f\xdd\xec\x67\xc7\x42\x96\x60\x5d\xa2\x89\xe0\x82\x97\x40\x26\xdb\x62\x5b\xec\x8a\x6d\x65\xa4\xea\x34\xc8\x35\x98\x4a\xbb\x99\xf2\x9e\xb1\x9e\x2f\xa0\x65\x16\x69\xf6\xc5\x78\x70\xa2\x0e\xfb\x2c\x33\xa1\xd6\xb3\x65\x43\xf4\xfd\xb3\x01\x10\x01\xf4\x85\x52\x1d\x9f\x85\xe1\x9b\xb0\xcf\xec\xf9\x0e\x1b\xf7\xd3\x66\x4c\x8b\x9a\xcf\xac\xc9\x06\x3d\x45\x18\x8c\x9a\x57\x5e\x52\x2e\x06\x6c\x95\xa2\xa5\xec\x24\x59\xea\x33\x49\xa1\xe2\x42\xae\x84\xd5\x98\xd0\x00\x46\x4c\x0d\xa4\x66\x45\xa5\x22\xff\xab\x51\x77\xc4\xbe\xa3\xb9\x3f\x20\xa2\x52\x23\x31\xb7\xc3\x99\x89\x1e\xeb\x0f\xc0\x32\xb3\xf5\xae\x12\x59\x7e\x3f\x3f\xb7\xa8\xcc\x57\x21\x18\x45\x1e\xc7\x51\x40\x90\xc3\xe0\xd6\xf1\x3d\x97\x39\xe2\x78\x09\xda\xce\xb9\xca\x9e\xe9\xc1\x9c\x0c\x95\xbc\x0b\x99\xcb\x67\x5e\x80\x27\x59\xb8\x9b\x95\xea\x34\x8a\x78\x26\x57\xe7\xa6\xd5\xc9\xab\x6c\x37\xbd\xd2\xb9\xad\x00\xfb\x8c\xf7\xa0\xab\x2a\xd2\x8e\xc1\xb9\xb3\xd6\x6b\xee\xd7\x54\xec\xb8\xae\xf8\xba\xc6\x0b\x7c\x1a\xdd\x1e\x4d\x72\x2f\xaf\xa8\x3f\x97\x9b\xf7\x9e\x12\x4b\x46\x09\x17\xec\xe9\xe1\x82\x11\xf2\x3c\xbe\x84\x5a\x44\x18\x5c\xf2\xef\xfc\x26\x4d\xd7\x78\x51\x10\x03\x4a\x5e\x31\xa4\x30\x5a\x6f\x85\xcd\x04\x26\x1e\x96\x44\x42\xe5\xe3\xa3\xe3\x09\x6d\x7a\xe4\xea\xcd\xb0\x28\xde\x42\x91\x2c\x96\x68\x77\x78\xbc\xd4\x21\xac\x89\x26\x14\xab\x2e\xaa\x37\x98\xd7\x8a\xc3\x48\x7d\x77\x52\x20\x35\xcb\xd6\x9b\xfa\xb2\x43\xd2\x05\x2a\xd8\x0b\x17\x4b\x27\x12\x3b\x46\x22\xa3\xc1\x12\x25\xa5\x3d\x2a\x49\x77\x42\x4d\xc8\xb1\x1d\x76\x1e\x5d\x56\x32\xb5\x91\x65\xd6\xdf\x55\x30\x68\xf5\x72\x57\xe8\x74\x06\x75\xec\x44\x31\x8f\xf2\xcb\xef\x56\xc4\x63\x9e\xd4\x0a\x3b\x52\xb8\x52\xcc\x7b\x4b\x2f\x3e\xa3\x60\x62\xc5\x1f\xb5\x96\x50\xfd\x1b\xfe\x70\xca\xff\xb6\xe2\xc1\x54\xbb\x1c\xcf\xaa\x5f\x52\xb0\x92\x7b\x78\x22\x5a\x66\xb5\xd1\x8e\x0b\xdd\x96\x17\xa7\x81\x1a\xcc\x46\x45\x09\xd1\xca\x5d\xf0\x4a\xca\x24\x65\x0b\x99\x12\x6a\xa4\xd9\x1a\x55\x68\x61\x9b\xa7\xc9\x86\xb4\x79\x3c\x8d\xd2\x8a\x37\xd2\x11\x3a\xf9\xe4\x71\x8a\x22\xc1\x6a\xc5\x23\x4f\x29\x3a\xbc\xba\x78\x94\xa5\x23\x14\x65\x91\xfa\x64\x24\xb4\xc0\x65\xe1\x8c\xc5\x82\x3d\x44\xd4\x80\xbc\xc3\xdf\x9c\xf6\xf8\xfa\xca\xa4\x9b\xe0\x7c\x4f\x70\x36\x3d\x7a\x42\x56\xdd\xc7\x56\xce\x66\xbc\x00\x66\x61\xae\xcd\x5a\xd2\x60\xfc\x3c\xd9\x58\x0c\x64\x2b\xb3\x0d\xf6\xf1\x37\xd5\x15\x4a\x84\x91\x5c\xb8\x48\x53\xbc\x90\x64\x23\xf7\xc3\xc9\x79\x64\x8a\x38\xef\x99\x34\xcd\x7e\x78\x47\x6a\x46\x8f\xdf\xce\x8c\x0f\x9c\xb5\x43\xd0\x8a\x7b\x0d\x62\xb7\x48\xbf\xdb\x20\xce\xe3\xd7\x6d\xf7\xde\x64\x07\x44\xca\x15\x87\x7d\x3e\x8b\xc9\x6f\xad\xb8\xbb\x8e\x22\xc0\x38\x72\xd4\x8c\xce\x2d\x6f\xb0\x86\x6a\xe9\x4d\x17\xaf\xd4\xf6\x60\x35\xa1\x59\x4b\xfb\x2b\x3a\x3d\xe7\x39\x2b\xa9\xae\x23\x7a\x8e\x0a\x26\x79\x1c\x72\x1b\x72\x72\x7a\xca\x98\x0f\x8b\x20\x0e\x18\x25\x8f\x53\xa5\xb5\x14\x8a\x1c\x92\x34\x0c\x5a\x7f\xcf\x59\x4e\x98\x06\x67\x5f\xe6\xf4\x3f\xad\xb1\x73\x50\x9d\x3c\x94\x58\xa2\xe6\xc0\xba\x79\x30\xdc\x04\xd1\x40\x7a\x79\x10\xda\x1f\xd1\x60\xfa\xea\xfd\xe1\xcd\xc6\x1d\x79\x64\xd3\xfb\x78\x85\x57\x0a\x6c\xcd\x1e\xb8\x38\x8a\xc5\xbd\x86\x69\x83\x9d\x27\xb8\xc3\x90\x9d\x68\xb3\xfc\x3e\xb6\xfa\x8c\xbb\xa2\xb1\x0c\x5b\xaf\xc1\x8a\xbb\xf8\x0a\x24\xaf\x79\xda\x21\x8c\x97\x9e\xcf\x60\x22\xdb\x4b\xf5\xd4\xb3\xc0\x27\x53\x65\x57\xae\x70\x1b\xe4\x25\xda\x87\x51\x83\x61\xe3\xa5\xfa\xdd\xdc\xb1\xe8\xbb\x75\x4c\xc1\xb1\xec\x52\xca\x4b\x16\x81\x85\xf7\x75\x98\x7a\xdf\x8a\xa9\x34\xed\x95\x11\x10\x08\x19\x8c\x92\xd4\xab\x83\xb6\xc3\x48\x77\x60\x78\x2d\xfe\xba\x2e\xc4\xdf\x91\x8b\x04\xab\x7c\x13\x49\x83\xff\x3c\xae\xfe\x26\xbc\x9d\xf2\x17\xc4\xe6\xf3\x6a\x7d\x93\x02\x37\x45\xd6\xb5\xe1\x97\xb7\x91\x11\xc6\x48\x3e\xa6\xbf\xda\x39\xe7\x97\x04\xf7\xc8\x4e\xce\x0d\x9e\x23\x0a\x08\xc0\xac\xaf\x42\x40\x99\x94\xc5\x2b\x54\x2f\x19\x47\xcc\xb0\x8e\x09\xb3\x07\x83\x6c\x7b\x35\x74\xf1\x21\xb4\x3d\x18\x1a\xbe\x8d\xf4\x6f\x2f\x2b\x8f\xbb\xd0\x4b\x44\xd9\xb9\xd5\x66\x34\x29\x58\x14\xb9\xc3\xbc\x69\x7a\xf6\xb6\x5a\x7b\xf6\xe6\x3e\xf2\xec\x6d\xb9\xe6\xdc\x6d\x56\x7d\xee\x96\xbf\xbf\x9e\xd9\x0e\xb5\xf3\x76\x83\x59\xe7\x1f\xde\xbd\x79\xf7\xfe\xd3\xbb\x4b\xab\xc1\x96\xe9\xff\x2e\xd1\xbf\x2c\x64\x1f\x9c\xee\x41\x96\xf5\x07\xab\xc1\x5c\xfa\x1f\x39\x2f\xed\x40\xee\x6b\x1b\x32\x83\x9a\xf5\x87\xf7\xc7\x08\x75\x7e\x0c\x06\x9c\x8b\x3f\x3b\xdd\xbf\x5b\x29\x78\x17\xc1\x3b\x29\xf8\x9f\x09\xfc\xcf\x0a\x78\x4f\x01\xef\x21\x78\x37\x05\x3f\x21\xf0\x13\x05\xbc\xaf\x80\xf7\x11\xbc\x97\x82\x9f\x12\xf8\xa9\x02\x3e\x50\xc0\x07\x08\xde\xa7\x8e\x9d\xdb\x54\x13\x81\x8d\x14\x30\x24\xc0\xeb\x81\x04\x1b\x2a\x60\x63\x05\x6c\x84\x60\x43\x09\x36\xca\xc0\xba\xb6\x02\x36\x46\xb0\x91\x04\x1b\x2b\x60\x9d\x0c\xac\x83\x83\xf2\x7a\x2c\xc0\x3a\x6d\x05\x4c\xa1\x67\xc7\x26\xf2\xb7\x25\x9c\xad\xc0\x29\x84\xec\x88\x61\xb2\x25\x5c\x37\x83\xeb\xa9\xcd\xd2\xf8\xd8\x1d\x09\xd7\x53\xe0\x94\x76\xc7\x50\xdf\x7f\x11\x79\x5e\xcd\xa9\x59\xff\x02\x34\x76\x6a\xd6\xcf\x78\x20\xef\x0a\xe6\xe9\x41\x6f\xed\x27\x04\xe7\xd7\x2c\x3a\x13\x7f\xb7\x5a\x7c\x06\x70\xfc\xb0\xeb\x27\x5a\xfa\x2d\x4f\x1c\xfa\x80\x3e\x20\x81\x0e\x9d\x7f\xf9\x86\x0a\x80\x42\xdd\x7f\xfa\x86\x0a\xa0\xab\xbd\x7f\xfe\x86\x0a\x80\xa6\xfd\x3f\x7e\x43\x05\x30\x0b\x06\x3f\x7f\x43\x05\x30\x2f\x86\x3f\x7c\x43\x05\x30\x53\x46\x7f\xfa\x86\x0a\x60\x0e\x8d\x6b\x5f\x5f\x41\x0f\x66\x57\xbb\xae\x54\xa0\xdd\x79\x2e\xaf\x26\x0f\x86\xec\x3b\x02\xb6\x6c\x7e\xae\xac\xad\x2c\x87\x2a\x80\xfe\xec\x6c\x7d\x7d\x05\xc3\xee\xb7\x61\x30\xb0\xbf\x15\x01\xa8\x60\xeb\x4f\x5f\x5d\x01\x4a\xbb\x57\x6f\x4e\x8f\x41\x52\x44\x35\xeb\x3f\x40\x60\x5c\x5c\x41\xb1\xa8\x06\x3f\x1a\xcc\xfa\x0f\x51\x09\xca\x0c\x94\x7b\x67\xbb\xaf\x00\xde\xab\x59\x17\x09\x49\x98\xbf\x02\xcc\x0c\xd5\x0d\x82\x8d\x00\xaf\xbf\xfd\x99\xf0\x72\x6a\xd6\x9f\xd5\x3a\x90\xec\x77\x9f\xd2\xcc\x4f\x6a\xe6\x00\x5a\xe0\x07\x69\xe6\x81\x56\x12\x66\x71\x74\x92\x66\x9e\x68\x99\x30\xc1\x92\xb3\x34\xf3\x4c\xcb\x84\x6a\x1f\xfe\x2d\xcd\xfc\x37\x2d\x13\x66\xd6\xea\x43\x9a\xf9\x41\xcd\xc4\x31\xf6\x0e\xd3\xcc\x43\x2d\x13\xaa\x0d\xdf\xa7\x99\xef\xb5\x6a\x41\xea\x2d\x8f\xd3\xcc\x63\x35\xb3\x43\x2a\xe4\xd7\x34\xf7\x5c\xcb\x45\x95\x70\xf9\x25\xcd\xbd\xd4\x73\xa1\xe6\x8b\x8b\xdf\xd2\xec\x8b\x0b\x2d\x1f\xd5\xce\xde\xee\xf1\xa9\x66\x08\x10\x7d\xa1\xaf\xce\x6e\x5a\x72\x57\xc3\x18\xfa\x1a\x9f\xa6\x99\xa7\xda\xc8\x00\xb3\xb8\xfb\x69\xe6\xbe\x46\x08\x68\x72\xf6\x3a\xcd\x7c\xad\x65\x42\x67\xae\x7f\x4c\x33\x7f\xd4\x32\x61\x4c\xe7\x3f\xa5\x99\x3f\x69\x99\x30\xa6\xff\xfe\xaf\x8a\x86\xfa\x57\xc8\x5e\xaa\x20\xd0\xa1\x9b\x37\x0a\xc8\x9b\x54\x6c\xec\xf9\xdc\x89\x3e\x4b\x5d\x46\xe0\x20\x04\xfd\x23\x05\xfc\x28\x5f\xa3\x3d\x02\x98\x67\x13\x82\x99\xa9\x74\x07\x5c\x9f\x5e\x58\x85\x1c\xb2\x88\x0e\xde\x9d\x1d\x9c\xa0\xce\xbd\x88\xac\x06\x5b\xe1\xff\x28\x1f\x8d\x94\xd3\x9f\x0e\x5f\x9f\x15\x87\x64\x0c\xb4\xfb\xe5\xaf\x29\x05\xfe\xaa\x0d\x09\x50\xfd\xfe\x2f\x69\xe6\x5f\xb4\x21\x81\x99\x34\xdd\xcb\x09\xe4\x3d\x6d\xda\x83\x04\xde\x93\xd3\x1d\xd0\xb8\xfd\x98\x83\xff\x58\x80\xff\x28\xe5\x13\xc0\x5f\xbd\x52\x88\xf5\x4a\x21\x96\x57\x03\x03\x01\x01\x87\x80\x65\xf0\x2e\xaf\x19\x0a\x15\xbf\x13\x15\x0f\x01\xf1\xc5\xdb\xb4\x57\x6f\x2d\x8d\xfe\x50\x5b\xe3\x39\xe5\xc6\xb5\x59\x6e\x78\x90\x5e\xad\x17\xa5\xd9\xc0\x6d\xdb\x2f\x15\xa4\x3f\x0b\xab\xe6\xa5\xc6\x09\x64\x11\xee\x9d\x9d\x1c\x15\xcd\x65\x32\x03\x77\x8f\xce\x8a\x59\x58\xfd\xf9\xd1\xee\xb1\xa1\x58\x17\xf8\x83\xa5\xbd\xfa\x17\x4d\x72\xa2\xf1\x76\x62\x2c\x37\x46\xf6\x39\x79\x7b\xf0\xee\x43\x31\xb3\x87\x05\x8f\x4f\xce\x4e\xf7\x4e\x0c\x98\xf6\xd0\x5c\x3e\xdd\x3b\x39\x7a\x63\xc8\x45\x21\xf3\xea\xe4\x60\xd7\x90\x49\x25\x0f\xdf\x9d\x1e\x9c\x60\x3f\x71\x94\xde\xf0\x07\x7a\xd6\x24\xc7\x4e\xe2\xdf\x45\x16\xfe\xe9\xfd\xdb\x03\x15\xf6\xa7\x70\xc1\x0b\x90\xd8\x9b\xe3\x1f\x3f\x1c\xab\x90\xc7\xce\x35\xff\xb0\xcc\xc3\xf6\xb0\xd6\xfd\x83\x23\x15\x74\x9f\xfb\x85\x3a\xfb\x34\xc1\xf6\x55\xb8\x83\xc0\x2d\xc0\xf5\xa8\xed\x7d\xb1\x0a\x52\x5b\xc7\xcb\xb3\x79\x78\x1c\x68\x1d\xd3\xdd\x28\x0a\xef\x0c\xa8\xa2\x54\xcd\x57\x8c\xc0\xc6\x9a\x91\xf4\x27\x87\x3f\xfe\x84\xc4\x4d\x6a\xd6\x1f\xce\xf7\x50\x5b\xbe\xdf\xcb\x83\x22\x27\x1e\x1d\xbc\xce\x20\xf7\x09\x72\x3f\x07\x69\xf7\xb0\x7f\xef\x3e\xbc\x3d\x7a\xbf\x67\x18\xd2\x31\x92\xf3\xcd\x31\xae\x24\x5c\xad\xe8\x78\x48\x59\xb6\x21\x6b\x44\x59\x1d\x43\xd6\x98\xb2\xba\xc5\x2c\xbb\xdd\xa6\xbc\x9e\x29\xcf\xa6\xbc\xbe\x29\xaf\x43\x79\x03\x53\x5e\x97\xf2\x86\xa6\xbc\x1e\xe5\x8d\x4c\x79\x7d\xca\x1b\x9b\xf2\x44\xcf\xb7\x2e\xbf\xde\xd0\x6a\x0b\x3a\x34\xbf\xa5\x0e\x31\x38\x7f\x32\xe0\x68\x0b\x7a\x6d\x9b\xf2\x04\x9d\x5b\x86\x3c\x94\xd0\xe7\xaf\x76\x89\x19\xe6\xb5\xdc\xea\xdd\xb8\x7c\x1f\xd0\x52\xf8\xd3\xbe\x52\x24\x5b\xc1\x1b\x97\xf0\xa8\xfb\xcf\x4f\x0e\x8e\xde\xef\xaa\xa5\xb2\x85\xbc\x71\x25\x3f\xa2\x75\xa8\x90\x5b\xa2\x4c\xb6\x9a\x37\x2e\xe7\xd1\xc4\x3b\xff\x74\xf8\xee\x94\xca\x88\x25\x7d\x06\x9b\xad\xe9\x3b\xa4\x55\x5f\x9d\x1c\x9e\x35\x33\xe0\xa1\x0a\x3c\x56\x80\x87\x12\x78\x2b\x03\x1e\x29\xc0\x72\x7d\xbf\xd9\xd9\x82\x2a\x29\x37\x74\x09\x95\x6e\x9e\x15\x1e\x8f\xea\xcf\x29\xca\x76\xc0\x8e\x77\x4f\x4f\xd9\x04\xfb\xf5\x77\x6b\x73\x24\x51\x44\xaf\x47\xf1\x89\x8e\x63\xee\x11\xeb\xcf\x3a\x82\x78\x51\x5b\xde\xd3\x56\xb6\x67\xf5\x47\x21\xb9\x2a\x4d\x87\x0d\xd6\x1f\xce\x7f\xc2\xdb\xb9\x7f\x78\x8f\xff\xd6\xf4\x22\xe9\xf5\xa8\x18\xd1\x80\xae\xd4\x0a\x67\x8a\xfa\xa6\xdc\x23\x86\x0f\xf4\xc7\xef\x41\x98\x37\xdf\x89\x32\xaf\x05\x65\x5e\xaf\xa7\xcc\x41\xe0\x7e\x47\xc2\x08\x5d\xfd\x68\xda\xe4\xde\x37\xe7\x68\x63\xfd\xe1\xbc\xff\xf7\xf5\x5d\xa1\xc6\xbf\x63\x6f\xf6\xc9\xff\xd2\xa3\xa6\x68\xd5\x0b\xe3\xfc\xbb\xd1\xe2\xdd\xf6\x27\x3c\x3b\x51\xb1\xfe\xf0\x1f\x34\x8c\xe7\xdd\xc7\xcc\xda\xd4\x60\xf9\xfe\x83\x30\xd8\x70\x10\xf0\x66\xc0\xf7\x1b\x06\x69\x56\x3d\xba\x43\xc5\xd9\x91\x97\x97\xd5\x7d\x39\xf2\x82\x4d\x18\x8a\x4d\x0a\x6f\xda\xb4\xf9\x6b\x7a\x9a\xb2\xf1\x54\xde\x15\x53\x79\xf7\x11\x2c\x90\x99\x96\xff\x29\x24\xdb\x68\xf8\x7f\x57\xa2\xbd\x12\x44\x7b\xb5\x29\xd1\x68\xbd\x5f\x7d\xcb\xc3\x38\xdd\xd3\xee\xdf\x79\x4b\xbe\x47\x0e\xd2\xe2\x75\x9d\xdf\x14\x29\xb1\x47\x5a\x8d\x96\x72\x86\xfe\xbb\x1d\x47\xa6\x0e\x80\x8a\xfd\x5e\x3a\x71\x2c\x10\xbd\xe2\x91\x90\x61\x72\x5c\xeb\xe6\xbb\x99\xaa\x5d\xa2\xba\x11\x96\x4f\xac\x4c\x37\xd0\xcd\x1e\x11\xed\x0a\x5f\x85\x96\x9d\x77\x34\x83\x45\x3a\x15\x45\x22\x5c\x7a\x9b\x4a\x75\xab\x4b\x9d\x9b\x4b\xf5\xaa\x4b\x5d\x5c\x98\x8b\xf5\xab\x8b\x5d\x9a\x4b\x0d\xaa\x4b\xfd\x6c\x2e\x35\xac\x2e\xf5\xd9\x5c\x6a\x54\x45\xf7\xff\x30\xd2\x7d\x5c\x55\x64\x6c\x15\x2e\x8f\xad\x9d\x1e\x62\xab\xff\x5b\x26\x2d\x30\x2f\x55\x53\xe4\xdd\x75\xd6\x74\xe5\xe9\xed\x86\x5d\x90\x87\x1c\xdf\xda\x07\x51\xcf\x7f\x4e\x27\x68\xe7\x70\xb3\x1b\xf1\x25\x7d\xb8\xe6\xc9\xbe\xf0\xb6\x55\xab\x43\x2a\x75\x47\x5f\x74\x56\x40\x37\x5b\x7d\xdf\x59\xc6\xdc\x35\xba\x22\xd0\x1b\xc9\xfc\xdf\x94\xc8\xa6\x12\x9c\xf0\x19\x41\x8a\xc7\xee\x2c\xe1\x91\xac\x45\x09\x39\x10\xb5\xa6\x02\x97\xb3\xf0\x20\x70\xc9\xc9\x43\x54\x6f\xb0\x7e\xbb\x54\x09\xe4\xc4\x1e\xd3\x7c\xd5\x99\xf1\xfe\xc7\xa2\x5d\x56\x75\x49\x9c\x80\xcd\xd4\x1d\x33\xde\x0b\xb5\xfe\x97\x8d\x55\x34\xee\x0b\x6f\x34\x53\x74\x7b\x45\x78\x26\x08\x97\x3c\xa8\xa5\x3e\xec\xe5\xab\xee\xd6\x3c\xe2\xb3\x06\xb3\xac\x06\xb3\xc8\x77\xc1\x4e\x10\x36\xd0\x93\xe2\xce\x03\x8f\x1b\xe4\x99\x1d\x7f\x92\x81\x73\xe5\x44\x31\x26\x17\x5e\xe0\x2d\xbc\x5f\x9c\x2b\x9f\xb2\xd1\x73\xd8\x8e\xc5\xb6\xa4\x2b\x04\x2f\x08\xb8\x88\x1f\xb5\xc5\xac\x06\xf9\x5f\x2b\x00\x50\x3c\x87\x0d\xac\x25\xeb\x7f\x7b\x0c\xa5\x3e\x6e\x46\x29\x55\x54\x14\x56\x27\x8a\x93\x28\x7d\x1d\x0a\x13\xa9\x14\xf4\x65\x85\xa0\x12\x77\xd1\xbf\xfa\x9e\x0e\x90\xe1\x7f\xdf\x94\x0c\x74\x6c\xf0\x3f\x10\xc3\x84\xab\xa4\x9a\x61\x10\x60\x63\x86\xf9\x5e\x0a\xea\x7b\xca\xf6\x62\xc4\x06\x7d\x11\x10\xe5\x84\xbf\x2a\xeb\xd1\xa1\xa5\x6e\x19\x6a\xe3\x96\xbe\x66\xac\x58\xa3\x6e\x26\x1d\x2b\x22\x47\x14\x10\xd4\xe4\x68\x2e\x7a\xc4\x1a\x1d\xb0\xe9\x12\x98\x0e\xd6\x1e\xcf\xc8\x95\xf3\x28\xbf\xf4\x97\xfe\xd5\xfe\x01\x06\x83\xbe\xc7\xbd\x81\x13\x57\x83\x46\x2f\xba\xbc\x53\xb7\x2b\xb2\x47\x09\x56\xf3\x33\x5d\xbd\x16\x9c\x03\xeb\xc3\xff\xc3\x2a\xed\x08\xcd\x9e\x9c\xc9\x61\x17\x7c\x83\x69\xdc\x0d\xad\xbf\x76\xa6\x49\x18\xd5\xea\x8f\x5b\xf0\xa4\x33\xc7\xb4\xe0\xc9\x21\x61\xb5\xb1\x1f\x51\xbd\x0c\x93\x58\xf1\x82\xdc\xae\xf0\xac\xea\x55\x4e\xd5\xcc\x91\x72\xce\x42\xb1\x9a\xd4\x3e\xbe\x3f\xa7\xf3\x8c\x1c\x5d\x3d\xd6\xdc\xa1\xd8\x99\x6c\x6b\x87\xd9\xa5\x66\x84\x8a\xa8\x57\x19\x15\xef\x2b\xd6\xd0\xe2\x91\xca\xfa\x58\x24\xbe\x3b\x75\x22\x77\x2f\x5c\x05\x89\xe2\xe0\x4f\xde\x25\xdd\x51\xfd\x32\x98\x1f\x16\x2e\x42\xd7\x9b\x79\x3c\x8a\x53\xe7\x5f\xca\x85\x5c\xa3\x93\xef\xf3\xe4\x12\x2a\x86\x7f\x7e\xfb\x8d\xbc\x13\xfc\x29\x7d\x35\x0c\x5f\xa5\x06\xd6\xb0\xdb\xda\x52\x48\x54\xe2\x59\xba\x12\x3f\x8c\x55\x87\x33\x03\x75\x56\x12\xa1\x8f\x07\xc7\xc7\x24\x3e\xf6\xbf\x2c\xab\x43\x79\x3c\xb9\xa1\xf8\xd1\x09\xfb\x9c\x25\xb9\x2f\x2f\x59\xd3\xc6\xad\x1f\xfd\xf3\x0b\x03\x20\xca\xf3\x35\x9d\x54\x42\x99\x38\xc9\x74\xbe\x81\x30\xd1\x86\xf9\x89\x32\xce\xe6\x78\xc0\x69\x9c\xb8\x76\x51\x75\x3d\x9e\x2f\x8a\x97\xa4\x65\xb4\x43\x0f\x1f\x9a\xa1\xa9\x7f\xee\x15\x1c\x2a\x91\xe7\x22\x9c\x7a\x7f\xb2\xa4\x34\x3a\xf7\x2e\xeb\x92\x65\xce\xbd\xcb\xcc\x41\x52\x94\xf7\x8b\xa2\xb1\x4d\x83\x45\x8f\x23\xaa\x78\xea\xb5\xe1\x46\x38\x8d\x03\xd0\x3e\x45\xe1\x11\x0d\xad\x9f\xbe\x25\x6d\xb5\xd5\xb6\xde\xa7\xb1\x3e\xaa\x85\x40\xe4\x2c\x1d\xf4\x51\x8c\x5e\x5c\xc8\xc8\xcd\x3e\xa6\xef\xc5\x6e\x79\x14\xf3\x4f\x1a\xf0\x13\x71\xf4\x56\xc8\x94\x8e\x67\x23\xef\xda\x0b\x84\xff\xd7\x14\x3a\xfb\x2a\xfd\x8c\xae\x92\x70\xcf\x89\x22\xcf\xb9\xe6\x27\xd4\x31\x05\xbc\x98\x2b\x5d\xa9\xe2\x56\xe7\x47\xf2\xa2\xad\x96\xd0\x32\x34\xe0\x57\xbe\x17\xdc\x14\x41\xf1\x73\x23\x75\x9c\xc8\xa3\x24\x8f\x73\xf6\x55\xa7\xc7\x47\xcf\xe5\xa1\x81\x14\xf8\x5d\x7a\x85\x8d\x9c\xe9\x0d\x4f\xb8\x9b\x7a\x9a\x95\xc0\x7a\x4e\x3a\x74\x9b\x3e\x0c\xa4\x77\xcf\x6b\xde\xf8\x53\xfc\x33\xb6\x83\xb6\x3a\xf9\xe8\x0a\x63\x45\xd6\x4f\xe7\x45\xe7\xac\x54\xb1\xea\xd1\x34\x8a\xc2\x68\x3d\x3b\x2a\xae\xe4\xb1\x0a\x46\xe5\x9c\x24\x73\x8f\x0d\x8d\x6f\x31\x4b\xbe\xfb\xab\x6a\x34\x7b\xba\xbb\x36\x44\x4f\xd6\xaf\x1d\xb5\xd7\xe2\x65\x5f\x55\x23\xc5\x37\x6e\xe6\x90\x63\x29\x19\xb9\x46\x45\x94\x46\x1a\x29\xb9\x66\xbe\x54\x35\x9d\x7f\x57\x5e\xde\x4b\x10\x8f\x5c\xf3\x97\x04\x03\xf6\xc5\xf4\xd6\x15\x9f\xea\xa6\x8d\x79\x2e\x0f\x12\x21\x87\xa5\x44\x7e\xc3\x1f\xe2\x3a\xe3\xe7\x6b\x81\xce\x93\xcb\xcb\x7c\x6c\x50\x11\x18\x3e\xa5\xc0\x73\x03\xb9\x8b\x61\x9e\x09\xe6\xc6\x5b\x52\x20\x71\xd3\x8b\xfc\x74\xe9\x84\x54\x39\x0b\x6f\x78\x50\x80\x43\x03\xd0\x0b\x12\x7e\xcd\x23\x32\xc3\x30\x58\x6b\x5d\xf1\x43\x07\xdf\x6e\x1d\x7f\xc5\x85\xe5\x47\x45\xd2\x0e\x6a\xa5\x4a\x5e\xa5\x79\x59\x25\xad\x24\xfc\xb0\x5c\x1a\xfc\x85\xaa\x18\x79\x1b\x51\x1c\x26\xfc\x5a\xa0\xd6\xdc\x89\xdf\xdf\x05\xc7\x51\xb8\xe4\x51\xf2\x50\xf3\xaa\xdd\x08\x61\x40\xe7\xb5\xa3\x58\xd0\xa3\x2a\xf2\xfc\x3c\x46\x83\x4b\x28\x54\x48\xca\xb8\x10\x38\x1a\xe2\x79\x7a\xf6\x6e\x57\x56\x4d\x13\x58\xd0\xa9\xcc\x91\x00\xd6\x0e\x56\x43\x31\x7b\xbd\xeb\x9e\x27\x15\x94\x15\xc3\x5d\x4e\x55\x09\x60\xa0\xa8\xa9\x7f\x32\x24\xd2\x0d\x7f\xd8\xac\x63\x0a\xc3\x55\xb6\x7f\xee\x5d\x1a\xfa\x9e\x7b\x68\x55\x4a\x0a\x72\xed\xf0\xb0\xb8\x0a\xc9\xf3\xa3\xe4\xdc\x62\x07\x52\xff\x01\x62\x7a\xb0\x30\x62\x0a\xdb\x97\xf1\xae\x18\x76\xd9\x5b\x23\x65\xb8\xac\x9a\x10\x59\x4b\x9f\x54\x14\x05\x1b\x8a\xa2\x0a\x0e\x77\x36\xe2\xf0\xa0\x8c\xc3\xf9\xb9\x73\x49\x11\x44\xe1\xc7\x0e\x70\xf9\x7a\xdf\x42\x06\x2f\x1c\x79\xf9\xd5\xc0\x75\xe0\x93\xd4\x6d\x77\xdd\xe8\xfb\x0f\x4a\x8a\x48\xa6\xaa\x7d\x6d\x1a\xbc\x24\x72\x54\x37\x42\xce\x2c\xe1\x11\x4b\x9c\xe8\x9a\x27\xc0\x93\x85\xf1\x13\xaf\xd7\x6e\x9d\x60\xca\x6b\xb6\x79\xf5\x98\x36\xbf\xb3\xa6\xf9\xb7\x5e\x1c\x7b\xc1\x75\x59\x7b\x72\x51\xb3\x89\x2a\xdb\x95\x4e\x72\x2a\x2d\x12\xa3\x22\x90\x3a\xae\x4a\x09\xe4\x5d\x9d\x70\x31\x1f\xd2\x75\x17\x89\xfe\x5c\x81\x9c\xf8\xe7\x66\xf1\x4f\xde\x9b\xb1\x82\x52\xbe\x25\x37\x3e\xe5\x52\x47\xe4\xe7\x85\x8e\xa8\x56\xdd\x16\x29\x2f\x7c\x2e\xa0\xf3\x3c\x5d\x29\xb4\x34\x1f\x56\xb2\x39\x23\x57\x54\x88\x0e\x11\x62\x3f\x27\x39\x2a\x87\x9d\xf3\x9b\x4d\x83\x28\x3e\x55\xfd\xdc\xc3\xa4\x7c\x6a\x3d\x55\xbe\xac\x6b\xe6\x30\x45\x69\x33\x63\x70\x3a\xa7\x75\x51\x6d\xfb\xdc\x69\xfe\xf2\xf9\x72\xdb\x5b\xdb\x93\x43\x21\x3a\x1f\x5b\x7f\xbb\x39\xbe\xdc\x5e\x6f\xe9\x21\x3b\x57\x84\x4c\x13\xe2\x78\x47\x91\x2a\x86\x55\x32\x9a\x81\x22\xa6\xb7\xd0\x0c\x0d\x86\xe3\x3d\x91\xe5\xbe\x98\x1d\x11\xe7\x64\x46\xc3\xe0\x7c\x9e\xa9\xf2\x4e\xa7\xba\xb2\xa9\x27\x9b\x57\xb8\x44\x47\x01\xbb\xab\x16\xfd\x62\x8a\xa2\x94\x31\x8f\xa1\x6e\x31\xc9\x0d\xf5\xca\x22\xa5\x75\x8a\x61\x34\x21\x2c\x4c\x47\x13\xb6\xb2\xd0\x97\x67\x55\x73\x45\xd1\x86\x09\x0c\xe7\x9a\xe9\xa1\xd3\xa1\x7a\xec\x9f\x94\x10\xbd\x4a\xd7\x9b\x15\xbc\xb6\x26\x02\x14\xa4\x47\x23\x9c\x0a\xed\xe6\xf8\xf3\xe5\xd6\xf6\xf5\x9a\xf9\xa0\x10\x65\xfd\xdc\x6e\x6b\x73\x3b\xf5\x05\x5d\xb2\x3e\x60\x4d\x66\xa3\xcd\x79\x6f\xe5\xd7\x6b\x62\xe9\xa4\xac\x14\x6d\x3c\xca\x78\xc9\x24\x42\x35\x43\xb7\xda\xf7\x30\x07\x9d\xe6\x8c\xfa\x55\x67\x93\x4a\xf0\x0b\x77\x6b\xfb\xba\xbe\xbe\xfb\xeb\x24\x9b\x9c\x8e\xb8\xb0\x4e\x23\x63\xcd\x73\x7c\x09\x62\x2e\x0d\x1d\xf0\x54\x86\x0e\x28\x8e\xaa\x68\x4d\xf2\x57\x31\xd8\x5a\x36\x75\xcb\x7c\x1c\xc1\xf2\xfb\x84\x5f\x1f\xdc\x2f\x6b\xd6\xf9\xc5\xc5\xc5\x05\xae\xba\x29\xec\x6c\x83\x59\xd7\x56\xfd\xd1\x2b\x37\xf2\x41\xe6\x3b\x71\x72\x18\xb8\xfc\x3e\xd5\xcf\x61\xdc\x60\x4f\x22\x0c\x31\x5f\x53\xea\x29\x31\xae\xc5\xa6\xb7\xa2\x64\x7c\x2f\xe1\x91\xc1\x9b\x30\x05\x21\xd9\x32\x71\x05\x3a\x9c\x4a\x1b\x57\x91\x22\x97\xe4\x1a\x91\xf4\xec\xb4\xd3\x04\x99\x0e\xc9\x6f\xbf\x31\x0b\xa8\xa4\x5a\x72\x28\x87\xc5\x38\x19\x01\x4a\xec\xf4\x74\x07\x00\xc7\x77\x03\x99\xcb\x94\xb8\x0a\x69\x8f\xa7\xf3\x46\x61\xb4\x35\x7f\x5f\x4a\x55\x69\x21\xe4\x56\xf2\xa5\x69\x88\x87\x50\x26\xc9\x2a\x06\x65\xed\xd4\x48\x43\x13\xad\xdb\xb0\xd0\xbb\xfb\xd4\x7a\x3a\x81\x3f\x39\xaf\x0e\xd6\x53\x6b\x02\x7f\xf2\x9f\x2f\x2e\xe0\xfb\xc5\x45\x21\x72\xea\x84\x59\xff\x6b\xfe\xe3\xd5\x04\x5f\xde\xe6\xbe\x82\xd8\xff\x43\xfe\xe3\x0c\x40\x67\xf9\xaf\x60\x3d\x5d\x04\xf9\xaf\xb0\xd0\xbd\x88\xf2\x5f\x13\xf8\x5a\x88\xe8\x7a\x0b\x5f\x6f\xf3\x5f\xef\x27\x55\x31\xf8\x99\xd1\xf4\xcd\x4b\xec\xcb\x5f\x3b\x5f\x40\xb4\x95\xfb\xc6\x31\xdd\x6d\x4c\x85\x20\x6f\x30\x7b\x50\x70\x48\x97\xc3\x73\xf5\x5d\xf0\xec\x7d\x7f\x3c\x15\x8e\xce\xdf\x82\x4a\x67\x5d\x7a\x6c\x90\xb7\xbd\xe5\xb4\xad\x5c\xf6\x93\xeb\x5a\x25\xb2\xf7\x74\x9e\x5f\x9a\x24\x74\x66\x45\x99\x97\x25\xda\x56\x9d\x9e\x46\x9f\x57\x89\x38\xc2\xd8\x61\x49\xe6\x31\xbc\x0e\xc2\x6b\xed\x94\x5b\x7f\x26\x20\x82\xd8\x5c\xfb\xe1\x95\xe3\x9b\xba\x0b\xfa\x3c\x0a\x1c\x9f\xb6\x65\x27\xec\x74\xe9\x04\x6c\x49\xf5\xc6\x6c\xb1\x8a\x13\x76\xc5\x19\x95\xd7\x24\x33\x37\x6a\x00\x13\x85\x8a\x1a\xa1\xe0\xae\x8f\x0e\x18\x34\xf1\x7c\xde\xbe\x94\xb6\xc1\x93\xac\xfe\x4a\xdb\x07\x8d\x6f\x50\x7f\x72\x27\xb9\xcc\x00\xc2\x6d\x5a\xbd\x3d\xbb\x20\x63\x1b\x88\x83\x1c\x03\xc3\x10\x08\xd0\x75\x67\x29\x68\xb3\x64\x7b\xc4\xb8\x21\x5c\x61\xdf\xa8\x3b\xc4\x86\x46\xd3\x85\xf2\x9a\x41\xdf\xbe\x88\x45\xd8\x86\x94\xdb\x4d\x4b\x07\x18\xa0\xed\x8b\x78\x6b\xfb\x7a\x91\x5f\x5b\xae\x1b\x5f\x96\xdf\xa5\xa5\xfe\x18\x94\x77\x42\x1c\x10\xd5\xb3\x30\x23\x62\xa7\x3c\x6b\xa3\xfe\x08\xf2\x34\x68\x5e\x37\x6d\xc5\xc2\x6c\x79\x50\xcb\xfb\x59\xcd\x6c\x6f\xa4\x3c\x12\x06\x9c\x85\x33\xe2\x0f\x8a\xc9\x3f\x0b\x57\x81\xab\xcf\xf3\x82\xbe\x54\x86\x42\x59\x9e\x93\xef\xc4\xf5\xdb\xbc\x9a\xce\xc3\x07\xeb\xb0\x9a\xa1\x63\xe7\x2c\x63\xef\xec\xe4\x68\x22\x4f\xa1\x95\xcf\xef\xdf\x9d\x9d\xbc\x37\xe5\xec\x1e\x9d\x09\x4f\xf5\xca\xc7\xb7\x07\x67\xbb\xd2\x53\x7d\x15\xee\xd9\x76\xa9\x86\xdd\xc1\xe9\xde\xee\xf1\xc1\x84\x75\x86\x0d\xed\x63\xee\xcb\x6b\x7b\xc2\x6c\xbb\xa3\x7e\xe9\xc0\x97\xae\xfa\xa5\x0b\x5f\x7a\xea\x97\x1e\x7c\xe9\xab\x5f\xfa\xf0\x65\xa0\x7e\x19\xc0\x17\xad\xad\x21\x7c\x19\xa9\x5f\x46\xf0\x65\xac\x7e\x19\x4f\x98\xdd\x69\x6b\x18\xb6\xe1\x93\xad\x7d\x02\xac\x3b\x1a\xd6\x36\xa0\xdd\x51\xd1\x7e\xff\xee\x60\xc2\x7a\x6a\xe5\x67\x9f\xde\x4f\x58\x5f\xad\xfc\xec\xa7\x93\x83\x83\x09\xeb\x6b\xb5\xbf\xff\x70\x32\x61\x7d\xad\xf6\xc3\x8f\x00\xa5\xd6\x7e\x7a\xf8\x97\x09\xeb\xab\x44\x39\x3d\xf8\x78\xf0\x6e\xc2\xfa\x2a\x59\x0e\x0e\x7f\xfc\xe9\x6c\xc2\xfa\x2a\x61\xde\x1d\x02\x62\x7d\x95\x32\x7f\x3d\x38\x79\x3f\x61\x3d\x95\x34\xaf\x76\xf7\xde\x9c\x1e\xef\xee\x1d\x4c\x98\xf6\xf9\xcd\xe9\x71\xee\xcb\xa9\x9e\x3e\xdb\x7d\x35\x61\x6a\xaf\xff\x3c\x61\x23\xb5\x7f\x9f\x26\x6c\xa4\x31\xc5\x84\x0d\x54\xf8\x93\x09\x1b\xa9\x9d\x3f\x9b\xb0\x91\xda\xcf\x7f\x9b\xb0\x91\x0a\xff\x61\xc2\x46\x6a\x9f\x0f\x27\x6c\xa8\x8d\xc3\x84\x0d\x55\x78\xc0\x5f\x1d\x83\xbd\xdd\xe3\xd3\xcf\x47\xef\xf7\xde\x4c\x58\x27\xff\xbd\xe4\x73\xee\xd3\xee\x84\x0d\x54\x0c\x80\x22\x2a\x06\xfb\x13\x36\xd0\xd8\x6e\xc2\x86\x6a\xf9\x1f\x27\x6c\xa8\x52\xe8\xa7\x09\x1b\xaa\x14\xf8\xd7\x09\x1b\xaa\x14\x78\x33\x61\x43\xb5\xbd\xa3\x09\x1b\xaa\x23\x8c\xae\x2e\x26\x4c\x9b\x44\x07\xef\xce\x72\x5f\x4e\x0e\xce\x3e\x9c\xbc\x2b\x7e\xcc\x7d\xf9\xeb\x84\x8d\x55\x64\xff\x32\x61\x23\xb5\x33\x7b\x13\x36\x50\x87\xf3\xe3\x84\x8d\x54\x64\x5e\x4d\xd8\x40\x63\xbf\x09\x1b\xaa\xe5\xdf\x4e\xd8\x50\x2d\x2f\x98\xae\xdb\xd1\xbe\xe5\x3e\x1c\x9f\x1c\xbe\x3b\xfb\x7c\xba\x77\x72\x00\x2c\xdf\xd3\xb3\xce\x40\xca\x68\xdf\x4e\xf7\x4e\xde\x1f\x1d\x89\x41\xb6\x7b\x7d\x3d\xeb\xa8\xf0\x11\x7d\x32\x4c\x98\x26\x19\x5e\x9d\xe4\xbf\x90\x73\x86\x09\xd3\x8a\x1e\xbe\x3b\xcd\x7d\xf9\xe9\xfd\x5b\xe8\xcf\x40\x0b\x13\xfa\xe3\xc1\xe7\x0f\xd0\x29\x95\xd2\xc7\x3f\x16\x3e\xed\x1f\x1c\x1d\x9c\x81\x18\x19\xe8\x1f\x73\x5f\x0e\xde\xed\x4f\x58\xb7\x9f\x6f\x61\xff\xfd\xa7\x77\x13\xd6\xed\x69\x6d\x98\x3f\xe6\x3f\x21\x26\xea\x30\x51\xb1\x9e\xca\x09\x27\x24\x5c\xba\x2a\x4d\x8e\x0e\x40\x27\x75\xd5\x01\x15\xfe\x10\x80\xc6\x1a\x13\x1f\xb7\x27\x6c\x3c\xd0\xbe\xd8\x13\x36\x1e\x6a\x5f\x3a\x13\x36\x1e\x69\x5f\xba\x13\x36\x1e\x6b\x5f\x7a\x18\x4b\x5a\xfb\x04\xda\xa0\x6d\x6b\x9f\x40\x1d\xb4\x3b\xda\x27\xd0\x07\xed\xae\xf6\x09\x14\x42\x5b\x47\x13\x34\x42\xbb\xaf\x7d\xfa\x7c\x7c\xf4\xe1\x14\x3e\xeb\xc8\x7e\xde\xdd\xdf\x37\x7c\x7d\x7b\xf8\x8e\xa0\x75\xb4\x3f\x9f\x7e\x78\x75\x76\xb2\xbb\x77\x66\xca\x3a\xdb\x85\x09\xdc\x1e\xe4\x6a\xfa\x70\x74\x76\x78\x7c\xf4\x6f\x86\xac\xfd\xc3\x8f\x87\xfb\x07\xa0\xce\xec\x5c\xc6\xc1\xde\xe1\xdb\xdd\x23\xc8\x69\xe7\x3a\x71\x70\x72\xf8\x7e\x3f\x9f\xf1\x6e\xf7\xe3\xe1\x8f\xbb\x67\x07\x9f\x41\x03\xa0\xab\x48\x53\xe6\xeb\xf7\x27\x9f\x76\x4f\xa0\xb0\x36\xf9\xc9\x09\x01\x7c\xd5\xe4\xdd\x87\xa3\xa3\x74\xb2\xda\x9a\x68\xfc\x74\xf8\x6e\xff\xfd\xa7\xcf\xef\x3f\x1e\x9c\x7c\x3c\x3c\xf8\x04\xd9\x1d\x6d\xd2\x01\x8f\xbd\x3b\x38\x3d\xc5\xe9\xd2\xd1\x34\xbc\x92\x49\xdc\xd9\xb1\x87\x95\x96\x8a\x3c\x61\x31\x86\x02\xae\xf4\x25\xa9\x4e\x3e\x11\x0e\x78\x53\xcf\x92\x14\x12\xb8\xc2\xaf\x64\x06\xaa\x3e\xb1\x9e\x94\x1a\xe5\x4c\xbb\x8a\xfc\x88\x57\xd9\x8a\x31\x6a\x6c\x74\x3f\xbc\x0b\x1e\xdd\xec\x26\x0f\x51\xab\x1a\x3e\x0b\xcf\xc2\xc7\x75\x76\x83\xd7\xf4\xd5\x0d\xbe\x0a\x93\x24\x5c\xfc\x63\xda\xa4\xcb\xf0\xe2\x91\xc2\xf4\x66\xc3\x46\x1f\xff\xd0\x95\xa9\x4b\x8c\xf4\x8a\x97\xef\x5d\xb5\x66\x56\x83\xe1\x8f\xd3\x24\x8c\x9c\x6b\x6e\xd5\x61\x72\x44\x7c\xc6\x23\x1e\x4c\xf9\x5b\x27\x70\xf2\xdb\xf0\xb9\x45\x60\x11\x5a\x0d\x48\x16\xb6\x5c\x8a\xb1\x25\xea\x6f\x30\x6b\x1b\xaf\x96\x6d\x2f\xa3\x70\xe6\xf9\x3c\xde\x2e\xac\xa1\xe5\x82\xd1\x80\x88\xac\x2d\xcb\x50\x97\x8a\xef\xaf\xfe\x9d\x4f\xf1\x3e\x74\x5c\x4b\xea\x86\xdb\x9f\x45\xa2\xe2\x52\x0c\x8f\xbf\x79\x56\x27\xde\xc3\x3a\xe7\x97\x7a\x6c\x65\xe3\xd5\x5f\x13\x8e\x53\x27\xe1\xd7\x61\xe4\xf1\x6c\xe5\x56\x01\xd3\x52\xa2\x2a\x5b\xf2\xb7\xb5\xae\xd0\xee\x72\xc9\x9d\x48\xec\x0b\x58\x59\x6a\x6d\xc1\xbd\x70\xf9\x20\x2f\x03\x5a\x69\x62\x6d\xb1\x53\x58\xbd\x42\x7f\x2c\xfa\xb5\xbe\x00\x72\xb5\x08\x32\x9f\x26\xd6\x16\x53\x43\xd3\xcb\xdf\x6b\x0b\xbd\xf5\xe2\x29\xf7\x7d\x27\xe0\xe1\x0a\x71\xd4\x3e\x54\x16\x7f\xd8\x4f\xaf\x3e\xe0\x35\x6d\x95\xb3\xdd\x89\x91\x05\x8d\xa3\xa0\x4c\xea\x84\xdf\x27\x13\x75\x4c\x58\x6d\x16\x06\x49\xdc\x60\xd3\xd0\x0f\xa3\xb8\xc1\xbc\x85\x73\xcd\xe3\x7a\xf6\x8c\xea\x71\xad\xa6\xa3\x56\x6c\x94\x9e\xd2\x30\x1a\xd3\xac\xfa\x0d\x2a\x95\xc4\x6e\xc8\xaa\x52\xea\x3f\x1a\x3f\xc9\xc4\x45\xf4\x52\xf6\x7e\x14\x6a\x29\xf7\xa4\xb8\x65\xfc\xf4\x68\xe4\x88\x7d\x8b\xa8\x09\xb6\x7e\x14\x62\x1a\x9b\xa5\xc8\xc1\xd7\x96\xf5\xe5\xd2\xc8\x76\x45\xf9\xa5\xdb\x1b\x96\xe3\x27\xcd\xeb\xa8\xb9\x08\x5d\x6e\x4d\xd8\xf9\xa6\xa4\x16\x91\xae\xce\xe9\x1f\x2b\x08\x03\x2e\xdf\x1a\x34\xc5\x43\x83\x34\x0c\x68\x83\x29\x81\x4a\x2f\x1b\xcc\xa2\xd7\x54\xcc\x09\xd8\xae\x9f\xfc\x18\x31\x97\x27\x5c\xf8\x1e\x77\xa6\x37\x3f\x7f\x9a\xf3\x55\xe4\xc5\x89\x37\x6d\x5d\x04\x17\xc1\x53\x68\xe3\xe9\x84\xed\xae\x92\x90\x20\xd9\x95\x13\xe3\x9e\x17\x0b\x9c\x5b\xef\xda\x49\xc2\xa8\xe5\x3b\xc1\xf5\xca\xb9\xe6\x93\x8b\x80\x7a\xf6\x94\x07\xcd\x55\xfc\x94\xed\xbc\x60\x4f\x01\xbd\xa7\x0d\x3a\x5c\x82\x74\x8a\xce\x53\xa8\x1e\x32\x27\x6c\xdf\x8b\x9d\x2b\x9f\x33\x27\x78\x10\x68\x45\xdc\xc7\x23\xa3\xc5\x2a\xb8\xf6\x82\xeb\xd6\x45\xf0\x54\xf6\x0f\xd0\x89\xe3\xd5\x82\xb3\xbd\x24\xf2\xb7\x76\xfd\x84\x2d\xb8\x13\xc4\x54\x12\x20\x65\xef\x33\x48\xf8\xc2\x0c\x90\x19\x32\x29\x28\x7e\x32\xc0\x5a\x6a\xf0\x0b\x1c\xba\x34\xe2\x41\xd3\x8b\x9b\x0b\x9e\x38\xd9\x97\x47\x0d\x27\xbe\x4d\xb9\x0a\xf1\x8a\x85\x75\x38\x63\x31\x4f\x1a\x6c\x15\xb8\x21\x8f\x59\x32\xe7\x8c\xa2\x1c\xb3\xf7\xa7\x80\x4b\x33\x75\x70\xd3\x7c\xb1\x7f\x70\xc4\x22\xbe\x70\x96\x0d\x16\x87\x2c\x99\x3b\x09\xd3\xf0\x62\x5e\xe0\x72\xee\x32\x2f\xd6\xbf\xb7\x8a\x7d\x11\x3d\xf8\x7a\xbc\x4f\x79\xc2\xee\xe6\x3c\x99\xf3\x08\x91\x76\xfc\x44\xde\x18\x8a\x99\x13\x33\x07\x83\x85\xe0\xa7\x30\xa2\x0f\x2e\xf0\x59\x30\x4d\x24\xac\x01\xad\x98\x07\x6e\xdc\xbc\x9b\x3b\xc9\xa3\x30\x93\x41\x02\x1b\xec\x3c\xfb\x29\x02\x21\x36\xf4\xf0\xa8\x30\x27\xf6\x28\x20\x4a\xcc\xe6\xb8\xc5\x9b\x21\xef\xc5\x6c\xee\x04\xae\xcf\x5d\x9c\x0d\x4c\x9c\xe0\xb4\xe8\x3f\x76\xca\x03\x17\x66\xd2\xc1\xe9\x1e\x5b\x46\x7c\xe6\xdd\xb7\x00\x08\xdb\x69\x49\xa0\x5d\xd7\x65\x76\x67\xc4\x92\x10\xab\x5e\x05\xb8\x4b\xcb\x5d\x36\x9d\x3b\x91\x33\x4d\x38\x52\xc3\x0b\xd8\x3d\xde\xc2\x87\x0a\x14\xfc\x5a\x2d\xf6\xc9\xf1\x12\x3c\x80\x87\xe2\x37\xfc\x61\x19\xf1\x38\x66\x18\xd3\x94\x39\x81\xcb\x62\xce\x19\x50\x08\xf3\x45\x51\x26\x67\x62\xf6\x5f\xec\x3c\xc4\x2d\xc6\x6a\x67\x73\x2f\x66\x77\x61\xf0\x34\x61\x77\x61\x74\xc3\xee\xb8\xef\xc3\x74\x5e\xfa\x4e\x32\x0b\xa3\x45\x0c\xc3\x18\x71\xac\xad\x58\x8b\xac\x7f\xc9\x23\x02\xc6\x0b\x85\x20\xe6\xc4\xdd\x30\xc4\x34\x06\x7e\x05\x22\xca\xf8\x52\x71\xab\x9e\x1b\xdc\x95\xeb\x5d\xf9\xbc\x79\xc5\x7d\xbf\x19\x83\x3c\xde\x60\x80\x85\x3c\x47\x23\xb6\x19\x71\xda\xcd\x9f\x90\x89\x09\x15\x86\xdb\x50\x1d\x8c\xf0\x8a\x9e\x5d\x7d\x38\x39\x62\xe1\x0c\xbb\x22\x1f\xc4\x31\x00\x61\xd8\x62\x8b\xb1\x83\xc5\x32\x79\x90\xe7\xd2\x80\x79\x10\x32\x81\x1a\x02\xe6\x58\xd2\xe5\xf1\x4d\x12\x2e\x9b\x41\x08\x0b\x49\x7a\xa2\x8c\x5d\x78\x04\xf2\xea\xac\x79\x7a\x38\x63\x49\xb4\x02\x13\x54\x45\x2f\xa6\x08\x47\x9c\xc1\x8c\xbd\xa6\x67\x30\x77\x9e\xef\xb3\x69\xc4\x9d\x84\x33\x87\x7d\xe2\x57\xec\x9d\x82\x44\x8b\xcd\x93\x64\x19\x4f\xb6\xb7\xef\xee\xee\x5a\x77\xdd\x56\x18\x5d\x6f\x9f\x9d\x6c\xab\x88\xc6\xdb\xc0\xc3\xfb\x5e\xbc\xf4\x9d\x07\xe8\xaf\x96\xc9\x22\xfe\xb7\x95\x17\xf1\x18\x86\x76\xe1\xc5\x31\x8e\x65\x14\x2e\x88\x6b\x61\x01\xcd\x3e\xcd\x39\x1d\x77\xb1\x10\xdf\xfc\xc0\xfc\x88\x79\x82\xac\x8d\xbd\xc0\xa1\x20\x54\x9d\x24\xe1\x8b\x25\xe6\x39\xf1\x4d\x5a\x09\x12\x59\x69\xc1\x9b\xb1\x80\x4f\x79\x1c\x3b\xd1\x43\x0b\xba\x94\xb2\x70\xcc\x16\xce\x03\xe0\xc8\xe2\xb9\x38\x79\x51\x0b\x02\xba\x3c\x4e\xa0\x02\x2f\x61\xae\xe7\x22\x28\xbd\xf9\x01\x1a\x21\xea\x0e\xb5\x49\x9c\x89\x53\x58\xc8\x52\x7e\x9f\xf0\x20\xc6\x7e\xdf\x79\xc9\x1c\xd1\xb3\x34\x7a\x58\x6a\x63\x73\xe7\x96\xab\xe9\x24\x04\xe1\x05\x74\xd4\x89\xd8\x7a\xaa\xb1\x4b\x36\x7c\x4d\x34\x07\x37\xe0\x12\xc5\xca\x64\x56\x74\x7d\x55\xb3\x07\x0d\x46\xff\xaf\xa3\x92\xc7\x7a\x1a\xcc\x3a\xd3\xd9\x03\xbf\x93\x94\xe0\xf7\x09\x75\x2a\x08\x59\x88\xf2\x98\x32\x9d\x24\x89\xbc\xab\x55\xc2\xe3\x1c\x57\x2b\x68\xa2\xb5\xfa\x58\x34\x01\x9d\xf4\x22\x9d\xb5\x77\x7a\x4a\xf7\xde\xe4\xcc\x53\xb0\xc4\xea\x4b\xa6\x1d\xe5\xc1\x18\xbd\x0e\x23\xc6\xef\x9d\xc5\xd2\x27\x8b\x62\x15\xf9\x35\xc9\xde\xd7\x61\xd8\xba\xf6\xb7\x9d\x80\xbb\x67\x6f\xea\x90\xeb\x7b\x01\x77\xa2\xe6\x75\xe4\xb8\x1e\x0f\x92\x5a\x12\x2e\xd9\x15\xae\xeb\x1b\xec\xca\x07\xae\x8c\xb8\x5b\x2f\xed\x71\xec\xfd\xf2\x7b\x77\x98\x41\x1b\x2d\xc6\x44\x04\xea\x18\xd8\x07\x0c\x9f\xf2\x61\x58\x86\x31\x2e\x53\x7e\x6f\xc4\x64\x3b\x46\xb2\xdb\xed\x3f\xc2\xff\xe1\xe7\x94\x07\x09\x8f\x8a\xe8\x92\x1d\x42\x4a\xfa\xfb\xd8\x3e\x42\x1a\x0a\x64\xc9\x8a\x89\xe7\xe1\xca\x07\x3d\x17\xb8\xec\xd5\x29\xab\x3d\xbd\xb8\xb8\x6f\x8f\x9e\x36\x98\x73\xe3\xb0\x9f\x7f\xaa\xb7\x18\x7b\x0f\x7c\x7e\xe7\xc5\x3c\x57\x14\x94\xb8\x5a\x1c\x8a\x0e\x67\x4f\x73\x94\x4f\x35\x71\x73\xe1\x2c\x9b\xe1\x2d\x8f\x22\xcf\xe5\xf1\x23\xa9\x2f\xcc\x71\xa4\x38\xc8\x76\x54\xb4\x20\x21\x97\x7c\xea\xcd\x3c\xee\xa2\xd5\x13\xb0\x90\x76\x2e\xd8\x61\x82\x86\x19\x8b\xf1\xde\x05\x73\xa2\xc8\x79\x68\x08\xe5\xcb\x9d\xe9\x9c\x2d\xc5\xe5\x12\x00\x83\x6e\x65\x06\x03\x08\xdd\x69\xe8\x72\xd4\xff\x90\x25\xee\x93\x2b\xf5\x93\x39\x58\x68\x80\x79\x49\xcc\xfd\x59\x8b\x1d\x06\x04\xa1\xb7\x6e\x6c\x37\xe2\x53\xee\xdd\xea\x16\x4b\xbe\x5d\x48\x08\x91\xa8\x02\x1a\x19\xeb\x57\xab\x6d\x4d\x7e\xb5\xb6\xac\x89\x75\x71\xb1\xea\xd8\xe3\x8e\xd5\xb0\x1a\x69\xaa\x6d\x35\xac\x66\x9a\xb2\xad\x86\xd5\x4a\x53\x5d\x60\x93\xb6\x48\xf6\x47\x23\xeb\xcb\x17\x5d\xe4\xa2\x6b\x90\x66\x18\x34\xf9\xbd\xb7\x89\xcd\x98\x5b\xd6\x3d\x69\x2b\xec\xf8\x49\x98\xb3\x28\x9f\x50\x93\x61\xed\xd8\x57\xf2\x11\x02\xa3\x45\x9a\x7a\x1a\x2e\x16\x40\x14\x68\x36\x2f\x5f\xe9\xf1\x67\xf3\xca\xf7\x82\x9b\x47\xf2\x94\x36\x3d\x8a\xf8\x60\x95\xd4\x3e\xf9\xe3\xbb\x7a\x90\xe6\x58\x05\x0e\xcd\xe9\xc3\xd4\x7f\xac\xd0\x3b\xb7\x79\xb7\xc1\xfa\xed\xf6\xa5\xc2\xe3\xa8\x85\x64\xd3\x88\x4b\x04\xba\xd7\x0b\xd8\xc2\xf3\x7d\x2f\xe6\xd3\x30\x70\x63\x64\x82\x5d\x96\xdc\x85\x8c\xfb\x7c\x81\x76\x2b\xb1\x1b\x60\x3e\xf3\xa2\x38\x01\x09\x75\x37\xf7\xa6\x73\x34\xb9\xc3\x3b\xe6\x87\xc1\xb5\xda\x31\x31\x89\xaf\x38\x0b\x83\x06\xa3\x8a\x35\x58\x2f\x51\x61\x66\x33\x73\xff\xbf\x56\x0d\x3b\xb5\x4e\xbf\xdf\x60\x6d\xfa\x5f\xab\x5f\xd4\xc5\xa4\x63\x85\xa0\xbd\x15\x8f\x85\xa9\xd5\x3c\x2a\x00\xd9\x5c\x3a\x3e\x4f\x12\xfe\xbd\xa4\x8d\xf5\x5e\xd4\x23\x36\x9e\xa4\x05\x29\x8d\x73\xd1\x1c\x0e\x05\x0a\xa6\xa9\x13\x00\xa5\xf2\xc2\x89\x84\x04\x58\x0b\x99\xa0\x62\xb0\x26\x35\x0a\x31\xa0\x3a\x88\x18\x5c\x39\xbb\xc8\x91\x7c\x53\x89\xe6\xb0\x60\xb5\xe0\x91\x37\xc5\x55\xea\x3d\xf3\x02\xb1\x42\x22\x4a\xaa\x08\x7f\x84\x4e\x96\xa0\xec\x2f\xc2\x38\xc1\x8d\x83\x69\x1c\x8b\xb2\xf4\xa8\x92\x31\x92\xc0\xc1\xd4\x5f\xb9\x3c\x66\xff\x74\xf2\xe3\xab\x06\xfb\xa7\x93\x93\x1f\x7f\x7c\xf5\xaa\xc1\xc0\xb8\x6a\xb5\x5a\x75\xfc\xe5\x88\x9f\x0e\x2e\xe8\x1e\x44\x3d\x81\xb3\xc0\x25\x38\xac\xab\x23\x58\xd0\xc4\x21\x5b\x3a\x51\x22\x87\x39\x4e\xc2\xe9\x0d\xfb\x8b\x6d\x43\x15\xad\xe4\x3e\x61\x33\xcf\x27\x94\xff\x2d\x5c\x21\xbe\xab\x98\x33\xda\x44\x01\xea\x10\xea\x0f\x54\xa5\x3a\x3c\x24\x47\x33\x06\x86\xf9\x7d\x85\x12\x3f\xb8\xe6\x6e\xda\x95\x18\xea\x9b\xad\x7c\x5a\x64\xdd\x78\xcb\x25\x18\x50\x0e\x8b\x17\x8e\xef\x03\x3d\xaf\x38\xf2\xa0\x17\xb8\xde\x94\xc7\x99\x78\x4a\xe5\xb4\x71\xbc\x0b\x0c\xba\x7c\x00\x11\x1a\xe3\x56\xd1\x06\x7c\x99\x6d\x52\xea\x02\x74\x77\x95\x84\x0b\x27\xf1\xa6\x8e\xef\x03\x4d\x97\x0f\x6c\x11\x02\x45\x62\xe9\xd2\x47\xae\x8a\xa7\xd2\xbb\x59\x0e\x95\x55\xcc\x9b\x82\x46\x4d\x12\xb9\x4d\xa8\xe6\x91\x38\x99\x84\x68\x12\xe2\xd8\xa8\x83\x20\x64\x3a\xe2\x79\xc5\xe7\xce\xad\x17\xe6\x6c\x1e\x3c\xc3\x69\xa6\xd8\x37\xf1\x01\xdd\xd7\x60\x64\x52\x33\xa8\x5f\xb8\x43\xeb\xfc\x8c\x42\xf4\x48\x0f\xda\xf0\x82\xeb\xfc\x48\x25\x91\xdf\x5c\xfa\xab\xb8\xb9\xf0\x82\x55\xdc\xfc\x85\x47\x61\xf3\x97\x30\x5c\x3c\xce\x06\x6b\x9b\x6c\xb0\x3d\xa8\xfb\xd8\x5f\xc5\xdb\xe8\xf9\x67\xfb\xaf\x3c\x0a\x65\xe8\xd8\x98\x41\x23\xad\x8b\xe0\x70\xc6\x66\x8e\x1f\x4b\x70\x8c\x99\x59\x5d\x48\x40\x62\x36\xda\x64\x31\xfb\xf9\xb3\xda\x1a\x16\x71\xc1\x42\x4e\xe6\x25\x3d\x9e\x6e\x4a\xf2\x12\x3b\x13\xf7\x0d\xf7\x80\xa6\x1e\x8f\x61\x05\x49\x3d\x46\x0b\xf1\xe7\x3d\x18\x88\x79\x48\x4b\x4b\xec\x5c\xeb\x02\x9d\xbc\x6d\x61\xef\xb6\xf6\x24\xd6\x1a\x20\xd5\x90\xd5\x48\x05\x0d\xb8\xdf\x36\xd1\x33\xdb\x37\x22\xff\x91\x61\x2d\x05\xe4\x3f\xae\x41\xfe\xa3\x44\xfe\x63\x11\xf9\xac\x46\x13\xf2\xdc\x89\x93\xa6\x13\x7b\x4e\xd0\x74\x16\x57\xde\xf5\x2a\x5c\xc5\x4d\x27\x6e\x26\x77\x21\x68\xd5\xd5\x62\x93\xc5\xca\x06\x7b\x87\x07\x4e\x9c\xb0\x5d\x68\x87\xed\xca\x76\x32\x7b\x32\xa6\xa5\x38\x98\x12\xd4\x28\x43\xbf\x6e\x79\x5c\x03\xe7\xca\xe7\x4d\xdc\x87\x6b\x0a\xee\xfb\x7a\xec\xce\xa2\x15\x07\x6a\x51\xad\xb4\xbb\x27\x79\x5a\xc1\xac\x41\x64\x03\x48\xef\x3a\x08\x69\x07\x6d\x81\xca\xe0\x13\x7f\xea\xfb\x2c\xe2\x20\x7c\x49\xee\x03\xd5\xae\x1e\x12\xce\x6e\x79\x44\xdb\x10\xa4\x52\x28\x72\x7b\xae\x66\x16\xf1\x6b\x27\x72\x7d\x1e\x0b\x30\xda\x76\x49\x8a\xb3\x43\x74\xfc\x2a\xf4\x37\xd9\x4e\x33\x98\x13\x49\xe4\xc5\x89\x93\xf0\xb4\xdf\xde\x8c\xdd\xa5\x8a\x09\x04\x26\xd4\xcd\xee\xd0\x2b\x1e\x9b\x85\x41\x92\xdb\x73\xc0\xe5\x57\xe8\xbb\xdb\x57\xb4\x8f\x9e\x6e\x3a\xb4\x18\x7b\x2d\xe9\x23\x05\x2f\x85\xab\x56\x6b\x6b\x31\xf6\x6e\xe5\xfb\xb8\x6b\x94\x1e\x39\x94\x77\x12\x18\x90\x1a\x7a\xac\x5d\xdd\xce\x0f\x70\xb1\xa3\xd4\x01\x61\x4e\xd5\x46\x4d\xbb\xcf\x40\x1c\x33\x7b\xa0\x9b\x28\x75\x24\x01\x58\x0d\x45\x32\x18\xfa\x1f\xca\xa5\x6a\x49\xb7\xbe\x66\x95\x50\xdd\x1b\x95\xf1\xc8\x4a\x37\x8e\x8a\xe0\x5a\x0f\x06\xc1\x88\x5a\xaa\xa4\x71\xff\x73\xa3\x85\x7e\x99\xce\x3b\x05\xbb\xdd\x61\x0b\x1e\xc7\xce\x35\x97\xc6\x6a\xba\x09\x9a\xda\x2d\x28\xa1\xee\x22\x0f\x04\xd3\x1a\x53\xa1\x80\x24\x16\xfb\x16\xeb\xc5\xf7\xc5\x71\x00\x62\x91\x84\x84\x08\x73\xbd\x88\x4f\x13\xff\x41\x22\x14\x3f\xc4\x09\x5f\xac\xc3\xcb\xe5\x53\xbb\xf3\x15\x0b\x52\x55\x12\x9d\x28\x03\x09\x38\x3d\x8d\xd5\x6d\x56\x32\x16\xb5\xd5\x20\x0e\x36\x4c\xe8\x15\x58\x8e\x60\x2b\xee\x1f\xec\xb1\xe3\xc8\xbb\x85\x65\x1a\x3a\x1f\xb2\x3b\x3a\xbe\x3c\xb8\xf5\xa2\x30\x80\x55\xda\xa3\x91\xfd\xf5\xec\xe0\xe4\xed\x84\x59\x78\x92\xd1\xec\xf4\x07\xb4\x42\xfa\x92\x5f\x31\x4a\x83\x4b\x69\x8b\xdd\x3a\x91\x07\x84\x8a\x1b\xfa\x36\x89\x4e\x4c\x90\x11\xcd\x99\xb3\xf0\xfc\x4d\xd4\xbf\x3a\x47\x9e\x5a\xfb\xfc\xdf\x9d\x8f\x2b\x76\xea\x04\x31\x7b\x1b\x06\x21\x60\x73\x00\xf2\x37\x0c\x64\xfa\x75\xc4\x39\xfc\x6c\x30\xeb\x2d\x0f\x7c\x04\x39\x13\x4c\x69\x35\xd8\x22\x0c\x42\xdc\x57\x7a\xaa\xed\xb3\x89\x7d\x3d\x21\x0e\x11\xb9\xf4\xbc\x26\x65\x69\x90\x0d\xa6\xce\x7c\xc5\xfe\x23\x46\xde\xf5\x82\x24\x4f\x4e\x6c\x1f\xea\x83\xf9\xb4\xf4\xee\xb9\x9f\xdf\x89\xa0\x16\x17\x21\x99\x56\x8f\x5d\x07\x3b\x41\xe2\x39\xbe\xe7\xc4\xdc\x2d\x6e\x34\xea\x55\xa7\x2b\xbd\x02\x02\x11\xff\xd6\x1d\xf1\x4e\xaf\xdd\x60\xf2\x4f\x71\x21\x9e\x35\xf1\x4d\x9b\xe2\xf3\x70\xc1\x9b\x37\xfc\x21\x6e\xd2\xbd\xaf\xef\xb0\xab\x09\x55\x6e\xf3\xf4\x2c\x47\xe8\x79\x8d\x4b\x52\x27\xb6\x74\xb2\x07\x36\x5b\xae\x18\xda\x79\x50\xe6\xe3\x19\xbb\xe1\x0f\x53\xf4\x3e\x83\x4b\x74\x61\x7e\x80\xe8\x4c\x8b\x90\xb5\xf7\xf1\x0c\x77\x0b\x63\x53\xa5\xd4\x62\xae\xf7\x37\xfc\xe1\x0a\xd6\x90\xc1\x75\xfc\xf8\x7b\x09\xd9\x0e\xe8\x2e\x5b\x38\x4b\x30\x59\x70\x1b\x56\xba\x9f\x4a\x42\xc5\x95\x04\xe0\xfe\x46\xc9\x4d\xd7\xeb\x0c\xd6\x32\x2c\x8c\xd8\x02\x94\x92\x7c\xfb\x02\x25\x63\x36\x0b\x41\x34\x73\x97\x5d\x3d\x30\x3a\x52\x86\xee\x89\x9a\xa8\xa7\x62\xaf\xc0\xe5\x53\x0f\x4c\x8c\x30\x62\x73\x7e\xef\xc8\x24\xad\x94\xe3\x06\x6e\x74\xd0\x49\x6f\x7a\x39\x54\x54\x23\x6f\x88\x16\x37\x1d\xe4\xb9\x05\xac\xe7\x71\x30\xc2\x54\x20\x37\xc4\xce\x89\x38\x0a\xd5\x2a\x7d\x8d\x6d\xcd\xc0\xba\xe1\xf7\x4b\xdf\x09\xf0\xc8\x48\x6e\x25\xcc\xc0\x14\x4a\x1a\x78\x98\x9b\x3b\xeb\x38\xfa\x74\x12\xb8\xb4\x93\x7a\x8a\x9b\xa8\xda\xf0\x5c\x04\xbf\x5e\x04\x8c\x96\x05\xcd\x5d\x3f\x69\xbe\xb1\x26\x62\xa1\x9a\x5d\x36\xb4\x1a\x19\x0c\xad\xd1\x8e\x00\xea\x78\xf7\xf4\x54\xcd\xfa\x09\xdf\xc1\x3e\xfd\xe9\xe0\xe8\xe8\xfd\xc5\x45\x70\xf1\xd4\xba\x08\x72\xfb\xab\x0b\xe7\xbe\x49\xfd\x6f\xca\x21\xdb\x60\x66\x64\x17\x8e\x6c\xae\x08\xaf\xb7\xce\x3d\x13\xef\xf1\xc2\x19\x73\xd8\xfe\xde\x69\x83\xbd\x3f\xdd\x6b\xb0\xe3\xb7\x48\xca\xdd\xe3\xd3\x8c\x6f\xae\x38\x4c\x6d\xb0\x6b\xae\xbd\x5b\xce\x56\x4b\x64\xe7\xcc\xd6\x26\x26\xd0\x67\x31\xc6\xbf\xa7\x69\xec\x44\xbc\x39\x83\x5f\xdf\x61\x26\x4f\xc3\xe0\x96\x47\x09\xc3\xea\x89\x27\x89\x0b\xbc\x88\xbd\x06\x76\xe2\x7f\x5b\x79\xb7\x8e\xcf\xc1\xa2\xcd\xd6\xc8\x3e\xd7\x0f\xe9\xe9\x6e\x81\x3c\xd7\xcf\x4b\x20\xbc\x51\x42\x67\x2b\xe2\x0e\xc3\x57\x2f\xea\xf3\x97\x33\xd2\xab\x18\x24\x1f\x1c\xe6\x73\x07\x6f\xe2\x51\x43\x62\x87\x39\x8f\x4f\xb8\x8a\x79\x93\x6e\xcc\x4c\x7d\x6f\x7a\xb3\xf1\x4a\xb6\xc4\xc2\x7a\x4a\x77\x13\xc3\x40\xdc\xb9\xa1\xbd\xa1\xab\x55\x92\x84\x01\xc3\x16\xe2\x6c\xbf\x32\x3b\x71\x86\xc9\x75\x4b\xdb\xcc\x2e\x5f\xf2\x00\x26\x99\x9c\x46\x02\x49\x44\xac\x49\x35\x59\xe9\x5a\x09\xea\x7a\x17\x26\x7c\x42\xbb\x69\x28\x4e\xc5\x10\xe0\xfd\xa0\x1f\x04\x1e\xf0\x8d\xbb\x6c\xe1\x4d\x81\xa7\x22\xb2\xf6\x72\x47\xbb\x86\x76\x1e\x45\x07\xed\x0a\x57\xbb\xc1\xec\x06\xeb\x34\x58\xb7\xc1\x7a\x0d\xd6\x6f\xb0\xc1\x25\x4c\x0f\xa4\x07\xb6\x21\xa8\x82\x93\x22\x28\x2e\x90\xe4\x29\x4b\xf6\xa9\xc1\xee\x70\xd1\x29\x97\x5a\x0b\xcf\x85\x7e\x6a\x24\xa6\x83\xd7\xa0\xf9\x17\xdb\x56\x6e\x7f\xd4\x48\x00\x03\x37\xa4\x17\x8f\xf0\x88\x2d\x60\x7f\xb1\xed\x7c\xbd\x86\x91\xab\xc5\x1e\x8a\x79\x58\xe0\x39\x09\x4c\x55\xb1\xb1\xb6\x10\x17\x8c\xc5\xb2\x84\x3a\x76\xeb\x39\x65\x08\xd6\xb1\x5f\x6d\xb6\xb3\x43\xe3\x53\x5b\x46\xde\xc2\x89\x1e\xea\x22\xbf\x75\x11\xe0\x73\x4d\x51\xb4\xe6\xac\xee\x3d\xdf\xd3\x01\x3a\xe8\x89\x0f\x91\xac\xd1\xa9\x81\x9e\xff\x28\xee\xba\x28\x9b\x03\x17\xdf\x8b\xc9\xf4\x19\x77\x17\x46\x6e\x13\x5d\x7e\x35\xf1\x1d\x72\x13\x6a\x78\x1c\x9f\x59\xe7\x3f\x5f\x5c\xc4\x17\x17\xe7\x17\x17\x97\xb5\xfa\xaf\x5f\x9e\xbf\xb8\xb0\x9e\x5e\x5c\xfc\xfc\xe4\x5f\xfe\xe9\x9f\xff\xf8\xc3\x9f\x1a\xcf\x26\xff\xe5\x52\x33\xe6\x9e\x9e\xf0\xeb\x95\xef\x44\xa0\xa4\x22\x9e\x5e\x77\x98\x3b\x7e\x42\x8f\xa1\x85\xea\x03\x7a\xd0\xa8\xc4\x89\x13\x25\x75\x92\xe0\xe9\xb6\xa5\xa0\x03\xac\xef\x61\xa5\x24\x76\xaf\x1d\xe5\x08\x71\xea\x3b\x31\x8a\xcd\x88\xe3\x51\x82\xd0\xb0\x53\x65\xe3\xa3\x75\x11\x7c\xe2\xcc\xc1\x75\x98\xf5\x77\x0b\xe5\xbd\xd5\xb2\x94\x53\x2e\x58\x2b\x2c\x9d\x64\x1e\xb3\x19\x5e\x08\x09\x60\x5d\x86\x08\xc9\x75\x78\x18\xf3\xdc\xd4\x2d\x50\x75\xd3\x0d\x84\x47\x91\xf5\xef\xad\x1c\x61\xad\x47\x10\x56\x30\x2c\x0f\xdc\xdf\x87\xae\x6b\xd8\x8c\x26\xd4\xf7\xa1\xc8\xe5\x9f\x36\xa1\x02\x3d\xb3\x77\x7c\x5f\x3f\xe8\x4e\x8f\xb1\x08\xa3\xef\xc5\x54\x17\xc1\x87\x98\x8e\xab\xf8\xfd\x52\x1e\x65\x67\x3b\xee\xf1\x2a\xc2\xf5\x83\x27\x8e\x20\x91\x9f\x70\x51\x13\x7a\x41\x5e\x2b\x2e\x9d\xeb\xef\xbd\x4e\x80\x2a\xd9\x6a\xb9\xed\x86\x77\xc1\x23\xd7\x0a\xc5\xa2\x1b\xad\x17\xb4\x62\xa5\x6b\x06\x1d\xca\xb4\x6e\xb0\x96\x4e\x1c\x37\x1d\x3f\x69\x92\x85\xfd\x15\xb7\x9a\xb5\x2d\x48\xd5\x6e\xc9\xf6\xb3\xa0\x11\xbc\x0a\x6b\xb7\x5a\x63\x39\x65\x84\x4d\x95\x89\x74\x71\x77\xf3\x81\x36\x93\xa2\x55\x10\xc0\x10\xd2\x0d\x35\x2f\x60\x4e\x6a\x85\x25\xce\x55\x76\x7f\xf6\x21\x5c\x31\x17\x2f\x47\xe2\x51\x3f\x69\xc0\xa7\x31\xbb\xb0\x44\xbc\x34\x68\xce\xb9\xba\xb0\x98\x74\xb5\xcf\x9c\xe9\x94\xfb\x3c\x72\x92\x30\x02\xca\xe2\x25\xb9\x20\x4c\xd2\x26\xb1\xb1\xc4\xb9\x62\x5e\xf2\x34\x66\x57\x3c\x49\xe8\xb0\x47\x8e\x4c\xcc\x55\x0b\x92\x36\x9b\x10\x1d\x58\xcc\xd0\xea\x63\x15\xa3\xcb\x4d\x76\xeb\x2d\xc0\x02\xe0\x0b\x67\x4a\x7c\x9c\xf2\x4c\x4a\x0e\x1c\xf4\x2b\x2e\xef\xad\x82\xac\x54\xc9\xc3\x14\x73\xb4\x50\x26\x06\x5d\xa7\xa0\x41\xd0\x34\x2e\x8e\xe1\x06\x78\x7a\x19\x54\x18\x0f\xc8\x2b\x42\xd9\xa3\x67\x09\x03\x73\xe0\x21\xc8\xef\xce\x1d\xb8\x9c\xf9\x9f\xec\xa1\x1f\xe6\x3d\x96\x3f\x8a\x85\xfe\x11\x0c\x82\xcb\x9e\xdf\x9d\x41\xde\x42\x2b\xff\x93\x41\x52\x06\xc9\xe8\xf1\x08\x06\x29\x16\xfa\x87\x31\xc8\xed\xf7\x59\x0c\x63\x5d\x1f\xd9\x35\x4f\x62\xe4\x0b\xb2\x0a\x10\x73\xbd\x65\x71\x07\xae\xc9\xe5\x4b\xa9\xc7\x6c\x7a\x58\xab\x64\xd6\x1c\xe1\x6b\x04\xf9\xcb\x8a\x9c\x3b\xf1\x1e\x87\xf6\x04\x52\x87\x8f\xb2\x05\x5c\x9e\xb9\x4e\xe2\x64\xf7\xef\xd2\xeb\xd8\x88\xa0\xb8\x19\xe3\xb9\x74\xbb\x23\xc6\x3b\x2a\x4f\xb1\x81\xa7\x48\xc7\xa7\x91\x73\x97\xbf\xea\x48\x6a\xbb\x19\x06\x68\xb3\x24\x51\x78\xb3\x89\x9d\xa7\xbc\xca\x32\x5f\x16\xa0\x6a\xd3\xb9\x84\xd7\x6f\xf1\xb4\x2c\x78\x60\x69\x43\xa5\xa8\x84\xab\x64\xb9\xda\xc4\x02\x57\xf1\x30\x9a\x4e\x65\x78\xa4\x46\x13\x35\x65\xc4\xe4\xca\x89\x9a\xe2\xe2\xd6\x37\x90\xe4\x6c\x8e\x87\xba\x78\xe9\x45\x31\xd1\x16\xc5\xbd\x29\xd1\xff\xbb\x39\xe7\x7e\x73\xe1\x3c\xe0\x5e\x4f\xd3\x89\xa2\xf0\xae\xb9\xe1\x3e\x55\x19\x3d\x50\x86\xd0\x81\x8f\x78\xce\xc2\x23\xb1\x16\x8f\xa7\x11\xe7\x01\xbb\x5a\xcd\x66\x3c\xa2\x0b\x4f\xfb\x07\x7b\x7b\x6f\xde\xb2\xda\x6e\x16\xde\x97\x89\xf8\xbe\xe4\x39\x5b\x2e\x8b\x79\x43\xac\xcf\x11\x67\x49\x6c\x7c\x89\x22\x56\xba\x7c\xb1\xf2\xf1\x85\x02\xf4\x82\x36\xb5\x50\xcc\x24\x52\x12\x25\x7c\xb1\x0c\x23\x27\xf2\xfc\x07\xe6\xd2\xab\x2f\x14\x31\xf3\xd0\xcf\xec\x6d\xb4\x36\x6f\xf8\x43\x26\x8c\x95\xd5\xdd\x34\x5c\xf0\x98\xad\x96\x24\x97\xa9\x93\x60\x99\x46\x31\xab\xf9\x3c\x8e\xeb\x20\xe1\x22\xb1\x9f\xb5\x70\xc8\xb8\x8d\x99\x3c\x04\xe0\xae\x97\xe0\x81\xee\xad\xb7\x1d\x38\x41\x88\xe0\x54\x0b\x91\x66\x3b\x59\xac\xee\x2b\x87\x2a\xbc\xe5\xcd\xc5\xca\x4f\xbc\xa5\xef\x6d\xa4\xa4\x94\x61\xb2\xf5\xa3\x9b\xac\x9a\xf4\xc4\x08\x0f\x6e\x98\xcb\xfd\xc4\x01\x91\x4d\xa4\x16\x34\x9e\x3a\x28\xc9\x85\x48\x16\xf4\x47\x88\x16\x58\x73\x78\x3f\x22\xbc\x63\x33\x27\x26\xa1\x82\x16\xbb\xd9\x52\x47\x66\xfb\xfd\xa4\x59\x41\x88\x49\xcd\x60\x90\xad\x72\x72\x7e\x25\x3a\x5e\x1c\x36\x3b\xed\x4e\x07\x31\x52\x12\x19\x76\xf8\xa3\xe9\x87\xd3\x1b\xee\x22\x9a\xea\xa9\x59\x2a\x1a\x52\x9c\x6b\xfb\xef\xf7\x4e\x69\xf3\xe9\xf0\xf4\x3d\x56\x27\x2e\x7f\x28\x37\x51\xf0\x68\x23\x89\x9c\x20\xf6\xc5\x03\x9a\x9a\xef\xdd\x70\x76\x1d\x39\xcb\xb9\x37\x8d\x21\x3f\x86\x4a\x3e\x9c\xbd\x6e\x8e\x24\xaf\xc7\x2c\x5e\x2d\x97\x61\x24\x1f\x74\x85\xb1\xbc\x92\xc9\x19\xe1\x47\x07\xa1\x81\x7c\x7d\xa8\x11\x73\xea\x04\xfa\xc5\x40\xe6\xa0\x99\x90\x78\x0b\xb1\x91\x96\xf6\x85\xf6\x71\xb3\xe7\x57\xf2\x4e\xa2\xbc\x0c\x9f\x78\xd3\x1b\xda\x22\x21\xfc\x56\x01\x5e\x2f\x01\xf3\x85\x0e\xf7\x41\x35\xdf\x80\xe1\xc3\x03\x97\xe3\x79\x07\x42\xfb\xfc\xda\x99\x3e\x30\x25\x12\x78\x81\xb1\xf0\x7c\x81\x02\xd9\x7c\xcd\x5d\x27\xfd\x9a\x00\x48\x82\x2d\x76\x88\xb5\x99\xee\x3c\x25\xc5\x0b\x4f\x85\x1b\x84\x51\x73\x1a\x3f\xf6\x7a\xad\x55\x7c\x45\x86\x4f\x89\xe2\xe4\xc1\xe7\xf1\x9c\xd3\xab\x27\x79\x56\x95\xbf\xbf\x90\xc6\xb1\x34\xe3\xd2\x04\x49\xf4\x35\x08\xa1\xb8\xf0\xbd\x80\x37\x95\xc3\xd7\x55\x0c\x4a\x6e\xef\xf4\x94\x04\x1c\x5e\x0d\x4d\x1e\x7c\x29\x4d\xd3\x48\x70\xd6\x65\x95\x23\x83\xd4\x1d\x20\xdb\x91\xbe\x15\xe8\xb5\x59\xcd\xe4\xf5\x21\x85\x36\xba\x90\x68\x4d\xc3\x20\x4e\xa2\xd5\x34\xc1\x68\x3e\x06\x08\x28\xb5\xba\x3a\x5d\x5d\xad\xf1\xe1\x1f\x5e\xc5\x3c\xba\xe5\x51\xfc\x19\xdd\x2b\x28\x1d\xc0\xd2\x2d\xc7\x75\x5f\x89\x9b\x9a\x15\x7e\x2b\xa4\x93\x89\x80\xdf\x31\x59\xd4\xec\xdc\x98\x3c\xf2\x53\xdd\x59\x1f\x19\x3f\x8f\x2e\xa9\x23\xb9\xac\xf3\xe8\x52\x78\x8a\xa8\x17\x70\x53\x1c\x2c\xae\xae\xe2\x69\xe4\x5d\xad\x09\xeb\x26\xf8\x48\xef\xf6\x6f\xbf\x09\xf7\x86\xd9\xb7\x73\x0e\xc8\x9c\x5f\x4a\xff\x86\x5a\x4e\x6b\xb9\x8a\xe7\xd5\xe8\xac\x82\x0d\x11\xd2\x5c\x31\x6a\xad\x14\xc2\x44\x0b\x07\x89\xd6\x61\x70\x8b\x26\x69\xbc\x42\x1e\x12\x5e\x33\x73\x0e\x3f\x28\xf4\x8d\xf4\xae\x98\xe4\x7d\x77\x7a\xec\x39\x6b\xcb\x0a\xdf\x85\x09\x4b\xd1\x75\x8b\xf5\x45\xad\x18\x44\x11\xaf\x79\x39\x2f\x97\xf9\x5e\x2f\x57\x57\xbe\x17\xcf\x0b\x3d\x6e\xb0\xc8\x1c\xe1\xdf\x33\xb8\x10\xe1\xec\x39\x0b\x73\xfe\xc5\x95\x50\xa6\x1e\x9e\xe5\x70\xb6\x85\x1e\x25\xc3\x73\x7e\x99\x8e\x04\xcb\x47\xf9\x97\xb1\xe8\x2a\x89\x4b\x21\xe6\x42\x1c\x6e\x98\x55\x53\x27\xa9\x85\x18\x55\x4e\x64\xbc\x64\x21\x8d\x78\x84\x41\x68\x01\x30\x02\xc6\x08\x8d\x98\x65\x71\xdb\xcc\x8e\x61\xee\xa6\xf0\x8b\xc2\x81\x9d\x84\x77\x7b\x24\x85\x29\x7d\xea\xfd\xc2\xb3\xd4\x19\xbf\x4f\x76\xd3\xbb\x13\xe8\x49\xe6\x94\x2c\xcb\x35\xae\x4b\x61\xc1\xb7\x8b\x8f\x0c\xa0\x4f\xd2\x43\x27\xde\xe5\xc4\x50\x84\x30\xd1\x31\x38\xdc\x48\x86\xbb\x4a\xb4\xa6\xd2\x69\xac\x63\x20\xc3\x08\x4b\xc9\x5b\xd7\xe2\xb1\x1d\x8b\x77\x6e\x69\x61\xea\x5c\x0d\x49\xa2\x41\x9e\x84\x77\xef\x42\x97\x7f\x16\xc1\xca\xb4\xbc\xd2\x8c\xf7\xb3\x59\xcc\x93\x5c\xd6\x5d\x18\xb9\xaf\x22\xee\xdc\xbc\x75\x92\xe9\xfc\x88\xcf\x92\xaa\xfc\x13\xdc\xf1\xaf\x00\x78\x4b\x87\x5c\x7a\x0c\x35\x49\x74\x85\xcd\xaf\x39\xc6\xfa\x5c\xeb\x4c\x9f\xe8\x80\x61\x41\x0b\x43\xd0\xc8\x0d\x95\x60\xf8\x7a\x75\xb3\x14\x2a\x79\xb3\xb0\x16\xf9\xaa\xab\x6b\xa6\x28\xcd\x9b\xc5\xcb\x50\xba\x51\x51\x69\xcc\x93\xbd\x0c\x72\x1d\xcf\xe6\xd9\xd3\xc8\x5b\x02\x8a\xbd\xd8\x21\x57\xb2\xe4\x70\x96\x27\x7b\x1a\x54\xcd\x54\x32\x0a\xef\x40\x6a\x34\x35\x01\x56\xc4\x19\x8c\xa1\x93\xf0\x6e\x33\x42\x48\xe8\xb8\x66\xd7\x35\x9f\xc3\xa5\xf5\x56\x45\x70\x34\x8f\x9d\x90\xbc\x20\xf2\xaa\x10\x17\x2e\x0f\xf2\xa8\x57\x09\x07\xa5\xea\xcd\x6b\x5f\x1b\x82\x32\xab\x5e\x14\x6a\x81\x1d\x2b\x9c\x77\xa7\x99\x6b\xda\x5b\x86\xcb\x8d\x07\x81\x60\xd7\x0f\x81\x80\xfb\xda\x01\x30\x4e\x29\xd6\x04\x46\xad\xee\xca\x2a\x9e\x3f\x6a\x54\x50\xd3\x6c\x52\x65\x55\x57\x08\xe8\x6b\x48\x4f\x8b\x8a\x02\xc6\xc6\x40\x8a\x05\x22\x71\x64\xa5\x64\xa3\xfa\xe3\xea\x06\xb4\x68\x18\xed\x67\x2c\xc2\xd0\xbb\x22\xb6\x05\x8b\xb6\xb6\xea\x65\x38\xb0\x2d\x16\x11\x1e\xa0\xa6\x2b\x50\x89\xf8\x22\xbc\xe5\xd5\x83\x53\xc9\x13\x1c\xcc\xa1\x6a\xae\x4b\xdb\x58\xd3\xdd\x75\xed\xac\xa1\x29\x1a\x84\x4e\xc2\xf7\xf2\xaa\xb8\xca\xf0\xcf\x49\x48\x40\xf4\xf7\xd1\xd6\x15\xa8\xe3\x25\xba\x3d\xd9\xd0\x46\x08\x4b\x84\x5a\x5e\x10\xf0\xe8\xa7\xb3\xb7\x47\x6a\x44\xd4\x1c\x0c\xd1\x3f\xb5\x61\xc0\x16\x0b\xe8\x41\xe8\xcc\x0f\xef\xac\x7a\x09\xd6\xed\x4a\xf5\x53\x9a\x2f\xeb\xcd\x87\xda\xc4\x68\x0b\xaa\x13\x7e\x69\x93\xea\x76\x57\xcb\x8b\xc5\x3d\xe7\x5a\x9d\xbd\x64\x96\xc5\x26\xcc\x6b\xcd\x50\x3d\xcf\xbd\x84\xe3\xbd\xe8\xa2\x29\x61\xf2\xf5\x67\xac\x3d\xc0\x78\xb6\xf9\x60\x34\x66\x28\xec\x82\xd1\x3a\x6c\xc5\x0f\xc1\x74\x0f\x9f\x6c\x14\xa2\xbe\x45\x25\x4d\xd3\x32\x77\x2f\x0c\x12\xc7\x0b\x78\x54\xe3\x85\x90\x38\xb9\x71\x73\x96\x4b\x1e\xb8\x7b\x73\xcf\x77\x6b\x51\xdd\xc8\x74\x91\x19\xbb\x0c\xff\x64\x3d\xfa\x55\x9c\x19\x2e\x16\x5e\x72\xe4\x05\xfc\x7d\x36\xaa\x8f\xe0\xce\x98\x27\xa5\x6c\xa7\x85\x77\x2e\x31\x9d\xca\x67\x72\x79\x14\xf0\x82\x35\x59\x5c\x5b\xbd\xd8\x31\x5b\x87\xec\x25\xab\x4d\xc3\x20\x0e\x7d\x2e\x9d\xef\xc3\x84\x0c\x57\x78\x33\xeb\x0a\x1d\xeb\xc8\xf8\x0c\x8d\x8c\x81\x0d\x1a\xd1\x86\x55\x12\x2c\xe2\xda\xb8\x7e\x7a\x5c\xa5\x28\x7c\x52\x24\x35\xa3\xb0\x88\x20\x99\x97\xa6\xea\x70\x6d\xc2\x4c\xb5\x08\xfc\x92\x32\xfc\xd6\xd7\xd9\x36\xaf\x7b\x4a\x27\x7f\x61\x6e\xa4\x34\x3b\xe7\x97\x0d\xb1\x50\xc7\x17\xfa\xc8\xee\xf9\x40\x0c\xb8\x3b\x41\x40\xe1\x5d\xc0\xa3\x7d\xb9\xef\x45\x73\x0a\x96\x68\xc0\x70\x35\x0b\x84\x59\xa4\x4d\x1c\xaf\x6e\x0a\xcf\x8b\x11\xa3\x73\xdf\x51\xdb\x46\x59\x5c\x06\x5d\xf2\xbf\x54\x87\xc4\x28\x11\x9b\x26\x31\x0a\xd4\xf5\xd8\x4e\x61\xee\x36\x10\x85\x47\xd6\x86\x83\x66\xd4\x4a\x91\x79\x38\x50\x9d\xe1\x54\x79\xc6\xbc\x62\x34\x2c\x49\x8d\x00\x23\x3f\x37\x59\xdc\x10\x11\x4a\xf5\x35\x6f\x2b\x08\x5d\x8e\x6b\xa3\x9a\x31\x14\x0e\xee\xcc\x78\xad\x80\xdf\x27\xa7\xde\x15\x6e\x08\xfe\xf6\x1b\x73\xd8\x0b\x16\xe8\x91\xa3\x74\xd9\xe5\x35\xd8\x6d\xe8\xb9\xac\x66\xd6\x99\xa6\x96\x30\x1a\x89\x43\xfc\xa2\x35\xa8\x83\xaa\x21\x76\xc8\x21\xa0\x64\xf0\x3b\x27\x0a\x6a\xd6\x6e\xf6\x98\x09\x3d\x9c\xd0\x19\x94\x74\x52\xc3\xc2\x80\x71\x72\x1c\x44\x32\xc9\xaa\x14\x53\x0f\xc1\xf4\x54\x5e\xe9\xda\x73\xa2\x7c\xf4\x6d\x5d\x46\x46\x0f\x05\x69\x04\x03\xee\x3b\xcb\x98\xd7\x8a\x3c\x62\x60\x01\xb5\x6b\x53\xbc\xcd\x96\x6b\xe3\x4b\x15\xb2\x4b\xdf\x4b\x24\xfd\x37\xd8\x90\x03\xdb\x24\x0c\x38\xce\xad\x27\x76\x9d\x98\x96\xa3\x32\x11\xce\x82\xb5\xa8\x3a\xca\xf7\x32\x36\x3a\xa5\x90\x28\xd2\x48\x86\xd9\xaa\x97\xf2\x5a\x77\x53\x19\x38\x25\x36\x81\x60\x54\xa4\xa5\x13\xf1\x00\x7b\x22\x8c\xe9\x57\x78\x69\xbf\x16\x35\x18\x57\xf9\x02\x24\xaa\x56\x1a\x83\xf5\x28\xa5\xc9\x36\x22\x51\x51\xb9\x26\x58\x38\x0f\x57\x7c\xcf\xf7\x96\x7b\xab\x08\x4a\x57\x5a\x6a\x32\xb2\x54\xc5\x4c\x32\xcc\xe3\xfc\xfe\x24\x67\xcf\x0d\xd2\xbb\xbe\x66\x53\xa0\x28\xee\xd3\xc8\x35\x8f\xd8\x25\x30\x2b\x8d\xf5\x42\xbf\x2d\xe3\xbb\x95\x04\xec\x29\xc7\xdd\x14\x12\xf8\x3b\x20\xbc\xf9\x30\xd0\x18\x18\x8d\xe1\xe7\xb4\x6b\x92\x51\x52\x29\xf1\x08\xae\x2f\x4e\xf0\xb6\xb1\xb9\x2d\x66\x17\xb4\x96\xb6\x16\x34\x30\x0f\x09\xc5\x02\x72\xca\x5c\x20\x15\x10\x69\x2c\xef\xd5\x37\x28\x97\x1b\x18\x19\x6a\x31\x67\x98\x3c\x7a\xc0\x72\xda\xcc\xcc\x4e\x9b\xc9\x35\x92\x01\x86\x00\x97\xe6\xf3\x99\xe2\x38\x20\x51\x4b\xe4\xda\xd7\x2c\xa9\x72\x4b\x82\x38\x15\x6c\x49\x44\x7c\x57\x66\xfc\xe7\x27\xf4\xd6\x0e\xcb\x07\x26\x0b\xf4\x1e\x08\xa6\x59\xab\xba\x93\x3a\x6b\xb2\x20\x27\x62\x1c\x3a\x02\x31\x4c\x56\x1f\x51\xce\xaf\xbb\x9a\x8e\x29\xc2\xa5\x69\x85\xb1\x0a\x5c\x1e\x01\x55\x40\xe4\x1a\x97\x20\x49\xe4\xdd\xf0\x64\x1e\x85\xab\xeb\x79\x19\x90\xe2\x49\xae\x04\xe2\x6e\x0a\x23\x02\xb9\x4f\x4c\xd9\x4e\x3c\xf5\x3c\x09\x21\xa3\x97\x9b\x00\x13\xcf\xe7\xfb\x4e\xe2\x50\xc4\xcc\x2e\x82\x21\xf5\xce\x1e\x96\x9c\x26\xbe\xd6\x96\x5e\x73\x82\xe5\xb3\x14\x1e\xdf\x62\x13\xfb\x7c\x1a\x46\x74\xd7\x45\xc9\xc9\xfa\x85\xab\xb0\x62\x80\x33\x39\x0c\x18\x52\xac\xda\xe8\xf5\x4d\x96\x92\x79\xaf\x40\xd1\x94\xf3\x06\xf4\x4f\xd3\x94\x06\x1b\x0d\x66\xcb\xbc\x6c\x57\x83\xed\xb0\xa6\x43\x73\xc7\xcf\x19\x60\x22\xc6\x67\x4e\x36\x46\xc0\xce\xfe\x33\x19\x24\x74\x87\xf9\xb9\x43\x36\xf8\x0f\xd8\xb8\x38\xed\xab\x58\x0d\x2f\xf5\xf3\x38\x5b\x59\x27\x59\xac\xe4\x3c\x0a\xf8\xca\xc7\x61\x2f\x01\x17\xc6\xd9\x84\x3e\x04\xec\x25\x9e\xa6\x45\x6c\x52\x2d\xc0\x51\x62\x07\x75\x11\x81\x6d\x0d\x68\x50\xaf\xb0\x70\x61\x62\xe7\x15\x3e\xe1\x62\x9c\x8e\x53\xe4\x83\x65\xc4\x6f\xbd\x70\x15\x9b\x25\x33\x54\x31\x4d\x8f\x04\xd6\x11\x69\x9a\x11\x69\xaa\x11\x69\x2b\x7f\xf2\x20\x99\x61\x5a\x69\xb0\xeb\xf2\x4d\xab\xb2\xa0\xcc\xdc\xaf\xd9\x22\x61\xf9\x23\x98\x0a\xee\x76\xc9\x72\x34\x74\xc2\xad\xec\x44\x6c\x8e\x37\x9b\x0e\x8e\x63\x1c\x9c\x15\x0e\x4e\xb9\xca\x84\xe2\xab\x8d\x07\x66\x95\x0d\xcc\x2a\xc7\xbd\xc0\x74\xda\x37\x63\x17\x57\x8f\x18\x27\xfe\x9f\x32\x36\xab\xaf\x19\x9b\x0a\xdd\xe6\xd6\xcb\xe2\x04\x83\x4d\x92\x2e\x7a\x68\x52\xe6\xd4\xe9\x57\xf5\x77\x23\x06\x5c\x2f\x5e\xdd\x12\xd1\x1a\x57\xd8\x3a\x60\x64\xa0\x83\x90\x47\x9a\x3b\xba\x69\xbc\x91\x1d\x4e\x01\x4e\x53\x6e\xd4\xaf\x0c\x48\x5b\xb4\xc2\xae\xd9\x5c\x60\xe7\xad\xf0\x74\xb2\x94\x18\xda\x5a\x68\xcd\xdc\x1e\xc9\xce\x0e\xe3\xa6\x7d\x07\x45\xf2\x46\x06\x46\x33\x58\x5e\x51\x61\xc8\x5d\xee\xf3\x84\xef\xcd\x9d\x28\xae\xbd\x75\x92\x79\x6b\xe1\x05\xb0\xe8\x4c\xea\x72\x80\x55\x53\xb4\x7a\x45\xa9\xd4\xf5\xf5\x36\xab\xc1\x0e\xf4\x36\x5d\x66\x51\xc8\x61\xd0\xc3\x69\x57\xb8\x79\x21\xe5\xd5\x33\x99\xd4\x36\xdd\x68\x8a\x1b\x2c\x20\x0b\x94\x3f\xa3\xa8\xc0\xdc\x1c\x7c\x3d\x5e\x67\xa4\x36\x0a\x2a\x7b\xbd\x42\x8e\xea\xeb\x95\x71\x24\x36\x58\x83\xf5\x08\x70\xd6\xdc\x61\x31\x58\xcb\x0d\x3c\x72\x8b\xa1\x3f\x01\x5e\x74\x31\x2b\x68\xa6\xd8\xcc\x9b\x88\x12\x8b\x15\x02\xc5\x23\x87\x95\x6e\x6b\xf8\x06\x69\x92\xa3\x92\x65\x51\xdf\xda\x02\x7f\xbb\x74\x4b\x8c\x69\xa6\x65\xb9\xd6\x12\x96\x11\xcc\xc4\x2c\x60\xbc\x22\xbe\x20\xa3\x6c\x2b\x45\xee\x13\xcf\x89\x4d\xcb\xed\xb9\xae\xa9\x6a\xcd\xee\x7e\x52\x25\x07\xca\x6d\xa5\x5c\x21\x93\xa9\x94\x37\xa7\xea\x46\xa3\x27\x0f\x55\x22\xb2\x2b\x78\xaa\xd8\x90\x30\x81\x09\x0b\x75\x5c\xcd\x18\x28\x10\x65\x67\x75\xe6\x5d\x17\xa6\x1a\x28\x06\x95\xb5\x6e\x33\x7d\xc3\x85\x85\xba\xdf\x5e\xa2\xda\x57\x65\x88\x57\xb2\x69\xf9\xd2\x9b\x1a\x9b\x9a\xd5\xbe\x90\x54\x4e\xf5\x6d\x9f\x23\x2f\xe0\xa7\x89\x83\x07\xf1\x9f\x2b\x24\x30\x0a\xb9\x67\x8c\xe7\x47\x51\xec\x44\xea\x1f\x5b\x73\x27\xae\xd8\x16\xa8\xe3\xb2\xa7\x50\xaa\x18\xec\x9b\xaf\xc7\x1d\x06\x6a\x2d\xde\x52\x7d\x58\xd6\x33\x26\x96\xb1\x68\xe0\xeb\x46\x24\xaf\x46\x3b\xc3\xdb\x2c\x2d\xa4\xae\xad\xc6\xf9\x2f\x27\xe1\xdd\x6e\x30\xe5\x71\x12\x46\x9b\xd0\x1b\xb0\xb5\xfe\xd2\x3c\x79\xff\xc9\x62\x4f\x76\xb0\xf5\xd0\xe5\xef\x9c\x05\xcf\xc8\x98\x4a\x9f\x47\x53\x50\xaa\xc5\x4f\x5e\x32\x97\x87\x9b\xc5\x5d\xf1\xfc\xa5\xcd\x9c\x45\xd4\xcc\x1f\x70\xa7\xba\x37\xdf\xdf\xe2\xed\xd3\x27\x9e\xb9\x9a\x4c\xa7\xd2\xbc\xf6\x40\x42\x96\x6a\xd3\xad\x4a\xc9\x03\x0a\xef\xc9\x9a\xc1\xc5\xed\x0c\x7d\xd3\xdc\x88\x17\x13\x67\xf6\xdc\x7c\xee\x52\x9c\x7e\xb1\x8c\x4a\x9e\xa3\xb6\x17\xc0\x84\x4b\xc9\xbb\xf9\x30\x51\xc1\x8d\xc6\x48\x55\x4c\x48\xbf\xb4\x4f\xb8\x07\xb4\xb3\xa3\xa9\x2e\xf6\x92\x35\x6d\xb9\x1b\x69\xc6\x56\x05\x97\x6d\xae\xed\x9e\x56\xa6\x5d\x37\x0d\xb2\x47\x2a\x5b\x8c\x75\xcc\x9e\x33\xde\x9a\x82\x6c\x7b\x87\x0f\xe8\xe5\x5d\x9f\x78\x6b\xcb\xa8\xea\x02\x3a\xa4\x49\x0b\x9c\xc7\x97\x06\x4d\x17\x60\x87\x53\x12\x78\x6c\x8b\x45\x85\x23\xd6\x6a\x4e\x0a\x2a\x25\x6d\xd3\xae\x1e\x45\xc0\x6d\x37\x70\x49\xec\x57\xcf\xb8\x52\x51\x90\xb0\x17\xd5\xac\x6e\x9e\x22\xdf\x93\xfd\x13\x30\xac\xd6\x4c\xb7\x47\x4d\x11\xc9\x3f\x05\xfa\x28\x4c\xb4\x66\x82\x94\x14\x7d\xfc\xc5\x32\x23\xdf\x45\x25\x7c\xe7\xe5\xf9\x2e\xba\x24\x26\x7e\xcc\xd9\x31\x4e\x54\xf6\x7c\x87\xc5\x29\xc9\xad\xd3\xe3\xdd\x77\x16\xdb\xd9\xc1\xf3\x5d\x21\xee\xe5\xe1\x42\x05\xa1\x3c\x71\x9e\x70\x0e\x3f\xf2\x53\x00\x87\x2d\xae\x1a\x87\x35\x17\xb4\x62\x9e\x9c\x38\xc1\xb5\xe1\xd4\xb4\x01\x4b\x49\xaf\xb0\x60\x0b\x15\x35\x50\xce\xfb\xe2\x64\x5e\x97\x5c\x72\x8f\x3a\x34\xd2\x3d\xde\xb8\xe2\x28\x4f\x6d\x59\x71\x4c\x77\x12\xa0\x53\x68\xfd\xd4\xc2\xf3\xf6\x65\x83\x85\xe7\xf6\x65\xbd\xc1\xf0\xfb\x41\xe0\xd6\x62\xfc\x1a\xc3\xd7\xe2\xd4\x37\x52\x89\x7c\x9c\xa4\x67\xe1\x55\xf1\x5d\x67\xa6\xb0\xa9\xd2\x54\x41\xb6\x46\x7a\xef\x26\xb5\xb6\x99\x69\x70\x5d\xd0\x4a\x42\xb1\xce\xae\xd3\x96\x42\x6d\xfb\x22\xde\x2e\xdf\x4b\x8f\x14\xe2\x69\xc6\x5f\xad\x44\x65\xb7\xd0\x23\x4b\xba\x6e\x2b\x6c\x95\x49\x7c\x22\x63\x93\x26\x66\x30\x5a\x1d\xb8\x81\x90\x6b\x2b\xfd\x42\xc3\x6b\x6a\x59\xb6\xde\xb4\xcd\xfc\x52\xc5\x3b\x15\x78\x80\x25\xaf\x62\xc1\x25\x8b\x95\xe1\xa0\xe3\x11\x57\xe2\xc1\xf2\x67\x58\xc5\xd7\x0b\xb4\x8d\x60\xc8\xc4\xa7\x0b\x8d\xea\xba\xe1\x3f\xdf\x5c\x9c\x1e\x36\x34\x68\xd9\xab\x70\x01\x9a\xd1\x78\xbf\x6e\xaa\x5f\x3f\x00\xc6\x9a\xe3\xfe\x42\x5c\xdf\xa0\xd9\x95\x78\xf4\x71\xc2\xaf\x0f\xee\x97\xb5\x80\x6d\xe1\xf1\x86\xf5\xcf\x56\xbd\x81\x9b\x8c\xd3\x56\xcc\x9d\x68\x3a\xaf\xad\xaa\x28\xc9\xd2\x8d\x99\x26\x3a\x1d\xc3\x83\x2f\x17\x74\x9f\x99\xb1\x4d\xd4\x5d\x1a\x3b\x12\x36\x72\x7b\x75\xf3\x7a\xbd\xc1\x66\x3a\xda\xd6\xcf\x96\x40\xdc\xd9\xa4\xd3\xf0\xdf\x35\xdb\x61\x4b\x31\x01\x67\xeb\xba\x26\xbb\x77\xbd\x51\x67\x64\x87\x16\xa0\x59\xd8\x56\x0e\xff\xeb\xf3\xf6\xe5\x26\xed\xc1\x7f\x44\xcc\x05\x10\x73\x81\x5b\x3a\xe9\x7b\xb7\x54\xb8\x03\xfb\xbb\x0d\xb6\xa0\xa3\x03\xde\x72\x5c\x17\x33\x6a\x89\x2a\x01\xcb\xfe\xfb\x52\x0d\x52\x91\x5d\x92\x65\xf8\xfc\xa5\x74\xb1\x5c\x11\x88\x9b\xde\x51\xd1\x33\xb5\xdc\x2b\x2b\xf1\x9a\x2a\xf4\xfd\xe3\x30\xaa\xba\xb7\x64\x7a\x07\x49\x41\xab\xb3\x27\x3c\xc7\x51\x78\xeb\xb9\x3c\xd2\x5e\xae\xc8\x57\xcc\xe2\xa9\x90\xf2\x18\xc8\x6e\x37\x98\x9d\x5e\x23\x8c\x56\x3e\x95\x54\x6e\x51\xc7\x8a\x26\x11\x05\x53\x5c\x5b\xa9\x9a\xd1\xd0\x98\xa6\x77\x73\x40\x39\xee\x39\xd3\x79\xfe\x6a\xb6\x5c\x78\xe7\x41\x7e\xfd\x22\x00\x7c\x27\x4e\x48\xb5\x21\x93\xe5\x8a\x67\xb9\xf4\x0a\xa9\x0c\xe5\x03\x7c\xc6\xed\x7e\xc6\x3b\x0c\x4a\x59\x7c\x0f\x26\x9e\xf7\xc8\xef\xf4\x6e\xfe\xd3\x9c\x73\xff\x6d\xfa\x4c\x1f\x00\x6c\xa5\xe0\x59\xb8\x9a\xce\x35\x44\xbd\x98\xc8\xc1\xdd\x83\xc0\x55\xdb\x11\x44\x90\x5e\x59\x69\xb2\x1c\xdf\x43\x85\x03\x09\x92\x44\xfe\x47\x19\xb1\x3b\xbd\xe2\xec\x7a\xb7\xb9\xfe\xc8\x57\x6e\xb9\xcf\x09\x3d\xf6\x8b\x35\x7c\x72\x4f\x68\xc5\xd7\xfd\x83\x57\x1f\x7e\x44\x32\xe8\x4b\x84\xc2\x38\xae\x7b\x1c\x12\xa7\x65\x54\x06\x8b\x85\x0a\xd7\xf1\xe3\x81\x5b\xf8\xe6\xc5\x6f\xe5\xa3\xe6\x7c\xc6\x9e\xb8\x80\xe7\x9a\xae\xea\x17\x10\x55\x2c\x9e\x99\x17\xb8\xaf\xd3\x4b\xab\x8f\x30\xb9\xb9\x7a\xd7\x95\x45\xe6\x95\x0b\xe9\xd3\x24\x7d\xbf\x1a\x65\x7b\xf0\x85\xd5\x1b\x18\x21\x45\xfb\xbd\xd4\x12\x4a\xb7\x29\xf4\x1e\xd0\x49\x46\x89\x91\x93\x6d\x57\x78\x95\x7b\x76\x11\x5e\xd3\xdd\x70\x01\xf4\x28\x72\xc7\x0f\xc1\xb4\xfc\xce\x5d\xfa\x99\xd7\x0a\xfd\x8e\x54\x46\x09\x1b\x32\x8d\x6b\x7f\x50\x95\x4e\x30\x9d\xd3\x5e\x65\x9a\x47\x36\x8f\x92\x4b\x1f\x20\x3f\x65\xaf\x58\xa4\xd2\x7a\x66\xe1\x74\x15\xcb\x6a\x52\xc3\x29\xcb\xa2\xb4\x46\x10\x43\x07\x92\x35\x1d\x88\x8b\x1d\xd0\x1a\xce\xe3\xaf\x34\xad\xa1\x1f\xe6\xd1\xd7\xc9\xa0\xe3\xaf\x12\xa1\xa4\x03\xaa\x8d\xad\x1c\x43\x29\x33\x17\x6c\x2e\xb9\xdd\x5c\xab\xe3\x1b\xd2\x54\x8e\x1b\x0f\x0e\xbf\xfb\xfc\x7e\x82\x77\xc9\x3d\xf5\x6b\x43\x1c\x31\x28\x9f\x0a\xf4\x4f\x67\x6f\x98\x23\xd4\x33\x7a\x01\xfd\xa4\x66\x45\xe1\xdd\x21\xcc\x54\x0b\x1f\xf7\xd7\x9f\xd5\x11\x36\x34\xef\x51\xca\x4e\x9a\xad\x76\x6d\x0b\x50\x19\xdc\x67\xb4\x78\xcb\x37\x16\x43\x63\x00\x1a\x97\x37\x26\x1b\x2c\x37\xcf\x11\x9d\x96\xac\x18\x6c\xa4\x34\x51\x87\x39\xa5\x9c\x59\x28\x60\x2f\x34\xb0\x44\x03\x53\x30\x67\x3b\x3a\xd9\xea\x39\x8e\x62\xcf\x75\x3e\x65\x2f\x71\x1a\x4f\x94\x1a\xd7\xac\x6e\x82\x12\x91\x16\x36\xd8\xb9\xce\xd9\x0a\x5a\x95\xe6\x23\x5a\xe1\x81\xf0\x0f\x80\x16\xc8\x01\x3d\x8f\xf8\x10\x64\x4e\xb4\xe0\x0b\x03\xc1\x84\x8e\x7d\x52\xa1\x65\x3c\x3a\x91\xff\x05\x79\x6a\xa8\xbd\xdd\xd8\x18\x64\x52\x33\xea\x9c\xaf\x8c\x0d\xac\xc6\xd2\x94\xc1\x9a\xd4\xaf\xc6\x8b\xb7\x1f\x99\x42\x46\x2a\x31\x2f\x26\x27\x71\x61\x98\x90\x6f\x37\x87\x21\x39\x42\x97\xd3\x93\x10\x85\x9c\xe9\x8e\x4d\xae\x17\xeb\x5a\x22\x4a\x6c\xd2\x54\x46\x33\x53\x5b\x5f\x8c\xaa\x44\x3d\x71\xc7\xbb\x78\x7c\x9d\xad\x21\x0c\x21\x69\x64\x78\xb3\xc8\x59\xa0\x95\xc8\x8d\x27\x66\x07\x14\x6c\xb2\x66\x11\x60\xfa\xa0\x4e\x94\x13\x57\xfe\xa6\x71\x0c\x4b\x4d\xb6\xc3\xac\xab\x30\x72\x79\x34\x61\xed\x67\x73\xb4\x22\x27\xcc\x6e\xb7\xff\xf8\x4c\x3e\x40\x98\x30\xe7\x2a\x0e\xfd\x55\xc2\x9f\x61\xd4\x29\xca\x46\xbf\x2c\xe1\x2f\x87\x41\xc0\x23\x32\x41\xff\x82\xb3\x5f\x78\xb1\x4b\xaf\x26\xa7\xad\x46\xa0\x2c\xad\x7f\xb2\x68\x31\xa3\x1c\xd1\xa9\x60\x79\x5c\xa7\x74\x24\xf4\x89\xbc\x1c\x38\xae\x7b\x70\xcb\x83\xe4\xc8\x8b\x13\x8e\x27\xd7\x11\x8f\xc9\x3f\x03\xd9\x7c\xc1\x09\xa6\x3f\x0b\xe7\x24\x60\x8a\x97\xbe\xc4\x53\x8d\x49\x53\x9b\x92\xaa\xea\x9d\x8b\xd6\x55\xe8\x3e\x14\x09\xb8\x70\xa2\x6b\x2f\x98\xb0\xf6\xf2\xfe\xd9\xd2\x71\x5d\x2f\xb8\xa6\x84\x46\x4f\x85\x78\xcf\xe4\x86\xef\x84\xcd\x3d\xd7\xe5\xc1\x33\x3a\x55\x9c\x00\x8a\xb5\x66\x13\x17\x45\x4d\xf2\xba\x2d\x42\x78\x62\xa3\xf5\x67\xcd\x3b\x7e\x75\xe3\x25\x4d\xf4\xa9\x43\x13\x7c\x82\x51\x95\x9f\x35\x17\xe1\x2f\x86\xcf\x96\x6e\xf9\xa6\xe3\x92\x76\x5f\xed\x93\x40\xfe\x2c\x5c\x16\xa8\x64\x00\x7b\x45\x7e\xe6\x76\x98\x35\x75\xfc\x69\x4d\x45\x1d\x56\x5a\x30\x12\x4d\xa2\x40\x9d\xfd\x89\x75\xeb\x85\x7b\xc8\x74\xcd\x39\xc7\xb5\xd8\x84\x06\x9a\x7f\x93\x61\xdd\x37\x61\x22\xfe\xca\x64\xe8\xdd\x09\xbb\xf2\xc3\xe9\xcd\x33\xc6\x24\xc1\xab\x70\x79\x46\xc1\xb3\x9b\x1b\xc1\x7e\x01\xfa\xb5\xe6\xdc\x71\xcd\xef\x1f\x81\xe0\x7b\x71\x7c\xe4\x05\x37\x9f\x4d\xbd\xc1\xb0\x56\x26\xe0\xdc\x63\xc4\x88\xfb\xe4\x30\x5a\xfa\x59\xca\x17\x92\xc7\xab\x65\xf4\x32\xa2\x59\xa8\xa0\x9e\x2d\xf2\x38\x0f\x8c\xf5\xdd\x37\x29\xd3\xca\xc1\xe6\x10\x16\xb3\x84\xbb\x5e\x02\x6b\x4b\x0b\x7d\x90\xae\xf8\x9a\x52\xf1\x92\xfb\xfe\x74\xce\xa7\x37\x50\x00\x1d\x57\xad\x29\xe1\xac\x92\x70\x1a\x2e\x96\x3e\x27\xe7\xa6\xe1\x6c\xb6\x51\x09\x74\x2d\xff\x88\x02\xce\x32\x71\x7c\xe1\xeb\x05\x26\xce\x9a\x32\x51\x28\xfa\xcc\xef\x93\xab\xf0\x7e\x0d\x74\xe2\x5c\xe1\xb2\x09\x4a\x34\xed\x22\x70\x5e\xa0\x4c\x9d\x88\x27\x14\x2a\x68\x42\xde\xd6\xc8\x92\x7a\x96\x63\x78\x25\x2e\xd4\x24\x0b\xd3\xf4\x2c\x8d\xb0\x34\x61\x76\x7f\x79\x4f\x69\xe1\x60\xad\xe9\x7b\xd7\x4e\xb2\x8a\x78\x2c\x04\x84\x26\xa3\xa4\x5c\x6a\x3e\x4c\x84\x03\xbd\x67\x2c\xfd\x76\x9f\x4a\xab\xbb\xb9\x97\xf0\x26\x36\x36\x61\xcb\x88\xeb\xb2\x6d\x95\xc0\xfc\xa2\xea\xd9\x13\x6f\xb1\x0c\xa3\xc4\x41\xe7\x7f\x42\x80\x16\x58\x54\x10\x22\x4f\x97\xa2\xbc\x17\xce\xc6\x53\x79\x4f\x7a\x55\x93\xf7\x6b\xeb\x40\xc7\x81\xf9\x2a\x70\xbf\xe3\x71\xf5\x24\xe1\x6a\x3a\xc7\x15\x41\x56\x19\xed\x8b\x3c\xbe\x9a\x45\x78\xcb\xbf\xbd\x16\x1e\xb8\xdf\x5e\xc9\xd4\x09\xa6\x2a\x7d\xbe\xa2\x1e\x0c\x53\x9a\x56\xb0\x17\x2e\x1f\x1e\x57\x9e\xdc\xe7\xa5\x15\xe0\xce\xd0\xe3\x6a\x70\xa3\x70\x69\x35\x4a\xcd\x2a\xa6\xdc\xcb\xc0\x3d\x38\xd0\xf7\xf2\x99\x7f\x23\xdd\x1c\x42\xeb\xad\x9e\xb1\x6d\xa1\x99\x1b\xfe\xe0\x86\x77\x41\x86\xea\xab\xd0\x7d\x78\xc3\x1f\xf6\xc3\xbb\xc0\x84\x70\x44\xdb\x7c\xb1\x51\xf0\xba\xde\xad\x55\x00\x6c\x79\xb0\x4c\xa4\x5d\xd2\x49\x14\xde\x35\xc1\xce\x8c\xad\x02\x58\x41\x84\xe4\x24\x45\x66\xcc\xcd\xbc\x7b\xee\x16\x0d\x10\xa3\x61\x01\xd2\xcd\x60\x58\xe0\x67\x2b\x3f\x08\xf9\x49\x9d\x22\x27\x3b\x95\x84\x4b\x32\xb2\x5f\x39\xd7\x65\xaa\x07\xf3\x9b\x57\xce\xb5\x65\x2c\xb5\xb6\x9f\x85\x7e\x6d\xa4\xe5\x0b\xf2\x4c\x76\x8e\x5c\xe9\xea\x58\x17\x91\xca\x5e\xa5\x2a\x48\xbf\x0e\x7d\xb7\xa4\x97\xb3\xd0\x77\xad\x3c\xa8\x36\xd2\x49\xb8\x44\xa8\xe6\x2c\x8c\xc0\xd8\xc9\x82\x2f\x5b\xf9\x62\xeb\x28\x52\xe4\x95\xc2\x48\xc9\xba\xea\x5a\xaf\xd3\x0e\x68\xcd\x15\xfb\xaa\x40\x6b\x7d\xa0\xef\x6b\xba\x51\x81\x95\x52\x6f\x5d\xdb\x9b\xde\x8d\xb8\xb3\x76\x06\x29\xa0\x1a\x56\xf4\xdd\x89\xb8\x63\x19\x20\x0b\xc4\x44\xcf\xc8\x9e\xef\x25\x0f\x92\xa3\xd6\xf2\xbd\x52\x5f\xde\xe0\xf5\x08\x8f\x64\x39\xd9\xde\xbe\xbb\xbb\x6b\xdd\x75\x5b\x61\x74\xbd\xdd\x69\xb7\xdb\xdb\xf1\xed\xb5\x95\xbf\xdf\x1d\xdf\x66\x2c\x07\xab\xc0\xaa\xf5\xde\xbb\xd3\x9a\x07\x16\xe4\x6d\x36\x75\xa0\xb8\xd6\xf9\x5f\xc2\x70\xd1\x24\x67\xe4\x18\x39\x30\x83\xd2\xed\x95\xfb\x85\x1f\x80\x90\xf1\xea\xe5\x30\x22\x84\x2f\x98\x34\x76\xcb\xd6\xdb\x2c\x90\xd1\xb0\x96\x4c\xc2\x25\x2c\x39\x7d\x3e\x4b\xe0\xdf\x72\x42\xa3\x36\x38\x73\xa2\x6b\x6e\x36\x80\x41\x1a\xe1\x78\xd6\x0d\x05\xb4\xee\xab\x91\xa8\x9b\x09\x02\x98\x1a\xd9\xcc\x78\xd3\x4b\x6c\xd0\xe1\xd4\xd0\x5a\xde\xa7\xf6\xd2\xf2\x5e\x12\x60\x79\xff\x4c\x38\xee\xa6\x44\xb8\x74\xa6\x48\x8c\xb6\x11\x45\x61\x7e\x1f\x08\xf3\x5b\x3d\x70\x29\x65\x4c\xb5\x02\x63\x2f\x0c\x16\x01\xbf\x4f\x0e\x83\xe5\x2a\xa5\x13\xb9\xc7\x3f\xce\x4a\x9d\x49\x08\xa3\xbe\xc3\xe5\x78\xce\xa7\x4b\x71\x23\x24\xe6\xc9\xeb\x30\x48\x5e\x53\x0c\xd1\xf5\x4e\xae\x74\xbb\x79\xa6\x16\xe5\x46\xd3\x9a\x74\x1b\xb4\x71\x9a\x86\xea\xdc\x61\xf8\xfc\x3e\xf5\x52\x84\x3e\x68\xd4\x03\xc2\xb5\x68\x5f\x97\xa2\x5d\xee\x9e\xae\x04\xf3\xf5\x04\xfa\x40\xab\xb8\x0f\x91\x5f\xe5\x63\x8c\xbd\x64\xb5\x75\x4b\xcd\x79\xc4\x67\x56\x03\x5f\x1f\xfc\x7f\xe4\xfd\xdb\x73\x23\x3b\x9a\x20\x86\x3f\xfd\x62\xdf\x7e\x0e\x87\x5f\x36\xdc\x2f\x50\x76\xcf\x39\xe4\x11\xc9\x22\x29\xa9\x2e\xd2\xa9\xaa\xa6\x28\x4a\x45\x5d\x4b\xa4\x44\x95\xa8\x52\xd7\x49\x92\x10\x95\xc5\x64\x66\x0a\x99\x94\x28\x9d\xaa\x5e\x87\x63\x77\xc3\x63\x7b\xd7\x6b\xc7\xc6\xec\x84\x3d\xf6\xae\xed\x59\xcf\x8c\x63\xc3\xb1\xbb\x31\xe1\xdb\x8e\xed\x88\x3e\x7e\xf7\xff\xe0\xbf\xc4\x81\x4b\x66\x02\x48\x20\x33\xa9\xaa\xee\xe9\xb1\x2b\xe6\x4c\x8b\x48\xe0\x03\xf0\x01\xf8\xf0\xe1\xbb\x26\xab\x72\x56\x88\xa1\x87\x6b\x2c\x02\x48\x7d\x5f\x92\xf6\xc5\xc8\x8b\x5a\x07\x35\xf4\xa4\x91\xa0\x0a\xd6\xfa\x09\xa8\xb9\xd1\xc3\x8e\x5f\xaa\x38\x8d\x7f\x0f\xcb\x0e\x6d\x19\x1d\x51\x19\x64\x7a\xcc\xa1\x50\x8c\x44\xea\x16\x64\x56\x95\x95\xe6\xd9\x59\x61\x3e\x57\xe2\x92\xfb\x98\xed\x45\xde\x8f\x79\x8e\x9e\xbe\x27\xb5\xe6\x53\xee\x23\x07\xe6\xc6\x30\xd8\x14\x9d\x8c\x1f\x33\x23\xc9\x4f\x39\x7b\x6e\x69\x7d\x66\xce\x6d\x90\x68\x9c\x35\x4b\xa1\xc7\xf6\xd4\x1c\x67\xca\x76\x75\x3d\x86\x8d\x17\xea\x31\x11\x01\x75\x81\x0e\x59\xdb\x85\xfa\x53\x87\xc2\xca\xdf\x27\xd7\x3e\x47\xbf\x4d\xde\x4a\x20\x3d\x70\x29\x5f\x33\xc7\xce\xa4\xf2\xeb\xf4\xf8\xb1\x71\x0c\x95\x31\x0c\x9a\xb6\x05\x9d\x20\x8e\x22\x1b\xca\x2f\x92\x66\xff\x3f\x86\xb7\x3e\xac\xd0\xbf\x4a\x80\xdd\xfd\xb0\x42\xfe\xe0\x9c\x1f\x55\x66\x12\x59\x3a\x84\x68\xf4\x0b\x04\x8b\x15\x66\x5c\x28\xd2\x71\xe4\xed\x67\x91\x70\xb7\x72\x47\x14\x03\xd9\x3d\x85\x0c\x6e\xbe\x5e\xa2\x9b\x24\xef\x14\x98\x82\x39\x3f\xf5\xc9\x00\x8c\x20\xde\x9d\xe6\xf0\x3a\x05\x2a\xbf\xc6\x8f\xb2\x07\xca\x3e\x1f\x9d\xd8\xe8\x29\x33\xb4\x69\x34\xe2\x42\x86\xc9\x94\x3f\xbc\x86\xa3\x99\x0d\x3b\x70\x84\xcc\xbb\xcc\x8b\x2b\x0e\x7b\x99\x62\x19\x11\xaa\x93\x61\xe2\x71\x29\x84\x87\x81\x91\x2f\x1e\xf7\x12\x4c\xda\xa6\xc4\x76\xba\x7a\xdf\xc1\xd0\x35\x87\xbd\x19\xa4\x30\x4b\x2c\x76\x9e\xd2\x30\x24\x75\x55\xf0\xc2\xe9\xa2\x2d\x62\xd6\x98\x84\xb7\x25\xea\xce\x02\x1f\xd8\x06\xdf\x85\x64\x46\xd1\x57\x85\x67\x2f\x32\xef\x7a\x34\x2b\x4f\xc7\xbd\xf3\x89\x35\xa0\x95\xc9\x07\xb1\xc5\x6a\xe7\x58\x05\xea\x3a\x74\xcd\x9b\x4f\x71\xab\xa7\xc4\x72\xb8\x5e\xaa\xd0\x48\x2a\x20\xe0\x25\x1f\x8a\x5d\x33\x8e\xf0\x1f\x75\x3f\x06\x50\x09\xa9\x04\xf8\xad\x25\x6b\xa5\x3f\xc7\x11\xde\x41\x0e\xb5\x2b\x7b\x6d\x2c\x7e\x59\x5e\xc5\xad\x20\x58\x06\x86\x37\xff\xe2\xb7\x43\xae\x98\xe5\x9e\x89\x7c\xd8\x76\x82\x42\xca\x98\xb2\xfa\x9b\x42\xd3\x9f\x21\xd8\x94\x2c\x20\xd3\xa9\x04\xb5\x82\x8c\x4c\x53\x23\xab\x48\x89\x81\x4f\x93\x63\x92\x26\xa2\x10\x13\x17\x95\x23\x53\xcc\x32\xaf\xc0\x65\xd5\xbf\x8a\xf8\x20\x7a\x6f\x9b\xb3\xc0\xe5\xd4\x10\xe1\xdb\x5b\x2e\x16\xc6\xd0\xf5\x4c\x27\x7b\xa6\xbe\x67\x3a\xe2\x54\x49\x3b\xc5\x74\x71\xcd\xf2\x9d\x8b\x26\x26\x61\x7c\x92\x7d\x09\x91\x73\x0b\xc6\x3b\xa3\x82\xa0\x07\xcd\xa0\x50\xab\x56\x8b\x78\xb3\xbd\x47\x46\x91\x2f\x13\x31\x96\x14\xbe\x46\x90\x85\x9a\x9b\xa6\x0f\x6d\xcb\x81\x5f\x73\x6e\x03\x06\xd3\x50\x76\x94\x3c\x3b\x46\x35\x3e\x38\x52\x5d\x49\xcf\xfb\x4e\x35\x80\x18\xde\x59\xc8\x8a\x40\xe1\x21\x9f\x22\x52\xa4\xd8\x52\x99\x04\x28\xd9\x3a\x01\x89\x48\x34\x18\x0e\x18\xfb\xf6\x04\xd4\xaa\x55\xaa\x7c\x25\xa3\x21\xbf\x15\x8c\x60\xf6\x6a\x45\x68\x20\x31\x06\x43\xac\x46\x11\x4a\x45\x4c\xb9\xc4\x2e\xe9\xc4\xf5\xc4\x7d\x90\x78\x46\x27\x20\x4b\x28\x52\x37\x88\x2a\x2a\x84\x8e\x1a\x0d\xde\xed\x98\x8e\xfb\xc1\x75\xa7\xdb\x26\x4b\x81\x13\x4b\x04\x23\xf6\xd6\xb4\x61\x06\xec\xc4\x90\x42\xd8\x59\x74\x5d\x26\xc2\x59\xdc\x98\x54\x97\x14\xaa\xe8\x64\x61\x41\x71\x16\xad\x96\x8f\x17\x54\x98\x45\x73\x5b\x91\x0b\x0a\x18\x3e\x31\xc2\xf7\x42\x78\x0d\x0c\xe3\x8a\xdc\x65\x44\xc1\x52\x5e\x3d\x96\x41\xdc\x3b\x43\xc6\xc2\xf8\x5b\xd6\x14\x3a\xbe\xe5\x3a\xfe\x87\x44\xc4\x68\xc5\x3d\x4f\xd9\x20\x9a\x4a\x86\xb3\xba\xf9\x31\x36\xa6\xa4\x62\x9f\xcf\x25\xdd\xa4\x01\x61\xc3\x68\xfd\x8e\x7b\x77\xe2\x52\x16\xa8\x00\x05\xce\x93\xf8\x5a\x31\xa3\xf4\x42\x91\x98\x0a\xa9\x39\x50\xb2\x00\x99\xfc\x90\x7a\xc2\xd9\x8f\x3c\xd5\xeb\x45\xc6\x86\xc2\x32\x9f\x3d\xe9\x52\x8c\xf3\xe3\x57\x20\xa9\x72\x1b\x71\x78\x61\x42\x0e\xab\x72\x55\xf1\xa7\x26\x0a\xb6\x6d\xd7\x45\x5b\x16\x46\x4b\x41\x6a\x25\x6c\xdc\x4a\xa8\xc3\xd2\x98\x39\xc9\x5d\x7c\xa7\x87\x21\xcf\x30\x6e\x7a\xe2\x7a\x07\xc4\xc6\x89\xf3\x13\x88\xbf\xd2\x95\x8c\x2a\x84\xe0\xf0\x4e\x55\x2b\xab\xa8\xbd\x54\x64\x3e\xa4\xed\x8c\xf2\x58\xea\x94\x55\x91\x9f\xb2\xd8\x81\x1c\xc9\x00\xf8\x1b\x45\x1a\xe8\x8d\x3b\x56\xec\x5c\xf8\x2c\x82\xac\x9f\x12\xff\x40\x22\x97\x4c\x9c\xcc\xde\xda\xe1\x5f\x02\x2f\x28\x57\xbe\x0e\x6f\xa9\x80\x44\x74\x4b\xad\x6b\xd3\x74\x39\xc2\x01\xa7\x94\x9e\x24\xd2\x49\x6d\x1b\xc4\x46\x5b\x62\xd3\x13\xd7\x03\xe5\xa8\xe7\x1c\x47\x86\x27\x1e\x19\x54\x4c\x76\x22\x49\x3c\x25\xc5\x03\xad\xd5\xb8\xc5\x48\xd2\x6d\xce\x70\xe3\x8a\x3d\x2e\xa7\x6d\x9e\xb4\x6d\x9a\x0f\x19\x02\xe9\x59\xec\x2d\x85\x48\x9b\x2f\x78\x47\xa1\xb0\xd3\x2f\x7a\x43\x51\x28\x98\x8e\xd2\xbf\x3e\x7c\xe1\xe3\x89\x41\xc9\xd8\x16\x44\xbe\x10\x29\xc8\xf1\x15\x23\x7b\x24\x91\x8d\x26\xdc\x4c\xca\x2b\x4b\x23\x31\xf9\xf1\xb3\xe6\xca\x52\x3c\xbb\x03\xfd\xb3\x3b\x19\x43\x0d\x4f\xee\x84\xd1\x92\x42\xa4\x21\xc1\xa5\x9b\xb1\x0c\xa2\x10\xf0\x1f\x84\x57\x3a\xe4\x02\xfd\x69\xaf\xdb\x0c\x59\x8f\x76\xe2\xe9\x38\x11\x7c\x0c\x44\x5f\x2c\x25\x66\x92\x07\x84\x5e\x10\x61\xcc\x66\xe1\xa0\x65\x59\x32\x73\x68\xcb\x70\xb4\x5e\x92\xf7\x41\xe8\x52\x11\xaa\x9b\x92\x9f\x38\xf3\x7e\x12\x3a\x43\x22\x7f\xb1\xef\x52\x1c\x4c\x35\x1c\x4b\xa8\x6d\xe2\xec\x69\xf8\x40\x56\x42\xe5\x04\x5d\x8d\x01\x6b\x8c\xfe\x55\xf3\xe1\xcd\xdf\x93\x53\xa2\xae\x22\xca\x09\x29\xe6\xcd\x87\xb0\xff\x1a\x53\x4b\xef\x22\x9c\x24\xb9\x64\xd5\xe3\xfe\xed\x0d\x28\xd9\x41\x71\x63\x21\xbc\xc8\xa0\x36\x12\xfb\x24\xf9\xae\xc8\x40\x87\xc2\xe7\x66\x23\xc7\xe6\x4b\x82\xcd\x31\x16\xe5\xae\x5b\xc0\x95\x40\xa4\x50\x0b\x9e\x41\xe6\xc0\x94\xbd\x5d\xbf\x57\x9d\x3f\x4c\x28\x44\x0c\xf0\xf6\x43\xc9\x5d\x91\x6a\xe8\xf3\xb5\x0e\x5a\x92\x76\x70\x63\xe7\x2d\x96\xf2\xec\xa5\x5c\x5b\x9b\x03\xaa\xd9\xdd\xc9\x03\x96\x7f\x20\x11\x99\xfc\xd2\xa1\x84\x80\xfe\x66\x1c\xaf\xe4\xde\x7a\xc4\x40\x14\xe0\x16\x3c\x5a\xfc\x1d\x9f\xe1\x57\x1b\x7e\x42\xc9\x6f\x80\x0f\x21\x84\xe7\xa3\x4e\x81\x42\xc3\x04\x85\x89\x60\xb7\xac\x11\x71\x43\x82\xce\x10\xdf\xc4\x10\x01\x6a\xb8\x44\xfc\x90\x0c\x8d\x5b\x1d\x73\x70\xe3\x0f\x16\x03\xd7\x0a\xc1\xc0\x11\x33\x37\x02\x57\xae\x3d\x02\x03\xb2\x7d\xb2\x60\x47\x0e\xc3\x8a\x6f\x89\x48\x43\x25\x80\x74\xf1\x18\x91\xcc\x84\x0a\xcb\xa1\x78\xef\x59\x91\x1f\x67\x14\xbe\x24\xb9\xbd\xb8\xf7\xa0\xbc\x4b\x4a\xb1\x43\x9e\x70\x38\xcc\x34\x15\x54\x89\xc4\xee\x88\x82\x91\xaa\xb8\x25\x85\xf2\x2c\x21\xc2\xb8\xa6\x41\x8d\xae\xc1\xf7\xc0\xde\x00\xd7\x9a\x10\x46\x43\xa6\x55\xb8\x56\xb8\x65\x9a\x78\xb7\x38\x34\x14\xbc\x29\x38\xd4\x0d\x69\x21\x71\xfa\xe4\x5c\xed\x5e\x92\x2f\xa8\x60\x96\x80\x5b\x0c\x43\xf1\x8b\xca\xb5\xd0\x2b\x92\xb8\x70\xfa\xca\xa6\x3e\x6b\xea\x6b\x9a\x92\x61\x91\xae\x4d\x7d\xa8\x15\x21\xde\xe3\x15\x0c\x86\xd7\x61\xe8\xc4\xc2\x50\x9b\xbd\x67\xa6\x75\x0b\x0d\x9d\xf6\x6c\x77\x5c\x30\x9a\xee\xcc\x1e\x39\xdf\x06\x80\xc0\x25\xde\x79\xc4\x52\x8f\xba\xe7\x29\xc1\xe3\x7f\x03\x04\xcd\x49\xf2\x93\xc2\xa9\x91\xcc\x6b\x16\x19\x55\x69\x68\xee\xac\x04\xcc\x62\x09\xcc\x14\x81\x31\xd5\x54\xc9\x64\x88\x9d\x89\x71\xbe\xd6\x49\xa1\x99\x92\x48\x48\xeb\x7a\x4a\x43\xb4\x68\xf1\xac\x8f\xd5\xf2\xbb\xc5\x67\x26\x16\x95\xf3\x4d\x60\x45\xef\x82\xfb\xff\x52\x3c\x28\x55\xd3\xa6\x8e\xdf\x22\x87\xd7\xc9\x21\xa5\x16\xde\xe8\x19\x4f\x7a\xd1\xe2\x5d\x34\xe8\x2b\x28\x6a\x24\xc3\x01\xa7\x82\x49\xde\xda\x42\xcd\x62\x51\x63\x90\xaf\x1c\x49\xa2\x92\x66\x30\x29\xc0\x92\xe3\x91\x2b\x17\xb3\x10\x3c\xc4\x2f\x73\x2e\x29\x5a\xba\xc9\x92\xe2\x51\x7f\x01\x23\xb2\x7c\x99\xc7\x38\x91\xdf\xec\x59\x21\xbc\x15\x0a\xaa\xf0\xd0\xa8\xe5\x12\xdf\x7c\xc3\x25\xea\x57\x57\x79\x9d\xf2\xf1\x02\x5e\x86\x96\xa1\x8a\xcb\x92\xf8\x13\xc0\x54\xa1\x4f\x14\x81\x9d\xc7\x2a\x95\xc4\x64\xea\xfb\xf1\x92\x35\x6c\x3b\x43\xee\x9f\xc8\x7e\x21\xbf\x70\x79\x12\xc4\x45\x19\x50\x73\x78\xe9\x62\xe9\x6c\xa6\x55\xd7\x54\x26\x3e\x50\x4d\xeb\xaa\x0a\x4f\x22\x9e\xca\x40\x49\x32\x9f\x29\xb4\xe2\x48\x50\x9c\x30\x45\x8b\x1e\x59\x1b\x51\x12\x52\x2d\x6a\x78\x25\x50\x06\x35\x55\xd4\x0d\xfe\x95\x24\xc7\x37\xe6\x99\xa1\x64\xdc\x3b\x7e\x2d\x34\x6f\xad\x1c\x4b\xa1\x69\x29\xaf\x44\xa0\x5e\x09\x94\x5c\x09\xe1\x05\x9c\xc4\x6c\xa0\x60\x55\xb5\x13\xd7\x45\xfc\x8d\x15\xfb\xfa\xb0\x26\x71\xae\x3f\x48\x73\x0c\x5b\x15\x38\x0f\xa0\x33\x2a\x60\xc6\x56\x11\xc0\x47\x23\xc5\xcd\x65\x78\xe7\xda\xf6\x81\x39\x4f\x23\x4c\x8c\x10\x25\x15\x35\xb2\xd2\x40\x21\xbb\xcc\x2b\xf7\x2f\xa7\x80\x27\xfa\xd5\x4c\x95\x40\xa4\xc0\xa4\x5e\xf2\xe9\xf6\x43\x3a\xb9\xb6\x2c\xa1\x85\x0b\x49\x63\x15\xba\x3e\x98\xa6\xd8\x4b\x41\x57\x49\xb0\x58\x8b\x97\x49\xd4\x77\x82\x57\x00\xb1\x68\xdd\xf8\x98\xc9\x3e\x16\xa4\x15\x46\x07\xa6\x98\x5c\x7e\xbf\xc4\xf7\x38\x01\xb0\xac\xd5\x5d\x00\xef\x91\xde\xf0\x6f\x38\xea\xd3\x77\x2a\xd7\x51\x81\x44\x8c\x55\x8e\x31\xa5\xe7\x62\x94\x4e\x57\xc8\x90\xfb\xa8\x45\xcb\x71\xc2\x39\xdd\x42\xa6\x41\x1b\x79\x52\x13\x43\x28\x5d\x9f\x4f\x52\xe6\x95\x3d\x16\x51\xcd\x93\x4d\x71\xb4\x5b\xa0\x0c\xd4\x61\xe2\xb8\xf4\x37\xa1\x0b\x7a\xde\x9c\x29\x29\x56\x05\xa1\x5e\x99\xdb\x77\x82\x81\x01\xc9\x70\x11\x6a\x4a\x13\x95\x42\x23\x83\xe8\xb1\x1a\xea\xfb\x42\x75\x53\x68\xbd\x11\xf9\xd0\x27\xad\x37\x62\xf7\x9d\x9c\xa6\x2e\x82\xfb\xbc\x06\x05\xf9\x1a\x67\xc9\xac\xc9\xa8\x84\x16\x61\x38\xf9\x11\x75\xdc\x7e\x4b\xdd\xb8\x15\xd1\xb2\x04\xfc\x73\xe1\x0d\xb7\xa0\x1d\x98\x04\x0a\x92\x35\xe7\xf1\x4e\x2c\x0b\xbc\x32\xd9\x34\xd1\xc1\x22\x56\x08\xaa\x7c\xce\x56\x36\x4d\xa5\x90\x5e\x01\x2b\x82\x64\x91\x81\x2c\x69\x47\x92\x7a\x44\x49\xa6\x59\xd9\x93\x7d\x11\xa3\x58\x09\x2d\x0b\xbc\x1f\xfc\x3b\x8b\xe6\xfd\xad\x8c\x70\xcb\x03\x12\x4f\x4b\x5a\x82\xa1\xe9\x43\x40\xa0\x13\x07\xc3\xca\xd6\xd1\xc1\x87\xad\xd6\xfe\x49\xe3\xc3\xdb\xf6\xbb\xd6\xfe\xba\xe2\x89\x4c\xc4\x89\x04\xe2\x79\x48\xe6\xd4\xa1\x29\x15\xaf\x71\xf2\x12\xdf\xc8\x3b\x84\xfd\xf6\x61\x2b\xe7\x08\x32\xcc\x66\x1e\xd7\xff\xdb\xc6\xce\x97\xf4\xcf\x61\x87\x6c\x8b\x31\x0c\x19\x9b\x8c\x20\xf9\xb8\x61\xe6\x11\x25\xe1\x1f\x16\x3f\xd9\x51\x80\xd0\x1c\x67\x9a\xd4\x5d\xec\x34\xd3\x40\xe7\x29\xe0\xa5\xc9\xfe\x68\x8d\xd6\x01\xac\x58\x23\xe8\x04\xd6\x95\x05\x51\x09\xdc\xaf\x93\x7c\xd2\x98\x0f\x3c\x2f\x81\x79\xfc\xeb\x9d\x1c\xd7\x56\x5a\xc8\x78\xbf\xe3\xb9\x2a\x7b\xc5\x6b\xcd\xc7\x07\x51\xac\x2e\x08\x9f\x27\x01\x15\x0f\x07\x2c\xe6\xbd\xe9\x8c\xe1\x88\x60\x84\x8b\x7b\xbf\xbc\x1c\x14\x69\x76\xb3\x82\x5c\xe7\x22\xb8\x2c\x72\x06\x67\x14\xef\x17\xa8\x62\x8d\x2e\x31\x59\x48\x13\x55\x29\xbe\xc5\x03\x67\x61\x40\x14\x23\x8f\xeb\x40\x67\xf4\x75\xa6\xc6\x0c\x57\xe4\x59\x28\xe6\xca\x2d\xa1\x1c\x57\x3f\xff\xdc\x48\xb4\x15\xcd\xc0\xc3\xa8\x8a\x72\x3a\xfe\xaf\xbf\x66\x2e\x58\x7e\xa9\x5e\xb8\xca\x3d\x28\x03\x54\xb9\x5f\x7c\x5d\x5d\xf0\xdd\xcb\x64\xa2\x08\x7e\x6e\x7e\xda\x55\xe7\x6a\x1a\xfa\xd1\x95\xe7\xab\xae\x3c\x1e\xbe\x93\xef\xea\x8b\x21\xbf\xa2\x49\xbd\x08\x64\x87\x58\xe4\x3d\xf2\x0a\xf4\xf5\x52\x53\x40\x7d\x80\xa4\xeb\x31\xff\xed\x18\x05\x96\xcb\xf3\xea\xf8\x22\xdb\x61\x1a\x2e\x67\x71\x7a\x4b\x82\xec\xe4\x23\xb7\xb8\xaa\x8e\xda\xc6\x18\x5e\xd8\x84\x4b\xa9\xaa\x5e\xd2\x18\x92\x44\x62\x9b\x72\x0e\xc5\x3d\xa8\x2b\x04\xf7\x32\x53\x2d\x59\x7d\x21\xbd\xd5\x57\x22\xa4\x70\x1c\xde\x35\x75\x14\x49\x65\x6e\x38\x0e\x4b\xa5\xc7\xd2\xa9\x22\x05\x90\x71\xa2\x72\x5d\xa5\xf5\x1c\x32\x48\xa5\x6c\x5d\x94\x79\xeb\x44\x6f\xc4\xc5\x26\x7b\xf6\xcb\xa0\xc6\x45\x03\xc9\xb0\xf0\x89\x07\x91\xcf\xee\x28\xbf\xe8\x51\x71\xac\x15\x8b\x97\x30\xb2\x52\x48\x06\xc3\xa5\x53\x51\xbc\x14\x15\xb3\x00\x34\xb9\x78\x4a\xc4\x85\x4b\x98\x4b\x8c\xb9\x1c\x85\x59\xcf\x50\x62\xa4\x2f\xa8\xab\x33\xb0\x8a\xc4\xd6\x39\x96\x52\x1e\x81\xce\xac\x25\x4b\x59\x95\x42\xb6\xf8\x78\x59\x79\xa8\x57\xec\xd9\xad\x25\x08\x34\x07\x4b\xe5\x0a\xb9\x53\x4c\x89\x9b\x44\xb5\x50\xb9\xbb\xb6\x86\xd7\xc5\x4a\xe0\xee\xbb\x77\x10\x35\x4d\x1f\x26\xae\x24\x7c\x4d\x07\xc8\xde\x83\xf7\xe0\xd3\x27\x00\x2b\x53\x18\x98\x7b\xf0\x9e\x24\x7f\x35\x6e\x0d\xf2\xe4\x8e\xd4\x10\x42\xdc\x14\x21\xa8\x43\xbe\x89\xd3\x98\x66\x59\xd7\x89\xaa\x17\x8d\xa1\x3f\xff\x20\xcb\x65\x30\x1c\xc4\x12\x81\x30\xde\xda\x8f\x78\x8b\xad\x93\x44\x61\x5c\xbf\xb7\xa6\x3d\x83\x34\x0c\x5a\xb2\x3c\x54\xa7\xa9\x03\x5c\x00\xd1\xc0\x58\x8b\x8f\x94\xa8\x32\x69\xd1\x4e\x2a\x7e\xe0\x7a\x6f\x91\xeb\x99\x63\x93\x4a\xb7\x33\x55\x41\xb1\xc3\x0d\xb3\xe9\x59\xcc\x05\x34\x0c\x2f\x76\x4e\xe4\x7e\xaf\x41\x28\x4c\x01\xeb\xc0\x60\x51\x8b\xf2\x0e\x81\x3e\x63\xdd\x5b\xc8\x9e\xb2\xd9\x5e\xdb\xda\xcc\x0c\x91\x66\x50\x48\xb7\x41\x42\x73\xf8\x46\x09\x90\x5f\x6f\x11\xbc\x82\x08\x3a\x43\x78\x60\x3a\xe6\x18\xa2\xf0\x03\x82\xbe\x3b\x43\x43\x18\xfe\xbe\x1b\x86\x7f\x71\xf9\x3a\xf6\xe0\xfd\xc0\x35\xd1\x28\x2e\x39\xf2\xf0\x38\x7d\x2e\xa5\x87\xaa\x03\x96\xdd\x83\x06\x14\xe5\x7f\x33\xc4\x48\x19\x40\xa2\x5f\x62\xd6\xae\xb8\xbc\x77\x42\xb2\x84\x9c\x40\x34\xb5\x1c\x33\x2d\x14\x0e\x53\x45\xba\x57\x96\x0d\xdb\xa3\x84\x9b\xbd\x35\x35\xd1\x7d\x37\x8a\x82\x1a\x25\xf4\x80\xd0\x61\x75\x4c\x3b\x80\xc8\x31\x03\x98\x5a\x8b\x0b\xa4\x9a\x04\x2c\xd4\x49\xe6\x1c\xa9\x96\x04\xb1\x2c\x97\x4f\x42\xce\x2f\x22\x64\x15\xe1\x03\xd8\xfb\xb3\x81\x3f\x44\xd6\x00\xe6\x0a\x85\x9c\x0e\x60\xa1\xd8\x9a\x2a\x00\x0b\x84\x6d\x8c\x9a\x47\xfc\x2e\xc7\x9c\xf2\x6d\x38\x5f\x41\x7d\x2e\x10\x1a\x73\x3a\x2a\x12\xba\x19\x98\xc3\x49\x68\xb7\x77\x71\x19\x72\x4a\xe6\xa0\x1b\xb8\x9e\x58\xc8\x78\xe1\x13\xf6\x8d\x0f\xa9\x75\xcb\xce\xec\x89\xeb\x49\xc3\x08\xbf\x50\x1e\x53\xfa\x28\xe6\x9c\x4d\x7c\xe8\x5e\x9b\x1e\x0c\x93\xf5\xd2\x0d\xcd\x7f\xa9\x6c\xee\x1f\x35\xf7\x84\x16\x24\x0e\x8d\x12\xd6\xa6\x6d\x39\x93\xe6\xfd\xd0\x26\x00\x2f\x88\x4f\x6a\xad\x5a\x0d\xe7\x36\xbd\x3f\x72\x9a\x71\xc5\x0f\x1c\xc2\xb9\xd2\x24\xde\xa5\x10\x38\x52\xd7\x57\x62\xe4\x20\x39\xfb\x0c\x41\xcc\x91\x73\x34\x0b\x18\x21\x57\x7c\xdc\x83\xf7\x7e\x80\xdc\x89\x8c\x21\x8e\xd6\x35\x10\x72\xef\x70\x3d\x79\x07\xc0\x2b\x68\x06\x07\xee\xcc\x87\x1d\xe8\xb9\x88\x26\x80\x89\xd2\xc7\x0c\xa0\x6d\x37\x66\x23\xcb\xcd\xf6\x75\x36\x71\xb5\xc8\xd7\x38\x6e\x28\x86\x33\x84\xb6\x5d\xa6\x35\x93\x15\xc5\x08\x5b\x1e\x82\xb6\x6b\x12\x62\x69\xce\x02\x11\xf2\xa1\x1b\x58\x57\xd6\x90\xdc\x57\xfb\x96\x1f\x48\x5b\xd0\x9f\x04\xae\xc7\xd7\xd9\x84\x54\xb9\x10\xcd\xcb\x37\x6f\xe1\x88\x51\x5f\x31\xe3\x4d\x5c\x46\xe9\x07\xab\x14\xee\x79\x55\x96\x9c\xdb\x20\xaa\xdd\x3b\x11\x56\x7e\xc2\x28\x7e\xf4\x3d\xbc\x02\x84\x5a\x96\x1b\x7d\x8f\xb6\x70\xfb\x48\xa8\x02\x49\xee\x21\xb2\x4c\x5b\xc8\x1c\xd3\xe3\x22\x24\x08\x72\xbd\xfb\x23\x87\xb2\x99\xe2\x0a\x93\x00\xe8\x24\xcf\x5a\xd3\xb6\x86\x93\x30\xa2\x8f\x5c\x83\x94\x6f\xce\x82\x80\xe6\x66\x8a\xbf\xe2\x2e\xe9\xa1\xa6\x01\xe4\x19\xa9\x89\x50\x39\xb4\xa1\x89\x22\xdd\x78\xe3\x2a\x80\x28\xac\x52\x8d\x9e\xed\x24\x2a\x34\xa6\xd7\x1f\x0a\xcf\xab\x25\x50\x5f\x8d\x5f\xbc\xa1\x28\x21\x24\x19\xdc\x63\x38\x78\x4b\xaf\x9e\x02\xf5\x4d\x67\xb4\xc5\x48\x75\x8f\x0d\xc5\xa1\x0c\x91\x1d\x68\x8e\xee\x05\x4e\x8a\xa7\xa5\x31\x9b\xa1\xa2\x1d\x78\x8d\xe3\x86\x84\x90\xac\x03\x83\xfc\xaf\xc1\xe5\x51\xdb\x6c\x35\x0e\x70\x79\xab\x71\xc0\x17\x9f\x1e\x6e\xb5\x3a\x44\x2a\x0e\x8c\xe8\x6f\x43\xd1\xa3\x20\xf1\xe5\x87\xad\x56\x01\x6a\xda\x06\xe6\x20\x8c\x5e\xf4\x5c\x5d\x23\xc6\x67\x8e\xe8\x80\xc2\xa5\x0f\x2b\x08\x7a\xb6\x39\x84\x85\x27\xef\x9f\x3c\x19\x97\x80\xa1\x8e\x42\xaf\x72\x77\x46\xf0\xca\x8f\x0d\x8f\xe8\xcf\xca\x08\x9a\xc3\xc0\xba\x25\x61\x48\x4a\x42\xc5\xf0\x20\x24\x18\xa1\x82\x34\x2c\xb1\x5d\xc5\x1c\x8d\x8e\xc2\x64\x55\x05\xba\x7b\xa5\x8d\x61\x98\x76\x50\x1e\xa3\xf2\xd4\x1d\x41\x63\x3d\x53\xe6\x1d\x9e\x11\xfc\x62\x21\x4c\x2a\x74\xca\x33\x9f\x3c\x60\x1c\xf3\xd6\x1a\x9b\x81\x8b\x2a\xb6\xe9\x8c\x67\xe6\x18\x8a\x4f\x22\x5c\x9b\x44\x3f\xc7\x0c\x2d\xc2\x27\xaf\x6c\xda\x01\xf9\x45\x33\xf7\xd1\x67\xd0\xbd\x07\xdd\x2b\x02\x5b\x6e\xbf\xce\xda\x97\xc0\x93\x5f\x15\xf0\x5f\x9f\x48\xd0\x4d\xd3\x0e\x3e\xd9\xf0\x8a\x40\xfb\x14\xc1\x2d\xfe\xe2\x49\x25\x80\x7e\x80\xe7\xf1\xe9\x13\x31\x5b\x8f\x83\xaf\xa3\x88\x04\x61\x56\x6c\x07\x1d\xd0\xa8\x4d\x50\x7a\x6d\x96\x28\x76\xf0\x45\x45\xe2\xf7\x96\x2d\xbf\x8c\x5f\x6e\x71\x49\x0e\x8c\x89\x7d\x6d\x86\x2d\xdb\xfe\x01\x0c\xcc\xe8\xa7\xbe\x77\xd6\xe7\xc2\x3d\xd1\x0e\xf4\x70\x7d\xe8\x8c\xfc\xf2\xdd\xb5\x19\xe4\x00\xfd\xe4\x57\x05\xe8\x0f\x4d\x0f\x7e\x7a\x5e\x1e\x58\xc1\xa7\x01\x72\xef\x7c\x88\xca\x13\x78\xaf\x44\x34\xad\x9c\x44\x75\x17\x77\x7a\x76\x6d\x06\x9a\x71\xcd\x46\xf8\xdd\x54\x26\xf7\xa1\x4f\xa2\x99\x64\x8f\x2d\x0e\xd2\xc4\x32\xa3\xfe\xca\xb6\x06\xe5\xf0\xe9\xb1\x5e\x78\xdf\x5d\x2e\x3e\x51\xe6\xf7\x07\xaf\x01\xd2\xdf\xb4\x3e\xc2\xaf\x15\x2b\x7a\xc4\x90\xe8\x61\x66\x60\x9e\x22\xbb\x10\x90\x64\xb1\x60\x3d\xbb\x7d\x22\x41\x4c\x09\x53\x6c\x72\x15\x97\x1d\xee\x2e\x26\x73\xce\x75\x00\xbf\xf9\x06\xf0\x97\x38\x78\x0d\x0a\x28\xed\x76\x37\xc6\xc8\x74\x02\x38\xa2\xb9\x86\xf9\x1a\x15\x0f\x93\x43\xdf\xb7\x5c\x07\x2f\x94\x16\xc6\xa7\x4f\x91\x0d\xf3\x9d\x89\x9c\x82\x7e\x02\xc0\xf2\x41\x80\x66\x10\x0c\x66\x01\xb8\x83\x60\xe4\x12\x07\x93\x6b\xf3\x16\x82\xb8\x33\x10\xb8\x61\x7a\x0d\xc0\x83\xf0\x2b\x06\x43\x6a\x2a\xb3\x92\x44\x68\xcc\x4a\xd2\xc4\x06\xb9\x0e\x4b\x32\x84\x63\x41\xb5\x58\x1c\x6c\x6b\x6a\x8e\xf3\x1d\x79\xe1\x15\x93\x08\xdc\x98\xd5\x0f\x79\x6c\x7d\x51\x37\xe4\x0d\x98\xd1\x4b\x18\xf8\xe8\xcb\x7a\x0a\xe3\x2c\x6a\x7b\xa3\x94\x93\x52\x9b\x47\xd2\xcd\xa8\x19\x21\x1f\x19\x34\x33\x8e\xfe\x34\x35\x3d\x92\xfd\x1c\x59\x23\xe8\xe7\xe8\x31\xbe\xd7\x3e\x7d\x22\x06\xc9\x7e\x60\x3a\x43\x7c\x1b\x1d\x0d\x3e\x62\x06\x92\x9c\xb4\xdb\x20\xd6\x94\x1f\x98\x1e\xd3\x66\x10\xbd\x40\xf2\x9b\x0f\x83\xa3\x70\x04\x05\x48\x36\xb7\x78\x92\xe2\x0b\x1d\x88\x03\x9f\xba\xa3\xf8\x5c\x84\xf9\xa2\x4c\x07\xb8\x64\x28\xd4\x5b\x40\x85\x72\x96\xd2\x67\x40\x92\xc3\xe4\x3c\x06\xdc\x3b\xad\xb0\xb4\x94\x05\xb5\x3c\xc4\x4f\xc1\x5c\xa4\x8a\x43\x61\x03\x21\xf3\x9e\x88\x3b\x9d\xd9\x74\x00\x91\x70\xd9\x5f\x54\x2f\xb5\x9f\x6a\x97\x84\x4c\xab\x9e\xa2\x90\xd0\x0a\xd5\x17\x15\x24\xf0\x1a\x5c\xc0\x12\x20\xc6\xe2\xf1\x23\x56\x3b\xd7\x85\x08\x49\x33\x7e\x42\x2b\x0f\x02\x01\x56\xf6\x4c\x1b\x06\x01\x5c\x68\x53\x46\x89\xd2\xd3\x36\xa6\x5c\x4c\x90\x9d\x9a\xfe\xce\x62\x22\x3c\xfa\x3f\x6f\xe9\xc0\x48\x8c\x18\x56\xee\x07\xee\x70\xd2\xe4\x3e\x56\x86\xae\x33\x34\xc9\x46\x87\xc5\xd8\x7b\x2e\x00\x96\x03\xd4\x43\x0f\xff\x85\x6a\xef\x38\xd6\x5c\x56\x2e\x3a\xcb\x3f\x34\x0f\x0b\x2e\x61\x28\x5c\xa2\x1f\x26\x7f\xbc\x02\xf5\xb5\xb5\xa2\xe8\x4f\xc3\xe2\x11\x02\x2a\x31\xb6\x1c\xc0\x90\x4c\x4f\x08\x89\x6b\xc2\x0e\xcb\x45\x70\xc9\xa7\xec\x83\x17\xee\x65\xae\x04\xde\x3e\x8f\x16\xc7\x45\x53\xf2\x6a\x6b\x76\xbb\x14\x44\x46\x56\xe6\x30\x07\xbd\x02\xdd\x17\xee\xa5\x42\xb5\xcc\xff\xcb\x9f\x2f\x19\x90\x9d\x28\x8a\x08\x89\x56\x27\x96\x74\xb2\xc8\x9c\xdc\x00\x28\xd1\x92\x85\x91\x39\x9a\x25\xfb\x97\xf2\xde\x25\x89\x9b\xfa\x00\x70\x84\xcd\x24\x04\xc2\x45\xd9\x14\xce\xf5\xee\xcb\xae\xc3\xf2\x3d\xe4\x3a\xa1\x92\x18\x60\x69\x49\x71\x6f\xcc\x7c\x58\x66\x4f\xe9\x32\x15\xfa\x95\x49\xae\x99\x3c\xf0\x75\xc2\x00\x55\x3f\x44\x30\x10\x27\xab\x28\x9b\x57\x98\xe4\xe7\xee\x2a\x45\xae\xa0\xec\x0d\xbf\x90\x3c\x7b\xe6\x97\xa7\x96\x33\xf3\xcb\x0f\x10\xb9\xe5\x07\xd7\x9d\x2e\x76\x03\x63\x30\x6f\xed\x99\x7f\x80\x81\xf4\x21\x72\xfb\x2e\x31\x93\xd6\xf5\x38\xcc\x3f\x21\xa1\x8f\x26\x9b\x8b\x0e\x2e\x4b\xb0\xb0\x38\xe0\x38\x64\x73\x29\xc9\xcd\xc4\xd1\x9f\x95\xc4\x1b\x9a\x7e\x50\x36\x7d\xcb\x74\xca\xe6\x74\x60\x8d\x67\xee\xcc\x2f\x9b\x7e\x39\xb8\x73\xf1\x2d\x31\x9b\xe6\x61\xa1\x48\x3e\x77\x04\xc7\x26\x1a\x35\x3f\x4e\x1a\x21\x1c\xf5\x5c\xa9\x5c\xab\x4c\xde\x5a\xe5\xa1\xeb\x04\xc8\xcd\xf3\x36\x20\x0c\x08\x6d\xfb\x7c\xd3\x22\x0a\x5d\xe4\xda\xba\xad\xc1\x3a\x19\xb8\x76\x9e\x57\x16\x22\x3a\xf4\x4d\xd7\x1e\x75\xcd\x2b\xd8\x0d\xd4\xb1\x52\x79\xa0\x18\x45\x03\xf2\x1e\xcf\x05\x3e\x9d\x76\x51\xb8\xb8\xfb\x86\xbf\x89\x58\x40\xa7\xa5\x25\x98\x87\x80\xe9\xda\xea\x47\x9f\x9f\x79\xc2\x38\xc1\xb5\xb3\x10\x32\xb4\x2d\x8f\xec\xc6\xf2\x1d\xb2\x72\x6e\xe0\x68\x31\x9b\x61\xe3\x33\xdc\x36\x63\xf0\x23\x38\xac\xd5\x17\x83\xbf\x85\x9b\xe8\xc0\x72\x79\xe0\x72\xa3\x24\x4e\x5d\xa1\x42\x49\x94\x42\x2e\x2f\x77\x15\x46\xb3\x55\x9e\x4e\x0a\x2d\x4c\xbd\xf1\xd5\x86\x18\x6a\x1f\x16\xe3\x03\xa5\x7c\x07\xca\x01\x5f\xbb\x53\x58\x9e\xc0\x7b\xbf\xcc\xb4\x62\x0b\x11\x33\xdc\x7a\x0f\xde\xfb\x91\xac\x3b\xb9\x64\xb8\xae\xe5\x8c\x2c\x67\xec\xe7\xe3\x2f\xf9\xa7\x16\x6b\x48\xef\x19\xc6\xec\xd1\xb0\x0f\x09\xb6\xb3\x08\x02\x74\xaf\xe3\xa0\x54\x30\xcd\xd1\x68\x93\xfd\x9d\xc0\x0c\x19\x3b\x18\x9a\xd4\xfc\x35\xd3\x97\x9a\x25\xd6\x25\x69\x8a\x31\xd7\xc7\xcd\x19\x78\x11\xdb\xa1\x66\x22\x40\x92\x5f\xc9\x0b\xae\x15\x66\x43\xa6\x4c\x8a\xa1\x58\xde\xa9\x39\x2f\x53\x79\x66\xd9\x87\x37\x33\xdc\x2e\xef\x69\x9c\x9a\x73\x6a\x61\xd2\x65\x0d\xd5\xb7\xc3\x14\x8e\x2c\x93\xee\x20\x13\xc1\xf2\x15\xfe\x6b\xb1\x4d\x44\x20\xe0\x5d\xd4\x40\x70\x1b\xff\xaf\xae\xa3\xc0\x64\x2f\x77\x26\xd0\x5b\xb0\x97\xc0\x24\x2f\xf6\x16\x69\xac\xe9\x83\x24\xa2\xa5\x62\xdb\xa1\x6d\x0d\x27\x0b\xdc\xf0\x1a\x1d\x8e\xb6\x17\x9a\x9c\x69\x40\xb4\x39\xb9\x09\xc5\x81\xa4\x06\x52\x91\x0b\xcf\x1c\x7f\xc1\x91\xc6\xad\x33\x8e\xb4\xe1\x99\xbe\x5f\x36\xed\xa0\xcc\x1e\xb6\x8b\x48\x33\xf0\x03\x84\xdc\x1a\x4c\x97\x1d\x0b\xea\x67\x3e\x44\x8d\x31\x74\x82\x50\x74\x7a\x60\x0e\xc1\x51\x17\xbc\x7b\x42\x2d\x93\x3c\xd7\x9b\x79\x06\x09\xc5\x51\xa1\x8d\x4f\xee\x3d\xe2\xd4\x52\xc1\x03\x6a\xd8\xc1\x21\x19\x4e\xca\x98\x09\xdb\xf6\xfb\x34\x68\xcc\xec\x65\x8e\x9a\xec\xfc\x2f\x18\xf5\xd7\x1e\xf4\x01\x0c\xcc\x7c\x83\xbe\x5d\x74\xef\x51\xe0\x3d\x35\x5c\x04\x87\xd0\xba\x85\x65\xe8\x0c\xdd\x51\xbe\xeb\xf5\xc9\xaf\x0a\xb3\xe0\xaa\xfc\xfc\x13\x32\xef\x64\xdd\x80\xf0\x3c\xfc\x56\x7c\xba\x5f\xb9\x48\xd9\xdf\xb7\x84\x82\xd3\x3c\x0a\x06\x81\x6c\x24\x64\x6d\x2d\x56\x5d\x3d\x09\x7a\x26\xf1\x93\x71\x12\x5a\x04\x2c\x20\xf3\x94\xec\x08\x52\xe1\xbb\xc4\x1c\x61\x21\xe0\xb1\x05\x83\x16\xf2\xc0\x44\x65\xe6\x3d\x98\x97\x0f\x91\x2d\xd1\x94\x8c\x08\x1b\x37\xc9\x59\x5b\x9e\x9a\xf7\x84\xd4\x97\x4d\x84\xdc\xbb\x72\xee\x3b\x45\x67\x4c\xa1\xc7\x13\xeb\xcf\xbd\x85\xe5\x69\x64\x6a\xb6\xd8\xc4\x92\xf6\x6d\xea\x09\xe2\xf9\xfc\xae\xb6\xae\xdc\x99\x6e\xdf\xc6\xcf\xd2\x9c\xbb\xf7\xda\xba\x0a\xca\xd4\x82\xf6\x31\xaf\x5f\xd2\xbe\x4d\x9a\xa7\x5c\x90\x01\x53\x8c\x2f\x82\x2e\x72\x06\x7d\x18\x84\xe3\x57\xae\x01\x49\xee\x3a\xf4\x17\xd9\x4c\xd1\xbb\x3c\xce\x4b\x97\x0a\xba\x8c\xdf\x7b\x5f\x00\x9f\x58\x35\xab\x3a\xb8\x73\xd1\xa8\x4c\x5c\x7e\xca\x84\x6a\x97\x6d\x78\xf5\xa8\xc7\x2c\x06\xb4\x89\xe1\x1c\x60\x30\xfb\x34\x12\xb3\xfa\xed\xaa\xae\x9a\x63\x6c\x8f\x7e\x69\x8b\x3d\x76\xc2\xbc\x07\x39\x46\x17\xd5\xcd\x31\xbc\xa9\x35\x1a\xe5\xa4\x5f\xa9\xe3\x3b\x20\x70\x72\x0e\x30\xae\x2c\x8d\x30\xfe\xf9\x59\xb2\x98\x40\xd0\x1c\x75\x03\x17\x99\x63\x98\x6a\xe1\xcc\x35\x21\x2a\xcc\xfb\x86\x6d\xd3\x90\xc0\xdf\x7c\x03\x82\x45\x4c\x6b\x04\xf7\xf2\xb7\x18\x64\xbe\x04\x52\xb4\xf7\x54\x80\x3e\x0c\x36\x91\x39\x9c\xc0\x00\x8e\x72\x65\x37\x0b\xed\xbc\x2a\x03\xb9\x19\xcc\xea\x88\x53\x81\xe4\x08\x48\xc4\x1b\x1c\xc2\xa4\x49\xa3\x3e\x4b\x9e\xbe\xae\x8b\x46\x10\x29\xb2\xe9\xa9\x11\xad\x1b\xae\x16\xdd\xfc\xa0\xb3\x70\x11\xc9\x67\x5b\x44\xbe\x32\xca\x42\x88\xce\x8e\x2d\x13\xe9\x0b\xe6\x20\x54\x98\x5c\x6a\x34\x1b\x45\xa5\x35\xb3\x2c\x59\x8b\xcd\xd4\xfc\x82\xd2\x6a\x53\x63\xea\x59\xd4\xd8\x41\x7f\x6d\xf8\x5a\xad\xb5\x28\x9d\xd1\xef\x92\x47\xe5\x95\x94\x47\x93\xb5\x88\x0b\x26\xc9\x54\x58\xc6\xfe\x7f\x69\x11\x75\x22\x36\xfd\x22\x3e\x2a\xdd\xa9\x3c\xc8\xd4\x4e\xd0\xcc\x69\xba\xd3\xa9\xe9\x8c\x9a\xb6\xe9\xfb\xe9\x86\x84\x42\x2a\x37\x76\x85\x8c\x61\x50\x30\xa0\x73\x6b\x21\xd7\x99\x42\x27\x10\x0c\x09\x0d\x26\x6c\xe2\x94\xd9\x24\x62\x0e\x79\x76\x2e\xbd\x04\x88\x30\xa8\x88\xd8\xde\xaa\xb2\x82\xab\x0c\x10\x87\x74\xb8\xcc\xb0\x10\x16\xa4\xeb\xcd\x44\x63\x2a\x89\x5a\x8f\x33\x19\x5b\xee\x7a\x68\x98\x5b\xf1\x66\xfe\x35\x11\x0e\xc6\x43\x5e\x07\xa8\x04\x5c\xa7\x35\xb7\x82\x3c\xda\x10\x0c\xc4\xf5\x48\xee\xba\xca\xcc\x21\xb2\x45\xdb\x8e\xec\x80\x49\x31\x8f\x9b\xa1\xed\xfa\x10\x3f\xb1\xe0\xdc\x0a\x0c\xf2\x66\x66\x6f\x6c\xf2\x25\xc5\x91\x2f\xba\xe0\x15\x7d\xf0\xa8\xc0\x8b\x58\x48\xdf\x4b\x96\xff\x96\x3f\x49\x0b\xe5\x79\x8c\xa2\xaa\x88\x87\x31\xbd\x3f\x71\xc4\x19\x51\x14\x23\x8e\x5f\x9e\x68\xe2\x14\x71\x69\x30\x0b\x45\x92\x18\x29\x7d\xde\x89\xf5\x79\xec\x48\xf0\x8e\x4d\xef\x0a\x33\x12\xbe\xdf\x33\x51\xe2\x0c\x51\xbf\x5b\xa3\x5c\x26\x26\xf3\x65\x23\xd9\x6d\x6c\x83\x1f\xfe\x15\xe6\x64\xa4\x1c\x02\xb5\xf4\xf5\x20\x0a\xee\x0b\x3f\xfc\xe2\x47\xf4\xf9\x17\x3f\xc2\xcf\x3f\x94\x84\x10\x44\x3a\x0a\x9d\x99\xd3\xaf\x4a\xac\xeb\x62\x09\x50\xe2\x74\xc7\x5a\x0f\xb5\xaf\x8b\xa0\xe6\x88\x6d\xbd\x29\x36\x0a\xc4\xac\x09\xb7\x2e\x93\x40\x3e\x86\x02\x82\x18\xbf\x83\xcf\x1e\x93\x06\x8e\xc6\xf9\xc8\x86\x17\x85\x9c\x22\x00\xb3\x49\x6e\xce\xfc\x83\xaa\xbd\x19\xe1\x21\x57\x37\x0b\xa6\x48\x97\x3b\x12\x75\x40\xba\x2d\x20\x28\x8c\x32\x76\xbf\x62\x55\x59\x27\xea\x3d\xc1\x74\x6b\xd2\x3b\x84\xdb\x33\x91\x6e\xab\xc8\xbb\x33\x2b\x55\xb1\x29\xe3\x97\xe5\xd8\x39\x33\x55\xf1\xc3\x51\x48\xd1\x8b\x52\x50\xc5\xc8\xf0\x6a\x29\x36\xbc\xd2\xba\xd3\xa6\x88\x47\xdf\x17\xde\xd5\x6a\x1b\xef\xfd\x65\xb5\xf9\x2e\x79\x65\x19\x4d\x74\xd4\xa5\x3d\x51\x2b\x31\x9d\xdb\x46\x3d\x74\x93\x56\x7c\xe3\xac\x49\xc3\x10\x89\x9a\x9a\x19\xcc\xb8\xbc\x24\x8f\xc0\x2f\xaf\x91\x97\x11\xcb\x2e\x7b\x92\x68\x40\x7c\x05\xe6\x53\x9b\xd3\xb7\xd3\xad\x6b\x8d\x98\xbb\x73\x7e\xad\x39\x6e\xaa\xcd\x01\xc6\xef\x76\x5d\x82\x3b\x94\xbb\xb2\x41\xe7\x5e\x12\x57\x9b\x30\x31\x15\x78\x33\x33\x6d\x5f\xcc\x67\x6b\x25\x6d\x93\xc9\x88\x23\xda\xe5\x83\x91\xe5\x93\x37\xd8\x3a\x20\x04\xc9\xbd\x02\xb8\x0f\x70\x47\xe9\xd9\xc8\xba\xba\xc2\xb5\xae\x90\x3b\x05\x94\x7b\xae\x00\x80\xcf\x2c\xa0\xe7\x12\x58\x3e\x33\x25\xcb\x20\x20\xf9\x98\x6d\x01\xab\x56\x4e\x0e\x5a\x6c\x94\xbd\x0d\x23\x83\x84\x2c\x4a\x15\xdf\x07\x8e\x3b\x82\xe5\xd1\x0c\x11\x7b\x53\x43\x41\x8a\x04\xd3\x08\xe2\xcb\x51\xad\x3c\xf3\x89\xf7\x46\x35\xe3\x3e\x88\xa8\x4f\x17\x5f\xc3\xf9\xc7\x44\xc9\x0d\x33\xc7\x24\x57\xb8\x11\xb9\x96\x31\xdd\x1f\xf1\xcd\x8b\x58\xab\xdb\xa0\x72\x70\x74\xda\x6d\x7d\xe8\xb4\xde\x1e\x75\x4e\x3e\x6c\xb5\xbb\x8d\xcd\xfd\xd6\x16\x1e\xed\xad\x89\x0a\x21\xef\x20\x00\xc6\xb8\x2e\x92\x79\x68\xab\x78\xae\xe5\x04\x10\x15\xb3\xe6\x69\xde\x42\x2a\x56\x58\x88\x33\x64\x92\x85\xd0\x90\x1a\x33\xb4\x4e\x8e\xbb\x4f\x74\x63\x5e\xac\x47\x71\x7f\x65\x71\x3f\x29\x3d\xa5\x38\xb5\x07\x72\xab\x4c\x91\x4c\x9f\x4f\xef\xb9\xe0\x6d\x2e\x32\x2b\x71\xa2\xd0\xac\xb9\x51\x7b\xbd\x13\x2b\x48\xf5\xd6\x97\x9c\x80\x2b\x01\xab\x9f\x3e\x25\x04\xfd\xc0\x45\xaa\x2d\xa1\x8e\xe1\x68\x55\xae\x2a\x43\xdb\x9c\x7a\x34\x71\x65\x29\x4a\x87\x18\x3b\x79\xc7\xe9\x0f\x6b\x8c\xae\xf2\x8d\xa8\x31\x9a\xb2\x1d\x65\x07\x71\x33\xf9\x25\x18\x05\x21\x08\x65\x62\x91\x45\x3f\x66\xb9\x8b\x25\x10\x01\x26\x71\x49\x48\xf8\x0a\xf6\xfb\xe5\x4b\xfa\x10\x8d\x63\x17\x14\x93\x61\x94\xa4\xdd\x1d\xd6\x24\x8e\x90\xe9\x9b\x9c\x98\xa0\xd0\x31\x1d\xc5\xad\x32\x58\xaf\xac\x3e\x6b\xf9\xe4\x98\xa1\xa7\x63\x0e\x39\x66\xe4\x6a\x0d\xe3\x70\x4c\x98\x58\x51\x40\x1f\xb2\x4f\xb2\xae\xc7\x0c\x51\x24\xed\x37\x7b\x83\x53\xef\x47\xdd\x44\x42\x21\x02\x8c\x82\x7b\x92\xdc\xb9\x62\x32\x4a\x12\x54\x75\x08\x2d\x3b\xf9\x96\x54\xbd\x3a\xbe\x8b\xa3\x9e\x0a\x55\xd5\x69\x69\x8b\x52\xee\x49\xde\x2b\x16\xea\x4e\x40\x51\x8a\xf5\xdb\xbd\x77\x86\xe2\xf6\xfd\x50\x88\x23\x8e\x2a\xa6\x64\xd4\xaa\xd5\x3f\x48\xf7\x3b\xf5\xc3\x60\x87\x8f\x45\x9f\x98\x79\x32\xf3\x6d\xf5\x9d\x9c\xfa\x5d\x40\x85\xf2\x44\x97\x00\x7c\x34\x26\xa2\xd1\xe5\x40\x05\x3f\x92\x74\xe9\x16\x94\x42\xac\x71\xdb\x22\x4a\x2f\x45\x81\xd1\xc8\xb3\xf4\xb1\xab\x6a\xc4\xc6\x27\xb5\x62\xa1\x68\xe3\x3c\x89\x96\x2b\x38\x08\xfb\xd1\xbe\x49\x3f\x77\xc2\x20\x32\xc2\x03\x41\xf0\xfd\x4b\x50\x65\x69\x8e\x88\xc0\xac\x45\xed\xc5\x1a\x41\x00\xa7\x5e\x00\x02\x17\x30\x78\x60\x60\x8e\x00\xcb\x02\x4f\x6d\xcf\x94\x51\x9d\xa5\x44\xcb\x63\x76\x4e\x0b\x32\xd7\xaf\x46\x64\x4c\x6a\x78\xe2\x4d\x68\x32\x0d\x77\x4f\x71\xfa\x8a\x8e\x59\x11\x7e\x22\xc4\xa9\xc2\xcd\x5c\x77\x71\x04\x42\x86\x31\x41\x86\x19\x45\xbc\x60\xf1\x09\x49\xac\x7d\x80\xc0\xab\x97\x34\xb0\x1f\x0b\xd4\x16\x55\xbb\x40\x97\xe0\x7b\x8c\x19\x80\xca\xe5\xa2\x0c\x83\x48\x05\xd3\x2e\x29\x6e\x9e\xca\xc1\xe6\x5a\xf7\x38\x6d\xf2\xd7\x5c\x78\xba\x65\x17\x5c\xf9\x30\xa0\xaa\x7a\xce\xfc\x51\x10\x93\x63\x09\x6b\x10\xf3\x9e\xc9\x3d\x04\xbe\xc7\x73\x90\xde\xe0\x2c\xd6\x24\x0d\xe9\xa8\x4b\x17\x26\xc7\x1b\xd6\xe6\x53\xe0\xfb\xb3\xb8\x39\xf2\x41\x52\xc2\xdd\x81\xb9\x06\xcc\xdf\x14\xb5\xd1\x34\xa3\x9d\x4f\xbb\x24\xfa\x79\xab\xa8\xaf\x2f\x60\xd4\x23\xc1\xfa\x88\x74\xb7\x5c\xd6\x4a\x85\x01\xe7\x97\x24\xee\xb0\x6b\xeb\x8a\xc4\x3a\x4b\x7a\x29\x29\x27\xe5\xcd\xfc\xeb\x8a\xe9\x79\xa1\x78\x47\xfa\x4e\x93\x64\x91\xf9\x46\xf9\xbe\xcc\x79\x81\x16\x90\x24\xd6\xd5\x64\x92\x06\xba\x6a\xaf\x14\xab\xc6\xd6\xf3\x65\x1a\x82\xb5\x2b\xe9\x0b\x19\xc7\xd4\x91\x6c\xa2\x6c\x0c\x49\x10\xf8\x9f\x03\x34\x5d\xfb\x9e\x6d\x0d\xa1\x12\x05\x31\x55\xf0\x4b\xc0\x57\x3a\x62\xf3\x0b\x30\x73\xe2\x25\x70\x08\x1d\x2b\xbf\xc4\x2d\x29\xca\x96\x5f\x02\x3f\x75\x51\xe3\x0b\x83\x66\xc2\xa0\xb9\x73\x03\x75\xb8\xe2\x90\x08\xf6\x4e\x28\x53\xd2\x81\x63\xcc\xf8\xd2\x40\x09\x44\x58\xcd\xb1\x74\x11\x2b\x1f\xa5\x98\xd3\xf1\x0d\x34\x35\x80\x3b\xcd\x7c\xeb\xf2\x92\x42\x21\xf9\x43\x21\x83\x35\xa6\xb5\x69\x92\x81\x47\xf4\xc0\xf2\xe3\x27\x8f\x75\x9e\x5e\xdf\x9a\x63\x78\xea\xe5\x14\x6f\x49\x02\x13\x21\x92\xa6\x82\xe0\xe9\xd0\x21\xd1\x4d\x51\x22\x5d\xcb\x3b\xea\x2d\xf7\x2e\xaf\xd8\xf3\xab\x8d\x7b\x39\xed\x09\x97\x63\xdc\xfb\x96\xf3\xd7\x82\xed\xdc\x83\xfb\xeb\x41\x6a\xc6\xf0\xee\x2c\x0f\xb2\x78\x96\x29\xa2\x91\xd4\x5b\x8a\x7f\x45\x47\x23\xa1\xbe\x90\xe6\xf0\x9a\xc8\x35\x2f\x14\x02\x3f\x8d\x48\xef\xb2\x72\xe5\xa2\x96\x39\xbc\x2e\xa4\xa9\x46\xe3\x50\x16\x6a\xbe\x00\xb0\x84\x29\x55\x3e\x96\x2e\x95\xb8\x53\x3a\x57\xe5\x72\x84\x93\xe7\x33\xa6\x42\x05\x58\xd4\x99\x23\x71\xfa\x84\xc4\x9b\x41\x31\x7d\x8b\x9a\x3d\x66\x2b\x1c\x08\x9e\x32\x10\x4f\xc6\xd7\xb0\x6d\x55\x84\x23\x5d\xf0\xa3\x78\x4e\x0a\xd4\xe7\x93\xc0\x46\xee\xfa\x2a\x80\xf2\xb2\xe5\xb5\x71\x10\x81\x26\x5c\xea\x13\xd2\x54\xc1\x4b\x3f\x5a\x86\xdb\x40\x06\xe4\x5e\x05\x1d\x5a\x92\x7e\x1a\xc3\x7a\x19\x28\xcf\x08\xe3\x15\x59\x7f\x71\x2e\xf5\xc4\x2d\x8c\xb2\xe8\x51\xe2\x7f\xee\x73\x7e\x94\xcb\x8e\xc7\xf9\xf1\xaa\x6c\x99\xe7\xd6\x8e\xd6\x21\xb4\x82\xce\x92\x38\x5d\xb9\xe8\xce\x44\x23\xb6\xed\xf4\xc8\x8c\xde\x40\x50\x66\x1f\x65\x59\x2e\x93\xc8\x71\x41\xdd\x95\x4f\xa6\x0d\x10\x2c\x2f\x17\x63\xc6\x37\x7e\x2b\x05\x97\xe0\x15\xa7\xf3\x21\x0a\x1c\x71\x72\xf4\x41\x94\x6c\xa7\x8b\x45\x95\x25\x25\x4b\x50\x62\x55\x47\x4a\xd1\x62\x29\xaf\x14\x2e\x5d\x2c\x8b\x69\xf1\x6f\x6f\x19\x52\x9e\xac\x24\x2f\x13\x5e\x26\xfc\x2c\x55\xaf\xc5\xf7\x5f\xbe\x16\xca\x16\x59\xd7\x2d\x0c\x54\xd8\x80\x6a\x74\x2c\x32\x4d\xd5\x03\x23\x31\x6b\x5e\xed\xa8\x0b\x70\x9e\x03\x4d\xf1\x70\xc2\xe7\x02\x8d\x49\x5d\x15\xc3\x24\xc9\x0c\x7a\xa2\x59\x55\x68\x92\x26\x3d\x66\x48\x6b\x04\x59\x7a\x99\x2f\xdc\x45\x24\x85\xeb\xd1\x55\x41\x78\xf4\x97\x6b\x51\xfe\x2b\xcd\xf4\x4b\x59\x7c\x8c\x7c\x47\x66\x25\x52\x95\xd7\x3b\xe6\x62\x14\x11\x48\x33\x45\xe1\x5b\x89\x36\xb9\xb6\x1e\x09\x53\x52\x2d\x09\x02\xa2\x33\x2a\xad\x24\xc2\x04\xf2\x5f\x19\xd4\x40\x99\xa4\x1f\x03\xb5\x22\xf8\x03\x80\xc0\x32\x40\x1b\xc0\x0a\x29\xa4\x4c\x60\xf0\xa7\x65\x92\x87\x31\x3c\x3f\x6c\x58\x05\x2b\x71\xb8\x54\xd1\x56\x53\xe7\x4a\xd4\x7b\x1e\x82\x69\x92\xde\xf0\x86\x8e\xea\x16\xf2\x89\x5e\x53\x3b\x1e\xc1\xa1\x8b\x64\x45\xad\xa2\x5f\x16\xa3\x16\x2a\x78\xb2\x10\x04\x3f\x9c\xd4\xe0\x53\x09\x66\x24\x11\xe1\x4a\x67\xa0\x24\xc5\x97\x4a\x03\x94\x6a\xe8\xa4\x88\x1e\x95\x06\x2b\x0a\x54\xa5\x83\xc7\xf9\x89\x24\xe0\x44\xfe\x27\x19\x8d\xe3\x5c\x05\xaa\xd6\xd4\xc5\xa4\xc8\x87\x0c\xa6\xd1\xc8\xa3\xbc\x9c\xf8\x47\x32\xc6\x2d\x6f\xd5\x95\x00\xae\xb2\x08\x93\x3c\xd3\x63\x30\x09\x8f\xae\x04\xb8\xa4\xb3\x58\x31\xd1\x5e\xe1\x38\xa5\x81\xa3\x71\xd3\x8a\x51\xc0\xc5\x48\x56\x3d\xee\x62\x03\x43\xb9\x01\x31\x38\xac\xb8\xce\x10\xbf\xcf\xe6\xc1\x14\x3a\x33\x3d\x59\x03\xb1\xea\x8b\x0f\xfc\xf6\x59\x67\x8e\xe2\x3a\xc4\xd2\x40\x0c\x37\xac\xb4\x3f\x89\x92\xe5\x91\x04\xc1\x3c\x0d\x41\x15\x73\x34\x22\x89\xb4\xf6\x2d\x3f\x80\x0e\x0c\x6d\x11\x46\xee\x1d\xb1\x8d\xa0\x61\x73\xd4\x75\x66\x5e\x56\x0d\x92\x21\x88\x7b\xa7\x89\x21\xab\xc5\x9c\x77\x81\x1a\x0e\xd9\x6d\x71\x34\xec\x6d\xfc\xb3\x49\xd2\x00\x71\x13\x2f\x81\xa5\x6a\x31\x6d\x20\x6c\x3a\x29\x98\xe7\xa2\xfd\xe7\xe8\x4a\xfb\xc6\x54\x8d\x60\x60\xcf\x50\x8e\x19\xd4\x8a\x3a\x8b\x66\x7d\xc8\x65\x6a\x2a\x22\x66\xa3\x15\x13\x5c\x7c\xcb\xae\xf6\xb2\xe3\x8e\xe0\x05\xc1\xe6\x4b\xe3\xca\xb4\x7d\x68\x5c\x82\x1f\x01\x18\xb8\x73\x7c\x30\x89\xfd\x33\xf5\x2b\x29\x0f\xdc\xf9\x06\x00\x72\xa0\xc1\x75\x10\x20\xd3\xf1\x69\x6e\x6f\xb0\x64\x4d\x3d\x17\x05\xa6\x13\xe0\xaa\xb4\x1d\xd3\x05\xd5\xbd\x79\x5c\x46\x06\xb8\x0e\x7c\xd7\xb6\x46\x1b\x9f\x2b\x77\x43\x32\x0e\xdc\x31\x8b\x8a\xb8\x0e\x2c\xc7\xb6\x1c\x58\x1e\xd8\xee\x70\xb2\x01\x00\x1e\x7e\xd9\xb4\xad\xb1\xb3\x0e\x86\x10\xdf\x40\x1b\x20\x54\x33\x0d\x4d\x7b\x58\xe0\x6d\x57\x44\x53\xd1\x22\xf8\x0e\xd4\x8b\x1b\x00\x10\x80\xa1\x86\x42\x59\x9f\xa9\x56\x37\x3e\xaf\x23\xd7\x0d\xf0\x78\xd4\x20\xa9\x73\x63\x62\xf3\x6a\x0d\x50\x37\x14\x80\x78\x55\x49\x06\x24\xde\xf4\x94\x07\x45\x97\xd0\xbd\xba\xf2\x61\x80\x97\x63\x1d\x33\xb5\x9a\xcf\xc8\xbd\x13\x3f\xd3\x50\x76\x82\xd9\xd3\x3a\xa8\x56\x9e\xf9\x5c\x9d\x84\x9d\xd0\x3a\x59\x08\x5d\x0d\x66\x26\xb4\x0e\x18\xff\xa1\xab\xc7\x96\x3f\xdd\x22\x69\xe3\xf3\x2f\x27\xf0\xfe\x0a\x99\x53\xe8\x03\x32\x58\xbc\x1e\xc4\x4c\xed\x47\xe0\x7a\xe6\xd0\x0a\xee\xd7\x41\xad\x52\xdd\x00\x9f\x01\x08\x5c\xbe\xb4\x4a\x4a\x3f\x57\xe2\x39\xe2\xb6\xa6\x63\x4d\x69\xa4\x4e\xc7\x9c\xc2\x75\x0a\x74\x83\x2f\x8f\x11\xc1\x8f\x4d\x81\xa9\xa2\xd0\xcc\x0a\x20\x2d\x2e\x0f\xdd\x99\x13\xe0\xcd\x7b\x65\x39\x56\x00\x85\x5a\x81\x35\xb5\x9c\x71\x39\xa4\x36\xeb\x00\x9a\x3e\x2c\x5b\xc4\x69\x5a\x1c\x85\x85\x20\xab\x12\x89\x07\x36\x3e\x1b\x89\x6b\xe4\x1a\x9a\x23\x21\xdd\xb5\x55\x54\xc6\xc6\x4f\x27\x16\x23\xeb\xd6\x50\xb4\x13\xc2\xb3\x47\xce\xb1\xb4\x82\xa1\xa8\x3e\xb4\x4d\xdf\x3f\x34\x89\xc8\xdf\xe0\x88\x8b\xaa\x2e\x55\xbf\x0f\x29\xcf\x81\xeb\x87\x0c\xce\x3a\x30\x07\xbe\x6b\xcf\x02\xb8\x61\xc3\xab\x20\xd7\xd1\x16\xbe\xca\x27\xa2\x58\xdc\x08\x5c\x2f\x0d\x4e\x98\xcf\x37\x0d\x10\x72\xef\x8a\xc5\x8d\x88\x34\x51\x9a\xc4\x08\x41\xca\xe0\x36\x72\xd1\x9a\xf2\x1d\x1c\x4c\xac\xa0\x4c\xe8\x28\xc3\x02\xdb\xc8\xa5\x04\xb9\x05\xb5\x6a\x75\xea\x13\x4a\x66\xa2\x8d\xf2\xd4\x7d\x78\x4c\x3b\x43\x16\x0f\x51\x47\x22\x9d\x90\x8e\x06\x00\x2a\x7e\xa9\x70\x4f\xb6\x0a\x52\xb3\x45\x42\xfa\x76\x69\xe3\x88\x0c\xc3\x26\x5e\x06\xf8\x88\x5d\x9e\x6c\x2e\x6c\x76\x4a\x8a\x46\xc8\x1c\xb3\x60\x26\xf4\x12\x82\xc8\xd0\xb7\xcf\xb3\xa3\xc9\x46\x2c\xbf\x78\xe1\xcd\x35\x5b\xa9\x56\xf5\xe6\xd1\x9e\x21\x3f\x92\x67\x5e\x8d\xa3\xe4\x88\x72\xf2\x56\x17\x02\x23\xc4\x31\x70\x02\xa7\x66\x90\xb8\x34\xf8\x8f\xd1\xc0\xa6\x7f\xe7\x13\xe7\xeb\xd0\x95\xe0\x86\x20\x2f\xb6\xe7\x28\x45\x6a\xc5\x18\x2b\xea\x6a\xe9\x22\xff\xd4\x6e\x1e\xcb\x1f\xca\xaf\xa0\x2c\xb5\x03\x0c\x3a\xf0\x16\x22\x1f\xf6\xac\x11\x74\x0b\x4b\x35\xd5\xba\xb1\xac\x4f\xaa\xd7\x9b\x94\xe8\x3d\xcb\xd0\x2e\x7c\x93\xe4\xb3\xb2\x8b\xf0\x9b\x21\x32\xa6\xaf\xc0\xbc\x5a\x58\x21\x87\x95\x7e\xa8\x2c\x59\x5d\xb6\x7d\x4a\xba\x25\x40\xd2\x5c\x36\xaa\x73\x01\x2f\x33\x0c\x54\x14\x10\x37\xd2\xed\x8a\x91\x7b\xe7\x93\xd8\xb3\x17\xc1\x65\x8e\x19\x86\xe4\x22\xc5\x9c\x4c\xb0\x36\xba\xb8\xa4\x12\x24\xc8\xe4\x44\x1b\xc0\x5a\x5e\x56\x2a\xd1\x5c\xd1\x68\x85\x3c\xf1\xac\x44\x8a\x6c\xea\xc6\xe8\xf2\x8f\x83\x62\x89\x82\x26\xf2\xa9\x6f\xbe\x01\x4b\x2e\x06\xc1\x45\x75\x27\x5c\x74\x28\xca\xa6\x8e\x88\x0c\x8e\xf1\xde\x31\x52\xb3\x22\xa3\xca\x47\xd7\x72\x0a\x46\xb6\xfb\x14\x33\x7a\xc9\xce\x2e\x2f\xcf\x11\x16\xf9\xc9\xe4\xe8\x86\xe6\xa2\x5f\xc0\xdc\x3a\xa1\x28\x5d\xd6\x6c\x01\xf6\x3d\x75\x0c\x9c\x89\xc6\x42\x72\x6d\x5d\x67\x89\xa7\xbe\x6a\xb4\x74\x0f\x55\xe9\x1e\x82\x39\xf6\x90\xf6\x56\x9d\x63\xd6\xc8\x90\xb7\x95\x9b\xbc\xa0\x64\x08\x78\x71\xc9\x7a\x19\x84\x3f\x70\xe3\x1c\x8d\x2f\x89\x20\xd4\x92\x34\x29\x78\x7f\x75\xdc\xbb\x82\xab\xde\x5f\xca\xdc\xbb\x32\x6e\xb4\x16\x14\x92\xb9\x98\xaf\x34\x3c\x12\xd2\xef\x26\x6d\xa5\x12\x66\x3d\xb4\xe7\x78\xa5\x33\xcd\xa4\x1c\xa5\xbe\x99\xc5\x44\x83\xa3\x96\x33\x8a\xad\x16\x43\x71\x2b\xf9\x46\xf2\x3b\x6a\xd2\x91\x13\x4d\x47\x3a\x56\x88\x22\x3d\x07\xee\x6a\xdc\xa5\xd5\x60\x3c\x4d\xd2\x5e\x3e\x43\xbf\x88\x19\x0a\xc5\x6e\xa7\xde\xad\x09\x7d\x84\x95\x18\x16\x0c\x01\x50\x42\xa9\xb3\x92\xa4\xc1\x82\x48\x3d\x54\x02\x96\x2c\x41\x71\x4b\x80\xf7\x07\xc7\x97\x08\x02\xaf\x41\xc1\xa5\x12\x66\x9f\x6d\xc2\xa0\x08\xd6\x69\x21\xa2\x85\xc4\x98\x25\x66\x67\x79\xbb\x03\x0c\x32\xed\x86\x6e\xe7\x37\x1c\xe0\xc0\x66\x62\x29\xa2\x0c\x16\x60\x6e\xf0\x3c\x1e\x4a\x82\x75\x9f\x82\x1a\xb0\x69\x6d\x90\x1c\xd4\xc1\x06\xf0\x89\x0e\x96\xbb\xc5\xfc\x4b\xfe\x64\xba\x60\x19\xa4\x3b\xcb\x78\xc8\x72\xd2\xa8\xb6\x40\xc9\xaa\xa1\x03\xc7\xdd\xb0\xe2\x07\xd4\x1a\xbe\x00\x8b\x54\x4b\x8c\x92\x06\x99\x81\xa0\xa2\xbf\x43\xa6\x67\x92\x27\x0e\x77\x2e\xd2\xf5\xae\x49\xd7\x0c\x77\x3a\xb5\x82\x7d\xcb\x81\xa1\x7f\x45\xc4\x66\x39\xf0\x0e\x97\x17\x8a\xf2\xc1\x26\xb4\xa6\x44\x68\x01\xa2\x06\x8c\x26\xd1\x25\x69\x6c\x25\xf5\x7a\x34\xb0\x0c\x1c\xe9\x78\x8a\xb6\xe2\x05\x93\x25\xcb\x92\xe8\x8e\x68\x96\x9c\xd9\x4f\x11\x8f\x10\xdf\xe4\x5a\xfc\xbd\xa6\x09\xc2\xe9\x42\xcc\x06\x7e\x80\xd8\x66\x73\xa8\x72\x6a\x59\xfe\x84\x18\x3d\x20\x48\xc0\xa7\x44\xd3\x5c\xc6\x5d\xb4\xfc\x36\x4b\xcc\x27\xc6\xc9\xf0\x6c\x2b\x38\xb3\x46\x10\xbf\x91\x69\xf4\x85\x02\x3e\x54\xd7\xf4\xb6\xba\x06\xdf\x03\x3b\x32\x17\xb8\xc6\x5b\x55\x98\xbc\x64\x39\x71\x37\x64\xfc\xa3\x7d\x71\x7d\xc9\x7e\x95\x52\x5b\x98\xfe\xd0\xb2\xf8\x46\x51\x81\x6c\x1e\x42\xe9\x0b\x49\x9a\xf4\x5a\x45\x79\xd8\xe0\x09\x10\x3f\x40\x91\xf3\x41\x58\x0b\xef\x48\x12\x06\x59\xae\x98\x3e\xc0\x68\x4a\x71\xbe\xba\xec\x99\x2c\xc9\xc9\xf6\x85\x86\x53\xf3\x7e\x40\xc2\x2b\x37\xa9\x6f\x4a\x68\x39\x0c\x96\x5f\x02\x27\xc5\x64\x34\x4d\xe1\x27\x90\xc1\x38\xc0\x1f\x77\x4a\xbf\xa6\x49\xa6\x6c\x06\x93\xce\x4e\x57\xa3\x30\x95\xb1\xd7\xa4\xd2\x34\x31\x72\x67\x59\x34\x3d\x25\xb9\x34\x54\x2d\xa1\xb6\x59\x86\xa7\xc6\x38\x9e\xe2\x49\x9a\x35\x08\xe3\x55\x43\x8f\x9c\xe4\x18\x5e\x2b\xca\xd6\x41\xba\x66\x98\xeb\x9c\x0e\xf8\x71\xfd\x87\x93\x7d\xad\x2e\x5e\x4f\x59\x89\xd4\xe1\x31\x32\xfd\x65\x76\x0d\xc4\x36\x3d\x07\x07\xc4\x1d\xa4\x3c\x93\xcc\xd1\x5b\xb2\xa5\xb8\x75\x4e\xbd\x42\x1e\xbe\x2b\xbd\x37\xcc\x95\xe1\x5d\x19\x13\xab\x2f\x81\x14\xad\xd5\x63\x81\x30\x93\x17\x61\x44\xbc\xa9\x7a\xed\xeb\x75\x91\xba\x75\xf0\x43\x76\x1b\xc2\x14\x0b\xf2\xfc\x36\x31\x32\x23\x1a\x71\x0f\x0a\x49\xeb\x6c\xea\x64\xc5\x6c\xba\x72\xd1\x34\x7d\x68\xe2\x7d\x64\xce\x02\xb7\x69\x22\x64\x99\x63\xd8\xa1\xa7\xf0\xb5\x34\x90\x10\xa7\xe1\xac\x33\xd9\x50\x22\x96\xda\x5f\x0c\x47\x22\xa5\xa2\x17\x49\xe6\xd1\xe3\x71\x17\x66\x55\x24\x4d\xe8\x5d\x8a\x87\xe0\xa7\x6d\x0a\x7c\x65\xe5\xb0\x26\x87\xc8\xf4\xe1\x89\xcb\x02\x4a\xe6\x5a\x71\xb5\x4b\x91\x72\x41\xab\xf2\xd5\x2d\x5f\xf1\x56\xe5\x8a\x78\xb9\x5d\x5b\x01\x24\xc9\xbb\x62\xaf\xe2\x65\x50\x2b\xaa\xfd\x2c\x32\xb6\x09\x9b\x52\x27\xc3\x45\x13\x3f\x6a\x97\xf2\x30\xc8\x5a\x73\xec\xc7\x33\x9f\x94\xc5\xc7\x4b\x1a\x39\xde\xd0\x3b\x79\x1d\x04\x3a\x43\x3a\x0d\x53\x13\xeb\x34\x48\xa4\xa1\xb4\xaa\x5b\xad\xed\xc6\xe9\xfe\xc9\x87\xe6\xd1\xfe\x51\x27\xd5\x1d\x48\x27\xbd\x4b\xdf\xb2\x97\x1a\x9f\xad\x04\x4b\xeb\xb8\x23\xea\x61\x59\xf0\x8b\xbc\xd7\x52\xea\xab\x00\x25\xc4\x96\xa4\xfa\x08\xda\x30\x20\xe1\x42\xfc\x02\xe6\x14\x63\xb3\x42\x85\x9f\x78\x4a\x3c\x30\x90\x90\x66\xa8\xf7\x39\x90\x79\xc5\xec\x2d\x8d\x34\xfb\xd8\x11\xac\xcd\xf5\x23\xfd\x9c\xbd\xdd\x17\xb9\xed\xd3\xce\x6f\xb8\x06\xf1\x80\x3a\xdc\xeb\x4f\x3e\x86\xd9\xc3\x4f\x1b\x73\x63\xe0\xde\x7e\x8d\x41\x73\x24\x4c\xf8\x28\xbe\xaa\xe9\xfb\x99\x44\x50\x60\x56\xd5\x79\xc4\x36\xb2\xe9\xb2\x84\x17\x79\x28\x5f\x17\x41\x9b\x30\x35\xb4\xc1\xa2\x08\xea\x24\xfc\x46\x74\x12\x54\x89\xd5\x64\xd4\x2a\x64\x21\x36\x00\x22\x87\x76\x03\xa0\x1c\x68\x44\x7f\x9d\x68\xbc\xb2\x48\xbc\xd4\xac\x08\x1b\x79\xee\x35\xcd\xf4\xaa\x78\x7a\x2a\x94\x22\xba\xe9\x90\xc2\x70\x95\xc9\x57\x29\xfa\x04\x59\x55\x35\xdd\xd2\x35\x1f\xba\xad\xac\x4b\x17\x66\x20\x3c\xe3\xe1\x15\xf9\xc6\xe4\xc0\x2c\x4c\x0a\xdf\x88\x28\x5e\xe3\xc8\x84\x6f\x8b\x2a\x89\x5d\xa9\x76\x4c\x96\xf0\x84\x42\x9c\x28\xa2\x94\x58\x6c\xdf\xa5\x6d\x36\x32\x7f\x45\xdb\xaa\xe4\x7e\x9b\x8d\x8e\x2f\x41\x85\x3a\xce\x4f\x22\x32\x67\xec\x91\x44\xa6\x95\x1c\x35\xa2\x6f\x11\x14\x0a\xb8\x32\x4c\x9b\x23\x2e\x32\xf7\x01\x49\x79\x00\x21\x05\xaf\xcb\x44\x17\xc5\x12\x13\xc3\x96\x89\xfa\x8c\x44\x41\xe4\xb9\x1e\xf2\xa1\x58\x24\x94\x85\xdb\x09\x91\x60\x24\x12\x8e\x63\x8a\x6c\x11\x21\x8c\xe8\x44\x1f\xed\x0c\x97\x29\x2a\x6b\x1b\xc0\x65\xbe\x0c\x6e\x14\x49\x20\x85\xd0\x83\x65\xe0\xe6\xa0\x52\x19\x16\xdb\x98\x0d\x59\x0c\x9f\x1c\xc1\x09\xf7\x02\x41\xa6\x95\x8a\x4c\x3c\x4b\x0b\x94\x89\x2c\xbe\x26\xf9\x63\xfb\xec\x5b\x02\xc9\x6e\x02\xc1\x24\x20\x87\xab\xc0\x32\x2a\x11\x69\xbf\x12\xc3\x0e\x3d\x7b\x0e\xd5\x90\x39\x39\xe8\x91\x0f\x96\x81\xf3\xc5\x57\x40\xf0\xf8\x2b\x80\x89\x1e\x59\x62\xe0\x47\x2e\x8c\xe2\x99\x82\x4f\xb3\x3e\x6a\x92\x20\xf0\x4c\x08\x30\x75\xe2\xc5\xaf\x3a\x71\x8e\x31\x5e\xf4\x84\xf3\x3c\x35\x4c\xc6\x8d\x88\xa4\xe6\x9a\x77\x86\xe5\x33\x0f\x91\x42\x51\xf9\x76\xca\x8a\x4e\x01\x16\x75\x31\x0b\x64\x04\x0b\xe8\x4f\x2e\x5e\xa0\x61\xc8\x51\x8a\xb7\xd1\xe2\x0b\x10\x4b\xab\x7e\x2b\x2c\x08\x79\xe0\xab\xc4\x0b\x91\x21\x50\x4c\x92\x62\x1e\x6a\xa1\x9b\x3e\x9c\x41\xc2\x97\xfb\xab\xb3\x51\x1a\x39\x49\x95\x0b\xd2\x1c\x09\x3d\x1e\x37\x97\xc4\x7d\x99\x2e\x11\x17\x25\x49\x2e\xb2\xc6\x96\x23\x68\x36\x60\xd0\x81\xb6\x19\x58\xb7\xf2\x54\xa2\x87\x7c\xfa\x8c\xb3\x43\x11\x69\xbb\x58\x20\x8e\xb8\x8c\x52\xe1\x02\x10\xa2\xc4\xe1\x17\x76\x09\xff\x9f\xee\xe6\x61\x62\xab\xb8\x49\x90\x11\x51\x4e\x11\x8b\xe8\x11\x28\x50\xa3\x2f\x2b\xd8\x94\x30\xb5\xec\x88\x79\x7f\x1d\x13\xe3\x29\x5b\x66\xf0\xc4\xc7\x8b\xa6\xb3\xe3\xe0\x6b\x07\xb2\x70\x84\x4a\x02\x62\xb1\x05\xed\x48\x2f\xdc\x85\x26\x2f\x6b\x2e\xd5\x8a\xde\x7c\xf3\x97\x07\xb2\xe8\xe4\x91\x7b\x97\x3e\x73\xc1\x28\xf1\x83\xbe\xaf\x58\x2e\x19\x7b\xd6\x23\xd2\x48\x79\x99\xc2\x64\x24\xff\x08\x73\x32\x00\xf0\x92\x37\xcd\x4c\x31\xe0\xc4\xff\xe8\xf5\x01\x60\x02\x4a\x09\x40\x29\xf8\x05\x99\x91\x22\x01\x62\xfe\x47\x94\xc2\x76\x67\x31\x0c\xf9\x51\xc3\x2f\xc0\x92\xcf\xdf\x76\x5f\x84\xa9\x18\x92\x8c\xad\xa4\x6e\x17\x2a\x15\xbb\x1c\x1a\x6b\x0b\x3c\x46\xc9\xa6\x4c\xe5\x38\x92\x11\x23\xf1\x30\x0b\xe5\x90\xa5\xcd\x78\xfc\x47\x2d\x52\xba\x80\xe1\x73\xb7\xa6\xf5\xc7\xd3\xdf\xac\xf2\x95\x05\xd6\x41\x55\x7a\xe6\xa0\xc5\xc0\x84\x37\x58\xaa\x3e\x55\xea\xc2\x15\x6f\x84\x4c\xc5\x5a\x68\x86\x94\x8b\x6d\x73\xf3\xe0\x38\xa1\x92\x51\xa8\x2f\x0a\x85\x18\xd5\x45\xf0\x3d\x5e\xbd\x1c\xca\x8a\x6c\x6d\x1d\xe0\x15\x0f\x11\x92\x99\xf6\xeb\x2c\x32\x91\x51\xe7\xf0\x90\x15\x16\x69\x76\x47\xb0\x5c\x4e\x7b\xe0\x94\xc0\x12\x2c\x6a\x22\x0c\x80\x9c\xf1\x2b\x44\x5d\x5a\xf8\xaf\x60\x31\x77\x73\x88\xf1\x46\x03\xe7\x90\x5c\x29\x08\x94\xe9\xcb\xf9\xca\x76\x5d\x54\x80\xe0\x89\x9a\x13\x28\x52\x23\xa0\xb0\x2d\xd2\x4b\x50\x97\x01\x02\x7f\xa0\xf9\x58\x2c\xc9\xe6\x7c\x82\x93\x9c\xa5\x68\x48\x7b\x97\x35\xa8\xd1\x9d\x18\xc7\x92\xa3\xec\xb3\x25\xd3\x13\x1a\x4b\x2e\x25\xaa\x5f\x04\x81\xa0\x47\x92\x6f\xca\x9b\x5b\x78\x9f\x25\xfa\x5a\x8c\x6c\xe5\xd2\xd8\x2d\xb0\xe5\xf3\x9d\xdf\x48\xc1\xa4\xe6\x13\x95\x11\x92\xb5\x18\x50\x04\x94\x4b\xe3\xee\x63\x27\x87\xdc\x19\xd8\x90\xd8\x08\x96\xb8\x18\xb3\x19\x29\x91\x48\x1d\x8d\x33\xbd\xec\xcd\x94\x9e\x21\x2b\x01\x29\x91\x18\xbd\x58\x8c\x2d\x7e\x16\x1d\x96\x02\xd8\x23\x87\xa5\x98\x60\x86\x82\xdf\x72\xc6\x9b\x50\x52\x1a\xe8\x22\x3c\xa7\xa7\xa4\x4b\x0c\x38\x99\x72\xaa\x20\x9b\xe0\x2a\xd8\x14\x62\x7b\x9d\x8b\x15\x81\xf9\x32\xe5\xa5\xf8\xb2\x71\x7b\xb7\x04\xea\x55\x4c\x3e\x28\x9f\x35\x80\xb6\xdd\xbd\x99\x41\x7b\x78\xcd\x06\xf2\x21\x36\x1b\xc5\x1f\x1b\xb3\x91\xe5\x7e\x90\x7c\x22\x7c\x34\x24\x29\x01\x12\xd5\x3c\xdb\x8c\x83\x18\x10\xd8\x50\x02\x9e\x97\xfd\x62\xac\x97\x6e\x90\xdc\x84\x04\xcf\xff\xb5\x2a\xb5\xfd\xc9\x68\x1e\x09\x52\xfc\x49\xe0\x7a\x87\x6e\x60\x5d\x59\x43\xe2\x8d\x8a\xf7\xc8\x87\x58\x10\xc6\x79\x9f\x9a\xfe\x36\x75\xe5\xd1\xd3\x25\x97\xa5\x86\x54\x0b\xbd\xf0\x38\xf8\xae\xf6\x2d\x3f\xa0\xa6\xfe\x4c\xf2\xef\x3a\xc4\xd9\x2c\x3d\x38\x02\xdb\x10\xb6\x4b\xd4\x88\x02\x40\x3f\xc9\xaa\x2f\x40\xb4\x8e\x62\x4e\x2b\x2f\xc9\x72\xf9\x26\x50\x7b\x73\x55\x33\x6d\x93\x7c\xc8\x32\xd6\x2e\xd4\xbd\xc5\x37\xc9\x4c\x9d\xd8\x48\x1a\x0d\xe5\xed\x48\x61\x6f\x94\xa3\xc3\x98\x9d\xca\xdd\xd1\x1d\xdf\x24\xb3\x83\x8e\xcc\xb6\x2d\x7a\xd7\x9c\x2d\xd4\x5d\x23\x74\xce\xce\x58\xa4\x3c\x52\x43\x99\x97\x04\x9c\x1d\x94\x1c\x23\x2e\xe4\xee\x95\x71\x17\xd3\xfc\x3c\xd4\x15\x2e\xaa\x9c\x91\xff\x52\x46\x64\xdd\x64\xf0\x6b\x9d\x67\x41\xd2\x79\x23\xec\x90\x6a\x76\xaa\x1b\xc0\x05\xdf\x03\x2b\xb2\xe8\x76\x89\xf3\xc1\x85\x7b\x29\x3b\x03\xb9\x49\x95\x09\x1f\x3f\x3d\x95\x6d\x94\x83\xa6\xeb\x78\xd3\xe4\xa5\x7b\xef\x0c\xe9\xa3\x2a\xd4\xde\x66\x84\x83\x4c\xd7\x6a\x48\x31\x34\xb3\x93\x7b\x89\x5e\xd6\xb9\x37\xb2\x18\xb8\x10\xe2\x67\x45\x1c\x7e\x4b\x15\xbf\x90\xdc\x6e\x34\x54\x18\xef\xc8\xaa\xac\x5b\x2c\x09\xf7\x88\xae\x8e\x6a\x44\x2c\x68\x0f\x1f\x78\x7d\xb1\xc8\x84\x72\x83\x0c\xee\x39\x6d\x0c\x0c\x2f\xa2\x95\xd5\xef\x0a\x39\x5c\x02\xaa\x24\x13\xc3\xfc\xf7\xc1\x4b\x92\x53\x48\x49\x1e\x52\x0c\xee\x53\xe1\xd5\x8c\x94\x18\x97\x29\x51\xf0\x94\xd3\x50\xbf\x53\x29\x78\x87\xdb\xb6\xa2\x5b\x1a\x97\x5d\xec\x77\x83\xed\xf4\x0d\x95\xc4\xe4\x57\x88\xe2\x5b\x52\x27\x06\x1b\xc3\x80\xca\x66\xa2\x9a\x50\x8e\x30\x9e\xc3\x71\x33\xf7\xdb\x1f\x2f\x1f\x02\xaf\x40\x10\x6b\xad\xa3\xbc\x87\x94\x0f\x8e\x23\x5b\x18\x25\x60\x94\x6b\x46\x98\xdd\x20\xd5\xe7\x2a\x71\x96\x0d\xc7\x75\xa0\x11\x99\xb3\x27\x77\x20\x8b\x74\x10\xf3\xd0\xfa\x2a\x2f\x81\xa1\x4c\xd4\xa8\x18\xf0\x0f\xbf\xf8\x11\xe1\x27\xfb\x67\xb0\x0c\x7e\xc0\xff\xfd\xe2\xc7\x04\xca\x59\x0c\x30\x22\x7f\xf4\x0e\x4c\x34\xb6\x9c\xcf\xde\xfc\x87\xcc\x1e\x86\xae\x6d\xe4\x92\xb6\xab\x4e\x9d\xf0\x2a\x20\x49\x92\x30\x7a\x0b\x46\xc6\xea\x45\x2f\x73\x5c\x3b\x7b\xa5\x71\xc5\x62\xc2\xed\x56\x13\x1b\x6a\xcc\x65\xe3\x4e\xf0\xe1\xc4\xf8\xc4\xaa\x58\x7e\xd3\xb5\x6d\xd3\xf3\x61\xd2\x99\x8e\x5c\x84\x21\x80\xa6\x89\x60\x20\x48\x3f\xd2\xd9\x68\x31\xe6\x47\xce\xf3\xc5\x67\xf7\xe1\xb3\x00\xd3\xf8\x54\xca\xcd\x26\x3e\xc7\x68\x74\xb0\x62\x94\x8a\x94\x1b\x1a\x07\xbb\xb2\xb9\x7f\xd4\xdc\xd3\xe6\xf5\x4b\x6c\x54\xae\xa2\x7f\x67\x05\xc3\x6b\x55\xc4\x8b\xa1\xe9\x43\x6d\x7f\xad\xc6\xc1\xba\x42\xc0\xc4\x25\xa2\x49\x8b\x14\x83\x77\xa5\xe2\x99\x6b\x70\x01\xb8\x68\x15\x12\x63\x8b\x92\x9b\x30\xe1\x1c\xf3\x5a\x62\xdf\xf6\xe1\x55\x10\x7e\x31\x48\x10\x2e\x43\x21\xf8\x52\x25\x9b\x48\x9b\xde\xe9\xe1\x56\xab\xb3\xdf\x3e\x6c\xa5\xcf\x31\x71\x50\xc5\x58\x57\x03\xd3\x87\xb6\xe5\x70\x89\x80\x1e\x3b\x67\x36\x33\xf5\xb4\x31\x42\x72\xce\x99\x05\xb3\xfa\xfa\x2b\xc7\x6d\xb3\x28\x0f\xf8\x82\xab\x87\xbf\x2c\xac\x79\x5a\xe4\xe2\x53\x6a\xa0\x22\x00\x5f\xa2\x81\x8a\x80\x2c\xa4\x81\x52\xde\xdb\x85\x98\x1b\x80\xca\x2e\xbe\x44\x5f\x77\xed\xde\xf5\x5d\x77\x7a\x66\x22\xc7\x72\xc6\x59\x09\x73\x28\xae\x1e\xe2\x06\x34\x1a\x8f\x8a\xbf\x5a\xd2\x45\x14\x56\x82\x58\x28\xc2\x91\xdc\x58\x88\x6f\x84\x3f\x96\xef\xe8\x57\x43\xd7\x20\x11\xd0\x88\x45\xfb\x1b\xd8\xe6\x70\xb2\x91\x8c\x02\xf8\xf3\xab\xab\x7a\xbd\x5e\xdf\x88\x42\x7a\xae\x03\xdb\x44\x63\xb8\xc1\x22\xfe\x21\x73\x64\xcd\xfc\x75\xf0\xdc\x9b\x6f\x70\x61\xda\x9e\xad\x6d\x78\xe6\x68\x44\xe2\x0c\x56\x2b\x75\x38\x05\xd5\xca\x1a\xf9\xff\xd1\xdf\x34\x6e\x12\xfd\x13\xb1\xd8\x48\xf8\xeb\x86\x22\xc4\x52\x14\x4b\x0b\xce\xe9\x28\xca\xe6\xe8\xe3\xcc\x0f\xd6\x01\xe6\x53\xa2\xcf\x24\xa8\xa9\x4f\xee\xb4\xf0\x0b\x89\xa4\xa5\x69\x85\xbf\x25\x9b\x68\x51\x97\x0c\x26\x14\x86\x4f\xca\x4a\x0a\x4f\x5f\xf5\x84\xaa\x61\x50\x2c\xdc\x41\x1c\x46\x43\x16\x66\xa5\x18\x71\x25\x46\x25\xc6\x7f\xb4\x2a\x07\xd0\xf7\xcd\x31\x3c\x30\x1d\x73\x0c\x51\x05\x41\xcf\x36\x87\xb0\x03\xaf\x20\x82\xce\x10\xfa\x05\x97\x87\xc1\x6a\x97\xc0\x85\x67\x22\x1f\xb6\x9d\xa0\x50\xab\x56\xc1\x77\x59\xd4\x3c\xce\x43\x59\xbc\xd4\x6e\x4e\xba\xd7\xae\xf8\xf4\xd2\x0a\x49\xb9\x90\x28\x3a\x92\x8d\x24\x80\xc5\xe8\x8b\xac\x90\x49\xb0\x5a\x0e\xad\x89\xe8\x24\xc9\xe3\xba\x9e\x0d\x3d\xe4\x93\x52\xea\x24\x16\x30\xd9\x53\x26\xed\x39\xba\x85\x88\x72\xc7\x29\x66\x40\x31\xe5\x71\x69\xf5\x14\xaa\x13\xa1\x24\xf5\x15\xc7\x81\x59\x88\xf2\xf0\x0d\x93\x44\x44\x22\x06\xb5\x35\x6f\xce\x53\x8c\xf9\xbc\x4c\x89\xc6\x82\x14\x22\x85\x12\x28\x8e\xba\x3e\xde\x1e\xa8\x3d\xaf\x4e\xfd\x30\x2e\xa3\xee\xe0\xeb\xa2\xee\x49\xad\x0d\x15\x46\xf2\x06\x1a\x53\xd3\x07\xa2\xd1\xc0\xcd\x23\xab\x53\x62\x73\x11\xb8\xde\x5b\xe4\x7a\xe6\xd8\xa4\x3c\x7e\xe2\xb6\x5b\x52\x5c\x77\xca\xa5\x0e\x57\x8c\x67\x50\xd2\xb5\x4c\xfa\x65\xd7\xf0\x3b\xe9\xea\x2f\x3d\xb8\x85\xc8\x43\x12\x88\x48\xfe\xa0\xbe\x1f\x5e\x04\x53\x79\xb6\xa6\x5c\x45\x1d\x8d\x49\x10\x16\xe1\x34\x2a\xb2\x51\x90\xd8\x5a\x4d\xdb\x82\x7c\x84\x6c\x72\x36\xa9\xfc\x54\xf9\x5d\x07\x54\x37\x23\x9a\x33\xa1\x80\x62\x13\x10\x2b\x94\x7b\x82\x27\xa0\x2e\x66\xdc\x54\xb4\xb7\xa9\x79\x46\x01\x45\x1a\x62\x2b\xe1\x00\x9a\x9d\xe2\x34\xee\x49\xc2\x83\x9f\x64\x17\xf9\x91\xf0\x5a\xb8\xa4\x6c\x48\xaa\x54\x2c\x81\x28\x4d\x7c\x10\xcb\x1c\x64\x50\x79\x19\xce\xcc\x0d\x62\x94\xc0\xe3\xa1\x2b\x7a\x10\xaf\x17\xed\x47\xe1\x5e\x91\x36\x84\x7a\x44\xf4\x05\x91\x6b\xc3\x27\xa8\x47\xbd\x5a\x15\xf5\xa5\x01\x31\x49\x20\x9a\xc5\xd4\xeb\xcb\x33\xfd\xb4\x0c\xec\x4c\xfa\xea\xd2\x7a\xdb\xc8\x9d\x36\x6d\xcb\x1b\xb8\x26\x92\x83\x86\x65\x18\x11\xb9\xde\x3d\x35\x80\x3f\x71\x23\x08\x59\x02\x73\x45\x66\xf7\x61\xd8\xb6\xec\xb8\x81\x35\x84\x54\x80\x20\x47\x78\xe4\xee\x64\x3e\x4e\x77\xa8\xe9\x6c\xba\xde\x7d\xc4\x2c\xad\x11\xc5\x32\xc6\x9f\x4e\xc8\xa0\xbd\x53\x3d\x24\x06\xf0\x0e\x04\x16\x1e\x4f\xb9\x1c\xb8\xdc\x90\x7d\x77\x86\x86\x24\xd8\xae\x82\xd4\x25\x2f\x62\xe5\xf5\x48\x42\x3b\x27\x2f\x3d\x52\x9c\x1a\xcf\x34\x4f\x98\x52\x6d\x32\x1e\x9d\xa4\x8a\x39\x53\x55\x4c\x67\x78\x4d\xe5\x30\x2c\x32\x17\x2b\x39\x22\xe2\x3a\x16\x83\x88\x46\x95\xa4\x95\xcc\xb8\x80\xd6\x11\x22\xdb\xd3\x59\x35\x6c\x9b\x8c\x0b\x41\x87\xe8\x68\x5c\xba\x8b\xc2\xde\xb9\x8d\x24\x6f\xc5\x12\x40\x15\x38\x0f\x20\x0d\x3b\x55\x20\x3e\x68\x44\x72\x56\xb0\x68\x08\xb0\xf0\x73\xc1\x29\x01\x93\xd8\x73\x68\x39\xc2\x4c\x83\xdd\x78\x40\x72\x30\xc4\x7c\xd2\x69\x3f\x6c\x2f\xc9\x86\xe9\x2b\x9a\xb2\x0f\x9c\xec\xaf\xc8\x87\x70\x51\xc5\xc6\xac\xf8\x81\x89\x82\x10\xf3\x28\x2e\xc2\x73\x93\xfa\x30\xde\x95\x3b\x47\x67\x06\xf1\x71\x24\x7e\xf0\x87\xe6\x14\x32\x05\xa2\xf1\x73\x92\x4e\x82\x66\x42\x0d\xbf\x11\x79\x72\xf7\x6d\xe3\x90\x95\x73\x58\xe3\xab\x50\xdb\x35\xee\x6b\x71\x03\xff\x44\xf0\xd6\x72\x67\x7e\xd7\x1a\xd8\x96\x33\xde\x28\x02\x56\x4d\x2c\x67\x91\x8d\x52\xfc\xf4\x91\xbc\x51\x7d\x55\xa8\xaa\xb8\x3e\xac\x40\x67\x44\x86\x01\xca\x80\xfc\x48\x6c\x3a\x09\x1b\x05\x8a\xb7\xb0\xd9\x6f\x1f\x37\x0e\x9c\x07\x32\x5e\xb8\x32\x7c\xac\x16\xc4\x89\x23\x46\x17\xa5\xb9\x43\xd8\x56\xe8\xb8\x77\x91\x02\xb7\x44\xe7\xc9\x17\x91\x68\x17\xc9\x28\xaa\x5c\xf0\x30\xcb\x19\xe3\xb3\x13\x94\xa4\xc8\x70\x0e\xc6\xb0\x9f\xe3\x2e\x50\x9c\xe2\xfc\xf1\x4b\x84\x23\x27\xc8\xcb\xe3\x4c\xe7\xe1\xd3\x4f\x79\xf3\x64\x05\xed\x60\x57\x30\xe6\xe7\xb2\xa2\xe6\xc6\x57\x8d\xc6\x21\x6c\x19\x18\x73\x43\x9b\x61\x33\x63\x1c\x4e\xef\x64\x0f\xde\xfb\x01\x72\x27\x99\x26\x26\x61\x2c\xaf\xa8\xc1\x17\x84\xf3\x0a\x1d\x9d\x5c\x71\x08\xb4\xea\x04\xde\x13\x2c\x56\xa0\x33\xa4\xf1\x5c\x33\x66\xe1\x41\xe7\x14\xa5\xb9\xbe\xdf\x59\xce\xc8\xbd\xab\x0c\xaf\x91\x4b\x0f\x89\x50\x50\x19\x20\xf7\xce\x87\x08\xbc\x06\x62\x01\x81\x7c\x62\x0e\x0a\x3f\xce\x90\xbd\x0e\xe0\xe7\x22\x58\x0f\x9b\xe2\x4f\xf8\x11\x6e\x7c\x18\xd8\xa6\x33\x31\x8a\xb9\x82\x1a\xe3\x56\x74\x77\xc1\xd1\x29\xb2\xf3\x6a\x43\x52\xf7\x24\x26\x2d\x85\x78\x63\x7e\xfa\x24\x59\x24\xc3\xb9\x67\x3a\xa3\xf8\x56\x4d\xbd\x71\x63\xee\x99\xaa\x4d\x34\xdd\x17\x8b\x45\x9a\xd4\x1c\x86\xaa\xc9\x57\xa0\x5e\x5d\x7d\x8e\x7b\x87\x15\x1f\x9a\x68\x78\x5d\x78\x72\xf1\xde\x7f\x7f\xf1\xfe\xb2\x50\xfc\xf1\xf3\xf7\xaf\x8c\x6f\xdf\xbf\xff\xd5\x0f\x97\x4f\x8a\xc4\x59\x39\x69\x2f\xc6\xee\x24\xda\xd4\xf8\xd5\x85\x59\x7e\x68\x94\xfb\x97\xec\x7f\xab\xe5\x17\xcb\x95\xf2\xe5\x77\xeb\x4f\x9e\x18\x45\x9a\xcb\x3b\x52\xc0\xd0\xa0\x81\x05\x63\xdd\x28\x81\x5a\xf1\xa2\x7a\xa9\x64\xb3\x87\xa6\x0f\x8d\xa9\x69\xd9\x81\x6b\x28\xe4\xf8\x40\x23\xf9\x07\xa9\xd2\x7f\xc0\xec\xff\x8d\xeb\x20\xf0\xf0\xd8\xc0\x72\x68\x33\x14\xfe\x93\x53\x33\x53\x65\x2a\xd9\xb1\x05\x45\xc2\x37\xed\x49\xa5\x29\x7d\xb2\x44\xcf\x10\xb7\x19\x42\xdf\x87\xa3\xcd\xfb\x10\xce\x1b\xd3\x19\xd9\x10\x25\x85\x41\xe1\xa5\xce\x04\x42\xf0\x0a\x9a\x01\xe9\xa7\x03\x3d\x17\x05\x7e\x7c\xc2\x6f\x83\xca\x34\xfe\xc0\x45\x3d\xab\x1c\x1c\x9d\x76\x5b\x1f\x3a\xad\xb7\x47\x9d\x93\x0f\x5b\xed\x6e\x63\x73\xbf\xb5\xa5\xb0\xae\x4f\x1d\x19\x0b\x72\x09\x2b\x61\x96\x09\xea\xa0\x14\x09\x1a\x0b\xb0\x32\x24\x4f\xe0\x73\xd5\x83\x53\xa1\xe6\x2d\x72\x96\xec\xa9\x19\x55\x8a\x34\x4e\x58\xdc\x73\xe4\x1a\x16\x75\x1e\xf6\xfd\x2e\x1b\x26\x33\x96\x27\x20\x97\x78\xc1\x0e\x09\x6a\x55\xc1\x4b\x89\x51\x9a\xe8\xed\x95\xc6\xf0\x3d\xdd\xe7\x40\xaf\x93\x5f\xa2\x2f\x5f\x11\x9f\x65\x50\x4b\x84\xb8\x53\x28\x96\x55\xe3\xcb\xd1\x94\xe9\xaf\x5f\x67\x59\x01\xbc\x0c\x0d\x06\xd6\xbf\xbe\xe5\x40\x31\x4c\x5a\xa0\xc0\x79\x01\x56\x4c\x3b\xd8\x83\xf7\x98\x46\x2d\x05\x91\xa5\xae\x6a\xd7\xd3\xdd\x18\xda\x08\x44\xb4\xaf\x45\x1e\x89\xa3\xc2\x52\x95\xb3\xf7\xd6\xb4\xaf\x25\xde\x43\x22\xa1\x8d\xde\x0c\x27\x6e\xcb\x19\x09\x49\xce\x12\xdd\xd5\x08\x93\x2e\xcb\xfd\xc8\x2d\x5a\xe4\x77\xa4\x90\x5f\x01\x3a\x63\x73\x0c\x47\xe4\x3e\x50\x23\x45\x34\xa4\x57\x36\xe6\x11\xa1\xcb\x78\x41\x65\x4b\xfc\x01\x5d\x13\xe5\x49\xda\x96\x4c\xaa\x14\x9f\xaf\xb8\x29\xc6\x2f\x97\x7f\x42\x5a\xca\x05\x31\xab\xc1\xdd\x75\x9c\x2a\x8d\x84\x1c\xd6\xb9\x8a\x84\x67\x2e\xce\x7c\x21\x0e\x27\xe2\x03\x8f\xd8\xdd\x9e\x0c\x32\xbc\xe8\x1d\x1c\xb3\x96\x39\x9e\xa3\xc5\x28\x3b\x87\x38\xae\x25\x48\x83\x93\xe3\x3d\x4f\x0f\xc0\x30\x40\x36\x3b\x01\xb0\x32\x85\x81\xb9\x07\xef\x23\x8f\x23\x85\x68\x2d\xd6\xa3\xb2\x1b\x4b\x34\x5e\x4b\x7c\x16\xe5\x5e\xd1\x55\xc7\x73\x3c\x49\xcb\x74\xc5\x85\x1b\x61\x5c\x7b\x98\x09\x6c\x7a\x23\x61\x2a\xde\xc4\xd3\x7f\x4b\x64\x4d\xdf\x7c\x03\xea\xb4\xf2\x60\x16\x04\xae\x43\x27\xcb\xfe\x0e\x49\x0d\x69\x49\xea\x6f\x92\x0f\xc5\x18\x28\x91\x44\x15\x8a\xb8\xd9\xd0\x75\x7c\xd7\x86\x15\xa6\x28\x2d\x18\x4d\x77\x66\x8f\x80\xe3\x06\x80\xca\xb5\xa6\xa6\x33\x33\x6d\xfb\x1e\x8c\x66\x10\x04\x2e\xb8\x83\x03\x80\x20\x7e\xbf\x90\x35\xf3\x39\x8a\x34\xf3\xa4\x29\x54\x85\x41\xea\xb6\x91\x6c\x71\x2f\x6d\x75\x95\xd1\x4e\x7e\x29\x06\x7f\xc0\x96\xf8\xb1\xc5\x43\x8e\x8b\x09\xd5\xcc\x41\x6b\x72\x90\x94\x5a\x3e\x92\x62\x50\xc1\x92\x68\x3e\x4b\x12\xd7\x34\x10\x72\xef\xf0\xab\xe1\x83\x62\x9f\x8b\x6f\x08\xd3\xf3\x6c\xe6\x0a\xc0\x4c\x0d\x22\xac\x5a\xfe\x5b\xde\x7a\xba\x40\x36\x81\x41\x52\x3e\x72\x6b\xa5\xbc\x82\x79\x06\x4a\xe9\xfe\xc2\x0d\x76\x0b\xda\x81\x99\xb4\xf5\x93\xff\x85\x3e\x98\xfe\xd4\x44\xc1\xb6\xed\xba\x68\xcb\xba\xb5\x46\x90\xfa\xb8\x99\x03\x5f\x88\xac\x92\xce\xd3\x64\x74\xe5\x63\xe4\xfe\xec\x08\x33\xab\x05\x44\x9c\xfa\x5e\x03\x63\x93\xdc\xc8\x8d\x84\x49\x19\xff\x2f\x7c\xb4\xf9\xd0\x19\x85\xc1\xb9\x2b\x08\x7a\xd0\x0c\x0a\x6e\x51\x49\x69\xd5\xc0\x3e\x27\x8b\x15\x45\x9a\x93\xb3\xd0\x91\x48\xbf\xa4\x1f\xeb\x1a\xc2\xae\x0d\x0d\x37\xae\x6d\xc4\x67\x78\xcc\x7a\x6e\xeb\x6d\x0a\xc3\xc4\x97\x50\x9f\x55\x6b\xa9\x4a\xe2\x63\x72\xd7\x53\xba\x6f\x8c\x76\xc4\x54\x89\x93\xf2\x4e\x65\x1b\xf2\xb1\xb9\x7f\x5d\x87\x50\xe1\x34\x6c\xc4\x92\xc7\x00\xce\x83\xd0\x4a\xa1\xf0\xe4\xbd\xf3\x64\x3c\x2d\x01\xe3\x3d\x12\xa5\xe4\xe1\x71\x94\xc5\x08\x41\xc2\x2c\x7d\x80\xcc\xe1\x04\x06\x70\x14\xdd\x1c\x24\x0b\x86\xf1\xb3\x8b\x7a\xb5\xfa\x6b\x22\x54\xc1\xec\x08\xfe\x59\xfb\xb5\xc1\x09\x2d\xb8\xfd\x9f\x29\x61\x69\xba\xde\x7d\xe6\x62\xcf\x7c\xc8\x4e\xcc\x19\x11\x2f\xe0\x46\x84\x9a\xaa\xd4\xbd\xf2\x15\xab\x23\xf8\xc2\x55\x9b\xa5\xa8\x71\x9d\x0e\x71\x49\xc8\x21\x92\xe0\x1c\x86\x13\xe4\x28\x4a\x28\x4b\xe5\x75\x39\x9e\x61\xec\xc9\x44\xb2\x65\x4b\x4f\x65\x1d\x45\xd4\xf7\x9a\xe2\xa7\xa1\x7e\xfc\xe1\x6e\x25\x59\xca\x52\x01\x82\xef\x5f\x82\x2a\xd1\xeb\x92\xbf\xf4\x81\x9d\x20\xef\x2c\x23\xca\xe5\x48\x6b\xc5\xd7\x44\xbe\x19\x20\xb9\xb4\xe0\x5a\x1f\x84\x24\x67\xb2\xfd\x59\xa1\x26\xf9\xe8\x64\x98\xdd\x94\x00\x8a\x08\x01\x27\x7a\x2c\x64\x64\xe5\xcb\x71\xaa\xf3\x50\x4a\xde\x17\x20\x6f\x30\x6b\xde\x23\xe0\xf5\x23\xac\x7e\x3f\x7d\x02\x46\x35\xed\x65\x19\x6a\x3c\x5f\xe7\xf1\xc3\x88\xfc\x26\xd4\x2e\x0b\x49\xa6\x77\x7a\x7f\x24\x4c\x5b\x30\x13\x27\x45\xcd\xfb\xa1\x0d\x3f\x5c\x54\x2f\xb9\x97\x64\x86\x2f\xc8\x6f\x6b\x0c\xb5\xcb\x84\x77\xa8\xb2\x8f\x74\x33\xa5\x64\x72\xee\x7c\xf2\xe4\xc8\xbd\x39\x91\xdb\x3b\x43\x86\x9e\x92\xce\xfb\x51\x3d\x2b\xb2\x82\x67\x8c\x40\x73\xa7\x66\xec\x71\xb5\xc7\x6b\x9e\x4c\x8a\xac\x47\xe1\xec\xf1\x7e\xc5\x49\xa8\x4c\x36\xfb\x32\xce\xef\x60\x55\x50\x30\x82\x5e\xc1\xb0\xad\x01\xbb\x18\x4f\x4f\xb6\x9f\x1b\x45\x7e\x8a\xed\xa3\x2c\x0c\x86\xe2\x21\x3e\xa7\x45\xa8\x66\x6b\x1f\x7d\x10\xac\x92\x3f\x8b\xa0\x1f\x63\xdb\x26\xf6\x29\x28\x45\x74\xd1\xa7\x84\x8e\xa8\x62\x7d\x1b\x99\x72\x18\x57\xb9\xa3\x50\xf7\x09\xef\x80\x5b\x21\xf5\x0b\x62\xdf\x24\x5c\x64\x76\x87\xf8\x30\xb2\x0f\x6f\x91\x7b\x65\x65\x9f\x06\x6e\x76\x30\x60\x6d\xd4\x1b\x50\xe8\xc8\x9b\xf9\xd7\xd9\x37\x36\x9d\x0f\x07\x41\x9a\x95\x42\x19\x07\x23\xfe\xa9\x69\x7a\xc1\x0c\xc1\xd1\x07\x99\xb1\x8a\x3e\x60\xbe\x9f\x8a\xff\xc2\x4c\x7a\x8c\x2f\x89\x8a\x4a\x34\xa0\xb2\xf0\x39\xfc\x1d\xbe\x1a\xe2\xbd\x23\x21\xc4\x72\x4b\xc9\x22\x16\x0d\x22\x13\x3f\x69\xc9\x52\x54\x40\xe5\xad\x9c\xbd\xd2\x21\x2b\x98\xb2\xc2\xa1\x20\xc1\x76\xc7\x05\xe3\xd4\xb9\x26\x52\xef\x11\x88\xdb\xd2\x14\xe0\xd9\xcb\x9d\x57\x61\x27\x75\xe8\x0e\x7c\x88\x6e\x21\x1a\x81\xde\x09\x98\x84\xed\x69\xa7\xbb\xdd\xa3\xc3\x0a\x55\xb9\x5a\x57\xf7\x1a\x65\x9b\x34\x88\xb0\x5c\xc5\x32\xa6\x67\xaf\xc4\xe8\xdd\x20\x89\xbd\x90\xb4\x65\x60\x09\x20\x61\x8f\x94\x24\xf5\x7d\xfb\xe8\x43\xc2\x3e\x4c\x1a\x49\x3e\x6a\x20\xb7\xd2\x0f\x5f\x0f\x83\x44\x98\xc6\x94\x33\x8f\x0f\xaa\xb0\xc5\x18\xe7\x56\x04\xc1\x35\x72\xef\x8c\x46\x10\xc0\xa9\x17\x80\xc0\x05\x34\x71\x1a\xc9\x6e\x6e\x39\xe6\x30\xb0\x6e\x21\x68\x1f\x01\x77\xf0\x11\x0e\x83\x8a\x21\x4f\x9e\x83\xea\x04\x10\x79\x08\x06\x39\x08\x06\x19\xb8\xed\xfc\x1e\x0e\x1d\x3f\xb5\xde\xa3\x38\x7f\x68\xca\xa1\x66\x19\xe6\xb2\x16\xa7\xf6\x34\x8b\xea\x46\xcb\x58\xb0\xb8\xdb\x30\xcf\x21\x20\x63\xb0\x9d\xac\x51\x10\x4c\xe7\x1c\x07\x5d\x15\xed\x48\x84\xab\x9b\xd8\xca\xfa\xec\xda\xe6\x4d\x36\xb2\x7a\x8a\xe4\x26\xdc\xcd\x1d\xdb\xe0\x76\x89\x09\x59\x48\x06\xbb\x9d\xe6\x07\x96\x46\x23\x64\x33\x22\x83\xde\xac\x9a\x31\xcc\xb0\x8e\x90\x90\x23\x01\x2f\xad\x16\xd3\xbf\x6e\xf3\x20\x0d\x34\x1e\x14\xea\x6b\x6b\x25\x10\xfe\xbf\xa2\x21\x56\xdf\xe4\x61\x93\xea\x55\x12\xf3\xa8\x1a\x55\x1c\xb8\xb6\x20\x8f\xbc\x32\xe9\xbe\x8a\x0a\xac\xc0\xb4\xad\x21\x5f\x32\x60\xdc\x76\x54\x30\x73\x46\x10\xd9\x34\x21\x45\x2c\xd8\x0c\x90\x35\x81\xf8\x94\xcc\xc6\xd7\x02\x40\x87\xc4\x98\x90\x8a\x22\x7e\x39\x2a\x4c\x66\x79\x13\xd3\xb9\x45\x6f\x01\x1b\x6e\x99\x81\x29\xe6\x24\x23\x7b\xe3\xad\x69\xc3\x20\x80\xe2\x17\x04\x7d\x18\x34\xb9\xcf\xa2\x74\x46\x30\xfd\xe1\xd2\x33\x10\x3d\xd4\x26\x43\x56\x35\x5f\xd5\x86\xbf\x89\x98\x5f\x5c\x7a\x13\x61\xc1\xc3\x38\x56\xd4\x10\xd2\x99\x4d\x0b\x86\x62\x8f\x73\xad\xb9\x8d\x87\x57\x99\x2d\xbd\x91\xd5\xa4\xb3\xb3\xc9\x36\x45\x6a\x55\x5f\x93\x2e\x3b\xeb\x5c\xe5\x40\x2a\x09\x7c\x9f\x9f\x73\xe3\xa1\x10\x63\x0f\x4d\x66\x0b\xcb\x61\x24\x1a\x5e\x04\x97\xec\x4c\x5d\x04\x97\x2a\xfe\x4e\xda\x26\x89\xad\x53\x19\xba\xce\xd0\xa4\x0e\x06\x39\x26\x44\xf6\x56\x06\xaf\xf5\xfb\x4e\x68\xfe\xe6\x12\x84\xbc\x0b\xd4\x14\x17\x3d\x35\xec\x97\x58\xd5\x62\x77\x8e\x6e\x8f\xd0\x79\xde\x3b\x43\xd2\x85\x9f\x8b\xb2\x44\xb1\xd3\xf3\x05\xa3\x4d\x6e\x9f\xe4\xae\x88\x04\x5d\xc9\x1d\xa4\xae\xbc\x14\xaf\x7c\xf4\x8b\xae\x7b\xac\x28\xa2\xab\x1e\x57\x0e\x23\x55\x2c\x49\x2b\x1e\xc7\x8a\x17\xd6\x3b\x06\xc4\x56\x9b\x2f\xe0\x8d\x36\xb8\x95\x0e\xa7\x11\x2f\xf3\x37\xdf\x50\xd3\xac\x70\x1e\x21\xf1\xcf\x43\x6c\x08\x3d\x6d\xba\x4e\x60\x5a\x4e\xaa\x7c\x24\x62\xfc\x84\xb0\xf6\xfc\x22\x68\xd3\x78\xc3\xc5\x2d\xdc\x7d\xcf\x74\x8c\x28\x1d\x04\x91\x79\x51\x93\xef\x8b\x4b\x99\x65\xe4\x8e\xf9\x92\xea\x04\xc7\x96\xd8\xb1\x9f\x51\xdc\xa6\x98\x24\x03\x69\x50\x34\x7e\x4b\x71\x71\x08\x8f\xbb\x1a\xa3\x7d\xc7\x7e\x14\x10\xf1\x57\x3a\x8b\xbc\xc3\xf1\x87\x48\x89\x10\xed\xb0\x42\x10\x53\x99\x38\x00\x7c\xb4\xe1\x18\x94\xc8\x9f\x9d\x7e\x89\xc0\x44\x3b\xb1\x60\xb1\xfc\xfb\xa4\xa4\xec\xb8\x23\x48\x2a\xd1\x1a\x11\x99\x48\x64\xc1\x26\x76\x33\xc9\xdb\x21\xb9\xaf\x0b\x2e\x58\x7e\x09\x0c\x10\x15\x12\x3f\x03\x81\xd8\xc5\x69\x2f\xe4\xdd\x1f\x36\xc6\x35\xcb\xac\xdc\xa0\x4e\x09\x12\x5d\xac\x92\x9c\x1f\x74\xe2\x01\x9c\x07\x5b\x70\xe8\x22\x22\xd1\xc2\x9c\x76\x51\xa4\x86\xfc\xbc\xef\x86\xdc\xa4\x63\x6a\x89\x39\x25\x91\x54\xd6\x38\x13\x47\x91\x89\xe2\xc1\xe1\x42\x0c\x8b\xff\xfd\x21\x32\xb3\x0d\x9b\x90\xce\xf0\x8f\x18\xc1\x25\xc0\x14\x49\xb2\x0f\x06\x01\xc6\xe7\x5b\xaf\x0c\x6d\xd3\xf7\x0f\xa9\x4c\xca\xaa\x7c\x74\x2d\xa7\x60\x00\x1a\xfe\x31\xc7\x99\x9e\x9a\xc1\xf0\x1a\xfa\x79\x0f\xb5\x41\x9f\xf8\x54\x26\x7e\xef\x41\xf7\x8a\xda\x8a\xae\x50\xdd\x2a\xc6\xdd\x09\xd1\x76\xf3\x3b\x80\x23\x02\x1a\x57\x00\x31\x26\x06\x6b\xbb\x54\xe0\x57\x89\xd8\x5f\xc4\x7f\x4b\x54\x2d\x5c\x85\xb8\xe4\xd3\x27\xcd\xfa\x10\x40\x11\xb6\x43\x50\x12\x61\x60\x14\x20\xfc\x2a\x1d\xf8\xc4\xc9\x8e\x2c\x16\x34\x27\xb9\x88\x9b\x2d\x2d\x05\xfc\x59\x8e\x60\x93\xc3\x47\x2c\x24\xe2\x53\x16\x7e\x64\x47\x98\x6b\x4d\xcf\xf0\xa7\x4f\xb8\x40\x3c\x5e\xa4\x12\xe4\x0a\xe2\x4a\xe2\x01\x61\x15\x85\xc2\x3c\xf7\x2c\xe6\x63\xe9\x42\xfa\x39\x04\xad\xaa\xe7\x16\x4c\x79\x5b\x05\x8f\xbb\xfc\xe3\xea\x39\xad\xa0\xe5\xeb\x9f\x8b\xb3\x24\x5f\xf6\x5c\x8e\x26\x89\xcf\x73\x95\xe5\xaa\x30\x5b\xdc\x65\x1d\x9b\x42\x27\x3b\x0a\xf4\xc3\x8b\xfd\x9b\x64\xac\x71\xe3\x48\x60\x3b\x79\xb7\x44\x6f\x29\xf9\x8e\x89\x8f\x8f\xc4\xd4\x88\x1f\xf0\x6b\x27\x9c\x45\x9a\x82\x21\x7c\x1a\x80\xef\xc1\x73\xf0\x1a\x40\xb0\x0c\x9e\x83\x75\xde\xa0\xf9\x73\x01\xc6\xc6\xfb\x3c\xef\x42\x31\x94\x36\x65\x42\x19\x55\xa3\x4a\x72\xf1\x6a\x5e\xed\x35\x40\xa1\x4f\x3e\xcf\x82\x5e\xc0\xcb\xc4\xa5\x2a\x31\x57\x4a\xf5\xad\x9f\x5c\xb7\xa8\x5b\x91\x27\x78\xad\x3b\x16\xeb\x32\x00\x95\x56\x57\x98\x59\xc4\x44\x4f\xad\x79\xc1\x2f\x25\xc4\x12\x95\x95\x95\x95\x15\x8d\xa3\xb6\x1e\x79\xe2\xe3\x46\x83\x3c\x57\x89\xbc\xe0\x52\x7f\x54\x87\xe1\xd5\xe2\x1f\xe0\xbb\x26\x3b\xfc\x80\xea\x8e\x29\xc6\x3e\x9e\x3a\xf0\xed\xe8\x96\x09\x64\xbf\x86\xf8\x62\xa2\xe4\x3b\x71\x4d\x2d\xd5\xa4\x16\xba\xfb\x4c\x48\x81\x1f\xa9\xef\x79\x9e\x33\xd0\x5d\x67\x11\x57\x19\xad\x1f\x46\xbd\x82\x4b\xc4\xdf\xe5\x42\x52\x93\x67\x04\x89\x84\x23\xfe\x1d\x7d\x67\x2c\x5e\xf8\x99\xfe\x24\x5f\x65\x3e\x08\x57\x11\xcb\xb2\x97\xb0\xad\x7c\x69\x29\x73\x25\xe4\xe4\x14\xb4\x7d\x46\x3e\x63\xd9\x5d\x41\xee\xda\x7f\xcd\x0c\x7c\x18\xcb\x14\xb2\x4a\xeb\x92\x07\x98\x50\x49\xbf\x77\xf1\x20\xba\xc4\x95\x2c\xb1\x6d\x4b\x52\xbe\xc5\x1c\x63\xa1\x4e\x69\x05\xda\x74\x9d\x77\x54\x13\xc7\xc3\xa0\xe7\x18\x55\x42\x5f\xf4\x25\x03\x23\x76\x48\xc9\xb1\x91\xec\x97\x8b\x0c\x8f\xf8\xd1\x9c\x59\x23\x38\xbc\x36\x51\xa6\x52\x4b\xc8\xa7\x7a\x71\x49\x69\x7f\x95\x05\x76\xa5\x7e\xba\x98\xfd\xe6\xb3\xe8\x85\x71\x5e\x95\x04\xd9\xa4\xa6\xe0\xee\x08\xbe\x75\x2d\x27\x68\x04\x24\x65\xb0\x0d\x5e\x02\x13\x7c\xff\x12\x3c\x5d\x5b\x5b\x59\x03\xaf\x41\x0d\xac\x83\xba\x44\x68\x4d\xf0\x3d\xa8\xd5\x9f\x13\x2a\xb7\xfc\x12\xd8\x21\x1e\xf0\x3c\xe8\xb6\x31\x49\xea\xe5\x1a\x78\xcd\xde\x22\x36\x1b\x20\xc9\x29\x5e\x70\x19\x47\x4e\x78\x7d\x95\x8b\x7e\x80\xd6\x31\xb1\xa0\x4b\x8e\x4a\xf8\x25\x92\xac\x15\x2d\xd3\x3a\xf0\x25\xbb\xc2\x62\x88\x0f\xea\x0b\x4c\xba\x11\x81\x3a\x25\x60\x17\x4b\x80\xf2\xc9\xeb\x04\x73\x1c\xbc\xa5\xda\x67\xf6\x4c\x76\xc0\x32\x1e\x3c\x41\x32\x7e\xc9\x90\xc9\x28\x2f\x8c\x90\xee\x12\xae\x41\xd9\x27\x9d\x88\x30\xee\xcf\xfc\xeb\x43\xa5\x71\x28\x01\xf2\xeb\x2a\xfc\xe3\xf4\x64\xfb\x39\x26\x44\x23\x88\x70\x11\xf1\x4d\xaf\xf4\x4e\x2a\xcd\xd0\x18\xe9\xc0\xf4\x88\x08\xb7\x77\x92\x57\x05\xce\x54\xbb\x9c\x91\x25\xf9\x1c\xab\xed\xa8\x2b\x94\x60\xde\x46\x6a\xf0\x1e\x4a\xac\x91\xd2\x3b\x29\x34\x58\x30\x91\x0f\xbb\x81\x49\xcd\x0f\xa9\xa4\xb5\x77\x52\x79\x1b\x15\x17\xe2\x6a\xa7\xce\xc4\x71\xef\x9c\x0f\x61\x57\x36\x34\x47\x96\x33\x3e\x70\x47\xd6\x95\x45\x1d\x98\x8c\xc8\x42\x07\x99\x96\xad\xfb\x68\xda\xb6\x7b\x47\xbd\x6a\xc8\xce\xa4\xd6\xa0\xa2\xcf\x88\xeb\x0f\x4f\xac\x29\xdc\xb7\xa6\x16\x91\x27\xd7\xe1\x6a\x28\x5c\x0c\xae\x42\x6c\x87\x63\xb6\xf8\x25\x10\x58\xc7\xe7\x9b\x16\x39\xf9\xc8\xb5\x79\xe8\xf4\x63\x64\x25\x78\x86\xac\x40\x50\x2a\xd0\xef\x5b\x70\x58\xab\xf3\xcd\x22\xeb\xb2\x96\x33\x74\x47\x94\x34\x18\xb3\xe0\xaa\xfc\x3c\x9c\xda\xd4\x9c\x53\xa2\x41\x02\xdc\x3b\x44\x68\x5b\xab\xd6\xc3\xb1\xdf\x99\xc8\x39\x75\xac\xa9\x47\xa5\x3f\xa2\x77\xc9\x90\xdb\x2e\x3e\xbf\x1a\xfc\x3e\xf2\x59\xe5\x9d\x6a\xb8\xbc\x3b\xb5\xe8\xaf\x7a\xf4\xd7\x4a\x24\xcd\xe6\xdb\x56\xc6\x30\x38\x30\xbd\x82\xb1\x19\x49\x4f\x76\xf6\xf1\x1c\x76\x22\xd3\xaa\x9d\x8e\xf8\x9b\xce\xb3\x7b\xef\x07\x70\x7a\x1a\x5c\x3d\x17\x16\x89\xff\xb8\xef\x0e\x27\xd4\x40\x22\xfe\x3c\xac\xbd\x35\x83\x00\x22\xe7\x83\xa8\x83\x99\x79\x23\xa2\xdb\x60\xed\xc9\xf6\x8b\xe5\xa7\xe6\x2d\x1c\x25\xb7\x24\xb5\xe3\x8a\xf7\x24\x47\xc2\x7b\x27\xdc\x93\x4a\xb9\xdb\x09\x39\x4e\xab\xd8\xdc\x6f\x37\xf7\xf0\x5a\xa5\x83\xeb\x34\x76\xc0\x4b\xb0\x52\x92\x0e\xc9\x02\x0f\xca\xb0\x5a\xfc\x9c\x1c\xcc\xae\x08\xcb\xca\xde\xfc\xe1\xc1\x74\x7d\x7a\x87\x50\x06\x7a\xe6\x0c\xb9\x26\x26\x1a\xfb\xe4\xc2\x11\x70\x10\x8f\x27\x55\x25\xa1\xa0\x3a\xa4\x12\x69\x1e\x8e\x8f\xb7\x8b\x84\xc1\xe6\xec\x8a\x26\x8b\x8a\x23\xd3\x92\xf2\x06\x1a\x13\xa1\xa6\xf0\xd8\x4d\x1d\x89\xd0\x49\x96\xa6\x84\x4e\x5a\x85\xbe\x9c\xbd\x6d\x12\xd4\xa6\x4e\x9d\x62\x5f\xc9\xef\xe1\xd7\xdf\xba\x62\x4d\x72\x76\x1e\x21\x27\x6b\x08\x78\x35\x39\x33\x35\xe6\x5e\x54\x25\xd1\x7b\x38\x4f\x1f\x5c\xed\xa2\x7a\x49\xe4\x68\xd9\x43\xb0\x4c\x94\xe4\xae\xf4\x69\x23\x09\x70\x78\x29\xc7\x4e\x56\x32\x29\x16\xef\x18\x8a\x48\xb6\xb2\x0d\xe5\x23\x9a\xb8\xf7\x58\x54\xa6\x88\x3b\x2a\x96\x80\x95\x76\x4d\x07\xd9\xd3\x32\x47\xb7\xa6\x93\x9a\xd8\x37\x5a\xa9\x65\x41\x09\xa9\x03\xe8\x41\x38\xe9\xc0\xa9\x69\x39\x96\x33\x96\xf7\x8b\x56\x0b\x33\x98\x5d\x45\x0c\x31\xeb\x2f\xc7\x9a\xe0\xbe\x30\x2d\x7f\x74\x1f\x25\x50\xcb\xd1\xcd\xd0\x75\xfc\xd9\x14\x7e\x51\x4f\xcb\xcb\xf9\xfa\xb2\xfc\xa6\x8b\x6f\xb3\x1c\x01\x8e\x04\xc5\x0d\x3e\x76\x91\x0c\x71\x76\x15\x6e\xff\xef\x5f\x46\xeb\x27\xf4\xcd\x11\xff\xac\xa5\xbf\x15\xcc\x3a\xf0\x65\x22\x11\x27\x0e\x16\x2f\x89\x33\x6f\x33\x95\x81\x61\x98\xd6\xb0\x9f\x88\x5b\x93\x12\x48\x33\x4e\x4e\x32\x49\x49\xb6\x1a\xc3\x40\xd2\x6a\x17\xc3\x64\xf0\xfc\xed\x1c\x35\xdc\xd9\xe7\x2f\xe9\xb8\xb8\x93\xe0\x09\x48\x71\x78\x79\xc4\x0c\x02\x29\x0e\x6f\xe7\x98\x5b\x20\xc5\xf5\x92\xcc\x3a\x90\xe2\x95\x1c\xb8\x63\xb9\x1e\x32\xd0\x27\x4c\x5d\x4a\x0f\x11\xa3\x37\x9c\xb9\x88\xde\x04\xa2\x14\x28\x0e\x51\xc7\x43\x88\xf1\x17\xe1\x8e\x62\x2c\x2a\xee\xf0\xc5\x31\x4f\x55\xe5\x8b\x63\x06\xab\xc6\x17\xc7\xdc\x56\x9d\x2f\x5e\x89\x99\x30\x0d\x8b\x92\xc7\x4c\x20\x1e\x4b\x26\x07\x57\x4b\xaf\x59\x8d\x6b\xd6\x73\xc3\xfc\x6a\xfc\x63\x5e\x6e\x6e\xc1\xe7\x8b\x06\xb3\xf2\xfb\x28\x8f\x96\x57\x15\xd3\x41\xd9\xa7\x3a\x74\x04\x7b\xf3\x4b\x37\xa0\xe4\x76\x8c\xc0\xa7\x97\x60\xb5\x58\x02\x85\xc8\xe7\x38\x22\x7f\xd1\x2e\x8f\xfd\x35\xed\xa0\xed\x1f\x40\xaa\x8e\x0b\x5d\xf6\x8b\x31\xa0\xe7\xc4\xcb\x30\x74\x64\x8e\x8a\x6b\x4f\x55\xa1\xf0\xf1\x2b\x99\x3e\x49\x2a\x57\xc8\x9d\xe2\x5b\xa1\xe9\x8e\x60\x81\x4b\x97\x2c\x47\x3a\x58\x06\x2b\xf5\x12\xf9\xaf\xbe\xb6\x56\x54\x3d\xf3\xfd\x05\x80\x76\x48\xa0\x7e\x01\xa2\x34\xcc\x38\x8a\x89\xd6\xd9\x94\x44\x2e\xa1\x6e\xa9\x9a\x10\x24\x98\x9b\x7c\xf1\x14\x2c\x83\x42\xb9\x06\xbe\x03\x24\x1d\x7e\x60\x9e\x83\x57\xc4\xb5\xb3\x0a\xd6\x49\x1e\x67\x07\x23\x0a\x51\x8d\x85\xf1\xb3\x8b\x03\x03\x2c\x2b\x27\xe2\x14\xc1\x32\x70\xc1\x32\xf0\x55\x0e\x9d\x1a\xf7\x50\x5d\xec\x14\x1a\x76\x25\x72\xe3\xd6\x4c\x20\x0c\x28\x45\xb3\x31\x5a\x4e\x21\x74\x8c\x2e\x81\x7a\x91\x60\x50\xd3\xed\xe2\x73\x7a\xf4\xf8\x67\x9e\x6e\xf4\x61\xe7\x3f\x37\xbe\xb8\x13\xe2\x8c\xad\xeb\x26\x41\x24\x94\x27\x16\xbf\xd3\xc8\xd9\xa1\x18\xf4\xc9\x21\xc1\xc8\xc5\x5b\xb0\x06\xf8\x2f\xaf\xa9\xfc\x08\x6f\x90\x55\xd5\x07\x22\x6b\x53\x7d\xa8\xe3\xc7\x01\xfe\x63\x85\x89\xa0\x30\xec\xc5\xd7\xe2\x51\x9b\x89\x86\x3a\x50\xe0\x88\x7c\x8d\x62\x34\x7c\xd5\x18\x3f\xa1\x3d\x3d\x44\xc8\x45\x05\x83\x49\x83\x00\x59\x0c\x40\xce\x07\xb3\xe0\x27\xa7\x38\xce\x43\x1e\xfe\x93\x83\x00\xc5\xf9\x98\x42\x02\xa8\xf6\x2a\x05\xa2\x7f\x9d\x40\xef\x23\xd3\xe9\x2c\x19\xad\x2c\xec\x8a\xdf\xb5\xec\x79\x19\x46\xd9\xda\x60\x8a\x33\xbe\x6e\xcc\xe4\x16\x8a\x6a\xb9\x6d\x74\x59\xf1\xcd\xf0\x80\x38\x6d\x20\xff\x89\xf0\xf2\x50\xf5\x65\x30\xbb\xd2\xa5\xe5\x95\x61\x57\x86\xa6\x6d\xb3\xb8\x9b\x72\x85\x62\xb2\x88\xbd\xa7\x5f\x72\x98\x97\x86\x44\x62\xea\x29\x3f\x92\x57\x32\x7e\x78\x32\xb3\x77\xf2\x16\x40\x60\x64\xd1\x48\x10\x24\x33\x1b\x08\xae\x21\xf0\x71\xfd\x25\x23\x7b\xe5\x28\xc6\x73\x68\x62\xa8\x78\x2d\x76\x63\x4c\x88\xe0\x22\xd5\x24\x67\x30\x1e\x6b\x6c\x13\x1d\xc7\x86\xe5\xd9\x9d\x4b\x66\xe8\xa9\x53\xc9\x07\x31\x21\xc1\x8c\xb7\x9e\x06\xba\x0f\x03\x4e\xda\xa8\x03\xaf\xcf\xc4\xa2\x3f\xd4\x7c\x68\x8f\xc2\xb7\x6d\x9a\xc3\x0d\xdc\x9a\xf6\x0c\x92\x43\x63\x84\x07\xb3\x0c\xd9\x00\x8c\x75\xf0\x2d\xf1\xd1\x51\xa4\x43\x31\x2c\xdf\x2d\xd7\xab\xf5\xba\x8a\xea\x3c\x5e\x94\xb8\x40\x42\x16\xba\x55\xca\x24\x94\xc6\x68\xb1\x51\x54\xd3\x47\x21\x73\x77\xd9\xa3\xf8\xaa\xdd\xd7\xd4\xca\x70\xbd\x24\x55\xb3\x95\x54\x95\xb3\xed\x4e\x8e\xa8\x03\xcb\x04\xde\xfb\x05\xca\xbb\x37\x6b\xc5\xca\x95\x85\xcf\x7c\x01\x82\x97\xaf\x18\xc5\x4c\xce\x8c\x18\x2b\x0d\xd9\x95\xd7\x08\x0a\x45\xaa\xa2\x2a\x56\xa6\xa6\x47\x5b\x1a\xef\xdf\x93\xc0\x8d\x98\x6f\x7c\xf0\xcc\x51\x41\xac\x5f\x09\x5c\x76\x19\xd4\x9e\x16\x31\x1b\x54\x64\x26\x62\x46\x22\xe0\xb7\x24\x6b\x86\x77\xa0\x03\xc7\xad\xb9\x57\xf8\xe1\xe2\x17\x3f\xc2\xcf\x97\x3f\xe8\x90\x22\xa8\x36\xd2\xae\x91\xb0\x3c\x50\x1c\xb2\x25\xa4\x98\xfe\x37\xdf\x00\x74\x81\x2a\x3b\xfb\x97\xf8\x95\x14\x1a\x88\xc4\x45\xd4\x8e\x04\xc5\xf7\x1f\xf1\xa7\x91\x03\xe2\xa9\x05\x76\x54\x5d\x9f\x14\x5f\x15\x8a\x25\x16\x45\x85\x45\x11\x94\x91\xa3\x70\x73\x24\x62\x3a\x37\x0e\x06\x66\xf9\x9e\x19\x0c\xaf\x0b\x46\xb3\x59\x33\x4a\x18\x14\x95\x0d\x55\x4b\x84\x85\x86\x61\x00\xa4\x48\x1a\x57\xa8\x11\xef\xe9\x72\x2d\x06\x54\xb0\xe2\x5a\xe4\xaa\x2d\x30\x27\xef\x02\x0f\xce\x8d\x1e\xeb\xda\x4e\x5d\x6d\xa7\x2e\x09\xa1\x9a\xba\xac\xcd\x6e\x3b\x5f\x54\x8d\x50\x32\x57\x28\x86\xe1\x7c\x4d\x34\x16\x42\xb1\x83\x57\x2f\x81\xf1\x4b\x83\xdc\x8e\xe0\xfb\x97\xc0\xf8\xb5\xa1\xc2\x58\xb7\x6d\xe8\xb4\x63\xcb\x3a\xcd\xd8\x32\x7e\x44\x42\xf2\xa6\x53\x09\xe2\x49\x18\xb0\x0d\x7a\x03\x86\x97\x5d\x12\xc8\x6b\x4d\x6b\x8c\x74\x14\x8a\xd7\x3e\x7d\x02\x88\x19\x73\x12\x93\xe3\xe8\x6f\x92\xe9\x83\x4c\xb1\xca\x4d\xf1\x85\xf1\xa8\xfe\xa2\xee\x5e\x93\xcd\xce\x7e\x94\x41\xed\x12\x73\xc9\x01\xae\x41\xe5\xd8\x41\xd4\x2b\xe0\x7a\x7d\x4d\xfe\x36\xd6\x49\xe0\x25\x3c\x63\x0e\x9e\x0e\x83\x0c\x96\x1a\xef\xdc\x47\xee\x20\x54\x02\xe8\x07\x85\xa0\x18\xf1\x0f\xd2\x0e\x24\x6b\x82\xf9\x08\x8d\x76\x84\xdf\xfd\xe9\xa4\x25\xb0\x6c\x16\x1d\x97\x1e\xf4\x40\x4e\x01\x97\xb6\x2b\xe5\xc3\xcd\xcc\xc4\x59\x74\xd1\xc2\xfb\x79\x6d\xf0\xfe\xfd\xa7\xf7\xf3\xea\xb3\xe2\x93\x62\x48\x15\xa4\xcd\x8b\x39\x48\x8b\xdb\x03\x05\x8b\xe2\xdf\xc0\x07\xed\xa2\x76\xc9\x08\xe6\x96\x19\xe0\x5d\x48\x8f\x71\x52\xf4\x4f\x1a\x61\x64\xaa\x84\x0c\x6a\x05\x00\x6e\x12\x07\x47\x0d\xd4\x0a\x52\x6a\x1b\xfd\x12\x18\x81\xeb\x02\xdb\x0d\x1d\x81\xb9\xb6\x74\x50\x4b\x2f\x69\x99\xe5\x8c\xe0\xfc\xe8\xaa\x60\xfc\x8c\xa5\xb6\x23\x8d\xe1\x74\x00\x47\x23\x38\x02\xd0\x1f\x9a\x1e\xe4\x60\xf0\xf5\x8b\xa5\x68\xa6\xa0\x4c\xe7\xfe\x4a\xa5\x6f\x8e\xc7\x44\xa3\x40\x00\x38\xf7\x2c\x04\x47\x14\xac\x04\x82\x90\xdc\xd7\xa0\x20\x38\x1c\xa7\xac\xfd\x3a\x30\x07\x2e\x0a\x22\x97\x67\xb7\x44\x06\x1a\x13\x80\x02\xfb\xc9\x2c\x33\xe2\x9d\x16\xc4\x08\xd1\xe5\x32\x51\x60\x7e\x19\x20\x3d\xf6\x5f\x63\xf8\x71\xaf\x98\x18\xc5\x3d\x47\x4b\xce\xd1\x6c\xa4\x25\x54\xfc\x99\x40\x60\x19\x60\x8c\x13\xaa\xc5\xd9\x5a\xd4\xf0\x79\xab\x13\x61\x0c\x3f\x89\x24\x4f\xcd\x4e\x63\xa6\x81\x50\xa8\x90\xc2\xed\x2f\xe0\xa5\xe8\xe7\x65\x81\xd7\xc0\xc2\x1b\x87\x40\xb7\xc6\x8e\x8b\xf0\x8c\xc9\x09\x07\x51\x6c\x28\xf0\x0a\x18\x7f\xc7\x88\x6d\x26\x93\x96\x03\xaf\x45\x5e\xd9\x68\x63\x48\x98\x21\x7f\x5e\x1e\x58\x01\xfe\x48\xaa\x0d\x89\xfd\x48\x95\x86\xa2\xe6\x19\x99\xaa\xc8\xc9\x50\x1b\x25\xd3\xf3\xec\x7b\xf6\x7c\xbb\x40\x25\x10\x5c\x46\xf9\x96\x92\x66\x02\xdf\x7c\xa3\x1a\x02\x1c\xd1\x17\x37\x58\x06\x06\xeb\x5d\xe1\xce\x1e\x14\x17\x01\x1c\xbe\xeb\xf3\x01\xd6\x3f\x5a\x1a\x87\xdd\x76\x32\x21\xb8\xac\x84\x5c\xa5\xcb\xf0\x5a\x92\x04\x08\x79\xdf\x31\x91\x5e\x07\xf5\xaa\xb6\x6a\x32\x83\x3b\x6d\x92\x7f\xca\xfc\x67\x3c\x70\x70\x10\xcd\x39\xed\x61\xb6\xd5\x6a\x66\x4f\x31\x7c\x9a\xc5\xb1\x74\x89\xca\x54\x9d\x32\xb3\xa6\x7b\x37\x28\xa4\xc4\x89\xa8\x7e\x49\xda\x0c\xd2\xf2\x57\xae\xe8\xfa\xd2\xda\xe7\x44\xba\x68\x1e\xfb\xd4\xb8\x0c\x5f\xd4\xb5\x15\x7c\xb2\x9f\x57\x8b\x52\xac\x8a\x0a\x89\xaa\xf9\xc6\x9d\xc2\x42\xe2\x93\x0f\x83\xde\x09\x8d\x3e\xd3\x81\x63\x4c\x47\xa8\xc2\x9d\xf8\x77\xaa\x64\x63\xda\xe9\xac\x65\xa2\x2e\x4e\x8d\xdf\xb3\x46\xd0\x15\x4d\x6d\x33\xe1\x3f\xcd\x03\xff\x08\x59\x63\xcb\x61\xbb\x76\x11\xe8\xcf\xf2\x40\x8f\xf3\xf2\x2f\x08\xbd\x56\xd7\x81\xe7\x2d\x9d\x12\x02\x39\x31\xf9\xfa\x82\x7d\xd6\x73\x2d\x88\x98\xfd\x7c\xb1\x1e\x56\xaa\x79\x7a\x48\x04\x55\x5a\xac\x93\xd5\xec\x4e\x52\xec\xd9\x16\x3a\x90\xab\x8b\x6c\xe1\x47\xef\x85\xa7\xd9\x5b\x2d\xa2\x31\x03\x73\x38\xf1\x3d\x73\x08\xbb\xd0\x19\xf9\x9b\xe1\xaf\x45\x27\x56\x83\x5a\x5a\x23\x29\xfc\x42\xf2\xae\x30\xce\x5a\x4f\x51\xcd\x25\xa8\xca\xbd\x33\x24\x1a\x40\x62\x42\xae\x54\xd8\xe8\x07\x5b\xad\x6a\x4f\x4b\x9e\xd1\x12\xe5\xc3\xef\x6e\xb0\xb5\x1c\x87\x80\x65\xad\x38\x9a\x05\xde\x2c\x58\x78\xf1\xaa\xb5\xec\x5b\x29\x91\x18\x63\xf1\x5e\x56\xb2\x09\x6c\xb4\x2f\xa7\x30\x30\xc9\x96\x6c\x11\x6e\xff\x11\x9d\xbd\x50\x75\x96\x60\x2e\xa2\x0e\xc3\x00\x3c\x0d\x3b\x20\xfd\x9e\x5d\x9b\xc1\x87\xd8\x73\x2e\x6f\x83\x97\x29\x2a\xdf\xa8\x9a\xbc\x3f\x94\x95\xc8\x9b\x87\x4c\xde\x88\x58\x9d\xbc\xa3\x48\x5e\xe3\xba\x2e\x16\x01\xab\x1d\xb6\x0e\x15\x72\x08\x87\xcc\x65\x5b\x95\x29\x17\x5b\xcc\x44\xb9\x62\x7f\xc2\xa0\x61\xe3\x77\xbf\x19\xc0\x47\xdc\xce\xb5\xea\xea\x73\x5d\x1f\x9c\x85\x03\x33\x37\xd2\x40\xd0\x6d\xb9\x82\x06\x8c\x82\x55\x92\xe7\xa0\x64\xb4\x0a\x5c\x3c\xc5\x1c\x8d\xf9\x8e\x99\x59\x4e\x61\x31\xce\xab\x5e\xad\xae\xe6\x59\x81\x4d\x21\xd6\x6c\xfe\x25\x48\xc9\x3c\xfe\x28\x0e\x7f\xab\xd5\x04\x6f\x91\x75\x8b\x9f\xf0\x49\x46\x1f\x24\xb4\x56\xec\xe1\xa8\x94\x94\x87\x86\x51\xcd\x1a\x78\x09\x7e\x64\xbf\x5a\xdd\x26\xf7\xab\xd9\x6d\x73\xbf\x8e\x84\x6f\xbd\x93\xb5\x3a\x5f\xb5\x59\xbb\x30\xde\x57\x8d\x4b\x20\xbc\x59\xb9\x8f\xff\xbf\x94\x6f\x7f\x8b\x7c\xcb\x13\xe8\xad\x82\x9f\x70\x9b\xd0\xb6\x65\xdb\x38\xd2\xff\x20\x3f\x1c\x6a\xb4\xb5\x0f\xaf\x02\x59\x0c\x46\x41\x05\xf9\x41\x5d\xb9\xe8\xce\x44\xa3\x13\x73\xd0\x0d\x5c\x4f\x39\x30\x67\x21\x68\xd3\x6d\x08\x47\x4a\x38\xb7\x31\x12\x23\xc0\xfc\xf7\xab\x8c\xef\x28\xff\x38\x22\x16\x97\xf2\x87\x85\xaa\x62\x3c\xff\xff\x4c\x70\xcc\xca\xaa\x66\x24\x1b\xff\x5b\x79\x1b\x57\x15\x8d\xff\xed\x94\xdd\xf4\xef\xa4\x7c\xfb\xdb\x52\xa7\xa2\x04\x13\x0f\x34\x52\xd8\x32\xd5\x87\x38\x92\x14\x19\x92\x88\x3e\xaa\x12\x31\x5e\x1b\x0a\xb4\xfd\xbb\xd2\x2a\xfd\x6d\x61\x91\x7e\x96\x3a\xc4\x54\x6d\x4e\xec\x1a\xc9\x99\xd2\x26\xc8\xba\xf1\x33\x9a\xd4\x8f\x8b\xb0\x1e\x89\x90\x5b\xdd\xa6\x51\x02\x28\x14\xeb\x0b\x9a\x77\x8d\x94\x5f\xa3\xf4\x81\x91\x19\x7c\x12\x01\x7f\x27\x65\x85\x7e\xf3\x77\xe3\x8f\xad\x6e\xb3\xb2\x95\x77\xc3\xda\x96\x03\x75\x07\xe7\x37\x7f\x4f\x04\xda\x7a\xfc\x29\x48\xdc\x5c\xe4\xf3\x96\x7b\xe7\x28\x09\xc9\x6f\xfe\x03\xb1\xe7\x37\x0b\xf4\x1c\x52\x14\xf1\xc3\x58\x1a\x52\x51\xd5\xeb\x3f\x10\x7b\x3d\xc8\x4d\x5e\xe9\x1b\x6d\x3f\x0d\x97\xff\x50\x84\x7d\xa8\x5f\xca\xff\x44\xac\x79\xa4\xaf\xf9\x8f\xc4\x9a\x6f\x53\x4e\x80\xec\xa5\x50\xe0\x76\x2a\x67\x00\xa2\x16\x61\x2b\xa6\xf3\x47\x62\xd7\x3d\xfd\x20\xff\x89\x58\xf3\x4c\x5f\xf3\x8f\xc5\x9a\xef\xf4\x35\xff\x73\xb1\x66\x3f\xef\x42\x89\x26\x47\xc6\xcf\x2e\x5e\xd7\x36\xea\x43\x15\xb5\xf9\xcd\x7f\x21\x74\x71\x61\x5c\xa4\x12\x18\x15\x7a\xd5\xfa\xa9\x1c\x5e\x73\x8a\x75\x69\x76\xdb\xaa\x55\xf8\x13\x69\x90\xef\xdf\xa7\xd1\x88\xff\x52\xaa\x7d\xf9\x78\x9a\x29\x5a\x29\xa9\x77\x0d\x6e\x46\x08\x20\x99\xcd\xd2\x4b\x49\x50\x9a\x24\xbf\xcc\xe5\x85\xc6\x79\x29\x3c\xf9\x55\xe1\xfd\x68\xb9\xb8\x51\xa8\x7c\x57\xfc\xc5\x13\x15\xf3\x88\xa8\x3a\x23\xf6\x94\x41\x17\xf5\xcb\x84\x86\xf9\x88\x12\xe7\x8b\xda\x25\xa6\xcf\x98\x57\x96\x44\xeb\xcc\x12\xe6\xa8\xdb\x54\x87\x87\x0d\x3b\x28\xa6\xa6\xce\xc8\x7b\xd4\x14\xab\xf8\x5f\x49\xeb\xf2\xab\x85\xb7\xda\x97\x9c\xe4\x7f\x2a\x9e\xa5\x34\x5d\xe5\xd7\xeb\x9a\xcc\x13\x64\xcc\x93\x01\x4e\x8b\xdf\x11\x6b\x4f\xd3\xee\xee\xc7\xbd\x1b\x7c\xa6\x34\x5b\x07\x98\xc1\xaf\xce\xeb\x55\xb2\x3d\x02\x2d\x37\xa3\x7b\x50\x90\xc9\xfe\xfc\xcb\x27\x6b\x3c\x67\x69\x61\x84\xb9\x26\xe5\xb8\x57\x96\x6d\x17\x8c\x96\x9e\xed\x4a\x1d\xe8\x1f\xfc\xae\x56\x25\xa2\x21\x0a\x3b\xa8\x28\x88\x85\xf1\xc4\xe0\x58\x29\x01\x9c\x68\x71\x22\x4d\x51\x63\x73\xae\x26\x41\xc4\x90\xeb\x97\xa9\x46\xc8\x9c\x5d\x5e\x21\xb6\x7d\x7b\x94\x35\xef\x4e\xfe\x8e\xa8\x71\xd9\xa3\x7a\x79\xa2\xeb\x25\x42\x45\x72\x81\x52\x13\x3f\xa5\x8f\x3d\xaa\xf1\x26\xb3\x46\x3b\xad\x06\xd0\xe3\x21\x34\xf5\x4b\x4b\xd7\x04\x52\xd0\x12\xfe\x4b\xb7\x7b\x16\x06\xb1\x80\x02\x15\xd3\x88\x3f\x00\x4f\x80\xc9\x88\x63\xba\x0a\x55\xfe\xa7\x48\x05\x95\x36\x95\xf4\x29\x3c\x6e\xe8\x8b\x0d\x5c\x1a\xf0\x23\xe8\x4c\x41\xba\xf5\x8a\xd2\xef\xef\xa4\xdf\xcb\xd2\xef\xb2\xf4\xbb\x22\xfd\x7e\x22\xd3\x31\x59\x53\x9b\x9b\x94\x65\x3f\x0e\x49\x28\x20\x6a\xfe\x80\x8a\x71\x14\x13\xf5\x93\x97\x51\x2d\xdc\x6b\x02\x50\x68\xdf\x20\x1a\x3f\xf3\xce\x58\x28\xd1\x24\x76\x1f\xb6\xc0\x6b\x60\x14\x44\xdb\x31\xe2\x59\x66\x81\x75\x60\x14\xd9\x87\x4f\x9f\x80\x51\x96\x2a\xd5\xc2\x4a\xdf\x71\x95\x2a\x52\xa5\x7a\x58\x69\x99\x19\x6b\x7d\xf3\x0d\xa1\xcf\x4b\xac\x01\x7b\xf5\xaf\xe0\x6a\xf9\x14\xf2\xb6\x3b\x8e\x4d\x91\xa3\xe9\x02\x1f\x06\xd4\x24\xf9\x5b\x96\x90\xea\x5b\x66\x8a\xac\xb7\x46\x49\xdb\x6a\x4f\x95\x3c\x31\xfe\xf2\x2c\x4b\x98\x92\x14\xd3\x26\xa0\x3f\x5f\x04\x46\x24\x71\x4d\x80\x79\xa1\x1d\xa4\xf1\x32\xbf\xfc\x49\x65\x2e\xb0\x07\xef\x3d\x73\x24\xc5\xcc\x0d\x61\xbf\xfa\x5a\xb0\x6b\x32\xec\xca\xb6\x66\x42\x95\x61\x46\x8f\xcc\x82\x54\x96\x19\xb0\xe2\x44\x3f\x36\xcf\xbe\x4e\x75\x9d\x66\x85\x1f\x60\xc2\xaa\xba\x91\x80\xef\xe6\x6b\xb9\x92\x68\x79\x61\x7c\xca\x96\xd6\x75\xb4\x8d\x3f\xe7\x6d\x9c\x1c\xf3\x85\xf1\xeb\xbc\x8d\x25\x21\xe3\x51\xb7\x49\xdf\x52\xb9\xe2\xd6\x50\x83\x10\x67\xe4\xde\x9d\x58\x81\x0d\xb9\xb7\x52\x02\x68\x3d\xda\xdf\xb4\x8b\xf8\xcb\x6a\xea\x2b\x94\x0f\xb9\x14\x3f\x11\x59\xfe\xf3\x8d\x30\x94\x6e\x64\x6d\x13\x45\xd1\x7a\x02\xea\x45\x2e\xe6\x60\xba\x17\x36\x17\x1f\x8e\x86\x2d\xba\xb8\x14\xc2\x38\xa1\x0d\xb0\xbc\xec\x68\x63\x38\xc5\xbd\x5f\xd4\xc1\x77\xc0\xb9\x64\x51\x9c\xd8\x4f\xb0\x0c\x6a\x97\x89\xf8\x4d\xaf\x30\x3a\x38\x2b\x4d\xe3\x35\xa1\xa4\x36\x7e\xd4\xda\x7c\xe0\xbc\x79\xad\x76\xe2\x36\xbb\xdd\x82\x5d\x64\x16\x90\x17\x26\x46\x99\x4d\xf4\x3e\x42\x55\x34\x1e\x9c\xb8\xef\x6a\x35\x52\x85\xd6\xf6\xa9\xd5\xaf\x09\x96\x89\x59\xf1\x32\xb0\x8b\x6a\x53\x42\x9f\x0b\xd5\x9a\x2e\x35\xb9\x5c\x25\x80\x7c\x66\x9d\xbf\x61\x14\x31\xf0\xbf\x65\x24\x17\xfd\x45\xda\xd2\xba\x15\xc7\x0d\x30\x73\xf1\xe3\xc0\x1d\xdd\xaf\xc7\xab\xfb\x39\x09\xa8\x96\xba\x27\xd3\xf6\x87\x1c\xe7\x52\x7b\xc3\x27\xf1\x1d\x50\xaf\xde\xa4\x7a\x0c\x29\x6d\x67\xe2\x30\x89\x24\x0e\x1e\x4d\x5e\x1a\xdb\xc3\x56\xc3\x94\xdc\x91\xb8\x22\x88\x11\x58\x8a\x8f\x46\xad\x76\x29\x1a\x0b\xc2\x4b\xc5\x8a\xf1\xb8\xa9\xfd\xfe\xe3\x66\x53\x8c\x11\x58\x40\xa9\x33\x5a\x5b\x70\xb5\x99\xc0\x88\x79\x99\x5f\x9b\x1e\x7c\x59\xa8\x14\x9f\x58\x8a\x09\x46\xaf\x9f\x8b\xda\xa5\xd2\x26\xcf\xa8\x69\x9d\x79\x92\xf2\x6d\xd2\x57\x81\x4b\x87\x32\x8c\x8b\x2b\x9b\xad\xc6\xc1\x22\x8a\x55\x43\xef\x4b\xb5\x60\xcf\xa7\x87\x5b\xad\xce\x7e\xfb\xb0\xf5\x95\x54\xae\x8b\x4e\x7c\xff\xa8\xb9\xc7\x2f\x30\xcd\x33\x2e\x3e\x3e\xf8\xc4\xd2\xc8\x87\xe0\xa8\xdb\x04\x6b\x55\xfc\x0a\xf1\x43\xc7\x52\xed\x5d\xb2\x56\x7f\xd4\xfe\xf8\xd5\xc5\xd0\xf3\xab\xb5\xfa\xca\xea\xda\xd3\x67\x97\xdf\x11\xc9\xe2\x93\xfc\x87\xe0\x8e\x5c\x74\x15\x33\x70\x07\x74\xfb\x64\xef\x7c\x92\xa7\x94\x0a\xc2\x12\x59\xa9\xa9\x23\x20\x4a\x3f\xdb\xcf\x9e\x3d\xcb\x9c\x2a\x37\x8c\xd8\xb5\x3e\x71\xd8\x4b\xa0\x56\xc4\xc8\x54\xee\x79\x4a\x88\x55\xdb\x8f\x4c\xbe\x04\x2c\xc5\x46\x2a\x68\x90\xfc\xab\x8d\xcb\xe5\x8d\x02\xfe\x9f\xef\x8a\x85\x8d\xc2\xc5\x7b\xff\x7d\xf7\xf2\xbb\x62\xf1\xf5\x2f\x9e\x14\xc3\xb0\x06\xf8\x6e\xac\x5d\xb2\x28\xa4\x17\x2b\xc4\xa8\x3e\xba\x0e\x34\xaf\x5a\xcc\x61\xac\x03\xa4\xc9\xf4\x4c\xef\x10\x2b\xf9\xf1\xf3\x97\x1f\x02\xf5\xb3\x79\x86\xe6\xb7\x01\x98\xba\xa3\x99\x0d\xd5\x5b\x16\x24\xd6\xb4\xd9\x6d\x5f\x18\xbf\x4c\x95\xb0\x49\x77\x2f\xb1\x8b\xee\x7a\xe6\x10\x73\x57\x96\x89\xc6\xd4\x31\xab\x28\x83\xad\x34\x72\xc3\xa4\x67\xf5\xd4\xcb\x00\xb8\xb9\x20\x40\xa2\xcd\x4b\x07\xd9\x5c\x10\x24\xc9\x74\x9f\x01\x73\x6b\x41\x98\xc4\x7a\x21\x1d\x64\xeb\x8b\x67\xae\x30\xe6\x49\xb5\x0b\xe8\xb6\xc9\x73\xe9\x4b\xd6\xef\x11\x5d\xee\x2c\xc2\xe7\x0b\xc0\xf8\x9e\x41\x59\x8e\x1d\x85\x61\xbf\x59\x1c\x76\x98\x6b\x37\x01\x1d\x3f\xfa\x49\x49\x4d\xdf\x5f\x3b\xd7\x8d\x10\xc3\x95\xf2\x67\xc7\xa1\x4c\x82\x12\xa8\x25\x70\x29\xa5\x3a\x56\xa6\x22\xa2\x81\x61\x00\x02\xdf\x83\x60\x03\xa0\xe5\xe5\xe2\x42\x26\x2f\xdd\x76\x65\x37\x32\x70\xc0\x64\xe2\xf5\x6e\x96\x04\x2b\xa1\x3a\xe3\xbd\x3d\x31\xa5\xad\x52\x93\x85\xd7\x80\x3a\x79\x25\x0c\x1e\x21\x32\x7d\xd8\x18\xb8\xb7\x90\xb8\xf2\xd5\xd5\xb5\x98\x99\x19\x58\xa7\x01\x91\x55\xf7\x5c\x54\x43\x01\x7f\x13\xda\xee\x9d\x62\xb6\x7b\xe2\x6c\xf7\x7e\x37\xb3\x3d\x71\x09\x01\xe0\xa6\x9b\x98\x0d\xa9\xb7\x6f\x39\x50\x37\xa3\x13\x97\x12\xa6\xe4\x9c\xf6\x17\x24\xec\xb8\x17\x3f\x83\x1a\x1d\xe4\x86\x49\x13\x1a\xe7\x81\x99\x66\x2a\xa0\x82\xd9\xbc\x36\x51\x16\xcc\x6e\x6e\x98\xb7\xcc\x4a\x3f\xf3\x0a\x4a\x0b\xa0\x0b\x85\x48\x8a\x24\xd4\x71\x62\x25\xc3\x8e\xb2\xaf\xa6\x0b\xe3\xd5\x89\x46\x77\xde\x6d\x13\x53\x84\x7c\x53\x13\xf6\x47\xfa\xe4\xfa\xbf\xff\x14\x6b\x60\x0e\x27\xa9\x24\xeb\xc2\xf8\x81\x33\xcb\xc2\x57\x4a\x3c\x3f\xf3\xd1\xb7\x4b\x86\xe9\x0e\xe1\xb4\xd2\x30\x3b\xd0\xad\x63\x9a\xb4\x3f\x7e\x99\x87\xa4\x24\x2e\x49\x04\xef\xca\x67\x48\x42\xb6\xd5\x70\x11\x6e\x4f\x06\xfb\xaa\xba\x51\x5f\x7b\xba\x51\x55\x80\xae\x8c\x16\xc1\x6f\x63\xe0\xbb\xf6\x2c\x60\x21\xf8\x3a\xee\x5d\x8e\x2b\xfc\x8a\x5f\xd8\x37\x71\x79\x5a\x64\x92\x34\x2c\xbe\x0e\x03\xea\x73\x55\x14\xf7\x48\xc3\xb6\xd9\x7e\xf3\x15\x04\x98\x54\x61\xdf\x1b\x6c\x63\x14\x96\x14\xa3\x4f\x0b\xc6\x2f\x48\x15\xab\x1b\x20\x20\xa1\xda\x39\x7a\xb2\x01\x82\xe8\x34\x70\xde\x8d\xe1\xc3\x2a\xb8\x4c\x7a\xb3\xd2\x5b\xec\x3a\xdd\xa6\x66\xc1\x7e\x99\xc7\x61\x46\xb7\x15\x4b\xbc\x49\x2d\x3d\x21\xb3\x7f\xdb\x48\x49\x2e\xc4\x85\xf1\xda\xfe\xad\x23\x45\xb1\xfe\xd3\x7c\xb6\x4d\xc9\xf7\x7e\x9c\x84\x80\xbf\x5f\x88\x1e\xaa\x8e\x29\xc1\x1a\xb7\xab\x03\x22\xdb\x05\xaf\x69\xb8\xd3\xf5\x90\x2e\x91\xba\x25\x50\xd5\x99\x79\x46\xfd\xa3\xc5\xfa\x5f\xc3\xfd\xd7\x53\xfa\x27\xd9\x55\x8c\x98\x40\x46\x03\x21\xae\xbd\x25\xe9\xcb\x8a\xf6\xcb\x6a\xf8\xa5\x68\x68\xa6\x20\x68\x2a\xd3\xa4\xeb\x89\xfc\x26\xdc\xb4\x12\x73\x8f\xf6\x82\x4b\xf7\x82\x9b\xdc\x0b\x2e\xde\x0b\x1a\x3b\x32\x3f\xbe\x32\x5d\x3c\x03\xc5\xcb\x1f\x8f\xc1\x07\xdf\x83\x95\x6a\x91\x86\x42\xf1\xc1\x6b\x60\x85\xca\x25\xb0\x4e\x99\x47\x5a\x18\x26\xc7\xac\x86\xcc\x22\x2d\x8e\xb3\xd7\x85\x2c\x31\x2d\x8f\x53\x65\xd2\xf0\x72\xd1\x07\x31\x67\x1c\x58\x07\x6b\x5c\x1f\x61\x36\x4d\x5c\xfe\x8c\x03\x16\xa7\xc9\xc4\x5f\x9e\x0b\x5f\xe2\x6c\x99\xf8\xdb\x8b\xf8\x5b\x32\xbb\x1c\x1e\x7a\x34\xf6\x82\xc5\x27\xfc\xe4\x66\x42\x02\x00\xd4\x95\x73\x21\x11\xf1\x74\x93\x21\x1f\x55\xb3\x21\x1f\xd4\xd3\x21\x9f\x74\xf3\x21\x1f\xd9\x84\x68\x76\xba\x64\x22\xd1\xe2\x06\x15\x28\x86\x4b\xb9\x56\x2d\xc6\xcb\xfa\xbc\x48\xd2\xc9\x24\xb2\xae\xfa\xa0\x0c\x56\xaa\x5c\xcb\x15\x3a\x04\xda\x34\x4c\xbf\x46\xa2\x06\xa2\x82\x5b\x2c\x6a\xc0\x58\x61\x2e\xa2\x92\xf0\x1d\xbc\x04\x4e\x89\xa6\xe2\x58\x63\x7d\x68\xc4\x58\x51\x6f\x2f\x5f\x82\x02\x66\x91\x02\xda\x5b\x9a\xd5\x0a\x6e\x43\x60\xd7\x4b\x54\x71\x64\x89\x29\x49\xc3\xc3\x34\x74\x9d\xc0\x72\x66\x50\x07\x46\x35\x21\x53\x21\x31\xe3\x90\xf4\x22\x44\x92\x1e\x19\x2c\xe1\x92\xb4\x28\xab\x64\x21\x14\x49\x6d\xf1\x42\xac\xf2\x0b\xb1\x1a\x2e\x84\x06\x63\x61\xa4\x4c\xbc\x2c\x29\x08\x0a\x97\xd0\xd1\xf4\x2b\xac\x9c\x90\x3f\x2a\xe7\xca\x01\x4e\xe3\x17\xe8\xc7\x22\x2f\xb2\x99\xbe\xb6\x5f\x71\x7d\x09\x28\xd5\xd4\x15\x6b\x0c\xd4\x46\x47\xe1\xd2\xeb\x11\x28\xae\xb6\x8f\x47\xfb\x82\xa8\xb6\x7c\xfc\x06\x7b\xf1\x8c\x52\x48\xf5\xf9\x7b\x51\x65\xf9\xd5\x48\xb3\x5a\x35\x6e\x57\xab\x3e\x63\xc7\x5d\xb3\x63\x70\xe5\x65\xf0\x3c\xdd\x0a\xc9\xe2\xd3\xfe\x25\x5f\x11\xb2\x82\x2e\x21\x32\x1b\x27\x15\x55\xc5\xa4\x1e\xc3\x52\x5a\x22\x50\x66\x7f\xaa\x67\xbd\x9c\x8c\xe8\xc4\x6b\x02\x6f\x2c\xbf\xc4\xe4\x97\x41\xd5\x31\x78\x32\xf8\x54\x6a\x9c\x16\xb4\x32\xf9\xb0\xc2\xcf\x01\x7c\xdb\xd7\xb8\xf8\x95\xa9\xcf\x2f\x39\x4e\x5f\xc6\x2b\xc6\x60\x66\x3c\x54\x01\x8d\xf0\x5f\x1d\x23\x5d\x46\xfe\xca\xd1\x62\xf2\xc2\x78\x2d\x7b\x86\x25\xb1\xf9\x37\x1c\x21\x74\x65\x6b\xe2\x9e\x48\x08\xb4\x14\xaf\xd0\x9a\x43\x1c\x84\xeb\x0b\xb7\xac\x87\x2d\x9f\x2e\xdc\x67\x7d\xa3\xb6\x51\xdd\xa8\xd2\xf6\x6b\x59\x6f\xbc\x24\x80\x35\xdc\x54\xb1\x05\xbc\x94\x2d\xb0\xe4\x65\x1a\xb8\x68\xcc\x88\x7c\xf7\x2a\xe8\xa8\x0f\x70\xe5\x17\x9e\x7e\xcf\xfd\x22\x65\x38\xdf\x1a\xde\xb7\xda\x83\x7f\xa3\x85\x09\x6e\x52\xf7\x71\x42\x91\xc9\x6d\xb2\x6a\x64\xa6\x57\x0b\x8d\xf4\x92\x2e\xc0\xb9\x75\xb5\x5a\xdd\x01\x8d\x04\xb2\x54\x2d\x46\xf2\xd2\xdf\x72\x4f\x34\xb6\xe0\xca\x17\xf5\x14\xab\xbf\xf3\xcd\x6b\xf5\x77\xd4\x1b\x9d\xdb\xda\x97\x61\xb1\xd5\x38\xc8\x39\xad\xa7\xbf\xfd\x8e\x6a\x0a\x7f\x9a\x50\x3d\xca\xf2\x67\x90\x0c\x93\xeb\xcc\x73\x22\x71\xc8\xbf\x35\x6e\xf4\x07\x27\x2d\xc1\xb3\x78\x36\x18\x99\xa6\xf4\x8a\xb3\xc6\xaa\x5e\x92\xe0\x4b\x98\x7f\x88\x72\xfb\x58\x4c\xf9\x2c\xd6\xac\x25\x6a\xca\x21\x47\x53\x62\x18\xa1\x12\xb0\xf4\xb8\x8a\xd4\x5a\xd5\x58\x22\x20\x88\x47\x90\x9e\x69\xf8\x05\xd2\x7d\x49\x49\x21\x9c\xc7\x72\x95\xf6\xec\xeb\x7b\x0e\xb4\x43\xd2\x7d\xb9\x30\x5e\x05\x29\x14\x1b\xe8\x3f\x56\x66\x5f\x6c\x42\x4b\xbb\x98\xa5\x60\xf2\x56\x3b\xb2\x6f\xef\xf4\xcd\xe6\xda\x56\xdf\xcd\x53\x3a\xd3\x35\xab\x3c\x64\xf0\x2d\x4b\x05\x59\xee\x53\x4b\xba\x65\xa4\x5d\x0c\x21\x20\x7a\x41\x28\x1f\x55\x09\x31\x0c\xf8\x1e\xd4\x43\xb3\x75\xc5\xdb\x22\xdb\x80\x32\x4a\x58\x1e\x8d\xa9\x76\x29\x71\xea\x8c\xa3\x89\x9c\x79\x92\xec\x79\x1a\x54\x12\x3c\x24\x95\x61\xfc\xf6\x21\x65\x39\x1e\xf4\x6b\xff\x63\xca\x96\xfd\xf6\x53\xda\xc7\xcf\x69\x1f\x7f\x9d\xf8\x28\xa4\x96\xbc\x62\x09\x22\x85\x64\x7f\xb9\xf2\xca\xf9\x43\x64\x79\x81\x98\x53\x8e\x58\x20\x73\x99\xcb\xc6\xf6\xd4\xf4\x36\x4d\x9a\x11\x85\xcf\x55\x2e\x07\x8b\xe6\x7b\x97\xf2\x94\x67\x25\x53\x59\x92\x7b\xfa\xe6\x1b\xb0\x24\x25\xd3\xe7\xc6\x45\xd5\x88\xdc\xf0\x3e\x30\x47\x08\xf1\x03\x82\x1f\x64\xba\x4b\x6b\x03\x1a\x0c\x90\x45\xa7\x36\x7d\xdf\x1a\x3b\x85\x1f\x3f\x27\x26\xcc\xc2\xb8\x4a\xa5\x1b\xc9\x8b\x83\x8f\x73\xcd\xf5\x93\x3b\x5a\xb5\x1c\xe4\x31\x11\xa2\x9a\x4c\x46\x0c\x4e\x6d\x50\xc6\x3f\x8a\x6a\x8d\xf9\xfe\x4b\xa3\x04\x8c\xb1\x98\x60\x87\xf6\x0f\x2b\x08\x7a\xb6\x39\x84\x85\x18\x1e\x49\x23\xf0\x8a\x47\x0c\x67\x1e\x9a\xbe\xa8\x79\xd2\x10\x31\x5c\x2f\x85\xd1\x1c\xc4\xe5\x5d\x78\x1b\xc1\xe0\xe8\x16\x22\x64\x8d\x60\x66\x56\x3c\x0a\x16\xe6\x82\x4b\x32\x3f\x65\x47\x32\x57\x26\xd4\x2c\xc8\xc7\x28\xb1\x83\x14\x21\xb3\x73\xe0\x46\x9c\xad\xb0\xa3\x4a\x52\x72\x82\xa6\x94\xf8\x33\x6d\x41\xa6\xa6\x47\x02\xcb\x25\x1b\x56\x98\x9c\x83\x4b\x12\x8a\x2b\x47\xa7\x3e\x6a\xad\xef\x9b\x43\x29\x75\x2e\xca\x99\xd0\x80\x80\xad\x5c\x9b\xfe\xd1\x9d\xf3\x16\xb9\x1e\x44\xc1\x3d\xae\xfe\x9a\xfb\x7c\x01\x2f\xc1\x3a\xf3\x4d\xca\x35\x02\x73\x34\xca\x47\x02\x19\x4a\x5e\xbe\x94\x27\x1d\x5d\x2a\x21\xd2\x34\xc4\x22\x6a\x11\xd9\x4c\x45\x23\x4e\xf8\x67\x6b\x06\x9b\xe7\x2c\xd1\x51\xe0\xed\x92\xbe\x78\xc9\x71\xa7\xd7\xd7\x1f\x11\xff\x91\x67\xef\x6b\x62\x54\x65\x66\x10\x00\xcb\x01\x7a\xd7\x3a\x7a\x56\xa8\x77\x5b\x22\x48\x14\xe7\xdd\x86\xa2\xa7\x0c\x5d\xaf\x80\xb8\xfc\x4b\x29\xf4\xc2\x4f\xe2\x71\x24\x59\xff\xa3\x7b\x81\x6e\xb5\x42\x50\xd2\x90\x08\x63\xe6\x43\x44\x5f\x2c\x25\x40\x9b\x6a\x59\x0f\xed\xaa\x72\x51\x9e\x74\x75\xa8\x17\x80\x66\x0c\x63\x64\x7a\xd7\xd6\xd0\x28\x09\xa1\x76\x7e\x30\xd6\x81\xf1\x7f\xff\x93\xbf\x6f\x70\x56\xb0\x26\x2e\xfa\xa3\xff\x8c\x2f\x1a\xe0\xa2\x7f\xf4\x87\x7c\xd1\x90\x14\xfd\xc7\x7c\xd1\x88\x14\xfd\x03\xbe\x08\x92\xa2\xff\x90\x2f\xba\x5a\x07\xc6\x6f\xfe\x25\x5f\x32\xc6\x25\xff\x8a\x2f\xb9\x26\xcd\xfe\x94\x2f\xb2\x48\xd1\x7f\xc4\x17\x7d\xc4\x45\xff\xf8\x8f\xf9\xa2\x09\x29\xfa\x47\x7c\x91\x4d\x8a\x84\x81\x4e\x49\xd1\x3f\xe6\x8b\x1c\x52\xf4\x57\x7c\x91\x8b\x8b\xfe\xe1\xff\xca\x17\x79\xa4\xe8\xdf\xf0\x45\x37\xa4\xe1\xbf\xc7\x17\x21\x52\x4b\x80\xe5\x93\xa2\xff\x8d\x2f\x0a\x48\xc3\x3f\xe1\x8b\x66\xa4\x48\x98\xf6\x2d\x29\xfa\x4b\xbe\xe8\x8e\x14\xfd\x0b\xbe\x68\x4e\x8a\xfe\x7d\xbe\xe8\x1e\x17\xfd\xa1\x00\xeb\x81\x14\xfd\x73\xbe\xc8\xf8\x11\x6f\x80\xff\x4b\x18\xbe\xf1\x89\x6c\x8a\x3f\xfc\x67\x42\xe1\x67\x5c\xf8\x9b\xff\x56\x28\xfb\x35\x29\xfb\x9f\x42\x8f\x2f\x05\x0b\x2a\xec\x4f\x62\x52\xac\xd9\x9e\x03\x64\x05\x96\x7f\x8d\xb7\xa7\xf1\x73\xd6\x55\x36\xc0\x4d\x3d\xc0\x99\x6f\xb0\x30\xc0\x59\xa7\x66\x35\xe5\xd4\x8c\x66\xc1\xf0\x5a\x3a\x33\x3f\x57\x60\xe2\x97\xa4\xec\x7f\x17\xca\x2e\x70\xd9\xff\xf9\xaf\x85\xb2\xf7\xef\x49\x45\x61\x23\x18\x97\xb8\xec\x53\x72\x59\x7e\xf3\xe7\xc9\x65\xb9\x52\x2c\xca\x5f\x29\x16\xe5\x2f\x73\x2f\x4a\x33\xf3\x86\xb8\x58\x4b\xc1\xd0\x95\xe5\x38\x6c\xe1\xe4\xb9\xff\xf4\x77\x15\x73\xff\xe9\x8f\x92\x73\xff\xe9\xef\x09\x65\xbf\x22\x65\xc2\xc9\xa0\x84\xea\xa7\xbf\x48\x22\xe9\xa7\x3f\x4d\x22\xe9\xa7\xff\x31\x89\xa5\x9f\xfe\x79\x12\x4b\x3f\xfd\x55\x6e\x2c\x75\x52\x30\x80\xa0\x93\x7f\x93\xfc\xf4\xcf\x92\x9b\x44\x24\x87\x21\xa2\xfe\x2c\x89\xa8\xdf\xfc\x99\x02\x01\x7f\xa1\x40\xc0\xff\xa2\x40\xc0\x9f\x2b\xb6\xc9\x9f\xe7\x46\xc0\x71\x16\x02\xc0\xd0\x74\xcc\x91\x65\x3a\x12\x26\xb4\xb3\xfe\xe9\xbf\xc9\x39\xeb\x9f\xfe\x7b\xc5\xf6\xf8\x1f\x14\xdb\xe3\x2f\xbf\x2e\x76\x7e\xfa\x37\xb9\xb1\xb3\x97\x72\xf1\x42\x34\x55\x23\x45\x5a\xcd\x05\xcf\xcc\x9f\x7c\xc1\x59\x50\x50\x8c\x9f\xfe\x69\xee\xc9\x9e\xeb\x27\x4b\xcc\x3c\x12\xb3\xd5\x53\x4c\x05\x06\x72\x1f\x06\x69\x69\x7f\x50\x2c\xed\x8f\x8a\xbd\x47\xb1\xf2\xaf\x73\x6e\x81\x7f\x91\x1b\x2b\xad\x6c\x3a\xfa\x34\x85\x8e\x3a\x2e\xba\x83\x63\xcb\x74\x9e\x8c\x4c\x05\x41\xfd\xa5\x62\x73\xd0\x0d\xf3\xf7\x55\xe8\xfa\xe3\x2f\x20\xb2\x7f\xaa\x40\xe1\x7f\xa7\x40\xe1\xff\xfc\xd5\x89\x6c\x5f\x8f\x1e\xdf\x53\x61\x65\xb1\x8d\xf5\x5f\xab\x30\xf5\x9f\x2a\xa8\xec\xff\xa1\xb8\x8b\xff\xa5\x02\x03\xff\x4a\x81\x81\x3f\xcb\x3b\x5b\x1a\x2c\x22\x03\x23\x6f\x52\x30\x72\x07\x47\xba\x7d\xf2\x87\x5f\x42\x58\x7e\xef\x2f\xe3\x30\x82\x85\x16\x31\x96\xef\xab\x36\x8a\x44\x1c\xf4\x17\xd3\x5f\x7c\xb5\x8b\xe9\x43\x92\xae\xe8\xaf\xaa\x2f\xa1\xde\xdc\x55\x45\xec\x49\x88\xad\x0b\x7e\x99\x16\x8c\xeb\x00\xa2\xe9\x13\x73\x36\xb2\xdc\x27\x03\x68\xdb\x46\x09\x18\xf4\x97\x3b\x1e\x6f\x0c\x4c\x1f\x3e\x5d\xc5\x65\x27\xf5\x91\x73\x7a\xd7\x68\x36\xa2\x7f\x5b\xd7\x37\x67\x6b\x7b\xe4\xcf\x83\xed\xdb\xd6\xc7\xf3\xcd\x37\xe3\xed\xfa\x60\x65\xd7\x32\xdf\x1d\xd0\x2a\xe7\xcd\x67\x51\xf5\x37\xc3\x4d\xfa\x47\x73\xb5\x71\xfa\xc2\xe9\xd7\x0e\x1a\xfc\xbf\x55\xd3\x9e\x75\xc7\x2d\xf2\x37\xf4\xdb\x2b\xad\xe6\xca\x93\xc4\xbf\xe7\x93\xad\xd1\xf4\xc5\xfd\xf9\xd4\x7e\x78\x73\xdc\x68\x34\xb6\xaf\xbd\xe1\xce\x78\x76\xb2\xb2\xeb\xb4\x77\xe6\xde\xb9\xdd\xbf\x1d\x4e\x77\xbd\xe1\xfd\xe6\x6e\x7b\xab\x7d\x77\xb0\x35\xb9\x3b\x7c\x68\xac\xd1\x1e\x5a\xdb\x61\xdb\xbd\xd3\xdd\xad\xde\xb8\x45\x27\xb3\xb5\x7d\xd0\x3e\x38\x6b\x54\x77\x37\x7b\x8d\x46\xe3\xb8\xd1\xd8\x1c\xef\x36\x27\x47\x93\x7a\x7f\x77\xcf\x3c\x3b\x75\xbb\xd7\x6b\xd3\xdd\x4e\xbb\xdb\x9d\xda\xf6\xc1\xe9\x9d\xd5\xb7\x4e\xad\xe1\xe9\xf9\xf9\xea\xdd\x7c\x7e\x7d\xfd\xf1\xe3\xd6\x9b\x9d\x9d\x9d\xa3\x83\xf6\x56\x67\xb2\x8d\x5b\x37\x9a\x8d\xbd\xc6\xf4\xc8\x5d\xee\xef\x9a\xfe\xea\x5a\x7f\x3e\x76\x3e\x3a\x7b\xe3\xa3\x33\xfb\xe8\x68\x6f\x38\xde\x5c\xf5\x3a\xab\x5b\x93\xdd\xbb\xdb\xd3\xe9\x79\xfd\xe9\x34\xd8\xeb\xa3\x81\xbf\xea\xed\x1e\x8f\x0f\xcf\x8e\x4f\x1b\x8d\x46\xbb\x71\xdc\x1a\x5f\x5f\x77\x3a\xdd\x6e\x73\x67\x7b\x7b\x67\xaf\xdd\x3e\x3f\x3f\x3f\x77\xc7\xd7\xd7\xf3\xf9\xfd\x7d\x73\xc7\x71\xde\xb4\xf7\xf6\x6e\xac\xf1\x78\xec\xde\xdf\x37\x9b\x5b\x27\x5b\xfb\x9e\xb7\x7b\x78\x74\x34\x9b\xba\xee\xea\xea\xd3\xa7\x96\x55\xad\xb6\xda\xfb\xfb\x83\x93\x6e\x77\x72\x37\xaf\xf5\xfa\x1f\x11\xaa\xee\xbc\x7b\x37\x7f\x78\xf8\xe8\x38\xce\x9b\xb7\x47\x47\x10\x0e\x87\xcf\x57\x77\x8f\x27\x87\x67\x8d\xe3\xc6\x18\x23\xe8\x78\x7c\xde\xef\x6f\x6e\x36\x9b\xb8\xdf\xed\xbd\xf6\x9e\x69\x9e\x0f\x71\x1f\xed\xad\xe3\xc9\xf6\x69\x03\x23\x6c\x4c\x70\xb9\xf9\x66\xd2\xe9\xec\xfa\x9d\x93\x7d\xbf\xf3\x70\x58\xed\x76\xde\x3e\xb7\xe6\x9d\xd6\xc3\xbb\xce\x41\xb5\x77\xd2\x6b\xd5\x7a\xf8\xdf\xa8\x57\x7b\x37\x9a\xbe\x7b\x37\x72\xf0\x7f\xb5\xfe\xb4\xdd\x1b\xcc\xde\xd4\xfa\xb3\x76\x6f\x50\x6f\xf7\x46\x2f\x56\x7b\xd7\x3b\xed\x7e\xf4\xdf\xf2\x9b\x95\xab\x17\x2b\xf8\xbf\xea\xf8\x70\xe7\xb8\xd7\x68\x36\x36\x1b\x7b\x8d\x8f\x47\xfd\xc1\xc7\x3d\xb3\x6d\xed\xdc\xec\x5b\x47\x66\x7b\xeb\xba\x6d\xfa\x8d\xf1\xe6\x04\x8f\xb9\xd1\x6c\xec\x4e\xac\xb6\x37\xb9\x39\xdc\xf5\xa6\xfd\x1b\x34\x9d\x0e\x82\xa9\x85\x82\xe9\xca\xbe\x6f\x3d\xec\xfb\xe3\xfb\xd6\xf5\xcd\x1d\x5e\xea\x4d\xb2\xbc\xf8\xdf\xde\xa6\x37\xbd\xe9\xab\xff\x9b\xf6\xfb\xf6\xb4\xb7\xd0\x7f\xc7\x3b\x1f\xdb\x7b\xe3\xcd\x46\x63\xbc\xd9\x98\xaf\xb4\x86\xf3\x95\xd6\xa4\xd3\x6b\x4f\xe6\x2b\x6d\x7f\xf3\x8e\xae\xeb\x3d\xde\xf9\x8d\xcd\xc6\xa9\xf5\xb0\x3d\xfc\xd8\x79\x33\x7c\x38\x79\x33\x7c\x78\x78\x33\x7c\x98\xbf\x19\xb5\x4e\x76\xed\xd6\xc3\xe1\x8b\xd6\xdd\xdb\x66\xa3\xd6\xdf\xc4\xc3\x1c\x37\xda\x74\xb0\x9b\x8d\x83\xce\xc3\xf6\xb0\xf3\xb0\x8b\xf1\x7c\x6a\xad\x9c\x0c\x3f\xf6\xde\x0d\x1f\x56\xde\x0d\xab\x2b\xef\x30\x8e\x7b\x8b\xfc\x3b\x7f\x43\xd7\x12\xe3\xa2\xb9\x33\xea\x7b\xfd\x9b\x71\x63\xfc\x30\xd9\x69\x51\x9c\xd3\x5e\xcf\xdd\xe3\xeb\xad\xad\x46\xb8\x27\x8f\x1b\x8d\xb6\x75\xbd\xd6\x6c\x9a\xd5\x5d\xf4\xf0\x70\x32\x39\x9a\xce\xce\xc6\x37\x9d\xee\xa0\xfa\x7c\x67\xb7\xb7\xeb\x3b\x33\x73\x7a\x3e\x5d\xa1\xfb\x6a\x70\xb0\xda\x5f\x5d\x9b\x3f\x3c\x58\xce\xde\x74\x78\x36\x9e\x8e\x9a\xe6\xf0\xf9\xda\xee\xd6\xee\x8d\xed\xee\x3a\xc7\x53\xe7\xed\x11\xec\xec\x0d\x36\x9f\xd6\xbd\xaa\xe7\x3d\x3c\x5c\x3b\x8e\xd3\x78\xb6\xb3\x73\xb6\x33\x1c\x3e\x5f\xf3\xaa\x9e\xfb\xe6\x66\x64\x13\x78\x67\xa3\xa6\xb9\x76\x63\xad\x6d\xef\x06\x0f\x0f\xee\xf4\x74\x7a\x0f\x6b\xb3\x5e\x77\x30\x7c\xbe\xba\xb6\xb6\x16\xef\xe7\x9b\xde\xd3\xe1\x83\xdf\x5a\x5b\xed\xcf\x1f\x1e\x5c\xc7\x9c\xd6\x67\x6b\xcd\x5e\x7d\x38\x7c\xfe\x6c\xad\xbf\xfb\x30\x7b\x38\x76\xae\xb9\x7d\x4f\xdb\x8e\xed\xe6\x71\xed\x7c\xb3\x41\xc8\x4e\xe7\xba\xbe\xf9\x71\xc7\x39\x6f\x8f\xaf\xce\x57\x77\xce\xaf\x8f\xaf\x3d\x6b\xe7\xe4\x8d\xd3\x7d\xbb\xe5\x1d\x8d\x0f\x86\x63\xcf\xdb\x7c\x7a\xf8\x71\xd2\xdf\xbb\x39\x3f\x3c\x3e\xbd\x9e\x38\xde\xbb\x6e\x93\xd1\x8d\x71\xa3\xd1\x6c\xb5\xb6\x77\xdb\xed\xf3\xd3\xd3\xd3\x49\x74\x7e\x77\x76\xf0\xf9\x35\xe1\x70\x38\x76\x6f\x6e\xf6\xba\x5d\xcb\x42\x7b\x7b\xfb\x6f\x0f\x0e\x7c\xdf\xf7\x9f\xdf\xdd\xdf\x3f\x7d\xd8\x7a\xf8\x88\x90\x7f\x70\x70\x7c\x7c\x77\x37\x0f\x0e\x77\xf7\xf6\xb7\xde\xf5\x7a\xd3\xa3\xc3\x00\x9a\x70\xf8\x74\x75\xad\xbb\x33\xb3\x83\x51\xdf\xdc\x7b\x7a\x76\x7a\x3a\x71\x3d\xaf\xdb\xa8\xf6\x37\x19\xad\x68\x6e\x4e\x26\xad\xd6\xce\x4e\xd8\xaf\x77\x7d\x7f\x6f\x4d\x1d\xb7\xdd\xde\x93\xf7\xd2\xf3\xe6\xc9\xdb\x56\x67\xa5\x23\xfd\xf7\xf6\x79\x67\xde\x69\x59\xbd\x4e\xcb\x7a\xd7\x39\xb0\x6a\x27\x07\x0f\xb5\x5e\x6f\xbb\xf7\x6e\x34\xad\xf5\xed\x95\x77\x83\x60\xb5\x37\xaa\xbf\x79\x77\x55\x5b\x59\xb9\xaa\xad\xd6\xae\xb6\x57\xfb\xf6\x59\x3f\xf9\x5f\x93\xd1\x55\xd2\x59\xab\xdd\x6e\x9f\x1f\xd3\x31\xf5\x3b\xd6\xc7\xad\x37\x6f\x9c\xf6\xe1\xd1\xf1\x78\xba\x19\xe1\x8c\xed\xf0\xce\x4a\xeb\x74\xbe\xd6\x1a\xde\xf7\x5b\x93\xee\xb3\xf6\xe4\x64\xd4\xf6\x1f\xae\xda\xd5\x93\x27\x07\xd5\x6a\xe7\x70\xfb\xf4\xa4\x73\x78\x3a\xef\x8d\xaa\x9d\x5e\xad\x3a\xef\xdb\xa7\x0f\xfd\x51\xf5\xa1\x67\x57\x6b\x3d\xbb\xf6\xae\x6f\xd7\x07\x7d\xfb\xec\xd9\xe8\xc5\xd9\xa0\xff\x62\xe5\xc9\x48\xfc\xef\xc5\xa8\xc6\x68\xf3\xb8\x71\xdc\x1c\xb7\x1f\xa6\xdd\xb6\x35\xed\xb6\x3f\x4e\x8f\x56\xab\x56\x77\xb3\xdd\xdd\x41\x8d\x76\xa3\x4f\x4e\x5a\x73\xbc\xd7\x7e\x66\x1d\xb5\x57\x3f\x76\xfb\xed\xc9\x59\xbf\x3d\x85\xfd\xbe\x63\xf5\x6f\xbc\x69\xff\x99\x77\x63\xb6\xf7\x28\xed\x21\x47\xa2\xc5\xee\xb3\xb1\xd9\xbe\x71\xa6\xed\x1b\xc7\x6a\x23\xc7\x6a\xaf\xba\x56\xbf\xef\x59\xfd\x9b\x9b\x99\xb9\xeb\xdf\xdf\x3c\x43\xb3\x45\xfe\x9b\x6c\xba\xf7\x4d\xb7\x71\x4c\xee\x81\xd1\xfd\x2e\xf9\xaf\xd7\xdd\x6d\xf5\xee\x77\x1b\xc3\xf6\x56\x6f\xb2\xdd\x38\x18\x13\xf4\xdd\x6d\xb5\x86\xc7\xb5\xf6\x64\xfe\xac\xed\x9f\x5c\x1d\x30\x7c\x1d\xbc\xa8\x9e\x1c\xbc\xe8\xbd\xeb\x6c\x6f\xdf\xc5\x77\x4a\x78\x71\xdf\xed\x36\x0e\xba\xcf\x5a\xc3\x87\x51\x6b\x72\xda\x6b\x07\xb5\x5e\xbb\x56\xeb\xb5\x83\x5e\xef\xf8\xc5\x22\x64\xa4\x76\xd2\x39\xac\x3e\x74\x37\xab\xbb\x4d\xbc\x96\x18\x7d\x1f\x8f\x8f\xcf\xfb\xd7\x9b\xcd\xbd\xa1\xb7\xd9\x1c\x7c\xac\x9d\x37\xb7\xde\x4c\x77\x1b\x67\xd7\x47\xc7\xe6\xf5\x7c\xd3\x6a\x79\xf3\xc6\xc3\xd6\xd6\xe4\xb0\xed\x74\x8f\x8f\xbb\xc3\x17\xb3\xdd\xf6\xda\xdd\xdd\xc3\xc3\xea\x76\xdb\xdd\x3e\xed\x1c\x8c\x57\xab\xf6\xe1\xea\xea\x78\x6b\xb8\x37\x7d\xd7\x72\xec\xa3\xc6\x35\x3e\x9f\xad\xc6\x76\x0b\x4f\x61\x7e\xbf\xf5\xa6\xb5\xf3\x66\xff\xa8\x3b\x9c\x8e\x3b\x07\xab\xf3\x66\xbf\x7e\x7a\xbf\x35\x71\xbc\xfe\xdb\x6e\xb7\x3b\x09\xec\xeb\xf1\xc3\x5e\xf7\x6c\xda\x9a\xbe\xfd\xe8\xec\xbd\xed\x76\x87\xd3\x89\xbd\x79\xbd\x6f\x79\xf5\x49\x6b\x7a\xb4\x8b\x8e\xc9\x41\x7d\x83\xf7\x5d\xf3\xe9\x9b\xe3\xc9\xe6\xe9\xe6\x31\x3e\x20\x3b\xed\x83\xd3\xb1\xeb\x5d\xf7\x3b\xdd\x07\xcb\x99\x4e\xb6\xf7\xf7\x0e\xe0\xe9\xf0\x74\xe2\xae\x79\x6b\xf3\x93\x87\x8f\x93\xf6\x9b\xf3\x93\xbd\x83\x63\x73\x34\x9e\x37\x3c\xaf\x33\x3f\x79\xb0\x9c\x37\x6f\xda\x27\x07\xc7\xf0\x6c\x38\x7e\xb1\xb9\x89\x19\xa1\xf6\x01\xde\x42\x5b\x8d\x63\x6b\x5c\xed\xb7\x4e\x1b\xad\xe6\xb0\xb1\xfa\xa6\x31\x79\x58\x3b\xa8\x76\xe7\xc7\x76\x77\x7e\xb8\xfd\x30\xef\xd8\xb5\xf9\xe1\x61\x6d\xed\xb4\xfa\x70\x72\x5c\x3b\xed\x75\x7a\xf7\xf3\xc3\x5e\xad\xd7\xe9\xd5\xe7\xfd\xc3\xde\xe0\xdc\x3e\xe9\x1c\x1e\x9e\x5c\x75\x4e\x7b\x9d\xc3\xed\x5e\xa7\xd7\xab\xae\xe1\xf2\x5e\xf0\xf0\xd0\xb1\xeb\xbd\x4e\x6f\xa5\xd3\xef\xd5\x06\x7d\xbb\x3e\xef\x2f\xf7\xd6\xfa\xb5\x95\x87\xc3\xc3\x33\x5c\x36\x22\xf5\x6a\xb5\xb5\xfe\x8b\x77\x2f\xfa\xc1\xbb\xfe\x38\xea\xe3\xa4\xd3\xa1\x7d\x3c\xf4\x7b\xb5\x67\xe7\xb5\xd3\x77\x9d\xde\x69\xaf\x67\x9f\xf5\x3a\xbd\xb3\x77\x7d\xfb\xec\x6a\xd4\xeb\xbd\xe8\xbf\xe8\xce\x71\xbd\xc3\xc3\x7a\xa7\xd7\xeb\x0d\xfa\xbd\xfa\x55\x2f\xe8\xf5\xfb\x2f\x56\xdf\x75\x7a\xef\x7a\x23\xbb\x7e\x35\xea\x9d\x75\xfa\xcb\x67\x2f\xe0\xf6\xea\x55\xff\xc5\x6a\xe7\x9a\xf4\x5b\x7b\xd1\xab\xe1\x7a\xe7\xa3\xbe\x7d\x3e\x18\xd5\x56\xee\xaf\xbd\xe9\xd4\xbe\x71\x6f\xac\x9b\xa9\x65\xdd\xdc\xdc\x4c\x6f\x82\x69\xfd\x06\xdd\xd4\x06\xee\xcc\xba\x71\x6f\xd0\x9e\x3f\x85\x37\xe8\xe6\x61\xcf\xbf\x59\x46\x68\xfa\x80\x70\x3d\x74\x63\xdd\xf8\xd6\xfd\xcd\x8d\x75\x8f\xfc\x9b\x87\x67\x37\xd6\x03\x0a\x6e\xac\x9b\xd9\xcd\xca\x9e\x8f\x9a\x03\x74\x73\x8b\x82\xd9\xf3\xfd\xbb\xfb\x07\x34\x73\x9a\x37\xe8\xe6\x29\xf2\x67\xb4\xde\xec\x66\x05\xcd\x9d\x37\x68\xf6\x71\xf3\xb9\x67\x39\xfb\xde\xcd\xed\xcd\xec\x66\xfa\x74\x36\x7d\x86\x66\xce\x9b\x83\x60\x5a\x1d\xba\x4f\xd1\xcd\xad\x85\xf6\xef\xd0\xd6\x9e\x3f\x7b\x82\x66\xd6\x72\x75\xd4\xdd\xee\xf4\xde\xbd\x1b\xd9\xdb\x2b\x1f\x7b\xf5\x5e\xff\x45\xbd\x76\x35\x5a\x5b\x1b\xbc\x58\x3d\xbb\x1f\x9c\xcc\x9e\xa0\xab\xdb\xbd\x21\x9c\x3d\xad\xde\x8e\xd7\x1e\xea\xcf\x87\xb3\x41\x3f\x78\xb3\x7f\x38\xdb\x79\xb6\x1c\x2c\x3f\x1f\x3c\xec\x3d\xbc\xb8\x3e\x6a\x3f\xb5\xfb\x6b\x37\xf5\x81\x79\x73\x83\xfa\xb3\x9b\x9b\xe5\xd9\xfd\xc0\xba\xdd\x42\x47\x41\xf3\xb4\xd7\x7b\xf1\xae\xdf\xb3\x57\x1e\x46\xa3\xb3\xce\x68\xb6\xf2\x00\x8f\xce\x5e\xc0\x43\xf7\x06\xdd\xcc\x2c\xb4\x7f\x8b\xc7\xf2\xf1\xcd\x70\x3e\x7d\x3e\x9c\x3d\x34\x86\xef\x46\x7e\xfd\xa3\x7d\xfb\xce\x19\xec\x05\xcd\x95\xda\xc7\xfb\xb7\xc3\xdd\x87\xfa\xed\x60\xee\xbc\x79\x76\x34\x7b\x5b\x1f\x99\x47\xb7\x5b\x0d\xe7\xcd\xc7\x3b\xbc\x9f\x5b\x64\x3f\xb7\x9e\x7b\x0d\xb7\xdb\x42\x0d\xb7\xb1\xda\x38\x6e\xec\xb4\xe1\xf0\xf4\x74\xde\xb8\x99\x77\x37\xab\xad\xed\xfd\x9b\xf6\x5e\xe7\xf4\xe0\xf8\xfc\x7a\x58\x5d\xdb\x9b\x77\xee\x4f\x4f\x5b\xde\x4d\xbb\x7f\x72\xba\xe7\x9f\x8f\x7b\xed\xb5\xdd\xfb\xdd\xfb\x93\xd6\xc4\x73\xf7\xfa\x27\xdd\xd3\x31\xbc\xf6\x7a\x9b\x7b\xe6\xd3\x9d\xd3\x9a\xbd\x8b\x7c\x73\x70\x7a\x3e\xab\x8e\xbd\xcd\xfe\x9e\xd9\xad\x9f\x56\x6d\xef\xe6\x66\x38\x38\x3d\x73\xef\x3a\xe7\xf3\xc6\xfd\xda\xd3\xce\xe4\x61\x32\xd9\xf5\x06\xc7\x67\xdd\xe9\x24\xc0\x7d\xac\x3d\x6d\x4e\xb6\x26\xbb\x7b\x9e\x79\x7c\xda\xad\xba\x41\xcf\x9f\xef\xde\x9b\xcd\x8f\xd5\xc9\x76\xfb\xc6\x3c\xee\x9e\x56\xa7\x5e\x40\xfa\x38\x9b\x6c\x4f\x82\xbd\x83\xc1\xf9\xe9\x59\x7d\x75\x5e\xbb\x9e\xe3\x3e\x26\x2d\x3b\xd8\xdd\x1b\x9e\x9f\x9e\xd6\xab\xc1\xdc\x73\x3f\xce\xad\xcd\xc9\xc3\xc4\xd9\x75\x76\x0f\xba\xb8\x0f\xfb\x70\xd7\x5c\xeb\x5b\xa7\xb8\x8f\xe9\xf9\x51\xb7\x3b\xb9\x09\xec\xfe\x6e\xf7\x61\xf7\xfe\x84\xf4\x71\x7e\x74\xd4\x9d\x4c\xbc\xc0\xdb\xdd\x3b\xd9\xdb\x39\xad\x4d\xbc\x3d\xff\xbc\xd7\x3d\x9b\xde\xcd\x6b\xde\xbc\x3b\xd8\x3a\xc3\xf3\xd8\xdd\x1b\x9a\xdd\xee\xf4\x2e\x98\xdb\xde\xe0\x64\xbf\x57\x6b\x6d\xbb\xfb\x74\x1e\xd3\x20\xe8\xf5\x91\x79\xb3\xb2\x73\xda\xf2\xd0\xae\x79\x7a\xde\xad\xde\xcc\x83\x4e\x1f\x0d\x3e\xbe\x99\xee\x4c\x3c\x0f\x99\xc7\xdd\xb3\xea\xd4\x9b\x77\xfa\x83\x8f\xd6\xca\xce\xd4\x3e\x44\x87\xe6\xe0\xcc\x9c\xae\xce\xd7\xae\xbd\xc1\xe0\x64\xe5\x6c\xea\x1c\xee\xa1\xd1\xe0\xf4\xd4\xc5\xf3\xf0\x3e\x0e\x06\x2b\xbd\xa9\x73\x7b\xdb\xde\xa3\xb8\xb2\x0f\x77\x2d\xd3\x5a\xd9\xa9\x4d\xf7\x02\xb7\x7f\x72\xd6\xb5\x6e\xe6\x76\x7f\xd7\x1c\x6c\xbd\x99\xd6\xed\x9b\x83\xa3\xfe\x49\xf7\x7c\x32\xf5\xe6\xfd\xdd\xee\xc7\x26\xee\x23\xf0\x67\xa6\x79\x66\x4e\xef\xe6\x6b\x9e\xd7\xb5\x9e\xbd\x9b\xd6\x9d\x43\xff\xf0\x6a\x70\x7a\x76\x73\x87\xfb\x38\xe9\x3e\x7b\x77\x76\xe6\x1c\xbd\x9d\x5d\xf5\xce\x1a\x8d\xad\xc6\xb8\x71\xd0\x38\x6e\x37\x76\xfb\xe6\xd6\xf1\x64\xf7\xb4\x41\x68\x5e\x6b\xa7\x7d\xea\xaf\xed\x9a\x9d\xcd\xfb\x87\xad\xc9\x4d\xf3\x74\xff\xe0\xa8\x0b\xbd\xe1\x64\xde\xdc\xeb\xda\xbd\x87\x56\xab\xbd\x3b\x78\x87\x91\xe8\xcd\x47\xe7\x6b\xcd\xe6\x99\xbd\x3d\xf1\x6f\xf6\x87\xbd\x33\xf3\x7e\xe2\x61\x3c\xec\x1f\x37\x1a\x2e\xa3\x79\x9b\x93\xfa\xee\xff\xc3\xde\x9b\x36\xb9\x8a\x6c\x09\x82\xdf\xc7\x6c\xfe\x83\x32\xda\xac\x2d\x6e\x29\x32\x40\x80\xb6\x7c\x7d\xab\xc6\xd9\xc4\x22\x84\x58\x25\xc8\x4a\x7b\x86\x00\x01\x62\x15\x8b\x58\x5e\xbd\xf9\xed\x63\x48\x8a\x7d\xb9\x71\xb3\xde\x74\x55\x75\xf7\x35\xbb\x16\xc8\x71\x3f\x7e\xce\xf1\xe3\x67\x73\xc7\xbd\x91\x28\x9e\xd4\x43\x0e\x50\x52\x5f\xb6\x60\x7b\x81\x03\x86\x99\xc9\x01\x09\xe3\x41\x48\x2f\x04\x89\x3d\x6a\x46\x2f\x1f\x99\x2c\x1f\xfa\xf1\x5c\x30\x82\x28\x05\xa9\x69\xcc\xc6\x38\x4f\xc4\x94\x46\xa5\x47\xde\xd0\x24\x25\x0c\xa3\x4c\xea\x1d\x84\xd4\xee\x8d\xec\x6e\x45\x68\x1b\xbc\xf5\x60\x0e\xe8\x40\x03\x5a\x1f\xa7\xf5\x3d\x08\x86\xe1\x39\xb4\xd4\xf2\xfe\x82\x52\xa9\x34\x39\x1a\x94\xb4\xf1\xaa\xd2\x71\x4c\xc5\x9a\x6c\xe9\x8e\xce\x8e\xf9\x4e\x35\x24\x10\x94\xbe\x63\xb4\x4a\xbb\x5d\x8c\xe8\x2c\xce\x6d\xc5\xd8\x04\x05\x00\x62\x07\x80\x44\x16\x35\xc5\xa6\xab\x85\x14\x01\x0e\x30\x80\x02\x46\xda\xc8\x87\xee\x10\x25\xd4\x62\x29\x88\xae\x67\xaf\x80\xd7\x8c\xfd\x96\x22\x83\x7c\x91\xb0\x82\x28\x79\x9e\xde\xe3\x4c\x10\x0b\xaa\x23\xc3\xde\xd7\x91\xa4\xca\xb0\xfd\x3e\x7e\xe2\x17\x24\x45\xb1\x6c\x66\xaa\x8a\x24\x79\xbe\x4f\x73\xfc\x44\x21\x28\x8a\x62\xb3\x6c\x2b\x49\x92\x17\xf8\x11\xcd\xf6\x26\xe3\x78\x26\x6e\x89\xcb\x26\x23\x12\xa1\x85\xf7\xf6\x8e\x00\x4c\x78\xb6\x77\xca\x21\x64\x52\x53\x5e\xf0\xb6\xe1\x45\x1c\xa7\xf4\x38\x51\x54\xc6\x1d\xb7\xac\x20\x05\x61\xd6\xe8\xc6\x18\x9f\x2c\xb4\x88\xce\x0b\x96\x53\x7b\xd9\x1e\x39\x3e\xce\x5b\x0a\xba\xd8\x84\x2b\x3e\xb7\x75\xc3\x9a\x60\x7e\xe6\x98\x41\x4e\x6e\xb7\x23\xfa\x28\x94\xae\x65\x59\x70\xda\xcb\x7b\xde\xed\x42\x00\x56\x35\x8d\xd7\x44\x0d\x9a\x92\x5e\x05\xb6\x41\x28\x29\x6c\x12\x1a\x08\x81\x0d\x3c\x82\x08\xe9\x85\xd8\xf3\x58\x33\xd2\xb4\x19\x8f\x1b\x92\x20\x12\x3a\x66\xd6\x22\x6f\x19\xc6\xc5\x8f\xee\x7d\xbb\xde\x07\x17\x05\xe1\xea\x5b\x37\x99\x7f\xf6\xcb\x99\x07\x7f\x1b\x7b\xe9\xab\xbf\xf4\xc1\x43\x00\x88\xac\xee\xcd\x67\x41\xd3\xa1\x8d\xf1\x72\x4a\xf5\x7e\x24\x0b\x8e\xbd\xef\x8a\xd3\x1b\x23\xed\x63\x6d\x6f\x47\x5c\x63\x6d\x41\x33\x94\x4b\x99\x7f\xd8\x3d\x94\xd9\x96\xf1\x50\x76\x78\x2c\x33\xde\xc4\xe9\x5f\x2a\xa3\xcb\x3c\xdf\x6d\x37\x9b\xb8\x2a\x7b\xbf\xdb\x9a\x20\xa3\x51\x94\xe5\xb9\x84\x83\x53\x9d\x32\x60\x3d\x63\x16\xa3\xcb\x88\x11\x6d\x7d\xe6\x17\x05\x30\x00\xf0\x03\x19\x33\xa2\x28\x4a\x86\xe3\xb3\x46\x36\xe1\x15\xaa\x17\x8d\x54\x5b\xf1\xa2\xe2\x45\x3e\x8d\xf3\xbc\x12\x75\x6a\x98\xf1\x99\xa5\x68\x9b\xf0\x68\xda\x23\x4e\xe1\x83\x4d\x14\x45\xbd\x8e\x56\xb5\x4d\x98\x9e\xfb\xc4\xdb\x4d\x34\x8a\xb8\x63\x8f\x87\xe8\x05\x51\xb9\x92\xcf\x78\x20\x14\xcd\xf2\xd6\x76\xb3\x49\x8b\xb2\x89\x70\x5e\x39\x22\x23\x64\xc1\x0b\x2b\xc3\x54\xb4\xf8\x58\x36\x8e\x1f\xe4\xe4\x22\x84\x23\xae\x58\xf6\x74\x4d\x5a\x3f\x5a\x99\x96\xd5\xf5\xb4\x16\x25\xbf\xdb\x6e\x8c\xb4\x1d\xf5\xe3\xaf\x58\xf1\x68\x14\xf3\xcb\x95\x63\x5a\x44\x4d\x7a\xbb\x04\x00\x8f\x0a\x6a\xdb\xb0\xf4\x6c\xb9\x26\x60\xf7\x2a\x8f\x04\x85\x03\x20\x13\x97\x71\x16\x24\xc9\xf3\x7c\xad\x97\x87\x09\x71\x96\x73\x56\x93\x79\xc1\x35\x6c\xe7\x92\x3b\xa0\x0e\x24\x93\xa4\xec\xa5\x9e\xcf\x81\x66\xec\x37\x30\x75\x89\x07\x24\xb1\xb2\x1f\xe6\x08\xa5\x85\x79\xc2\x30\xd2\xb9\x5e\x44\xe3\xc4\x75\x8e\xb0\xa9\x21\x2b\x4a\x10\x3a\x7d\x3d\xfe\x5c\x2f\xcd\xb8\x7e\x7e\x79\x71\x14\xd1\x1c\xcf\x5b\x9b\x6b\x3d\x49\x51\x82\x38\x8a\xce\xf0\xae\xb1\x91\x29\x29\x17\x78\xe7\x7a\x9a\xd6\xf3\xdf\x94\xa5\x73\x3d\x9a\xe3\x7b\xfc\x2e\xf5\x64\x45\xf1\x42\xff\x3c\x26\xd6\xa5\x2d\xfb\x00\xef\xdc\x56\xeb\xe7\x20\x89\xd7\x04\x00\xc4\x3a\xe5\xf8\x35\xcf\xc6\x35\x8c\xd9\x2c\xa9\x2d\x0e\xac\xe2\x11\x80\x04\x00\xd8\x8e\x7f\x9c\xb4\xc9\xa6\x25\x48\x3a\x61\x95\x8d\x82\x29\x92\xed\xe3\xc1\xd2\xa7\x95\xe0\x10\x31\xc5\x46\x67\x17\xb6\x66\x8c\x4c\xa9\xc9\x64\x59\xd5\xe2\x53\x9a\x2c\x78\x41\xd3\x86\x8e\x01\xa7\x4d\x3d\x69\x35\x3d\x4c\xd3\xd0\x58\x2a\xf6\xb1\x1d\x39\x45\x8d\xf7\xce\x3d\x01\x40\x4d\x11\x54\xe7\x69\x00\xa7\xf1\x03\xdb\xe3\xe2\x03\x29\x03\x3c\x51\xd7\x2b\xd0\x6c\x81\x44\x8d\x71\xb6\x25\xbc\x9a\x6f\x30\xb9\x83\xe3\x90\x04\x38\x41\xb5\xa0\x95\x03\xda\x13\x28\x5f\x3c\x7a\x16\x17\x88\x1e\x6e\x6c\x17\x24\x8b\x63\x40\x38\x18\x08\x1c\xd4\xa7\x54\x28\x00\xbd\x75\x9a\x11\xcb\x4a\x1e\x4e\x2c\xd8\x46\xa2\xb4\x90\x25\x48\x0a\x19\x63\x98\x37\x5a\x57\x02\xc5\x89\xe1\x26\x22\xbd\x4b\x80\x86\x01\x00\x18\x0f\x80\xd0\x83\x3d\x36\xe0\x2d\xd1\xc0\xc4\x43\x3d\x94\x28\x8e\x92\x43\x9f\x57\xa9\xf1\x35\xaf\xb2\x04\xb8\x07\x66\xc0\x23\x24\x3a\x60\x03\xd6\x4c\x27\x87\x7a\x28\x90\x8f\xd5\x80\x14\x3d\xcf\xf9\x11\xe0\xda\x01\xb8\xc4\x52\x04\xf0\xd7\x26\xe2\xa8\x00\x48\x86\x58\x5f\xdf\x88\x8b\x6c\x17\xa6\xe7\xf0\x25\xb6\xa7\x18\x29\x7b\xed\xea\x20\x4c\xe6\x2a\xe8\x44\x35\x9c\x62\x4e\xb2\xac\x76\x6e\x5d\xec\x2c\x2f\x36\x83\x55\x86\x39\x30\xbf\x2b\xa8\x46\x31\x20\x16\xdd\x6a\x6a\xcd\x79\xa1\x6b\x3a\xc9\x88\x31\xb0\x2e\x3d\xa0\x28\x74\x9c\x93\x74\x79\x42\xb5\x60\x04\x1d\xe2\x66\x1a\x11\x5a\xb9\x2e\x47\x33\xa8\x30\xf6\x73\x21\xf4\x40\x01\x16\x32\xee\xa0\x9e\xb0\x58\x63\x85\x36\x3c\x12\x6b\x03\x6f\xe7\x7a\x0e\x50\xe3\xc8\xa5\xc5\x06\xdd\x20\x90\xbb\x1f\x05\x22\x54\xa1\xb6\x37\x33\x17\xd0\x78\x7a\xd0\x86\xd9\x3a\xde\x2d\x28\x4e\xf3\xc2\x7d\x62\xb1\xe8\x9c\xdc\xad\x8f\x8d\xe3\x98\x99\xbf\x13\xe4\x98\xb0\xc7\x39\x1f\x85\x9a\x10\xb6\xcd\x2c\x60\xd3\xca\x1b\x3b\xdc\x69\x24\x1a\xcc\xdc\x1a\xcf\xed\x21\xba\x70\x4f\x47\x7b\x6a\x22\x5b\xda\x21\x0f\x4b\xe8\xc8\x74\xa5\xbc\x3f\x6c\xa8\x4d\xc3\x41\x56\xb0\x70\xbb\x43\xea\x57\x73\xa6\x20\x4c\x47\xc2\xf9\x5d\xc0\xc0\x7a\xa9\x41\x68\x3c\x1b\x47\xe4\x02\x51\xa1\x61\x13\x9a\xb6\x91\x6c\x87\xd4\xd0\x3a\x76\x27\xa8\x51\x3d\x31\x55\xe9\xc5\xb0\x99\x2a\xab\xa3\x84\xad\x86\x29\x00\xb8\xd2\x54\xfa\xb6\x9e\x4e\x39\x67\xbc\x8b\x63\x35\xae\x38\x2b\xdc\x4e\xc7\xf0\x9c\x61\xe0\x70\x61\xf0\x27\x20\xaf\xb3\x7a\x2f\x2a\x60\xdd\x2a\x6b\x60\x23\x54\x98\xb8\x64\x8d\x23\x4c\x0d\x00\x17\x49\xa4\xc1\x8f\xc7\xb3\xc9\x6a\x3a\x5f\x70\xc4\xa1\xc1\x86\xd9\xc4\x22\x35\x07\x99\x6e\x95\x93\xdc\x6e\x97\x5c\xc8\x12\x63\xc3\x1c\x1d\x96\xa7\x71\x94\x89\xbe\xa9\x76\x70\x31\x24\xe3\x84\x1e\x39\x41\x56\xe6\x89\x30\xd1\x48\x8f\xdd\x8c\x46\x5b\x7f\x66\x33\xaa\x03\x43\x4a\xbc\xf3\x44\xe0\x34\x76\xbb\xa9\x85\x3e\x16\xcb\x4d\x74\xbf\x52\xf6\xe6\xae\xe3\x03\x23\xe7\xb1\x61\xb9\x3e\x96\x89\xa0\x2f\x45\x77\x23\x2e\x90\x50\x3f\x69\xc6\x30\x43\xd6\x1c\xe4\x87\x44\x34\xb6\x68\x59\xc4\xd4\xe5\xc2\x58\x9b\x36\xab\xa1\x27\x43\x18\xf9\xa9\xaf\x60\x07\x86\x8e\x92\x76\x03\x21\xea\x98\x9b\x86\x8b\x42\xf1\xb7\x1b\x75\xb6\x82\xc7\xfa\x10\x25\xa0\xc5\xfa\xc8\x8d\x24\x67\x13\x14\x8b\x75\x43\x75\xcc\x71\xcb\x2a\xf8\x9a\x0d\x92\x0d\xa9\x95\x53\xd6\xe5\x4e\xd0\x7e\x2c\x96\x1d\xde\x64\x6a\x67\x9a\xd2\x90\x30\x28\x9f\xd9\xee\x8f\x0b\xcc\x02\xb8\x14\x05\x53\x64\xc1\xf9\x2b\x2c\xe5\x4e\x43\x7f\x6b\x83\x0c\x70\xbd\xf7\xbd\x99\x2d\x90\xdc\x02\x5b\x6c\xa5\xd9\x00\x4f\xf4\x72\x3a\x25\x3a\x13\xe0\xc3\x65\xc1\xd8\xba\x3b\x94\x30\x7f\x28\xe1\xa3\xda\x87\xcc\x9c\x53\xd7\x8d\x82\x3b\xec\x2c\xf6\x54\x17\x38\x6a\x21\x89\x29\xad\x51\x89\x37\xc7\x19\x5b\x5f\xfb\x8d\x22\xcb\x46\xc8\x6f\x4c\x6e\x2b\xd2\xc6\x7a\xa2\x63\x80\xca\x83\x8c\x4b\xa9\xc3\xd6\x03\x48\xcd\xc9\x26\xa3\x50\x4d\xcc\x91\xf1\x8c\x86\xc1\xc4\xa3\xb6\x85\x37\xb6\x30\xb3\x00\x4b\xb6\xcc\x05\x7c\x3a\xdc\xfb\x53\x8d\x5f\x9e\x8c\x8d\xe2\x94\x05\x59\xd3\xfb\x51\xdc\x19\x9d\x32\xa2\x67\xc8\x6a\xe4\x23\x62\x5b\x22\xee\x74\x82\x8f\x24\x47\x81\x15\x50\x48\x81\xb7\x5c\x0b\xa2\xc7\x29\x73\x4e\x26\xa6\x3e\xbd\x05\x93\x50\x2b\x96\xf4\x8a\x27\xc7\x36\x18\xdb\xaa\x7c\xf2\x94\xe3\xda\x46\xe9\x68\x3e\x6f\x36\xa8\xa4\x06\x90\xc0\xc8\x33\x92\x8a\x27\x9b\xe4\x64\xe9\x12\x50\x6b\xf9\x28\xf3\x87\xb6\x96\xe6\x78\x9e\xfb\x95\x27\x23\x80\x57\x8b\xb5\xe4\x4a\x76\x98\x03\xd1\x23\x45\x7f\xa7\x52\x4a\x9b\x6f\xa5\x31\x4c\x84\xab\x6c\xeb\x94\x9b\xc3\x66\xba\x41\x20\x54\x3b\xb8\x5b\x54\x9b\x8f\x3d\xd6\xb1\xa2\xbd\x0c\x52\x10\x1f\x35\x2a\xa8\xb3\x31\xea\x13\x8e\x4d\xd4\x91\x87\x4d\xf7\xf6\x6e\xdf\x45\xb1\x24\x00\xcf\xc2\x43\x7f\x6a\x0f\xf7\x36\xe9\xd1\xc3\x60\x5f\x93\xdb\x7d\xe7\x7a\xcb\xb5\xbd\x34\xb8\x02\x00\x4e\x02\xb9\xba\x3d\x24\x3e\x54\x6d\x17\x2d\x7a\x1a\x31\x21\x9a\xe9\x13\x08\x2d\xc7\x79\xb5\x99\x8d\xf6\x93\x2c\xdf\xef\x04\x44\x42\x37\x2b\xbd\x9d\xcf\xf0\x5a\x2b\x77\x44\xed\xd3\x3e\xd8\x54\x6b\xf7\x34\x11\x5d\x08\x33\x2d\x5a\xd2\x6c\x8f\xcf\xf5\x02\x73\xdc\x7d\xe7\x8f\x54\x40\x82\x11\x49\xf9\xe3\x1d\xaa\x47\xa4\x45\x11\xf5\x78\x37\xdc\xad\xf7\x21\x9f\x16\x68\xc9\x82\xca\x45\x4f\x9c\x29\x06\x3e\xea\x59\x68\x34\x11\xd1\xb5\xb2\x67\xc3\x7c\x79\x82\xd7\x40\x99\x4d\xd6\x6b\x67\x39\xf5\x66\xa9\x8d\xf0\xa5\xbb\xa2\x79\xbe\xe3\x65\xd7\x17\x4f\x88\xc7\xa5\xf4\x12\x5f\x6f\x63\x6b\x7b\x5a\xe3\x12\xbb\xf4\xb8\x34\x81\x77\xbb\x0d\x5e\x74\xb9\x61\x1a\x46\x4d\x4d\x0f\x11\x72\x18\x9e\x54\x47\x9b\xea\x59\x88\x8c\x96\x81\x06\x4b\xd6\x61\x1d\xb6\x35\x00\xcc\x71\xa7\x11\x30\x64\x14\x86\xe8\xec\x30\x77\x82\x8b\xd9\x94\x82\x6d\x22\x80\x31\x90\x42\xaa\x47\xce\x4c\x1d\x08\x81\x33\xf1\x21\x30\x63\x5d\x7c\x15\x66\x13\xa8\x29\x00\x20\x4c\x6a\x49\x19\x6c\x38\x6c\x4f\xec\x44\xea\x46\xab\xd5\x24\x62\xf7\x25\x0e\x4d\x58\x61\x73\xd8\x70\x2b\xf1\xb8\x56\x05\xd7\x01\x71\x6b\x1d\x26\x54\x01\x4b\x78\x18\x71\x59\xa0\x6c\x36\x4c\x22\x21\x44\x6a\xec\x16\x1b\x10\x97\x43\xd8\x5b\x4a\x38\xcd\x13\x78\x92\x21\x92\xa6\x49\x26\x34\x2a\xfd\xd2\x24\x71\x4e\xcb\xe8\x8d\x31\x43\x3a\x14\x4b\xed\x34\xc7\x27\x48\xba\x9d\x1e\xf1\x71\x0b\xa7\x6b\x70\x82\xf6\x69\xc3\x20\xe3\x5a\x8f\x3d\x96\xd9\x23\x0d\x2d\xe6\xb3\xc8\x35\x6d\xfc\x38\xe2\x1b\xd4\x3c\x11\xc7\x39\xee\x59\x13\x74\x0e\x9a\x8a\xa9\xd6\xab\x25\x34\x1d\x19\x04\x8d\x51\x6d\xcd\x65\x47\x86\x06\xdb\xc9\x96\x86\x8b\x83\xbe\xab\xc0\x2a\x39\x9d\x9c\xa5\x9d\x57\x7b\xa3\x16\x05\x28\x20\xad\x49\x00\xb0\xc9\x0c\x18\x00\xe0\x93\x78\x85\x0b\x5b\xdb\x53\xc9\x19\xa3\xc8\x47\x0e\x3b\xd5\x33\xe2\x00\x22\x82\x5a\x03\x02\x28\xd1\x16\x02\xeb\x7a\x2d\x72\x7c\x34\x6f\x7a\x9b\xb6\x8e\x13\xf7\x84\xb8\x9b\x0c\x45\xb7\x5e\x5b\xa3\xe4\x69\xdf\x4d\xe2\x76\x11\xa2\x69\xbb\x9e\x19\x2a\x5f\x10\xe2\xa9\x03\x1e\x58\x4a\x01\x9c\x8e\xec\xc9\xaa\x2b\x10\x11\x59\x7b\x88\x8f\x01\x82\xe5\x80\x07\x16\x6b\xd8\x5a\x25\xe3\x06\xc6\x49\x4f\xdf\x33\xd3\x0a\x51\xcb\x76\x4f\xda\x32\x5b\x19\xa0\x95\x7c\x9c\x86\xa2\x93\x22\x83\xb2\xf4\xc0\x44\x5d\xef\x36\x26\x98\x7b\x96\x96\x9b\x1b\xa0\x51\x00\x0c\xc9\x06\x9b\x4a\x28\x94\xcf\xa6\x4c\xa3\x69\x47\x33\xc6\x61\x3c\xd6\x2a\x21\x4a\x0f\xf4\x81\x29\x47\x1e\x59\x25\xc9\xa9\xda\xce\x04\x36\x2e\x0e\xca\x71\xbc\x09\x3b\xa5\x5b\x28\x13\x44\xa4\xd8\x48\xac\xf6\x9b\x8d\xdb\x35\x9b\xec\x34\xa1\x71\x8f\xf4\x38\x3d\x2a\xf7\x5b\xda\x28\x57\x00\x24\x47\x0d\x6e\xa4\x94\x84\x8d\x65\xb0\x4d\xc7\x36\x3d\x96\x74\x8c\x48\x8c\x51\x89\x2f\x41\x68\x12\x22\xc0\x81\xbf\x0b\x21\x20\x0c\x21\x50\x2b\x04\xe1\x44\x1b\x00\x80\x60\xd3\x07\x65\x9a\xd6\xbb\xd9\x42\x27\xbb\x93\x4b\x06\xe6\xa9\x4b\x76\x25\x52\xd2\xd8\x6e\x39\x4e\x9d\xd5\xc8\xe0\xa3\xd9\x4c\x05\x14\xc0\x09\xf3\x54\xed\x8f\x73\x83\x50\xf1\x52\xa8\x75\x20\x69\x14\xa8\x17\xe5\x2a\x2a\x3b\xc5\x62\x4e\x80\x34\x08\x54\x6d\x96\xfa\xe1\x18\xc8\x60\x28\x9a\x40\xf0\x3b\xfb\x48\x79\xb2\x0d\xc4\x3a\x25\xbc\x93\xa3\x36\x85\xca\x2c\x25\x8a\x9e\x0c\x53\x71\x87\x0c\xd7\x80\x9d\xd9\xc1\x5a\xb2\xb7\xde\x34\x13\x8d\xe1\xf2\xd0\x48\xca\x09\xd9\x1f\x62\xba\x3a\xa0\x98\xb7\xa8\x3b\x74\x04\x43\xbb\x05\x3f\xe9\xd0\xc6\x53\x67\xb3\xa9\x9b\xc6\x1b\x6d\x49\x59\xa4\x03\x63\x35\xe3\x96\x46\xe3\x1c\x6c\x4f\x47\x94\xe6\xa4\xd4\x09\xac\xa7\xe6\x96\x93\x8f\xa1\x4c\x8c\x81\x22\x41\x71\xa5\xa7\x40\x2b\xb6\x53\xe0\x01\x49\xc0\x57\x96\x5e\x03\x10\x01\x5c\x6e\x54\x68\xb4\x8e\xf7\xc7\xe5\x51\x91\x57\xe4\xce\xdf\xee\xe0\xe9\xce\xcd\x33\x1c\xdd\xcd\xb1\x43\x56\x6d\x28\xf3\x80\x13\x24\x22\xda\xce\x96\xce\x08\x9e\xf2\x08\x72\x6f\xd3\x6b\xa1\x9e\x01\x00\x48\xad\x56\xb1\x28\xd1\xe2\x31\x1c\xc5\x75\x9e\x09\x82\xbf\x96\x58\xfe\x30\xa9\x60\x6a\xb6\xcf\x91\xd3\x02\x4f\x3c\x61\xe5\xb0\x51\x6e\x16\x81\x77\xb0\xfc\xe8\x50\x39\x13\x40\xeb\xde\xb0\xec\xb6\xb5\x96\x6c\x97\xa8\xca\x2d\x33\xeb\x60\x6d\x78\x80\xe9\xe7\x95\xd7\x80\xde\x1f\x39\x8f\x07\x26\x3c\xdb\xe6\xa5\x82\x3a\x4d\x29\xad\x0c\x3b\x5b\x9f\x0c\x6a\x31\x23\xa2\x53\x2e\xc9\x9c\x87\xbb\x99\x5f\x27\xab\x0d\x77\x28\x17\xc7\x38\x9b\xe8\xd4\x5a\x39\xe1\xee\x94\xc2\x03\x0d\x3d\x7a\xdc\x4e\x02\x35\x89\x2d\xdd\xd9\x12\x08\xa4\xe0\x33\xbb\x15\x00\x20\xf2\xf8\x61\x49\x17\x63\x71\x68\xb4\x9c\x3d\x6f\x89\x84\xb3\xd0\xbc\x59\xae\xab\x68\x91\x34\x75\xb1\xd5\xe7\x74\x11\x22\x21\xb6\x0e\x0a\x02\x30\xc4\x8c\x0e\xeb\xdd\x82\x9a\x7b\xdc\x39\x2f\x90\xb4\x61\x71\x80\x09\x87\x16\xcc\xe5\xd2\x4b\xbb\x99\x30\xa4\x9b\x2e\xa8\x0d\xdc\x63\x9b\x61\xc7\x04\x04\x0e\x78\x10\xe1\xfc\x2a\xa3\x47\x85\x38\xe7\x32\xdd\x6e\xa9\x60\x6e\xe5\xa3\xb1\x11\x31\x5e\x5e\x95\xe3\xfd\x9a\x4b\x42\x87\x9b\x9c\xa8\xda\x5c\xb7\x80\x91\x70\x96\x22\xb5\x3c\x12\x6c\x1c\x00\x07\xe1\x81\x02\x4b\xc7\x21\x57\xcb\x05\x85\x01\xce\x2c\x85\x04\x90\x93\x45\x22\x1a\xb5\x11\x89\xac\x79\x2a\x3b\x6d\xe5\x1c\x72\x17\x9f\xee\x03\x21\xdc\xb0\x30\x11\xe3\xf8\x04\xb0\x80\xb7\xd1\x19\x98\xa7\x05\x45\x47\xaa\xb2\xa0\x08\x7c\x68\xef\x5c\x99\x24\x60\x4b\xce\x96\xcc\x71\xb5\xce\x22\x9b\x87\xa6\x93\x65\x93\x20\x79\x16\x1f\xdb\x6d\x61\x70\x1b\x39\x80\x68\xe9\xbc\x7e\x28\x85\x73\x81\x00\x62\xb0\xcb\x25\x5c\x02\x24\x41\x16\xc7\x2c\x4d\xd7\x55\xe9\x0c\xe1\x31\xe1\xce\xbd\x49\x40\x39\x81\xb1\xf5\xf4\x58\x02\x2c\x36\xc4\xea\x22\xa4\x70\x2a\xc0\xa3\x74\x25\x4d\xf8\x00\x86\x78\x55\x82\xa5\xc3\x66\x7f\x68\xba\x60\x08\xdc\x6a\xcb\xa7\xc2\x81\xd2\xf7\x92\x68\x76\x39\xdc\xce\xb1\xd1\x71\xe9\x09\x08\xa0\x9a\xd3\xce\x14\x8f\x76\x63\x4e\xb8\x49\x98\x47\xf9\x01\x6b\x91\xc3\x1c\x38\x1e\x4f\x36\x93\x45\xc2\x15\xfa\xd2\xdf\xd9\x18\x52\x1d\xc7\x33\x6c\x98\x56\x8a\xb3\xc2\xb3\x94\x08\x08\x9d\xc9\xbb\xe1\xa6\xd3\x00\x44\x92\x25\x39\x03\xaa\x07\x50\x1f\x8e\x04\x02\x80\xce\xa6\x4f\x43\x65\xb8\x57\x94\x55\xe8\x1a\x9b\x48\xcd\x76\x28\x16\xef\x11\x79\x1f\x1f\x8f\x9c\x2b\xb8\x61\xe4\x33\x60\x5f\x4d\xf5\x14\x50\x80\xf0\x00\xc8\x78\x39\x2c\x97\x43\x3e\x94\xc8\x8d\x50\x1b\x84\x76\x5e\xd3\x27\xf8\x22\x9b\xc1\x8d\x9f\xcd\x47\xda\xe1\x28\xe1\x35\xda\x60\x63\xdb\x2d\x8f\xe1\x41\xa2\xda\x0a\x5d\xcc\xf1\xc9\x6a\x28\x4f\xc6\xb0\x76\x9c\x89\x7a\xe0\x4d\x6b\x31\x9e\x6c\xaa\x04\x76\x2d\x2c\xe2\xc9\x75\x6a\xed\xb8\x62\x62\x2d\x8f\xde\xce\x5f\x77\xd9\x09\xe3\x65\x2c\x10\xc9\x20\xaa\x67\x33\x33\x33\xa6\xc7\x11\x2b\x0a\xf6\x0e\x28\x40\x04\x7a\x19\xdb\x44\x92\xee\xec\x3c\x5c\x60\xfc\x42\x99\x42\x66\x52\x84\xfa\x46\x5e\xd1\x73\x0e\x96\x35\x86\x17\x13\xbc\x6d\xe7\x1b\x30\x23\x4f\x93\x65\x1f\xb5\xc8\x02\x21\x69\x00\x5b\xe4\x41\xb9\xd7\x05\x24\xb2\xcd\x3d\x83\x4a\xa8\x0a\x9d\x4c\x6c\xca\x21\x06\xe3\xdb\x31\x6e\x75\x87\xbd\xd3\x56\x3a\x32\xf6\x96\xa0\xcb\x6d\xd8\xae\xaf\xbb\x17\xfc\xc9\xda\xe9\x9f\x17\xed\xe6\x40\x6f\xb0\x56\xb1\x0f\xd6\xc6\x42\xe2\x85\x9d\xee\x99\x66\xd3\xb8\x35\x0f\x96\x5e\xb4\xc1\xb3\xa5\x76\xaa\xeb\x70\xa8\x89\xfe\xd8\x5d\x2d\x5a\x4d\x2c\x46\x10\x45\x0f\x33\x33\x1f\x56\xce\x58\xec\xd2\x45\x6d\x38\x00\xe0\x1e\x92\x8d\x91\x65\xa0\x78\xc0\x80\x4c\x3d\x12\xe3\x20\xa2\x3c\x26\x99\x2c\x50\xd1\xae\x8d\x43\xc7\x4e\x4f\xe2\x61\x54\x4e\x9a\xa2\xc1\x14\x34\xc2\x8d\xf9\x84\xd2\x24\x9c\x9e\x00\x16\x07\xf1\xda\x60\xb0\x75\xba\x30\x88\x94\x03\x80\xdc\xb8\x43\xd1\xe4\xcc\x60\x0a\x35\xd3\x09\xd4\x32\xd3\x65\xb7\x9f\x0b\xe3\x6d\x27\xf3\x09\xbd\x8e\x4f\xae\x50\x04\x91\xc4\xd4\xfa\x75\xcd\x1b\x5d\x4a\xe4\x6c\x4d\xf4\x21\x17\xe9\x59\xb0\x54\x1d\xf0\x65\x5b\x77\x2e\x87\x59\x13\x13\xa2\x64\x1d\x07\x5a\x04\x28\xf2\x78\x4a\x85\x29\x2e\xe1\xa0\x02\x5e\xd5\xd1\x47\x76\x59\xc6\x07\x06\xe5\x1d\xac\xde\xf3\xe9\x2e\x11\x7d\x80\x76\xb3\xf1\x71\x93\xca\xeb\x78\xee\xd7\xa9\xc8\xa4\x24\x00\x11\xb1\xab\x49\x00\xc6\x13\x9a\x01\xd5\x76\x1f\x31\x29\xba\x77\x91\xb2\x5c\xcc\xb7\x1a\xe9\x82\xb9\x11\x1b\x04\x2e\xc1\xe9\x3a\x87\x46\x12\x31\x63\x4f\x15\xd1\xf3\x36\xdb\x69\x40\x22\x78\x04\x11\x62\x0a\x15\xa7\x33\x1d\xec\x18\x49\x9d\xaf\xd9\xf5\x2a\x55\xe7\xdd\xca\x46\x23\x44\x74\xa7\x2a\xd0\x97\x60\x85\x57\x7b\x75\x26\x9c\x97\xf1\x17\x9d\x8a\xad\x95\x59\xff\x9c\x52\xcb\x05\xb3\x43\x2a\x8b\xa8\x6b\xea\x34\xa2\x36\x3e\x9d\x07\x5a\xb8\x03\x16\x30\x91\x29\xb4\xd7\xcd\x44\x35\xb7\xbb\x4d\x87\xe2\xf5\x26\x3b\x68\x96\x60\xf2\xf2\x61\xea\x98\x30\x07\x41\x9e\xe6\xaa\x4e\xa6\x53\x35\x00\xa6\x48\x17\xab\x86\x07\x07\x49\xf1\xc0\x10\xe0\xeb\xd4\x98\x71\x87\x71\x8b\xd4\x07\x77\x36\x3a\x14\x5b\x1b\x1a\xa9\xa2\xb4\x51\x49\xc3\xc4\x63\x10\xae\xc8\xe0\xa8\xd7\x15\x40\x56\xdc\xbc\xc7\x83\xc2\x79\x50\xce\xf5\xbc\xd8\x17\x10\xb2\x1a\xcd\x05\x71\x3e\x52\xe6\xb9\x33\xa3\x18\xd5\x64\x92\x8e\x18\x0b\x46\xbc\x97\x28\x40\xb4\x10\x8d\xdb\xfd\x38\x80\xb1\x8d\x79\x01\xd6\xcd\x64\xb0\x2a\xa0\x78\x42\xaf\xb6\x7a\x3a\x27\x75\x6c\xc2\x2d\x46\x38\x4e\x56\xe1\x31\xaa\x21\xdd\x1a\x2f\xa6\xd2\xae\x5a\xe8\x0a\x3b\x44\x2c\xd3\x4a\x97\x5b\x2e\x77\xf4\x03\xd2\x45\xc4\xdc\x1c\x4b\x14\xa0\xe8\x0e\x1a\x4b\x9d\xa7\x9b\x17\xe1\x85\xd2\xad\xdd\xb4\xc4\x64\xb3\x57\xab\x21\x3c\xd7\x5d\xc8\x9e\x4e\x26\x1c\xa6\xab\x60\xa1\xd1\x31\x18\x8f\x86\x3a\x58\xf1\x01\x24\xad\xd3\x75\x6d\x78\x06\x08\xc1\x74\x34\x16\x19\x4c\x81\x8b\x31\x4f\x32\xa7\xc3\x7c\x2e\x08\xc7\x99\xba\xa6\x6d\x34\x4f\x79\x33\x59\x88\xa9\x3a\xda\xc5\xac\xaf\x7b\xf6\x74\x0b\x84\xcb\x7e\x1e\x01\x6c\x46\xfa\x4e\xaa\x51\xcf\xca\x3a\x31\xe3\x9c\x21\xef\x20\x7b\x1b\x0b\x14\x71\x82\xec\xb9\xd3\x7c\x18\xa7\xa7\x2a\x18\xd5\xda\x6a\x0d\x0e\xf0\x78\xbe\x42\x45\xbd\xe9\x42\xcc\xd3\xa7\xe8\x3a\xa6\x66\x34\x0b\x10\x7a\x1c\xcb\x47\x74\x4a\x9d\xec\xe9\xe4\xb4\xdc\xee\x69\x2c\x27\xc7\x1a\xc7\x81\x1a\x9b\xf8\x9b\xc9\xd2\x24\x76\xe3\x62\xb5\x74\x9a\x61\xd8\xf5\xfa\x0b\x1f\xfa\xfb\x76\x3e\x1e\xce\x27\x63\x93\x58\xae\xa7\x38\x0a\xe9\x91\xca\x10\x27\x92\xe2\x4b\xc9\x57\x63\x8c\x0f\x08\x0f\x50\x60\x97\xef\x77\x1b\x75\x5d\x14\x3d\xaa\xdb\xcc\x75\x47\xa5\x9e\xa7\x9d\x61\x24\xd8\xa8\x26\x47\x96\x6b\x94\x21\xbf\x2f\xea\xd5\x18\x52\xb6\x6b\x11\xe6\xc8\xc4\x17\x21\x2c\xb2\xb4\x34\x2f\x4f\x50\x81\x8d\x90\x7d\xbb\x73\xa1\x44\x5c\x39\x89\x9d\x12\x4c\x82\xeb\x51\x50\xc1\x84\xb4\xc0\x37\xd1\xda\x80\xc6\x7c\x9d\xc6\xc8\x12\x0a\xf3\x6a\x2d\x4c\xa4\x69\xda\x61\xe5\x89\x94\xda\xe1\xce\x44\x99\x4e\x0c\x86\xde\x8a\x01\x18\x69\x52\x1e\xf8\x7e\xf3\xd1\xf6\xab\x20\xb6\x3c\xb7\x80\x02\x3b\x4d\x7e\x9d\x4f\x6e\xee\x06\x37\xe7\x12\x28\x4b\x9e\x6f\xc1\x0a\x74\x5c\x94\x6b\x98\x5f\x78\x69\x4f\xcd\x4a\xd1\x7c\x4a\xf3\xfa\xa9\x70\xde\x26\xe2\x11\xbd\x8b\x0b\xc8\x20\x66\x6c\xec\x5c\xb2\x70\x70\xb5\xf7\x21\x97\x8b\x35\x01\x35\xfe\x79\x2b\x00\xa0\x3b\x2d\x74\x08\xc0\x4f\x45\x46\x0c\xfb\x02\xcf\x80\x7d\x45\x95\x00\x70\x03\x03\x00\x96\xa0\x00\x20\x27\xe7\x17\xa2\x07\x00\xa3\xd5\x00\x90\xc7\x1e\xb2\x98\x79\x00\xe0\x4e\x9d\x2c\x33\x79\x7d\x1e\x7b\x33\x50\x60\x87\x02\xeb\x19\x80\x6a\x12\x4a\x97\x48\x12\x9e\xa5\x2f\xaa\x15\x3a\xea\x00\x00\x15\x5b\x03\xb0\x0c\x04\xdc\xe5\x35\xc8\xf1\x00\xa0\x79\x9f\x93\x29\x5a\x73\x57\x79\xb9\xdd\x46\xc5\x56\x70\xc6\x10\x0a\x77\xb3\x09\x36\xaf\xc8\x8e\x8a\x1b\x99\x0e\x76\x6c\x21\x6e\xcd\x99\xce\x26\x2a\x47\x1b\xca\x06\xb5\xec\x92\x1a\xd9\xe5\x26\xb4\x4b\x8a\x9e\xb0\xb0\x2e\xd3\xd4\x06\xa4\x81\x2f\xa5\xe0\x20\xd1\x71\x80\xa7\xde\xc6\x62\x29\x40\xd2\x44\x2c\x6d\xc3\xa8\x4c\x61\x4c\x15\xe1\xc4\xd9\xb0\xd8\xae\x31\xad\x68\xc3\x70\x41\xa2\x1a\x65\x28\x73\x74\x68\x97\x5d\x34\xef\x9c\xf9\x74\x3a\x9c\xd9\xd8\x7c\xa8\x1d\xaa\x20\xdb\x11\xe4\xa8\x1d\xce\x87\x96\x3b\x1d\x9f\x4e\x31\x17\x9d\x50\x4f\x44\x0c\xb9\x7a\xfa\xaf\x55\xa0\xcc\x53\x7c\x85\x26\x7b\x97\x2f\x2c\x65\xbb\xa9\x72\x2b\x3f\xc9\x27\x2d\x57\x93\xc8\xb6\x75\x68\x6c\xa1\x11\x29\xbb\x32\x4f\x3b\x36\x66\x5a\x93\xa4\x3b\x6d\x90\xdd\x54\x64\xb3\xda\xf4\xa7\x2b\x74\x1a\x30\x46\xb0\xee\xca\xf6\xd4\x62\xc1\x11\xc0\xb0\x9a\x78\xd0\x66\xcb\x8c\xf6\xe8\x42\x2a\xe8\x30\x80\xa5\x5d\x37\xb4\x81\x3e\x42\x0e\xae\x1c\xaa\x75\xad\x39\x48\xbb\x2f\x74\x76\x36\x17\x0f\xbb\x65\x5e\x27\x29\xc7\x2e\xeb\x19\x95\x02\x91\x05\xb4\xb7\xac\x49\x95\x68\x6c\xc0\xe1\x14\xc7\x06\x2c\xf0\xd2\x80\x25\x00\xeb\xb1\x1e\x8b\x73\x8b\xcc\x59\xcb\x84\x7c\x44\x8b\x35\x81\x03\x0e\x04\xc1\x4c\xf2\x40\x08\xad\x59\x3a\x54\x70\x5c\x3a\xf9\xa8\x24\x87\xd2\xb6\xa4\x08\x9c\xcf\x1a\x66\xbe\xcb\xc7\xbe\x10\x84\xbe\xed\x61\x92\x8e\x39\x46\xe8\x01\x99\xc6\x95\x30\x8e\xb4\x38\xc9\xa6\xcb\x78\xb9\x9d\xe5\xd1\xec\x38\x1f\x8b\x6b\x9e\x83\x49\x89\x08\xd7\xae\x4a\xd9\x52\x0e\xc8\xd1\x74\x3e\x9c\x0e\xa7\xcb\x62\x8d\x9e\x4a\xae\x9e\x83\x91\x79\xda\xd5\xf4\x5e\x12\xa0\x7c\x04\x81\x96\x9c\x60\xfc\x12\x3f\x8d\x39\x86\xb5\xd8\x9a\xcd\xd8\xf1\x82\xdd\xb9\x27\x67\x86\xe4\x30\x8b\x8a\xc2\xe1\xc4\xed\xc5\x74\xbc\xdf\x13\xc7\x7a\x44\xf9\xac\x1c\x49\x52\x80\x5a\xe8\x34\x83\xad\x72\xaf\xea\x38\x82\xac\x7d\xb6\x62\xca\xf9\xb8\x4c\x12\x3c\x9f\x8e\x3b\x66\x05\x81\x05\x50\x52\xe3\xd0\x36\xba\xae\x12\x22\xb1\xde\x8b\x9b\xd5\xbc\x58\xec\x2b\x77\xe8\xec\xa1\x25\x96\xa3\xdd\x4c\x50\x16\x9a\x78\x42\xad\x8d\x41\xb2\x47\x0c\xc5\xa9\xed\x96\x92\xe4\x35\x21\xf1\xe3\x23\x1d\xb9\xb4\xe0\x38\x95\x58\x0b\x3a\xba\xe1\x35\x7c\x4b\xeb\xad\xbe\xf5\xcb\x18\x3d\xe4\x87\xf2\x54\x8f\xd0\x53\xb5\x1c\x55\xeb\xed\x02\x00\xcd\xf3\xc3\x45\xb4\x5c\x6f\xb9\xb9\x79\x32\xbb\xe5\x14\x9d\xa5\xdd\x8c\x29\x44\x9f\xa6\x91\x0a\xb5\x18\x98\x9a\x71\xd4\x09\x6c\x7c\x77\x98\x20\x6b\x86\x9c\xc2\xe6\x14\x6a\x62\xdb\xde\x2f\x71\x85\x9f\x0b\xa3\x64\x0b\x83\x63\x62\xd0\x0a\x1f\xe0\xd3\x3d\x1b\x88\x9d\x87\x86\xc8\x08\x77\x0d\x66\x33\xf2\x84\x30\x1f\x41\x24\x8e\x0f\x27\x4c\xb8\x58\xaa\x4d\x2c\xef\x77\xcd\x7e\x3e\x3c\xda\xd0\x6c\x53\x74\xc2\x3c\xea\xe8\xf9\x1c\x9b\xcc\xca\xba\x31\x09\x70\x1c\xd7\x3b\x29\x10\x08\x99\xda\xac\xf1\x90\xdc\x3a\x2e\xea\xb2\xd8\x6c\x3e\xf4\x5d\x68\x38\xaa\x20\xb7\x84\x6c\x14\xf0\x43\xa8\x52\xba\x76\xa9\x9e\x3c\x7e\x9a\xc7\xc3\x12\xa7\x30\xc2\x04\x23\x46\xb6\x68\x75\x9a\x1e\x57\x12\x4f\x10\x4e\x71\xc4\xb3\xc9\x68\x41\xce\x5d\x06\x27\xa7\xd3\x6d\x95\x70\xa3\x49\x0a\x0d\x27\xc7\x21\x64\x2f\x39\x63\xb9\x46\xf6\x7e\xb7\x5f\x2c\x42\xd9\x08\x6a\x5c\xe6\x91\x7d\xb2\x84\xd0\x40\xee\xa6\x50\xa5\x42\xb3\x64\x5f\x55\x1d\xa2\x36\x91\x35\x1b\xfa\x5b\x79\xc7\x4d\x24\x8d\xc7\xad\x65\xc5\xc5\x9e\xbe\xe4\x64\xbe\x52\xfc\x88\xc5\x33\xab\x0c\x28\x1a\x64\x80\x60\x69\x86\x81\x22\x00\x41\x2b\xe6\x80\xa2\x47\x17\x76\xd7\x27\x89\xdb\x52\x16\xc4\x25\x0c\xc5\xa6\x00\x37\x9c\x39\x32\x86\x3a\x71\xbd\x1f\x72\x07\xbc\x11\x38\x02\x82\xda\x66\x3b\x5c\x18\x96\xe9\x2d\x80\x59\xb8\x30\x5c\xb9\xa3\x0a\x6f\xdb\x8d\x20\xe5\x0b\x2a\x72\x74\x9d\x27\x36\x3b\xc9\x03\x60\x22\x26\xae\x27\xad\xa7\xf3\xf9\xb4\x3b\x59\x6b\x06\xf1\x16\x05\x9b\x49\x81\xb3\xa9\x52\xb9\x06\x50\x8a\x40\x3b\xb2\x9b\xc2\xe9\x7e\x3f\x4c\x68\xd6\x82\xf6\x76\xd7\x21\xeb\x23\xe2\x77\xca\x4e\x0e\x8b\x25\xd1\x14\x2b\x15\x43\x71\x76\x7a\x30\x88\x0d\x14\xf8\x0e\x64\xe3\xfc\xc6\x54\x4d\x5f\xb2\x69\x7d\x7e\x14\x0e\x12\xec\xc5\x54\x1d\x63\x7e\x78\x2c\x44\x9d\x3a\x90\xa9\x77\x44\xa7\x3b\xf1\x70\xb2\xa8\x08\xe7\x97\x41\x17\x1b\xba\xc7\x6f\xcc\x0d\x3c\x62\x0e\xae\xb8\x5c\x58\x60\xa7\x05\x59\xca\xa9\x1b\x06\xf0\xb5\x85\xf1\x46\x16\x33\x04\xa7\xe1\x70\x24\x11\xa9\x06\x17\x36\x0f\x7b\x84\xc0\xc9\xca\xf1\x28\x1e\x18\x37\x82\xa9\xa3\x99\xb1\x19\xcd\xee\x48\xcd\x5d\xd5\xb5\x28\x20\xed\xd4\x13\x31\x27\xd9\xf8\xb9\xd2\xe9\x34\x4e\x52\x9d\x90\x85\xda\x72\x25\xc0\x18\xce\x7a\x74\xa1\x8c\x3c\xaf\x59\x69\x1d\xaf\x2b\x01\x21\x17\x14\x4e\x69\x54\x4a\x07\xf2\x11\x39\x8d\x99\xd5\xf6\x20\x38\xa3\x59\xa6\x30\xba\x6b\x26\x55\x95\x04\xa6\x95\x66\x2c\x2b\xe1\x80\xf5\x13\xed\xe8\x9d\x76\xa3\x52\x43\x45\x9c\xa7\x83\x0d\xe1\x08\xad\x03\xf0\x0d\xce\x0c\x77\x6c\x4a\x9c\x00\x2a\xaf\xb4\x39\xdf\x92\x62\x60\xf9\x0b\x64\x0b\x00\xe8\x8a\x4e\xc4\x56\x4c\x68\xb2\x2b\x9b\x07\xcb\xca\x71\x64\x26\x40\xf5\x0d\x2d\xbb\x4b\x7b\xd3\xce\x1c\xbf\x11\xc7\x16\x9d\x9d\x42\xdf\x8a\xc9\x31\x43\xd7\x92\x54\x29\xa5\x57\x2f\xd7\x59\x21\x76\x16\x36\x5e\x90\x50\x4b\x62\x02\x59\x23\x07\x9d\xc8\x09\x81\x51\x42\xad\x8e\xeb\x02\x0d\x09\x01\x90\x24\xbc\x20\xcc\x2c\xb0\x22\x61\x81\xee\x02\x0b\x2b\x73\xbd\xe0\x86\x4d\xc8\x01\x5e\xd1\x94\x52\x80\xd3\x4d\xa1\x48\xb9\xa7\x2e\x9c\x0a\x11\xb6\x51\x44\xc5\x6e\x47\x43\x8c\xec\x77\x43\x02\xdb\x4d\xfc\x96\xf2\xf2\xa4\x3d\xe8\xcb\x65\xe7\xdb\x7e\x3a\x0a\xe8\x82\x0c\x16\x69\x2c\x76\xe5\x90\x2c\xa0\x59\x37\x5d\xb3\x5d\xbc\x97\xd1\xb9\xbb\x1e\x39\xba\xae\x88\x54\x70\x90\xe1\x44\x2e\xe8\xdc\x1b\xb1\x4a\x16\x87\xa9\x34\xb7\xd5\x6a\xdf\xf0\x39\xbe\x63\xe2\x54\x4b\x39\x73\x13\x47\x6b\x72\x2b\x9b\x9e\x23\x90\xab\xa2\x99\x31\xd5\x76\x57\x36\x68\x77\x3a\xf9\xb2\xc4\xab\x0e\x1d\x2f\x25\x40\xa4\x2a\xe2\xd4\xc6\xdc\xdd\xcb\x9b\xe8\x04\x46\xf1\xa8\xe1\x5a\x84\xa5\x3c\x2f\x5c\xe7\x89\x76\xda\xe2\x73\x01\x8e\x16\xa1\x15\xe8\xcd\xb0\x91\x21\x40\x4a\xd5\x5a\x96\x40\x96\x59\x06\xcc\xed\x3b\x11\xc7\xe1\x43\x4a\x83\x02\x13\xdb\xdd\x78\x4e\xc1\xc6\x34\xa3\x31\x92\x24\x9d\xb8\xc6\xa6\x4b\xaf\xf5\x24\x66\xb7\x2b\xa6\xec\x01\xe3\xb2\x85\xc0\xd6\x87\x85\x4c\xdb\x34\xdc\xd0\x9c\x4d\x3a\x14\x9c\x69\x3b\xd4\x90\x46\xbe\xb1\x29\x48\x5a\x69\xf6\xfb\xe9\xc9\x63\x84\x56\x44\xc3\x50\x58\x04\x16\x58\x77\x8a\x0d\xea\x0e\x87\x0d\x3d\x8a\x41\xc5\xa4\x68\x27\x31\x21\x6f\x69\x59\xa6\x32\x40\x93\xf0\xa5\x94\x00\x23\xc6\x38\x6c\xb4\x77\x89\xc8\x01\x0b\x97\x71\x11\xda\x22\x8e\xf3\xbd\xb3\x95\x84\xf5\xec\x58\x8e\x0b\x7c\x22\x46\x8b\xdd\x1a\xcb\x42\xbc\xee\x70\x6f\xc1\xa4\xbc\xe0\xd8\xec\x62\x86\x50\x35\x2c\xf3\x13\xed\xa8\x36\x0c\xe0\x28\x46\xc1\x89\x65\xac\x33\x84\x75\xda\xd6\x9a\x5d\x2f\xb2\xad\xc0\xb1\x0a\x62\x24\xba\x9f\x1f\xe1\x91\x0d\x44\xa9\x09\x89\xa9\x21\x2c\xc6\xc1\xe4\x54\x07\x93\xf1\x52\xc7\xca\x9c\xe5\x47\xf0\x82\x6b\x99\xe5\x29\x53\x55\x19\x26\x4d\x33\xe2\x4a\x8a\x12\x1a\x79\xc8\xea\x1c\xa7\x2a\x11\x0d\x68\xc7\x5c\x62\xd3\x43\x4a\x7b\x45\x9d\x53\x26\xba\x85\x27\xe4\x09\x75\x01\x33\x9d\x4d\xf5\x78\x06\x95\x70\x31\x4b\x84\xc8\x99\xaf\x0f\xb8\xcf\x10\xab\xd1\x62\xea\x44\x31\x36\x87\x3d\x34\x67\x3b\xa2\x84\xc6\x2d\xc3\x6e\x02\x30\x76\x9a\x85\x34\x66\x44\xbb\xd7\x53\x95\xb1\x30\x8f\x55\xad\x2a\x2b\x75\x2b\x80\xd4\xd3\x53\xa5\x0a\x2b\xb0\xad\xa3\x8e\xd6\x32\x45\x26\xda\x68\x64\x37\x43\x3d\x15\xf1\x0e\x32\x83\x39\x7a\x6a\x5d\x92\x1a\x4d\x76\xcd\x28\xa8\x96\x05\x5b\x88\xca\x52\xd9\x10\x55\x5d\x53\x3e\x6c\xcd\x7d\xa8\x6a\xc8\x62\x88\x78\x9b\xa4\x21\x0f\xc3\xe9\xdc\x91\xf8\x83\x1f\x79\xd8\xce\x8c\xa0\x53\x18\x58\x64\x5c\x86\xa7\xed\x8a\x0e\xe2\x58\x93\x05\x0e\xd3\x0d\x31\xc4\x33\x25\x72\x94\xca\x9b\x8f\x54\xd2\x08\x58\xd2\xd9\xd6\x25\x75\x52\x5d\x72\x15\x35\x66\x8d\xb6\x10\x8a\xad\xc9\xc4\xa9\x96\x44\x00\x09\xd8\xd0\x95\x60\xb3\x1c\xdb\x84\xc3\x8e\x16\x10\xcd\xd3\x8d\x37\x8e\x05\x6a\x2e\x4f\x73\x59\xd8\xa8\x01\x04\xca\x4e\x5c\x27\xd4\x6a\xc9\x6f\x75\xa4\x6c\x73\x30\x8c\xe8\x63\x17\xf2\x21\x8a\xaf\x58\x38\x44\xf1\x0d\x35\x2e\x6a\x72\x0b\xa6\x75\x14\xc3\x3b\x9a\x0a\x29\x91\xdc\x1d\xba\xcd\xba\x8b\xc9\xa3\x5a\x63\x0c\x99\xec\x0e\xdd\x78\x5a\xd4\x0e\x63\xf3\xd9\x78\x32\x3c\x94\x2e\x14\xc2\x7a\x95\x97\xb2\xc6\x26\xca\x9a\xdb\x92\xa8\x31\x87\x8d\xfd\xd6\xde\x99\xe5\x94\xc5\xe6\x25\x24\x60\xe3\x25\x37\x36\x3d\x7a\x1a\x09\x60\x57\xac\xe6\xf8\x3e\xd8\x52\xe3\xaa\xf4\xb7\x54\x47\xe3\xfc\x50\xcd\x80\x9f\xeb\x55\x02\x00\xe5\x52\x19\x46\x4a\x58\xda\xb6\x12\x3b\xdc\x87\xca\x21\x81\xca\x45\x61\xee\x37\x36\x98\x1f\xd0\xed\xe1\x14\x42\xb0\x51\xba\x53\xe4\x44\xce\xe8\xd6\xac\x21\x03\xd1\xad\x4a\x2e\x00\xc0\x66\x28\x1b\x93\xc9\x30\xa5\x91\x19\xb9\x84\xba\x23\x9d\xa0\x75\x12\x40\x8d\x4d\x29\x4a\xaa\x9e\x42\xaa\x69\x1c\x1c\x5f\x05\x11\x9d\x10\x20\x5a\xe2\x82\x30\x64\x7c\x27\x44\x99\x52\xf5\x53\x96\x1a\xa9\x55\xa4\x56\x64\x54\xd8\xc0\x03\xd5\xaa\x21\xc0\x64\xbb\x1a\x33\xeb\xe1\xda\x9a\xf9\xee\xa6\x60\x80\xa7\x48\x2d\x80\xcd\x8e\xce\x67\xec\x82\xf1\x19\xa2\x0a\x5d\xc6\x75\xf6\xb1\x20\x66\xbb\x59\xed\x45\x2d\x8c\x8c\x0e\xdb\x50\x2d\x0e\x46\x3c\x27\x60\xe3\xb0\xe2\x14\x8f\x3e\x31\x15\x58\x4f\x8f\x3b\x01\xa0\x6a\x66\xd7\x20\x1d\xa5\x29\x29\xd6\xf5\x81\x57\x19\x44\x22\x4f\x15\x14\x79\x68\x44\x24\xb5\x37\x99\xdb\x8d\xad\x64\x9c\x3d\x73\x4c\x6e\xed\x01\xc2\x75\x2b\x60\xf8\xde\x1e\x5e\x3b\x13\xee\xd0\x0a\x20\xdf\x8e\xa1\x45\x64\xce\x0a\x0f\x41\xb5\xb1\x4a\xec\x87\x94\x52\xc2\x12\x4d\x10\x74\xb0\x31\x6c\x7a\x84\x8e\x42\x15\x8b\x7c\x3c\x23\x95\x2d\x10\xda\x21\x75\x1c\xad\xc1\xd6\x00\xac\xc1\xe0\xd5\x5c\x3c\xcb\xbc\x7b\xb2\x23\x61\x3f\x6d\x41\x38\x9d\x8d\x3b\xa5\xda\xce\x82\xa9\x31\x99\x99\x62\x4a\x80\x49\xe3\x6c\x66\x2b\x04\xaa\x30\x55\x76\x10\xa7\x9e\x8e\xe9\x6a\xbd\x3e\x26\x15\x4a\xc6\xd5\x74\x85\xcd\xe5\xa2\xdb\x2c\x83\x76\x71\xf2\x16\xf2\x5e\xc0\xa6\xcc\x61\xe5\x74\xb1\x37\xd1\x26\xa1\xbc\x04\x05\x8c\xd4\x0b\xb0\xad\x03\x17\xaf\x3d\xcf\x4b\x2d\x41\x23\xa7\x29\x3b\x99\xee\x63\x8a\xd9\x1d\x4e\x9a\xb7\x8f\x73\x5c\xed\xd0\xf9\x78\x5f\x8f\xc3\x62\xc9\xf3\xc8\x24\x8b\xbd\x4e\x84\x6b\xa2\xa0\x6d\x73\x3c\x9d\x6c\x1b\x57\xcd\x4c\xa7\x6b\x2c\x62\x5f\x0d\x19\xb0\x95\xbd\x09\x82\xb9\x8a\x0a\x77\x3e\xe4\xe0\x13\x7a\x4b\x1e\x78\x54\xd5\x3c\xbd\x96\x64\x4a\x4b\x4b\x18\xd0\x04\x45\x35\xa8\x36\x33\x53\xdc\xa3\x80\x5e\x3b\xa9\x96\xf8\x2e\x9d\x6c\x16\xcb\xae\x04\xa3\x16\x53\x0f\x50\xd7\x10\x6c\xab\xd5\xec\xd0\x8d\x0b\x6b\x9d\x8c\x13\x7a\x76\x6c\x4f\x34\x5d\xc0\x24\x44\xcc\xe0\xb1\x99\x04\xe8\x21\x3b\x8e\x04\x03\x1a\x52\xc4\xf4\x90\x28\x1e\xb4\x1f\x8e\x3d\x7c\x32\x6f\xb7\x35\x84\xdb\x89\x81\xef\x49\xd6\x15\x72\xc3\x5a\x6e\x26\x80\x00\x06\x1d\xc7\x87\x2c\x98\x1a\xc7\x2c\x26\xe4\x8d\x70\x44\x62\x6b\xe9\xb1\xe2\x91\xb6\x35\x69\x2a\x50\x79\x36\x36\x9b\x63\x9c\x98\x30\xd7\xc0\x43\x96\xdb\xac\x70\x36\x1f\x1f\xb3\xa2\x8b\x66\x63\x04\xda\xd7\xd9\x14\x35\x57\x04\xba\xae\x63\x9f\x27\x24\xa0\x6d\x88\x05\xa8\xc6\x42\xb5\x8a\xf2\x80\x92\xa8\x76\xd2\x59\xb4\x55\x2d\x7d\xc6\x9b\x48\x44\xb4\x72\x0f\xa5\x3b\x37\x24\x96\xb0\x97\xa3\x00\x0d\x67\x10\xa6\x71\x0e\xb4\x33\x4d\x86\x52\x17\xf5\x82\x30\xf8\x59\xdb\x1d\x6a\xd4\xc7\x4e\x0c\x1d\x83\x6d\x1b\x8f\xe6\x4e\xb3\x5f\x51\x30\x55\xda\xc7\xb0\x51\xf5\xb5\xea\x38\xf0\x91\x06\x99\xbc\x5e\x59\x54\x69\x37\x12\x08\x00\xac\xba\x11\xe1\xf2\x27\x99\x54\x95\x74\xbb\x51\x0d\x6e\x37\xf6\xd3\xe9\x58\x3e\xc2\x21\xc8\x76\x35\x99\x67\x7e\x2e\xca\x94\x03\xb3\xd9\x18\x88\x78\xd5\xfa\xc1\x82\x29\xca\x32\xc3\x31\x3c\xf5\x03\x2d\x3e\x9a\x99\xe7\x46\x98\xd0\x8c\x8e\x69\x95\x27\x06\xb1\xb3\x35\x0f\xab\xb3\xa5\x6d\x6b\x56\xa1\x9b\x3a\xb1\x04\x9c\x66\xfa\xbc\xa5\xa9\xd9\xd8\x3f\xa9\x1b\x22\xdb\x26\xc0\xa6\x58\xb1\x58\xc0\xf0\xbe\xa9\x74\x43\x3e\x4e\x04\x80\x3a\xdb\x31\x57\x11\x8b\x72\xdc\xf8\x14\x38\x6e\xc2\x23\xc6\x12\x98\x30\x5c\x18\x3b\x1d\x86\x76\x4b\x6e\xe2\xcf\x11\x27\x8e\x2c\x8e\x5d\xcc\x55\x3f\x6c\xbb\x1d\x35\xf7\x24\x38\x37\xf0\x49\x94\x29\x9e\x66\xc3\x84\x3a\x23\xe0\x44\xea\xd6\xda\x89\x20\x43\x24\x9d\x06\x6d\xa1\x69\xb0\x17\x17\xb4\xad\x10\x44\xc2\x99\x45\xe6\x1e\x27\x78\xb9\xd6\x42\x94\x51\x9a\x7c\x41\xf8\xbc\x99\xc5\x15\xa4\x21\x5e\xcd\x17\xc2\x3a\x45\x4c\x88\x1a\xa2\xf3\x29\xa0\x96\xf4\x12\x9b\x51\x02\x43\x1f\x17\x0e\x04\x35\x70\x88\xcd\x3d\xa3\x96\x87\xfa\x46\x5b\x9c\x62\x20\x35\xe4\x08\x59\x98\xa6\x97\x36\xa3\x32\x0b\x2a\xc5\x9a\x30\x22\xc1\xb1\xdc\xac\x8a\x9b\x74\xec\x53\x4b\xb1\x39\x29\xf4\x9a\x0a\x2a\xb6\xb1\xe5\x71\xe0\x6c\x57\x9d\x7e\x3c\xd6\x89\xb4\xc5\x4d\xcf\xcf\x67\xf9\x38\x86\xf8\x1d\xe3\x35\x5d\x31\x4c\x57\xd4\x48\x85\x0a\xfc\xe4\x7b\x5e\x00\xec\x56\xcc\x87\x38\x04\x0d\xe9\x56\xeb\x8a\x3d\x09\x53\xb2\x30\x95\x69\x36\x3c\xa8\xde\x01\xc1\x57\x6a\xec\x25\xbe\xa6\x6d\x6d\x07\x59\x61\x4a\x86\x6b\x19\x46\x4c\x48\x9d\x01\x78\x6c\x51\xf9\x78\x38\x5b\x0e\xf3\x32\x58\x44\x2a\x20\x35\x1e\x63\x67\x21\x37\xdb\xba\x24\xe9\x16\x10\x0f\x6a\x74\xda\xad\x35\x25\x37\xb4\x64\x5c\x66\x9c\xc8\x1d\x29\x4c\xf5\x45\x05\x10\xc9\x50\xee\x40\xef\x5b\x41\xd9\x14\xc4\x1e\x8b\x64\xfe\x26\x6c\x5d\x1c\x33\x8f\x52\x00\x0a\xda\x2c\x43\x5a\x34\xd9\x21\x48\xa7\x8a\xd7\x96\xba\xe7\x72\xa9\xa4\xe3\xe1\x7e\xc8\xc8\x8b\xbc\xd1\x7d\x1d\xa7\x17\x47\xe6\x80\x60\x41\xac\xac\xd1\xac\xa0\x35\xd0\x46\x06\xa1\x50\xc5\x46\x21\x9d\x0a\x69\x67\x93\xcd\x4a\xaa\xc2\xaa\x2d\x63\xd6\xa9\xf5\x23\x5a\x2a\x5c\x3a\xee\xf2\x92\x85\x39\x4d\x38\x04\x80\xeb\x76\x39\x0e\x01\x30\x33\xe4\x84\xd8\xac\x92\xa5\xb8\x42\xb9\x8a\x6e\xa9\x80\x3d\xcc\x80\xe9\x26\x73\xdd\x86\xeb\x25\xcc\x85\x72\x29\x78\x8b\x68\x7f\x20\x19\xbc\x26\x95\x25\xdf\xd5\xd9\xd4\x91\xcd\xe5\xd0\x32\x82\x93\x59\x03\x90\x99\xd1\x26\x01\xeb\x12\x56\x9b\x4a\x31\x70\x3e\xb5\x09\x30\xc2\x79\xad\x61\x3d\x01\x6f\xe1\xd2\xd2\x80\x48\x04\x7a\x10\x06\x91\x16\x06\x09\x0c\xd1\x3b\x3a\xa1\x3a\xb4\x69\xa4\xe5\x42\xf0\x26\x79\xe6\xc6\xdb\x79\x66\x49\x31\x3a\x6d\x10\x32\x5a\x2e\x05\x6d\xc2\x9a\x2c\xab\xd6\xa2\x46\xe4\x16\x05\x74\x4a\x4e\x4b\x19\xb3\xed\x94\x94\x38\xc0\x4e\xc9\x05\x9e\x17\xcd\x4c\x58\xa3\xe9\x30\x39\x41\xfa\xdc\x61\xf6\x28\x0e\xec\x11\x7b\xf0\x2a\x11\x86\x9d\xa1\x98\x31\xfb\xbd\x91\xd7\xe3\x20\x87\xe7\x1b\x21\x18\xd7\x0e\xb6\x26\x66\xd2\x92\x64\xb6\xcc\x22\x66\x99\x7c\xb4\x98\x39\x5e\x21\x8a\x5e\xda\x8a\x1c\x3e\x9f\xa2\xcb\x83\x0c\xf1\xca\xd2\xa0\x8d\xb2\x6a\xe3\xc6\x20\x4c\x51\xd3\x4a\x61\xd6\x21\x01\x54\x63\x33\x7b\xed\xa9\xaa\xb0\x26\x49\x6c\xe2\x4a\xdb\x51\xbc\x98\x4e\x66\x4a\x7c\x3c\x0e\x8f\x80\xa6\x64\xb1\x66\x25\x05\x28\xa6\xee\xe0\x20\x90\x58\xc9\xf3\xd8\xd9\x71\x3a\xb4\x21\x70\x50\x08\xca\x33\x71\x2f\x86\x54\xcf\x44\x8b\x32\x3d\xf1\x2d\x26\x17\xc7\x6e\x89\x0b\x07\xb1\x76\x65\x25\xd8\xfa\x2b\x91\xae\xe5\x00\x26\x62\x6e\xe7\x53\x08\xae\xc6\x10\x5f\x64\x2b\x09\xce\xec\x5c\xd0\x2d\x2d\x24\x0f\x10\xbc\x4f\x3a\x0f\x5e\xc3\x69\x14\x1e\xfd\x62\x88\x59\xd3\x51\x93\xba\x00\x5e\xf2\x44\x95\xb3\xb9\x1f\xef\x91\x9c\xeb\x6c\x6b\x6e\x47\xb0\x16\xa3\x1a\x7c\x34\x53\x70\x5c\xd5\x3a\x32\x06\xca\x3c\xa3\x9c\x04\x20\xac\x57\x09\x58\x48\x2f\x89\xf9\x78\x67\x2c\xd7\x41\xc0\x19\xec\xa1\xa4\xd8\x19\x8a\x7b\xde\x86\xb7\xb2\x5e\x82\x1a\x94\x42\xca\x26\x48\xa2\x35\x27\x36\xf3\x6e\x16\x4e\xc1\x0e\xd7\x54\x5c\x91\xc3\x3c\x9a\x95\xa7\xe1\x42\x73\x48\x96\x88\xc2\x22\xf3\xe9\xe2\xb4\x1c\x9a\x96\xcd\x2f\x93\x91\xb7\x44\x75\x98\x64\x89\x5c\xac\xa4\xed\x49\x49\x7d\x6d\x45\x21\x79\xd2\x61\x23\xe9\x68\x3b\xeb\x55\xa9\x17\xf2\x82\xc2\x77\xa2\x97\xec\x9c\xf0\xa0\xea\xbc\x66\x6e\xbc\xcd\x51\x4e\xb0\x3d\x03\x70\x31\xd5\x71\x7b\x45\x21\x5d\xc9\xac\x93\x74\x39\x5d\x81\x3d\x03\x56\x8c\x32\x73\xd6\x1d\x05\x17\x8d\xe0\x14\xec\xf2\x78\x2a\x16\xf9\x36\xb5\xa9\x85\x6c\x6c\xe9\x66\x32\xdd\x6b\xdd\xb8\x9d\x22\x73\x77\x6a\xd4\x18\x20\x0f\x55\xbc\x43\x00\x97\x6e\x8e\x48\x43\x94\x79\xed\xf8\xb0\x2a\xc9\xdd\x14\x33\xe2\x65\x66\xb2\x73\x26\x28\x99\x83\x4a\x10\x16\xcc\x9b\x60\xc5\x2f\xd2\xed\x6c\x16\x69\xe9\x30\x23\xe2\xc8\xc7\x81\x62\x08\x31\x38\x50\xd3\x09\x65\x61\x44\xba\x6a\xdd\x9c\x34\x2a\xcd\x64\xe4\x6d\x1d\x1c\x91\xb5\x95\xcc\x72\xa8\x5d\x85\xb6\x00\x82\xe3\xe9\x34\xcc\x6a\x9a\xde\xc4\x99\x74\xdc\x45\x93\xa0\xb0\x8e\xa9\xae\x97\xd6\xa1\xe0\xf6\x38\x9c\x05\xdb\x9a\xa0\x02\xc7\x6f\x99\x6c\x02\xad\x99\xac\x72\x92\x7d\x31\xf3\xc8\x18\xdb\xc1\xd3\x66\x3b\x6c\xb6\x78\x42\x6d\x30\xf4\x70\x42\xc4\x43\x3a\x45\x21\x04\x6e\x86\x6e\x67\x63\x53\xba\x9d\x08\x2b\x88\x15\xb7\xe6\xd0\x6c\xf0\x13\x4b\x4d\x5c\x97\x48\x4f\xbb\x04\xa6\xb7\xdd\x61\x7b\x2c\xb1\x2a\xa7\x0a\x3d\x5a\x14\x6b\x69\xb6\xa2\x99\x35\x2c\xaf\x21\xc7\xed\xb0\xe2\x04\xcd\x17\x33\xb3\xe2\x67\xf5\x11\xa9\xf8\xa6\x0c\xe5\x62\x88\x61\xf6\xaa\x98\xba\x68\x39\x19\xad\xa8\xed\xb6\xd6\x59\x5d\x6b\xdd\x51\x3a\xdc\x0f\x93\x64\x5b\xa8\x23\x33\xca\xeb\x80\x99\x3b\xfc\x61\x99\x0d\x55\x53\xf7\x53\x79\xd5\x42\xdc\x6e\xbc\xce\xd7\x87\xa8\xdd\x83\x8e\x0c\xea\x3d\x32\x3b\x79\x24\x06\xf4\x21\xa8\x94\xb1\x73\x1c\x5b\x15\x4a\x57\x0a\xc4\xc2\x2c\x3e\xc1\xd1\x9d\x30\x5d\x4e\xeb\x62\x83\xb2\x9c\x82\x1f\x4e\x3b\x24\x2a\x60\x6f\x87\x1a\x1e\x1b\xb4\x31\xec\x07\xd0\x8a\x8d\x71\x28\x1b\x0b\x59\x00\xcf\xc5\x7c\x58\xbb\xf8\xb1\x1a\x12\x51\xc0\x18\x65\x03\xe5\x15\x91\x2d\x36\x66\x85\xda\x22\x09\x29\xb6\x5b\xc1\xb3\x20\xd5\x02\xda\xb7\xb7\x23\x24\x67\xd4\x16\x96\xa8\xad\xea\xd4\xbc\xbe\xd4\xa7\x38\x84\x22\x93\xb2\x44\xf7\x4c\x72\x8a\x69\x59\x12\xaa\xd9\x09\x46\x2d\x40\xc9\xec\x41\x6d\x89\x31\x0b\x4a\x6e\xe1\x78\x50\x31\x15\x0f\xcb\x78\xc7\x18\xf8\x56\x47\xe6\xaa\x5e\x4e\x2a\x5a\xc7\x47\xa8\x2e\x6c\xcd\x92\xae\x73\xb6\x16\x56\x00\x75\x4e\x3b\x7b\xd1\x58\x80\x36\xa4\xdd\x7c\x49\x8d\x25\x40\xb3\xd3\x55\x38\xb7\x3d\x07\xb4\xa2\xbb\x44\x08\x3a\x52\x66\xdb\x75\xbe\x23\xb5\xd4\x54\x09\x2c\x62\xdd\x46\x67\xb9\x1a\x5e\xcf\xd9\x9a\x54\x6c\x5c\x6f\x3a\x5d\x54\xe7\x92\xe7\x9d\x76\x41\x75\xda\x88\x07\x63\x9e\x14\x6b\xbc\x89\x97\x04\x5c\xee\xec\xe1\x62\x54\x83\x8c\xdb\xb4\x8c\xa0\x8a\xbb\xc6\x3e\xd4\x06\x3e\x04\x4b\x0f\x57\x89\xd5\x6c\xb8\x51\x8d\xcc\x82\x25\x20\x69\x2a\x59\xc1\x2e\x33\x6c\xed\x38\x1b\xe3\x62\xd9\x2e\x74\x25\x86\x73\x0c\x0f\xbc\x49\x6d\xc4\xdc\xd1\xa8\x57\x2b\x75\xcf\x5a\xdb\xa2\x40\xd0\x29\x49\xb1\xb2\x2e\x18\xb4\x26\xb1\x54\x92\x90\xb5\xb8\xc0\xeb\x74\x31\xdf\xef\x47\xf3\xf5\xb0\xdc\xaa\x63\x04\x37\x03\xbd\x24\xe4\xa5\x32\x21\x67\xb5\x00\x77\x72\x33\xd9\x73\x3a\x24\x76\xf3\xf9\xe8\xe0\x88\x5d\x86\x6e\x33\xc4\x9a\x07\x3a\x1f\xa9\x91\xd5\x4a\xb4\x3c\x9b\xaf\x8d\xb5\x9a\xcd\xea\x25\x27\xb4\x05\x56\xb2\xe5\xaa\x32\x98\xf1\x70\x7f\x3c\x35\xf3\xa9\xc1\xb0\x92\x04\x6f\xed\xbd\x56\xca\x71\xa8\x79\xc9\x71\x3a\x9c\x39\x95\x9a\x8e\x72\x6b\x21\x1e\x46\x20\xc2\x61\x35\x01\x62\x3c\x37\x26\x22\x3a\xde\x72\x63\x01\x14\x61\x38\x8b\xca\xd9\x4e\x10\x63\xa1\x13\x6b\x86\x32\xce\x7c\x6b\x1d\x31\x39\x18\xf2\x24\x25\x26\x38\x20\xf9\x71\x4d\xce\xdd\x99\x83\x90\xfa\x64\x2c\x7a\xa8\x23\xf0\x0b\xad\xaa\x34\xcd\x26\x4e\x74\x68\xaf\x01\xb4\xae\x09\x39\x31\x44\x12\x70\x29\x40\x81\xb3\x92\xdb\x91\x95\x2c\x00\x4e\x80\xa9\x38\x3f\x31\xfd\x3b\x27\x6f\x66\x58\xe1\x78\x78\xb5\x8b\xc7\xb9\x31\x66\x09\x0b\x8b\xa1\x99\x12\x8e\xdc\x44\x50\x25\x18\x06\x88\xb7\x98\xe1\x3b\x5e\xb4\x09\x3c\xce\x3a\x0f\x28\xde\x01\xc3\x84\xe9\x10\x4a\x2d\x6e\xbf\xcd\xe2\xbd\x8c\x96\xe3\xa6\x85\xa7\x60\x53\x68\xb4\xaf\xb1\x72\xd4\xba\xb1\x2d\xda\x8e\x3b\x17\x33\x9d\xe1\x3c\x6b\x93\x8c\x69\xab\xf6\xbd\xe3\x72\xef\x87\xb1\x78\x70\x91\x49\x62\xc6\x72\xdb\x6c\xa5\xd2\x5d\xc7\x7b\x0d\x6d\xf4\x94\xce\xe2\x9d\x87\xac\x8d\x52\xd8\x8c\xf2\x7c\x32\x44\x5d\x77\x3b\xce\x44\xeb\x48\x79\xed\x86\x53\x7c\x26\xe4\xe6\x59\x83\xcc\xa7\xf2\xd6\x71\x92\x22\x08\x36\x3b\xa2\xae\x96\xa7\xb1\x16\x70\x73\x6b\x0b\xed\x0c\x05\x8f\xa8\x69\xa2\x8f\x45\xc3\x45\x1c\x70\x24\xc1\x14\x99\x76\xd1\x1c\x2b\xc6\x2c\x30\x15\x36\xe5\x4f\x73\x9a\x8d\x99\x12\xc9\x57\xd3\x8c\x2c\xa6\x2b\x0c\x8a\xc6\x1b\x9d\x15\xdd\x42\xae\x99\x99\xba\x2b\x90\xa3\x57\x9f\xb6\xc1\xa4\xe2\xf3\xf9\x1a\x1f\x56\xb3\xea\x90\xc6\x8a\xcb\x47\x69\x62\x2e\x60\x39\x96\xa3\x09\xb0\xd7\x8c\xad\x02\x89\x98\x2d\x70\xa8\x5a\x50\xbb\xb1\xbc\x56\x53\x1f\x9b\x1c\xd0\x9d\x4f\x34\x36\xea\x7b\xe8\x1c\xf7\x92\x79\xb2\x2b\xea\x75\xa6\xe3\xfc\x18\xa5\x72\x53\x46\xca\xa3\xa8\xa3\xe3\x99\xab\x37\xd8\x16\xa9\x3b\x59\x6e\x10\x7e\x04\xa3\xc7\x11\xd2\x52\xdb\x74\x8c\x9f\xec\xbc\x9d\xcf\x59\xcc\xad\x2b\x76\x1c\x1a\x4e\x71\x98\x28\xac\xd8\xe8\xe3\x34\xde\x8a\x75\xe6\x2b\x93\xea\x90\x7a\xbe\x40\xd6\x30\x05\x4e\x5b\xea\x94\x2a\x9e\xba\x07\x7c\xbe\xb7\x34\x01\xcc\x69\xf8\x20\x4d\x1d\xd4\x41\x6d\x3f\x84\x93\x43\x2a\x0d\xe7\xbb\x19\xba\x0a\x78\x58\x57\x13\xc6\x4d\x1c\xf1\xe8\x24\x4b\x87\x65\x8d\x29\x8f\x1a\x1c\xbc\x9a\x65\x99\x1b\x34\x3b\xd7\x16\x8c\xa0\xa1\x33\xc6\x5a\x91\xec\x30\xd6\xe0\x04\xcd\x9c\x68\x66\xcd\x93\xe1\xaa\xe1\x66\xd3\xea\x70\x9a\xc2\x28\xec\x88\x33\xe3\xc4\x2c\x47\x71\x3e\xeb\x36\x85\x81\x47\x66\x6e\xb6\xb1\xaa\xf0\x96\x16\x49\xab\x25\xd0\x53\xe8\x14\x37\x05\xdb\x10\x3a\x5c\x2e\x5d\xae\x2b\xa6\xc8\x2e\x9d\x81\x5d\xaa\x30\xd3\xd1\x31\x4f\x95\x72\xa9\xcc\xaa\xf1\x64\xba\xee\x5a\x7e\x36\x59\xcc\xd3\x06\x45\xda\xc3\x66\x14\x69\x13\xa8\x50\x73\x83\xf6\x63\xe9\xb8\x31\x95\x85\xa3\xc4\xe6\x31\xd3\xcd\xe8\xa8\xa3\x6c\x67\x37\xa1\x39\x71\x4f\xea\x86\xce\xdc\xdd\x26\x56\x91\x61\x79\x98\x08\xf4\x74\x22\x96\xce\x4e\x59\x4e\x46\x5e\xdb\x91\x5b\x35\x32\x61\x9f\xa7\xe6\xd2\x3c\xa7\x16\x79\xce\xcf\x2a\x6a\xe9\xda\x23\xdd\x1e\xee\x6c\x8e\x3d\xed\xe5\xba\x15\x46\x75\x16\xe4\x2d\x52\x68\xe3\x34\x3b\xc9\x47\xa3\x24\xec\x4a\xe3\x71\x85\xe7\x0c\x69\x0f\x49\x9d\x4d\x6f\x61\x42\x5e\xc1\xb2\x3d\x73\xd6\x09\x39\x3e\x72\xe6\x34\x0d\x75\x9e\x60\x0c\x67\xa6\x23\x53\x48\x96\x6d\x61\xee\x01\xe0\xd6\x36\x84\xec\x8a\x25\x33\x5c\x24\xc4\x7e\xb8\xcd\xa0\x75\x4c\x17\x74\x49\xe1\x55\xbc\x3c\x16\xc5\x2c\x55\xd9\xed\x3c\xea\x34\x9a\x90\xb8\x79\x0e\x82\x83\xac\xcf\x11\xbd\x3c\xa8\x0d\xd3\x1e\xab\x63\xc6\x77\xcb\x43\x32\xa4\xab\x61\x53\xf0\xad\xb6\xec\x96\x5d\xdb\xf8\xd5\x36\x09\x95\xd5\x72\x32\xd9\x24\x86\x8c\x0f\xf9\x84\x20\x57\xad\xc3\xac\x21\xc7\xec\x32\x42\x9b\x6e\x36\x5a\x05\x16\x5d\x53\x1f\x4e\x91\x61\xce\xed\xe5\x26\xc6\xec\x5d\x23\x68\x19\x89\x9c\xc2\xa3\xd4\x75\xa1\x5e\x53\x1a\x4b\x4c\x17\x3b\x28\xd8\x4a\x27\xb7\x43\xf7\x8a\x11\x6d\x64\xd8\x30\x97\x95\xa6\x9d\x42\x83\xa9\xb1\x31\x3a\xe4\x16\xfc\x9c\xe2\x1d\x35\x77\x54\x98\xdc\x21\xea\x66\x4e\x88\x13\xd2\x5d\x28\xad\xcf\xb8\xe5\x66\xab\x1f\xc5\xfd\x69\x0b\x8e\xd3\x18\x3e\x19\xf3\xe6\x84\x6f\x86\xc8\x4c\x3e\x71\x68\xeb\xad\x31\xdb\x42\x79\x3b\xe3\x34\x6d\x5a\x53\x32\xa4\x03\x09\x3f\xf2\x08\x03\x91\xb2\x39\xf4\x8b\x8c\x74\x67\x23\xca\xe0\x0b\xa9\x30\xf5\x91\xee\x4d\x53\x7e\xc5\x1f\xda\x85\xeb\x15\xdb\x55\x45\x8b\x14\x0a\x23\x46\x9d\x43\x8b\x19\xed\x22\xd9\x11\xdb\x1f\xd9\xbd\x19\x4b\xa7\xc3\x8e\xd9\x99\x13\xb0\xe8\xc8\x55\x7c\xf9\x8f\x34\xea\x90\x19\x8e\x35\x75\xaa\xb6\x8d\x8d\x30\x00\x00\xc0\x6d\x65\x7a\xc3\xc8\xa1\xb1\x95\x23\x31\x5e\xb5\xe6\x86\x86\x4d\x09\xb4\x02\x49\x21\x4b\x15\x20\x4b\x55\xa8\x75\x92\x6a\xc4\x83\x56\x8b\x07\xd0\x2e\x55\x00\x8b\x07\x50\x8b\x96\x12\x12\x1e\x00\x80\xd0\x61\x59\xf7\x61\x73\x41\xc3\xa6\x9a\x95\x3b\x44\xce\xcc\x24\x04\xc2\x01\x34\x42\x0b\x37\x82\x02\xd7\x82\x2e\x35\x02\x99\x76\x22\x99\xb6\x2b\x02\xae\x57\x64\x5a\x0b\xab\x9d\x35\xc3\xcf\x2b\xb4\x40\xd1\x74\x51\xe6\xc7\x84\xc1\xb2\x1f\x2f\x97\xda\x69\x62\x5b\x25\xe4\x58\xa5\x7b\x73\x37\xb8\x29\xdd\xa6\x84\xb2\xc8\x0a\x92\xf3\x41\x15\x95\x7b\x37\x40\x90\x01\xa8\xbc\x01\x02\x8f\xa6\x03\x78\xf2\x1b\x86\xfc\x86\x8e\x06\x43\x18\x86\xe1\x8f\x81\xfa\x56\xe2\xb9\x51\xea\x41\x27\x37\x2f\x82\x34\x79\x0b\x7a\x74\x3f\xfd\x4a\xfb\xf7\xf1\xea\x71\xf9\x15\x9e\xfd\x3a\x9a\x7c\x08\xc2\x0b\x4a\x88\xa1\x00\xf9\xb6\xb1\x17\x94\x83\xdc\x3d\xfd\x7a\xb9\xbd\xfe\x5c\xe7\xdb\x9d\x7b\xef\x36\x59\x9a\x97\xe7\xe3\x1f\x9f\xce\xfc\x38\xc3\xfa\x6d\x90\x3e\x3f\xe5\x30\xd8\x3d\xde\x56\xfe\xf7\xff\xfb\xff\xfa\xfb\xdd\xab\x73\x4f\xef\x06\xf9\xe3\x81\x99\x37\x55\xe1\x0e\x8a\x32\x0f\xec\xf2\xe6\x7a\x3c\xe6\xf5\x20\x4e\xc7\xdd\x07\x89\xfb\x78\x06\x6b\x79\x37\xb8\xf9\xeb\x5f\xdd\x42\x38\xdf\x49\x7e\x73\x37\xf8\xdb\xc9\x8a\x2a\xf7\xb7\xc1\x2f\xf0\xdf\xbf\xdd\x0d\xca\x7b\x82\x01\xb2\x42\xa9\xca\xe5\x74\xca\x41\x79\x7f\xbd\xcd\xe7\xaf\xd7\x17\x83\xef\xcf\x2a\xdd\xe3\xcf\x9b\x5c\x0e\xab\xfc\x93\x67\x51\xfe\x6b\xf9\xfa\x28\xca\x7f\xdd\xbf\x3e\x89\xf2\x5f\xf3\xd7\x07\x51\xfe\x6b\xf2\x0f\x3a\x87\xf2\x5f\x4f\xff\xe7\x18\xca\xff\xbc\xc7\x50\x3e\x97\xb2\xf3\x91\x93\xcf\x4e\x94\x7c\xf1\x0e\x7f\x3a\xe5\xfc\x49\x2c\xb1\xd7\x62\xf9\x53\xc7\x3d\x06\x87\xff\xe0\xe3\x1e\x5f\x10\x48\xbc\x98\x7f\x97\x63\x1c\xff\x2b\x9f\xd2\xf8\x82\x38\xf9\xab\xe3\xf4\x9f\xf4\xc4\xc5\x17\xc4\x48\xaf\x88\xf9\x5f\xe1\xd0\xc4\x17\x04\xf2\xef\x11\xf8\x9f\xf4\x00\xc4\x17\x88\x1b\x5f\x56\x07\xff\x75\xce\x32\x7c\x41\x20\xf5\x52\x49\x4c\x5e\x2b\x89\xff\x15\x4e\x1e\x7c\x41\xb0\xf9\xef\x1a\xd1\xff\xb9\x87\x08\xbe\x40\x9c\x79\x39\x52\xd3\xf7\x47\xea\xbf\xf6\xd9\x7f\x2f\x6c\xf1\xf5\x9c\xbf\xff\x3d\x0f\xf2\xfb\x81\x13\x0f\xfd\xd3\x3f\x5d\xdb\xfe\xd3\x80\x8d\xb3\xc8\x8d\xdd\xa4\x2c\x06\xa5\xef\x0e\xac\xb2\xb4\x6c\x7f\x10\xbb\xa5\x9f\x3a\x77\x83\xd2\xb7\xca\x6b\x99\x7b\xa9\xf0\x70\x4b\xce\xa0\x4c\x07\xd6\x60\xe3\xee\x94\xd4\x0e\xdd\xb2\x8f\x09\x5c\x2b\xbe\x7f\x84\xfb\xff\xc4\x67\xc7\x7f\xd0\x5c\x4e\x10\x74\x9c\x34\x29\xa0\x0b\xa4\xeb\x9f\xa7\xaa\x51\x60\xbb\x49\xe1\x0e\x04\x56\x7d\x28\x84\x2e\x0f\xbf\x3c\x11\x51\xbe\xba\xdb\xfd\x29\xba\x29\x6f\xf3\x5b\xf8\xf1\xe2\x91\xdb\x8f\xee\x17\x78\x27\x74\x19\x3c\xbb\x84\xe5\x6f\x7f\x7f\xe7\xb2\x8d\xfb\x2b\x3f\x5e\x5f\x05\x71\x37\x38\xdf\xb3\xf5\xe6\xba\xec\xc1\xf7\x87\x5b\x02\xbe\x9f\x6f\x09\xf8\xb7\x7f\xeb\x2b\xba\xf7\xc5\x85\x49\xe7\x1b\x70\xdc\xfb\xbf\xee\xa3\xaa\xf0\xf1\x6a\xbf\x77\xf3\x8f\x6f\x6e\x78\x22\xb5\xce\x83\xd2\xbd\x75\xef\xff\x7a\xc1\xe6\xc2\xf1\x4b\xf3\x6f\x67\x78\x6f\xcb\x1f\xdd\xd3\xcb\x1d\xf5\x41\xec\xa6\x55\xf9\x3e\x88\xfe\xe5\x87\x70\xce\x2f\xaf\xc0\x5e\xa2\xf6\xf7\x73\x8b\xac\x2a\x7c\x35\x7d\x87\x94\xf7\xaf\x7b\x7a\x1f\xd7\x7f\xf9\xa0\x7c\xf8\x7d\x50\x0e\x7e\x1b\xbc\x8f\xf6\x85\x99\x85\x5b\x3e\x23\xee\x19\x5f\xcf\x57\xa8\xbd\xbe\xa2\xf5\x8c\xb2\xe7\x96\x82\x5b\x14\x96\xe7\x7e\x01\xe1\xe0\x82\xdc\x73\x32\x6f\xcb\x7b\xc7\x2a\xad\x6f\xe7\x2b\xd6\x2f\x43\x73\x2d\x79\xaf\xb7\xc2\x4d\x9c\xeb\xc5\x51\x1f\x09\xe6\xc3\xbf\xf2\x7c\x19\xe5\xe3\xc5\x33\xcf\xe0\x94\xf7\x96\xe3\x50\x27\x37\x29\x97\x41\x51\xba\x89\x9b\xdf\xde\xc4\x17\x1a\x6e\x5e\xd1\xf4\xed\x6e\x90\x5f\x2e\x81\x49\x93\xdb\x9b\x1e\xad\x9b\x17\x78\x7c\x7b\x1f\x9a\x1d\xa5\xc5\x05\x96\xe3\xf6\x9c\xbe\xdf\x05\x3d\x2a\x77\x83\xf2\xdb\x07\x2d\xdc\x3c\x4f\xf3\x0f\x5a\x3c\x11\x70\x46\xfe\x52\xe1\xf3\xfb\x54\x2e\xd2\x91\xee\xf7\x1f\x20\x7d\x5b\xbe\x9c\x5b\xe5\x79\x60\xae\xf3\xea\xb7\x1e\xd8\x7f\xff\xef\x83\xf2\x3e\x77\xe3\xf4\xe4\x7e\x99\x55\xd7\x1b\x9f\x1e\x00\xbd\xc0\xdb\x7d\x7e\x23\xcc\xbb\x6a\xe0\x7d\x1d\xf0\x52\x75\x9c\x6f\x09\xb9\x1b\x3c\xd4\xfe\xb0\x87\xf7\x78\xf4\x31\xec\x4b\xed\x07\xd8\xaf\xf8\x7d\x55\x84\xdf\x7e\xc2\x0a\xd0\x41\xf9\xa4\xd9\xed\xf3\x5d\xb2\xc5\xc0\x4a\x9c\x41\x9e\xd6\x45\xaf\xea\x7b\xd5\xef\x04\xb1\x9b\x14\x41\x9a\x14\x83\x74\x3f\x08\xca\x62\x40\x8a\xc2\xc0\xbd\x98\x8f\x47\xcd\xff\x08\xf3\xbf\xfd\xb7\x01\xc8\xb2\x3c\x7d\xae\xea\x7f\x1d\xc8\x69\x5d\xfc\x36\x50\xf3\x2a\xb1\xad\xf3\x5d\x5b\x3d\xdc\x53\xd0\x83\xed\xa1\xbe\x30\x31\x99\x95\xbb\x49\xf9\xd0\xc3\xc0\x77\x03\xcf\x2f\x07\xbb\xf6\x65\xad\x3c\xad\xaf\xaf\xde\xe2\xf0\xeb\xe0\x72\x31\xee\x9f\xed\xb2\x0e\x9c\xd2\x7f\xd3\xa3\xfd\x70\x66\xf0\x63\x3f\x97\x7a\xb7\x56\x96\x45\xed\xc0\x09\x8a\x2c\xb2\xda\xdf\x06\x41\x72\xbe\x9b\xde\x2a\xdf\x22\xdc\xf3\xb6\x7c\xc0\xa8\x67\xe5\x05\x42\x1d\x94\xfe\xb9\xb2\x5d\xe5\x3d\x22\x8f\x1d\x24\x55\xbc\x73\xf3\x1e\xdd\xeb\xe8\x7c\xfb\x81\xa9\xdd\x07\x65\xff\xff\x7f\xb6\x91\xfd\xdc\x9a\x66\x79\x9a\xa5\x85\xbb\x70\xd3\xd8\x2d\xf3\xf6\x07\xd2\x7e\xbe\xda\xcd\xbd\x7f\x10\xb0\xcb\xd0\x50\x97\x5f\x8f\x97\xbb\xbd\xba\x1e\xf3\x11\x87\xcb\x7c\xbb\x1b\xa4\x77\x83\x1e\xfd\x3a\x48\x9c\xb4\x3e\xdf\x97\x9c\xc6\x59\x55\xba\x8e\x52\xb6\x51\x6f\x54\xdf\x07\x7f\xf7\x56\x3d\x27\x83\xef\x4f\xb7\x74\x9e\x6f\x09\x7a\x48\x76\xea\x56\x54\xb9\xb7\x37\x17\x21\xbc\xe9\x15\x66\xaf\xee\x05\xab\xf4\xef\x63\xab\xb9\x85\xef\x3e\x6f\x77\x1e\xfa\x9b\x6f\xe7\x0b\x3f\xa7\xef\xf5\x1c\x7d\x85\x80\xf7\x1a\xf6\xfa\x24\x19\xfc\x3a\xb8\x7d\xec\x3f\x7a\xa7\xff\xcc\x72\x9c\x20\xf1\x7e\x2d\xd3\xac\xc7\x62\x38\xf8\x52\xed\x5d\x5a\x96\x69\x7c\xf3\xed\xdb\x7b\x1d\xdb\x83\xef\x03\xeb\xcb\x1d\xe7\x57\xbe\x7d\xb5\xeb\xc8\xdd\x97\x1f\x74\x5c\x9d\xaf\xac\xbb\xcf\xd3\x9a\x48\x93\xd2\x0a\x12\xf7\xec\x7f\x3d\xff\x7d\xbf\x0f\xf2\xe2\x61\xa0\x09\x3f\x88\x9c\xde\x10\x0c\xbe\x0f\xaa\xfb\x20\x49\xdc\x9c\x51\x85\xe5\x5f\xde\xd5\xbb\xd5\xfd\xf9\x06\xd9\xfb\xeb\x04\x1f\x7c\x1f\xdc\x5c\xa6\xf8\xcd\xdd\xf3\xc6\x7d\xf9\xe6\xe6\x72\xbb\x6b\x75\xbe\xc8\x3d\xad\x92\x1e\x71\x22\x0a\xdc\xa4\x94\x5d\xbb\xbc\xfd\x76\x7f\x1e\xf5\xbb\xf7\x60\xde\xdc\x9d\xa7\xd0\xc7\x4d\x2f\x82\xf6\xba\x4f\xe7\x72\xf3\xec\x23\x07\xfd\x01\x34\x28\xbf\xdd\x0d\xd2\xe7\x85\xf6\x00\x3a\xdf\x0f\xfb\x8e\xfb\x61\xa7\x51\xf1\x72\x61\xe0\x91\xfc\xb3\xce\xce\x5f\xb9\x25\xaf\x8c\xfc\x3e\x28\x7f\x30\x9f\x1f\x2f\xd9\x7a\xad\x07\x6e\xdd\xd7\x57\x6d\x5d\x5d\x98\xdc\x2d\x82\xce\xbd\xcd\xef\x7b\xdc\xee\x06\x79\x3f\x8e\xc5\xc7\x36\xf4\x33\xf5\xf2\xb1\x2d\x7d\x8d\x4c\x6f\x54\x3f\xee\xe3\x35\x99\x1f\xc3\xdd\x07\xe5\x3b\xb0\xfe\x94\x75\xae\xa2\xa8\xb0\x73\xd7\x4d\x06\x67\xed\x7e\xbe\xce\xec\xac\xed\xef\x0f\xc5\x0f\xcc\xc0\x63\xd3\x67\x8f\xff\xb9\x8c\x42\x99\x7a\x5e\xe4\xf6\x34\x2a\x17\x1a\x7f\xe8\x27\x9e\x05\xe9\x8d\xc4\xbc\xe3\x1e\x3e\xe8\x76\x3b\xb2\x8a\xa2\xf7\x07\xef\xed\x8b\x16\x28\x6e\x6f\x9e\xd8\x71\xf3\x6d\xf0\x2f\x83\x9b\x8b\xe7\x78\x33\xf8\x6d\x70\x63\x39\x4e\xff\xb7\x87\xf1\xf0\xfc\xf0\xfa\xee\x3d\xa8\xbf\xe7\x7f\xbc\x84\xf7\xa1\xf4\x3c\xd1\x5a\xbc\x43\xeb\x1b\x42\xdf\x32\xe7\x1f\xe7\xf2\xa9\x7e\x50\x0c\xae\x22\x93\xe5\xe9\xe9\x7c\x3b\xdf\x25\xee\x2f\xce\x02\x76\xf1\x5f\x83\xc4\x1b\x58\xaf\xa2\xfe\xeb\x2f\x27\xfd\x38\xfe\xff\x5c\x2a\x1f\x01\x3c\x3d\xfd\x97\xc8\x06\x3c\x62\x0b\xfe\x4f\x5a\xe0\x7f\xeb\xb4\xc0\x83\x29\xe3\x14\x71\x75\x7f\x36\xae\x0f\x39\x80\x77\xee\xac\xbe\x29\x4a\x27\xad\xca\x9b\x41\x3f\xf6\xbf\xc3\x7f\x9c\xef\xb2\x7c\x2f\xb1\x90\xff\x3e\xfa\xe3\x79\x5a\xe1\xfc\xfb\x1f\x94\x55\x38\x63\x5a\x9c\xef\x08\x0e\xf6\xed\xed\xef\x3d\x52\xe7\xdd\x03\xee\x87\x5d\x94\x4a\xd0\xb9\xff\x9e\x1e\xdc\xf2\xaf\xbd\xfd\xbe\xb9\x7a\x60\xbd\xde\x3a\x5b\xf2\xf7\x7a\xfc\xc7\xa6\x38\x2e\xaf\x2e\xee\xc3\xcd\x73\x72\x7e\x98\xfe\x78\x9c\xe2\xe4\x4f\xe7\x41\x3e\x69\xfa\xca\x57\x7a\x55\xf3\xbf\x72\x66\xe4\x07\x2a\xf1\xf3\x14\xc9\xab\xc6\x5f\xcd\x95\x7c\xca\xbe\x2f\xf4\x46\xfe\x03\xb2\x27\xff\x98\x8d\x30\xd7\x96\xbd\x3a\xe9\xa3\x85\xfc\x16\x99\x5e\x3d\xf6\x9b\x2a\xb9\x00\x72\xce\x7a\xa3\xa7\x3b\xdd\x0f\x12\xeb\x14\x78\x56\x99\xe6\x97\xa8\x36\xed\x3d\x94\x24\x75\xce\x6e\xcb\xe3\xbb\xfb\xaa\x70\x73\xe0\xb9\x49\xf9\x7c\x77\xc8\xc7\xd5\xb3\xc8\x2a\xf7\x69\x1e\x5f\x91\x29\xef\x83\x82\x0e\x72\x77\x9f\x36\x83\xef\x83\x5f\x7e\xf9\x7f\x8b\xfb\x20\x71\xdc\x46\xdc\xdf\xde\x5c\xcb\xcf\x57\x84\xdf\x07\x85\xa0\xb0\xd4\x9b\x3a\x7d\xe1\xcd\xb7\xde\xc6\xbd\x2c\x57\xf3\xc0\x71\x93\xf2\xb1\xad\xd5\x07\x89\xc1\x93\x47\xf6\xfb\x8d\x60\xd9\x41\x52\xa6\xe7\x8b\xa9\xfa\x1f\x6c\x52\xba\xd1\xf5\x79\xbd\x26\xae\x4f\x93\x19\x7f\xf3\xc7\xdd\x20\xb9\xc2\x61\x33\xcb\x39\x47\x62\x6b\xeb\xcc\xaa\xef\x83\xe4\xe1\x85\x7f\xb9\x9b\xfb\x26\x58\xf7\x4f\x2f\x5e\x0a\xca\xe6\x1c\x50\x17\xaf\x71\xb8\x16\xf7\x7d\x6d\x82\x64\x34\xb9\x3e\xa0\xc8\xf5\x81\xa0\x9e\x77\xbe\x0c\x92\xaa\xe7\x52\xf2\x44\xe6\xb9\xe8\xe6\xdb\xe0\x9f\xbf\x0f\xe0\x9f\x93\xa1\x4b\xe1\x63\xf5\xe0\xad\x2a\x08\xf6\x83\xdb\xde\xe4\xf6\x12\xe1\xde\x67\x96\xe7\x6e\x3f\x4a\x84\x3c\xde\x78\xdc\x9b\xa9\x6b\xdd\x4b\x48\x7a\xf9\x61\xfc\x65\x50\x9e\x15\xc3\xf9\x3e\xe3\xc2\x8d\xf6\xf7\x4e\x6a\x57\x67\xc7\xf6\xe1\xe1\x1a\x23\xff\xe5\xdb\x20\x1f\xfc\xda\xc7\x6d\xe9\x7e\x5f\xb8\xe5\xd2\xdd\x97\x3d\xa8\x67\x45\x6a\x9a\x5d\x82\xd6\x9b\xcb\xef\xf5\x39\x99\x72\x33\x08\x92\xb3\x3a\x7a\xa8\x76\x29\xee\x95\xd2\xcb\x74\xcb\x5b\x5f\xeb\xf7\x5e\x03\xfc\xf1\xb8\x9c\xf5\x8a\x35\xe9\x93\xb7\x95\xde\x0d\x8a\x7e\x3c\x5e\xc7\x1b\xbd\x69\xbc\x72\xf0\x2d\x74\xeb\xb2\x83\xec\x9c\xa1\xb1\xdd\x20\xba\xbd\x3d\x97\x0c\x07\xb7\xc9\xe0\x5f\x06\xf9\x25\x36\x1f\x40\x03\x64\xf0\xdb\x00\xfe\xf6\x6d\x00\x3d\x94\x7d\xbb\x1b\x58\xbf\x8f\x5e\xb6\x3d\x17\xf4\x35\x2e\x61\xf9\xb9\xca\x13\xf8\x38\x48\x6e\x1f\x33\x41\xfd\x8b\xbb\xc1\xe8\x3c\xc5\x87\xe7\xbf\xcf\xa1\xbd\xaa\x3a\xba\x56\x2d\x1e\xaa\xbe\xe2\xc6\x9f\xdc\x77\x77\xce\x20\xa5\xb9\x53\xc8\x6e\x64\x95\xc1\xc9\x55\xd3\xeb\x28\xf4\x1c\x7b\x51\xa3\x57\x19\xd7\x02\xd9\xaa\xf1\xb6\x74\x1f\xcb\xdf\xf3\x7b\xef\x06\xc5\x9b\x61\x38\xab\x9d\x57\x75\x2e\xe9\xb1\xe4\xcc\x8b\xe8\xfc\x34\xfa\xe3\x9d\x31\xea\xfd\x3c\x14\xe9\xab\x5c\x1f\xfe\xd6\xfc\x36\xb0\xee\x06\xed\x6f\x83\xe8\xef\x8f\xbc\xf8\x8f\x50\xd2\xe8\x55\x47\x7f\xe0\xa1\x3f\x16\xbb\xef\x85\x74\x7e\x50\x9c\x23\xc8\x7e\x26\xde\x0d\x82\xfb\xf3\x86\xcc\x1e\x1f\xab\x8a\x4a\xa5\x4c\xf3\x8b\x07\x9a\xb8\xf5\x20\xb8\x8f\x82\xdd\xfd\xb5\xec\x5e\x70\xe3\x34\x6f\xaf\x77\x8b\xf7\x8d\x1e\x6b\x5d\x40\xa8\xd7\x18\xed\x59\x8d\x4b\x0e\xcd\xdd\x17\xb7\xdf\xee\x0b\xb7\xbc\xbd\xe9\xdd\x86\x5f\xdd\xc4\x4e\x9d\x20\xf1\x7a\x8d\x96\x5b\xf5\xcd\xb7\xe7\x2d\x1c\xd7\x4e\x73\xab\x74\x6f\x1f\x11\x7d\x78\x1d\xa4\x0f\x17\xa4\x9f\x2b\x06\xe9\x7d\xef\xbc\xde\xbe\x68\x1d\x24\x45\x69\x45\x11\xef\xb6\xbb\xd4\xca\x9d\xdb\x97\x97\x95\xbf\x19\xe3\xe7\x46\x3d\x48\xf6\x9f\xf0\xf4\x59\xa3\xbf\xd9\x0f\x29\xfd\x73\xbf\xd7\x5f\x77\xd7\x2c\xd5\xb9\xac\x7f\xfc\xfb\x87\xee\x43\x5a\x95\x59\xf5\xa3\x64\xd5\x59\xcd\xfe\xf2\x92\xe0\xb3\xbe\x7c\xce\x80\xb3\x9b\xae\xa9\xf4\xec\xd6\xfd\xd8\x5b\x29\xfc\xb4\x7e\x2f\xb2\x78\xd7\xd1\xbb\x5c\x1c\xff\x58\xbb\xaf\x33\xf8\xe7\x01\xdc\xeb\xd0\xe7\x1d\xf7\x30\xc5\x93\x9b\x47\x56\x7b\x85\xf3\xdb\xa7\x15\xce\x97\x59\x7f\x88\xe1\xc5\x35\x7c\x0f\xc7\xf7\xf1\x7b\xaf\x93\xe7\x88\xdf\x0d\xe0\x4f\xd8\xe1\x96\x17\x1b\xab\x06\x65\xf4\xa3\xf0\xe2\xa9\xbb\x97\xcd\x3e\xe5\xf7\x45\xe6\xdd\xdc\x4d\x6c\xb7\xf8\x42\x4e\xb2\xbc\xca\xf5\xab\xd0\xed\xaa\x29\x42\xb7\x2d\x6e\xdd\x6f\xf7\xfb\x34\xa7\x7a\xaf\xf1\x09\x5a\xfe\x41\x34\xf4\xde\xcc\xeb\xc3\xfd\xdf\xf3\x3f\x5e\xc7\x3d\x1f\x53\x91\x26\x6c\xf2\x63\x29\xbd\x4e\xcc\xfb\x34\xd1\x55\xde\x6d\x8b\x32\x4f\xc3\xaf\xc4\xaf\xee\x6d\xf9\x4e\x08\x76\x05\xd6\x2b\x09\xe5\x1c\xc7\xfd\x39\x48\x9f\xd0\x24\x9f\x23\xb2\x3f\x3f\x26\x4f\xf4\x3e\x68\xbb\x77\x20\xbe\x1b\x73\x5c\x86\xe6\x61\x69\xf3\x7b\x6f\x8c\xca\xb3\x9e\xb8\x58\x3d\xf7\xf6\x55\xc8\xf1\x39\x25\x8e\x6b\xd9\x65\x70\xb2\xca\xaf\x4c\x97\x77\xc6\xe7\xba\x75\xf8\x3d\x86\xbf\x7c\xf5\x0e\xa1\xcf\x2a\x9c\x25\xad\x4a\x3e\xd3\xba\xaf\x67\x7a\xe1\xfe\x20\x7f\x7d\x51\xa0\xcf\x35\xc2\xd7\x55\xfc\xcb\xce\xce\x41\xf5\x57\xd5\xc9\x0f\xa9\x78\x48\xe8\x7d\x7b\x0c\x53\x98\xab\x11\x4c\xff\x23\xbc\x80\x2f\xd8\xfe\x0f\xf5\x7b\x95\x47\x57\xdd\xde\xff\x3a\x33\xcc\x4e\xa3\x73\xf2\xf2\xeb\xf6\xd2\xce\xdd\x1f\x8a\xdf\x43\x68\xe0\xd6\x83\xf4\xf6\xa1\xeb\xd7\xdd\xfe\x90\xcf\x44\x9a\x24\xee\xb9\x0b\xda\xb2\xcb\xf4\xbc\xb0\x12\x3c\x63\xc7\x17\x5d\xa1\x0f\xd9\xb1\xb3\xf2\x07\x8f\xe7\x31\x9f\x7c\xa9\xfe\x75\x6e\xa4\xd9\xab\x64\xfa\x8b\xae\xfe\xa4\x60\xf6\x88\x5d\x2a\x7f\x22\xe5\xfd\xe4\xfd\x8a\x96\x3e\x03\x7b\xb3\xc1\xe7\x15\xb0\xa0\x10\x3f\x25\xe4\x19\xf5\x4f\x40\x73\xd7\x72\x5a\xa5\x3c\x4b\xc3\xf7\x27\x16\xde\x13\xe2\x6a\x45\x11\x2a\xbb\x5a\xf4\xc1\xf8\x8f\xeb\x8b\x6b\x6a\xf5\x89\xee\x7e\x83\xd9\x67\x84\xa6\xc9\x9b\x11\xf9\xc8\x7e\xfc\x94\xf9\xb0\xdd\xe0\xf4\x25\xa7\xe1\x8a\x45\xfc\xe5\x94\xee\x47\xbb\xb8\x3e\x41\x87\x78\x23\x47\x9f\x23\xf3\x56\xee\xfe\x1c\x4f\x3e\x9b\xa2\xff\xd3\xf4\xe1\xdd\x65\xe9\xf4\x51\x75\xfd\x7e\x53\xbb\xbb\xb2\x6c\x6f\xfe\xe8\xdf\xc4\x85\x77\xf6\x5f\xb4\x24\x4c\xd2\xba\xc7\xea\x06\xbe\x79\xfe\xa2\x2f\x19\x3d\x94\xac\x2f\xa6\xef\x06\x79\x28\xb8\x18\xbb\x07\xd3\xd7\xbf\x42\x1f\x5e\x5d\x21\x8a\x0f\x4e\xfc\x13\xdc\xa7\xa2\x27\xc0\xe9\x2b\xc0\xca\x6b\xef\xf3\x09\xb0\xf2\xda\x6f\xbc\xc1\x9e\xbd\x92\x5d\xfb\xc2\xe3\xfe\xc5\xf8\xe6\x4f\x59\x83\x0f\xd2\xa1\xcf\x03\xba\x07\xa3\x60\xbf\xa3\x73\xcb\xeb\x3b\x2b\xf7\x1e\x3c\x98\xf3\xcf\xaa\xf4\xd5\x34\x3c\xcf\xb6\xe0\xee\xc1\x7e\x3f\x61\xfb\xeb\xe8\x1f\xa5\x46\x1f\x28\xbe\x26\x68\xaf\x2e\xda\x25\x16\x7e\x1f\xeb\xab\x95\xba\xfd\x76\xc9\x57\xfe\x60\x49\x2b\xbd\x6a\x99\xdb\x1f\xd4\x1b\x3c\x5b\x81\x09\x1e\xbc\x92\x7d\x7a\xfb\xde\xe2\xcb\x05\xee\x7b\x0b\x14\x7f\x03\xb9\x77\x4e\x73\x15\xbf\x0d\x82\x33\x53\xef\x06\xe0\x81\x97\xe7\xa2\x87\x1f\x7f\xff\xf6\x11\xe4\x1e\x8b\x37\xe9\x90\xf7\xe3\x82\x57\xc8\xbc\x27\xe6\xc3\xc1\x6b\x14\x1f\x43\x5d\xf7\x21\xbe\xcd\xff\xfe\x7a\xf1\xe4\x71\x6c\x3f\xc0\xf1\xca\xa1\x07\xe7\xfb\xb6\xe8\x07\xe3\xb2\xa7\xe2\x1a\x38\x5f\xb6\x55\xdc\x3d\xd5\x3c\xcf\xd0\x0f\x97\x4e\x3f\xa3\xe8\x32\xb7\x87\x83\xd7\x9b\x58\x1f\x91\xfc\x76\x37\xe8\xa7\x5d\xe1\x96\x6c\x52\xba\xf9\xc9\x8a\xbe\x32\xda\xaf\xbb\xe9\x15\xc6\x47\x3d\xdc\x0d\x50\x17\x7b\xe7\x65\xdf\x75\xfa\x64\x44\xbe\x44\xde\x53\x02\xb5\x88\x02\xdb\xbd\x1d\x7d\x24\x08\x45\x1d\x94\xb6\x3f\xb8\x75\x7f\x87\xff\xf8\x94\x0a\xdb\x2a\xdc\xe7\xea\xea\xb7\x8f\xab\x0e\x9e\x0d\xde\xb9\xee\xad\x55\xa6\xbb\xdb\xfc\x43\x69\x7c\xf8\xb7\xcb\x5d\x2b\xfc\xa4\xce\x13\x0e\xbd\x7e\xfc\x01\x06\x5f\x07\xf6\x52\xb7\x7e\x8d\xb0\x57\x61\x7d\xfe\x8f\x23\xed\xa5\x3a\xff\x01\x36\x0f\x6e\xec\xb3\xf5\xdc\x1f\xa2\xf2\x44\xc1\xb3\x7e\x6e\xd3\x7f\x28\x05\x8f\x56\xe7\x0b\xf8\x17\x3f\x89\xbf\x9d\x26\x45\x1a\xb9\xf7\x51\xea\xdd\xde\x50\x89\xb5\x8b\x7a\x2b\xfc\x68\x39\x7e\x1b\xdc\x0c\x86\xe7\xe4\xf3\xcd\xa0\xe8\x0b\x9d\xe2\xe6\xac\x23\x9e\xdb\x96\xe2\x83\x39\xf8\xf1\xec\x3b\xfb\x4c\x5f\x99\xf2\xe7\x1d\x0a\x8f\x3a\xc2\x7d\x52\x4f\x4f\xb1\xf7\xed\x53\xe1\xb3\x24\xdb\xed\xcd\x33\x6f\xe8\xdc\x9d\x73\x73\x37\x80\x5f\xe1\xfe\xcf\x03\xf8\xbc\x0e\x9f\x5f\x94\xd1\xc3\x6e\x81\xaf\xea\xa2\x87\x65\xa3\x0f\xcd\xdd\x15\xaf\x73\xc0\x7d\x36\x7f\xaf\x7d\xba\x47\xc6\xdc\x0d\x46\x2e\x3a\xf8\xa7\xe7\xd8\xbd\xa7\xe2\xaf\x0c\xec\xcd\xe3\x6b\xef\xf0\xfd\xdd\x8a\x45\xdf\xed\x0f\xe8\x79\xb1\x0d\x24\x3f\xf7\xf0\x26\xde\x19\x7c\xc5\x01\xdd\xb8\x3b\x55\x35\x7a\xae\xfc\xc7\xa4\xe4\xe1\x6b\x4a\x3e\xbf\x45\x1f\x5e\x06\xf7\x51\x6a\x39\xc0\x71\xd2\xe4\xf6\x66\x1f\x94\x37\xcf\x5b\x7d\xe2\x8d\x7c\x9a\xbe\xff\x51\x6e\xea\x29\xb3\xff\x26\x4d\x7f\xf7\x26\xb9\x7b\x9f\xd6\x89\x9b\x93\x0f\xcb\x6e\x17\xe1\xb9\x2e\xc6\xdc\xde\x38\xc1\xe9\x31\x35\x7f\x6d\x74\xd9\x72\xb6\xb2\xe2\xb3\xe7\x7a\xde\x52\xf5\x6b\x7a\x49\xc0\xde\xbc\xac\x79\x1d\xd3\xc1\xf7\x01\xe2\xa2\x8f\x4e\x61\x6f\xff\x1f\x16\xff\x7f\xec\x8e\x5d\x33\x99\xfb\xe0\x2c\xc0\xd7\x5f\x85\x9d\xa7\x51\xa4\xa6\xf8\x79\xfb\xef\xe5\xc5\xf3\xc9\x77\xc9\x63\xdd\x5e\x6b\x9f\xf3\x0b\xbd\x0a\x69\x7a\x65\xf2\xf2\xdd\xd5\xe9\x28\x5f\xa1\xfc\x41\x5e\xf2\xea\x99\xdc\xde\xf4\x33\xe0\xe6\x87\x82\x5d\xbe\xa2\xb6\x47\xf4\xba\x85\xfa\xed\x16\x8e\xc7\x7d\x22\x5f\x98\xfd\x6f\x01\xbf\x37\x55\x5f\xa7\x79\x5f\x10\xd1\x4f\x61\xf7\x6e\xf0\x0b\xfc\x50\xec\xb8\x76\xea\x5c\xf6\x53\xb9\xf5\x20\x3d\xaf\xfa\x68\x2a\x3d\x23\x2f\xe5\x5f\x77\xdf\xff\xec\x1a\xca\xe3\x58\xbd\x58\x45\x79\x1c\xa5\x7f\xe7\x52\xca\x13\xb0\xeb\xd7\x52\xcf\x68\xbe\xfe\xbd\x75\xbf\xfd\x83\x16\x50\x1e\x37\x19\x7f\x30\x41\x1f\x66\x52\xe9\x36\x25\x91\x26\xe5\x65\xdd\xf3\x61\xbe\xf6\xd3\xf7\xde\xca\x32\x37\x71\xce\x3b\xc3\x5f\x2c\x6b\x7c\x7b\x3b\xc5\xce\xfb\x90\x5e\x28\xd1\x37\x35\xbe\x3d\x2c\xe1\xf4\xd6\xe6\x6d\xfb\x9f\xb1\x3e\xf9\x05\xbf\x4b\x72\xf6\x82\x5f\xfe\x88\xdc\xdb\x69\xf3\x09\x4b\x7f\x76\xc5\xe7\x81\x6b\x97\x55\xfb\x55\xea\x9c\x53\x47\x4f\x1a\xef\x61\x61\xec\x0d\x7e\x2f\xf8\xf7\x49\x0e\xed\x27\xd6\x84\x1e\xb7\x29\x94\xd7\xca\xee\x67\x70\xbf\xb6\x14\xf4\xe7\x17\x5f\x1e\xf5\xd2\x75\x6f\xd6\x9f\x58\x2f\xf9\x6c\x11\xe8\x4b\x0b\x26\x2f\x11\x79\x47\x9b\x7d\x9c\xf1\xba\x4c\xfa\xf2\xf5\xc6\xfa\x1f\xe0\xf5\x53\xcb\x1f\x17\xc4\x1e\xb7\xaf\xbd\xd4\x85\x7d\xf1\x15\xe1\x17\x2f\x76\x51\x95\xff\xff\xbb\x8e\x71\x9e\xb5\xff\xbe\xd5\x8b\xab\x41\x79\x77\x67\xdd\xe3\x28\xbc\x63\x7a\x5f\x2d\xb8\x17\x65\x9e\xb6\x3f\x5e\xee\xd8\x5e\x9d\x89\xe2\x3f\xef\x72\xc7\x7b\xb9\x8f\x2b\xa5\x4d\x69\xe5\xae\xf5\x22\xc3\x15\x67\x69\x11\xf4\xad\xf5\xc0\xad\x9f\xe5\xb7\xca\xa7\xb4\xdf\x43\x8e\x2b\x28\x88\x4b\xf5\x73\x3a\xef\x97\xd1\x63\xb1\xe2\x5e\x3e\x8f\x79\x02\xf6\xfc\xfd\xb3\x3e\xd6\x4f\xaf\xdf\x99\x0a\x45\x69\xe5\xe5\x6f\x97\xa5\xb5\x77\x26\x4a\xe2\xfc\xf6\xde\x56\xe7\xaf\x1b\xe6\x67\x88\x9c\xbb\xfa\xca\xaa\xe1\x4b\x92\xe1\x8f\x49\xba\x7f\x00\xf9\x82\xd5\xf7\xe7\xc1\xbc\x8f\xdc\xc4\x2b\xfd\xf7\x79\xfe\xca\x08\xde\xdc\x7c\x50\xed\xe9\xf3\x89\xf3\x71\x64\xe7\xb9\xef\x7e\xf2\x95\xc3\xb3\xf6\x55\xe6\xbc\xd6\x12\x3f\xef\x54\x7f\x8e\xb6\x7b\x4e\xdb\x5f\x51\xbf\xf4\xf7\x4c\x1c\xae\xee\xf4\x39\x18\xfa\xba\xa5\x2d\xdf\xe5\xf3\x65\xa5\xa7\x7c\x9f\xc5\x6f\xec\xef\x27\x7b\x20\x9e\x01\x7f\xbd\x7a\xf4\xbe\x30\xec\xfb\xf9\x10\x74\xcf\x09\xbb\xfd\xe5\x93\x0e\x42\xb7\x75\x2e\x69\xf7\x1f\x7d\x60\xf9\x46\xd6\x1e\x96\x8a\xde\x9b\x5b\xef\x7f\x22\xbf\x1f\xdc\x22\xc8\xfc\xbc\xbb\xf2\xdc\x33\x91\x3a\xee\xe3\xb6\xc4\x5f\x46\xef\xa4\x21\xfa\x26\xa3\xc9\xcb\x16\x7d\xc7\xa3\xe9\x3b\x65\xb3\x9f\x81\xfc\x31\xb7\x46\x1f\x87\xb4\x83\xa7\x49\xdb\x13\xf2\xcb\x6b\x14\x2e\x4c\xf2\xad\xc4\x89\x5c\x90\xb4\xea\x75\xf8\x89\xf3\x99\x7e\x67\xc9\x7a\x01\xfd\xcd\x17\x63\x6f\xb0\xf9\xf3\xf3\xe1\x71\xc8\x3e\x9e\xa3\x17\x73\xf4\x34\x4d\x3f\x57\xa1\x17\xe7\xf5\x4a\xd1\x83\xa4\xf7\x24\xbd\x9f\x14\x7d\x70\xaf\xff\x76\xd5\x98\x9f\xeb\xa4\xbb\x8b\xea\xfc\xb0\x92\x9b\x38\xef\x65\xad\x3f\xd5\xed\xf0\xcf\xcc\xe3\x47\x96\x7d\x5d\x9c\x1f\x91\xf8\xd8\xba\x7c\x92\x58\x3b\x2f\x8f\x3c\xee\xc7\xff\xa4\xa2\x7b\xd6\x24\xcf\x07\xe5\x5f\xde\x6a\x96\xa2\xda\x5d\x16\x03\x6e\xf3\x07\x86\xe6\x3d\xd3\xce\xdb\xbd\x7e\x58\xf9\xdb\xb3\x4f\x0d\xa2\xab\xf8\xe6\x6f\x8e\x97\x78\xf8\xf7\x5e\xce\xee\xa5\x12\x3b\x17\x0d\xdc\xa8\x70\xdf\x55\x99\x9f\x1a\xe4\x77\x38\xf1\xe0\x5a\xbc\x67\xb5\x9e\x68\xf9\x91\x80\x7d\x26\x5b\xef\x65\x40\x5f\x78\x18\x8f\x4c\xf9\xfa\xce\x9f\x8f\xb4\xc0\x17\x56\xcd\x1e\xd7\xcb\x3e\xb0\xd5\xaf\xb0\xfd\xba\x94\x5f\x3f\x94\x7f\x26\x4c\x5f\x58\xd0\x78\xc5\xf1\xdc\xcd\x22\xcb\x76\xcf\x4e\xe1\xcd\x47\xa9\xe3\xfc\x6a\xeb\x1e\xe2\x59\xf7\x2d\x27\xf3\xf7\xf2\x21\x3f\x63\x1b\x3f\xb4\xe0\xff\x00\xa5\xf9\x23\x0e\x3d\x4f\x1e\x3c\x91\xf6\xf0\xbd\xe7\xb1\x72\xf3\x56\x71\x23\xd7\x2e\xd3\xfc\xf6\xe6\xb1\xc2\xaf\x76\x95\x17\x69\xfe\x2e\xd7\xfa\xae\x3f\x5e\x0f\x7c\x35\x05\x7e\xd0\xdf\x25\xdf\xd7\x47\x6a\x37\xdf\x9e\xf6\xdd\x0f\x86\x83\xfc\xe9\xd7\x07\x03\xf7\xae\xc9\xb8\x7c\x78\x1e\xb9\xfb\x9e\x6d\xf9\xb3\xcd\xfd\x83\xe1\xe0\x26\x6b\x3e\xf2\x06\x2f\xcd\xca\x34\x1b\x7c\x1f\x04\x5f\xa9\x7a\x3d\xb7\xe3\xa9\x0f\xe6\x52\xf0\x85\xa6\x51\x90\xb8\xcc\xa7\xcd\x3f\x59\x98\x7d\xb6\x2c\xfd\x12\xf6\x07\xdf\xd5\x7f\xc6\xbb\xc7\xd9\xf2\x45\xa6\xbd\xaa\xff\x2e\xb7\x5e\xd5\xb9\x7c\x74\xf0\x7d\x90\x5e\x9f\x3e\xab\xfb\xc8\xd2\xf4\xe1\xf1\xb3\xda\x2f\xb8\xf8\xaa\xc5\x0f\x67\xeb\xe0\x6c\xac\xfe\xed\xdf\x7e\xd2\xea\x3e\x7e\x75\xf5\xb1\x47\xfe\xcb\x6b\xe3\x32\xf8\xc0\xe6\x7c\x12\xa3\xbf\xe3\xbc\x7c\x29\x25\xf1\xde\x68\xde\x7c\x3a\x78\x37\x37\x3f\x0a\xd0\x9f\xd1\xc8\xb8\x51\x76\x4e\xee\xfd\x07\x2d\x87\x20\x8f\xcb\x21\xd8\xe7\x5b\x2f\x7e\xfc\xad\xc2\x5f\x9f\x85\xe3\xee\xd7\x23\x5e\xcb\x71\x08\xdf\xca\x7f\x9c\xb0\xed\x35\xa4\x3b\xf8\xe7\xef\x83\x9b\xc1\xcd\xa7\x4a\x39\xb9\x2d\x3f\x34\xe8\x8f\x48\xde\xdb\xbe\x95\x17\x6e\xf9\x98\x91\x7c\xf3\xe6\x77\xf7\xf2\x01\xee\x83\x39\x7e\xb7\xc6\x7b\x1d\xbd\x50\xd6\x4f\x8d\xda\xc1\xf0\x4d\xd1\xce\x2a\x5e\x5b\xf3\x07\x52\x7f\xc9\xdf\x41\xad\x79\x0c\x6a\xce\x7e\xe3\xed\xab\xd7\xfd\xfc\x3d\x1f\x1c\x73\x1b\x7c\xfb\xfd\x75\xd3\xc1\xaf\x83\xd1\x1f\x4f\xc9\xe5\x9f\x69\xf5\x3b\xf2\xc7\xc3\x87\x05\x3f\xd5\x6c\xf4\xc7\x60\xf8\x7d\xe0\x3e\x7c\x73\xf0\xf5\xb6\xc8\x9f\x42\x14\x79\xec\xf1\x21\x96\x79\x7a\x7f\xd1\x2e\x72\xef\x82\xbd\x06\xdb\x7e\x7b\x77\xa3\xc4\xa3\x33\xf0\xbc\x93\xe1\x20\xef\x49\xeb\xc5\xf0\xb5\x4c\x9c\x57\xb0\xde\x69\x54\xe7\x56\x66\xe5\xbd\x19\x11\xce\x51\xe9\x1b\x98\xdf\x07\xf0\xdd\x60\x38\x7c\x23\x2e\xff\xfc\xba\xea\x65\x49\xed\xb2\xa0\x36\xf8\x97\x37\x1d\xb5\xbf\xfe\xfa\x86\xec\x4b\x93\x5e\x83\x7e\xfb\x6c\x14\xde\x70\xe4\x3e\x28\x36\xb9\x95\x65\xae\x73\x0e\xa5\xfe\x72\xf1\xe6\xcf\x11\xfc\xe5\x68\x82\x07\x41\xfc\x80\x71\x3f\x21\xff\x6f\x70\x0e\x92\xc2\xcd\xcb\x0b\xb3\x1e\xbf\x4e\xec\x95\x14\xfc\x97\x41\x3a\xf8\x1f\x83\xfc\x2f\x83\xe1\x30\xfd\xf6\xf0\x15\xf3\x57\x69\xfa\xa0\xfb\x6f\xf7\x59\x9a\xdd\x7e\xfb\xfd\x22\x72\x3f\x21\x71\xe7\xed\x8c\x0f\xa2\x8a\x7c\x8e\xcb\x27\xad\x7f\xff\x79\x59\x7f\xea\xf9\xfb\xe0\xcd\xbb\x2a\x07\x65\x99\xdf\xf5\x5a\xf2\x6e\x30\xfa\xe3\xed\x4c\x78\x0e\xf9\xbe\xc8\xce\xdb\x95\x5e\xcb\xe4\x5d\x2f\x92\x3f\x04\xfd\x63\x25\xfb\xe9\x8c\xfd\x14\x7d\xf7\x6e\x90\xff\xf1\x06\xf7\x66\x38\xfc\xb9\x99\x7d\x77\x1d\x99\xfc\xa7\xf5\xc9\xe7\xcc\xbd\xb9\x1b\xc0\xef\xa2\xf7\x65\x97\x64\xe7\x46\xd1\xd7\x63\xc1\xf7\xb2\x9d\x4f\xfd\x9e\x82\xa2\xb2\x22\xbc\x87\xf8\x0e\x99\x0f\x61\xc2\xc5\x49\xd9\xa5\xb9\xe3\xe6\x44\x1a\xa5\xbd\xad\xbc\xa9\xfd\xe0\x7c\xcd\xc4\x4f\x2c\x2e\x3e\xd8\xf0\x2f\xf6\x70\xf3\x26\xa8\x1b\xc1\x6f\xa5\x32\x4b\x33\x31\x79\xa0\xe0\xd5\xbb\x7d\x6a\x57\xc5\xed\x27\xab\x94\xfd\x40\xd2\xae\xfb\x95\x04\xe9\xf3\x59\x94\x9c\xdc\xbc\xa4\xd2\x77\x99\x76\x56\xcb\xdf\xfe\x84\x5e\x7e\x07\xd6\x27\x8a\xf9\xdb\x5b\x4e\x34\x1f\x18\x97\xf7\xdc\x81\x5f\x7f\xfd\xd8\xe5\xb5\xf2\x3c\xb0\x3c\x57\xbe\x0c\xd7\xcf\x70\xe6\x4c\xfb\xc7\x82\x6b\xd9\x61\x91\x59\xf6\x57\x96\xf4\x5e\x50\x75\x49\x05\xfc\x04\x09\xa5\xb5\xfb\x69\xbc\x5f\x15\x25\x6e\x53\x2a\x65\xaf\xe0\x3f\xd9\x35\x10\xec\x4b\xb1\xfa\xca\x52\xcb\xb3\xe1\x73\x4b\x6f\xe9\x9e\xdc\xe8\xf6\x93\x84\xee\x19\x32\xfb\x73\xbc\x7f\x02\xfc\x49\xea\xe3\x62\x21\x7b\xb7\xf9\x4b\xc9\x8e\xc7\x23\x0e\x5f\x69\x91\xb3\x71\x3d\x9f\x0a\x72\xd9\x8d\xfa\x3f\x06\xa3\x8b\xf8\x0e\xbe\x9f\x3f\x24\xcf\x7f\xc6\x88\xbf\x63\xf1\x9b\x4b\x6c\xf1\x5a\x8b\xba\xb9\x55\xb8\xbd\x1e\xfd\xff\xd8\x7b\x1b\xf6\xb6\x6d\x24\x71\xfc\xab\x20\xbc\x36\x21\xab\x17\x93\x7a\x97\x6d\xd9\x97\x38\xce\x36\xb7\x79\xfb\xc7\x6e\xbb\xfd\xcb\x3a\x1f\x2d\xc2\x12\x37\x32\xa9\x92\x54\x6c\x6f\xd3\xfb\xec\xbf\x07\x03\x80\x04\x48\x80\xa4\x2c\x27\xdd\xbb\x5b\x3f\x4f\x1c\x83\x00\x06\xc0\x00\x18\xcc\x0c\x06\x33\xa6\x95\x9e\x25\x07\x28\x69\xb5\x48\xe3\x3e\x3a\x54\x2d\xfe\x83\x02\xdf\x94\x11\xef\x28\x3d\xc6\x7c\x72\x3c\xd8\x4d\x14\x96\x9d\x7a\x11\x3b\xf1\xf5\x3b\x07\xb4\x34\x3f\xad\x6b\xea\x91\x08\xee\xf2\xe4\xb9\x80\xca\x02\x1a\x5b\xd9\x35\xa6\xf8\xf9\x50\xb4\xff\x10\x33\x26\xa5\xd7\x44\xd0\xe3\x97\xd5\x17\x39\xbb\xf5\xb9\xa1\xee\x73\x91\x5c\xc1\x73\x49\xcd\x30\x54\x45\x5b\xaa\xf6\x1e\x8b\x0e\x02\x6e\x5e\x85\xd1\xad\x1b\x55\xbd\xbf\xda\x05\x3d\x77\x6a\xf4\x94\x8d\x42\x75\xe6\xa8\x59\xbd\x12\x22\x43\xc7\xf7\xc2\x9d\x7f\xfa\xea\x03\xdc\x66\x3e\x14\xd5\x95\x4b\xfe\x4e\xb7\xe4\xef\xea\x2c\xf9\x77\xf8\x2e\x79\xe3\x07\x75\xef\x8d\xff\x67\x2c\xfb\xb2\xc3\x97\x0e\xfb\x43\x84\xe7\xd8\xf3\x83\xc5\x57\x1f\xfb\x43\xc8\xd4\x83\x86\x44\x0e\xb3\xe7\x57\x71\xb8\xda\xd4\xb6\x02\x78\xd8\x22\x9e\xa0\x84\x20\xbe\x0a\xc3\x5b\x5c\xc0\x36\x0b\x3e\x26\x79\xef\x48\x43\x4d\x76\x8d\xc2\x2f\x44\x26\xa8\x83\x8e\x11\x9e\x3a\x90\x8b\xf6\xc9\x79\x95\x00\x42\x8f\xa1\x9e\x0d\x0e\x25\xcb\x16\x57\xe9\x6a\x8a\x18\xa8\x88\x81\x8a\xca\xe8\x8f\xe2\x80\x4f\x09\x8e\x12\x75\x91\x62\x2d\xc8\x8f\x91\x4b\x88\xef\x79\x8e\x9d\x2b\xa2\x33\x95\xf6\x53\x04\x7e\xf9\x82\x1c\xe0\x0d\x8a\x0c\xc0\xc3\xb8\x3d\x60\x3e\x5e\x07\x2f\x53\x2f\xba\x95\xd3\x9b\xbf\x6d\xab\x7a\xc0\x03\xef\x32\x6c\xcd\x3b\x0c\x18\xa1\x8a\x1f\xfa\xe8\x2f\x96\x05\x9d\xc5\x5d\x11\xdd\x96\x70\x2b\x28\xb3\x65\x04\x4f\x45\xe6\x89\x2c\x8d\x03\x94\x34\x1a\x05\xfc\x41\xb3\x84\x80\xa8\xd5\xa5\xa8\xe4\x09\x0a\x0c\xd1\xd9\x76\x88\x6f\xf0\xf5\x0e\x23\xd4\xac\x82\x5d\x47\xd1\x29\x1d\x85\x7a\xab\x7d\xa5\xae\x74\x35\x5d\x91\x2e\x1b\xf3\x1c\x2d\x23\x2b\x2d\x65\x3f\x35\x37\x2e\xb2\x95\x71\x1e\x62\x12\xf9\x37\x67\x89\x1b\xd1\x77\x1e\x2a\x66\x5f\xf4\x59\xae\xcc\x6f\x11\x42\xa1\x38\x08\xee\x3d\x3f\x5e\x97\xd5\x86\x7c\x56\xbb\xb6\x26\x85\x6d\xe9\x1a\x67\xe1\x6e\x7b\x77\xa7\x6d\xfb\x98\x3b\x6c\x97\xcd\xf5\x98\x7b\x44\xbf\xfe\xf3\xad\xd6\x9d\x4a\x2a\xd8\x12\x18\x5b\x08\xb6\xdf\x58\xaa\xe5\x87\x6d\x71\xcf\x89\x7a\xa0\xf2\xaa\xea\xe6\x50\x0b\x6e\x7e\x75\x07\x9e\xb4\xe1\xb5\xea\xe6\x1b\xf7\xee\x0d\x2d\x52\x63\x87\xab\x78\x3e\xd2\x13\x05\xff\x0e\x7b\x93\x7c\x8f\xc8\x2f\xbf\xd5\xd2\xc9\xd8\x4c\x16\x8f\x40\x12\xcf\x95\xb8\x5a\xb9\xc1\x27\x58\x22\x4f\x6c\x85\xea\x4b\x02\xe0\x37\x51\xe1\x15\xed\x76\xaa\xdf\xfa\xa5\xc5\x99\x2b\xb3\x66\x5f\xe1\x04\xff\x5f\x5e\x9d\x7f\xfe\xca\x24\x12\x89\xa3\x39\x58\x5a\x4a\x96\x5f\x5e\x54\x64\x7f\xed\xba\x32\xa3\x7f\xd2\x95\xb9\xa5\x42\xf0\x6b\xaf\xcc\xda\x7a\xbf\x7a\xca\xbd\xe2\xe1\xa6\x9d\x6c\xae\xe6\xdb\xc4\x4b\xb3\xc4\x3d\x30\xc5\x6d\xa5\xa2\x6f\x2b\xa9\x44\x5a\x28\xca\x05\x5c\x40\x19\x7f\x29\xb9\x2e\x19\xd1\x03\x00\xf2\x9d\x5e\xb6\xd6\x0b\x17\xdf\xdb\x2e\xcc\xf3\x70\xfd\x15\x96\x33\x2d\x55\x43\x9f\xf9\x6d\xa6\x86\x63\xf2\x31\x67\x07\xa6\xfb\x7f\xe0\xd4\xc0\x26\xfe\xd7\xcd\x03\xa7\x31\x53\xbf\xd1\x98\x51\x57\x4a\x1a\x84\xc9\xfa\xd9\xaf\xaf\x03\x59\x47\xf8\x73\x85\x0e\x64\xbe\x74\xa3\x0f\x61\xfc\x2d\xd5\x6d\xdf\x5e\x2f\xfe\x23\x57\xe1\x71\xf7\xc5\xff\x0b\x75\xff\x11\x5e\x63\x37\x49\xb5\xc1\x27\x3c\xdc\xdc\x83\x96\x98\x72\xd7\xe9\xcd\x69\xd4\xc4\x8d\x30\x37\x60\x59\xa8\x31\x3f\xfb\xf2\xa5\xb0\x29\x3d\x7c\x2d\x99\x95\xf0\xa5\x5e\x04\x41\xb7\x9a\xaf\x3f\x36\x70\xe0\xbd\xc4\x9f\xfd\x39\xec\x71\xff\x6a\x93\x54\x32\xea\x30\xfa\x23\x64\x67\xaf\x77\xa4\x9d\x74\xed\xdf\xa1\x63\x64\x1c\x19\x2a\xae\x96\xe5\x2b\x26\xd3\x8f\x4d\xea\x74\x01\x22\x06\xe5\xe9\x2c\x0e\x3c\xd3\x6f\x9f\xd8\xed\xd3\xb3\x13\xd4\x40\xc6\xf4\xc8\x3e\xe8\x0c\x07\x07\xf6\xdc\x50\x18\x69\x11\x58\xd1\xdd\xe7\xa4\xb5\x09\xfc\x79\xe8\xe1\x9a\x20\x47\xfd\x83\x71\xbf\x04\xe4\x8a\x79\x9f\x57\xc3\xc2\xd4\xcb\xb9\xa1\xad\x9e\x06\x44\x2a\x5e\xfe\xa8\x3a\xd3\x3d\xe8\xd9\xb6\xdd\x85\xfe\x68\x20\x72\x7c\xf1\x67\x65\xa5\x18\x50\x17\x12\xa2\x34\x55\xf6\xe9\xd8\x39\xe8\x54\x22\xa7\xce\xe0\x8e\x07\x64\x4c\xa5\xa6\x29\xdf\x86\xd6\xde\x6b\x69\xed\x63\xdd\x55\x69\x07\xf9\xf3\x37\xa4\xb5\xff\x43\xaf\xa1\x7f\xfc\xb9\xe6\x95\x12\x6c\x3d\x8e\x03\x4c\x03\x04\x90\x6e\xc1\x8d\x51\xf6\xdd\x99\xe9\x57\x41\x76\xf5\xf4\xed\x90\x34\x49\xaf\xb4\xbe\xfd\x31\x98\xb8\x57\x27\x2b\x9c\xb7\x21\xdf\x72\xd5\x4d\xe0\xda\x8c\x45\xb3\xc9\x75\x21\x71\xaf\x62\x85\x81\xe0\x3e\xea\xb2\x40\x3a\x8a\x91\x90\x3a\x10\x1f\xac\xd4\x9b\xc5\x5b\xf0\x90\x51\xf9\xf8\x36\xbb\x3e\x44\x8e\x25\x9f\xdf\x36\xbd\xf6\xe1\x25\xc4\xbb\x1e\x06\xdf\x9c\xe2\x69\x32\x9b\x59\x82\x11\xaf\xf2\x24\xd3\x3e\x19\x33\x8e\x4b\xce\x3f\xab\x9e\x4b\xbb\x52\x85\x36\x2a\x8a\x37\xee\x9a\xc8\x54\x2e\x41\xca\x09\x70\xd0\xd4\x0e\x59\x5f\xbf\xcc\x5d\x5a\xa9\x16\x5b\xd1\x78\x8c\x13\x60\xa6\x62\x9c\x98\x76\x13\x85\xed\x97\xa7\xaf\x9e\xff\xf4\xe6\xfc\xf2\xe4\xc7\xe7\x1f\xcf\x4e\xcf\x8b\x1b\x40\xac\xe1\x6c\x5d\xa3\xb3\x75\x8d\xae\xaa\xc6\x2e\xd8\xd1\x5d\x3e\xa9\xb0\xe3\x7e\xc6\xde\x09\xf3\x43\x5d\xdc\xab\x85\x7e\xb3\xe0\x9c\x4e\xb7\x53\xcc\x0a\x6f\xe3\x9d\xba\x3d\xa8\xdf\xed\x30\xf2\x17\x7e\xc0\x36\xdc\x6e\x4b\x69\x58\xbf\x55\xd9\xfa\x7f\xe7\x96\x9d\xb2\x55\x5c\x8d\xad\x2d\xd0\x05\xde\xfe\xce\x70\xe4\xbb\x2b\xb4\x0e\xa3\x04\x45\xf8\xb7\x0d\x8e\x13\xec\x21\x61\x6f\xa2\x4f\xf8\x7e\xed\x7a\x6d\xa3\xb8\x5c\x85\x52\x7f\x85\x42\xa2\x77\x8a\xac\xd8\x67\x1f\xdf\x12\xf8\xed\xf8\x3e\x98\x9f\x81\x0e\xe2\x79\x84\x5d\xed\x2b\xbb\x5a\x03\x1d\x6b\xc6\x49\x71\x88\x75\x8b\x9d\x66\xdb\xb6\x0e\xc9\x3c\x7f\x8b\xcd\x72\xe7\xd8\x6f\xc3\x0d\xdc\x99\x32\xcf\x07\x53\x85\xe1\xf8\xe7\xa4\x63\xa7\xe5\x1c\xdc\xd5\x97\x0c\xc2\xe8\xc6\x5d\xf1\xa2\x4c\x7c\x71\x52\xd7\x6d\x59\xc1\x1b\x52\x04\x7c\xcd\xc4\x6a\xc4\x17\x63\x9e\x82\xd3\x0e\x1c\xb8\x57\x2b\xdc\x82\xea\x2d\x0c\xf5\x15\x93\x1b\xc3\x6b\x53\x3f\x0c\xde\xba\x81\xbb\xc0\x51\xdb\xf3\x63\x52\xcf\x54\xa8\xc8\xc8\x42\x7a\xe1\xc3\x2b\x4a\x94\x84\x08\x20\x23\x0a\xb9\xad\x7d\x41\x5c\x6b\x9a\x1d\xdb\xee\x6d\x43\xd5\x03\xef\x55\x38\xdf\xc4\xbb\xef\x42\xdb\xee\xd7\x6f\x77\x93\x5c\xf3\xf9\xda\xb9\xd9\x2d\x36\x70\xbc\x88\x1e\xab\x59\x67\x9b\xd1\x12\x51\xed\x71\x1a\xee\x6c\xd1\x2c\x55\xb3\xfd\xe8\x7b\x1e\xae\xf4\xbd\x50\x63\xc4\xbd\x52\x0a\xd2\xd3\x1d\x00\xbc\x76\xd9\x01\x01\x8f\xfb\x94\xbb\xba\xd4\xcd\x04\x12\x9d\x6a\x94\x17\x23\x3f\xa0\xb8\x51\xbf\xf8\x52\x38\x2e\xca\xff\x80\x76\xa7\x50\x9b\xea\x5b\x6b\xd4\xf6\xfc\x78\x5d\xac\x4d\xbe\xd6\xa8\x7d\x57\xa8\x79\x57\xa7\xcd\x62\x7b\x35\x6a\xa5\x9a\xf2\x42\xed\x4c\x4b\x5f\x17\x0a\xd5\x9c\x6b\x00\xb1\x2b\x84\x6a\x58\x44\x6c\x28\xc0\x20\x1f\xcb\xab\xea\x7c\x7a\xa7\x60\x0b\x2c\x19\xf5\x36\x5b\xf7\x30\xd6\x9c\x43\x4a\x93\xc0\x78\x19\xde\x52\x9e\x5d\xe7\xca\x16\x69\xdc\x88\xe4\xcc\x44\xa8\xbc\x52\xcf\x68\x47\x77\x0e\xe4\xb5\x3b\xe9\xdb\x42\xa0\x4e\x75\xed\x52\x00\x5f\x5f\x55\x56\x4b\x5b\xf8\xdf\x23\xad\xed\x44\x82\xcb\x58\x2c\xa7\xab\x7c\x6e\xa9\xd1\xca\x64\xe2\x4a\x31\x8f\x89\x26\xba\x1a\x6a\x79\xa5\xa9\xd1\x12\xa4\xd5\xfe\x14\x81\x66\x27\x6c\xef\x24\xd0\xec\x76\xd4\x7e\x63\x81\x06\x56\x3f\xe1\x44\xaf\xdc\xf9\x27\xc2\x8e\x32\x42\xb6\xa5\x1c\x53\xd4\x72\xfd\xdf\x91\x63\x54\x83\x17\xe5\x17\x45\xbe\x2c\xb5\x28\x0a\xe4\xa4\x95\x62\x81\xa2\xb4\xc2\xdd\x97\x3d\x50\x60\xa1\xd5\x76\x9b\x9e\x1d\xe4\x8f\x1d\xf9\xd3\x87\xca\x1f\xbb\x36\xfb\x40\xf9\x63\xc7\x66\x1f\x2e\x7f\xec\xd4\xf0\x2e\xf2\xc7\x8e\x12\xd7\x57\x94\x3f\xd4\x8c\x9c\xce\x24\x4f\xf1\xc8\x02\x2a\x30\x51\x42\x29\x22\x68\xeb\xa8\xfd\x3d\x70\xab\x70\x4d\x1d\x10\x1b\x6a\x18\x3e\xb0\xf2\x0a\xa3\x67\x3d\x6c\xcd\xb3\xec\xf3\x50\xdf\x1f\xc1\x7c\xa7\x84\xd3\xaf\xa8\xce\x2d\x8a\xd4\x77\x06\xea\x9a\x24\x53\xcf\x86\x0b\xc1\x1e\x4b\x88\x5e\x8c\x93\x17\x9b\xeb\x6b\x1c\x29\xe7\xba\x48\x35\x23\x7c\x1d\xe1\x78\x69\x16\xb5\x47\xfa\xbb\xa0\xfa\x32\x84\x28\x28\xd4\xf0\x06\xf7\xd5\xc5\x01\xa7\xae\x38\x30\x5f\xba\x51\x6d\x13\x03\xf0\x61\xca\x7c\x85\x32\xc9\xe0\xcb\x17\x64\xd3\x2f\xba\x41\x64\x62\x83\xfc\x7e\x8c\x5a\x57\xd8\x42\x28\x2d\x89\x04\x91\x4e\xa1\xa3\x23\xe4\x8c\xa8\x33\x27\x7d\x89\x31\x7a\x8a\xfa\x8e\xd3\x84\xc0\xdb\x7d\xc7\x41\x45\x37\x48\xb4\xf0\x01\x18\x46\x91\xff\x88\xac\xc2\x6c\xb6\xfc\x19\xc4\xad\xef\xd2\x17\xfd\xe8\x70\x82\xba\x43\x74\x4c\x9b\x44\x2d\xf2\x9d\x3d\x62\xeb\x65\x25\x7a\xa4\x44\xc0\x4a\xf4\xd2\x12\xe3\xac\xc4\x98\xc3\x30\x13\xd4\x98\xa0\x91\x85\x5a\x24\x9b\x15\x74\xec\xac\xa4\x63\x73\x60\x42\x51\x52\x60\x9f\x7b\x85\x41\xc7\xc8\x54\xe0\x88\x19\x9b\x94\xe0\x48\x28\x51\x8d\x23\x56\xd8\x42\xfb\xc8\x49\xdb\x0d\xd1\x97\x09\xbc\xec\xeb\xc9\x9f\x3a\x68\x1f\xf5\xe5\x4f\x3d\xb4\x8f\x86\xf2\xa7\x11\xda\x47\xa3\x1c\xac\x01\xda\x47\x9d\x8e\xf0\xf1\xe9\x04\xb5\x08\xb8\x4e\x2f\xf7\xb1\x4b\x3e\xf6\x73\x1f\xfb\xe4\xe3\x30\xf7\x71\x4c\x3e\x8e\x72\x1f\x9d\x21\xda\x47\xdd\x71\xfa\xb5\x16\x7e\xc8\x48\xb3\x2a\x55\xb8\x22\x0d\x64\xcd\x76\x98\xa6\x1b\xec\xb2\x67\x64\xd2\x7c\x32\x9f\x9d\x26\x6a\x51\x84\x9a\x8a\x2e\xdc\xb8\xc9\x7c\x09\xfe\x45\xcc\x4e\xbf\x8f\x9e\xc2\x82\x6c\xa2\xf4\x6f\x80\x25\xa7\x3b\x33\x0b\xcc\x39\x00\x5c\xdf\x71\xc0\x4c\x89\x34\x64\xa5\x93\x92\x76\x82\x17\x83\xa0\x28\x19\x8c\x09\x01\x42\xc6\xba\x4d\xef\x83\xc7\xed\x7d\x50\xaf\xf7\x81\xbe\xf7\x64\x93\xa4\x1b\xe4\x11\x97\x7f\x9e\x5d\x8f\xa2\x30\x32\x0d\x1e\xd5\xf1\xec\x2f\x1f\x91\xcb\x09\xe6\x3e\xfa\xde\x6b\x1b\x4d\x54\xcb\x4b\x1b\xa3\x58\x13\x14\xa2\xc3\x43\xe4\x8c\xd0\x17\x14\x93\xbf\xc6\xe8\x0b\x0a\x94\x07\x84\x16\x82\xba\xf3\x5a\x22\xef\x81\x25\xd9\x59\xe2\x26\x9b\x3a\x24\xfe\x4f\x52\xd2\x6c\xc3\x90\x17\x6d\x97\x0c\xd4\x50\x18\x7e\x34\x90\x03\xb1\x78\x0e\x54\xd9\x77\x69\xf6\x47\xe3\x91\x0f\x6d\x1d\xbb\x5d\x39\x0e\x3b\xd0\xde\x23\x95\x3e\x6c\xd3\x21\xaf\xb2\xc1\xc7\xc5\x9b\x9e\xd1\x88\xc3\xeb\xe4\x63\x21\x84\x45\x95\x03\xc6\xe2\x65\x48\x89\x8f\x35\x75\x7e\x4e\xb7\x54\xc8\x57\x5c\x6b\x3f\xba\xe2\xa4\x0c\xa0\xa0\x65\x2c\xe5\xe1\x8b\xbd\x2a\xe7\xd2\x39\x5b\x5b\xa8\x56\x45\x46\xea\x48\x28\xf7\xca\x0e\x71\x47\x94\x1a\x1e\x7e\xb1\xc2\x9f\xf1\xaa\xac\x26\x04\xd5\x25\x75\x67\xfa\x75\x84\x13\x8a\xb1\xb3\xe4\xbe\x32\x6e\x8e\x64\x19\x7c\x88\x1c\x74\x0c\xdc\x8c\xc2\x6c\x8a\x6f\x6b\x75\xd8\x18\xad\x12\x79\x9b\x47\xa5\x31\x4e\xde\xaf\xc1\xd5\xbc\x31\xcf\x46\x60\x34\x91\x71\xb5\x0a\xe7\x9f\x1e\xb6\xef\x55\x7a\xaf\x6d\xc4\x06\x7d\xa7\x36\x81\x87\x23\x22\x4c\x3d\xac\x63\x2a\x0a\xb8\x1d\xa5\xd2\x62\xcb\x8d\x4a\xc8\x0e\x12\x9f\x99\xa3\xef\x81\xb1\x41\x79\xdd\x49\x55\x5b\x2f\x56\x7e\xf0\xc9\x68\xa2\xa8\xd4\xe2\x8d\xee\xf0\x8f\x78\x51\x6d\x0a\xa9\x3c\x1c\x55\x96\xda\xe2\x8e\x37\x53\x83\x76\x4b\xb9\x91\x73\xfb\x9f\x9a\x52\x3e\x7d\xca\xec\x2b\xd5\x04\x81\xf9\xeb\x28\x70\x39\x70\x1d\xa0\xb1\x79\x54\x6d\xd8\x0a\x37\x4e\xb1\xfb\x19\xa7\x84\x6d\x0b\xc4\xc0\xc5\xc3\xdf\x94\xaf\x60\x54\x25\x7f\x55\x50\xa6\xb2\x9b\xaf\x24\x8c\x1e\xd4\x2d\x05\x05\x64\x3d\x25\xf2\x6f\x1d\x35\x0d\xeb\x2e\x29\x2e\x77\x90\xa6\x44\x9f\xca\x10\x87\xeb\x47\xea\x5b\x1e\x4d\x50\x2c\x78\x3a\x2e\x5b\x66\xe9\xf7\xa4\xe4\x09\x53\x26\x71\xfb\x99\xbf\x85\xfc\xee\xa0\xee\x89\x0f\x91\x3f\xb5\x67\x6c\x05\x62\x74\x84\xfc\x69\x38\x9b\x3a\x33\x7d\x30\x12\x90\xf4\x0f\x50\x48\x44\xdc\xe8\x80\x79\x90\xe5\x3e\x12\xae\x57\x61\x18\x99\x66\x84\x1a\x28\xb4\xd0\x1e\xea\x40\x54\x64\x02\x35\x61\x50\x61\xc3\xc2\x9b\x71\x5d\xbc\x05\xb0\x37\x60\x5d\x4b\x48\xd7\xac\xac\x33\x2a\x2d\x65\x58\x74\x91\x83\xb4\x61\x50\x9e\xe8\x62\x86\xa7\x88\x8d\x14\x88\xe5\x2e\x24\x41\x43\xd0\xed\x77\x98\x9f\xe5\xc3\x09\xea\xf5\x7a\x43\x82\xba\xb1\x6d\x53\x09\x0a\xb3\x54\x27\x4b\x41\x35\xc7\x19\xdb\x3d\xd8\xb7\x50\xad\xe3\x8c\xc1\x32\xda\xe9\x74\xfb\x4c\xd9\x92\x16\xed\xf5\xec\x6e\x27\x2d\xda\xef\x77\xec\x6e\x9a\x37\xe8\x0e\x7b\x19\x98\x41\x8f\x48\x4c\x69\x5e\xdf\xa6\xea\x0b\x9a\xd7\xb7\x7b\x63\x31\x6f\xd8\x11\xf2\x9c\xae\x58\xaf\x33\x12\xeb\x75\x87\x03\x21\xaf\x2f\x74\x7b\xd0\xef\x3b\x76\x36\xa4\xae\x23\x02\x75\xc6\x83\x81\x9d\x41\x85\xe4\x28\xcd\xed\x0c\x3a\x4e\x4f\xb2\x89\x16\xb1\xcf\x5d\x50\x4f\xa7\xc3\xc1\xa8\x89\x46\xc3\xf1\xac\x89\xa6\x8e\xd3\xef\x37\x91\xe3\xf4\x47\x34\x35\xb0\x49\x6a\xe0\x40\xaa\xd7\x21\x79\xbd\x01\x2d\xd9\x1b\x3a\x24\x35\x64\x79\xc3\x2e\xa4\x7a\x2c\x35\x80\xd4\x90\xa5\xc6\x90\xa2\xf5\xfa\x5d\x92\xd7\xef\xb2\x54\xbf\x43\x52\x7d\x5a\x72\x40\x64\x49\x67\xd0\xb5\x69\xaa\x37\x22\xa9\x1e\xed\xcb\xb0\x4f\xfa\x32\x1c\xd0\x16\x86\x83\x21\xa4\x58\xde\x10\xf2\x86\x5d\x48\x8d\x6c\x92\x37\xb2\x87\x2c\x35\x86\x14\x6d\x6f\xd4\x23\x25\x47\x83\x01\xa4\xc6\x7d\xd2\xc2\x98\x42\xe9\xd8\x9d\x61\x13\x75\xec\x6e\x1f\x52\x5d\xbb\xdf\x44\x9d\xae\x3d\xa0\xa9\x41\x8f\xa4\x68\xeb\x9d\xee\x60\x4c\x52\x43\x96\x37\x72\x48\x6a\xe4\xb0\x14\xd4\x1b\x51\x98\x3d\xbb\xd3\x44\x9d\x9e\xdd\xa5\xa9\x6e\x97\xa4\xba\x2c\x35\x86\xbc\x71\x87\xa5\x48\xeb\x7d\x1b\xc6\xde\xe9\x93\x5e\x93\xdf\x34\xd5\xb5\x49\xaa\x4b\x5b\xe8\x0f\x48\x7b\xfd\x01\xad\x37\xe8\x90\xbc\x41\xc7\x66\xa9\x3e\xa4\x68\xcf\x06\x5d\x52\x72\xd0\x65\x25\xbb\x90\xd7\x1d\xd2\xd4\x90\xb4\x3e\xa0\x38\xeb\x0c\x46\xa4\xbd\xc1\x98\x42\x19\x12\xcc\x93\xdf\x34\xd5\x27\xbd\x1e\xd2\x39\xea\x0c\xfb\xa4\xe4\x70\xc0\x4a\x0e\xfa\x90\xa2\x38\x1b\x8e\x06\x24\x35\xa2\x25\x47\x0e\x19\xd1\xc8\x61\x29\xb2\x26\xc8\x6f\x96\x1a\x43\x8a\x8e\x6f\x04\x18\x1c\x8d\x68\x5f\x46\x63\xd2\xde\x68\x4c\x53\x63\xc0\xe0\xd8\xa6\x63\x18\xf7\x08\x94\x71\x0f\xa0\x74\x6d\x7b\xd4\x44\xe4\x37\x4d\x75\x1c\x92\xea\x00\x96\xba\x4e\xb7\xd7\x44\x5d\xa7\x4b\x4b\x3a\xbd\x0e\x49\xf5\x7a\x2c\x35\x80\xd4\x98\xa6\xfa\x43\x92\xa2\x6b\xbe\xdb\x21\x6b\x9e\xfc\x66\xa9\x2e\xa4\xba\x34\x35\x84\xbc\x21\xcb\x23\x23\xea\x76\xe8\x2a\xef\x76\xc6\xa4\x2f\x9d\x31\x85\xd9\x25\x63\xe8\x76\xc7\x80\x97\x6e\x8f\xac\x25\xf2\x1b\x52\x30\x9b\xe4\x37\x4b\x91\x7a\xfd\x1e\x4b\x41\x3f\xfb\x3d\x18\x6d\x77\x40\xd6\x0b\xf9\xcd\x52\xa4\xbd\x01\xcf\x23\x3b\xb5\x3b\xa0\xab\xa0\x3b\x24\x6b\x82\xfc\x66\xa9\x1e\xa4\x68\x5f\x86\x43\xc8\x1b\xb2\x92\x23\xc8\x1b\xd1\xbc\x11\x94\x1c\xd1\xf9\xeb\x02\xe6\xbb\x0c\xf3\xdd\xd1\xb8\x0f\x29\x9e\x37\x84\x14\x1d\xed\x98\xac\x89\xee\x98\xee\xa3\xee\x98\x90\x90\xee\x98\xb5\x30\x1e\xf6\x20\x45\xeb\x8d\xa1\xbd\xf1\x98\xf6\x6c\x4c\x5a\xe8\xd9\x1d\xc0\x75\xcf\x26\x63\x27\xbf\x21\xe5\xf4\x9c\x26\xea\xb1\x39\xea\xc1\x1c\x91\xdf\x34\x45\x76\x7f\xcf\xe9\x3b\x2c\xd5\x85\x54\x97\xa6\x48\x0b\x3d\x67\x04\xed\xf5\x7a\x64\xed\xf6\x06\x74\xf7\xf7\xc6\x64\xb5\x92\xdf\x24\xd5\x1f\xdb\x83\x26\xea\x8f\xe9\x7a\xe9\x8f\x49\xeb\xfd\x31\xc5\x7c\x7f\x4c\xe6\xb6\x3f\xa6\xf4\x6c\x60\x93\x55\x37\xb0\xe9\xbe\x1d\xd8\x64\x7c\x03\x9b\xe2\x73\x60\x13\x7c\x0e\x6c\x3a\xef\x03\x9b\xac\x79\xf2\x9b\xa5\xc6\x24\x45\x57\xc1\xc0\x21\xbb\x98\xfc\xa6\x29\x32\x63\x03\x87\xee\xa3\x41\xd7\xe9\x36\x11\xf9\x0d\xa9\x5e\x97\xb4\xd7\xeb\xf6\x58\x8a\xd4\xeb\xd1\x9e\x0d\x7a\x64\xec\xe4\x37\x4b\x0d\x21\x45\x61\x0e\xc8\x3e\x1a\x0c\x46\x34\x6f\xec\x10\x28\x63\xa7\x4f\x53\x64\x6e\xc9\x6f\x96\x22\xfd\x1c\x0f\x59\xc9\x21\x94\xa4\x33\x36\x18\x0f\x47\x90\x02\xbc\x0c\x6d\x67\xdc\x44\x43\xbb\x03\xfd\x1c\x0e\x9c\x41\x13\x0d\x19\x45\x19\x0e\x48\xc9\xe1\x80\xee\xdb\x51\xc7\xee\x36\xd1\xa8\x43\x71\x3d\xea\x90\x1d\x37\xea\xd0\xd9\x1c\x75\x46\xe4\x58\xe9\xd0\x79\x1f\xc1\xee\x18\x75\x29\x3e\x47\x3d\xdb\x6e\xa2\x51\x8f\xd2\x33\xa7\xd3\x25\x1b\x82\xfc\xd7\xa7\xe9\x1e\x59\x08\xe4\x3f\xe8\x5d\xaf\x6b\x3b\x64\x82\xc9\x7f\x3c\x3d\xa6\xe9\x31\x4b\xf7\xfa\x90\xa6\x6b\x65\xd0\xeb\xc0\x94\x90\xff\x20\xdd\xb7\x3b\x04\x11\x7d\x9b\x9e\x39\x83\xbe\xdd\x1f\x40\x9a\xe1\xb0\xdf\x01\x24\x92\xff\x68\xba\xdf\x81\x34\xa3\xb7\x83\x91\x4d\x96\x3e\xf9\x8f\xe6\x8f\x1c\x9b\x2c\x80\x91\x43\x69\x12\xf9\x63\x04\x69\x87\x95\x77\xc8\xa1\x46\xfe\xeb\xf1\xf4\x98\xa6\xd9\x19\x3b\x76\x7a\xe4\xb4\x24\xff\xf7\xf9\x17\x7a\xee\x8e\x1d\x3a\x3f\xf0\x17\x2b\xc3\x4f\xe6\x71\xc7\x81\xd3\x78\xdc\xa1\x6b\xc6\x71\xc6\xdd\x41\x07\xbe\xb0\x73\x69\x4c\x0e\xc9\x7e\x13\xd1\xff\xf9\x17\x42\x35\xc6\xce\x70\x40\x47\x33\x76\x86\x43\x42\xe1\x08\xdc\xf1\x78\x26\xca\xeb\x8c\xe7\xca\x18\x61\x5f\xc7\x96\x51\x05\xb1\x4f\xa4\x9e\x76\xb0\x59\xa1\x7d\xb8\x01\xea\x76\x08\x2b\xe2\x03\x2b\xd2\x19\xf2\x07\xf3\xce\xc0\x86\x72\xf3\x30\x48\xa2\x90\x94\x4d\x08\xe0\x63\xea\x7b\x90\xfe\xd9\x41\xfb\x48\x66\x13\x29\xf3\xfe\x7b\xb0\x59\x81\xfb\x43\x56\x79\x1f\xd9\x7f\x58\x7f\x46\x18\x94\x00\xdf\xa2\x8f\x78\x71\x7a\xb7\x36\x0d\xf3\x78\xff\x3f\xbf\x4c\xff\xf3\xe2\xc2\x73\x5b\xff\xb8\xb8\x68\xb7\x66\x0d\xcb\x34\x97\x49\xb2\x8e\x8f\xf7\x2f\x2e\xf6\x2e\x2e\xf6\x2c\xd3\x34\xa7\x52\x81\x8b\x8b\xb6\x39\xa5\xc9\xd9\xef\x9d\xe6\xe0\x0f\xcb\xfa\x62\x9a\x17\x17\xde\xef\x4e\xb3\xfb\xc7\xc5\x45\xdb\xfa\x9d\xfc\x47\x93\xd6\x17\x73\x15\xce\xdd\xd5\x32\x8c\x13\xcb\x32\xf7\xe9\xf7\xfe\x1f\xd6\xb1\x79\x71\xb1\x37\x85\x36\x6e\x2f\x2e\xda\x17\x17\xad\xef\xff\x7b\xf6\x83\xf5\x83\x79\x71\x71\x3c\xb5\x5b\x63\xf8\x3c\xbd\xb8\x98\x5d\x5c\x98\x17\x17\x16\x14\x3c\xbe\xb8\x78\xf2\x6f\xff\xfe\xdd\xf7\x4f\x9f\xfd\xd0\x68\xee\x1f\xfc\xf7\xc5\xc5\x84\x56\x9d\xfd\x60\x1d\x9b\xff\xf6\xa0\x6a\x96\xf9\x1d\x60\x40\xe8\xc7\xac\x61\x19\x96\x60\xbd\x1a\x52\x91\xa8\x22\x98\x99\x10\x33\x46\x1d\x3c\x13\xa4\xbd\x80\xfa\x70\xfd\xf4\xd6\x4d\xe6\x4b\x1c\xbd\xf6\xd0\x04\x85\x5c\x3a\x8c\xc2\x5b\xe6\x96\xfe\xb5\x07\x7a\xae\xf4\x45\xc4\x2a\xab\x22\x65\x44\x78\xe1\xc7\x09\x8e\x04\x90\xa6\xdf\x64\xba\xb5\xdf\xe1\xf2\xe5\x75\xe0\xe1\xbb\x7d\xe4\x14\xc2\xaf\x8b\x2c\x34\xd2\x84\xab\x49\x12\x77\xbe\x3c\x0f\x5f\x82\xf6\xa0\x22\x64\x4d\x36\x48\x1e\x8b\x5a\x88\x6b\x7b\x09\xaa\x05\xc9\x7b\x26\x52\xbe\x1f\xfe\xe4\x5f\xdf\x7f\x0c\x6f\xa5\xe6\xd4\x6d\x65\xf7\x10\xbc\xbd\xca\xf8\x6e\x0a\x34\x4f\x93\xbc\x9e\x2f\xc5\x47\x21\x8a\x79\xe6\x22\x30\x0f\x42\x0e\x58\x9e\xcd\x19\x1d\x4c\xfb\xca\x0f\x68\xd0\x6f\x82\x37\x32\xe4\xf3\xd7\x6f\x4f\x2f\x5f\x9c\xbe\x7a\xff\xf1\xf4\xf2\xcd\xeb\x77\x7f\x7d\xfd\xea\xd7\x5a\x11\xe2\x72\xea\xa5\x1f\xef\xc9\xfe\x67\x4b\x2a\xd3\x07\x94\xa9\x2d\xb2\x59\x12\xd7\xd4\x34\x9c\xf1\x58\x75\x72\x40\xa3\x8a\x76\x7f\x76\x57\xbe\x47\x75\xd4\xee\x6a\x05\x56\x86\x0f\x6d\xfe\xb3\x0a\x54\x79\x4f\x14\xab\xbf\xb0\x4c\x55\xc1\x97\xf9\xea\xa1\xe1\x28\x85\x90\x19\x11\x7d\xc3\xda\xd4\x6e\x56\x22\x6b\x87\xa4\xe8\x93\xc4\x42\xc9\x32\x0a\x6f\x81\x9e\x9e\xd2\x0b\x40\x8e\xc1\x9b\x4d\x9c\xa0\x2b\x8c\x28\x95\xf6\x94\xda\x51\x4e\x8e\x75\xe1\x39\x79\x80\xd0\x62\x27\x1a\x0d\x8d\x65\x7d\x84\x17\x64\xa7\xeb\x1e\x2d\xb0\xde\xed\xa3\x44\x53\x40\x24\x17\x51\x3b\x4b\x69\x8a\x17\x27\x8c\x54\x2b\x7e\xd5\x54\x5f\x47\x7e\x18\xf9\xc9\x3d\xa9\xc4\xff\xce\x29\xbf\xf8\x8f\xca\xde\x9f\x47\x52\x03\x1c\xb9\x9e\x27\xa0\xe8\x3c\x7c\xe3\xc7\xe4\x64\x6e\x22\xbf\xed\x7b\xa5\x4b\x48\x59\xb5\xc6\x1a\x26\xcb\x87\x5a\xb5\x14\x57\x33\x53\x9b\x69\xa9\x91\x64\x25\xaf\xad\x0e\x5a\x37\x6a\x0c\x62\x83\x97\x10\x8b\x19\xdc\xa7\xc8\x3a\x54\xd5\x9e\x26\xb3\xb4\x84\x1c\x3f\x4b\xd1\x12\x77\xe5\x94\xfa\x89\xc3\xa5\xe1\xfe\x94\x95\x6d\x56\x51\x31\x6d\xe2\x15\xb5\x54\x15\x7c\x95\xe5\xab\x14\x6e\xa4\x2b\xb7\x77\x85\x31\x11\x9a\xc8\x6e\x82\x15\x48\x66\x8f\x12\xb2\xa3\x24\x8f\x4a\xdf\xa3\xaa\x38\x4b\x5e\x70\x4a\x2c\x52\x9f\x59\x4a\x35\xa3\x42\x81\xa8\x5a\x8c\x9a\xf3\x4f\x1f\x74\x38\x11\x0f\xb5\x78\x8a\x55\x67\x19\x0c\x4e\xbb\x18\x13\x31\x6e\xb8\x66\xf2\x53\x94\x46\xf4\x59\x47\x54\x8e\xd2\x88\xa0\xb4\xe4\xd5\x96\x1c\x32\x4e\xc2\x78\x34\x13\x8d\xbd\xbc\xf0\x4d\x8a\x10\x82\x5e\xbf\xcc\x7e\x99\x0c\x33\x14\x22\xb1\x56\xbe\x2f\x83\xc8\x5d\x0a\x7a\x25\x3c\x63\xa9\x32\x85\xd0\x8d\x0e\x22\x4a\xaa\x67\xa3\xd0\x0b\x45\x0f\x4c\x69\x52\xe0\x10\xdb\xb6\x17\x88\xc7\xa7\x2c\x3c\x79\xa5\xf1\x52\xfd\x00\x9a\x6d\xc1\x2d\x56\xc9\xc3\x25\xfe\x93\xe7\x1e\x0b\xf9\xd4\x70\xc6\x3e\x40\x01\x3a\x44\x61\xba\x16\x02\xb2\x16\x62\x33\x28\x9b\x39\x94\x6e\x10\x7d\x19\xc5\x03\x2a\xcd\xe7\x72\xa6\x49\x5a\x53\xf5\x78\x5a\xbe\xf0\x09\xd3\xed\xd3\xf8\xd4\x94\x28\x84\x54\x26\xc0\xed\xf9\xd2\x5f\x79\xef\x42\x0f\xc7\x14\x0b\x58\x9c\x3e\x7a\x90\x9a\x49\x1b\x8e\x67\x5d\xec\xbd\x27\x01\xb5\x93\x24\x70\x83\xf4\x00\x61\x64\x23\xef\x71\x1f\x89\x7b\xd2\x25\x32\xdd\xd4\x08\x36\x37\x57\x38\x32\xd0\x93\x09\x22\x03\x0d\xaf\x51\x22\x1c\xe1\x4c\x46\x15\x3f\xcd\x9a\x08\x4c\x69\xdb\x3e\x14\x68\x20\x37\xb5\xba\x5c\xd2\x99\x5c\xa2\x43\x94\xed\xea\x65\xc9\xae\x26\xfd\x98\x13\x1e\x78\xba\x9c\x35\xd1\x06\x4d\xd0\x5c\x42\x01\x34\xf1\xfe\xda\x74\x75\xcb\x80\xa0\x60\x03\x47\x5d\x25\xe5\xf0\x52\xf2\x30\x8f\xb0\x9b\xe0\xe7\xc1\x7c\x19\x46\x2c\x7c\xaa\xe9\x36\x51\xc2\x39\xd9\x1a\x04\x43\xee\xa6\xe0\xd3\xd5\x4d\xe7\x80\x14\xa3\x4f\xd4\xe7\xed\x20\xf4\xf0\xf9\xfd\x3a\x8d\x6b\xc8\xc2\x43\x93\xa9\x37\xe7\x4d\xe4\x59\xfa\x8b\xa9\xfc\x30\xd6\x04\x49\x15\x9b\x02\x6c\xa7\x9e\x53\xdb\xa9\x35\x34\xfe\xce\xbd\xc1\xa5\xcb\x42\xfc\x59\xb7\xfd\x20\xc0\xd1\x8f\xe7\x6f\xdf\xb0\x38\xae\xeb\xb6\xbb\x5e\xe3\xc0\x3b\x21\x0b\xd6\xf4\xca\x1e\x2c\x66\x0f\xf2\xe6\xc2\xfa\x56\xbf\xf6\xbb\xa6\xeb\xe5\x1a\x1d\x22\x45\xe1\x03\x74\x5d\x71\x20\x70\x9c\x2c\x60\xe1\x64\x00\xa6\xd7\xb3\x26\x22\x92\xe7\x62\xbb\xd5\x24\xe2\xaf\x45\x2f\xc8\x6e\x6a\x51\xcd\xc2\xac\x9e\xf1\x88\xeb\xbf\xf8\xc9\x12\xa6\x79\xd1\x44\x5e\x13\xb9\x4d\x74\x53\xd5\x3a\xe2\xf6\x0f\x15\x94\xb3\x72\x12\x96\xe0\x04\xaa\xba\x6b\x73\xde\xb5\x4d\xd5\xb2\x8f\x28\x07\xe6\x11\x76\xc5\x2c\x52\xac\x2c\xce\xfc\xca\xca\x91\x2f\x6b\x4b\x32\xc5\x7f\x76\xa2\x52\x0d\x15\x99\x7a\xe8\x81\x80\x32\x76\x2c\x2a\x3f\x2a\x14\xf4\xa5\xb6\x78\x29\xf3\x38\x5c\x41\xd1\xa6\x20\x39\xb1\x32\x5c\xa5\x68\xc8\x7a\xe7\x8b\x73\x42\x55\x29\x7e\xdb\x8b\xdc\xc5\xc2\xbd\x5a\x71\x4b\xb5\x08\xec\x70\xdb\xcb\x08\x5f\xf3\x22\x89\x1b\x2d\xc0\xc2\xca\xb8\x04\x07\xab\x06\xf9\xe8\x7a\x1e\x3c\x38\x23\x7c\x00\x0e\x70\x64\x1a\xf3\x95\x3f\x27\x79\xd9\x78\xf4\x11\xd9\x19\x0b\xc9\x59\x60\x33\xd2\x30\xfc\xe0\xee\xee\x31\x1a\x7b\xe2\x0b\x6c\xcb\x3c\x0c\x12\xd7\x0f\x62\x35\xef\x52\xa7\x5b\x4d\xd1\x8f\xa2\x6a\xaa\x85\x7d\xb5\xad\x88\x41\xd8\x82\x88\x8a\x1a\x84\x2f\x76\xa3\x05\x4c\x75\x8e\x1d\x4e\xa6\x11\xf5\x09\x39\xc9\x4a\x4c\x23\x15\x83\x98\x02\xf7\x61\x5f\xae\xdd\x08\x07\x09\xe9\x58\x53\x0c\x0b\x9b\xa4\xe0\x43\x10\x60\x98\x8d\xe3\x0b\x7c\x1d\x46\xd8\x4c\xa6\xe1\x4c\x23\xcc\xf9\xec\x29\x21\x3d\x02\x2a\x64\xb0\x52\x7a\xa3\xda\x0b\xe4\xc8\xd5\x89\xcb\xcc\xc4\x41\x71\x9a\xf0\xc0\xd3\x62\xde\xd4\x9e\x59\x4d\xd4\x65\x4f\x49\xc4\x83\x37\xa7\x74\xa1\xcb\x1d\x3a\xc4\xf5\x2e\x2e\x22\x3b\x07\x91\x4a\x28\x8c\x50\x18\xac\xee\x11\x5b\x44\xc8\x45\xb1\x1f\x2c\x56\x38\x2b\xa2\x55\xcf\x84\x79\xc2\xa8\xe1\xdd\xd8\x15\x42\x29\x77\x14\x43\x8c\xf9\x8c\xb0\x46\x9c\x78\x52\x8e\x51\x49\x26\xce\xf1\x1d\x8c\xcb\xd4\x7a\x8d\x92\x64\x52\x91\x19\xa1\xd3\x11\x58\x4d\xa5\x3a\x45\x3d\x0c\x9f\xcd\x8f\x82\x23\x6a\xa1\xa8\x4a\xa9\xc1\x59\x51\x71\x90\x36\x59\x0e\x94\xcd\x2c\x1f\xa0\xf6\x24\x2f\x1b\xe0\x8a\x2a\x53\xeb\x0d\x90\x74\x6f\xa9\xee\xde\xbc\xb2\x7b\x64\x96\x36\xb9\xca\x3e\x6a\x64\x48\xd1\xa8\xb8\xbc\x4a\xc8\xca\x93\xba\x6c\xd0\x73\x98\x58\x72\x70\x2b\x54\x09\xc2\xb5\x53\x6a\x2c\x16\xab\x74\xcd\x68\x82\x3a\xb6\x4d\x58\x65\x2a\x0c\xf9\xd4\x8c\xec\x9f\x25\x2a\xff\xef\x5c\xe1\x17\x4f\xc1\x10\xfd\xc5\xe9\x9b\x59\xb9\xac\xc6\x51\x06\x81\x86\xb9\x23\x8e\x3f\x9a\x1c\xc0\x9b\x57\x75\xeb\xf3\xe0\xba\x45\x18\x3f\x9f\x83\x96\x3f\x05\x98\x66\xbc\x7a\xa5\xc9\x38\xf9\x58\xb7\x55\x39\x7c\x6d\xb1\xed\x17\x67\xb5\xc7\xcf\xe3\xd5\x16\x81\xfc\x78\x5e\x17\x48\xe2\x5e\x15\xab\x9f\xbd\xaf\x5b\x9d\xc7\x98\x55\xc0\x78\xbd\x15\x8c\xd7\x0a\x54\x9c\x9e\x9d\xd4\x83\x41\xed\x6f\x13\x37\xc1\xe6\x92\xd4\x7a\xfe\xe1\xf4\x25\x87\x96\xb3\x97\x4c\xd7\x5b\x30\x35\xa6\x46\x05\xf8\x84\x1c\xc9\xee\x4d\x7a\x0d\xd7\x9e\x6f\x22\x72\x44\x7f\x20\x5f\xe9\x4d\xa1\xd4\xf4\xc9\xd9\xeb\xcb\x0f\xcf\x3f\x3e\x7f\x9b\x0d\x25\x98\x1a\xb3\xc7\x6e\xe6\xfd\xd9\x89\xd0\x40\xfb\xc3\xe3\x42\x7f\x79\x72\x26\x42\xbf\x2c\x87\x2e\x55\x7d\xfd\x97\x77\xef\x3f\x9e\x4a\x83\xff\xcf\xaa\xc1\x97\x03\x68\xcf\xab\x06\xc7\x3c\xfe\x08\x55\x4e\xab\xaa\x70\x1b\x66\x2a\x67\x98\x56\x0e\x01\xef\xde\x7f\x7c\xfb\xfc\x8d\x08\xf1\x65\x15\xc4\xba\x80\xde\x56\x8f\xe6\x33\x8e\x62\xfc\xba\x1e\xbc\xa9\xf1\x7d\xf5\xda\x12\x03\x2f\x37\x59\xba\xc2\x07\xa8\xaa\x55\xf8\xfa\xc9\x5f\xbf\x23\x2c\xc3\xd2\x8d\x44\x94\x33\x1a\xf8\xfc\xdd\xac\x84\xa3\x56\xed\x55\x79\x44\xc2\x56\x75\xc5\xad\xea\x4e\x8d\xe3\xfc\x38\xb5\xa0\x3f\x80\x31\xbd\x69\x1c\x1b\x59\x07\xdd\xa9\x71\xb4\x35\x80\x23\x19\xc0\x93\xad\x01\x3c\x91\x00\xd8\xb5\xab\x93\x7d\x69\x3a\x36\xfa\x01\xe1\xf6\x82\xa7\x2d\x11\x96\xb3\x23\x2c\x78\xb1\x25\xc0\xeb\xec\x0e\xaf\x23\xc2\xeb\xee\x0e\xaf\x2b\xc2\xeb\xed\x0e\xaf\x27\xc2\xeb\xef\x0e\xaf\x2f\xc2\x1b\xec\x0e\x6f\x20\xc2\x1b\xee\x0e\x6f\x28\xc2\x1b\xed\x0e\x6f\x24\xc2\x1b\xef\x0e\x6f\x2c\xc0\x6b\x7f\x57\x17\x5c\x18\x27\xb0\xb5\xbe\x93\xb6\xd6\x33\xe3\x59\xed\x0e\x31\x08\xcf\x8c\x67\xd2\xee\x46\xf5\x77\x37\xef\x03\x92\xe9\xc3\xb3\xed\x21\x3c\x93\x21\x1c\xd4\x83\x70\xed\x07\xee\xca\xff\x07\x66\xb8\x14\x20\x3c\x3a\x15\x5e\x89\x54\x78\x35\x35\xfe\x5d\x71\xda\xe4\xb5\x62\x69\x03\x42\x2c\x7f\x33\xc9\xba\xb9\x6a\x3f\xaf\x0f\x83\x47\xac\x97\x01\xbc\xd8\x16\xc0\xcb\xf0\x36\x90\x41\x9c\x6c\x0b\x82\x85\xfa\x95\xa1\x14\xf9\x82\x0a\x28\x3c\x5a\x8e\x0c\xa6\xc8\xb0\x54\x80\xe1\xd1\xc1\x65\x30\xaf\xb6\x05\x23\x45\xdb\x96\x61\xfd\x65\x5b\x58\x62\x98\x6b\x19\xd4\x8f\x5b\x77\x8b\xc5\x13\x90\xc1\xbc\x7e\xe0\x8c\x9d\xbb\x57\x32\xa0\xff\xa8\x0f\x48\x8e\xa9\x2c\x83\xf9\xeb\xd6\x60\x8a\x58\x7e\xb3\xed\x6e\x82\x10\x8d\x32\x8c\x22\x4b\xa9\x85\x21\x84\x79\x94\x61\x14\x25\x88\x0a\x18\x8a\x5d\x7d\x56\x1f\x06\x0f\x4f\x27\x03\x38\xaf\x04\x90\xea\xa8\x0e\x11\xbc\x26\x7a\x02\x96\x5c\x62\x54\x35\x19\xe2\xdf\xb6\x9c\x22\xc5\xa8\xfe\xff\x87\xee\xf0\xfc\xb2\x9b\x1a\xff\xb5\x0d\xf1\xcc\x45\xb2\x92\x3b\xe5\xd6\x87\x53\x08\x16\x25\x43\xba\xaa\x0f\x49\x17\x92\x49\x06\x58\x14\xd7\xf4\xab\x40\x11\xd4\x48\x06\xe6\xd5\x07\x96\x8b\x46\x23\xc3\x51\xaa\xaf\xd5\x70\x0a\x01\x5f\x64\x48\xd7\x5b\x60\xfe\x67\x35\x1d\x5b\xd4\x07\xc1\xc3\x7f\xc8\x00\x96\xdb\xa0\x98\x7a\x5e\x95\xea\xaf\xb6\x99\x73\x25\x84\xa2\x81\x70\xe9\x3a\xd6\x4d\x6f\xb0\x0d\xd5\xc9\x5c\x96\xc8\x40\xd6\x95\x40\xf8\x5b\xfe\xe2\x2d\xd4\xdc\x8d\xb1\xf1\xc4\x50\xbc\x40\x17\x1c\x54\xa4\xcd\x21\xc1\xc4\x9f\xb4\xfc\x5b\x9d\x4b\x11\x03\x19\xec\xd1\x01\xa5\x54\x92\xbb\x02\x79\x24\x45\x7b\x56\x3d\xe3\x26\x3c\x35\x97\x81\xc4\x75\x81\xa4\x2f\xb2\xe5\xfa\x9b\x9a\xf5\xa5\xd7\xd3\x32\xa1\xd3\x70\xa1\x4d\x21\x68\xa2\x0a\x66\x58\x8b\x23\x5d\xb2\xbf\x9f\xe8\xf8\x5b\x3c\xc5\xac\x36\x9a\x20\x90\xb9\x0d\x9a\x34\x9a\x90\xc7\xb4\x83\x68\x42\x2f\xe8\x0c\x96\x66\xb9\xa9\x02\x0f\x4d\x10\x08\xc5\x46\xfa\x25\x2b\x81\x26\xa8\xcb\xf3\xd8\xd7\xf7\x67\x84\xa1\x04\x31\xd5\x78\x7f\x76\xc2\xcb\x52\x85\x0a\x9a\xa0\x3e\x2d\x4f\xd3\x2c\xf7\xe5\x09\x39\x32\x41\x74\x34\x5e\x9e\x9c\xb1\xaf\x54\x03\x86\x26\x08\x64\x40\x83\x26\x0d\x86\x08\x13\x3c\xac\x99\x4b\x6a\xba\x2c\xaa\xd7\xe7\xfa\x07\x0b\xc2\x63\x05\xa5\xdd\x13\xbd\x7b\xf0\xe5\x67\xe6\x05\x67\x86\x48\x88\x4d\x15\x93\x59\x42\x13\xc4\xe7\x49\xda\x1f\x8a\xc5\x92\x5d\x32\xae\xdd\x28\xae\xe5\xa5\x03\x96\xca\xbc\x89\x36\x4d\xb8\x59\x49\x1d\x4b\xcb\x45\xe1\xfe\x94\xf6\x69\x9d\x05\xa1\x52\xb8\x46\xd9\x44\x51\xb8\x70\x13\x7c\xb9\xf4\x17\xd9\x1d\x64\x79\xa9\x46\x11\x09\xf9\x22\x13\x64\x18\xd6\x01\xca\xf5\xe0\x10\x79\xf9\x6f\x1a\xa3\x18\xb0\xcf\x00\x87\x24\x72\xf1\x59\x13\xf5\xfb\x9d\xf1\x00\x1d\x4e\x90\x39\x67\xb7\xa5\x6e\x74\x12\x7a\xf8\x79\x92\x1b\x30\x75\xa0\x35\x87\x17\xe0\x83\xae\x33\x2e\xbd\x6b\xdf\x94\xc3\x02\xbd\x50\x13\xf9\xf1\x3b\xf7\x9d\xb9\xb1\x4a\xed\x68\xaa\x30\x53\x66\x21\x32\x0f\x83\xc4\x0f\x36\x58\x5d\x42\x63\x25\x43\xf0\xe0\xd8\x9d\x1e\xfa\x81\xe0\xa4\x45\x31\x64\xa1\x06\x19\x55\x8b\x8c\xbd\x63\x83\x22\xa5\x0f\x4f\xc7\x22\xd4\x98\xa4\xe7\x8f\x6a\x98\xc5\x46\x34\x77\xa5\x4f\x4c\x80\x4d\x10\x3c\xcf\x50\x3d\xec\xf6\xba\x56\xe6\xcf\x4a\xd8\x18\xe5\x6e\xad\xf8\x9e\x29\xf1\x6e\x15\x21\x3f\x40\x31\x3a\x46\xf1\x34\x9a\xf1\xa7\x24\x85\x4d\x9a\xf9\x26\x13\xbf\xb6\x5d\xcf\x03\xed\x2c\xd9\x3e\x3b\xb9\x00\x4e\x2f\x52\x2a\x22\x3e\x40\x6f\x83\x4a\x93\xab\x20\x3f\x16\xbe\x72\xaa\x4c\xab\x2a\xcc\xaa\x4a\x4c\xaa\xf4\x07\x7f\xfe\x07\x18\x01\x53\xc5\x08\x14\x4a\x59\xb5\x4a\xfd\x50\xab\x54\xa3\x56\xa9\x56\xad\x52\xed\xaa\x52\x68\x2b\x8c\x48\xb0\x2b\x31\x23\xfe\xe4\xbd\x3c\x65\x5e\xa0\xca\x3c\x07\xe7\x7f\xca\x96\xa7\xb2\x8f\x95\xf3\x52\xaf\x8f\x65\x6e\x95\x77\xee\x63\xe5\xaa\xa8\xd7\xc7\xce\xd7\xec\x63\xe5\x9a\xac\xd7\xc7\xee\xd7\xec\x63\xe5\x8e\xa8\xd7\xc7\xaf\x3a\xd7\xb5\xf6\x63\x75\x1f\x3b\xd5\x40\x4a\x28\xa0\x0c\x3f\x63\xdb\x18\x23\x5a\xd7\xa8\xb5\xa2\x1c\x8c\x77\xaf\xce\x78\x4b\xd6\x4b\x91\xb7\x64\x9d\x6c\xe6\x58\xa9\x56\xeb\x31\xbb\xfd\xae\x16\x71\x7d\x5f\x67\x70\xb5\xdb\x0c\x1e\x80\xaa\xec\x12\xb7\x53\xdb\x16\xb9\x4e\x5f\xc2\x5a\xe3\xff\xb2\x53\x8f\xbb\x8f\xda\xe3\x3f\xfe\x89\xb0\xf7\xdf\x3b\xf5\xc5\x79\xd4\xbe\x0c\xeb\xf7\x45\x62\x18\x05\x4d\x80\xa5\x13\xf0\x1e\xb3\x9f\xa3\x07\xf6\x53\xd6\x38\x7c\x93\xae\xfe\x5b\xfd\xae\xe6\xbb\x91\xa7\x5a\x8d\xc6\x63\x76\xec\xc7\x07\xac\xbb\xc4\xbd\x3a\x13\x43\x63\x7d\x4d\xc4\x4d\x1e\xd0\xbf\x3f\x3d\x2c\xe7\xb7\x40\xcc\xd1\x83\x11\xf3\xed\xc3\xfb\x7c\x65\x7c\x78\xf8\xda\xdd\xac\x92\x9d\x77\x98\xce\x33\xf7\xe9\xd9\x49\xe6\x7f\xe5\xfb\xb8\x9d\xf3\xdf\x99\xff\x29\xe1\xa2\x6a\x88\xca\x8c\x57\xa9\x12\xea\x43\x5e\xf0\x0c\x1d\x53\x67\x88\xd9\x17\x78\x0f\x6b\xec\x19\x99\x8b\x02\x18\x9f\x6c\x72\x05\x42\x3f\x54\xcb\x19\x6d\x95\xc6\x54\xd6\xb0\x5d\xd4\xa6\x5a\xcf\x93\x89\x5e\x7b\xb7\x58\x04\x35\xd0\xf5\xfe\xec\xa4\x52\xab\x00\x5a\x73\xee\x9a\xfa\xcb\x17\x24\x7c\x79\x71\xfa\xa6\x52\x80\x4d\x45\x5d\x19\x50\x1a\x29\x2d\x23\xcc\x05\x14\x50\x73\x49\xfa\x6c\xaa\xe8\x2c\x39\x35\x9d\x2c\xe2\x8e\x56\x2c\xf3\x6c\x5e\xc0\x85\x5d\x63\xfd\x57\xc6\xac\x93\x0a\x96\x85\x1d\x13\x7f\xd4\x7d\x67\x7e\xf6\xf3\x67\x87\x9f\xac\x14\x0a\xd3\xb4\x4e\x01\x13\xf4\x55\xe6\x39\xa9\xa6\x84\xa5\x8a\x8a\x22\xfe\x54\xc8\x34\xca\x7e\x48\x4e\x74\x94\x33\x26\xea\x86\xf5\x3a\xec\x42\x5f\x94\xde\xf7\xd9\x22\x61\x17\xd2\xc7\xa5\xcd\x36\xc8\x86\xde\x47\x11\x3a\x9a\x20\xc3\x36\x08\x86\x23\x74\x38\x41\xc6\xd8\xa8\xa8\x39\x41\x60\x3b\x55\x0a\x1c\x45\xa2\x42\xd7\xb6\x50\x0b\xf5\x46\x68\x1f\xbc\xb5\x67\xee\x4e\x1e\x6b\x8d\xe7\xba\x67\xec\x16\xe7\x58\x30\x99\xae\xa3\x66\x74\x2b\x37\x96\xcb\xd5\x8c\x5f\x4f\xad\x08\x08\xc9\x19\x61\x29\xa4\xd7\xb3\xd7\x95\x03\xaf\x3a\x2e\x56\xe8\x18\xad\xf8\x80\x64\x76\x58\x43\x7b\x8a\x9f\xc1\x16\xb5\xf8\x99\x9a\xa0\xd1\xef\xd5\x51\x2e\x4e\xce\x5e\xa3\x79\xe8\xe1\xec\x20\xd5\x6d\x23\x4d\x07\xd8\x9b\x64\x75\x2f\x20\x73\xb7\x55\xf4\xf2\xe4\xec\x6b\x1f\x28\xd5\x07\x49\xc5\x8a\xe3\x2f\xc1\xa9\x27\xa2\x26\xbc\xa4\xa6\x7f\x57\x54\x94\xef\x18\xea\x04\xe2\x50\x61\xc9\xa8\xab\x90\xaa\xab\xea\x02\xa0\xdf\xfd\x56\x17\x2c\x1f\x85\x22\x00\x99\x48\x53\x28\x5e\x08\x9f\xba\xae\xad\xa4\xe6\xbd\x79\x66\xfc\xf6\x6c\x0b\xad\x1b\xf9\x21\xbd\x79\x66\x1b\xbf\x3d\xdb\x42\x17\x88\xb6\xd4\x07\x66\xdd\x5b\x3f\xa8\x7b\x03\xc7\x58\x7f\x8b\xfe\x19\xd1\x36\x3a\x4b\xde\xbd\x3c\xdf\x99\x7a\xac\x6f\x20\x47\x1b\x33\x44\xf2\x55\xcf\xc3\x87\x44\x65\x44\xe0\xd1\x06\x79\xf3\x90\x41\x1a\xf6\xcd\xd7\xee\x5c\x7d\x79\x48\xfc\x29\xa7\xdb\x2f\x4f\xce\xd0\x87\x84\x53\xed\xb5\xd5\xa4\x83\x31\xea\xb5\x51\x43\xb1\xac\xe8\x42\x21\xa6\xcc\x07\xb2\x00\x1a\xd7\xe4\xcf\xef\x22\xf2\x37\x59\x1a\x62\x81\x8b\x8b\x52\x26\x42\xfc\xd9\x8a\x36\x35\xd6\x5f\x85\xe4\x35\x6a\x93\xbc\xda\xa4\x6e\x0b\x14\x36\xbe\x15\x0a\xb7\x5b\x8f\xd5\xeb\x90\x1e\x57\x7c\x2d\xaa\xcf\xb2\xc7\x14\x0a\x74\x8c\x7f\x19\x67\xb2\xab\x50\x20\xb5\x59\x4b\x26\x50\xf7\xe9\xcb\x17\x64\x7c\xc7\xb9\x77\x92\x68\xf0\x04\x0f\xcd\xa6\xac\x57\x4f\x1a\x29\x6b\x58\xdd\xab\x7c\xf5\xdd\x78\x35\x6a\xd2\x54\xca\xfb\x3e\xc9\x71\x5a\xc2\x97\x17\xa7\x6f\xc0\x06\xaa\xbe\x5c\x9f\x9f\xd4\x1a\xce\x37\xe5\xa4\xf4\x46\x1b\xa0\x65\xf9\x8a\x30\x30\xac\xb9\xea\x78\x22\xbc\x63\xa5\xe0\x3e\xf0\x55\xba\x7d\x3c\x99\x2a\xc8\x29\xf7\xbd\x0d\xe8\xb4\x52\x39\x6c\xb6\x54\xb6\x0b\xed\x25\x2e\x32\x3d\xf8\x85\x0a\x7c\x01\xba\x34\x69\xca\x46\xb4\x0d\x48\xb2\x5d\x79\x2b\x5f\x49\xaa\xb6\xf5\xb8\x15\xd4\x81\x75\xba\x96\x6d\x07\x19\x24\x4d\x89\x71\x66\x3e\xb8\x51\x0c\xa6\x7f\xf3\xed\x5c\x03\xd0\x8f\x69\x71\x3f\x37\xd3\x69\xcc\xad\x76\x78\x1b\xe0\xe8\xa5\xc6\x3b\x4e\xbc\x76\xe5\x50\x77\x89\xec\xd0\x6a\x89\x57\xab\x10\xdd\x86\xd1\xca\x33\x08\x46\x44\xdf\x56\x52\xb8\xc5\xd4\xfd\x6f\x3b\xbc\xbe\x8e\x71\xf2\x8b\xef\xf1\xe0\xb2\xfc\xd3\x8f\xd8\x5f\x2c\x13\xa9\xad\x38\xb9\x5f\xe1\xf6\x75\x18\x24\xbf\x40\x26\x69\xf2\x2a\x5c\x79\x46\x0e\x72\x58\x84\x1c\x97\x41\x16\xec\x65\x33\x47\x2c\x89\xd5\x64\x14\x2d\xa4\x9e\xc7\xc9\x9f\x31\x9b\x11\x8e\xd0\xdd\xbc\x2d\x30\x77\x75\x91\xd9\x19\x58\x75\x2c\x66\x5f\xbc\x7f\x93\x99\xc4\x92\x04\xb3\x46\xfd\xe9\xdd\xcb\xd3\x8f\x6f\x5e\xbf\x3b\x4d\xed\x61\xd3\x2f\xac\xc4\x8b\x37\xaf\xdf\xfd\x35\xb5\x7d\x85\x14\xb7\x64\x7d\xf7\xf3\xe9\xc7\x33\x52\x73\x44\x4d\x59\x69\x3a\xcb\x7d\x7d\xf6\xfa\xc5\x1b\x92\xef\x0c\x78\x01\xfa\x29\x35\x77\x05\xfc\x40\xb4\xdb\x9c\xb9\x6b\x90\xaa\xa7\xdd\x7a\x86\xaf\x15\x3b\x57\xf2\x5e\x4d\x43\x43\x7f\x0c\x6f\xe3\xff\x6f\x83\x37\x58\xd2\x2b\xb2\xcc\x57\x91\x7b\x83\xe3\xb3\x4f\xfe\x7a\x8d\x3d\x91\xbb\x60\xf9\xcf\x03\xff\x06\x6e\x5e\xa0\x60\x5e\x97\xbe\x76\x03\xb6\xf0\xe9\x2c\x7f\x08\xc3\x15\xf3\xd6\x1e\xb7\x5f\x86\x37\x85\x4c\xbe\x3f\xa8\xb7\x6f\xea\x91\x2b\x8d\x87\xea\xe7\x49\x0d\xa6\xd5\x2d\x6b\xf7\x06\x05\xa2\x51\x61\xd9\xfb\x1b\x41\xd5\x47\x3a\xfa\x6a\x5f\x8b\x6a\x4c\x53\xc2\xa9\x10\xfa\xe3\xc4\x8d\x12\xb5\xb7\x63\x1c\x78\xfb\x05\x4f\xe3\x56\xf9\x74\x64\x81\xd6\x74\xd3\x75\xeb\x07\x5e\x78\xdb\x66\x57\x90\x72\xb6\x5c\xf5\x4d\x18\xae\x33\x9f\xdf\x96\xa5\x0f\x82\x26\xd6\x28\x27\xdd\x8a\xa8\xab\xb7\x91\x9f\x60\x1a\xe1\x5c\xf0\x7b\x9a\xb1\x3d\xaa\x75\xd9\x68\x80\xa5\xaa\x55\xb1\x34\x1f\x3e\x56\xbd\x07\xc4\xf2\xcd\xa2\xf1\xc1\x44\x56\x8a\xce\xa7\xad\x7a\xbd\xa4\x98\xe8\x59\x08\xb3\x6d\xa8\x0f\x7d\x59\xee\xb0\x31\xbb\xdf\xc8\x37\x33\xb5\x67\x6d\x58\x81\x22\x74\x55\x21\x1c\x78\x75\xbc\xea\x3a\xa2\x57\x5d\xcd\x98\xb8\x2b\x31\x75\x5b\x11\xeb\x10\x3a\x44\x38\x67\xcd\xae\x2d\x9b\xdf\x13\x52\x09\x1c\x78\xe8\x08\x25\xf4\xa6\xa0\x14\x16\x0e\x54\xae\x24\x15\xa2\x59\x7d\x62\x5a\x4a\x2c\x79\xa0\x7e\x20\x23\x5a\x36\x5d\xb3\xd5\xaa\x09\x11\xcc\x49\x6e\xd2\x12\xd4\xa2\x91\xc9\x54\xeb\x68\x8f\x3e\x3d\x34\x15\x01\x53\x19\xdd\x15\xdc\xb8\x59\xd9\x06\x2d\x94\x92\x38\x82\x42\x43\x27\xd4\x85\x19\x8e\xf2\x62\xbd\xec\x6c\x30\x63\x20\xc3\x55\xcc\x5c\xd4\x2a\xdf\x48\xe8\x86\xa3\xb8\x1a\x02\x7b\x83\xff\xc2\x81\xf7\x5f\xc8\x8f\x51\x12\x86\x68\xe5\x46\x0b\xdc\x46\x6f\xc3\x38\x41\x2b\xff\x13\x5e\xdd\x23\x17\x5d\xb9\x1e\x3a\x39\xfb\x48\x2d\x0f\xf4\x9b\xce\x3a\x40\x31\x78\x2f\x3f\x40\xb1\xe6\x15\x04\xf7\xf7\x11\xa3\x46\x1e\xca\xbd\xe7\xc7\x6b\xd1\xab\x58\xd6\x4b\x3f\xc0\x31\x91\x05\x4c\xd7\xa2\x5e\x6c\xb5\xba\xf4\xa5\x32\x60\x2d\xe1\xb9\x50\xab\x18\xec\xf8\x8a\x48\xa9\x2d\x65\x47\x54\xd3\xc9\xa3\x91\x12\x51\x0e\x62\x05\xa8\xb2\x59\xc0\xe2\x82\x5c\x7e\x87\xf6\x51\x4b\x65\x86\x9a\xd2\x8b\x79\x49\x5c\xde\x0d\x9a\xa0\x9c\xb3\x31\xce\x5e\xbf\x8a\xdc\x05\x30\xd6\x16\x7d\x3f\x03\x0e\x61\x33\x1c\x15\x06\x41\x56\x61\x84\x83\x69\x3c\x4b\xff\xe6\x84\xa8\xd4\x0b\xdc\xb5\x62\x25\x2a\x60\x15\x03\xec\xca\x54\x42\x59\x59\xdc\x20\xd7\xe5\xbc\x4c\x3b\xc2\x2b\xec\xc6\xd8\xbc\xae\x47\x9b\x52\xf4\x2e\xa8\x97\xc5\x05\x3a\x44\xfe\x01\x5a\x54\xb8\x3d\x26\xa2\xd9\x6a\xba\x98\x4d\xed\x59\x13\xdd\xf3\x84\x33\x6b\xa2\x2b\x9e\xe8\xe8\x06\x4a\x4e\xb1\xab\xd2\x1b\x0d\x52\x62\x01\xeb\x92\xbe\x50\x22\xad\xb5\x1c\xab\x89\x6e\x40\x40\x80\xb7\x27\xe6\x5c\x88\x43\x90\x5f\x11\x50\xc0\x83\xdf\xb2\x3b\x60\x8f\x2f\x02\xab\x89\x36\x92\xe8\xc4\x55\xc2\x84\xe2\xa6\x2d\xa9\xa1\x5b\xd4\x3d\x32\xc0\x7f\xb2\xa6\xad\xa4\xb3\xaf\x9e\x15\x77\xfe\xdb\xc6\x8f\xb0\x69\x41\x2b\x0f\xe9\x1d\xeb\x5e\xad\x46\x9a\xa8\x45\xdd\x4e\xde\x58\x68\x9d\xf7\x84\xce\xfc\x2a\xb5\x3e\xfb\x1e\x0e\x49\x4b\x85\x12\x7c\xbc\x2d\xba\x65\x8d\x2d\xfc\x3b\x5f\xa2\x09\xea\x3b\x0e\x7a\x8a\x6e\x9a\xe8\x04\x4d\xd0\x0d\x3a\x3a\x42\x63\xf4\x94\x7c\x6d\xa2\x5b\xfe\xc5\x19\xd5\x70\x62\x7c\x8b\x9e\xa2\x90\x0a\x64\x94\xc3\xff\xf2\xa5\xd8\x59\xea\x1c\x15\x24\x54\x8b\x34\x79\x88\x20\x80\xa6\x79\x82\x1a\x13\x34\x22\x68\xa3\x60\x32\xf9\xed\xe9\x53\x1d\x14\x21\xb2\x33\xaf\x46\x85\x3a\x7d\x95\x2b\xea\x8e\x95\x17\x67\x92\x5d\x6d\x97\xf9\x67\x68\x82\x2e\x6b\xa8\xa0\x09\x5e\x4f\x28\x46\xcf\x9a\x88\xe0\xf7\x96\x74\xaa\x30\xdc\x9d\xd4\xc6\xe9\x10\x98\x38\xaa\x1f\xf5\x12\xa8\x78\x61\xd8\xd0\x93\x4e\x7f\x08\x6b\xef\x12\x52\xa4\xe3\x4e\xdf\x82\x70\x97\xf0\xf9\x84\x75\x77\x82\x6c\x32\x39\x97\xe8\x10\xb2\x4a\x30\xbc\x68\xcd\xc3\x55\x18\xb5\x0c\xd4\x40\x97\x6c\x8e\xcb\xab\x64\xe5\x4f\x1e\x66\xe7\x46\x56\x1f\xd5\x2e\x5f\x59\xc8\x23\xa8\x7d\x76\x48\xf6\x1c\x82\xe6\x26\xac\x99\x5b\xdf\xc3\xad\xf9\xd2\x8d\x8c\xa3\x67\xa8\x81\xee\x51\x03\x19\x87\x7b\xa4\xdc\x91\x71\x90\x79\x12\xbf\xcf\x19\xa5\x1c\xa1\x4e\xbf\x5f\x02\x96\x9a\x33\x56\x00\xe6\x77\xc8\xf7\xf5\x5e\x74\x3d\xad\x73\x51\x03\x3d\x32\x9e\xba\x37\xeb\x83\x3a\x57\x7c\xb5\x4d\x3d\x0f\xb7\x68\x7c\x95\x3c\x6e\xdb\xb5\xcc\x4c\x59\xdb\x8b\xc7\x6b\xbb\xfe\x95\x11\xb4\x0d\x51\x6d\x0c\x64\xa0\x63\x64\x3c\x0d\xae\xe2\xf5\x81\x81\xf6\xd1\xfd\x83\xd6\x2e\xe1\x93\x6e\xd4\xd9\xf5\xdc\x84\x7f\xdb\x73\x4d\x3a\x75\x4b\x78\x20\xb1\xd6\xa6\x3c\xca\x7d\x54\x22\x6b\x48\xda\xd2\x12\x59\xa3\x68\x53\x7b\xe3\x27\xe4\xf0\x04\x69\xca\x68\xaa\x76\x1d\x6b\xa3\x68\x42\x44\xbf\x2b\x54\x36\x5b\x6b\x73\xb4\x72\x1e\xeb\xd8\x19\x5e\xe1\x79\x52\x08\xbb\xaf\x92\xf7\x58\x50\xf2\xc2\x85\x2b\x03\x90\xa2\x42\xc1\x06\x57\xd7\x29\x11\xe9\x4a\x5a\x98\xda\xb3\xbc\x90\x47\x43\xae\x93\xf9\x2c\x8d\x52\x02\xb1\xfb\xd5\xa2\x0a\xd3\x78\x97\x15\x08\x79\x2c\xf6\x1b\xf7\xce\x8c\x9a\xc8\xd6\xb9\x36\x8e\xd3\x82\x7e\x60\xfa\x85\x35\x92\x49\x7a\x6a\xdd\xcd\x13\x33\xd4\x49\x9f\x5f\xbe\x10\xe1\x90\x9c\x89\x65\x6c\x77\x50\x4f\xce\x71\xe1\x66\x13\x82\xb5\xa0\x63\x84\xa7\xf6\x0c\xed\x23\x5b\x33\x26\xf2\x43\xa4\xca\x90\x09\x82\xc7\x28\xa1\x15\x14\x72\xb5\x86\xea\x05\x8a\x6d\x45\x7b\x97\xae\x47\x7e\xbd\x11\x42\xa8\x86\x95\xa5\xbb\xa3\xe7\x1e\xab\x09\x1e\x43\x45\x08\x7e\x11\x9d\xdb\x34\x9b\xc6\xfa\x52\x6a\x0b\x96\x16\x78\x63\x86\xdb\x87\xca\x50\x2c\x84\xc0\xfa\x0f\x47\xd6\x96\x08\x8b\xa1\xd7\x73\x9d\x99\xb0\xe6\x20\xa8\xde\x73\x62\x07\x82\xad\x2e\x82\xd5\x01\x23\xf2\x1d\xaf\xeb\x27\x5f\x88\x46\x08\x9e\x61\x4c\xb2\x5b\x0b\x3b\x88\xdf\x38\xe9\x42\x4a\x78\xfe\xe7\x82\xd9\x47\xe6\x4d\x05\xae\xb4\x96\xfc\x3a\xcb\x57\x98\x11\x2f\xdd\xe8\x2d\x76\xe3\x4d\x94\x96\x6b\x20\x63\x7d\x67\x34\xd3\xea\x09\xa8\xaa\xf1\x83\xea\xae\xf0\x35\xe8\x85\xca\x2b\xdf\xfa\x5e\xb2\x2c\xd4\xa5\x5f\x15\xba\x85\x7c\xc5\x1f\x90\x19\x11\x0a\x67\x65\x20\xaa\x2e\x3a\x3f\x62\x22\xf0\xc0\x55\xa7\xbb\xbd\x17\x74\x51\xff\x96\x9d\xb8\x97\xf8\x2e\xc1\x81\x07\x14\x4d\xa3\xd8\x07\x0d\x37\x9a\xf0\x8b\x3d\xb8\xda\x67\xab\xe9\xfd\x35\xa9\xf7\xfb\xe5\x25\xac\xaf\xcb\xcb\x7d\x34\x9d\xfd\x81\xfc\x20\x4e\xdc\x60\x8e\xc3\x6b\xf4\x3c\x8a\xdc\x7b\xd2\x5a\xc5\xe9\x86\xdb\x29\x0c\x39\xfe\xea\x1f\x72\xc7\xf4\x47\x23\x3d\x5a\xfc\x80\xe4\x27\xed\xa5\x1b\xbf\xbf\x0d\xd2\xdb\xc7\xc8\xa2\xca\xe6\x69\x34\x83\xc3\x25\x9a\x89\x47\x73\xf1\xda\x53\x88\xea\xaa\x8a\x9d\x22\xdc\x19\x2b\xc9\x0f\xa0\x76\x1e\x06\x71\x12\x6d\xe6\x49\x18\x29\x42\xca\xe6\xe3\xda\x62\xda\x52\x13\x25\xd9\x5e\x65\x8c\x56\x6a\x40\xc3\x26\x80\xee\x25\x13\xfc\x68\x98\xbe\x54\x3c\xca\x52\x4d\xb8\x24\xf3\x15\x2e\xa0\xd2\x15\xf5\x48\x37\xb5\x8e\xcd\xe2\x43\x44\xe6\x98\x9f\x66\xa6\xc3\x42\x2a\x44\x66\xc7\x61\x6a\xce\x33\x88\x49\xd0\xbe\x8e\xc2\x9b\x13\x26\x61\x99\xce\xc0\x66\xc1\x0d\x84\x88\xcf\xcb\x26\x32\x16\x46\xad\xeb\xdf\x32\x87\x49\xbf\xbc\xff\x98\x5d\x0d\x93\x04\xfb\x2e\xdd\x0a\xc3\x85\x70\xe5\xad\xed\xa6\xc4\x22\x24\xfd\x9e\x98\xa9\x9f\xa8\xe2\xa9\xc4\xd9\x01\xdc\x9e\xbb\xab\x15\xbd\x86\x82\xc8\x77\x4b\x3f\x7f\xf2\xb0\x35\x18\xe4\x5d\x18\x05\xed\xcb\x2b\xb8\x47\x23\x68\x85\xa4\xc8\x02\x13\x4a\x09\x1f\x05\x52\x43\x0e\x66\xf8\xe6\x07\x7e\x1a\xe7\x26\x26\x2c\x47\xd0\xc6\x81\x7b\xb5\xc2\xf4\xef\xcb\x9b\xd0\xc3\xfc\x5e\x75\xd5\x4e\xcf\x86\xb7\xe4\x33\xdc\xfa\x07\xed\xcb\x95\x1b\x27\x6f\xc3\x4d\x8c\x5f\x86\xb7\xc1\xb9\x7f\xc3\xae\xad\x82\xf6\xa5\x3b\x4f\xfc\xcf\x58\xaa\x05\x2f\xbd\xb8\x61\x7d\x50\x7e\x19\xeb\x13\xbc\x61\x79\xe9\xe7\xba\x5c\x7e\xeb\xc8\x09\x94\x02\x97\x94\xc8\x51\xb4\x9d\x47\xfe\x0d\x87\x58\x23\x72\x73\x7a\x55\x7c\x19\x06\xa4\xaa\x59\xb8\xc5\xe1\xdc\xc9\x0d\xc1\xca\xdb\xf0\x33\x7e\x20\xf4\xb7\xbc\x7e\x45\x13\x04\xf1\xbb\x34\x21\xf9\x99\x54\x37\xf1\xd3\x7a\x97\x06\x04\xc7\x98\xc2\x84\x8b\x2d\x89\xa1\x53\xfd\x98\x85\x88\xaa\xb2\x05\x82\xf0\xd6\xe9\xf2\xca\xde\xac\xd0\x69\x6d\x87\xd7\xd7\xa6\x91\x44\xfe\x8d\xd1\xd4\xce\xb7\x18\x13\x3b\x2f\xf6\xe4\xa2\x40\x01\x1e\xbc\xf0\x36\x30\xb4\xc8\xb7\xb4\x83\xa2\xbb\xaa\x8e\x7d\x13\xef\x7c\xf0\xc0\xbe\x17\xc3\x57\xed\xd4\xf1\x18\x27\x2f\x38\x71\xa9\xb6\x7d\x4b\xe9\x10\xb7\x3d\xc9\x4f\x91\xd4\x8e\xe6\x9c\x11\xcf\x2a\x63\xe9\xc6\x69\xf5\xa2\xb8\xbe\xc0\xc9\x7e\x19\x4a\x51\x8e\x0a\x30\x8a\x46\x0d\xe2\x52\xb8\x67\xf9\xab\x70\x55\xa1\x53\xe5\x55\x38\x0f\x8d\x6b\x3e\x01\x23\x8c\x27\x09\x67\x28\xec\x19\xbd\x79\x20\x7f\x7c\xf9\x42\x05\x5b\xfa\xc1\x99\x29\xc2\x56\x05\x9b\x1b\x1c\x91\x25\xb2\x0f\x2f\xbc\xe7\x61\x70\xed\x2f\x36\xe9\x17\x01\x6d\x56\x3d\xbc\xa5\xa2\xc2\x39\xbe\x4b\xfe\x09\x11\x07\x72\x74\x8a\x33\x86\x46\xe5\xa3\x29\x49\x37\x30\x61\x28\xcc\x24\x36\x7a\xb5\xee\xc3\x35\xbc\xaa\x1d\xc9\x76\x31\x72\x83\x78\xe5\x72\xbb\x93\x37\x7e\x80\xcf\x43\xca\x7e\x98\xd2\xee\x5b\xe0\xc4\x24\xed\x41\x44\xe4\x26\x88\xde\x84\x0b\x53\x49\xbb\x29\x93\x19\xf2\x3e\x36\x90\x03\x61\xce\x52\x6d\x85\xc3\xe2\x9c\x95\x68\x04\xe2\x14\x85\x42\x0f\x42\xc6\x35\x55\x77\x3e\x26\xfd\xd4\xc9\xe2\x71\xdb\x8f\x7f\x89\x5c\xb0\x55\x39\x46\xfe\xd4\x17\x62\x74\xcf\x50\x63\x82\x5c\x88\x7b\x07\x78\x72\xeb\x7b\x91\x93\x97\xf4\xd6\x83\x4b\x28\x7a\xb7\x18\x1f\x95\xfb\x15\xca\xa5\xaf\x38\xd0\x34\x9a\xe1\x8d\xbb\x36\xeb\x84\x31\x16\xbd\x0b\xaf\xdc\x39\xc4\xb5\xcc\x1c\xeb\x4b\xad\x59\xed\xbf\x87\x7e\x60\x06\x6d\x3f\x7e\x7b\xf6\x0b\xd8\x2c\xc5\xe8\x18\x19\x17\xd1\x45\x60\xa0\x7d\x64\x5c\x04\xf9\x7a\x5b\x93\x0a\x91\x94\xcb\xb4\xb8\xce\x59\x44\xb7\xb3\xee\x98\xa5\xa7\xe4\xdb\xfc\x59\x12\xa7\x25\xb8\xa5\x8b\xfe\x74\x29\x9b\xf4\x07\x68\x1e\xa2\xd4\x90\x85\x48\x0f\x4a\x95\x04\x68\xa8\x55\x06\x25\x7c\x0b\x1b\x06\x93\x5f\xa8\xec\xe2\xd3\x25\x6a\x1f\x20\x17\x1d\x66\x5e\x3b\x91\x5b\x62\xfa\x01\xc6\x97\x53\x57\x45\x8e\x42\xb2\x0c\x57\x70\xc3\x4e\x7b\xb6\x9a\x76\x66\xac\xd7\x47\x64\x81\x3e\x7d\x8a\xe2\x56\x8b\x10\x34\x9e\x0c\x5a\xad\x72\x3d\x39\xd7\xb5\xc1\xc5\xaa\xc6\xaf\xa8\x3e\x8c\x3a\x57\x85\x85\xed\x18\xbb\xd1\x7c\x69\xee\x5d\xc4\x8d\xef\xf6\x74\xba\x4f\x16\x91\x96\xde\xdd\x2f\x45\x3d\xea\x12\xd4\x5b\x44\xa4\x3b\x04\xf5\x5b\x4a\xd3\xcb\x95\xfc\xa9\x76\x27\x0d\x4e\x07\x8a\x3c\xed\x92\x51\x1a\x42\xa9\x5d\xae\x96\xb1\xfe\x5f\xc5\x8e\xb2\xe2\x40\x4d\xe3\x83\x5d\x2a\x76\x06\x0c\xd5\x02\x91\xca\x8f\xdf\xf8\xc1\xe6\x0e\x1c\x2c\x67\x6e\x33\xc4\x03\x5d\x88\x3d\x09\x99\xf4\x56\x23\xc0\xb7\xb1\xc0\x2b\x15\xab\x95\x6c\x44\x15\x5a\xcb\x8d\x5c\xcb\x8c\xdc\x6a\xdc\xb2\xb0\x3b\x93\x2a\xe6\x42\x77\xa3\x52\xc6\x6e\x94\xde\xb6\xc8\xcc\x2d\xa9\xf4\x7c\xb5\xaa\x4f\x0c\x7d\xc6\x8e\x3e\x5f\xad\x9e\x83\x78\x2b\xfa\xe4\xa9\x41\xf0\xa8\xcc\x58\x8b\x99\xa6\x0d\x32\x21\x53\xb0\xbc\xab\xd1\xca\x02\x53\x91\x9c\x52\xd5\x93\x30\x8c\xbc\xb8\xe6\x8e\x01\x13\x34\x5a\xc3\xc4\x2a\x09\x83\x7f\x13\xf4\x09\x1a\x75\xbc\xe2\x82\x44\xc5\xa7\xf0\x6d\x31\xb5\x67\x84\xf2\x11\xc6\x80\xff\x9f\xc5\x8e\xce\xdf\xf5\x24\xd5\x83\x07\x31\x88\xf9\xff\xb9\x09\x37\x79\x5d\x76\x1d\x0c\x70\x9f\xf9\xe7\x21\x57\x51\x2b\x51\x62\x11\x82\xae\xd8\xef\x1a\x23\xc2\x1f\x8a\x18\x64\x2a\x67\x0d\x66\x20\xb6\x3c\xcc\x3f\xa1\xad\x11\x8b\x34\x6d\x26\xe8\x88\xfb\xc2\x40\xad\x09\xd5\x12\x8a\x14\x39\xbd\x0b\x4b\x9a\xa8\xd5\x27\x47\x22\xfc\x32\x13\xb4\x37\x21\x7f\xa2\x3d\x5a\xd6\xbd\x8a\x4d\xd0\x37\x43\x2a\x0a\x37\x81\x67\x3a\x3d\xd2\x4d\xab\x74\x25\xa7\x47\x7f\x05\x62\x6d\x16\x9b\xf5\x6a\x93\x24\x21\x35\xf3\xc7\xed\x75\x84\xc9\xfc\xbc\xa4\x37\xec\x19\x57\xe1\x45\xee\x22\x37\x69\x99\x8b\x13\x36\xb3\x27\x2b\x7f\xfe\xe9\x84\xe4\x9a\x98\x86\x71\x5b\xfa\xd7\xc9\x5f\xf1\x7d\x6a\x90\x18\x06\x67\xe4\x13\x14\x24\x3d\xda\x47\x8e\xf0\xca\x70\x9e\xd6\x17\x2b\x40\x64\x5d\xa1\x46\xa7\xaa\xc6\xcb\x70\x73\x25\xd5\xe8\xaa\x6b\xa4\x4a\x75\xd8\xcb\xeb\xac\x0a\x1f\x97\xeb\x79\x35\xd8\xa8\x27\x76\xd9\x74\x28\x61\xec\xa8\x1f\x93\x14\x0b\xf2\x6b\x27\x8d\x9a\x81\x70\x84\x86\x56\xff\xa5\xd6\x58\xd4\x02\xbc\x59\x1b\x1a\x85\x94\x62\xe5\xbc\x0e\x12\x1c\x7d\x76\x57\xe7\xfe\x0d\x8d\x0d\x8b\x13\xfe\xa9\xe6\x19\x8d\x45\x70\x85\x63\x1a\x36\x52\xd9\x59\xaa\x66\x8c\x6b\x1d\xae\x7a\xcc\xe8\xb5\x52\xbb\x63\x5d\x0f\xbb\x14\xf1\x20\x1a\xa4\xa8\x2d\x9d\x86\x1a\xd3\x44\x18\x88\x32\x7a\x93\x6d\xe9\x2d\xce\xcf\x58\xe2\x27\x04\xf3\xf0\x5c\xf6\x69\x90\x05\x3c\x56\x1d\x9f\x26\x2e\x27\x86\x02\xf9\x78\x70\xef\xde\x50\xae\x4e\xa0\x78\x25\x4c\x47\xfa\xca\xbe\x4a\xcd\x5e\x82\x8d\x8a\x01\x97\xd6\xad\xc0\x24\xe5\x06\x6d\x81\x22\x8a\xc2\xbf\x16\xec\xd4\x99\x59\xd3\x92\x6c\x7b\x36\x63\x12\x53\x69\xa1\x46\xa3\x74\xb2\x04\xca\xbd\x85\x20\xa1\xc5\x53\xe1\xb9\x45\x86\x1b\xdd\xe4\xfc\xf2\xfe\xe3\xcb\xec\x58\x23\xb9\xbf\x84\x91\xf7\x3c\x31\x2b\xce\x5c\xe1\x00\xf9\x93\x7a\xfe\xe6\xf5\xbb\x53\xb9\xe7\x44\x6a\x7f\xce\x34\x3a\x25\x9d\x2f\x9e\xde\x35\x47\x60\x06\xf8\x16\xbd\x74\x13\x6c\x91\xc5\x43\xa8\x85\x99\xef\x38\xe1\x80\x58\x9f\x8a\xf7\x51\x47\xa8\x67\xdb\xfc\x52\xad\x7d\xe9\xf9\xf4\x1a\xfa\x55\x14\xde\xbc\xe1\x85\xd3\xd0\x46\xd8\x42\x47\xc8\xb1\x2d\x01\x13\x73\xb1\xc3\x76\xba\x2b\x54\x17\x5f\x49\x21\xf3\x43\x16\xc6\x63\x8a\xdb\x6b\x77\x81\xff\x06\x86\x17\xee\x02\xff\x9a\xbe\x1b\xca\x5a\xc8\x3d\x6b\x96\x10\x58\xda\xf1\x0a\x5c\xb2\x73\x2d\x65\x09\x33\xc6\x4f\xdd\xdd\xa9\x3d\x43\x2d\xd6\xcf\xbf\x59\x4d\x54\x59\xde\xc9\xca\xff\x5a\x83\x6d\x24\x07\xd4\x56\x0b\x58\x41\x64\x8e\x91\x9a\x52\x9c\x06\x1e\x28\x6c\xb5\x99\xce\x8c\x6b\x8e\x55\x6a\x92\x07\x9e\x0e\xa5\xe4\x78\xc2\x77\x4e\xca\x3e\x2a\xbb\x95\x3e\xa2\xd3\x90\xc6\xb2\xda\x70\xd3\x6d\xa3\xfd\x8a\x12\x0a\x49\x2d\xad\xa3\xef\x3a\xdc\x99\xa7\x44\x97\x42\x3d\x0f\x39\xc9\xd2\x34\x58\xc6\xd3\xcb\xa8\x2c\x88\x6a\x02\x3e\x0b\x95\x8f\x90\x5d\x89\x07\xd5\x28\x5b\xc8\x49\x47\x5a\x00\x7a\x48\x05\x2c\xed\x58\x18\x7a\x75\x47\x62\x6e\xfa\xae\xc4\x77\xad\xa5\x16\x8f\xf5\x8e\x46\x0a\x5e\x73\x30\xd2\xde\xa9\xb4\x8d\x20\x1c\x32\xd5\x68\xd9\xa9\x49\x21\x88\xa4\x07\x15\x35\x75\x54\x8e\x01\x3c\x4c\xf4\x9b\x92\xe4\x43\x39\xa7\xa2\x9c\x33\x4b\x29\xb2\x20\xe0\x94\x10\xbf\x74\xc6\xea\xb0\xd1\x85\xf9\x55\x3c\x0b\x64\x41\x16\xfd\x78\x5d\xe0\x5c\x69\xa5\x26\x7a\xe2\x3c\x74\x19\x12\x72\xaf\x59\x84\x05\xc5\x08\xa8\x34\x8a\x4f\x05\xa3\xf0\x36\x9e\x95\xec\x67\xd2\x44\xd1\x12\x12\x80\xcd\xf2\x0a\xa9\x6a\x82\xfc\xd3\xba\x16\xdb\xaa\x57\xfa\xeb\x5b\x98\x87\xc1\x67\x1c\x25\x3f\x33\xbf\xcc\x27\xe1\xea\x3c\x4c\xa3\x2d\x42\x60\xf8\x9a\x66\xce\xe9\xa6\x01\xf2\xee\x53\xbd\x3c\x2c\xc9\xa3\x09\xf2\x0f\x90\xdf\x68\x58\x5c\xdb\x30\xf5\x39\xa3\x18\x15\x02\x2c\xb0\xc3\x30\x2a\xd5\x21\x51\xe2\xb6\xd5\x19\x55\xbc\x3d\x8c\x6a\x5d\x6f\xb1\x95\xa6\xd0\xb8\xa7\xd5\xab\x71\xc8\xad\x65\x42\x7a\x6b\x01\x2f\x67\xe9\x29\x1e\xd2\xab\x0c\x9b\x5f\x65\x14\xcf\x3c\x1e\xd4\x2f\xe2\xe1\xa5\x42\xb5\x01\x33\x33\x36\x0f\xf9\x53\xfd\x62\x3d\x6a\x37\x6d\xa1\x50\x19\xd5\x82\xd5\xf7\xd1\x21\x8a\x04\x1d\x76\x01\x8c\x0f\x2e\xf4\x0e\x2c\x32\xa3\x39\xa2\xa4\x7d\xc3\x96\xdd\xbc\x90\xc5\xb1\x64\x7f\xe9\x3a\xc1\x04\x93\xe9\x8a\xaf\x12\x13\x1c\x2c\xad\x5a\x2d\xab\xc9\x35\x3f\xd3\x65\x9a\xe9\x92\xcc\x65\xa3\x61\x09\x83\x67\x2f\x63\xfd\x98\xcc\x03\x59\x2d\x67\x78\xed\x46\x6e\x12\x46\x66\x0e\x1f\x64\x24\x69\x83\x70\x1b\xa9\x68\x34\x6c\xb5\xe0\xcf\x32\xb4\x35\x90\x93\x43\x5d\x9d\x4e\x50\x6c\x92\x4e\xa4\x03\x23\x5f\x8a\x83\x6b\x12\x7c\xc3\x9f\x75\xee\x6c\x7e\x67\x2a\xfc\x10\x35\xc0\xce\x3b\x68\x22\xda\xaf\x7d\xc1\xb8\x1e\xec\xbf\x1b\x28\x40\x0d\xe4\x52\xe3\x6d\x05\x55\xb4\xf4\xd6\x40\x92\x70\xb4\xad\xc4\xc3\xf8\x93\xa2\x98\x53\x26\x11\x4f\x13\xee\x1b\x81\xec\x61\x1d\x1b\x29\xcb\xeb\xfc\x42\xa6\x6a\x18\x9c\x65\xfa\xaa\x03\x91\x4e\x1f\x9a\xe7\x46\x19\x57\xf7\xb3\xbb\xda\xe0\xf8\x23\x7d\x3e\xea\x99\x16\x39\xc4\x98\xf3\x85\xfd\xf4\xaf\x46\x3a\x24\x86\x06\xfd\xc8\x14\x4b\xaf\x9e\x2c\x62\x20\xd3\x9a\xce\x7e\xff\xe3\xd9\x85\x61\xb4\x7d\x42\xc2\xde\x5f\x83\xf8\x95\x77\x17\xa5\x40\x24\x15\x39\x1f\xae\x06\xa2\x47\x27\xae\x39\xbb\xc5\x15\x2b\xf7\x8f\xa6\xfe\x30\xdd\x36\x30\xb2\xa7\x37\x7e\x92\x64\x8e\x0e\x12\xc1\x70\xd2\x0d\xdc\x05\xe8\xba\x36\xdb\x1b\x6a\xef\x66\x96\xeb\x3f\xb6\x5b\xa1\x12\xd3\xae\x0a\xcf\x3a\x5b\x1b\x22\x14\x26\x4f\xb8\x6a\x54\xaa\x9d\x20\xa7\x54\x6b\xa6\x53\xbc\x49\x13\xab\xc6\x38\x96\x0c\xad\x14\x97\x96\x0f\x33\xb7\x12\xfd\xbb\x15\xbb\x7e\x0c\x0b\x36\x7b\xa3\x22\x0d\xbb\x70\x47\x9c\x6a\xe8\xe0\x73\xd5\xee\x2f\xc0\xcb\xb7\x01\xe0\x76\xb4\x44\xd9\x1e\x97\xa7\x81\xf7\x95\x30\x59\xe7\xb6\x92\xba\xcd\x50\x32\xe5\xd4\x9e\x48\x89\x24\x74\xcc\x4e\x64\x09\x9f\x5c\xd0\xa9\x98\x88\xa9\x02\x20\xe1\xe0\x1a\xfa\x25\xab\x5c\xcd\x4c\xbf\xa1\x5f\xe7\x85\xf5\xc2\x04\xb2\x54\x60\x53\x29\x1e\xa6\xd9\x75\xe2\xce\xdd\xa4\x82\xa2\xa5\xfa\xac\xea\x3b\x5d\x93\xf2\xf5\xc0\x83\x16\xa1\x48\x85\xf4\x93\x51\xff\xd6\x2c\x37\x58\xc1\x14\x32\xd6\x9b\x3f\x72\x8a\x48\xd0\x7a\x44\x25\x65\x6e\x21\x9a\x9a\x37\x3e\x7d\x4a\x99\xf7\x23\x10\x6f\x24\xa2\x24\x8e\xa1\x96\x35\x81\xb8\x1d\x74\x3a\xfc\xe2\x84\xb7\x26\x08\xab\x26\x48\x51\x87\xad\x9e\xb2\x1a\xca\x0a\x87\x20\x41\x9b\xca\xd3\x04\x0c\x05\xe0\x92\xbb\x84\xb0\x15\x7b\x2d\xa9\x71\x8a\xd9\x54\x83\xf3\xc4\xc9\x3d\xc0\xe5\xe7\xb7\xea\xc4\x66\x2f\x20\xfc\x7f\xd2\xf3\xba\xc4\x2e\x8e\x7b\x77\xd4\xdc\xee\x2a\x8e\x74\x1e\x39\x29\x7b\xfb\xc7\xf5\xd9\x71\x1a\x46\x89\x9a\xc6\xd1\x39\x93\x5e\x94\xf0\x77\xb4\xcc\xf3\xe7\xc7\xf0\xf6\x47\xfe\x54\x8f\x6b\x29\x56\x6e\x9c\x7c\xc4\xf3\x30\xf2\xb0\xc7\xc4\xe0\xfc\x8d\x97\x58\x84\x0b\xbb\x05\x38\x29\x35\x0e\x03\xd3\xa0\x5d\x4b\xed\x9d\xa4\x98\x4f\xa2\x6f\x37\x55\xdd\x08\xc7\xfe\x3f\x70\xed\xba\x39\xfc\x28\xee\xaa\xe5\xce\x84\x01\x05\x27\x83\x8a\xe9\x1d\x46\xb8\x49\x6a\x5e\x46\x87\x85\x48\x56\x05\x32\x68\x6f\xc1\x7e\xd5\x32\xf5\x4a\x35\xe1\x8a\x77\x91\x47\xc8\xae\x36\x18\x57\xd4\x4b\x5d\xf1\xe4\x97\x88\x42\xe4\xc5\xd9\x36\x56\x2c\x28\x4d\x0b\x9a\x69\x62\xaf\x37\xfd\x00\x57\x01\x48\xdf\x5c\xca\x4b\x45\xba\x38\xdf\x1a\x9a\xca\x94\x52\x92\xf0\x4a\xd6\x7c\x8a\x32\x89\x01\x51\x00\x34\xc1\x78\x31\x11\xae\xad\x4a\x77\x52\x11\x66\x29\xee\x96\x55\x23\xfd\x41\x01\x51\x83\x4c\xc5\x23\x66\x55\x23\x65\x1d\xb1\x0a\x54\x69\xcb\x7e\x6a\x09\x11\xed\xb2\x56\xfb\x91\xf7\x5d\x2c\x6d\xcb\x1a\x82\x8c\xb6\xdd\xe2\x3c\x53\x77\x70\xa9\xff\xf3\xe2\x9c\xe6\x08\xa8\xbe\x76\x41\x17\xcc\xf9\xab\xad\xd7\x1d\x17\x16\x52\x50\x1c\xd2\x36\x9b\xbd\x68\xc7\xa8\x78\x24\x8e\x0b\x23\xa2\x4a\xf2\x1f\x6a\x11\x10\xf5\x02\x4a\xe3\x78\x90\xae\x09\xf4\x45\x5f\x90\x30\x34\x25\xbc\x97\xe2\x3a\x42\xaf\xcb\x11\x6c\xeb\x2a\x5a\xdd\x53\x8f\xd1\xe2\x37\xdc\x32\x4a\xca\x8e\x76\xe9\x8a\x03\x38\xaa\x92\xd1\xfc\xb2\xc4\xb8\x6a\x30\xe4\x54\xb0\x29\xfe\xda\x1e\x5e\x25\xee\xaf\xda\x83\x40\x17\x8f\x9a\x55\x4c\xef\x16\xa1\x59\x38\x45\xdb\x2f\xdf\xbf\xbd\x7c\x79\xfa\xe6\xfc\xf9\x25\xbf\x29\x45\xba\x35\xb4\x5f\x0f\xce\x87\xe7\x7f\x39\x95\x5d\x80\x16\x20\xa9\x28\x97\xee\xbc\x17\x42\xc1\x64\x18\x20\x10\x28\x2e\x65\x83\xc6\xba\x44\x24\x0c\xce\xc3\xcd\x7c\xc9\x55\x1b\x95\x0a\x2d\xb2\x6b\xa1\xc6\xaf\xf0\x6c\x38\x21\x7f\xe2\x78\x6a\xcf\xe8\xdd\x7b\x55\x3b\xdb\x5e\xbc\x0b\xcd\xb5\x14\xcd\xa9\x96\x5f\x45\x0f\x9b\x88\x2e\xa1\xa4\xce\x16\x6c\x4c\xd4\xd8\xad\xe4\xe0\x39\x41\xdb\x9e\x77\xa7\x1f\x45\xff\xef\xf9\x0b\xa9\xd4\x2e\xf7\x58\x78\xe8\xb3\x77\x11\x1d\x5f\x04\x7b\x8b\x26\x32\x2e\x22\x83\x90\x46\xde\xab\x02\xc8\xb0\x08\x92\x1f\x70\xeb\xcc\x9c\xc3\xb8\xf6\xef\xb0\x47\xce\xcf\x9c\x0f\x09\xa3\x63\xd3\x73\x35\x7f\xea\xe5\x33\x98\xc3\x0a\xdc\x9e\xaf\x7c\x1c\x24\x7f\x43\x2d\xe4\xd8\xd9\xb1\x2c\xbb\xc4\x60\x85\x7e\x55\x17\xfa\x07\xbf\xa7\x33\x1c\xdb\xb6\x21\xe3\x3a\x9c\x6f\xc0\x4a\xb5\x1e\x43\xab\x18\x21\x53\xd6\xe5\x86\x27\x7f\x4d\x07\x27\x7f\x66\x43\x93\x3f\xd2\x91\xc8\xdf\xd2\x8e\x17\xac\x0b\x7b\x56\x6e\x7e\x1e\x20\xb0\x51\xe5\x34\x5e\xbb\x11\x3e\xc7\x77\xc9\xab\x30\x3a\xcf\xc4\x2a\x22\x11\xb5\xe7\xe1\xfa\x9e\x85\xb8\x53\xbd\x68\xca\x2d\x82\x2b\x42\x7e\x70\x04\x4f\xc2\x5e\x13\x12\xc8\xde\x9b\xcc\x57\xfe\xfa\x2a\x74\x23\xef\xa5\x9b\xb8\xed\x18\x27\xe4\x7f\xd3\x60\x8f\x3b\xa3\xdc\x33\x0f\x20\x8f\x9a\x2a\x09\xbe\x4b\xf6\xd6\x2b\xd7\x0f\x14\x15\xf5\x74\x8c\xaa\xe1\xdd\x38\xc1\xba\xc1\xc8\x3e\x18\xda\x71\x12\xae\x09\x0e\xdd\x85\x4b\x25\x7a\x45\x48\x83\x0c\x40\xd1\x97\x06\xbf\xa3\x05\x7f\xf0\x44\xe2\x94\x70\xc3\x9e\xcb\x01\xfe\x69\x68\xce\xc8\xa4\x5e\x32\xc8\xf8\x5c\xc2\x11\xc2\x2c\xf1\x60\x38\xec\x45\x0a\x0c\x80\x87\xfb\x6b\xcf\xdd\x60\x8e\x57\x26\xd6\xf8\xfc\xa8\x39\x1b\x70\xc5\x6c\x2a\xe7\x69\x21\xcd\x93\xa5\x98\x17\x5a\x39\x3f\x59\x0b\xc5\x64\x59\xe2\x44\xdc\x84\x9f\x61\xbd\x11\xb6\xf3\xa7\xc0\xc3\x11\x35\xac\x03\x3f\x9f\x68\x82\x42\x52\x26\x22\xfb\x06\x6c\xed\xea\x2e\x3f\x46\x96\x48\x65\x8e\xbc\xdc\x02\x81\x6d\x05\x1f\xcc\x6c\xf3\xfc\xcb\xe1\x0c\xff\xf9\x97\xc3\x99\x3f\xc5\xe1\x4c\x7d\x27\x2c\x4a\x56\xc7\xdf\xca\xfd\x8a\xdf\xbe\xe4\xde\xab\xa8\x7e\xcc\x6f\x5f\x52\xff\xe8\x99\xd6\x2c\x6a\x12\x9e\x43\x89\xe2\xbc\x6f\x93\x3a\x6f\xf9\xe1\x60\xdc\xfd\x2a\xe4\x12\xe0\x7c\x95\x6b\x9c\x9c\xcf\x06\x38\xb2\x1f\xa1\xc3\x14\xd0\x8e\x3d\x16\x6f\x8f\x6f\x52\x6d\xe5\x2e\xcf\x62\x18\x14\x3e\xdf\xdc\x08\xcc\x0b\x99\x08\x6c\x66\x8a\xeb\x7c\xd9\x49\x5a\xb6\x2c\x6a\x50\x53\xd9\x90\x82\x43\x74\xaf\xe2\x70\xb5\x49\x84\xc7\x1f\xaa\x1a\x94\x2d\x32\xec\xf2\x52\x8c\xa3\x32\x5a\xe3\xf1\x78\x8c\x6f\x74\x85\xc9\x91\x74\x12\x06\x09\x1d\x8d\xf1\x8b\x16\x28\x4e\x9e\x27\x49\xe4\x5f\x6d\x12\x6c\x1a\x6e\xe4\xbb\xdc\xdd\x70\x13\x19\x49\xb4\xc1\xd9\x30\xa5\xed\xa3\x70\xa9\x27\x43\x7e\x88\x06\x15\x4b\xb3\xa3\xd0\x9e\x96\x19\x1e\x86\x6f\xb7\x5d\x35\x05\x64\x2c\x70\xf2\x82\x88\xfe\x7e\xb0\x38\x01\x2e\xfb\x23\x1c\xa1\xb9\xd5\xc5\x25\x6b\xca\x08\x83\xe9\x26\xfd\xb0\x4c\x15\x27\xa6\xb0\x93\xd9\xab\xba\xb4\xb4\xb8\x67\x58\x1e\x4b\x64\xcf\x9a\x39\x8f\xcd\xaa\x35\x73\x95\x50\x4e\x93\x4a\xb9\x26\x08\xa0\xef\xff\x03\xcf\x97\x6e\xb0\xc0\x9e\x91\x8f\x09\x93\xda\x3f\x44\xa6\x63\x69\x4c\x20\x4e\xa4\xbb\x82\xf0\x5f\x5c\x43\xf6\xf3\x2f\xae\xe1\x9f\x99\x6b\x50\x07\x58\xd9\x8a\x65\x88\xda\x97\x2e\x2c\x24\xea\x48\x0d\x16\x15\x8d\x99\x06\x21\x13\xa3\x84\x8b\xa8\x36\x7c\x5a\x09\x77\x62\xd1\x23\xb2\x11\x37\xee\x1d\xd5\x16\x3f\xc2\xc9\x0c\xe3\x29\x18\xbd\x21\x4a\x0f\x62\x19\x9a\xda\x59\x4a\xba\xea\x13\x09\x2d\x98\x19\xcd\xda\x34\xa4\x50\x6a\x48\xc8\xaf\x28\x99\x49\x3d\x8f\x26\xc4\x76\x44\xd6\x27\x66\xdb\xb1\xc0\xc9\xc9\xfd\x7c\xe5\xcf\xa9\x69\x6c\x64\xa9\x0c\x41\x85\x6a\xe2\xd3\x1d\x79\x4a\xbe\x01\xbf\xb4\x7a\xac\x59\xd9\x65\x3e\xa8\x87\xe6\x23\x09\x92\x25\xcf\x92\x98\x75\x80\x20\x56\xd3\x01\x4a\xb2\xa8\x4e\x6c\x02\x66\x65\xa1\x6b\x44\x18\x72\x50\xcc\xaf\x86\xde\xeb\x30\x3a\x75\xe7\x0f\xc4\x6f\x09\x33\x88\x94\x04\x57\xeb\x19\x48\xb2\x60\xc7\xe9\xdd\x0c\xb3\x62\x07\x93\x68\x66\xc1\x9e\x98\x10\x13\xd4\xf4\xad\xa6\x44\x01\xd3\x81\x3f\x22\x47\xbc\xc0\x55\x4a\xe7\xe2\xbe\x57\xef\x31\x6c\xe9\x8d\x44\xe3\x7c\x2b\xfa\x10\x7a\xe5\x2d\xe4\x4e\x57\xb9\x95\xf5\xa6\xd2\x2f\x4c\x65\x1b\xd2\xf2\x9f\x89\x51\x14\xf9\x9a\xe5\xf7\x5a\x29\x39\x45\xc7\xc8\x6c\x34\x8a\xb4\xa3\x58\x30\x63\xe0\x64\x1a\x63\x49\xec\x16\x73\xfb\xe7\x64\x17\x75\xac\xed\x92\xb7\x81\xeb\xaa\x08\x7c\x75\x67\x51\x6c\xaf\xd5\x02\xeb\xf5\x92\x69\x5d\xaf\xfc\x39\xde\xf2\xf1\xc6\x94\x3d\xdd\xe8\xd0\x45\xef\x46\x0b\x90\x84\xe2\xd4\xb5\x12\xec\x81\x68\xea\xa3\x16\xf5\xc5\x9a\x96\x98\xfa\x79\x2a\xae\x77\x6b\x04\x4d\x82\x4b\x36\xf0\xc5\x26\xcf\x61\x0b\x25\xcc\x25\x5b\x25\x3e\x42\xab\xd6\x01\x13\xa2\x06\x4a\x4a\x0e\x19\xde\xb0\x8a\x93\xca\x0f\x08\x5e\x6d\x95\x3e\x1e\x4b\x07\x97\x1b\x15\xf8\x9d\x3b\xa2\x63\x6e\xb5\xea\x8c\x0e\x35\xb2\x96\xea\x0d\x54\x39\x48\xc9\x83\x96\x4d\x31\x1e\xa5\x13\x5a\x0f\xd3\x18\x35\x10\xc5\x76\x34\x0d\x95\xae\xfc\xd2\xc7\x99\x2b\x7e\x59\x2f\x84\x8e\x94\xb7\x5a\x4d\x7f\x74\x45\x40\xad\x1c\xa0\xd2\x28\x5f\xc2\x2e\x6e\x80\x4b\xdd\xdc\xe9\x26\x83\x52\x6d\xf2\x58\x45\xde\xc5\x48\xec\xbc\x87\x93\xb4\x8b\xda\xf5\x93\xdb\x9c\xa4\x85\x3a\x97\x8a\xf9\x43\x3f\x17\x7e\x91\x2d\x0e\x05\x73\xd4\x28\x12\xc7\x56\xf6\x49\x1a\x26\x2e\xf1\xc1\xb4\xf4\xaf\xb9\xec\x1f\x57\xaa\xa8\x51\x1a\x82\x01\x5c\xe1\x68\xe2\x2c\x50\x5e\xe6\x10\xc1\xa3\x6f\x21\xf0\x21\xdf\x52\xc9\x32\x0a\x6f\x81\xe9\x3c\xa5\x61\xfd\xe9\xa3\x07\x4e\x68\x50\xb8\x49\x50\x78\x8d\x22\x22\xee\x2a\xcd\x77\x68\x03\x0d\x60\x51\x6d\x05\xbc\x13\x37\x08\xc2\x04\xc1\xd8\x78\x3c\x91\x98\x48\x77\x2b\x3f\x4e\xd0\x15\xbe\x0f\x03\x0f\xc1\x93\x07\x64\x6b\x1b\x88\xb4\x86\x56\x48\xa2\x01\xc2\xc6\xb7\xc5\x8d\x1f\xe3\x84\x6e\x2a\xd2\x51\x36\x2b\x0b\xfe\xad\x34\x72\x03\x68\x44\x09\x45\x83\x21\xb6\x64\xc6\x4f\x5d\x8d\x74\xd8\xa7\x1d\xa6\xf1\x19\x73\xab\xd7\x3f\x90\x57\x4a\x7e\xc3\xa6\xe1\x48\xf8\xd1\x53\x5c\x70\x69\x00\xfc\xdc\x31\xa9\xdd\x41\x2a\xaa\x24\x11\xfe\x0a\x0c\xd5\xdd\x69\x39\x4a\x56\x8f\x9f\x2a\x32\x02\x0d\x84\x2d\xf4\x7d\x0e\x2f\xdb\x2b\x5a\xfc\x68\xbe\x59\xb9\xd1\x1b\xb2\xd2\x1e\xa4\x69\xf9\x13\xdf\x99\x30\x3d\x40\xe6\x50\x2c\x97\x5e\xd3\x30\xcf\x59\xc0\x55\x3f\xf8\x29\xc6\xe0\x97\xbd\x5c\x50\x96\x0c\xbf\x69\xb0\xa3\x6a\x75\x9e\xd6\x1d\xd7\x44\xf2\x6b\x42\x3a\x95\x19\x6a\xd1\x6f\x54\xc9\xf1\x0e\xdf\x66\x36\x52\xb4\xdc\x3a\x5c\x67\xbe\xae\xa0\xf7\x53\x50\x0f\x66\xba\x52\xdc\x7e\xff\xe2\x3f\x4e\x4f\xce\x2f\x5f\xbf\xbc\x7c\x7e\x7e\xfe\xf1\xf5\x8b\x9f\xce\x4f\xe9\xe1\x9c\x77\x7b\x26\x5b\x75\x42\xc0\xc8\x0a\xf7\xdc\x40\x3b\xb7\x6f\x5c\x45\xe2\xc2\xcd\xca\x43\x84\xca\xf1\x96\xdd\x80\x93\x3a\xf8\x7c\x8f\x13\xc4\x50\xed\x15\x68\x9c\x87\x57\x38\xc1\x0f\x40\x43\xe6\x31\x03\xa7\x81\xac\xcc\x2c\x14\x30\xc5\x31\x78\xfc\x2d\x89\x5b\x9d\xce\x4e\x8d\x35\xa0\x8f\x56\x92\x2d\x51\xa6\xb3\xc0\xed\xcb\x10\xf6\x0e\xf3\xe4\xa1\x59\x3e\xb2\x6a\x5c\x39\xce\x26\x8a\xda\x09\x7f\x1d\xec\x40\x84\x3d\xfd\xcc\x4b\xb8\xa8\x3a\xec\x69\xa4\xbd\x77\xd4\x63\xa3\x41\x8e\x66\x29\xc4\x97\xe8\xad\x53\x65\x57\xe3\xab\x7a\x4b\xea\x79\x6e\xe2\xb6\xc2\xab\xbf\xb7\x7c\xcf\x80\x3b\x31\x01\x13\xcc\x72\x5b\x19\x97\xfd\x9b\x99\xd6\x53\x5b\x0c\xb0\x38\x2d\xb2\x19\x2a\xeb\x1e\x9c\xbe\x49\x4c\xc4\x37\x89\x0f\xb8\xf0\xde\x9d\xa0\x46\xa6\xe3\xb0\xa7\xdd\x91\xe9\xf4\xac\x34\xc4\x46\x37\x0d\xb1\xe1\x74\x98\x37\xe5\x74\xb5\x2e\x30\xe7\xab\x5e\xdc\xbf\x16\xe2\x81\xa6\x9b\x11\x82\x2f\x6d\x56\x2b\xb8\x65\x70\x0b\x4a\x73\xd1\x39\x0a\x99\x27\x63\x49\x20\x18\x68\x32\x41\x8b\x30\x49\xee\xe1\x51\x14\x3a\xa6\x8a\xda\xf6\x8f\x24\x65\xba\x84\xdc\x91\x0f\x61\xfb\x6f\xec\x43\xce\xee\x83\xf0\xc5\xa6\xb1\x4c\x92\x75\xbc\x0f\xb0\x98\xe5\xc4\x2a\x9c\x83\xa1\x08\x5d\xd8\xf3\x70\x85\x8e\x91\x71\x1b\xc7\xfb\x7b\x7b\xe0\x0c\xf9\x16\xfe\xb2\x50\xa3\x50\x61\x19\xc6\x89\xe2\xf3\xda\x4d\x96\x01\x59\xe7\x0d\x52\xd9\x50\x94\xa0\x0e\x6f\x73\xcf\xee\xe7\xa8\xd8\x25\x56\x10\xc2\x7d\x90\xc1\x05\xed\x93\x30\x08\xa8\x59\xc4\x2b\x77\x9e\x84\xd1\xbd\xb9\x6c\xa2\x38\xed\x7b\x9c\x7f\xcc\xef\xb1\x9a\x71\xfb\x17\x7c\x75\x7e\xfe\xab\xb9\x6a\xa2\x4d\x13\xcd\x9b\x0c\x95\xee\x26\x59\x5e\x26\xe1\x27\x1c\x58\xed\x70\x8d\x65\x73\x99\x79\x18\xc4\xe1\x0a\x43\x1c\x6b\x75\xd7\x74\xa5\xa5\x0c\x56\xb5\xf8\x68\x61\x13\xac\x42\x97\x6c\xdb\x12\x5a\xe8\x91\x53\x6b\xd5\x9e\xaf\xc2\x58\xba\x41\xfb\xa3\xa6\x1d\x88\x68\x4e\xa7\xda\x6d\x91\x19\x66\x1e\xd6\x94\x16\x73\x85\xf5\x09\xbe\x3a\xa7\x58\x14\x15\xb9\x68\x00\xef\xdd\xb5\x6c\xf9\xb5\x1f\x78\xe8\x06\xb6\x1d\x7a\x46\x16\x06\xac\x91\x67\x6d\xe9\x94\xe2\xd4\x3a\xd7\x23\x2e\x3f\x0a\x7d\x31\xda\x7b\x6e\x92\xb8\xf3\x25\xfb\xcf\xd8\x47\xfd\xa6\x3e\xbb\xfd\xf7\x58\x5b\x62\xed\xce\x3f\xb9\x0b\xdc\xfe\x7b\x1c\x06\xc6\x3e\xea\xda\x72\xa9\x6b\x3f\x21\xff\x8c\x7d\x34\x50\x66\x50\xd0\x8a\xbc\x3c\x5c\x27\x57\x64\xb3\x5a\xc5\xf3\x08\xe3\x40\xf8\xd3\xd8\x47\xc3\x1a\xc5\xda\xf3\x98\x34\xda\xed\xd4\x29\x0b\xfd\xd3\x43\xcd\x77\xb3\x2b\x97\x64\x04\xcc\x0b\x0b\x05\x7b\xba\x82\xe9\x5f\xc6\x3e\x1a\x55\x16\xa2\xfd\x1b\xb1\x29\x4f\x8f\xbc\x4f\xf8\xbe\xc4\x77\x23\x5b\x28\x8c\xc8\x93\xb2\x66\x9c\xd9\x6a\xf9\xed\x08\xc7\xe1\x0a\x6c\x6e\x43\x72\xda\xe2\xbb\x75\x18\x81\xa4\xeb\x93\x5c\x9f\x50\x86\xce\x58\xb1\x7b\xd2\x46\xc4\x3a\xbf\x13\x8a\xb6\x8f\x68\x88\xd9\x36\x5b\x6f\x4d\x74\xe3\xfa\xc1\x3e\x32\xb2\x05\xd6\x44\xeb\xc8\xff\xec\x26\xf4\x38\x79\x18\x74\xb2\xd2\x52\xd0\x6c\x75\xd5\x87\x5b\xd8\x7c\xf4\x9c\x43\x6b\x37\x22\xa2\x99\xeb\xaf\xb0\xb7\x8f\xf6\x96\xe1\x0d\xde\xbb\xdf\x78\xae\xbf\x47\x08\x99\xff\x19\xef\xad\xa3\xd0\xdb\xcc\x93\x78\xaf\x63\x3b\xfd\x3d\xa0\x8f\x7b\x71\x34\xdf\x5b\xf8\xc9\x72\x73\xd5\x9e\x87\x37\x7b\x57\x9b\x75\xf2\xcb\xaf\x27\x4b\x1c\xb0\xfc\xbf\xc7\x7b\x41\xe8\xe1\x4b\xba\xab\xe3\x3d\x18\xc1\xde\xca\xbf\xda\x73\x3d\x2f\x0c\x62\xfd\xda\x45\x3f\x05\xf8\x6e\x8d\xe7\x09\xf6\x10\x10\x60\x64\x3a\xfb\xb6\x75\x11\xfc\x1a\x6e\xd0\x8d\x7b\x8f\x02\x8c\x3d\xc2\xdb\xba\xeb\x75\x14\xae\x23\xdf\x4d\x30\x22\xf4\x12\x47\x28\x09\x11\x35\x3e\xa4\xb7\xd2\xd7\x3e\xf9\x8b\xb0\x64\x17\xc1\x17\xd4\x66\x58\x4c\x5b\x43\xbf\x93\xcf\xe4\x87\x9b\x70\xec\x23\x30\xf1\x3d\xe0\xdf\x93\x70\xbd\x8f\xec\x03\xc3\x7a\xe0\x84\x65\x7b\x37\x9b\x37\x69\xf3\x3d\xc6\xb2\xc8\xb6\x54\xda\x88\xb4\x81\x0a\x6d\xcc\xac\x83\xff\x17\x00\x00\xff\xff\xad\xd0\xde\xb8\x36\xc5\x06\x00")
+var _staticJsGottyBundleJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xbd\x69\x77\x1b\x47\xb2\x28\x78\x66\x3f\xc7\x1f\x66\x7f\xb3\x2f\x25\x74\x3f\x1b\xb0\x40\x08\x85\x8d\x9b\x64\x5d\x88\x20\x2d\xde\x4b\x89\x6a\x92\xb2\xaf\x87\x66\xab\x8b\x44\x92\xac\x36\x88\x42\x57\x15\x24\xb1\x6d\xdd\xf9\x65\x73\xe6\x27\xcd\x5f\x98\x88\xc8\x7d\x29\xa0\x28\xbb\x6f\xdf\xf3\xda\x4d\xa8\x2a\x2b\x32\x33\x32\x32\x32\x32\x22\x32\x32\xf3\xd1\xf5\x72\x7e\x55\xa6\xd9\x3c\x6a\xb2\x56\xf4\xf3\x17\x11\xfc\x4f\x25\x95\xcd\x54\xa6\xe1\xff\xd2\xeb\xa8\x99\x9f\xa7\x17\xad\x28\x67\xe5\x32\x9f\x47\xf8\xd2\x61\x1f\x17\x59\x5e\x16\xbb\x0a\xec\x7d\x92\x47\x59\xf4\x8c\xbe\xc2\x3f\x3f\xa7\x3b\x51\xda\x8e\x66\x3b\xd1\xa3\xb8\x1d\x09\xe8\x9d\xe8\xe7\x4f\x9f\x74\x16\x51\x1e\xc3\xf2\xae\x92\xd9\xac\x99\xc9\x62\xdb\x51\x06\xff\xd7\x6f\x65\x0b\x5f\x67\x50\xee\xa3\xae\xf1\x81\x4a\xfa\xf4\xc5\x17\xb2\xfe\x1c\x2b\x16\x15\x94\x9d\x3b\x78\x63\x90\xb7\x73\x85\x68\xe1\x43\x0a\x0f\x81\x96\x9b\xb8\xf0\x22\x11\x78\x6a\x03\xb7\xb1\x08\x8b\x30\x65\x27\xa3\xe4\x56\xf4\xcb\x2f\xd1\xf1\xe5\x9f\xd9\x15\x64\x62\xd7\xe9\x9c\xbd\xc9\xb3\x05\xcb\xcb\x7b\x91\xeb\xe7\xab\x6c\x7e\x9d\xde\x2c\xf3\xe4\x72\xc6\x04\x41\xe6\xcb\x3b\x26\xdf\xa1\x49\x37\xac\x04\x7a\x7d\x6a\xe9\xea\xe7\xd5\xb8\xca\xa6\xb2\xe8\xcb\x2f\x23\xd6\x79\xf7\x8e\x15\xaf\xb2\xe9\x72\xc6\xa2\xe7\x46\x16\x33\x87\xd9\x42\xc4\x31\x59\xce\x4a\xf5\xf1\x53\xb4\x53\x23\x9b\x06\xf7\x3a\x10\x9a\xdd\x84\x66\x36\x92\x06\x52\x03\xfe\x74\x2b\x32\x97\x88\x65\x80\xe8\x82\x76\x8b\x3c\x2b\xb3\xf2\x7e\xc1\x3a\xb7\x49\x71\xfc\x61\x2e\xa9\xc8\x79\x83\xf2\xea\x82\x17\x50\x70\x03\xea\x2b\x9b\x65\xa7\x80\xe7\xde\x56\xeb\x8b\x4f\xcd\x73\xbb\x2e\xea\x1c\x5e\x5d\x63\x59\xb0\xa8\x28\xf3\xf4\xaa\x6c\xec\x7e\x61\x33\x7c\xea\x93\xb7\x84\x22\xcb\xdb\xd4\xe0\x6f\x1c\x06\x8f\x9a\x98\x16\xa5\xf3\xa2\x4c\xe6\x57\x2c\xbb\x06\x86\x50\xc3\x62\xce\x3e\x40\x49\x49\x7e\x03\x3d\x3b\x2f\x8b\xf3\xee\x45\x3b\xd2\x6f\xb1\xf5\xd6\xbb\x68\xed\x1a\x7c\x74\x99\x67\x1f\x0a\x86\x5d\x7a\x4a\xfc\x8a\x85\x23\xb3\xa7\xe2\xb1\x1d\x2d\x3b\xfb\xef\x21\xe7\xfe\x5d\x5a\x96\x2c\xe7\x14\x41\x5c\x80\xda\x0d\x60\xa5\x4b\x96\x37\xa2\x67\x80\x32\x50\x0f\xb0\x22\xc6\x68\x32\x1c\x0d\x56\x5f\x5e\x65\x33\x18\x83\x16\x8a\x76\x5f\x03\x1a\x3b\x36\xd2\xd6\xf7\xdb\x64\x3e\x9d\xb1\x7c\xc7\x6a\x89\x66\x0c\x40\x86\x11\x5b\xc2\x78\xf8\x19\x7a\x49\xf4\xeb\x4f\xec\xbe\x68\xa6\x92\xed\x8a\x56\xe7\x3a\xcb\xf7\x93\xab\xdb\xa6\xee\xac\xdc\xe5\xba\xf9\x72\x36\xc3\x06\xb1\xf3\xfc\x82\x37\x06\x1f\x90\x22\xd9\x02\xb3\x14\xf0\x0a\xc3\x11\x13\x1f\x3d\xc3\x64\x59\xba\x0b\x0f\xff\xb4\x00\xaf\x92\xbf\x62\xaa\x85\xef\x16\x54\x02\xc9\x1d\xa0\x4c\x96\x17\x9d\x19\x9b\xdf\x94\xb7\x30\x8c\x64\x4a\x64\x7c\x84\x51\x7c\x95\x80\x70\xec\xbc\x13\x09\xc5\x2c\xbd\x62\xcd\x2d\x60\x81\x9d\x28\x1e\xfd\xfa\x92\xe2\x11\x2f\xaa\xfb\x80\xa2\x78\x4e\x90\x20\x1b\xbd\x56\x25\x86\xf4\xb5\xed\x66\x82\x34\xaa\x2e\x4c\x03\x22\xe2\x43\x70\xaf\xae\x04\xe9\x0f\xbc\xea\x17\x26\xd2\x45\x97\x0a\x71\x8d\x29\x8b\x24\x07\xee\x22\xc8\xcb\x6c\x7a\x8f\x0c\xc5\x64\x22\x3c\x37\xc7\x40\x8d\x71\x07\x84\xe6\xfe\x8c\x11\x1f\xbe\xb8\x3f\x4b\x6e\x5e\x27\x77\xac\xd9\xc0\x0c\x8d\x16\xf0\x36\xb4\x0d\xd9\xc8\xa8\x5d\xd5\x5d\xf0\x12\x6f\x58\x76\xc7\xca\xfc\x9e\xc6\x2a\x01\xe1\x08\x20\x20\x7a\xb0\x81\x62\x09\x24\x53\x00\xf0\x5c\x15\x6d\x14\x70\x81\x54\x10\x63\x05\xe9\xc8\x1b\x39\x6f\x36\xa6\x49\x89\x32\x52\x7d\x94\xa8\xdd\x5f\x26\x05\x8e\x9c\xae\x7c\x9f\xa6\xc5\xc2\x78\xff\x68\x7e\x33\x9e\xaf\x96\x79\x91\xe5\xa7\x65\x52\x32\x2f\xf5\x65\x3a\x9d\x32\x9c\x44\x70\xba\x11\x58\xce\xdf\x83\x3c\xdd\xcf\x66\x22\xe1\x2f\x4b\xb6\x64\x52\x8e\x62\x42\x71\x95\x67\xb3\xd9\x59\x66\xd6\xcd\xd3\x5e\x64\x65\x99\xdd\x09\xa1\xc8\x89\xb3\x11\xa9\x82\x97\x05\x7c\xfc\x27\x76\x4f\x32\xea\xa5\x68\xf8\x33\xa2\xbe\x85\xd3\x8b\x59\x3a\xff\xe9\x70\x0e\x12\xec\x7d\x32\xb3\x01\x92\xc5\x02\xf8\x25\x41\x46\x80\x82\x16\xc9\xd4\xc4\xdc\xf8\xb8\x47\x05\x99\x1f\xb3\x3c\xbd\x49\xe7\x30\x01\x32\x33\x15\x64\x34\xb4\xd5\x4d\xfd\x90\x27\xc0\x45\xd9\x72\x3e\x95\x5f\x64\x33\xe7\x59\x7e\xe7\xe2\x74\x75\x9b\xe4\x05\x2b\xed\xc4\x9b\x70\xea\x8c\xbd\x27\x91\xdd\xb5\xf3\x22\x33\x9d\x23\x9c\xe4\x9d\x29\xbb\x3a\xca\xa0\x29\x59\x2e\xfb\x36\xee\xbe\xca\x60\x72\x12\xaf\xef\xcb\x5e\xd7\x49\xe8\xdb\x09\x1c\x53\x33\xe5\x0e\x9f\x89\xf6\x92\x0b\x0b\x36\x9f\x1e\x64\xd0\x2f\xe2\x7d\x59\x5e\x9b\x19\x8a\x9b\xdc\x7c\x5d\xe6\x1f\xdf\x97\x66\x02\xe3\xa3\x4a\x35\x25\x9d\x4d\x61\xe8\x49\x0e\x67\xd7\x39\x2b\x6e\x81\xeb\xf2\xd2\x4e\xda\x9f\x4f\x65\x05\xc9\x7b\x36\xfd\x67\xf3\xe5\x07\xf3\x65\xcf\x18\x2f\x2c\x99\xa2\x1a\x64\xf6\xc5\x87\x3c\x2d\xdd\x34\x90\xef\xe3\xb2\xc4\x8e\x8f\xfb\xf1\xd6\xc0\xe0\x75\x91\x6c\x42\x69\x29\x92\xdc\x51\x0f\x5c\x68\x70\x94\x21\x6f\xf0\x83\xd1\x59\x0b\x68\x40\xfa\xd1\x1c\x09\x8b\xac\x28\x9d\xa4\x74\xbe\x58\x9a\xcc\x0d\xd3\xfd\x5d\xe7\xd0\x48\x94\x13\xb2\xac\xbb\x50\x70\xf7\x9d\x37\xf4\xda\xf4\x0a\xe2\xd0\x2d\x45\x8c\xf9\x94\xe5\x4c\x35\x47\xbd\x83\x20\x32\x98\xad\x80\xfe\xa1\x89\xf3\x55\x32\x4f\x6e\x34\xb8\x97\x6e\x67\xc3\xc1\x97\x5e\xa7\x1a\x5e\x27\x20\x20\xe0\xf9\xae\x73\x24\x93\x8c\xae\x60\x2f\x96\xd7\xd7\x94\x4b\xd1\x91\x92\x0f\x51\x43\xbb\x81\x8e\x2f\xcc\x21\xf6\x31\xbb\xbe\x3e\x05\x1a\x9f\x65\x7b\x49\x79\x75\xfb\x76\x61\x8f\x3f\xc8\x77\x5a\x66\x8b\x05\xb3\x06\x78\x01\xfd\x92\xdd\x80\x18\x7b\x77\x9b\xde\xdc\x9a\x54\x07\x1c\x59\x21\xc8\x78\xdd\xd9\x4b\xf3\xab\xe5\x2c\xc9\x8f\xd2\xa2\x6c\x1a\xe2\xe9\x32\xb9\xfa\xc9\xd0\xa8\x40\xc1\x88\x9a\x52\x4b\x56\x12\x6b\x37\xca\x37\x36\x76\x5b\x46\xb9\x9d\xc5\xb2\xb8\xe5\xe5\x5c\xce\x92\xf9\x4f\xd0\x7a\xd6\x6c\x99\xaa\x59\x90\xac\x52\xa4\xbb\x1f\x20\xa1\xe4\xb4\x6a\xea\x3a\x64\xdf\x02\x47\xeb\xd1\x59\x2e\x17\x48\x85\xa2\x29\xbf\xc2\xd8\xcb\x4f\xa9\x29\xe9\xfc\x86\x28\x63\xda\x36\x4a\x51\xca\xa4\x5a\xeb\x98\x22\xe3\x3c\x4f\xee\x3b\x69\x41\xff\xa2\x36\x8b\x93\x24\x8e\x9f\x73\x76\x41\x73\xb2\xaf\x72\x31\x57\xe5\x62\x9d\x64\x3a\x25\x29\x82\xd4\x65\x73\x6c\x05\xaf\x09\x4b\x7b\x14\xb7\x0c\xc5\x29\x88\x5c\x51\x81\x1c\x4c\xa6\xec\x2e\x7b\xcf\xd6\x96\xed\x16\x38\xf7\x0c\x06\xf5\x29\xf7\xec\x14\x39\xc5\x81\x8e\xbf\xbc\x2a\x69\x8a\x30\x6c\x96\x2f\xb4\xcd\xa1\xed\x0c\x64\x0e\xfd\x86\x64\x32\x3f\x21\xc7\xe5\x41\xbc\x12\x0f\x2f\x65\x91\x49\x55\x1e\xfa\xe2\x55\x72\x45\x9c\xd2\x49\x66\x25\x4c\x69\xf8\xfc\x08\x74\xfb\x32\x9f\xe9\x37\x50\x23\x12\x7c\x23\x45\xc3\xc8\x7a\xfa\x7d\x3a\x9f\xe2\x24\xeb\x14\x50\x91\xdf\xb3\xc8\x1a\xa0\x73\x2f\x70\x64\x72\xab\xa0\x43\x4d\x7a\x0e\x28\xee\x44\xc4\xbe\xcd\x47\xa4\x96\xef\xe1\x24\x08\x75\xeb\x97\x6f\xa2\xc1\x66\xb8\x33\x66\xae\x45\x65\x1a\xfd\x2c\x7a\xfa\x14\x55\x60\x28\x0a\x9f\xb6\xe0\x21\xb7\x4d\x27\x52\xe8\x1f\x3d\x8b\x66\xa0\x37\x02\x23\xb2\xf3\x4c\x3b\x14\x8c\xb4\xc0\x18\x86\x51\x03\x53\x4f\xd2\x8e\x6e\xdb\xd1\x15\x58\x42\x38\x05\x44\x4f\x50\x76\xa3\x08\xd9\x00\x11\x42\xea\xca\x2e\xfc\xf3\x14\x0c\x81\xf7\x96\x1a\x0b\xa9\x8f\x1f\xbb\xac\x82\xf8\x14\x64\x4c\x08\xe0\xf3\x05\x08\x36\xd4\x95\x84\xd5\x46\x4f\xa8\xee\xdd\xd2\x53\x0f\x9e\xb8\x4e\xde\x44\x57\xc2\xac\x03\xea\x19\x19\x80\x9a\xe1\x05\x86\xa0\xeb\x3a\x75\xe1\xff\x10\xcf\xc5\xae\x97\x7c\x09\x73\xdf\x4f\x56\xea\x27\xdb\x5a\x83\xf6\x2c\xa9\xb7\xb0\xcd\x57\xbc\xbd\x0b\x63\x18\xba\xbd\x6e\xd0\x11\x20\xa7\xc1\x6e\xbc\x0d\xba\x3d\x94\xf5\x62\x30\x45\xbc\x19\x48\xdb\xb2\xd3\x78\x15\x4f\xbe\x7e\xf4\x45\xf4\x75\xf4\x11\xf4\xba\xbb\xa8\x79\x5b\x96\x8b\x62\xe7\xc9\x93\xf9\xe2\xee\xcf\x38\x22\xef\x9e\x2c\x40\x3a\x83\x7c\x7c\x42\x00\x2d\x04\xfd\x07\xb4\x0d\x40\x43\x8b\x5e\x1d\x9e\xd1\x3b\x28\xa6\x05\xa2\xd7\xeb\x6c\x75\x62\x4c\x79\xf6\x8c\xa0\x9f\x1c\x1d\xee\xed\xbf\x3e\xdd\x7f\xf6\x0c\x13\xf7\xb2\xc5\x3d\x68\x7b\xb7\x25\x74\x44\x2b\xea\x75\xe3\xc1\x06\xfc\x80\xe5\x71\x9a\x2d\xf3\x2b\x76\x94\xa4\x79\xf4\x26\x4f\xdf\xa3\x3e\xbc\x97\xdd\x2d\x92\xf9\xbd\xc6\xe7\xc3\x87\x0f\x9d\x82\xe0\x66\x00\x87\x88\xb5\x82\x65\xf6\xb0\xcc\x7e\x3b\xda\xbb\xcd\xa1\x9b\xb3\xc5\x2d\x48\xfc\x7f\x64\xd7\xa0\xe1\x18\x85\xdd\xa4\xe5\xed\xf2\x92\x5a\x77\x75\xfb\xe7\x3f\x3f\xc1\xa2\xb0\xb4\x37\x80\x73\x5a\x50\x53\xd2\x22\x82\xac\xec\xf2\x3e\xba\xc9\x13\xd0\x79\x41\x37\x82\x32\x58\x04\xf6\x39\x2a\x87\x37\xc8\x3b\x59\x84\x28\x2e\xa0\xf1\x28\xdc\x2f\xcb\x24\x9d\xa3\xfc\x4f\xc0\x52\x5f\xdc\x63\x79\x00\x4c\x2e\x87\x22\xbb\x2e\x3f\x80\xfd\x03\xf0\xd3\x28\x29\x8a\xec\x2a\x85\x46\x4e\xa3\x29\x28\x79\xa8\xaa\x91\x6e\x1c\x5d\xa7\x33\x98\x2c\x61\xf2\x61\x51\xe3\x54\xe4\x68\xb4\xa8\x9e\x29\x4b\x66\x58\x60\x3a\x8f\xf0\xb3\xfc\x1a\x7d\x80\x86\x64\xcb\x12\x18\x81\x3b\x47\xa0\x18\x90\xca\xf3\xab\xd9\x72\x8a\x98\xc8\xcf\xb3\xf4\x2e\x15\x95\x60\x76\x22\x58\x81\xe5\x41\xd1\xa4\x37\x22\xc2\xed\xe8\x2e\x9b\xa6\xd7\xf8\x2f\xa3\xf6\x2d\x96\x97\xb3\xb4\x80\x71\x8b\x03\x26\x4f\x2f\x97\x25\x24\x16\x98\x48\xbd\xdf\xc6\xd6\x3c\x81\x71\x0e\x13\x29\x21\x07\x85\xa4\xd0\x00\x6a\xb4\xc6\x91\xc0\xb0\xa2\x05\x12\xb7\x14\xe4\x2a\x30\xe5\xc3\x2d\x18\x25\x56\x7b\x52\xc2\xea\x1a\x98\x1a\x2a\x66\x94\x6d\x9a\x01\xf9\xa8\x5e\x74\x47\x60\x0a\xe6\xb8\x86\xb9\x36\xfb\x80\x6d\x84\x49\x63\x9a\x92\x05\xba\x23\x7a\xf1\x0c\xbe\x27\x97\x30\x65\x51\xb3\x38\x77\xcc\xb3\x12\xb0\xe6\xa8\x60\x8f\x2c\x74\x4f\x8b\x4f\xc5\x6d\x02\x32\xee\x92\x09\xf2\x41\xe5\xe9\x1c\x4b\xc3\x54\xd9\xb2\x1c\xd1\x40\xe1\x51\xa6\x60\x6d\xa0\x13\x93\x2c\x5f\xa7\xc5\x1d\x89\xc7\xcb\xfd\xe8\xf4\xf8\xe0\xec\xfb\xf1\xc9\x7e\x74\x78\x1a\xbd\x39\x39\xfe\xee\x70\xb2\x3f\x89\x1a\xe3\x53\x78\x07\x1d\xe9\xfb\xc3\xb3\x97\xc7\x6f\xcf\x22\x80\x38\x19\xbf\x3e\xfb\x21\x3a\x3e\x88\xc6\xaf\x7f\x88\xfe\xe9\xf0\xf5\xa4\x1d\xed\xff\xf3\x9b\x93\xfd\xd3\xd3\xe8\xf8\x04\x4b\x3b\x7c\xf5\xe6\xe8\x70\x1f\x92\x0f\x5f\xef\x1d\xbd\x9d\x1c\xbe\xfe\x36\x7a\x01\x59\x5f\x1f\x9f\x45\x47\x87\x30\x10\xa1\xdc\xb3\x63\xaa\x53\x94\x76\xb8\x7f\x8a\xe5\xbd\xda\x3f\xd9\x7b\x09\xaf\xe3\x17\x87\x47\x87\x67\x3f\xb4\xb1\xac\x83\xc3\xb3\xd7\x58\xf2\xc1\xf1\x49\x34\x8e\xde\x8c\x4f\xce\x0e\xf7\xde\x1e\x8d\x4f\xa2\x37\x6f\x4f\xde\x1c\x9f\xee\x03\x12\x13\x28\xf9\xf5\xe1\xeb\x83\x13\xa8\x68\xff\xd5\xfe\xeb\xb3\x0e\x54\x0c\x69\xd1\xfe\x77\xf0\x12\x9d\xbe\x1c\x1f\x1d\x61\x6d\x58\xdc\xf8\x2d\x34\xe3\x04\x11\x8d\xf6\x8e\xdf\xfc\x70\x72\xf8\xed\xcb\xb3\xe8\xe5\xf1\xd1\x64\x1f\x12\x5f\xec\x03\x7e\xe3\x17\x47\xfb\xbc\x36\x68\xdd\xde\xd1\xf8\xf0\x55\x3b\x9a\x8c\x5f\x8d\xbf\xdd\xa7\x5c\xc7\x50\x10\x35\x12\x21\x39\x9a\xd1\xf7\x2f\xf7\x31\x15\x6b\x1d\xc3\xff\xf7\xce\x0e\x8f\x5f\x63\x7b\xf6\x8e\x5f\x9f\x9d\xc0\x6b\x1b\x9a\x7b\x72\xa6\x72\x7f\x7f\x78\xba\xdf\x8e\xc6\x27\x87\xa7\x48\x99\x83\x93\xe3\x57\xd4\x52\xa4\x2e\x64\x3a\xa6\x72\x20\xeb\xeb\x7d\x5e\x10\x52\xde\xee\x20\x00\xc1\xf7\xb7\xa7\xfb\xaa\xcc\x68\xb2\x3f\x3e\x82\xe2\xa0\xb7\x5e\xbb\x1d\x8a\x9d\xfc\x84\x04\x68\xd8\x77\x0c\x13\x4b\x43\xbb\x76\xa1\xaf\x7f\x06\xa3\x79\x49\xfe\xe2\x4f\x42\x45\xc5\xe9\x91\x9c\xdb\xcd\x78\xd8\xe2\x53\x23\x3c\xb6\xf8\x4c\x91\x37\x7b\xe8\x8f\x59\xf0\xc7\x3e\x3c\x5e\xf3\x47\x04\xbd\xe1\x8f\xf0\x74\xc7\x33\x8d\xe0\xf1\x9e\x3f\x6e\xc1\xe3\x25\x7f\xdc\x86\xc7\x77\xfc\x71\xb3\xa5\x7d\x81\x7b\x3c\x77\x17\xbe\x7e\xe0\x8f\x03\x78\x3c\xa5\x47\xcc\xf2\x9e\x67\xc1\xef\x63\x54\xe6\xc1\xde\xa6\xb6\x4d\x1b\xa8\x02\x08\x27\xe5\x07\x52\x71\x40\x2b\xe1\x0f\x1d\x29\xca\x84\xdb\x86\xb7\x70\xde\x4c\x5d\xe7\x27\x14\x9a\x1a\xbe\x62\x96\x27\x05\x13\x36\x60\xd8\x8b\x2d\xe6\xb8\x8d\x61\xdc\x8b\xbe\xb4\xad\xc9\x5f\xa2\x61\x1c\xcb\x44\x61\x4b\x4a\x1f\x73\x0a\x2a\xfb\xfc\x26\xdb\x93\x4e\xab\xf3\xc6\xef\x7a\xac\x3f\xe8\x8f\xa0\x2b\x1a\xbf\xbb\xba\xea\xc2\xff\xe8\x71\xc0\xb6\x93\xae\x48\x1d\x24\x32\xb5\x3f\x18\x0d\x93\x01\x3d\x6e\x0e\x87\xdd\xcd\x4b\x7a\xec\x8e\xb6\xb7\xb6\x13\x7a\x9c\xf6\xa7\x9b\x57\xd7\xf4\x38\x1c\x0e\x37\x87\x7d\x7a\x64\xd7\xbd\xed\xde\x36\x3d\x6e\x25\xac\xd7\xe7\x25\x5c\x5f\xb1\xed\x01\x87\xdd\xec\x6d\x5f\x8b\x6c\xc9\x74\xf3\x3a\xd9\x12\xb5\xb1\x1e\xeb\xf1\x12\xf0\x7f\x57\x0d\x74\x8f\x6a\x8f\x5b\x98\x32\x2a\x95\x85\xd6\x37\xf0\x7f\x19\x37\x91\x1a\xbf\x6b\x44\x8f\xa3\x12\x75\x07\xfc\x27\xe7\xff\xa4\xad\x56\x48\xc3\x36\x16\x92\x58\xc5\x6a\x02\xd9\x26\xac\x53\x66\xa7\x30\x2d\xcc\x6f\xc8\xf3\x29\x1d\x8f\x4f\xa3\x1e\x30\x45\xa3\x8b\x35\x32\x60\x86\xa0\x16\x4f\x0a\x77\x9b\x74\x4f\xab\x9f\x84\xd7\x11\x78\x84\xba\x0c\x94\xc4\xed\x61\x3b\x8a\xfb\xf8\xb3\x09\x3f\xbd\x18\x7f\x86\x43\x57\xd1\xcc\xb9\x0a\x99\x63\xed\xf1\x08\x1e\x50\x6b\x64\xcd\xe2\x3c\x07\x55\xb3\x3f\x8a\xfe\x7d\x84\xca\x2d\x2a\x87\x3c\xc9\x4d\x81\xb7\x8b\xd6\x8a\x32\x07\xba\x48\x48\xdd\x82\xa6\xc5\x5d\x90\x07\xd0\x04\x50\x6f\x8b\x96\xa7\xc0\x67\x9c\x0b\x9b\xc4\xec\xef\x54\x37\xa6\xb6\x73\x95\xbe\xbe\x5f\xd7\xc9\x52\x91\x46\x85\x43\x18\xf1\xb9\x51\x16\xa7\x22\x20\x9a\x21\xa2\x43\x68\x7c\x46\x98\xa2\xb2\x89\x5e\x8b\xc3\x39\x74\x38\xa8\x94\x1d\x9a\xb9\x78\x77\x41\xc5\x24\x31\x4a\xce\x1e\xe7\x60\x38\x7c\x83\x4a\xe4\x97\x48\x5b\x5c\x05\x80\xd7\x2d\xf9\x06\x3f\xf0\xc8\x2e\xfc\x56\x96\x66\x2b\xa5\x07\xdf\x5a\xb5\xe0\x28\xee\x68\x64\x0d\xd3\x9c\xdd\x81\x30\x6c\x88\x6c\x8d\xb6\x91\x47\xfa\x39\x69\xad\x4d\xe7\x80\x19\x1b\x5d\xc4\x90\x89\x74\x4b\x23\x8b\xf4\xe8\xee\x44\xe7\x5b\xc0\x33\xbd\x81\xb1\xf0\x61\xb8\x2e\xed\xf2\xa4\xf7\xf5\x1e\x17\xf1\x1a\x97\xb3\xec\xea\x27\xa3\xc8\xf7\x69\xb1\x4c\x66\x2f\x40\xb3\xb1\x73\x2d\xb2\xc5\xf1\xdc\x4f\xd6\x7e\x8c\x9d\x28\x66\x7d\xeb\x03\x63\xe8\x13\x2d\xec\x0c\x53\x76\xb9\xbc\x71\x10\xa2\xc5\x22\xee\x08\x74\x80\xd3\x02\x1d\x6a\xa7\x25\x28\x75\xf6\x17\x50\xde\x0e\x40\x0f\xda\xcb\xe6\x65\xee\x11\x2c\xb9\x44\x2f\xc5\xf7\xe9\xb4\xbc\xdd\x89\xb6\xb4\x60\xd4\x0e\xfb\x9f\xe9\xfd\x1a\x5d\x8d\xca\x21\x5a\xb9\x06\x27\x6d\x2f\xe5\x8e\x68\xe9\x45\x49\xe9\xa0\x20\xf8\x80\x15\x98\x72\x1e\x4d\x81\x47\x99\xb2\xed\x52\x64\xd4\x92\xaf\x86\xc1\x50\xc2\x85\xe4\x36\xae\x3a\x33\x61\xc2\x56\xb9\x3b\x10\x09\x63\x71\xaa\xe5\x2c\x44\xd2\x82\x15\x6b\xd9\x6b\xdb\x25\x1f\x35\xe7\xae\xe4\x28\x51\xa5\x86\xd2\xeb\x2f\x62\xa2\x49\x97\x0b\xd7\x93\x3f\x26\xc4\xfc\x63\x2e\x98\xb5\xab\x16\x82\xd3\x73\xc6\x17\xc5\xd4\xea\x17\xbb\x30\xfa\x27\xf0\xf5\x0b\xb9\xf0\x65\x4e\xa1\xb2\xfb\x56\x4e\x9f\xdc\x89\xc5\x3e\x96\xa0\x9f\x26\x3c\x4b\xb3\xa5\x59\x42\x97\x76\xc3\xca\x63\xaa\x7f\xe5\xd2\x2f\x5f\x4e\x45\x5d\xd9\x40\xb0\x85\xce\xb9\x1c\x94\x02\xf4\xbd\xec\xe7\x79\x96\x37\xbf\x7a\x9d\x45\xbc\x39\x64\x8b\x44\xd0\x37\x51\xe3\x2b\x9a\x1a\x1e\x47\x5f\x35\xbe\xf2\x09\xf8\x3e\x4b\xa7\x60\xae\xe3\xb2\xa0\xb9\xba\x84\xc4\x78\xee\xa5\xec\x50\x8a\x22\x8c\xdd\x92\xe2\xef\xd5\x92\x02\x00\xae\x6e\x03\x3e\xba\x2b\xd0\x75\x1a\x5a\x54\x34\x76\x3c\xa7\x02\x62\x53\xc2\x20\x51\x5e\xcf\x90\x43\xc2\x74\x59\x35\x4e\x59\x59\xa2\x19\x84\x06\x88\x2e\x3a\x22\x65\x33\x9a\xa1\x77\xb7\xbc\x4d\xb8\xd9\xc7\x57\x9a\xd1\x5a\xa1\x15\xa0\xc6\x6e\xb0\x64\xc9\xc8\xd1\x63\x28\xbd\x49\x8a\x83\x5a\x34\x7a\x1c\x35\x5a\x68\x19\x83\xc9\x84\x76\x51\xf6\x81\x4d\x3b\x0d\xb4\x1e\xe7\x45\x36\x63\x1d\x30\x7e\xe6\x4d\xd4\xf0\x84\xff\xb3\xda\x39\xa2\xda\xea\x74\x28\x75\x7b\x55\x9b\x55\x06\xee\xfd\x15\x0a\xc7\x37\xd5\x19\x4c\x1f\x97\x9f\x71\x03\x85\x42\x21\x3f\xf1\x45\xbb\x0d\x9a\x46\xbb\xbb\x95\xc5\x19\xc5\xc0\x64\x7a\x47\xcb\x28\xcd\xcc\x5d\x09\x7c\x95\x94\xb7\x9d\xbb\xe4\x63\xd3\x48\xdd\xc0\xa0\x98\x6e\xcb\x59\x23\x74\x20\x25\x12\x1c\xb2\x50\xbe\x6a\xb1\x44\xd3\xec\xb6\xed\x25\xbc\x56\x10\xd1\x4f\x5f\xac\x41\x1d\x2a\x3c\xe2\x44\x78\x16\xc9\x55\xa0\xf7\x29\xfb\x80\x16\x6d\xa7\xb8\x9f\x5f\x71\x67\xf6\x18\x64\x45\xb3\xb5\xa2\x2b\x3f\x79\x3c\x2f\xc6\xa3\x51\xae\x25\xcd\x4a\x94\xee\xa1\x51\x61\x4c\xd3\x81\x61\x21\xfd\xee\x7b\x1a\x0a\x15\x19\x53\xfe\x5a\x6e\xb9\xdd\xaa\x0a\x68\xba\xaf\xaa\x40\xac\x9b\x75\xae\x66\x49\x51\xa0\xa7\x1b\x34\xdc\x9b\x9b\x19\x6b\x72\x85\x63\x83\x17\xb1\x51\x60\x19\x1b\x68\x14\xe5\x48\x4d\xd4\xda\xf5\x0b\x39\xd8\xca\x56\xfb\x33\x4a\xbc\x4c\x72\x2c\x0b\xff\x11\xa5\xd4\x6f\x9d\x39\xd7\xaf\xa0\x9f\x5a\xb7\x70\x7a\xd0\x91\x9c\xe8\x4d\x02\xbe\xb6\xa9\x5d\x39\xc3\x54\x75\x8e\xd1\xf9\xe6\x02\x72\xab\x42\x56\xaf\xa8\xcd\x9b\xfd\x1f\x42\xdc\x4b\xe2\x29\xe4\x3b\xb9\x8a\x38\x63\x49\x6e\xd7\x26\x17\xb5\x51\x95\xf5\xf8\x33\x1c\x50\xa4\x17\x2d\x82\x6b\xe3\xd0\x20\x55\x68\x75\x74\x16\x8f\x1f\x7a\x48\x43\x36\xb2\x79\xc3\x1e\x91\x40\xc9\x51\xb7\xbb\xa6\x47\x57\xb4\x79\x75\xbf\xfa\xb8\xf1\xd5\xa0\x4a\xdc\x56\x44\x0c\xa0\x2f\x9c\x10\x51\x94\xa9\x02\x6d\xad\x8d\x3b\x30\xb8\xe8\x32\x15\xab\xe5\xd5\x3c\x93\x81\xd2\x2a\x95\x1f\x18\x62\xa4\xfe\x34\x4c\xbd\xac\xf4\x97\xd3\xd4\x22\x3c\x0f\xd3\xc3\xd7\xe6\x4d\x67\xaf\xdb\xd9\x3f\xdd\xc3\x89\xf0\xfc\xb0\x41\x6b\x73\x3e\x85\x92\xe9\xb4\x29\xea\x20\x88\xe2\x16\x34\x74\x6a\x09\xf1\x57\x78\x78\x51\x94\x04\x2e\xfe\x99\x1a\x39\xad\x6a\xb1\xbb\xb4\x6c\x2a\x9c\x7f\x46\xb2\xa7\xf3\x04\x94\x7d\xf6\xc9\x5f\xd4\xb3\xbb\xfd\x72\xb6\xcc\x1f\xa6\x19\x62\x8e\xa6\x43\xdc\x17\x6e\x29\xab\x68\x8b\x05\xac\x23\xad\x9c\xcd\x58\xe7\x1e\x1b\x78\xcf\xa9\xb4\x8e\xde\xc7\x55\xf4\x96\x1c\x69\x90\x7c\x05\xbf\x07\x09\xcd\x4d\x1f\x49\x6b\xd1\x86\x07\x91\x3a\x9d\xa7\xe5\xb7\xb3\xec\x72\xc5\x80\x22\x2b\x5e\x08\x92\x76\x28\x46\x91\xa8\x8d\xb6\xa2\x8c\x45\x30\x98\xdb\x4e\xc2\x2e\x91\x29\x59\xd3\x8e\xfa\x68\xa0\xd7\xdb\xea\x82\xdc\xef\x02\x23\xe8\x04\x57\x86\x16\x1d\xcc\x24\x23\x21\x72\x32\xfc\x98\xb7\x42\x6e\xd2\x60\xd7\x5b\x3d\x5d\xb1\x38\x2d\x58\x6d\xd1\x59\x24\x45\xc9\x64\x35\x3a\x26\xd4\x09\x4c\x15\x2d\x32\xb8\x8a\xf2\x89\xf8\x54\xaf\xbd\xe6\xc7\xd2\x58\x87\x3d\x48\x81\xd1\xb2\x8f\x60\x37\x78\x59\xa8\xf9\xd3\xec\xc3\x7c\x35\xab\xf6\xf8\xfa\xeb\xe5\xb2\x2c\xe1\x3b\xf0\xe5\xa2\x43\xcb\x09\x7b\xb3\xf4\xea\x27\x15\x37\x82\xa4\xd2\xb8\xae\x26\x1b\x98\x2c\x81\xfe\x9a\x63\x76\x78\x5b\xae\x46\xe7\x57\xd7\x6e\xd3\xe7\x28\x9d\x2f\x3f\x62\xab\x3c\x8c\x92\xe5\xc7\x2b\xac\x64\x35\x3a\x31\x57\x53\x2c\xf2\xe0\x48\x3c\x03\x6c\x50\x75\x7c\x8b\x5a\x11\x05\x26\x09\xe9\xf7\x20\x5c\x6d\x19\x84\xa3\x62\xb5\x0c\xd2\xd8\x83\x69\xb6\xbe\x6b\xc7\x9d\x04\x3e\xbd\x67\x22\x04\x31\x12\xf6\x26\x17\x3e\x50\xc2\x04\x4a\x68\x9a\xec\x09\x56\x4d\x97\xb8\xcf\xa9\x8a\x2f\xd4\xff\xaa\xba\xde\x60\x11\xb5\x2a\x5b\x2e\x56\xd7\x84\x3e\x09\x1e\x8e\xc0\x4d\x7c\x16\x2e\xd3\x18\x59\xb5\x88\xb5\x96\x22\x76\x81\x35\x48\x62\xb5\x5b\x5a\x20\x64\xb3\x62\xf4\xd0\xba\x3a\xae\xb2\xbb\x45\x56\xf0\x55\x3f\x9c\x4a\x1b\x3c\x70\x55\x25\xbe\x64\xb3\x05\xcb\x3b\x2e\x18\x71\x52\x33\x00\xd9\x5a\x59\xc5\x72\x31\x4d\x48\xc0\xac\xa9\x83\xc3\x7d\x66\x25\x30\xdd\xad\xaf\x01\x80\x56\x16\xcf\x28\x4e\x55\x4c\xaf\x15\xc5\x71\x2c\xf7\x74\xba\x0c\xc2\x7d\x50\xc9\x2b\xbb\x96\x22\x53\x45\x2c\x1a\x8f\xfb\xc4\x30\x7f\x11\x71\xd8\x81\x46\xac\x9f\x4c\x31\xfc\xf3\x24\xfb\xb0\x76\xc7\x47\x23\x23\xc7\x9d\xb9\x32\xa5\xc3\xe7\xc7\x9d\x2b\xa0\x73\x29\xc7\x5d\xb3\x31\x4d\xdf\x37\x54\x3c\x73\xce\xfd\xa6\x09\x98\x6d\x39\x2a\x05\x80\xd7\x1e\xc6\x4a\x36\xb5\xa5\x20\x42\x27\xb9\xb7\x0f\x93\x59\x08\xdb\x6c\xc1\x56\xfb\x98\xcc\x58\xb6\x36\xf9\x42\xf9\x53\x41\x5e\x51\xdf\xb2\xd1\xf1\xd3\x14\xde\xa3\x93\x60\x34\x18\x6f\xbe\x93\xaa\x71\x26\x34\x16\x20\xcf\x5f\x96\x30\xfd\x15\x51\x12\x89\xd2\xa4\xde\xd4\x70\xfd\xb3\x32\xf4\x0a\xf9\x52\x7a\x43\x78\x9e\x0e\x8c\x78\x96\x4f\xc4\xca\x9e\xf4\x8e\x7d\x97\xb2\x0f\x32\xac\x53\x2e\xfa\xad\xc8\x26\x40\x29\x10\xfc\x99\x9d\x6d\x5d\x08\xb8\x6d\x45\x7b\xb9\x43\x9d\x5b\x69\x78\x93\x52\x2e\x15\xba\x75\x70\x7c\x81\xa1\x0e\xd0\x06\x2d\x64\x6c\x28\x37\x19\xbd\xb6\xda\x0f\x36\x8a\x9d\xaa\x20\x27\x2e\x66\x52\xcc\x45\x13\xad\x7b\x18\x9a\xec\x63\xc3\x70\x20\x49\x77\x8d\x9a\x53\x2a\xb8\x3d\x0c\x1e\x6e\x8b\x04\xf2\x5a\x6e\x8e\x8e\x50\x71\x6e\x2d\xda\x7d\x54\x17\x2f\x9d\x23\x8c\x1a\x77\x6d\x6e\xa0\xdc\xac\x6c\x53\x25\x96\xba\xf0\x96\x1b\x83\xab\x24\xc0\x3a\x44\xfd\x1c\x15\x88\x4a\xb8\xf5\x44\xf4\x8b\x0c\x89\xa6\x75\x88\x59\x62\x2c\x88\x12\x2a\x7c\xeb\xb1\x31\xcb\x71\x05\xa0\x19\x3c\xac\xc2\x8e\x3b\x49\x59\x26\x57\xb7\x67\xd9\x24\xbb\x6b\x8e\x9d\x1c\xb2\x84\x5b\x9a\x41\x6a\x37\xc6\x01\x0f\xb7\x87\x03\xd5\x68\x92\x53\x9a\x8a\xe5\x15\x13\x70\x08\x19\xf9\xad\xe1\x02\xaf\x42\x65\xa3\x3a\x97\x3d\x90\x93\x65\x99\x5d\x65\x79\x8e\x33\x16\x4c\xff\xd9\xf5\x75\xad\x1c\xc9\x22\x2d\x93\x59\xfa\x57\x56\x33\x53\xb1\x60\xb3\xd9\xd5\x2d\x23\x05\xbe\x71\x9d\xcc\x0a\xe6\x67\x01\xa1\x72\x88\x42\xc5\x08\xa4\x57\xdf\xbc\x50\xe2\x80\xcb\xc4\x0b\xcb\xac\x76\x54\xa4\x9f\x1c\x23\x64\x4d\x65\x9e\x0f\xa1\xaa\x2e\xdf\x50\x0f\x56\xe5\x72\x95\xc7\x28\x12\x17\xbd\xd9\x48\xa9\x40\x38\xdb\xad\xe3\x5a\x07\xdc\x65\x15\xe3\x33\x89\xd8\x50\x3e\xae\x69\x89\xb8\xe5\xab\xce\x9e\xfb\xc5\xb5\x88\x43\xf5\xda\xbb\x11\xd6\x36\xda\xc9\xdd\x32\xb6\xbe\x9c\x02\xaf\x91\xab\x7c\xc5\xec\x42\x4e\xeb\x46\xfd\xda\x02\xc5\xb6\x76\x41\x05\x7a\x6a\x86\xf8\x17\xb4\x24\xac\x77\x00\x81\x0a\xd8\x6c\x55\x6a\x48\x7e\x2d\xcc\x9e\x8f\xb0\xd2\x57\x2c\x29\x96\xb9\x0c\x09\xff\xd0\xd9\xd3\x69\x4a\x6a\x55\x08\x0a\x23\x3b\xa9\xbf\xb4\x2b\x08\xda\x00\xff\xce\x6f\xd8\x74\x0d\x8b\x0a\x45\xdb\x6c\xb7\xe5\x79\x0f\xd5\x72\x27\x10\x73\xa7\x38\x81\xfd\xb4\xf3\x9d\x48\x68\x0a\x27\x52\x60\x1e\xac\x9c\x59\xfd\xfa\x02\x3b\x57\xb0\x9a\xcb\xce\x89\x48\xb0\x36\xc5\x04\x36\xae\x20\xf4\x5e\xe7\xd4\xf9\x60\x22\x47\x0b\x4e\x81\xa9\xaa\x1a\x19\x6f\x5f\x46\x95\xe5\xe1\xbb\x99\x54\x43\xd4\x36\x27\x59\x16\xd8\x35\xc2\x00\x61\xae\x01\xb2\xaa\x5a\x68\x9f\x9e\xd0\xd7\xd4\xad\x04\x9a\xb4\x63\x31\x28\xcb\x5b\x73\xb7\xd2\x78\xd9\x21\xa6\xc0\xca\xb9\xce\xb3\x92\xcb\xe4\x62\xad\x8f\xbd\xf4\xb1\x06\xca\xf6\xf4\x26\x4f\x00\xff\xad\x6a\xae\x5c\xc6\x54\x9b\xb4\xa4\x13\x15\x09\x25\x42\x01\x9e\x85\xd6\x84\xd1\xa4\x42\xb5\xa4\xf1\x43\xb6\x8c\xa6\x00\x87\x4b\xd2\x8b\x84\x96\xbb\x59\xf4\x27\x22\xf6\x9f\xd4\x46\x67\x5c\xdd\xff\x93\xb4\x8d\x1c\xcb\xad\xd9\xfa\x53\xe7\xc7\xb9\xb3\x18\x3e\xa7\xd5\xef\xb3\x50\x51\x73\xb0\xb8\x54\xb0\x53\x99\x45\x7f\x2a\xf3\x25\xfb\x53\x04\x33\x6f\x44\x1c\x26\xe3\xae\x79\x40\x7d\xe7\xcf\x45\xd4\xef\x74\xa3\x46\x5b\x94\x99\x96\xf0\x75\x36\x93\x45\x50\x09\x34\x43\xff\xc9\x5b\x49\x27\x25\x8a\x76\xd1\x05\x16\x5c\x4b\xb5\x36\xac\x18\xcb\x77\x2c\x7a\x3e\xbc\x10\x19\xb9\xa5\x0c\xa6\x98\x1e\x2c\xa2\x62\x30\xc4\x8b\x3d\x60\x84\x64\x51\xb0\xa9\x4d\x20\xb4\xd2\x00\xd1\x64\x6e\x6e\x5c\x2f\xa3\xe7\x60\xa0\x46\x3b\x51\xe3\x04\x05\x24\x7d\x62\xb4\x7b\x85\x9c\x52\xa9\x15\x77\x62\x31\x06\xda\x37\xe4\x29\x54\x92\x8f\x4f\xf0\xd8\x41\x0d\xc3\x45\x30\xcb\x92\xe9\x78\x3a\x5d\x13\xd3\x21\x79\xb3\xd9\xdb\x6e\x35\x1b\x9d\x27\x0d\x11\xa6\xd1\xe0\x4f\x41\x97\x43\x48\x5a\xaf\x5e\x1a\x0b\xcd\x68\x34\xb4\x51\xc6\xf1\x49\xb3\xd1\xe1\x6a\xe2\x87\x74\xca\x36\x10\xfe\xe7\x0f\x14\x8d\x85\x68\xf4\x30\xfc\xde\x95\xff\xf4\x19\x31\x5d\x7c\xdc\xfd\x24\x32\xf3\x1d\xa3\x6e\xf6\x5a\x59\x69\x7c\x7d\x03\xe3\xe3\xfd\xcf\xb7\x0c\x5d\xc7\xe1\xac\xfc\x9b\xcc\xdb\x08\xae\x24\xc9\x0e\xaa\x15\x8d\x1a\x5c\x42\x6d\x9b\x9b\x8a\x74\xb0\xcb\x33\xdc\x28\xd6\xe2\xe1\x59\xef\x91\x0d\x4f\x92\x0f\x2f\xee\xd1\x43\x95\xe5\x53\xda\x94\x36\x73\x26\x8e\x99\x89\x3c\xbd\xd2\x86\xd4\x19\x0f\x98\x69\xa9\x58\x84\x94\x87\xa3\x91\xf3\xea\x3d\xcb\x73\xe8\x84\x33\xdc\x49\x45\xfe\x51\xce\x95\xfe\x72\x2c\x2d\xa7\xeb\xe5\x81\x9d\x60\x28\x05\xc5\x4d\x84\x83\x44\x02\x2b\xf4\x76\xb1\xcb\xc5\x8a\x42\xfb\xbd\x2f\xaa\x22\x2d\x6a\x05\xe8\x1a\xa1\x6e\xb7\xce\x80\x2d\x7f\x33\xfa\x82\xe0\x49\x9b\x14\x1f\xd4\xef\xd9\x4b\x38\x5f\x84\xb6\xfa\xb9\xa3\x53\x76\xfd\x4c\xed\x6b\x0e\xf5\x03\x42\xf4\xba\x83\x4d\x29\xfe\x55\xa8\x21\xf9\xe2\xba\x81\xd8\x08\x8c\x9b\x8a\x7b\x9b\x74\xd2\x82\x08\xcf\x03\x51\x04\xed\xfe\x26\xa2\x82\xd0\x2b\x88\x44\xc0\x17\x62\x0a\x02\x8a\xb7\x7b\xb4\xef\xed\x9b\x6f\xa2\x91\x91\xda\xc3\x4d\x70\xa3\x3e\x06\x97\xb7\x9c\xf5\xf6\x88\x81\xb4\xae\xc2\x78\x38\x7c\x08\xc2\xdf\x10\xc2\x12\x31\x78\xd6\x18\x94\xad\x07\x70\x42\xba\x82\xc8\x7a\x07\x7a\x88\xcc\x2c\xfa\x12\xba\x11\xdd\xb2\x1f\xa3\x0d\xd1\xa1\x9d\x7b\xfe\xe8\x63\x2c\x43\x39\xad\x75\xd9\xde\x20\x10\x49\x86\x75\x8b\x83\x30\x5a\x90\x05\x67\xbe\xb8\xb1\xcb\x49\x87\xdf\x62\xfb\x5b\xdf\xfc\xd6\xb3\xbf\x0d\xe5\xb7\xea\xa8\xb0\xbe\xcc\xc1\x89\x1e\x1e\x99\xbc\xb4\x6e\xa3\x46\x68\x9a\xe8\x3b\x9e\xe3\x5f\xce\x49\x68\x02\x81\xa0\xb5\x6d\xfe\x7c\x8f\xcf\x17\x3f\xa2\xf1\x49\x5a\xca\x8c\xaf\x57\xa7\xad\x15\x1b\x02\xd5\x36\x3f\x7d\x88\x00\xf0\x6a\x73\x55\x0f\xc8\x8d\x8b\xb8\xfb\x13\x25\x6f\x0f\x0f\xfc\xb0\x52\x06\x90\xd2\xb3\x52\x46\x90\x22\xe8\xa1\x1c\xe1\xfd\x96\x8d\xa7\xd5\x7f\x6a\x76\xdc\xc5\x27\x49\x4b\x28\x0d\xcb\xee\xb6\xd4\x17\xd1\xea\x5d\x83\x1a\x3c\x47\x09\xe6\xd8\x0d\x89\x55\x0e\xfd\x25\x58\xb8\x38\xf4\x66\xc6\xd1\x04\xd8\xd2\x70\x0b\x21\xf5\xf1\x63\x4a\xc1\x7f\x6a\x62\x69\xd6\x2f\xf0\x7a\x25\x2b\x95\xc7\x23\x54\x57\x59\x59\xc9\x53\x6a\x0f\x91\x00\x09\xf1\x25\xb2\xd4\xf3\x68\x63\x80\x4f\xb8\x97\xa1\x05\xca\x6a\xbf\xd7\x5a\x81\x86\x9b\xb7\x71\xd7\x40\x7d\x08\x91\x23\x69\x94\x37\x53\xe1\x4c\xa3\x39\x0f\xf7\xc7\x40\x21\xfa\xf1\x7e\x55\x4f\xbd\xc2\x7a\xf8\x9e\x8b\xce\x75\x9e\xdd\xa1\xb6\x82\xfb\x38\x45\x48\x03\xff\x82\xdb\x40\xd6\xec\xec\x98\x57\x4f\x1c\x14\x80\x8d\xdb\xed\xec\x31\xa4\x42\x5a\x7f\xeb\x99\x54\x1e\xc4\x81\xeb\x37\x4c\xae\x24\x3f\x8f\x1e\xab\xe7\x1d\xe3\xf3\x87\xdb\xf4\x8a\x9f\xa4\xc3\x9f\xc0\x72\x10\xdf\x71\x76\x32\x37\x60\x1f\xee\x6b\xa9\xca\x05\x32\x64\xeb\x02\xf0\x40\xbd\x61\xd6\x50\x88\xdd\xaf\x9b\xc4\xb1\xc6\xfe\xc3\x0b\x9d\x1c\xbf\x22\x9e\xe5\xc6\xfa\x8a\xb2\xf1\xfc\x2f\x98\xa7\x67\x18\xa5\x0a\x8d\x18\xe1\x18\x1d\x0d\x1f\x5e\xe1\x87\x5b\xc6\x56\xd7\x43\x10\x13\x36\x2b\x93\x1f\x60\x9e\x32\x2a\x5b\x2f\xe2\xf8\x5e\xfa\xe2\x36\xbd\xa6\x9d\xef\x42\x92\xf0\x95\x2f\xa6\x76\xcd\x3f\x8f\xb6\x78\x72\xc6\xcf\xf5\x11\x5b\xe3\x9f\xe3\x4e\x14\x4a\x47\xfd\x1b\x37\x79\xa5\xf0\x97\x61\x0f\xeb\xd3\x55\x00\xaa\x40\xb9\x99\xd1\x88\x37\xce\x54\xa1\xa3\x13\x68\x5d\x4d\xd8\x30\xfd\x1e\x8e\xca\x02\xf7\xb5\xd3\xc0\x2d\xab\xf6\x23\x25\x72\x7d\x49\xd9\x4f\x33\xb5\x50\xc7\x55\x33\x33\x40\x25\xa9\x0c\x22\xc9\xc3\xd3\xb0\x11\x70\xa3\x75\x03\x0a\x96\x9e\x69\x0b\xce\x69\x62\x33\xb7\x87\xdb\xb3\x48\x71\x5f\x5b\x65\xe6\xdb\x46\xe0\x85\xe4\x8b\x4d\x0c\x8a\xed\x98\xa9\x15\x33\x89\x33\x06\x69\x34\xf8\xb1\x7c\x33\x75\x82\x0d\x52\x2e\x04\x6c\x87\x1c\xe4\xcd\x34\x34\xd6\x55\x83\x52\x2a\xd3\xc1\xa1\x58\x8d\x43\x51\x55\xab\xd5\x40\x77\x6e\x75\x1a\x6f\xd9\x94\xbc\x7f\x38\x8f\xaf\x5c\xab\xf6\xfa\x86\x4e\x66\x68\xda\x54\x31\x75\x28\xfe\xae\x67\xf0\x96\xd1\x99\xa5\x89\x52\x59\x0f\x23\x16\xc2\xe8\x51\x98\x5d\x66\x3a\x46\x3b\x9b\x7f\x8f\x45\x91\xe1\xa4\xaa\x64\xa1\x2a\xcb\x6c\x79\x75\x2b\x83\x24\x7e\x83\x7a\xcf\xb0\x3c\x1e\xf6\x5e\xb3\x72\xd1\xd3\xbf\x55\xdd\xaf\x30\xd6\xaf\xba\xea\x90\xf1\x3a\xc5\xdd\xf1\xd9\xfd\x6a\x73\xde\x3c\xca\x28\x0e\x1c\x65\x24\xd3\xde\x31\xce\x28\x7c\xf7\x14\x77\x1f\xab\x63\x85\xc2\xe5\x7f\x32\xcf\xcf\x59\x0b\x25\x97\xbb\xa5\x7f\x47\x2e\x6f\x71\x9f\xf7\x6b\x3c\xcb\xa1\xfa\x93\x08\x86\x0c\xb8\xc4\xc3\x81\xde\xe4\x8f\x5b\xe9\x4c\xb1\x1d\xc4\x7a\x43\x82\x70\xb4\x52\xac\xc7\x89\x0c\xe8\x34\x4f\x79\x34\x2b\xf2\x23\x42\xd6\x6e\xca\xb1\x17\x1b\x5b\xf6\xa9\x40\x8c\x36\x66\xa2\x11\xce\x37\x66\x3a\x2b\x93\xfc\xe9\x1e\x57\x0f\xf2\x70\xbc\xb9\x0a\xc9\x5d\xe3\xe3\x31\x8e\x68\xc3\x99\x25\x70\x6e\x5b\xec\xf8\x36\xf9\x46\x0e\xb9\xcd\xa3\x15\xae\x9e\xa6\xf9\xd5\x47\x84\x96\xce\x39\x46\xd6\xde\x95\x67\xcf\x9e\x85\xf7\x73\x90\xd6\x13\xda\xa3\x12\x5b\x7b\x54\x36\x36\xda\xe6\x26\x2b\xbe\xef\x44\xf6\x2d\xbd\x6d\x6c\x58\x19\x48\x45\xf7\x8f\x3a\x52\x34\x91\xfb\x59\x74\x8e\x96\x0e\x71\x15\x3b\x60\x1e\xbb\xc7\xcf\x81\x7e\xb6\xe1\x9c\x49\x87\xbb\x72\xdc\x73\xeb\x5a\x6e\x73\xd5\x91\x8a\x6b\xce\x82\xe2\x0e\x64\x54\xb5\x5b\x62\xab\x98\x00\x2e\x16\xb4\x01\x17\x66\x1b\xe9\x89\x0e\x66\xb2\x68\xa4\x4f\xd7\x43\x1a\x9b\xc4\xa3\xc6\x29\xc2\x2b\xfa\x3e\x90\x5c\xad\x76\x08\x43\x8f\x7a\x0a\x8d\x76\x14\xab\x3c\xdc\x79\x49\x4e\xd7\xa6\x0d\xb5\x06\x42\x10\xd8\xf2\xb9\x2a\xe7\xbf\xc6\x74\x05\x13\x4f\x78\x43\x56\x8f\x65\x86\xea\x6a\x48\xea\x77\x75\xd7\xf2\x8a\x82\x16\x7c\xf8\x8c\x2d\xc3\x2b\xce\xfd\x03\x8a\x46\x9c\xba\xdf\x84\xfb\xc7\x3f\xab\xcb\x3d\x31\x8c\x67\x7f\xac\xcf\xb3\x14\xe5\x99\xc5\x3d\x8f\x2a\xfa\x51\x32\x1a\xff\x82\x5a\xba\xe6\x0c\x79\x2e\x24\x0d\x0d\x19\xd9\x55\x4d\xf4\x76\x9d\xbd\x5f\xc1\x4e\x79\x03\xf6\xf8\x8a\x70\x59\x83\x01\xb0\xfb\xa0\x7b\xbe\x8e\x9a\x76\xd9\x2b\x0a\x3f\xcb\xf8\x11\x93\xab\x76\x03\xe9\xb2\x37\x6a\x71\xd1\x59\xa6\x8e\xa8\xac\x57\xac\xb5\xb1\x6e\x4d\x15\xfe\xb4\x1b\xde\x50\x64\x9c\x96\xa7\x23\xfe\xac\x88\x2d\x7b\xbb\x35\x3f\xc5\x2b\x7c\x6a\x9e\x94\xa5\x66\x99\x72\xc3\xe2\xb3\x68\xa8\x99\x42\x5b\xf9\x93\xbd\x7e\x6b\xd5\x29\x7c\xc8\x36\x8f\x82\xe7\xf7\xad\xaa\xcc\x1f\x77\x55\x47\x00\x3a\xbb\x1e\xab\xb7\x3d\x15\xac\x3c\x4b\xef\x58\xb6\x2c\xd7\x6e\x6d\x4a\xe7\x73\x96\x7f\x8f\x75\x35\x5d\xf5\x7d\xf5\x86\x25\x9d\xb1\xc6\xc9\x08\x72\x00\x9a\xed\x17\x02\x14\x06\x0d\xa8\xee\xad\x5d\xb5\xed\x1c\x29\xb2\x5b\xb5\xc5\x4b\x18\xad\x4d\xc7\x2d\x50\xca\x2d\xeb\x3e\x1d\x83\x3d\x2f\x4f\x00\xd6\x67\xf1\x1a\x72\x2e\xd0\x49\x41\x66\x88\x57\x32\x43\xdc\x0a\x9c\xe7\xa9\x04\x91\x77\xb0\xa7\xfa\xe2\xf7\x71\x6e\x04\x6f\xa2\xfb\x84\xfe\xf1\xb6\x56\x9a\x10\xd0\xff\xa4\xfc\x34\xf3\xca\xd9\xe5\xbe\x15\xd2\x8a\x56\x9e\x3d\x1a\x3a\x19\x2d\x38\x34\xab\x39\x5b\xba\xa7\x7f\x05\xcb\xa6\x2b\x58\x16\xa3\x2f\xdd\x39\xa7\xf2\x3c\xcd\x4a\x41\x34\x9b\xaf\x11\xcc\x04\xd5\x24\x5f\xe7\x8f\xf9\x8f\xd6\xf2\xa7\x2e\x8a\xc7\xde\xed\xc9\xa3\x7b\xd1\x0b\xf1\x32\x60\x85\x58\xa5\x5b\x8b\xcb\x8d\xea\x12\x9a\xb4\xc7\x7b\xb2\xff\xe6\x64\x7f\x6f\x8c\xe7\x4a\xe1\xa9\x59\xb4\x68\x7d\xc9\x22\x6e\x5f\x4c\xa3\x22\xcb\xe6\x9d\xe8\xcd\x8c\xa1\x08\x46\xc3\xd8\x29\xcf\x3c\x4c\x18\x0b\x9c\x17\x25\x18\x58\x1d\x15\x30\xb4\x0a\x9c\xd5\x69\xb2\x73\x5a\xf1\x2a\x8a\x56\x9d\x70\xcc\x2a\xb6\xa2\xbe\xbc\xc7\x43\x1f\xd8\xc7\x12\x2d\x96\xb5\x55\x90\xf5\xea\x5a\x2b\x5e\x64\xf6\x5e\x32\xa7\x5d\xf3\xd4\x88\x28\x89\x6e\x65\x1d\x11\xe6\x92\x67\xb3\x03\x85\x41\xac\xb1\x48\xc5\x2a\x50\x6c\x39\xf4\x06\x9e\x3f\xc1\xa6\x8d\x96\x6f\x14\x08\x93\xa7\x02\xed\x26\xfb\x3c\x35\xc2\x28\xed\xbb\x64\x96\x4e\xf9\xe9\xcd\x89\x38\x5d\xe0\x6f\x46\x8b\xf7\xaa\x2e\x6a\x31\x55\xf6\x9b\x90\xc4\x6f\xc4\x67\x52\x26\x67\x37\x18\x26\x93\x23\x8d\x5f\xa1\x3c\x76\x59\x23\x7c\x68\x8a\x4b\x93\x6a\xc1\x65\x34\x20\x50\x97\x2a\x7f\x37\xe4\x78\x5d\x17\x64\x93\xae\x9e\x74\xd1\xa2\x5f\xd3\x3a\x6f\x6c\xe9\x53\x88\xa5\x16\xa2\xf1\x0f\x96\x87\x65\xd4\x3b\xd6\xc0\xc1\xee\x36\x29\x54\x84\x4a\xad\x4d\xad\x5e\x64\x92\x59\x42\xc5\xd9\x27\xbf\xb2\x06\x95\x80\x7b\xe0\x2a\x37\x62\xaf\xaf\x24\x5c\xba\x9d\xb9\x59\x31\x72\xf1\xf3\x78\x36\x7b\x60\xd9\x2a\x5f\xb8\x58\xb1\xff\xab\x86\x16\x1d\x16\xb6\xa8\x2a\xc7\x5a\x09\xba\xaa\x90\xfb\xca\xef\xf7\x28\x0e\x44\x7d\x06\x37\x4e\x37\x95\x5a\xec\xef\x75\x12\x9b\xdb\xf8\xce\xa6\x30\x4c\xcb\xac\xd5\xb0\x2a\x2a\x7c\x23\xb6\xb9\x42\x75\xc7\xbb\xc1\xbb\x47\x3a\x0c\xe3\x00\x41\x2d\xc2\x56\x16\x57\xc9\x82\x9d\xb2\xbf\x2c\x19\x9d\x3b\x1b\x38\x4b\x0b\x71\x25\xfa\x48\x5b\x40\x9a\x98\xee\xa1\xdb\xb8\x8a\xe5\x41\xc7\x5a\x87\xf4\x0e\xe9\xa6\x5d\x9f\x86\xb1\xfe\x3c\x6a\x7a\xd6\x94\xf1\xa2\xc2\x52\x85\x73\x95\x76\xe0\xa1\xff\xe4\xd1\xa3\x44\x04\x76\x8a\x13\xaa\xd5\x55\x27\x92\x38\x56\x16\xec\x15\x42\x53\x39\x3d\xb8\xa5\xab\x37\x1c\x32\xdb\xef\x00\x1f\xd0\xfe\xc5\x3c\xc6\x37\x7b\xf3\xbc\xe9\x6e\x6d\x12\x68\x18\xdd\x20\x0f\x57\x76\xc8\x1a\x47\x1c\xba\x7a\x79\xf1\xfc\xf6\x1f\xa8\x75\x27\x92\x6e\x22\x4d\x38\x99\xf6\xc9\xb9\x95\xc5\x5e\x0c\x7b\x8a\x2e\x01\x5c\x36\x15\xc7\x42\xe3\xc9\xcb\xf4\x2e\xd7\xbf\x70\xa1\x8a\x12\xe4\x3a\x19\x24\xf4\x03\x87\x0b\xc9\x43\x7c\x43\xc7\xa9\x44\x5b\xe1\xa3\x85\x34\x1e\x55\x47\xe6\x08\xce\xe2\x7c\xf5\xe2\x74\xc5\xc2\x62\x8d\x30\x0a\xab\xb0\xc9\xfe\x51\xed\xf3\x4c\xa2\xed\xdf\x04\x7f\xb9\x6e\xfe\x7f\x35\x7e\xc3\x76\xbc\x3c\xb3\x2e\xf9\x79\xd4\xad\xdf\xaa\xb8\xbf\xb3\xba\xec\xbd\x93\xcf\x2e\xbb\xb7\xb9\xa6\x6c\x20\xc6\x67\x17\xde\x0f\x14\x9e\xf3\xf8\x8a\x00\xb9\x63\x1e\x16\x92\xe3\x31\x89\x14\x2b\x31\x91\xe3\x3a\x7a\xe6\x81\xf6\x27\x0d\x2e\xbb\x44\x49\xdc\x1b\x6b\x1d\xc3\xfe\xdc\xca\x73\x89\x01\x15\x4e\x21\xc3\x49\x43\xbb\x78\xfd\xeb\x46\x9e\x07\xd9\xe2\x78\xd2\xd0\xa2\xd4\x2e\x71\xd2\x78\x00\x6d\xb6\x7f\x15\x6d\xf6\x56\xd1\x66\xef\x81\xb4\xb9\x0e\xd1\x66\xef\x73\x68\xb3\x57\x49\x9b\xbd\x87\xd0\x66\xeb\x57\xd1\x66\xbc\x8a\x36\x63\x9b\x36\x6e\xab\xc7\x9f\xd3\xea\x71\x65\xab\xc7\x0f\x68\xf5\xa0\xfb\xab\x5a\xfd\x62\x55\xab\x5f\xac\x6e\xf5\x8b\xcf\x69\xf5\x8b\xca\x56\xbf\x78\x48\xab\x87\x7e\xab\x8d\x79\xef\x17\x73\x8e\xe3\xda\x43\xa0\xc2\xde\xbf\x34\x5a\x0f\xa8\x72\x54\x2d\xf3\x72\x67\x68\x9c\xf7\x5d\x3a\xff\x8b\x37\x58\xfa\xff\xf2\x10\xde\x1e\x85\x7b\xb9\x5e\x27\xbf\x6c\x3c\xb8\x9b\x5e\x56\x76\xd3\xcb\x87\xa0\x3d\xfc\x35\x68\x1f\x3c\x1c\xed\x83\x4a\xb4\x0f\x1e\x82\x76\x7f\x25\x77\x3d\xb7\x55\xdc\x67\xd1\x86\xb3\x9e\x51\x85\xc3\xf0\x41\x5d\x3e\x78\x18\x12\xf6\xca\x66\x05\x06\xa3\x87\x60\x10\xc7\xbd\x07\xb0\xbc\xd7\x7d\x6f\x5c\x96\x3f\x7e\xf3\xa0\xca\xfb\xbf\xa6\xf2\x3f\x78\x95\xff\xe1\x41\x95\x0f\x7e\x4d\xe5\x27\x5e\xe5\x27\x0f\xaa\x7c\xf8\x6b\x2a\x3f\xf5\x2a\x3f\x7d\x50\xe5\x0f\x11\x73\xf1\x70\xbd\x9c\x8b\x87\x0f\xe3\xb9\xcd\x87\xd4\xbf\x59\xa3\xfe\xcd\x87\xd5\xbf\xf5\x90\xfa\xb7\x6a\xd4\xbf\xf5\xb0\xfa\xb7\x1f\x52\xff\x76\x8d\xfa\xb7\x1f\x54\x7f\xaf\xfb\x80\xfa\x7b\xdd\xf5\xf5\xf7\xba\x0f\xab\x3f\x7e\x48\xfd\x71\x8d\xfa\xe3\x87\xd5\xff\x10\x99\xd7\xab\x31\xcf\xf7\xfa\x0f\xab\xff\x21\x62\xaf\x37\xa8\x51\xff\xa0\x66\xfd\x62\x93\xa0\x5f\xfd\x23\x4b\x97\x72\xd5\x2c\xe1\x5a\xf8\xe5\x17\x2b\xda\x36\x60\x3d\xe0\xed\x5d\x36\xb8\x55\xea\xca\xbc\xe8\xd1\x63\xe6\xed\x58\xcc\xbc\xdd\x4a\x67\x86\x97\xd1\xd0\xb9\x8b\xc8\xbe\x82\x8d\x9c\x8e\x18\x5e\xaf\xef\xb1\x7a\x86\x79\xe4\xf1\x58\x94\xf4\xf4\x59\xb4\xdd\x0d\xeb\x18\x81\x88\x78\xed\x1e\x81\xef\x18\xb1\xbf\x13\xe1\x46\x1f\x1b\x8b\xb0\xc2\xf2\xa7\x86\x8b\xca\x60\xcb\x43\x65\xb8\x59\xa5\x86\x1b\x35\x6f\x40\xce\xd6\x83\x1b\xb6\xba\x35\x1b\xd1\x68\x80\x45\xf6\xab\x1b\x13\x2a\xa0\xeb\xa1\x31\x8c\xfd\x46\x0d\x1f\x80\x06\xe4\x7f\x1c\xf5\x36\xb1\xdc\xe1\xe8\x41\xb8\xc4\x3c\x57\x2f\xde\x7e\x50\x36\x91\xab\xd7\x7d\x58\xae\x2d\x9e\x2b\xf6\x39\xb0\xb9\x32\xdf\x76\x6b\xc5\x55\x5d\x65\xc5\x9a\xd9\xcd\x91\xb8\x47\x74\xd5\x8a\x89\xba\x6c\x94\x79\x17\x95\x5a\x77\x8f\x56\x1f\x6b\x7e\xb3\xa7\x72\xac\x89\xdb\x34\xca\x32\x8e\x64\x96\x18\xa8\x3d\x42\x2e\x1a\x55\x4b\x00\x6f\xc4\xf2\x76\xbd\xb0\xc5\x7f\x8d\x25\x01\x15\x02\x4c\x87\x9d\x89\xde\x6b\x89\x60\x0e\xf9\x6e\xec\x2d\x53\x37\x53\xf3\x2d\x24\x12\x52\xb0\x45\x70\xa3\x99\xb1\x95\x4d\xec\x3b\xc1\x9d\x4e\xf2\xd6\x65\x55\x65\x00\x4b\x73\x33\x05\xe4\xab\xe6\xa7\x47\xcd\x47\xfc\x66\xe4\xf5\xc2\x98\x16\xcf\x0c\x4f\x7c\x4b\x6d\x74\x09\xb1\x71\xe9\xb9\xd8\xe5\xd9\x7c\x65\x95\xff\xbd\xa6\xef\xbd\x45\x8e\xf6\x30\x83\x52\xbc\xc9\x1a\x8f\xba\x1d\xa3\x61\x5c\x68\x0c\x8d\xad\x15\xad\x51\x1a\xeb\x00\x94\x93\xd6\x39\xf4\xa5\xc8\x66\x5c\xb5\x8a\x96\xe1\x9f\x1f\x57\x2c\xfd\x5f\xb2\x15\xcb\x66\x8a\xe5\xf4\xd5\x20\x55\xbb\xe9\x2b\x4e\x8a\x56\x87\x7b\xe1\x86\xf1\xce\x65\x96\x4f\xf1\xcc\x91\x19\xc5\x20\x37\x80\x43\xe8\x8c\xc1\xda\x91\x2a\x6c\x75\x79\x0d\x37\x76\x25\x56\x47\x87\xa9\x3b\x4c\xdc\x23\x04\x56\xaf\x0e\xcf\xb2\x9b\xf5\xc4\xa1\x7b\x4d\xf4\x82\x10\x3f\x55\xae\x23\x22\x4f\xaa\xd2\xb1\xe8\xca\x93\x09\xe8\x6e\x54\x83\xbb\x30\xac\x8b\x5f\xc7\xa1\xae\xb1\x0f\xc5\x2a\x05\xaa\x10\xdb\xe4\x42\x9f\xd5\xa5\x23\x95\xad\x67\x18\xba\xf0\xb7\x6a\x3f\x15\xfe\xb7\xa6\x00\x55\xf2\xab\x68\x00\xa2\x23\xfd\x2b\x5b\x7f\x9d\x46\x5a\xbc\x4e\x5e\x8b\x75\x7e\xf1\x52\x7a\x57\x6b\x96\x32\xa0\x56\xdd\x38\xd2\x34\xef\xc6\x68\x19\x4a\x62\xe8\x7b\xdb\xdb\xbc\x27\x2e\x95\x12\xfb\x17\xdb\xd1\xdc\x3f\x6d\xc0\x58\xd6\x95\xd7\xc8\x97\x3a\xa9\xea\xbe\x0d\x19\xc1\x1c\xab\xfd\xb4\x24\x4f\x54\x4a\x29\x52\x9a\xea\x9e\x09\x2c\xbc\x85\x77\xdd\x88\x6d\x0b\x85\xba\x6b\x5e\x5d\x71\xdd\xc0\x23\x41\xf0\x92\xd2\x34\x74\x39\xc5\x6e\x94\xd2\x65\xc7\x94\x7b\xd7\xfc\x0e\xd4\x6a\xa6\xc6\x6d\x5b\xcc\xbe\x12\x59\x7c\xa6\xf0\xd5\xa2\xb5\x5b\x7d\xe9\x06\xb5\x5f\xeb\x20\xc6\xe5\x00\xba\x05\x6d\x7d\x73\x06\xd2\x86\x5f\xb2\x4a\x1b\xf6\x9e\x62\xaf\xab\x6b\xb2\x4c\xe9\x46\xf7\x4f\xe1\x77\x1b\x2f\x85\x6f\xa9\x82\xb4\x03\x61\xf3\xb4\xd1\xd0\x88\x23\x31\x32\x86\x76\x12\xe0\x86\xdb\x39\xda\x59\x6a\x4d\x5b\x05\xdf\xcf\xd5\x86\x05\x19\xbc\xdd\xf5\xf6\x37\xd8\x7b\x02\xc2\x97\x49\x7b\x47\x81\x1a\x0d\x91\xe5\x99\x47\x55\x71\x05\x42\x74\x5b\xb6\xb1\x81\x4c\x0e\x94\xa8\xbc\xba\xa4\x82\x1a\x2e\x58\xb0\xe9\xb1\xb3\x03\x22\x5b\x34\xf9\x89\x07\xfe\xae\x0d\x6a\xf2\xe3\xc7\x95\xcd\xf9\x66\xf5\xad\x2b\x8f\x74\x37\xab\x9c\x22\x52\x16\xc6\x35\xca\x91\x74\xbe\x64\x15\xf7\xc9\x54\xed\x4e\xca\x5b\x75\x96\x5e\x95\x0b\x55\xab\xaf\xf7\x14\xda\x6f\xb0\x0e\x7e\x13\x11\x4e\x73\x33\x19\xe6\x79\x75\x10\xe1\x47\xcc\x64\x50\xf8\x23\x1d\xaf\x6a\x9c\x3d\xa4\xf7\x77\xe8\x03\xf1\xcc\x4d\x12\xb2\x92\x1a\xa7\x76\xad\x3b\xe6\x88\x6f\x8f\x54\xc7\xce\x0b\x29\xc8\xef\x30\x53\x92\x82\x1f\xba\xa1\xf2\x5f\x58\xba\x1a\x97\xc4\x78\xf4\x5d\x78\x15\x5c\x9d\x87\x47\x6a\x62\x10\x06\xcb\x07\x9b\x30\xfc\x11\x6b\xdc\x31\xf6\xca\x06\xc2\xb6\x43\x37\xc1\x04\xcf\xd4\xa1\xb0\xe0\x6a\x85\x90\xa9\x13\xe0\xcc\x48\x66\xd5\x4f\x4e\x7c\x33\x29\xfa\x72\x44\x18\xf1\xcd\x2e\x3c\x8f\x79\x0e\x07\x92\xde\x25\x1f\x7d\x9c\x02\x5b\xde\xac\x8a\xbb\x95\x31\xd5\xb2\x77\x2b\x8c\x35\x21\x56\xab\x09\x40\xc2\x42\x6d\x72\x97\x63\x1a\x2f\x9c\x47\xab\x4d\x5e\x05\xcf\x35\xb7\x9c\xbd\xc7\xd2\x9a\x7c\x47\x53\x53\x41\x8a\xdd\x88\x8c\x76\x97\xec\x6a\x9a\x62\x27\xe3\xeb\xe3\x67\xde\x5c\x6b\x5d\x19\xd3\x6a\xd9\xb5\xaa\xed\x35\x4e\x7b\x24\x02\x75\x5a\x63\x9d\x40\xc1\xc7\x1c\xa0\xf6\x48\xd7\xb3\xb1\x01\x35\xa1\x13\x42\xc4\xe4\x7b\x41\x51\x4c\xed\xe1\xa1\xd9\xea\xb9\xf1\xcc\x17\x54\x98\xd8\x06\xdf\x55\x17\x4d\x3a\xf8\xce\x41\xbf\xf9\xcd\xf0\x7d\xfc\x58\xe2\x6b\x52\xf7\x6f\x82\x37\x5d\x8b\x7a\x42\xa7\x32\xd5\x3d\x58\xda\x50\x00\xec\xe9\xc2\x39\x3f\xd1\xd3\x33\xad\x4b\xfa\xb8\xec\x51\xb7\xb2\xa2\x48\x13\x9a\x8a\xcf\x55\xb8\xf1\x32\x17\xb7\xd4\x85\x36\x6c\x99\x9b\x02\xd6\x6a\xd7\x50\xe1\x11\xbb\xfe\x57\x68\x6e\x8d\x96\xfa\xe4\x81\xb6\x42\x83\x49\x25\xfb\x8d\x5a\x4c\xb1\x9c\x2b\xed\x09\x6f\x8b\x21\xb9\x1d\x74\x5a\x70\x5f\x8f\xd8\x3d\x08\x54\xe9\xb6\x57\xd3\x49\x6c\x4f\x6d\x87\x76\x97\xaa\x59\x4e\x6d\x59\x73\x2e\x38\x53\xef\xf6\x09\xe6\xde\xb6\x9c\xd8\xe0\x1a\x7e\xc2\x27\x33\xb7\xeb\x56\xaa\x5d\x01\xe2\xae\x9b\x54\xd7\x6c\x5e\x5c\xc3\x7a\x50\xed\x1a\xb7\x9d\x1e\x92\xcd\x6e\xbb\x62\x66\x51\x4d\xa8\xc5\xc6\xe7\x4a\xd8\x1b\x5c\x28\x95\xd2\xb0\xa1\x80\x07\xc7\x64\x36\xcd\xc5\x35\x96\x28\xb8\xd2\x4e\x5a\x7c\x9f\x27\x22\xf2\x13\x8f\x57\xcd\xec\x21\x4b\x57\xc3\xa6\xe7\x19\x32\x70\xee\x89\xad\x34\xc8\xa7\xb7\x6b\xcf\xe0\xc7\x66\x54\x8f\x26\x68\x50\xd8\xf4\x09\x6e\xfb\x2a\xd6\xd6\xc6\x3d\x6f\xf2\x3c\x5c\x7e\x27\x2c\xfa\xed\x1b\xad\x0e\x1d\x92\x7e\x7c\x5d\xb1\x7f\xe4\xb6\xd6\x5e\x11\xb9\xd5\xcf\xbc\x73\xd5\xd8\xb7\xfb\xb0\xd8\x60\x83\x31\x41\x32\x24\x14\xf3\xba\x02\xb7\xb3\xb4\x9c\xad\x65\x43\x2a\xad\x44\xc8\x46\x15\x1b\xa6\xe2\x5c\xe7\xb0\x6c\x79\xfc\x58\x6a\xd1\x01\x25\x57\x6b\xcf\x6a\x0b\x33\xff\xde\x6c\x59\x9a\xb4\x9e\xd1\x64\xeb\x3f\x6e\x6c\x84\x7d\x06\xef\x19\x5d\x43\xbc\x0a\x25\x29\x4b\xfc\xbd\xd6\xcf\x2d\x83\x88\x6c\x0e\x79\x63\x80\xd2\xf2\x0d\xd1\xd4\x76\xb7\x98\xc7\xce\xb6\x6a\x25\x06\x43\xd9\xb4\x04\xc2\x18\xe2\xdf\x68\x6f\xb5\x1a\xd1\xf7\x55\x04\x72\x3d\xf9\x95\x3c\x29\xed\x20\x6d\x90\xdb\xf7\x09\x70\x93\x5e\x8f\xf7\xe0\xcd\x53\x61\x47\xbb\xb1\x59\x3f\x70\xe9\x9a\x79\x47\x15\x39\x9e\x8c\x03\x7e\x45\xed\xfa\xca\xb6\x8a\xbd\x56\x2b\xaf\x74\x2b\x6b\x5a\x4e\xab\x2f\xa9\x74\x28\x8b\x1a\xee\x3a\xd2\x92\x62\xc7\xf9\xb7\x5a\xeb\xbd\xc3\x9d\x2b\xd2\xb3\x4a\x67\x4d\xbd\xbb\x4a\xae\x6e\x99\xd0\xba\x67\x28\x2c\xca\xc4\x0b\x24\x6f\xdb\x27\x6a\x05\x64\x19\xdd\xfe\xb9\xc8\x3e\x34\xfb\x78\x9d\x78\x13\xed\x51\x3c\x40\x87\x0e\x2a\x52\xdf\x86\xdb\xb4\x3d\x1b\xaf\x05\xf5\xbe\xc5\x31\x7e\xcb\xe1\x5b\x41\xdf\x34\xf6\x44\xfe\x7d\x10\x16\x25\x91\x7f\x69\x25\xb4\x69\x9f\xe3\x2d\xcb\x53\x3a\xc8\x64\x4e\xb7\x0e\xa3\x73\x27\xa3\xa7\xeb\x6b\xbc\xc1\x90\x6e\xa0\x97\xd1\xc2\x35\xce\xea\x30\xef\xaf\x26\xcf\x9a\x79\x06\x62\x4e\xa7\xa6\x4c\xf8\x7a\x36\xbf\x06\x0f\xf8\x64\x81\xf7\x2b\x27\x37\x89\x3c\x74\x56\x6f\x9b\x84\x79\xf9\x23\x76\x34\x5d\x9b\xfe\xc5\xa7\x15\xd7\x52\x37\x70\xf3\x21\xde\x6c\x7e\x55\x8a\x45\x75\x71\x97\x33\xcc\x37\x30\x94\xe5\x0d\xce\x78\xd8\x43\xe3\xdd\x3b\x56\xbc\xca\xa6\x4b\x92\x9d\x3f\xd3\x39\xcd\x3b\xd0\xe7\xf2\x36\x33\xa9\x1a\xae\x3d\xe0\x34\xa8\x7a\xe9\x83\x61\xac\x57\xa0\xc4\xcf\xc1\xd3\x1b\x0d\xe2\xa8\xf3\x88\x57\x1f\x8b\xe2\x56\x25\x56\xf5\x9c\x14\xa8\x50\x5d\xd6\xa0\x93\xbd\x53\x25\x89\xc8\x46\xd5\xd4\xe9\xab\xeb\x56\x5d\xad\x8b\x75\xce\x65\x71\xbe\x72\xa5\x25\x77\x9c\x9a\xa4\x96\x9f\xa7\x17\xe2\x28\x36\xc0\x17\xdf\x40\x42\xf3\xe3\xa7\x9d\x03\x34\x69\x53\x85\xda\xcf\x9d\xb6\xcd\x4b\x6f\x9d\x36\x70\xef\xd2\x78\x36\x93\x27\x59\x17\xab\xaf\xa5\x73\x29\x07\x53\xd9\x94\xcd\x58\xc9\xdc\x2f\x95\x44\x0b\x8d\x79\xcf\xbe\xd2\x67\x67\x85\x7c\x6c\x92\xe9\x3e\xc3\xcd\xef\x6e\x47\x83\x3e\x44\x0c\xf8\x3d\x78\xda\xd1\x8f\x27\x02\x3a\x6e\x9b\xf0\x51\xde\xba\x07\xf4\xed\xbd\x73\x5e\x62\x15\x05\x50\x1d\x59\x4d\x64\xc5\x1f\xa5\xd0\x5f\x73\x6e\x17\xe4\xa0\x9a\xaa\x86\x29\x0e\xe1\xa7\xf9\x9c\xa3\x44\x8b\x91\xb9\x15\xc4\x79\x7e\xb1\xfb\x00\x66\x4c\x43\xcc\xc8\x35\x67\x52\x8a\x53\x55\xa3\xd2\x89\x2d\x8a\x55\x0f\x94\x59\x3d\xde\x32\xfb\xc5\x1d\x9c\x56\xd1\xe2\xc2\x78\xd1\xb5\xa5\x2b\xb8\xff\xd5\x84\xdf\xa3\x2a\xff\x5c\xe7\xf5\xdb\x23\x5c\x51\xfc\xb1\x4b\x37\x8a\x9d\x1e\xbf\xc4\xb7\xff\x88\xbf\x9c\xfd\x33\xbe\xfc\xc7\xf4\xb2\xcf\x5f\xfe\x13\xfe\x72\x7c\x86\x2f\xff\x29\x7f\x79\xfd\x07\x7c\xf9\xcf\xe8\x65\xbc\xf7\x4f\xf8\xf2\x9f\xd3\xcb\x8b\x7d\x2a\xfb\xbf\xe0\x2f\xa7\x54\xcf\x25\xbd\xbc\xa4\xfc\x3f\xf2\xbb\xdd\x8e\x0e\xe8\x85\x76\x8d\x75\xbe\xe3\x5f\xde\xd3\xcb\x01\xff\x72\x4d\x2f\x7b\x27\xf4\x92\x0b\x44\xf1\xe5\xbf\xe4\xcf\x87\xf8\xfc\x5f\xd1\xf3\xe4\x68\x1f\x5f\xfe\x6b\xfe\xb2\x17\xe3\xcb\x7f\x23\x5e\x7a\xf8\xf2\xdf\x8a\x97\x3e\xbe\xfc\x77\xe2\x65\x80\x2f\xff\x3d\xbd\xbc\x1e\x53\x03\xfe\x1d\x2f\xf9\x87\xd7\xf8\xf2\x3f\x08\x0a\xbc\xc0\x97\xff\x91\x23\x33\xa6\x2f\xff\x13\xff\xf2\x0a\x9f\xff\x67\x9e\xe5\x2d\x41\xfd\x2f\xfc\xc3\xe9\x1e\xbe\xfc\xaf\xbc\x31\x44\x80\xff\x8d\x9e\xbf\xa5\xe7\xff\x9d\x9e\x4f\xe8\xf9\xff\xa0\xe7\xb7\xf4\xfc\x7f\xf2\x92\xde\xe0\x73\xc4\x51\xe4\x94\xfc\xbf\xc5\xf1\xdd\xcd\xb2\xb3\xd7\x15\x81\xf6\xf0\x80\x9a\x0a\x98\x1e\xb5\x79\xe9\xc9\xd7\x8f\xbe\x00\xd5\x62\x96\x5e\xc2\xa8\x28\xa2\xe6\x6d\x59\x2e\x8a\x9d\x27\x4f\xe6\x8b\xbb\x3f\xd3\x2e\xca\x27\x8b\xe4\xea\xa7\xe4\x86\x3d\x11\x20\x2d\x04\xff\x07\x94\x59\x73\x28\xe6\xc5\xe9\x64\xa3\xbf\xb1\x37\x4b\xa0\x4c\xfa\x80\x46\x00\x56\x1a\x77\x36\xbb\x9d\x2e\x26\x3d\x7b\x26\x72\x3e\x39\x3a\xdc\xdb\x7f\x7d\xba\xff\xec\x19\x26\x3f\x79\x12\xed\x65\x8b\x7b\xba\xe8\x32\x6a\x5e\xb5\xa2\x5e\xb7\x3b\xda\x80\x9f\xed\x08\x8f\xe3\xdf\xbb\xcd\xb3\xbb\x74\x79\x17\x1d\x9f\x46\xe3\x65\x79\x9b\xe5\x45\x27\xc2\xdd\xae\x94\xa1\x88\x50\x9b\xce\xdf\xb3\x69\x87\x97\x25\x4a\x3c\x61\xa8\xa1\xd1\xf5\x38\x88\x04\x9e\x67\x80\x8d\x05\xcb\xae\xc8\x96\x39\xc8\x70\x4c\xb9\x4c\xe7\x49\x7e\x8f\x22\xe4\x0e\x04\x00\x1d\xe1\x0f\xd2\x04\xff\xcd\x96\xa5\x28\xe8\x2e\x9b\xaa\x13\xcc\xda\x20\x9c\x58\xb4\x40\x57\x3b\x8c\xd5\x69\x04\x22\xe2\x7d\x3a\x85\x87\xf2\x36\x29\xe9\x1a\x82\x6b\x50\x4f\xb3\x0f\x78\xcc\xd0\x55\x36\x9f\xf2\x7b\x08\x31\x93\x2c\x8c\x61\x64\x9f\x81\x26\xfc\xef\x6b\x07\xd7\x22\xca\xae\x25\x92\x57\x18\xb8\x74\x07\xfa\x35\x0a\x97\x24\x9d\x53\x1d\xc9\x25\x4c\x79\xd1\x95\x24\x99\x28\x69\x9e\x95\xd0\x13\x5c\x86\x47\x28\xb1\xb0\x18\x13\x89\xf9\xd4\xc1\x10\x2a\xbd\x9a\x25\xe9\x1d\xcb\x3b\xab\xb0\x81\x5a\x0d\x3a\x49\x6c\xa0\xe9\xd3\xe5\x15\xd3\x08\x89\x22\x14\x5a\xbf\x0a\x21\x51\x98\x68\xb0\x3c\x29\x32\x91\x5d\xf9\x04\x7a\x29\x2b\x71\xa7\x3a\xe8\xe9\xa0\xd2\x26\x60\x05\xa9\xbe\xa0\x6e\x84\x8f\xa2\x0c\xb3\x31\x56\x3b\x5f\xb3\x94\x8a\xc0\x1a\xe6\xe8\x51\x00\xf4\xbe\xcd\xb2\x1b\xb0\xc7\x0f\xe7\x57\x1d\xc0\x5f\x7f\xa3\x3e\x01\xcd\x59\x35\x72\xce\xcb\x04\x5e\x04\x14\xee\xf1\x94\x0c\x3c\x18\x14\xaf\x76\x60\xf3\x29\xa4\x32\x64\x24\x40\xe9\x2e\x03\xd5\x82\xd3\x0a\x58\x75\x0a\xb8\xe2\x49\x1a\x18\xb0\x23\x8a\x22\xea\x14\xd9\x75\xf9\x01\x59\x4b\xb0\x5e\x54\x2c\xd8\x15\x72\x1d\x64\x4d\x91\x23\x73\xe4\xb7\x39\xe7\xbc\xa2\xd0\x2d\x11\x85\x9c\xbd\x3c\x3c\x8d\x4e\x8f\x0f\xce\xbe\x1f\x9f\xec\x47\xf0\xfc\xe6\xe4\xf8\xbb\xc3\xc9\xfe\x24\x7a\xf1\x03\x7c\xdc\x8f\xf6\x8e\xdf\xfc\x70\x72\xf8\x2d\x08\xd8\x97\xc7\x47\x93\x7d\x10\x2e\xe3\xd7\x13\x48\x7d\x7d\x76\x72\xf8\xe2\xed\xd9\xf1\xc9\xa9\x28\xa9\x31\x3e\x85\xfc\x0d\xfa\x3c\x7e\xfd\x43\xb4\xff\xcf\x6f\x4e\xf6\x4f\x4f\xa3\xe3\x93\xe8\xf0\xd5\x9b\xa3\x43\x28\x12\xea\x38\x19\xbf\x3e\x3b\xdc\x3f\x6d\x47\x87\xaf\xf7\x8e\xde\x4e\x0e\x5f\x7f\xdb\x8e\xa0\x98\xe8\xf5\xf1\x99\x28\xe7\xe8\xf0\xd5\x21\x9e\x23\x72\x76\xdc\x26\x04\xfc\xcc\xd1\xf1\x41\xf4\x6a\xff\x64\xef\x25\xbc\x8e\x5f\x1c\x1e\x1d\x9e\xfd\x40\xb5\x1e\x1c\x9e\xbd\xc6\x1a\x0f\x8e\x4f\x44\x59\xe3\xe8\xcd\xf8\xe4\xec\x70\xef\xed\xd1\xf8\x24\x7a\xf3\xf6\xe4\xcd\xf1\xe9\x7e\x84\x0d\x9d\x1c\x9e\xee\x1d\x8d\x0f\x5f\xed\x4f\x3a\x80\x09\xd4\x1e\xed\x7f\xb7\xff\xfa\x2c\x3a\x7d\x39\x3e\x3a\xb2\xdb\x2d\x8a\x3a\xfe\xfe\xf5\xfe\x09\x36\xc6\x6c\x7a\xf4\x62\x1f\xf0\x1d\xbf\x80\x49\x02\x2a\xa5\x66\x4f\x0e\x4f\xf6\xf7\xce\xb0\x7d\xfa\x69\x0f\x08\x0a\xa8\x1e\xb5\x45\x59\xa7\x6f\xf6\xf7\x0e\xe1\x15\x68\xb4\x0f\xad\x1b\x9f\xfc\xd0\x16\x25\x9f\xee\xff\xe1\x2d\x80\xc2\xc7\x68\x32\x7e\x35\xfe\x16\x1a\xdb\xac\x45\x29\xe8\xb6\xbd\xb7\x27\xfb\xaf\xb0\x11\x40\x1e\x98\x35\x4e\xcf\x0e\xcf\xde\x9e\xed\x47\xdf\x1e\x1f\x4f\xa8\x17\x4e\xf7\x4f\xbe\x03\xe1\x79\xba\x1b\x1d\x1d\x9f\x12\x11\xdf\x9e\xee\x4b\x94\x26\xe3\xb3\x31\x21\x01\x05\x01\x1d\x01\x08\x9e\x5f\xbc\x3d\x3d\x24\x8a\x1e\xbe\x3e\xdb\x3f\x39\x79\xfb\xe6\xec\xf0\xf8\x75\x0b\x18\xe1\x7b\xa0\x16\xe0\x3b\x86\x02\x26\x44\xfa\xe3\xd7\xd8\x78\xc5\x53\xfb\xc7\x27\x3f\x60\x05\x48\x1b\xea\x9f\x76\xf4\xfd\xcb\x7d\x48\x3f\x41\x6a\x13\x05\xc7\x48\x9a\x53\xa0\xe4\xde\x99\x09\x06\xb5\x02\x61\x65\x0b\x75\xdb\xa3\xd7\xfb\xdf\x1e\x1d\x7e\xbb\xff\x7a\x6f\x1f\x61\x8e\xb1\xac\xef\x0f\x4f\xf7\x5b\xd0\x9d\x87\xa7\x08\x70\x48\x28\x00\x9b\x40\xcd\x6f\x89\x08\xd8\x8d\x80\xa1\xec\xc0\x03\x9b\xd9\xdb\xd4\xe5\xd1\xe1\x41\x34\x9e\x7c\x77\x88\x0d\x11\x59\x80\x49\x4e\x0f\x05\x5b\x11\x29\xf7\x5e\x8a\xce\xc0\xa1\xf3\xe4\x0b\xa9\x4f\x8a\x1b\x67\xd0\xbf\x97\xfa\xe7\x96\x7c\x25\xee\xf7\x6e\xc0\xc4\xd5\x88\xf8\xc5\xa3\x51\x32\xc3\x83\x43\xef\x23\xf6\x11\x44\x4b\xd1\xf9\xca\x31\x25\x7f\x16\xd7\x5a\xa7\x9d\x7c\x39\x2f\x41\x9a\x4d\x18\x5e\x59\xc5\xe6\x57\x29\x2b\xde\x09\x07\x42\x4a\x17\xdf\xc8\x83\x49\x28\x19\x15\x65\xc8\x53\x4e\xd9\xa2\xfe\x31\x91\x20\x95\x5d\x03\xc7\x6a\x81\x71\x78\xd1\x55\xc2\x77\x91\x87\x56\x47\xc4\x8e\xf5\x12\x70\x21\xb3\xab\x6c\xa2\xe6\xd5\xf2\xe3\x1a\xcd\x23\xcd\xf0\xbc\x82\xfc\xbc\x77\x01\x46\xd8\x62\x96\x80\xa5\xf6\xe4\x8f\x3f\x16\x5f\x27\xe5\x8f\xc5\xe3\x27\x6d\xf4\xce\x46\x3b\xf0\x3d\x76\xbe\xdf\x70\x8a\xe2\xcc\xf6\x0f\x1c\x2c\x10\x24\xa9\x94\x7a\x43\x7f\xf7\x2d\x07\xd2\xe3\xfd\xc6\x14\x96\x11\x91\x05\x8c\x88\x82\x0e\x27\x42\xe7\x05\x88\x75\xb2\xc2\x5a\x92\xf4\xc2\x24\x48\xd1\x7b\x53\x79\x09\x81\xbc\x8a\x88\x3b\xac\x02\xbd\x7c\x5e\x5c\xf8\x76\x1b\x77\x2a\xaf\xcb\x47\x9c\x80\x61\x0d\x6b\x2f\x85\x50\x8e\x1f\xd0\xbf\x96\x39\x3b\x09\x73\xdb\xea\x8b\xe9\x1f\xb9\xcb\x0a\xdc\x6c\x83\x89\x37\xcc\xbe\x8e\xf1\x1f\x06\x3a\x2f\x85\xe5\x55\x4a\x66\xa2\x43\xa0\xb0\x5f\x60\x9e\x9f\x02\x7f\x52\xc4\xe7\xec\x5a\x84\x17\xed\xc2\x3f\x4f\xa3\x4c\xf5\xeb\xdc\xef\x57\x1e\x92\x92\x9d\xcf\x2f\x48\x85\x6b\x05\x4f\x9f\x37\x0f\xbb\xfa\xea\x15\x4e\x94\xa0\x54\x34\xbe\x42\xbf\x2f\xfc\x7d\xd5\xc0\x23\x84\xe6\x8c\xa1\x92\x70\x79\xff\x95\xb8\x28\x86\x0e\xcc\xa8\xd8\x9a\xb1\xe2\xdc\x73\x6c\x0c\x30\x17\x20\x74\x51\x71\x8a\xd9\x23\x16\x38\x07\xe9\x20\x49\x67\x50\xbd\xa0\x1a\x28\x04\x82\x6c\xf7\x11\xbf\xbc\xd1\x70\xe7\xc9\x83\x74\x0e\xe7\x69\x59\xe7\xee\x23\x57\xa4\x70\xfe\x39\x47\x68\xe4\x27\x23\x80\x3d\x5d\x57\xa2\xee\x5e\xbb\x48\xde\xab\x2b\x6e\xc0\x43\x37\x8f\xe8\xc6\x2a\xc7\x07\x1d\xf1\x1e\x3e\x74\x4f\x5f\x72\xd5\x6c\x60\xc5\x3b\x11\xee\xab\x29\xce\xbb\x88\x7f\x01\x92\xa4\x99\x76\xae\x3b\xc9\x2c\xc9\xef\x9a\x59\xf8\x8e\x16\xf3\x04\xb7\x4f\xf6\xb2\x70\x43\xe2\x6d\xdd\x1c\x1d\xe8\x23\xc9\x39\xc0\xe8\xe9\x9c\x8e\xa7\x52\xe2\x64\x27\xca\xe6\xd8\x1f\xa6\x68\x5c\x35\xfe\xd0\xc3\x69\x84\xf7\x66\xea\x3c\x39\xe8\x07\x11\xbc\x6d\x44\x5a\x24\x53\x1e\x7a\x82\x62\xa2\xfa\x6b\x0d\x56\x40\xb7\xb2\x5c\x33\x17\x9d\xf1\xf4\x19\x85\x1f\xf0\x62\xf9\x1d\x7f\x18\x4e\x62\x5e\xb8\xa6\x22\x1b\xc1\xbc\x94\x31\x37\xd6\x79\x85\x34\xa2\x41\x98\xb3\xa4\x84\x1a\x9e\xe8\xaf\x8f\xc5\xc1\xb4\xdc\x83\xc5\x17\x4b\xd5\x2e\x1d\x5e\x99\x68\x76\x2b\xdc\x6e\x8c\xad\xa9\x6a\xf5\xbe\x1b\x5b\xfe\x6f\xaa\xcd\x56\xe1\x8f\x6d\x12\xe8\x36\xa7\xb8\x3a\x93\xe5\x85\x9a\xfd\xf9\x2b\x14\x4c\x0a\x81\x6a\xcb\x2d\xfb\x18\x8f\x76\xa2\x27\xbf\x6b\x9e\x27\x1b\xd7\xdd\x8d\xed\x8b\x56\xe8\xe9\x49\xda\x36\xb3\xf4\x06\x56\x96\x9f\x7b\x9f\x5a\xd5\x2f\x66\xde\xfc\xe6\x72\x87\x38\xff\x84\xdd\xec\x7f\x5c\x34\x1b\x7f\x7c\x52\x7c\x0d\x89\xf0\xfb\xa4\x09\x3f\xcd\x27\xd3\x9f\xe3\x76\x1f\x32\x15\x5f\xb7\xd7\xbc\x3f\xc1\x9f\xdf\x37\xf4\x64\xff\xe3\x93\x27\x37\x30\xbf\xff\xf8\x23\x12\xb6\x91\xc2\xaf\x59\x6f\x12\xac\x38\xf9\x9c\x9a\x9b\xcf\x77\x44\xd2\x63\x78\x7c\xd2\x81\x7f\x5b\xcf\xf1\x43\xeb\x81\x38\x7d\x0c\xe3\xf4\xfc\xb7\x45\xea\xf9\x43\xb0\xfa\x18\xc7\xd4\x49\xa4\x3a\xe1\x93\xee\xcb\xb8\x3d\xf8\xd4\xfa\xf1\xc9\xda\x04\xa8\xcb\xec\x73\x34\x67\xa1\x3c\x00\xfa\xeb\x05\xfe\x00\xe4\x8f\xc5\xc5\xe3\x27\x7a\x7a\x90\xbc\x79\x73\x79\x96\xfd\x73\x1c\xaf\x08\xa4\x5a\x71\x2d\x99\x0a\x14\x78\x86\x57\x64\x6d\x82\xf6\x4e\x2b\x58\x77\x29\x79\x97\x7b\xc3\x61\xab\xd5\x29\x33\x31\x7a\xe2\x11\x8d\x91\xeb\xce\x5f\x61\xbc\xe3\xf7\x41\xab\xea\xce\x0e\xae\xa9\xd2\xf2\x5c\xd3\x1c\x46\x88\xee\x47\xff\x90\x2d\xdc\xac\xd9\x40\xba\xd1\x7d\x3d\x4d\x54\x47\x5b\xea\x5a\x40\x7c\xef\x39\xef\xfd\x8b\x96\xb8\x60\xc6\x23\x08\xf4\xc5\x4b\xf6\xf1\x2c\xdb\x3b\x3d\x5d\x73\x1c\xa2\xb8\x79\xb4\xf8\x1e\xcc\xf9\x66\xe3\x77\x0d\xbd\xa3\x09\x0b\xde\x75\x0e\xca\x26\xd5\x7b\x79\x59\x94\x39\x1d\xd9\xbe\x81\x3b\xa4\xa2\xf3\x7e\x3b\x1a\xb5\xa3\xed\x76\x14\x83\x86\xad\xc2\x1c\xa4\x6c\x5b\x55\xa0\xa0\xce\x93\xf3\x3f\x0a\x46\x78\x92\x56\xc1\xeb\x53\x70\x85\x54\x7b\x82\xf7\x52\xe5\x26\x4a\x5d\x7e\x63\x47\x66\xa6\x95\xe2\x16\x0f\x2b\x0d\x09\x68\x87\xe8\x2b\xc5\x44\x10\x30\x41\x4d\xfb\x2c\x3b\xf9\xf6\xc5\xb8\x79\x9e\xf3\x25\xd2\x0b\xc0\x76\xd1\x5c\xef\x55\x47\x32\xd1\x61\xb4\x87\xf3\x12\x39\x84\x58\xa6\x17\x89\x9b\x7d\x98\xb8\x25\x4b\xbc\x3c\x7d\x4a\xf7\xcf\x30\xbc\xe2\x6d\x20\x97\x52\x7b\xe6\x9d\x11\xad\x50\xef\xae\xe9\x5a\x4d\xac\x00\xfb\xf1\x81\x1a\x38\xe5\x8d\x9f\x02\xa3\xcf\x26\x8e\x8d\xe9\xc0\xa2\x48\x59\x93\x12\x71\x64\x9e\x34\xfc\xdc\xa0\x0a\xde\x97\x45\x94\x11\x37\x84\x85\xa1\x24\x04\xbf\xb7\xca\x3c\xb2\xb8\xc9\xf7\x49\xc9\x1e\xed\xe1\xfc\x46\xa3\x36\xcf\x96\xf3\x69\xd3\x2d\xe2\x09\x0c\xe3\x4d\xeb\x1a\x16\xb1\xf5\xd5\x61\x7e\xa8\x3d\x34\x86\xb0\x81\x3b\xfa\x0b\xca\x25\xa2\x84\x15\xcb\x23\x3e\xde\x62\x1e\xf8\xf6\x79\xb2\x68\x10\x68\x28\x26\x48\x01\x9c\xb7\x83\x6b\xf8\x2b\x6e\xbd\x81\x66\x09\xab\x02\xff\x72\xf1\x97\xe2\x9f\x73\xe6\x75\x6b\xb7\xe2\xd8\x69\xce\x43\x59\xc5\xa9\x9e\x42\x6c\x35\xb1\x1a\x45\xf7\xf2\x1c\x83\xc2\x90\xf4\x78\x2d\x5d\xe4\x7c\xeb\xad\xf8\xd6\xd7\xdf\x5a\x0d\x53\xbe\x55\x48\x58\x8b\xb5\x49\x29\xe1\x12\xc0\x4d\xef\x0d\x5c\x61\xe6\x1b\xd6\xca\x60\x2c\xb8\xad\x87\xf7\x7f\x33\x65\xeb\xd1\xf5\xdf\x8c\xdb\xbd\xd0\x75\xf0\x20\xcd\x6e\xc6\x53\xfc\x21\xc5\xc2\x53\xd5\x4b\x27\xda\xa8\x36\x7b\xc8\x3e\x51\xc5\x5d\xe5\x60\xed\x70\x4e\xac\xee\x1d\xe2\x00\x35\x67\x35\x0d\x62\x83\xb9\x42\x67\xfc\x8d\xa2\x5f\xec\xbe\xa3\xe4\x2d\x3b\xb5\xc7\x53\xbb\xee\x6c\x38\x6a\xad\xe8\xa6\xb5\xa4\xce\x39\xa9\x73\x8b\xd4\x39\x27\x75\x2e\x49\x9d\x7f\x0e\xa9\xf9\x46\x86\xf4\xaf\x6c\xa5\xa8\x54\x81\x0e\x2b\x44\x81\x1c\xd3\x8e\x1c\x90\x73\x79\xa7\x64\x05\x75\x16\x97\xee\xf5\xe4\x84\x21\x4f\xeb\xc8\x71\xb5\x15\x06\x5d\x59\x0c\x46\x09\x4e\x23\x1e\x11\x70\x20\x26\x34\x12\x19\xf4\xad\x1e\x5f\x0c\xba\xd4\x7c\xeb\x19\x6f\x25\x1f\x6a\x1e\x82\x60\x0c\x8e\x67\x8b\xdb\xa4\xa6\xf9\xbd\x8a\x21\xa5\x86\x83\x68\xd3\xea\x7d\x70\x56\xc9\x7d\x22\xdd\xa5\x1f\xd7\x9d\x5f\x6c\xf9\xdf\x42\x68\xf0\xc9\xdf\xff\x52\xb6\x2c\x9f\xce\x60\x37\x7a\xfc\x78\x1e\x1a\x70\x09\xf9\x4f\xe6\x17\x18\xe3\x04\xff\xd8\xe3\x8c\xfc\x3c\xcf\x82\x73\x0f\x7e\x42\x39\x96\xc0\xb4\x9e\xaf\xd8\xaf\x1f\x24\x46\xe6\x13\x43\x62\xbe\x5a\xa3\xb3\x19\x19\xf9\xa1\xd1\x0a\x86\xca\xac\xd0\x4e\x13\xe3\xd0\x59\xe9\xff\x68\x9b\x97\xd3\x85\xbc\x8d\x2b\x75\x0e\x5b\xe1\x50\x38\x04\xab\x11\x97\xdc\xda\x35\xba\x44\xb3\xf6\x8d\x36\x1b\x7b\xd9\x72\x36\x9d\x7f\x55\x46\x44\x24\xee\x8d\xc1\xae\x0f\x6a\xc7\x6a\x5c\xd6\x08\x1e\x4e\x8d\xfe\xa1\x7f\x5e\xd3\x1a\xdb\xf3\x50\x2a\x86\x50\xec\xc8\x19\xbb\xcc\x8e\xb2\x0f\x2c\xdf\x4b\xf0\xda\xf0\xd6\xe7\x97\xa3\x4d\xaf\xe2\x31\xd9\x5e\x8d\xcf\x2a\x2d\x48\x88\xa2\xcc\xae\x7e\xa2\xd0\xc1\x37\xc9\x8c\x95\x74\x9d\x85\x2f\xf4\xce\x1b\xbf\xeb\xd2\xff\x50\x60\xfc\x6e\x6f\x4f\x3d\x0e\xf6\xb7\xc7\xdd\x11\x4f\x1d\x8c\x65\x6a\x7f\x30\x1a\x8e\x07\xf4\xb8\x39\x1c\x76\x37\x5f\xd0\x63\x77\xb4\xbd\xb5\x3d\xa6\xc7\x49\x7f\xb2\xb9\x77\x40\x8f\xc3\xe1\x70\x73\xd8\xa7\xc7\xfd\x83\xde\x76\x6f\x9b\xc3\x76\x5f\x8c\x63\x9e\x7a\xb0\xb7\xbf\x3d\xe0\xb0\x9b\xbd\xed\x03\x91\xed\xa0\xd7\xed\xee\xbd\x90\xb0\xc3\x17\x13\x5e\x02\xfe\x6f\x4f\xa4\x2a\x24\xf1\x61\x78\xa0\x1e\xb7\x36\xd5\xe3\x58\xa7\x4e\x74\xea\x81\x4c\x1d\x1e\xa8\x12\x86\x07\x43\x9d\xaa\x4a\x18\x1e\x8c\x75\xea\x44\xa7\xaa\x12\xb6\x36\x55\x09\x5b\x9b\x43\x9d\xaa\x4a\xd8\xda\x1c\xeb\xd4\x89\x4e\x55\x25\x8c\x35\x0e\x63\x8d\xc3\x58\xe3\x30\xd6\x38\x8c\x35\x0e\x63\x8d\xc3\x44\xe3\x30\xd1\x38\x4c\x34\x0e\x13\x8d\xc3\x44\xe3\x30\xd1\x38\x1c\x68\x1c\x0e\x34\x0e\x07\x1a\x87\x03\x8d\xc3\x81\xc6\xe1\x40\xe2\x80\x74\x14\x25\xe0\xe3\x50\xa7\x8a\x12\xf0\x71\xac\x53\x27\x3a\x55\x95\xa0\xfa\x02\x1f\x87\x3a\x55\x95\xa0\xfa\x02\x1f\x27\x3a\x55\x95\xa0\xfa\x02\x1f\x87\x3a\x55\x95\xa0\xfa\x02\x1f\x27\x3a\x55\x95\x30\xd6\x38\x8c\x35\x0e\x63\x8d\xc3\x58\xe3\x30\xd6\x38\x8c\x35\x0e\x13\x8d\xc3\x44\xe3\x30\xd1\x38\x4c\x34\x0e\x13\x8d\xc3\x44\xe3\x70\xa0\x71\x38\xd0\x38\x1c\x68\x1c\x0e\x34\x0e\x07\x1a\x07\xd5\x17\x48\x05\x51\x02\x3e\x0e\x75\xaa\x28\x01\x1f\xc7\x3a\x75\xa2\x53\x55\x09\xaa\x2f\xf0\x71\xa8\x53\x55\x09\xaa\x2f\xf0\x71\xa2\x53\x55\x09\xaa\x2f\xf0\x71\xa8\x53\x55\x09\xaa\x2f\xf0\x71\xa2\x53\x55\x09\x63\x8d\xc3\x58\xe3\x30\xd6\x38\x8c\x35\x0e\x63\x8d\xc3\x58\xe3\x30\xd1\x38\x4c\x34\x0e\x13\x8d\xc3\x44\xe3\x30\xd1\x38\x4c\x34\x0e\x07\x1a\x87\x03\x8d\xc3\x81\xc6\xe1\x40\xe3\x70\xa0\x71\x50\x7d\x31\xd6\xe3\x62\xac\xc7\xc5\x58\x8f\x8b\xb1\x1e\x17\x63\x3d\x2e\xc6\x7a\x5c\x8c\xf5\xb8\x18\xeb\x71\x31\xd6\xe3\x62\xac\xc7\xc5\x58\x8f\x8b\xb1\x1e\x17\x63\x3d\x2e\xc6\x7a\x5c\x8c\xf5\xb8\x18\xeb\x71\x31\xd6\xe3\x62\xac\xc7\xc5\x58\x8f\x8b\xb1\x1e\x17\x63\x3d\x2e\xc6\x7a\x5c\x8c\xf5\xb8\x18\xeb\x71\x31\xd6\xe3\x62\xac\xc7\xc5\x58\x8f\x8b\xb1\x1e\x17\x63\x3d\x2e\xc6\x7a\x5c\x8c\xf5\xb8\x18\xeb\x71\x31\xd6\xe3\x62\xac\xc7\xc5\x58\x8f\x8b\xb1\x1e\x17\x13\x3d\x2e\x26\x7a\x5c\x4c\xf4\xb8\x98\xe8\x71\x31\xd1\xe3\x62\xa2\xc7\xc5\x44\x8f\x8b\x89\x1e\x17\x13\x3d\x2e\x26\x7a\x5c\x4c\xf4\xb8\x98\xe8\x71\x31\xd1\xe3\x62\xa2\xc7\xc5\x44\x8f\x8b\x89\x1e\x17\x13\x3d\x2e\x26\x7a\x5c\x4c\xf4\xb8\x98\xe8\x71\x31\xd1\xe3\x62\xa2\xc7\xc5\x44\x8f\x8b\x89\x1e\x17\x13\x3d\x2e\x26\x7a\x5c\x4c\xf4\xb8\x98\xe8\x71\x31\xd1\xe3\x62\xa2\xc7\xc5\x44\x8f\x8b\x89\x1e\x17\x13\x3d\x2e\x26\x7a\x5c\x4c\xf4\xb8\x98\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\x71\xa0\xc7\xc5\x81\x1e\x17\x07\x7a\x5c\x1c\xe8\x71\xd1\xdd\xc2\xff\xe8\x31\xee\xe1\x7f\xfc\x71\x0f\xff\xa3\xc7\xde\x08\xff\xe3\x7a\x5f\x17\xff\xe3\x8f\x63\xfc\x8f\xeb\x88\xf4\x3f\xa1\x2e\xe2\x7f\x7c\x4a\xda\xc2\xff\xe8\x91\x0a\xe0\xe5\x8e\xf6\xf0\x3f\xae\xec\x8d\xf0\x3f\x2e\x32\x09\x09\xfe\x38\xc6\xff\xe8\x71\x7b\x80\xff\xf1\xc7\x7d\xfc\x8f\x0f\x69\x82\xa0\xc7\x17\x3d\xfc\x8f\x3f\xee\xe1\x7f\x5c\x4f\xa5\x3a\x38\xcb\x75\xf1\x3f\xfe\x38\xc6\xff\xb8\x12\x49\x58\x6a\x7d\x72\xbf\x71\x61\x7a\x41\xaf\x2a\x94\x65\x4f\x93\x6e\x07\x15\x74\x73\x01\x2d\x41\x37\xeb\x25\xc5\x39\x93\xff\xac\x37\xe8\xb6\xa3\xde\x60\x8b\x2f\x39\x34\xf4\x32\x48\x32\x2f\xd3\xbf\x2c\x19\x9d\xc1\x24\x61\x87\x08\xdb\x1f\xc2\x4f\x5c\x09\x1b\x2b\x60\x84\xeb\x6f\x23\xf0\x76\x15\x70\x4f\x02\xf7\x11\x81\x5e\x1f\x7e\xba\x83\x2a\xe0\xbe\x04\xee\x42\xc9\xf1\x76\x0f\x7e\x36\x47\x55\xc0\x03\x01\x1c\x23\x06\x71\x1f\xb7\xbd\xf5\xba\x16\xf0\x5f\x96\xc9\x5d\x92\xa7\x73\xd9\xba\xb8\xb7\x49\x44\x40\x84\x7b\x61\xc8\xb8\x3e\xa8\x6c\x59\x1c\x63\xcb\xb0\x79\xf1\xf6\x56\x18\x54\xb6\x2b\xee\xf6\xb0\xfd\xd8\xb8\xcd\x0a\x54\x65\xab\x46\xd4\x28\xfc\x89\x6d\x0a\xfc\x75\x99\xdb\x3d\x4b\x48\x3a\x3d\x8b\x40\x71\x2d\x28\xd5\x3f\xbd\x81\x68\x05\xfc\x78\x50\xaa\x01\xdb\x7d\xd1\x00\xf8\xf1\xa0\x74\x8f\xc4\x12\xf9\xbe\xc5\x18\x97\x2c\xbd\xd1\xc8\x63\x29\xf4\x63\x77\xdb\x65\x5a\xfc\x45\x33\x2f\xe1\xdd\x23\xe2\x8e\x7c\xa8\xb8\x1e\x98\xcd\x84\x31\xb4\x21\xde\xea\xfb\x60\x36\xfb\x6d\x21\xd8\x70\xcb\x07\xb3\x19\xaf\x87\xb0\xdd\x4d\x0b\x6c\xc6\x7d\x05\x08\xd4\xa5\xdb\x76\xbb\xce\xe7\xf9\xd5\x2d\x9b\x26\xb3\xbb\x6c\x3e\xb5\x07\x93\x4f\x59\x63\x24\xf3\xb2\x9c\x5e\xc4\xef\xf1\x3a\x80\x9e\x0d\x60\xf7\x30\x02\xf4\x6d\x00\x1f\x85\x81\x05\xe0\xf6\x2b\x00\xbc\x4f\x33\x10\x50\x8a\x34\x40\xe8\x01\xf2\x4a\xcf\xee\x8e\x3c\xfb\x30\x97\x30\x23\x68\xec\xa0\x87\x7f\x1e\x88\xd5\xaf\xa3\x01\xfe\x79\x30\x56\xa7\x0e\xb7\xf1\xcf\x83\xb1\x7a\x74\x18\xe3\x9f\x07\x63\x75\x27\x76\x40\xdf\x6e\xfc\x32\x9f\xdd\x7f\xc8\x32\xd5\x51\x3d\x14\x4b\x5b\x03\x24\x42\x18\xd0\x66\xca\x18\xc7\xc2\x30\x0c\x69\x35\x21\xde\x06\x91\x13\x0f\xc2\x90\x36\x6b\x6e\x76\x89\xf1\x82\x90\x36\x77\xc6\x00\xbe\x65\x01\x5e\x25\x53\x56\x1a\x4c\xb5\x3d\x24\x36\xc7\xc5\x91\x6e\x10\x4e\x89\xc4\x61\x4f\x0e\xd8\x61\xb8\x44\x25\x11\xb1\x57\x7b\xbd\x6d\x8f\xd3\x14\xa4\x92\x27\x44\x4c\x6c\xb8\xc3\x72\x0a\x52\x36\x87\x46\x63\x7f\xe0\xb1\x1e\x9e\x48\x54\xe6\x6c\x59\xf8\x42\xbe\x1b\x86\x8b\xeb\x02\xfa\x02\xbe\x02\xd0\x17\xef\x15\x80\x9e\x70\x77\xe0\x32\x98\xd2\x13\x3d\x19\xc7\xd8\xcd\x58\x5c\x3f\x0c\x67\x31\x1a\x35\xa7\x3f\x0a\x02\xda\x7c\x86\xcd\xe9\xf7\x83\x80\x36\x9b\x51\x73\xb6\x83\x80\x16\x97\x51\x73\x6c\xb8\x2c\xc7\x73\x98\x1c\xec\xb6\xba\x1e\x8c\xdd\x84\x18\xfa\x77\x6b\xe4\x01\xd9\xe8\x77\x47\xc1\x92\x2c\xd4\xb7\x63\xc4\xca\x83\xb1\xb1\x46\xd1\xb3\xe9\xc0\xcc\xaf\x67\xe8\x5d\x35\xc6\x47\xdc\xc5\x5e\x18\x10\x33\x7b\xd0\x45\x3a\xfb\xc9\x1a\xed\xa4\x66\xf5\xba\x21\xb8\xb8\x2e\xa0\x3d\x5b\xf5\x7b\xfe\xe0\x10\x80\x56\x93\x7b\x84\xe7\x66\x10\x45\x47\x55\x1a\x79\xaa\xd2\xd5\x7d\x32\xd7\x02\x3e\xa4\x2c\x20\x44\xbc\x1e\xc4\x98\x68\x42\xca\x04\x82\x18\x53\x4d\x48\x93\x40\x10\x63\xb2\x09\xa9\x11\xd3\x24\xff\xc9\x9b\x16\x03\x30\x76\xab\xaa\x4a\xba\xc9\x66\x53\x36\xcf\x95\x80\x17\xb2\x1d\x7f\xe2\x4a\x58\x9b\x6f\xb7\x48\x86\x56\x02\xdb\xfc\xbb\x89\x92\x6c\x50\x09\x6c\x0f\xc1\x01\xa9\x18\x95\xc0\x76\xc7\x76\x81\xeb\xb7\x3c\xd8\x3c\xb9\x57\x33\x2e\x42\x89\x1f\x1f\x8e\x31\x93\x5a\x5d\x57\x6d\x11\x40\xb5\x0a\xfb\xe9\x36\xf9\x29\x55\xf4\xdc\x96\xda\x94\xad\x26\x21\xe0\x5d\x72\x83\xbb\x9a\xcc\x66\x84\xfb\x32\x9b\xa5\xef\x99\x89\xe3\x16\xd7\xbb\xdc\x31\x6c\xc3\xaa\x6e\x22\x51\xc6\x65\x4c\xaf\x1a\x5c\x49\xfc\x2d\xa5\xd2\x77\x07\xd5\xe0\x4a\xee\x8f\xa4\xdc\xdf\xee\x56\x43\xab\xbe\x8a\x25\x33\x8e\x3c\xfe\x02\x21\x35\xbf\xb1\x74\xdf\x78\x10\xea\x07\x0e\xe7\xcb\xff\x0a\x40\x5f\xfe\x57\x00\xfa\xf2\xbf\x02\xd0\x93\xff\x01\xb8\xab\xdb\x54\x8d\xa9\x21\x74\x3f\xd9\x96\x01\x7a\x12\xa0\x9a\x95\x49\x74\xf7\x3c\xe1\xa2\x21\x55\x27\x6d\xa2\xde\xe7\xcb\x18\x0d\xa9\xfa\x67\x38\x90\xb5\x57\x94\xa9\x9a\xd3\x05\xc8\x80\x96\x81\x90\x39\x9b\x3a\x6c\xea\xb5\xb9\x20\x95\x5e\x11\x9b\x0c\x08\x52\xd5\x3c\x9e\x2b\x58\x62\x32\x73\x3c\x20\x9b\x04\x7b\x67\xd0\xaf\x82\x8d\x2d\x23\x8c\xfa\x7c\xbb\x12\x58\xb3\xb2\x14\xc5\xf1\x56\xb7\x0a\x58\x13\x6a\x28\xed\x53\x9f\xfa\x12\x58\xd3\x6a\x28\x85\xaa\x4f\xd6\x02\x95\x04\x43\x46\x6f\xf6\x90\xdd\x83\x74\x25\x50\x43\x4a\x0d\x80\x8f\x37\xb7\xf1\xaf\x12\x52\xab\xa4\x71\x78\x2a\xb2\xa0\xb5\x5a\x1a\x87\x67\x25\x0b\x5a\xab\xa6\x71\x78\x82\xb2\xa0\x95\x7a\xda\xab\x9e\x60\x04\x30\x5b\xdf\xc0\x72\x99\xff\x65\x99\xa5\x05\x33\xa7\xc8\x11\xfe\x78\xa0\xb6\xb9\x85\xda\x44\x97\x8c\x0d\x0b\x8e\x5d\xa6\xc9\x5c\xf3\x6d\x0f\xad\x01\xd4\xc3\x1c\x28\xb6\x58\xa4\x73\x5b\x8f\x21\x9d\x67\x33\x04\x16\xd7\x84\xb3\x65\x0e\xfe\xf5\x83\x70\xb6\xc8\x19\x91\x7c\x0a\xc1\x39\x53\x9d\xab\x03\x22\x58\xf1\xd3\xbd\xad\x16\x90\x30\x71\x59\x43\x03\xc6\xb5\x21\x0d\xb5\x86\xc4\x8e\xcb\x40\x1a\xd2\xd0\x6e\x48\xec\xb8\xcc\xa3\x21\x4d\x25\xa7\x1b\x10\x39\xe9\x9d\x39\x75\x73\x81\x3c\xf4\x07\x1b\x82\xb1\xf5\x60\xd9\xf4\xc6\x52\x6b\xfb\xd4\x73\x03\xbf\xd1\x0a\x30\xae\x0d\xa9\xba\x7a\x4b\x28\x4e\x2e\x79\x14\xa4\xea\x6c\xd2\xae\x46\x3e\x79\x14\xa4\xea\x6e\x00\xda\xdc\xf2\xa8\x73\x9d\xe6\xec\x32\x4f\x95\x9b\x85\x7a\xa5\x4f\xc2\x3b\x08\x66\x71\x2d\x8e\x96\xc1\x56\x10\xce\xe2\x5a\x6c\xf4\x20\x5c\x9e\xc5\xb5\x08\xdb\x0f\x97\x67\x71\x6d\x0f\x1b\x6c\x99\x37\x60\x6d\x80\x59\x62\x7b\x60\x49\x9a\x91\xcb\xd6\x62\x70\xbc\x0e\xbe\x28\xcd\x49\x43\xcc\x53\x6e\x9b\x6f\x92\x74\x5e\x5c\x66\x79\xa6\x1c\x16\x5d\x32\x35\x3c\x7b\xe3\xe6\x36\x2b\x4a\xab\x6e\x32\x4a\x02\x9e\x62\xd4\x37\x6d\xa7\x86\x6b\xe3\x22\x44\xbc\x1e\xc4\x36\x6d\x50\x5f\xf5\x40\x1c\x4f\x47\x3f\x00\xe2\xb8\x38\x36\x03\x20\xa6\x42\xdf\x23\xe9\x83\xde\xa6\x7e\x2f\x08\x67\xeb\x51\x38\xbb\xda\xa2\xaa\x42\x91\xc7\x99\xd5\x21\x7d\x58\x89\x27\x7a\x6c\x07\x01\x1d\xa9\x46\x22\xd5\x02\x34\x44\xc0\x36\xc9\x29\xfe\xe3\xc2\x74\xab\xdd\x8d\xe6\x84\x89\x4d\x83\xff\x7b\x9f\x65\x76\xce\xa1\x2e\x97\x72\x90\xae\xcd\xa1\xfe\xa4\x4b\x60\xb1\x21\x0b\xf8\x9f\x07\x22\xa9\x88\x5e\x62\xfe\xe7\x81\x48\xfa\xa1\x0a\xc5\xff\x3c\x10\x49\x39\xb4\x66\xf9\x9f\x07\x32\x94\x20\x5b\xa1\x31\x4a\x20\x23\x39\x27\x03\x1e\xfc\xcf\x03\xd9\x94\x20\x7d\xee\xd2\x1c\xf8\xb8\x6c\x49\x10\xc0\x83\xff\x79\x20\xdb\x12\x64\x2b\x24\x7f\x4c\x35\x05\x3d\x95\xf0\x7f\xef\xb3\xa4\x3f\x77\x63\xba\xae\x4c\x02\x91\xc4\x27\x7d\x97\xfe\x3c\x10\x55\x0b\xa0\xc9\xff\x3c\x10\x49\x7c\xee\x53\x75\xfd\xaa\x04\xa2\x1c\x59\x31\x57\xeb\x46\x3e\x2e\x92\xf8\xdc\x7b\xeb\x7a\x70\x09\x44\x12\x7f\x04\x78\xf0\x3f\x0f\x64\xd3\xf0\x98\xf1\x3f\x0f\x44\x12\x7f\x13\xf0\xe0\x7f\x1e\x88\x24\xfe\x26\xe0\xc1\xff\x5c\x10\xd9\x66\xe8\x99\x2d\xc7\x88\xa6\xcf\x92\xf8\x9b\xa8\xbb\xd1\x9f\x07\x22\x89\xcf\x55\x3b\x57\xbd\x23\x90\x9e\xa1\x2d\xf2\x3f\x0f\x44\x21\x82\xae\x30\xfa\xf3\x40\x94\xce\x89\xaa\x1c\xfd\x79\x20\x92\xf8\x68\x7a\xf3\x3f\x0f\x44\x12\x1f\x57\xd9\xf8\x9f\x07\x22\x89\xbf\x0d\x78\xf0\x3f\x0f\x44\x12\x1f\x5d\xb8\xfc\xcf\x03\x91\xc4\xdf\x06\x3c\xf8\x9f\x0b\xa2\x2d\x0a\xae\xd9\xc5\x9e\xfc\x1a\x74\x4d\x17\xab\xfc\xf1\xa0\xe2\x75\x6a\x10\x41\x29\x7b\x00\xbd\x07\xf2\xc7\x83\xea\x5b\xe6\xba\xf8\xf1\xa0\xb4\x51\xdf\x23\xdf\x82\xe7\x60\x20\xa8\xa1\x82\x1a\x0a\x9f\x7c\x1c\xfb\x78\x8d\x14\xd4\xa6\x98\xd6\xe0\xc7\x83\xda\x54\xf6\x09\x59\x96\x5d\xcf\x97\x47\x50\x5b\x96\x83\x5d\xfc\x78\x50\xdb\x0a\x6a\x28\x57\xb2\x7a\x1e\x5e\x0a\x79\xf2\xc0\xe3\x9f\x07\xd1\x35\x5d\xea\xf2\xc7\x83\x52\xbd\x43\x1a\xa5\xf8\xf1\xa0\x54\xef\x90\xf9\x2c\x7e\x3c\x28\xd5\x3b\x7d\x32\x42\x87\xde\x6a\x0c\x41\x0d\xcc\xe5\x28\xf9\xe3\x41\xa9\x06\x0e\xba\xc2\xdf\x12\x0f\x7c\xbc\x46\x96\xa9\x2e\x7e\x3c\x28\xd5\x3b\x03\xf2\xdd\x0c\xbd\x15\x1d\x82\xda\x32\xed\x36\xf9\xe3\x41\xa9\xde\x21\x1f\x82\xf8\x71\xa1\x14\x5a\xa4\x68\xb8\x6e\x48\x82\xe8\x9a\x4e\x18\xf9\xe3\x41\x69\x9b\x1a\x6d\x30\xf1\xe3\x41\xa9\xde\xa1\x15\x23\xf1\xe3\x41\x69\xc7\x18\x9a\xfc\xe2\xc7\x83\xd2\x2a\x3e\xa2\x24\x7e\x3c\x28\xd5\x3b\x38\x43\xc8\x1f\x0f\x4a\x91\x61\x44\xba\x1e\xff\xf1\xa0\x54\xef\xe0\x3c\x21\x7f\x3c\x28\xd5\x3b\xe4\xa9\x15\x3f\x1e\x94\xea\x9d\xcd\x11\x85\x29\x78\xb1\x0a\x08\xa5\x2a\x94\x36\xb0\x87\xd3\xa6\xea\x1d\x9c\x33\xe4\x8f\x07\xa5\x7a\x67\x0b\x91\x16\x3f\x1e\x54\xcf\x72\x61\x8b\x1f\x0f\x4a\xf5\xce\x16\x22\x2d\x7e\x3c\xa8\x81\xed\xbe\xe5\x3f\x1e\xd4\xd0\x74\xdb\xc9\x1f\x0f\x4a\xf5\x0e\xce\x22\xf2\xc7\x83\x52\xc4\xda\x1e\xd1\x3a\xbe\xb7\x98\x4f\x50\xaa\x77\xb6\x69\xd5\x89\xff\x78\x50\xdb\x4a\x01\x8f\x85\xa1\x01\x3f\x2e\xd4\x96\x02\xe2\x9e\x0c\x5f\x8e\x6e\x29\xa5\xb7\x4b\xb6\xfc\xc0\xf3\x60\x12\x94\x76\x33\xd3\x4a\x26\xff\xf1\xa0\x94\xf5\xd0\xdd\x26\x97\x8e\xe7\xd7\x21\x28\x65\x3a\xe0\x64\x22\x7f\x3c\xa8\x81\x82\x42\x94\xc4\x8f\x07\x35\x54\x50\x88\x92\xf8\xf1\xa0\x46\x0a\x8a\x87\xf2\x78\xf1\x3c\x04\xb5\x69\xae\x7f\xcb\x1f\x0f\x6a\xcb\x0a\x27\x11\x3f\x1e\x94\xea\x1d\x5a\x82\x15\x3f\x2e\x94\x02\x42\x6f\x27\xfd\x79\x10\xaa\x77\x68\xad\x59\xfc\x78\x50\xaa\x77\x68\x29\x4d\xfc\x78\x50\xda\xb6\x53\x81\x18\xfe\x8c\xb2\xad\x7a\xa7\xbf\x49\x0b\x82\xde\xaa\x20\x41\x0d\xec\xd0\x2b\xdf\x98\x27\x28\xd5\x3b\xb4\x5c\x2e\x7e\x3c\xa8\x91\x1f\x2f\xe3\xcf\x28\xdb\xaa\x77\xd0\xb9\x28\x7f\x3c\xa8\x2d\x2b\xcc\x4b\xfc\x78\x50\x8a\xf0\xb4\xe0\x2f\x7e\x6c\x28\x6b\xa9\xc8\x5f\x43\xb7\xdc\xd5\xd5\x10\xee\x62\xa1\x0f\xe1\xae\x15\xfa\x10\xee\x52\xa1\x0f\x71\xcf\xf0\x70\x3d\x53\xa6\x73\x27\x90\x43\x1e\x56\xc3\xce\x66\xab\xed\x6c\xb6\xda\xce\x66\xeb\xed\x6c\x56\xcf\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xda\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xab\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xde\xce\x66\xeb\xed\x6c\xb6\xd6\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\x6b\xed\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\xb6\xd6\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\x6b\xed\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\xb6\xd6\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\x6b\xed\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\xb5\xec\x6c\x56\xcb\xce\x66\x35\xec\xec\xdb\x6c\xce\xee\xa7\xec\x83\xbf\x91\xc7\x6e\xa5\x84\x8b\xeb\x02\x06\x36\xfd\xd8\xdc\x23\x01\xfd\x7d\x3f\x4e\xc8\x99\x04\x0c\x6d\xfd\x89\x6d\xc0\xd2\x0d\x32\xe2\x8a\xe3\x56\x37\x00\xe6\x84\x85\x77\xab\xe0\xfc\xc8\xf0\x78\xb4\x19\x80\xb3\x83\xc3\x47\x14\xd0\x12\x00\xb3\x56\xe3\x71\xd2\xb5\x37\x74\xa5\xf3\xa9\x1d\x4f\x45\xa5\x79\x3a\xbd\x02\xb3\x5b\x41\xd8\x75\x47\x41\x48\xab\x1d\xbe\xf2\xae\xe0\xac\x76\x6c\x79\x7b\x3b\x14\x9c\xd7\x10\x5b\x79\x48\xdf\x67\xf9\xbd\xef\x66\xb0\x99\x85\x80\xe2\x5a\x50\x4e\xc4\xba\xcf\x4f\x04\xe5\x84\xab\xfb\xcc\x44\x50\x4e\xac\xba\xcf\x49\x66\x6c\x31\xe7\xf4\xbe\xaf\x4a\x12\x90\x13\x71\x3f\xf2\x54\x49\x82\x72\x90\xef\x7a\x8a\x30\x41\x39\x5b\x13\xb7\x3c\xd3\x82\xa0\x1c\xe4\xd1\x04\xb6\x08\x3f\x4b\xde\xe3\xc9\xb8\xb9\xaa\x52\xa2\xef\xc8\x07\x09\x77\x39\x5b\x16\xb7\x76\x2b\xba\x9e\x60\xb2\x80\xe3\x07\x41\x3b\xbb\x33\x07\x9e\x60\xb7\xa0\x1d\x1a\xf4\x69\xeb\x4e\x25\x74\x60\x7f\xa6\x1d\x2d\x33\x4b\x3e\xcc\xad\x20\x58\xaa\x7f\xe8\x06\x1d\xcf\xd8\x5d\x36\xbf\xba\x4d\xaf\xaf\x75\x68\xad\x0e\x92\xb2\xed\x4e\x13\x36\x7e\x08\xb0\xc3\x04\x7d\x4f\xbf\x32\x81\x1d\x46\x26\xb5\xbb\xb2\x64\x87\x0c\x9b\x9e\xe5\x3a\xc3\xab\x42\xcc\x0d\x27\xe4\xa2\xa4\xe0\x38\xdb\x6c\x53\x80\x56\xac\x34\xdf\x80\x6b\x7b\x08\x15\xa4\x15\x2b\xcd\x77\xdf\xda\x86\x99\x82\xb4\x62\xa5\x69\xeb\xad\x4b\x2d\x09\x69\xc5\x4a\x4b\x79\xef\x41\x5a\x3b\x80\xc8\xd6\xa3\xe8\xc0\x9e\x5f\xbb\xb1\x4b\x83\x73\x60\xc0\xe5\xa9\x00\xe3\xda\x90\xb6\x16\x18\x88\xfa\x55\x90\x7d\x2b\x54\x3a\x10\xf1\xab\x20\x07\x96\xb5\x1f\x08\xf7\x25\x48\x2f\xfe\x8c\x0f\xae\xd8\xb3\xc3\x6d\x68\x67\x5b\xf5\x68\x4d\xe1\xce\xd0\xed\xae\x29\xdd\x19\xbb\xdd\x30\x1b\x56\xc4\xa5\xa1\xc6\x67\x1b\x5d\x36\xb4\xe5\x62\x37\x94\x9e\x38\x80\x90\x0e\x68\xa3\xad\x91\xf2\x27\x00\x68\x86\xc7\x4b\x3d\xc5\x99\xb7\x25\x64\xbd\x22\x3d\x05\x84\xa2\xb6\xed\x39\x48\x01\xda\x93\xf7\x26\xd9\x78\xc3\x20\xa4\xad\x84\x8c\x7a\x9e\x1d\xab\x20\x9d\xbd\x3d\x7c\x63\x59\x10\xd2\xa2\x3f\x6d\xd1\xec\xfa\xed\xb1\xb7\x1b\x10\x9e\x23\x7f\xbb\x81\x01\x1a\x3f\x00\xd6\x6e\x15\xed\x43\x8a\x07\x15\xb0\x76\xbb\x90\x5b\xb6\xb7\x2a\x40\xad\x86\xa1\x57\x75\xe4\xf3\x95\xb3\x39\xa2\xdf\x13\x51\xd7\xce\xa6\x79\x0e\x6b\xc5\x7c\xc7\x7c\x03\xf5\xc8\x9f\x50\x0d\xd8\xd8\xf4\x28\xf4\x68\x19\x26\x20\x43\x9c\xc8\xef\x78\x34\x90\x9c\x15\x10\x23\x4e\xf0\x37\xed\x34\x20\xee\x0a\x49\x12\x27\xfe\x9b\xd4\xd1\x5e\x3f\x3c\xdc\xdd\x7d\x14\x71\x5f\xfa\x3f\x83\x38\x3b\x5b\x29\xe2\x58\xed\xf7\x1b\xf6\xab\xa0\x59\x4d\xe8\x92\xb1\x99\x35\x4d\x49\xef\x41\x2f\xc4\x43\x12\xda\xde\x81\xd5\xab\x10\xd8\x0a\xdc\xde\x81\x15\x77\x2b\xc8\x2d\xc1\xad\x1d\x58\xe4\xae\x09\x12\x5c\x82\xdb\x5b\xb0\xaa\x68\xee\x48\x33\xa5\xf8\xf6\x06\x15\xa0\xf1\x03\x60\x03\xaa\x72\xb7\x0a\x85\x80\xc2\xbc\xd5\xad\x80\x0d\xa8\xcd\x6e\xb7\xdc\x59\xfb\xe7\x86\x72\xb2\x73\x07\xca\x9c\xcd\x2d\x49\x2e\x54\x6c\x13\xc8\xde\xb5\xc7\x57\x77\xbd\x8e\x15\x40\x71\x2d\x28\x8b\x2c\x7e\xaf\x0b\x28\x8b\x20\xbe\x42\x27\xa0\x06\xab\x77\x13\xde\x25\x79\xa6\xa4\x26\x71\x31\x3a\x86\x6d\x0f\x18\x87\xb1\x50\x1f\xf6\x3c\x8f\x21\x87\xb2\xb7\x0c\x6c\x79\x26\x28\x87\xb2\x50\x27\xf1\x60\xcf\x64\x1c\xca\xde\x2e\xe0\x19\xa0\x77\x6c\x9a\x2e\xef\xfc\x73\x4d\xaa\x8e\x15\xe1\xf0\xfe\x09\x12\x0e\xd9\x08\xca\xde\xaf\x87\xde\xca\xad\xa1\x37\x8d\x9a\xa0\x96\x2a\x16\x77\x7d\x91\x64\x02\x5b\xda\xd8\xf6\xd0\xef\x60\x03\xd6\xd2\xc7\x02\x42\xd4\x84\xb5\x34\xb2\xe1\xd0\xef\x6c\x82\x5d\x2c\xf3\xc5\x4c\x51\x6b\xb0\x29\x45\x68\x5c\x09\x1b\x5b\x1e\xec\x7e\xb7\xa2\x71\x1c\x58\x3b\xeb\x69\xd8\xc5\x15\xad\xe3\xc0\x7a\x45\x65\x53\x6c\xfc\x09\x36\x8f\x03\xab\x39\xa2\xcf\xd7\x5c\x83\xad\x73\xa6\x4b\x9a\xd6\xc9\xbf\x6d\xaf\x20\x09\x60\x77\x42\x21\x29\xd8\x1d\x54\xe0\x5c\x2c\xf0\x3c\x66\x2f\xe0\x83\xef\x6e\xf2\xc1\xdd\x0d\x6c\x9b\x3d\xe5\x81\x0d\x40\xf3\x3d\x6c\xc6\xbe\xca\x6d\xf2\x89\x7a\x06\xf8\x5d\x3a\x9d\x3b\x46\x12\x9f\x48\x3c\x25\xf6\x2e\x9d\x97\x57\x39\x4b\xee\x2c\x67\x9e\x30\x00\x6d\xc0\xa2\xbc\xcf\xb3\xc2\x3f\x67\xa6\x67\xaf\xcc\x29\xc0\xb8\x36\xa4\x7f\xda\x8c\xa3\x08\x2b\xc8\xc0\x81\x33\xf6\x6a\x83\x82\x0c\x9c\x39\x63\xfb\x69\xef\xb2\xab\xab\xa4\x48\xe7\xfe\x81\x38\x76\x89\xf3\xe4\x7d\xf2\xe7\xcc\xdf\x71\xd4\xf3\xd5\x56\x03\x34\x7e\x00\xac\xb3\xc7\x67\xd3\x5b\x94\x32\x60\x9d\xcd\x3e\xbe\x41\x65\xc0\x3a\x24\x88\xdd\x75\x72\x00\xbd\xb7\x4f\x04\xb0\x8d\x4d\xfc\xee\x9f\x1a\x60\xc3\x80\x41\x83\x87\xe2\xaa\xd6\xf6\xa5\x2b\xd8\x9e\xff\x68\x53\xf7\x34\x4f\x2e\xad\x15\x6e\xf4\x2c\xdb\xae\x6c\x05\xa7\x2d\x76\xb9\x0f\x7d\xd4\x0b\x02\xf6\xcc\x55\x2a\x7e\xb2\xcd\x56\x10\xd0\xb2\xd7\x03\xf3\xb8\x02\xf4\xb7\xeb\xda\x5e\x98\xc0\x96\xf3\x91\x1b\x69\x15\xda\x6e\x5e\x01\x64\x9b\x0d\x88\x5c\x00\xc8\xee\xf6\x7e\x3f\x08\x64\x9b\x40\xdd\x20\x8c\xe1\x8b\x25\xa2\x6e\x57\x41\xc5\xf5\xc0\x2c\xec\x47\x83\x2a\x30\xfb\x58\xa1\x61\x15\x98\x7d\xb2\xd0\xa6\x07\x66\x4c\xb8\x7c\x8f\x1a\x9f\x94\x06\x3e\x94\x4d\xfa\x7e\xec\xc9\x33\x67\x96\x25\xe2\xd3\xda\x91\x2d\x49\xed\x09\xb6\xa7\xc2\x37\x9c\x45\x3b\x67\x6e\x45\xf4\xf9\xec\x63\x29\x36\x8b\x64\xc6\xc2\xbe\x0e\x6e\xb1\x75\x3d\x60\xd3\xa4\xe7\xeb\x1d\x74\x38\x52\x2f\x08\x18\x5b\x1c\x4e\x0d\xb7\xa7\x1c\x05\xd9\x5b\xe7\x26\x50\x90\x7d\xcb\xd5\xc8\xad\xd5\x30\xe4\xc0\x88\x0f\xe2\xb6\xaa\x07\xe7\x4e\x76\xf1\xe6\x30\xec\x69\xb2\x80\xf5\x0a\xf3\x66\xd8\x83\x65\x41\x6b\x79\x30\xa8\x51\x76\xdf\x8c\x64\x08\x79\xb2\x2c\xe8\x81\x15\xeb\x13\xf0\x66\x21\xb4\x3b\x47\xd3\xba\x25\x0f\xc0\x19\x6c\x56\x02\x3b\xfc\xda\xf5\x02\x00\x2c\x68\x87\x6d\x89\x27\xaa\x11\x71\xb8\x77\x10\xe4\x4b\x05\xed\x31\xb1\xed\xee\x59\x24\x8b\xe4\x3e\x81\xf9\x65\x11\x38\xd4\xd0\x86\x64\xc9\xd5\xed\x62\x79\x7d\x6d\xef\x72\xdd\xf2\xfc\x42\x0a\x30\xae\x0d\xe9\x4c\x99\x7e\x24\x84\x82\x74\x26\xcc\xa1\xe7\x41\x52\x90\xce\x26\xd9\x6d\xcf\x85\xb4\x60\xf9\xd2\x93\xc3\x76\x60\x88\xe7\x30\xe3\x7e\x61\x0f\xc6\x39\x0d\x27\xf6\x16\x06\x02\x6e\xb2\x6d\x2f\x68\x22\xe0\x21\x1b\x7a\x71\x2f\xbe\x73\x8c\x5a\x66\xc3\xcc\x96\x4a\x01\x24\x6e\x1a\xd1\xe6\xe7\xd8\x85\x71\xd0\xde\xf4\x87\x22\x00\x39\x87\xf7\xf8\x8a\x32\x02\x39\xfb\x7d\x7d\x1b\x0b\x81\x9c\xbd\xbe\x3d\x7f\xbc\x65\x1f\xa6\xf6\xd9\x53\xdc\x43\x35\xf0\x14\x10\xdb\xa0\xc1\xe6\xd1\x72\xfb\x20\x00\x14\x9b\x67\x7b\x04\xf6\x76\x3b\x26\x0c\x92\x7c\x10\x68\xa4\x6d\xbb\xf4\xc4\xae\x77\xb7\x95\x96\xd1\x42\xf6\xa3\xef\xc6\x76\xe6\x6c\x77\x62\x77\xa5\x47\xe0\xbb\xeb\x2c\x70\xbf\xbb\x6e\x02\xf7\xfb\x60\xd5\x39\x2e\xa0\x6d\xdf\x5b\xc7\x14\x8a\x53\x59\xbc\xc5\x43\x05\xe8\x6f\x1f\x77\x7c\xca\x0a\xd2\xdf\x3f\xee\x38\x74\x14\xa4\xbf\x81\xdc\x39\x91\x45\x41\xfa\x3b\xc8\x9d\x58\xcc\x3c\xbb\x4f\x4c\xc7\xdd\x48\x4d\xfa\xbd\x30\x5c\x6c\x18\x6f\xfc\xec\xbb\x61\x18\x50\x36\x67\xb4\x29\x16\xe9\x1d\xa6\x51\x80\x2a\xd2\x79\x4b\x18\xff\x15\x18\xaa\x58\xf3\x6d\xae\x7d\x39\x9c\x53\x24\xd3\xe9\x8c\x59\x9d\x13\x3e\x7e\xce\x71\x8c\xab\xd5\x27\x5b\xb5\x0a\xf9\xc4\x07\x5d\x8f\x7e\x21\x77\x38\xce\x68\xb6\x6b\x26\xe4\x08\xc7\x59\x72\x2b\x50\xa5\x3d\x27\x61\x70\xf8\xa6\x0d\x34\x9f\x5a\x2c\xd8\xc3\x01\x49\x0e\x68\xdb\x4b\xe5\x18\xfe\x83\x91\x54\x55\x36\x43\x50\xb1\xa1\xd1\x08\x75\x6a\x3b\x04\x27\x5b\xba\xa9\xce\xc0\xb2\x63\x26\xdd\x43\x83\x46\x9b\x4a\x95\x0a\xc2\x0d\xd6\xa2\x57\xdc\xb2\xd9\xcc\x5e\xb7\xf6\x3d\x44\x12\x2e\xae\x0b\xe8\x2c\x90\x6d\x7b\x8e\x6a\x09\xe8\x2c\x8d\x6d\x7a\x6b\xbf\x12\xd0\x5f\xdd\x77\x96\xdc\x8a\x94\xcd\xe7\x89\x29\x96\xd1\x7d\x65\xaf\xbf\x73\x18\x5f\x41\xb2\xf5\x23\x0e\xe5\x2b\x46\xf6\x6a\x09\x87\xf2\x15\x22\x87\x9f\x08\xca\x53\x84\x1c\xaa\x55\xad\xa3\xd8\x16\xad\xbb\x84\x62\xac\xb7\x84\xe0\xd4\xb4\x42\xf3\x00\x69\x0f\xc1\x6a\xf5\x09\x9a\x5b\x22\xf8\xd4\x11\x11\xce\x9a\x09\x2d\xc7\xc5\xfe\x0c\xea\x79\xb7\x10\xb5\x6d\x7f\x3a\x56\x70\xb1\x15\xb7\x14\xfb\x87\x00\x29\xc8\x9e\xb5\xf6\xdb\xf5\x97\xd2\x15\x64\xdf\xb4\xb8\xb7\xb6\xab\x2b\x1f\x18\x3b\x2a\x46\xdd\x70\x63\xac\xd5\x9c\x9e\x14\x64\xb6\x79\xe3\x9f\x8b\xb5\xbd\x15\x5e\xd9\xf2\xcf\xc4\xda\x1a\x86\x97\xb5\xfc\xf3\xb0\xc8\xb9\x19\x70\xc7\x7a\x67\x61\xf1\x4e\x0c\xac\xae\xf8\x0b\x4e\x55\x0d\x9a\xbb\xeb\x2f\x7e\x88\x0c\xc2\xc4\x75\x80\xfc\xd0\x0e\xc7\x28\x46\x20\x3f\xa4\xc3\x31\x89\x11\x28\x10\xca\x61\x5b\x1e\x15\xde\xd2\xa1\x17\xa7\x6f\x00\xc6\xb5\x21\xdd\xed\x72\xce\x41\x59\x06\xa4\xbb\x6d\xce\xd9\xc3\x61\x40\xba\xdb\xe7\xec\x7d\x3f\xee\x9a\xdf\xa6\xda\x50\x60\xab\x2d\xde\x6a\xdf\xf6\xb6\x88\x8b\x76\xf9\xcf\x5d\xe7\xe3\x67\xbc\x07\xe4\x82\xbb\xc2\x47\xbe\xc1\x81\xaf\x72\x7a\x6b\x7b\x14\xbf\xd2\xf5\x47\x53\xa9\xa3\x4c\x44\x3c\xa1\x17\x34\x56\x26\x73\xdf\xc7\xb5\xe9\x96\xe2\x7b\xb8\x6c\x5b\xad\x4c\xe6\xfd\x35\x76\x55\x99\xcc\x3d\xef\x96\xad\x5c\xe2\x65\xda\xe5\x4c\x9f\x04\x3c\x92\x47\x94\xd9\xa7\xc1\x0b\x30\xc7\x3d\xeb\xfb\x14\x04\x9c\xe3\x99\xf6\x35\x36\x01\xe7\xf8\xa5\xfd\x65\x55\x01\xe7\xb8\x64\xfd\x11\x5f\x66\x77\x49\x99\x99\xd8\x6d\x6f\xbb\x53\x1d\x87\x89\xeb\x00\xd9\x61\x92\x3d\x77\x3a\xe4\x40\x16\xf2\xc8\x36\xf6\x6c\xc8\x81\xec\x00\xc9\x81\x3b\x1b\xba\xae\x9d\x91\x8a\xb2\xee\x86\xe1\xcc\x51\x1c\x38\x22\xdb\xf3\xe7\x74\xc3\x07\x64\x7b\x9e\x9c\x6e\xf8\x78\x6c\xcf\x87\xd3\x0d\x9f\x8e\x6d\x9d\x12\xa8\x55\x57\xa7\x56\xcf\xc7\x83\x02\x9c\x7c\x78\xb6\x58\x0e\xbb\x77\x68\xe9\xdd\x16\xbb\x61\xcf\x0e\xed\x62\x19\x84\x01\x6d\x8f\xaa\xbf\xd9\x2a\xec\xcf\xc1\x16\xdb\x7b\xfb\x3e\xdc\xb2\xa4\xb4\x16\x7f\x7a\xfe\xba\x2d\x01\x39\xe1\x56\xb1\xb7\x3b\x83\xa0\x9c\x11\x43\xbb\x4f\x06\x1e\x94\x33\x5e\x46\x1e\x41\x08\xca\x19\x2d\x23\x4f\x2e\x07\xce\x81\xf3\x59\x89\x80\x8a\xbb\xec\x27\xb6\x2e\x60\xbd\x22\x8e\xa1\xeb\xc3\xc4\x75\x80\xfc\xc8\x85\x00\x90\x1f\xb2\x10\x00\xf2\x63\x15\x02\x40\xb6\xb3\xd8\x5c\xe4\xd0\xd7\x0d\x5e\xe3\xc5\x27\xfc\xa9\x83\xcb\x7e\x25\xdb\x9f\x2f\xef\xd6\xde\xbe\x88\x37\xdc\x8b\x8b\x66\xcd\x0b\x54\xaf\xe5\x9d\x88\xdf\x25\x79\xb1\xf2\x76\x52\x75\xb7\xab\xba\x44\xf1\xdf\x37\xe9\x36\xf9\xaf\x5b\x3f\x36\x9b\xe7\x7f\xfc\xb1\x75\xf1\xb8\xf5\x63\xeb\xc9\x4d\xea\x5c\xab\x8c\x37\x7e\x87\x2e\xcd\x7c\x9f\xa5\xd3\xa8\x1b\x3d\xc3\xeb\xbb\xcf\xb3\x8b\x16\x08\x58\xb0\xff\x1a\x6f\xe7\x3f\x81\xda\x31\xc7\x2b\x30\xd3\xe4\x92\xa6\x83\xe8\x71\x94\xf9\xf7\x29\x23\xba\x98\xd1\xbf\x0a\x33\xe7\x77\x3a\x5a\x6d\xeb\x48\x9c\x8a\x96\xb8\xc3\xb4\xe2\xf3\x79\x7e\xd1\x2c\xe4\x0d\xb9\x54\x9a\x8b\x19\x2b\xae\x92\x05\x53\x8d\xe4\x08\xe6\xc1\x2b\x83\x0b\xe3\x96\xec\x0a\xa2\xeb\x9a\xad\x1b\x6d\xd8\xfc\x2a\x9b\xb2\xb7\x27\x87\x3b\xfa\xb1\xed\x7f\xdd\xcb\xee\x16\xd9\x9c\xcd\xcb\x9d\x40\x9a\x01\x4f\x28\xbf\x3c\x7b\x75\xb4\x13\xad\xb8\x6a\x5c\x5e\x3b\xfa\x73\xe3\x69\x03\x9a\xf5\xe5\xac\xdc\xc5\xdb\x7b\xbe\xa1\x97\x1b\xfe\xf2\x25\xbd\x24\x77\x0b\x7c\xfb\xaa\xf1\x15\xbe\x81\x5c\xe6\x1f\xbf\xa2\x8f\xbf\xeb\x6f\xef\x36\x3e\x05\x09\x62\xb0\xcf\xf9\xd3\x6f\xbe\xfc\xb1\xf1\xe3\x57\x17\x78\x13\xe7\x0a\xa4\x8c\xdc\xe5\x39\xbb\x70\x2e\xda\x76\x6e\x35\x15\xf4\xbd\x61\xe5\xf8\xea\x8a\x2d\xca\xa3\x64\x7e\xb3\x4c\x6e\x58\xb1\xe2\xa2\xd7\x20\x7c\xe7\x0a\xfa\xfc\x8e\x9d\x2e\x17\x8b\x2c\x2f\xd9\xb4\x89\x37\x12\xf3\xb4\x4e\x1a\x6f\xcd\x03\x59\xf8\x8d\xc6\x05\x2b\xcf\xd2\x3b\x96\x2d\x4b\xe3\x52\x77\xb7\x4d\xac\x79\x3e\x4f\xde\xa7\x37\x30\x2b\xe7\x9d\x99\x28\x40\x93\x66\x83\x2e\x27\x7d\xd7\x68\x5d\x18\xed\x43\x89\xb1\xba\x8d\x2e\xce\x56\x9b\x03\x63\xf9\x43\x3a\x9f\x66\x1f\x44\x2e\xbc\x20\xdd\x68\x9f\x59\x13\xdd\xc2\xfb\x87\x25\xcb\xef\xab\xa9\x68\x5f\x95\xfb\xbc\xd1\x32\x2e\x5c\x17\x17\xcb\xc7\xe6\xad\xe8\xea\xaa\xe1\x52\xc8\xb3\x9c\x83\x2e\x66\x69\xd9\x04\x26\xc3\x8b\xa2\xf8\x85\xc2\x69\xf4\x34\xca\xd5\x6d\xd6\x29\xde\x66\xed\x73\x2d\x5e\x58\x9c\x9f\xa7\x17\xb2\x80\x67\x0d\xe7\x8a\xdc\xf2\x7c\xca\xdc\xf1\xd1\xcc\xf0\xd2\x6e\xbc\x72\x38\xf8\x2d\xbe\x58\x71\xd1\x70\xe9\x74\xc5\xdb\x93\xa3\xb5\x32\x58\x83\xae\xe2\xae\x7c\x39\x2f\x81\x81\x04\x24\x67\x2a\xe6\xd7\xf6\x5b\x75\xb6\xa8\xce\x4f\x11\xf5\x98\x35\x5f\xcd\x60\xdc\xaf\xbb\x44\x5a\x5d\xf7\xfb\x94\x6e\x4c\x2f\x11\xfd\xe8\x1b\xe8\xdf\xe7\xf0\xe7\x34\x05\x2f\x51\xaf\x33\xeb\xa8\x99\x0b\x78\x71\x7a\x8a\x8c\xd6\x84\x6a\x1b\xdd\x86\x3b\x22\xbe\x27\x4d\x61\x01\xa3\x68\xcd\xcd\xce\xd1\x53\x60\x2e\x75\x65\x72\xa3\xb1\xeb\xdd\x13\x8e\xfa\xbe\x5d\xa4\xc3\xbd\x65\xe7\x83\xae\xed\x97\x5f\x9c\x84\x67\x30\x2d\xa8\xff\x35\x70\x42\x01\x22\x98\x10\x92\xa5\x5b\x56\x6a\xf4\xf8\x99\xf5\xee\x5d\xfc\x6d\x15\x21\x46\x16\xe8\x8e\xf6\xa4\x9e\xcc\x92\xfc\xae\xd6\x65\xe3\x25\x62\x3e\x64\x60\xc6\x64\x62\x56\x84\x26\x03\x85\xaf\x7e\x82\xf1\xea\x55\x5e\x2d\xd1\x24\xd5\x6a\x89\x3f\x99\x01\x47\x78\xa3\xa0\xbe\x6d\x44\xa8\x07\xdc\x2f\xf0\x5a\x7b\x86\x97\x2f\x23\xab\xd0\xed\xd2\xbb\x5e\x56\xcc\x96\x02\x4c\x83\x4f\xbd\x08\xda\x80\xd9\x47\x5e\x63\xfd\x21\xc9\xe7\xcd\xc6\x2c\xbd\x94\x84\xd8\x89\x4a\x8e\x52\xc4\x3e\x2e\x52\xae\xf3\xe3\x94\x1d\x3d\x89\x62\xd6\xc7\x2b\xdb\x0b\x2a\xa7\xa5\xcb\x98\x65\x37\xcd\x0c\x6f\xcd\x86\xaa\xd4\x75\xcf\x86\x28\xce\x85\x7c\xaa\x37\x6f\xad\xa6\x84\xd9\xb9\x24\x35\xaf\x66\x2c\xc9\x25\x15\x4b\x03\x0b\x78\x64\x9d\x64\xb1\x98\xdd\x37\xf1\xb5\x1d\x25\xf9\xcd\xf2\x0e\xa4\x55\xd1\xf2\x4a\xfd\x64\xa3\x1c\x9c\x8d\x57\xd0\x3e\x95\x6a\x22\xe5\x6e\xba\xe3\x8c\x38\xa4\xba\xfd\xc4\x0d\x42\xa6\x43\x81\xc0\xd5\x51\x0f\x0a\xed\x69\x2c\x4a\x98\x4c\x6c\x52\x90\x92\x45\x7a\xea\x3e\xde\x43\x6e\x5c\x90\x7e\x85\x77\xa0\x07\x28\xcc\x6f\x48\x2f\x10\x15\x29\xf7\x7f\x9c\x37\x42\x42\x5b\xcd\x36\xa9\x98\x6d\x68\xbe\xd8\x85\x7f\x40\x48\xa9\xb9\x25\xc3\xb9\x25\x3d\xcf\xa2\x8d\x28\xbf\x10\xda\xa5\x9e\x94\xff\xf8\x63\xf1\x75\x52\xe2\xcd\xe1\x74\x71\xb8\x37\x36\x52\x93\x44\xc5\x1d\xc8\xa8\x83\x59\x96\xe5\x93\xf4\x7d\x3a\x65\xb5\xc6\x22\x03\x86\x2c\x39\x5f\xbd\x4a\xca\xdb\xce\x15\x4b\x67\xa0\x7e\xfa\x35\x21\x82\x80\x79\xcc\x36\x06\xa2\xbb\x08\xfe\x1a\xeb\x83\x0c\x96\xa6\x99\x80\xd8\xbf\x3b\x9c\x97\x75\xc4\xac\x51\x0a\x3d\xf2\xcc\xc0\xb4\x5f\xe3\x1d\xf7\x1b\xd4\x8f\x30\x8b\xe3\x7d\xf0\xba\x8e\x57\xac\x28\x40\xfb\x78\x95\xcc\xe1\x37\xaf\xe6\x09\x92\xa7\x52\xd7\x29\xde\x89\xdb\xed\x17\xcd\x15\x43\xc8\x57\x1a\x95\x66\x64\x28\xd6\xed\x68\x23\x26\x0e\xb6\x2b\xe8\xc0\x84\xc7\x3e\x1e\x5f\x37\x1b\x6c\xce\xd5\x11\x17\x60\x39\x2f\x6e\xd3\xeb\x92\x03\xb4\xf9\xe7\x3b\xde\x1a\x52\xc6\x3f\x55\x35\xb2\xb3\xc8\xb3\x32\xc3\x11\xd3\x49\xa6\xd3\x57\x3a\x4b\x45\x5b\x0c\x65\x07\x2c\x93\xa0\xda\x4d\xfd\x7f\x5e\x3a\xd6\x0c\xd4\x84\xed\xbe\xc5\x88\xa3\x1c\xef\xa4\xb7\x70\x04\x70\x64\x63\xf9\x6e\x5c\x6f\xff\x7b\x6e\x9a\x81\x55\x56\xfc\xf8\x7b\xb4\xcb\x7e\xef\xd8\x65\x60\x94\xa5\x2b\x04\x16\x62\x62\x55\x7d\x9e\x76\xca\xec\x08\xaf\x89\xda\x4b\x0a\xd6\x6c\x5d\x74\x40\x52\x96\x20\x79\x1c\x75\x1c\x58\x71\x15\x8a\x21\x6d\xbd\x92\xb4\xd7\xd0\x81\xe3\xf9\xf4\x28\x4b\x2a\x48\xec\x30\xb1\xfa\x92\xfb\x9c\x84\x52\x88\x4f\x70\xbc\xcb\x49\x53\x37\xdf\x11\x13\x2e\x07\x00\x72\x4e\xdf\x41\x07\x6c\x47\x85\x29\x4e\xbe\x70\x27\x2f\x97\xa3\x80\x28\x20\xac\xb0\x34\xac\xec\xfc\xa2\x4d\x95\xe0\xbf\xf3\x4a\xfd\x4c\x8f\x0d\xa3\xa1\x4d\x4a\x11\x1d\x7a\xc2\xae\x59\x0e\xe6\x1d\x9a\x18\x6d\x9a\xa6\xf2\xce\x25\x10\x87\x80\xda\xd1\xa3\xae\x97\x02\x83\x54\xa3\xad\xbf\x18\xa2\x64\x6e\x8a\xf4\xca\x2e\x98\xad\xa2\xbd\xa3\xf7\x49\x9a\xa0\x04\xff\xe7\x57\x47\x2f\xcb\x72\x71\xc2\xfe\xb2\x64\x45\xb9\x6b\xd8\x5a\xd9\x1c\xcb\x64\xf3\xe9\x4a\x7a\xf4\xba\xe8\x18\xc0\xde\x29\x93\x72\x89\x8c\xcf\x09\x62\x8c\xb6\xe6\x3f\x9e\x1e\xbf\xe6\xa6\x49\x33\x05\x4a\x15\xa0\xb7\x17\xec\x8c\x7d\x2c\x5b\x38\x96\x9b\x2d\xec\xc2\x1c\x87\x7d\xde\x94\xe5\x84\x89\x82\x14\xc8\x16\x0c\x74\x86\x6f\xf7\xcf\x1a\xa8\x4a\x61\x4a\x01\x38\xae\xa0\x91\xd7\x35\x0f\x74\x9e\xc0\x10\xfd\x71\xfa\xb8\xe5\xd8\xbe\x0e\x49\x2d\xd3\x37\x07\x01\x1c\x5f\x04\x1d\x0a\x95\xfd\x17\xc2\x72\x7d\x53\x56\x16\x09\x93\x7f\x2d\x4e\xd8\x75\xf4\x6d\x10\x7c\x96\x64\x68\xe9\x01\xa4\x84\x05\xbb\x30\xfc\x2e\x96\xc1\x42\x36\x29\x29\x47\x98\xcb\xb1\xc3\x05\xae\x30\xea\xa1\xe7\x1e\xa5\x4a\xab\xb7\x15\x42\xe9\xbe\x11\xb5\x71\x1d\x10\xfb\xda\x70\x44\xe5\xa4\xac\xec\x98\xde\x1c\xa5\x99\x21\x0f\x42\x2b\x80\x93\x80\x46\xa0\x29\x8d\xf3\x3c\xb9\xe7\x7a\x3f\x8e\xf1\xf2\x42\x4e\x21\xfe\xa8\x4d\x91\x1d\x90\x1f\xd3\x1a\x7d\x06\x4f\x90\xa7\x38\x84\xc6\x8d\x4b\xd0\xcf\x2e\x97\x65\xcd\xb9\x05\x67\xd4\xbf\xa0\x99\x7e\xca\x66\xec\xaa\xcc\xf2\xf1\x6c\xd6\x6c\x9c\x23\x99\x2e\x70\x82\xcb\xb9\x41\x9d\x5b\x4a\x4f\x8e\x4a\x0f\xe1\x1d\xaa\xb8\x09\x7c\x77\xd1\xfa\x4c\xac\xd7\xe8\x87\x84\x2f\x7a\x31\x54\x65\x0d\x44\xd5\x54\xad\x90\x0f\x4a\x4f\x58\x7a\xaa\xa3\x54\x06\x0d\x91\x50\xda\x1a\xb1\x52\x22\xf3\xd0\xac\xc7\x55\x4f\xc9\x2e\x0c\xd5\xcf\x66\x63\x2f\x99\x7f\x55\x46\x54\x1a\x67\x95\x4e\x99\xdc\xe0\xd5\xd3\x68\x30\xfc\x8e\xa7\x00\xe3\x3c\x96\x66\x08\x2a\xea\xf9\x17\xd5\x2a\xb8\xea\x28\x72\x4f\x96\xab\x6c\xa2\x9c\xcf\x1f\x48\x7b\xdf\xfa\xc9\x2c\x4f\xcb\x33\xe1\x69\x11\xf0\xf0\x9b\x69\x7f\x0b\xb2\xa4\x0d\xfe\x7b\x03\x7c\x8d\xed\xb2\x46\x01\x03\x7d\xe0\xed\x62\x21\xf5\x01\xdf\xfc\x68\x3a\x7d\x6b\xa0\x85\x0a\x24\x14\x81\x26\x58\xab\xb5\x1b\xa4\x42\x61\x18\xe0\x60\x88\xf9\x40\x98\xff\x19\x37\x03\xa1\x63\x40\xea\xef\x71\x7d\x04\x4d\x50\x32\x1d\x0b\xb3\xf2\xd4\x9a\xc8\xed\xbe\x31\x54\x91\x37\xb9\x1c\xb3\x41\x6d\xd6\x11\xea\x84\x5f\x01\xc3\x0c\x20\x91\x97\xdb\x56\xca\xf1\x65\xc1\xf2\xf7\x2c\x7f\x27\x5b\x92\xcd\x4f\xf9\x97\xbd\x5b\x8c\x95\x7f\x67\xcd\x40\x04\x91\x16\x63\xa8\xea\x3d\xc3\x2c\x8f\x62\x91\x98\x60\x52\x02\x6d\x90\x50\x65\xce\xdd\x0b\x8f\xe2\xdd\xb0\x35\xdf\x78\x62\x78\x33\xf2\x0e\xcc\x63\xa2\xf3\x9f\x40\xe7\xa3\xbc\xca\xd1\xc9\x80\x6f\x6d\x39\xf0\xd9\x75\xfa\x91\xf3\x9d\x4a\x38\x61\x57\x59\x3e\x25\x3d\x1d\x0d\x26\xde\x19\xb3\xec\x32\x99\xc9\x96\xd1\x37\xd4\x6d\xe8\xdb\xd5\x6d\x3a\x9b\x1e\x24\x28\x76\x52\x66\x67\xa3\x4f\x47\x69\x51\x8a\xe4\x15\x04\x37\x24\xca\x64\xff\x60\xfc\xf6\xe8\xec\xdd\x77\xe3\xa3\xb7\xfb\xc2\x37\xa1\x17\x3c\x9a\x0d\xf1\x9d\x3c\x85\x81\x82\x38\xfe\xeb\x3b\x10\x5d\x0c\x46\xef\x4d\xd9\x75\xb2\x9c\x95\xdf\x25\xb3\x25\x26\x97\xb2\x09\xcb\x1c\x4a\xd7\xc9\x98\x66\x21\x28\xe0\x32\x49\x1b\x22\xcd\xaa\x86\x72\xfc\x56\xb6\xb7\x36\x75\x6a\xd6\x00\xda\x93\xec\xbb\x35\x66\x9a\x6a\x46\x67\xb1\x2c\x6e\xad\x15\xa3\x1a\xf5\xe4\xec\x2e\x7b\xcf\xd6\x57\x65\x79\xda\x74\x95\xd2\x6e\x63\xc6\xb0\x2f\xa3\x6f\x60\xee\x52\x46\x9c\x06\x46\xbb\x1f\xe4\x12\xf4\x53\xfc\x30\x24\x5d\x3d\x26\xa0\xae\x05\x7a\xfe\x59\xa8\xef\x41\x06\x3d\xf9\x63\x93\xfb\x52\x7e\x01\xde\xbc\x64\x79\xeb\xf7\x4f\x40\x2a\x15\x65\x53\xb8\x55\x3c\xde\x6a\x49\x63\xce\x62\x38\x98\x49\xb2\xcb\x3f\xc3\xdc\x6d\xba\x64\x7c\xb0\xe7\xe6\x4c\x47\x8f\xbc\xf2\xf4\xfa\xbe\xe9\xd7\xa4\x8c\x32\xa7\x2a\xaf\x79\xf5\xc6\xe5\x94\x49\x91\x54\x49\x3f\x9c\xb6\x1f\xd9\x22\xad\xe5\xfa\x77\x9a\x8d\xd7\x59\x19\xc9\xa2\xa6\xe6\xac\x5f\x2d\x0c\x85\x6c\x75\x38\xac\x19\x94\xbb\xad\x7a\xcd\xa9\xd5\x98\xb5\x6d\x19\xcf\x40\x32\x4d\xef\xeb\xb6\xa7\xeb\xb4\xc7\x18\x99\xbf\xaa\x31\x88\xc4\xa9\x9a\x91\xaa\x34\x46\x99\x5c\x7a\x76\x17\x59\x5d\x1b\x1b\x64\x36\xd1\x92\x80\x39\xb5\x3b\xf6\x2f\x69\x93\xdc\x5b\x75\x4c\x3c\xdb\xf9\x89\xdd\x0b\xc3\xd5\x9c\x40\x5a\x35\xbd\x3c\xe6\x54\x24\x1d\x4c\xae\xa9\xe6\x12\x95\xcf\x85\xb8\x74\x61\xf8\x6d\x1b\x9a\x42\x45\x84\x14\x11\xfa\x99\x2e\xbf\xe5\x90\x1f\x64\xc1\x61\xc9\xee\x48\x51\xd7\x88\xa6\x55\x0b\x4d\x46\x49\x52\x8d\x0e\x6b\x7a\x05\x2d\x44\x57\x69\x7a\xe8\x12\x48\xcf\x0b\x98\x40\x13\xd4\x5a\xa4\x7e\x94\xb5\xda\x41\x5d\x6c\x06\x40\x89\x32\xa3\xcc\x39\x15\xda\xef\x08\x81\x79\x2b\x7a\xf4\x2c\x0a\x49\x06\xb3\x67\xce\x93\x0b\x6b\xfc\x07\xd4\xac\xb5\x79\xd0\xe0\x6f\x03\x6e\xa8\x54\x82\x29\x21\x09\x7b\x3f\xbf\xda\x93\x18\x36\x13\x32\x7f\x56\xa8\xc6\xc4\x76\x9a\xe9\x8c\x35\x04\x16\x36\xd9\x5b\x75\x45\xd5\x75\x3a\x67\x1a\xa2\x8e\xe1\x6a\x75\xb0\x6a\x35\x33\x14\xf1\x4c\x8a\xee\x2b\x52\xe4\x26\x5c\xaa\x0a\xed\x62\xa7\xb2\x00\xe1\x1a\xa9\x9e\x9c\x78\x09\x6d\x4e\x88\xcc\x92\x09\xf9\x67\x36\xb8\xae\xdd\x08\x56\x61\x09\x56\xa1\x5a\x93\x8a\x4a\x65\x15\xba\x45\x36\xd1\x37\x78\xde\x05\xae\xa5\x87\x58\x3e\xf4\x2e\x1e\x84\x23\x71\x07\x40\xac\x57\xd0\x7c\x04\xda\x5a\xeb\xcc\xe6\x8a\xcd\x3c\xb5\x1a\x3d\x39\xad\xb0\x76\xca\xbb\xa3\xf4\xf5\x53\xfe\xa1\xae\x8a\x6a\x74\xd1\x6a\xff\x0f\x77\x81\x40\xb7\x36\x24\x4c\x03\x07\xa8\x5c\x73\x09\x4c\x28\x87\xf3\xf7\xc9\x0c\xcc\x4b\x98\xe3\x71\x67\x3f\xd7\xbc\x0b\xd7\x36\x66\x4a\x23\x72\x55\x73\xa9\xb8\x11\x22\x6b\x4c\x4f\x9a\xae\x79\xd4\x8c\x2f\xbd\x03\xb8\x49\x4f\xca\x42\x91\x46\x2c\xa8\xb9\x6b\xee\xde\x28\xc8\x2f\xec\xa9\x4e\x39\x17\xd6\x19\x62\x06\xd1\xe7\x59\x09\xd2\x6c\x3c\x9b\x55\xce\xd8\xaa\xb9\x2c\xdc\x24\xa1\xf8\x53\x39\x82\x6b\xd6\xa8\xb8\x6e\xed\x22\x57\x4d\xd5\xb6\x52\x92\x70\x3d\xe9\x01\x14\x66\xa1\x08\x0a\x32\xfc\xc8\x50\xb6\x83\x26\xc2\x7c\x61\x05\x52\x04\x41\xce\xd3\x0b\xee\x89\x74\xea\x32\x8b\x36\x34\x70\xbb\x40\xfd\x01\x8b\x01\x83\x52\x18\x56\x35\xc9\xcb\x0d\x3c\x1a\xd3\xb5\x84\x75\xdb\xf6\x14\x30\x67\x7c\xe4\x21\x56\xcf\xb8\xa5\xbb\x11\xe3\x10\xd4\xe6\x46\x16\x62\xf5\xc9\x12\xed\x0b\x54\x0f\x49\x48\x88\x5e\xd0\x4e\x9f\xcc\x60\x5f\xee\xba\x24\x52\xed\x46\x8f\x32\x34\xb5\xfd\x3a\x76\x5b\x6a\xa1\x5d\x2d\xcd\x35\x71\x2f\xc4\x70\xd8\x1f\xa2\x53\x45\x84\x3a\xc4\xa3\x96\x5e\x92\xc7\x30\x09\x5c\x8c\x18\xd0\x5a\xb0\x72\xf7\x10\x1a\x1c\x0b\xdb\x15\x30\x97\x34\xf1\xc5\x9e\x90\x8c\x99\xbf\xaa\x38\x57\x9e\x05\xad\x5a\xb5\x21\x35\x67\xe8\x4a\x99\xcd\x9a\xad\xa0\xb8\x3c\xcf\x2e\xb8\x0e\x20\x6c\xc5\x4c\x29\x57\x8c\xe6\x44\xf4\x6e\xcc\xeb\x6a\xaf\xa8\xd5\x87\x3b\xdf\x9b\x16\xa0\xbb\x09\x92\x7f\x35\xb0\x0c\x78\x45\x34\x7b\xf0\x01\x92\xab\x0e\x29\x0d\x70\xde\x59\x29\x57\x65\xa4\x61\x99\xa2\x61\x69\xb7\x28\xc7\x59\x65\xca\x66\x0c\xb8\x22\x44\x90\xf2\xa2\x5e\x73\x65\x0b\xd6\x31\x3a\x17\xcf\x2c\xa4\xfa\xad\x92\xce\x04\x16\xcd\x00\xce\x97\x1d\xd6\xfa\x95\x8d\xbe\x23\x9d\xc8\xe7\x0d\x3a\xec\x9a\x08\xce\xdc\x47\xe4\x2b\x89\x48\xe3\x2b\x31\x66\xbe\x6a\xc8\x51\xf4\x15\xf9\x4b\x83\x31\x09\x79\x75\xcc\x55\xba\x86\xb4\xd3\xf4\xfa\x5a\xa9\x03\x6b\xd6\x0a\x4d\xe1\x69\xb7\x0c\x26\x28\x8c\x10\xf9\xf9\x53\xdb\x41\x0f\x99\x33\xf0\xe1\x2a\xbb\xa3\x8d\x97\x3f\x7f\x32\x63\xf5\x0c\x61\xc9\x6c\x11\xc9\xd9\xac\xd4\x6e\x0e\x10\x94\xe8\x0d\xc8\x3b\xbc\xa4\x73\x4c\xb8\x20\x2b\x11\x57\x22\x3a\x84\x8f\x91\xb8\x4a\x2a\xdb\xb2\x18\xb2\x60\xe7\x89\x22\x50\x1e\xe9\x24\x5e\x17\x77\x07\x8a\x81\x37\x3d\x2f\x55\x25\xbe\x78\xc8\xeb\x31\xb5\xa5\xf9\xd7\xef\x82\x6e\x5b\xeb\xcd\x72\xa4\x16\x21\xcb\xd2\x66\xd6\x16\x5f\x58\x6d\xae\xe7\x06\xbe\x96\x8b\x31\x94\xbb\x90\xe5\x29\xe8\xd7\x92\x56\xf3\x70\x44\x21\xda\x64\xd9\xf9\xfc\xa2\x4d\x86\x97\x16\xe1\x49\xcb\x0f\x38\x9e\x29\xef\x94\x72\x48\xcd\xb8\xdc\x78\x14\x12\x10\xc9\x45\x95\x49\x78\xbb\x56\x70\x27\x01\x23\x8d\x06\xea\x6d\x95\x0f\xdf\x5d\x05\xc3\xa0\xea\xa8\xcc\x22\xbc\x5a\x3d\xcb\xab\x26\xb6\x50\x3d\xa2\xb4\x32\x9d\x2f\xd9\x9a\x78\x23\x3a\x60\x3c\x38\xa1\x84\x09\x02\x40\xb7\xed\x88\x0c\xc8\x5b\xd3\x8b\xd1\x5c\x1f\x3a\x65\xfa\x2c\x68\x72\x2c\x43\xcb\x11\xab\xbd\xff\x8a\x1f\xe7\x7c\x34\x21\x87\x14\x36\x87\xac\x10\xf8\x05\xb0\x89\x21\x37\x1f\x69\x54\x0c\x4b\xb6\xac\xed\xc2\x29\xdc\x15\xd6\x7f\x65\xc5\x32\xe0\x69\x43\xef\x48\xd3\x71\xd0\xe0\xac\x68\xbb\x02\x1e\x85\xbd\xa3\x38\x9f\x96\xb5\x3d\xe8\xae\x5a\xfe\x10\xb2\xad\x32\x0a\xb8\x3d\x80\x5e\xf9\xdd\x8a\xb8\x1c\x6f\x72\xad\x08\xd3\xf1\x58\xc0\x8d\xda\x31\xa2\xcd\x50\xd6\xb6\x78\x64\xb2\xd6\x50\x56\x05\xa8\x65\xab\x4c\x16\xab\xbf\xb3\x8b\xfa\xd4\x5f\x9b\x39\xdc\x21\x4c\xaa\x74\x8e\xea\x50\xfc\x3d\x7d\x7e\x3e\x67\x16\xcd\x82\x22\x23\x81\x88\xfb\xc9\xd5\x6d\xd3\xe7\xa3\xcf\x70\x1d\xc1\xec\x51\x27\xa4\x43\x85\x4d\x6a\x73\x9e\x16\xe1\x1e\x3d\xf9\x63\x73\x39\xe7\xbe\x8b\xe9\x2f\x97\x19\x48\xe0\x64\x2e\x16\x07\x7e\xe1\x3e\x39\x77\x8d\x80\xd1\xea\x9c\xe8\xc8\x9a\xe6\x92\xe9\x81\xaa\x17\xef\xbb\x5e\x6e\xe4\xbf\x46\x6e\x20\xdd\x40\xa3\x30\x97\x1a\x08\x13\xae\x54\xd7\x5b\x4c\x71\xf2\x1b\x9e\x1a\x4f\x38\x90\x86\xe4\x02\xb7\x3e\x83\x78\x0f\x89\xde\x0b\x38\xff\x4a\xee\x05\xab\x59\x73\xc1\xfe\xee\x9d\xa5\x42\x0d\xb8\xe3\x20\xd4\x8b\xa9\xea\xb8\x95\x3d\xfb\xdc\xef\x58\xd5\x5f\x72\xac\x16\xdc\xc1\xee\x4e\x21\x32\x2c\x26\x50\x40\xd5\xf4\x50\x63\x5e\x42\xd5\xd1\x98\x77\x57\x08\x03\x1e\xeb\xd5\xad\x2b\x13\x6e\xfe\xce\x13\xb3\xda\x01\x40\x5d\x56\x0f\x67\xf6\x11\xb7\x87\x8c\x8b\x7f\x2c\xb2\xf9\xda\xb9\xf1\xe7\x4f\x2b\xe7\x46\x7b\x46\xa2\x75\xb1\x3a\xf3\x26\xe3\x41\xa0\xe7\x55\xb3\xa4\x65\xa2\x97\x0f\xd8\xf8\xe3\x6e\xfe\xf1\x35\x56\x1e\xc7\x8a\x73\xd8\xcf\x78\xec\x20\xd8\x00\x7f\x2e\xd0\x56\xb3\x3d\x08\x25\xfa\x43\x2c\x4a\x35\x5b\xd5\x0a\x23\x77\xf3\xfc\xfc\x45\x65\xc8\x8a\xd5\xf9\xae\x6e\x50\xb8\x93\x76\xd5\x9c\x2d\xa8\x66\xc3\xaf\xd8\x90\x54\x73\xf5\x36\xbd\xc3\x56\x1e\xe4\xd9\x9d\xc7\x11\xab\x85\xde\x8a\xee\xb6\x7a\x12\xf1\xfe\xcc\xbd\x5b\xe9\xd4\x70\xe7\xd9\x0a\x96\xb7\xcf\xb3\x20\xd3\x55\x43\x6b\xbf\x21\x76\x27\xdf\x1d\x91\x91\x28\x70\x9a\xdc\xa4\xaa\x90\x0d\x3c\xd7\x9d\x72\xf2\x3c\x4c\x9a\xfb\x6b\x10\x6b\xa2\x2b\xec\xd5\x31\x56\xbb\x1a\x3b\x7e\xe8\x81\x7d\xf7\xc8\x14\x93\x40\x3b\x32\x9b\xca\xce\x2c\x29\xca\x43\xe9\x0c\xd3\x10\x24\x15\xab\xf4\x5f\xb9\x52\xe9\xaf\x83\xb6\x2a\x36\xe3\x06\xc4\x47\x75\xf4\x5b\x68\xd9\x80\xbb\x07\x68\x38\x83\xd0\x14\x93\x0f\x8f\x66\x35\xc7\x87\x2f\x01\x0a\x52\x33\xd2\xc0\x00\xc3\xdc\xdc\x83\x25\xfd\x7b\x34\xc3\x91\x73\x94\x47\x9a\xba\x93\x92\xe1\xef\x22\xef\x2e\x72\x99\x78\x7b\xee\x55\x41\xd2\x26\xa0\xb4\xe4\xad\x1a\x81\x68\x60\x2b\x64\xcb\x9c\x6c\xe7\x9f\xe5\x73\xf1\x0e\x7d\x4c\xd6\x67\x74\xec\xac\xf5\x1e\x6a\x68\x5d\x92\x58\xe6\x42\xbe\xda\xc1\x1c\x18\x04\xb5\x83\xf3\xf1\x34\xc1\x23\xee\xf3\x00\x2a\xc1\xc0\xdf\x0a\x37\x65\xb0\xce\x75\x2e\xc4\xd2\xdd\x67\x2d\xb3\x8a\x89\x30\xe8\x2d\x2c\x57\x78\x0b\x2b\x1a\x1e\x6e\xda\x04\x1a\xfe\x1f\x44\xf3\x3a\xd8\x85\xd5\x6d\x7c\x9b\xcf\x6a\xa9\x9e\x76\x4e\x0e\xe6\x69\x22\x0d\x62\x17\x5a\xfa\xeb\x20\x2f\xa1\xfb\xa8\xcd\x5f\x6d\x2c\x74\x4c\x0a\xaa\x3c\xdc\x74\x34\xd2\x3b\x7b\x7c\x57\xea\x1a\xb1\xc9\x81\xdf\x19\xd1\x7a\x99\x1b\x8a\x28\x22\xc3\xa5\x96\x88\xa2\x19\x87\xdd\x14\x47\x0a\x4a\x5b\x36\x97\x11\x36\xea\x53\x85\x71\x68\xa3\xe6\x88\x7b\x9e\x71\x2d\xbf\xd8\xd8\xd0\x84\xfe\xcc\x6e\x8b\x33\x79\xca\xe8\x6c\xbb\x6d\x81\x58\x6d\xfd\x11\xcf\x2b\x60\x75\xf0\xfe\x8c\xe8\xbf\x77\x7e\xf8\x5f\x65\xf1\xbf\x2e\xe8\xef\x5d\x30\xea\x4f\xb8\xca\xfd\xb0\xbf\x77\x15\x71\x7f\x95\xd8\xd1\x96\xca\x9a\xfc\xc5\x81\x71\xb9\xcb\x8b\x46\xb1\xf7\xe0\x57\xd6\x26\xe2\x89\x6a\x47\x0f\xbf\xd3\x63\xac\x7e\xe9\xc5\xdf\xa8\xf8\xa2\x02\xf9\x8a\xed\x3e\xa6\xcd\x90\xda\x71\x15\xaa\x7a\xd4\xa9\x52\xcc\x5f\xbf\xfa\x87\xb4\xae\x78\x40\xeb\xb4\xdd\xf8\x80\x0a\x78\xa6\xcf\xa8\xa3\xf8\xcd\x2a\x39\xca\xae\x92\x6a\xe7\x66\x58\x18\xba\x52\x53\xec\xa8\x99\x61\x51\xa2\xdc\xb6\x4c\x04\xe9\xb0\xff\x1e\x74\x1d\x25\x24\x1b\x22\x63\xa3\xed\x84\xaf\xaf\x91\x97\x84\x68\x48\x6d\x7d\xb7\x66\x47\xbe\xc4\x74\x0c\xaa\x7c\x40\x4e\x86\xb7\x9b\x43\xf9\xb3\x69\x20\x28\x56\xa7\xd3\xf1\x09\xea\xcd\x0f\xad\xe3\xe7\x4e\x48\xa5\xd2\x2d\x45\xa6\xf3\x52\xb4\xea\xe9\x72\xbe\xe0\x7e\x74\x87\x92\x66\x25\xeb\xe3\xda\x95\xc8\x86\x8a\x55\x85\x0d\x9c\x71\xe1\x9f\xad\x10\xfe\x59\x48\xf8\x67\x17\xcd\x34\x18\x41\x53\xd5\x1d\xbf\xc1\x2c\x50\x5d\xf8\xdf\x7f\x0e\xa8\xc6\xed\xb7\x9f\x01\xaa\xeb\xaa\x23\xff\x2d\xcf\x87\x29\xa8\xc9\xa7\xe5\x06\xb2\x04\x76\xea\xa3\xe7\xcf\xdb\x09\x95\xdb\xbb\xa0\xf2\xd6\x9a\x6d\xf4\x9f\x8c\x03\x5f\xb5\xcb\x8c\x8f\x70\x6e\x44\xe7\xad\x87\x34\xf9\x21\xab\xee\x62\x95\x5c\x2e\x8f\xe3\xf6\x46\xf4\x18\x7c\xc3\x3d\x07\x1b\x1b\x55\xbe\x02\x5c\x0a\x37\x1c\x05\x1e\xed\xb2\x80\x15\x1a\xa0\x5f\xd1\xaa\xd8\x49\x96\xf3\x90\x16\x83\x8c\x45\xc5\x66\xb2\xf0\x99\x0f\x3c\x7b\xe1\x64\x21\x0f\x03\xb3\xc2\x4a\x7e\xfb\x5e\xa8\x39\x77\x7b\xd3\x27\xe7\xb9\xb6\x17\x31\xdc\x92\xe1\xa8\x06\x5e\xf9\x43\x30\xa9\xcd\x0f\x86\x67\xdd\x43\x2c\xf7\x10\x63\x18\x38\x68\x6d\x0f\x71\x16\x5b\x6b\xe1\xf8\x2b\x74\x01\x31\x48\xdb\xbf\xba\xe6\x07\x0c\x18\x61\x1c\xb0\x80\x39\x10\xc4\x0e\x69\xf4\x50\x12\xbd\x82\xfc\xce\x31\x4f\x0f\xd7\x33\x54\xf8\xac\x5b\xee\xdf\x6a\x1e\xf2\x4a\xff\xb7\x34\x11\x79\xc8\xad\x99\x89\xf4\xa1\x58\xbb\x15\x03\xc5\xd1\x8c\x30\x8a\xd6\xd1\x39\x4c\x00\xf2\x9e\x69\x15\x84\xbb\x24\x3e\xed\x56\xda\xd6\x28\x97\x6b\x9d\xe2\x63\x84\xd9\x12\x73\xb2\x15\xca\x0b\x0b\x29\x2f\x18\xd4\x52\xb5\x25\xbf\x5b\x7b\xf2\xf5\xe8\xfb\xd9\xb3\xaf\xb7\x52\xf3\x6f\x6e\xd6\xad\x6a\xeb\xdf\x71\xda\x0d\x9e\xc0\xf8\x1f\xe8\x74\xeb\x91\xff\x81\xb6\x72\x35\xb7\xb9\x5f\x22\x7f\xf7\x8e\xb3\x78\x9b\x39\x22\x22\x13\x3e\x5d\x2d\x05\x52\x73\xd8\x97\xff\x9a\xa3\x3a\x5b\x35\xaa\xeb\xe9\x13\x55\xa4\x2e\x6a\x0d\xeb\xa0\xec\x4c\x85\x92\x81\x0b\x40\xab\xe4\x65\x6a\xc9\x4b\x64\xfb\x4f\x6d\x0f\xc4\xef\x20\x8a\xe3\xdc\x0d\xb1\xd6\xdf\x96\xd8\x79\xc5\x16\xa9\xf6\x83\xa6\xfc\x87\xab\x45\x66\x40\x9c\xc1\xb9\xbf\x41\xb5\xbf\x5a\x27\x0a\xa3\x06\x13\xe1\x45\x5d\x7d\xe8\x8c\x15\x65\xcd\x13\xad\xb2\x1b\x3a\xba\x0b\xd7\x83\x68\x7b\x97\x91\x15\xf4\xbe\x9b\x50\x91\xde\x86\x07\xfc\x76\xb2\x9c\xd7\x3a\xc5\xd7\x2e\x49\xe4\x54\xe1\x0c\x6b\xaa\xcb\xe6\x22\x03\x1e\x74\x49\x44\x0a\x56\x59\x5d\x00\x06\x2c\xbd\xc1\xdb\x46\x31\x8a\xb4\xaa\x9b\xd6\x64\xcf\x8a\xf2\x61\xf9\x8f\x88\xc6\x06\x2c\x9e\x01\xc3\xc3\x5a\x7d\x45\x3d\x79\xcf\xcc\x2d\xc3\x53\xbe\xb0\xd3\x68\x58\x87\x1d\xbc\xf3\x93\xe8\x48\xbb\x77\x3c\x16\x99\xaf\x29\xf3\x1a\xc4\x7a\xc3\x79\x03\x7a\x1a\xcf\xb8\x9d\xb2\xcb\x25\x3d\xa4\xf3\xeb\x0c\xff\xc5\xc0\x5a\xfc\x97\xce\x0d\x69\x5c\xa8\x30\x34\x40\xe3\x1b\x67\xbc\x03\xc5\x49\xb7\x33\xcf\x9b\x24\x61\xf1\x21\xad\x9e\xe1\xae\x92\x82\x89\x5a\x77\xc2\x1f\x09\x83\x8a\x6f\x1c\xab\x9d\x60\xe8\x2c\xf7\xa0\x59\x07\x79\x88\x08\xe0\x15\x9b\x35\x91\x2c\xa5\x11\x08\x29\xa8\x64\x4d\x67\xb2\x37\x84\x3f\xd8\x81\xc3\x88\xed\x66\xa7\xd3\x81\xd6\x7a\x14\xb2\xfb\x51\x07\x1f\x61\x2f\x3e\x7e\x66\x77\xe1\x63\xda\x02\xc3\x3a\x7f\xce\xd2\x79\xb3\x11\x35\x08\xf9\x1f\xe9\x2c\xb7\x5c\x1c\x93\x68\x55\xad\xc6\x47\xe8\xf8\xe0\x16\x76\xf1\x4d\x9e\x2d\x17\xd8\x97\xf4\xb0\x97\xcd\x66\xc9\xa2\x60\xd3\xd5\x9d\xca\x09\x52\xfe\x5a\x82\xd0\x91\xa1\x8d\x2a\x92\x70\x63\xb3\x36\x61\x98\x26\x85\xcb\xf5\x51\xd4\xc0\xe3\x5c\x40\xec\x34\x1f\x3f\xf6\xf8\x7f\x05\x81\x74\x6b\x2a\x5a\xdc\x21\xaa\xed\xcf\xa7\x8e\x5e\x23\x93\x2b\xe1\xa9\xfd\x81\x96\x97\xcd\xd5\xf8\x6f\x6c\xac\xc0\xff\x53\x58\x16\x9e\x2e\xd3\xb2\xde\xe6\xf8\x32\x70\x1a\x17\x8f\x64\xd7\xc5\xbd\x33\x97\x3b\xb0\xe4\xd7\xf6\x71\x22\x30\xc1\x2c\x17\xd6\x14\xfd\x85\x1f\xce\xe5\x66\x44\xe3\x18\x51\xa6\x65\x58\x0f\x77\xf9\x15\x01\xa7\x69\x81\xe1\xfc\x2b\x80\x0d\x88\x36\x8f\x1c\x5b\x01\x2c\xbe\x1a\x80\x62\x17\xc7\x0a\x60\x84\xc0\x0c\xa5\x78\x56\x4e\x01\x41\xa6\x85\x3a\x0a\x46\x4f\x03\x3a\x7c\x98\x4f\x7f\xcd\x50\xf9\x0a\x9a\x07\x9a\x23\xcb\xe5\x4b\xdc\x10\x11\xc6\xa7\x1d\x44\xb2\x58\x5e\x5e\xcd\x92\xa2\x60\x62\x77\x1a\x9d\xa6\x5a\xcd\x17\x06\x3c\x6f\xc6\x0a\xfa\xd7\xd8\xf0\x2b\x6d\x75\x49\x09\x19\x02\xa0\x77\x15\xe2\x17\x11\x7d\xe1\x44\x01\x48\x0d\x27\x3c\xe5\xcb\xf9\xde\x5e\x96\xb7\x2a\xe3\x72\x45\x1e\xe9\xa3\xba\x87\xd3\x21\x5f\x31\x38\x1c\xae\xfa\xb7\xd6\x48\xeb\xa8\x87\x09\x47\x75\x4a\x75\x88\x5d\xc8\x9d\x6b\xb0\xe1\x70\x38\xad\x6a\xa3\x1e\x08\xab\x35\x2e\x97\xa4\xeb\x8b\xf4\x36\x3e\xad\x2a\x95\xba\x64\x45\x99\x96\xfd\x13\x0e\x55\x5e\xe9\x53\x2d\xb9\xc9\xc0\x3d\x44\xdc\xcb\x8a\x27\x66\xe1\xfb\x0e\x39\x8e\xea\xd6\xbd\x5c\xd4\xd3\x14\xdd\xac\x8b\x87\xea\x8a\x5e\x01\x0f\xd6\x16\x83\x7c\x1b\xf4\x80\x63\x55\x7b\x38\xdc\x0d\x89\x8d\xbd\x22\x64\xb1\x14\xec\x92\x9f\xc4\x61\xf6\x52\x58\xc3\x0c\x7b\xde\x10\x4a\x48\xe3\xa2\x61\xe4\x3f\x10\x55\xa3\xe8\xcb\x2b\x71\x34\x6d\x9f\x55\xba\x7f\xe8\xa8\x64\xb7\x22\xa1\xee\x08\xf4\x40\x95\x61\xed\x88\x6b\xdb\xa0\xeb\x77\xae\x3e\x5e\x04\x5c\x52\xc1\x73\x00\xac\x23\x0e\x6d\x9c\x4f\x58\x01\xdc\x47\x49\xd2\x7a\x90\x47\x2d\xda\x8a\x0e\x76\x5f\x3a\x2f\x67\xa0\x94\x51\x5f\xe4\x49\x0a\x4a\x54\x94\x00\xf3\x7d\xc4\x0b\x0b\xd4\xa5\x19\x24\x8d\xf9\x01\xce\x62\x97\x10\x7f\xf6\x4e\x59\x7c\x6e\x94\x29\x80\x84\xd6\x87\x3a\x0e\x5f\x9c\x75\xbe\xb7\x68\x4b\xc8\x95\x40\x14\xf1\x78\x07\xe4\x39\x18\x1f\x1e\xed\x4f\xe8\x84\x54\x6f\xd5\x34\x68\x58\xad\x5f\x19\x28\x2d\x53\x51\xb2\x91\x30\x0b\xf1\x5f\x69\x4e\x7c\x94\x07\xb4\xa9\x83\xd1\xae\x93\x74\xb6\xcc\xe5\x5c\x23\x34\x1d\x63\xf6\x91\x16\x6c\x92\x63\xec\x16\x13\xa7\x80\x4b\xc3\x66\x99\x27\x84\x96\x95\x2a\x42\x16\x79\x5f\xd9\x9f\xee\x92\x8f\x07\xba\xc2\xae\xaa\x6f\x9e\x5e\x99\x06\x13\xb6\xe7\x0f\x4b\xb6\x64\xef\xcc\x33\xcc\x82\xc4\x31\x38\x78\x7c\x74\xf4\xee\x6c\xff\xf4\xec\x34\x70\x52\xdb\xd3\x64\x36\xdb\xc0\x62\x8b\x6f\x40\x21\x5d\x57\x52\x41\x87\x57\xae\x16\xce\x0e\xa2\xde\xc2\x40\x9d\x1a\x02\x8a\xe0\x2a\x4f\x03\xf5\x1c\xd5\xab\x9a\x2a\xc2\x9c\x45\x6c\xaa\x29\xff\x9b\x62\xfb\x6a\x77\x17\xfe\x31\x37\x9b\x16\xe1\x10\xe8\x39\x6d\x36\x2d\x82\x77\xd9\xa8\x7d\x5d\xaa\xde\x96\x0a\xac\x55\xc3\xe4\x84\xdd\xec\x7f\x5c\x84\xac\x47\xbe\x4b\x84\x6f\x34\x9a\x2b\xe9\x06\x63\x26\xb8\x93\xf3\x93\x3e\x7f\x55\x03\xe3\xba\x47\xae\x33\x04\x0e\xf9\xd0\x7d\xd6\x9c\x63\x00\xff\xe3\xc7\x2b\x02\x17\x1f\xd4\x4b\x6f\x92\xb2\x64\xf9\xbc\xee\x39\x36\xd6\x21\xa7\x6b\x14\x42\x7b\x19\x4d\x19\x51\x06\x77\x34\x57\x97\x40\x2b\x2b\xa1\x0d\x1a\x8a\x55\x60\xf4\x8b\x7d\xb7\xaf\x33\x52\x51\x8a\xe8\x0e\x85\x2f\x48\x43\x5e\x0d\xb6\xe7\x2a\x87\x62\xf3\x34\xd1\x27\xd2\x96\x35\x49\x94\xcd\xdf\xce\xaf\x92\xe5\xcd\x6d\xb9\x2f\x05\xeb\xbb\x3a\xa7\x09\x50\xec\x37\xd3\x07\xa4\xcb\x52\x22\xbc\x34\x61\x8d\xc8\xe7\x67\x48\xf2\xc5\x30\xa3\x08\x7e\xc0\xf2\x4e\x44\xe2\x6b\x8a\x32\x59\x10\xc3\xdc\xb0\xae\x8e\x52\xb3\xa5\x14\x9d\xfb\x04\xc5\x69\x3c\xd4\x79\x5d\x16\x20\x6e\xd1\x00\x9a\xb1\x29\xed\x99\x11\x47\xd1\x06\x43\x83\xe8\x62\x09\x10\x90\xb8\x06\xb1\x5b\xb9\xf1\xd0\x2e\x5c\x1c\x35\x2d\x77\x64\xd8\x1f\xdf\xec\xbf\x9e\x1c\xbe\xfe\x56\x9d\x40\xdc\x48\xae\xa1\xcf\x94\x79\x8d\xfd\xcc\xe4\x46\x1e\xd1\x06\x35\xd7\x89\x4b\x2a\x40\x49\xe0\x9a\x30\xba\x65\x76\xaa\x9a\x88\x10\x5a\x81\xf5\x8a\xe7\x21\xb6\x91\x52\x3b\x78\xf0\x6d\x2b\x24\xfc\x9d\xb9\x2f\x00\x60\x4c\x86\xf8\x53\x6f\x42\x5c\xe5\x4c\x5c\x11\xe2\xc5\xd4\x19\xde\xe6\xbc\x05\x6a\x3f\x4d\x6c\x1b\xce\x4c\x67\xb4\x5b\xfa\x08\x9a\x26\x31\xd0\xf5\xd6\x34\xa6\x4b\xb9\x96\x85\x34\xe6\x1c\xd8\xd6\x04\x96\xb3\xac\x09\x84\x69\x16\xd0\x5d\x71\x96\x9d\x32\x10\x71\xd3\xe2\x9d\x8d\xa7\x3a\x43\xaa\x58\xde\xdd\x25\x79\xfa\x57\x3a\x8a\x55\x84\xcd\x65\x73\xea\x17\x7b\xa2\x35\x34\x02\x9f\x46\xc2\x3b\x6f\xdf\x14\x25\x22\xec\x5c\x72\xda\x6b\x27\x74\xd2\x7b\xed\xae\xe1\x7d\xbc\xda\xcd\xbb\x46\xbb\x94\xc5\x5b\x4e\xdb\xa6\xda\xc3\xff\x91\x34\xac\x82\xeb\xeb\xfe\xe7\xf2\x63\xab\xce\x1d\x1e\xde\xf0\x91\x83\xdc\x18\x40\x60\xb6\xa8\x0a\xf8\xc8\x69\x8a\x7b\x3f\x48\x33\xe4\x4f\x3b\xc6\x71\x5f\x4a\xa9\xe9\x06\xe6\x21\x19\x6d\x28\x87\x3b\xeb\xbc\x19\x9f\x9e\xee\x4f\xe4\xb1\x6e\xc6\x68\x93\xe7\xc6\x93\x82\xa9\x18\xf7\x9b\x68\xd8\xd5\x67\x91\x6a\x19\x7f\x3a\xcb\x3e\xf0\x21\x5e\x66\xd9\x4f\x15\xbc\xc5\x7c\xc6\x72\xd9\x5c\x21\x47\x52\x9a\x8f\xd2\x96\xc3\xcb\xfa\x78\x2f\x63\x5c\x4b\x95\x06\x79\x71\x37\xb4\x4f\xad\xaa\xe9\x2d\x4b\x2e\x5a\xdd\xa1\x36\x2b\xa0\xa4\x96\x52\x5e\x9c\x87\x6d\xca\x2a\x7d\x74\x84\xa2\x9a\xd7\x19\xa1\xe3\xc1\x0c\xe7\x4c\x70\x7b\x1b\x3f\x52\x7d\x39\x7f\xcd\x3e\x96\x5c\x92\x3d\x74\x0c\x9c\xb0\xf0\x28\x08\x29\xf3\x46\xd3\x8f\xe8\xd4\x27\x99\x13\xd5\x0c\xed\x63\xa8\x6c\x7b\x59\x75\xfc\x90\x68\x67\x28\xb0\x24\x57\xa7\x87\x58\x22\x4d\x2c\x5d\xe7\xc6\x01\x44\x6e\xef\xd7\xa5\x84\x49\xbe\xf5\xe7\x9c\xf2\xfe\xc2\x3d\xec\xae\x9a\x2d\x36\x79\x59\xcc\xe2\x4b\xad\x66\x2b\x30\xe3\x9b\xc6\x87\x1c\x39\xae\x60\xfe\xe6\x99\x67\xa8\x54\x32\xe6\xab\xe4\x63\x7a\xb7\xbc\x8b\x44\x19\xd0\x51\xcb\x79\x89\xa7\x7d\xa2\x72\xd5\x8e\x92\xcb\x2c\x07\x65\xf5\x86\x0f\x47\x68\x7f\x47\xcd\xd5\xab\x11\x96\x8b\xb1\x4e\xd3\xe9\xc0\x43\xed\xf0\xb6\xd4\x97\xe7\x41\x6d\x82\x86\xe2\x0e\x1f\x8a\xab\x84\x2d\x3f\x2b\xc4\x50\xe5\x99\xe9\x97\xe0\xd7\x0b\x98\xa2\x26\x38\x1f\x52\x62\xd3\xcc\xa9\xfd\x14\xf2\xfe\x28\x98\x6b\x4d\x80\xa6\x2b\xe6\xb5\x58\xaf\x23\xb7\x2b\xba\x45\xe9\xa1\x11\xd7\xbe\x22\x72\x1d\x3d\x50\x66\x8b\x2b\x19\x2a\x7b\x2b\x24\x25\xc2\xb3\x98\x49\x1b\x65\xfa\x54\x59\xca\x9e\x0f\x92\x7f\x12\x5e\xc8\x92\x6f\x16\x0f\x4f\x8e\xc2\xc9\x15\x50\xb2\xcc\xc9\xb2\x54\xde\xb0\x75\x80\xce\xb8\x13\x77\xd4\x7c\x7e\xb7\x04\x67\x55\xd1\x43\x34\x42\x24\x62\xb5\x7a\x2a\xc8\x86\x7f\x8b\xee\xb3\x87\x14\x8c\xe2\xe6\x6f\xa6\x52\x98\x8d\x87\x82\x7f\x9d\x56\x51\x4b\x02\xaf\x0e\xaa\x54\x5a\x6d\x03\x32\xce\x11\x35\xa5\x3e\x78\x02\x58\x99\x12\xcd\xa2\xd5\x08\xe9\xa2\x82\xf4\xbe\x71\xe8\xdf\x39\x60\x39\x97\x84\x3a\xde\xfe\x15\x93\xae\xa9\xeb\xac\x75\xae\x37\x19\xbf\x82\x0f\xcf\x3d\x3c\x48\x3f\xb2\x69\xb3\xd7\xe2\xd7\xf1\xd5\xf5\x11\x48\x95\x7c\xfd\xac\xe6\x4c\x36\xad\xea\xd8\x1b\x07\xd2\x8c\xbc\x31\xb8\x8a\xab\x65\x3b\xbe\x99\x81\x7b\x30\x6d\x2b\x6e\xb7\xd2\x6f\x25\xfa\xd3\x57\x25\xcf\x04\x5f\xc2\xc4\x20\x55\x90\x9d\x9a\x1c\x11\x7d\x60\x30\x1f\xce\x33\x39\xed\x05\x49\xa9\xa4\xde\x7a\x2f\x79\xa9\x1c\xa1\xcc\x5c\xe8\x34\xfc\xe3\x25\xf7\xd5\xe5\x9f\xe3\xaf\x94\x9a\x2e\x7f\x13\x66\xb6\xf8\x16\x34\xf7\xcd\x0b\x19\xc3\xad\x32\xf8\x43\x9a\xed\x60\xb0\x2f\xd8\x7c\x8a\xee\x80\x75\x59\x78\xbf\x62\x0e\xfe\xb4\x36\x83\x30\x1e\xb0\x0a\xee\xfb\x68\xaf\x75\x9b\xaf\x71\x6c\xe6\xb2\x73\xd8\xaa\x56\x9a\x05\x9a\x11\x36\xe2\x04\xd1\x75\x4b\x50\x8d\x1a\xbe\x1e\x83\xe5\xf2\x80\x7b\x82\xfb\x22\x4c\x9b\xc0\x04\xe5\xe9\xf5\xfa\x69\xad\x78\x5c\x21\xa6\x08\xa5\x5c\x84\x40\xb5\x6c\xb6\x7a\x64\xf3\x95\xf2\xcb\x89\xfb\x40\xdf\x71\xfd\x8a\xc7\x10\x98\x2b\x16\x53\x98\xbf\x68\x04\x71\x5a\x25\xa0\x16\xe2\xe6\xb0\x64\x3e\x35\x3e\xd1\xdd\x66\xd1\x1d\x9e\xa1\x47\xf7\xe5\x2a\x0d\x33\xe0\x78\x31\x5c\x2d\xad\x7a\x24\xe1\xcc\x6f\xb2\xfe\x1a\xae\xa9\x1a\x2d\xac\x5e\x7d\x30\x53\x4b\xa2\xac\xec\x89\x52\x43\x05\x86\xb1\x43\x6f\xe3\xf0\x31\x93\xbc\x58\xc6\x34\x82\x52\x7e\x63\x9a\x89\x3e\xc1\x86\xac\x5b\x3b\x90\xad\xc0\xbb\x03\xac\xeb\x56\xcd\xaf\x4a\x15\xd3\x6d\x0e\x38\x6d\xc4\x47\x6b\x6e\x65\x35\x31\xb6\x9a\xbd\x76\xb1\x7d\x15\xa9\x3f\xc3\xbd\xa6\x64\xaf\x39\x94\x70\x82\x75\xbd\x47\xda\x67\x15\x04\xd3\x06\xb6\x71\xaa\x92\xba\x9a\x56\x4e\x51\x4f\xa3\x1e\x8d\x3f\xf3\xf0\xa3\xb5\x82\xad\x69\x9e\x1d\xbd\x86\x96\x09\x2e\x15\xee\xff\x61\x3d\x19\x1f\x31\x6e\xdd\x6a\x1f\x35\x05\x54\x3d\x2a\x9d\xc3\xd5\x25\xe6\x74\x5c\xab\x63\xfb\x9a\xb7\x2f\xad\x8e\x48\x7d\xfc\x38\xe7\x0b\x36\xb4\xea\xfe\x88\x5f\xeb\x15\x2c\x47\x79\xcc\x6b\xb6\x17\x66\x9b\xbc\xdc\xff\xc3\xba\x59\x5c\x7d\x4c\x7d\x5d\x99\x82\xf5\xf9\xa9\x74\xd6\x2d\xc0\x0a\x3f\x67\xb5\x47\x2e\xb4\xe8\x0b\xb9\x8d\x8b\x77\xd7\x1d\xfa\x67\xb4\xd1\x8d\x9d\x76\xc3\xce\xc2\x4e\x7b\xe5\x28\x88\x62\xbc\xfe\x19\xea\xc4\x39\xc7\x58\x09\x46\x0e\x35\x5e\x43\x51\x57\x3c\x68\x85\x16\xd5\xa8\xd3\x43\x97\xfa\x49\x7d\x4c\x72\x14\xe7\xa2\x56\xe5\xe1\x45\x88\xcc\x39\xbf\x52\x9a\x42\x01\xe8\x42\xea\x80\xb3\x0b\x55\xc4\x66\x43\xf6\x1b\x9d\xdc\x6d\xb8\x8e\xe4\x99\x54\xc9\x6c\xc6\x72\xdc\x31\x46\x2a\x7b\x33\x6e\x69\x18\xea\x41\xd4\xf5\x10\x7f\x9e\x60\xde\xac\xf1\xe9\x21\x8c\xb3\x7e\x98\x74\xa9\x9a\xca\xeb\x6a\x4b\xd5\xec\xb2\x7e\xb3\x15\x95\xea\x37\x5a\xf1\xda\x83\x1b\xea\x17\xfc\x30\x3f\xf8\x9a\x0b\xa9\xf3\xaa\x5d\xe9\xb9\x7f\x29\xf5\x39\xdd\x81\x7d\xd1\xa1\xe5\xbf\xe6\x93\xe6\xf9\x1f\x9f\x5c\x3c\xde\xc1\x2b\x40\xf1\xe7\xc7\xd6\xf3\xdf\x3f\xa9\x60\x7a\xf4\x2f\x9d\xc7\x18\xaf\xd3\x78\xfe\xfc\x79\xe3\xa1\x34\x10\x33\x6f\x8d\xb5\x74\x14\xe9\xca\x0c\xad\x3b\x81\x61\xdf\x56\x97\xed\x4d\x02\x72\x64\x49\x7d\x80\xd5\x99\xff\xbb\x35\x71\x59\xf0\x48\x9e\x15\xca\x4b\xa0\x16\xae\xbe\xdb\xb5\xbc\x3d\x3b\xd8\x9a\x80\x11\x3b\x75\xa2\xff\xbd\xf2\x2e\xef\x81\x70\x47\xec\xba\xb4\xc2\xc6\xa7\xec\x0d\x88\x20\x33\x6d\x86\x37\x27\xbf\xc8\x96\x14\xea\xda\x0d\xd5\x63\x5d\x90\x82\x29\x35\x57\xbc\x31\x88\xbd\x7a\x23\x44\x78\x65\x94\x8e\xbe\xcc\xf7\xa0\x92\x71\xd9\xcc\x03\xbb\xa5\xbb\x4a\xbb\x50\x0d\xc4\xeb\x5a\x9f\x3e\x8b\xe2\xde\x26\xf2\x23\x2e\x96\xf3\x52\xa8\x04\xe0\xcd\x78\xbb\x87\xdf\xe9\xf4\x7c\x82\xec\xf5\xfa\x4a\x27\x31\x49\x82\x97\x97\x03\x70\xdb\x27\x60\x1c\x22\x56\xdc\xdb\xc2\xe2\x7b\xbd\x81\x53\x7c\x7f\xbb\xb2\x78\x00\x0e\x14\xdf\x0b\x15\xdf\xeb\x0e\x78\xf9\x83\xae\x53\xfe\x60\xb3\xba\xfc\x41\x37\x50\x7e\x3f\x54\x3e\x5e\xeb\x30\xe2\x15\x6c\x39\x15\x0c\xe3\x15\x15\x6c\x05\x2a\x18\x84\x1b\xb0\xbd\x19\x0f\x7b\x54\xc5\xd0\xed\x82\x61\x75\x17\x00\x70\xa0\x8a\x61\xb0\x0d\x9b\x71\x77\x6b\x6b\x34\xa0\xd9\x95\xae\x8e\xfc\xff\xfe\xdf\xff\xa7\x61\xdc\xd4\x0b\x7d\x64\x57\x1c\x6f\xc7\x55\xa1\x28\x76\x95\x1b\x1b\x81\x41\xe3\x22\xfc\xf4\x69\x34\xc2\xe9\xa0\x49\xac\x03\xfc\xd0\x8e\x82\x0c\x1a\x3e\x1e\xdc\xba\xd3\x49\x15\x1a\x3e\xdc\x5c\x1d\xbf\x61\xb4\x1f\x34\xc5\xe1\xb0\xb7\x3d\xc2\x76\x65\x74\x33\x13\x3e\x0d\x37\xfb\x83\x3e\x7e\xcb\x50\x19\x89\xe3\x01\xfc\xc9\x81\x41\xe4\xa1\x8b\xa0\x9e\xf2\xfe\x97\x1f\xf8\x44\xd6\xb9\xce\xb3\xbb\x3d\x31\x00\x9b\x19\x9d\x7f\x9a\x45\x1b\x82\x57\xda\xd5\xa0\x1c\x8d\xc7\x08\xfd\xcd\x37\x50\x6b\x37\xfa\x12\x7e\x7a\x7d\xa0\xc7\x70\xd4\xef\x75\xf1\x13\xbe\x43\x72\xd6\x6a\xb5\xd6\x9c\xd0\x2e\xcf\x3c\x54\x18\xb7\x83\x22\x2d\xdf\xd8\xa8\x0e\xe5\xd1\x71\x2a\x5c\x66\xa1\x3c\x5b\xef\xf4\xe3\x8a\xbf\x21\xfb\x5a\x22\xbb\x39\xe9\xb0\xf9\xfa\x02\xff\xf6\x82\x90\xd3\xd5\xe2\x6d\xea\x79\x7e\x79\x35\x76\x58\xdf\x18\x07\x8a\x4f\x0c\xe8\x51\x3f\xde\x96\xf1\x5a\x8f\x41\x6d\xd5\x98\xad\x3a\xcd\xd4\x46\x0c\x33\x06\x8e\xe3\x57\xc8\xb9\x6c\xf9\x9c\xc7\xa8\x70\xde\x03\xa6\x90\x5c\x91\xb6\x70\x2c\xc5\xdd\x96\xc5\x29\x6d\x4e\xa1\x1d\xdd\xa4\x60\x44\x98\x6a\xaf\x7f\xf0\xa9\x4f\x37\x39\x57\xb4\xbc\xa9\x62\xd7\xb8\x25\x87\x0b\xa9\xae\x90\xb4\x55\x6c\x8f\x33\xcb\x2f\xb8\x41\x19\x78\x7e\x24\xe2\xea\x62\x8e\xef\x53\x8e\xd3\x70\x65\x7e\x9c\x3a\x64\xfe\xb8\x27\x0a\x20\x81\xb9\x22\x0f\x4c\x07\x2a\xcf\x96\xc8\xd3\x6f\x61\x34\xdf\x37\xc0\x61\xad\xa8\x20\xc9\x55\x89\x32\x08\x44\x85\x72\xf4\x35\xe4\xfa\x32\x1a\xf5\x5b\x75\x06\xd2\x87\x2b\xbe\x5d\x02\x9f\x3a\xf3\xe5\xec\xfb\x74\x5a\xde\xd2\x50\xa4\x14\x8c\xc1\xcb\x33\x37\x35\x67\x37\x49\x3e\xdd\xfb\xf3\x4f\xe3\xbb\xcb\xf4\x66\x99\x91\x51\xff\x28\x16\x59\x8c\x64\x99\xaf\x27\x4b\x83\x0f\x73\xee\x2c\x3c\x3f\xdf\x1c\xc1\xac\xb3\xb5\xb9\x7d\xd1\x8e\xce\xe3\x78\x08\x73\x01\xfc\x6e\xf1\xb7\x51\x17\xdf\x46\x31\xbd\x0d\x7a\xf8\x6d\x30\xe2\x90\x83\xcd\x18\xdf\x36\xc5\xb7\xcd\x3e\xbd\x0d\xc4\xdb\x88\xde\x36\xc5\xdb\x36\xbd\xf1\x7c\x24\xec\xe0\x57\xbc\xe1\x7c\x04\xbf\x1c\x72\x14\x63\x99\xa3\x7e\x97\xbf\xe1\x74\x88\xbf\xf4\xb6\x39\x44\x5c\x36\x47\xbc\x86\xcd\xd1\x26\xbd\x89\x6f\x9b\xf4\x6d\xb3\x4f\x6f\x5b\x5d\xfc\x06\xbf\xe2\x6d\x9b\xde\x78\x7d\x5b\x38\x87\xc7\x5b\xa3\x11\xbd\x6d\x0f\xb1\x86\x6d\x5e\x4a\xaf\xdb\x83\x7c\xbd\x6e\x7f\x48\x6f\xfd\x2e\xb4\x16\x7e\x47\xfc\x6d\x34\xc0\x37\x5e\x3b\xfc\xbb\x8d\x6f\x9b\xe2\xdb\x56\x8c\x6f\x5b\xb1\x78\xa3\x7c\x5b\xbc\xcc\x41\x17\xda\x07\xbf\x7d\xfe\xd6\xef\xe3\x5b\x5f\xbc\x6d\xd3\xb7\xed\x9e\x78\xc3\xda\x87\x5d\x6a\x3b\xfc\xbb\x4d\x6f\xdb\xfc\xad\xdf\xc5\xb7\x3e\xaf\x61\x38\xc2\xfa\x86\x23\x9e\x6f\xd4\xc3\x6f\xf0\x2b\xde\x86\xf4\xc6\x31\x03\x29\x84\x6f\x7d\x01\xd9\xa7\x6f\xfd\x4d\xfe\xb6\x89\xb5\x8f\x38\xcd\x7a\xa3\x2d\xac\x6f\xb4\xcd\x4b\xd9\x44\xca\xe3\x2f\x7f\x1b\x22\xd6\x9b\xbc\x8f\xe0\x5f\x84\xdc\x1c\x09\xc8\xd1\x90\xde\x38\xcd\x36\xb7\x46\xf8\xb6\xc5\x21\xb7\x62\x6c\x11\xfc\xf2\x37\xe4\x09\xfc\x15\x6f\xdb\xf4\xc6\xdb\xb7\x45\x14\xdc\xda\xe2\xb8\x6c\x6d\x63\x7d\xf0\x4b\x6f\xdb\x44\x41\xf8\xe5\x6f\x03\x2c\x05\x7e\xf1\xad\xdf\xed\x02\x9e\xf8\xcb\xdf\x7a\x31\xbe\xf5\x88\x4a\xfd\xb8\x0f\x3d\x06\xbf\x1c\x12\x98\x17\xdf\x06\x03\xf1\x36\xa2\xb7\x6d\xfe\x36\xdc\xc4\xb7\xff\x9f\xbd\x77\x5b\x6e\x23\x49\x12\x05\x1f\xf6\xe1\x98\xf5\xc3\x79\x3c\x2f\xfd\xb0\xa9\x9c\xee\x11\x50\x02\x40\x80\x57\x90\x2c\x55\x0d\x45\x52\x55\x9c\x12\x45\x0d\x49\x49\xd5\x45\xb1\x34\x49\x20\x41\xe6\x08\xc8\xc4\x64\x26\x48\xb1\x55\x9a\x31\xdb\xbf\x58\xb3\xfd\x82\xfd\xac\xfd\x92\xf5\x4b\x44\x64\x44\x66\xe4\x05\x94\xaa\xfb\x8c\x9d\xd3\x17\x0a\x19\x57\x0f\x0f\x0f\x0f\xf7\x08\x0f\x77\xa6\xf9\xb5\x55\xa4\x79\xfc\x2b\xbe\xd6\xe8\x6b\x8d\xbf\xb6\x28\x6f\x4b\xe4\xe1\x88\xe0\xef\x16\x7f\x6d\x23\x2c\xab\xdb\xdc\xe6\x1a\x8e\x01\xfe\x12\x5e\xd6\xd6\x91\x96\xf0\x2f\x7d\xd1\x6c\xe2\x5f\xf1\x85\xf5\x36\xd6\xc5\x17\xc1\x09\x7f\xe9\x6b\x13\xe9\x05\xff\x8a\x2f\xec\x6f\x53\xe6\xe1\x4a\x5d\xdb\x64\x2a\x00\x52\xc4\xb1\x6f\xf1\x4a\x85\x7f\xd7\xe9\x8b\x61\xd9\xda\xa2\xbc\x2d\x51\x72\x48\x79\x43\xce\x1b\x52\xc9\x21\xcf\xdf\x1a\x61\x7e\x4d\x60\x1e\xfe\xdd\xa0\x2f\x99\xb7\x45\x5f\x3c\xda\x6d\xa4\x89\xb5\x6d\x5e\x47\xf0\x2f\x8e\x61\x5b\xf4\xb0\xbd\xb5\x4e\x5f\x5c\x6f\x9b\xfa\xdb\xde\x66\xc8\xb6\xb1\x07\x58\x14\x84\x6b\x58\x16\x43\xfc\x5a\xe3\x2f\x90\xa6\xe0\x4b\xcc\xd1\x3a\xcd\x11\xfe\xe5\x2f\x5c\xfd\xf0\x77\x20\xbe\xd6\xe8\x6b\x8d\xbf\xb0\x07\xf8\x4b\xfd\xad\xaf\x23\xed\xae\x6f\xf2\xea\x5f\xdf\x46\x6a\xc5\xbf\xf8\xb5\xb1\xdd\x87\x36\xe1\xef\x90\xbf\xb0\xf7\x8d\x6d\xc6\xfc\xc6\x36\xce\x2d\xfc\xa5\x1e\x36\xfb\x48\x75\xf0\x77\x8d\xbf\x70\x7c\xf0\x77\x9b\xbf\x10\x9f\xf0\x77\x8b\xbf\x90\xe6\xf1\xaf\xf8\xda\xc6\x2f\xa6\x82\xcd\x01\xae\x62\xfc\xcb\x5f\x38\x63\xf0\x97\xeb\x01\x69\xc2\x17\xfc\xa5\xaf\xf5\x35\xec\x6f\x7d\x6d\x5d\x7c\x61\xbd\x75\x86\x6c\x73\x1d\xc7\x8e\x7f\xc5\xd7\x16\x7d\x71\x9b\x9b\xb8\x8e\x36\x37\x87\x9c\xb7\x3d\xc0\x56\xb6\x07\x1b\xfc\x85\x73\x8b\x7f\xc5\x17\xc2\xb9\xbd\x25\x4a\x6e\x51\x49\x9e\x31\xf8\x77\x48\x5f\x84\x97\xad\xfe\x00\xda\xdc\x02\x6e\x48\x5f\x9b\x03\xa8\xb7\x25\x38\x0a\xb0\xdd\x21\x7e\xf1\xba\x1d\x02\xb3\x84\xad\x63\x95\x71\x3d\x5c\xc5\x15\x07\x7f\x87\xfc\x35\xc4\x6d\x65\x95\xe7\x7d\x48\xab\x63\xb8\xc6\xf8\x04\x26\x0c\x23\x1a\xae\x33\x3f\x1b\xac\xae\xe1\x82\xc0\x7f\x36\xf8\x7b\x1d\x09\x01\xff\x21\xe8\xd6\xd7\xfa\x03\x9c\x60\xfc\x47\x7e\x6f\xf3\xf7\xb6\xf8\x5e\xdf\xa0\x6f\xa6\x15\x58\x20\x34\x25\xf8\x0f\x7d\x6f\xf4\x51\x31\x84\x7f\x78\xcf\x81\x1f\x1b\x9b\xf4\x2d\x70\xb8\xb1\x4a\x48\xc4\x7f\xf8\x1b\xe4\x39\x8e\xcc\xc7\xb4\x00\x7b\x07\xa2\x7c\x28\x67\x75\x38\xe8\x23\x01\xc0\x3f\xab\xf2\x7b\x48\xdf\x03\x51\x7e\x80\x9b\x1a\xfe\xb3\x2e\xbf\xb7\xf9\x5b\xec\xb1\xdb\x83\x75\xdc\x2d\xf1\xdf\x0d\x99\xc2\xfb\xee\xf6\x80\xe7\x87\x7e\x89\x32\x72\x67\xde\x5e\x1d\xd0\x6e\x0c\xff\xae\x89\x94\xb5\xcd\x55\x4a\x11\xfb\xd2\x36\x6e\x92\xd0\x0e\xff\x2b\x53\x90\x6b\xc0\xbf\x9b\x3c\x1a\xf8\xb5\x85\x1c\x0e\xdb\x85\xf1\x5c\xb2\x44\xe0\x69\x02\xc4\x05\xee\xc3\xb8\xf1\x0e\xc4\x2e\x4c\x9b\x30\xff\xc6\x8d\x55\xee\xb9\xb4\xe5\x32\xb4\xb8\xe0\x07\x5b\x72\x67\xa6\xed\x96\x77\xdb\x55\xda\x6c\xf9\x37\x6d\xb5\xdc\xe6\x36\xfd\x16\xbb\x2e\x6e\x35\xbc\x22\x57\x07\xb8\x99\x0c\x78\x87\x58\x45\xb6\xbf\x2a\xf7\x62\xda\x8a\xf9\x37\xa6\xaf\xc9\x7d\x98\xb6\x61\xb1\xd3\xd2\x46\xcb\xbf\x69\x63\x15\xbb\x2c\x6f\xab\xbc\x73\x62\xfa\x06\xef\x24\x1b\xb8\xa1\xf3\x0c\xad\x6e\x50\x19\xb1\xbf\xd1\x66\xb7\x26\xf6\x3a\xda\xf8\x78\x57\xa2\x4d\x49\xec\x49\x58\x97\xf9\x22\x30\x7c\xfc\xcd\xdc\x94\xd8\x3c\xaf\x86\xb5\x01\x6d\x38\xcc\x11\x91\x5e\xd7\x56\xf9\x37\xd2\x22\xfc\xe1\xdf\xc8\x36\x79\x5e\xd6\x88\xd5\x0b\x4e\x4f\x5b\x02\x53\xeb\xda\x06\x6d\x0f\xdb\x62\x07\xa0\xed\x80\xa8\x1e\xa7\x7e\x9d\xb9\xff\x3a\xce\xd1\x3a\xcf\xd1\x3a\x2e\xf3\x75\xe6\xcb\xeb\x9b\xc4\x0a\x99\xbb\xe2\x7c\xad\xf3\x7c\xad\xe3\xe2\x5f\xe7\xb5\xbf\x8e\x73\x27\xe4\x36\x12\xdb\xd6\x79\x87\xde\x40\x46\xbd\xb1\x2d\xf8\x1f\x33\x35\xe6\x0e\xc8\x00\x78\xbe\xb6\x50\x5c\xdb\x62\xaa\xdf\x42\x76\xb6\xc5\x9c\x67\x0b\x37\xfe\x2d\xde\xf7\xb7\x50\x3c\xd9\x62\xe9\x64\x0b\xc7\xbb\xc5\xe3\xdd\xc2\xf1\x6e\xf1\x78\xb7\x50\x30\xd9\x5a\x13\x14\x0b\xe9\xdb\xab\xd4\xd7\x36\x8a\x2f\xdb\x3c\xbf\xdb\xb8\xc6\xb7\x99\x26\xb7\x11\x0f\xdb\x42\x0e\xed\x93\x54\xda\x67\x4a\x19\xf4\x49\xbe\x1b\xf4\xc5\x0a\xe9\xd3\xaa\xe2\xb5\x30\x24\x52\x1b\x0a\x5a\x1b\xae\x22\xf0\xf0\x77\x5d\x7c\x6d\xd2\x97\xe0\x64\x08\x34\xfc\x15\xbc\x6b\x95\xf8\x1a\x73\x40\xf8\x97\x5a\x59\x13\x79\xeb\x54\x92\xe9\x6e\x48\x84\x37\x14\x94\x07\xff\x6e\xd0\x97\xe8\x7d\x83\xfa\xdb\x10\xf5\x36\xa8\x4d\xa6\x3f\xe0\x8b\xcc\x1d\x19\x32\x22\x17\xfc\xcb\x5f\x28\xd5\x0c\x05\xc5\x0c\x49\x0e\x1d\x8a\xf5\x3e\x5c\xa7\x36\xd7\x45\x9b\xeb\xb8\x2b\xe2\x5f\xf1\xb5\x45\x5f\x3c\xa2\xf5\x4d\xfa\xda\x14\x5f\x5b\xc4\x7f\x99\x1a\x86\xeb\x43\x6a\x65\xb8\x2a\xbe\x36\xe9\x8b\x47\xbb\xbe\x4d\x79\x82\x8b\x6f\xe0\x84\xc0\xdf\x55\xf1\x85\x70\x0a\x59\x05\xfe\x45\xc8\x36\x36\x78\xb4\x1b\xc8\x63\xe0\x2f\x8f\x61\x03\x25\xdd\xe1\x66\x9f\x5b\xd9\x5c\xa3\x2f\x9e\x7b\x10\x3d\x70\xec\x9b\x2c\x7d\x81\x60\x8e\xf5\x36\x59\xfa\x1a\xd2\xee\x36\x94\xbb\xcd\x56\x1f\x7b\x80\xbf\xe2\x6b\x93\xbe\x78\x44\x44\x8a\xf0\x77\x55\x7c\x6d\xd0\xd7\x86\xf8\xda\xa6\x2f\xd1\x0a\xe1\x73\x4b\xcc\xed\xd6\x2a\x95\x5c\x15\x25\x91\xd1\xe0\x5f\xf1\xb5\x46\x5f\x9b\xe2\x8b\x5a\x59\x13\xad\xac\x53\x2b\xeb\xa2\x95\x75\x2a\xc9\xf2\xf2\x90\x34\x15\xfc\x2b\xbe\x08\xce\x0d\x51\x8f\xe6\x4f\xc8\xcb\xc3\xad\x2d\xca\xdb\x12\x3d\x0c\xa9\xde\x50\xd4\xa3\x79\xd8\x12\xf3\x30\xa4\x1d\x73\x28\x30\x38\x24\x4c\x08\x0d\x07\x84\x67\xca\x1b\x88\xbc\x01\xe5\x89\xb1\x0f\x69\x4f\x1e\xae\xc9\xaf\x21\x7d\x31\x2c\x43\xa2\x97\xa1\xa0\x97\x21\xd1\xcb\x50\xd0\xcb\x10\x35\x1c\xfc\xcb\x5f\x28\x09\x0e\x85\x24\x38\x24\x69\x61\x28\xa4\x85\x6d\x62\x74\xdb\xeb\x2c\x4b\x6f\x13\x45\x6e\x6f\xac\xf3\x9a\x25\xed\x0e\x94\x2c\xfe\xda\xc4\x31\xc0\x12\xe6\x75\xbe\x39\x18\xe2\xd7\xaa\x5c\xd1\x58\x52\xd0\xc4\x36\x69\x2a\x20\xb8\x88\xbc\x0d\xaa\xb7\x21\xbf\xd6\xe9\x4b\xb4\x42\x7b\xd9\xa6\xe4\x0b\x9b\x94\xb7\x29\xf2\xb6\x28\x8f\xf9\x1c\xfc\x4b\x6d\x6e\xc9\x3c\xea\x7d\x28\xea\xe1\x86\x04\xa2\x11\x7f\x11\x2d\x6d\x0b\xbe\xb6\x4d\x54\x00\x7f\xd7\xc5\xd7\x16\x7d\xf1\x88\xb6\x70\xbd\xc3\xdf\x35\xf1\x45\xad\x08\x4c\xd0\xbc\xe3\x5f\xf1\x45\x79\x4c\xe5\xdb\x5b\xdb\x54\x8f\x35\x3f\xf8\x77\x9d\xbe\xb8\x87\x21\x72\x1b\xf8\xbb\x21\xbe\xb6\xe8\x8b\xdb\x1c\x12\x43\x1c\x0a\x58\x86\xb4\xb3\x0f\x05\x2c\x43\xa4\xcf\x6d\x31\xb7\x83\x3e\xc9\x44\xf4\x0f\x7f\x0f\x50\xa0\x85\x7f\x78\x54\x78\x60\x84\x32\xd1\x9a\x80\x9d\x8e\x78\x58\xe6\x59\x13\xad\x31\x27\xed\xaf\x03\x45\xac\x09\xd6\x0a\x3f\x71\x73\xc7\x63\xcf\xbe\x12\x1d\x82\xe4\x6c\xce\xe1\x1e\xab\x1c\x1b\x76\xf4\x70\x9f\xe6\x21\x84\xee\x90\x2e\x77\x5d\xfb\x6d\xae\xe8\x45\x1f\x43\xd0\x73\xb8\xb4\xef\xf2\x79\x11\x46\xa5\x2f\xbf\xa8\x47\x2f\xcb\xdf\x3d\x75\xe2\x5d\x19\xd3\xe6\xa9\x73\xec\xa5\x37\xbd\xc9\x34\x8a\xe2\x16\x9d\xb6\x45\x6d\x67\xc5\x59\x25\x0f\x84\x85\xd6\x53\xd1\xba\x08\xc0\x3c\x28\x7d\x67\xf1\xc8\x06\x77\x8a\x70\xdb\x1f\xc7\xf1\x01\x34\xb6\x0a\x08\x28\x3f\x2a\x12\xef\xb4\x3e\x4b\xa4\xe7\x4e\x7d\x96\xc3\xbd\x12\xf7\xea\x71\xaf\x8a\x5a\x70\x9f\xe5\xfd\x1e\xb8\xcf\x5a\x7f\x08\xee\x8d\xda\x5f\x15\xf7\x78\xa6\x29\x0f\xd4\xaa\x8f\xbc\xcb\x0e\xe9\xbe\x77\xcc\x86\x4e\xa9\x88\xca\x6f\x91\xdb\x71\xb3\xc8\x41\x90\xc4\x85\x52\x56\xa8\x8a\x25\x6b\xe1\xe4\x48\x25\xbe\x84\x4b\x1d\x3f\xee\x90\x71\xec\xda\xaa\x98\x74\xbe\x06\xa4\x57\x9b\xdf\x82\x1a\xd0\x57\xe3\xd0\x0f\x27\xb9\x0e\xdf\x17\x0e\xe4\x30\x04\x8f\xc0\xce\xbf\x77\x30\xbc\xf1\x80\xac\xae\xb1\xc9\xf5\xb5\x8d\x55\x0e\x7c\x85\xc7\xba\xa0\xbd\x6f\x61\x6f\xdb\xfd\xfe\x80\x9e\x41\x89\x8f\x55\xf5\xc1\x70\x0c\xb6\xfb\xeb\x02\x12\xa8\xb4\x0a\x7a\x05\x7e\x81\x0a\xb9\xc1\x0f\x54\x55\x49\xd0\xa1\xd7\x56\x55\x49\xd8\x8f\xfa\x6b\x2a\x0f\xb9\x5f\xd6\x0a\xe8\x8b\x1b\x1b\x59\xde\x06\xf0\xbf\x2c\x0f\xbe\xb6\xf5\xbc\xad\x55\x2d\x6f\xb0\xa6\xd7\x5b\x1d\xea\xf5\xd6\xb6\x36\xb5\xbc\x0d\x0d\x6a\xf8\x1a\xf4\xb3\x11\xad\x0d\xf4\x46\x07\xb8\xb1\x65\xad\xd2\xe7\x50\xe5\xae\xc2\xae\xb9\xae\x5b\xc7\x55\x10\x53\x33\x22\x35\xf9\x09\xcf\x53\xc9\x29\xf2\xd2\x94\x99\xa4\x75\xcb\x25\xbb\xd0\x31\x58\x95\x70\x8c\xaf\xae\x74\xda\x25\xd1\xd7\xfc\xec\x7e\x6f\x2f\xb5\xba\x01\x6f\xa5\x6a\xd7\x91\x60\xb7\xd0\xb0\xed\x5b\xa7\xaf\xf8\x42\x77\x90\xb3\xc3\xa0\x97\xe1\x08\x09\xfc\x9b\xe8\x77\x0e\x48\xd6\xab\xe5\xdc\x22\x36\x06\x4f\x21\xb3\x9a\x38\x0a\xc5\x40\xd5\x1d\x27\xcc\x71\xce\x88\xd1\x11\x16\xd1\xc1\x56\x4c\xad\x10\xc1\xcb\x0d\xcd\xb8\x46\xc2\x3b\x41\x60\xa9\x69\x5b\x84\x12\x30\xf9\xbc\x88\x54\xc4\x2f\xfd\x2d\x4e\x2a\x11\xbf\x91\xea\x3f\xc9\xf5\x1f\x22\x5a\x62\x8c\x9b\x5c\x03\x04\xf9\xa5\x4c\xcc\xee\x75\xff\x86\x00\x20\xfb\x07\xc5\xfb\x15\x5f\x60\x0d\xad\x7f\x28\x7c\x77\x45\xf4\x3b\x19\x93\x2c\x6a\x17\xb1\x9e\xb7\x86\xb6\x20\x5e\x5f\x03\xa2\x25\x51\x0a\x8d\x27\xb3\x36\xf5\xc8\x5b\x2d\x34\xa1\x1e\x47\xe9\x0a\x87\x02\x9d\x46\xd7\x2b\xe8\x94\x13\xba\xc0\x17\xda\xa9\xff\x31\x5d\x99\x4f\xbd\x80\xbe\x06\xbd\xc1\x26\xf9\xbe\xa8\x69\x60\xec\xa5\x7e\xb1\xf6\x6a\x7f\xb0\xd5\xed\x0f\xbb\xb2\x8d\x74\xec\xcf\xa9\xb2\xf4\xa6\xe9\x0a\x5a\xcf\x3b\x86\x8d\x7a\xfc\x36\xe4\x9c\x9d\x6e\x89\xb0\x78\xbd\xbf\x46\xd1\xec\xad\x17\xa3\x44\x92\xd9\x16\xbb\xbf\x9c\x9c\x1c\x23\x01\x80\xb0\xf8\x67\x17\x8b\x89\xc0\x65\xd1\xfc\x5e\x2b\xf5\xff\xfd\x3f\xff\x17\x65\x8e\xfd\xe4\x43\x1a\xcd\x5f\x62\x99\x80\xad\xab\xce\x83\x74\xca\x65\xfe\xef\xff\xd7\xf9\x73\x2b\xc5\xcf\xb6\x03\x1f\x54\x01\x4d\x8c\x0e\xfc\x39\x9d\x2c\xb9\x37\xa9\x1f\xcf\x7a\x67\x23\xd8\x2e\xa6\xaf\xa2\x98\x8d\x3e\x13\x1c\xab\xca\xf1\xfd\x30\x9f\x7a\x0e\x7f\x82\xd0\x9b\xe6\xd3\xdf\x9c\x5b\x52\xf6\xbd\x30\xf4\xc7\x9c\x7e\xc9\xa1\xb0\xae\x31\xb8\x49\x7c\x14\xa2\x51\x16\x95\x83\x0a\x0d\xfc\xc0\xe5\x17\x44\x0e\xab\x29\xc5\xbe\xea\xd8\x3d\x55\xe7\xac\x0f\x23\x19\x3f\x55\x46\xc4\x42\x03\x74\xf1\x7e\x87\xa3\x45\x91\x45\xb2\x11\x37\xaa\x98\x42\x11\x04\x81\x01\x15\x5a\x93\xaf\xd8\xcc\xd0\x34\x2d\x4b\x6d\x94\x2c\x6a\xab\x93\xdb\x7a\xcb\x8b\xd6\x47\x39\x21\xb1\x6c\x00\xf1\x22\x44\xd3\xe9\x62\x0a\x5a\xe6\x1d\x7b\x61\x30\x81\xd9\x29\xe3\xe6\xe5\x35\x5a\x79\x4b\x39\x8a\x93\xe9\xcd\xe7\xc4\x39\xf0\x47\xef\xca\x1b\x7d\xc0\x27\x6a\xe1\xd8\xc6\x33\x00\x75\x65\x9e\x9f\x45\x4c\x3c\x77\x1e\xcd\x17\x73\xf7\x33\xf9\x2c\x06\x54\x95\x8d\x30\xf5\xae\x50\x8c\xd3\xbe\xc8\xe8\x90\xdf\xad\x69\xb6\xb0\x05\xa6\x4a\x5c\xce\x6c\x55\x35\xc3\xc9\x1c\x0f\x35\x16\x5f\x47\x63\x19\xd5\x32\x65\x8b\x11\x93\x06\xdd\x30\x8a\x67\xde\xd4\x2d\x52\xa1\xee\x10\x31\xe7\x74\xc0\x36\x6e\xd1\xce\x67\xcd\x01\x76\x1b\x57\x2f\x8e\x6a\x1a\xe0\x1b\xf3\xfc\x6b\x2b\x9b\x2c\x41\xd3\x45\xf6\x33\xc0\x62\xb8\xde\xa9\x3f\xd2\x82\xd6\x6a\x0d\x36\x13\xa1\x4b\x5b\x04\x3c\x40\x7d\x4b\xbb\x3f\xfa\x01\xba\xe1\x78\x78\xc3\x37\xd4\x40\xd6\xf2\x08\x18\xe1\x99\xf4\xda\x72\x1e\x41\xd9\xf9\x55\x04\xf2\xce\x32\x36\xa5\xf8\xae\xc3\x1f\xed\x47\xb3\x99\x07\xa8\x77\xb1\x49\xb7\x99\xb7\x78\x02\x61\xee\x01\x03\xc3\x48\xa6\x0f\xea\x5c\x8d\xd8\x80\x81\xda\x74\x97\x78\x5c\x6a\xf1\x14\xa2\x36\x8b\xfa\xb0\x06\xc2\xd4\xf8\xe9\x77\x8e\x12\x39\x58\xe5\xc0\xd7\xd2\x9a\x5d\x96\x91\x8b\x22\x06\x06\xdb\x7c\x24\xa5\xff\x16\x87\x2b\xb6\xf0\xa6\x4f\x57\xd1\xf8\x1e\xdd\x35\xe0\xbf\xc0\xf6\x47\x14\xef\x17\xa4\x10\xfc\x55\x12\x11\x51\xec\x06\x2b\xc1\x0c\xb8\x5e\xb2\x82\x25\xbb\xdb\xb0\xd7\xb6\x3f\x77\x72\x41\x67\x9f\x52\x53\xb4\xb3\xa9\x77\x97\xe3\x68\x44\x46\xae\x9c\xac\xbb\x00\x57\x31\x6a\xc5\x6e\xd3\x96\x31\x4b\x27\xe8\xc1\x74\x0a\x9a\xd0\x1b\x2f\x4e\x5a\xe5\x9b\x29\xae\x4b\xfc\x17\xd6\x6d\x7e\xac\xf2\x81\x87\x5e\x07\x83\x97\xc6\x45\x7f\x44\xf8\x52\x63\x34\x0d\x46\x1f\x4a\x8d\x59\xf1\x3f\x62\x34\x13\x18\x4d\xa2\x5e\xf5\x8e\xa6\x11\x7a\x05\xce\x26\x1c\xb8\x50\x36\xe7\x45\x5e\x60\xf3\xa9\x70\x27\x16\xb8\x7c\x65\x72\x23\x17\x66\x6a\xb6\x64\x3c\xe4\x49\x7e\xaf\xa6\x61\x44\xa1\x5f\xf7\x38\x0e\x11\xcb\x35\x5b\x59\x3f\x46\x1f\xed\xd2\x0e\x90\xc3\x46\x35\xe6\xd6\x0a\x6e\x4b\xcb\x94\x9c\xe7\x3d\xb9\x3e\xfc\x7f\x5f\x78\xd3\xa4\x99\xf3\x4a\xd1\x97\xea\x4c\xd9\x60\xcb\xee\x1a\xf4\xd7\xf8\x51\xe1\x85\x10\xdf\xa0\xba\x66\xe0\xcf\xfd\x66\x9e\x8b\xb4\xde\xe9\xe1\x40\xd6\xef\x69\x74\xb7\x1f\x4d\x1b\xbd\x4b\x45\xe3\xfc\x6c\xe6\x47\xd1\x74\x31\x0b\xb5\x47\xa9\x11\xc8\xe2\x93\x29\x95\x79\xf4\x28\xce\xf7\xa0\xbf\x58\x8e\x6e\xfd\xbf\x69\x87\x4b\x50\x20\xd7\x6d\xc9\xee\x8d\xae\x73\xdd\xb6\x2b\x7a\x6c\x42\x92\x62\x74\x85\x6e\x84\x5e\x6d\xe9\x91\xc3\xef\x89\x8f\x8a\xde\x97\x21\x56\x82\x42\x80\xa1\x08\x55\x02\x92\x41\x92\x05\xe1\x51\xb0\x34\x04\x66\x59\x4a\xe6\x06\xf4\xd7\xad\xd0\x99\x49\xc9\x02\x24\x33\x51\x01\x66\x10\xb8\xa1\xbb\x4d\x5c\x92\xac\x9e\xc7\xde\xcc\x6f\xf8\x12\x9b\xd5\x1f\x3d\x02\xee\x38\xb8\xa5\x4f\xfa\x21\xd2\x16\x14\xe3\x57\xd1\x25\x3d\xfd\x4f\xe8\x9d\x92\xee\x7c\x32\x98\x60\xc7\xb9\x77\x9c\x78\x84\x07\x4a\x27\x3b\xb6\xd6\x7d\x47\xb2\x02\x88\x91\x6f\x43\x7f\x9a\x7b\x84\x2d\x07\x61\xbc\x26\xd5\x5e\xa0\x96\xcc\xbb\x72\x7a\x4f\x4e\xcc\x7b\xe8\x20\x38\xbf\x27\x91\x07\xfb\x60\x3e\xea\x06\xa0\xb3\x75\xa3\x0f\x16\x3f\xf6\x62\xbe\x32\x97\x13\x89\x1f\x8e\xa5\xa2\x78\x14\x4e\xa2\xf7\x79\x75\x81\x1a\x95\xb8\xec\xe2\x6b\x97\xca\x96\x73\x78\x01\x2d\xea\x7e\x4a\x3e\x92\x61\x03\x47\x79\xc7\x9d\x4c\xfd\x8f\xae\x1d\x4f\x3d\x8c\x3c\x3f\x00\x6c\xcc\x94\x02\x2d\x5e\x8d\x0a\xf4\x18\x8f\x46\x75\xb7\x19\x2f\x22\x6f\x9c\x07\x5c\x68\x6b\xd5\x38\x30\xdc\x23\x4b\xf7\x4d\xb2\x7b\xb4\x52\x75\x68\xde\x77\x5c\x3c\x5f\x41\xc4\xb7\x6d\xd2\x5c\xe9\x7c\x5a\xd7\x4d\x49\x15\x1c\x43\xcd\x6b\x62\x0b\x5d\x01\xe3\x3b\x36\x52\x1f\x88\x5a\x8b\xb3\x0b\x7b\x13\xf4\x2c\x56\x49\x3c\x62\x59\xd0\x8c\x93\x76\x42\x52\x11\xba\x1f\x13\xed\xb6\x4c\x12\x15\x7e\xad\x25\x91\xba\xa6\xc4\xe8\xc5\xd7\xb7\x3b\xce\xc5\x27\xd1\x33\x1e\x75\xec\x94\xc3\xb2\xfa\xf9\x52\x97\xb4\xe4\x62\xee\x38\x17\xe5\x55\x2e\xdb\x75\x53\xd0\x7c\xca\x0a\x4b\xa7\xdc\xad\x06\x88\xb0\x20\x3f\xef\x8d\xd0\xb3\xc8\x0b\x2f\xbc\x5e\xa0\xe4\x5c\xf5\xf8\x93\x9f\x7d\x69\x68\x34\x97\x20\x90\xe3\x45\xd1\x1e\xde\x33\xdb\xc7\x10\xf5\x85\x32\x93\x28\xf6\x59\xd5\x07\x46\x1d\xc5\x3b\x39\x5e\x89\x60\x3e\x37\x8b\xc0\x5c\x17\x5a\xc9\x0e\x0c\x4a\x5b\x79\x66\x16\xb1\xb5\x32\x5a\xc4\x49\x14\x97\xb6\xb0\x9f\x65\xdb\x6a\x4f\x22\x56\xaf\xed\x43\xe0\xbc\xb2\x7a\xcf\xbd\x59\x30\xbd\x2f\xab\xc9\xb9\xf6\x71\x27\xfe\xeb\xd3\x17\x3b\x72\x46\xe1\x77\xcb\x5d\x71\x73\xef\x71\x2f\x4b\x62\x0c\x55\x91\xde\x3e\xaa\x0e\xfb\xa8\x76\xf8\x75\x5c\x40\xd7\x32\xac\xad\x51\x81\xba\xa7\x7d\x6a\xe7\xca\xe4\x06\xc5\xb4\xe7\x1e\x1e\xc8\xbc\xc4\x77\x75\xca\x27\x81\x35\x5b\x84\x21\xda\xbf\x09\xa6\xe3\x7c\xb1\xcc\x83\xd8\x3e\x03\x5c\x3f\xfe\xe6\x6b\x4f\x5b\x1a\xf5\x2f\x73\x6d\xec\xa0\x9d\x7f\xb0\xda\x72\x65\x7b\x23\x2e\xe2\x04\x09\xb9\xae\x00\x71\xd0\x59\xcc\x7b\x6e\xde\x31\x8d\x9d\x3d\x1a\xac\x8f\xb9\x9d\xdf\x11\x8c\x2d\xfd\x5c\x81\x82\xe4\x86\x64\x44\xfb\x9c\xa9\x54\xf2\x18\x19\x97\x1c\x35\x70\x74\x01\x25\xc2\x7c\x9f\xfd\xc6\xe8\x06\x3b\x4e\x6c\x3b\x63\xd5\x83\x3a\x66\x4e\xdb\x6c\x5e\x68\x1b\x92\x4c\x5b\x6d\xaa\xd2\xf7\x0f\x89\x6c\xde\x34\xf6\xbd\xf1\xbd\x73\x1b\x24\xc1\xd5\x14\xcf\xe0\x6d\xd7\xd1\xf2\x5c\x22\x77\x84\xd6\x52\xd2\x5b\x5b\xc4\xe3\x6b\xb9\xa4\x26\xb9\x68\xb1\xda\x17\x27\x05\x90\xc8\x7a\x12\xa4\xae\x0f\xfb\x96\xf5\x8b\xf2\x39\x1d\x12\x92\x1a\x19\x0b\xb5\xaa\x93\xe7\x03\xf2\x8c\x02\x7b\xf3\xb4\x38\x2c\x9a\xb0\x27\x5d\x76\x4f\x7d\x2c\xd8\x72\x01\x36\x37\x27\x80\x78\x42\xf8\x19\x25\xc9\xb9\xff\x91\x5e\x5d\x01\x75\x04\xec\xd0\xdd\xbb\x02\x14\x2d\x52\x7f\x57\x88\x46\x3b\x40\x6b\xa1\xbf\x8b\xd2\x51\x77\x1c\xc4\x7c\x6c\xb6\xe3\xb0\xb4\xbc\x9b\x46\xf3\x1d\x67\xd0\xff\xf3\xee\xd4\x9f\xc0\x96\xb8\xfe\xe7\x5d\x1a\xc2\x8e\xb3\x0d\x69\x3c\x88\x1d\x67\x08\xbf\x61\x00\x5d\xf9\xbd\x8a\xdf\xde\xc7\xae\x9e\x7f\x15\x7d\xec\x26\x37\x1e\x6c\xd2\x3b\x4e\x1f\xfe\xbb\x3a\xff\xa8\x3b\x4a\xaa\xda\x05\x50\x36\x87\xfa\xf1\xd8\x07\x6e\xbd\x64\x3d\x07\x06\x1b\x8c\x77\xdd\xe2\x73\xa8\xa9\x44\xaf\x26\x60\xe7\x71\xcb\x59\x79\xf4\x4e\x81\x6b\x4c\x79\xc3\xd6\xa4\x40\x53\x8e\x99\x16\xa7\x40\xe1\x1b\x51\x4d\xf8\x06\xcc\x4a\x74\x8a\xcb\x9c\x29\xea\x80\x7b\x29\xa8\x3e\x57\x30\x45\x2d\x37\x89\x47\x6e\x26\x60\xb4\x2d\x05\x7c\x6f\x36\x85\x55\xef\xd2\xcb\x68\x20\x1a\x3c\x56\x07\xf1\x80\x19\xe3\xb4\xad\xa9\x1f\x46\x8e\x67\x8d\x46\x2e\xb4\x1e\x56\xa9\x7e\xf2\xef\xe9\xe8\x12\x7f\x1c\x7b\x73\xd6\x82\x64\x62\xed\x3b\x75\x9e\x18\x4d\x03\xfa\x20\x6a\x0a\xe4\xe6\x14\x17\xe0\x65\xe3\xa9\x8c\x55\x7b\xe1\xd2\xe9\x56\xb4\x48\xb3\xa8\xf6\xcf\x31\xe5\xc4\xf4\xdf\x82\x66\x4e\x2e\xb4\x0b\x44\x15\x66\x25\x01\xc4\x03\x48\xb0\x15\x9c\xc7\x8c\xaa\xac\xe4\x2b\x4c\xb1\x15\xa5\xf8\x52\x59\xb9\xd7\xf3\x42\x21\xbc\xfb\x3b\x0a\xe7\x3a\x90\xe7\x32\xc9\x28\x7c\x99\x61\x00\xf0\x28\x64\xe7\x28\x8f\x5f\x43\x02\xc6\xda\xa0\xfd\x26\xc5\xc2\xcf\x44\x8e\x51\xdc\x9b\xa6\x3f\xc4\xc7\xfc\x1a\xdd\xc5\xe5\x2c\x41\x22\x8f\x84\x47\x21\x7a\x74\x78\x85\xe7\xc7\xc2\xf1\x1f\xa3\x3c\x9a\xf9\xd0\x6c\xc2\xb7\x7b\x7a\x68\x81\x39\xec\x21\xf6\x9c\x51\x1a\x4f\x5f\x4d\x17\xc9\x71\x10\x2e\x92\x5f\xfc\x38\xfa\x25\x8a\x66\x7a\xa3\x58\x60\x1f\xaf\x22\xf3\xb5\xde\xa8\xfe\x65\x2a\x86\xc2\x10\x07\xa2\xd0\xd9\x9c\xd6\x93\x2d\x93\xa5\x31\x3d\x13\x85\xc0\x04\x0d\x54\xce\x80\x9e\x93\x67\xf2\xcb\xe8\xf2\xc6\x8b\xbd\x11\x90\xe1\x21\x3e\x50\xe5\x83\x04\x77\x91\x4e\xba\xc3\x4c\x05\x4c\x3d\xaa\x7f\x98\x8c\xbc\xb9\x81\x19\x74\x50\x70\x0c\xd9\x6f\xf4\x44\xc0\x31\x15\x7f\x7b\xe3\xd1\x72\xf6\xa9\x9a\x9b\xe5\x1e\x51\x1d\x63\x10\xd3\x54\x01\xc7\xb9\x56\x58\xa1\x98\xa4\x44\x7f\xac\x79\x23\x98\xf9\xe3\xc0\xc3\x79\xd8\x8b\xfd\xe7\xf8\xaf\x31\x47\xb1\x7f\x1b\x44\x8b\x64\x4f\x03\xab\xa8\xef\xeb\x34\xb6\x37\x92\x07\x0c\xd9\x62\xdd\xdf\x7b\xb9\x7f\x28\xa4\x4a\x3d\x14\x04\xa7\xbb\xda\x46\x76\x70\xf8\x7c\xef\xf5\x8b\xf3\x62\x51\x91\xa1\x97\x45\x5f\x0d\xc5\x82\x98\xaa\x97\x3a\x3b\x3f\x3d\x7a\x55\x2c\x46\xc9\x6e\xdb\x32\x06\xed\xa8\x2a\xac\x76\xbd\x20\x0f\x87\x78\xc2\xd5\x23\xf4\x22\x51\x7c\x6f\xb2\xab\xde\x6d\xaa\xbd\x68\x66\xbb\x2e\xbf\x12\x12\xf2\x49\x33\x9d\xd6\xb3\x45\xe9\xcf\xc6\xce\x0c\xf3\x72\x95\xaf\x24\x9d\x02\xdb\x94\x19\xb9\x9e\xe9\x7e\x30\xb7\x51\x99\x31\x17\x76\xe1\x9f\x6f\x73\xdc\x56\xbd\xbe\x4e\x8b\xaf\xaf\x0b\xbe\x91\x55\xad\x8b\xf4\xb2\xf8\xcc\x99\xee\x86\xd0\x5c\xe2\xf0\x16\xe0\xc4\xe8\x1a\x3e\x88\x2c\xad\x98\x5c\xf2\xc6\x64\x10\xb8\x63\x1f\x92\x90\xe5\x4b\xeb\x55\x04\x39\x2c\xdb\x59\x7c\xdb\x61\x89\x65\xfa\x16\x61\xd5\x04\x16\xb6\x35\x2b\xce\x2b\x3b\xd0\xf7\x84\x0a\xaf\xfb\x1c\x1f\x10\xed\xc5\xf8\x90\x4d\x78\xa4\x71\xdb\x2a\xa0\xa1\x49\xa7\x51\xf8\xe6\x1c\x39\x42\x1a\x47\x1f\xb4\xc3\x29\x55\xa0\x5d\x07\x95\xda\xfa\xea\x6f\x02\x6d\x2f\xa7\xfd\xde\xdd\x4d\x30\xba\x41\x87\xa0\x2f\xd0\x07\x03\x07\xa5\xdc\xcd\xb9\xef\x22\xc6\x0f\x3d\x91\xe9\x93\x4f\x1c\x17\xbf\x7e\xfb\xcd\x71\x47\xae\x8a\xd2\xee\xde\xf2\xef\xb6\xd5\x09\x3a\x33\x47\x2a\x77\x05\x6a\x13\xec\x64\x5d\x98\xef\x6c\x4d\x1b\x6c\x19\x4a\x89\x98\x19\xd2\x96\xa9\x8a\xa8\xed\x03\x83\xd6\xa9\xa2\x85\xc2\x79\x3b\x15\x43\x68\xc5\xe4\x41\xc8\x40\x00\x45\xb4\xd4\xcc\xa8\xe8\x09\xff\x60\x75\x68\x0f\x7a\x2d\x0b\xa2\xc5\x20\x1b\x3d\x52\x9b\x1a\xf0\xbb\x45\x5b\x84\x0a\x3a\x68\xd9\x06\x14\x73\x80\x25\xdc\x2e\x60\x6d\x88\xd0\x64\xd2\x7b\x7d\x34\x7f\x15\x47\xb0\xdb\xf3\xad\x64\xbb\xe1\xa2\x11\x6d\xb1\xd1\xca\xde\x7c\xfe\x12\xf6\x68\x98\xe8\x33\x44\x8e\xe8\xa0\x82\xac\x0a\x26\x1a\x46\x82\x34\x0f\x29\x24\x0a\x3b\x0a\x36\x3d\xd5\x09\xcb\x9c\x95\xe2\x48\xeb\x56\x82\x12\x2c\x6b\x44\xda\xc2\x2e\x5d\xbf\xc2\x5e\xcf\x2b\x1a\x1d\x0c\x99\x52\x05\xbd\x65\xe7\x1d\xf9\x8e\x2c\x89\xff\xe8\xfc\x47\x6b\x80\x4e\x22\xf0\xfc\x98\xa5\x24\xb4\x88\xc6\xa9\x5e\xdd\x2a\x34\xab\xe4\x85\xba\x49\xcb\x0c\x41\x2b\x06\x45\xb2\x75\xa3\xc0\x9c\x51\x61\x45\xa3\x7e\xbd\x6b\x8d\xaa\x14\x17\xe2\xd3\xcb\x3d\x5c\x36\xa8\xfb\xbd\xa3\x8b\xa5\x16\x5d\xad\x8b\x88\x6a\x52\xba\xe6\x90\x6a\xf1\x25\xa2\x22\x24\x33\x69\x62\xab\xd2\x5e\x06\x19\x4d\xa4\x2a\x03\x87\x15\xe9\x54\xdc\x76\x48\xf1\x25\xb3\xf4\x9b\x63\x9b\xa3\x2f\x9e\xa2\x92\xc8\x05\x3c\x7e\xfc\x07\xca\x26\x17\xaa\xf9\x4b\x93\x2b\xc7\x65\x4e\x56\x58\x19\x4b\xd4\x81\x0c\xc5\xba\xb2\x09\x90\x3d\x16\x0e\xf9\x80\xc2\x5e\x42\xc8\x84\xc5\x63\x90\x69\x69\x15\x94\x0e\x3b\xce\x4d\x69\x3e\x89\x85\x1d\x67\xc4\x0c\x92\x97\x7f\xb1\xfd\x05\x8a\xc8\x39\x81\x9c\x78\x04\xcf\x4c\xc7\xd1\xa7\x4a\xe4\x7f\xaf\xb2\x99\x81\xc8\x6d\x6a\x27\xfb\x5d\xec\x08\xd5\xb8\x47\x2b\xbf\xbe\xbb\x78\x77\xf7\xe4\xdd\xe5\x9f\x56\x38\x70\x57\x4c\x48\xf7\xe6\x6d\x7b\xd8\x68\x53\x57\x2b\x8d\x21\x8d\xa3\xeb\x42\xb1\x92\x68\xd0\xc4\x1f\x47\xf8\x67\x41\xe4\x28\xa3\xc2\xd1\xd8\x6d\x0e\x68\xe8\xfc\x18\x24\xec\x0f\xbb\xf6\xfe\x62\x3c\xa5\xa9\xeb\x10\xf6\xa7\xdc\xde\x23\xc5\xae\x3c\x6f\xfa\x1a\x20\xe1\x69\x53\x1d\x44\x83\x2f\x80\xa8\x42\xa4\xc4\xf5\x30\xc9\xc9\xd1\xc4\x2b\xd0\x92\xd3\x15\x6f\x27\xd0\x6f\xd4\x02\xbf\x11\x46\xf8\x3d\xc6\xdf\x48\x2c\xe4\x5c\x51\x32\x95\x22\xab\x43\x35\x14\x25\x21\x2f\xf9\x70\x26\x77\x2d\x63\x0f\x2b\x52\x1a\xaa\xd7\x9f\xc4\x5a\xde\xc9\xb8\x06\x2c\x55\xac\xb2\xa3\xd7\x75\x90\x72\x76\x9c\x11\x2c\xcd\x29\xe4\x2c\x3a\x0e\x82\x04\xd0\x01\x43\xbf\x97\x84\x2f\x8f\x16\xe8\xaa\x84\x7f\xb7\x66\x16\x1b\x7c\x02\xac\x85\x00\x23\xee\x10\x6f\x52\x41\x0f\x0c\x43\x46\x2b\x83\x6e\x21\xca\xee\x7b\x1e\x65\xb4\xa9\x25\x4c\x01\x4d\x0f\xc4\x67\x19\xa9\x41\x9f\x3b\x00\xb5\x8d\x4c\x78\xd1\x5c\xd2\x9b\x38\x92\xbb\x53\xdb\x78\xd6\x35\x21\xcb\xb3\xa9\x48\x7b\x44\xf9\xb0\xa6\x29\x4a\xca\x02\xff\x8c\x15\x2c\x90\x47\x26\x36\xad\x85\x18\x21\x8e\xac\x7c\x2c\x71\x8f\x07\xac\x8f\x45\x0b\x5d\x5e\xdc\x7c\x32\xe8\xe8\x2d\x8c\xe4\x0c\x9a\x31\xbe\x68\x57\xa4\x0b\x6b\xf6\x6b\xf1\x5e\x01\xdf\xf2\xb5\x97\x10\xdd\xe4\xd8\xc3\x36\x3f\xd9\x9a\x88\xbd\x0f\x65\x6d\x36\xad\xd7\x87\x34\x29\x73\xfb\x96\xd1\xf7\x53\x74\x8f\xf5\xbd\xc0\x0c\x12\x35\x51\xba\x48\x5d\xa8\x54\xa6\xf9\xa7\xc2\x97\x56\x8b\x49\x04\xa0\x71\xff\x78\x41\xc9\x13\x39\x30\x7c\xed\xdf\x16\x08\x97\x53\x72\xdd\xae\xf2\x06\x77\x65\x67\x1b\x8f\xae\xd5\x6c\xe2\x8a\x7c\x44\xbf\xae\xe5\xe7\xb5\xe2\x8b\x8f\xf0\xf3\x0a\x49\x75\x77\x1d\x57\xe5\xa3\x91\x2a\xb8\x90\x05\x89\x8b\x3e\x5a\xa8\x82\x9b\xbc\xae\x55\xce\xb5\xca\xd9\xc2\x9c\xac\x71\xca\x6e\x71\xd6\x90\xfc\xd9\xf2\xef\x0d\x57\xfd\x5c\xcb\x7e\xae\xba\x44\x0f\xce\x1a\x63\x44\x90\xc0\xf7\x88\xa4\x01\x9e\x65\x5f\xc1\xff\x15\xa2\xf0\xd9\x27\x36\xa8\x63\x6e\xa2\xbd\xfb\x6b\xe7\x2b\x18\x99\x45\x6f\x72\xb6\xcb\x0e\x7d\xca\x27\x99\x94\x54\x4f\x91\x1d\x31\xf2\xf7\x96\x82\x54\xc0\xd4\x7e\xda\xf4\x8c\x8a\xde\x50\xbd\xc7\xa7\x27\xdb\x1b\xaa\x1b\x9b\xae\xf2\x1e\x86\xb0\xb9\xae\xf1\x81\x61\xf7\x2a\x48\xcb\x39\xc0\x40\xc7\x67\x19\x29\x49\x72\x7a\xcf\xfc\xa7\xa8\x9c\xed\x96\x56\x2b\x85\xb3\x6d\xad\xf2\xd9\x9a\xda\xe2\xa1\x88\xe3\x42\xfb\x58\x90\x33\x29\x31\x30\x77\x30\x99\x71\x1c\xf7\x8f\x48\x2c\x93\x3a\x8f\x81\x85\x43\xf8\x9c\x96\x98\x6b\x40\x10\x88\xbc\x3a\xe3\xb8\x16\x47\xe1\xad\x37\x0d\xc6\x8e\x37\xca\xa2\x00\xe7\x7c\x41\x4f\x8c\xf8\x43\x96\x36\x5e\x46\x68\x09\x83\xc6\x16\x28\xfa\xe2\x59\x94\xda\xc5\x38\x36\x97\xd4\xb4\x2d\x3a\xc7\xb3\xec\xfc\xbb\xca\xad\xab\x28\xc5\xa1\xeb\x2b\x9a\x31\xae\xa8\x7d\x2f\xfe\xfa\xcd\x7a\xe3\xb1\x48\xad\xf6\x6b\xae\x22\xa9\x93\xb4\x1d\xe4\x37\xe5\xf7\xa5\x72\x7b\xa0\x45\x42\xd1\x42\xd6\x06\x66\xc8\x5a\x2a\x79\x91\x5c\x62\x23\x22\x24\x2b\xbb\x32\xfe\x49\x7d\xa3\x83\xe6\xc2\x23\x07\xe0\xdf\x85\xb0\xb6\x24\x9f\x95\x3c\xcb\x88\xc5\xd6\x4e\x6f\x71\x77\xf8\xdc\xc2\x6c\x33\x03\xa0\x60\x0d\x22\x89\x2a\xd5\x8d\x68\x02\xe5\x02\xd5\x86\x0b\x8e\xa4\x86\x01\x34\x83\x5e\x12\xc5\xfa\x93\x8d\x3c\x86\x35\xdd\xd1\xd4\x24\x24\x3e\xb0\x3e\xfa\xad\xf7\x62\x71\xdc\x23\x32\x30\xf0\x53\xf6\xa5\xbf\xc7\x50\x27\x97\x36\xd0\xf0\xc2\x2a\xbe\x6c\x2f\x4b\x26\xb5\xc6\x03\x96\x6d\xbb\xd4\xc5\x37\xdf\x11\xbd\xf2\x62\x10\x9a\x72\x5a\x34\xda\x68\xfb\x69\xcb\xb7\x08\xa3\x41\xce\x07\x78\xe1\x21\x82\xd4\xd9\x63\xbc\x64\x4f\xf0\x4a\xe8\x0c\x23\x37\x84\x23\xdd\xd4\xbc\xfa\x2d\x42\x99\xd9\x9b\x08\xdc\x58\x25\x88\x93\xb6\x8a\x2f\x5d\x65\x8c\x81\x76\xa9\xd4\x52\x44\x55\xa9\x9f\x59\x89\x90\xb4\x44\x2b\xb1\x63\x21\x0b\x03\xa7\x63\x83\x75\xd3\x56\xc9\x6e\x50\x89\x93\xa5\x71\x53\xce\xeb\x8b\x29\x26\xd6\xe4\x25\x87\xc6\xa2\x5a\xc5\x1b\x48\xc9\x1d\x82\xb6\x78\xba\x64\x42\x13\x67\x91\xe3\x64\x8c\xae\x70\xec\x00\xa2\x13\x41\x11\xc2\xf1\x7d\xbb\x72\x73\x79\x70\x5b\xd2\x0b\x6e\x7e\x1c\xb4\x70\x96\x5d\x7a\x49\x23\x57\xb5\x41\x88\xeb\x2d\xd7\x63\x0b\xc3\xcb\x5d\xa4\x4d\x97\x7b\xa6\x62\xd5\x1f\xb3\x37\xdc\x07\xb4\x70\x18\x66\xcc\x42\x5b\x74\x8b\xb4\x89\x77\x5d\x8c\x73\x61\x71\x0d\x6b\xdb\x42\xf0\xec\x8f\xf6\x0f\x23\x46\xb8\xf5\xa5\xaf\x7e\x0f\x59\x61\x58\x20\x8e\x77\x4c\xe3\x02\x71\x23\x5e\x73\x20\xfb\x21\xbb\xb2\xd1\x6c\x0c\xf0\xf0\x8b\x5d\xb3\xaa\x28\x47\xbe\xf1\xa0\x2d\xd7\x89\x49\x21\x3f\x51\x03\x95\xbc\x99\x6d\x9d\xf4\xde\x50\x1f\x30\x24\x9f\x83\x05\x5f\x5e\xfb\x39\x89\xa7\x6d\x82\x79\x41\x9b\x47\xba\x14\x68\x49\xd3\x08\xeb\x7c\xdb\x97\x77\xec\x2f\x2e\xfa\x24\x55\x73\x9b\x2d\x55\x8a\x7d\x6f\x74\x8a\x5b\x39\x88\xf9\x3b\x8e\x51\x6c\x70\x69\x6e\xea\xac\x1e\xe7\x0a\xad\xe6\x0a\x09\xed\x32\x57\x6a\x2d\x57\x8a\x0e\x2f\x8c\x12\xeb\xb9\x12\x7c\xac\x61\x14\xd9\xd0\x4c\x72\x1b\xcd\x36\x11\x46\x43\xbb\xb7\xb4\xdc\xf4\xcd\x7a\x64\x20\xee\x42\xe9\x7c\x60\x84\x66\xc1\x74\x2a\xa0\x2e\x93\x73\x67\xcc\xfa\x1b\xe6\xf2\x8e\x32\x38\x83\x8e\x13\x55\xec\xb3\x3e\xac\x5d\xfd\x4a\x24\x50\xb7\x25\xf4\x91\x1d\x75\x06\xfa\x8d\xdc\xa3\x91\x76\x7c\x56\x30\xbe\xf8\x9e\xc4\xbc\x18\x96\x32\xf6\x5d\xb2\x69\xdb\x86\x14\x7f\xa5\x21\x69\xd0\x95\x59\x7f\x80\x54\x8a\x72\xda\xf2\x30\x06\x5f\x06\xa3\x94\xc8\x35\xac\x0b\x50\x76\xcb\xc6\x13\x98\x27\x80\x6c\x65\xe2\xe3\xb3\xc0\x65\x61\x4f\xbe\x1a\xc9\x08\xba\x78\x30\x16\xc3\xbf\x17\xf1\x3e\x18\x62\xaf\xf4\xe9\xac\xfd\x66\xd8\x3c\x3b\xa0\xa3\x8a\xca\xf6\xa7\xa5\xed\x67\xe8\xb0\xe1\x2c\xf7\xa8\x05\xb6\x09\x2e\xb6\xc4\xd0\x6e\x5a\x69\x6d\xd7\xb8\x9a\x2b\xba\xd6\x56\x5c\xd1\x10\x09\xd8\x1b\xc1\x44\x4d\xec\x38\x83\xcd\xcd\xec\x90\x13\xaf\xb4\x60\x82\x06\x9b\x5b\x96\xb4\xa1\x99\xf6\x7d\xe5\x15\x13\xf9\x3e\x28\xbb\x2c\xaa\xc7\x46\x41\x24\x30\x6f\xce\x46\xea\xaa\x6b\x51\x7b\xd5\x35\x5e\xee\xaa\x6b\x5e\x73\xcd\x35\xa9\xbe\xe6\xca\xd9\x7b\x67\x9b\x7f\xeb\x02\xf8\x84\x7b\xf1\xfa\xe5\x4f\x2f\x4f\xde\xbe\xbc\x74\x3b\xce\x5c\xfd\x8f\x1d\xa0\x62\xf6\xe1\xd9\x3e\x66\xb9\x7f\x84\x3f\x63\xfe\x1f\x7b\xd7\x5c\xc5\xdc\xe7\x03\xcc\x04\x29\xe5\x8f\x27\xaf\xa8\xd4\xc5\x2b\x94\xbe\xc6\xf4\x73\x75\xed\x3f\x5c\x55\x7c\x8d\x8a\xaf\xaa\xe2\xff\xc2\xc5\xff\x45\x2b\xbe\xae\x15\x5f\xa7\xe2\x6b\xaa\xf8\x29\x17\x3f\xd5\x8a\x6f\x68\xc5\x37\xa8\xf8\xba\x2a\x7e\xc6\xc5\xcf\xb4\xe2\x9b\x5a\xf1\x4d\x2a\xbe\xc1\x03\xbb\x18\x70\x4b\x5c\x6c\xa8\x15\x23\x04\x3c\xdf\x94\xc5\xb6\xb4\x62\xdb\x5a\xb1\x21\x15\xdb\x92\xc5\x86\x59\xb1\xb5\x81\x56\x6c\x9b\x8a\x0d\x65\xb1\x6d\xad\xd8\x6a\x56\x6c\x95\x26\xe5\xf9\xb6\x28\xb6\xda\xd7\x8a\x69\xf8\x44\x3f\x96\x88\xfe\xbe\x2c\x37\xd0\xca\x69\x88\x5c\x15\xd3\x34\x90\xe5\xd6\xb2\x72\xeb\x7a\xb7\x3c\x3f\x83\x55\x59\x6e\x5d\x2b\xa7\xf5\x8b\xbe\x0b\xdd\x7f\x15\x79\x41\xcb\x6b\xb9\xff\x84\x38\x86\x7f\x7f\xa5\x7b\xef\xb1\x20\x9e\x75\x1c\xed\xe0\x11\x97\x9b\xb6\x5c\xbe\x7d\x7e\xb9\x98\xbd\xc7\xe2\x94\xb0\x37\x4d\x8d\x6f\xbc\x3f\xe5\x04\x72\x52\x88\x78\x58\xfd\xa7\x2f\x68\x00\x31\xb4\xf6\x0f\x5f\xd0\x00\x0e\x75\xfd\x4f\x5f\xd0\x00\xe2\x74\xe3\xcf\x5f\xd0\x00\xae\x82\xcd\x5f\xbf\xa0\x01\x5c\x17\x5b\xff\xf8\x05\x0d\xe0\x4a\x19\x7e\xf3\x05\x0d\xe0\x1a\xda\x6e\x3d\xbc\x01\x74\xab\xe9\xf6\xdb\x5a\x03\x86\xc9\x70\x79\x33\xf9\x62\xec\x87\x1a\xc9\xb2\xfb\xbe\xb2\xb5\x71\x65\x03\x38\x9e\xa7\x4f\x1e\xde\x00\x7a\x98\xfe\x12\x08\xd0\x3b\xf7\x97\x01\x80\x0d\x3c\xf9\xe6\xc1\x0d\x10\xb7\x7b\xf6\xd3\xd9\x2b\xe4\x14\x71\xcb\xfd\x4f\x64\x18\xef\xae\xb0\x1a\x7c\xc1\x0f\xf8\xfc\x4f\xd1\x08\xf1\x0c\xe2\x7b\xe7\x7b\xcf\xb0\x7c\x00\x25\x52\xe6\x30\xbf\x60\x99\x09\x6d\x37\x54\x0c\xfd\x10\xbb\xff\xfe\x2f\x0c\x17\xf0\x93\x7f\xd1\xdb\x20\xb4\xdf\xbd\x55\x99\x6f\xf5\x4c\xf4\x56\xeb\xfa\x87\x2a\xf3\xd0\xa8\x89\xab\x38\x3e\x55\x99\xa7\x46\x26\x2e\xb0\xf4\x5c\x65\x9e\x1b\x99\xd8\xec\xfd\x5f\x54\xe6\x5f\x8c\x4c\x5c\x59\x8b\xd7\x2a\xf3\xb5\x9e\x49\x73\x1c\x1c\xa9\xcc\x23\x23\x13\x9b\x8d\x4e\x54\xe6\x89\xd1\x2c\x72\xbd\xf9\x2b\x95\xf9\x4a\xcf\x5c\xe5\x2d\xe4\x93\xca\xbd\x30\x72\x69\x4b\xb8\xfc\xac\x72\x2f\xcd\x5c\x6c\xf9\xdd\xbb\xdf\x54\xf6\xbb\x77\x46\x3e\x6d\x3b\xfb\x7b\xaf\xce\x0c\x41\x80\xf1\x8b\x63\xf5\xf6\x54\xcd\x3d\x03\x62\x1c\x6b\x72\xa6\x32\xcf\x8c\x99\x41\x62\x19\x1f\xa8\xcc\x03\x03\x11\xd8\xe5\xe4\xb9\xca\x7c\x6e\x64\xe2\x60\xae\x7f\x50\x99\x3f\x18\x99\x38\xa7\x37\x3f\xaa\xcc\x1f\x8d\x4c\x9c\xd3\x7f\xfb\x67\x6d\x87\xfa\x67\xcc\x9e\xeb\x45\x70\x40\x1f\x7e\xd2\x8a\xfc\xa4\xd8\xc6\x3e\x5e\xe9\xbc\x97\x7b\x19\x17\x47\x26\x38\x7d\xa1\x15\x7f\x91\x6f\x71\x80\xfe\x9a\xdd\xdd\x1d\x2e\x33\xd1\xf1\x8e\xb0\x3e\x7e\xe7\x16\x72\x58\x22\x3a\x7c\x79\x7e\x78\x4a\x7b\xee\xbb\x18\xfe\x2e\xe8\x7f\x9c\x4f\x42\xca\xd9\x8f\x47\xcf\xcf\x8b\x53\xb2\x8d\xb8\xfb\xeb\x2f\x0a\x03\xbf\x18\x53\x82\x58\xff\xf8\xb3\xca\xfc\xd9\x98\x12\x5c\x49\xa3\xfd\x1c\x43\xde\x37\x96\x3d\x72\xe0\x7d\xb9\xdc\x11\x8c\xdb\x37\xb9\xf2\x6f\x0a\xe5\xdf\x48\xfe\x84\xe5\xaf\x9e\x69\xc8\x7a\xa6\x21\x2b\x68\xa1\x80\x40\x05\xd1\x07\xb3\x1b\xbe\xcc\xef\x0c\x85\x86\x5f\x8a\x86\xb7\x10\xf0\xd9\xb1\x1a\xd5\xb1\x6b\xe0\x1f\x5b\xeb\x7c\xcb\xb9\x49\x6b\x92\x9b\x1e\xc2\x57\xef\xbb\xd2\x6c\xa4\xb6\x95\xef\x35\xa0\xdf\x0b\xa9\xe6\x7b\x83\x12\x58\x22\xdc\x3f\x3f\x7d\x51\x14\x97\x59\x0c\xdc\x7b\x71\x5e\xcc\xa2\xe6\x2f\x5e\xec\xbd\xb2\x54\x43\xd7\xd7\xae\xa3\x46\xf5\x4f\x06\xe7\x24\xe1\xed\xd4\x5a\x6f\x9b\xc8\xe7\xf4\xf8\xf0\xe5\xeb\x62\xe6\x3a\x55\x7c\x75\x7a\x7e\xb6\x7f\x6a\x81\x74\x9d\xc4\x65\xc8\x7b\xf1\x93\x25\x97\x98\xcc\xb3\xd3\xc3\x3d\x4b\x26\xd7\x3c\x7a\x79\x76\x78\x4a\xe3\xa4\x59\x02\x7d\x82\x5f\x05\xc9\xb9\x93\xf0\xaf\x11\x09\xff\x78\x72\x7c\xa8\x97\xfd\x31\x9a\xf9\x85\x92\x34\x9a\x57\x3f\xbc\x7e\xa5\x97\x7c\xe5\x5d\xfb\xaf\xe7\xf9\xb2\xeb\xd4\xea\xc1\xe1\x0b\xbd\xe8\x81\x3f\x2d\xb4\xb9\xc1\x0b\xec\x40\x2f\x77\x18\x8e\x0b\xe5\xd6\xb9\xef\x03\xa1\x05\xe9\xbd\x93\x7d\x6a\xbe\x3c\x4d\xb4\x09\xe9\x5e\x1c\x47\x77\x16\x50\x89\xab\xe6\x1b\xa6\xc2\xd6\x96\x09\xf5\xa7\x47\x3f\xfc\x48\xc8\x4d\x41\x93\xb9\xd8\xa7\xdd\xf2\x64\x3f\x5f\x94\x28\xf1\xc5\xe1\xf3\xac\xe4\x01\x97\x3c\xc8\x95\x1c\xac\xd3\xf8\x5e\xbe\x3e\x7e\x71\xb2\x6f\x99\xd2\x6d\x42\xe7\x4f\xaf\x48\x93\x18\x1b\x55\xb7\xb7\x38\x6b\x60\xc9\x1a\x72\xd6\xaa\x25\x6b\x9b\xb3\xd6\x8a\x59\x83\x7e\x9f\xf3\xd6\x6d\x79\x03\xce\xdb\xb0\xe5\xad\x72\xde\xa6\x2d\x6f\x8d\xf3\xb6\x6c\x79\xeb\x9c\x37\xb4\xe5\x6d\x70\xde\xb6\x2d\x4f\x8c\xfc\xc9\xe5\xc3\x05\xad\xbe\xc0\x43\xf7\x4b\xda\x10\x93\xf3\x8d\x05\xc6\x81\xc0\xd7\x8a\x2d\x4f\xe0\xb9\x67\xc9\x23\x0e\x7d\xf1\x6c\x8f\x89\xe1\xa6\x95\xd3\xde\xad\xea\xfb\x26\xab\xc2\x6f\x0f\xb4\x2a\x99\x06\x6f\x55\xe1\x69\xef\xbf\x38\x3d\x7c\x71\xb2\xa7\xd7\xca\x14\x79\xab\x26\x3f\x64\x3d\x54\xf0\x2d\x51\x27\xd3\xe6\xad\xea\x3c\x89\x78\x17\x6f\x81\x2b\x71\x1d\xa1\xd2\xb7\x2d\x3a\xfd\x2a\xef\xaa\xcf\x4e\x8f\xce\xbb\x59\xe1\x2d\xbd\xf0\xb6\x56\x78\x4b\x16\x7e\x92\x15\x1e\x6a\x85\xa5\x7e\xdf\xe8\x8e\x40\x67\x94\xcd\x1c\x23\xa9\x33\xb3\xc2\xcb\x4b\xf3\xa9\xc2\xf7\x15\xe7\x43\x68\x57\x07\xa3\xfa\x0f\xb7\x31\x88\xc4\x9f\x6b\x01\x7c\x64\x42\x98\x7b\xff\xf9\xab\x09\x1e\xd9\x44\x4b\x93\x68\xed\x0c\xd6\x7c\x6e\x91\x6b\xd2\x76\x71\x00\x43\xf9\x91\xac\x62\xff\x78\x42\xff\xb6\xcc\x2a\xca\x48\x29\x21\x30\x70\x24\xad\x76\xa7\xf2\x38\xae\xf9\xd4\xe1\xd6\xf1\x3b\xa0\xe5\xa7\xaf\x84\x97\xe7\x02\x2f\xcf\xeb\xf1\x02\x23\xf9\x7a\x68\x11\x9b\xf4\xb2\x98\xc9\x3d\x0b\xce\x61\x06\xc6\x03\x2b\xb8\x76\x20\xdc\xf7\xd7\x1b\xcb\x01\xbb\x23\x5a\x66\x69\x56\x3d\xcb\xcd\x3f\xbb\x2c\x9a\x92\x3f\xf2\xb3\xab\x12\xf7\x8f\xff\xc9\x53\x78\xb1\xb6\xc4\x6a\x55\x52\xca\x57\x9f\x80\xcd\x86\x13\x40\xf7\xf8\x5f\x6d\x0a\xa4\x24\xb5\xec\x70\x8a\xab\x22\xcf\x23\xab\x47\xf2\x22\x08\x9b\x90\x92\xb3\x53\x78\x23\x66\xac\x5b\xdb\xdb\x8f\xc6\x4b\x78\x4f\x2c\xe1\x3d\x77\x39\x74\x3d\x68\xfe\xbf\x06\xc2\x1a\x4d\xfd\xef\x8a\xb2\x67\x02\x65\xcf\x1a\xa2\x8c\xd5\xfb\x4a\x9b\x0c\xeb\x32\x57\x83\xbf\x0b\xe6\xfe\x3e\xbb\x09\x4b\xea\x86\xde\x10\x24\x71\x20\x5a\x09\x94\x76\x07\xfe\xbb\xdc\x33\x2a\xff\x38\xc5\x11\xa3\x03\x03\x01\xe3\x95\x1f\x0b\xae\x25\xe7\xb3\x6d\x37\x8f\xd4\x25\x90\xdd\x82\xf3\xbf\xd4\x66\xec\x6d\x75\x03\x38\x28\x77\xd1\x07\x79\x16\x17\x7f\xab\xe5\x15\x62\xd2\xae\x2d\x75\xd6\x2a\xeb\x5c\x58\xeb\xac\x57\xd6\xc1\xb3\x35\x4b\xa5\x8d\xca\x4a\x97\xd6\x3a\x9b\x95\x75\x7e\xb5\xd6\xd9\xaa\xac\xf3\xde\x5a\x67\x58\x81\xe9\xff\xb4\x61\x7a\xbb\xa2\xc2\xb6\x5b\xf9\xfa\xd7\xb2\x08\xc4\xe9\xfd\x17\x2c\x4c\x24\x53\x6e\xa5\x48\xa5\x75\x12\x72\xe5\x6d\x6c\xb3\x01\xc8\x5b\x8b\x2f\x1c\x81\x68\xe6\xef\x32\x04\x3e\x08\x6c\x64\x71\x5e\x32\x02\xf4\xae\x2d\x9c\x4e\xb5\xda\xf8\xa5\x5c\xa7\x17\xde\xf3\xb3\x59\xe9\x74\xea\xcd\x41\x14\xb2\xbd\xd6\x37\xbb\xc8\x5c\xc1\x94\xf0\x9f\x12\x88\xc8\x42\x5f\x41\xb1\x37\x81\x0c\xd9\x8a\xe6\x15\x3f\x46\x7f\xae\x04\xca\x79\x04\xc2\x31\xfb\x40\x40\x03\xf1\x8d\x7e\x29\x8b\x37\x59\x9b\xe9\xa5\xcd\x0e\xf6\xdf\x16\xea\xb2\xa6\x4b\x1c\xda\x37\xda\xcb\xac\xc6\x99\xee\xff\xe1\x2e\xb1\xd1\x35\x5a\x20\xa6\x18\x22\x9e\xef\x47\x73\x3f\x6c\x29\xbf\xeb\xf2\x19\x74\xef\x26\xf6\x27\xa0\x8c\xe3\x11\x01\x3f\xf0\x7f\x1a\x46\x1d\xf2\x1d\xf8\xf4\xde\x4f\x3a\xec\x55\x9c\x7e\xb2\xdc\x72\xe5\xc5\x09\x7d\x02\x3e\x82\x59\xf0\x57\xef\x6a\xca\xd9\xe4\x3c\xeb\x29\xda\x56\x8a\x0e\x83\x30\xf4\x45\xf4\x22\xf4\xf3\xcb\x0e\xc8\x0a\x05\x38\xe4\x40\x03\x21\xc8\xfd\xef\x4b\xe0\xe9\x4d\x23\x3c\xe9\xfc\xa1\xa0\x6c\x68\x8e\x92\x4c\x95\x12\x57\x50\x69\xd1\xef\x2b\xb8\x93\x30\x00\x7f\xb0\x95\x0d\x22\xe1\x7f\xb8\x4b\xf0\xd3\xff\x42\xc4\x02\x0b\xb2\x9a\x58\xa8\x40\x63\x62\xf9\x4a\x3b\xd2\x57\x64\xe7\xbb\x15\x78\x07\xfa\x8a\x73\xec\x5e\x67\xef\xe4\xc3\xd1\x14\xf9\x8c\x49\x53\x2f\x02\x2b\xf4\xcd\x66\x2c\xb1\x3c\xd2\x41\x01\x3e\x83\x77\x9a\xd1\x0e\x6a\xb8\x7e\xbb\x39\xfe\xdf\x2c\x4f\xc1\x95\xcb\x27\xaf\xc2\x4b\xd7\x62\xbf\xbf\x70\x60\x1e\x4f\xd7\x7b\x2b\xb5\xec\xdf\x45\x5f\x6f\xfa\xa1\x43\xf6\x10\x00\xcd\x23\xc8\xfe\x59\xd0\x0c\x6a\x7a\xff\xa7\x5b\x3a\x0c\x5e\x34\xa6\x78\x31\x28\x38\xc7\x32\xa8\x1a\x3b\x7f\xee\x8d\x52\x74\xec\xb8\x94\x06\xa3\x16\x8c\x4d\x83\x31\x41\x70\xfb\x34\x88\xb8\x5d\x06\x47\xa2\x39\xf8\xed\x97\xfb\x11\x0d\x2a\xd7\x67\xe6\x22\xd8\x94\x45\xdc\x2e\xf7\x4e\x6f\xb6\xf9\x12\x22\x87\xd2\xc0\xe9\x3e\xe5\x20\x8d\x18\xbf\x6d\xd0\x69\x02\x66\x50\x11\x8d\x6d\x79\x45\x58\x3c\x08\xa9\x0d\x9b\x31\x1d\x8f\x30\x88\x66\xb4\x08\x53\xcd\xa1\x9d\xb4\xfa\x7c\xaa\xbb\x31\xb0\x3f\xd2\x9b\x45\xe3\x60\x12\xf8\x71\xa2\x1c\x60\x69\x36\xb3\x36\x9f\xd5\x17\x29\x3e\xa6\xc0\xb7\x39\x74\x12\x81\x17\x2d\xdf\xa8\xd7\xb6\x98\x2a\x37\x5b\x03\xb8\x27\x4f\xea\x1c\x26\x57\x02\x47\x31\xd2\x68\x35\xd0\xf6\x04\x0b\x06\xff\xc5\x97\xf2\x1d\xf1\x34\xfe\xb2\x53\xff\x0a\xb1\x19\xbf\x31\x91\x8a\x6f\x7b\xcc\x94\xef\x9d\xee\x80\xce\x6d\xcc\xe4\xef\x2c\x05\x89\x79\x57\x0f\x51\x8b\xbb\x81\x6f\x7f\xea\xb9\x87\x31\xc1\x8f\xb4\x19\xb6\x46\x99\x55\x11\xca\xfa\xbb\x7f\x68\xf4\x68\xb3\x8a\x1e\x02\xdb\xcb\x26\x8a\x9d\x4b\x8f\xb8\x48\x8e\xbf\x08\x2e\x2d\xce\xb4\x5a\xf4\xf4\x1a\x09\x45\xf0\x1e\x28\xd6\x96\x94\x02\xbf\x33\x77\x41\x71\xce\x6f\x88\x41\x2d\x1d\x27\x5e\x0a\x9b\xe2\x25\x55\xb3\x63\x6b\xc6\x3f\xe2\x5c\xf6\xbf\x44\x37\xb5\xeb\xb5\xa4\xa7\xbe\xd6\xd3\x89\x0a\x4c\x51\xb9\xe6\x63\x0f\x68\x19\xbd\xef\x92\x8b\x13\x96\x5f\xb3\x44\xf5\x1a\x0b\x43\x2f\xfa\x6f\x8d\xc2\x8f\xc4\xe5\x58\x21\x53\xfa\x55\x8d\x83\xeb\x20\x14\xee\x4d\x55\xe9\x2c\x55\xba\xd1\x5c\xa4\xd1\xbe\x17\xc7\x81\x77\xed\x9f\xf2\xb0\xb4\xe2\xc5\x5c\xe9\x29\x94\x8e\x26\xdf\xb0\x73\x68\xbd\x86\x91\x61\x14\x7e\x36\x0d\xc2\x0f\xc5\xa2\x94\xdc\x51\x9e\x02\xfd\x38\xcd\xc3\x9c\xa5\x9a\xf8\x78\x13\x8c\xfd\xc8\x82\x0a\x4a\x97\x4e\x4f\x63\x6f\xf4\xc1\x4f\xfd\xb1\x72\xa4\x2a\x0b\x9b\x39\xcb\x3e\xba\xe3\x57\xc3\xd5\x2f\xe2\x39\x12\x17\xba\x20\x90\xfe\x4e\xe7\x51\xa2\x31\xf6\xd1\x4d\xc1\xf3\x28\x37\xab\xfb\xec\xc4\x37\x9f\xb5\x84\xa8\x79\x45\xa7\x16\x1c\xae\xe6\xa5\x99\xd3\x67\xec\x1a\xe4\x75\xf9\xa6\xae\xa2\xcb\xec\x15\x6c\x5d\x18\x99\x6c\x4c\x4f\xf5\x11\x8b\x57\x73\x15\x5d\x14\x5f\x91\x59\x03\x60\x29\x04\xfa\x06\xfe\x88\xfd\x18\x48\xf4\x0d\xf1\xa4\xa2\xe3\xfc\x8b\xec\xd2\x11\x22\x33\xf4\x0d\x1f\x42\x38\x51\x9f\x77\x4b\xde\xbc\xaa\xae\x80\xf4\xc2\x54\xf0\x5c\xc9\x7d\xf1\xf2\xa9\x0d\xcc\xb4\xb6\x10\x6c\xb8\x97\xb9\x00\x94\x22\xb0\xb8\x1a\xfd\xb7\x16\x44\xef\x5a\xa3\x51\x24\x1f\x82\x39\xc7\xa3\xb6\x3c\x62\x57\xba\x10\x21\xe4\x3c\xfa\xe0\x87\x2d\x8b\x37\x23\x37\x08\x53\xff\xda\x8f\x59\xc4\xa2\x48\xa0\x6d\xcd\x05\x1b\xa6\xdd\x7a\xd3\x85\x2f\x64\x3a\xae\xa2\x06\x67\xd4\xb2\xbf\x00\x0b\xb2\x36\x7a\x69\xf4\x7a\x3e\x2f\x3a\xc5\x34\x9e\xd7\x36\xc2\x35\xae\xef\xda\x42\xbd\x1b\x2f\x39\xb9\x0b\xd1\x1d\x10\x30\x97\x7b\xd8\x0e\xab\xbc\xea\x50\x80\xe0\xda\xe9\x0b\x2e\x77\x4b\x1d\xc6\xf8\x17\x09\x49\x53\x62\xdb\xc4\x4f\x19\xd3\x80\x26\x42\x3c\xee\xce\x1e\xc1\xca\x96\x79\xc5\x0a\x24\x95\x3c\xbf\xa7\xc6\x51\x2e\x58\xde\x91\xcd\xa3\x0a\xa4\x8a\x79\x2e\x47\xa8\x2c\x60\x41\xa6\x6d\x70\x32\x60\x0f\x54\x6b\x34\x2a\x8d\xd0\x2a\xbb\x07\xc4\xd7\xbc\xef\x2f\x45\x03\x3b\x42\xb8\x9f\x5d\x45\xec\xe6\x50\x92\x6b\x11\x78\xf5\xec\x5e\xac\x09\x07\x56\xa6\x46\xeb\x25\x14\x2b\xa6\x5b\x0e\xd4\x8a\x14\x5f\xb6\xcc\x70\xd4\xa1\x46\x71\x9e\xb0\x21\xe7\x29\x27\x6b\xaf\x11\x59\x87\x97\x65\x54\xe7\x5d\x72\xb8\x4a\xfc\xf1\x14\x49\xbb\xce\xcb\xce\x67\xfb\xe9\xb7\xc6\xab\x3a\xa4\xd1\x3d\x52\xbe\xa7\xdb\x36\x8f\x77\x58\x51\x84\xcc\xd4\x45\x66\xdb\xa4\xa5\xb1\xa7\x3b\xd4\xf1\xf0\x3c\xc5\x49\xbd\x18\x54\x4a\xa4\xc3\xfc\xbc\x89\xa7\x63\xb7\x1e\xba\xeb\x18\xd8\xb4\x40\xd5\xf7\xd3\x9a\xbe\x8f\x83\x24\x41\x1f\x06\xf6\xce\xa4\x86\xd2\x60\xb3\xda\x93\x4e\x63\xaa\x64\x0d\x1b\xb7\x97\x9b\x58\x05\xa7\xcf\x3b\x02\xf1\x05\xfd\x2b\x05\x8a\xf9\xbb\x59\x3e\xc7\xe2\x7d\x2b\x8b\x67\x47\xc4\x54\xbd\x94\x4e\x3d\xe1\x5c\xbd\x8c\xbf\x88\xfc\x3c\x7b\x11\xcd\xea\x27\x1a\xe5\x95\x2f\x44\xe9\xcb\xfc\x44\x57\x70\x27\xc3\x83\x93\xec\xcd\x42\x0b\x15\x5c\x42\x44\x66\xcf\x31\x89\xaa\xd9\xf6\xfd\x0f\x0d\xa3\xf7\x3d\xd6\x9d\xb3\xe3\x0a\x7c\xec\x3e\xd6\x52\x6a\x3a\x39\x52\xf0\x34\x92\xef\x46\x37\xac\xe2\xb4\x56\x2e\xbc\xee\x5f\xdf\x5f\xae\x04\x75\xa3\x38\x12\x0c\x72\xc9\xd6\xfb\xdd\xed\xcb\x95\x5a\xe1\x8d\x28\xb8\x3c\x72\x97\x60\xb9\x4f\x35\xfe\x51\xd4\x72\x49\xb0\x13\x21\xa1\x05\xef\xef\x38\x34\xc9\x3b\xb2\xda\x67\xab\x5b\xdd\x1c\x77\xe8\x14\x3c\xa6\x1b\x5c\xcd\x44\xb6\x76\x00\x27\xfb\xd6\x28\xc3\xec\x9f\x46\xaa\x57\xfd\xbc\x6b\x6f\x9e\x09\xc6\xd2\xb4\x58\xd2\x96\x66\x65\x95\xb2\x26\xc5\xec\xd9\xc0\x15\xc2\xa0\x0d\x56\x59\xe9\xf3\xee\x1f\xaa\x56\x98\xda\xea\x52\x9c\x46\xb7\x76\xb6\x1b\xd0\x6a\x76\x19\x59\xc4\x77\xd5\x2e\x6e\xdd\xba\x0d\xc5\x06\x01\x90\x0e\x7e\x88\xf8\x81\x42\xdf\x5f\x3e\x59\xb9\x0e\xea\xe1\x6e\xb6\x04\xc4\xd1\xa9\x5c\xc9\xca\xa5\x71\x89\xa0\x8f\xbe\x0a\x49\x86\xfc\xe8\xe6\x55\x2e\xa1\xff\x68\xaa\xde\x80\xee\x19\xbe\x77\x24\x3c\x2d\xcb\xa0\xfa\x1f\x71\xd5\x79\xdd\x09\x8f\x0a\xaf\x25\xaa\x8a\xbf\x1b\x43\xb1\x76\xed\xe0\x6b\xb8\x98\x5c\x81\xa4\x15\xab\x68\x4d\x37\x26\x39\x22\x47\x53\xae\xee\x1f\x4b\x57\xf7\xc5\xf9\x14\x7d\x49\xba\x2a\x44\xfd\xca\x16\x6b\x89\xcf\x1f\x54\x9d\x4f\xfd\x6b\xa0\x8b\x96\x7b\xf1\x0e\xfe\x43\x1a\x33\x07\x36\xc5\x97\x59\x18\xf8\x6a\x39\xed\x8b\x3d\x70\x4d\xbd\x24\x3d\x0a\xc7\xfe\x47\xb5\xff\x46\x49\xc7\x79\x14\x53\x78\xf2\x96\xd6\x4c\x89\xa4\x2c\x8e\xa5\xb5\xad\x64\x1a\x40\x5a\xd1\x41\x2e\x87\xc8\x78\x62\x23\x07\x72\xbd\xa4\xfa\xd6\x61\x62\x97\xda\x06\x86\xcc\x6c\x35\x64\x2e\xa9\xa6\x03\x0f\xfe\x10\x47\xba\x80\x46\x4c\x57\xcc\x91\xb5\x80\x5d\xec\x56\xea\x3b\x4d\x6d\x3d\x87\xd5\xa3\x00\xa8\xf1\x8e\x6e\x3a\x85\x89\x36\xbc\x5e\x69\x2d\xa9\x4a\x44\xa5\xec\x27\xb2\xd5\x6c\x5b\xaf\x9c\x8f\xba\xf5\xa0\x42\xe5\xd4\x1c\x35\x18\x23\x05\x8c\xef\xe0\x1f\xd3\x45\x02\xa0\x79\x07\xff\xe4\x52\x01\xe1\x3b\xf4\x37\xe7\x12\x08\x12\xff\x5b\x2e\xed\x6a\x87\x1e\xb1\x9a\x89\xc8\xdd\xff\x98\x4b\x9b\x60\xc1\x49\x2e\x11\x45\xa2\x77\x61\x2e\x11\x55\x54\x7c\xd4\x67\xca\x57\x98\x98\x0f\x18\x7a\x8b\x89\xb7\xb9\xc4\x8f\x3b\x15\x71\xdb\xed\x62\x6c\x9e\x2b\x5f\x7e\x5a\xfd\x8c\x0c\xac\xd4\x75\x8c\xcd\x64\x50\x71\x3a\x1f\x38\xe5\x66\xde\x05\x9b\x09\xe3\xe2\x6b\xc0\xb8\xfe\xb5\x61\xcc\x48\x77\xd7\x76\x27\x09\x6b\x4b\x9d\xe4\xe7\x45\x68\xb9\x36\x2b\xf5\x74\xf6\xbe\xaa\x85\x87\x86\x0a\xbb\x05\x17\x6c\xfe\x85\xc8\xbb\xb4\xef\xa3\xfa\x1a\xb4\xfa\x7d\x4a\xc5\xa5\x02\xde\xda\x29\x4f\xd7\x78\x3f\x90\xd6\xad\xac\xda\x83\x7a\x11\x51\xe5\x7a\x1a\x5d\xa1\x17\xea\xe2\x50\x71\xa3\x8e\x31\xe4\x1a\x7d\xef\x38\xa0\x43\x85\xb0\x07\x52\xb3\x89\x33\x5b\x24\xa9\x73\xe5\x3b\x5c\x5f\xe7\xbc\xbe\x95\xc1\x5b\x90\x53\xe4\xf7\x79\xcf\x74\x7c\xe8\x6f\x30\xdf\x8b\xfe\xa5\xdc\xf2\x1f\x65\xad\x57\xca\x33\x24\x45\xe3\xd6\x26\x4f\x78\x77\xcb\x4f\x6b\x73\xdd\x0d\x3a\xc5\x89\x42\x10\x04\xf6\x2d\xc8\x17\x25\x6b\x2e\x37\x48\x0e\xd1\x62\xca\xdf\x38\x95\x32\x8b\x76\x74\x6b\xe9\x52\x29\xb8\xd5\x93\xbd\xf2\x2e\x11\x51\x05\x14\x85\x5b\x14\x00\x9c\x19\x28\x09\xd2\xcc\x2c\xa7\x15\xd6\x4c\x6b\xe1\xfc\x94\x87\x52\xdc\x91\x53\x9e\xf7\xb8\x9d\xc5\xbe\x10\x87\xd7\x59\x0f\xed\x25\x10\xd3\xe1\x75\xdc\x1d\x68\xf2\x62\x2f\xc0\x56\x4e\x26\x2d\xbf\x5a\xd4\x8d\x42\x1f\xfd\x44\x12\x59\x70\x20\xf7\x09\xde\x13\x99\xeb\xba\x60\xc7\x6a\xd3\xa9\xd9\x45\x60\xfd\x01\xac\xbe\x99\xd1\xc3\x6e\xd4\x48\xf8\xc2\x37\x0b\xa2\x76\x8e\x81\x79\xc5\xf5\x6f\x96\x7a\xf2\xf2\xfc\xf4\xc4\x92\xb1\x87\x51\xd4\xf8\x92\x58\xa5\x1d\x1f\x9e\xef\x49\x6f\xea\x15\x50\x67\xe7\x98\x3a\x5c\x87\x67\xfb\x7b\xaf\x0e\x77\x9c\xd5\xad\x8e\x9e\x66\x26\x3c\x1f\xec\x38\xe8\x01\x28\x4b\x58\xc5\x84\x35\x2d\x61\x0d\x13\xd6\xb5\x84\x75\x4c\xd8\xd0\x12\x36\x30\x61\x53\x4b\xd8\xc4\x04\xbd\x97\x2d\x4c\x18\x6a\x09\x43\x4c\xd8\xd6\x12\xb6\x21\x61\xb5\xaf\x03\xd6\xc7\x94\x81\x9e\x82\xb0\xae\xea\xb0\x0e\x10\xd8\x55\x0d\xd8\x93\x97\x30\xe0\x75\xad\xdd\xf3\xb7\x27\x3b\xce\x86\xd6\xee\xf9\x8f\xa7\x87\x50\x66\x43\x6f\xf8\xe4\xf5\x29\xa4\xe8\x0d\x1f\xbd\xc1\x32\x5a\xc3\x67\x47\x3f\x43\x82\x86\x85\xb3\xc3\x37\x87\x2f\x21\x49\xc3\xc3\x21\x3e\xf7\x85\x24\x0d\x13\x2f\x8f\x10\xa0\x0d\x0d\x15\xbf\x1c\x9e\x02\x44\xeb\x1a\x2e\xf0\xfd\xe6\xd9\xab\xbd\x7d\x28\xa8\xa7\x42\x9a\x99\x70\x66\x7c\x9e\xef\x3d\xdb\x71\xb4\x81\xfe\x0b\xe4\x6a\x63\x7a\x0b\x9f\xfa\xbc\xef\x38\x9b\x5a\x61\x18\xee\x50\x1b\x2e\x00\x3d\xd4\x86\xf6\x17\xf8\xd4\x0a\xbf\x86\x4f\x6d\x94\x47\x3b\xce\x96\x8e\x71\xf8\xd4\x0a\x23\xcc\x1a\xb6\xd1\x0d\xc5\x7b\x7c\xad\x8c\xd1\x5f\xcd\x64\x7b\xaa\x99\x02\xc4\xbf\xa9\x75\x8d\x18\xd0\xba\x3e\x80\x5c\x9d\xa6\x00\x12\xad\xee\x0f\xf0\xa9\x21\xe4\x47\xf8\xd4\x86\xfc\xcf\xf0\xa9\x0d\x19\x20\xd9\xd2\x3a\x82\xe5\xb9\xa5\xcd\x22\xb9\x76\x00\x4a\x5b\x33\x92\xcc\x84\xd3\xc3\xf3\xd7\xa7\x2f\x0b\x69\x66\xc2\x2f\x18\x20\x37\xfb\x04\x92\x1a\x6a\x03\x80\xa5\xb9\xa9\xcd\xd9\x1b\xc8\xd5\x80\x80\xe9\xde\xd4\x29\x0b\x40\xd4\xea\x1e\xc3\xa7\x56\x57\xd0\xd3\xda\xaa\x9e\x64\x7e\xbf\x3a\x3d\x7a\x79\xfe\xfe\x6c\x1f\x96\x03\xb4\xb5\x6e\xe4\x9c\x23\x9b\xd0\x93\xa0\xd8\xc9\x8b\x17\x62\x26\xd1\xeb\x80\x9e\xf3\x22\x9f\x46\x2e\x07\x20\x6d\x5b\x4f\xca\x25\xb0\xe7\x01\xe8\x65\xc3\x48\x33\x13\xd0\xe1\x00\x40\xbd\xa9\xc7\x90\xfc\xe1\xf0\xfd\x6b\x1c\x8a\x86\x56\xf4\x36\x60\xa6\x1c\x1c\xbe\x38\x3c\x47\x56\xb0\x69\xa4\x99\x09\x87\x2f\x81\x82\xd6\x36\x72\x8d\xe3\x1b\x7f\x48\x5e\xd7\x9b\xb7\xa6\xe5\x52\x08\x04\x6d\x46\xb8\xce\xba\x36\xdd\xa7\xcc\x1f\xd6\x34\x2c\xe0\xb3\x7f\x48\xd1\x66\x4e\xbc\xee\x47\x8c\xea\xf4\xf9\x0a\xb8\xe1\xf6\xa6\x9e\x00\xcc\x70\x7b\x4b\x4f\x00\x5e\xb8\x3d\xd4\x13\x80\x71\x6f\x6f\xeb\x09\xeb\x14\x50\x58\x4f\x41\xce\xdd\x1f\xe8\x29\xc8\xba\xfb\xab\x7a\x0a\xf2\xee\xfe\x9a\x9e\x82\xcc\xbb\x6f\x40\x87\xdc\xbb\xbf\xa1\xa7\xbc\x7f\xf5\xe2\xf5\x19\xa6\x1a\x30\xbe\xdf\x3b\x38\x28\x26\x1e\x1f\xbd\xe4\xb2\x06\xb4\xef\xcf\x5e\x3f\x3b\x3f\xdd\xdb\x3f\xb7\xe4\x9c\xef\xe1\x7a\xec\x6f\x9a\xcd\xbc\x7e\x71\x7e\xf4\xea\xc5\x5f\x8a\x39\x07\x47\x6f\x8e\x0e\x0e\x71\xcb\x19\x98\xe9\x87\xfb\x47\xc7\x7b\x2f\x30\xa3\x6f\x02\x7f\x78\x7a\x74\x72\x90\x4b\x7f\xb9\xf7\xe6\xe8\x87\xbd\xf3\xc3\xf7\xc8\xb1\xc9\xbb\xa1\x25\xef\xf9\xc9\xe9\xdb\xbd\x53\xac\xaa\xaf\x65\x7e\x3c\x8f\x89\x3a\xc3\x7a\x0d\x4b\x4a\x2e\xc0\x81\xce\xd9\xde\x1e\xbd\x04\xf2\x79\x7f\xf2\xe6\xf0\xf4\xcd\xd1\xe1\x5b\xcc\x5d\xd5\xd7\x12\x12\xd2\xcb\xc3\xb3\x33\x5a\x09\xab\xfa\xd6\xab\xe5\x31\x01\xae\x0e\xb6\xaa\x24\x07\xaf\x22\xfa\x6b\xa5\xef\xc3\x62\x04\xd8\x86\x8e\x10\x39\x0a\x6c\x85\x1b\xc4\xec\x91\x99\xf6\x32\x78\xa7\x4c\x26\x36\x8d\x6f\x97\x78\x4b\xfc\x07\x8b\x26\x6c\x3e\x85\x5d\xb6\xcf\x26\x6f\x28\xcb\x7b\x3d\x8f\xce\xa3\xa5\x86\xd9\xe0\xed\x77\x55\x6f\xcf\xa2\x34\x8d\x66\x7f\x83\x0e\xd9\xde\x5b\x18\xe1\x8f\x3e\x34\xeb\x71\xe9\xc7\x99\xba\x64\xaf\x6c\x9c\xa6\xc1\x55\x6f\x82\xa7\x9c\xf8\xe3\x2c\x8d\x62\x98\x25\xb7\x8d\x2b\x21\xf6\x27\x7e\x8c\x06\x32\xc7\x5e\xe8\xe5\x0f\xb2\x4d\xa5\xab\x58\x58\x0f\x57\x15\xf5\xc6\x1c\x81\x49\x34\x8f\xee\x87\xc8\xb4\x6a\x05\x54\xbb\x49\x30\xf5\x93\x95\xbc\xba\x2a\x15\x34\x0b\x18\xb2\xb1\x2c\x43\xd3\xcd\x4e\xae\xfe\x0d\xf4\x1d\x14\xf6\x93\x56\xda\xb6\x58\x3c\xfa\x56\x3b\x19\xba\x1c\xf6\xb3\x16\xc9\x14\xe9\xc2\xbf\x34\x62\xe8\xda\xcc\x5c\x23\xeb\xc8\x53\xff\x3a\x8a\x03\x3f\x53\x95\x2a\xca\xe8\x71\xe1\x5d\xf9\xdb\xad\xab\xb4\x37\x9f\x03\xc5\x08\xfd\xdb\xcd\xbe\x6a\x2b\xe2\x33\x02\x69\x06\xe7\xaa\x8f\xda\x6a\x67\xa8\x2c\xe2\x78\x5c\xfe\x55\x5f\x81\x88\x59\x44\x0f\x57\x1f\xb5\xd5\xf4\x98\xe3\xf2\x77\x6d\xa5\xe3\x20\x19\xf9\xd3\xa9\x17\xfa\xd1\x82\x60\x34\x12\x2a\xab\xa3\x6f\x58\x61\x16\x40\x26\xc9\x1a\x4d\x8f\x77\x9c\xc6\x93\x90\xad\x64\x0c\x6d\xbf\xa3\xcf\x88\xd3\x9a\xc0\x1a\x85\x55\x30\x8a\xa6\x51\x8c\x7e\xaa\x67\xd0\x4e\xd2\x56\x2f\x83\x96\xea\x52\xcd\x58\xa1\x47\x7e\x1f\xe2\xf0\x74\xaa\xb6\x1b\x34\x29\xd1\xdc\x91\x2d\x29\xbc\x2f\x0b\x9c\xa4\xde\x02\x6c\x8a\xac\x97\x81\x4b\x11\x8d\x02\x2c\x23\xa3\x65\x21\x63\xa2\x2d\xc0\x25\x68\x79\x19\xa8\x0c\xd2\x52\x90\x61\x6a\xcf\xfd\x7c\xd9\x69\xc6\xaf\x0c\x51\x02\xcf\x33\xba\xd7\x71\x77\x16\x8d\x7d\x77\xc7\xb9\x68\x8a\x64\x11\x08\xe9\x82\xff\x71\xc3\x28\xf4\xa5\x29\x7d\x57\xd8\xd1\xab\x58\x90\xe8\x15\x52\x85\xaa\xbc\x84\x2f\x7e\x1b\xe4\x78\xa1\xb3\x87\x41\x35\x9d\xb1\x9f\xfa\xc2\xff\x35\xec\x3f\xbf\xbe\xbd\xf1\x17\x71\x90\xa4\xc1\xa8\xf7\x2e\x7c\x17\x3e\xc6\x3e\x1e\xef\x38\x7b\x8b\x34\xe2\x92\xce\x95\x97\xd0\xa9\x92\x13\x7a\xb7\xc1\xb5\x07\x8c\xbd\x07\x48\xb9\x5e\x00\xb8\x3b\xef\x42\x71\x7d\xe1\x87\xdd\x45\xf2\xd8\x79\xfa\x9d\xf3\x18\xc1\x7b\xdc\xe1\x5b\x19\xfc\x56\xe0\x3c\xc6\xe6\x31\x73\xc7\x39\x08\x12\x7c\x72\x06\x50\xdd\x0b\xb0\x62\x7f\x4a\xb7\x2d\xb3\x45\x78\x8d\xe7\xe2\x50\x56\x8e\x0f\xc1\x49\x92\xc5\xcc\x77\xf0\x05\xe1\x13\x28\xef\xcc\x7c\x0f\x56\x31\xd5\xc4\x92\x72\xf4\x59\x49\x4c\x71\x2c\x25\x33\x60\x54\x51\x4a\xb2\x94\x75\xb5\x78\x0a\x34\x73\xca\xa7\x7e\x37\x48\xba\x78\x0a\x95\xa5\x2c\x35\x9b\xf4\xee\xe2\x2a\x22\x33\x04\xf7\x08\x23\xba\xa4\x1d\x07\xc8\x33\x02\x72\x49\x6f\x60\x94\x1c\xf5\xf9\xe4\x0c\x41\xe9\x2a\xb7\x2b\xdd\xef\x40\x67\x03\x3c\xcd\x30\x86\x62\x12\x41\x51\x2f\x75\x0c\xb8\x1c\x3c\x21\xc4\xcb\xee\xc4\x4c\xef\x15\x86\x22\x06\xf0\x70\xb0\xcf\xfc\xd4\xb9\xbb\xf1\x01\xdc\x98\x60\x86\x46\xa5\x25\x0d\x74\x0e\xff\xa3\xf0\x13\x94\x84\x96\xc9\x98\x30\x46\x2a\x0b\x47\xa9\x2c\x5b\x84\x2a\xc1\x58\x05\xdd\x3b\x18\xd7\x52\x80\xc9\xb0\x71\xb0\x42\xb2\x9f\x22\x2c\x5e\xc7\x8c\x93\x89\x0b\x62\x9f\xe3\x6b\x24\xce\x0d\x9d\xa0\x66\xb0\x03\xda\x6e\xbc\x70\x3c\xf5\xc7\xb4\x14\x1c\x71\x21\xd2\xe3\xff\x38\x18\x49\x01\x97\xd1\xe1\xd9\xbe\x33\x07\xc8\x82\x8f\x3d\x2c\x44\xfd\xf4\x64\xa1\xbd\xf1\x18\xc3\xe5\x39\x69\x44\x4d\x2f\x42\x3a\x07\x85\x39\x41\x7f\x15\x80\x1c\x9f\x90\x11\x84\xce\x47\x32\x38\xc7\x06\x34\xf8\xa0\x81\xb7\x5e\x90\xd2\x95\x35\x56\x87\xa4\x39\x3a\xd5\x71\x28\xb8\x25\x74\x3e\x06\x62\xf1\x1d\xc4\x10\xe5\x8b\xaa\x8e\x5c\x86\x5a\x34\x5d\xef\x3e\xe9\x39\x4e\xeb\x1c\x44\x18\xe7\x2e\x0a\x1f\xc3\x74\x45\xf1\x07\xe7\x0e\x38\x1a\xae\xe5\x39\xac\x36\xe8\x65\x96\xe0\x2c\xc6\x3e\xb5\x56\x6c\x45\xb6\x3f\xf7\x63\x2e\x4c\xc6\x75\xc8\xe2\x84\xc9\x14\x41\x9a\x20\xb5\x22\x12\x65\x68\xa2\xa4\xd7\x36\xe7\x76\x31\xc6\xe7\x01\xdd\x2b\xe8\xbc\x9b\x20\x27\x6e\x30\xbf\x82\x91\x93\xb4\xda\x05\x24\xd0\x59\xf9\x0e\x0b\x93\xd8\x60\xb4\x82\xcd\xe1\x04\x2f\xf8\x45\xd1\xeb\xd3\x17\x78\xee\x8d\x23\x91\x8f\xbc\x1c\x2c\xe2\x50\x8f\x80\x8c\xc3\xd9\x3c\xbd\x97\xd7\xb9\x08\x78\x18\x39\x02\x34\x2a\x68\x12\xe4\xd8\x4f\x3e\xa4\xd1\xbc\x1b\x46\xa8\x1d\xf2\x3b\x5b\x1a\xc1\x12\xb0\xeb\x4b\xe6\x31\xac\xf4\x34\x5e\xa0\xb8\xa9\x43\x97\x70\xa4\x1c\x80\x00\x56\xeb\x35\x3f\xf7\xb8\x0b\x00\xea\x51\xec\xa3\x5d\xb0\xe7\xbc\xf5\xaf\x9c\x97\x1a\x10\x3d\xe7\x26\x4d\xe7\xc9\xce\xca\xca\xdd\xdd\x5d\xef\x6e\xad\x17\xc5\xd7\x2b\xe7\xa7\x2b\x3a\xa0\xc9\x0a\x52\x30\x70\x59\x98\xe7\x7b\x1c\xae\x91\x09\x4c\xe4\xdf\x17\x01\xe0\x14\x27\x76\x86\x76\x8b\x38\x93\xc0\x74\x98\x66\x51\x2b\x76\x60\x5f\xe0\x3b\x24\x27\x9a\x73\x00\x90\x04\x19\x15\x11\x36\x8d\x82\x66\x82\x41\xc5\xfb\x33\xc0\x2d\xe6\x79\xc9\x07\xd5\x08\xe1\x58\xeb\x21\x98\x38\xa1\x0f\xd4\x91\x78\xf1\x7d\x0f\x87\xa4\x08\x38\x71\x66\xde\x3d\xc2\xe8\x24\x37\xe2\x5a\x43\xaf\x88\xe0\xfa\x49\x8a\x0d\xc0\xe2\x18\x07\x63\x2a\xca\x6f\x5b\x10\x47\x04\xba\xc7\x7d\x32\x5d\xd2\x02\x16\x7c\x14\xf6\x6d\x3f\x4c\x68\xdc\x77\x41\x7a\x43\xe0\xb9\x06\x3e\x5c\xbd\xb3\x1b\xef\xd6\xd7\xbf\x61\x50\x63\xc6\xa3\x89\xc4\xde\x63\x9d\x5a\xb2\xd9\xeb\x92\xf4\xd7\x80\x48\x34\x99\x12\xf6\xec\xeb\xab\x16\xfa\xf0\xe3\xff\xb7\x69\x7b\xa7\x76\xe0\xc7\xb9\x49\x1d\x94\xce\x2c\x02\x86\xc6\x63\x02\x4a\x8e\x88\x17\x73\x26\x4c\x48\x1c\x5c\x2d\x52\xe8\xc5\x2d\x81\x92\x64\xd3\x65\xa1\x44\x68\x94\xa9\x99\xbb\x7f\x76\xc6\xa6\x61\x72\xd9\x69\x40\x52\xf3\x25\x6b\x8e\xf3\x70\x86\x9e\x43\x82\xff\xd1\xc3\xe7\x27\x24\x4a\xc0\x4a\x6e\x49\xe2\xbe\x8e\xa2\xde\xf5\x74\x05\xc4\xaf\xf1\xf9\x4f\x6d\xcc\x05\x79\x10\x40\x01\x01\xca\x1b\x07\xf8\x74\x1b\x56\xa7\x73\x45\x7a\x7b\xc7\xb9\x9a\x22\x4d\xc6\xfe\xb8\x5d\x36\x60\x7c\x01\xff\x3b\x8f\xd7\xc1\x3e\x60\xd0\x22\x0a\x71\x82\xb4\x83\x02\x4f\xe9\x24\xcc\xa3\x84\x14\x92\xdf\x1b\x2e\xd9\x8f\x15\xe9\x83\xfe\x9f\xf1\xff\xf8\x73\xe4\xe3\xfd\x78\x01\x5a\x16\x3f\x78\x77\xfe\x3a\x22\x8f\x60\x84\x02\x56\x16\x5e\x60\xe9\x2f\xa6\xb8\xc1\x01\xd8\xcf\xce\x9c\xd6\xe3\x77\xef\x3e\xf6\x87\x20\x49\x7a\x1f\x3c\xe7\xd7\x1f\xdb\x80\xd8\x13\xa4\xf1\xbb\x20\xf1\x73\x55\x71\xf7\xd6\xab\x63\xd5\xad\xc9\x63\x13\xef\x6a\x07\xee\x82\x04\xd5\x8d\x6e\xfd\x38\x0e\x80\xc9\x2f\x89\x7b\x21\x83\x13\xbe\x91\xab\xd3\x06\x8b\xbc\x71\xee\x8f\xf0\x14\x71\x4c\xc2\x4e\xe8\x44\x7c\x3c\xe1\x1c\xa5\x24\x8e\x41\x3e\xbd\xd4\xf2\xe2\xd8\xbb\xef\x88\x4d\xd7\xf7\x46\x37\x20\x4a\xb0\x8d\x06\x16\xc3\x51\x65\x82\x02\xb2\xdb\x11\xbe\x87\xc0\x7d\x1f\xb3\x84\x75\xb5\xd6\x3e\x0b\x81\x85\x0e\x80\x4d\x26\xfe\x74\x02\xbd\x87\x5c\xc2\xec\xdd\xda\x6f\x0c\xac\x39\xb8\x35\x25\x95\x7c\xbf\xf8\x21\x98\xa1\x5e\xd0\x4a\x56\x9f\xdc\xbe\xbb\xf3\xc9\x7d\xe2\xee\xb8\xef\xde\x2d\x56\x07\xdb\xab\x6e\x07\xfe\x2b\xbf\xfa\xf0\xbb\xab\xbe\x06\xf0\xd5\x53\x5f\x6b\x48\x25\x7d\xf1\xb9\x31\x1c\xba\x9f\x3f\x1b\xcc\x96\x1c\x5b\x74\x61\x3b\xf6\x3f\x06\x4d\x44\xc5\x9c\x26\x87\x71\xa4\x14\x31\xbe\x15\x42\x2c\xb1\x26\xda\xc2\xa8\x75\x1a\x2a\x7b\xb8\xc0\xc9\xe2\x2d\x7a\x14\xcd\x66\x88\x13\xec\x36\xc7\x59\xf9\x71\x63\xf7\x0a\x5f\x37\x2e\x49\x51\xc6\xda\x28\x82\x43\x4d\x72\xf7\xec\x1f\xee\xea\x5e\x0a\x61\xe5\x20\x74\x47\xf7\xa3\xe9\xb2\xec\xee\x62\xe0\xaf\xa1\x93\x08\x0c\x6e\xa7\x08\x9c\x76\x1f\xd9\x33\x81\x12\xe3\x96\x0b\x42\xcb\x0c\xb6\x7e\x58\x8a\xa3\x08\xd8\x02\x51\xc0\x9e\x93\xde\x45\xa0\xf7\xf9\x33\x12\x56\x99\xd6\x10\xf0\x49\x10\xc3\xee\x0d\xcc\xe9\xee\x26\x40\x2b\x25\x16\xbd\xa7\x11\xbe\x96\xc8\x5a\x17\x0b\xf8\x0a\xd8\x58\x08\x0a\x0e\x35\x6c\x94\x0d\x52\xbd\xcc\x64\x62\x1d\xfe\x43\x77\x5f\xaf\xb5\x8a\x51\x45\xfa\xfc\xbf\xde\x46\x71\x0b\xe6\xad\x55\xb0\xd8\x5b\xf1\x14\x96\x7b\xcd\x41\x82\x05\xbb\x73\x6f\xea\x83\x5c\xf4\xb5\x18\x8d\x7b\x22\xda\x11\xa7\x4b\x52\x6c\x94\xf2\xb8\xe8\x8e\x26\x82\x78\xd2\x08\x98\x04\xe0\x29\xcf\x97\x98\x3f\xa0\x8c\x90\xf1\x28\x07\x95\x50\x2b\xff\x42\x9c\x23\x77\x21\x4d\x79\x4c\xe4\xe8\x37\x65\x66\x1e\x0c\x61\xe6\xc7\xc1\x88\xd4\xd2\x8f\xf8\x90\x2a\x12\xcb\x08\x11\xa9\x03\xfc\x06\x07\x59\x02\xf2\x74\x16\x25\x29\x1d\x14\x8c\x40\x11\xe2\xba\xfc\x82\xd0\x71\x98\xf9\x86\xa3\xe9\x02\xcd\x3d\xfe\xe1\xf4\x87\x67\x1d\xf8\x7b\xfa\xc3\x0f\xcf\xe0\x07\x8a\x54\xa0\x92\xb5\xe9\x97\x27\x7e\x7a\xa4\xc3\xdd\x8b\x76\x42\x6f\x46\x3a\x37\x2a\xd2\x31\xea\x30\xa0\x56\xc3\xd0\x52\x39\xcb\x49\x1a\x8d\x3e\x38\x3f\x0f\x06\xd8\x44\x2f\x05\x69\x0b\xcf\xb0\x09\xe4\xbf\x44\x0b\x82\x17\x84\x4e\x87\x0f\x4d\x10\x3b\x0c\xfa\x3d\x37\xa9\x4f\x0f\xb3\xd0\x8c\x7c\x71\x71\x5f\x11\xb3\x0f\xaf\xfd\xb1\x1a\x4a\x82\xed\x4d\x16\x53\xd6\xab\x3e\x04\xf3\x39\xca\x4d\x80\xf0\x99\x07\x72\x76\xc8\x0e\xd4\x00\x38\x8c\x80\x8a\xa7\x4b\x8a\x35\x29\x16\x6d\x9d\xef\x3c\x7d\xce\xef\x91\x7b\x26\x74\x32\xd4\x80\x2c\xb3\xa3\x48\x93\x77\xe2\x21\xd1\x0c\xa4\x61\xbc\x00\x40\x94\xce\xef\x9d\x59\x84\x08\x49\xa4\x3f\x1a\xa9\x07\x8f\xa4\x3b\x2e\x13\x12\x28\xdb\x15\x18\xea\x32\xb3\xed\x62\x2b\x4b\x82\x64\xe3\x9f\xd0\xef\x42\x70\x71\x39\x05\x82\x9b\x13\x98\x57\x3e\x88\xf9\x41\x64\x8a\x3a\x74\x35\xd3\x55\xb0\x77\xe9\xf1\xd8\x43\x00\xea\xdb\x01\xe2\xa8\xea\x44\x57\x0a\x3f\xfc\x40\x0d\xfb\xc0\x43\x2f\x13\x1c\x3c\xfe\x9a\x4f\x17\x49\x77\x86\xce\x65\xba\x7f\xf5\xe3\xa8\xfb\xd7\x28\x9a\x2d\x27\x79\xf5\x6d\x92\x17\x1e\xa4\x75\xd1\x6f\xcd\x0a\x39\xae\x59\x41\xcf\x35\x32\xf0\x68\xe2\x60\x27\x40\xe4\x50\x7a\x02\x4b\x42\x16\xa7\x48\x8c\xd5\x95\x44\x49\xca\x26\x49\x2c\x71\x7e\x7d\xaf\xf7\x46\x55\xc6\x28\x15\x83\x8e\x67\x1d\xf0\xa8\x29\xc2\x4b\x84\x4b\x3a\x22\xdc\x47\x8c\xe2\xad\x4c\x20\x07\x4c\x62\xe1\xaf\xfb\x38\x0d\x37\x11\xab\x92\x34\x36\x18\x26\xd5\xa0\xc1\x41\x3d\x01\xb4\x51\x90\x5b\xc8\x5a\xe4\x8a\x45\xd0\x6f\xbb\xe4\x4b\xec\x0b\x61\x7f\xe3\x50\x2b\x05\xd8\xdf\xd4\xc0\xfe\x46\xc2\xfe\xa6\x08\x7b\xd6\xa2\x05\x76\x1f\x32\xbb\x5e\x12\x78\x40\xf1\xb3\xab\xe0\x7a\x01\x4b\x18\xbe\xbb\xb0\x99\xe3\x6e\xba\x98\x85\x5f\xe7\x98\xf0\x10\xfa\x71\xf6\xb0\x1f\x67\x4f\xf6\x93\xc9\x90\x09\x2b\xde\x28\x41\x70\xa7\x0e\xb9\x22\xcb\x81\x1a\xe2\xa9\x71\x97\x8e\xdc\xba\x82\xf2\x1e\x0e\xdc\x39\xe0\x06\x71\xc5\xad\xf2\x41\x9e\xa4\x67\x0d\xb0\x0e\x23\x0d\x4b\x06\xd7\x61\xc4\x87\x65\x33\xda\x04\xde\xfa\x8f\x81\x2d\xc7\x3e\x32\x5d\xe6\xf7\x88\xb4\xab\x7b\x10\x90\xd0\x35\x05\x9d\x39\xf0\x56\xc2\xf1\xbd\x73\x2d\x43\xcd\x6b\x00\x6b\x8a\x27\x7c\x54\x8c\xcf\x58\xd2\xc2\xca\x10\xe3\xbe\x8a\xa6\xe3\x87\x49\x11\x29\x1e\xf1\x7b\xa9\xaf\x86\x0d\xa4\x70\xa7\xf6\x23\xe4\x94\xd8\x36\x24\xd1\x59\x38\x5e\x63\xe5\x0e\x18\x48\xdf\x82\x22\x2b\x57\x7c\x5c\xae\x4e\x18\x60\xeb\x7a\x2e\xd1\x23\x39\x2e\x87\x39\xd6\x5b\x83\x52\x2f\xf1\x11\x2d\x9e\x10\xa9\x9b\x85\xd2\x31\x22\xf5\x71\x3f\xcb\x8a\xd2\xfd\xfc\xf4\x16\xc7\xc9\xf0\x0b\x21\xaa\x35\xec\x0e\x36\xf0\x3c\xd4\x73\x06\x9b\xa6\x60\xd2\x26\x0c\xa0\xac\x50\xc4\x82\x65\xf8\x91\x54\x4d\xed\xa3\x7a\x88\x5e\x50\x3d\x18\x9d\xea\x58\x30\xb7\xce\x89\x20\xd9\x00\xa7\xc0\x06\x99\xda\x99\xe9\xa0\xb3\x91\x5a\x5f\xb6\xd5\x9d\xa1\xa4\x8e\x07\xff\x49\x82\x87\x0e\x42\x40\x55\xa7\x9d\x4a\x56\x21\xde\x74\x17\x07\xc8\x92\xaa\xe5\x83\x02\x8c\x54\xeb\x4b\x24\x96\xe9\x54\x1c\xfa\x13\x10\xd0\x3b\xb5\x08\x4a\x2d\xa8\xbf\x29\x08\x31\x02\x9e\xe4\x1e\xea\xce\x6a\xc0\x1a\xfb\xa3\xc1\xea\x03\xd4\x4f\x9d\x07\x9d\x6a\xb3\x88\x20\x3d\x4e\xf4\xd3\x54\x16\x0f\x0d\xe5\x8f\x66\x1a\xd7\xf2\x02\x65\x45\x94\x0e\x0f\x0e\xf7\x9d\x57\x71\x70\x8b\x6a\x19\xf9\xd2\x19\xac\x1a\xe0\xfa\xe1\x6d\x10\x47\x21\x2a\x65\x4b\xc3\xfa\xe9\xfc\xf0\xf4\x78\xc7\x71\xe9\xb6\xa2\xbb\xba\xb1\xc9\x1a\xd1\xe7\xbc\x82\x28\x65\x2c\xad\x2f\x34\xec\x08\x10\x4f\xd0\x8c\x71\x24\x62\xa0\x12\x99\x43\x77\xe2\x81\x2e\x79\xbf\xe4\xf2\x78\xec\x1e\xf8\xff\xe6\xbd\x59\x38\x67\x78\x69\x77\x1c\x85\x11\x02\x73\x88\x7c\x17\xd6\xb3\xf8\x7e\x1e\xfb\x3e\xfe\x84\x9c\x63\x3f\x9c\x52\x91\x73\x41\x90\xf0\x7b\x06\x59\x74\x82\xf4\xd8\x38\x50\x13\xe7\x77\x82\x0f\x12\x70\xea\x4a\x46\x91\x33\x72\x05\xcb\x58\x1e\x70\xcc\x48\x51\x5e\x41\x3b\xca\x23\x93\xba\xc7\xf6\x70\x29\xcd\x83\x8f\xfe\x34\xb1\x75\x38\x8b\x58\x98\x5a\x56\xe9\xf5\xc2\x34\xf0\xa6\x01\xde\xee\x16\xcf\x13\xcd\xa6\x95\x5e\x97\xef\x1f\x36\xb0\x2f\x3c\xf4\x5e\xc5\x58\x52\xf2\x4f\x51\xe9\xce\xba\xf8\x92\x73\x6f\x0c\x57\x82\xf7\x6b\x49\x97\x6d\xb7\xbe\xc2\xd9\x25\x36\xb9\xe2\xab\xcb\x1a\xb1\xb7\x1b\x14\xa2\x5c\xad\xf2\xc5\x1d\x4a\x69\xb9\x6a\x24\xd9\x61\x9d\x37\xe7\x78\x86\x39\x22\xe7\x2a\xa4\x8e\x0b\x91\x03\x59\xa6\xaa\xc2\xf2\x1d\x14\xa5\x72\xb6\x46\xb9\x47\x73\xf0\xe8\xf9\x10\xd5\xc5\xf0\x3a\x59\xde\xe4\x20\x3b\xe7\xdc\x73\x66\xde\x1c\xa5\x14\x3a\x6b\x95\xfe\x94\x80\x47\x65\xfe\x13\x10\xf4\x9f\xb4\x5c\xa5\x9a\x3b\xa8\xba\xe0\x99\xc3\x0c\xb7\x22\xf9\x74\x04\x6b\x26\x30\x9f\xc8\x92\x41\x5d\xbd\x82\x66\xa8\x31\x1c\x9d\x68\x89\x07\x2a\x8e\x05\x80\xdd\x06\x28\x56\x40\x3b\x37\xfe\x47\x4f\x7e\xb2\x52\x8c\xb6\x67\xb1\x68\x20\xb3\xe8\x14\xcd\x48\xb3\xce\xe2\xf9\x82\xbc\x99\x40\xd5\x9d\xe6\x22\x52\x9c\xb8\x23\x0e\x49\xc4\x45\xa7\xd1\xe8\x73\xea\x6b\x82\x12\x8d\xff\x71\x0e\xdc\x92\xae\x84\xe4\xa9\x01\x5e\x95\x7a\x69\x87\xae\x6a\x73\xb7\x19\x2f\xde\x9e\x86\x63\x3e\x2f\x3d\xa3\xa3\x52\x63\x7a\xde\x85\x9f\xf0\xe4\x94\xf4\x80\x2e\xde\xfd\xff\x84\xef\x3c\x73\x16\x83\x6e\x27\x2b\xc3\x2a\xd9\x0b\x2c\x85\xc6\xa2\x7a\xd6\x8f\xf4\x44\xf4\xf1\x8f\x87\x2f\x5e\x9c\xbc\x83\xfe\x1e\xbb\xef\x42\xf3\x14\x75\xe6\x7d\xec\xf2\xf0\xbb\x72\xc6\x1a\xac\x8b\xcc\x86\x68\xe0\x6b\x7c\xeb\xd8\xfb\xe8\x88\x27\x6c\x80\x05\xcf\x39\xd8\x3f\xeb\x38\x27\x67\xfb\x1d\xe7\xd5\x31\x61\x72\xef\xd5\x59\x46\x36\x57\x3e\xae\x6b\x14\x66\xae\x03\x10\xfa\x17\x73\x22\xe6\x4c\xba\x66\x1a\x30\x96\x30\x85\x57\xe7\x35\xec\xc5\x7e\x77\x82\xbf\xbe\xc2\x32\x86\x85\x0b\x3b\x06\x9a\x7e\x40\xf3\x4c\x91\x4c\x03\x41\xec\x3c\x47\x62\xc2\x9b\x52\x58\x04\xe8\x6d\xd9\xc9\x14\xe2\xa9\x6f\x5e\xc0\xb3\xdd\x80\xbc\xb3\x4f\xf2\xa0\xa7\x9e\xb8\x3d\x11\xe6\x09\x0f\x56\xe0\xf3\x66\x17\xca\xc8\x82\x79\x83\x07\x80\x79\x64\x54\xc7\x1d\x89\x63\xe4\x1c\x38\x78\x44\xd3\x65\x43\x18\x10\x70\x46\x1f\x1a\xab\xad\x25\x52\xd5\x63\xb6\x32\xc4\x8b\x5a\x92\xad\xf9\x0c\x08\xd8\x70\x0a\x49\xd4\x43\x92\x1d\x4b\x66\xb7\xc9\xb8\xb0\x6e\xf9\x2c\x79\xec\xcf\x01\x7e\x14\x1a\xc4\x12\x12\x40\x12\x60\x5d\x6e\xc9\x55\xaa\x11\xb6\x85\xb7\xc7\x3b\x7c\x68\x46\x9c\x54\x4c\x00\x99\xfd\xfc\xa3\x80\x03\xd3\xd0\xa2\x08\xa4\x5a\x98\xe7\x98\x25\x3c\xf3\xda\xd6\xd2\xcd\x52\x68\x30\x0c\xb3\xd0\x1b\x1d\x6c\x63\x1d\x67\xad\xe3\xac\x77\x1c\x58\x1d\x9b\x78\x92\x7e\x4c\xe8\xa0\x3e\x04\x52\x68\x41\x84\x45\x7d\x48\x5e\xa3\x64\x49\x1d\x58\x23\xa8\x62\x4a\xcd\x6a\x16\x8c\x71\x98\x06\x86\xf9\x56\x35\xec\xe2\x31\x65\x66\xd6\xd1\x62\xde\x8b\xb4\xa0\xec\x89\xe8\x0a\x2d\xc4\xf3\xcc\x7c\xbb\x96\x89\x6b\x81\x70\x39\x12\xfa\x1c\xb0\xb7\x5b\x75\x1d\x32\x13\x36\xc2\x42\x0f\xe1\x81\xdd\xc2\xea\x29\x01\xb0\x4d\xe3\xea\xe3\x4b\x46\x9a\x9e\xd6\x3c\x06\xc6\x1d\xdf\xb7\x45\x3e\x64\xd3\x33\x47\x51\xb5\xe5\x2d\x3e\x06\x20\x96\x18\x05\x56\xc9\xb7\x1c\x01\xd9\xe2\x9b\x01\x33\x7f\x29\xe2\x7a\x57\xb6\x04\xde\x7d\x2d\x1a\x33\xd6\xdb\x5d\x04\x8a\x0b\x79\xb5\xea\xd2\xab\xdd\x2e\x36\xb0\x1c\x99\xb9\x17\xbf\xbe\x7b\x97\xbc\x7b\x77\xf1\xee\xdd\x65\xab\xfd\xe9\xf3\xb7\xdf\xbd\x73\x1f\xbf\x7b\xf7\xeb\xa3\x7f\xfa\x87\x3f\xfd\xf9\x1f\xbf\xe9\xec\xee\xfc\xeb\xa5\x21\xc3\x3d\x3e\xf5\xaf\x17\x53\x7c\xa4\xfe\x91\x0c\x88\xc4\x51\xec\x0d\x5a\xe9\x10\x10\x62\xd3\x43\x74\xf0\xa4\x80\x4a\x11\xa7\x6d\x66\xde\xea\x78\x52\xa0\x01\xb5\x79\xd4\x8d\xc4\x11\xb5\xa7\x5d\x11\x8e\xa6\x5e\x42\x2c\x33\xf6\xe9\xbe\x40\xec\xad\x23\xed\x94\xa3\x87\xe7\x24\x8e\x47\x9a\x97\xfb\x1f\x2e\xb1\x7a\xb7\xe7\x6a\xf7\x58\xa8\x1f\xcc\xbd\xf4\x06\xa4\x01\x32\xf5\x08\x51\x13\x23\x80\xa4\xda\x1d\x81\x5e\xfd\xb8\x12\xa9\x4d\x8f\x0b\x96\xc2\xea\x7f\xf4\x72\x78\x75\x97\xc0\xab\x20\x57\xa0\xbd\xdf\x07\xad\xd5\x44\xc6\xab\xe9\xeb\x20\xe4\xf2\x9b\x26\x48\xe0\x27\xe9\x78\x4f\x61\x5c\x63\xab\x9b\x2a\x86\xe8\x6b\x91\xd4\xbb\xf0\x75\xc2\x37\x52\x00\x85\xbc\xa8\xce\xce\xd5\x93\x45\x4c\x5a\x43\x20\xee\x18\x89\x9a\x48\x93\x89\x40\x62\x31\x71\x87\x11\xe3\xbe\xb2\x76\x80\x4d\x82\x58\xb3\x32\x46\xbf\x0a\xcb\x69\x08\xc5\xaa\x8d\xb4\x04\xa3\x5a\xa9\xa6\x60\x96\xb2\x68\x0b\x2e\xfa\xde\x47\xfb\xd8\x2e\xcb\xd5\x0f\x30\x53\x36\x0e\x1b\x75\x81\x25\x3b\xbb\xc2\x4e\xc8\xb8\x75\xd0\xeb\x6d\xcb\xf5\x22\x64\xa9\x8c\x9b\x0b\x7b\xcc\x7b\x3e\x39\x8a\x17\x61\x88\x13\xc8\x76\x67\x01\x1e\xd9\x49\xe9\x2b\xf5\xae\x32\x8b\xd8\xfb\x68\xe1\x8c\xc9\xe0\x91\xee\xf1\x79\xf3\x7b\x9c\x00\xd7\x17\x61\xba\xb0\x3b\xef\x0a\x78\xbd\xf4\x09\x0f\x9b\xc5\x08\x08\x27\x46\xd3\x66\x44\x2c\x99\xbe\xe1\x05\x9d\xec\x92\x3a\x83\x3a\x4e\x80\xe7\x33\x57\x78\x3a\x18\x67\x2a\x03\x1d\xee\x6a\x92\x23\x1f\x2d\x11\x38\xa8\xc2\xb0\xce\xb1\x48\xc8\x95\x24\xec\x93\x33\xdc\xfc\xfd\x99\x37\x62\x2a\x56\x24\xa3\xd0\x41\x73\x0e\x55\x84\x2d\x2a\xf2\x49\x1d\x3d\x8e\x26\x86\x16\xea\x24\xb8\xcd\x69\x60\x70\x69\x9e\x17\xcf\x62\xd2\xad\x0c\x3c\x85\xdc\x40\xa4\x22\xf6\x79\xf2\xc3\x50\xa4\x0d\xba\xea\xf8\xdd\x89\x83\x74\x98\xff\x4d\x1d\xe6\x85\xdd\xb2\xe4\x51\xac\xf4\x37\xa0\x0f\xd2\x76\x7e\x77\xfa\xc0\xf0\x20\xff\x9b\x3e\x32\xfa\xc8\xf0\xb1\x04\x7d\x14\x2b\xfd\xad\xe8\xe3\xf6\xeb\xa8\xc0\xd4\xd6\x1b\xe7\xda\x4f\x13\x22\x0b\x16\x08\x08\x70\xa3\x63\x61\xdb\xd6\xf5\xe5\x7b\xa7\x65\xce\x39\xdc\x45\x3a\xe9\x0e\xe9\x75\x81\xfc\xe5\xc6\xde\x9d\x78\x5c\xc3\xe7\x00\xca\xd1\xa1\xec\x81\xb4\xb2\xb1\x07\xda\xb9\xb2\xab\x53\x06\xd6\x04\x9f\x30\x7b\x09\xc6\x6c\xba\x91\x90\x01\xca\x63\xea\xe0\x31\x61\xf1\x31\xf4\x91\xb3\x60\xe4\x0d\x1b\x4d\x37\x90\x60\x40\x9c\xf8\xd0\x44\xc0\xd3\x5e\x57\xd9\x8d\x01\xb8\x59\xb5\x90\xc8\xa2\x96\xee\xc4\xc2\x7b\x47\x75\x54\x06\x49\xb4\x48\xe7\x8b\x74\x49\x30\x06\xcb\x80\xa1\xa4\x25\xee\xca\x06\x08\x48\x50\x5d\x61\x91\xf5\x05\x08\xc1\xf3\x66\x3c\x0c\x42\x7b\x16\x4d\x34\x9b\x15\xce\xa2\xc4\xe8\x81\x0a\xfd\x29\x88\xdb\xf7\x74\xb8\xd3\xf5\x30\x64\x6d\xb7\xe1\xb9\x54\x19\x36\x88\x7b\xf0\xbd\x8e\x78\x9a\x82\xfe\xaa\x48\xfd\x86\x5e\x7d\xc8\xbc\x5a\x4c\xa0\x59\xb6\x64\x3a\x38\xdc\xdf\xff\xe9\xd8\x69\xed\x65\x41\x64\x1d\x11\x45\x96\x7d\x41\x4b\x4d\xd8\xef\x08\x95\x9c\x60\x96\xa8\xa6\x57\x25\x42\xb9\xf5\x67\x8b\x29\xbd\x37\xc0\x51\xf0\x21\x16\x31\x98\x54\xf2\x20\xbc\x94\x8a\x62\x2f\xc6\xcb\x90\x31\x3f\xdf\x22\xe6\x72\x13\x4d\x33\x29\x9b\x84\x4c\x7c\xc8\xa2\xd8\xb0\xa6\xd1\x8d\x22\xb4\xb6\x5a\xcc\x99\x23\xf3\x20\x51\x20\xc5\x7b\x58\xbc\x03\x6f\x23\x6f\x8b\xc5\x01\xd6\xcc\x63\x99\x36\x71\xe4\x81\xbf\x3f\x0e\x52\xba\xb3\xbd\x0d\x56\x42\x2f\x8c\xa8\x38\xb7\xc2\xa8\x59\x49\x67\x8b\x8f\x55\x33\x15\x01\x0b\x80\x61\xa6\x01\x60\xab\xd1\xee\xa4\xcd\xd2\xc0\xbc\xa3\xc9\x9a\x51\x37\x43\x74\x43\x03\x2a\xed\x14\x16\x3d\xf0\x6a\xc6\xb4\x40\xf1\xc8\x23\x16\x2e\x78\xb1\x40\x3f\x95\xe8\xa1\x10\x47\xe6\x0f\x80\xf6\x09\x9a\x48\x50\x5b\x64\x4d\x6e\x93\xcf\x89\xd4\x7e\x3f\x3e\x56\x60\x5f\x72\x47\x28\x32\x55\xb9\x2e\x1f\x08\x4d\x90\x44\xdd\xd5\xfe\xea\x2a\x01\xa4\x7d\x64\xc0\xd1\x8f\xee\x34\x1a\x7d\xf0\xc7\x04\xa5\x7e\x39\xa6\xb8\x82\x02\xb9\x75\x70\xb2\x7f\xc6\x87\x4d\x47\x67\x27\xd4\x9c\x30\xed\xd0\xcc\x4c\xe8\x16\x23\x8d\xbd\x30\x99\x8a\xb7\x30\xad\x69\xf0\xc1\x77\xae\x63\x6f\x7e\x13\x8c\xf0\x21\xca\x3c\xc1\x46\x5e\x9f\x3f\xef\x0e\x25\xa1\x27\xa0\x4f\xce\x81\xfa\xe5\xcb\xac\x28\x91\x86\x96\xbe\xc3\xf0\xf1\x6d\x67\x28\xdf\x10\x1a\xb8\x84\x05\x64\x9a\xfb\x61\xa4\x0e\x90\x0e\xd2\x60\x26\x0e\xce\xd4\x58\xf8\xd4\x36\x7b\x47\x25\x2d\x0d\xa5\x71\x3b\xf0\xa6\x0f\x7c\x26\xc2\xf0\x2d\x42\x32\x1e\x41\xa9\x85\xaf\xef\x71\x47\x46\x8f\xc9\x31\x1e\x79\xd1\xd5\x06\x95\x9e\xfa\xd7\xde\xe8\xde\xd1\x82\x4d\xe7\xc9\x8a\x6e\x12\x38\xf4\xca\x43\xcc\x98\x4c\x3b\x00\xe4\x02\x4f\x9c\x23\x6a\xcd\x66\xce\x94\x16\x6d\x99\xf2\x76\x81\x71\x77\x94\x24\x0f\x79\x17\x61\xbe\x06\xa3\x37\x41\x49\x7a\x0f\x68\x82\xd5\x46\xfd\xca\x4b\xa9\xbc\x7d\x82\x8a\xaa\x68\x05\xa5\x8b\x3c\xe8\x21\xf0\x10\xa7\xc0\xa7\x2b\x5d\xed\x86\x75\x91\xe0\xe6\x86\x17\xad\xc4\xda\xc8\xda\x13\x80\x94\x7c\x54\x05\x29\x73\x2f\x2b\xbc\x0f\x28\x6f\x79\xce\x53\xe9\x0d\x81\x1f\x8d\xb5\x82\xaa\xd2\x6d\xfb\x2b\x79\x20\x09\x98\x1f\x8c\x1d\x67\x77\xc6\x80\xb5\x16\x57\x67\x8b\xab\x6a\xcf\xf4\xd1\x15\x20\x0c\x2f\xe1\xdf\x93\x4b\x84\x0c\x7c\xaa\xdb\xf3\xc6\xe3\x67\xc2\xf6\xb2\xdc\xc9\x84\x74\x09\x81\xce\x7a\x65\x4d\xab\x3f\x5f\x76\x34\xcf\x2d\x67\xe3\x73\xfc\x8b\xf8\x92\x07\x91\xcb\x82\x74\xe1\xd9\xa1\x9d\x07\x4c\x73\x3d\xb8\xb8\x02\xce\x1b\x5c\x55\x87\x1c\x13\xf4\x63\x8e\x18\xa3\x23\xe4\xd2\x2e\x7c\x04\xe5\xe2\x52\x7a\xff\x33\x72\x7a\xf3\x45\x72\x53\x09\xcc\x22\x6c\x06\x8e\xe1\xa5\xd0\xe8\x23\x1f\x9f\x58\xf8\x0e\x74\x8f\xc2\x5b\x12\x3f\xa1\x7d\x24\x1d\xe1\x47\xd2\xf4\xcb\xc1\xf1\x5a\xa4\xdf\xc1\x34\xe7\xcb\x32\x70\xbe\x75\xfa\xb2\xb9\x97\xf8\x78\x4f\x82\x3a\x2e\xb4\x16\xf7\x12\x64\x3d\x40\x9a\xa6\xdf\xc7\xfc\x78\xe7\x8b\xab\x69\x90\xdc\x14\xc6\xda\xc1\x80\x83\x96\xb0\xf1\x41\xd1\xcd\x87\x0f\x60\x45\x39\x17\xda\x5a\x28\xcd\x80\x2e\x6a\xd0\x33\x22\xba\x59\x8c\x00\x43\x72\x02\x72\xa1\xe3\x65\x80\xb4\x2a\x94\x72\xdc\xb3\x88\x66\x18\x97\x10\x30\x82\x56\x44\xb1\xce\x44\x06\x06\xb0\xa4\x49\x8e\x29\xfa\x29\x16\x8c\x91\x16\x22\x2b\x54\x2a\xa2\x98\xdd\x67\xcb\xdd\x08\x7f\x71\xa8\xaa\xd3\xe8\x6e\x9f\xd9\x2d\x7f\x63\x1c\xc3\xec\xeb\x1c\xb8\xca\x9e\x32\x86\x20\x27\x2f\x67\x2c\x3e\x56\xfb\xf0\x44\x75\x6e\x8f\x5e\x08\xe0\x88\xa4\xc3\x4a\xb2\xc7\xa4\xb8\x78\xb8\xa8\x29\x6c\xd9\x50\x06\x64\x4a\x8d\x9e\xd4\xa2\x35\x01\x90\xd1\x6b\x25\x8b\x6d\x1b\x91\xc2\x5e\x89\xd7\x69\xaa\x32\x8f\xad\x45\x08\x31\x4a\x42\xc6\x4b\x90\xc6\xdf\x8b\x40\x5a\x46\x5e\x69\xc6\xc9\x64\x02\x88\xce\x65\xe1\x79\xf9\x33\x3c\x2e\x3f\xc6\x53\xeb\x17\x78\x05\x52\x91\x7f\x4a\x47\xf9\x15\x05\x8e\xf9\xee\xca\x88\xef\x25\x51\xae\x51\x37\x86\x6a\x45\x7b\x9e\x1a\x3f\xf1\x8c\x05\x8a\x4c\x59\x98\x80\x4e\x6e\xa2\x04\xa1\xb7\x2b\x3b\xe5\xf0\xbc\x8d\x22\x34\xe4\x1b\xae\x6c\x97\xe3\x02\x37\x0a\xfc\xa0\x0d\xa1\xbc\x49\x98\xa7\xfd\xac\x60\x0d\xad\xe6\xc9\xd2\x4a\x53\xa2\x94\xf3\xdd\x53\xf6\xa8\xca\x7e\x57\xa1\x1b\xa3\x54\xcb\x56\x13\x30\x81\x7c\xa2\xab\xf3\xab\x22\xc4\x28\xeb\x00\x59\x36\x42\x82\x2c\x9c\xb4\x06\x6d\xdd\xe1\x6e\x69\xab\x49\xb3\x98\x84\xd9\x9c\x09\x26\x8b\x0c\xae\x02\x68\xe1\x95\x20\x0f\x76\x05\x3b\xd0\x1a\x6e\xdc\x76\xd2\xb8\x71\x51\xa7\x87\xe2\xa9\xf0\x55\xad\x32\xab\x7b\x9b\x47\xf3\xa6\xc8\xe7\xa2\xb5\xa8\x17\xc5\x1e\x88\x78\xeb\x12\x02\x12\xf2\x6b\x86\x01\x3b\xc4\x32\xb3\x41\x3b\x4a\x83\x06\x2b\x86\xc1\x65\x1e\x80\x72\xd6\x11\x0a\xd0\xda\x02\xfa\x15\xd0\xe3\x13\xf9\xa4\x4d\x5a\x4f\x2a\x9b\x37\x62\x3a\xf4\x77\xe1\x1f\x8c\xf5\x2a\x22\x34\x38\xf1\x93\x27\xed\x32\x08\x30\xd6\x16\x43\x81\x1b\x71\x39\x20\xb1\x8f\xc7\x05\x95\x93\x52\x49\x09\x68\xd7\x59\x4d\x69\xaa\x87\xa4\x49\x6c\xdb\xd2\x5e\xaa\xb1\x49\x32\x1e\xa8\x04\xfb\xf9\x9d\xb6\x42\x84\xcf\xf1\x41\x84\xf2\xf7\xd9\x8b\xcb\x01\x27\x9b\xb7\x7d\xd9\x4f\x13\x70\x25\x38\x30\xe6\xd0\x8f\x7f\x3c\x3f\x7e\xa1\x47\xe2\xcc\x95\x61\xd4\x2b\xf9\x04\xc5\xac\x90\x5f\x6a\x4e\xa6\x20\xcb\xb6\x4b\x60\xee\x57\x6e\x31\xa5\xf9\xb2\xdd\x5c\xac\x47\x8a\x21\xa0\xb9\x98\x97\xa2\xa6\x29\x51\xf5\x82\x44\x98\x23\xb7\x30\xbc\x8b\x8b\xf1\xc2\x83\xde\x84\x76\xdf\x1b\x34\xa1\xa7\x28\x60\x85\x0d\xd1\xe2\x60\xcf\xda\x78\x48\x21\x54\x73\x51\x54\xec\x85\x08\x7c\xab\xd4\xd7\x4b\xee\xc3\xd1\x3e\xbd\xa8\xc8\xc7\x23\x8b\x4b\x3a\x66\x45\x15\x5d\xf1\x78\x80\xfa\xb8\xe5\xe7\x23\xb9\xe4\x66\x0c\xf8\x94\x1f\x8e\xf7\x6f\x82\xe9\x18\x44\x69\x2b\xb1\xc5\x9d\x1a\xe8\xd3\x7a\xe0\x2b\x28\x32\x9a\xcd\x82\xf4\x05\x00\x7b\x92\x4d\x67\x73\xaa\x04\x0a\x2a\x25\x37\x3d\x94\x70\x89\x50\x54\xbe\x7a\x4b\xc3\x4c\x17\x24\xc4\x82\x9a\xf4\xdd\x53\xbb\xc8\x07\x64\xd6\xc2\xe3\x80\x68\xea\x4b\xe7\xf2\xb8\x08\x41\x4d\xc1\x13\x95\x2b\xf2\x6d\x23\xc3\x0e\x74\x32\xba\xb5\x6c\x7b\x03\x54\x7a\x50\x1f\xeb\x93\x3a\xb4\x5c\xa3\xc4\x6f\x14\x90\x86\xb4\x57\x04\x90\xc5\x46\x5b\x73\xa4\x6c\x38\xb6\x56\x04\x7c\x69\x19\x7c\xf5\x6d\xf6\xdb\x4b\xad\xf8\xc2\xa2\x50\x28\x03\xa5\xb2\x23\xb4\x6d\x7a\x2c\x4f\x84\x9e\x0b\x33\x40\xa7\x0b\x5c\x26\xba\x83\x35\x73\x20\xcf\xab\x78\x2d\xa1\xc6\x85\xb4\xd6\x72\x91\x7f\xc5\xc6\x8a\x09\xda\x96\xa0\xb0\x14\x9b\xd8\x4c\xa6\x6d\x35\xce\x62\x0e\x98\x8c\xfe\x7b\x7d\x3a\xac\x2c\xb0\x6b\xe3\x9b\x88\xd9\xc0\x79\x5a\x58\xb2\x1d\x82\x60\xc9\xd6\x68\xc2\xac\x9b\x50\xdc\x29\xdf\xbd\x68\x91\xec\x3a\xc1\x6e\x59\x2c\x97\x90\xe2\x0c\x77\x1d\x7c\xab\x42\x27\x47\xa6\xfe\xda\x0b\xa1\x13\x52\x76\x5a\x41\x49\xe4\xcf\x47\x41\x2f\x84\x3a\x67\xc1\x15\x9d\xe2\xc1\x5c\x79\xce\x77\x4e\x68\x46\x3a\x32\x19\x16\xe8\xfc\xb7\x51\x30\x76\x5a\xf6\x0d\xd2\xd2\x11\x05\xd8\xf0\x98\x52\x8c\xfe\xca\x02\xc5\xb0\x17\x3e\x49\xd7\x77\x5e\x1c\xb6\xdc\xbd\xec\x7d\x11\x79\x18\xe1\xfb\x22\xe9\x22\x06\x6f\xdc\x7c\x76\xda\xc3\x8c\xc8\xad\x62\x4d\xc0\x3a\xcf\xa4\xcd\xd5\xbe\x17\xfb\x55\x3a\x5b\x7c\x9f\xe7\x40\x38\xd1\x53\x6f\x9e\xf8\xad\x22\x6d\x58\xa6\x5e\x1b\xd6\x88\x6c\xcd\xcc\x0e\x3e\x57\xc0\x09\xd2\x52\x2a\xd1\x5e\x7f\x88\x86\xf2\x47\x14\xfa\xb4\x9a\x1e\xe1\x29\x11\x07\x51\xc1\x7d\x43\x78\xe1\xd5\x23\xc3\x68\xc9\x65\xa4\x73\xc6\x11\x3e\xa4\xf8\x8b\xcb\xd3\xac\x15\xf4\xee\x46\x32\x0e\x48\x62\x2b\x42\x41\x7d\xe6\x80\xe1\x90\xc6\x21\xe4\xe4\x67\x64\x47\xdf\x02\xd2\xf7\x75\x6a\x40\x06\x6a\xd4\xa6\x90\x33\x5a\x6d\x96\x7f\x98\x37\x54\xc9\xfa\x33\xef\xfe\xca\xdf\x9f\x06\x73\xd8\x80\xb0\x72\x95\x2c\x26\x43\x22\x55\xac\x1d\xcb\xc2\xcd\x1d\x29\xc2\x5e\x61\xe1\xd4\xed\x1a\xcd\xbe\xc8\xda\x55\x14\x96\x25\x54\x7d\xfb\x06\x51\xcf\xe0\xfb\x32\x04\x99\x3d\xf0\x4c\x39\xe8\x96\x50\xb4\x5f\x01\xdc\xe6\x73\xc0\x13\x60\x95\x75\xbf\xe5\x83\x8f\x0c\x8f\x5a\x8d\x25\x08\xbe\xb8\xac\xfb\xd6\xee\xf0\x2c\xb6\x6d\xd9\x8a\x8c\xcd\xd2\x24\x1c\x66\x82\x05\xd8\xb4\x55\xc0\xfc\x3e\x36\x88\x3d\x68\x37\xa8\x67\x4e\x8b\x8c\x02\x98\x13\x40\x96\x9e\xae\xdc\xce\x65\x27\xa5\x26\xcc\x8c\x97\xbe\x25\xea\xa2\xf5\x0e\xa5\x38\x05\x84\x50\x3b\x33\x7b\x88\xae\x64\xca\xfb\x89\x62\x66\x69\xcc\x04\x57\x22\xd9\xe7\x97\x31\x6c\x6c\xb9\x78\x5a\xa1\x09\xbd\xa0\x95\xda\x0d\x1a\x10\xdd\x75\x42\x93\xad\x78\x7c\x53\x51\x5c\xa0\x53\x02\x37\xaf\x4d\x75\x3d\x4b\xd0\x45\x9b\xea\xb0\xc0\x4b\x56\xc4\x07\x72\x58\xab\x6e\x01\xbf\x3e\xf8\x78\x43\xb2\xb8\xbe\x29\x2b\xa4\xb9\x6b\x2b\x29\x71\x37\xc2\xb9\xc0\xdc\x47\xb6\x6c\x2f\x19\x05\x81\x2c\x21\x03\x65\xdb\x0a\xa6\xc1\xd4\x3f\xf0\x52\x8f\x83\x38\xae\x51\x31\x42\xdd\x39\xde\x22\xd2\x62\x37\xfa\x32\x5b\x4e\xa9\x7e\xf6\x45\xd7\xaa\xd4\xc5\x81\x3f\x8a\x62\x36\x3f\xd1\x72\xb2\x71\x91\x7a\xd5\x2e\x13\xbe\x28\x1e\x56\xb5\x4c\x3b\xb5\x08\x43\x76\xe5\x5f\xdb\x16\x31\x56\x64\x6e\x5b\xb4\x48\x61\xb8\x48\x6e\xca\x0e\x29\xe0\xff\x5d\x8f\x97\xcc\xd4\x16\x99\x32\xcd\x31\xc3\x18\xe9\x78\xba\x2b\xc3\x56\xc2\x6f\xf3\x1a\x8c\xc2\x34\xa2\xdc\x5d\x15\x48\x38\x37\x69\x64\x57\xef\x27\x99\xae\x9c\x66\xb1\x7a\xf3\xfd\xd3\x2b\x1b\x0f\xd8\x53\x4c\xd1\xbf\x76\x38\x21\x84\x04\x3a\xf8\xc2\x2b\xa8\x2a\x76\x4d\xfc\x19\x64\x56\x8e\x1c\x56\x53\x34\x6c\x57\xc8\xaf\xb8\x9c\x73\x7b\x3b\x83\x62\x5b\x85\x23\x22\x80\x79\xec\xdf\x06\xd1\x22\xb1\xb2\x61\x6c\x60\xa4\x0e\xf0\xeb\x30\x34\xca\x30\x34\x32\x30\xf4\x24\x7f\x4f\x20\xa9\x60\x54\x29\x8b\x9b\x1c\xcd\x68\x32\xbf\x6f\x8d\x1f\x70\xe0\x51\xb8\x2c\xa9\x20\xea\x31\x4b\x87\x96\x21\x8c\x2b\x87\x90\x94\x05\xb7\x67\x92\xb1\x4d\xcb\x82\xa6\xa5\x74\x67\xc4\xca\x8b\xc6\x53\xb2\xc8\xa6\x64\x91\x23\x5a\xa4\x35\x23\xcd\x3a\xbc\xc5\x12\x33\xe4\xff\x1d\x66\x65\xf1\x90\x59\xa9\xd8\xc7\xc6\x6d\x7b\xac\x5a\x14\x3b\x94\x3a\xc3\xeb\xd0\xdc\x37\xc7\x5f\xe9\xc8\xad\x40\x76\xf5\xbc\x74\x5c\xc2\x47\x93\x72\x69\x06\x05\x09\xf2\xc1\xb1\x9c\x40\x63\xca\xbd\x4d\x64\x6c\x8e\xbe\xa9\x88\xd0\xb8\xc6\x97\x72\x66\xb9\xe4\xd2\x9c\x39\xe7\xe5\x6b\xb5\x42\x4a\x44\x68\x23\xfc\x63\xee\xac\x03\x56\xa6\x6f\x3b\x41\xd0\xb8\x6c\x6c\xa1\x2f\x8b\x6c\x15\xe7\x27\x7b\x0c\x6a\x3b\x50\xc3\x8d\x17\x27\xad\x63\x2f\xbd\xe9\xcd\x82\x10\x15\xc9\xb4\x2d\xa7\x56\x97\x33\x2b\xb5\x44\xad\xa9\x07\xcb\xa3\x16\x39\x2f\x68\xa8\x3c\x71\x08\x5c\xdc\x6c\xd5\x38\x7c\xbb\x7a\x14\xb4\x33\x26\xd4\xb7\x18\x14\x81\xe6\x1d\xb2\x7c\xe9\xef\x72\x98\x5a\xdf\x1a\xee\x3b\xa9\x93\x40\x3b\x85\x8d\xb9\x7e\xdb\x8d\xdb\xf5\x5b\x6e\x2c\x8e\x47\xc3\x7a\x00\x40\x7f\x83\x75\x87\xa2\x70\x87\x6e\xc7\x12\x1c\x4e\x48\x56\x27\xd6\x7d\x58\x97\x88\x9b\x70\x0f\xd7\x71\x6d\x32\x59\xe9\x19\xc5\xd4\xc2\x40\x72\x38\xc2\xdb\x1a\x71\x8d\x42\xd0\x0f\x4a\x4e\xb5\x4c\xb9\xb1\x74\x7f\x12\xa2\x0f\x2e\xbf\x2c\x3c\xb9\xc6\xae\x30\xa3\xec\x50\x44\x1e\xf0\xde\x30\x79\x96\x89\x6b\x6b\xb6\x86\x0d\x81\xfa\x51\xd5\xd2\x2f\x95\x85\x72\x75\x2c\xa2\x50\x5e\x5a\x6a\x5b\x85\x9a\x7c\xa9\x12\xf6\x5c\x41\x4a\xc5\x8e\x84\x70\xcb\x50\xe8\x13\x6a\x87\x40\x2b\x51\x76\xab\x66\x3d\x3e\x31\x84\x10\xcb\xee\x54\x77\x04\xde\x4c\x5f\xd0\x0f\xc9\x4b\x36\xf0\x45\x19\xd8\x15\xd4\x59\xae\x47\x73\x57\x23\xdb\xd6\x2e\x18\x93\x57\x69\x71\x83\x17\x50\x67\xf8\x96\x15\xda\x7d\x5f\xce\x6d\x89\xa5\xed\xe2\xa1\x9f\x39\x7b\xe2\x1c\xd1\x4c\xc4\x60\xe1\x15\xfa\x7d\x9b\x14\x19\xbf\x5c\x3e\x97\x61\xca\x6a\x01\xc7\xf9\xa9\x03\x5a\xee\x13\xae\xbb\xeb\x08\x95\x94\xa4\x76\x53\x3e\xf4\xab\x61\xce\x80\xb6\x72\x07\xb9\xa1\x56\x02\xfc\x33\x60\x78\x0f\x8d\xb0\xd3\x28\x6e\x80\x69\x04\xd5\xfd\xb9\x7b\x7a\xf2\xd6\x05\xa6\x40\x5d\xc3\xb4\xbf\xf4\x66\x7e\x86\x40\xc5\x6b\x96\xc4\x9d\xdc\xfa\xde\x06\xe9\x8d\xbc\x7d\x7c\x5f\x67\x22\x99\x93\x77\xba\x83\xa2\x69\x27\x91\x69\x7e\xa8\x05\x33\xcf\x47\x81\xb5\x91\x6c\xd7\xe4\x35\x1c\x20\x2f\x2c\xdb\x2f\x9f\x54\x32\x19\xdc\xd2\x1e\xd5\x4c\x29\x1d\x48\x98\xa7\xdc\x36\xa8\xe4\x4d\xba\x6f\xbd\x1d\x29\x2c\xb6\x44\x86\xc4\xce\x61\x39\x08\x71\x7d\x29\xb4\x36\x9e\x1d\xae\xd7\x64\x6a\xf4\xad\x87\x30\xa7\x86\x43\x07\x38\x4f\x9f\x1a\x9b\x13\x28\xf0\xdd\x81\x3c\x40\xb4\xc3\xaa\x17\x97\x5d\xd6\x0e\xce\xa8\xd3\x6f\x5b\x66\x37\xe0\x0d\x59\x4c\x72\x82\x27\xc3\xbd\x11\x32\xb1\x97\xf4\x0c\x5d\x9a\xdc\x24\x68\x72\x53\xdc\xcd\x42\xbe\x4d\x51\xe5\x2f\x92\xcb\xe2\x66\x16\xd2\x68\xd5\xf8\x03\x3c\xa9\xc8\xdf\x7d\x56\x13\x50\x58\xc1\x4f\xbb\x83\xca\xd9\x43\xb0\xf6\xc2\x31\xf3\xf5\xea\x05\x56\xb6\xec\x53\xe7\xbb\x6a\xea\xb6\x2e\x8a\xaf\x48\xf0\x29\x4a\x4b\x35\xeb\x6b\x89\x45\x21\x69\xa6\x80\x1a\x8d\x70\xda\xcb\x21\xb5\x64\x5d\xd4\x99\x74\x59\x49\x2d\xb6\x93\x5a\x90\x27\xb5\xf8\x92\xc9\x76\x89\xcb\x5c\x5a\x98\x78\x05\x95\x28\x5c\xbb\x67\xaf\xf6\x5e\xba\x40\xa1\x74\xe3\x2a\x58\xba\x3c\xff\xaf\x40\x52\x20\x8e\xfc\x2f\xf0\xc7\xa5\x65\xbe\x92\xf2\x09\xa8\xb6\x8d\x82\x7e\x4e\xf1\x19\x91\x95\xcd\x80\xda\x94\xd7\xba\x22\x8d\xd3\x97\x53\xbb\xb8\x23\x37\x78\x94\x3c\x4a\x8e\x6c\xf8\x4e\x1a\x37\x1b\xe7\xb0\x2c\x9b\x4d\xd8\x34\x00\x07\x44\x22\x4d\x2b\xba\xc0\xd0\x03\x11\x86\xe2\x84\x71\x60\x3a\x06\x59\x4d\x28\x35\xc1\xd4\x76\x93\xfb\x11\xf6\x07\xa2\xae\xa5\x2b\x62\x99\x4e\x2c\x41\x42\xa5\xfc\x41\x74\x4c\x78\xde\x4b\x5b\x7d\x2b\x9d\x90\x78\xdf\x4b\x23\xa1\x22\xb7\xf9\x30\xa0\xb5\xf2\x2e\x59\x29\x3d\xeb\x8e\x35\xac\x19\xb2\x5c\xab\x64\x33\xee\x91\xe7\x12\xa5\x77\xb5\x4b\xcc\x0f\x62\x5b\x87\x36\x0a\xb0\xca\x12\xa4\xf8\xe7\x7a\x52\x29\x3c\xab\x96\x7e\x65\xdf\xb0\x33\xd9\x88\xa4\x8a\x60\x2a\xa0\x40\x79\x5c\x87\xc1\x97\x74\x55\x02\x81\x09\x45\x52\x05\x45\xe1\x66\xa9\xf8\x0a\x80\xd5\xff\xb2\x27\x00\x9d\xca\xa6\xf1\x3f\x53\x7b\x6d\x7e\x1f\xd0\x61\x95\x55\x9b\x7e\x92\x88\xc9\xa0\x6d\x64\x5a\x01\x20\x41\xdd\xd0\xb9\x40\xd2\xae\xef\x75\x21\x9e\x4e\x9c\xfa\xd7\x87\x1f\xe7\xb0\xa1\x3e\xa1\x8b\x07\xf7\x4f\x68\x0a\x34\x26\x3d\x2c\xf1\xbd\x18\x88\x73\x51\x81\xc5\xec\x30\xa5\x4b\x0e\xb9\xe8\x3a\x6a\x8c\x9b\x5b\xbb\x0e\xab\x12\xb3\x73\xeb\x28\xa2\x4e\xee\x64\xed\x06\x0f\x99\x26\x26\xd0\xee\xaf\xae\x00\xdb\x6b\x30\x62\xfc\x0f\x1e\x16\xce\xc5\xa2\x9b\xd4\x8c\x4b\x8e\xed\xba\xc9\x48\xe4\x68\x66\xb8\x7b\x00\x40\x26\xf0\xd7\xc0\x8f\x1a\xf4\x86\xff\x61\x3c\xce\x10\x8f\x33\x3a\x83\x51\x0f\xc4\x14\x13\x47\xa2\x1f\x77\x9c\x19\x1f\xeb\xfb\xf8\x40\x8e\x32\xf0\x96\xa7\xb6\x8f\xcf\x7f\x78\x58\xae\x3d\xa7\x98\xfa\xb9\xcc\xae\xa8\xf4\x99\x12\x3f\x3f\xe2\x67\x5d\xb9\xc7\x49\xe2\x11\x12\x86\x07\xc7\x97\xb4\xa5\x6c\xd9\xf6\x56\x90\x43\x31\x67\x6f\x5f\x5e\xc5\xd1\x6d\x30\xf6\x63\xe3\xe1\x87\x7c\xe3\x2b\x9e\xd8\x68\xaf\x68\x06\xe8\x9a\x4f\x19\xeb\xc5\x8b\x29\xd7\xd4\xcc\x93\x13\x6d\xbb\x10\x15\x15\xa8\x3d\xb5\x97\x18\x60\x8c\x94\x4d\x0c\x6e\x7f\xfb\xde\xe8\x26\x6f\xf3\x2c\x35\xe6\x7c\x91\x4f\x9f\x45\x81\xa9\x97\xa4\xbc\x7f\x11\x69\xe5\xaa\x67\xb9\xfc\x7e\xa7\x0c\xe4\x43\x7a\xe4\x3c\x7e\x4f\x16\x04\x5a\x5d\x7a\x46\x25\x5e\xc7\xc8\x74\x7e\x53\xfe\x16\x5f\x9e\x1f\xab\x27\xec\x58\x60\xa0\x55\x3c\x8f\x16\xa3\x1b\x03\xd0\x20\x61\x74\xf8\x63\xd8\x91\xf5\x7e\x04\x12\xa4\x7b\x52\x5e\x22\xaf\x3e\x62\x83\x9b\xb2\x48\x1a\x4f\xdf\xc8\x40\xd4\xca\x82\x78\x1c\xdc\xe6\xc6\x23\x1f\x87\xe5\x92\x53\x7e\x21\x97\x18\xf0\xe4\x5e\x99\x8a\xd4\x83\xc3\x67\xaf\x7f\x20\x34\x18\x32\x7f\x61\x1a\x6b\xde\x59\x24\xaa\x8a\x4e\x5e\x89\xd8\xa9\x4d\xe8\x60\x77\x2a\xa4\x05\xc9\xb1\x7c\xf3\x9b\xcf\xd8\x17\x06\x6f\x63\x8b\x05\x7c\x01\x4c\x4d\xa6\x99\x04\xe1\xf8\xb9\x32\x0c\x6d\x2e\x47\xfb\xba\x39\x29\x68\x55\x36\x45\x84\x37\xce\x54\x3d\xf3\x8c\xb3\x43\xf2\xb8\x28\xf6\xc4\x45\x91\xbc\x4c\xd6\x51\x47\x0c\x26\xf4\x7c\xcb\x60\x17\x63\xb2\xa3\x86\xa0\xe2\x78\x2d\x26\x23\xd8\x46\xba\xcc\x32\x48\x46\x6b\xc6\x52\xf3\x36\x95\xea\xb7\xf2\xe3\x8d\x75\xda\x88\x3a\xf2\x9b\xf4\x76\xdc\x0e\xbd\x70\x74\xc3\xe7\x89\x2a\x8f\x25\x1a\x2d\x97\x13\x30\x5f\x51\x54\x22\xbe\x54\x3b\x13\x58\x20\x89\x6c\x46\x89\x45\x59\x16\x7f\xdb\x9e\xb2\x2a\xe0\xd3\x6a\xe0\x93\x22\xf0\x46\xa7\x79\xd8\xb5\x6e\x0d\xd0\xa3\x3c\xe8\x26\x0a\x4c\xd8\x75\x04\x94\xbd\xc3\x95\x62\xb3\x76\x2b\xa4\xad\x52\x94\xa6\xe4\x51\x30\x88\xe1\xf8\xca\x52\x71\x6c\xdb\x1d\xde\x57\x5f\xca\x8f\xc8\x38\x3b\xd0\x53\x3b\xe2\xe8\x5f\x4b\xca\xa3\x5e\xad\xd4\x28\x87\xa3\x5d\x7e\x1e\xfc\xa8\xe5\xc2\x56\x77\x84\xcb\xd2\xa5\xa7\xee\x78\x90\x10\x91\x46\x6b\x3b\x4a\x94\x43\xb4\x0a\xe2\xc6\x69\x9d\x36\xab\xbb\xac\x85\xe5\xbb\x4a\xb0\x2b\x2c\x9a\x94\x76\xa5\x0e\xf7\xca\x24\x2a\x82\xa5\x27\x9b\x45\xf1\x47\x7d\xb4\x71\x15\x69\x17\x09\x5a\xb1\xef\x8c\x62\xa9\x51\x4c\x83\xdb\x79\x6a\xa2\xac\x9d\x23\x24\xe8\xce\x20\x4f\xb4\x80\x69\x91\x09\x5e\xab\x5d\x7a\xdf\x60\xd5\x11\x72\xcc\x0b\x48\xfb\xc2\xa4\x67\x0d\xaa\xcb\x1a\x1d\xe5\x51\x28\x9e\xcb\x93\x8c\x71\xc8\xcf\x0c\x5e\x87\x99\xf7\x28\x4c\x71\x90\x0f\x91\x57\x1b\xc5\xa3\xdc\x8a\x76\xc3\x3c\x2a\xf4\xa1\xfe\xa1\xb9\xf4\x67\xa1\x77\x6d\x5a\x50\xb5\x52\x5f\x45\x39\xd1\xb4\x33\x17\xef\x27\xb2\xfd\x96\x10\x84\xce\x88\xc8\x2f\x5a\x14\xa5\xec\xce\xcc\x73\x08\x15\x00\x33\x3f\xab\xd0\x30\xa9\x8e\x5a\xda\x7f\x58\xa6\x23\xc6\x42\x93\x9e\x32\x7c\x59\xba\xfa\x6c\xdb\x32\xf4\x0b\x6f\x32\x77\xf3\x6b\xe4\x08\x21\xe2\x48\x01\x22\x98\xc4\xd0\x09\xa5\x58\x6f\xaf\x0e\x39\x70\x62\xcb\xe5\x82\xea\x15\x9a\xa8\x27\x8c\xea\x46\x49\x82\x4a\x23\xde\x90\x5c\x81\x8a\xe9\xc7\x3b\x4e\x7f\xf7\x86\xe4\xc3\x1d\x90\x71\xfb\x7f\xde\x95\x96\xfc\x3b\x8e\x77\x05\x58\x5a\xa4\xfe\x2e\x85\x52\xe2\x6c\x72\x49\x12\xfd\xf5\x08\x5f\xc9\xb1\x70\xf9\x33\xad\x78\xe1\xb4\x4d\x19\xfc\xaa\x5e\x63\xdc\x13\xdd\x7f\x70\x59\x3b\xd1\xee\xcb\xf4\x62\x79\x58\x47\x7c\x51\xf3\x96\x9f\xfd\x83\x20\x7f\x88\x7e\x90\x5e\x04\x20\x04\xd2\xe5\x71\xec\x27\xec\xaf\x80\xa5\xb9\xf0\x94\xbe\xdf\x0b\xe7\x1c\x28\x64\x97\x3d\x60\xd3\xa5\x44\x5b\x97\x12\xa9\x9a\xb9\x43\xef\x2a\x1a\xdf\x17\xd1\x37\xf3\xe2\xeb\x00\xb0\xd4\x9f\x7f\xdc\x9d\x03\x88\xb0\xe4\xf8\xc3\xc0\xa6\x86\xba\x5d\x79\x38\xbb\xe3\xdc\x80\x3e\xef\x87\xbb\x7c\xc5\xb7\x83\x10\xb6\xba\x5d\xd2\x75\xba\xec\x5b\x5a\x44\xa3\xa4\x4e\xdb\xbb\xdd\x3b\xff\xea\x43\x90\x76\xc9\x99\x0c\x2f\xeb\x1d\x8a\x0c\xbc\x0b\xc5\xff\x6a\x49\x76\x4d\x89\x56\xcd\x8a\x1a\xbd\x3e\x26\x01\xfc\x79\x34\x2f\x20\xc9\x52\xec\x19\x3b\x56\x03\x04\x8c\xbc\xe9\xa8\xa5\x83\x8e\x1a\x14\xce\x43\x97\x31\xd0\x76\xbe\x71\xd6\xda\xae\xed\x45\x5f\x9e\x64\xa9\x07\xbd\x64\xfe\x81\x83\xfb\xb1\x8b\x2b\xf0\x93\x23\xe3\xc7\xee\x38\x57\xe8\x39\x69\xd7\x71\x24\xba\xab\x20\xd9\xe5\xe8\xcf\xdd\x46\x65\x3f\x23\xf6\x7a\x37\xbe\x37\xb6\x3f\x1b\x44\x74\xef\x27\xc9\x8b\x20\xfc\xf0\xde\x36\x18\x8a\xd5\x64\x2b\x9c\x7b\xc5\x17\xfb\x53\xf6\x8b\x2c\xbd\x0b\xe5\x2b\xc9\xfb\xce\x32\x74\x59\xc1\x2c\x34\xd0\xce\x54\x37\x58\xad\xd6\xf6\x3e\x76\x39\xd3\xcd\x95\xcd\x01\x2c\x96\x08\x3a\x52\x43\x8d\xd1\x25\x77\x9b\x0b\xbf\xa6\x56\x32\xf7\xa7\x53\xd0\x5c\x31\xf4\x84\xe3\x92\xb7\xa6\x9a\x1a\xe8\x29\x72\x14\x61\x78\x0b\xf6\xe3\x19\x4d\x26\x8d\x6a\x90\xfb\xf4\x25\x2a\x78\xf3\xd4\x9b\x0a\xbf\x27\xb8\x6c\x6a\xea\x00\x27\xe7\x31\x03\x56\xaf\xa2\x8f\x35\xa5\x01\x45\xa4\x11\x61\x8d\xee\xa0\x58\x38\xcf\x4e\x46\xf8\xfc\x89\xe3\xe0\xec\xb0\x87\x31\x96\x9c\x76\x73\x04\xaf\x45\x3c\xda\xc9\x02\x10\xed\xaa\xe0\x41\xc0\x6b\x36\x80\x09\xd1\xb7\x70\x2a\xd6\x9d\x06\xd7\x1e\xa8\x33\x7e\x22\xd8\x83\xc1\xa1\x24\x57\xea\x42\x7b\x2c\x14\x83\xfc\x28\xd3\x3e\x2a\x5e\x75\x87\xf6\xed\x5d\xea\x6c\xc7\x99\xc7\xbe\xc9\xd9\x16\x29\xae\x2f\x6e\x1e\xc4\x59\x74\xf5\x97\x7a\xe4\xee\x4e\xb0\xcf\x02\x89\x0a\x44\xe4\xf1\x52\xe4\xf5\xc2\xa9\xb6\xe2\xf5\xbc\xa5\x1a\xbc\xbe\xb6\x0d\x72\x95\x97\x6f\x82\x4e\x31\x96\x6b\x27\xc5\x93\x0d\x12\xff\xb3\xc6\xf8\xb4\x63\xf9\x66\xd0\x9c\xe3\xcb\x5b\x01\xa4\x7e\x79\x23\x23\xf4\x37\x36\xfd\xa2\x76\x28\xea\xa6\x6a\x00\x9d\xc2\x2f\x57\x9f\x7d\xc6\xa9\x06\xe8\xbc\x67\xb9\x16\xc6\x71\x34\x87\x06\x4a\x24\x2a\xdd\x58\x82\x0e\xd6\x70\xaf\x97\x0f\xe3\x3b\xf2\xc4\x87\xc4\xb6\x76\x46\xb4\x85\x4e\x3e\xf8\xf7\xe8\xfb\x3c\x03\xf4\x19\x94\xfb\xc9\xbf\x3f\x80\x44\x1b\xb8\x31\x1f\xdd\x25\x56\xb6\x0b\x02\x9e\x5b\x28\xd8\x0b\x50\x23\xe4\x83\xcf\x1d\xf4\x08\x8a\xe2\x65\xe2\x16\x8a\x15\x18\x48\x8e\x4f\x64\x62\xdc\x24\xf8\xe8\x8f\x8b\xc2\x87\x55\xa8\x40\xde\x66\x11\x2a\x28\xd9\xcd\x4f\x41\x7e\x49\x2b\xe0\xe4\xa0\xd2\x68\xce\xb2\xf5\x33\xef\xba\x6c\xe3\xa1\xfc\xee\x95\x77\xed\x5a\x6b\xd5\x8e\xb3\x30\xae\x46\x7b\x7c\x81\x9b\xc9\xc1\xb1\xdf\x58\x13\xea\x22\x50\xd9\xfb\x4e\x0d\xe8\xe7\xd1\x74\x5c\x32\xca\x09\x46\xde\xcc\x17\x35\x66\x1a\x12\xa9\x14\xfc\x89\x51\xd4\xc9\xe2\x08\xbb\xf9\x6a\x75\x18\x29\xd2\x4a\x61\xa6\x64\x5b\x6d\x63\xd4\x6a\x00\x46\x77\xc5\xb1\x6a\xa5\x8d\x31\x70\x7a\xcd\x30\x2a\xa0\xd2\xda\x6d\x1b\xe7\xcd\x7b\x80\xcf\xda\x15\xa4\x15\x35\xa0\xe2\x74\xd8\x4b\x3d\xd7\x52\xb2\x80\x4c\xf2\x03\x0c\x1a\x6c\x7a\x2f\x29\xaa\x96\xee\xb5\xf6\xda\x45\x2b\x29\x17\x63\x78\xed\xac\xac\xdc\xdd\xdd\xf5\xee\xd6\x7a\x51\x7c\xbd\xb2\xda\xef\xf7\x57\x92\xdb\x6b\x37\x67\x54\x0d\x49\x0a\xff\xa8\xfd\x55\xe9\x79\x2f\xcf\xd0\x46\xc0\xc5\x56\x14\x06\xa0\xba\x31\x74\x8c\xa9\xdc\x65\x9f\xdb\x14\x12\x2f\x2b\x65\xca\x2a\x1f\x67\xd3\x10\x59\x4c\xd0\x2e\x2f\x23\x02\xd2\xa2\x38\x33\xe8\x0d\xcc\x3e\x0b\x48\xb4\xe8\x90\x40\x4f\xa8\x6a\x62\xb4\x18\xfc\xb7\x1c\xcd\xb4\x13\x9c\x83\x72\xe5\xdb\x85\x5f\xe4\x45\x34\x9b\x6d\x4b\x05\x63\xf8\x7a\x54\xe5\x6e\x4a\x05\x6c\x9d\x34\x13\xdc\xcc\x1a\x0d\x06\xac\x84\x2c\x90\xc6\xa4\xac\x04\x3f\x05\x02\xe0\x97\xf0\x51\xcd\x1f\x11\xb0\x22\x42\x46\xdf\x0a\xa2\x10\xbd\x0f\x85\xe8\xad\x5f\xa1\x94\x92\xa5\xde\x80\x75\x14\x16\x69\x00\x86\x73\x14\xa2\x43\x6e\x51\x9e\xbd\xc0\xbf\xca\x6a\x9d\xcb\x12\xd6\xdd\x8e\xd4\x70\xd3\x0d\x4a\xf1\xf8\x03\xd0\xfd\x1c\x86\xf3\x9c\x03\x63\xd6\x7a\x81\x32\x25\xe6\x89\x5e\xd3\xb7\x0a\xd5\xbc\xaf\x61\x17\x67\x2a\x00\x25\xbe\xd3\xfb\xed\xb7\xcc\xa1\x0f\x39\x6d\xd1\x2f\xfc\xea\x80\xbe\x2e\x05\xba\xd4\x5b\x5b\x09\xdc\xb5\xc8\x79\xcd\xca\xdb\xeb\x78\x5a\xe1\x7e\x0b\x7d\x98\xd4\x29\x98\x37\xb1\x8f\x97\xaa\xbe\x55\x17\xd5\x8c\x03\xe5\xab\xd1\x4c\xed\xaf\xd4\x2a\xa9\x7e\x5b\xbd\x47\x2e\x6b\x55\xbe\x5b\xc9\xb5\x6a\x98\xc9\x17\x5a\x6d\x8a\x1c\xb1\xee\xaa\x8e\xcf\x74\x1d\x38\xff\x62\xac\xba\x1b\x3e\x6e\xac\x74\xcf\x23\x4f\x8d\xa8\x68\x2b\x2f\x9c\x8a\xd4\x06\x14\x25\xa3\x93\xd2\x0b\xd7\x07\x90\x15\xe9\x8b\x0d\x96\x5b\x79\x3f\x7e\xcd\x82\x1b\x89\x1a\x7e\xed\x68\x9e\x99\xef\x75\x1f\x30\x9a\xdc\x8b\xdf\xda\x71\x55\xf5\x58\x37\xae\xab\x42\x5d\x7f\x89\xfe\x8e\x66\xe8\x61\xfd\x61\xfd\xc9\xba\xcb\xf4\x57\x70\xfb\xd9\xbc\x3b\x51\x75\x99\xde\xec\x9e\xa2\x1a\xf7\xa8\x55\xaf\xef\x75\x5f\xbf\xde\xaf\xf4\xd7\xa9\x17\xac\xa7\x46\x3e\x9c\xae\x74\x97\x9a\xb9\x1c\x81\xf2\xfb\xd3\x00\x0d\x11\x94\xd3\x54\x79\x3e\x51\xb0\xb5\xff\x24\x37\x76\xbf\xc7\xbf\x3a\x8e\xd8\xde\x61\xeb\x21\x87\xa6\xdd\x4a\xdb\x86\xcf\x0d\x41\x6f\xee\x1c\xd5\x18\x6d\xab\xcd\x50\x34\xec\x65\x09\xd7\xae\xf9\x6e\x78\xf4\xb5\xfd\x48\xf1\xb5\x51\x1f\x6a\xb3\x68\x08\xbe\xb8\x21\x6e\xcc\x68\xaa\x9b\x05\xf9\x05\x08\x01\xcd\x6c\x6a\x9d\xb3\x3d\xd8\x74\xa7\x76\x41\x9c\x66\xe6\x49\x75\x0e\x3d\x15\xb8\xad\x1a\xdb\xa6\x04\xca\x8c\x17\x53\xff\xd4\x1f\xc7\xde\x5d\xdd\xce\x94\xb9\x7d\x2c\x37\x67\x90\xf7\xc1\x7e\x41\x5f\x34\x5c\xa8\xf8\xea\x85\x9b\xa6\xdc\xed\x96\x1b\xcd\x96\xbe\xc6\x93\xcf\x5f\x84\x1a\x90\x73\x41\x24\xdc\xc8\x95\x3d\x78\x2e\x9b\x0d\x9c\xb0\x12\x7f\x83\x28\xea\x92\x2b\x57\xba\xb1\x6c\xe9\x7e\x5f\x70\xab\xa3\xc1\xa8\xdc\xe2\xeb\x58\xc0\xf2\x1b\x0e\x28\x83\x0e\x3a\xc9\x4a\x2f\xa8\x13\x6e\xc4\x1c\x1d\xd5\x63\x9f\xdf\xe6\x18\xd6\x4d\xda\xa4\xd9\xb0\x2b\xa7\xc9\xe2\x33\xc8\xd6\x04\xde\xdc\x67\xce\xc5\xed\x40\xa8\xb7\xf1\xf4\x7c\x17\x5f\x58\x59\x1a\xc2\x4b\xbf\xec\x2b\x77\xa1\xfc\x59\x79\x2c\x6f\x70\x69\x2a\xb4\x86\xa5\x37\xc2\x49\x56\x09\x1d\x05\xb8\xf3\x8f\x5f\xaa\x03\x34\x71\xc1\x0d\xc4\x9a\xc0\x4c\xa6\xad\x0a\x88\x6a\x7a\x9b\xf9\x5e\xb2\x88\xfd\xfd\x9c\x59\x62\x25\x43\x60\xcb\x44\x65\x25\xaa\x2c\x15\x73\x82\x78\xd5\x39\x24\x55\x31\x0f\x21\x31\xa9\xab\xcc\x23\xbb\xfa\xd5\xab\x28\xfe\x55\x0e\x00\x94\xc6\x8c\x97\x33\xda\x25\x82\xd4\x9e\xf3\xc9\x06\x0c\x67\x73\x2f\xac\x1f\x69\x02\xa5\xcc\xa1\x52\x3d\xcb\x70\xb1\x64\xf7\x2e\x8a\x3f\x78\x24\xd2\xb8\x96\x3a\x9a\xa3\xd8\x96\xfb\xb3\x0b\x2c\x69\x0e\x9d\xb5\x06\xfd\x3e\xbe\x9c\x72\xdf\xc5\x6e\x5b\x4f\x33\x31\x56\x3c\x3c\x55\x2d\x1b\x25\x9f\x79\x89\x8f\x57\x2b\x5f\x73\x6c\x57\xa2\x4d\xd7\xda\x51\x71\xdd\xb8\xfd\x6c\xd1\xe4\xca\xe6\x6e\x69\x7f\xb6\x01\x90\xb5\xf7\x56\x0a\x1b\xbe\xa1\x8c\x57\x1c\x09\x32\xb6\x2c\x97\xf9\x56\x99\xcd\xc0\x61\x6c\xda\xf0\xa6\x42\x38\x5b\xc1\x1b\x2b\xbe\x39\x25\x60\xe8\xbb\x28\xe5\xd5\xcf\x95\x42\x02\x39\xdb\x93\x38\x55\xae\x39\x4d\x3c\x45\x64\x45\x04\x3b\x8a\x49\x05\x05\x65\xb8\xd0\x72\x0e\x41\xf6\x0a\xaa\xa0\xe5\xd0\xb0\xe4\xf6\xed\xf6\x9a\xe1\xc6\x23\xc2\xe7\x9e\x08\xdb\x92\x9d\xe8\x29\xd9\xd5\x9b\xfa\x35\x6d\x17\x40\x92\x6d\xd7\xf0\xf3\x3c\xf7\xad\x11\xb9\x72\x45\x29\xd1\xc6\x22\x5b\xcb\x1d\x46\x71\xa9\x46\xe2\x9e\xc5\x48\x59\xa3\x42\xcd\x3d\x9e\x54\x1d\xa4\x22\x20\xd9\xff\x28\x2b\xa8\x6d\x41\xdc\x2c\xcb\xe1\xd9\x49\x02\x64\x08\x69\x25\x39\x80\x7d\x35\xc4\xb3\x57\x90\x23\x72\x2b\xa1\xb8\xad\xb3\xbc\xc3\x51\x50\x34\x3b\x99\x4f\x99\xb9\x23\x1f\xdb\x7c\xee\x38\xe5\xdb\xba\x2f\x8e\xb4\x01\x82\xf3\x88\x65\x9d\x96\x6f\xc8\x96\xf4\xba\x49\xd8\x87\xb7\xda\x64\xdb\x63\x95\x31\xf9\x42\xad\x9e\x12\x2c\x63\xad\x55\xdb\x6c\x4a\x49\x0e\x11\x16\x03\x79\xa1\xa4\x55\xd8\xc8\x67\x7a\x1d\x15\xb9\x55\x72\x9c\x8c\x2a\x81\x1e\xdf\x92\x99\x17\xa7\xcf\xa7\x51\x14\x1f\x04\x88\x92\x56\xae\x96\x41\xaf\x3d\x79\xed\x64\xb7\x49\xca\xf7\xf0\x4d\x79\x13\xb9\xf1\x65\x35\x81\xb5\x1c\x93\x41\x92\x66\xac\x9f\xe5\xf2\x1c\xaa\x02\xb2\x35\x24\x50\xfb\xed\x12\x1b\x37\x29\x5b\x9f\xd2\xce\x58\xa0\xda\x2d\x79\x85\xa9\xb9\xe8\x55\xed\xe7\xdd\x00\x38\x09\x7a\x59\xe4\x07\xba\x6a\x31\x89\xd5\x90\x08\xdf\xa9\x49\xb9\xf3\x00\xeb\xad\xe8\x9d\x50\x9d\xe5\x2f\x43\xec\xcb\x17\xbe\x91\x9b\x52\x4a\x2e\xce\x2a\xcb\x4e\x39\xd0\x8b\xb1\xaa\x99\xb5\x53\x08\x98\xca\xba\x69\x66\x5f\x65\x56\x45\xc3\xab\xae\xea\xb9\x7e\xad\xe8\x0c\xa3\x9a\x71\xe5\x5f\x71\x14\x54\x44\x73\x19\x97\x5e\x8f\x65\x28\x2a\xa3\x4b\x49\xb3\x66\x8f\x4f\xaa\x08\xa7\x8a\x44\x1b\xa1\xc2\xe0\x37\x4b\xe9\x4a\x31\x55\x79\xb0\x9e\x14\xcb\x1e\xbf\x44\x47\xe2\x46\x90\x71\xf2\xaf\xf7\x5f\xa2\x1c\x89\x26\xaa\xa9\x81\x8e\x0b\xd4\x25\x36\x6e\x26\xf9\x97\x40\x44\x5e\xc6\x1e\x64\xdd\x9c\x4a\x8e\x3f\x3e\x7d\xde\x2d\xe5\xd1\x39\x5d\x3a\x2d\xd7\xa5\x7d\x9b\x2e\x7d\x2e\xb8\x47\x4b\xdd\x65\x60\xea\xb3\xec\x48\xa1\x95\xea\x19\x86\xea\xed\x6b\x1e\xef\x4a\xb7\xd5\x9a\x63\x9b\xd2\x61\x37\x7e\xcb\x95\x7f\x01\x65\x45\x4c\x71\x55\xf0\x86\x20\x7d\x14\x1b\xab\xab\xc6\xc6\x58\xc3\x5a\xf5\x13\xe6\x47\x79\x1a\x90\xaf\x1b\xe4\xb5\x50\x31\x4b\x33\xb7\x27\xcf\x13\x39\x7e\x97\xbd\x19\xca\x1c\x89\x4a\x50\xe4\xad\x90\x66\xef\xa2\xbb\x79\x32\x0a\x77\xca\x1b\xb6\x1b\xe1\xdb\x86\xa3\x9b\xa4\x17\x47\xc4\x8f\x36\xac\xe3\xb1\x0c\x5b\xf7\xd0\xfe\x35\x46\x56\xdd\x85\x1c\x23\xed\xa9\x76\xb8\x7f\x3f\x80\x8a\x1d\x00\x38\xcb\xe0\x25\xdf\xd4\x6e\xbb\x56\x97\xa9\x43\x47\xf1\xf5\xcb\x6e\x03\xd2\x2b\xb6\xda\x00\x14\x2b\xcd\x35\xb6\xef\x37\x79\xd3\x72\xcb\x4f\x3c\x23\xaa\x27\xd5\x6f\x6d\x4b\x0f\x39\x84\x39\x7c\xdd\xb4\xa7\x48\x11\x95\x36\x38\x5f\x69\x8d\x15\xb9\x86\x06\xba\x6e\x4b\xd4\x84\x8c\x1a\x51\xb5\xd6\x68\x09\x61\x17\xd7\x56\x73\x40\x14\x83\xfc\x52\x50\x64\x43\xff\x25\x56\x56\x91\xb2\x1e\x00\x87\xa5\xb9\xa5\x56\x95\xbe\xb1\x57\xbf\x62\x95\x39\x71\x21\xcb\x70\xbf\x83\x63\xb1\x46\xf5\x60\x17\x3b\x32\x3a\xe9\x41\x30\xa6\x17\x41\x18\x93\x19\xf6\x5e\x1f\x94\x37\xb2\x86\xa1\x27\x41\xae\xfd\x61\x9b\x78\x63\xa6\x2f\x28\xd1\xda\xa1\x6c\x05\x43\x7a\xb3\x8a\x85\x36\x73\xce\x15\xd1\x4d\x4d\xd3\xea\x69\x6e\x31\xab\xe0\xa3\x07\x0f\x78\x4a\x3c\x14\xc6\xed\xd2\xb7\xf9\x76\x27\x4e\xfc\x7c\x52\xb9\x02\x29\x52\x94\xa6\xec\xe5\x29\xa3\x93\x3d\x87\x33\xd6\x83\x57\x75\x79\xd4\x21\x67\x18\xca\x27\xa7\x4d\x30\xea\xd4\x69\x34\x6d\x76\x99\xd1\xdf\x85\x7f\xbe\x45\xc7\xda\x37\x76\xe7\x3f\x23\x71\x3b\x70\x53\x7c\x0e\xe9\x21\x8d\x84\xec\xf1\xdc\x33\x1e\xb4\x8d\x38\x91\xde\x5a\x6a\x4f\xdd\x9e\x52\x4e\xdc\xf2\x00\x5b\x6d\xe9\x6e\xde\xbc\x14\x93\x0f\x12\xe9\xed\x64\x62\xad\x9a\x88\xaa\x49\x49\x55\x02\x8b\xba\xf6\x4a\xfd\x96\x18\xfe\x0f\x27\x3e\x7a\x21\x11\xde\x04\x5b\xa3\xb2\x30\x34\x8b\xb2\xe7\x98\xf2\xcd\xdc\x34\xba\xc6\xd0\x46\x8b\xe9\x38\x7c\x9c\x3a\xd4\x2a\x3d\x8e\x23\x9b\x39\x7e\x1d\x37\x2a\x79\x6e\x78\x85\xfe\x4c\xfe\x50\xff\x9c\x90\x46\xb4\x50\x16\x4e\x25\xec\x75\xd1\x41\x4f\x1f\xce\xc2\xe2\x22\xd2\xce\x81\x3c\x81\xd2\x85\xe9\x14\x6b\x87\x12\xbd\xf2\x70\x38\x65\xcf\x3d\xd9\xd7\x49\x29\x82\xdb\xff\x13\x60\xb2\x16\x7f\xb6\x91\x16\xd0\xb1\xfb\xbf\x0e\x06\x2c\x37\xc8\x5e\x99\x28\x45\xeb\x34\x6c\x37\x30\x29\xd0\x94\xee\x6a\x15\xdd\xb4\x32\x37\x8d\xe9\x5a\x96\x12\x45\x07\xb8\x95\xcd\x14\x37\x64\xa3\x64\xbb\x5d\x62\x04\x6f\x85\xa4\x50\xa8\x04\x98\x8a\xc6\x8a\xf0\xe4\x0b\xb7\x6b\xd0\x3b\x42\x55\x5b\x8b\xe2\x55\x69\x40\x64\x51\xd2\x2f\x7c\xc5\x7d\x2f\x1b\x18\x06\xea\x04\x5e\xe3\xab\xba\x78\xa3\x24\x97\x89\xfd\x90\x01\x3d\xab\xaa\x98\xf0\xf6\x22\xdf\x57\x64\x62\x9c\xf8\x9d\xd2\xed\x90\xcc\xf7\xfd\xca\xf3\x1b\xe5\x66\x5c\xc7\x28\x1f\xab\xd4\x5d\xcc\xe3\x64\xed\x4d\xa7\xd5\x07\xf6\xf9\x60\x0e\x8f\x2a\xb6\x7e\xed\x05\xff\xa7\x52\xd1\xb4\xf4\x44\xb9\x5e\x0c\x2d\xab\xda\x2e\x3a\x02\xb5\x30\xb6\xbe\xe5\xcd\x8e\xce\x56\xfc\xdc\x91\x7a\xed\xe1\x53\x3e\xde\x5a\xb5\x4d\x8d\x49\x66\x1d\x23\x1a\x60\x89\x18\x84\xb1\x98\x2c\x7e\x2c\x74\x95\x27\xef\xd3\x57\x17\x74\xe2\xaa\x69\x28\xd1\x9b\x1a\xcc\x42\x49\xcd\x76\xde\xd9\xa1\x75\x12\xe2\x76\xf5\xe5\x71\x01\xa9\xa9\x45\x02\x2d\x1d\x75\x89\xaf\xdb\xec\xee\xbd\xd4\x47\x48\x16\x93\xce\xe7\x90\xb7\x41\x0f\x86\xe6\xa3\xa5\x3c\x5e\x3a\x17\x9d\xdf\x94\x9c\xc1\x36\xb1\x7f\x83\xf4\x63\xef\xe3\xfb\xda\x08\xc3\xc5\x8b\x95\xc2\xb3\x95\x87\x1f\xd5\x77\x2b\x9a\xa7\x5b\xd0\xba\x53\x7c\x75\xd1\xc8\x0f\xd0\x2b\x2d\x7b\xca\x4e\xa4\xf3\xa7\xab\xfe\x52\x27\xa9\xc5\x6b\x39\xbf\xea\x0e\xae\x02\x57\x1d\xc3\x80\x2c\x9b\x22\xe3\x62\xd2\xf9\x0e\x4a\xb1\x67\x6a\x5c\x5b\xf9\x37\x0c\x54\x09\x71\x81\x1c\x52\x8b\x44\x57\xc8\xcf\x22\xd2\xe6\xaf\x5e\x9b\xe3\x5c\xdd\xf0\xfd\x97\x46\x7b\x35\x89\x66\xfd\xb4\xc8\x73\xea\xb2\x77\xae\x6d\x15\xe0\xd5\x88\xd9\xfa\xa0\x09\xab\x5f\xd7\xda\x85\x40\x9d\x91\x19\xe9\xc6\x64\x9e\x54\xd6\xe3\x4a\xc5\xa8\xea\xed\xf9\x8d\x8b\x99\x5a\x2e\x53\x3a\xf9\x5d\x67\x50\xdd\x97\x7a\xd3\xdd\x30\xfc\x47\xf9\x9d\xbf\xbc\xf9\x7d\x5a\x72\xfd\x4f\x31\x1b\xe4\x6d\xe6\xd3\x32\x13\x00\xa5\x78\xca\x8b\xb9\x4e\xce\xac\x42\x3d\x49\x2f\x9a\x55\x64\xef\x62\x9a\xd9\x9f\x18\x8f\xd1\xed\xe3\x6f\x56\xb7\xe6\x98\xb9\xf8\xf2\x5d\xba\x4d\x1f\xf3\x2b\xe8\x57\xfc\x26\xba\xe8\x66\xca\x40\xbd\xe6\xff\xef\xc0\x9f\xa6\x1e\x35\x12\xe7\xef\xb5\x33\x02\xec\xea\x82\x30\xfb\x5e\x93\x8b\x89\x2c\x04\x2c\x71\x85\x83\x5a\x0e\xca\xed\x7c\x07\x25\x65\x3b\x01\x41\xf1\xa8\x14\x8c\xca\x45\x49\xe1\x4f\xf3\x4f\xc2\x9b\xdb\xa5\xe6\x30\xd2\x58\x2d\x48\xee\x02\x0e\x42\x8b\x01\x70\x52\xef\x98\xbc\x50\x99\xa8\x1f\x79\x20\xb8\x50\xd3\xf4\x4e\xaf\x77\x70\x72\xfc\xfe\xe0\xf0\xc5\xf9\xde\xfb\x57\x47\x3f\x1f\xbe\xd8\x29\x6a\xbb\x74\x02\x48\xed\xfd\x45\xb2\x34\xbb\xcb\xc6\xa2\x56\x4d\x1a\xf5\x6e\xc3\xfe\x5f\x1c\xbd\x3c\x6c\xd6\x7d\xb5\x15\xcb\x83\x3a\x7f\xb5\xf7\xc3\x17\x74\xae\xe1\x85\x48\x01\xc8\x4c\x6e\x6b\x55\x5e\xe0\xb1\x5a\xdd\x72\x24\xbf\x09\x4b\x2f\x62\xe5\x2e\xb3\x7e\xf9\x52\xd1\xa5\x16\x2e\xfb\xf4\x2e\x6f\x3c\xff\xb8\x25\x18\xe3\x3b\x16\xd0\x21\xc2\x34\x98\x04\xe8\x42\xf9\x7e\x87\x02\x1a\xa3\x78\xf7\x97\x8e\xf3\x31\xfb\xfa\x39\xe7\xde\xd5\x9c\xbe\x8c\xbc\x71\x98\xb6\x2e\x71\x82\x75\x8f\x1a\x3b\xd6\x83\x1e\xbe\xa1\xe1\x43\xdc\x54\xf8\x74\x47\x07\xb7\x63\xc2\x85\xe6\xd7\xfd\xc9\x13\x8c\x01\x80\xcc\xa0\x95\x2f\x73\x91\x5e\xb6\x0b\xfe\x49\x2f\x62\x18\x26\x2a\xfd\x71\xc5\x09\xd3\x6e\x05\xd4\xc2\x6b\xc6\x4e\x45\x11\xf4\xce\xf1\x35\x86\x25\xec\x47\xf2\x23\xb0\x8c\x53\x9b\xba\xcb\x07\x8e\x8b\x1c\x93\xec\x54\x7a\x03\xef\xef\xfe\xce\x73\x15\xa1\x41\x98\x75\xc2\x7a\xf7\x68\x2c\xd5\xbb\x5f\x7a\x3e\x23\xe7\x9b\xa7\x85\xd0\x07\x56\x07\xe3\xb6\x6d\x2c\xb2\xd7\x4b\xd4\x76\x96\x58\xb6\x33\xab\x43\xc0\xca\x6d\x2d\x6b\xf7\x3b\x8e\x3d\x45\xed\x86\x64\x07\xf7\xc0\xed\x2d\x69\x57\xb8\x42\x2d\x6e\x7c\x4d\xf7\x3d\xe5\x74\xad\x81\xf2\xf0\x25\x06\xba\xec\x4c\x66\x69\xa6\x4a\x1e\x68\x1a\xf1\x54\x2c\x59\xc6\x52\x33\xcc\x2e\x6d\x3c\x65\xbd\x2e\x7e\xd4\xaa\xb9\xd4\xef\x36\xb8\x3c\x77\x56\x8b\x27\xeb\x79\xf9\x38\x67\x6f\x15\x97\xdb\x5b\xa5\x65\xb1\x8a\xab\x61\x48\x4b\xbd\xf6\x5a\x2e\x96\xca\xee\x05\x8d\x06\xb3\xa0\xd8\x65\x85\x76\x1a\x9c\x18\x5a\x4f\xc0\xcd\x93\xe9\xb2\xc3\x32\x7a\xbc\x52\x3f\xf6\x27\xe8\xeb\x3a\xa8\x39\x03\x2c\x02\xd1\xcc\xe4\xa7\xf1\x51\x61\x71\x29\x5b\x26\xae\x60\xdd\xe4\xc4\x65\xd3\x66\xe1\x6f\x15\x77\xbd\x46\x93\xc5\x89\xb3\x22\x4d\x4e\x5f\xa3\x53\xc7\x27\xca\xa1\x78\xcd\x35\x43\xf5\x64\x46\x9d\x1a\x9a\x6a\x32\x8d\x79\x08\xca\x4c\x4a\x6a\x9c\xdd\x97\xb2\x2a\xdd\x85\x54\x03\x8e\x95\xbd\x83\x2e\x63\x02\x1c\x50\xa4\x37\x89\xa3\x19\x72\xde\x7d\x3a\xfc\xef\xdd\xdd\x04\xa3\x9b\x36\x10\xe5\x8b\xe8\xce\x8f\xf7\x61\xbb\xcf\x6f\x3e\xb8\x17\x43\xe3\x00\x0b\x5a\xf1\xe0\x0b\xb4\xd4\x83\x0f\x0a\x41\xea\xde\xba\xa4\x31\xab\x7b\x02\xc3\x93\x88\xee\xf0\xa0\xd1\x98\xd9\xbf\x57\xcd\xd6\x61\xeb\xc2\x6e\x42\xaf\x69\x55\x4d\x4c\x72\xd3\x4c\x95\x97\x7e\xc7\x3e\x21\x59\xed\x50\x44\x2b\xad\xd3\x5b\x6f\xba\xf0\xd9\x21\x58\x31\x5d\x5e\x72\x59\xdd\x3e\x98\x26\xbc\xa5\x98\xa8\xf0\xaf\x52\xe1\xfc\x03\x16\x58\x34\x07\x8a\x9f\x7b\xd7\x1e\x9f\x40\xd7\xbe\x9d\x54\x8f\x57\x84\x09\xcd\x52\x6f\x28\xa5\x8f\xad\xbf\xd0\x19\xdd\xf7\x8e\x3c\x00\x81\x25\xed\x0a\xe7\x3d\x0d\x01\x60\x35\x14\xda\x13\xaa\xa8\x1f\x37\x72\xa6\x6f\x09\x38\xe0\xdb\x62\x48\x90\xb3\x0a\xf4\x64\x44\x5f\xb0\x8d\x4f\xfc\xd8\x07\x29\xfd\xd8\x0b\xbd\x6b\x3f\x96\x19\xb0\x99\x47\x8b\x78\xe4\xcb\xef\xbb\x91\xfc\xa5\x05\xa1\x00\xca\xbf\x8a\xbc\x78\x9c\xa5\x9c\xcc\x11\xca\x44\x8b\x53\x61\xeb\x40\x84\xac\x60\x87\x9a\xfa\xb7\x40\x4b\x2e\xac\x85\xfa\x32\xa3\x4c\x65\xe9\x6f\xce\x29\xf4\xc5\x39\xfc\x0e\x42\x6f\x5a\xb7\x66\xe2\x68\x12\x4c\xfd\xa3\x71\xe1\x41\x7a\x30\xf3\xe2\xfb\x33\xe5\x04\x54\x45\xa9\x80\x6f\x51\xc6\x9b\x42\x87\xa1\x97\xfa\x95\xa5\x34\x3f\xa2\xc5\x86\x8d\x32\xc5\x40\x1a\xfd\x8e\x71\x7e\xaa\x85\x49\xc8\x07\xcd\x30\x42\x65\xe8\xbe\xda\x31\x32\xcc\x08\x90\xe4\x37\xf2\x02\x5c\xdd\xc0\x52\xae\x25\x6d\x0d\x2c\xe1\xb5\x50\x55\x57\x02\x6d\x89\xaf\xc4\x46\x01\x2e\xd8\xdd\xb2\x4a\x32\xba\x41\x47\x1c\xd2\x42\xee\xe2\x52\x0a\x44\xde\xd5\x19\x30\x0d\x33\x51\x88\xbb\xe7\x22\x4f\xf7\x2a\x75\x2b\x16\x2c\x08\x92\x39\x30\x64\x0e\xcb\x91\xb9\x4c\x33\xf6\x69\x21\xe3\xec\xc6\x9b\xfb\x32\x64\x2c\xd3\xb3\x9e\xd3\x7b\xf6\xe2\x64\xff\x27\xa3\x06\x39\x67\xb1\xb6\xf5\x0c\xfd\xec\xee\xdf\x8f\xa6\xd4\xe0\x05\xbd\xea\x84\x3f\x72\x6c\xb3\xfb\x93\x70\x3f\x2b\xf8\x5e\x43\xb8\x96\x5a\xc4\x7b\xce\x2f\x4c\xae\xeb\x89\xe9\x49\x27\x1f\x52\x85\x10\x73\x12\x9e\x2c\x52\xc1\xc1\x2d\x99\xc0\x57\x92\x34\x8e\x3e\xf8\xd6\x7c\xe2\x74\x7b\x31\xc8\x23\x58\x2e\x4f\x01\xc0\x6e\xbc\xf4\x18\x3d\x44\x9f\xfa\xf8\x0c\x3a\x79\xaf\xc7\x44\xb9\xf2\xa7\xd3\xbd\xc5\x38\x88\xea\x1f\x0b\x7b\x58\x4c\x3d\xd6\xcd\x2a\x9a\xfe\xfc\x20\xb9\xcb\x25\x8b\x05\x4d\x57\x53\x20\xc8\x4d\x23\x8f\x78\x25\x3e\xd3\x36\x5a\x7e\x19\xe1\x79\xc4\x88\x76\x2a\x74\x35\x96\x23\xc1\xe4\x03\xd0\x9e\x5e\xe6\x99\xcf\x57\x01\x6a\x5c\x89\x77\xeb\x8f\x05\xf3\x35\xc3\xb8\x64\x69\xcc\x3f\x44\xa1\xaa\xd0\x2f\xb7\xa9\x2a\xfd\xe6\xdc\x98\xf9\x0f\x82\xe1\xab\x7c\xb9\x03\x18\xa5\x82\x48\xe5\x2b\x12\x3e\x3a\x31\x8a\xf8\x14\x50\x87\xa6\xe9\x20\xf6\xae\x79\xb9\x18\x51\x6f\x60\xc9\x9f\x84\x2c\x51\x9a\x33\x4c\xde\xbf\x29\x56\xd8\xfe\x34\x18\x7d\x90\xee\x6e\xf2\x25\x28\xfd\xd9\x02\x16\x60\x68\xe6\x62\x97\xbc\xa8\xd9\x77\xba\x60\x35\x0a\x95\xb0\x5a\xbc\x58\xdd\x5c\xef\x4d\x60\x9e\x65\x91\xbe\x52\xcb\xc9\x29\x32\xf2\xeb\xf7\xad\x21\xa4\xae\xae\x67\x4a\xad\x3c\x27\x90\x2c\x43\xd3\x77\x41\x6b\xa6\xad\xa7\xc5\x8f\xbb\x05\x6f\x71\xab\xde\x99\xca\x53\x4d\x81\xc7\x53\xdf\x1b\xdf\xeb\xf2\x93\xce\x49\x95\x80\x61\x63\x1c\x38\xc1\xaa\x1a\x31\x11\x90\x4c\xe8\x5f\x37\x8b\x05\xf6\xec\x70\xef\x18\x93\xe1\x1f\x2d\xf5\xf5\xcb\x83\xc3\x53\x3a\xce\x76\x5c\xf5\xdb\x2d\xf6\x66\x9c\xd8\xea\x00\x5b\x6f\xe9\x4a\xaa\x02\x17\x96\x6e\x7d\x86\xf6\x12\x19\x1e\xeb\x3d\xe3\x19\x7b\x3d\x1a\x53\xcd\xa7\xde\xc8\x6f\xad\xbc\x5b\x59\xb9\x86\x85\x68\xf5\xbc\x6e\x79\x2c\x0c\x42\x4c\x92\xd9\xff\xf0\x27\xac\x4b\x0f\xba\xbe\x25\xa7\x1d\x1d\xa3\xa0\xa4\xfe\x82\xf0\xd3\xca\x01\x65\xd6\x43\x6f\x83\x27\x32\xec\x52\x8b\x49\xd6\xa4\x06\x17\xe4\x8f\xee\x75\xdc\x9d\xa1\xe1\xf3\x4e\xdd\x81\xb5\x5c\x16\xa8\x95\x90\x4c\xea\x87\xdd\x45\x42\x4a\x4a\xe8\xdd\xa2\xc3\xeb\x28\xee\x4d\xbd\xf0\x7a\x01\xb0\x99\x5a\x0f\x96\x26\x7f\xdf\x28\xbf\xc6\xb8\xd8\xba\xd0\x35\x7d\x71\xd0\x39\x56\x75\x60\x3a\xa2\x09\xb5\x9d\xaf\xbf\x23\xea\x77\x9c\x95\x5f\x5b\xf8\xeb\x37\x72\x35\x09\x8d\xfc\x86\x2f\x44\xe9\x87\x6a\xb7\xfd\xa7\x15\xd0\x59\x93\x14\x87\x81\x9e\x39\x48\x6f\x90\xee\xc6\x63\xc5\x75\x50\xfa\xfa\x21\x3e\x66\x7f\x46\x7e\xfe\x29\x20\xe1\x06\xb7\x26\x72\x59\xdb\x0d\x92\x2e\x2a\x67\x59\x4a\x3d\xbe\xcc\x9e\x9e\xc9\x8a\x47\xc9\x31\x34\xa4\x3e\x4b\xfb\x16\x3d\x2e\xdb\x0f\x37\x5f\xda\x6a\x02\xaa\x73\xd2\xbd\xbb\xf1\xd2\xfa\x86\x01\xd5\x7e\x32\x82\xc5\xfe\xdb\xb0\x7b\x15\xa4\xbf\x5d\xc1\x1e\x89\x3e\x8a\xa1\x3b\x2b\x8a\xb9\x70\x11\xc9\x67\xd8\xe7\x5b\xe8\xd2\x0e\x15\xec\x6e\xc0\xbc\xbb\xb4\xf7\x25\xe4\xfa\xa3\x16\xb2\xcc\x7d\x91\x88\xe0\xf9\x2b\xa8\x12\x5d\xa9\x64\xec\xb4\xde\x9d\x3d\x69\xaf\xd8\x22\xca\x03\x69\xc5\xe5\x7b\x6a\x12\xa3\x5a\x12\x28\x6d\x85\x9c\x69\x79\xa9\xf7\x3a\x9e\xb6\x52\x0a\x67\x0a\x84\x58\x5b\xdf\x2f\x3c\x2b\x75\xc5\x9e\xdb\x0d\xb5\x4d\x97\x06\xdc\x64\xd5\x01\x9f\xd0\x37\x6b\xbc\x2c\x8f\xab\x76\x71\xf7\x3a\xf6\xf0\x28\x95\x63\xe0\xea\x25\x7a\x73\x64\x7f\x09\x1e\x6d\xe1\x1c\x95\xb6\x01\x33\x2a\x0d\x86\xef\xbc\x38\x6c\x95\xc3\x8f\x81\x5b\x30\x22\x81\x03\x08\x70\xee\x7c\x67\x1c\xd1\xab\x8d\x1b\x90\x1f\x9c\xac\x33\x27\x8d\x64\x14\x09\x47\x6f\x22\xe9\xb9\x02\xa5\x95\x42\x49\x01\x9d\x99\xc4\xc8\xee\xfb\x9b\xac\x91\xa2\xf3\xc2\x96\x65\xa2\xb4\x96\x03\x74\x1a\xd8\xa8\x65\x5d\x53\x29\xb8\x2c\xac\xe9\x85\xf4\xa9\x2f\xe9\x84\xb4\xbc\xea\x3e\xa4\x6b\xa0\x2f\xea\x47\xba\x18\x2c\xeb\x8b\xd9\x24\xb3\x97\x87\x31\x49\x55\x8b\xf8\x45\x35\x83\xcc\x7c\x23\xcd\xbc\x39\xc5\xe0\x8e\x03\x74\xda\x5e\xdb\x5f\xb6\x81\xe1\x81\x9b\x13\x84\x49\x8a\x97\x8a\xb0\xed\x9c\x5c\xfd\x1b\x0a\x87\xb4\xba\x6e\xd3\xec\x22\xfb\xd8\x9b\x8b\xcb\x08\x3a\xd7\x2f\xe6\x41\xce\x89\x04\x00\x3a\x45\x82\x36\x57\x4f\xb6\x6f\x3b\x26\xdc\xb0\xef\x66\x6b\x41\xc6\x40\xf2\x42\x27\x22\x50\xd8\x1c\xdf\x82\x6e\x11\xaa\xe6\x8a\xc2\x9e\x34\x23\x7d\x4d\x03\x6b\x3d\x7a\x54\xd3\x66\x77\x84\x3a\x5e\x13\xd6\xa4\xa1\x0f\x34\x28\x58\xdd\x78\x6e\x19\x2e\x66\x57\x7e\x6c\xec\xe8\x17\xfd\xcb\xd2\xac\xc1\x25\x31\x65\x9b\x8a\xe9\x13\x6f\xb0\xe5\xd8\x5a\x82\x66\x2e\xd0\xfd\x19\x9a\x65\x67\xca\x69\xd9\x48\x97\x61\x1c\xfb\x99\x62\x6c\x23\x7f\x6a\xaa\x3b\xf7\xa6\x7e\x9a\xfa\xcb\x10\xa3\x0a\xd9\x5d\x45\x90\xf9\x64\x42\x74\x55\x08\xb7\x40\x1c\xca\xf1\x3f\xaf\x18\x2c\x72\xa2\x22\xd2\x93\x34\x1a\x7d\xd8\xd7\x32\xd1\xd9\x35\x50\x21\xd2\x37\xc0\xa9\x1e\xa1\xa5\x68\x1d\xef\xd7\x45\x89\x46\xed\x2c\x73\xbe\x56\x13\x4f\x2d\x48\x5e\x7a\x2f\x31\xe0\x1d\x8c\x2a\xa2\x9b\x5c\xfa\xf1\x9d\xb3\xba\xb1\xd1\x36\x5f\xa9\x08\xaf\x7c\x0e\x9f\xfc\x06\xe8\xe1\x8d\x80\xe5\x65\x41\xae\x3f\xc4\x0a\xc1\x0b\x6c\xed\xa1\x96\x7f\x11\x5d\x36\x09\x2a\x9d\xe8\x38\x09\xa3\x78\x46\x5a\xd8\xfe\xd9\x19\xb7\x50\x1d\x30\x58\x86\x42\xb7\xa0\x1a\x2a\x17\x2f\x81\x1f\x12\xcc\x97\xe9\xcf\x3c\xee\xa3\xcb\x98\xec\xd0\x52\x78\xa4\xd4\x7a\x67\x26\x95\x3f\x58\x6c\x50\xad\x2e\x46\x5c\x91\x97\xd9\xc9\x5e\xe3\x63\x1e\xf1\x04\x20\xa7\x3a\x86\x06\x0a\x71\x17\x24\x0a\xbe\xe0\x69\xb2\x28\x73\xfa\x3c\xb0\xb4\x42\xa3\x18\xd4\x4b\xa8\xc4\x5d\x3e\xbc\xeb\x52\xc8\x94\x06\xad\x97\xe9\xf4\x96\x5e\x48\xbd\xcf\x62\x2e\x74\x3d\x54\xf0\x1b\x77\x54\x71\x38\x60\xeb\x0b\x55\x9e\xf9\x74\x01\x8a\x41\x10\xc2\xdf\xbf\xfa\x71\xd4\x45\x77\x62\x4b\x6d\xb3\xd8\xca\x2b\x68\xe4\x18\xdb\xf8\x05\x9a\xf8\x25\x22\x7b\xe4\x92\xfe\x46\x8d\x07\x63\xf4\xb0\x2f\xc6\x51\xd2\xaa\x88\x11\xb0\x74\xb3\x99\x3b\xe2\x4e\x51\x58\xc9\x1c\x1b\xdb\xb8\xb4\x0f\x19\x5d\x2f\x09\x3c\x98\xa4\xd9\x55\x70\xbd\x88\x00\x85\x5e\xd2\x4d\xef\x22\xdc\x0c\x16\xb3\x06\xf2\x11\xc5\x15\x8f\xfd\x6b\x00\x67\xff\xdf\x3e\xec\xc9\x66\xac\xe3\xe4\x33\xa9\x2e\xa9\x4e\x5d\x8c\x26\x00\xb0\x36\x19\x2f\xc8\x17\x5c\x75\xf8\x2c\xa0\x6b\x57\xa8\x57\x42\x10\xa2\x8b\x2b\x8c\x75\xd2\x64\x27\xbb\x0f\x47\xcf\xa0\xec\x99\x37\xf1\xcf\x52\xab\x77\x50\xbd\x49\x44\xce\x15\x29\xd5\x4d\x1a\xaf\x66\x53\xdc\x2c\x76\xbe\x97\x3c\x8b\x85\x6f\x23\x18\x52\x13\x5e\x55\x56\xb7\x14\xf6\xc6\x72\x11\xe2\x03\x0b\xd7\x20\x63\x34\x0d\xe6\x44\x82\xdd\xbb\x38\x68\x46\xb4\x6a\x12\xf7\x65\xdd\xb7\x58\xb5\x1a\xf0\xb1\x3f\x1a\xac\x2e\xd5\xfa\x01\xd6\x28\x69\x54\x0b\x5b\xd6\x14\x19\x59\xbc\x05\x0b\x32\x54\xbc\xb3\x86\x42\x93\x74\xdc\x6a\x5b\x8b\xdc\x96\x8c\x15\xf1\x95\xc0\x93\xf7\x04\x4b\x89\x76\x39\x47\xfd\x36\x60\x6f\xa2\x99\x8f\x67\x1e\x49\x57\xdc\x5e\x2d\xc3\xb4\xb0\x32\xde\x2b\xa8\x23\xe9\xc2\x44\x61\x51\xd8\x65\x00\x0f\x49\x23\x81\x51\xd7\x98\x44\x3d\xde\x47\x84\xf8\xc6\x9e\x10\x0a\x72\x64\x1b\xf4\xf4\xfb\x12\xa9\xc8\xd6\xa4\x37\x1e\x3f\x13\xbf\xf3\x48\x61\xe1\x60\xe4\xb1\xb5\x69\xdd\x93\x63\x11\xf2\x95\x62\xe7\xa2\x18\xa7\x8d\x17\xe3\x40\x09\x69\xc2\x2a\x1b\x94\x07\x90\xad\x6d\xed\x50\x46\xe8\x65\xd1\xc3\x2d\x4e\xeb\xcc\xfb\xd8\xe5\xc3\x47\xd8\xbe\xff\x7d\x81\xd5\x1a\xae\x3d\xa8\xc9\x06\x1f\x67\xa2\x9e\x95\xff\xcf\xfc\x71\xe0\x31\xdd\x78\xb1\xdf\x9d\xe0\xaf\xa5\x48\x87\x1a\x40\xda\xd9\x8b\xfd\xe7\xf8\x6f\x49\x37\xa9\x27\xd4\x6e\x71\xfe\xb6\x5c\x1f\xa9\x47\xea\xf6\x21\xd5\xb5\xf7\x40\xf1\x51\xf9\x74\x75\x84\x77\x24\xcd\xf7\xee\x92\xcb\x95\xb2\x3e\x38\x6e\xd0\x15\xdd\xb2\x34\x65\x0a\xc7\xb9\xdb\x19\x0b\x6b\x98\x7b\xd7\x0f\x5f\xc0\x58\xb9\x7a\x01\xe3\x9d\x74\x82\xe7\xce\x5d\xa1\x94\x2e\x71\x08\x81\x4a\x04\xed\x0a\xe2\x7a\x39\x3b\x48\xc7\x60\x2a\x7b\xd7\x68\x86\x2f\x8e\x38\x8f\xbd\x91\x73\x72\xe6\xfc\xbc\xc2\xd6\x41\xf3\x68\xbe\x98\xbb\xe4\x93\xa2\xc7\x95\xcf\xd1\xea\x9b\x1d\x90\x24\xc9\xde\x34\x7d\x49\xd0\x94\x43\x4c\x82\xd8\xff\x44\x20\xa3\xf8\x56\x07\x33\x51\xfb\xc3\x61\xfe\xda\x20\xe3\x51\x7b\x23\x90\x6f\x97\xa4\x39\x6e\xfa\x8d\xb5\xd5\xd8\x1f\xf9\xc1\xad\xdf\x45\xd7\x38\xe3\x46\xdb\xe7\xca\xaf\xad\x45\x3a\xe9\x0e\x7f\x43\x1f\x8c\xb9\x93\x7b\x43\xbd\x7b\x6c\x2a\xdd\x78\x12\x60\xeb\xee\x31\xb1\x6a\x0e\x01\xe0\x52\xcb\x6e\xe1\x68\xec\x50\x14\xb7\x0e\x81\xd7\x21\xaa\x7c\x1f\xe4\xdd\x7c\xf3\xc3\xc9\xdc\x85\x7e\x55\xeb\x11\x99\x05\x2c\xd3\x74\x66\x48\x50\xd6\xee\x15\xe8\x7b\xe2\xc1\x5d\x43\x19\x23\x6f\x09\x66\x13\x32\x04\xcc\x14\x36\xb5\x3b\xf3\xee\x89\xa5\xc3\xc6\x81\x11\xfd\x9a\xee\x1c\x65\xf6\x0c\x7e\x4d\x6f\xa0\xba\x77\x67\xca\xd4\x6b\xa9\x41\x15\xad\xcb\xac\x83\xc3\xb1\xfc\x8d\xc8\x35\xdf\x57\x19\xad\x66\x6a\x65\x33\x8a\xbd\x09\x26\x69\x97\x4d\x55\x1f\xa0\xbb\x52\xf5\x23\xaa\x5d\xbe\x09\xa6\xe2\x72\x7a\x09\x54\xd1\x9a\x83\xd9\x90\xb0\xdb\xb0\x4f\x71\x45\x47\x49\xf2\x80\xe3\xff\x2c\x30\x5a\x55\xc3\x5d\x54\xd8\x1e\xde\x3a\xd9\x0d\x5b\x9a\xbf\x8b\x40\xe3\xa2\xb7\x33\x5d\x62\xce\x5d\xbc\xe9\x7d\x80\x2a\x8a\xed\x3c\xc3\x66\x8e\xb1\x95\x17\xec\x50\xd8\xae\x79\xda\x8b\xd6\x43\xf6\x50\x2d\xd9\xec\xef\x54\xfa\xea\x6f\x00\x9b\x2a\x5b\x0f\xdc\x2c\x18\x8f\x9b\xf1\xaa\x4a\xe8\x8e\xa9\x99\x86\xe0\x65\x85\xcb\x6c\xb4\x73\xb6\x0a\x50\x73\x7c\x06\x3b\x32\xde\x98\xd5\x98\xaf\x88\x1a\x74\x91\x78\xbf\x37\x9d\xb2\x7b\x5b\xb4\xa6\x68\x6e\xc9\x62\x3c\xbb\xc6\x33\xcd\xa4\x51\x4c\x23\xee\xba\xaa\x39\xbc\x33\x03\x76\xf2\xc1\x07\xdd\xa3\x49\x8c\x2d\x69\x50\xd5\xbb\xca\xd7\xf2\x6b\xba\xd1\x2e\x25\xea\xdd\xf0\xe8\x76\x7d\x7e\xd1\x72\xb0\x3c\x42\x5b\x79\x59\x98\x6f\x3f\x2e\x46\x72\xb3\xa3\xb8\x0c\xd8\x32\x44\xeb\x20\xd7\xe0\x41\x1d\x9f\x1e\xd2\x91\xc8\xb8\x06\x19\x65\xa6\x62\x75\xe8\x5e\x2e\xf2\x9d\xc5\xa6\xb1\xe4\xaa\xa1\x6d\x35\x17\xce\x9f\x81\x65\x76\x60\x49\xcb\x6a\x16\x59\x62\x4b\xd9\x2e\x31\x34\xfe\xda\xed\x97\x5e\x1b\x1b\xa7\x2a\xe5\xf4\xf1\x90\x48\x86\x79\x58\x6a\x26\x70\xb9\x90\x8c\x16\xb3\xd3\xff\x95\x26\xb0\xe4\x58\xac\x7c\x02\x1f\x12\x58\x33\x0f\x62\x55\x17\xf1\x22\xdc\x8f\x66\x33\x0f\xca\x4e\x41\x3b\xaa\x34\xd5\x33\xc2\x8a\x89\xdd\x02\x40\x6c\xb9\x7e\x78\x1b\xc4\x51\x88\x06\xb9\xba\xa9\x9e\x2b\xce\x88\xb4\xcb\x64\x72\x17\x43\xfa\x23\x7a\x63\x22\xc9\x33\x26\x9b\xd6\xb6\xdd\x51\x51\xce\xc2\x6f\xc4\xa0\x0a\xcb\x3d\xbf\x65\xee\x62\x5e\x7c\xcd\xe7\x47\x3b\x59\x8c\xdc\x20\xda\x91\xd6\xae\xbd\xf9\x22\xb9\xa1\xc3\xbc\x0c\xdc\x1d\x7c\x54\x0e\x2c\xee\x63\x90\x36\xb8\xa4\xc0\x36\xa2\x39\x85\x50\xeb\x2d\x42\x3a\x0a\x9c\x4e\x95\x6d\x2d\x25\xeb\x68\x19\x4d\xa3\xc4\x47\x75\xc9\x87\xe6\x5d\x52\x7c\x85\xa2\x4c\x39\xa5\x6f\xe0\xd4\x1e\x6e\xe9\x41\x47\x03\x4e\x5e\xab\x92\x80\x82\xe4\x95\xbe\x7a\x96\x89\x2f\xa8\xbc\x8a\x98\xeb\xaf\xb2\x37\x13\xdc\x6a\x07\x81\x4a\x84\xcf\x0f\xb2\xb0\x6c\xb4\xc8\x8b\xad\x36\x45\xea\xa9\x1c\x73\x61\x62\x1e\x08\x07\x52\x69\xbb\x4e\x56\x48\x92\x37\x5e\x5c\x58\x34\xfc\x4c\xd5\xed\x76\xc9\xfa\xbc\xeb\x16\x3a\xcd\xac\xd9\xe5\x2f\x19\x0d\x90\x85\x00\xb6\x9d\x9d\x83\x56\x73\xdf\xfa\xd7\x3f\x7d\x8a\x3f\xff\xe9\x93\xff\xf9\x5f\x3b\xba\xd7\x9d\x32\x66\x5c\x17\x58\xae\x4f\xd6\x6b\xd9\xd1\x4d\x61\x31\x67\xf7\x11\xed\x52\x26\xa6\x6e\x20\x32\x9b\x69\x46\x45\x8b\x2c\x88\xb0\x76\x97\x1c\xd8\xb8\x96\x16\x4c\x57\x16\x7a\x5c\x93\xaa\xe6\xd8\xe5\x45\x7d\x7b\xca\xc5\x12\x35\x58\xcb\x5d\x9b\x05\xc1\xb3\xd1\xa4\xc2\x42\x93\x4e\x96\x8b\xb6\x9d\xef\xc6\xb8\x9c\x29\x9b\x7c\xe3\x22\xa7\x9a\xe6\x2d\xf3\x29\xba\xb0\x53\x83\xb8\xed\xca\xa9\x16\x1a\xb5\xa8\x1b\xa7\xb6\xfe\xee\xd7\x76\x27\x5a\x01\xfd\x71\xf1\x3d\x40\x83\xb0\x49\x3a\x30\x96\xd3\xee\xb6\xe9\x2d\x50\xd9\x37\x3d\xca\xec\x9b\xca\x1e\x9f\x56\x9c\x66\xbe\x6b\xfd\x3c\x18\xec\xbe\x4b\x9e\x58\x2d\x62\x49\x69\x72\xf7\xe3\x93\x33\xee\x87\x4d\xb1\xca\xde\x3c\xac\xca\xe7\xc4\x96\xbc\x41\xc1\xf7\x5f\x49\x41\xbf\x0e\xb9\xc6\x5c\x2c\x8f\x59\xfd\x46\x3c\x87\x52\xb1\x9b\x93\x23\x7c\x53\xa7\x6b\x76\x71\xcd\xda\xd0\x6d\x14\x8c\xc5\xb3\xe0\xe6\xf7\xd6\x58\xb5\x2c\x14\x95\x4e\xe3\x65\xd1\xd5\xe2\xc6\x85\x5d\x1e\x79\xe7\x0f\x05\x6f\x00\x3d\xff\xdf\x17\xde\x34\x31\x22\xa7\x06\x45\x4b\x5f\x02\x57\x71\xaa\x04\xcd\x76\x49\xab\xda\x71\x88\xff\x00\x1d\x62\x0f\xce\x1d\x73\xaf\x71\x30\x99\x60\x29\x7c\xf8\xec\xb0\x58\xdc\x73\x1c\x5c\xa7\x0e\xaf\x45\x27\x48\x84\xbd\x56\x0d\xcb\x68\x26\x45\x1b\x28\x0d\x1a\x8a\xc6\x66\xa5\x5a\x02\x54\x06\x01\x35\xbc\x29\xe3\xfd\xe8\x37\xbe\x3b\x5e\xc4\x64\xc7\xe9\x76\xca\xa9\x92\x0c\x13\xe8\x31\x44\xbf\xb7\x95\xd0\xf3\x87\xbe\xdb\x8c\xdf\x9c\xe1\x7e\xdb\x18\x22\x66\x30\xc2\xd2\x91\xb6\x6a\x57\x3d\xc6\x12\x97\x72\xf4\x9a\x4d\x09\x4f\x90\x7c\x7c\xf2\xfa\xec\xf0\xfd\xe9\xe1\xab\x93\xd3\xf3\xf7\x07\x47\x67\x7b\xcf\x5e\x1c\x1e\x20\xac\x40\xae\x2d\x29\x22\x18\x0d\x23\x9e\xdb\x34\x8a\xd2\x22\xf3\x28\x40\xb7\xfb\xed\x9a\x51\x7a\xb7\x3e\x9f\x10\x2c\x15\xc2\x9e\xfb\x90\x86\xc9\x28\xac\x86\xf5\xbb\x9c\xf9\xe8\x77\xa9\xfe\x4c\xba\xaa\x91\x70\x2a\xfa\x29\x7f\xfc\x9d\xe6\x2b\xd5\x9d\xab\xfc\xa2\x07\x93\x5c\x6e\xcf\x36\xc5\x91\x2c\x2a\x65\xcd\xb8\xd8\x22\xee\x3c\x48\xab\x5e\xb4\xe7\x1e\xcb\xf6\x52\x51\xbc\x72\x38\xb1\x9f\x40\xf7\x36\x42\xb0\x3a\x26\xc4\x98\x80\xa3\xa9\x37\x9b\x73\xa0\xc4\x8e\x0a\xc2\x97\x3d\x85\xce\x82\xee\x0d\x04\x17\xd5\x2b\xb1\xd1\x97\xb5\x9e\x8c\x2a\x3f\xc8\x87\xc6\x52\xaf\xf4\xe5\x91\x96\x32\x8b\x47\x61\x1a\x3a\x51\xed\x92\x9f\x0e\x72\xea\x20\xbe\x9f\x3e\x65\xad\x32\x7b\xdc\xdf\x2e\xba\x11\xca\x91\xb4\x2c\x49\xaf\x05\x2b\x29\x9b\x2c\x40\x18\xa4\x93\xac\x52\xb5\x6c\x55\xd7\xe3\xa0\xd1\x09\xa4\x7c\x0e\x58\x7f\x02\xa9\xde\x22\xfb\x99\x3f\x22\xe4\x4d\xdc\xce\xfb\xda\xa5\x5b\xd6\x5f\xf5\x21\x22\xf7\x5a\x4b\xd5\xfc\x46\xb0\x64\x10\xf2\x24\xc0\x57\x9e\x2a\x29\x36\xab\x19\xfb\x90\x9c\x83\x8e\xfc\x60\xda\x6a\xa4\x4a\x7c\x93\xf9\xef\x34\x8a\xda\x43\x9f\xb6\x73\xa1\x0e\xf5\x27\xa3\x7e\x19\xe1\xb7\x73\xde\x6a\xcf\xd0\x33\x94\x31\xdf\x80\x74\xe5\x3e\xd3\x32\x24\x77\xd0\xef\xff\xd9\xad\xc1\x9d\x25\x2e\x62\x73\xe4\x99\x81\x0e\x6b\xd5\xa5\x6f\xf2\x21\xc5\x0d\x44\x58\x97\x71\xc7\xf1\x1f\x8c\x07\x05\x5d\x3d\x22\x74\x40\x2a\x4f\xa7\xfc\x9c\x5b\x31\x8d\x24\x54\x78\x23\x6e\x8b\x5d\xa8\xb2\xf6\x6a\xab\x24\xa0\xcb\xd5\x12\x3e\x55\xb3\xf8\x7c\x41\x64\x3c\xa0\x4d\x14\xcd\xb4\x1b\x8c\x47\x45\x71\xad\x70\x92\xe3\x63\x78\xa9\xbe\x88\xb5\x43\x27\x5e\x87\x6c\xa5\x05\x5b\x99\x3f\x9b\xa7\xf8\xfc\x4b\x34\xe7\x5c\x79\x20\x3c\x72\x74\x71\x36\xf8\xb2\xf9\x24\xee\x16\xfc\x45\x72\xac\xdf\xb6\xc5\xa5\x7a\x01\x89\x19\x7b\xd1\x99\x35\xf1\x60\x76\xce\xce\xf8\xfc\x8e\x21\xb6\xf8\x64\x90\xf8\xb4\xbc\xbd\x2e\xdb\x27\x52\x23\xba\x95\x71\xfe\xa8\xdc\x40\x08\x57\x7c\xe4\x19\x1e\x9d\xa9\x3e\x65\x37\x76\xc2\x41\x99\x2a\x76\x11\x5f\xa2\x1f\x3f\x68\x30\xee\x76\xdb\xf9\x36\xe8\x58\xaf\x62\x4f\xd2\x86\x69\x85\xb5\xc9\x94\x67\x71\x79\xbf\xe2\x9c\xcb\x48\xf3\xcb\x4c\xba\x74\x12\x6a\x1d\xaf\xbe\x02\x8c\xd8\x4c\x06\xf6\x33\xc9\xb2\x40\x3c\xf8\xfa\xc4\xea\x66\x3f\x15\x6e\x0b\x4b\xe2\x54\xe5\x3d\xe6\x96\x39\xfd\x37\x9e\xbd\x68\xa3\xd3\xfd\x85\x48\x9a\x40\xd9\x00\x85\x98\x76\x99\xaf\x48\x45\xee\xdc\x21\x5d\x99\x07\xed\xd2\xe2\x06\x26\xe7\xe4\x99\x8e\x0e\x65\xbb\xdd\x0a\x77\x66\xf2\x31\x8f\x49\x54\x68\xb8\x80\xb0\x16\x9e\xf6\x58\xc7\x83\x47\xd7\x18\x16\x40\x1e\xd1\xe4\xf2\x39\x40\x13\x0d\x55\x45\x9a\xf2\x3e\xb6\x38\x81\x42\x23\xf7\x0b\x61\x04\x78\xb2\xbe\x2b\x4e\x96\x98\xc5\xa7\x55\x98\x2d\x9b\xc0\xc4\x08\x74\xd5\xa9\x6c\xa2\x63\x35\x6f\x0d\x9d\x92\x8e\x93\xf9\x34\x18\xf9\xad\xaa\xe9\xee\x3a\x09\xc6\x9b\xaa\x99\xb7\x45\x98\x21\x3f\x24\x9e\xd5\x7d\x8a\x15\x19\x5b\x4f\xe0\x77\xc5\x64\x66\xdb\x02\x07\x69\xe0\xc8\xac\xa9\xcd\xe1\xae\x64\x76\x6f\xce\x59\xe8\x38\xf5\xaf\x51\xa0\x65\x87\x01\x74\xba\xac\x89\x6b\x4a\x40\x97\xf1\xcc\xca\xe4\x02\xf6\x5e\x1f\xcd\xfc\x25\x8e\xf8\x8c\xc8\x04\xad\x7e\x83\x0e\xd8\x0b\xfe\xf2\xed\x8b\x10\xeb\xc5\x35\xdc\xa0\xcf\x57\xde\xb5\xff\x7a\xde\xec\x6c\x2a\x77\xe2\x61\x38\x89\xdc\x6d\x8c\x89\x1c\x73\x34\xcf\x8f\x07\x0d\x41\x46\x6f\x77\x7f\x5b\xa0\x9f\x54\xe9\x63\xf5\x40\xbf\x08\xc2\xbf\x03\x9e\x9b\x42\xf6\xf7\x40\x67\x35\x6c\x77\xc1\xdc\x17\x6e\x1a\x93\x26\x8b\xa2\xb8\x0b\xe9\xaa\xb0\x82\x83\x9f\x0a\x62\x8c\x22\xdc\x42\x2e\xca\x5d\x90\xe5\xcf\xe1\x2e\xf1\x82\xf7\x10\x2a\xb6\x2a\x2e\x2c\x33\x4f\x0e\xd6\x0d\x5f\x86\xef\xe8\xeb\x7e\x61\xf9\x6c\x9c\x39\x5a\x5f\x8b\x35\x4d\x3a\x30\xb2\x1c\x7c\x85\x6d\xb7\x04\xd2\x4e\xfd\x0b\x0a\x80\x65\xec\x01\xdb\x17\xd6\x5e\x0b\x10\x8e\xaa\x71\x4e\xc0\xed\x4d\xa7\x36\x3f\x3e\x65\x2e\x7e\xb2\x01\x59\xb0\xde\xec\xc4\x54\x3d\x5c\xb7\x35\x98\x9f\xb1\xa6\xc6\x06\x66\xa3\x85\xe7\xe5\x85\xf3\x4f\xe3\xc1\xba\x9a\x84\xdb\x34\xdf\x50\x04\xbb\x1d\xa7\x54\xae\x41\x59\xac\x1a\xe1\x35\xae\xaa\x94\xe1\x95\xf6\xbc\x9c\x5e\x52\xe5\x42\xcf\x6b\xd9\xcd\x11\x9e\x7f\x90\xdb\x1c\xab\xd6\x9a\x4d\x36\x67\x35\x0b\xd2\xc8\xb8\xe6\xc0\x08\xd6\xe6\x9d\x17\x8f\x05\xcd\x95\xa2\x52\x29\x34\x7e\x5e\x32\xcc\x9f\xbe\x8a\xd3\x34\xcd\x19\xb9\x55\xff\x81\x2c\x8c\x08\xaa\x04\xda\x4c\xf1\x49\x2f\x61\xa1\x67\x97\x33\x74\xd3\x62\x0e\x8d\xd5\x9b\x62\xbd\x76\x99\xf4\x5f\x7d\xc6\xb5\x5b\x3c\x3f\x2f\x74\x63\x3d\x14\xec\x34\x3d\x42\xab\x3c\x4c\x45\xfe\xfb\xbb\x4d\x41\x85\xee\x49\xc1\x81\x70\x8a\x50\xbf\xb4\xcf\xc3\xb7\x5f\x3c\x0f\xd6\x0a\x83\x3a\xb3\x00\x1b\x2a\x7c\x2b\x2e\x96\x19\xe3\x27\xbb\xd7\x66\x63\xc4\xfa\xbd\xe0\x6e\x83\xf2\x76\x0c\x65\xc0\x48\x2d\x80\xfd\x2a\xf7\x0d\xaf\x40\x39\xd1\xbb\x50\xa9\xaf\x57\xa8\x3a\xef\x15\xe8\xda\x4b\x6b\x2e\x4f\xbe\x90\x78\x28\x38\xe8\xc9\xa4\xa5\xeb\xed\xdd\x81\x0a\xbe\x54\x32\xf2\x4e\x8d\xb8\x92\xdf\x10\x6b\xe2\x74\xe6\xa7\x39\x13\x56\x2c\x3e\x35\xeb\x4e\xae\x0f\x0a\x55\x9a\xd0\x1b\xb9\xe8\xe8\x77\x8c\xb3\x9d\xb7\x7c\xc4\x48\x07\x02\xf4\x7f\x20\x3f\xf8\x7f\x8b\x85\x5c\xe7\xcf\x50\xf8\x89\x13\xef\x42\x81\x6f\xed\x87\x7e\x98\xf5\x84\x22\xfe\xc9\x25\x23\xa0\x02\xfd\x3e\xb7\x9c\x6c\xce\x43\xab\xed\x9c\x60\xc3\x81\x7d\x38\xad\x39\x9d\x87\x8d\x58\x15\x6d\x35\x3b\x2c\xad\xea\x76\xec\x8f\xa2\xd8\xab\xb5\x7c\x16\xee\x56\x7d\x8b\xd8\x25\x5b\xd0\x81\xa9\xf4\xb1\x54\x90\x38\x0a\x7e\x9c\xda\xb5\x2d\xd1\xfd\x79\x55\x43\x95\xb6\x46\x16\x47\x49\x55\x6d\x29\x97\x4c\x65\xed\x69\x2f\x2f\x0a\xed\xa8\x07\x1d\x35\x95\x33\xef\xfa\xb6\xda\xfc\x6a\xa3\xad\x7b\xbf\x65\x7f\xda\x2a\xfc\x23\x7e\x14\xdd\xb5\xea\x86\x55\x8d\x8c\xb2\x72\x2f\xb7\xb3\x66\x0a\xef\xa2\x0a\xcd\x15\x5f\x5c\xb5\x0b\xf5\x2d\x4f\x90\x4a\xda\x29\x79\xef\x94\xa1\x40\x73\xf7\x5b\x6d\xdd\x97\xaf\x40\xd6\x7e\xbd\x28\x44\x37\x0f\x80\x55\x48\x5b\x94\xb2\x33\xed\x96\x4a\x73\x6d\xf6\xb9\xc4\x3c\x24\x0a\xe9\xfa\xdf\x74\x9b\x1b\x97\x00\xc8\x82\x25\x85\x9e\xd5\x78\x47\x8c\x6f\xb9\x29\xa2\x13\xfa\x82\xf5\x43\x5f\x1a\x08\x8c\x41\x85\x75\x59\x7f\x2a\x2d\xb3\x98\xd7\x95\xa0\xe0\x35\x9a\x16\x66\x7a\x5e\x36\x63\xad\xa5\xf6\x76\x88\xd2\x32\xa7\xce\xcf\xf1\x73\x9f\x62\xd4\x68\xe3\xee\xe0\x15\x68\x15\x20\x62\x38\xe5\x58\xd7\x3c\xd5\x37\xe8\xa9\x4c\x81\xb4\xf5\x7f\x35\x5d\xc4\x0d\xe0\x1f\xb4\xdb\xb5\x71\x4e\x73\x6e\x83\xd9\x78\xc3\x88\x77\x6a\x86\x63\x78\x2c\x36\xf2\x2e\x5a\xa1\x5c\x10\x26\x9f\xba\x13\x6f\x9a\xf8\xee\xa5\xf3\xc9\x71\xae\xa2\x8f\xb8\x20\xc9\xda\x98\xdf\x6c\x74\x21\x69\x17\x32\x72\x4e\xf4\x76\x9c\x34\xf6\xc2\x84\x63\x45\x3b\x8f\x82\x19\x1a\x88\x78\x61\x8a\x45\xb9\x9e\xb8\xb7\x59\x9d\x7f\xcc\xd2\x08\xbe\x1d\x27\x89\x40\x4d\xde\xfd\xdc\xbb\x1b\x11\x1c\xd8\xb1\x70\xf8\xb7\xe3\x04\x21\x28\x4f\x68\xfd\x12\x8d\x3e\x40\x45\x04\xbf\x0b\x5a\xf5\x75\xb8\xe3\x8c\x30\xe6\x0b\xec\x8e\xf2\x4a\x68\xe4\x4d\x47\x2d\xdd\x98\xc4\xb4\xd2\x6c\x3b\xdf\x38\xab\x6d\x28\x4e\x0d\xca\x2b\x05\x6b\x79\x71\x01\xba\xfb\x79\x27\x8e\xa2\x14\xe1\xb1\x37\xc9\x0f\x04\x9b\xdb\x7e\xee\x5a\x1a\xd2\xef\x36\x96\xb0\xfa\xd4\x9b\xe2\x29\x8c\x26\x13\xa0\x51\x9c\x8e\x1d\x14\x5e\x4b\xb2\xe3\xe8\xce\xcc\x66\xa7\x6d\x86\x15\x12\x14\xe8\x6d\x25\x5a\x99\x82\xe1\xce\x0e\x4d\x44\x59\x09\x61\xb7\xb3\xe3\x08\x99\xa3\xac\x9c\x98\xfe\x6a\x13\xa1\xdd\xcf\xff\xf4\xc1\xbf\x9f\xc4\xde\xcc\x4f\x1c\x02\x16\xe7\x83\x6c\xc6\x3e\x39\xd1\xdc\x1b\x05\x29\x90\xc9\xa0\x07\x63\xfa\xec\xe0\xc5\x91\x96\xda\xa7\x54\x58\x82\x6a\x8c\x58\xd7\x0b\x61\x57\x27\x7f\x4b\x21\x34\xba\xc3\x8d\xee\xea\xe9\x19\x22\x74\xd8\x2c\x98\x6a\x1b\xd5\x82\xff\x9f\xba\x37\xed\x95\x1c\xcb\x12\xc3\x3e\x19\x02\xf4\xc1\x86\xe1\x2f\x82\xfb\xcb\xcb\xe8\x1e\xd4\x8b\x8e\x97\x19\x64\xec\x91\x6f\xaa\x72\xc8\xd8\xf7\x7d\xcd\xcc\xce\x66\x30\x18\x0c\x46\x30\x48\x06\xc9\x58\x6b\xaa\x65\x18\x92\xe0\xb1\x2d\x79\x6c\x08\xa3\x81\x3d\xb2\x64\x7b\xe4\x99\x31\x04\x43\x12\x06\xde\x34\xb6\x81\x2e\x7f\xf7\x7f\xf0\x2f\xf1\xbd\x97\x4b\x70\x0f\xbe\x57\xd5\xad\x51\xce\xbc\xae\xf7\x2e\xef\x7a\xee\xb9\xe7\x9e\x7b\x56\x50\x4d\xfb\x8d\x86\xf2\x60\x88\xbc\x2b\x4e\x00\xa5\xb6\x5a\x2a\xb7\x83\x61\x23\x0c\x4a\xf3\xfe\x81\xa1\xc0\x92\x39\xe4\x6f\x6c\x9f\x05\x27\x33\x7a\x15\xf3\xf5\xff\xfc\x5d\xc4\x75\x7d\xac\x19\x6a\x69\xcb\xa6\xcc\x45\x3d\xc3\xbb\x07\xd3\x0a\x70\x85\x47\x3c\xda\xd9\x22\x8c\x9b\x3e\xa6\x5a\x85\x88\x47\x75\x1a\x3a\x58\xb4\x29\x24\xb9\x8f\x58\x88\x4b\xc4\xd7\x17\x8c\xd6\x78\x0d\x58\xdf\x60\x6c\xc0\x82\x17\x80\x28\x1c\xc0\x7a\xa1\xdb\x66\xa8\xa3\x6d\xfb\xea\x3c\x11\x80\x74\x02\xa6\x37\xa8\x1f\x23\x77\x6c\x50\x47\x50\xc7\x16\x7d\x36\x49\x93\x46\x93\x74\x42\x10\x30\xb9\xe7\x50\xb4\xe6\xed\x89\x59\x6c\x39\xf5\x2d\xa2\xa3\x3a\x14\x74\x44\x7e\x72\x91\x5b\x18\x9c\x70\xa7\x20\x4a\x46\xc9\xcf\xe0\xd4\x5c\x5f\xd3\x2e\xf2\xe4\x7e\x69\x8b\x1e\x0c\x90\x2d\xe8\x61\xf4\x87\x4a\xee\x9c\x76\x3b\xde\xec\x90\x2d\x3b\xb8\x03\x71\xec\xcc\x02\x09\xb7\x81\x79\x05\x96\xbb\x9b\xdb\x90\x5d\x23\x45\x4b\x99\x62\xf5\xc8\x1f\xda\x25\xc4\xc8\x11\xff\xf6\x61\x30\x1a\x21\xe2\xdb\x7c\x1e\xd0\x70\x6f\x54\xc2\x31\xf0\xc9\xc0\x19\xf4\x47\x24\x24\x8c\xdc\x33\x0a\xc9\x57\x7d\xb4\x31\x41\x16\xe6\xcd\xc6\xa5\x45\x50\x00\x17\xf8\xcb\x72\xc1\x6b\xbf\x87\x12\xd3\xfb\x41\xcb\xc5\x0a\x31\x56\x79\xbc\x85\x50\x04\x56\xbc\x01\xc5\xbb\x5a\xa0\x2c\x3f\x70\x94\x57\x72\x86\xce\xa7\xcf\x1d\x6d\x02\xa3\xf6\x19\x18\x64\x9d\x19\x73\x4b\x46\x7c\x7c\x83\x7b\xed\x99\x9e\xab\xc8\xeb\xc5\xe6\x48\x26\x7e\xc7\x0c\xce\x78\x86\x84\xb2\x81\x33\x41\x1b\x2c\x0b\xd6\x9e\x7d\x21\xf5\xa8\xd6\xac\x4b\xfe\xd3\xd4\xb3\xa9\xdd\x35\x23\x09\x56\xdf\xbb\xad\x57\xcd\x3a\x1f\x99\xcf\xc1\x76\x24\x1e\x1d\x3e\x07\x1a\xf8\xc2\xf8\xe6\x28\xbc\xea\x47\xf5\xf3\xfd\xd5\x19\x14\xc2\xdf\xd4\xcb\x66\x0d\x04\x13\x70\x70\xc8\x54\x45\x13\x06\x81\xff\x40\x41\xf8\xb7\xfe\x36\x18\x37\x20\xda\x64\x41\xba\xaf\x3e\x74\x12\x14\xad\x8f\x01\xe8\xd7\x87\xd2\xfa\x41\x11\x14\xb4\x2c\x12\x61\x0f\x96\xf0\xe4\x88\x6b\x36\xa4\xd3\x9a\x9f\x9f\xde\x4f\xe4\x93\x10\x09\xc8\xc5\x2b\xbf\xdb\x00\x7e\xf0\x31\x12\x09\xb1\xe7\x6e\xa0\xf8\xa1\xa6\x65\x79\x4c\xd4\xba\x90\xfb\x83\x68\x69\xce\xc3\x5b\x3c\xbb\x54\x9d\x31\x9f\x8d\xd7\xbf\x07\xcd\xc0\x62\x4f\xf1\x12\x39\xb5\xdf\x50\xae\xd7\xbc\xd7\x5c\x35\xcc\xc1\x34\xcc\x61\xee\x63\x8e\xef\xe5\x79\x86\x1c\x50\xc4\x81\x4c\xa2\xfb\x1a\x72\x76\x00\x77\x15\xed\x54\x04\x71\x01\xe2\x2d\x6f\xe0\xd7\x48\xc2\xc9\x39\x54\x22\x10\xab\xa0\xd1\x91\xe8\x85\x55\x9e\xa9\x5f\x9d\x70\xf1\x35\x79\xb0\x9b\x71\x29\x5e\x96\x41\xb6\xec\xaf\x6e\x4b\x26\xa7\xe5\x8d\x36\xec\x6d\x87\xef\x1a\x31\x09\x9e\xba\x62\x3d\x44\x18\xb3\x84\x46\x29\xa6\x11\xa1\x21\x45\x45\xdf\x50\xc6\x41\xcf\xac\xd7\x48\x61\x11\x0c\x10\xa4\x01\x0f\x01\x36\xdc\x72\x2f\x11\x3a\xcb\xe2\x36\x56\x0f\xd6\x0e\x42\x76\xc1\x03\xc5\x35\x9f\x51\xa7\x6a\x81\x73\x4d\x8a\x31\xda\x6b\x24\xd1\xc7\x60\x51\x8b\xa6\x83\xaa\xc1\x94\xd9\x0e\xd9\x88\xf8\xf4\x60\x71\xab\x86\x17\x05\xcc\x7b\xf9\x28\x6a\x32\x63\x45\x47\x3c\x15\x9a\xed\xa2\x42\x59\x2b\x44\x66\x27\x37\x46\xd5\x6a\x2b\x00\x7b\x0c\xba\x7f\x6b\xa1\xb5\xfd\x96\x5e\xef\x01\xc8\xa4\x04\xdc\x83\xee\x49\x6e\x85\xc1\x93\xcd\xe2\xce\xe3\xf4\xeb\x8b\x7a\x46\x79\x8f\xc1\x9d\xa1\x20\xe5\xa9\xe5\xa6\x52\x3e\x5b\xcf\xa2\x08\x56\x1f\xe8\x99\x22\xc9\x9c\xa0\x86\xa4\x5b\x98\xe1\x33\x71\xa2\x01\x5b\xac\x59\xa2\x43\x25\x10\x52\xee\xca\xcf\xde\xb9\x85\x0c\xb5\xfa\x49\xa6\x24\x0a\xbd\x5b\x2c\x67\x21\x58\x5f\xea\xf6\x86\x10\x77\x3b\x4e\x85\xd6\x36\x86\x4f\x83\xc9\x3f\x09\xcc\x09\x96\x3f\x46\x1d\x67\x19\xd1\x96\x27\x74\xfa\x65\xcd\xa2\x90\x42\x0a\x21\x6f\xdb\x45\x7f\x45\x18\x80\xa3\xe0\x38\x92\x76\x43\xed\x47\x4a\x4f\xe1\xe4\x20\x34\x76\xbb\xe0\xbb\xe3\x44\xe1\x04\xe1\x65\xed\x0b\xbd\x0f\x5a\x46\x6a\x6d\x17\x0e\x0b\xb0\x11\x3a\x9a\x09\x9a\x8a\x29\xe6\xfc\x24\xeb\x34\x00\xc1\x00\x1e\x0f\x9f\xe6\x0e\xc8\x99\x5b\xcf\xeb\xd9\xe2\xec\xf1\x25\x24\x9e\x83\x56\xd8\x0c\x7c\xf5\x6a\xd1\x0b\x1e\xe1\x61\x5a\x6b\xf7\xd2\x1a\x60\x04\x6f\x6a\xf8\xd7\x10\x49\x03\x1c\x9b\xc0\x5c\x74\xd6\x90\xff\xb8\xfe\xac\xff\xf5\x14\xd8\x82\x52\x68\x8e\xb3\x36\x32\x0b\x9c\xf6\x1c\x1a\x55\x41\x69\x7d\x3e\x78\xd1\x1b\x7d\xf2\xa8\x13\x00\x0d\xd3\xec\xdf\xa8\x05\xd1\x11\xc5\xf9\x75\x56\x7c\x0a\xb7\xa4\x5b\x12\xb5\xfb\x2b\x79\x83\x05\xe0\xe5\x8e\xba\x2c\x50\xfc\xe0\x82\xe6\x12\x62\x18\xf1\x42\x75\xa1\xe0\x6b\xc7\x19\xa4\xb5\xf3\x49\x9a\x67\x39\x9f\x3f\xa2\xad\xa4\xd3\x66\x25\x90\x53\xc6\xcc\x38\x8d\x37\x9f\x44\x4f\xbb\x41\xd3\x87\xe4\xa5\xe9\x12\xd1\x3d\xe1\xd5\x92\xf1\x6d\xa6\xde\x63\x77\x8d\x05\x0e\x03\xac\x37\x74\x66\xd4\x70\x82\x71\xcf\xe0\x83\x47\xd9\xfb\x07\x2c\xe4\xd0\xda\x6c\x5f\x35\xba\xb1\xd0\x0f\xde\xc5\xef\x03\x76\x21\x68\x72\x3a\x65\xfe\x41\xc6\x08\xc8\x3e\x3c\x04\xa7\xf3\xec\x72\x32\x0a\x5a\x61\x88\xc1\xdc\x2d\xed\x38\x33\x92\x1e\xc3\xb0\x57\xc1\xa3\x41\xe6\x0b\xa2\xe3\x8d\x40\xfd\x90\x9e\xcc\x8d\x7a\x6d\x27\xba\x89\x8a\x6d\x46\x56\xa3\x71\xfc\xc7\x1b\x22\x08\x6f\xe0\xeb\xb4\xcc\x30\xcb\x1f\xc1\x8a\xc5\xc1\x6f\x9a\xbc\xc2\x93\xb7\x59\x12\x73\xcf\x50\x6e\x17\x38\x2f\xfb\xf5\x03\x93\x71\x16\x28\x59\xe6\x28\x96\xe9\x6b\xa7\xef\x83\x63\x1a\x06\x38\x8d\x15\xdf\xe3\x36\x91\x68\xa9\xf9\x22\xf0\xd8\x89\x93\x76\x6d\xdc\x3d\x72\x16\xb0\x19\x39\xfe\x8c\xf0\x3e\xe0\xde\x84\x13\x50\x82\x90\x01\xde\x4f\xf7\x6d\xbb\x19\x99\x52\x98\xa1\xa8\xc7\x56\x0c\xb3\xd3\x9e\x2e\x3c\x9e\x1b\x89\x39\xef\x68\xe7\x5d\x0e\xdd\x7f\xa1\x37\xd9\x1a\x14\xa1\xe4\x52\x37\x77\x5d\x68\x5d\xed\xed\xe8\xc0\x84\x59\x4f\x3f\xd8\x07\x12\x3e\x55\xdf\x84\x61\x82\xfd\xec\xa3\x5f\xcf\x61\x6a\x4c\x3c\xdc\x4c\xd3\xe1\x45\xbb\x7d\xc1\x3e\xfa\x58\xba\xf9\x70\x2e\x37\x55\x04\x8a\xcd\x13\x54\xb5\x58\x2a\x13\xa3\xe6\xf0\x4b\xa1\xd3\xec\xf4\x83\x9c\x70\xfc\xe4\x6f\xc1\x98\xfa\xd9\xdb\x43\xca\xc5\xb5\x42\xb5\x95\xf6\x6c\x51\xa2\x56\x4f\xa1\x40\xbe\x5f\x8e\x7a\x0a\x07\x97\x0c\xcf\xa8\x28\xdc\x06\x0c\xa2\xf1\x64\xb1\xfa\xf3\xf0\xbd\xf6\x8d\x99\xe5\x16\x4f\x78\xa2\xb7\x8b\x19\xbc\x8f\xc9\xb2\x0f\xfa\x0a\x36\xe3\x6f\xdf\x69\x7e\x77\x17\xc9\x5f\x70\xab\x07\x1c\x59\x03\xf8\xb7\xc9\xf4\x2d\xcf\x3a\xe7\xc9\xbb\x3b\xf5\xa0\x09\x13\x0b\x00\xb5\x1f\x3c\x63\x0b\xc1\xb2\x7e\xb3\xbf\x94\xb5\x37\x31\x0a\x44\xa0\x1b\x38\x87\x91\xc0\x3c\x05\xc3\xc4\x31\x91\x1f\x15\x36\x24\x13\x14\x21\xe0\x85\xb0\xe9\x3b\xdd\x36\xfc\xc4\x9f\x0e\x36\x52\x27\x4d\x06\x87\x00\xdd\x62\xe1\x21\x05\xff\x0d\x01\x41\xf9\xdf\x1c\x04\x57\x1c\x0a\x19\x7a\x27\x40\x45\x88\xdb\xcb\x67\x69\xd0\x00\xd8\x0b\x9a\xb2\x86\x6a\xb2\x87\x2d\xa9\x2e\x1b\xd5\x20\x67\x93\x39\x61\xc1\xc6\xa7\xe1\x20\xcd\xdd\xbb\x59\x99\x60\x58\xab\xf7\x4d\x81\x5d\xbe\x82\x9e\x40\x65\xdc\x12\x34\x24\x3f\xf7\x76\x1f\x82\x97\x02\x86\x42\x38\x7a\x7a\xfa\x3a\x40\x24\x1b\xe0\xf0\x08\xef\xc1\xe9\xd8\x16\x80\x62\x68\xe9\x1e\x4d\x31\xbb\x57\xeb\x5d\x40\xfc\x00\x20\x78\x47\xc4\x79\xf6\xf0\x44\xd2\x9c\x80\xd0\x8a\xdc\x33\x96\xb5\xb7\x85\x6c\xc8\xa8\xee\x04\x53\x34\x98\xc3\xb0\x47\x22\xe0\x39\x23\x7b\x30\xb0\xba\xfc\x21\xfa\xa4\x8b\x50\xdf\x22\x15\x17\x0a\x0a\x68\xe5\x68\xd0\x87\x68\x14\x11\x12\x4b\x84\x2b\x43\xb8\x61\x4a\xb4\x21\xe9\xe5\x90\x18\xc5\xe6\x88\x6e\x22\x84\xa8\x6b\x11\x01\x39\x12\x75\x2f\x02\xd1\xf4\xc4\x0f\x20\xe8\xa0\x3f\x31\x04\x49\x0a\xb6\x9c\x86\x2c\xc6\x8b\x60\x69\x21\x2f\x06\x12\x20\x40\x72\x81\x80\x84\x6b\x84\x3e\xe9\x32\x7a\x98\xd9\x55\xe1\xfa\x27\x17\x7c\x45\x27\x6c\x51\x20\x0b\xd1\x03\xc0\x80\x5e\x30\x76\xff\x66\x13\xb8\x82\x76\xda\x04\x4d\x8d\x25\x84\x20\x3e\x0a\x94\xc4\xfe\x50\x52\xaf\xbe\x9a\xd4\xeb\x32\x43\x3d\x11\xed\xeb\xb6\xc4\xe3\xd5\x01\x0f\x30\x1e\xac\x17\xd1\x09\xab\x4b\xee\xe8\x27\x16\xfc\x31\x57\x6d\xe1\x75\x5f\x78\xa8\xad\x5c\x32\xe3\x0a\xb9\x60\x0a\xba\x7d\x9e\x0d\x9c\xa2\x7b\x66\xc0\x70\x2c\xee\x87\x90\x1c\x96\x69\x0e\xe9\xc9\xa5\x3a\x61\x6b\x83\xbc\x7b\xdb\xd4\x68\xa0\x2b\xbc\x87\x38\xf6\xc5\x90\xbf\x09\x9a\x7e\x03\x0c\x06\x7a\xa1\x7b\x89\x07\x4c\x43\x9c\x1b\x01\xba\xb1\x47\x2f\xb9\xc9\x8d\xe9\xbb\xbc\xa3\x7f\x64\x0e\xc9\x47\xc8\x81\x59\x22\x12\x9b\x22\x8b\x57\x2d\xc4\x75\x29\x06\x8a\xaf\xed\x22\x20\x51\xe6\x58\x4e\xb0\x69\x20\xa0\x85\x10\x4f\xa9\xdc\xd1\xb9\x10\xf3\x29\x1e\xbc\x5e\x26\x44\x4c\x5d\xef\x11\xc2\x47\xca\x76\x82\xd3\x4a\xe9\x6d\x91\xd3\xe0\x1b\xf9\x09\xfe\xbf\xdf\x05\xa3\x8b\x9b\x6e\x4d\xd4\x3b\x51\xd6\x9e\xee\x45\x57\x0b\xb3\x7e\x6f\xd0\xdd\x89\xc5\x64\x5b\xd8\xfd\x18\x72\xff\x06\x96\x65\xa5\x64\xf7\xc2\x08\xbe\x5e\x8e\xcc\x44\xc3\x66\x70\x70\x4e\xe3\xa5\x51\x1a\x51\x0f\x2f\xda\xca\xbe\xe3\xa5\xfa\x92\x85\x33\x4f\x61\x94\xb0\xa1\xd6\xde\x0f\x78\x30\x87\x58\x38\x00\x73\x70\x64\x09\xab\x11\xe0\x17\xdf\x91\x6e\x92\xc4\x9b\x8f\xba\x8c\xda\x78\xdd\x98\x8c\x2b\x3e\xfd\xcd\xa3\xd2\xd1\x1c\xe6\xac\xbe\x99\x41\xfa\xdb\x4a\xc2\x7f\xda\x3d\x01\x5e\xee\xce\x4e\x00\x22\x39\x82\x47\xa0\xd5\xb8\x93\xf2\x85\x7d\x11\x79\xd8\xcf\xbc\x08\x34\x8a\xd9\xee\xd5\xe0\x51\xac\x37\xda\x0f\x01\xd1\xad\x23\x27\x98\xdc\xda\x56\xc6\x4b\xd5\x6a\x81\x1f\x1e\xfa\x49\x89\xb0\x30\x88\x9d\x70\x87\x4c\x84\x53\x7c\x7c\x6b\x70\xa9\xd1\xfb\xdd\x07\x5f\xf7\x8c\xf1\x64\xc5\xfd\x9c\xdc\xfc\x6f\x4e\xe7\xa5\x04\x55\xa3\xf6\x07\x8b\xfc\xb2\x5e\x8c\x3b\x2a\x50\xbf\x69\x1f\x41\xb4\x13\xfd\xbb\xaa\x2e\xc3\x0a\x28\x0c\x3b\x26\x86\x80\xae\x4b\x57\xe2\x56\x2d\x3c\x3e\xde\x80\x1c\x05\x8f\x2b\x3c\x1a\x42\x91\x70\x57\x7b\x66\x53\x0a\x98\xe0\xd5\x15\x52\x13\xd3\x44\xc5\x2f\x53\x6d\x68\xa3\x1f\xe6\xed\xdb\xa0\x97\xca\xd3\xc3\x1b\x26\xea\xed\x9c\x1f\x36\xe4\x83\x4d\xbb\x65\xfc\x7b\xe4\x74\x8f\x6d\x06\x82\x4c\x0b\x31\x83\xd2\x7c\x40\x51\x02\x7a\xfa\x82\xf1\xa1\xa0\xf0\x21\xee\x7d\xc9\x47\x35\x0b\x1c\xa3\xad\xec\x2f\xec\x04\x1c\xd3\xc3\xef\xf8\x47\xe9\xe4\x7c\x35\x3c\x31\xf0\xed\x77\x7c\x46\x77\xaa\x33\xcd\x4b\xef\x16\x58\x4d\x63\x89\x39\x07\xf9\xd0\x02\xab\xf9\x87\xb6\x33\xdb\x23\xe0\xd8\x85\x91\x81\x0f\x2d\xce\x37\x55\xc8\x7d\x2c\x0f\xa3\x41\x0b\x8f\xe6\xe1\xce\xab\xa9\xf3\x61\x42\x87\x01\xf6\x7f\x64\x86\xbd\xd1\xec\xde\x02\x61\xf3\x83\xc9\xf6\x36\xcc\x93\x25\x9e\xea\x9d\xe4\x3d\x41\x8e\xe8\x4e\x8f\xa0\xe0\x3c\x4e\x1e\xfe\xde\x8e\xac\xdb\xd1\xe8\xcd\xd0\xe6\xa5\xd3\xf2\xe8\xec\x95\xd3\xf2\x58\x60\xb0\x8e\x1d\xbc\xf7\x49\xc6\x21\xd4\xf7\x89\x62\x1c\x9c\x30\xcd\x3b\xce\xbd\x7d\xe1\x0e\x43\x57\x37\x13\x82\x0c\x9b\xc3\x30\x1a\x4c\xb8\x24\x6e\x01\xae\x60\x7f\xd3\xc2\x53\x24\x30\x48\x2c\x34\x0e\x6a\x01\xa0\x31\xd8\x1f\x18\x9e\x5e\xeb\xd3\xf8\x72\xb3\xcf\x84\x1f\x89\xc3\x92\x13\xbf\x38\x3c\x0c\x14\x99\x46\xf1\xed\x5d\xd5\xa0\x8f\x94\x29\xa5\x42\x7d\x33\x8e\xce\x43\xb2\x56\x3a\x5b\xe5\x37\x47\x4f\x3f\x9d\xa7\x87\x34\xa6\x59\xdc\xdc\x69\x6d\x4a\x41\x94\xad\x2a\x4a\x6d\x98\x60\x90\xa3\x91\x27\x27\x44\x8e\x2f\x37\xf1\x95\xc5\x73\x93\x52\xca\x9a\x3b\x8c\x2f\x29\x12\xf5\x54\x85\x9e\xa2\x2a\x38\x0b\xeb\x40\xd0\x73\x49\x33\x9f\xd7\xa5\xf3\xa2\x80\xdc\xb4\x02\xc3\x09\xe8\xa8\x00\x93\x35\x91\x8e\xfe\x94\x2f\x8f\xaf\xa2\xc9\x60\x33\x3a\x37\x36\x2a\x24\x8d\x12\xad\x2d\x18\xdf\xeb\x09\xbb\x67\x0a\x04\x9a\xd4\x6e\x56\x9c\x21\x07\xe7\xac\x2d\xee\x65\xf3\x23\xdc\x56\x3a\x21\x87\xa1\xbc\x5a\xde\x1b\xee\xc6\x2b\x85\x1d\xe6\x64\x6d\xc1\x84\xbb\x4f\x5e\x3e\x8a\xec\xd1\xf0\x2e\xe8\x0c\x47\xe6\xe0\xcd\x09\x21\xe3\x73\xf2\x88\x0f\x16\x9b\x23\x67\xb0\x34\x83\x5d\xf7\x8c\x3c\x18\xe4\x32\xe1\x63\x60\x82\x59\xcc\xe6\xdf\xdc\x09\x21\xeb\x0e\xea\xec\x67\xab\xcf\xf9\x5a\xb4\x68\x3a\x17\xa8\x4b\x02\x6c\x0b\x67\x5a\x4a\x8b\xc8\x9c\xff\xa3\xf8\xd9\xe9\x50\x23\xba\xf4\x19\xd6\x90\xe0\x81\xfc\xa0\x33\x0e\xf8\x9d\xd0\xf0\x96\x9b\xf5\x22\xd0\xda\x23\xc9\xd0\xa4\xde\x09\x89\x18\xac\x75\x70\x04\x91\xbc\x9b\x82\xca\xee\x8d\x1c\x16\x85\xed\xe1\xfb\x18\xf8\x56\xb8\x05\xa5\xf2\x8a\xe2\x87\xae\x31\x2d\x7c\x96\xd5\xe5\xd3\xb3\x2e\x58\x8a\xf5\xca\xf0\xab\xe3\x35\x23\x3d\xa6\x8d\x35\x9a\xf8\x8b\xe2\xf3\x39\xeb\x07\x73\xc6\x41\x33\xd0\xa1\x62\xb7\x6a\xfa\x6d\x81\xc6\x92\x2f\xc9\xcd\xaa\xe8\x4e\xee\xd0\xcb\x1a\x8b\x78\x12\x86\x00\x2b\xf6\xc0\xee\xf0\x48\x40\x98\x47\xff\x88\x70\x9e\x8b\xf0\x7c\x78\xea\xa1\x5e\x2c\x08\x6b\xf3\xeb\xb2\x64\xc1\xfa\xed\x40\xfa\x5e\x12\x21\x07\x14\x7f\x78\xe4\xda\x27\xef\x14\x56\x30\x97\x2b\x92\xb0\x98\x35\x99\xa8\x8f\xbc\x26\x9c\x6b\x66\xd0\x43\x1e\x6e\x9c\xfc\xf0\x0d\x94\xe9\xbb\xf2\xf1\x39\xc3\x3e\x40\x9f\xf7\xb7\x78\xc4\x08\xd4\x1f\xe4\xba\xe4\x3a\xc0\x11\x41\x14\x98\x88\x69\x24\xee\xc6\x3c\x3d\x0a\xc0\x8d\x43\xf6\xaf\x02\x90\xd3\x33\x7f\xa0\xc7\x7c\x61\x46\x45\xf8\x00\xff\x0e\xc0\xe4\x97\xf0\xe7\x67\xdf\xba\xe0\xad\xc7\xc5\x42\xa2\x43\xa9\x45\xc9\x80\xff\xfa\x4e\x3a\xff\xf2\xee\x08\x80\xfd\x8f\x3c\x85\xf3\x4f\x72\x2f\xc8\xc6\xf3\xa3\xdc\x3e\x10\xba\x8f\x91\x3b\x7b\x67\xbe\xb6\x61\xed\xfb\xfb\x0c\x2b\x46\x23\x1e\xfe\x5d\x1e\x51\x93\x58\x4b\x1e\x68\x27\x9f\x8d\x6c\x40\xb8\x77\x9c\x02\xde\x43\x3c\x25\x29\x8c\xdb\x23\x0d\xdd\x7c\x46\x7b\xc0\xdd\x31\xaa\x55\x94\xf1\xdd\xbd\xac\x45\xb7\x58\x18\xe1\xce\x95\x35\x31\x8d\x25\x0d\xad\x16\xb5\xc9\x13\xcd\xec\xcf\x2c\x2d\x5e\x56\xd4\xcc\x8d\x69\x99\x98\xa5\xeb\x77\x64\xb3\x53\x68\xf8\xe5\x9d\x73\x61\xe8\xad\x9e\x72\xe2\x54\x7a\xed\x11\x05\x82\xa6\xc0\xc9\xf1\x1b\xac\x44\xb4\xde\xbb\xa5\x44\x96\x14\x2a\x41\xb1\x53\x20\x2e\x7a\xbc\x5c\x23\x96\x90\x54\x5a\x15\x14\x75\x4a\xa3\x30\x46\x4a\x34\xdd\xf5\x47\xff\x06\x85\xa5\xc6\x97\x08\x0a\x4b\x15\x71\x0b\xaf\x3c\xd2\x26\x04\xad\x6d\xd4\x2e\x96\xfa\xcd\x5a\xbb\x14\xb8\xc0\x3b\xa1\x9f\x16\x60\x00\x68\xf1\x7f\xcb\x5f\xf3\xda\x05\xeb\xcb\xf2\x5e\x33\x84\x46\xa8\x05\xeb\x91\x9d\x7e\xf4\x3d\x73\xe5\x6f\x7f\xf9\xbe\xc1\x2f\x2f\x54\x0f\xbd\xe0\x8a\xf3\x54\x13\x99\xed\x5f\xaf\x26\x32\xbb\x78\x89\x9a\x88\xf1\xe3\x71\xbc\xf4\x47\x66\xcd\x57\x6b\xd3\xd6\xe2\x09\xa6\x8c\x9b\x50\xb2\xc0\x09\xec\x97\x30\x0e\x0b\xd7\x5b\x7d\x2d\x1e\x8d\x07\xf7\xf4\x86\x09\xe0\x92\x9c\x1d\xbc\x28\xc2\x8f\xb3\xb1\x2d\xbe\x0f\xfc\xf8\xf6\xa4\x7d\x8d\xf8\x35\x70\x05\xf4\xd1\xa3\xdd\x2d\x78\x80\xba\xcf\xee\x28\x78\x3f\x5d\xad\x12\xe0\xdf\xb3\x19\xca\xf2\xfd\x03\x0f\xee\x55\xe6\x59\x8f\x78\x27\x53\x4b\xee\xa0\xbc\x7f\xc8\x49\xe7\x67\x4b\x98\xb2\x6c\xfa\x59\xa2\x96\x4b\x14\x67\x0f\x7b\x97\x60\x76\xe0\x7f\xd3\xe8\x7f\xcd\xdf\xb5\xb8\x41\xda\xaf\xb2\x1e\x1b\x08\x7e\x7d\xf6\x08\x31\x64\xc6\x92\x82\xd1\xf2\xd0\xa9\xa3\x96\x9b\x83\x02\x9a\x40\x5e\xc4\xfc\x8c\x82\x79\x2a\xe8\xe6\x32\xbe\xa0\x48\x52\x3e\xad\xe0\x37\x77\x13\x5f\xd0\xb9\xc3\xe9\x18\xe1\x83\xee\xa4\x20\xd7\x9e\xea\x88\x8a\xc1\x9e\xf4\x80\x00\xb7\xf8\x12\x0e\x91\x94\xaf\xf9\x94\x6b\x46\xf6\xd8\x87\xdc\xbb\x16\xa3\x28\x14\xcb\xb4\x28\x01\xfc\xaf\x0c\xc6\x01\x3c\x16\xcd\xf4\x99\x15\x03\xc6\xa6\x19\xe5\x51\xb4\xf6\xa1\xd7\x7e\x7a\xf8\x08\xe6\xa6\x30\x35\x80\x6c\x38\x86\x3d\xfc\x3c\x7c\x72\xc4\xe8\xe7\x68\x30\x9e\xad\xac\xa9\x8d\xef\x65\x29\x36\xa5\x1d\xae\xce\x6e\xb0\x33\x4d\x7d\x51\x80\x56\x0b\x4c\x5d\x31\x3b\xdc\x07\xf5\xfd\xfd\xde\x0d\x4e\x28\xa0\x8e\x6b\xf7\xdc\x23\xdd\xa3\x39\x50\x97\xa7\x31\xbf\xfe\xb6\x38\x37\x8a\x23\x6a\xb5\xfd\xa9\x8d\x09\x8f\xa0\xb7\x99\xa5\x93\x17\x51\x1c\x6b\x43\x37\xf1\x70\x10\x01\x3c\x0d\xa8\x80\x85\x52\x9c\xcf\x6f\x35\x62\xf1\x42\xca\x10\x40\x01\x3c\x8e\xb8\x7f\x9c\xb9\x07\x3c\x07\xa3\xc3\xe9\xf1\x08\xfd\x0e\xbc\x5f\xb4\x39\x47\xeb\x88\x17\x44\xc2\x86\xd8\x62\xbc\x6f\x3b\x09\x8a\x1c\x05\xd5\x34\xf3\x44\x96\x10\x80\x2e\xc2\x34\xf2\x14\x4b\x69\xfc\xbb\xf3\x7a\x7b\x83\xf9\x52\x08\xaf\xdd\xb2\x32\x22\xc1\x5a\x21\xff\x2d\xf7\xe1\x6b\x82\xd5\x55\xfe\xdd\xbd\x88\x2c\xb8\x3b\xb1\x93\x3d\xc6\x7f\x1c\xab\x44\x05\x20\x9e\xe7\x0e\xfa\xd1\x16\x17\x41\xb1\x9d\x43\x77\x8a\x05\x14\x5e\xaa\xc0\x73\x8c\x35\x18\x34\x3a\x95\x9a\x20\xd4\xf3\xbb\x4f\x9f\x7e\xeb\xd1\x92\x01\x3c\xca\x37\xdb\x0c\xce\x10\x60\x3e\xc4\x1f\x12\xf6\x5c\x90\x1e\xed\x79\xcd\x74\x02\x74\x60\xe8\x72\x39\x97\xeb\xe4\xfd\xd4\x9b\xb7\x91\x9e\x3d\x83\x18\x79\xaf\xc3\xaa\x37\x73\x8b\x7a\x1c\x95\xa2\x4f\x0f\x66\x82\x72\xf5\x26\x47\x70\x76\x15\x92\xb3\xbc\x8b\x1b\x00\x64\xaf\xee\xdc\x63\x00\xfb\x85\xe2\xfb\xd1\x76\x93\x38\x70\xc1\x7b\x42\xda\x1b\x21\x14\xaa\x3b\x89\x06\xd4\x66\x5a\xb5\x9b\x2a\xb2\x1a\x40\x9a\xc0\xc0\x00\x39\x30\x5f\xfd\x3d\x3b\x3f\x51\xab\x56\x96\xc5\x1d\xb4\xc4\x5f\x88\x94\xec\x0c\x9c\x15\x6c\xd7\x23\x4a\x17\xcd\xca\x7c\x28\x9a\x1d\xdc\x91\x78\x7b\x24\x14\xa7\x8d\xa6\x6f\xa1\x86\x91\x66\x34\x91\x80\x33\x98\xa1\xe5\x02\xb6\x46\xa3\x36\xd4\x92\x05\x30\x17\x93\x31\x4a\x23\x15\x30\x04\x9d\x8f\xd8\xc0\xf7\x0a\x05\xd3\xb3\xca\x6a\x54\x1b\xa3\x0e\xd7\xfb\x56\x15\x2d\x13\x56\xc4\x83\x4c\xa3\x90\xb2\x1e\xc4\xcd\x7d\xed\x7a\x5e\x86\x28\x80\xb1\xfb\x8a\x43\xc5\x81\x51\x3b\xc3\x04\xe3\xf4\xcb\x29\xe3\x27\x73\xd2\xbd\x93\xde\x51\x02\xbd\xd6\xe4\x2a\x7a\x90\x2a\xbd\xa4\x83\xe4\x6e\x7a\x54\x1e\x2d\x84\xa2\x56\x89\xba\x15\x68\x75\xac\x91\xdb\xb5\x35\x11\x3c\x8f\x66\x05\xce\x11\x52\xaf\x88\x1a\x02\x19\x83\x5b\x70\xc8\x89\x84\xd0\x89\x0b\x80\x83\xd1\xa2\x30\x3d\x22\x8f\x2e\x24\x03\x83\x0e\x6d\x8a\xe5\xf3\xa3\x00\x66\x82\xec\x2d\x7c\x39\xbf\x7b\x56\xb2\xb7\xf9\x38\x43\x00\x86\x12\x30\x2b\x46\x73\xbb\x7c\x57\x7b\x20\x6b\x8c\x82\x45\x84\x17\xb5\x86\x36\xf1\x08\x03\x09\x50\x88\x92\x55\x03\xe8\xf2\xad\x08\xae\xcb\x3e\x42\x64\xfa\xb6\xdf\x99\x44\x90\x9b\x20\xf2\x18\x87\xb1\x8f\x75\xad\x5f\xe4\xa7\x28\x4b\x82\x96\xa0\xd3\xf8\x86\x44\xc2\x83\x2e\xd1\xd6\xcb\x2d\x00\xb3\x56\xd1\x4c\xc9\x2c\x5f\x61\x4e\x57\xc4\x00\x71\x80\x63\x1a\x70\x30\xa8\x2e\xfb\x1c\x7d\xd0\xab\xd9\xcb\xf5\x38\x3f\x01\x1e\xed\x72\xd4\x7d\x19\x05\x54\x67\xde\x81\x5d\x46\xb3\x80\x22\x65\xf8\x87\x13\xdb\x1c\xb0\x78\xd4\x80\x66\xb4\xfa\xcd\x43\x46\x00\xfd\x39\xa1\x62\x29\x83\xc7\xe9\x65\x10\x11\xec\x81\x34\xb5\x7c\x18\x3a\x1a\x80\xbf\x4d\x95\xeb\x93\xb6\x4c\x6b\x11\x0a\x07\xe1\x0a\x16\x6a\x09\xa2\x05\x1d\x84\x04\x64\xe7\x69\x0f\x8f\x26\x40\xf0\x2a\xf7\x89\xbf\xc7\xd9\x0d\x1d\xd9\xc3\x76\xd0\xac\xd2\xee\x5b\xa6\x6d\xe3\x59\xe7\x79\xd1\xdc\x89\x68\xa1\xdf\xb5\x90\x67\xbb\x13\x13\xf6\x76\xb1\x3c\xfa\x59\x2f\x46\xce\x11\xdf\x3c\x90\xc1\xb3\x10\xc6\xc3\x06\x73\x01\x80\x15\xb7\xf7\x6c\x40\x8c\x80\x56\x66\xfd\x1f\x10\xd3\xca\x9a\x34\xdb\x32\x03\xad\xea\x96\xb9\x20\x08\x02\x74\xa1\xb5\xb8\xa5\xc1\x6b\x00\xf7\xc9\x48\x0e\x70\x15\x3f\x81\xeb\x18\x60\x1d\xbd\x96\x45\xed\x64\xd8\x0a\xde\x2d\xa0\xf9\x00\x03\xc3\x1e\xda\x0b\x50\xc7\x43\x6a\xf1\xf8\xed\x41\xe6\xdf\x3f\x30\xdf\xc1\xa7\xbe\xde\x14\x7e\x82\x6f\xeb\xc8\x97\x05\x4f\xc1\xa0\xdd\x61\xc2\xf5\xc2\x46\x1a\x56\x31\x4b\x30\xe1\x2f\x3f\x1c\x15\x21\x31\x79\xbc\xe1\x23\x60\xbd\xec\x86\x98\xcc\x59\xa2\x84\xe5\xed\xfe\x0c\xbc\x5b\x6f\xcc\xb1\xa6\xef\xf0\x19\x1d\x9a\x60\xa1\x8c\xda\x8c\xa1\x4b\xfc\x06\xb0\x32\xa9\x1c\x1c\x1d\x2a\xd4\x29\x99\x5e\x3f\xc6\x3f\x7e\x52\x3e\x7d\xfc\xf4\xf9\x31\xfa\xed\x77\xbf\xfb\x4d\xe4\xab\x4f\x9f\x7e\xf1\xcb\xcf\xf1\x28\xf2\xf4\x8d\x7a\x49\x1b\xcc\x96\x91\x5f\x7c\xa4\xde\x5e\x89\xb7\xf3\xcf\xfa\x7f\xb1\xb7\xf9\xd8\xbb\xb7\x9f\x7f\xfe\x3e\x1e\x8f\x44\xb5\x74\xd2\xa6\xea\x44\x0b\x98\xf7\x18\x79\x1f\x81\x79\xb0\x3e\x62\x9f\xbd\xb8\x68\xa8\x73\x88\xec\x28\x8e\x57\xc5\xc8\x7b\xcf\x2c\xc3\x3e\xf9\x9d\x7d\x65\xf7\x86\xb9\x3d\xe0\xbb\x54\x09\xce\x0b\xda\xb6\x06\xa5\x09\xd6\x14\x9f\x08\x4d\x1f\x99\x90\x52\x64\x23\x27\xcd\x1d\xe9\x31\x7c\xec\x8b\x34\xe0\x2c\x99\x25\x79\x31\xba\xa9\x82\x5d\xe7\x19\xf9\x8b\x9f\xb1\xdc\x1b\x23\xd3\x15\x60\x2e\xd1\x28\x7d\x06\xe6\x21\x51\x6e\x27\xfa\xa8\xbe\xdb\xdd\x3e\x58\x62\x7e\xbd\x6b\x75\x46\x83\xd2\x97\x7e\xa9\xdb\xe9\x0f\xbf\x14\x6b\x03\x82\x6c\x96\x8a\xcf\x1e\x1b\x1a\x30\x2f\x3d\xa8\x23\x78\x79\xea\x1f\x34\xaf\x1f\x53\x5e\x08\x03\x06\xa1\x27\xed\xcc\xeb\x05\xe9\xa1\x8d\x8d\x5a\x8c\xc7\x03\x93\x82\x44\xb5\x20\x59\xb7\x91\x4d\x5f\x2b\x73\x70\x63\xec\xe9\xfd\x3e\x75\xfb\x74\xd4\xe5\x1b\xab\x8c\x06\x45\x76\x7a\x07\xf7\x11\x42\xd4\x35\xda\x37\x3e\xb6\xe6\x81\x36\xfe\xfe\x9a\xf3\x37\xda\x5b\xd6\x0e\x4e\x18\xc6\xef\xeb\xfb\x01\xe0\xbc\xa6\x17\xa2\xa9\xae\x65\xfe\xf0\x70\x5f\x11\xaf\xa9\xf5\xdf\xff\xf8\xfa\xfd\xa8\x11\x76\xdf\x03\xe4\x00\x18\x14\xaf\x82\x8b\x05\x92\x25\x00\x1f\xc3\x5a\xd6\x0b\xe7\x35\x64\x34\xf3\x5d\x19\xe4\xae\x84\x9e\x7f\x4b\x68\x42\x74\xb3\xb6\xf6\x69\x8f\x3f\x05\xbf\x5b\xcc\x17\xc1\x50\x2c\x81\x67\x40\x34\x68\x38\x1c\x71\xe1\x4e\x11\x1e\xba\x33\xa3\xbe\x29\x02\x00\x25\x06\x4f\xcb\x25\xba\x02\xbc\x81\x62\x37\x63\xf7\x6c\x6c\x05\x84\x5f\xce\x06\x4d\x56\x64\x3d\x9f\x69\xbb\x7c\xc8\xb7\xa5\x2e\x25\xba\x1d\xaf\x5b\x53\x08\x5f\x4b\x06\x05\xc7\x56\xbe\x10\xb2\x3e\xb0\xb3\xe4\xf9\x42\xe1\x75\x7d\xbc\x33\x4c\x1e\xdd\x4c\xdd\x60\x9f\x8d\xc9\xf0\x75\xf4\xbb\xdc\x1d\x50\xf7\xa5\xb7\xee\x8d\x87\x0c\xf1\xd6\x8c\x9a\xe9\x25\xec\xf3\x7a\xc3\x68\xb1\xb7\x21\xca\x6b\xf8\x4f\xab\x32\xaf\x1f\x00\xe6\xdd\x8e\x51\x29\xf0\x87\xe9\xe0\xf3\x10\x64\x16\xa5\x5f\x55\x76\xc3\x32\xd7\x67\xbb\x24\xcb\xbc\xe3\xac\x1c\x8e\xdb\x3c\xfc\xd9\x1f\xe0\xbe\x47\x59\x0f\x7c\x01\x8f\x1c\x24\xe1\x05\xb8\xfa\x2e\x12\x20\x81\xaf\x09\xad\x32\x78\xa5\xa8\xe0\x82\x44\x6b\xd5\x7f\x37\x08\x0d\x6a\x89\xea\x93\xe8\x43\xf4\xd6\x29\x92\x2f\x01\xd6\x0b\x34\xa3\x01\x69\x15\x01\x96\xea\x8a\xce\xc7\x08\x60\x55\xf9\xe5\x83\x20\xaa\x0f\x9a\xb0\x6a\x47\x09\x07\x8a\xe7\x2f\x0f\xcb\x03\x03\xd3\x27\x9d\x98\xc5\x03\x34\x4b\x91\x39\xb4\x65\x8a\x85\x1e\x1d\x24\xc7\x12\x30\xdb\x24\xfd\xb0\xe8\x4d\x30\xa2\x7b\x99\xd6\x84\x97\x50\x58\x8f\xd7\x1b\xeb\xdc\x6e\x53\xbe\x15\x23\x9a\x19\x82\xd2\x84\x20\x28\x78\x38\x82\x12\xd1\x44\x46\x76\xab\x56\x94\x78\x85\x90\xc1\x35\x05\x5f\x08\x5f\x3c\xd0\xdc\xfe\x5e\x80\xd1\xe4\x75\x8b\x7c\xdd\x46\xc0\x84\x2a\xa7\x74\xad\x36\xcd\x8f\x08\x09\x22\x28\x55\xa1\x65\xaf\xa2\x3e\x04\xc1\xcb\x01\xce\xf1\xe0\x41\x73\x2d\x32\xbc\x4a\xb9\x6c\xf1\x9c\xff\x0c\x4f\x47\x05\xcc\x47\x2d\x43\xe7\xb7\x22\x77\xe4\xc0\x43\x07\x79\x84\x51\x0b\xc5\x16\x8e\x24\x98\x9d\x09\x1e\x09\x4a\x2b\x22\x3f\xe9\x40\xfe\xf4\x51\x46\x1e\x74\x1f\x1e\x22\x24\xba\x8c\x89\x88\xcf\x59\x34\x19\x56\xf0\x38\x53\xc0\x83\xdd\x08\x43\x0d\xd5\xbe\xe0\xea\x7b\x14\xa3\x9e\x34\xd6\xb3\xaf\xef\xfe\xe6\xfd\x12\x9f\x23\xf3\xa2\xb3\x10\x7c\x37\xbf\xca\x35\x43\xbf\x2a\xbc\xb9\x6f\xdf\x36\xd6\xa4\x84\x77\x9e\xd3\xfe\xb6\x7e\x46\xa2\x46\xc6\x3f\x13\xd4\x1b\x0c\x05\x87\xb4\xdc\x48\x81\x6e\x29\xbe\xf3\xd5\xb4\x30\x5f\xee\x65\xa5\x79\x65\x8e\x5a\x51\x40\x64\xf7\x4b\x88\x58\x5b\x48\x3e\x6d\x58\x16\x3c\xc6\x3f\x09\x71\x76\x07\x88\xd6\x27\xd9\x26\xee\x36\x8e\x9f\x53\x42\xa0\xba\xcc\xc3\x17\xe0\xa8\x6c\x19\x70\x0d\x99\x17\x05\xca\xea\x10\xf9\xc9\xc7\x04\x86\xfd\x0a\x49\x4b\x20\xef\x01\xff\xc4\x7f\x15\xb1\xc8\x23\x2c\x28\x7f\x4f\x74\x02\x65\xfa\xf7\x76\x19\xa0\x90\x7e\x44\x26\x48\x72\x00\xdb\x20\xd2\xe9\xa5\xa4\x75\x5e\xa7\x7e\xd4\xdd\x76\xad\xde\xd1\xb4\x88\x42\x1f\x79\x05\xdc\x97\x36\x58\x7c\x71\xfd\xd3\x9e\x6a\xf2\xb7\x68\xe8\xa7\x11\xca\xe5\x6c\x7f\x0c\xfb\x51\x3f\xff\x41\x03\x1c\x25\xbc\xdf\x78\x70\xd4\x67\x87\x53\x2b\x03\xa3\x1e\x62\x48\x19\x8b\x7e\xf3\x8d\x7d\xc4\x58\x5d\x55\xec\x92\x36\xd4\xf8\xcd\xd7\x77\x73\xa6\x38\x1d\x4a\x60\xa5\x2f\xb6\x64\x5c\x4e\x1b\xb1\x47\xdc\xe1\x20\x73\xc7\x42\x06\x4a\xdb\x8d\xb3\x6f\x11\x25\x3e\xde\x49\x1e\x77\xff\x28\x87\x20\x8c\x56\x73\xfc\x90\xf1\x9a\xad\x46\xf9\x1f\x5e\x61\x83\x0b\x00\x1f\xc1\x82\xde\x8e\x86\x9e\xf2\x43\x18\x37\x08\xd3\x6f\xc1\xdb\x6b\xc0\xcd\xd7\xee\x00\x9f\x66\x5d\xb5\xcd\x5c\x1b\x15\x15\x2e\x80\xa1\xfe\x02\x25\x50\xb7\xb7\xe2\x1d\x57\x8c\xdf\xd4\x1c\xf0\xcf\x2e\x2f\x4c\xcf\x31\xee\xf8\xc5\x38\xf3\x46\x87\x92\x0e\x9b\xce\xc3\xae\xac\xd3\x4c\x34\xd4\x78\xee\x3c\xd3\xaf\x19\xd7\x23\x5b\x35\x73\x27\x36\xa6\xe7\xf5\x19\x8c\xdd\xde\x5e\xa5\x21\xf2\xfc\xe9\xe3\x59\x4f\x9c\xd5\x6b\xd7\xdd\xa7\x2e\x68\xfd\xda\x4c\x58\xc0\xbd\x93\xd5\x25\x23\xc1\x6c\x63\x0b\xfd\x12\x1c\x0d\xcb\x39\x70\x91\x59\x96\x57\xeb\xdc\x81\x9d\x21\xf6\xb1\x26\x68\x30\x34\x64\xb5\xce\x17\xab\x8d\xf0\x77\xf6\x8e\x5f\x61\x79\x66\x1f\xd1\xa6\xd6\xf0\x09\xd0\x64\x1b\x46\x53\x84\x97\x65\xca\x19\xbf\xd4\x31\x8c\xa1\xb0\x64\x4e\x0f\xe2\x3b\x54\xfd\xd1\x3e\x32\x0a\x9b\x78\x77\x38\x78\xfa\xf4\x0f\x5d\x59\x5c\x71\x77\xf1\xdf\xb2\x32\x46\xd5\x9b\x78\x22\x9d\x6d\x18\xe8\x82\x7c\xf7\x62\xd6\xd6\x62\xe9\xc0\xb1\x22\xb7\x0a\x8d\x31\x39\xa4\x02\x25\x81\x12\x66\xf9\xc5\xc9\x3a\x99\x1f\x20\x33\xaf\x49\xf3\x8c\x7c\x6f\x3a\xef\x61\x16\x3d\x69\xc1\x83\x6d\x9f\x8d\xbf\x8d\xa7\xc0\x0d\x65\x1c\xd0\xe0\xc4\x27\x77\x91\x1e\x5a\xe1\x1e\x70\x02\x12\x7e\x78\x75\xe9\xc4\xdf\xbb\x5b\x6c\x70\x7a\xfe\x5b\x6b\x48\x05\x78\x91\x7d\x8c\x8c\x84\x35\x92\x5f\x2f\x1f\x6e\x4d\xb5\x7c\xd4\x77\xf7\x39\xa4\x9e\xcd\x31\x9c\xb8\x50\x18\x19\x9c\x91\xe5\xc3\x78\xf8\xb0\x35\x9a\x6b\x43\xd6\x07\x9d\xf6\x3b\x4d\x45\x0a\xbd\xde\x3d\x75\x64\x8e\x29\x18\xe5\x5e\x0c\x61\x60\x52\x45\x64\x7d\x85\x72\x51\xc9\x0e\x54\x81\x11\x61\x6d\xb8\xf1\xe4\x50\xb4\x83\x6d\x70\xda\x6d\x39\xe6\x11\xea\xf4\x3b\x1b\xf9\xce\xdd\xbf\x0b\x14\x47\x19\x12\xc9\x10\x3e\x9e\x36\xc4\xd2\xf9\x32\x28\x7b\x85\x1e\x63\x80\x3b\x61\x76\x92\x0a\x45\x40\x5a\x9a\x2f\x94\x62\x1b\xd4\xa6\x61\x58\xc0\x07\x40\x74\xc5\xc5\x06\xb0\xec\xef\x22\xcf\xbe\xd8\x0a\xb3\x7e\x4b\xd0\xff\xe9\x2e\xe2\xa0\x59\xf3\xc2\x5f\xbb\x79\xc3\xc7\xd3\x27\xd9\x4c\x68\x19\x70\x88\xf5\x54\x68\xf7\xb6\x05\xcf\xdc\x21\xb0\xe6\xfe\x3d\x72\x96\x1b\x2f\x04\xe2\xa3\x19\xf0\xc2\xbd\x39\x20\x20\x87\x9b\x85\xb6\x1f\x7e\xf3\xb0\xdd\xcd\xc8\x66\x55\xd1\xef\x65\xab\x45\xc5\x9d\x71\x4c\xc9\x87\xe5\x6a\xbe\x99\xc2\x0e\x90\x5d\x97\x41\xf2\x06\xfd\xc2\x17\x3d\x15\x84\xc1\x45\x98\x76\xb5\xf7\x6a\xde\xfa\x34\xea\xd8\x92\x4a\xb8\xfa\x0b\xaa\xa5\xeb\x4b\xcb\xd6\x2e\x23\x32\xbb\x78\x4c\xa4\xd3\x4f\x0f\xc6\xff\x44\x23\xf6\xea\xa4\xb5\x6f\x54\x1d\x43\x61\x82\x30\xb3\xe2\x42\xe4\x6d\xa2\xc4\x15\xa5\xa1\x94\x59\xc0\xa9\xe0\x95\x45\x5b\x4b\x16\x3a\x13\x6d\x16\x80\xee\x01\xaf\xa1\xe5\x56\xb8\xc9\x24\xc1\x66\x6c\x19\x78\x3c\x0e\xec\xda\xd6\xa1\x80\x22\x36\x38\x8a\x4c\x3e\xd8\x2c\x74\xa7\x23\xb3\xe7\x1d\x33\x59\x7c\x9e\x29\x52\x2a\x65\xcf\xa0\x85\x50\xa3\x4b\x01\x2e\x5d\x65\xec\x5f\xc0\xe3\x8d\x51\x0b\x96\xcf\x36\x31\x8b\xcd\x2e\xc7\x92\x70\x00\xe9\x8f\x48\x1d\x56\x58\xb8\xaa\x84\x42\xca\xba\x17\x5a\x70\x13\xdb\x7e\x1b\x91\x9f\x34\xdb\x44\xe1\xb0\x83\x09\x4b\x83\x5a\x5b\xf0\x0e\x6e\xb2\xbe\xf3\x91\x7b\x4d\xfa\x15\x52\xc7\x89\xc0\xaa\x8a\x4f\xb6\xe6\x3b\xa7\xea\x3e\x48\x51\x64\xf7\xd0\xec\x99\xb5\x13\x64\x94\xe1\x9d\xaa\x81\x13\x74\xb2\xcc\x7c\x54\x3f\xeb\xc7\x09\xfc\xe6\xc1\xc3\x39\x10\xc4\x85\x34\xe0\x0f\x01\xbc\x12\x90\xce\xeb\xfe\x62\x10\x52\x05\xf3\x53\x7f\xdd\x09\xcc\xbf\xbd\x84\x20\xe4\xf6\x14\xec\x3b\x1e\x14\x25\xcb\x5e\x93\xd3\x2f\x1a\x3f\xfc\xb8\xc5\x79\x40\xd5\xc2\xd0\x13\x33\x4a\x78\xa8\x70\xac\x6e\xd4\x71\x63\x84\x29\xb0\x72\x63\x8f\x77\xe5\x37\xb7\x5d\x37\xff\xd2\xf6\xfc\xa6\xd7\xd1\x76\xfc\x56\xd9\x88\xfb\xf0\xc6\xb1\xdb\xb7\xa0\xe8\xb6\xbd\xbe\x75\xa4\xef\xb4\xb5\xc0\x6a\x61\x61\xd9\x65\x63\x19\xb7\x2d\x06\x25\xc8\x74\xca\x58\x87\x41\xf0\x43\x90\x18\x44\x43\xa1\x61\x36\x58\x57\x90\xb0\xc3\xe4\xf1\x6c\xd1\xdb\x3d\x93\xd0\x3b\xb3\x49\x33\x2f\x36\x33\x57\x24\x4a\x88\x98\xa9\x0e\x90\xec\x4a\xb3\xbc\xfe\xf8\xf9\xd9\x8f\x64\x98\xf2\x4b\xfb\x55\x61\x1a\x44\xdf\xdc\x7b\x6e\x6d\xa2\xee\xe3\x1f\xd4\x8b\x8f\xbb\xd0\xad\xd8\xe8\xcf\x72\x17\x9a\x38\xa7\xff\xf1\x28\x23\x37\xa1\x89\xe9\x7c\x0d\x3f\x98\x0a\x00\x13\xbb\x1e\xd5\x1b\x75\xb9\xc5\x3b\x37\x91\x4d\xef\xc5\xf4\x15\xd7\xbe\x98\xdd\x98\x58\x08\x78\x44\x2d\xf5\x3b\x2a\x79\x0b\xad\x68\x51\x25\xad\x86\x49\x1e\x9c\x49\x99\x91\x7d\xcb\xb3\xe7\x41\xb3\x61\xf4\xa3\x08\x8d\x75\x23\x0f\x66\x21\x32\xf5\xb7\x91\xb8\x5b\x4a\x07\x27\xde\x1b\x8d\x51\xc2\x7a\xbd\x3c\xa2\xf9\x05\x38\xa8\x21\x86\x92\x59\x68\xcb\x86\x7a\x9a\x22\x43\x8b\x32\xa5\xc7\xfd\x16\xa3\x76\x1a\x68\x5d\xf5\x89\xb6\x2c\xf9\x46\x23\x21\x5f\x64\x27\x90\xb8\xc5\xf8\xd0\xce\x32\x59\xbb\x83\x85\xb0\x2f\xeb\xdf\x5f\x4c\xcb\x57\xa3\x09\x1a\x0c\xfe\x71\x03\xef\xd3\x83\xae\x02\x72\xba\x41\xa0\xce\xac\x99\xbf\x61\x38\x4b\x45\x69\x6b\x52\x26\xee\xdd\x46\xe4\x84\xc7\xc8\x83\x16\x23\xf1\xfe\x69\xde\x51\x2a\xbd\x66\x94\x90\xc7\x39\xa2\x3d\xdf\x35\xb1\x36\x68\x2e\xae\x34\x1b\xce\xa4\xa6\x09\x85\x90\x1b\x22\xa5\xb4\x75\xff\x2d\xc7\xdf\xdb\x20\xdf\x16\x64\x42\x6f\xf9\xe6\xd1\xba\x43\xc8\x48\xe2\xf6\xbb\x83\x96\x19\x3b\x70\x2b\x01\x75\xbc\xf7\x06\x75\x64\x42\xda\xe8\xca\x41\x12\xf4\xb3\x6f\x7c\x75\x1c\x75\xd7\x99\x36\xcd\x0a\x7c\xce\x70\x14\x36\x7b\xf3\x46\xb5\x9e\x62\xb3\x6f\x74\xec\x90\x19\xc3\xed\x7c\x19\x1f\xf5\xc3\x6b\x69\xad\x9d\x5e\x68\xeb\xe0\xbc\x2c\x50\x25\xc6\x52\x70\xab\x64\x3f\x1c\x7a\x45\x5b\x61\x88\xbb\x15\x72\xac\xda\x2e\x2a\xf7\x65\xa6\x5e\xcf\x2a\x26\xe0\x0d\xa5\xbe\xea\xba\xbf\xd5\x0e\x67\x96\xec\xbc\xf0\x2d\x91\x8a\x9c\xd7\xbb\x25\xe1\x90\x83\xab\x13\x3d\xcb\x3d\x42\x54\x59\x6e\xe7\x9b\x6d\xb2\x7b\x1c\xd5\x7f\x76\x37\xd7\x22\x27\xc8\x2c\xd3\x70\x81\xda\x7d\xa1\x98\x2f\x26\xe7\xc5\x72\x3b\x39\x0e\x2e\xc6\xfe\x01\xbe\x69\x8c\x55\x04\xa8\x08\x8c\x47\xc0\xc3\xef\x3e\xe4\x1e\x3e\x3c\x40\x79\x4b\x0e\xa6\x8e\xbd\x69\x0c\xa0\xc4\xc1\xc5\x92\x9a\xf0\x09\x5a\x30\x22\x87\x5e\x73\x72\x33\xec\xde\xac\xd9\x07\xd0\xf9\x7b\x37\x1f\xfa\x91\xf9\xec\xba\x47\x1d\xbc\x94\x97\xce\x55\x71\x6f\x9a\x39\xaa\x9d\x0b\xf8\xe0\x77\x20\xde\x3b\x3b\xf0\x50\xc5\xda\xd6\x65\xf2\xcb\x3b\xee\xfc\xa8\x3c\xb9\xe4\x0e\xef\x92\xe0\x9f\xa7\x3b\xb4\x3f\xe0\xec\x6f\x18\x1f\xc0\x89\x9e\x80\x53\x3f\xfb\x9e\x50\xda\xb8\x4b\x94\x16\xbc\x5c\xee\x3a\xf7\x7b\x5d\x2a\xd1\x9b\x47\xa5\x5f\xef\x35\xf3\x5a\x51\xa3\x4e\xd7\x30\xe3\x22\xd2\x08\xb6\xeb\x5a\x7a\x83\xdb\x1b\xf8\x5d\x5f\xd6\x34\xec\xa6\xae\xdd\xca\x5c\xaa\x3e\xb7\x97\xc9\x3e\x9a\xfb\x06\x81\xee\xc1\x0e\xc2\xef\xce\x42\x54\xd3\xca\xf1\x21\xd9\xc5\xed\x6f\xf3\xbb\xce\xcb\x19\x9f\xb5\x3f\xd1\x57\x27\xcb\x03\xab\xd8\xcb\xee\x6e\x5e\xcd\xf3\x31\xe5\x95\x20\x20\x24\x53\xe0\x37\xa2\xe9\xa7\x75\x77\x20\xc6\x72\xc3\x7f\xd0\x0d\x70\x74\xce\xc8\xe0\x88\xde\x3b\xdc\xae\x6c\x95\xa2\x41\x53\x18\x20\xf7\x2d\x17\xb2\x3e\xd9\x53\x04\x86\x98\x89\xe6\x07\xf6\xa8\xb5\x7c\x6f\xf5\x0d\xb3\xcf\x46\xef\xfc\xfe\x9c\x5c\xea\x9e\x1f\x30\x2d\x64\x24\xe4\x9e\x19\xca\xd4\xf8\x82\xc9\x21\x27\x16\x00\x61\x06\x5a\x7b\xdc\xd3\x48\xd9\x92\x7e\x7e\xfc\xac\xd1\x79\x4c\x0f\x7c\xaa\x39\xc3\x42\xfe\xda\x9a\x02\xce\x88\x83\xea\x45\x7c\x29\xcd\x22\x1b\xac\xb1\x0b\x58\x5d\x30\x2b\x94\xc9\x16\xda\xf6\x52\xd0\x4a\x26\x93\x4e\x27\xd3\x60\xf5\x38\x58\x61\xc2\x4e\x54\x29\x18\x19\x3e\x91\x43\x34\x0d\xbc\x23\x78\x03\x06\x70\x11\x1a\xba\x50\x28\x1b\x30\x0e\x6d\x32\xb4\x2a\xfa\xf4\x50\x76\xeb\x47\x51\x67\xb8\x11\x2b\xef\xe1\xfb\xae\xca\xef\x21\x79\xd0\xf6\x5a\x86\x99\xf1\xdc\xb6\x8e\xe6\x06\xbd\x7f\x50\x1c\xc1\x6e\x0c\x50\x68\xce\xb6\x68\x0c\x7b\x97\x02\x58\x26\xf8\xa8\xf1\xc1\xef\x11\xd0\x2c\xdd\xbd\xc1\xbf\xd3\xdf\xbf\x30\x94\x1e\xaf\xc3\x17\xbe\x52\xd0\x4a\x3c\x6e\x06\x83\xc4\x22\xc6\xc0\x73\x44\x6d\x11\xb6\x49\x7f\x67\x79\x57\x78\xe9\x0d\xc0\xcd\x04\xff\x5a\x19\xbf\x40\x55\x03\x24\x3b\x80\x2f\x85\x45\xc8\xef\xfb\xdd\x78\xf8\xae\x60\xd8\x09\xb5\x28\x09\x89\x62\xc7\xc3\x90\xfa\x6a\x5d\x15\x6b\xb1\x77\x74\x68\xe9\x34\x2f\x24\x9b\xc9\x99\xc5\x20\x5b\x73\x0f\xd2\x1b\x79\xba\x06\x3d\x99\x71\x8a\x14\x66\xa0\x52\x9a\x39\xa0\x26\x33\x05\x13\xef\x9a\xc5\x8f\xb7\x6a\x23\x61\x2b\xc0\x1c\x34\xc6\x50\x3c\x43\xc1\xc0\x2e\x2d\x71\xc9\xad\x38\xcd\x7d\x28\x62\x9a\xcf\xc8\x14\xc7\xfb\x7d\xa4\x78\x1e\xea\x26\xa1\xc6\x12\xa1\xa4\x66\x99\x69\x77\xd9\x10\x15\x1a\xda\xd9\x34\xb9\x1d\x87\xe4\xc2\x09\x26\x65\x88\x0a\xd5\x95\x01\x6c\x63\xce\x9c\x75\x07\x6c\xbc\x61\x8e\xe4\xd0\x71\x97\x45\xde\xda\xbb\xf6\xd1\xb4\xdc\x9b\x40\xf5\x91\x55\x35\xa0\x7d\x07\x1d\xe2\x09\x6b\x33\xd3\xee\xab\x04\x35\x4c\x1a\x41\x88\x80\xf9\xbc\xcd\x19\x4b\xdb\x51\x67\x8d\x54\xa0\x18\xef\x02\x12\xc1\xe2\x58\xc2\x98\x3b\x34\x63\x1f\x09\xdc\x4e\xd2\x44\x3a\x76\xe7\x0e\xda\x82\x2d\x8a\x75\x37\xac\x68\xa4\xe8\x95\x2b\x98\xb1\xbd\x15\xdc\xfc\x2d\x61\xfe\x96\x34\x25\xd3\xd6\xb6\xd0\x8c\x0b\xfc\xf7\x31\x42\x9a\x32\x91\x4a\x13\xae\xa1\x62\xda\x3d\x55\xfa\xf6\xbf\xb5\x75\x0e\x2e\x8a\xca\xec\x46\x00\xf2\xb6\x4d\xb2\x7e\x6c\x42\x13\xf2\xa5\xfd\x33\x8d\x77\x29\x15\xc6\xb2\xfe\x62\xd7\xa4\x1c\xa4\x25\x52\x51\xe8\xed\x11\xfa\xdd\xe4\xa1\xd4\x91\x59\xba\x51\x52\x33\xb2\xba\xe1\xe4\x8d\x6e\x83\x8f\xb7\x07\x93\x27\xb2\x23\x22\x1c\x54\xb1\xd0\xac\x15\x1a\x70\xab\x82\xbb\xeb\x13\x15\x50\x29\xf9\xe4\x38\x23\xe1\x1f\x8b\x46\xad\xdb\x53\x71\x71\x58\x21\xbe\x54\x7f\xcd\x1b\xc7\x52\x54\xb4\x8b\x43\xe3\x91\x41\x33\x4b\x13\x4a\x66\x15\x74\xcb\x58\x21\x70\x9b\x4d\xa0\x72\xc1\x4d\x71\x50\x1d\xd4\xda\x98\x9d\xd5\x5a\x91\x51\xc9\xc3\x4a\x4b\x86\x74\x0b\xdd\x8a\xca\x09\x99\x45\x52\x4a\xeb\x33\x36\x70\x1e\xb6\x31\xee\x68\x3c\xb4\x05\x7b\x81\x2e\xdc\x58\x24\x82\x6a\xd0\xb2\x35\xb8\x7b\xb2\x75\xf0\x5d\xf7\xde\x63\x37\xc2\x0d\x6d\xc2\xe5\xce\x04\xe0\x2e\x5a\x2c\xc8\x74\xa7\x1e\x0c\x05\xc1\xb1\x38\xd8\xc0\x6a\x1f\xb1\xcf\x48\x2a\x76\x77\x02\x1c\xa8\x1d\x3e\xe7\x21\xea\x9a\xf9\xec\x08\x29\xec\xc5\x8e\x70\x56\x47\x4c\x19\x25\xe0\x7a\xf6\x7a\x17\x23\x87\x1a\x4e\x13\x0e\xc2\x51\xa0\x1c\xcf\xff\x4e\x56\xef\xae\x87\x5a\x1e\x29\x81\xbe\x67\xe3\x05\xb7\x27\x66\xd5\x1c\xfa\x75\x27\x31\xcc\xb6\xcf\xec\x00\xeb\x0f\x53\x9e\x38\x50\xc4\x4f\x85\x02\x10\xc5\x64\x77\xf5\xd1\xa2\xa1\x46\x82\x54\xfb\xb5\x23\x40\xbf\xe9\xbb\x83\x40\xb3\x24\x80\x6a\x3f\x64\x9c\x58\x2c\xd4\x48\xd0\xaf\x03\xde\x59\xf7\x83\x03\xd9\xf4\x2d\xf0\x8c\x99\x42\x40\x30\xb8\x8e\xed\xbf\xfb\xb5\xb9\x6f\xd6\x91\x2d\x04\xfe\xce\x86\x1f\x6d\xf6\x17\xf0\xbe\xb0\xd3\x20\x4b\x4f\x56\x51\x1a\xa8\x17\x26\xc7\xcd\x83\xe9\x54\xfd\xc5\x64\xc7\x1c\x89\x8d\x75\x56\xcd\x61\x38\xe2\x6e\x05\xe3\x86\xdb\x15\xd0\x51\x23\x2f\xb9\xf5\xfa\x35\x1b\x56\x9a\xd6\x5b\xf8\x56\xdc\x77\x5d\xfa\xa8\xd8\xb8\x1f\x6e\x1c\x00\x2a\x36\xae\xdf\x1b\x3b\x80\x8a\x13\x4f\x4e\xde\x00\x15\x27\xef\x43\x4e\xcf\x71\x10\x0c\x3c\xdb\xc2\x1d\x59\x11\x6e\xc0\x35\xd6\x6d\x07\xae\x0b\x4c\x1e\x00\x36\x00\x67\xed\xe1\x06\x3d\x13\x72\x1a\xbc\xcc\xe2\xbe\xb5\xf8\xc6\x32\x61\xd6\xe2\x1b\xff\x84\x5b\x8b\x6f\xcc\x54\xc2\x5a\x9c\xbc\xf1\x58\xde\x1c\x48\x08\x8d\xfe\x6d\x26\x77\xd9\x33\x3c\xb8\x26\x76\xab\x99\x08\xdd\xe7\x8f\xc6\x1c\x86\x65\xd5\x5e\xf8\x36\xf1\x86\xab\xf3\xed\x13\x42\x29\xeb\x15\x2b\xc1\x73\x44\xcf\x88\x0c\xfa\x23\xfe\xd9\x61\x24\x6e\xf3\xe7\x95\x1f\x7e\xff\xeb\x87\x14\x58\xe0\xa3\xe9\xcc\x6b\x92\x3c\x13\xbf\x6f\x9e\x90\xbc\x5a\x53\x5a\x8c\xa6\x3f\x33\x5c\xe1\xa3\xb7\x8e\x72\xc8\x87\xcf\xf0\x10\x36\x8b\xf1\x8c\x47\x20\x78\xf8\xf2\xd5\x9e\x1a\xef\xa0\xc9\x20\xbc\x05\x0a\x50\xad\x6c\x49\xe9\xeb\x0c\x20\x10\x7b\x48\x02\x54\x86\x3f\xd0\xe0\xcb\xe3\xe1\xae\xbc\xa0\xcf\x3e\x0a\x52\x6f\xeb\xd0\x3e\xc9\x5b\x38\x10\x3f\x1f\x4e\x14\x02\x44\x73\xf6\xf4\x8e\xe6\x01\xd9\xc4\x7c\x06\xba\x49\xbe\xc5\x1f\x7e\xfe\x80\x72\xb3\xab\xd4\xec\xe1\x1b\xe4\x32\x89\x01\x2e\x0d\x69\x28\x21\x8c\x64\x4d\xcd\x10\xf9\xc9\xc7\x16\x54\x3d\x7a\xad\x42\x80\x21\x21\x44\xf0\xa3\x78\x79\x4a\x3e\xbf\x24\x06\x89\x16\xbc\xc4\xf4\x8c\xf6\x9e\xbd\x11\x86\x49\xcb\x28\xc8\x09\x8f\x86\xab\x31\x00\x57\x14\xc1\xee\xd9\x67\xd5\x2f\x5d\xcf\x2b\xe7\x7e\x90\x7c\x66\x6e\x8c\xfc\xd3\xc8\x0f\x1c\x01\x39\x36\xfb\x8c\xe1\xa2\x08\x9e\x07\x14\x3e\xb9\xd0\x61\xd1\x40\xa7\xa0\x53\x01\xa1\x0a\xb1\x0e\x7f\xb0\x7e\xf9\xa0\x49\x81\x20\x5a\xa4\xbc\x3e\x20\x69\x99\xd7\x87\x04\x64\xf7\xe1\x2f\x49\x5d\x90\x04\xfb\x7e\xf9\x2e\xbc\x02\x85\xb4\x90\x01\xef\xbd\x3f\x9a\xa1\x0e\x7e\xbc\xf0\x38\x86\x25\x3b\x23\xcb\xe0\x5e\x8e\xe8\x12\x9d\x07\xb4\x0d\x0f\xe8\x3c\xe8\x96\xf3\xe8\xcc\x9a\x99\xb1\x7d\xe2\xe7\xdc\x72\x0d\x19\x94\xce\xd3\x53\xd3\xee\xbb\x66\xa3\xe9\xa6\xe9\xf2\x1d\xc9\xaa\x53\x56\x75\x7b\x9a\xea\x6f\x44\x23\x22\xd5\xb3\xae\xda\xb2\xd6\xbd\x71\xaf\xe0\x94\x07\xa6\x86\xb5\xb6\x82\xd3\xb1\xa8\xeb\xac\x9f\x10\x83\xce\x78\x7d\x01\x5c\xae\x4f\x06\x59\x67\xd7\xef\x68\x8a\xe7\xf5\x68\x94\xce\x0a\xd1\x27\xef\xe9\x3c\x18\xf1\x51\xbd\x66\x84\x82\xce\x79\x7e\x44\x4f\x5d\xf8\x7e\xd4\x4d\xce\x11\x8b\x2f\x3f\x2c\x39\x2d\x8e\x02\xca\x36\x06\x3e\x31\x0f\x0a\xac\xff\x26\x72\x6f\xd3\x34\x68\xdf\x57\x99\x68\x82\xb1\x9b\x77\xa0\x4b\x78\x66\x2a\x0e\x2d\xe6\xda\xa6\x36\xd5\x35\xec\xcd\xaa\xfb\xee\xd0\x0e\x13\xf0\xa0\x65\x84\xea\xcf\x25\x77\xbc\x61\x9c\x77\xdf\x00\x39\x2d\x22\x42\x9f\xce\x7d\x53\x8d\xf8\x1e\x61\x6b\x34\x8c\xc7\xaf\x6a\x5a\x36\x32\x80\xc2\xfc\x81\x41\xe7\x24\x62\x1c\xc4\xb7\x8c\x3e\x7a\xe4\xfd\xc3\x57\xc8\x13\xc6\x9d\xf0\x23\xc2\x29\xe2\xdb\x04\x96\x48\x78\xd0\x97\xd7\x8b\xfe\x42\xe7\x1b\xd1\xd0\xe3\x2d\x8a\x3c\xb1\x7c\xd1\x14\xb0\xe0\x29\x60\x2f\x9c\xc2\x8f\x39\x36\xee\x17\xaa\xdb\x5b\xe2\xe9\x8d\x3c\x5e\x75\xef\x5a\x7f\x74\x34\x2f\x11\xe8\x8e\xf4\xa8\x31\xe1\x05\x3c\xfa\x6e\xc5\xc1\xc3\x0d\x6d\x0f\xbe\xd1\x09\xa3\x7b\x55\xc8\x5c\x88\xd6\xaf\x33\x02\x70\x42\x9a\x06\x29\xfa\x6e\x07\x1e\x03\xa8\x65\xe4\xd3\x27\x14\xc9\x10\xb2\x81\x57\x89\x5a\x3e\xda\xeb\xbf\x53\x45\x9d\xe0\x03\x46\x15\xf2\x36\x51\xdd\x40\x2b\xe2\x0c\x72\xed\x10\x08\x83\xf7\x42\x9f\x61\x4b\x67\xe9\xf1\x97\x1f\x7f\xf6\x2d\xf3\xdd\xe7\x5f\xfa\x40\xc4\xa6\x7e\x08\xb8\x2a\x8c\x62\xd5\x7d\xa6\xde\xc8\x1e\x4b\x87\x7a\xe4\x8f\x32\x78\xe6\x7c\x86\x4f\x1d\xc3\x4a\xe3\x56\xa4\x19\x73\xc8\xb7\xfb\x0d\x39\xad\xd8\x63\xc5\x79\xca\xd6\x34\xcd\xb9\x5b\xe2\xf4\x18\x7d\xd2\xe3\x8c\xe8\x71\xf5\x9c\x60\x71\x3b\x0d\x22\x99\x9a\x78\x8b\x94\xc5\x29\x12\xb4\x38\x78\x8c\x80\x0d\x8e\x40\x3b\x39\x5d\xa4\x83\x3d\x21\x66\x98\x31\xc2\x03\x99\xd2\xb3\x47\x1c\x39\x1e\xbf\xc5\x6f\x1d\x3d\x72\xb7\x5a\xe8\x26\x7d\xd4\xfd\xa3\x1f\xad\xdd\x89\xe6\x5b\xdb\x77\x50\xd1\x77\x50\x11\x45\x11\x0d\xda\xcf\xc2\xa0\x16\x2a\x04\x85\x21\x4b\x7b\x8c\x1a\xb1\x6c\xa1\xe0\xd2\x1a\x7e\xe2\x1b\xc0\xa1\xfd\x5e\x04\xdd\x7d\x50\xb8\x14\xf9\x55\xc4\x0b\x5c\x83\x5a\xc4\x4f\x71\x15\xf3\x53\x5a\xc5\xe0\x2b\x90\x41\xaf\x32\x2f\x39\x39\x0a\x90\xf5\xac\x5d\x71\xc6\x6d\xe6\xee\xe4\x83\x4f\x6b\x08\x71\xd9\x10\x8a\x81\x53\x28\xeb\xf6\x93\xc8\xc4\xd7\xfc\x1d\x65\xb2\x40\x4b\xc4\x2c\x4b\xcc\x47\x5e\x35\x9e\x39\xdc\x07\x84\xe5\xfa\x1f\x6f\x1f\xf0\xcf\x90\xed\x55\x61\x0d\x4d\xd8\xac\x9a\xa3\x3e\x58\x46\xfd\x80\x7e\x8f\xbc\x47\x41\x89\xe0\x8a\x2d\xfd\xf9\x41\x50\xef\xcb\x1b\xee\x96\x8f\x96\x43\x00\xce\x9b\x02\x2d\x5e\x4c\x06\xc1\x81\x7e\x68\x4f\x20\xa3\xe0\xa3\xbc\xb0\xa2\x7e\x20\x41\x51\x39\x5e\x0f\x10\xab\x1d\x70\xd5\x99\xc5\x2c\x00\x25\x9d\xc7\x5a\x37\xca\xd6\x03\x6d\x3e\x7e\x3a\xe3\x8b\x4f\x9f\x7e\xff\xd3\x19\xcb\x46\xe3\x51\x83\x1e\xd8\x31\x17\x32\x87\x9c\x05\x01\x1e\x39\x0d\xf8\x50\x51\xca\x7d\xc4\x3f\xeb\x34\xb2\x08\x2e\x00\xd0\x83\x76\x80\x5d\xf2\x79\xd4\x06\x02\xd2\x43\x44\xe0\x29\xa4\x87\x0d\x6e\x31\x42\x7d\xb4\x96\x9a\x21\x32\xcc\xc9\x25\x8a\x0f\xbc\x68\x78\xd4\x5a\xda\x6a\x33\x02\x78\x80\xca\x38\x18\x38\xb8\xb3\x7a\x8c\xfc\x44\x4f\xcd\x86\x1a\x33\xbb\x05\xb3\x5c\x32\x80\x2e\x28\x34\x25\x31\x96\x3e\xac\xf5\xe1\x13\x5e\x5f\x26\xcc\x51\x00\x17\xfe\x8d\x97\x12\xf8\x36\x27\x2d\x6e\xc2\x03\x73\x96\x38\x99\x59\x6a\xdd\x3a\xba\x40\x84\x16\x50\x01\x9b\xef\x6e\xc0\xb6\xc3\x58\xe9\xe0\x15\x6a\xfa\x0e\x8b\x4f\x68\xa2\xb7\xa3\xff\xa8\xff\xa9\xdb\x49\xdc\x70\x4c\xbd\x01\xc4\x3b\x57\x87\x07\xdc\x63\x0f\xb2\x3f\xec\x3f\xc0\xde\x6f\x63\x42\x22\x74\x1b\xd7\xdc\x6e\x0b\xa1\x96\x7d\x09\x94\xf5\x2c\xc0\xcc\xba\x10\xde\x88\x5a\x59\x8c\x1f\x70\x78\xce\x12\x48\x96\x62\x59\x82\x9b\x5d\xd6\x0f\xe1\x3d\x33\x1d\x43\x5f\x04\x9b\x7f\x64\x3e\xdb\x7c\xa8\x38\x30\x12\x07\x71\x06\xf5\xcd\xb1\x02\x94\x2a\x7f\x78\x40\xc7\xfa\xc1\x0c\x9c\x04\x20\x13\xf9\xdb\x91\x9b\x99\xa2\x5b\x93\xff\xc1\xce\x09\x47\x6a\xb0\x27\xc8\x6b\xe7\xde\x2e\x38\x15\x7e\x44\xd5\x68\x64\xcd\x81\x69\x51\x98\xad\x2c\x0b\x66\xe7\x59\x34\x43\x21\x18\xa6\xec\xa2\xbf\xc8\x3e\xc2\x87\xff\x67\x33\x89\x90\x5b\x6d\x0f\xd3\x5a\xb8\xa7\x00\x3e\xa0\xd7\x33\x74\xc3\xd5\x47\xf7\x70\x0a\x57\xa3\x2f\xe9\xd8\x78\xa3\x87\xeb\xd8\xf7\x39\x42\xb4\x07\x35\x77\xd2\x6a\x87\x7a\x30\xa5\x6d\xc2\x07\xc7\xab\xde\x96\x92\x1c\xd2\xe5\xf7\x0f\x09\xcc\xb7\xaa\x3b\xbd\xb8\xd6\x24\xfc\x82\xad\x9f\xe1\xbc\x1f\x5a\xe6\x8a\x03\x1e\x5c\xc5\x52\xe1\xee\x02\x8d\x27\xd7\x2d\xaa\x2c\x52\x66\x7a\xe6\x7a\xc4\x7d\xde\x05\x1e\x62\x5d\x57\x80\x3b\x17\x39\x0e\x4a\xbc\x98\xf4\x19\xc8\xd7\x4c\xc6\x54\x0e\x5b\xc1\xae\x19\x77\xc1\x4b\x19\x4f\xc2\xd3\x9c\xc3\xa2\x8e\x10\x0f\xef\x50\x70\xc9\xaa\xb8\x63\x1e\x5d\x9f\x40\x07\xe3\xa1\x16\xa5\x05\xb0\xe4\x90\x76\x68\xfa\x6f\xe4\x30\x19\x0d\xbf\x96\xf4\x3d\xa0\xdd\x32\xb6\x8f\xb9\x25\x23\xda\xec\x5a\xef\x75\x9e\x09\xd1\x79\x47\xe6\x58\x4e\xd0\x11\x35\x7c\xd7\xd9\x10\x5d\xdf\x32\xc5\xbf\xa8\x6b\x3c\xe1\xd3\xb7\xd5\xc8\xc8\x25\x49\xb3\x27\x04\x7f\xd1\x80\x89\x30\x9b\x60\xcf\xc7\xfd\x92\xee\x93\x58\x88\xee\x5d\x81\x86\x5e\x32\x42\xea\xee\x08\x01\x16\x64\x2f\x38\x78\xa9\x17\x60\xeb\x2b\x37\x3f\x93\x0d\x4d\x45\xa0\x89\x34\xb8\x62\x69\x66\xc0\x08\x4b\x85\x34\xfe\x7a\xd9\x92\x70\xc6\x8f\x9a\x38\x94\x6f\x06\xe5\xf6\x30\x83\x7a\x7f\xdf\x6c\xf0\x06\xa0\x8b\x40\x23\x75\x1c\x32\xcc\x7e\x7c\xc9\xb1\xc0\xb0\xc4\x0f\x98\x2a\x52\x0c\xfc\xb6\x66\x8a\xdf\x47\x79\x3d\x0b\x43\xe7\xa0\x4a\x07\xf5\x85\x7b\x86\xe1\x78\xd8\xfe\xad\xd1\x6a\x5e\x34\x44\x32\x13\x1a\x11\xa1\x1e\x13\xe1\x60\x09\x71\xed\x2f\x1e\x29\xef\x31\x92\x8b\x51\x30\x47\x33\x42\xd2\x10\xbc\x8a\x06\x9d\xac\x29\xf5\xcb\xcd\xdf\x2c\x6c\x83\xaf\x03\x74\xae\x66\xb5\xa7\x30\x95\xd0\xc3\x05\xad\x3c\x62\xb2\x2d\x61\x67\xe1\xbe\x99\xfd\x86\x78\x49\xb7\x4f\x0f\x2f\x04\x85\x23\xcc\xc1\x5d\x3a\xe8\x20\x52\xfa\x46\xa6\xc2\x5c\x8a\x04\x14\x30\x82\x27\x14\xf3\xe2\x2b\x17\x0c\x90\xf3\x19\xc0\x62\x57\xa0\x9b\xf7\x78\xb7\xf7\xc1\xb4\x47\x9f\x4e\x9e\xee\x4f\xdf\x93\x65\x7a\xb4\x44\x0f\x0c\xd1\xd8\x3a\xb0\x6e\x08\xf3\xf8\x12\x1e\x2a\x81\x61\xa9\x10\x90\x27\x6d\xb1\x54\xc3\x82\xde\x3f\xf3\xf5\xab\xd8\x73\xc0\x74\x3f\x74\x65\xee\x08\x1f\xde\x2e\x2e\xdd\xa5\x43\xd2\x5f\x7c\x5e\xb2\x6c\xc3\x00\xa9\x00\x0d\x5e\xbe\xd5\xff\x2a\x0d\x0a\x96\xbf\x0a\xe0\x25\x70\xfb\xab\x63\xfb\x36\x1e\xa6\x13\xd6\xaa\x05\xfc\x63\xe4\x13\x16\xf9\xfc\x60\x7b\x6b\x5a\x3e\xfe\x3b\x01\xdf\xfe\x06\xfa\x16\x22\xc2\xd9\x3b\xf8\xf2\x82\xf1\x09\x1d\xf6\x67\x68\xf4\x45\xe8\x5e\x34\xd3\x28\x98\x04\xdc\x21\xb0\xd2\x3a\x52\x43\x77\xb4\x12\x65\xb0\x49\xcb\x21\xb5\x18\xa8\xa2\xe4\x35\x29\xe1\x25\x7d\xed\xca\x0c\xb3\xf4\xea\xe5\x78\x03\x9e\xd9\xad\xf5\xfb\xea\xce\x77\x39\xf4\x2c\x4c\x4e\x55\x63\xf6\x1e\x31\xf7\x6c\xfe\xdd\x7b\x9d\xe9\x56\x4c\x78\xc4\xd5\xf4\xdf\x0b\xd9\x14\x73\x37\xfd\xf7\x03\xf0\xe7\x3f\x08\xf8\xf6\xb7\x1c\x43\xda\x24\x8c\x70\x92\xa6\xbe\x54\xd7\x48\xd8\xe7\x11\x20\xe9\xb1\x03\x4e\xd3\x54\x44\x3e\x44\xdc\x00\xfb\x0f\x1d\xbb\xf3\xb7\x6c\x9b\xf3\x93\xa0\x09\x06\x69\x58\x6e\xce\x83\x16\xc3\x54\x27\xe5\x86\xf2\xa7\x37\xba\xa2\xda\x29\xa1\x07\xa7\x3d\xf2\x04\x4d\x2e\x34\x81\xbb\x4d\xe3\xed\x23\x7f\xf7\xd2\xc3\x30\xa6\xf9\xb8\x6b\xe5\x7f\x3b\x60\x63\x7e\xfd\x77\x6e\x1f\xc1\x4c\xde\x15\x43\x62\x28\x74\x44\xf7\x39\x27\xbf\xfe\xbb\xf6\x2e\x4b\xaf\x46\xfa\x27\x4f\x8a\x51\x14\x4f\x82\x17\xc5\xf8\xf5\x7f\x62\x1f\xb7\x1a\x7e\x5c\x83\x74\xd8\x3f\xb0\x8e\x09\x45\x3d\xc6\xfc\xfb\xf6\x31\x5b\x61\x29\xa8\xf6\xbc\x6a\x06\x40\xf1\x1f\xd8\x7b\x6e\xfb\x6f\xe1\x7f\x61\xaf\xd9\xf1\xaf\xf9\x87\xf6\x9a\x5d\x7f\x94\x67\x5c\xee\x0e\x4f\x16\x14\xb3\x38\x47\x79\x49\x95\xdd\x8b\xf9\x23\xfb\xc0\x63\xff\x29\xfe\x23\x7b\xcd\x89\x7f\xcd\x3f\xb6\xd7\x9c\xfa\xd7\xfc\xaf\xed\x35\xe7\x21\x37\xc9\x6e\xd6\x13\xf9\xc9\xc7\x0f\xf8\x73\x82\xf6\x20\x2d\xbf\xfe\x6f\x6c\x03\x7c\x8c\x7c\x0c\xa2\x26\x5e\xa0\x7d\xb5\x67\x99\xc7\x9e\x40\x0d\xa3\x7b\x8a\x7f\xe2\x98\xe2\xa7\x4f\x41\x54\xe1\x1f\x3b\x6a\x7f\x7e\x2d\x79\xb4\x5b\x02\x79\xa3\x0b\x6c\x85\x88\x1d\x5a\x0a\xd4\xb1\x79\x99\x4d\xde\x08\xad\xee\x1c\xa2\x05\x38\x79\x8c\xff\xe2\xf1\xd3\x32\x16\x7d\x7e\x7c\xf7\xf3\xe8\xcf\xe2\x1e\xec\xa0\xac\xa9\x16\x6e\x2e\x25\xf2\xc7\xc4\x67\x97\x8a\xb7\xa3\x91\xe1\x8f\xf8\x67\x48\x89\x21\xe7\xeb\x90\x74\xeb\x66\x27\xa0\x9e\x77\xcc\x53\x63\x80\xa8\x7f\x86\x87\x90\xa7\xcb\xbd\x79\xff\xad\x63\x3b\x7e\xf1\x52\xfc\xfa\x01\x47\xf7\x9f\xd8\x0f\xcf\x97\xdf\xc2\xc0\x68\x8d\x0f\xc1\x6b\xd4\xbb\x0d\x88\x5a\x71\x53\x5c\x06\xdc\xcc\xaf\xe3\xff\x15\x5d\x69\xf5\xfe\x01\xf2\xea\xd8\x39\x81\x21\x94\x50\x7d\xf9\x14\xef\x87\x01\x5a\xe7\x4f\x7f\xe8\x3a\x23\x39\x3d\x5b\x89\x6d\x99\x6e\xd9\xea\x8a\x03\x1c\x7b\xa4\x14\x79\xcd\x24\x7f\xe7\xb7\xb2\x19\x26\xb1\xf0\xb0\x33\x32\x23\x37\x44\xe2\x11\x0b\x7f\x64\xeb\xcd\x6e\xdd\xe1\x58\x9d\xb7\x99\xb6\xea\x6b\xa1\xfd\x7b\x41\x16\xbc\x16\x4b\xb7\xc7\x9b\x45\xd9\x2b\x4c\x61\x2b\xa1\x47\xd1\xac\xb6\x5e\x31\x44\xdc\x67\x08\x13\x04\xee\x6d\x09\x4a\x3d\x14\x38\x6d\xb3\x42\xf5\x5e\x85\x5a\x40\x85\x00\x00\x18\x96\x73\x01\xf9\x82\x02\xe0\x11\xca\x5a\xf8\xe5\xa4\x41\x53\x55\x42\x52\xf0\x3b\x0f\xf1\x07\x4a\xa7\x7f\x81\xca\xca\xfb\xa9\x88\x5e\x6b\xec\xfc\xba\x69\xbf\x68\xd2\xdf\x39\x7c\x43\x5e\x48\x4c\x1e\x1d\xb7\x59\xd4\xf1\xf7\xcf\x1d\x7f\xc7\x1c\x7f\xbf\x75\xfc\xfd\xce\xf1\x77\xdc\x49\xac\x1c\xea\xd0\xb0\xf4\x4a\x0e\x43\xaf\x74\xd3\x02\x39\x7a\x8b\xd4\xe1\xfd\x50\xd5\x49\x13\x1c\xf3\xd1\xc3\xb1\x85\x0b\xf4\x4e\x92\x9d\x2d\x6e\x5e\xb3\xd0\xba\x00\x80\xd4\x66\x8d\x85\x3c\xad\x38\x68\xa6\x15\xd5\x3f\x40\x4f\xe6\xb7\x8e\x4a\xb8\x51\xe9\xe7\x96\x4a\xef\x1c\x95\x12\x46\xa5\x98\x6e\xfe\x04\x4d\xa1\xe2\xfa\xef\xa6\xbc\x1a\x39\x57\x71\xe1\xf4\xdd\xd0\x26\xc5\xb4\xe2\x35\x57\x0b\x53\x8c\x68\xd6\xbc\x5f\xe9\xf9\x90\xbe\xd2\xad\x78\xe5\x57\x5c\x58\x19\x4f\xde\x16\x7e\xc9\xde\x91\x7d\xb8\xe5\xa7\xce\xbe\x73\x2f\xe8\xc1\x14\x84\x3a\x3b\xc9\xfb\x4e\x30\xf2\x75\x68\x31\x91\x97\x2a\xbe\xc1\x5c\x24\x6a\x69\x8f\xf3\x6a\xf4\xfc\xcd\x8f\xd4\x33\xee\xe8\xf9\x5d\xd9\x67\x31\xef\xe8\xe0\xf1\x74\x2b\xcc\x27\xd7\xeb\x18\x15\x3b\x47\xe1\xad\x7c\xe8\xce\x6f\x48\x21\x94\x6c\x2b\x11\x71\xf6\x2e\x86\x6a\x97\x8c\xb8\xa0\xfa\xfb\x77\xc5\x69\x7d\xbf\xa6\xdf\x85\x6c\x9a\x70\x37\xfd\x55\xc8\xa6\x76\xf9\x1f\x78\xbd\x68\x6f\xa0\x30\xd1\x58\x34\xfb\x0a\x98\x28\x6c\xc8\xa9\x30\x81\x88\xf9\xc4\x71\x76\x99\x30\xb1\x59\x1b\xe0\xf6\x25\xf5\x39\x64\xf4\xa0\xdb\xb3\x4e\x4f\xa3\xfd\x6c\xc4\x7c\x35\xad\x56\xcc\x50\x50\xf1\x87\x44\xd4\x12\x28\x2f\xd8\xf1\xd8\x12\xda\x4c\x0b\xc3\x03\xe3\x14\x59\x22\x12\xc9\xcf\x0f\xb1\x98\xe0\x17\x8e\xe8\x36\xf8\xc7\xc4\xc3\xcf\x1f\x84\xcf\x7a\x40\x22\xfd\x4f\x68\xee\xfb\xd9\x19\x8a\xe8\x1b\x08\x0b\x8b\x7d\x23\x34\x20\x7d\x03\x83\x12\x81\x77\x28\x6f\x8d\xf7\x76\xc6\xf1\xa1\x58\x18\x0c\x1e\xf9\xa8\x6e\x3e\xf8\x91\x82\xf0\xe2\x91\xe2\xc5\x56\x55\x66\x17\x43\x71\x8a\xe3\xa8\x8a\x56\x5b\xd1\x8c\x65\x29\x68\x1d\xf5\x0c\x6f\x6d\x3e\xea\x65\x87\xa7\x58\x42\x8a\x06\xcb\x37\x3e\xa7\x50\x37\x8a\x6e\xc7\x0e\x36\x00\x76\xfd\x37\x22\xae\xed\xce\x07\x6c\xaa\xf8\x4e\x80\x19\x83\x2e\x8f\xdf\x2e\xc4\xe5\xe5\xfd\x6d\x5f\xbf\x73\x75\x83\x07\x61\x62\x10\x5e\x38\x62\x32\xfa\xdd\xdd\x6e\x38\xab\x9a\x1b\xab\x4b\x2f\x25\x7b\x5a\xa2\xdc\x62\xfa\xa1\xe8\x6d\x5a\x22\xcc\x9b\x0d\x29\x66\xa4\x76\x36\x05\x0b\xea\x0d\x72\x4f\xb7\xe3\x80\xe3\x9f\xed\x56\x76\xcc\xe7\xa8\xcf\xd5\xa5\x57\xff\x6b\x0d\x15\xd2\x1e\xd3\xee\x51\x0e\x58\x4b\xfa\x65\x3b\xac\x0b\x74\x74\x47\xea\x35\x25\x31\x5f\x3f\xbe\x8b\xc6\x39\xf7\xd2\xcc\xb7\x0b\xb4\x79\xf5\x30\x63\x8b\xe0\x91\xd0\xd6\x40\x68\xa0\x47\x4b\x3a\x0e\xfa\x56\xfc\x8e\x2c\x11\xad\xf0\x4a\xcc\x48\xe2\x47\x1a\x76\xd4\x2e\x96\xfa\xcd\x5a\xbb\xf4\x63\xa8\x37\x5f\xba\xe4\x66\xa7\xd0\xb0\x6c\xaa\x96\x9c\xda\xfe\x78\xb0\xe6\x23\x86\xa1\x50\xa1\x5a\x32\x8d\xc1\x57\x84\x62\xb8\x52\xfa\xdd\x15\xe9\xc4\x6b\x70\xe2\x17\x1f\x69\x49\xc1\xf0\x44\x32\x95\xce\x64\x3f\xff\x1c\x49\xfb\xe2\x61\x51\xfe\x84\x2e\xb1\x77\x94\x2a\x2e\x34\x8c\xb9\x8b\xe7\x28\xd9\xa5\x26\xa2\x72\xe5\x31\xd6\x7c\xe0\xe4\xa0\x33\x9c\xcd\x66\xef\x2d\xf2\xd9\xed\x10\xe7\x3e\xd3\xd0\x4c\x19\xc2\xd0\x0b\xc3\x35\x32\xeb\x81\x6f\x68\xd5\x4f\x0f\x9c\x1b\x73\x1e\x7d\x40\xfb\x8b\xe7\xcf\xb1\xe7\x47\xf8\x9f\x9f\x47\x1f\xc1\x2f\x9f\x94\x4f\x03\xf0\x6b\xf4\xc3\xcf\xe2\x51\xc3\x4f\x1f\x5e\x79\x50\x36\x8a\xde\x29\x1f\x93\xc8\xd2\xdc\x24\xf5\xde\x0f\x51\xc8\x34\xbc\x07\x53\xf1\x7e\xda\xa2\xdb\x81\x73\xe7\xe4\xfd\x81\x18\xef\xfd\xc8\x3d\xc8\xe7\xa3\xfa\xb0\x13\x61\xba\x49\x4f\x04\x75\x6d\x63\x61\x50\xfb\x18\xf9\xbd\x48\x68\x5e\x89\x43\xa6\xc2\x03\x68\xbb\xf6\xa8\x85\x24\xd2\x7c\x93\xa2\x8e\x4e\xdf\x11\x61\x7b\xd4\xce\xe4\x48\x0a\xee\x8e\x7c\x59\x77\x48\x79\x16\xd8\x61\xe1\x65\x1d\xa2\x2c\xe8\xc1\x3d\x16\x5f\xd6\x23\xb2\x08\x08\xec\xb0\xf4\x43\xd7\xfc\xf4\x32\x7d\x3b\x18\xb2\xfc\xc3\x76\xed\xe5\x03\x56\x5e\xc0\xa4\xdb\xba\xb2\x8e\x0b\x7d\x9c\x5c\x3d\x57\x5f\xdc\xb3\x91\x96\xd5\xd5\x37\x7c\x98\xa3\x12\xdc\x77\xb4\x5a\x18\x62\x7f\xeb\xd5\x9e\x5f\xf9\x16\x81\x43\x7d\x7a\xc0\x9f\xdc\xd6\x81\xb6\x8c\xb8\x5e\x29\x6e\xb4\x58\x26\x0f\x30\xdf\xb8\x0a\xfe\x13\x8b\x45\x5f\x62\x3c\x02\xe6\x5f\x37\x8d\x06\x20\x41\xf8\x50\xbf\x23\x5b\x72\x69\xa9\x2c\x9e\x8d\x90\x92\x62\x9a\x1d\x00\x0c\x62\xfa\xb5\xf6\x8b\x7d\x3a\x8c\x0c\x68\x3b\xb1\x10\xa1\xe0\x01\x05\x6e\xf0\xac\xa5\xdb\x67\x81\x0a\x49\xbb\x03\xbc\x47\x0d\x8f\xfe\x49\x86\x17\x4f\xee\xa5\x36\xec\x4b\x6d\xfc\x36\x96\x3a\x14\xd1\x71\xb7\xac\xd5\xb5\x14\x54\x0f\x6a\xca\xfd\x96\x33\x14\x35\x22\xe4\x5a\x50\xf3\x65\xc4\x1b\x8e\xa1\x04\x53\x9e\x56\xd8\x1e\xb5\x84\xb7\x21\x7a\xec\xbe\xac\x47\x28\x86\xbc\xd3\xe3\x20\x6c\x8f\x47\xdd\x5e\xfd\xde\x15\x33\x0c\x52\xfd\x58\x03\xfa\xa1\x00\xbb\xae\xfd\x33\x86\xb9\x7b\xf5\x7c\x8c\x7c\x33\xf4\x51\x4a\x83\x59\x4c\xc3\x2e\xcb\x86\x13\x81\x0b\x9b\xff\x35\xa7\x4d\xd0\x36\x3e\x88\x38\x7d\x8c\xfc\xd2\x62\xd4\x04\x2f\x8d\xdb\xda\xa8\xd7\xde\x1f\x77\x6c\x60\x10\xeb\x14\x00\xd3\x85\xdf\xfe\xd1\xf7\xb0\x08\xbe\xa1\x0d\xb2\x71\x2b\x71\x85\x95\x0a\x65\x95\x81\x90\x89\x7e\x01\xf7\xe6\xec\xf4\x1b\xec\x39\x91\xce\x3c\x63\xee\x8e\xdf\x2d\x5f\x00\x59\x62\x01\x98\xd1\x83\xaa\x87\x84\xeb\x03\xaa\x7b\xf7\x7a\x5e\x59\x37\xb4\x7a\x2b\x67\x5f\x07\xbf\x0f\x46\xb0\x76\x4b\x15\x8f\xab\x82\xe0\x79\x1d\xcb\x14\x0f\x32\x8b\xaa\xe8\xdf\x09\x1d\x21\x1e\xdf\xb8\xe7\xbe\x0e\x29\xec\x03\x58\xaf\xa2\x60\xe0\x16\xea\x01\x8a\xcc\x13\x60\xf1\xdc\x33\x9e\x45\xea\x67\x97\x97\xa6\x76\x4d\xad\x23\x9f\x7f\xbc\x51\x75\x6f\xba\xe0\x41\xdf\x71\xf6\x8b\x92\xf3\x27\x5a\xfc\x6f\x18\x20\xb8\x07\x40\xf8\xdf\x34\x40\xdc\xfb\xbe\x0b\x65\x1c\xa4\xfa\xe5\x15\xb1\x5f\x22\x48\xf9\x93\x80\x07\x3f\x6d\x41\x65\x15\x89\x5a\x61\xec\x2a\x18\x6b\xf3\xbd\x41\x84\x50\x5d\x98\x27\xc3\xcb\xcc\xc6\x1c\x5b\x7e\xd1\xd8\x69\x38\x76\x22\x60\x6c\x94\xa0\x23\x72\xa3\x84\xe6\x24\x90\xa3\xea\x93\xe3\x4b\xd2\xf7\x4b\xca\xf8\x12\x8d\xf8\x85\xd6\xe0\xc2\x08\xb9\x9d\x39\x32\x2c\x4b\x72\x2e\xdb\xdc\x7f\x51\xdb\x7f\xd1\xbd\xff\x22\xdc\x7f\x6f\xfb\x2b\xe5\x76\x23\x8a\x70\xee\xee\xa7\x3a\x9c\x80\x02\xfa\x4c\x82\x85\x21\x67\x59\x18\x6e\x8c\x33\x34\x3a\xd0\xdd\xfa\x56\x68\x64\x50\xc4\x0c\xe6\x4f\x2b\xbe\xa5\x3a\x33\xf8\x5b\xad\xfc\x96\x4f\x51\x8b\x75\x66\x7e\xb0\xa7\x18\x03\xdf\xd2\x96\x31\x8c\x94\x8b\xb0\x3c\x6b\xe9\xec\x96\x4b\x11\x7e\xc9\xd9\xbe\xdc\x52\x2a\xc2\x6f\xf9\xdb\x37\x77\x32\x32\x38\x75\x73\xee\x8f\x9c\x35\x2b\xa4\x65\x25\xc8\x89\x3d\xe1\xb9\x16\x14\x9e\xcd\x6f\x31\xe8\xa3\xd7\x6a\xd0\x07\xef\xe5\xa0\x4f\x7e\xeb\x41\x1f\xf5\x05\x69\xc9\xcc\xdc\xd9\x26\xa3\xcf\x9a\xc4\xcf\xd8\xca\x34\xd8\x4a\x73\x5b\x73\x51\x94\x92\xc4\x95\x9a\x53\x01\x77\x59\x12\xb3\xb4\x4c\x6a\x53\xd0\x9a\x1a\x19\xbb\x50\x08\x3b\xf9\x51\x8c\x46\x7d\xba\xe1\x8c\x4c\x36\x4f\xb6\xef\xd0\xb9\xe7\x49\x4b\xed\x90\xd6\xc7\xf0\x96\x39\x99\x83\x81\xb1\x1f\x21\x0b\xa4\x6a\x83\x05\xd8\x83\xc0\x26\xa8\x67\x70\x82\x91\xee\x86\xb3\xe7\xad\x34\xce\x11\x74\xaf\xe7\x84\x03\xe3\xd3\x8b\xd7\x6a\x28\xb7\x74\xcb\x02\xa0\xbc\x01\x20\x7f\x40\xe8\xa9\x7a\x1c\x1b\x92\x42\x9b\xe0\x91\xf5\x14\x6e\x42\xca\xba\x09\x29\x63\x13\xbe\x0d\x0c\xd5\x08\x77\xe4\x39\x18\x9e\x60\xf3\x04\x9f\x51\x6d\x7b\x66\xcb\x3b\x14\x6e\xcf\xac\xea\x36\xd5\x77\x26\xce\xdd\xa5\xa2\xf7\x8c\x7c\x7e\x9c\x8d\xd5\x36\xd7\x63\xd9\x54\x48\x3b\x1e\x63\xcb\xfd\x41\x67\xdf\x65\x05\x4e\x35\x8f\x94\x4b\x0a\x7c\x51\xe5\xb3\x1a\x55\xf4\x3e\x73\xa0\xa2\x96\x8c\x0b\x35\xc3\xb1\x5b\x3b\x1c\xcb\xea\x47\xdc\x07\x53\x60\x65\xd0\x36\xc8\xb4\x87\xb3\x26\x87\x73\xbf\x0c\x9c\x0a\x32\x97\x98\x8b\x75\xab\x8b\xa2\x2e\xcd\x02\xe7\xa5\xef\xd7\x38\xf8\x9d\x3f\x67\x25\x04\x07\xc2\x4d\xdb\x98\xde\xe8\x1d\x76\x1f\x13\x22\x56\xaa\x97\x71\x34\x0e\x88\x9d\xe8\x7e\x28\x41\x26\x1f\x5e\xea\xb8\x25\x8c\x62\xe0\x73\x0a\xf7\xb0\x48\xf5\x7f\x98\x44\x74\xeb\x18\x4d\xe5\x0b\xa3\x9b\x44\xfa\x91\x20\x19\xf6\x37\x82\x2f\x10\x01\xb3\x28\x04\x31\x8b\xff\xb6\xc3\x42\xdb\x51\xdc\x8e\x0b\x2e\x39\x94\xc7\x83\x12\x17\x90\x13\x6c\xe2\xc5\x2d\x13\x46\xcb\xcc\x8b\xc7\x4c\x3c\xe3\xcf\xd8\x33\xa6\xb5\x4f\xdf\x7b\xb4\xb9\x3b\x48\xc3\xa6\xee\xdd\x97\x02\x76\xff\x8d\x74\xcf\x88\xc4\xc7\x40\x47\x11\x57\x6a\xdf\xf3\xd0\xbe\xfb\x99\xe4\x8f\x6c\x3f\x0b\x98\xcc\x57\x11\xe9\x2b\xdf\xc3\xbe\xf7\xed\xf3\x61\x1f\x79\x89\x3a\xf1\x86\x5e\x98\x69\xf2\x86\x1b\x06\x6f\x8f\xaf\xd7\x96\x3e\x05\xc7\xb1\x78\x83\x45\x4d\x09\xe7\x6f\x78\x24\x2d\xec\x5d\xf2\x07\x8d\x74\xd3\x3e\x87\x5b\x57\xea\xb7\x34\x9a\xb6\xb6\xf4\x0f\x83\x22\x54\xe8\x87\x5b\x56\xe6\x37\x3f\x10\xee\xe1\x69\x62\xe8\x2c\xf5\xbc\x0c\x28\x0d\xe1\x7b\xdd\xbf\xc0\x79\xbe\xbf\x8a\xec\xfd\x4f\x8d\x1c\xf6\x60\xe8\xd4\x59\x23\x54\x16\x8b\x27\xec\x33\x8a\x13\x04\x79\x05\x33\x35\x0c\xa7\x6b\x82\xed\x35\x71\x57\xcd\x67\x7f\xa1\x98\x23\xe4\x0e\xd4\x55\xfb\x03\xca\xd4\x3d\x61\xe6\x2b\xdf\x26\xed\x90\xfd\x59\x84\x9f\xc9\x7e\x5f\x94\x1f\x62\x03\xaa\x8d\xab\xf8\x8f\xab\xfa\x4e\x48\xf5\xa5\x63\xdf\xa8\x01\x74\xfa\xc1\xff\xe3\xbb\xc3\x0f\x34\x46\xd5\x06\x38\x04\x40\xf1\xe8\x3b\xaf\xaf\x4e\xfe\xcd\xce\xbe\xad\x7e\x7e\x0e\x18\xcc\xaf\xd9\xbb\x6b\x30\x9b\xf2\xe6\xd1\x29\xc9\xc1\xa3\xfe\x3e\x28\xae\xdb\xc0\xe8\x46\xbb\x15\xbc\xde\x4b\x2e\xc9\x0a\x18\x22\x61\x18\x7d\x3f\xdf\x31\xbc\xf1\x32\x4c\x34\x13\x57\x9b\x13\xc2\x3f\xff\x4d\x8f\x87\x03\x6e\x7a\xbb\x3c\xbe\xa8\x53\x14\x0d\x23\x80\x2f\xfc\xea\x1a\xb0\x0d\x57\xff\x3d\xff\x36\x00\x51\xbf\xfa\xfd\xa0\x8f\xdf\x05\x7d\xfc\x95\xeb\xa3\x2d\x05\xe1\x4a\x4f\x24\x68\xcb\x0a\x17\x26\x03\x99\x42\xcb\x9c\xe4\xc8\x3e\x86\x6c\x79\x2d\x99\xae\x58\x7e\x47\x49\x24\xa5\x65\xd7\xb0\x66\xac\x76\x04\x2b\xb6\x8e\xed\xc8\x56\x7d\x27\x2f\xc7\x1b\xe7\x38\x30\xcc\x9f\x23\x99\xba\x65\x56\x9a\xae\xcf\x32\xb9\x2f\xba\xff\x80\xfd\x83\xcc\x7c\x71\x90\x59\xad\xf2\x83\x16\xa3\x4e\x0f\x8e\x4c\x29\x0a\x80\xe8\xe3\xb7\xdf\xb9\x56\xab\x07\x14\x75\x94\xba\x33\xb9\x5b\xa3\x2c\x5b\x86\x09\x1d\x2b\xd9\x19\x78\x30\xea\x9a\xb5\xcc\x38\x42\x23\x47\x34\xe6\xde\x0c\xa9\x0c\x79\xfb\xcf\x30\xcb\x24\x6b\x4f\xd3\xa2\x0d\x0f\xdd\x03\x24\x1e\x9a\xde\xdc\xfa\x43\xb1\xea\xbf\xb1\x82\xe5\x66\x78\x19\xbc\x9f\x21\x52\xd9\xe8\x70\x7e\x63\x44\x2d\xb0\xef\xec\x4b\xf1\x87\x51\x3b\x47\x46\x96\xb9\x25\x73\x2f\x7d\x9a\xd6\x29\x13\xa6\x57\x94\x38\xe8\x6e\x00\x6d\xcf\x6c\x8b\x8f\xce\xb3\xe3\xc2\x1c\x77\xc0\xe6\x10\x60\xb1\xaf\xd4\x86\x49\x4f\xf6\xf0\xf7\x05\x47\x4e\xc8\x80\x9d\x00\xcd\x51\x00\x34\x77\xbb\x77\xba\xe0\xc2\x92\x3e\x12\x56\x36\xcf\xb9\xd9\xda\x77\x64\x0b\x34\x35\x3f\x9c\x70\x21\xf3\x51\xa7\xef\xd6\x94\xd2\x39\x09\x5d\x59\x94\x18\x59\xbd\xc0\xda\x1f\x2c\x9f\x01\x2e\x82\x93\xa7\xb9\xf1\x84\x19\x9f\x5a\x2e\x43\x11\x3c\x1d\x1c\x5f\x7f\xed\x5c\xb0\x79\x7f\x18\x00\xf3\xa1\x0e\x66\x0b\xd3\x68\xc9\x9c\xaf\xd3\x35\xd9\x67\xaa\x21\x8e\x8f\x36\x07\x88\x26\xc1\xdb\xe6\x9e\x75\x70\xfd\x68\x98\xf9\xbd\xe0\xb8\xfd\x98\xd0\x7c\xf6\xd2\xc1\x71\xc2\x83\xaf\xeb\x99\x76\x3e\x34\xff\x2f\xd5\xdf\xff\x4b\x36\x1f\x28\xda\x46\xa9\xc8\xc5\xdd\x91\x70\xcd\xf8\x64\x3f\x81\x28\xd7\xbb\x79\x03\x68\x28\x06\xad\x19\xbc\x89\x42\xe4\x00\xf3\x67\x44\xf4\xe8\xe0\xa8\xa9\x0f\x7b\xe1\xbb\x9d\x96\x50\x45\x7e\x75\x34\x4b\x7a\x9f\x19\xb0\x32\x25\xad\x39\x1a\xdc\x03\x96\xe8\x31\xbf\x8c\x80\xd7\xd1\xff\xf7\x8f\xfe\x5e\xe4\x66\x68\x4a\xc1\x92\x3f\xfa\xaf\x2c\x25\x0b\x58\xf2\x87\x7f\x60\x29\xa1\x51\xc9\x7f\x6e\x29\x59\xa2\x92\xbf\x6f\x29\x61\x50\xc9\x7f\x6a\x29\x59\x81\x92\x5f\xff\x0b\x4b\x01\x0c\x9c\xfc\xeb\x7f\x69\x29\x58\xa3\x36\x7f\x6a\x29\xe1\x50\xc9\x7f\x66\x29\xd9\xc0\x92\x7f\xf8\xc7\x96\x92\x2d\x2a\xf9\x43\x4b\x09\x8f\x4a\xac\xf3\xdb\xa1\x92\x7f\x68\x29\x11\x50\xc9\x5f\x59\x4a\x44\x58\xf2\x0f\xfe\x0f\x4b\x89\x84\x4a\xfe\xb5\xa5\x64\x8f\x5a\xfd\x47\x96\x12\x19\xd5\xb1\xf6\xa3\xa0\x92\xff\xd3\x52\xa2\xa2\x56\x7f\x62\x29\x39\xa0\x12\xeb\x4a\x8f\xa8\xe4\x2f\x2d\x25\x27\x54\xf2\xcf\x2d\x25\x67\x54\xf2\x1f\x5b\x4a\x2e\xb0\xe4\x0f\xac\xfd\x5c\x51\xc9\x3f\xb3\x94\x44\xbe\x85\xbb\xfc\xff\x5a\x27\x1d\xf9\x7d\xb4\xf1\x7f\xf0\x4f\xad\x65\xdf\xc1\xb2\x5f\xff\x0f\xd6\xa2\x5f\xa1\xa2\xff\x55\xf7\x83\x8a\xde\xc1\x3f\x64\x9f\xeb\x83\x7e\x0b\x19\x3c\x45\x95\x35\x44\xbf\xc8\x4f\xf5\x81\xee\x77\x48\xfa\x77\x78\x80\x49\xb5\x11\xa3\x7e\xef\x54\xa4\x02\x4e\xc5\xf2\xa0\xd2\x6b\xfb\x99\xf8\xa9\x1b\x0c\xbf\x87\x8a\xfe\x2f\x6b\xd1\x47\x58\xf4\xff\xfc\x2b\x6b\xd1\xa7\x4f\xa8\x9a\x75\xe3\x01\xdf\xf5\x1e\x02\xdb\xb9\x19\xbf\xfe\x73\xd7\x66\xac\xdc\x5b\xf1\x57\xee\xad\xf8\xcb\xb0\x5b\x51\xb8\x4b\xf0\x3f\xa6\x03\xe0\xb2\xe2\x04\x41\xdf\x2e\xc7\x9a\xbf\xff\x3b\xee\x35\x7f\xff\x47\xae\x35\x7f\xff\x77\xad\x45\xbf\x40\x45\xd6\x03\xa0\x91\x9e\xef\xff\xc2\x05\x9a\xef\xff\xd4\x05\x9a\xef\xff\x17\x17\x6c\xbe\xff\x67\x2e\xd8\x7c\xff\x57\x61\x61\xd3\x0f\x58\xb7\xcc\x08\x61\x11\xe2\xfb\x7f\xea\x42\x08\x1b\x79\x33\x80\xf3\x67\x2e\xe0\xfc\xfa\xcf\xdc\xcb\xfe\x0b\xf7\xb2\xff\x77\xf7\xb2\xff\xdc\x8d\x12\x7f\x1e\x76\xd9\xbd\x7b\xcb\x7e\xa0\x29\x81\x5a\x72\x94\x60\x5f\xbf\xdf\x62\xbf\xff\xef\x43\x2d\xf6\xfb\xff\xc9\x8d\x09\xff\xb3\x1b\x13\xfe\xf2\xc7\x03\xc9\xf7\xff\x3a\x2c\x48\x1a\x01\xf7\x25\x23\xef\x3c\x21\x61\xdf\xbc\x17\x9d\x89\x3f\x79\x2d\xb6\xbb\x29\xc1\xf7\xff\x24\xec\x1a\x67\xfe\x6b\x44\xa6\x14\xce\x45\xfa\xd2\xbf\x3f\x7b\x2d\xba\xdb\x37\xf2\x97\xee\x8d\xfc\xd6\x8d\x61\x1a\x28\xfe\x55\xa8\xed\xfe\xe7\x61\x41\x51\xba\x4f\x14\x33\x01\x44\x51\x10\xe5\x13\xc3\x02\x80\xc5\x97\x94\x9b\x3a\xfe\x9e\x1b\x13\x34\xe4\xf8\x7b\x1e\x40\xfa\xe3\xd7\x12\xcc\x3f\x75\xc3\xed\x7f\x74\xc3\xed\x7f\xfb\x71\x09\xe6\xdc\x1f\x26\x8a\xe4\x01\x8a\x97\xa0\xd0\x7f\xe7\x01\x9d\xff\xd2\x4d\x31\xff\x6f\xf7\x1d\xfa\x2f\xdc\xeb\xfe\x97\xee\x75\xff\x59\xc8\x45\x6a\xd1\x0d\xee\x00\xa2\x1a\x00\x88\x13\xb3\xf4\xc1\x89\x3f\x78\x35\xc1\xf8\xeb\x7b\x89\x1a\xb1\x16\x7c\xa1\xc1\x29\x8a\x07\x52\xd8\xcf\xbd\xef\xcd\xf2\x17\x3f\xca\xcd\xf2\xc5\x45\x2f\x7c\xaf\x9a\x57\x93\xe1\xdb\x55\x83\x6c\x31\x90\x91\x08\x7c\x0e\x3e\x46\xd6\x50\xda\x1c\xa7\x0e\x4b\x4e\x8c\x2f\x18\x9e\x87\x12\x38\xed\x2f\x91\x65\x9f\x17\xe0\x65\x9b\x49\xc1\xb2\x61\x62\x29\x8c\x4e\x44\x81\x30\xff\x15\xd7\xfb\x49\xba\x81\x7e\x6d\x95\x8f\xa5\xcd\x8c\xac\xb2\xe5\xc4\x22\x59\xe7\xa8\x69\x4b\xab\x32\x2b\x64\xcd\xea\x55\x9a\xd4\x7e\x29\xa4\x88\x51\x5e\x98\xe3\x2d\xc2\xfa\x2f\x45\xf1\x87\x01\x5b\x42\xbf\x33\x4a\x2d\x59\x2a\x24\xe3\xae\x7f\xb9\x6d\x71\xb9\xcb\x5f\x66\x3b\xfe\x5a\xed\x81\x8a\xe5\xb5\x44\x57\xd8\xc3\x30\x59\x17\x6a\x95\xb3\x34\xe3\xe7\x47\x7a\x57\x97\xe8\x0b\x59\xaf\x15\x6b\xa7\x56\x71\x7b\x6a\x5f\x89\xb4\x36\x42\xa9\x6c\xb4\x6d\x8c\xea\xc5\x31\x18\x0b\x2d\xa6\x58\x6e\xd5\x5a\x13\x02\xab\x93\x63\xf0\x17\xe8\x95\x64\xeb\x85\x6d\x67\x9b\x98\xd7\x1b\xd4\x64\x24\x0e\xd6\xe9\x5d\xbd\x5f\x1b\x0c\x76\x3c\xdf\x1a\x9d\xb8\x39\x37\xe2\xe8\xd1\x6c\x96\x3a\x9d\xcf\xeb\xf5\x66\x53\xac\x56\x2a\x95\x4e\xab\x56\xec\x6f\xcb\xb0\x35\xe8\xb5\x41\xec\x3a\x62\x6c\x5e\xa7\x94\x54\x7a\x7e\x66\x85\x8d\xd0\x60\x3b\x13\xbe\xd3\x69\xd0\x2c\x99\x92\xfa\xa9\xe2\xb6\x7e\x3a\x8e\x76\xb3\x44\x66\xa7\x36\xe6\xf2\x42\x49\x49\xf5\x1e\xdb\x9e\xf4\x46\x60\x0a\x35\xa2\x57\x62\xd7\xeb\x7e\x7f\x30\x28\x54\xca\xe5\x4a\xa3\x56\x9b\x81\x7f\x22\x28\x3b\x9f\x2f\x97\x42\x45\x10\xaa\xb5\x46\x63\xcf\xb1\x2c\x2b\x82\xbf\x0b\xc5\x61\xb1\x29\x49\xf5\x76\xa7\x73\xd8\x89\x62\x2a\x95\xc9\x70\x1c\x86\x95\x6a\xcd\xe6\x62\x38\x18\x6c\x4f\x67\x7c\x3c\xdf\xc8\x32\x56\x99\x4e\xcf\xd7\xeb\x46\x00\xed\xbb\x9d\x0e\xc3\xd0\x74\x2e\x55\xef\x6d\xdb\x13\xb0\x6a\x16\x02\xa8\xc7\xce\xe6\x73\x92\x2c\x14\xe0\xb8\xe5\x46\xad\x41\x51\x33\x1a\x8e\x51\x2b\xf6\xb6\x65\x30\x37\x00\x30\x16\xc1\x92\xac\x6e\xfb\xfd\xba\xd2\x1f\x36\x95\xfe\xb5\x8d\x0d\xfa\xdd\x1c\x77\xee\x97\xae\xd3\x7e\x0b\x1b\x0f\xc7\x25\x7c\x0c\xff\x2d\xc7\xf8\x74\xb9\x9b\x4e\x97\x02\xfc\xc1\xe7\xbb\xda\x78\x71\xa8\xe2\xf3\x03\xf8\x6f\xa2\x36\x5e\xe6\x53\xe3\x75\xa5\x36\x37\x7f\x62\xd5\xe4\x2a\x9f\x84\x3f\x18\xdb\xae\xf4\xc6\x60\x3c\x12\x00\x73\xd3\x99\x2f\x36\x0d\xaa\xc6\x55\xf6\x4d\xae\x43\xd5\x8a\xeb\x1a\xa5\x10\x2c\xb9\x85\x73\x06\x75\xea\x5b\xae\x26\x6d\xf7\xed\xba\xb4\x9b\xef\xe5\xdd\x6e\xa1\xee\x38\x59\xdd\x25\x9b\x0a\x77\x6d\x2a\xec\xa5\xb4\xde\x9f\xe0\x56\x93\x68\x7b\xe1\xbf\x06\x29\xed\xf6\x73\xef\x9f\xdd\x7c\xce\xef\xc6\x2f\xfa\xe9\x55\x36\xb5\x06\x0b\xba\x07\x3f\xe7\x64\x89\x06\x3f\xdb\xfe\xb8\xb6\x3d\x27\x6b\x0a\x79\xd2\xf6\xf5\x02\x31\x1f\x4c\x61\xc4\x5d\xcb\xf4\xa6\x5f\xa5\xaf\x43\xf0\x73\x05\x3f\xe7\xea\xb2\x34\xac\xf3\xa5\x6b\x3b\x5f\x3a\x75\x0b\x04\x3e\x27\xe1\x34\x59\x80\x08\x1a\xac\x89\x56\x1f\xb4\xe9\x5f\xeb\x10\xce\x23\x2e\x39\xa4\x37\xe3\x29\x7d\x4d\x4e\x69\x2c\x39\x85\x30\x1e\xbf\xe4\xdf\xac\xaa\xed\x25\x84\x45\xa1\xb2\x9c\x4b\xf3\x3d\x4b\xb0\xd7\x6d\xa5\xa4\xc1\x5c\x1b\x75\x26\xf6\xd6\xc5\x22\x61\xe0\x24\xa8\x5b\xe3\xd6\xe9\x42\x81\xc2\xea\xf2\xf5\x3a\xdc\x76\x76\x87\x09\xbb\xef\x0f\x16\x58\xae\x52\x1f\xd7\x15\xe1\x40\xed\x66\xbb\xa4\x86\x57\x8b\x56\x6a\x9e\x4a\x03\x5c\xe3\x84\xc6\x8e\x9e\xb0\xbb\x65\x81\xa2\x73\xe9\x7a\xb1\xbe\xe7\xc5\xba\xd0\xdb\x09\xdd\x0e\xd3\x6f\x2c\xc8\x4c\x42\xc2\x24\xe9\x7a\x5d\x03\x9c\x24\xb2\x95\xca\xa4\x02\x70\x32\x0d\xca\xc4\xea\x7e\xc9\xa3\xfe\x26\xa0\x6d\x7a\xcf\xa5\xcb\x75\xf5\x7a\x15\x77\xa3\xdd\x85\xc1\x0f\xe3\xc1\x02\xe0\x6e\x3a\x9d\xbe\xe1\xf3\x7e\x9c\xa1\xaf\x4a\x29\x9d\x9a\x83\x32\x51\xa0\x76\x89\x43\xba\x30\x4e\x80\xfe\xb2\xe9\x79\xfd\x7a\xb8\xf6\x84\xb5\x05\xef\xb5\xb6\x2c\x5f\xe8\xe1\x33\x92\x40\x64\xa7\xbf\x4e\x90\x9b\x8a\x30\xab\xb1\xab\x59\xaa\x32\x5b\xf7\xd6\x12\x57\x19\x56\x85\x41\xb7\x28\x75\xd8\x16\xcd\x4a\x12\x99\x69\x6f\xb6\xf3\xc6\x7e\xd6\xee\x8d\xd6\x5b\x41\x9a\x0e\x0a\x3a\xdd\x00\xc7\xa2\x50\x2a\x95\xeb\xe0\xac\x8e\x46\xa3\xad\x79\x7e\xc1\x3f\x70\x7e\x29\x30\x26\x2b\xee\xf7\x8d\xc1\x80\xe3\xe4\x46\xa3\xd9\x6d\xb5\x14\xf0\x2f\x77\xba\x5c\x32\xd7\xe2\x15\x9c\x4e\xa5\xd5\xea\xf5\x4e\xa7\xb3\xda\xae\x37\x9a\x45\xb0\xa5\xbb\x4e\x5b\x65\x40\xc3\x4c\x2a\x3d\xa8\x80\xeb\x6c\x39\xa7\x1a\x99\x09\xe8\x5b\x94\xa4\x01\x81\xcd\x49\x9d\x56\x14\xc8\xed\xb6\x54\xaa\x54\x8c\x71\xa5\xf5\xe5\xc2\xed\x04\xb1\x56\x6b\x38\x71\x29\x57\x18\x76\x4b\xfd\x64\xdf\xf1\xd3\xcd\xf5\xc1\xd9\xe5\xc6\xe0\x07\x9c\x5f\x0e\x1f\xb6\xae\xe0\xfc\x96\xc7\xe0\xec\xe2\x73\x3e\x39\x5d\xa8\xa9\xf1\x32\x51\x9d\xae\x70\x70\x36\xf1\x14\xbe\x2a\xa7\xe6\xfc\x64\xee\xfe\x29\xe8\x74\x15\x0d\x56\xaa\x01\x58\xf4\xb4\x39\xcd\xfb\x1c\xa0\x93\x55\xa1\xd6\xee\xf4\xd8\x1d\x69\xc2\x4c\xc7\xf0\x7e\xb2\x34\x3a\xa7\x4b\xf4\x65\x5e\xda\x0e\xb2\xb5\xed\x70\x59\x53\xae\xab\x1a\x36\x8c\xb7\x30\xac\xdf\x2e\x8f\x86\xfd\xf6\xe8\x3c\x5e\x62\xfd\x31\x8e\x9d\xe7\xfc\xe8\x3a\x5f\x62\xd7\x31\x8f\xe1\x63\x1e\x9f\xce\xf9\xc4\x02\x8c\x9f\x5d\xe6\x27\x8b\x79\x3e\x19\x5f\xda\x7f\xf2\x4b\x5c\xa7\xcd\x2c\x00\x16\x5b\xbb\xee\x06\x35\x0e\xfc\x6c\x76\x9d\x14\xc6\x0d\xc8\xda\xa0\x22\x83\x89\xcc\xd1\x49\x2b\xb0\x8d\x5a\x96\xeb\xd4\x52\x9b\xc1\xbc\xb6\x9d\xcc\x6b\x3b\x66\x3e\x17\xb8\xf9\x1e\x90\x96\xac\xb4\xa7\x6a\x0d\x8d\xf6\xa0\x23\x51\xd2\xef\x33\x96\xaa\xed\x85\x1d\xf8\xe1\x6a\x32\xf8\x49\x89\xdc\x7c\x2e\x81\x36\xfb\x03\x55\x57\x2e\xfb\xac\x7c\x78\xc9\xcf\x96\x14\x2f\x05\x11\x74\x0f\xef\x81\xe5\xa5\x8e\x7e\xc6\x03\xf0\x73\xa9\x13\x74\xad\x38\xde\x96\x89\x16\x8b\xc0\x77\x2a\x96\xe8\x1e\x0e\xe8\x4c\xb6\xa6\x0c\x57\x2d\x1d\x5e\xad\x3c\x36\x6c\xe5\xc7\xd3\x7e\xb9\x7c\xba\xdd\x29\xc6\xc5\x7d\xaa\x13\xad\x41\xb6\x44\x5f\x97\xa5\xed\x68\x5c\x53\xf1\x71\x0d\x07\x3f\xea\x78\xdc\xcb\xbf\x84\x8c\xe0\x60\x4f\xb0\xeb\x00\xec\x65\x01\xee\x25\x04\xdf\xa6\xd7\x9b\xcd\xd7\x64\xa1\x41\x4b\x64\x61\xb1\xc1\x67\x85\x62\x75\x57\x27\x26\xeb\x4e\x8f\x5a\x9f\x49\xae\x24\x9d\x89\x6b\xb1\xb8\x6d\xd7\x84\x41\xaf\x37\xa0\xf3\x87\x7a\x2d\x7d\x3a\x5d\xaf\xa9\x72\x4d\x2c\x8f\xfa\x2d\x36\x85\xf1\xed\x54\x8a\x2d\xd2\x8d\xdd\xb4\x24\xf0\x1d\x62\x0d\xcf\x67\x89\x28\x97\xe0\x12\xce\x97\x62\xb5\x54\xa9\x36\x3b\x03\x7a\xc7\xf6\x5b\xa9\x73\x61\x9e\x18\x5d\x8a\xe0\x1c\xce\xbb\x03\x70\xd1\xa9\xfc\x9a\xbd\x36\x06\x93\x5d\x69\xd7\x05\x57\x2e\x28\xa3\x77\x5b\x9e\x5c\x37\x39\x29\xb1\x2d\xed\x3a\x75\xb9\x87\x0e\x6a\x15\xe2\x5d\x21\x03\xe8\x20\x39\x22\x7b\xf0\x80\x54\x6a\xad\x11\x2b\x4a\x00\x4f\x07\x80\x66\xed\xb6\xe5\x66\xa3\xc5\x8c\x68\x70\xd6\xd2\x52\xfa\x3c\xbc\x6e\xb6\xb5\xea\x6c\xd8\x68\xf5\xa8\x25\x7b\x26\x24\xa9\x0f\xca\x38\xa1\x5a\xad\x0d\x5b\x3d\x66\x42\xb3\x79\x92\x84\x8c\x50\xad\x05\x51\xa8\x48\xf4\x38\x16\x9b\x97\x46\x44\xa9\x40\x13\xa9\x2a\xb1\xbd\xa6\x5b\xd8\xe0\xdc\xe3\x07\xe7\x76\xf9\x7a\xee\xf3\xf8\xb9\xdd\xc6\xd3\x23\xec\x3a\xec\xe1\xa3\x71\x7f\x7c\x39\xb7\xc7\x38\xf8\x6f\xe2\x3c\x6f\x8f\x17\x33\x1e\x80\xb6\x3d\x5c\xf5\xc1\xa7\x76\x19\x14\x8f\xb1\x34\x2c\x1f\x03\x02\xd8\xe7\x13\xa0\x20\xd9\x9f\x8f\x71\x80\xf3\xa0\x7e\x6c\x9c\x9e\xe3\xc9\x6b\xbb\x3d\x81\x65\x4b\x54\x0f\xc7\xd3\xf3\xfc\x34\x3f\x57\xa7\x73\xd6\x1c\x63\xd8\xef\x6b\x63\x5c\x41\xbd\xec\x0c\x1f\x4d\xfb\xe3\xd1\x78\xcc\x4f\x40\xd9\x04\x9c\xa1\xc9\x6a\x39\x1e\xe7\xe7\xf9\xc1\x19\xd6\x6b\xb7\x13\x60\xdc\xf1\x62\x3e\x4e\xac\xc6\xea\x78\x3e\xcf\xa7\x40\xfd\xe9\x78\xc9\x27\x40\x3d\x30\x56\x6c\x92\x67\xca\xa9\x15\x28\xef\xaf\xd1\xb8\x78\x1e\x34\x03\xf5\x66\xcb\x39\x3f\x5b\x2c\xf1\xe4\x65\x0d\xee\x52\x7e\x2f\xee\xb9\xfd\x8e\xe3\xf6\xfb\xfd\x6e\xaf\xee\x12\x7b\x79\x8f\x2f\xc4\x03\x07\xca\xe5\x86\xb2\x63\xc0\xdf\xd7\x86\xb2\x8f\xc9\xf2\xee\x2a\xc3\x7a\x32\xa8\xaf\x70\x97\xfd\x9e\xbb\xc8\xca\xfe\x9a\xdd\x73\x57\x59\x05\x65\x87\x7d\xb2\xa1\xc8\x85\x85\xbc\x3f\xca\xea\x21\xd7\x3c\x5d\xae\xf2\x41\x28\x80\xfa\x19\x59\x39\x68\xf5\x40\x1d\xf9\x2c\x54\xe5\xc3\x86\xcc\x49\x9c\xd0\x94\xf6\x47\xd0\x6e\x97\x39\xec\xc0\xb9\x12\xaa\x2d\x75\x87\xd1\x62\x06\xf4\xc0\xc9\xcd\x93\x5c\x6c\x28\x87\xb8\x7c\xe0\x62\xd8\x72\x50\x06\x8b\x9b\x2e\xf9\x72\x72\x33\x4e\x8c\xe7\xf9\x04\xbe\x5a\xa6\xd3\x8b\x7c\x6a\x72\x59\x0c\x41\xa5\xd5\xb1\x41\x33\x87\x0c\x76\x64\xd3\xd7\x44\x8e\x3e\x2c\xe6\x6a\xb5\xd9\x3e\x54\xb2\x31\x35\x96\x5b\x5c\x1b\xd7\xfc\xba\x53\xcb\xf0\xf3\xf4\x3e\xb1\xa0\xf6\x7b\x79\x7e\xd8\xef\x63\x87\xcb\x82\x3b\x16\xe5\x8e\x5a\x00\x70\xce\x4f\xe7\x63\x3e\x79\x5d\x2e\x27\xfd\xe5\x21\x79\x65\x3a\x00\x7e\x6d\x00\x83\xfd\x01\xcc\xe5\x08\xe7\xb2\xa9\xd2\xe7\x1d\xe8\xfb\x4a\xd0\xd3\xa5\x92\xd8\xf0\xc7\xa9\xb0\x68\xa8\x85\x24\xbe\xb9\x74\xe9\xfa\x35\x71\x5c\x80\xb5\x65\x3b\x87\x6e\x62\x49\x75\x8e\x45\x42\xa8\x6e\x4e\x10\x9f\x4b\x08\x9f\x4b\x39\x89\x10\x07\x25\x99\x10\x89\x14\x20\x1e\x95\x1a\x43\x8f\x46\x67\x62\x7f\x06\x47\xb4\x54\x6e\xee\x6b\x8d\xfe\xa8\xd5\x9b\xad\x69\x2c\xdd\x38\xf7\x2f\xa3\x51\x49\xda\xd7\xe6\xc3\x51\x43\x99\xb1\xe3\x5a\xba\x7e\xa9\x5f\x86\xa5\xad\x24\x36\xe6\xc3\xc1\x88\x65\xd6\xd2\x98\x6c\x50\x99\xca\x08\xe7\xeb\xb2\x42\x2d\x46\xb3\x03\xc6\x4a\xe4\xbc\x41\x0d\x12\x23\x8c\x97\xf6\x7b\x7a\x31\x9a\x88\xa7\xfe\xec\x4c\x5c\xd2\x99\xfe\xf6\xba\xdd\xd6\xa5\x45\x6f\x32\xd8\x6d\x55\x38\x46\x3a\x53\xd8\x02\x36\xb7\x21\x51\xbd\xd1\x00\x13\xd5\xb1\x72\xae\x5f\xa8\xc2\x06\xdb\x96\x6b\x7b\xaa\x37\x18\x61\x3b\x49\x45\x63\x4c\xb6\xe5\xad\xda\x68\x2d\x66\xa3\x49\x22\x75\xc6\xd7\x67\x38\xc6\xb6\xc4\xab\xf5\x06\x0d\x2e\xb5\x04\xa6\x9e\x25\x71\x73\xe6\x48\x30\x86\x50\x17\xea\xad\x01\x1c\x83\x6f\xd7\xa9\x34\xe0\xc5\xe1\x18\xbb\x59\x07\x1c\xff\xbd\xca\xcf\xeb\x83\x2b\x58\x07\x1a\x63\xd6\xe9\x0c\xb6\x5b\x49\x95\xea\x8d\x61\x03\xac\x63\x2b\x81\xa5\x8e\x01\x75\x00\xfc\xb0\x74\x1e\x2c\x8a\x13\xb8\x0e\x30\x06\x05\xfa\x3b\xa9\x67\x5e\x5a\x0c\x9b\x63\xbc\x54\x16\x9b\xda\x3a\x76\x2a\x40\x7c\x99\xda\x27\x2b\x00\x56\x72\x9d\x1a\xcd\x06\xd8\xfe\xac\xf6\x01\xbf\xbe\xa9\xee\x2a\x5b\x49\x92\xc1\x3a\x26\x60\x1d\xe7\x3e\x60\x50\xb9\x64\x65\xc7\xb7\xe5\x36\xb5\x98\x50\xbb\xd4\x39\xbd\x96\x16\x8b\x61\x72\xb2\x13\xda\x0d\x79\xb9\x18\x8d\x44\xb8\x0e\x69\xb3\x58\x24\xc7\x3b\xe1\x78\xac\x35\x34\x58\x81\x75\x70\x14\x68\x8b\xef\x1a\xaa\x38\x1f\x4e\x06\xdc\xfe\x0c\xd6\x41\x2d\x00\xed\x4c\xf0\xfb\x56\x07\xec\xc7\x6c\x0b\xc6\x00\x6b\xdb\x14\xe0\x18\xaa\x72\xa0\x28\x30\xc6\xe9\x9c\x06\x9c\x00\x97\x9d\xee\x12\x42\x5b\x69\xaf\xc0\x7e\xec\x4f\x70\x8c\xe1\x20\x3b\x9d\x4c\x84\x4e\xf7\xb0\x1a\x4f\xc0\x03\x07\xe0\x48\x8b\xe8\xd5\x88\xfa\x9c\x02\x7c\x7c\x7d\x44\x20\x9a\x57\xaa\xd4\x46\x4a\xba\x4e\xf5\xc9\xcb\xb5\xb8\xdd\x17\x46\xcd\x56\x67\xc0\x48\xf4\xf6\x5c\x68\x0c\xf8\xf1\xb5\x54\xaa\xd5\x17\x53\x08\x44\xe9\xbc\x9c\x01\xd6\x6f\xc2\x97\xb7\xca\xbe\x49\x8f\x27\xd4\x05\xc0\x15\xc0\xa1\x09\xba\x11\x75\x9a\x47\x6e\x13\xf5\x73\xaf\xd4\x00\xf7\x53\x1d\xbc\x24\x60\x19\xa0\xaa\x00\xe1\x88\xd9\x5c\xea\x73\x45\x8c\xe4\xb6\xe5\x4a\xab\x57\xdb\x8f\x66\x10\x3f\xa4\x7e\x7f\x03\xf7\xb3\x52\x6d\x75\x7a\x9c\x38\x9f\xe5\xd2\x64\xa3\xb0\x2b\x8d\x4a\xe2\xbe\x31\x1b\xf5\xc0\xde\xf1\x52\x0f\x32\x08\x22\x0d\x2f\xd9\x45\xbb\x30\x9a\x90\x17\x16\xab\x13\x63\x62\x04\xfe\x0f\xbc\xd3\xe0\x08\xad\xd9\x8c\x5d\x96\x7b\x97\xc6\xba\x52\x1a\x96\x44\x61\x3f\x2b\xf5\x26\xec\x41\x5d\x2e\xe7\x03\x2a\x33\x2d\x5f\xcb\xd2\x5e\x5e\x0c\x67\x3d\x82\x53\xd7\xcb\xd9\x65\x70\x99\x56\xf0\xb2\xb4\x93\xe9\xc1\x6c\xc2\x29\x04\xd1\xb9\x82\xbb\xbc\xa8\x9c\x4a\x35\x11\xf0\xbf\x3c\x51\x07\xf7\x43\x09\xf0\xbf\x67\x30\xbf\x0d\x2f\x94\x2a\x00\x2e\x0c\x4b\xb7\x09\x16\xec\xe9\xa5\x54\xe4\xe4\x8a\x50\x03\x73\x66\xd9\x31\x9c\x33\x78\x2b\x95\x00\xfc\x20\xaf\xd3\xeb\x1d\x66\xf4\x1a\xbe\x9f\x1a\x95\x22\x00\x5f\x4d\x02\x7b\xd7\xeb\x01\xfe\xa7\x5c\x6f\x64\x06\x80\x3f\x2c\xd5\x24\x69\x0a\xea\xb1\xdc\x9a\x2f\xd7\xe0\x95\xb1\x47\x8b\x6b\x92\xfd\x79\xb5\x53\xd8\x52\x24\xbc\xef\x0a\x44\x75\x8b\xee\xbb\xc1\x66\x5b\x15\xe7\xfd\x0a\x38\x07\x2c\x5f\xaf\x0f\xe0\x9c\x4a\x25\xa9\xbe\x9f\x02\xc8\x72\x60\x5f\xc6\xb3\x34\x09\xce\x28\x5f\x96\x95\x5a\x7d\x08\x71\x1b\x5f\xae\xc1\x99\x1a\x24\x2b\x93\x2d\xc0\x3b\x7a\x3c\xa3\x32\xa9\xb5\xb4\x9c\x73\x72\x71\x3a\xc5\xcb\xfb\x16\xe0\x29\x29\x0a\x9c\x47\x80\xef\xf2\x75\x01\xc6\x69\x9f\xca\xe4\xa9\x70\x22\xce\x6a\xb9\xcd\xd1\xb3\xc2\x40\xc4\xe6\x85\x11\xb1\x25\x68\x82\x2d\x14\xc0\x9e\x75\x20\x8c\x47\x33\x51\x3c\x03\x9e\xb9\x58\x28\x08\xe5\x1d\xe0\xa3\x1b\xd4\x6c\xa6\xf1\xd1\x90\xb7\x83\x3c\x38\xa8\xa7\xf3\xd6\x67\x69\x8d\xf8\xf2\xaa\xc1\x6f\xa7\xec\xbc\xba\x9d\x07\x07\x73\x28\x48\x27\x78\x7d\x2a\xe5\xf2\x96\x4e\x35\xfa\x62\x09\xf2\x91\x35\x62\x0f\x79\x57\xb2\x3c\x99\x89\xf0\xad\xcd\x2e\x0a\xfa\x5b\xbb\x05\x8e\xa2\x56\xb6\xde\x2c\x8c\x32\x1a\x4c\x48\xb4\xbf\xc9\x5b\xf4\xcc\xf5\x4e\x0f\x55\x56\x56\x65\x79\x01\xce\xd0\xee\xa0\x42\xbe\x9b\xca\x24\x70\x1c\xc0\x4b\x06\x68\x7d\x3c\x89\x55\xa2\x9b\xab\x56\x70\x6d\xc7\x0a\x97\x13\x82\x57\x09\x90\x5d\x82\xdc\x14\x77\xd5\x4e\xa7\xd3\x9b\x2d\xd7\xb5\x99\x94\x69\x0c\x4a\x10\x35\xc4\x51\xbb\xd1\x19\xb0\xfc\xba\x4c\x36\xc0\xd9\x02\xcf\x27\x09\xd0\xc5\xc1\x68\xb2\xdd\xcf\x69\xbc\x3e\x68\x70\x13\x9e\xe7\x21\x8d\x1e\x82\x32\x11\x8d\x49\x5e\x26\x3c\xa0\xbd\x7b\x38\x8f\x0e\xcb\xf1\x6a\xbb\x8f\xe6\x91\x28\x01\xc4\xa1\xc0\xdc\x44\x05\xec\x21\xd9\x18\xec\x41\x59\xa5\xd1\x6a\xcf\xe6\x83\x11\xb8\x62\xcf\xcb\x35\xd8\xeb\xca\x16\xe3\xeb\x4a\x13\xae\x2b\x73\x59\xf3\x6d\xb0\x86\x2b\x5c\xab\xa2\x36\x40\x7f\x33\xf1\x82\xc3\xfd\x1f\x50\x3b\x1c\xd0\x9d\x66\x1b\x8c\x57\x38\x15\xd9\x85\x00\xee\x93\x12\x77\xa2\x67\xd4\x58\x6a\x76\x0b\x18\xa3\xe3\x63\xa1\x04\x1e\xb7\xfd\x82\xb6\xcf\xe0\x9d\xc2\xb2\xeb\x11\xc4\x87\x4c\x01\xe1\x79\x6d\xd4\x07\xfc\xd2\x8c\x5e\x6a\xb2\x83\x12\x80\x21\x78\x7f\x68\xf5\xd6\x75\x02\x9c\x9b\x33\x56\xd2\xde\x03\xbd\xce\x81\x36\xce\x48\x69\xb4\x95\x01\x8e\xf4\x50\x3d\xbe\x4c\x16\xf4\x33\x52\x13\x67\xe0\xed\xc4\x6d\x97\xb0\x5e\x03\xd5\x13\xa5\x3a\x3c\x5f\xec\x8e\xe7\xc1\x59\x6a\x50\x13\xbd\x5e\x0f\xd4\x03\x65\xa8\x3f\xfd\x6d\x34\xef\x0d\xb4\xfe\x50\x3d\x50\x06\xea\xcd\xfb\x3d\x54\x0f\x94\xc1\xf9\x69\xf5\xc0\x18\xec\x76\x8d\xf6\x84\xd2\xda\xd6\x8c\xfe\x50\xdb\x11\x3c\x83\x45\x12\x8a\x0e\x0a\x5d\xb1\xde\xe8\x36\x6a\xbb\x13\x96\x02\x2c\xf8\x08\xbc\xf7\x07\x6c\x01\x50\x56\x82\xa0\x97\xeb\x7d\xe6\x22\x4c\x2e\x85\x62\x59\xa8\x0d\x26\x83\xd4\xa0\x07\x16\xc8\x35\xd7\xe5\x01\xb7\xe1\xab\xca\x64\x5c\xab\xd0\xa3\x19\x3e\xef\x9d\x01\xcd\x1b\x8e\x76\x47\x51\x00\xfb\x35\x1a\xc5\x96\x33\x4c\x3c\x9f\x32\x97\xd1\x78\x2b\x8a\xdb\x59\x73\x40\xef\x2f\xf8\x52\x39\x41\x39\x19\xe8\x9d\x38\x95\x0a\xa5\x2b\x3b\x02\x27\x80\xdc\xd4\xe0\x5c\xd6\x44\x4f\x22\x1a\x85\xd3\xa9\x4d\x9c\xa7\xe0\x75\x90\x26\x6b\x97\x02\x7b\x6a\x9c\x53\xfd\x2b\xb6\xdb\x02\x22\x5c\x28\x5d\x88\x4b\x9f\x2b\xb3\xad\xd2\xba\xb3\x67\xa9\x3a\xd7\x61\xc9\xd9\xb4\x52\xac\x91\x29\xa2\xb5\x99\x25\x30\xee\x74\x14\x5b\x0a\x51\x9e\x2e\xcf\x78\xad\xd6\x63\xc9\x42\xa5\x06\xe8\x36\x00\x13\xd8\xcb\x44\x1a\x70\xdf\x78\xf7\xd0\x2a\xd5\x3b\xdb\x09\x5f\x64\xb5\x07\x5a\x0a\x8a\xef\x00\xe3\xbc\x65\x31\xb6\xc6\x35\xa8\xce\x2c\xd5\xd9\x9c\x62\xbd\x52\xbd\xd4\xdf\xae\x1b\xc3\x52\x5a\x97\xab\x34\x01\x51\x26\x72\x60\xf2\xbd\x32\x57\xe3\x6a\x73\x31\x03\xaa\xb5\x8a\x66\x35\x02\xd0\x57\xcb\xbf\x02\xa1\x0f\x40\x68\x6f\xa9\x02\xb1\xee\xce\x13\xcb\x21\x20\xc8\xb3\xce\x49\xff\xd2\xa9\x48\x8b\xad\x88\x9e\x2f\x3b\x3a\x9b\x2a\xf6\xd9\x4b\x7b\xd3\xca\xe4\x87\xc4\xb5\x33\xdc\x66\x53\x4b\xa1\x79\x58\x30\x27\x65\x41\xb1\xbb\x39\xd7\x96\x52\x4b\xac\xb1\x50\x4a\xe7\xc1\x2c\x5e\x4b\x4e\x47\xc3\x53\x9d\xdd\x32\xf3\xa5\x80\x57\x67\xa9\xab\xb8\x49\x26\xe3\xfb\x7c\xb1\xac\x1e\x93\x23\x0e\x8f\x6f\x76\xe7\x2c\x78\xb6\xaa\x5d\x15\xcf\xc5\x95\xd9\x2a\xdf\xda\xb2\x84\x42\x54\xfa\xe4\x32\xc9\xb6\x2a\xdd\x94\x32\x8a\xed\x0b\xdd\x19\x79\xc9\x8f\x65\x22\x39\xdb\xd7\x45\x65\x92\x9c\x24\xe2\xcc\x0a\xe7\x3a\xf1\x43\x92\x66\x73\xf3\x4a\x3c\x9d\xdd\x8c\x62\x52\x77\xb7\xa8\x94\xea\x23\x76\xbb\x12\xa8\x5a\x32\x5f\x5c\x74\xf7\x67\x70\x0d\x49\xeb\x45\xab\xbf\x2b\xd0\x69\xb9\xc1\x6f\x47\xad\xed\xe5\x9c\xe3\x6a\xe2\x81\x4d\x2f\xeb\x47\xbc\x33\xab\xe6\xa9\x74\x9e\x8e\x25\x2b\xcc\x71\x4f\x67\xe7\x89\x69\x79\x59\xdc\x34\xe3\xfb\xea\x55\xed\xaf\x36\x93\xd2\xe4\x5c\x8f\x53\x5c\x85\xb9\x6e\xc4\xf5\x21\x5f\x55\x0a\xf3\x65\x8f\x6c\x2c\xb8\x2a\x36\x56\x47\xf1\xe4\x2e\x97\xe6\x8b\x95\xc4\x30\x1e\x3b\x6f\xe7\xf4\x4c\x98\xc6\x4a\x31\x6a\x7f\x3d\xc6\xcf\x43\xb6\x23\x0e\xcb\x95\xd8\x39\x3b\x68\xef\x7b\xa9\x76\x4c\x04\x04\x6a\x70\x3e\x8c\xa7\xa7\x6c\xb6\xbe\x4c\x2f\x76\xbb\xe1\xee\x50\xa7\xb6\xd3\x6c\x1a\xcb\x57\xab\xd8\xb6\x32\x6b\x1c\x89\x7e\x57\x3a\xad\x3a\x03\xa2\x7b\x19\x74\x09\x3a\x51\xda\x0a\x4c\xf1\x44\x26\xaa\x60\x3f\xea\x7c\xaf\x38\x6b\xa4\xd3\xb9\x4c\x3b\x9b\xaf\xd4\x0b\x9b\x73\x2a\x26\x65\xa8\xe2\x68\x99\xc8\x4e\x07\xc7\xfe\x65\xda\xac\x03\x3c\x4a\xcf\xe6\xf8\xa6\x79\x4c\xf3\x52\x67\x3d\x1f\x5e\x31\x25\x56\xdc\x09\x65\x7c\xc9\x49\xaa\x2c\xb4\x32\x23\x80\x56\x13\x1c\x9f\xae\x73\x74\x75\xb8\xc4\xe2\x83\xdd\x82\xed\x10\xcb\x33\x7d\x99\x9c\x5a\xf0\x2d\x26\xcf\x93\xab\xf6\x60\x35\x07\x8c\x35\x37\x93\x1b\xa9\x98\xda\xdd\xab\x42\x6b\xdc\xec\x30\x93\x4e\x25\xb1\x1d\x1f\x47\xb3\x98\x94\xe8\xd6\xe3\xeb\x6d\x81\x4f\x53\xe5\x7e\x27\x35\x6c\x56\x66\xdd\x39\x5d\x1b\x25\x8f\xb3\x16\xbe\x16\xd7\x83\xd4\xa6\x5a\xe6\x85\xcb\x24\x9e\x18\xa6\xeb\xd9\x6d\x45\x19\xac\xa7\x93\x61\xae\x8d\xa5\xc7\xb1\x64\x21\x5e\xe9\xee\xeb\x78\x6f\x09\x58\x80\x4a\xf7\x5c\xba\x56\xc1\x9d\x3a\x20\xbb\x35\x4e\x98\x14\x47\x6a\xb6\xc6\xd4\x8f\xf1\x55\xba\xa3\x5e\xc9\xb3\x34\xbc\xce\xe7\xbd\x58\x61\x56\x5a\x57\xa7\xab\x7d\x25\x45\x11\x64\x8f\xe7\xb2\x89\x4a\x7d\xdd\x4e\x89\xf5\x63\x6c\x3d\xa5\x09\x89\xa8\x43\xee\x7b\x92\xab\x24\x64\x8a\x98\xa6\xda\x23\x9a\x20\x85\xb1\x9a\xcd\x16\xae\x73\x82\x8c\x35\x95\x2a\x3d\x66\x62\xbd\xd4\x3a\xd6\x23\xf1\xd3\x3a\x3e\x97\xeb\xc3\x2e\xe0\xce\x97\xb5\xdc\x8e\x1d\x32\xc4\x72\xa8\xf4\x3a\xe0\x19\x5c\x12\xc0\x73\x12\xd4\xed\xae\xcf\x83\x7e\x7f\xb6\x6d\x4c\xe6\xf5\x69\xa7\x3c\xeb\x66\xc6\x29\xa2\x24\x73\x52\x5d\x2c\x6d\xa6\x2c\x91\x38\xd5\x01\xd3\x30\x28\x9d\x77\xf5\xe2\x2e\x57\xc6\x88\x0c\x5b\x9a\x2a\x6c\x9a\x4a\xcd\x15\xa2\x59\x53\xe5\x16\x99\x8d\xad\xd6\xd9\x51\xa3\x79\x9c\x4d\x06\x4b\x55\x29\x9e\xca\x2b\x7c\x77\x9d\x5d\x07\x78\x39\x97\x68\xe3\xeb\x44\xe7\xa2\x26\x98\x6c\x86\x04\x80\x18\x60\x03\x42\x01\x0f\xd7\x66\xb7\xd5\x61\xeb\x83\x7c\xbd\x5f\xc8\xae\xcb\x53\x22\xb3\x1d\x29\xcd\x72\xbb\x51\x4c\xd3\x44\x9a\x1e\xf6\x8f\xec\x60\xdf\xa5\x93\x65\x3e\x9f\x3f\x4f\x92\xbd\x21\x17\x6f\x55\xfb\xb9\x62\x69\x97\x99\x08\x47\x6a\xdc\x23\x86\xa7\xfe\xbe\xdf\xd8\x5c\x4e\xbd\x3c\x29\xcb\xeb\x03\xdb\x4f\x10\x8d\xa1\xd2\xed\x31\x3d\x7a\x2b\x13\x1d\xb6\xd8\x59\x2f\x86\xa5\xc1\x45\x9e\xf6\xd2\x58\x61\xdb\x96\xa6\x4b\x75\xb2\x99\x64\xc1\xa9\x4a\x8e\x36\xcc\x34\x39\xca\xa7\xd9\xda\x92\xe2\x57\x7d\xf0\x98\xd9\xed\x47\xe0\x46\x92\xd2\xc9\x75\x61\x49\x17\x4e\x3c\x9b\xca\xae\xe8\xc5\xea\xca\xef\x7a\x2d\x82\xa5\xc8\xed\x3a\x4b\xc7\x56\x74\x91\x2d\xc7\xb8\xd5\xa9\x38\x5d\x5d\x19\xb0\x06\xba\x39\xab\x03\xde\xae\xde\x23\xe4\xe1\x74\x23\xac\xe3\x87\x69\xe5\x92\x3c\xe2\xd5\x6d\x52\x1a\x67\xe2\x49\x35\x2d\x1f\x26\x39\x7c\x95\x91\xe4\xd5\xa2\x95\xe8\x25\x27\xed\xf1\x25\x9f\x23\x4f\x23\x75\x51\x38\xad\xcb\x6b\x62\x72\xe8\x32\xc7\x4c\x87\x89\xa7\xe6\x54\xb9\x37\xa2\xd9\x86\x3c\x56\x52\x4b\x66\x75\x5d\xe3\x43\x40\xf9\xf1\x62\x69\x9d\x06\xbc\x3c\x5f\xa4\x4a\x85\x53\x7a\x11\x5b\x74\x57\xdb\x86\xa8\x24\xd5\x1a\x71\x60\x92\xc7\xfa\xbc\xc3\xad\x93\x2c\x95\xe4\x33\x9d\x64\x77\xb0\xaa\x6d\xc1\x3b\x0f\xeb\x12\x83\x5c\xa6\xdb\x5d\x36\xb3\x6c\x4e\xa4\x13\x0d\x95\x69\x97\x1b\x8d\x6b\xa3\xcf\xac\x3b\xc7\x04\x5b\x17\xcb\x4d\xb2\x3b\xdd\x51\xd3\x63\x97\xec\xd5\x9a\xa0\x40\xc0\x16\x8b\x09\xa9\x5c\xe5\xd9\x7c\x36\x3b\x95\xb2\x1b\x3e\xb1\x89\x1d\x87\xcb\x51\x76\x2c\x6d\x13\x78\x93\x1b\x61\x3d\x6a\xd3\xdd\x5e\xc0\xf9\xac\xee\x17\xa3\x02\x16\x9f\x29\xb3\xce\x72\x91\x62\x32\x64\x47\xca\x96\x30\xba\xc0\x61\x29\x42\x8c\x0f\xd9\x62\x6e\x3e\x26\x5a\xdc\x32\xb3\x8e\x13\xb9\x1a\x43\xb6\xb7\x52\x26\x7e\x06\x70\x2a\xcc\x4b\xcd\xd2\xac\xb6\x8d\x5d\x8e\xb5\x4c\xef\x8a\xb7\xdb\x19\xbe\xb6\x52\xc9\x78\xa6\xd6\x02\x5b\x53\x6f\x77\xf6\xdd\x61\x8b\x59\x12\xbb\x0b\xb5\xc9\x94\x14\xac\x47\x6e\xf9\xba\xc4\x0d\x26\x93\xaa\xd0\x4b\x14\xc4\xd9\xa2\x32\x21\x76\x6a\x0c\x63\x9b\x3d\xb2\xdc\x28\x90\x82\x94\xe8\x8d\x46\xbd\x79\x1c\x57\xd7\xea\xbc\x48\xd6\x47\x12\xe0\xe3\x72\x89\x6b\x32\x25\xd2\xa2\x4c\x66\x12\xe2\x34\xbb\x27\xd3\x17\x4c\xec\x12\xe0\xa8\x89\xe7\x6a\x22\x7d\x1a\xef\xd8\x5a\x75\x95\x38\x97\x3b\x72\x8e\x67\xe6\x34\xb9\xc7\x1b\xe7\xe4\xfc\x58\xd8\xe7\x49\x96\xca\x24\xf3\xc4\xf9\x50\x3d\x74\xdb\xcd\x78\x16\xb0\x25\xe5\x54\xe9\x72\xaa\x4b\xfb\x6a\x99\x98\x02\x4e\x1f\x53\x36\xe3\xc5\x81\x68\x83\xc7\xd5\xb2\x49\xcb\x87\xd5\xec\xd4\x69\xc5\xb9\x22\x95\xe1\x88\x54\x26\x47\xcc\x00\xf9\xcb\xec\xda\x64\x6b\x4a\xb3\xc3\x62\xae\x3a\xe8\xef\xeb\xa9\xe3\x29\x57\xd8\x10\x7c\xa1\xd4\x05\xb7\xcf\x80\x9f\xc6\x89\xee\xa9\xdb\xa9\x37\xf8\xfc\x19\xde\x69\xdd\x9d\xc0\x1c\x13\xcc\x44\x4a\x26\xa7\xec\xe5\x94\x2c\x1e\x57\xd7\xcc\xee\x52\xd9\x26\xc5\x4b\x37\x37\x1b\x36\x94\x42\xe7\x78\x05\x0f\xac\x66\x8f\xc3\x44\x9c\xce\xb4\xaf\x4a\xa2\x93\xe8\xb2\x89\x75\x8a\x28\xd4\xea\xe0\x4b\xa5\x8b\x51\x6d\x21\x7d\xc6\xc8\x22\x3b\x5e\x55\xb3\x87\xc4\x50\xbd\xac\x8a\x74\xbf\x76\x98\x11\x97\xde\x9a\x2c\xc7\xf9\xe3\xa0\x4f\xa8\x2a\x4b\x64\x86\xdd\xc5\x64\x4e\xe4\x59\x6a\x24\xcf\x27\xc4\x08\xdc\x8a\xb1\xe2\x39\x95\xed\x25\xe3\x72\x2e\x5b\x3d\x8f\x46\xfb\xf9\x8e\xc4\xc8\xdd\xe8\xd0\xe2\xc5\x4d\x79\x53\x55\x71\xb6\x78\x10\x84\xe3\x61\x9a\x6b\xd5\x76\xca\x66\xb0\x4f\x4f\xb6\xd7\xc1\xb5\x32\xc8\x24\x3a\xa5\x1a\xdf\x39\xac\x26\x13\xe6\x7a\x9e\x48\xc7\x4c\x19\xbc\x98\xd8\xfa\x98\x57\x57\xd3\xf2\x4c\x6d\x13\x84\xb0\x1f\x61\xe7\x9e\x58\xc4\x66\x4d\x6e\x2a\xa6\xe9\x72\xba\x37\x4e\x15\x84\x19\xae\x92\x4d\x62\x3b\x2f\x74\xc0\xd3\x6e\xbd\xd8\xc6\x89\x56\x2c\x4e\x9c\x06\x85\xc2\x92\x9f\x40\x6d\x1f\x5d\xde\x0c\xb2\xe2\x69\x91\xab\x8c\x8b\xd7\x23\x53\xe4\xe6\xc7\xab\xb0\x50\x13\x6a\x39\xb5\x68\xa6\xc5\x65\x1b\x9f\x35\xf8\x5c\x6e\x08\x6e\x75\xb2\x30\x3f\x1e\x56\xfb\xfc\xac\x30\x24\xd5\xd6\x69\x4c\xf4\xc0\xa2\x4e\x15\xb5\xcd\xab\xd7\x01\x55\x3d\x12\xc5\x59\x21\x39\x3c\x37\xc7\x9b\x3d\xd7\x27\x62\x9d\x39\xd1\x5a\x5f\xe9\x7d\x89\xed\xd3\x44\xe7\x24\x16\xd8\xe3\x72\x78\x56\x86\xd5\x66\xaf\x54\xce\xc4\xc4\xce\x22\x11\xeb\x12\xb5\x1c\xcd\x75\x7b\xf4\x94\xcd\x4a\x9d\x59\xac\xb9\x39\xf7\x06\xc7\xc4\x6a\xb3\x2b\x1f\x36\xc9\x14\x5b\x39\x5d\x93\x38\x16\x5f\x54\x1a\x99\x6b\xf2\xcc\x0e\x73\xb9\x2c\x23\xee\x26\xa3\x66\x89\x2a\x2e\xb1\xd4\xa9\xca\xa8\xb3\xf3\x72\x43\xb3\xe3\xc4\xe0\x7c\x1c\x9c\x04\x6c\x2c\xce\xa7\xf5\xfe\x7e\xdb\x2f\xa4\x89\x41\x2f\xbe\x3b\x8c\x45\x62\xa4\x4c\xb3\x50\x62\xdc\x22\xdb\xd4\x18\x9c\x2d\x9e\x20\xfb\xe7\x61\x1c\xef\xee\x56\xfb\xe6\x7e\xd0\x6f\x17\x17\xeb\xe9\x02\xcb\x2e\x18\x59\x22\x93\x8b\x7c\x6a\x23\x1d\x26\xa5\xf9\x86\x2c\x14\x13\x1d\x7a\x39\x2d\x4b\x85\x46\x89\x2d\x14\x57\x74\xb9\xdb\x3a\xe5\xa0\xa2\x71\x74\x1a\xa6\x78\x61\xb4\x4b\x63\xfc\xee\x24\x4b\xad\xd6\xba\xdb\xab\x35\x36\x99\x03\x56\xca\xad\xe4\xc4\xb1\x42\x0a\x6c\xab\xbd\xac\xf1\xf2\x5c\xe1\xd8\x0d\xb5\xe6\x37\x87\x65\x86\x28\x8f\xd9\x98\x7a\x9d\x9e\x46\xc2\xb4\x99\x1c\xd6\x9b\x12\xb5\xa1\x26\x0d\x22\x35\x46\x9a\x57\xae\xbc\xda\xd7\xd9\x06\x31\xc7\x72\x53\x59\x1d\x24\x97\x67\xb5\xd7\x9e\xd1\x52\xf7\x38\x2b\x55\x72\x05\xfe\x28\xf7\xfa\x75\x96\x64\xa4\xf5\x49\x68\x4f\xea\x1b\xb5\xb2\xdf\x49\x99\x71\xa9\x3b\x38\x92\x4c\xb6\x44\x72\xa3\xe4\x9e\xad\x2f\x7a\xc4\xa9\x98\x6a\x32\xb9\x26\xd1\x2a\xb6\xd6\xd5\x05\xc0\x0e\x82\x67\x1b\x31\xb5\xac\xa4\x3b\xb1\xd9\xa5\x4e\xe7\x2f\x05\xa1\x4e\x25\xe5\x73\xb3\x7b\xe0\x2b\xc2\xf9\xa4\x4c\xc7\xf9\xb2\xb2\x4d\x6c\x53\x5d\x4e\x01\x6f\xd6\x42\xae\xbc\x3d\x01\x0e\x28\xcf\xd6\x91\x5c\x40\xb8\x6c\x95\x0d\x56\x58\x96\x5b\xf3\x66\x93\x15\xaf\xb9\x56\xac\x7c\xbe\x72\xa7\x19\xc9\xd6\xce\xb1\x6b\x95\x2b\x40\xe5\x1e\x78\xc2\xb4\xa5\x32\xae\x74\xf2\x75\x69\x4c\x5f\x4a\x5c\x9e\x92\xf1\xf4\x8c\xaf\xb2\xf2\x41\x4d\xaf\xba\x75\x61\xbb\xac\x67\x8e\xa5\xd3\xbc\x7b\x21\xaa\x3d\xb2\x56\x2a\x8e\x64\xbe\x05\x95\xc9\xcb\x44\x83\x18\x60\xbd\x7d\xac\x7e\xea\x2b\xa5\x14\x51\x9f\xab\x2d\x81\x28\x66\x2a\x42\x67\x76\x9a\xf1\x9d\xda\xfc\xa8\x5e\x47\xed\xe5\x46\x66\xc8\xec\x8a\x6b\x6d\x27\x35\xac\xb0\x23\xc9\x0c\xe0\xa5\x1b\x74\x32\x47\xe4\x45\xa5\x54\xe6\x87\x83\x4a\xa9\x40\xc6\xe8\x05\xd3\x2f\x16\x30\xaa\x2f\x35\xab\xfb\x76\x57\xe2\xe9\x46\x3c\x9b\x69\x9e\x85\x84\x2c\xed\xf6\x97\xa9\x32\xab\x4f\xfa\x5c\x1c\xea\x78\x89\x06\xd9\xdb\xe6\x5b\x05\xa2\xc3\x2d\x64\x28\x4c\x29\x16\x8a\xca\x5e\x12\xc5\xee\x41\x5d\xc6\xb0\x74\x81\xc9\xb3\x19\xae\xb4\xe4\x66\x53\x76\xbc\xeb\x11\xb5\x54\x2c\x75\x52\xb6\x25\xb2\xc4\x91\xbc\xd8\xee\x65\x1a\x1c\x16\x6f\x0c\x7b\x58\x6f\x33\x59\x6d\x00\x4c\x62\x04\x73\x98\x36\xc4\xd6\xa6\x34\x5e\xf5\x3a\xf3\xab\x8c\x5d\xf2\x29\x7c\xdf\x64\x5b\x09\xa2\x74\x3e\x2e\xe6\x9d\x3d\x7d\x9e\x67\xea\x99\xad\xcc\xcb\x9b\xd4\x25\xb1\xc9\x13\x4b\xb6\x51\x3c\x83\xb5\xd6\x95\x71\x73\xbd\xa0\x53\x89\xc3\x3e\x9d\x4b\xc5\xc4\xc3\x60\xd9\x26\x25\xb1\xc0\x15\xc6\x55\xf9\x1a\x9b\x5c\x47\x44\xbc\x58\x54\x8b\x39\x62\xc8\x12\xc9\x35\xc6\x83\x79\x13\x57\xba\x7c\x8c\x0d\x62\xab\xc1\xa0\xbd\x65\x66\x13\x7e\x28\x2d\x92\xa9\xdd\x2a\xd1\x5f\xed\xf6\xfb\x3a\xd3\x62\xb6\xfc\xba\x4a\xac\x0e\x59\x70\x12\x4a\x44\x01\xf0\xfe\x52\xa3\xbf\x55\x9b\xb1\xc6\xb6\x57\x9c\xb4\x4e\xb3\xc2\x08\xe9\xf4\x0b\x0d\x45\xca\x61\xe7\xb5\x94\xc7\x47\x9b\x7d\x8f\x3c\x25\xcf\xa9\x34\xcd\xa8\xfb\xed\xa6\x57\xba\x1c\x92\x95\x3c\x99\x69\xc7\xfa\x99\x34\x36\xda\xe7\x3a\x63\x8e\xcd\x9e\x3a\x80\x53\x38\x08\x18\x43\xa5\xf8\x46\xb1\x2b\x52\x8b\xba\x92\xa1\x9a\x7b\x76\xb1\xee\x5e\xa5\x23\x78\xfd\xa7\xb8\x4e\x91\xe3\x4f\xb9\xdc\x5c\x9a\x65\xf7\x78\xad\xd3\xa2\x17\xc4\x80\xe8\x10\x63\x75\x47\x17\x04\x71\x41\xcb\xdb\x4a\xaa\x51\x19\x64\xe3\x73\x41\xd9\x8e\x27\xfd\x76\x39\x5f\xc7\xfa\xa3\x6a\xa3\x23\x90\x97\x4b\x7e\x42\xe4\x8a\xc7\x4c\x13\xbe\x5a\xfa\xad\x42\x6f\x44\xa4\x2a\x32\xa7\xae\xc6\xad\x04\x4f\xcf\x57\xd5\x64\x2f\x39\x8c\x1f\xe7\xa9\x6c\x3d\x31\xab\xae\xe9\x1d\x49\x5d\x37\xab\xe5\xe5\x30\x4e\xa4\xd9\x26\x71\x95\x69\x8c\x3e\xe9\xd6\x0b\xeb\x4c\x77\x09\x7f\xaf\x5c\x26\x9b\xf2\x24\x75\x19\xd0\xe0\xe8\x51\x89\x5d\x85\x16\x57\xd5\xf3\xe4\xcc\x9c\x1a\x44\x93\xe5\x27\xa4\xd4\x1c\x1d\x4f\xa7\x6d\x6c\xd4\x59\xa7\x99\x76\xe5\x32\xea\x28\x78\xbc\x54\x8e\x49\x73\x39\x76\x58\xa6\x3b\x57\xb1\x72\x9a\x2d\xa1\xcc\x2a\x21\xa5\x13\x4d\x6e\xc0\x12\xb3\xf8\x7c\xcc\x77\x76\x1c\x5f\x62\xab\x42\xa6\x92\xec\xd0\xa7\xd9\xe6\x5a\xcb\x1e\x3b\x1b\x5c\xcd\x9c\x95\x73\x6a\x90\xe4\xc9\x59\x3e\x53\x1a\x81\x2b\x15\xe0\x2c\x49\xec\xba\xb3\x6a\xaa\x2b\x56\x66\x05\xb1\x0e\xe8\xc9\x84\x01\xf4\xb2\x3e\xe7\xb2\xf1\x73\x36\x13\xbf\x54\xb3\xcd\x2b\x78\xc0\xa4\xa7\xd7\x7e\x43\x28\x77\x77\x47\xa6\xa5\x70\x7c\xaf\x7a\x1a\xeb\x3a\xef\x64\xb3\x57\xcc\x75\x0b\xf0\xc9\x55\x64\x29\xac\x77\xd8\x90\xcd\xcb\xe9\xca\xd4\x53\x54\x66\x1e\x2f\xf5\xc7\x24\x31\xe2\x89\x52\x71\x0f\x5e\x88\x59\xb2\x47\x12\x07\x82\x3d\x5c\xcb\xfb\x5a\x53\xdd\x6d\xaa\xc9\xc6\x32\x75\x5a\x35\xc4\x85\xd0\x59\x13\xc9\x6b\x2e\xbd\x9f\x88\xfd\xee\x2e\xbf\x3e\x89\x9d\xaa\x08\x9e\xc2\x7c\x61\x71\x02\xff\x49\x67\xca\x55\xe2\x30\x5d\xf1\x55\x31\xb9\x62\x12\xaa\x5a\xc9\x4f\x47\x45\x86\xc8\xcf\x76\xb3\x02\xd9\x03\xf7\xbd\x1c\xc7\x7b\x85\x5c\xed\x78\x28\x40\xd8\x4a\x8b\x11\xd1\x2b\x34\x12\x89\xd6\xae\x94\xec\x64\x73\x63\x62\x51\xed\x0d\xf3\xdd\x5a\xb7\x2d\x0e\xf3\xd7\x36\x9d\xe4\x13\x1d\x26\x3b\x24\xc6\x4d\xa2\x4d\x1e\x56\xc3\x5c\x0b\xa9\xf1\x2b\xd7\x61\xaa\x3b\xc8\xc1\xdf\xc5\x52\xb3\x52\x5d\x24\x0e\x14\x78\x1a\x97\x8e\x78\x69\xb2\x2e\xcb\xdc\x68\xbb\x20\x28\x62\x9e\xc8\xc6\x57\xe3\xb9\x30\x9c\x4f\x17\x93\x6b\x92\x3c\x4d\xa4\xcd\x88\x6a\xcd\x1b\xfd\x4d\x76\x39\xc7\xea\xf1\x38\x3b\x62\x86\x4b\x69\x5c\x02\x73\x99\x77\xca\x4a\xfb\xdc\x20\x36\x3d\xb0\x3f\x31\x82\xec\x8a\xb3\x5c\x7d\x93\xbe\x24\x4e\x1b\x26\x87\x6f\x94\x29\x1d\xc7\x87\x9d\xde\x64\x58\x9c\xcd\xc9\x1d\xb1\x6d\x17\xb9\xfd\xf8\x74\x20\x12\xed\x7a\x1e\xce\xa3\x44\x36\x08\x15\x3c\x14\x95\x95\x12\x07\xfc\x75\xbe\xd5\xc9\xe3\x83\xbc\xbc\xcc\x95\xaa\xc3\x79\x55\xb8\x16\xd2\xad\xd9\x6e\xd5\x03\x07\xea\x12\x2f\x93\x50\xf2\x57\x04\x3c\x75\x8a\xe5\x52\xd7\x5c\x9f\x68\x2b\xf1\x5d\xa6\xdc\x9e\x8e\xc5\x7c\x71\x9c\xca\xd4\x2b\x38\x49\x16\x0f\xdb\x3d\x7f\x8a\x8f\xa9\x74\x25\xdb\x5b\x1c\x2a\xe3\x41\x2d\x96\xa0\xe6\x94\xd8\x9c\xd6\xe5\xe5\x78\x93\xb8\xf2\x85\xfc\x3c\x0d\xba\x2c\x95\xaf\xf1\x74\xef\xca\x8e\xe7\x1a\xf2\xc6\xc5\x29\x7d\xbe\x14\x32\x93\xd5\xf0\x10\xc3\xf2\x63\x26\x4e\x67\x33\x99\x7a\x6a\x3c\x24\x2a\xa3\xf2\x8e\x48\xe3\xb1\x31\xd1\x6e\x70\xf1\x5e\x57\xec\x9e\x66\xec\x8c\xd8\x12\x59\x3c\xdd\xa9\xa6\x06\x98\x92\x6e\x14\xab\xc7\x4d\x3e\xdf\x6a\xed\x73\xc3\x6e\x99\x4e\xca\x62\x63\x2e\x54\xc0\x7b\x12\x5f\xec\x6a\xeb\x31\x4b\x67\xa7\x44\x4b\xb3\xe7\x69\x11\x13\x7c\xbc\xe8\x9d\x00\xaf\x2b\x5d\x3b\x52\x7d\x19\x6b\x2c\x13\x2b\x3a\xc5\x0d\x3a\x99\xc4\xaa\x7e\xcc\xc7\x76\xe2\xf1\xc0\xe1\xa7\x51\xbb\x4b\x6c\xb0\x74\xbe\x9d\xec\x8c\xcf\xd7\x6d\x8a\x1d\x67\x93\xdd\x5d\x29\x57\xae\x11\x89\x72\x7a\xd7\xdf\x27\xb3\xa5\x23\x98\xe4\xb1\x39\x5d\x95\x53\x72\x31\x3d\xaa\xd7\x89\x53\x2a\xb3\x9e\x64\x9a\xf3\xc2\x22\xad\xb4\x9b\xcb\x73\x6c\x7b\x85\xf4\x8b\x8c\xad\x57\x97\x7c\x3a\x96\xcf\xa4\xe7\x85\x66\x37\x4b\x26\xe3\x63\x7e\x58\x2d\x1c\x8b\xa5\x86\xda\x5b\x0f\x77\xa9\x06\x07\xa8\x56\x89\x58\x00\xfe\x7e\x32\xec\x2a\x0a\x9c\xea\x54\x62\x18\x5c\x1d\xcb\xe2\x75\x36\x13\x52\xf8\xa9\x88\x53\xcc\x4c\xdd\x36\x56\xca\xa9\x9d\x8e\x0f\xa6\xdd\x0e\x56\x2f\x0a\xeb\x4e\x3c\xc5\x53\x23\x51\x56\x8f\x71\x25\x85\x27\x56\x97\x05\x13\x17\x3a\xed\xa5\x40\x8b\x85\xaa\x40\x8e\x79\xee\x80\x15\x7a\x15\x72\xc2\x77\x67\xf1\x74\xe3\x24\xee\x12\xcd\xf8\x56\x3e\x74\x5b\x99\x5e\x56\xbc\xa6\xd4\x63\xb1\x77\x89\x2d\xe6\xc9\xea\xb5\xc3\xc5\xd8\x76\x95\x48\x15\xe7\x25\x96\xf8\x3a\xe2\x67\x7e\xc5\xed\x28\x96\x51\xe2\x1c\x2d\x0a\x6f\xf3\x19\x68\x6e\x85\x4a\xe2\x92\x60\x35\xc1\xe2\xc6\x64\xa7\x7f\xc2\x1a\x15\x56\x84\xab\x69\x0f\x46\xeb\xd2\x08\x6a\x82\x2b\xc8\x4c\x84\x2d\x40\x16\x97\x28\x72\xbb\x2a\x9d\x42\x25\x95\x25\x39\x84\x3c\x64\xb3\xd2\x2d\xc4\xcf\x6b\x64\x0a\x40\x94\xaf\xa3\xed\xb2\x40\x34\xb2\x9d\x6a\x07\x5a\xd0\x10\xec\x0c\x5b\x0f\x86\xe0\x1b\xc3\x81\xf6\xb5\x02\x68\x50\xcc\xa0\x0f\x1d\xa8\x2c\x1d\x01\x7c\x2a\xee\x61\xcf\x1d\x09\xfc\x4d\x2e\x4f\x42\x53\xea\x77\xd1\xde\xcf\xb9\x01\xb6\x2c\x11\xdd\x1c\x11\x3f\x15\xe3\x62\x33\x21\x6c\x11\xf6\xf1\xa7\x41\x99\xbf\x82\x5f\x0e\x35\xf0\x77\x93\x6b\x91\x4c\x63\x14\x5f\x82\xe6\xe5\xc6\xba\xde\x2f\x95\x47\x4c\x5b\x56\xa7\x53\x5e\x99\xb6\x96\xe9\x78\x12\xbb\xe6\x32\xa9\xfc\xa1\x78\x2d\xed\xce\xfd\x32\xb7\xa8\x29\x9d\xe9\x3c\x37\xae\x09\xc3\x7a\x79\x36\x98\x24\x29\x5a\x2d\xe1\xb4\x3a\xd9\x82\xff\x96\x33\x35\x6c\xdc\x2f\x97\x26\x84\xc8\xad\x7b\x22\x38\xac\xe5\x1d\x47\x8a\xec\x84\xaa\x95\x88\x62\xb9\xb0\xeb\x4d\xb7\xbc\x2a\x62\xa9\x61\x07\x13\x96\x93\x5a\x6a\x71\x9e\x53\xfc\xa4\x5a\xe7\x84\x21\xd8\xec\x7e\xbd\x0c\x7a\xb9\xf2\xf9\xeb\x32\x9f\xcd\xc6\x72\x74\x2a\x1f\x1b\x6d\x0e\x9c\xb4\x28\x14\xf1\x4b\x2c\x1f\xa3\x98\x6c\xfa\x78\xdc\xd5\xf9\x63\x92\xed\x24\x66\xfd\xc3\xed\x67\x74\x20\x54\x59\x24\xdb\x49\x61\xc5\x34\x14\x6a\x30\x9d\x1c\x64\x4a\x3e\xf6\x8f\x23\x79\x28\xf0\x34\x3d\x8e\xa7\xc1\x7b\xaf\xd8\x67\xfa\x8d\xf2\x92\x06\x0f\xc7\x8c\x70\x3d\x4e\x12\x8b\x6c\xa7\x26\x9d\xe6\xeb\x6c\x3b\x99\xe5\xaa\x33\xae\x7b\x55\x2f\xc7\x4b\x8a\xdb\x13\x18\x36\x14\xd8\xf8\x64\x5a\xc5\x57\xc9\x4a\x4f\x29\x6f\x39\xac\xb7\xb8\xc6\x68\x62\x8c\x27\x36\x4c\x7f\x3b\x3c\x9d\x46\xcb\xc4\x65\xa5\x8c\x6b\xb9\x7c\x67\xb3\x68\xca\x27\x41\xac\xd7\x9a\xa7\x5c\x49\x24\x3a\x35\xa2\xcc\x36\x4f\xc5\x61\xe1\x4c\x13\x75\xb2\x54\xaf\x71\x35\x82\x15\xb9\x5a\x81\xa8\xb1\xe0\xff\xc8\x7a\x45\x5a\x76\xfb\x05\x70\xb0\x94\x2e\xe0\xb5\xea\x04\xc7\xe5\x7a\x2c\xb1\x8d\x77\x6b\xe5\xed\x80\x24\x7b\xc7\x75\xb2\xd7\xdf\xf6\xa6\x2a\xe0\x7f\x1a\xd2\xb9\x9a\x5f\xc8\xe9\x75\x8b\xdb\xae\x69\x36\x05\x5e\x02\xcb\xd9\x96\x25\xfa\x65\x72\xb0\xdd\xf1\xa3\x9d\x20\x65\x9b\xbb\xe6\x34\x27\xf3\xb9\x7d\x3e\xdd\xe9\x36\xea\x58\xb1\x57\xd8\x76\x99\x61\x89\xee\xc9\x44\x11\xcf\xe6\x63\xd9\x58\xb6\xa9\x74\x93\x47\xb5\x7e\xca\x13\xf8\xfc\xb8\x38\x95\x57\xbd\x56\x5c\xc6\xe3\xc4\xa5\x98\x49\x35\x9a\xe4\x31\x5d\xaf\xd6\xa8\xda\xa9\x26\xd5\xd2\x95\xda\x82\x39\x2e\x73\x09\x19\xab\x25\x3b\xad\xcd\xb1\xbe\xea\x88\xe9\xd5\xaa\xb0\x3f\xe1\xa5\x75\xad\xcf\xf7\x7a\x5c\x92\x4a\x66\x25\x8c\x52\x57\xc3\x31\x99\x48\x74\xd7\xb5\x43\x55\xcd\xa7\x55\x41\x20\xe5\x6c\xfa\x5a\x6d\xc7\x01\xee\x0f\xc4\xd9\xe6\x72\x1e\x8f\x87\x85\x4e\xa1\xbb\xea\x4c\xda\x79\xa5\xb2\x3a\x30\xb1\xe5\x2a\xde\x4c\xc9\xe0\xa6\x6a\x0d\x2a\xa3\xce\x31\x49\x4d\x66\xc5\xda\x3e\x95\x24\x4b\xd3\x69\xa9\xd7\xef\x16\x7a\x8d\xf4\xbe\xcc\x33\xe5\xd6\x72\x79\xe8\x9c\x5a\xe3\xe4\xa4\x31\x22\xa7\xe5\xf1\x65\x3c\x5d\xab\xbb\xe4\x46\xde\xa8\xc7\x13\x9e\x3c\x1e\x9a\xf8\xa1\x3b\x05\x87\x6c\xc4\xae\xb7\x15\xbe\xd9\x9d\xd6\xf3\xf3\xe3\xfc\xda\xcc\x26\x73\x80\xad\xad\x2a\x9d\x75\xb9\x9c\x38\x24\xa9\x2a\x60\xe4\xeb\xa5\x23\x31\x59\x33\x31\x21\xd1\xad\x16\xb3\xd8\x1c\x5c\xce\x3b\x9a\x5e\x35\xc9\x41\x23\xdf\xc2\x85\x29\x46\xec\x85\x59\x79\xd0\xe0\x00\x4b\x5a\xe3\x3a\x57\x36\x09\x5e\xf1\x24\x33\xab\x4e\x70\xb6\xb5\x05\x90\x2a\x92\x64\x2c\x53\xdd\x56\x9a\xc3\xf3\xae\xbf\x5a\x9c\x57\xf9\xd8\x9e\x8e\xe7\x26\xca\xb5\x95\xe7\xaf\xe5\x7c\x1e\xbc\x65\xd5\xd3\x79\x5e\x20\xf6\xe9\xd3\xa2\xc7\xb5\x0a\xfd\xd2\xa4\x4b\x6e\x8b\xd3\x25\x93\x64\x6a\xa9\x5c\x3e\xb6\x66\xe2\x31\xfc\x10\x67\xd4\x38\x9d\x24\x1a\xb1\xf8\x61\x70\xbd\x34\x87\x47\xb6\x91\x95\x77\x31\x95\x2c\xa5\x0a\x73\x02\xaf\xf6\xa9\xf2\x30\x2b\xee\xdb\xbd\x06\x78\xd8\x29\x7b\x52\xca\xe0\x95\x62\x9e\xa9\x92\xc5\x6c\x76\x7a\x10\xea\x78\x46\x8c\xc7\x32\xfb\x58\x9c\x6e\xd6\x67\xcd\x6e\x62\xb5\xbe\xae\x2a\x95\x6d\x7f\xc6\x9d\xc8\x7e\x23\xb1\x12\x9a\xf1\x24\xd7\xbf\x66\xe3\x87\x61\x3c\x27\xac\x0e\xff\x3f\x7b\x5f\xda\x9e\x48\x8e\x24\xfc\xbd\x7f\x05\xc5\xce\xce\xc0\x60\x9b\xe4\x86\x72\x57\xd7\x72\x1a\x6c\x2e\x73\xd9\xe0\xaa\xad\x4d\x20\x81\x34\x49\x26\x64\x26\x67\x4f\xed\x6f\x7f\x15\x52\xde\x17\x89\x5d\xd5\xd3\xf3\xee\xd4\xd3\x6d\x5b\x29\x29\x14\x0a\x49\xa1\x88\x90\x14\xb1\x3d\xc5\x7b\x07\x8e\xce\x46\x16\xcf\x9d\xf1\x7d\xfa\xb1\xff\x50\xa0\xeb\xdb\xfb\xd5\x7c\x50\xbf\xef\x3c\x6c\xbb\x0b\xae\x56\x58\xd3\x32\x5b\xae\xe4\xd7\x48\x37\xae\x54\xab\x51\x2e\x1f\x8d\x36\xab\xaf\x89\xc4\x86\xa1\x98\xf6\xee\xf1\xfe\xb9\x4c\x47\xef\xf9\x6a\xb9\x26\xe4\x0b\xc3\x69\x2e\x9e\x8a\x9e\x5a\xed\x59\xe4\xfe\xb5\x70\x68\xdc\x17\xa3\xd1\xe3\xe1\x39\x72\x37\xa4\x47\xf3\xbb\xfc\x48\x62\x28\x6a\xcb\xc4\xb6\x48\xe4\x7b\x6a\x3c\x8a\x77\x65\x6e\x3a\x18\x3c\x14\x9f\xc6\x68\x8e\xe7\xd3\x2d\x9e\x99\x3f\xb6\x33\xb9\x5c\xe6\xb4\xa3\xdb\xd5\xf8\xfc\x4e\xaa\xad\x1f\xd9\xe9\xd3\x56\xe8\xec\xd1\xee\x18\x8f\x8e\x4b\xa7\x0c\x25\xcc\x66\x11\xbe\x52\xa3\xa3\xb3\xc9\xe9\x14\x6f\x6f\xe2\x8b\x53\x77\xdc\x59\x4a\xf5\xe2\x41\x6a\xf6\xd0\x64\xa9\x65\x5e\x87\xc5\xa7\x28\xbb\x98\x46\x27\x85\x87\xa7\x51\x6f\xb4\x78\x9c\x54\x06\xb9\x4d\xe3\xf5\x91\x9a\xaf\xca\xfb\x55\x72\xb1\xdc\x48\xad\x41\xf9\xb5\x24\xcc\xd1\x1e\x36\x6e\xbd\xee\xe8\x32\x52\x6b\xea\xec\x69\x35\x1c\xcc\x51\x9d\x27\x2a\x56\x7d\x65\x5a\xf5\x3b\x3a\x3f\xee\xb3\x6b\xe1\xbe\xf7\x54\xcd\x3f\xec\xe9\xe4\xc3\x70\xbd\xaa\x16\xef\xfb\x05\x8a\x7b\x2c\x0a\x7d\x4a\x9a\x3c\x50\xf3\x62\xe3\xbe\xd3\xdd\x6c\x5a\xaf\x55\x86\xa3\xca\x9b\xd1\xba\xb6\xae\xd4\xc6\x25\xc4\x32\xf7\xfb\x56\x23\x7e\xcc\xcc\x5b\xc9\x29\xff\xb4\x10\xbb\xa7\x41\xa5\x50\x2a\x9f\x1a\xeb\x65\xbf\xde\x6c\x50\xc9\x42\x6d\x5e\x91\xba\xb1\xf9\xfc\xd0\xec\x9f\x1e\x06\x5d\xb6\x88\xf4\xa2\x02\x1c\xa9\x56\xd8\xce\x26\xbe\x4b\x55\x9b\xcf\xaf\x8d\x69\x2c\xbb\xee\x56\x07\xcc\x88\xdf\x6e\x79\x16\xc9\x12\xeb\x5a\x0d\x09\x7b\xb5\x05\xdf\xdf\xcc\x77\xe3\x98\xdc\x4f\xb4\x0a\x0f\x15\xf6\xa9\x38\x6d\x1c\xa7\xf9\xc2\x53\xa1\x1a\x19\xd7\x84\xe2\x2e\x9f\xe8\x34\xfb\xb9\x87\x63\xa9\xc5\xd2\x8b\xbb\xf8\x33\xe2\xef\x27\xe9\xd4\x4a\x36\xab\xcb\x51\xad\x39\x41\xa2\xf0\x76\x3a\xed\x54\xd9\xc4\xe0\xa9\xd2\x61\xea\x93\xa7\x63\x76\xba\x38\xb4\x52\x74\x65\xbd\x5b\x2e\xe8\x55\x29\x55\xad\xec\x1f\x1f\xb7\x5d\x79\xbe\xaf\xb7\xd7\x52\xeb\x44\x27\x53\x77\xa5\xe8\xb1\x94\x6c\x94\xf6\xf1\xd7\x41\x51\x2c\x36\xaa\xdd\x65\x7f\xbf\xda\x4b\x89\x65\xb1\x91\x2f\x95\xa8\xbb\xe2\x68\xcd\xd2\x5c\xe3\x2e\x31\x66\xe9\xa4\x2c\x0e\xa4\xfb\xc8\x61\x79\x9f\x7f\xe8\xf6\xbb\x72\x83\x12\x9e\xa4\xee\xa3\x38\xef\xdd\x4d\xb7\xf1\xc6\x33\xc7\x95\x57\xcc\xa9\x12\xad\x76\x16\xa7\x48\x31\x39\x4e\x2f\x8e\xe5\xb9\xc8\x1f\x5f\x07\xf5\xfa\x69\x31\x59\x08\x31\xb6\x22\x95\xd8\x3b\x61\xd5\x3a\xc9\x91\x92\x14\xcd\x9e\x32\xed\xda\x69\x35\xeb\x24\x72\x4c\x3b\x86\xe6\x4f\xb7\x55\x66\x5f\x3b\x14\xdf\x91\x2a\xe2\x3c\x56\xeb\xae\x57\x4b\xe1\x31\x37\xe9\x6d\x67\x87\x07\xb1\x30\xae\xae\x84\xbe\x70\x3f\x7a\x5a\x71\xed\xd2\x73\x67\x34\x9f\x36\x4a\x4d\xe9\x90\xad\x6e\x9f\xc7\xf2\x21\x71\xda\xed\x16\x9d\xc7\x87\xde\xb4\xb2\xaa\x3f\xe6\x8b\x42\x2f\x3e\xdd\x0f\x73\xcc\xac\xf3\xc4\xed\xf2\xb1\x55\xec\x70\x7f\x8c\xd7\xca\xf3\xf9\xb2\x2d\xf2\xfd\xdd\x73\x21\xd7\xa0\xb8\xbb\x25\xcd\x0e\x0e\x91\x43\x27\x9a\x2f\x3d\x6e\xdb\x9d\xc7\xfc\x7a\x4d\x0f\xa9\xfb\xd9\xa9\x55\x28\x50\xaf\x42\x25\x2f\x25\x5b\xc7\x71\x2a\x57\xa6\x86\x99\x75\x25\x59\x2a\x95\xa6\xab\x7d\x32\x53\x9f\x1f\xe7\x8f\xd5\xf1\x58\xca\xd4\x5e\x93\xf7\xeb\xbb\x46\x6d\xff\x7a\xd7\xa9\x4c\x2a\xd4\xa1\x72\x3f\x29\x4d\xcb\xd4\xba\x3f\x4e\x0c\x1f\x63\x8b\xe1\x93\x54\xaa\x74\x0f\xb3\x59\x66\x37\xaf\x36\x8e\xad\xc4\x72\xd9\xb8\x63\xe9\x7c\xfb\xd4\x9d\xe4\xf7\xa7\x02\x35\x1c\x70\xab\xfc\x16\x89\xeb\xa7\xc7\xea\xf2\x81\xee\xaf\xd7\xbd\x6a\x1e\x29\x1d\xf5\x47\x3e\x3f\x5c\x25\xef\x93\xb1\x19\x53\xe4\xa6\xf9\x3b\xa6\xca\xc4\x2b\x74\x71\x93\x9b\x4d\x9f\x1f\x1b\xed\xec\x46\x4e\x49\x85\x74\x8b\xbb\x1b\xb7\x93\xeb\x65\x01\xc1\x9a\xdf\x55\x85\x87\xc6\x74\x52\xbb\xcb\xc6\xcb\x7b\xaa\xf3\x90\xee\x6f\x7a\x87\x6a\xfe\xbe\x5c\xed\x16\x8a\xf5\xd5\xa0\x5a\xa4\x77\xcf\xfb\xfe\x64\x7f\xb7\x7e\x6e\xdc\xd7\xba\xf1\x21\x3f\x58\x88\x1b\x2a\x36\xc9\xb7\x1e\x0f\xcb\x62\x66\xd8\xb8\x4b\xb1\xe9\xdd\x9e\x4d\xa7\xea\x03\x34\xde\xb5\x87\x18\x75\x77\x7f\xac\xd6\x77\xeb\x5e\xaf\x43\x95\x46\x23\xee\x5e\x2e\x97\x1b\x87\x4e\xa4\x36\xb8\xbf\xef\x75\xb9\x4a\xbe\x32\x1d\xd5\x93\x19\x44\xab\xb9\xb4\x17\xcb\xa3\xc4\x33\x95\x2e\xed\x12\x4c\xbe\x9a\xc9\x66\x06\xab\x6c\x54\xa6\xa4\x2c\xdf\xe0\xa6\xb9\xf6\x6b\x61\x51\x2d\x36\x63\x77\x99\x29\xb7\x4a\xe6\xa8\x79\x42\xac\x9d\x8a\x72\x34\x75\xac\xd6\x9e\xd8\x7c\x6a\x7a\xb8\x7b\x4c\x55\x5b\x13\xe0\x53\xdb\xe1\xdd\x68\xb3\xdd\xf7\xba\xcd\xde\x73\x23\x2f\xcc\x07\x42\x77\xbb\xdc\xe6\x9f\xf7\x88\x09\xf7\xd7\xdd\x4e\xf1\xc8\xc5\x26\x87\xc8\x40\x68\x15\x4e\xd1\x11\x9b\x4b\xec\x8e\x4c\xa9\x1c\x4b\x8f\x0f\x31\x76\x5b\x97\x90\x08\xd3\xad\x77\x9f\x8a\x5b\xa4\x92\x2c\x28\x3a\xb7\x88\x6e\x0f\x25\x29\x12\x9f\x3f\xf1\x87\xd2\x6b\x24\x93\x9b\x3e\x3e\xbc\x2e\xb8\x79\x72\x3c\xe2\xa2\xbb\x25\x4b\x97\x56\xf2\x72\xf7\xdc\xac\xb0\xab\x55\xbf\xd3\x40\x82\xfa\xb0\xb5\x2c\xac\xbb\xdc\xb4\xbb\x9d\xe7\x62\x48\xe5\x60\x6b\xa5\xe9\xf3\x5e\x2e\xef\x7a\x4c\xa9\xc9\x1d\x46\xfb\xc4\x31\x9a\x48\xb6\x4b\xfc\x74\x5b\x2f\xb2\xd1\x46\x32\xc2\x3c\x52\x23\x39\x35\x29\x4e\x6b\xb1\xbb\x68\xe5\xa1\x72\x98\xa7\x56\x8d\x72\xae\x93\x11\x3b\x8d\xa7\x1e\x1b\xcd\xcb\x88\x9b\xf2\xe5\x66\xfd\xe1\x79\x10\x97\x8f\x62\x3e\xc2\x55\x36\xa7\xe5\xc3\x32\x51\x68\xd6\x28\xf4\xf3\xa9\x9c\x92\xf6\xa5\xe7\x7c\x66\xcf\xad\xa8\x71\xa5\xbc\x2c\xb7\x4a\xe3\xd7\xd3\x53\xfb\xb4\x2a\x6d\x7a\xfb\x64\xb5\xc4\xa3\x64\x2a\x23\xed\xa7\xd5\xc9\xc3\x3a\x95\x8e\xbc\xca\x4c\x74\x49\x0d\xb6\xa2\xdc\xe9\xd7\xf8\x6e\xfb\xfe\xb9\x94\x18\xe6\xa8\xe1\xec\x79\x32\x1e\xc9\x99\x5a\x32\x27\x23\xcc\x52\xf5\xfb\xd4\x68\x5e\xc9\x70\x8d\xfc\x58\x6a\xe6\x0a\x33\xf6\xb9\x9c\xda\xca\x8b\xe7\xf2\xa9\x52\x78\x88\xf4\xd6\xf9\x85\x38\xd8\xf2\x48\x83\x62\xca\xeb\x64\xe9\x31\x29\x1c\x8f\x8f\xb5\xc8\x6c\xd9\x7d\xe5\xa3\xf2\x9d\x34\x9a\x3d\x4d\xf2\xb9\xd7\xc4\xf3\xeb\x6e\x19\xa5\x86\x32\x93\x89\xef\x4a\xd9\xca\x71\xb4\x8f\x0e\xe3\x03\x7a\xdb\x41\xe2\x7d\x32\x9b\xa8\xad\x4a\x7c\x44\xa8\xc4\xb3\xa5\x7a\xf4\xb4\xa9\xf0\x89\x3d\xcf\x46\x0f\x93\x72\xb7\x2b\xf4\x76\xcb\xf2\xe1\x30\x2d\x14\x9a\x2c\x57\xe1\x8b\x79\xae\x5e\x68\x34\x22\xd5\xc5\x74\x99\xa8\xca\xbd\x85\x50\x2b\xc7\x7a\x5b\xae\xb7\x2d\x71\xd2\x24\x3f\xcf\x6f\x9b\x87\x62\x3e\xfd\xdc\x4c\x55\xdb\x91\x36\x9d\x5d\x30\x4f\x52\x35\x3f\xef\x3e\x1e\xf3\xd4\xe8\x54\x11\xb3\xb5\xbb\x2a\x9a\x48\xdb\x25\x5a\x11\xd3\xd9\xaa\xd1\x5a\x8f\xb3\xfb\x39\x77\xa4\xe2\xb1\xd7\xe7\x65\x4f\x7a\x1d\xae\x72\x45\x6a\xf8\xda\xbc\xef\xce\x2b\xbb\xea\x36\xdf\xce\x6c\xc6\x8d\x7c\xa2\xb7\x9e\xec\xf3\x42\x4c\x10\x4a\xad\xfd\xfe\xf5\xa1\x57\x8d\x3f\x96\x76\xdb\x28\x37\x4f\x70\x45\x7e\x3f\x4f\xe7\x26\x87\x49\x77\x7d\x3f\xc9\x4e\x47\xf7\xed\x79\xbe\xc8\x30\xdb\xfc\x70\x31\x9f\x51\xed\x69\xfa\xfe\xf5\xd8\xc8\x8b\xcf\xa9\xe8\x1d\x37\xca\x4a\xf3\x78\xa2\x9f\xea\x15\x67\x91\x72\x57\xa6\x1e\x2b\xc5\x22\xe2\xd7\xc3\x49\x25\x96\x88\x2d\x7b\x49\x6e\x51\x58\x97\xba\x48\x43\x3b\x46\xca\x9b\x58\x3b\xff\x3c\xcc\xd7\x86\xd5\xc2\x36\xd7\xc2\x73\x9e\xd9\x4d\xb8\xc6\x2c\x73\xcc\x2f\x33\xd9\xd4\xa9\xbb\x7d\xce\xb2\x99\x61\x3a\x3b\x6a\x09\xa8\xcf\x87\xe9\x53\xb6\x19\x8f\x6e\x93\xbd\xce\x14\xb1\xaf\x4c\xaa\xb2\x6d\xb7\x37\xfc\x36\x51\x5a\x6d\x33\xcd\x64\xae\x23\x9d\x9e\xea\xec\xf1\x6e\x37\xbf\xeb\xcc\x1a\xc9\x4c\xf5\xb5\x39\x3d\xad\xe6\xe9\x7e\x7a\xd9\xa9\xe7\x25\x2a\xbe\xbf\x43\x6b\x84\x65\x0a\x7b\x78\x9e\x40\x37\xfa\xa5\x8c\x50\x4b\x67\x66\xab\x72\x75\xfc\xba\xeb\xcf\x67\x2b\xb1\xd0\x3b\x25\x72\xa9\xd9\x3e\x85\xb6\xd9\x87\x87\x78\x7a\xbd\x9a\x9f\x5a\xd4\xbe\x28\x55\x26\xa3\x54\x26\xfd\x7c\x60\x7a\xeb\xd1\xf4\x74\xa0\x8b\xb3\x6d\xa4\x9a\x7f\xee\xcc\xd3\xf1\x24\xd3\xed\x51\xa7\x45\x74\x5a\x48\x57\x9e\x4b\xaf\x0f\x89\x5e\x7f\x3e\xd8\x3f\x76\xca\x7d\x41\xa6\xf2\x95\x62\xb9\x7c\x48\xf4\xb3\x23\xa1\x30\x2f\xe7\x07\xfb\xa9\xd0\xe7\x17\x4c\x85\x7f\xba\xab\x9f\xe4\x7c\xec\x98\xec\xbd\x46\x4f\x87\x62\xed\xd8\xdf\xd7\x22\xcc\x4a\xa2\xdb\x7c\x8a\xaf\x64\x37\xc7\x5d\xa5\x22\x51\xa5\x68\x31\x4b\xa5\x46\x3c\x9b\x78\x5d\x6f\x62\x8d\x61\x34\x52\x2e\x66\x5e\xf9\xee\x3c\x3a\x8b\xa4\xe6\x85\x74\xee\xf8\xbc\x8f\x16\x26\xfc\xb0\x30\x2b\xd5\x98\x86\x38\xa4\xeb\x4f\xe9\x3c\xd2\xb6\x2a\xab\xd5\xeb\x1a\xd1\x6e\xb3\x5e\x15\x3b\x4f\x8d\x4d\x7c\x45\xd7\xe7\xb5\xd6\xa6\x32\xe9\x3f\x66\x1a\x65\x71\x9d\x1a\x1d\x36\x2b\x7e\x44\xdd\x1f\xa8\x48\xed\xfe\xa9\x59\xa8\x89\xa9\xcd\x5a\x3a\x71\xd9\x54\x3c\x3a\xdb\xaf\x33\x89\x51\xb3\x98\x68\xef\x57\x8b\x87\xe2\x63\xbe\xff\x54\xbc\xcb\x6f\x53\x8d\x6d\x93\x13\xd9\xf2\x63\xf9\x98\x3e\xd1\x15\x7a\x5b\x5f\x54\xe7\xe9\xc7\x22\xd7\x64\xd0\x62\xcb\x0d\x1f\x6b\xc5\x49\x3d\xc6\x26\x96\xd9\x68\xb2\x7f\x3f\x8d\x8e\x47\xa3\x6a\xb9\x77\xb7\xbf\x2b\x0e\x1f\xb2\xc7\xd3\xeb\x3e\xb1\x48\xee\xaa\x48\xc3\x7f\x3e\xae\x62\xb9\xe9\x61\xd6\x2c\x53\x65\x79\xb2\x59\x1e\x7a\x83\x76\x6f\x3a\xa5\x36\x48\xfe\xea\xb4\x9b\x34\xfa\x78\x78\xcc\xb3\x79\xaa\xc7\x70\x45\xe6\x61\xd7\x29\xf5\xba\xc2\xf3\x53\x6f\x78\x3f\x4e\x2d\x84\x4c\xaa\xb3\xa1\x96\xf9\xf5\x78\x5f\x12\xd7\x0b\xb1\xd5\x29\x4f\xa9\xda\x3a\x95\x6f\x15\xb6\xc7\x05\x7b\x57\x95\x64\x79\x5d\x48\x16\x84\x05\xdb\x87\x67\x13\x73\x86\x4b\x36\x0e\xb1\x0d\x52\x6d\xf9\x61\x71\x3c\xe9\xcf\x93\xfb\x75\x7d\x32\xe9\xd3\xd2\x60\x34\x28\xd6\xf3\xf7\xfd\xd1\x02\xed\x2d\xbd\x75\x6a\xb1\xeb\x3d\x15\xd7\xcf\x7c\x7e\x52\xae\xb5\xa4\x3b\x8a\x9a\x1d\xb6\x83\x61\x67\x93\x46\x0b\x64\xfa\x9c\xba\xdf\x16\xef\xe4\xd4\x61\x51\xce\x6f\x9e\x96\x9b\x64\xad\x98\x6c\x20\x91\x6f\x3c\xa0\xa2\xe3\xfa\x7d\x7a\x91\x8b\x4f\x57\x1c\x7d\x5f\xbb\xcb\xf5\x16\xcb\xe3\x69\x5c\xce\xcd\x1f\x29\x71\x58\x48\x73\xeb\xee\xbc\x3f\xa1\x8a\xbd\x6c\x91\xe2\x1f\x4f\xed\xfe\xae\x58\x5a\xc6\x85\x0c\x7b\x94\xfa\x7d\x24\xa9\xa1\xd9\xd5\x2d\x16\xf9\xfb\x91\xb4\x66\x36\xe9\x82\xdc\xee\xa3\xa5\xdf\x3d\x88\x77\xc5\xc5\xc3\x68\xbd\xda\x46\xfb\xf1\xf9\xfe\x41\x6a\xb4\x85\xf8\x28\x5a\x8e\x24\x72\x99\x7c\xb9\x5e\xa9\x27\xb3\xe5\x46\xb5\xb2\xb9\x9b\x46\xa3\x07\x6a\x99\xcc\xcd\x87\xfb\x4e\x64\xf0\xd4\xbf\xdb\xad\xf2\x8f\x87\x52\x2c\x7e\x37\x1a\xcd\x85\x43\x4c\x5e\xb3\xdb\x2e\x9d\xae\xb6\x8a\xf7\xb5\xfb\xec\x76\x75\x10\x52\x8b\x72\xbd\x75\xd8\x75\x2b\xed\x32\xbb\xad\x1d\x26\x9d\x14\x3b\x7d\x6e\x9e\x06\x9b\xcd\x9e\x7f\x7c\x2e\x8c\xe6\x0b\x31\x2b\xa6\x56\xd1\x87\x71\x75\x7e\x38\x49\x11\xa1\x89\x78\x50\x54\x2a\xec\x16\xf3\x39\x9b\x9f\x1c\x5b\x62\xa4\x10\x8d\x46\x2a\xc7\xfe\x49\x9a\x95\xa8\x72\xa7\x91\xe9\x54\x6a\xcb\xd7\xde\xfc\x35\x5e\x68\xf6\x56\x73\x7e\xd1\xef\x3f\x4f\xa6\xf1\x66\xb2\xbb\x2e\xf4\xd7\xc9\x62\xba\x34\xa8\xe6\x0b\x2b\xba\x2c\xa6\x22\xd9\x7a\x44\x94\xd9\x3b\xae\x97\x2f\xf5\x1f\x92\xb5\xec\xf2\x3e\xfb\xcc\x94\x4a\x8c\x14\x7d\xc8\xef\x13\x19\x44\x9f\xae\x38\xec\xf3\x29\x79\x7d\xdf\xba\xdf\x94\x93\xbd\x45\xab\x9b\x2f\xf2\x91\x0e\x52\xdb\x91\x6c\x15\x5d\x67\xf2\xab\x79\x2d\xbe\x5e\x3c\x2d\x8f\x4c\x21\x39\xda\x3c\xb2\x79\xa9\x32\x92\x97\x95\xd6\xa8\x16\xc9\x0b\x99\xee\xfc\x28\x0f\xe6\xcc\xbd\xf0\x38\x28\x2c\x67\x91\x6a\xe7\x4e\x3c\x0c\x16\x83\x42\xe5\x6e\x53\x7d\x8d\x27\xd9\x55\xb7\x9d\x58\x4b\x95\x7e\xfe\xc8\x0d\x8b\xdd\xb2\xf4\xd4\x2d\x21\x19\xed\x98\x4d\x3f\x35\x1f\xd1\x46\x7a\x94\x57\xb5\xe9\x7e\xb0\x49\xc8\xdd\x7b\x21\x75\x12\xe5\x1a\x75\xdf\x6f\xbc\xb2\xf9\xfb\xd3\x58\x2c\x44\xf3\xf9\xec\xb0\xc3\x17\x9f\x9a\x7c\xbd\xd5\x4c\xdc\x6f\x2b\xc7\x32\x5b\x7b\xcd\xe6\x47\x0c\x9f\x1b\x4c\xa8\x7d\x9d\xba\x5f\x76\xe4\xc6\xfc\x8e\x9b\xbd\x96\xaa\x85\x7d\xa9\x5b\x7f\x38\xa1\x05\x35\xed\x8c\xea\x11\x7a\xc8\xee\xc0\x0c\xb6\x1e\x71\x4f\x7c\xbe\x2d\x53\xbd\xc3\xb6\x3b\x2c\x3c\x08\x93\x62\x3e\x56\x78\xe8\x1f\x6a\xf3\x46\xe1\x48\xc9\x74\x3f\xdf\x2a\xb2\x03\x76\xc9\x72\xfd\x25\xcb\x53\xd1\xca\xb8\xc2\x97\x4f\x89\xc3\xe1\xb1\x7e\xd7\x98\xa7\xc5\x35\xb3\x7a\xce\xad\xe9\xc7\x55\x22\x73\x88\x97\xb8\x7a\xbd\xd1\x4f\xd7\x46\xb5\x5a\x6f\xdf\xea\x17\x45\x1a\xb1\x9a\x72\x47\x90\x3b\xc9\xc9\x44\x28\x3d\xde\xe7\x6b\x99\xd2\x5d\x41\x44\xf2\x60\xa3\x9d\x10\x22\xfc\x2e\x3a\xc8\x4d\xab\xb3\x44\x21\x3f\x89\xd5\x5e\xe7\xdb\x16\x45\x4d\x23\xad\x75\x75\x36\x1b\x8a\xfb\x14\x2b\x52\xb9\xa7\x06\x9b\xda\x4f\x93\xed\x62\xf6\xb1\x5e\xaa\x3e\x57\xef\x56\xb5\xaa\x18\xbb\xcb\x4e\xe7\x52\xab\x35\x17\x8e\xad\xfb\x42\x2e\x93\xa8\xbf\x76\xa2\x0f\xdd\xfa\xb0\x32\x94\xb7\xc7\xd5\x61\x58\x1c\xb5\xfa\x7d\xb9\x91\x3d\xc5\xd9\xe8\x3e\x99\x9d\xb4\xe7\xbd\x5e\xa3\x5d\x2a\x25\xd3\xcc\xe3\x73\x6c\x75\x97\x49\x67\xbb\xab\xcd\x26\xb2\xc9\x57\xca\x9d\xd6\xbe\xf6\xd8\xcd\x77\x47\x83\x69\x21\xcf\x3e\xd6\x1e\xe7\xf3\x5a\x76\x93\x89\x4c\xa2\xf9\xd7\x6e\xb1\x3c\x1f\x15\xe6\xab\x68\x6f\x3e\x4a\x48\xb2\xb0\x7b\x38\x26\x3b\xd2\xe6\x84\xb6\xc6\xd7\xd6\x9e\xe9\x74\xd9\xe7\x45\xb3\x55\xd9\x77\x58\xaa\xb8\xba\x1f\x2f\xca\xf1\x42\x0f\x4d\x57\x69\xdd\x7c\xa4\xd6\x13\xb1\x31\xa0\xfb\xcb\xd2\x6b\x94\x9a\xf1\xa7\x39\xd5\xa6\x04\x6e\xb9\x59\x48\x91\x24\x9d\x89\x1d\x04\x26\x4f\xd5\x1f\x8a\x5b\xb1\x26\x2e\x56\xb3\xb8\x78\x7f\x9a\xd0\xb9\x09\x47\xf5\x57\x89\x3e\xb5\x19\x09\xf9\x4d\x73\x3f\x88\xa7\xf2\xdd\xdc\xba\x3c\xe5\xf3\xf1\xda\x7c\xdb\x48\x2e\x2b\xf5\x62\x2e\x35\x46\xba\x26\xcb\xde\x0f\x6b\xaf\x72\xb9\x96\x4d\x14\xe6\xf3\xa7\x07\x7a\x0d\x33\xe8\x90\x28\xc7\xe5\x03\xcb\x73\xed\xfb\xd6\x21\x77\xca\x2e\x33\xf9\x71\xa1\xdf\x2b\x74\x3b\x4b\x91\xcb\xca\xbb\xc8\x5d\x7f\x5a\xaa\x15\xb9\xa5\xb4\x5e\x54\xa4\x5d\x3d\x32\xa2\x27\x0f\x75\x3e\x36\xaf\x27\x06\x14\xca\x10\x5b\xdb\xc7\xe7\x5d\x57\x58\xf4\x9b\xe5\xb8\xc8\x9f\x92\xb1\xc7\xcd\x64\xda\x6e\xca\x03\xa9\x73\x57\x2e\x8c\x5b\x73\x7e\x3c\x45\xeb\x6b\xf0\xd0\x1f\x3d\xcd\x9f\x36\x1d\x3e\x39\x43\xeb\xa9\x25\x0c\x0a\x13\x54\xe3\x24\x57\xdb\xbc\x50\xcf\x34\xf3\xe8\x6b\xb3\xda\xcd\x4e\xdb\xa7\x32\x25\x1d\x1a\x53\xa9\x56\xdf\xec\xa4\x3b\xf1\x59\x98\x94\xef\x3a\xc3\xe7\xca\x01\x6d\x78\xfd\x53\xea\x98\x89\xe7\x98\xcc\x70\x9f\xcc\x97\x5e\xb7\xab\x71\x3c\x7f\x2f\x3c\x6d\xe2\x87\xa2\x2c\xee\xa7\x0b\xaa\xf7\x88\x94\xe7\xe4\x70\x55\x5f\x8f\x6a\xb9\x2a\x2b\x57\x5f\x7b\xf0\xbe\xea\x61\x94\x6f\x3e\xdc\x09\xcf\xd9\x2c\xd7\x17\x22\xeb\xe2\x0a\xed\xe6\xf9\xee\xb0\xb1\xca\xbf\x96\x33\xe9\x32\x9d\x2c\x0a\xcd\x23\x23\x96\x86\xdb\xfe\xa8\xda\x41\x5b\xed\x26\xde\xa6\xf9\xac\x18\x3d\x36\x97\x93\x46\x9e\xdd\xec\x76\x91\xf5\xbe\x52\x79\x5a\xad\x1f\x37\x63\x2e\xcd\x4a\xf4\x46\x18\x0c\x64\xfa\x55\xba\x9f\x15\xa8\x35\xfb\xbc\x2f\x96\xd9\xe9\xe2\x58\x5d\xa7\xa3\xed\xea\x7a\x3b\xe5\x67\x52\x76\x5e\x5a\x25\xc7\x54\x06\xa9\xd7\x87\xe7\x02\x5f\x7e\x4a\x26\x5e\x77\xf1\xd6\xab\x90\x49\x44\xe3\xd4\x21\xc2\x9c\x26\xc9\x4c\xe5\x98\x6e\x34\xa3\xb5\xd6\xf3\x28\x32\x3a\x14\x76\xb5\x72\x9a\x61\x8a\xc2\x6e\xcc\x53\x95\xe7\xd3\xeb\xf3\x46\x4e\x6e\xc5\xb2\x34\xe0\xee\xa4\xf6\x63\xb6\x59\xa9\xb6\xa9\x4e\x3b\x3a\x65\x4e\x49\x69\x17\xcd\xdd\x65\x47\xdb\x87\xec\x7e\x13\xdf\x3e\x1c\xe4\x65\x07\xcd\x93\xe4\xa4\x29\x65\x98\x84\x9c\x8e\x35\xcb\xcf\xcf\xfb\x41\x6d\xd0\x3f\x32\x31\x21\x82\x14\x72\xfe\x59\xea\xc5\x46\x9c\xb8\x67\xab\xb9\xe9\xc3\x6b\x7d\x1d\xe9\x8d\x06\x0b\xa1\xd3\x3c\x46\xd1\xe6\xd4\x16\xdb\xaf\xdc\x71\x96\x3f\x95\xd8\xfd\x2c\x9e\xdd\xcd\x4b\xc9\xfc\x20\x92\xdf\x76\x53\xd3\x4d\x8a\xde\x26\x2a\xdb\x6e\xb4\x46\xd5\x0a\xe9\x42\x62\xdc\xc8\xd4\x33\x7b\xe9\x29\x51\xbb\xef\x16\x5e\x77\xe3\x38\x27\x51\x73\xa4\x16\xcd\x6b\xec\x71\x45\x2d\xd8\x68\xb3\xb6\x2a\x44\xd7\xa9\xc6\x9a\xa5\x72\x88\xe1\xee\x99\xc2\x66\x1b\x29\x72\x6c\x75\x28\x1f\xa2\xe2\xb6\xb8\xbe\x7b\x1a\x6d\x13\x93\x56\x29\xda\x9d\x30\x5b\x2a\xcb\x0a\x7d\xb6\xb2\x98\x3c\xc7\xe2\x62\xb5\x77\xa4\x1e\xcb\xcf\xbd\xe9\xfe\x61\x50\x1f\x64\x0a\xd1\x44\x3c\x2d\xcb\x89\x59\x95\xdf\xad\x2a\x9d\xc7\xc6\x36\xbb\xa3\x12\x74\xbe\xdc\xa9\xbd\xf6\x8e\xc5\x54\x2d\x2f\xdf\xdf\x4d\xe7\x51\x29\xd3\x7a\xad\xaf\xc6\xd5\x61\x01\x09\xe1\xb9\xde\x40\x4e\x6f\x2b\x83\x42\x2c\x31\x68\x3c\x8f\xe4\xca\x5e\xac\xed\x1b\x4d\xb4\x11\xee\xc6\x93\xbb\x03\x9d\xaf\x0c\x1f\xc7\xb9\x3a\x5c\xb0\xab\xd4\x32\xcd\x65\x6e\x32\x9f\xe6\x8f\x2d\xa6\x1e\x2f\x56\xb8\x6e\xf6\xb9\x2d\x8e\x4b\x7d\x61\xd4\x2b\x26\xb9\x1a\x73\x18\xd4\xee\xf7\x54\x3b\x57\x43\xdc\x70\x52\x18\x1c\x4e\x83\x56\x2f\x87\x16\xfc\x6e\xcc\x6e\x77\x4f\xad\xd7\x61\x8e\x97\xda\x85\xc3\xaa\x5e\xa4\xe4\xf1\x24\x72\x17\xdb\xe7\xd7\xf7\x4f\xc7\x6a\xa3\xd7\x1a\x1f\x26\xaf\xfb\x61\x21\x92\xaf\xcf\x0b\xbd\x62\x33\x1b\x41\x62\xc0\x9a\xa6\x1e\xf3\x8f\xfd\x5e\x69\x4b\x31\xd5\xc8\x71\xb2\x5a\xa7\x0a\x2d\xf9\x78\x37\xe8\xae\x28\x31\x59\x60\xe7\xe9\xfd\x70\x75\xbf\x19\xee\xe1\xb1\x4a\x8d\x7e\x96\xa4\x78\x22\x53\x2a\xd7\x3a\x83\xc6\xb0\xd2\x7f\xac\x95\x79\xbe\xb4\x6f\xdd\x15\xf6\xc2\x5d\x6e\x36\x8b\xe5\xda\x11\xf9\xb9\x97\x8a\x17\x46\xec\x40\x2e\x76\xea\xdd\x74\x29\xbb\x6f\x50\xa7\xce\x21\x3d\xbb\x1f\x44\x5b\xa7\x5c\x2e\xf6\x3a\x6d\x9d\xd6\x89\xe7\x75\x9c\xce\xb1\x83\x07\xae\xc7\xd1\xc7\xc7\x4a\x27\x9b\x6b\x0f\xdb\xbd\x75\x76\x5f\xbf\x6f\x1c\xa5\xa4\x5c\x93\x9b\xdb\x61\x35\x15\x99\x6d\x76\x87\x5c\x66\x58\xad\x3d\x3e\x52\xcf\x93\x59\x5f\xee\xac\x96\xfd\x39\x8f\xb8\x5b\x76\xba\xed\x09\x31\x91\xbe\x6b\xbd\xc6\xf2\x5c\x81\xea\xf1\xf9\xd6\x2a\x37\x4c\xb7\x12\xa9\xe7\xfb\x54\x23\x2f\x2d\x97\x59\x4e\xce\x8e\x1b\xad\x55\xe3\xd4\xda\x57\xcb\x43\x4c\xb7\xe3\xb4\xc5\xbf\x0e\x3b\x69\xa1\x98\x2e\xe4\x4b\x0f\xa9\x7d\x29\xc7\x64\xa7\xf1\xd2\x20\x9d\x6a\xcd\x13\xd3\xc6\xc3\x5d\x7f\xbb\xed\xf7\x27\xc5\x5d\x65\x39\x69\xe7\xa3\xed\x7d\xb1\xc3\x0f\x5b\x25\xb4\xae\xf3\x89\xfc\xb4\xd9\x39\xc6\x68\xfe\x2e\x5f\x28\xe6\x33\xad\xdc\xae\x0a\x79\x53\xf1\x90\x4d\x4a\xd3\x79\x61\x3b\x5e\xa5\xc4\x61\xaa\x56\xa4\x93\xab\x68\xb6\xbb\x8c\x31\x7c\xa3\xf7\x48\x51\xf9\xf8\xfc\x2e\x5b\x18\x3f\xb4\x26\xc5\xc2\x6a\x7d\x9a\xe7\xbb\xf3\xd7\x64\xb2\x91\x89\x44\x05\xfa\x7e\xf6\xbc\x06\xdb\x04\x12\x87\x8e\x54\x26\xff\x24\xf5\x2b\x8b\x7e\xad\xc3\x1d\x99\xd5\xa4\x35\x99\x32\xb9\xd6\x7a\x50\xbd\x9f\xd3\x4f\x7c\xaa\x42\xef\x17\xf3\x4d\x7d\xb6\x58\xae\x5a\xaf\x4c\x3c\xcd\x8f\x56\x9d\xe3\xe1\xf9\x51\x66\xda\xab\x59\x3f\x71\x18\x08\x95\xf5\x6a\x3c\x8f\xb7\x87\x72\xe3\x29\x26\x8a\xe9\x48\x82\x61\x9e\x53\xeb\x16\xbd\x29\xcf\x8f\x4f\xf7\xdd\x45\x75\x79\x9f\x5b\x1f\xe2\xb9\x4c\xe7\x79\x3a\xe5\x25\x96\x7d\x1a\x17\xf7\xdb\xfa\x2e\xd5\x67\xef\x73\xf4\x73\x74\x3c\xec\x16\xb8\x72\x86\x1f\xa4\x5a\x43\x26\x3e\xcd\x6f\x4a\xf9\x4c\x3c\x73\xe2\x72\x49\x09\xcd\xed\x51\xb7\x26\x3c\xec\x72\x95\xda\xaa\x2a\xc7\xc5\x66\x66\x5d\x92\x90\xb6\x10\xe5\x52\x4f\x83\x5a\x8b\x91\x3a\xfb\x6a\xb6\x37\x96\xe2\x9b\xf9\x7e\xf7\xcc\xa6\xb7\x0f\x62\xae\x5d\x88\x6c\xb3\xdb\x57\x61\xd5\x65\x1e\x38\x81\x1f\xdd\x51\x9d\x55\x87\x4b\xe7\x27\xed\xea\xa4\x97\x7f\x2c\x66\xef\x0a\xd1\xed\x5d\x79\x9c\xea\xb4\x7b\xc2\x22\x99\x7e\x4d\x8c\x17\xc5\xc3\x24\xb1\x98\x27\x72\x85\x39\x9f\xe3\xc7\xd2\xbe\x0d\xaf\x54\x52\x89\xb2\x38\xea\xc4\xe5\x4d\x6b\x90\x48\x65\x99\xc1\x21\xf9\x1c\xdf\x9f\x3a\x9d\x43\xfc\x21\x46\x25\x36\xb1\xf8\xb1\xfc\x2c\xa4\x0a\xbb\x89\x78\xcc\xe5\x6a\x49\x66\xbf\xad\xa5\x96\xc3\xa9\xf4\x9a\xee\xd6\x5a\x87\x41\x4a\x58\x3d\xb7\xf6\xeb\x45\x37\x8d\x90\x99\x2f\x1a\xa5\x3d\x55\xce\xef\x9e\xcb\x3b\xa1\x3b\xef\xcd\xf2\x0f\xe2\x8c\xee\x37\xf2\xb9\x0a\xf5\xfa\x98\x99\x26\xa6\x89\xc9\x62\x49\xf1\xaf\xc2\x63\x24\x37\xce\x26\x9a\xec\x03\x35\xe8\xf1\x55\x86\x9f\xb6\x36\x53\xbe\x3e\xad\xd5\x86\x99\x87\xc4\xf0\x9e\x6a\x66\xd7\x6b\x86\x3d\x8c\x99\x49\x63\xc8\x1e\x2a\xeb\x2a\xdd\x2c\xd5\x22\xab\x3e\xc5\x27\xd6\x53\x2e\x4b\xe7\xf8\x48\xf3\x70\x9f\xcd\x6c\x5f\x77\x19\x2a\x41\x4d\x5b\xd9\xe1\xae\x5a\x8f\xad\xc4\xec\xe9\x49\x1a\x16\xb8\x91\x38\x3a\xae\x7a\x5d\x24\x32\x73\x8f\xcd\x7a\x7e\x20\x44\x77\xab\x83\x54\x3b\x14\x07\x94\x5c\x67\xee\x4f\x52\x26\x3e\x16\xb2\xf9\xb1\xd0\xad\x66\x62\x1b\x51\xe8\xca\xf5\x6e\x76\x9b\x4a\x67\xda\xa7\xe3\x43\x36\x7d\x97\x13\x0e\x89\xf8\xf1\xf5\x29\xc6\xf5\xd3\x51\xa9\x27\x0e\x2b\x8b\xd5\xe3\xe6\x69\xd4\xbd\x9b\x76\x57\xa3\xcd\x7a\x30\xe2\x36\x83\x44\xed\x34\x39\x2c\x47\x69\x06\x89\xe4\x95\x35\x33\x7e\x5a\xf5\xe2\x11\xf9\x35\xdd\xa8\x64\xd2\x2d\x79\x3a\xee\xd6\xd3\xb1\xf9\xf1\x54\x7a\xee\x71\x23\x6a\xf1\x50\xce\x3d\xe6\xc4\xf2\x9d\x28\x3e\x64\xb7\xe5\x3a\x33\x89\x0d\x26\x91\xf1\xe4\xbe\xb6\x9b\x75\xf6\xc7\x46\x6c\xbf\x66\xc5\x63\x5c\xea\xa7\x84\xf5\xae\xb3\x19\xca\xc5\xc9\xb6\xff\x50\xe8\x3e\xdc\x0f\x1f\x67\xd1\xc7\xd3\xa4\xf2\x4c\x15\x3b\x4d\xaa\x83\xd4\xdb\x36\x5f\x4a\x6d\xee\x47\x19\x61\x39\x78\x28\x56\x87\xd3\xec\x20\x9e\x89\x76\x3a\x93\x46\x6e\x9e\xcf\x33\xfb\x49\x34\x3e\x96\xea\xd5\xc8\x1d\x8f\x34\xdc\xe7\x75\xb4\xbd\xaa\x48\x15\xb9\x5c\xd8\xae\xea\x1b\x49\xca\x0a\xbd\xda\x73\x8e\x3b\xf5\x2b\xc5\xc7\xfb\x9c\x98\x67\x5f\x3b\x83\x5c\x7c\x20\xbf\xf6\x0e\xd5\xe3\x66\xbb\x59\x3f\x9c\xea\xaf\x7c\xa4\xb2\x8d\x1c\xa4\x87\x63\xbf\x7e\xaa\x9f\x8e\x87\xc5\xf6\x99\x5f\x76\x9b\xf5\x74\xfa\x89\x1f\x76\x0a\x91\x07\xbe\x58\x6a\x1e\xa7\x55\xb4\x43\x8d\x4e\xeb\x62\x3f\xf3\xf4\xd4\xdf\xe6\xef\x4e\x87\xfd\xeb\x8e\x1b\x8e\x72\x93\xfa\xd3\x2a\x39\x19\x1f\x1a\xfd\x75\x29\xbe\x5b\x6e\x1e\x4f\xa7\xe5\x60\x5f\xee\xd7\x8a\x99\xbb\x71\x94\x7d\x7e\xdc\x31\xa7\xc4\xac\x3b\xe4\x9e\x3a\xd4\x70\x54\x47\x6c\x61\xb7\x1c\x56\xf7\xc9\x54\x22\x72\x7f\xf7\x90\x2b\x3f\x4c\x7b\xe2\xb4\x47\x95\xc6\xf1\xde\x53\xae\xd8\x4a\x97\x98\xbb\xee\x71\x51\x65\xe4\xa7\xe7\xc1\xa6\x35\xdb\x3d\xe7\x37\x99\x15\xb5\x1b\xe6\x0e\xbb\xc2\x53\x24\x9e\xed\xec\xee\x13\xc7\x79\x3b\x39\xa1\x13\x0f\x93\xf5\x7d\xbf\x9f\xd9\x97\x3b\xd1\x41\xfe\xb1\xb0\x79\x88\x57\xa3\xa5\xce\x28\xb2\x90\xd6\x25\x26\x1b\x2b\x0f\x1f\xa4\x47\x69\x34\x88\x0d\xe6\x19\xe1\xa1\xf9\xf0\x7a\xbc\x63\xe6\xd2\x73\x73\x5b\x69\x95\x13\x54\x7c\xb8\x17\xa3\x77\xd9\x0a\x13\x5f\x6f\x92\xb3\x4d\x6d\x36\x5a\x3d\xee\x5e\xc7\xd5\xf1\x28\x8d\x7a\x57\x6a\xae\xc8\xff\xf1\x43\x2f\x52\x8d\xa4\xfa\xbd\x4c\xef\x78\x98\xc4\xab\x70\x6a\x77\xff\xdc\xa9\x3c\x55\x3b\xcb\xe1\x73\x87\x6b\xad\x9a\xc7\xd1\x53\x85\x1a\x3d\xe6\x8f\x8d\x52\x39\x5e\xef\xe5\xd1\xff\x8d\xfd\xa0\x54\x3e\xb4\x5e\xfb\xfb\xd6\x6b\xfe\x88\xbe\x51\xe8\xf7\xbe\x45\x77\x97\x70\x45\x24\x8f\xe6\x66\x67\xb0\xa0\x46\x77\xa8\x5e\x6f\x2d\x8f\xe3\x9d\xf5\x88\x5f\xe6\x1b\xaf\xf9\x43\xe3\x48\x1d\x1a\x5d\x6a\xdf\x18\x3c\x1e\x1a\x25\xe1\xd4\x2a\x09\xc7\x66\x91\xda\x37\x4b\x02\xda\xef\xc6\x74\xb6\x80\x4f\x68\xf3\xdd\xfe\xa0\xd5\x79\x48\x15\x87\xb5\x9a\xfb\x71\xe9\x44\xe0\x27\xb4\x1c\x9d\xd2\x32\x03\xe7\xa2\x32\x73\x90\xa3\x6b\x8e\x66\x79\xec\xa8\x62\xcb\x5c\x41\xc4\xa6\xfc\x76\x1e\x88\x53\xb1\x4c\x80\x4a\x7f\x4c\xc6\x3f\x26\x62\x81\x08\x85\xfe\xb9\x03\x5d\xd0\x3c\xd2\x62\x85\x79\x14\x02\x2d\xb1\x02\x6f\x07\x1d\xbb\xc9\xf8\xa9\xef\x8c\x17\xe0\x72\x4d\x65\xaf\x63\x69\x57\x10\x73\x56\x8e\x56\xcb\xf9\x92\xbd\x32\xca\x09\x88\xcc\xee\x9a\xc4\x62\xc7\x65\xc2\x57\xcc\x0d\x73\x58\x0b\xa2\x8c\xbd\x2e\xea\xde\x09\x01\xd4\xc7\x80\x60\xf0\x33\xc8\x8e\xd5\x38\xdc\xdf\x7f\xf9\x7e\x65\x71\x30\x7a\x15\x10\x55\xff\x94\xe0\xff\x31\x00\xe1\xa2\x26\x72\x90\x78\xa3\x54\x9c\x5e\x4e\x99\x19\xcb\x33\x9a\xa7\x53\x54\x27\xf8\xed\x1b\x23\x35\x70\xb4\x6d\xf0\x9f\xb2\xa3\xb9\x2d\xf3\x31\xf0\x81\xfa\x0e\xfe\x28\x6f\x8a\xd5\x7c\xa7\x5b\xee\x75\x89\x3b\x48\xf4\x41\x09\x80\xf3\x4d\xc9\x00\xdf\x97\x5a\xa1\x9b\x82\xb1\x0a\xf1\x0e\xf9\x16\xe7\x8f\x5f\x64\x8b\xef\xc7\x2f\x33\x8b\xeb\xc7\x2f\xa2\xc5\xf3\xe3\x17\xfe\x07\x38\x7e\xfc\xb2\xfb\xb7\xdf\xc7\x3f\x83\xdf\x47\xe3\x2c\xc2\x3e\x1e\x0d\x2e\x1c\x4d\x79\x05\xdd\x37\xb8\x3e\xed\x92\x96\x69\x77\x81\x7f\x45\xf6\xf5\x9f\xe5\x5f\xd1\xd4\xad\xa2\x69\x55\x11\xbf\x89\xff\x7a\x6e\x11\x4d\x5d\xea\xf8\x1b\x93\x3f\x99\x8b\x43\x53\x17\x1e\xcd\x5d\xf8\xd7\xf5\x52\x68\xea\xd5\x83\x43\xaf\xfe\x64\x1e\x07\x4d\xf8\x0e\x7d\x2e\xee\x3f\xbd\xf3\x40\x53\xaf\xca\xe6\x15\x9f\xfe\xea\x34\xd7\xfe\x15\x5d\xfd\x99\x7a\x39\x7a\xfb\xd8\xfd\x51\x5e\xfb\x4c\xf8\x56\xcd\xa3\x92\x71\x1c\x95\x7f\x45\x67\x7b\xa6\xfd\x52\x71\xac\xf7\x7f\xc6\x73\x9e\xa7\xe0\x1c\xfd\xfb\xdf\x49\xbd\xbf\x07\x6a\xab\x35\xc7\xac\x18\x1e\x49\xe5\xf2\x82\x09\xd0\xb2\x4c\x4f\x16\x81\x15\x23\x2f\x84\x29\xb8\x6a\xa7\x65\xe5\x1b\x43\x0a\xa8\xc1\x5e\x02\xb2\x10\xa0\x03\x4f\xcc\xb8\x2b\x4c\x96\x8c\x0c\x62\x38\x43\xaf\x6e\x54\xb0\xff\xb5\xc2\xb2\x76\xe0\x40\xdc\xf5\x4d\xa7\x02\x2f\x45\x09\x20\xe5\x97\x56\x92\x63\x27\x0c\x8f\x04\xf9\x46\xad\xa7\x7c\x8b\xe2\xdf\x1f\x74\xf4\x65\x73\x6c\x71\x5d\x8d\x90\x43\x62\x88\x52\x43\x68\x84\x5c\x1c\xe7\xdb\xd5\x04\x63\x28\x91\xdf\xbf\xdb\x23\x47\xdc\x28\x64\xf8\x64\x27\x21\x84\x86\xb2\x06\x6e\x46\xe5\x14\xf7\xf7\x9f\xb0\xfb\xfb\x7f\xfc\x03\xca\x31\x37\x12\x21\x0d\x8e\xe0\xc2\xdc\x7c\x9b\x71\x5b\x69\x51\xd8\xce\x66\x8c\xe8\x1a\x8c\x40\xef\xe4\x5e\x64\x65\x88\x72\xfd\x8d\xe0\x42\xc8\x4c\x6a\x87\x31\x38\xfb\x77\x4d\x4e\x24\xd1\xd1\xd9\x15\x23\x6c\x65\x67\x10\x90\xe9\x0a\x07\x67\x2a\xc0\xcc\x01\x80\x70\x85\x35\xea\x46\x4f\x70\xe8\x88\xec\xdc\x13\x27\x4c\x3f\xbb\x7c\x8f\x40\x64\xa1\x8f\x81\x90\x5b\xff\x10\x25\x25\x46\x36\x74\xcd\x40\x54\x1c\xf0\x2b\xec\x80\x30\x84\x2c\x60\x24\x89\x9e\x33\xe7\xd1\x65\x09\x6a\xc6\x3e\x86\x90\x42\x49\xcb\x74\x18\xc7\xf8\x26\xc3\xa2\x7c\x71\x68\x0b\x62\x20\x2a\xf1\x8e\x5c\xa6\xa3\xa6\xc6\xe0\x78\x89\x6a\xe8\x14\x03\x14\x19\x74\xec\xf2\x0e\xad\xc9\x3a\x2b\xc9\x0c\x8f\x30\x08\xae\x08\xfe\x41\x4b\x7f\xc2\x10\x28\x0d\x07\x32\x11\xf8\x50\x10\x70\x0a\x9a\xb0\x08\x3b\x43\x9b\x70\x82\x44\x60\x4d\x19\x20\xf2\xcd\x98\x05\x4c\x20\x7c\x87\x4b\x0d\x46\x14\x05\xd1\xa5\xc6\x2f\x26\xdc\x49\xbe\x67\x5c\x10\x32\x2d\x84\xd9\xcc\x05\xe5\x90\x6c\x5e\x51\x32\x1e\x13\x65\x35\x7d\x04\x58\x10\xd2\xe6\x46\x64\x56\xc2\x8e\xf1\x4d\x28\x25\x52\x91\x0a\xe8\x17\xd3\xb8\x19\xe2\x9a\x38\x2e\x7d\xc7\x75\x6f\xe6\x16\x38\xe4\xc5\x55\x40\x2d\xec\x06\xdf\x89\x3e\xae\x90\x49\x61\x15\xb2\x99\xd2\x8a\x87\x54\xbf\x8c\xbe\xc2\xca\x3a\xf3\x9e\xe0\x00\xa7\x52\x80\xe6\xa7\x01\x51\xd8\x4b\xc0\xcd\x81\xbb\x4f\xd1\xc2\xe2\xc1\x98\x24\x05\x84\x59\x80\x45\x3c\xb6\xd4\x6a\x04\x18\xb2\x43\xa8\xcc\x5d\x85\xf8\x1f\xff\x11\xc8\xaf\x51\xc7\x0c\xcc\xfc\x3a\xd0\x41\xd0\x3e\x06\x7a\xe2\x16\x4c\x5e\x8c\x02\x14\xe2\x7d\x23\xec\x10\x48\xd3\x16\xb2\xa6\x45\x04\x56\x05\x1f\x58\x30\xec\x7c\x21\x07\xc6\x47\x73\x29\x84\x9f\x92\x65\x45\xe0\x3a\x40\x02\xb5\xbe\xb5\xc1\x3d\x3b\x95\x17\xb6\xf6\x26\xaa\xcb\x5d\xb5\x19\x52\x2c\x44\xaf\xd7\xdc\x11\xc1\x96\xd6\x1c\x7d\xfc\x18\x60\x79\x1c\x13\x1d\xed\x8f\x36\x6c\x81\xaa\xb2\x8a\x10\x10\x91\x40\xd8\xb3\xe8\x07\x14\x9e\x6c\x45\xc0\x43\x85\xcf\x6f\x57\x63\xc4\xdc\x10\xb2\xca\xb0\x84\xbd\xb7\xd1\x19\x2b\xc3\xff\x7f\xdc\x06\xea\xb9\x55\xa2\x09\xb0\x46\xdc\xe4\x8e\x11\x90\xd0\x20\x1e\xbd\x27\x36\x8e\x3c\x86\xda\x57\x26\x14\x19\x8f\x32\x49\x69\xb1\xc7\xcc\xc1\x1a\x35\x04\xc8\xba\xba\x0a\x08\x68\x0b\x40\xad\xec\x11\x03\x12\xf6\x38\x66\xaf\xb0\x5a\x6f\x65\x08\x2a\x78\xe4\x60\xc7\x74\x86\x7e\x65\x63\xc0\x10\x80\x4d\x8b\x18\x89\x03\xdb\xa8\x16\xc3\x01\x98\x07\x43\x41\x32\xeb\x82\xc0\x13\x81\x9f\x37\x68\x79\x71\xb3\xa2\x0f\x10\xf8\xd1\xb3\x1e\x1e\x6e\x54\x0d\x82\x4f\x66\x1c\x1a\xe6\xfc\xa0\xef\x50\x0f\xb8\x06\x8f\x80\x86\xb4\xd6\x39\x87\xd6\xd7\x68\x9a\xb0\xfc\xfc\x5a\x16\xd6\x80\x43\x24\xe0\xab\xf4\x58\x90\x65\x61\x85\x2a\x38\xb4\x3b\x81\xe0\xe2\xbe\xdb\x15\x15\xa2\xf9\x6d\x99\x63\x66\xb2\x73\xbb\x5b\x1c\x4e\xed\x06\x2d\xa9\xa2\xc0\xcb\x34\x5a\x6e\x58\xac\x32\xa6\x6f\x66\xac\x28\xa9\x63\x5c\x5c\xb0\xdc\x14\x38\x3d\xaa\xb7\xbd\x61\x79\x94\x5f\xed\x35\xea\xb7\x4e\xbc\x75\x7b\x83\xc3\x98\xde\x28\xeb\x19\xd5\x08\x92\x15\x8d\x76\x0e\x43\x5d\xf8\xfe\x14\x24\x41\x46\xb7\x38\x7a\x38\x04\x0e\x47\x68\x17\x39\x16\xb5\xd8\x61\x26\x72\x28\x7c\x83\x07\xfc\xca\x09\x26\xaa\x2a\x7b\x56\x25\x73\xcc\xda\xe6\x94\x04\x40\xd5\xe8\xb7\x08\x44\xd1\xb6\x07\xc1\xeb\x0d\x1f\x27\xe8\x23\xc4\x29\xb5\x8b\x16\x88\x8f\x48\x26\x93\xba\xd6\x79\xcc\x9d\x45\x97\x00\xeb\x78\xff\x46\x9c\xc5\x7b\x0d\x6b\xd1\xa0\xac\x4b\x1f\x15\xb5\x90\x9a\x88\x26\x22\x23\xb1\x27\x26\x24\x42\xb0\x7b\x88\xec\x0a\x03\x28\xb9\xee\x8f\x5e\xfc\xc4\x75\x9f\xb4\x62\x02\x1b\xa6\x6b\x0b\xd6\x1e\xba\x42\x45\x05\xed\x90\x2e\xde\x77\x11\x37\x93\x26\x22\xc3\xf0\x01\xcc\xbf\x71\xb0\x2d\xcc\xcf\x6f\x5e\x25\x6f\x3e\xaf\xd5\x34\xfc\xf9\x27\xe1\xfa\xb2\x30\x9f\x73\x0c\xf4\xad\x4b\xfa\x76\x4e\xde\xc3\xb3\xe6\xd6\x4b\x6f\x22\x52\x9e\xca\xbc\x27\x1c\x2d\x49\x20\xd6\xa1\x59\x83\xd7\xba\x14\x0a\xea\x54\x08\x42\xec\xba\x20\x11\x00\x83\x48\x24\x0c\x22\x92\xc1\x6f\x80\xa1\xfe\xad\x66\x5f\x39\x41\x7d\x11\xbf\x9a\xe1\xb9\x4d\x16\xbd\xa3\x92\x43\x47\xad\xbd\xb4\x13\xe6\x87\xc8\x6e\x3d\x04\x23\xa0\x4c\x11\x84\xda\x0e\x07\x8a\x23\x3a\xba\x84\xe7\x13\x91\x40\x11\x6f\x41\x7c\xda\xac\xa1\x2b\xa9\xa9\xe0\xaa\xab\x7b\xce\x41\xad\xba\xfe\xd7\x9f\x5a\x71\xd7\xb0\xcc\xff\x5b\x83\xff\x3f\xaa\xc1\xab\xfb\xd3\x7d\xb7\xd5\xbc\xc1\x9b\xa5\xaa\xae\xdb\x83\x22\x07\x25\x79\x8a\xd0\x08\x42\x44\x63\x11\x8e\x72\x21\x8c\xa2\x93\x09\x40\x7c\x89\x7d\x35\x1a\x00\x70\xfa\x87\xe8\xff\x18\x4d\x09\x87\xa2\x65\x67\xc7\xd0\x0b\xa0\x84\x0f\xd1\x19\xb7\x06\xe4\x2e\xda\x4e\xdf\x01\x9f\x91\xbf\xc1\x86\x1c\x54\x64\x29\xe0\x4e\x78\x6b\x76\x68\xef\xc7\x9a\x22\x48\x16\x11\x07\x82\xc6\xce\x9c\x35\x53\x68\xcb\xba\x74\xb1\xbd\xc2\xa3\xaa\x59\xf0\xb1\x14\xfc\xd7\xb5\x60\x9c\xe1\x81\x9e\xa6\x0c\x4b\x5d\x9f\x36\x0d\x4f\xd2\x9d\x6f\xab\xf4\x4e\x2b\xc7\x0f\xb9\x07\x42\x2a\x02\xf3\x00\x59\x5f\x0c\xc5\x33\x8a\xbc\x1d\x44\x52\x3b\x86\x33\xc5\x6c\x02\x3a\x8c\x54\x75\x9e\xde\xb1\x73\x5a\x16\x44\xa2\x8d\x0a\x20\x78\xf0\xc2\x14\x4b\x23\x5a\xde\x0d\x04\x28\xcd\xcf\xd1\xf0\x1a\x6e\x4a\xb8\x97\x46\x4a\x83\x8c\x76\xf3\x15\x41\x45\xbe\x61\xa5\x0a\x2b\x32\x33\x01\x42\xd0\x7f\xf8\xf0\xbf\x12\x52\x11\xa6\xcc\xa1\x85\x26\x9d\xf2\x1d\x47\x9e\x46\xc5\x1a\xdd\x5a\xd9\x56\x06\x3e\x22\x39\x09\x6d\x65\xe6\xef\x3d\x08\xb5\xca\xcb\x5a\x5d\x1a\xd4\x3b\x56\x17\xb3\x5e\x82\xe8\x13\xcb\xcb\x02\x0e\xbb\x04\x09\xa4\x6c\x30\x9c\xf2\x77\xbb\x5d\x54\xfe\x4a\x67\x1f\x82\x5f\xaf\x02\xbc\x02\xa7\x86\xf4\x39\xac\x45\xb5\x69\x4c\x28\xb4\xf9\xa8\x19\x0b\x12\xf7\x19\x65\xc1\x5f\xa6\xcc\x46\xf7\x09\x2b\xc2\x92\x15\x07\xe5\x33\xb4\x85\xfe\x8c\xa5\x95\x3f\x12\x71\xe5\x8f\x62\xd9\xd8\x78\x9d\xe5\xb7\x40\x25\x5e\xef\x26\xfe\x84\xfa\xff\xdb\xa7\x00\x75\xc1\xec\xc1\x9f\xb4\xb2\xac\x6d\xe5\x83\x05\x03\x76\x55\x98\x0a\x68\x09\xa0\x85\xf9\xec\x62\xb8\xd0\x82\xea\xc2\x66\xa4\x14\x25\x8a\x24\x49\x0c\x6f\x11\x5b\x00\x36\x80\x83\xe6\x4a\x0c\x37\xbb\x99\x0a\x93\x2d\x96\x53\xd5\x3f\x14\xc5\xf6\x16\x35\x11\xb8\x06\x95\x0b\xb1\x1c\xc4\x33\xeb\x48\x65\x06\x50\x86\x4f\x3d\x61\x4d\x54\xcd\x20\x49\xb7\xb1\xf1\x23\x08\xf1\x7c\x81\xf9\xa8\xc5\xc8\x67\x60\x41\x66\xf3\x88\x4d\x94\x7a\x81\x05\xff\x55\x39\x4b\x32\x13\x45\xd0\x25\x29\xb0\xc5\xc0\x28\x58\xd4\x06\xd8\xfd\x14\xd2\xd9\x00\xd3\xe4\xc6\x14\x36\xa6\x4c\x18\x96\x0b\x85\xf0\x97\x08\x22\x2b\xc2\x53\x24\xba\x34\xd2\x6c\xe3\x08\x49\x24\x0c\xa0\xbf\x94\x6f\x60\x85\x41\xbb\xae\xa9\x2e\xfe\x00\x25\x88\x1a\x8d\x8b\xe8\xe0\x11\x77\x09\x69\x46\x1b\xc8\x40\xf2\x05\x5e\xd4\x11\xfc\xdb\x08\xcd\x52\x34\xa6\x14\x95\xd4\xa2\x46\x42\xbc\xf1\x92\x19\x36\xf5\x08\xe2\x54\xea\x30\x68\x9d\xb3\x3b\xa6\x27\x28\xc4\x07\x6a\x99\x4a\x00\x8b\x50\x3e\x74\xe8\x7d\xe1\x28\x33\xda\x77\x27\x71\x16\xe1\x69\x1d\x01\xcc\x65\x2c\x45\x88\x15\x8b\xc7\x74\xe0\xf0\x5f\xb1\xaf\xf6\xe1\x01\x01\x2e\x11\x87\x12\xca\x1f\xbf\x1f\x3e\x06\xe8\x2b\xb8\x1b\xc5\x7d\xff\xa3\x2e\xe5\x59\x99\x71\x42\xe1\xc5\xce\x22\xb7\xf6\x95\x71\xd0\xc8\x20\x90\x34\xa8\x7f\xb0\xee\x10\x21\x6e\xf0\x7d\x43\x40\x06\x62\xae\x75\x11\xdb\x25\x42\x25\x44\x5c\x63\x6f\x38\x76\x7c\xa3\x7c\xbb\x69\xa0\xcd\x59\x3c\x2a\xe1\xaa\xa1\x92\x56\x8a\x80\xe8\x29\x7a\x96\xa1\x04\xb1\x72\x31\x33\x29\x14\x86\xb8\xd6\xa1\x20\x88\x04\xd7\x0c\x3f\x11\xc0\xee\x03\x9c\x4b\xa4\xf7\xc1\xb0\xb1\xc6\x94\x99\xa0\xe6\xe0\x48\x49\x45\x54\xcd\x66\x05\x35\xe4\x36\x2e\xc8\x0a\x37\x20\x91\x86\x4c\xb5\x11\xaf\x94\x69\x8e\x7b\x60\x8e\x63\x81\x16\xa7\x21\x63\xfc\x6b\xeb\xc8\x1a\x37\x6c\x96\x9f\x09\x7e\x6c\x20\xbf\x4f\x54\x03\x3b\x6e\x53\x49\x5d\x29\x76\x24\xfc\x0d\xfe\xfc\xee\x26\x18\x20\xd9\x7a\xbd\x3d\x63\x4f\xc2\xdc\xf4\x83\xb9\xab\x98\x2f\x1a\xbb\x8e\xa5\xee\x7e\xaf\x92\x0d\x31\xae\x52\x88\xb4\x10\xf6\x4e\x5a\x82\x93\xf0\x46\x62\x90\x6b\x85\xa1\x48\xe0\x37\x24\xac\x7d\x36\x37\x0b\x20\x21\x3a\x39\x47\x1f\x15\x30\x1f\x3d\x0b\xe0\xc8\xc9\x6e\xf8\x11\x69\xcf\x09\x43\x47\xec\x9c\x9a\x30\xa2\x7d\x85\xd8\xa3\x2b\x29\x18\x99\x6c\xa0\x3d\x56\xe6\xce\xe8\x0a\x7a\x63\xe6\x5a\x5e\x94\x26\xd3\x9c\x81\xf8\xb6\x5e\x21\xeb\x8d\xea\x3a\x34\x63\x56\xc0\x14\xbe\xb0\x64\x8e\x12\xaa\x76\x83\xf6\xcb\x32\x88\x81\x3a\x2c\xd1\x59\xad\x71\x5a\x69\xa0\xad\xbf\x88\x5f\x2d\x0a\x8c\x6b\x07\x04\xbe\xc6\x9f\x9d\x98\xca\x2a\x44\x85\x07\x3d\xb4\xc2\x10\x4f\x13\x96\x3e\xf4\x4f\xb4\x94\xed\x7a\x94\x02\x0a\xf8\x41\x17\xeb\x62\x6f\x81\xe3\xde\x9b\x0e\xd6\xa9\xde\x3a\x10\x7a\x47\x55\x9e\xe6\x00\xcf\x49\x6d\x20\xe3\xa1\x1e\x26\x7e\x82\x9d\x46\xc6\x0c\x81\xec\x68\x4c\xc8\xac\x35\x78\x76\x62\xca\xd0\xa8\xa5\x1d\x1c\x99\x9d\x5d\x1a\x0e\x83\xa2\x5c\x90\x75\xa2\xb3\x39\xcb\xa1\x93\x86\x02\x78\x6e\x6d\x79\x0f\xbe\x6a\x5d\xd3\x12\x23\x9f\x47\xd8\xb4\xf4\x7d\xb3\x70\x73\x53\x58\x1b\xf6\xc9\x36\xce\xf5\x40\xb1\xbb\x85\x55\x4d\xa3\xaa\xec\x6f\x82\x79\x6b\x27\x16\x41\x54\x9e\xd8\xed\x10\xdf\x67\x20\x00\x10\xe3\xbe\xd7\xc3\x24\xfb\xf6\x0d\xed\xae\xb0\xcf\x23\x80\x8e\x7b\xbf\xb1\x28\x5a\xc7\xad\x3d\x0f\x39\x25\x46\x9a\xe8\x35\xf4\xef\x50\x03\xf2\x44\x76\x8d\x76\x66\xe7\xba\x4d\x7a\x85\xf9\x90\x63\x65\x9c\x69\xac\xb7\xa0\x25\x25\x5b\xaf\xa2\xd3\x59\xcf\xb5\xe2\x4a\xc8\x00\x87\x52\x32\x2d\xa2\x36\x90\x40\x85\xd8\x7d\xe0\xd3\x6f\xda\x38\x68\x32\x3f\x8f\xda\x04\xd9\x9b\x36\xee\x07\x1a\x61\xb4\xfa\x50\x0c\x49\x3e\x01\x94\xf8\x08\x65\x5f\xe0\x03\x12\xcf\x18\x1e\x89\xff\x22\x3d\xe6\x90\x3c\x24\x8b\x5b\x26\xa0\x8a\x44\xdf\x8d\xf8\x4c\x84\xf5\x11\xc0\x49\x18\x25\x24\x31\xce\x44\x61\x05\xa3\x35\x61\xd6\x32\x98\x0b\xa4\x89\x09\x3b\xd0\x5d\xa0\x08\xde\x5d\x89\x1a\x8b\x93\xa0\x8f\x05\x05\x4c\x87\x20\xe8\x8b\xb6\x3c\x75\x46\x04\x4d\x52\x17\xf4\x95\x43\xb3\x1f\x31\x70\x38\xbc\xb6\x8d\x07\x6e\xcb\x64\x53\xc2\xc7\xbf\x46\xfa\xdf\x4c\x50\xa7\x31\xea\x08\x08\x36\x8a\x00\x30\xd0\x87\x48\x27\xcc\xbc\xc3\x40\x3f\x52\x43\x23\x5d\x08\xf7\x13\xda\x7b\x41\x9f\x2d\x04\xfc\x10\x9a\x92\xa9\x65\x9d\x6d\x21\x42\x2f\x68\x1a\xeb\xc9\x50\xec\x46\xaf\xa9\x11\x5d\xe7\x5a\xaa\xed\x42\x70\x18\x0d\x59\x28\x0a\xab\x95\xc0\xdf\xc3\x33\x12\x58\x3a\x18\x27\xc3\x28\x85\x74\xfc\xe1\x95\x89\x45\xfa\x0d\x28\xe2\xaf\x32\xdc\x57\x60\xef\x37\xc8\xc1\x7b\x66\x4c\xac\x3e\xdf\x0c\x8f\x68\x94\xf6\xd5\x99\x19\xb2\x15\xd2\x0f\x07\x8b\x02\xcf\x33\x78\x10\x55\x6a\xe9\x5f\xae\x6c\x65\x2a\x88\x17\x23\xe1\xd7\x5e\x54\xc9\x50\xac\x32\xa4\x79\xc2\x25\x0c\xc6\x7d\x23\x2d\xec\x38\x29\xb5\xf0\x51\x8c\x1d\xb2\x86\xf0\x44\x40\x1c\x4c\xdc\xc2\xc7\xd0\x56\x44\xfc\x19\xaf\x50\xb0\x53\x1a\x27\x21\x66\x79\x28\x1b\xce\x58\x45\x83\xf2\x8d\xbf\x6b\x35\xe0\xd4\x54\xfd\xdb\x3a\xa4\x13\x91\x01\xf1\xdb\x08\x54\x55\xe7\x91\xb0\xaf\xe3\x17\x52\x9b\xba\xb2\x00\xb7\x4c\x92\xef\x8e\xbd\x7b\x4b\xb7\xc6\x48\x41\x57\x74\x0e\xed\xec\xc6\x5a\xe7\xd6\x5e\x05\x2c\x9c\xb4\x78\xec\xa1\x45\x0c\xe6\x00\x5a\x14\xe9\xe3\x18\x5b\xb1\x83\xd6\xbe\x23\x16\xc7\x1b\x7a\xae\x91\x04\x76\x9a\x90\x23\x3e\x37\x4a\x9e\x15\x12\x36\x35\x93\x5b\x72\x4e\xb5\xf4\x6c\x6b\x45\x56\x6a\x99\x91\x20\x5c\x42\xaf\x8a\x86\x67\x7a\xec\xca\x58\x2e\xf8\xa4\x13\xe2\xa6\xd8\x6a\x36\xcb\xc5\x5e\xad\x79\x87\x59\xd6\xd9\xf2\xad\x76\xb9\x69\xde\x30\xd5\xc5\x8c\x16\xdc\xed\x2f\x76\xf9\x44\xc9\x9e\xd1\x9c\xc4\xd8\x08\xc7\x63\xac\x81\x7b\x8d\xe9\xc9\xd2\xb9\xd3\x02\x0f\xf4\xc5\x77\x18\x76\xf8\x7e\x8b\x81\x15\x63\x32\x2b\xb5\x43\x86\x51\xfc\x6e\x6f\xa9\xc3\x4c\x18\x76\xc7\x9c\x6d\x4c\xd7\x66\xce\xb5\x87\xb3\xad\x47\x22\x0e\x2d\x17\xf1\x58\x9f\x6b\x57\x95\x4b\xde\xde\xcb\xef\xff\x96\x3a\xfe\x2d\x75\xfc\x5b\xea\xf8\x73\x4a\x1d\xb2\x7c\x3c\x23\x72\x18\x4a\xe8\xf2\x06\x62\xbc\xbd\xde\x50\xa5\x11\x49\xa9\x72\xc6\x4a\x9a\xe7\x27\x4b\x5e\xd8\x73\xcc\x74\xce\xb4\xb0\x8d\x48\x2d\xe9\x94\x67\xa8\x87\x59\x52\x87\xa1\x25\x5d\x8e\x31\x7f\x35\x96\x15\x56\x6b\xa4\x9f\x49\xcc\xd4\xd6\x84\x35\xcb\x50\x0b\x1b\x06\x0c\x45\x71\xda\x9a\xdf\xe7\x01\x45\xde\x5a\x4c\xf9\x6c\x28\xfd\xc0\x30\xeb\x3c\x87\xd8\x77\x1b\xe9\xa2\x86\xe2\xa6\xef\x8e\xe5\x05\x97\xf2\x82\xa9\x7c\x1d\xed\x73\xfc\xe4\xd8\x61\x80\xfc\x86\xf2\xa6\xef\x86\xf2\x36\x4a\xd8\xfa\x6f\x41\xd4\x82\x9f\x05\x2d\x0b\x36\x44\xa3\x56\xf5\x6b\x43\x39\x73\x86\xa1\x06\xb9\x24\x53\x47\x5b\xb4\xa1\xb4\xfe\xd1\x58\x12\x0d\x17\x62\x06\x65\x7e\x6a\x2c\xa9\x7d\x34\x95\x94\xd5\xf1\x65\x4d\xd3\xc4\x9c\x61\xae\x61\xb0\x67\x99\x6b\x18\x32\xac\x35\x88\x10\x36\x20\xcf\xef\xad\xb5\x4c\x99\xe6\x9a\x68\xa6\x4e\x5b\x3c\x77\x34\x57\x51\xbf\xda\xca\xe2\x93\xd8\x9e\xb0\x64\x78\x5b\x05\x3d\xcb\x5a\x6b\x42\x44\x4f\x6b\x0d\xe5\xb3\xb9\xb4\xc1\xe8\x67\x2e\x6f\xc8\x30\xd4\x50\x66\xb9\x6d\x2a\x99\xbe\x9b\xca\x73\xe6\xf1\x25\x1f\xd4\x12\x6b\x67\x3a\xae\x9d\x29\xa8\x89\xbe\xd6\x72\xd2\x65\x3a\x89\x81\x69\xa9\x0a\x09\x48\xe6\x01\xa3\xce\xf0\x12\x24\x05\x83\x5f\x9d\x4a\x28\x88\xa1\x72\x71\x63\xb6\x85\x17\x80\x08\x4e\x05\x9d\x0a\x40\x4e\xcc\x9a\xd3\x26\x26\xab\x60\xdc\x9a\x61\x5e\x41\x50\x24\x61\x2d\x62\xe2\x11\x50\x22\x65\x2d\x61\xe3\xac\x50\x2a\x6d\x2d\x45\x86\x07\xb2\x0a\xf6\x2c\xc3\x10\x3b\x76\x4d\xcf\xb2\xf7\x4d\x70\xe9\x5b\xd7\x6a\xad\xb6\xf7\xad\x6b\xb5\x38\x07\x93\x0e\x45\xb4\xf9\xed\xd8\x7b\xc3\x1a\x73\xec\xb7\xce\x4c\x20\x3b\xef\x4e\x5e\x65\x8c\x6c\xd4\xb1\xee\x2a\x50\xa8\xe8\x8c\x85\xbe\x70\xa1\x50\xc9\x06\x49\xdf\xd0\x20\xbf\xe6\x00\xc4\xc0\xc9\xa0\xc8\xbd\xad\x88\xc6\x44\x21\xfb\xc1\x91\xa0\xd6\x89\x1c\xac\x5b\x8b\x99\xf6\x11\x28\xd1\x30\x95\x20\xaa\xa6\x66\x69\x0d\x8d\x8f\x32\x23\x99\xa4\x3f\x52\x6e\xb2\xd8\xf2\x4b\xbc\xa4\xb4\x13\x47\x4d\x90\x83\x13\x3e\xea\x16\xfd\xfa\x35\x80\xab\xdf\x70\x0c\x3f\x97\x17\xf0\x25\xf2\x29\x90\x8d\xe5\xe2\x46\xf9\x8f\x40\x22\x47\x61\xa4\xdd\x1b\x90\xa7\x8a\x0b\x5a\x2c\x0a\x53\xe6\x06\xbf\xd2\x08\x11\x8b\x2e\x01\x27\x6d\xc7\x58\x07\x0e\xb1\x70\x64\x1e\x21\x10\xc3\x6e\x42\x96\x02\xff\x55\x60\xf9\x50\x30\x68\x96\x7f\x8d\x7d\x86\x43\x59\x2c\x01\x13\xa5\xd7\xd6\xe3\xb1\x92\x0f\xda\x7b\x9f\xe5\xe5\x6c\x1e\xe3\x00\xa5\x95\x0e\x86\xbd\x28\x61\x28\x87\x3e\x44\x22\x46\x12\x60\xd0\x2f\x2c\x9c\x5d\xe3\x62\x13\xa5\xef\x79\x39\xc4\xba\x75\x0b\xd7\x71\xe8\x8c\x44\x66\x50\x8f\x9e\xe3\xce\x6c\xb6\x8c\x78\x74\xe8\x8d\x4c\xf2\xf5\x2b\x97\xb8\x20\xd2\xb5\xd7\x1c\x3d\x61\x42\xd1\xff\xfe\xf2\x39\x8a\x64\xcb\x60\xf8\x46\x5a\x73\xac\x1c\x0a\xfe\x35\xa8\x1f\xe8\xc0\xb3\x01\x7a\x65\x51\x0c\x09\x5c\x96\xdc\x67\xa7\x57\xfa\xbd\x8d\x4f\x41\x83\xa2\x48\x4a\x61\xb5\x06\x75\x16\xad\xee\x29\xd3\xef\xd4\x60\xea\x0b\x3c\x52\x36\x43\x40\x2a\x38\xb4\xc3\x30\x02\x1f\x15\x58\x68\xc4\xc9\x85\x37\x78\xa9\xc1\x86\x0d\xf0\xf0\xed\x0d\x04\x4d\x6f\x0e\xf5\xec\x06\x29\x18\xa0\x70\x50\x66\xf3\x00\xf4\x19\x6b\x46\x06\x78\xc9\x30\x50\x5d\x6d\x35\x08\xd7\x77\x1c\xb0\xb2\x62\xc1\xe2\x5b\x04\x3a\xb5\xbe\x44\xa2\x73\x44\xad\x40\xd0\x88\x9a\x3a\x60\xba\xf8\xaf\x8a\xfd\x08\x0f\x87\x91\x9b\xe8\xeb\xbf\xbb\x5d\xc3\xf2\x64\x80\x6f\x29\x5a\x54\x89\x31\xe6\xe3\xfb\xbe\x58\xc1\x31\x5c\x66\x32\x02\x9b\x6a\xc5\xb5\x55\x7c\xa5\x3d\x02\xd6\x0d\x00\xb6\x79\x41\x6e\x12\x2b\xd3\xbc\xc0\x09\xe3\xd0\x0b\xae\xfc\x35\x7c\x43\xb2\x42\xe1\x9b\x35\xbb\x66\x7a\x0b\x51\xd8\xce\x17\x21\x28\xe6\x80\x5a\x88\x34\xa5\x93\x03\xca\x29\x2f\x64\xd0\xc6\x87\xc8\x2a\x31\x21\x02\x30\x7c\x83\x17\xb3\x72\x2d\x33\x7c\x23\x2f\x18\x3e\xa4\x11\x31\x44\x0c\x5d\x18\x53\xcd\xf0\x60\x64\x52\x21\xcb\x7a\x54\xca\x1b\x5f\x9f\x84\xf0\xdd\x41\xbb\x1d\x03\x21\x21\x20\x91\x82\x13\xe6\xa1\x60\x85\x66\xd1\x2e\x0a\x37\xac\x0d\x94\x13\x14\x81\x28\x88\x86\x9b\xc0\xb8\x75\xb2\x83\x04\x83\x0e\xa3\x6e\x61\x34\xd8\x7a\x48\x94\x26\x47\xcb\x21\x1c\xd7\x5c\x05\x26\x56\xe3\xe9\x55\x00\x69\xf8\x68\xe4\xe8\xad\x4c\x36\x17\x9b\xcd\x46\x39\xe7\x81\x5f\x16\xd3\xa1\x0d\x18\x2a\x66\xfb\x66\xa9\x03\xad\xc1\xdb\x20\x71\x2e\x59\x73\x54\x0c\x20\x5b\xfd\xdb\x52\x46\x34\xec\xd6\xd7\x31\x5b\xa6\x65\x8f\x3c\x67\xbc\x0c\x04\x80\x85\xea\x18\xab\x97\x27\x6c\x7d\xb8\x51\x2d\xbe\xb7\xa6\x9a\x6b\x34\x3b\xf0\xb5\x69\xf3\x67\x0d\x49\xe5\xc6\xb2\x95\x37\x49\x68\x91\x82\x41\x27\x64\x9b\x30\x50\x5b\xd2\x44\x0a\xbc\x38\x4d\xb6\x44\x0d\x65\xd8\xeb\xa7\x85\x63\x97\x11\x77\xe4\x3a\xb6\x6b\x29\xfd\xee\x40\x30\x68\x2d\x20\xea\xa2\x8d\x23\x00\x24\xd3\xd1\x5c\x79\xb2\x10\xdc\x0a\x20\xb9\x76\x82\x85\x17\xbc\x0b\x59\x9b\x37\x4b\x1b\xf3\x13\xbb\x36\x61\xa0\x88\xc6\x0c\x7e\xe0\x44\xa4\x1f\xf3\x76\xaf\x17\xa2\x75\x21\xd4\x59\x64\xd0\x59\xb5\x01\x25\x1b\x7b\x26\x56\x67\xed\x22\x79\xc8\x01\x84\xd2\xa6\x32\xfc\xc4\x08\xed\x28\x05\x47\xf4\xce\x3b\x5c\x12\x77\x25\x8c\x72\x9a\x4a\x59\xea\x7c\xff\xc5\xb9\x36\x92\x66\x0c\xdb\xb0\xc5\x12\xaa\xd3\x07\x5f\x75\xd1\x49\xe8\xd4\xb1\xfd\x02\xb1\x1f\x44\x1e\x13\xb9\x15\x71\x01\x5f\x63\xf9\xeb\x5f\x2d\x63\xf1\x42\x7d\x55\x2f\xe3\x7c\x20\x07\xde\x76\x6a\x92\xe6\x49\xa9\x38\x6c\xcc\x66\xf0\xd2\x82\x9d\xc1\x8b\x35\x52\xc0\x4a\x07\xfd\xec\x99\xe4\x87\x14\x38\x36\x7a\x1a\x86\x5f\x2d\x63\x15\x88\xec\x64\x74\x20\x93\x76\xb5\xc8\x2e\x81\x29\x84\x37\xa3\x8f\x25\xc6\xdf\x35\x26\x0d\x95\x0c\x7b\xad\x42\x57\x03\xe9\x43\xe1\x33\xe3\x34\xb1\x4b\xfb\xae\x93\xd9\xb4\x3c\x40\x90\xd2\xf0\xc0\xb7\x9f\xbe\xdf\x9e\xc5\x5c\xf9\x66\x41\x58\xdf\x7d\xd4\x4d\xdb\xb0\x50\xaf\x5c\x49\xa3\x35\xa1\x5f\xd0\x82\x92\xd6\xa1\x72\x27\x47\xc0\x42\x3a\x2b\x79\xd4\x25\xa7\x9c\x93\x84\x5c\x49\x02\x33\xa6\x46\x2e\xa3\x19\xaf\x44\xcc\x04\x6b\x7b\xd6\x75\x6c\x79\xd6\x60\xc1\xdc\xce\x07\xf2\xe2\x1c\xdf\xa8\x55\xaf\xad\xe1\x8d\xd2\x5e\x4a\xdd\xaa\x3e\x5a\xb6\x31\x7b\xd1\x0a\xda\x44\xb6\x22\x58\x8b\x7e\x57\x54\x82\x8a\x08\xf6\x67\x62\x4b\xbd\x0a\xcc\x38\xf2\x4c\x55\x14\x38\xf5\xd3\xc4\x68\x95\x72\x92\xdf\xae\x02\x92\xc1\x1e\x46\x6c\xb2\xf6\x96\xeb\xc0\xc9\x19\xe3\xc5\x71\x8e\xe6\xe7\x5b\xb8\x12\x66\x2b\x6b\x31\x1f\xd9\xb7\x56\x7b\x15\x9b\x5d\xcb\xc5\x12\xa3\xff\x03\xed\xe1\xa3\x51\x95\x08\x69\x24\x0e\x5b\xd9\xa5\x29\x1d\xb6\x0f\xb2\x04\x3b\x19\x98\x3a\xaa\x34\x3f\xe5\xf0\x7e\x18\x42\x6d\xaf\xb6\xea\xb5\x43\xc7\xe9\x6c\x9d\x1d\x36\x14\xed\x16\x94\x48\xc0\x7b\x0a\x39\x4f\x23\xe5\x75\x2d\xb9\x13\xa9\xe2\xe5\x58\x0a\x70\x75\xc8\xf8\x6e\xfb\x66\x25\x91\x75\xa1\xdd\xba\xdc\xf1\x51\x6f\x7d\x85\x4c\xf4\xb2\x3e\xc1\x35\xe6\x85\xd4\xd5\xa6\x5f\xe4\xd4\xbe\x60\xd2\xba\x37\x85\x2d\x56\x56\x12\x85\x58\xf8\xea\xb2\xf1\xe2\x53\x17\x55\x28\x09\x3b\x92\xd2\x61\x6f\x26\x96\xb1\x48\x80\x40\xb6\xef\xc5\xdf\xbd\xe7\x93\x85\x8f\xaa\x22\x5d\x00\xee\xd7\xcb\xf0\x8c\x41\xdc\xd1\x9c\x8b\xac\xe0\x80\x4d\xdb\xce\x71\xd1\x7e\x9f\xa0\x02\x7f\x0f\xc4\x98\x84\x99\x03\x86\x5d\x59\xa0\x7a\x80\x1b\x52\x4e\x68\x5d\x84\x1d\xf5\xfc\x16\xdf\xdc\xe2\x27\xa0\xc5\xe5\x75\x55\xc7\x6d\xbb\x76\xb1\x33\xa8\x4d\x59\x29\x28\xed\x59\x79\xb2\x08\x38\x99\x4e\xc8\x0e\x82\x04\x85\xb0\xd3\x68\x4d\x68\x89\x31\x1c\x20\x38\x8c\xa6\xb2\xef\x9b\xb4\x2d\x8b\x05\x26\x16\x0e\x3b\x8c\x69\x20\x30\x46\xf3\x64\x79\xeb\xda\xa6\xed\x20\xc7\x71\x2e\x99\xcb\x38\x34\xed\xbb\x65\xeb\x24\x23\x8a\xb8\x97\x90\xa7\x68\xd1\xe4\xdd\x9f\x42\x7b\x27\xd6\xb6\xa6\x8f\x9c\x80\xdf\xc9\x60\xcb\x8d\x04\x4f\x68\x11\x6a\xe6\xa2\xea\x18\x41\x11\x18\x0e\xfb\xc8\x9f\x19\x0b\x65\x24\xd0\xd6\x30\x0e\x29\x2d\x3a\xf5\xde\xb1\xef\xfe\x69\x6e\xa7\xb8\x6e\x18\xb3\xb4\x7d\x69\xe3\x96\x13\x1c\xd7\xa6\x89\x36\x62\x78\xe8\xa9\xb6\x78\x43\x0c\x0a\x17\x36\x8b\x0f\xb7\x2e\xc6\xd4\x78\x7a\x62\xbf\x1d\xeb\x76\xb9\x5a\xc5\xf4\x72\xca\x18\x4f\xaa\x9c\xd4\x1d\x7c\x44\x61\x34\x95\x3b\xd0\xe7\xd6\x1b\x51\x43\x13\x21\x03\xac\x37\x20\xab\x1f\x44\xb9\xa0\x8a\x24\x2c\xc1\x68\xb6\xf7\x85\xac\xc9\x16\x53\xe6\xe9\x31\x07\x62\xb5\xa8\x1f\x7a\x81\x09\xc6\x0c\x39\x82\xbe\x49\x90\x9a\x4a\x41\xb7\xee\x1b\x0d\x12\xa6\xda\x6f\xe9\xb7\x72\xc4\x67\x77\xc1\xa1\xeb\xe9\xbe\xfa\x0a\x5b\x83\xf7\x36\x6a\x18\x3a\xfd\x05\x44\x28\x08\x28\x04\xa0\x56\x10\x6d\x59\x96\xdd\xca\x4d\x16\xf1\xd1\x2d\x83\x38\xe9\x46\x45\xb3\xe5\x46\xe9\xda\x85\x34\x34\x1f\x9c\xfb\x51\xeb\x4d\xc7\x5f\x6f\x5a\x57\x66\xb9\xf7\x32\x00\xe6\x83\x78\x97\xc9\xce\x91\x42\x97\x0c\xbd\x52\xe5\x46\x37\xdf\x20\xdd\xfe\x83\x96\xba\x68\x46\x74\x91\x4a\x62\xb4\x90\x85\x60\x99\xa8\x0d\x48\x2b\x41\x90\x17\xcc\xb4\x23\xcb\x0d\x09\x2f\x97\x15\x3c\x19\x63\x50\x23\xb0\xb8\x70\x8b\x78\x2e\xc5\x7d\xce\x25\xa3\xc1\xc9\xd6\x8b\x4b\x87\x47\x57\x8b\xdc\x48\x4b\x0e\xba\xfc\xd3\x15\x95\x07\x74\x96\xcc\xd4\x99\x86\x3e\x6c\x4b\x06\x5b\x80\xc8\xc0\x9b\x5a\x96\x36\xb8\x76\x42\x6a\xd3\x6a\x2d\x87\x1c\x6b\x05\x14\x04\xb0\x99\x38\xf0\x39\x60\x48\x20\xca\xdf\x04\xb0\xff\x92\x20\xb8\x50\x0a\x62\xdf\x1f\x8a\x6e\x15\x40\x7f\x12\x9c\xe1\xae\x11\x1a\xe0\xc0\x51\xd8\x8a\xc6\xd6\x65\x01\x44\xfe\x2d\x5c\x03\x93\x83\x8e\x4d\x3b\x8a\x41\x84\x26\x06\x38\xae\x46\x22\x8f\x05\xa8\x9c\x1b\xdb\x14\xab\xdf\x8d\x08\x7e\x0f\xbb\x20\xf0\xdd\x49\x59\x02\x87\x10\xd4\xfb\x39\x97\xf1\xd6\x90\xcb\xfc\x11\xd5\xc3\xd6\x4b\x98\x32\xaa\x71\x03\xa7\x3f\x40\xae\xa0\xc4\xd1\x3b\xe6\x1b\xb1\xe6\x06\x5d\x14\x1e\xb3\x11\x57\x81\xe0\x28\x2f\xe2\x5e\x06\x18\x0e\x75\xc0\xd0\x98\xc4\xce\x41\x77\x05\xf7\x24\xe4\x03\x73\x60\x65\x10\xde\x7d\xb5\x17\x6c\x13\x5b\x64\xa0\x8c\x6a\x31\x53\xb2\xfe\xcd\x48\xc0\x8c\x0b\x07\xfd\x10\xdc\x89\x04\x2b\x5a\x42\x93\xf2\x9b\x4c\xd6\x4d\xd0\x83\x39\xb9\x9b\xf7\x3d\x77\x65\xe3\x31\xc1\x9b\x77\x31\xed\x1e\xd1\x2f\xf6\x35\x6f\x32\xd7\x9b\x6f\x06\x5f\x8c\xbf\xcd\xb0\xaf\x49\xff\xf0\x16\x1e\x29\xe4\x01\x95\x50\x30\xa0\xc6\x4c\x75\xb9\x33\x87\x35\x2b\x6a\x47\x75\x17\x53\xc6\x81\x0e\xdf\xfd\xa9\xac\xe4\xe6\xaf\xa3\xd9\x0e\x1c\xd3\x68\x9a\xb4\xa6\x61\xbb\x9a\x0e\xf4\x67\x5d\x56\x8b\x1e\xe6\x38\xc6\x09\x0a\x33\xc8\x61\xce\x38\x6f\x66\xc6\x9a\x0e\x26\x78\x7d\xe5\x98\x47\xc1\x2f\xf8\xa0\x32\x4d\x02\x45\xb2\x9e\x5d\x9b\xf0\x07\xce\x34\xb1\x3e\x5b\x17\x22\xe6\xf6\x86\xb7\x02\xee\x6d\xda\x08\x68\x99\x07\xbf\x39\x9d\x92\x58\x8f\xb0\x88\x35\xc4\xff\xc1\xc9\x25\x27\x69\x76\x32\xe0\x17\x72\x4e\x45\xf0\xc9\x99\x3d\x43\x7d\x2d\xa9\x77\xca\x6e\x6c\xf1\x3b\x8f\xf1\x09\xa1\xc3\xb5\x73\x87\xc6\x95\xc3\x76\x3b\x35\x4c\x9e\x98\xac\xa4\x74\x6b\xd9\xf2\x4a\xc2\xe1\xc6\x3b\xfc\xbc\xfd\xe3\x1f\xd2\x53\xca\x43\x7a\x78\x52\x4f\xf2\xd8\x1b\x60\x3b\x79\x70\xf7\x15\x0a\xce\x90\xd4\x60\xa8\x23\xbd\xe9\xc9\xfd\x99\x87\xa6\xfa\x63\x7c\xdb\xcb\xfa\x2b\xdb\xbb\xec\x1b\x61\xcf\x33\x62\x49\xf5\x8b\x41\x26\x9c\xe2\x36\x21\x14\x9c\xb2\x3b\xed\x35\xbd\x52\x89\xb8\x78\x6b\x92\x1b\x23\x41\xec\xcb\xec\x5a\x20\xaf\xa7\x83\xe6\x92\xfa\x6a\x40\x12\xad\x36\xe9\xc0\x5c\xaa\xfa\xe2\x39\xeb\xe3\x4b\x79\x8b\x0c\x7e\x02\xc3\xaa\x0b\xa1\x1b\x69\x22\x0a\x1c\xd7\x13\x0a\xd8\xa3\x26\xc9\x30\xf2\x02\xc5\x34\xa6\x94\x26\x2f\x7f\xd0\xae\x7b\x80\xcd\xd8\x9c\x87\x6d\xb2\x50\xdd\x8c\xb1\xf3\xeb\x62\xc5\x50\x1b\x0a\xc2\xac\x0f\x5e\x9d\xc5\xdc\xdc\x55\x40\x53\x91\x5b\xed\xce\x94\x34\x8f\x4d\xde\x30\x9d\xe1\xda\xf7\x6b\xeb\x23\x6d\x53\x07\x60\xc9\xa2\xa9\xf1\x81\x52\x3f\x93\xfb\x35\xa2\x32\x45\x04\xec\xa2\x01\x1c\x01\x94\xc8\xf7\x9f\xeb\xf0\x40\x1b\x22\x93\xcb\x03\x6d\x70\xde\xe5\xf7\x40\x07\xa5\xf8\x14\x37\xf4\x56\xf9\x8d\x2a\xfd\x10\x6f\x07\x9a\xcb\x4e\xe7\x15\xa9\x2e\x1d\x88\xe1\x05\x47\x56\xc4\x25\x89\xba\x40\x61\xbd\xc2\xb5\x3a\x24\xe5\x63\x07\xab\x26\x27\x04\x61\xfb\x9a\xc2\x5e\xc0\x4c\x5c\xd3\x56\x22\xac\xba\x5b\x00\x77\x6b\xf6\xfa\xa6\xfd\xc9\x7b\xce\x89\x04\x3d\xf2\xbe\x9a\xa0\x27\x6a\xb8\xd9\xd6\x49\xf8\x47\xf9\x66\x50\x29\x46\x7c\xe8\x34\x41\xfe\xfd\xf4\xc9\xc0\xde\x54\xf7\x15\x36\xe4\x4c\xb4\xfb\x11\xde\x1b\x34\x97\x41\xb2\x52\x96\x79\xaf\xd3\x86\x37\xbb\x4a\xd0\x38\x90\xe2\x12\xed\x62\xff\x06\xe1\xf7\x39\x38\x30\x23\xe1\xc0\xb4\xdc\xd0\x50\x56\xb8\x6c\x71\x4c\xeb\x8d\xd3\x25\xfe\x0a\x08\x52\x9a\xb7\x38\x33\xc7\x83\xcf\x0a\xb2\xa6\x8c\x31\xb7\x15\x7f\xa6\xe3\x01\xbc\x44\xdf\xe3\x6e\x40\xd9\x2f\x1c\x9d\xd8\x69\xd4\x77\xd8\x56\x2d\xfe\x6f\xc0\x85\xc3\xf1\x8c\x7f\x82\x67\x45\x4a\x90\xfe\x68\x89\xe9\xbc\xf4\x63\x46\xc1\x32\xec\x07\x19\x31\x08\xda\xc0\x4c\xe1\xe4\x42\x90\x58\xa8\x3c\x60\xd1\x7e\x86\xfd\x61\x6a\xd4\x50\xae\xf6\x8b\xaa\xb7\x0a\xa9\x48\x8a\xe3\x3b\x23\x1f\x62\xda\xe7\x2e\xb9\xbd\x51\xd4\x81\x19\xf3\x0d\x6d\xb4\xf5\x6c\xfb\xcc\x97\x10\x72\xca\x0d\x14\xfb\x11\x36\x03\xef\x6c\xec\xee\x43\x7d\x6e\xb7\x06\x14\x70\x2b\x3e\x5c\x7a\x98\xfb\x4a\xb9\xf7\xe5\x46\x85\x68\xa2\xf1\x0d\x1e\x42\xe5\x26\x91\x33\xb1\x2d\xfb\x5b\x30\xe8\x52\x4c\x77\x3d\x8c\x63\x5d\xe2\x65\xce\xb8\x7b\x08\x36\x54\xdf\xae\xa7\x56\x7e\x70\xa9\x74\xec\x8d\x33\x83\x5f\xed\x2a\x78\x93\xd6\x0c\x93\x40\x91\x8b\x25\x58\xe7\xbe\x77\x50\xd9\x91\xc6\x0c\x7e\x04\x21\x3b\x93\xf7\x17\xdb\x05\x38\x1f\xb4\x21\x10\xcf\xcc\x82\x19\xac\x00\xc4\x2a\x0c\x9d\x0a\x7d\x70\x07\xbf\x64\x8e\x53\xf2\xc6\xe6\x4c\xcc\x01\xdb\x14\x53\x9f\x8c\x3b\xad\xa5\xb0\xcb\x6d\x86\x78\x3c\x87\xef\x89\xe3\x76\x89\xd1\x4d\x99\xfa\x1f\x62\xce\x26\xc2\x58\xda\x5c\x01\x9a\x8d\x65\x1c\xbe\x65\x2f\x00\xec\x4e\xa8\x58\xd8\xc3\x5a\xa0\x40\x84\x4e\x7c\xb0\xb6\x4f\xe8\xb3\xc0\xb7\x44\xf2\xfc\xb1\xa7\x0c\x7a\x11\x87\x88\xc5\xd3\xc9\x08\xdb\xe6\x4e\xdd\x86\xca\x5b\x57\x80\x36\x54\xee\x4b\x92\xec\x36\xfa\xaa\xf4\x66\x95\x44\x0c\x55\xba\xa3\x4e\x6e\xe8\x0f\xe3\xe5\x39\xf8\x77\x85\x33\x7a\x73\xa0\x2b\xc2\x23\x5d\x0b\xa1\xdc\xef\x2e\xa3\xe7\xc6\xc1\xa9\x0b\xd6\xad\x46\x2f\xdf\x73\x58\x5f\xf1\xae\x3b\xc8\xad\x6b\x25\xa0\x0d\xa3\x39\xb5\x75\x2f\xc7\x60\xb6\x61\x1c\x8e\xcf\x76\x36\xa2\x3f\x8d\x10\x55\x5a\x8a\x40\x2f\xec\x5e\xed\x6c\xe1\xb0\xc1\x5b\x2f\xa7\xcc\x5a\xd1\x1a\x47\xc9\xdd\x3a\x6c\x66\x58\x1e\xa6\xbc\x33\x83\xe5\x40\x2c\x55\x6a\x70\xda\x99\xf4\x6e\x9c\x9b\x56\x5e\x33\xca\xcb\xd4\x66\x22\x87\x5f\xb7\x5b\x6e\x8b\xde\x93\x57\xab\x93\x81\x78\xec\x75\xd9\x8b\x2d\x97\x5a\xfc\xce\x6b\x25\x44\x8c\x61\x06\xb9\xcd\x65\xdd\xb7\xaa\x85\xd4\xea\x43\x1b\x19\x3f\x49\x72\x9e\xab\xa2\xe5\xba\x34\x63\x27\xa1\x18\x3e\x73\xd6\xe0\xb9\xf5\xb9\xee\xce\xef\x66\x8e\x67\x48\x63\x54\xf6\xf5\x4e\xa9\x11\x10\xf0\xc3\xad\x2e\x4a\xe1\x77\x24\x41\xad\xc0\xf5\x64\x2b\x4a\x82\x18\x74\x3b\xec\xf2\x1a\x04\xd6\x77\x6b\xc4\x1e\x07\x6a\x56\x30\xac\x3b\xae\x85\x53\x28\x3d\xe5\x3c\x5e\x8e\xdb\x02\x09\xb7\x02\x01\x64\x40\x6a\x36\x38\xc7\x05\x83\xda\xfa\xe0\x26\xe0\x91\x6a\x32\x76\xc4\xc1\xfa\x29\xaa\x44\xa6\xd2\xdb\xa8\x92\x0f\x3e\xaa\x42\x48\x99\xaa\x67\xf5\x5b\x57\xba\x0a\xba\xe5\xdd\x0c\xda\x25\x98\x8c\x07\xe5\xb4\x15\xe2\x93\x64\x96\xf2\x8e\xb4\xb2\x94\x21\x8e\x7b\x3f\x05\x04\xe5\x2f\xaf\xb2\x1a\x41\x05\xf5\x4f\xaf\xd2\x26\x1a\x5a\x6a\xf8\x38\x0e\xc4\x22\xce\x45\xfb\xaa\xe6\xa2\xdc\x5d\xc4\xfe\x40\x85\xfd\xec\x2c\xee\xda\xb5\x83\x60\xe2\xc7\x90\xe0\x34\x8c\x41\xcf\x51\x0b\x06\x3d\x55\x6b\x43\xe7\xaa\x0c\xb7\xc6\xe6\x37\xf6\x9f\xe0\x6e\x5d\x3b\x98\x48\x86\xaf\xde\x78\xf0\x80\x69\xf0\xcd\xa0\x48\x33\x3e\xd5\x55\xa4\xe7\xc1\x2d\xd8\xb3\x76\x54\xe0\x84\x0c\x78\x14\x87\xb7\x9b\x1e\xac\x97\x0f\xc9\x6e\x1b\xb5\x86\x1e\x7e\xb6\x0b\x36\x1c\xd5\x56\x68\xcb\x79\x61\x48\x3c\x0a\x75\xa7\x75\x2c\x11\x3e\x23\x85\xe8\x75\x8e\x68\xc5\x58\x3f\x8d\x69\x89\xb9\x75\xb9\xba\xed\x80\xd8\x41\x53\x4c\xb0\x18\x18\xb2\x64\xc3\x3a\xc5\xf1\xd0\x90\xf4\xf1\x62\xad\x0a\xc1\xd0\xbe\xea\x06\xdf\x4b\x6a\xbd\xc4\xbf\xaa\x8e\x79\x2f\xaa\x86\xda\x8b\xa0\x49\xa0\xfa\xec\xf5\x5f\x37\xfe\x26\x44\xe3\x5a\x8b\xaa\x56\xa2\xe7\x13\x46\xd2\x01\xd1\xca\x0a\xf6\xe8\x78\x3f\x56\xdb\xed\x8d\x6d\x44\xc0\x0d\x7c\x20\x06\x33\xd0\x3a\x21\xf0\x31\x92\x43\xa5\xbd\x48\xaf\x69\x11\x36\x8b\x06\x56\x2c\x6d\x30\x3f\x05\x90\xd6\x11\x89\xd8\x26\xcb\x6f\xd6\xa2\xe4\x5c\x8b\x9c\x6a\xa1\xe1\xb0\x75\xe3\xfa\xfa\xca\xb9\x0a\xf0\xca\xb0\xd7\x20\xd8\x08\x82\x44\x9c\x27\x11\x8e\x1c\xa6\x58\x2b\xba\xd5\x4f\xf3\xe3\x24\x40\x4f\xd8\xf1\x4e\xb6\x4a\xb7\x0b\x26\xbf\x0d\x65\x96\x97\x10\xf3\x22\xb4\xd2\xfc\x6a\x09\xe4\x61\xbe\x10\xf8\x35\x20\xde\x22\x62\x09\x61\x35\xae\x87\xdf\x2e\xb9\x34\x1f\xbe\x59\x0b\xeb\x50\xf8\x85\x4c\xb8\x0b\xe6\x1b\xf6\x50\xa2\x4e\xd4\xb8\x37\x2e\x1e\xb5\x5f\x2e\x9f\xe9\x7a\xcb\x9f\x02\xb6\xbc\xad\x98\x97\x65\x11\xbf\x6d\xbf\x42\x2b\xd0\xbe\x0e\x8c\x90\xb1\xaf\x80\x89\x6d\x3d\x1c\xae\x60\x46\x9e\x05\x7d\x96\xbf\x7a\x2e\x57\x4f\xec\x21\xdc\xc9\x57\x1b\xea\x87\x48\xe4\xb2\x65\x7d\xa5\x0c\x8c\x78\x31\x33\xf1\xa6\x2d\x5c\x0c\x71\x44\xcf\xa7\xec\x31\x66\x20\x7c\x85\x4f\xf5\xee\xd6\x63\x87\xbd\xd9\xb1\xd2\x96\xe6\x0a\x00\xcf\xa1\x8b\xaa\x12\x40\x84\x91\xb1\x20\x4e\x19\xb1\x28\x70\x02\xec\x90\xc1\xfd\x82\x95\x41\x26\xf0\x7f\xd2\xa7\x6e\xdb\x3e\x1b\x08\xfe\x62\xbb\x49\x68\x9f\x8e\x68\xf5\xb5\x78\x15\x7f\x4b\xde\x4c\x98\x6c\xa5\x90\xfb\x91\x21\x8c\x60\x85\x61\x7c\x58\x35\x8d\x8b\x87\xdf\x21\xf6\x52\x16\x1c\x09\x86\x99\x71\xf8\x0d\xdc\xd8\x01\x96\x07\x3b\x0e\xdb\xe9\x70\x70\xd9\x52\x9c\x64\x80\xeb\x6b\x57\xa1\x96\x16\x45\x96\x9e\x33\x1d\x32\x54\x17\xd0\x05\xf7\xdc\x75\xbe\xd2\x93\xa5\xb4\x46\x0a\xfd\x65\x10\x15\xc5\xde\x3f\xfa\x32\x3d\xbe\x14\x67\xcb\x27\x1e\xc9\xdf\x5d\x19\x38\xba\xfb\xa9\x3d\x3b\x93\x5b\x5b\xf9\x92\x86\xd0\x12\x99\xd7\x99\x1d\xc3\x85\x62\xde\x70\x6b\xfc\xdb\xc0\xba\x1b\x31\xc8\x6e\x08\xb2\xb1\x1f\xb3\x85\x16\xa1\xd7\xcc\x34\xf0\x36\x8a\x23\x62\x31\xf8\x39\xd3\xaf\x48\x82\xc1\x33\x16\x7d\x89\x85\xaf\x74\x63\x85\x9f\xed\xda\x61\x6f\x3f\x10\xcd\xc1\xca\x30\x19\x11\x55\x00\x96\x09\xf6\x5e\x65\xd7\xb8\x0d\xc8\xd7\xd7\xd0\x38\xb8\x70\x71\x98\xef\xb7\x61\xf7\x5d\x44\xd4\x36\x2c\x16\x76\x02\xb4\x47\x09\x5e\xfb\x9b\xa8\xec\xed\xae\x8b\x05\xdb\x5b\xfa\x6b\x7f\xe6\x20\xa0\x9c\x85\x15\xdb\xe8\x68\xa3\xe1\xb5\x7e\xba\x68\xfc\xfc\xab\xf1\xe2\x85\x31\xe3\x93\xd7\x49\x0e\x46\xb7\x74\xf6\xb4\xe5\x5d\x08\x47\x9c\x11\xb6\xf3\x26\x1c\x63\xc0\xa5\x0f\x4e\x45\xaf\x9d\xda\xfb\x41\x4c\x0f\x13\xa6\x22\x88\x7b\x5a\x9c\xfe\x34\xda\x1c\x9c\x69\xe3\xd5\x85\xd0\x79\x7e\xa5\xc8\x72\xb1\x33\xa3\x5e\x40\x0c\xf8\x27\xf7\xee\x92\x91\x70\xa8\x7e\xed\x4c\x1c\x97\x99\x7e\xf0\x31\xd3\x9b\x88\x95\xd7\x59\x9e\xf9\xff\x68\xb6\x7b\x6c\xb1\xa4\xcf\x6d\x91\x99\x30\x60\x54\xfc\xc9\x1d\x7f\x0b\x5f\x7a\x4b\x7f\x60\xdf\xca\x8f\x25\x81\xdb\xca\xcc\x4f\x9c\xbb\x10\xde\x11\x91\xfc\x0c\x6d\xfd\x1f\x8d\x5e\x59\x03\x25\xab\x98\x41\x2b\x57\xca\x91\x87\x7a\x7a\xf1\x09\x29\x19\x9f\x51\x6e\x0c\xe7\x42\xcc\xb4\x2b\x8c\x33\x0e\x65\x04\x24\xc2\x51\x91\xbd\xe6\x94\xe7\x24\x12\x15\x50\xa2\x02\x4a\xf4\x62\x38\xa2\x07\x87\x71\xa4\x9b\xe8\x30\x0b\x3e\x05\x64\x5f\xac\xb6\x67\x91\xd6\x6c\xb4\xd4\xb4\x77\x8d\x7c\x70\xd2\x8e\x25\x80\xdb\xf0\x0f\x11\xe6\xb0\x84\x51\xe3\x4b\x5a\xb8\xf7\x73\x23\xeb\xfc\xce\x9b\x71\x7c\xe4\xad\xbc\x58\xa1\x3e\x3a\x5a\xa8\x71\xe7\x9c\x04\x9e\x0e\xd8\xc6\x1d\x54\x6a\x07\xed\x54\x76\x96\xbb\x80\x44\x76\xe9\x08\xe6\x04\xca\x00\x4f\x84\x4e\xcd\x02\xc7\x70\x34\x7a\x9e\x7b\xde\x1f\xbb\xb0\x7f\x70\x54\xf1\xf6\xee\xb9\x8c\xfe\xfb\xba\x10\xf7\xea\x82\xf3\xea\xfa\x29\x78\x24\x3e\x7a\x1e\x93\x3a\x4a\xaa\x0a\x1b\xb9\x76\x44\xd2\xf9\x6c\xc4\x7c\x71\xd7\x0a\x50\x16\xd9\x55\x17\xce\xb1\x43\xa2\x03\xeb\x07\x19\x5e\x0b\xb9\x48\x1f\x42\x8e\xf9\xd7\xc0\x17\x1c\x38\xfe\x71\x8a\x16\x9a\x57\x6d\x9c\xaf\xd4\xf6\x69\x0b\x51\x96\xf0\xf9\x0d\xef\x3d\x8b\xf5\x5d\xeb\xf4\x87\x2d\xa9\xf7\xac\xa6\x1f\xb6\x28\xdc\x27\xbc\xb5\x49\x7f\xe3\x47\x14\x54\x80\xe0\x5f\x41\xfd\x63\xb5\x53\x75\x33\xb5\xaf\x31\xa3\x09\xc7\xbb\xaa\x73\x73\x28\x8b\x55\xf8\xb5\x13\x33\x31\x2d\x70\x57\x03\x31\x5a\x47\x75\x52\xc4\xc7\x92\x8e\xb9\x2c\x69\x07\x99\x1c\x2f\x46\xf8\x2e\xc2\x0f\xf6\xfa\xda\x4d\x57\x56\x74\x6a\x11\x6b\xd4\x96\x12\x63\x8e\xe6\x97\x78\x7a\xc0\x11\x82\x37\x00\x16\x22\xb6\x7a\x5a\x2b\xcf\x18\x6b\xfd\x97\x36\x0e\x9c\xc7\xfd\x70\x08\xd6\xfd\x7f\x77\x66\xfe\xf3\x67\x25\xe8\x19\x31\x97\x5d\xe4\xda\x51\x96\x37\x4f\x28\x58\x5b\xef\x9d\x95\xe2\x9f\x72\x56\x5e\x66\xd0\xfb\xc9\xb3\xd2\xb7\xdd\xce\x9f\x71\xce\xbe\x9b\xc5\xce\x99\xe9\xc0\xbf\xa2\x7b\x70\x7b\x42\xd7\x73\x86\xba\x8b\xb4\x0d\xd3\x14\x71\x9c\xba\x11\xe7\x25\x89\xa3\x7c\x9f\x9b\xb8\x97\x00\x54\xd7\xb8\xd7\x2c\x0f\xbf\x79\x02\x6b\x48\xff\xf8\x89\x4c\x0a\x9d\xb7\x47\xfe\x31\xe3\xa2\x92\xf1\x47\x0e\x0d\x1e\xeb\x7f\xb9\x71\xc1\xab\xf7\xdf\x07\x06\x84\xb5\xbc\xb0\x91\xc8\x57\x1c\x56\xd5\x97\x7d\xf5\xa7\x9b\x34\xd6\x22\xb3\xf3\x36\x69\xc0\xc5\xa2\xb6\x20\xfd\x71\x56\xb3\x3f\xdc\xa4\x5d\x55\x2d\x71\x1d\x86\xa3\xe1\x0d\xc1\xff\x6f\x36\x7b\x91\x59\x33\xb4\xac\xd9\x72\x61\x2d\xd2\x13\xd9\xf2\x9a\xdd\xe7\xd4\xba\x72\xb7\x1c\x38\xdd\x72\x71\x66\x67\x20\xc6\xe0\x4b\x7e\x2e\x77\xc2\x50\x43\xd6\x9c\x29\x33\x33\xdd\xf6\x50\xa7\xb8\x1d\x04\x59\x60\xac\xfb\xa3\x57\x7e\x5a\x62\x76\x88\xf7\x02\x3c\x76\xbc\x95\xcf\x89\xe3\xb8\xef\x60\xe2\xd0\xde\xc5\x98\xd6\xcf\x8c\x3d\x80\x03\x8f\xdf\x82\x4e\xc2\xab\x92\xef\x30\x90\xac\x14\x22\xce\x08\x82\x61\xfb\x55\x36\xec\x40\x89\xbd\x29\x52\x37\xe5\x6e\x11\xee\xb5\xbe\xfc\x46\xdd\xc6\x33\xe9\x5b\x6a\x12\x74\xb8\x38\x05\xb0\xc4\xc3\x4e\xbe\xde\xf2\x2c\xbc\x12\xf7\x09\x32\x9b\xba\xcd\xa5\x3c\x40\xa2\x61\xdd\x1e\x5c\x61\x61\xb2\x20\x38\xae\xd5\x89\xe7\xe2\x60\xd8\xe1\xcc\xc6\x09\x99\xc4\x6d\x92\xa2\xa8\x04\xc6\xc7\x05\xa2\x4a\x2f\xf5\xad\x96\x27\x05\x9c\x0b\x69\x48\xf9\x20\xd0\xe7\xd8\x6d\xfc\x2c\x71\xfc\x74\xee\x73\x1a\xfa\xe4\x75\x6d\xe4\x8f\xe0\xb0\x47\x57\x0e\xfb\xa3\x8e\x98\xdc\x7a\x38\xf8\xc3\x38\xec\xbf\xe6\x89\x71\x75\xe0\xef\x30\x08\x2f\x39\x95\x02\x38\x41\x88\x80\x0f\x7b\xf4\xef\xb1\xaf\xee\x13\x40\x3f\x35\xfa\x23\x8f\x19\xd5\xd3\xa8\x3f\x7c\xeb\x93\xe9\x71\x11\x2e\xd6\xbf\x63\xc2\x7d\xc2\xc7\x5d\x44\x4d\xb6\xb6\x8f\xc0\x4b\x0e\x77\xf5\x3e\x06\x12\x64\x33\x70\xea\x06\xd4\xc1\x91\x93\xbc\xbc\x3d\x34\xb0\x03\x89\x73\x8f\x58\xf5\x43\x3f\x44\x02\xf3\x86\x4d\x91\x33\x1b\x46\x0b\x19\xa5\x1f\xd4\x28\xe0\x43\x2f\xcc\x8b\xfc\xf5\x6b\xd8\x70\x97\xd6\x71\xf3\x72\x7b\x92\x15\xfc\xec\xb1\xe3\x85\xcf\x9a\xc7\xcf\x19\xa7\x1d\xf4\x17\x88\xe4\xc5\x4e\x68\x20\x48\x11\x8b\xc9\xe4\x2e\xb0\x6b\x75\x17\x6b\xf4\x39\x8b\xb4\xcb\xcd\xa8\x22\xb9\x61\x0f\x11\xa4\x84\x9b\x52\xb9\x92\xef\xd7\x7b\xdf\x8a\xd5\x7c\xa7\x5b\xee\xd9\x67\xbd\xb1\x46\xec\xe2\x1a\xf1\x8b\x6b\x24\x9c\x6a\xbc\x9d\x34\x09\xff\xa4\xa1\x77\xcc\xb4\x48\xc2\x23\x3a\xac\x4e\x1b\xd2\xc4\xf3\x43\x28\x96\x88\x5f\x39\xf1\x90\x77\xe0\x9c\xf6\x8d\xb3\x20\xb2\x73\x96\x57\xd6\xd8\x7b\x66\x50\xc6\x77\x93\xe6\x5b\xf7\xef\x6c\x36\xe6\x31\x73\xcf\x52\xc9\x3f\x99\xb0\x33\xe5\x2e\x23\x82\x97\x4e\x1c\xed\x0f\xc2\x6e\x33\x12\x38\x8a\x34\x2c\x45\x88\x5b\xbc\xa6\xa7\x37\x41\xfb\x04\x35\x94\x7a\xc0\x85\x8c\x8e\x1c\x8c\x97\x86\x99\x3d\xc0\xbf\x91\x8e\xfc\xa4\x8b\x6d\x0a\x79\xd4\x8b\xd0\x3b\xe6\x42\xee\xa3\x07\xf5\x98\x84\x57\x2e\x45\xc5\xbd\xb3\xfd\xaf\x8d\x43\x8c\x6a\x08\x5b\x7c\xc0\xa9\x38\x0a\x78\x71\xb8\xa7\xbd\x93\xe3\x94\x56\x0e\x21\xe7\x5e\x92\x17\xc4\x15\xcd\xa9\x45\x15\xbd\x24\xa6\xf9\x2a\xd3\x0b\xae\xa0\x08\x76\xc0\x22\x39\x93\x5c\xbd\x28\x6d\xf1\x6c\xc1\x80\xe7\x6c\xe6\x1a\x57\xbf\xc6\xd1\xdb\xa5\xa0\x13\xdf\xe1\x88\x57\xbb\x06\xcd\xd3\x73\xf0\x4d\xc5\x4a\x50\x2f\xe4\x60\xeb\x82\x29\x54\x60\x49\x94\x1b\x59\x08\x60\xc8\x01\x02\xf9\x26\xf8\x8e\x01\x46\xe3\x90\xbc\x80\x7d\xf3\xd3\x0a\x5c\xde\x7e\xef\xba\xa3\xa8\x94\xef\x46\xb7\xf2\x4c\x1d\xa9\x77\xb6\xe9\x7f\xc5\x4a\x73\xf1\xc7\xb4\x19\xbb\xa0\x9f\xa0\x77\xfd\x88\x56\xe3\xfe\xdb\x24\x56\xb2\x2a\x3b\x9d\x32\xe7\xdc\x14\x9c\xef\x6b\xd2\x8b\x59\x24\x33\x9e\xcc\x20\xe9\xb1\x03\xe0\x47\x73\x8e\x2b\xd8\xcb\x17\x83\xc9\xed\x84\x67\x29\xf8\x87\x2d\x2f\xce\x0f\xa9\xae\xce\x56\xc6\xd6\x99\x8f\xce\x56\xd2\xf3\x95\xe1\xd4\xec\xa3\xe3\x59\xda\xf9\xca\x87\x8f\x76\x0b\xec\xf9\x16\xed\xad\x9d\xaf\xa4\x19\xb6\x3f\xba\xdb\xd4\x7d\x02\x21\x76\xee\x8f\x9e\xf6\xfe\xb3\xa0\x40\xfa\xff\xe8\xa4\x46\x78\xd6\xfc\x7e\xeb\x99\x6d\x17\xb1\x18\xd9\x81\x19\xbb\xee\xb3\x2e\x1b\x8d\xe3\x7d\x3c\xf0\x32\x48\xa4\xef\x50\xd8\x15\xa9\xef\xe7\xfc\x24\x10\x9d\xc3\xd7\x15\x9a\xa4\xaf\x7b\x23\xfa\x2b\x3d\xcc\x85\xfc\xdd\x15\xc1\x84\xfa\x99\xaa\x96\xd6\xc0\xff\x27\xca\x56\xec\xa7\x68\x14\x48\x17\x70\xea\x9c\x8b\x1d\x45\x57\x38\xec\x79\x8a\x72\xe1\x56\xc3\x59\xe3\xb8\x72\x51\xee\xb5\x6a\x7f\xbc\x4a\x12\xfb\xe7\xa8\x24\xb1\x7f\x11\x95\x04\xcf\x76\x90\x28\xe1\x21\x18\x88\x95\x0a\xbf\xba\x50\x13\x89\xfd\x9f\xd4\x44\x9c\xba\x6d\xd4\x40\x1c\xf2\xcd\x7a\x87\x43\x01\x8b\xbe\x11\xf3\xa1\x6f\xa8\x6e\xbb\xde\xa8\x72\x90\x6a\xa1\x7f\x8a\x06\x11\xfb\x27\x68\x10\xb1\x7f\x82\x06\x11\xfb\xa7\x68\x10\xb1\x7f\x8a\x06\x41\xfd\x19\x35\x08\x67\xa9\xcc\xed\x02\x9c\xc3\x83\x05\x5c\x41\x51\x07\xdc\xae\x5e\x3b\xd7\x71\xf6\x85\xa0\x5e\xb8\x76\xa9\x83\x85\x7f\x3f\x0f\x29\x48\xf9\x83\xf3\x11\x95\x33\xec\x2b\x57\xf9\xdd\xb5\x8e\xe1\xd6\x8c\xd7\x5b\x69\xef\xea\xea\x45\x1e\x67\x2b\xbe\x73\x4d\xc8\x74\x97\xa9\xb1\x7b\xd5\xb3\x0c\x0e\x09\x8e\x4a\x48\x7b\xa7\xb1\x0e\x3b\xd8\x55\x67\x48\xfa\x59\x84\xa8\x2b\xff\x47\x33\xfe\x15\x02\xa3\xd4\x1f\xfe\x27\x0b\xf7\x31\x9f\x0e\x95\xe0\xad\x97\xcf\x23\x7e\xec\x9a\x53\xf1\x82\xa9\xc8\xf9\xff\xf8\x47\x80\x22\x5f\x5c\xf0\xd7\x75\x00\xf3\x0b\x2c\x72\xb5\x81\xba\xd2\x9d\x75\xd9\x5c\x36\x04\x7e\x43\x5a\x44\x96\x38\x35\x72\x2f\x91\x0b\xfc\x35\x90\x8a\xa1\xbd\x14\x18\x14\xfa\x23\xf0\x57\x97\xc2\xb7\xf8\x2e\x12\xfc\x02\xc5\x43\xb9\x26\xc5\x22\xa4\x7f\xfb\x04\xa1\x49\x41\x4c\x86\x63\xac\x44\x26\xf0\x99\x34\x89\x66\x43\x42\x7b\x06\x96\xd4\x4b\x24\xa1\x04\xaf\x94\x48\x6a\x25\x72\x7a\x89\x9c\x0a\x03\xb5\x12\xf9\x14\xc8\x86\x51\xc1\x9c\x56\x10\xed\x34\x5a\xc9\x18\xa5\x02\x33\x14\x85\x02\x1f\x55\x4f\x29\xe0\x32\xc6\x81\x46\xca\x4d\x0f\x0f\x1a\x19\x4a\x9c\xa7\x91\x52\x18\x4e\xf1\x63\x5a\xbb\x42\xe0\x1f\x9f\xf0\xdb\xb8\xa4\xf9\x53\x1c\x7d\x4a\x99\x3f\x25\xd1\xa7\x8c\xf9\x53\x16\x7d\xca\x5a\x60\xa5\xd1\xb7\x78\xdc\xf0\xf1\xaf\x9f\x02\xd7\x00\x2e\x9e\xb4\x7c\x4c\xc0\xc7\x94\xe5\x63\x0a\x3e\x66\x2c\x1f\x73\xf0\x31\x6b\xf9\x18\xcb\xc0\xf1\x62\x4e\xfb\xea\x8b\x3e\xd0\x53\xbd\xca\x39\x5a\x41\x03\x7a\xb3\x71\xc5\x1e\x8d\x6f\x3f\x83\xdb\x25\xb8\x07\x8d\x48\x75\x85\x70\xc1\x59\x21\x07\x14\x56\x34\x5a\xfb\xd8\xf3\x46\x28\x9e\x4a\xa1\x96\x60\x42\x5e\x05\xb4\xbf\x31\x2c\x73\x3a\x0e\x61\x66\x61\x6f\x93\x08\x7a\xf8\x8e\x10\x34\x14\xd6\x06\x45\x43\x42\x2d\x86\x63\x75\xe8\x30\x3e\x01\x10\xe8\xeb\x25\xd8\xf3\x3f\x16\x7b\xde\x1f\xf6\xbc\x3b\xf6\xb0\x48\xb4\x05\xf2\x03\xa7\xbf\xed\x72\xa3\x08\x1e\x18\xfb\xfc\x92\x87\x3b\xb3\xdd\xbb\x4e\x80\x56\xd9\xe5\xc7\xc0\x7f\x22\x35\x0a\xfc\x9d\x9d\x77\x5a\xa6\x30\xac\x4f\xe0\x0c\xe9\x57\x70\x5c\xfc\x0f\x34\x27\xd1\x5f\x39\xf4\x07\xef\xb4\x31\xb8\x02\x70\x46\xdd\xfd\xe2\x3a\x5c\xe2\xea\xca\xb4\xbc\x95\x7c\xfa\x7b\xfe\xa3\xad\x2d\xe9\x8b\xf4\x0b\xcb\x9d\x21\x88\xfe\xe2\xe4\x35\x2a\x86\x83\xc3\xdc\x3a\x65\x1f\xb4\xec\x4e\xf0\x47\xee\xd2\x29\x5f\xbb\xb4\xbd\x0f\x14\x1f\x7c\xc3\x43\xb1\xf4\x1b\x5b\xfb\xa1\x04\x73\x15\x2b\x24\x61\x26\x77\x6c\xa1\x16\x98\x73\x6e\x7e\xac\x07\x16\x57\x67\x64\x9c\xab\x33\x06\xa2\x2b\x1f\x07\xcc\x3f\xdc\x0a\x72\xe5\xcf\x48\xe8\x29\xaa\x53\x17\x0a\xe3\xaa\xf4\x7a\x75\x29\xdf\xf0\xa3\x88\x1c\x1d\x11\x52\xfd\x30\xba\x88\xea\x73\x0e\x7c\xe4\x78\xd5\x04\x7e\xf4\x02\x75\xbf\x7a\x5c\xf1\x21\x04\xeb\x82\xa7\x2a\xff\xb7\x93\xf0\x2d\xaf\xcf\x58\x74\xb1\x5f\x56\x52\xd7\xb2\xec\xba\x90\x1d\xcc\xbf\x17\xbc\xcd\x44\x48\xb7\xd6\xd8\x5f\x7a\x70\xa2\x23\x8f\xb6\x89\xe0\x18\xe2\x7c\x06\x7f\xcc\x0b\xe5\x0b\xf4\x02\x77\x84\xd0\x32\x60\x44\xd0\x94\xde\x82\x54\xea\xe3\xfb\x18\x93\x2b\x95\x68\x31\xe8\xe5\x90\x5f\x7b\x97\x1d\xf8\x4f\x2c\xb7\x04\x62\xb7\xbf\x5c\xd2\x50\x01\x75\x78\x19\x04\xbf\xac\x1e\xd3\x8e\xac\xe7\x0e\x33\x3f\x7b\xdb\xd0\xf9\x2a\xb3\xc3\x25\x68\xe3\xf2\x0e\x69\x37\xc5\xc3\x8e\xab\xd6\xb2\xd8\xc9\x6d\x45\xf0\xb6\x8d\xaf\x30\x3a\xaf\x7e\xc5\x9b\xc5\x47\x47\xeb\xbd\xcb\xb5\x42\xa7\xd5\xe9\xed\xcf\x08\xcc\xfd\x1a\x13\xf3\x4f\x16\x7c\x4a\xf0\xec\xf8\xa4\xc4\xa9\xe4\xd0\x81\x09\x79\x1c\x4f\xc9\x82\xf8\x16\xa4\x1c\x58\x9d\x82\x27\xa8\xb5\x7e\xcc\x2e\x0a\xb2\x50\xdc\xd3\x59\x30\x0e\x05\x55\x25\xfe\xd1\x21\x1c\x8f\xee\xc5\xd7\x63\x76\x69\x9f\x65\xf7\x67\x40\xba\x0e\xcd\xea\x3e\x09\x62\xb7\x0e\xde\x77\x7f\x0d\xb0\x68\xce\x29\xd3\x8e\x09\xfc\x86\x92\x02\x38\x5e\x75\x8d\x99\x81\x35\x77\x70\xde\x89\x54\x56\xf1\x56\x71\x92\xaa\xba\x11\x98\x71\x02\x12\x8b\x43\x10\x76\x59\x08\x07\xa2\x01\x70\x43\x4c\x80\xca\x0a\x50\xbc\x42\xf1\x2b\x6b\x97\x20\x01\xf8\xe0\x5f\x41\x4c\x06\xc4\xc2\x3a\x2a\x0e\x76\x46\xc1\xe6\x2f\xc6\xce\x1a\xb4\x9e\x38\xf9\x2f\xd6\xc8\x29\xda\xc9\xa9\x3a\x49\xc4\x9a\x7e\x22\x15\x57\xfc\x07\x83\xb2\x9f\x44\xea\x3e\xa2\x58\x8e\xa2\x88\x26\xc4\x28\xa9\xb8\x9e\xc2\xd5\x62\xb1\x1c\x95\xc4\x6b\x14\x57\x8b\xc7\x72\xf8\xa2\x71\x2c\x9e\x48\x29\x36\x13\xad\x68\x32\x49\x25\xe2\x5a\xd1\x54\x2a\x4e\x25\xb4\xbc\x74\x22\x93\xd4\xc1\xa4\x93\xa0\xf9\x68\x79\x29\x8a\x98\x21\x48\x1e\x4a\xe5\x8c\x79\x99\xb8\x21\x2f\x96\x30\xd6\x8b\x67\x8d\xf5\x12\x99\xb4\x21\x2f\x65\x40\x1b\xa5\x62\x94\xde\xa5\x44\xcc\x08\x34\x96\x4b\xa7\x29\x1d\x2a\x4e\x66\xb5\xdc\x78\x3a\x1e\x4b\x1a\xaf\x18\xeb\x94\x57\x1d\x2b\xbf\xbc\x64\xd2\xd9\xab\x40\x36\x93\x43\x6a\xe1\x4b\x2c\x96\x4a\x5d\x21\xb2\xa5\xb2\x24\x95\xa6\x20\x95\x8e\xe1\x14\xea\x37\x4a\x25\xd3\xa4\x64\x32\x13\x83\x54\x46\xc9\xcb\x24\x70\x2a\xa9\xa4\xd2\x38\x95\x51\x52\x39\x9c\x22\xf5\x52\x09\xc8\x4b\x25\x94\x54\x2a\x0e\xa9\x14\x29\x99\x06\x85\x30\x96\x4e\x50\x24\x95\xcc\x42\x2a\x49\x70\xc9\xa4\x00\x97\x4c\x9a\xb4\x90\x49\x67\x70\x4a\xc9\xcb\xe0\xbc\x4c\x02\xa7\xb2\x14\xe4\xa1\x9f\x4a\x2a\x87\x53\xa4\xbd\x6c\x12\x4a\x66\xd3\x69\x9c\xca\xa5\xa0\x85\x1c\x81\x12\xa7\xe2\xa8\x1e\x1a\xf7\x14\x4e\x25\x28\xd4\x5b\xf4\x33\x4d\x52\xe9\x24\xa4\x48\xeb\xe8\x77\x0e\x52\x19\x25\x2f\x1b\x83\x54\x36\xa6\xa4\x70\xbd\x2c\x81\x99\xa4\x50\xff\xd0\xcf\x04\x49\x25\x12\x90\x4a\x28\xa9\x1c\xce\xcb\xc5\x95\x14\xb4\x9e\xa2\x70\xdf\xd1\xef\x1c\x4e\xe5\x48\x2a\x41\x41\x2a\x41\x5a\x48\xa5\xa1\xbd\x54\x9a\xd4\x4b\xc7\x21\x0f\xfd\x54\x52\x29\x9c\x22\x98\xa5\x13\x50\x32\x9d\x50\x4a\x26\x70\x5e\x22\x43\x52\x19\x68\x3d\x4d\x68\x16\x4f\x67\xa1\xbd\x74\x8e\x40\xc9\x00\xe5\xe1\x27\x49\xa5\x00\xeb\x0c\x19\x23\xf4\x1b\x4a\x66\xd2\x4a\xc9\x74\x0a\xa7\x08\xcd\x32\xd9\x34\xa4\xb2\xa4\x64\x36\x06\x3d\x42\x3f\x49\x0a\xe6\x04\xfc\x54\x52\x39\x9c\x22\xfd\xcb\x62\x0a\x66\xb3\x04\x97\x6c\x0e\xda\x43\x3f\x71\x2a\x87\x29\x88\x7e\x92\x54\x12\xa0\xa0\x9f\x90\x4a\x50\x14\xc2\x13\x7e\x92\x54\x3c\x06\xa9\x38\xa6\x52\x22\x96\x40\x23\x86\x7e\x92\x92\x68\xf2\x42\x2a\x99\x54\x52\x69\x9c\xca\x91\x54\x2a\x03\x29\x32\xe7\x13\x71\x98\xf3\xf0\x53\x49\x25\x70\x2a\x41\x52\x19\x9c\x97\x51\xf2\xa0\x47\xe8\x67\x86\xa4\x72\x80\x4b\x3c\x47\x60\x26\xa0\x0f\xe8\x27\xa6\x4b\x22\x09\x73\x09\x7e\xe2\x14\x1e\x4d\xf8\xa9\xa4\xa0\x5e\x2a\xa9\xa4\x30\x9e\xe8\x27\x4e\xa5\x61\xbe\xc0\x4f\x25\x05\xed\xa5\xd5\x3c\x58\xa9\x89\x34\x99\x05\x68\x2a\x42\xdf\x33\x64\xa5\xa2\xdf\x49\x9c\x22\xb8\x64\x32\x38\x2f\xa3\x94\xcc\xe2\xbc\x2c\xc9\xcb\xe2\x92\x59\x32\x7e\x09\x4c\xf9\x84\x42\x79\xf4\x3b\x85\x53\x6a\x5e\x06\xa7\x48\x6f\x73\x30\x27\x12\x39\xb2\x8e\xd0\x6f\xe8\x43\x4e\x69\x21\x97\x49\xe2\x14\xa9\x97\xc3\xed\xe5\x72\x04\xb3\x1c\xb4\x80\x16\x05\xa6\x35\x5a\x16\x59\x48\x25\x48\x0a\xb1\x2a\x94\x52\xc6\x28\x89\xc7\x08\x7e\x92\x14\xac\x7e\xf4\x33\xa6\xa4\x12\x38\x95\x20\x29\x68\x01\xfd\xc4\xed\xa1\xbd\x01\x60\xa6\xc9\xea\x4f\xe6\x60\xb6\xc2\x4f\x48\xa5\x72\x14\x82\x89\x7e\x66\x49\x0a\x5a\x4f\xe5\x08\xe5\x53\x39\x18\x5b\xf4\x13\xb7\x90\xa6\x60\xd6\xa1\x9f\x09\x92\x82\xfe\xa1\x9f\x39\x92\x02\x7a\xa2\x9f\x19\x92\x82\x39\x0f\x3f\x95\x54\x0e\x52\x64\x16\xa4\x63\xb0\x8a\xe1\x27\x49\xc1\x88\xa1\x9f\xa4\x1e\x9a\x9a\x28\x85\x7e\xe2\x54\x12\x2e\x95\xa3\x9f\x49\x25\x05\xf5\x92\x04\xb3\x74\x12\xfa\x0e\x3f\x95\x54\x06\xa7\x08\xcc\x34\xac\xa3\x74\x3a\x4b\xf2\x72\x31\x80\x92\x8b\xa5\x48\x0a\xc6\x16\x7e\x2a\x29\xc0\x33\x97\x51\x4a\x66\x70\x49\x32\x62\xe8\x77\x16\xa7\x30\x5d\x32\x54\x0c\xc1\xcc\x20\x6e\x88\x53\xe9\x18\xaa\x97\x51\x38\x0a\x62\xbb\x59\x48\x91\x75\x9b\x45\xcc\x12\x6d\x1d\x71\x42\xeb\x6c\x1c\x56\x1c\xfa\x99\x25\xa9\x2c\x6c\x2b\x71\x32\xee\x59\xbc\x3a\xb2\x09\x42\x4f\xc4\x84\x51\x8f\xb2\x49\xc2\xcf\xd0\x6e\x0c\x0b\x02\x7e\xa5\x48\x3a\x09\x13\x01\x7e\x61\xec\x92\x09\x2a\x06\x03\x0c\xbf\xd4\x74\x8e\xa4\x73\x4a\x3a\x99\xc2\x69\x32\x57\xd0\x02\xc1\x43\x02\xbf\x70\x3a\x45\xc5\x81\x10\x29\x8a\xec\x39\xe8\x8f\x54\x1a\xa7\x15\x1a\xa6\xe2\x98\x88\xf0\x8b\xa4\x53\x71\x9c\x56\xf8\x2d\x22\x2e\x4c\x7d\xf8\x45\xf2\xb3\x31\x0a\x26\x00\xfa\x15\x57\xd3\x59\x9c\x8e\x29\xe5\x63\xb0\xa9\xc1\xaf\xa4\x9a\xce\x91\xb4\xb2\xc7\xe6\x62\x49\xd8\x2d\xe1\x77\x4a\xfd\x42\xf6\xdd\x5c\x8c\x8c\x0f\xfe\x4b\x29\xa3\xee\xcc\xb9\x78\x0c\xef\xc6\xe8\x77\x42\xf9\x92\x48\xc7\xf1\x17\x65\x5f\xca\xc1\x26\x89\xe0\x90\xdf\xea\x17\xe0\x1a\xe8\x77\x9a\xf4\x06\xfd\x95\x01\x0e\x07\x70\x51\x7f\xbe\xda\xa2\x86\xeb\x82\x2f\xeb\x22\x91\x11\x1b\x2f\x0b\xca\xcd\x0d\xbf\xe5\x90\x76\x03\x87\x38\x48\x7a\x42\x52\x08\x8b\xa5\x90\x78\x46\x7d\x66\x8e\x24\x08\x5c\x6e\x22\xf0\x32\x52\x9f\x40\x13\x02\xb8\x9f\x89\x03\x3e\xf2\x27\x9c\x35\x18\xa5\x43\x22\xa7\xff\x8e\x40\x63\x0f\x80\x4a\x55\xf4\xf7\xf7\xf0\x1f\x1d\xcb\x03\xc2\x34\x23\x85\xb3\x7c\x58\x87\x82\xa1\xcf\x1f\xff\xfb\x1f\x2f\xff\xfd\xe5\xcb\x94\xbe\x3e\x7d\xf9\x72\x73\xfd\x35\x12\x0e\x85\x16\xb2\xbc\x96\x3e\x7f\xfc\xf2\x25\x8a\xfe\x43\xe9\xd0\x8b\xa9\x00\xfa\x15\x7a\x21\xc9\xaf\xbf\xc7\xaf\xd2\xdf\xc3\xe1\x7f\x84\x42\xa8\xc8\xef\xb1\xab\xc4\x77\xf4\x35\xfc\x3b\xfc\x22\x49\x94\xc5\x09\x13\x9a\x5b\x08\x92\x1c\x0e\x87\x3e\x92\xef\xa9\xef\xe1\xcf\xa8\x46\xf4\x05\xb7\xb1\x47\x75\xbe\x7c\xb9\xfe\xcf\xff\xfd\xfa\xf7\xf0\xdf\xd1\xe7\xcf\x2f\xd4\x75\x0e\x7f\x46\xf9\x5f\xbf\x7c\x41\x9f\xc2\xb8\xe0\xe7\x2f\x5f\x3e\xfc\xc7\x7f\xfd\xe5\x3f\xff\xfa\xb7\xbf\x47\xae\x3e\xde\xfe\xef\x97\x2f\x9f\x48\x55\x54\xf1\x73\xe8\x3f\xde\x54\x2d\x1c\xfa\x0b\xa6\x80\x01\x0f\xd4\xc7\x60\x58\xbf\x3c\x2a\x10\xe5\xc7\x3b\xe4\x96\x21\xee\x49\xd8\x2d\x52\xd9\x37\x9e\x38\x2d\x5d\x36\xe0\x44\x83\x11\x6b\x60\xf1\x13\x54\xfd\x4f\x84\x78\xc2\xd8\xd3\x7a\x6d\x8a\x4d\x56\xda\x8b\x03\x4e\xaf\x62\xca\x10\x99\x39\x84\x6c\x15\x0d\x20\xc1\xcd\x12\x31\x93\xfd\x8e\x8f\x4d\x6a\xfc\x94\x39\xa0\xa9\x68\x0d\xea\xfd\x8b\xa3\x5a\x62\x0c\xb9\x22\xcb\xf4\x64\xd1\x13\x4a\xd8\x30\xe0\x1d\x76\x45\xef\xa0\x1a\xed\xd8\x10\x51\xf5\x1b\x36\x1a\x18\xbd\x46\x3a\x3f\xba\x5d\xb2\xb3\x63\x47\xd8\x9b\x1a\x93\xdd\x8e\x04\xcc\xad\x9d\x0b\x40\xe6\x40\x5f\xa4\x09\xba\xb9\xcf\xb3\x46\xc7\xd6\xfd\xe4\x59\x21\x98\x03\x61\xeb\x63\x45\x7a\x72\x33\x66\x79\x12\x50\x1a\x48\x06\xfd\xed\xd5\x1a\xe5\x6f\x85\x72\xa5\xd5\x29\x7f\xab\xd7\x9a\x0f\xb5\xca\xd0\x47\x08\x33\x8b\xc5\xa8\x7a\x84\x15\xaf\x4c\x24\x5d\xd5\xf7\xb0\x46\xe8\xc3\x63\x9c\x48\x48\x2b\x57\x23\xa9\x99\x22\xf1\x9c\x69\x75\x40\x73\xec\x94\x98\x98\x69\x8e\xc3\x37\xfe\xde\xd8\xf8\xce\x09\x92\x27\x1e\x0e\xf3\xdd\x36\x37\x1d\x62\xfd\xaa\x93\x86\x84\x46\x34\x84\x7c\x10\xc9\xc3\xcf\x2b\xd7\xd5\x09\xea\xb4\x00\x45\x3f\xc8\x70\x85\x18\x8d\x3f\xe6\x9e\x65\x72\x56\xa7\x52\x6f\xb5\x95\xe4\xc0\x98\x09\x10\x96\x3c\x0d\x7a\x84\xfc\x71\x09\x13\xa9\x86\xa9\xb4\xa3\x10\x89\x5c\xb9\x44\xc0\x9a\xc3\xba\x76\x79\x19\xa0\x60\x86\xa0\x3a\xe7\x1b\x59\x83\x78\xa3\xa7\xae\x5c\x96\x91\x75\xa0\xa0\x96\xfd\xab\x73\xed\xb5\xc8\x0a\x22\x2b\x1f\xa1\x8e\xfa\xb7\xd9\x90\xe5\x71\xad\x5e\x0d\xf4\x85\x89\x43\x4f\xa7\x06\xda\xf4\x04\xb8\xb8\x88\x76\xdb\xab\x00\x7b\xc3\x4e\xbd\xe6\x8d\x63\xcd\xf3\xd3\x16\xe6\x0c\xb9\x74\x62\x9f\xc0\x8a\x11\xcc\x8d\xf3\x98\x6e\xa4\xbb\xd6\xc6\x26\x34\x72\x57\x83\xc2\x1e\x34\xc2\xca\xe5\x76\x8d\x4e\xbf\x3a\xd5\x46\x9c\x47\x2b\x61\x0e\xf8\xe4\xd0\x92\xea\xd8\x48\x73\x96\xc6\x78\x85\xa1\x73\xac\x4b\x29\xf5\xec\x03\x66\x3c\x41\x36\xd5\xc4\x2e\xbb\x2c\x35\x6c\x07\xc6\x67\x97\xb3\xf7\x3d\x1f\x78\x9e\x67\x74\x85\xeb\x40\x60\xe5\xf2\xbf\xbe\x63\x58\xc9\x88\x68\x86\xad\x6b\x61\xf3\x4c\x73\xa4\x20\xf1\x1e\xe5\x64\x32\xb4\x9b\x03\x9d\x26\xa1\xcb\x1e\xe7\x1a\xe9\x56\x36\xee\x5c\xd2\x0b\xf3\xd5\x25\x08\x95\xdb\x1c\x94\x8d\xb1\xa9\x6f\xbd\xa7\xa9\x48\x1e\x4e\x88\xde\xc4\x14\x81\x98\xbf\x7b\x86\xa0\x65\x1d\xe7\xfb\x0b\x84\xeb\xd1\x2f\x17\x4d\x85\xba\x46\x0b\xa0\x2b\xeb\x71\x6f\x18\xba\x28\x18\x62\x81\x9e\x7b\xac\x85\xe3\x4b\x39\x30\x27\xc3\x2b\x11\xe9\x3c\xfd\xdd\xc6\x43\x70\x1c\x06\x1b\x0e\x0e\xed\x87\x4c\xc3\x81\x37\xaa\x0b\x71\xc0\x2e\x27\xb0\x1f\x15\xeb\x3b\x51\x12\xb5\x93\xe5\x71\xab\xd7\xf8\xd8\x29\x7c\x16\xdc\x77\xef\x22\xdf\xc9\x2d\x16\x34\x29\x78\x34\x29\x04\x6d\x0e\xf0\x30\x07\xa4\x10\x1f\xf6\x26\x03\x59\x12\x17\xbc\x48\xf2\xf5\x4e\xc9\xb2\xa2\x8c\xf3\xc8\x97\x90\xaa\x4e\x75\x90\xa0\x59\x12\x08\x99\x30\x00\x81\x88\xf7\x70\x4d\x91\xe5\xa6\x4d\x61\x0a\x37\x74\x79\x2d\x76\xad\x32\x6a\x64\xa7\x0c\xc1\x6d\x79\xb4\xfb\xba\x04\x85\xfb\xc0\x93\xcb\x8a\x00\x96\xd7\xb6\x09\x85\x45\x88\xb7\xee\x0c\x8d\x06\xdd\xec\x25\xc8\x6f\x57\x63\x46\x0c\xa2\x7d\x27\x00\xbd\x14\x66\x01\xd9\xb0\x43\x2b\x5a\xa6\xf1\x13\xea\x0b\xbe\xc7\x7a\xc3\xe2\x02\x91\x00\xad\x5d\x7d\x5c\x90\x11\x5c\xa0\x11\xd4\x57\xf1\xc2\x7d\x15\x03\x1a\x13\x90\x6a\x5f\x16\x08\xea\x16\xfd\x35\x31\xf5\x1f\xb7\xd0\x9a\x85\x68\x97\xd1\x87\xfe\x6f\xf1\x76\x76\x8e\x4f\x4c\x35\x66\x30\x11\x19\x5a\x66\xf2\xfc\x64\x21\x88\x4a\xf4\xce\x10\x0d\x11\xa4\x15\x21\xe6\x3c\x7b\x30\xe3\x68\x70\x5d\x4a\x6b\xe4\x87\x62\xe4\x25\xf7\xe4\x86\x47\xa3\xdb\x43\x94\x55\x1d\xb2\x29\xd1\x88\x61\xd0\x43\x93\xab\xc0\x34\xec\x7a\x92\x64\xed\x04\x9c\xb0\x4e\x6e\xcf\xb2\xa3\x60\x9e\xdc\x5f\x5a\xe3\xa6\x9b\xf4\x8a\xf1\x9a\x0f\x26\xd9\x09\x51\x9c\x67\xc4\x6a\xaf\x51\x57\xc2\x88\xae\xe1\x92\x07\xc3\x4f\x8b\x30\x4f\x43\x53\x8f\x37\x7f\xfa\xd3\xb6\x89\x61\x56\x3b\xbf\x9b\x9b\x91\x79\x32\x43\xf3\xc4\xa1\x30\xca\xf0\x66\xfc\x2a\x3d\xe6\x78\xc2\xe8\xf5\x5f\x66\x68\x16\x81\xfa\x38\xbf\x68\x16\x19\x69\x77\x4d\xce\xb4\x56\x7e\x18\xa4\x6d\x38\xbb\x6a\x48\xef\x27\x56\x5e\xe0\xf1\x9d\xa3\xf1\xbd\x0a\xa0\xe9\xb5\x0a\x9f\x67\xe3\xf8\x52\x82\x37\x93\x3c\x47\xfe\x05\x76\x80\x74\x1e\xaf\x89\x8a\xd7\xf6\xcc\x5c\x17\x89\x68\x35\x05\x49\x24\x64\x67\x50\x7a\x10\x73\x2e\x6c\xe1\x56\xe1\xcb\xd8\x92\xfa\xef\x5d\x5c\x29\xe2\xc4\x96\xde\xc4\xfa\x0d\x62\x96\xe8\xb9\x27\x38\xb0\x13\xbf\x3a\xa2\x59\x7e\x51\x8d\x0b\x37\x04\xa2\xca\x9a\x82\xb4\x93\x7e\xa7\xa0\xc6\x1a\x07\x83\xd8\x40\xd8\x9b\xa9\x48\xcf\xe7\xf0\x3c\x4a\xb9\x8d\x25\xe2\x6b\xaf\x37\x0b\x91\x99\xa9\x45\x64\x5a\x9c\xe3\x1b\x4e\xc1\x6f\xd8\x7d\x68\x10\x3e\xa2\xad\x1d\xbf\xe1\x82\x7d\x9e\x41\x5c\x20\x14\x9c\x20\x41\x14\xf2\xf4\xde\xb8\x86\xfd\x56\x24\x43\x55\xaa\x05\x3f\xc7\x4e\x02\x3c\x76\xed\xf6\x03\x9a\xfa\xc0\x1a\x64\x12\x30\x6e\xd2\x2c\x2f\x39\x0b\x26\x3e\x90\xba\x32\xf8\x0b\x74\x1a\x63\xc3\x52\xba\x50\x61\x80\x8d\x5f\x24\x8a\x03\xc8\xba\x88\xec\x78\x8c\x2d\x22\xae\xfc\x22\x12\xcf\x87\x9f\xf4\x12\x48\x84\xf5\xd8\xbb\x59\xbc\x12\xd7\xb4\x88\x8a\x02\x5a\x57\xc6\x90\xa4\xb2\x06\x5d\xc0\xda\x88\x72\xb9\xb0\xc0\xa0\xea\x48\xaf\x78\x11\xbe\x3a\x6b\x65\xac\xf2\x2a\x8f\xf0\x7a\x6f\x6d\xca\x93\xbd\x38\x2d\x00\xd8\x56\x5d\x74\x5e\xe5\xfe\x81\xc3\xae\xa1\x46\x3b\x36\xe6\xc1\x85\x8a\xab\x40\x42\x79\xae\x61\xdc\x5c\x2d\xe6\x12\x32\xc9\x31\x3e\xaa\xc5\x84\x0e\xc0\x7a\x09\x40\xa5\x00\x22\xa5\xc0\x73\xc7\x80\x32\x7b\x50\x9e\x84\xba\x80\x56\x8d\x56\xc4\xcd\xb0\x22\x58\xd9\xe0\xad\x8b\x2a\x8f\x4d\xfc\x5e\xb2\x8f\x84\xe3\x97\xeb\x4c\x54\x54\x19\x25\x11\x06\x1d\x19\x03\x04\x09\xc7\x3c\xdc\xcd\x59\x92\x49\xb3\x34\x0a\x1b\x64\x24\x78\x04\x9c\xf2\xc1\xfd\x94\xc0\xb9\x9f\xac\x2c\x5f\x33\x25\x88\x67\x6c\x12\xaa\x8c\x69\xec\x20\x05\xd3\x80\xc8\x8f\xde\x9d\xa3\xdf\xd0\x39\x8e\xd8\x3d\xfd\x74\x0e\x70\x5b\x38\xe3\x36\x39\x8b\x1b\x0c\xcf\xd6\x52\x19\xee\xf6\xeb\xa3\xe7\x88\xfa\xf4\x2c\xe0\x6d\xf8\x8c\x45\xca\xd2\xe1\x09\x1e\x50\xd8\x9b\xed\x96\x00\xfd\x2c\x48\xbd\xad\x25\x39\xd9\x83\xe1\x0d\x02\x1c\x24\xca\x37\x44\xb5\x61\xc9\x3d\xae\x3f\x43\xbc\xf7\xdf\x15\xfb\x9c\xf4\x82\xef\x79\x17\xca\xf5\xaf\x9e\x4a\x97\x4a\x29\x1c\xcf\x56\xf1\x4e\x81\xba\xa1\x54\xaf\x57\x7c\xd6\x56\xc3\xb8\xda\x20\x0c\x7a\xd8\x00\xaf\x81\xd3\x32\x2a\x15\x97\x8c\x62\xc7\x67\x9b\xe6\x40\xa9\xb6\x96\x0b\x5d\xbf\x3d\x57\x23\xa3\xda\x40\x54\x7b\x3e\x41\xc8\xf4\xd8\x56\xb9\xdb\xf2\x59\x59\x8d\x66\x6a\x87\x50\xbb\x04\x42\xcd\x4e\x82\x72\xb7\xe8\x0b\x02\xb9\xe4\x2a\xa3\x55\x15\x5a\x40\xa5\x7c\xbb\x5c\x52\x60\x99\xef\x26\xaa\xb3\x0b\xc9\x9a\x2f\x41\x6f\xd0\x32\x6c\xb4\xf4\x4a\x3b\x0a\x83\xbb\xe7\xb0\xf1\xb6\xe1\x2b\x39\xab\x33\x35\x5b\xec\xd6\xbe\xb5\xf3\x9d\x7c\x43\xeb\x04\x6a\xe4\xeb\x0f\x6e\xa4\xd5\x2d\xea\xe0\x6f\xda\x3f\x14\x76\xa9\xd8\x35\xc0\xfe\xe6\x09\xdb\x54\xb1\x76\xd7\x44\xbc\xc5\xd8\xed\xff\x3e\xd3\x6d\xcf\xea\x37\x93\x33\xdd\x52\x5c\xdd\xe8\x15\xca\x67\x2a\xa8\x37\x83\x89\x92\x10\x0a\x5b\x3a\x8e\x9a\x6f\xe4\xeb\x06\x78\xa5\x33\xf0\x7c\x82\x69\x9c\xed\xc7\x8e\x11\x21\xee\x94\x1f\x68\x2f\xc1\xff\x3c\x3b\x97\x8c\xb1\x7c\xaf\x94\xf4\x19\x0f\x96\x4e\x6d\xe2\xaf\x4b\x76\x0d\xc1\x2f\xa1\xba\x81\xd4\x0a\x87\xcb\x37\xbf\xba\x8b\xc4\x4e\x6b\xd2\xd4\x1b\x7d\x49\xd2\x86\x25\x49\xbf\x04\x3f\x07\x7d\x82\x6d\xe3\x6b\xe9\xf0\x64\x4b\x43\x0d\x55\xff\xed\xd2\xea\xbf\x99\xaa\x7f\xb8\xb4\xfa\x07\x63\x75\xca\x6f\x65\x58\x7d\xa1\x18\x15\xf8\x3b\x4a\xcf\xd5\x74\xd8\x00\x29\xf6\x3e\x48\xf8\x75\x93\x0e\x2d\xfe\x6e\x68\x71\x03\xb4\xc4\xbb\xa1\x25\x0c\xd0\x92\xef\x86\x96\x34\x40\x4b\xbd\x1b\x5a\xca\x00\x2d\xfd\x6e\x68\x69\x03\xb4\xcc\xbb\xa1\x65\x0c\xd0\xb2\xef\x86\x96\x35\x40\xcb\xbd\x1b\x5a\x4e\x87\x76\xf3\x17\x9f\xc0\x04\x49\xc6\x8b\xe8\x2f\xc6\x45\xf4\xb7\xe0\xdf\xbe\x5e\x56\x1f\xd5\x30\xae\xe1\x40\xf0\xc2\xfa\xa8\x86\xb1\xfe\xdf\x2e\xae\xff\x37\x53\xfd\x5b\x5f\xf5\x67\xf0\xda\x82\x3d\x31\x0a\x05\xf5\xfa\x3f\x96\xbb\x72\x06\xee\xca\xbd\x04\xff\xcb\x61\xff\xb0\xd8\xa8\x34\xe0\x86\x88\xef\x21\x59\x43\x90\xbb\xc9\xfb\x86\xa0\x06\x36\x37\x55\x2f\x5c\x58\x1d\x02\xfb\x98\x00\x14\x2f\x04\xa0\x44\x89\x35\xc1\x28\x5d\x08\x43\x8d\xcb\x62\x02\x52\xbe\x10\x88\x1a\x48\xda\x04\xa4\x72\x21\x10\x53\x64\x66\x13\xa4\xbb\x0b\x21\x19\x63\x22\x9b\x00\x55\x2f\x45\x49\xf1\x61\x6f\x02\x52\x7b\xdb\x28\xf5\x90\x12\x62\x04\x73\xef\x1b\x8c\x39\x04\xaf\x09\xc8\xc3\xa5\x40\x6c\xb4\xad\x5f\xb8\x6a\x70\xb0\x3f\x13\x84\x86\x6f\x08\x86\x70\x81\x26\x08\xed\x0b\x21\xd8\x57\x6e\xd7\x37\x04\x35\xd2\x99\xa9\x7a\xef\x5c\x75\xcd\x44\xf4\x6b\x00\xbf\xb1\xf9\x80\x2f\x3f\x19\x23\x74\x99\xe0\x3d\x5f\x36\x2c\xf6\xfe\x8c\xde\xb8\x8a\x2d\x93\xec\x25\xf8\x3f\x17\xb0\x45\x4b\x5c\x24\x13\x42\xb4\x6f\x28\xb6\xd8\x43\x26\x38\x63\xdf\x70\xdc\x42\xfc\x98\xc0\x4d\xfc\x8f\xbb\x43\x90\x1c\x13\xa8\xa9\x6f\x50\x96\xe8\x26\x26\x28\x8c\x6f\x28\x03\x4f\x3a\xcd\xfc\xd3\x7b\xe0\xc8\xa5\xe6\xbe\x01\xa8\x01\x25\x4c\xd5\x17\x17\x10\x96\xb8\x03\x35\xd6\xe6\x2e\x18\x65\xa7\xfa\xab\x8b\xe6\xac\xcb\x80\xf2\x17\x70\x15\xdd\xef\x86\x09\xc4\xfa\x1c\x08\xf5\x7d\xba\xed\x60\x07\x5e\x57\x23\x15\xca\xfe\xbc\xda\xe0\x6e\x41\x6d\xca\x70\xbb\x1d\x1a\xdd\xf8\x38\x6a\x40\x82\x9d\x72\xd9\x9e\xb0\x21\xd3\xdb\x7b\x53\x17\x44\xff\x16\x26\xc3\x33\x6a\x13\x08\xc9\x27\x08\xed\xb5\xb1\xa9\xf6\xd6\x5f\x6d\xd3\xcb\x60\x13\x0f\x73\x11\x1b\xaf\x0c\xa1\xf5\x1c\x20\x0a\x3e\x44\xc8\x05\xf9\xf3\x83\x8b\x30\xca\xbc\x30\x4a\x4d\xb0\xb0\x40\xf3\x41\x92\x0c\x5e\xe1\x3c\xc5\x14\x07\x47\x61\x38\x53\x49\x2b\xb9\x9a\xc5\x0c\xcc\xd1\x38\x5f\xfb\xa2\x97\x40\x9f\x13\x6a\x9e\xf2\xb5\xd5\x05\x31\x10\x2b\x8f\x41\xf4\xb7\x5a\x96\xd8\x34\xc0\x27\x0e\x29\x4f\xd2\x4a\x6e\xa9\x08\x1b\x20\x56\xea\x82\xe8\x6f\xe5\x2b\x31\x3d\xa1\x6f\x58\x3f\x0b\x92\x64\x50\x79\x46\x81\xdd\x81\x85\x16\xe4\xf6\xae\xc1\x72\x3d\x71\xbd\xa1\x6f\xb8\x9d\xef\x74\x37\x88\x58\xf3\x59\xf3\xe3\x69\x9b\xbf\xbd\x80\x21\x96\x91\x04\xa3\x83\x3e\xa8\xe3\xe3\xf4\x1c\xd5\xe1\x56\xfd\x1a\x4c\x3e\x7e\x03\x2f\x4e\xae\x02\xdb\x2b\x7c\x4c\xa1\x39\x33\xb6\x3f\x9c\x26\xe8\xac\xf5\x80\x45\x0e\x1e\x3d\xb6\xa2\x28\xcc\x11\xbe\xdf\x16\xec\x5c\x3f\xc4\xf3\x2e\x15\xb1\xf7\xdf\x5a\x04\x6e\xa4\x84\x6f\x03\x16\x0c\x7e\x0d\x4c\xad\xdf\x9c\x6f\x8f\xe0\xeb\x0c\xd8\x91\x86\xb9\xf4\xd7\x2b\x78\xaa\x9c\x4b\xc3\xe5\xd7\xd0\x44\x39\x6d\xa4\xc5\x22\x62\xb4\x79\xd9\xd2\x5f\xe2\xe5\x69\x82\x9f\x37\xa7\x13\xb1\x9c\xd7\x19\xf5\xd6\x1b\x14\xb6\xce\xa0\x25\x29\x35\xe9\x66\x68\x1b\xf6\xba\x70\x72\x8e\x2c\x1e\xd7\x29\xe0\x74\x93\xe5\xb7\x8c\xcf\x4b\x10\xb8\x0a\x2c\x52\x2a\x9e\x44\xda\x3d\x22\xc7\x35\x21\x0e\x28\xf7\xa8\x47\xd7\xd0\xed\x38\x85\x8d\x1a\x29\xfc\x2a\x4a\x84\x4b\x17\xea\xde\xe2\xd4\x45\x9f\x47\x8d\x1f\x42\x18\x32\x50\x76\xa2\xd3\x38\x93\x48\x26\xc2\xba\xcb\x25\xc3\x5a\xf0\xf4\xbc\xa4\xae\x12\x77\x07\x4c\x62\x80\xe5\x03\xe0\x5b\x02\x0e\xda\xd5\x47\x13\xb6\x55\xa9\xfb\xcd\x32\x7e\x85\xeb\x0b\xd8\x1a\x0a\x6b\xe6\x1d\x2e\x68\xb5\x03\x0a\xef\x20\x02\x18\x55\xfe\xdc\x85\x24\xde\xda\x0f\x75\xba\x9c\xb9\x78\xe4\x7d\xe9\xc8\xfd\xc2\x91\xeb\x6e\xee\xd4\xd5\x60\x28\xf8\xf1\x7c\xa1\xb0\x9f\x42\x7f\xf7\x53\x28\xe2\xa7\xd0\xb5\x9f\x42\x37\x67\x0a\x5d\x44\x8a\x8b\x48\xe2\xc1\x00\xe6\xba\x8b\x22\xea\xd6\x37\x10\x8f\xe9\xf8\xa6\xe1\xf0\x87\x60\xec\xe7\x21\xf8\xf7\x1f\x82\x60\xfc\xe7\x21\x18\xf9\x21\x08\x26\x7e\x1e\x82\xd7\x7f\xf6\x21\xbe\xf9\x31\x43\x7c\xfe\x72\xba\xcf\xcb\x9e\xba\x18\xa6\x08\x95\x3e\x6f\x75\xde\x9e\xe7\x34\x51\x1f\x5d\xf5\x98\x24\x57\x6e\x18\x5e\x59\x84\xa3\xeb\xeb\x1f\x87\x73\xd3\x0f\x0b\x6d\xf9\xe8\x98\xdf\x06\xf9\xcb\x89\xa4\x1f\x85\xc6\xc3\x3f\xae\xe7\x82\x9f\x9e\xff\xe3\x3d\xd8\x26\x7e\x20\xb6\xdf\xff\x2c\x64\xfb\xdf\xf7\x20\x12\xfb\x81\x88\x64\x7c\x23\x62\x92\xfc\x0c\x7a\x7b\xd8\x4d\x35\xfb\x71\x48\x66\xdf\x86\xa4\xd9\x3c\xf0\x07\xe0\xf9\x1f\xbe\xf1\xb4\xe2\x70\x65\xd3\xdc\x7e\x1c\x56\xd5\xcb\xe7\x9a\x4c\x8f\xbb\xc6\xd8\x49\x3f\x8f\x64\x9f\x2e\x47\xee\x9f\x1e\x8f\xf1\xe7\x53\xe5\xb7\xb7\x52\xe5\x8f\x0f\x09\xf3\x53\x89\x31\x65\x66\xf4\x96\x93\xdf\xbb\xaa\xdc\x9c\x3d\x83\xcb\x5c\xcd\x23\xc8\x7f\x4a\x37\x66\xa7\x91\xfe\x65\xa3\xf3\x0a\xae\x22\x83\x9c\xd1\xc3\x05\xb5\x5c\x17\x9e\x2a\xe0\x77\x8f\xda\x17\xfc\x94\x13\x09\x47\xfa\x33\x7a\x12\xc5\xd8\x74\x2b\x09\xeb\xe9\xb8\x9a\xe5\x5e\x93\x67\xd0\x5c\x17\x69\x8a\xdc\x22\x76\x17\xb5\x8c\x5e\x61\xfd\x8f\xfe\x79\x5a\xb5\xba\xc5\x73\x86\x00\x6c\xc6\x56\x9d\x1e\xff\xe3\x1f\x01\xc3\x97\x42\xb9\x7e\x4e\xfd\xd4\xf4\x54\x33\x1c\x2d\x8e\x96\xce\x84\x6d\xdd\x27\xf7\x07\xc9\x5b\x20\xbb\x23\x5e\xed\x2e\x61\xd8\xa5\xa2\x87\xa7\x6c\x1b\x21\xa8\x8f\xfe\xca\xc5\x7c\x96\x8b\xfb\xd3\x20\x9c\xf1\x56\xdc\xb5\x5b\xb7\x09\x56\xe6\x1c\x2c\x9a\x5a\x1d\x1b\x15\xc8\xd3\xc2\x1e\x54\x73\x84\x15\x0e\xbf\xf1\xd9\x97\x2b\xe6\x26\x7f\x2e\x8e\x63\x65\x34\xdd\xba\x5a\x97\x5d\x9e\x99\x39\xcf\x0e\xe5\xd8\xf7\xb3\x67\xab\x11\x58\xc5\x1f\xd1\xff\xbf\x7d\x0a\x04\xa9\x20\x90\x57\x04\x53\x5f\x30\x17\x3c\x53\x13\xac\x92\x81\xbf\x7b\x03\x0f\x88\x46\x83\x2b\x05\xee\x61\x93\x10\xaf\x01\xfc\x7f\xeb\x7e\x38\x7e\xd4\xe4\xb6\xa0\x17\x0c\xbe\xcb\x18\xa8\x1d\x79\xf8\x30\x07\xd2\xe7\x96\x13\xad\x9a\x03\x7f\x92\xf9\x0f\x53\xc2\x72\x7b\xc9\x41\x05\xed\xd6\xce\x75\xf9\xcc\xd6\xc0\xa1\x49\xc1\xa9\x7d\x31\xcb\xb9\x2e\xbc\xe6\xca\xd9\x73\xb1\xfd\x33\xb9\xb7\x45\xbe\x9f\x0f\x90\x00\x07\x50\x13\x34\xad\xf4\x0d\xf3\xca\xef\xee\xab\xb8\x4e\x26\x0f\x6a\x9d\xb1\xc0\x99\xef\x99\x3c\xa5\x62\xf7\xe7\x6e\x1e\xe7\x37\x8d\x5b\x5f\xef\x97\x89\x53\x9c\x2b\xfc\x04\x98\xfc\x7d\xeb\x6b\xd3\xf2\x1d\xc1\xc1\x51\xb0\xf4\x69\x46\xf2\x69\x9e\xc2\x20\xff\xb2\xf1\x09\x54\xed\xc1\xda\x31\xdc\x8f\xc6\x40\x08\x49\x40\x04\x5d\xfb\xb5\x23\xab\xb8\xfc\x2d\xb8\xf9\x9b\x7f\x3b\x19\xfc\x03\x5c\xfe\x46\xa1\x6a\xb7\x17\x55\xbb\xc0\x7c\xa7\xe3\xb6\x7e\x0b\x6e\xe9\x18\xaa\xf7\xd3\x91\x0b\x8a\xc1\xcb\x71\x73\x75\x7f\x1e\x09\xc4\x5c\x43\x4d\x98\x1c\x9f\xab\x51\x27\xc4\xe0\xcf\xef\xe1\xea\x0d\x3d\x0c\x52\xab\x9f\x8b\x99\x6f\xfd\xc6\x43\xce\xb1\xf0\x67\x38\xd6\x6f\xcb\x2a\x77\x5e\x23\xee\x8c\x7b\x12\xf4\xd5\xc4\xf7\xb7\x88\x88\xb6\x10\x24\x6d\x18\xf8\xc8\x0c\xfe\xfc\x8b\x08\x7f\xc3\x94\x30\x16\xf8\xf2\x25\x18\xbe\xfd\xe1\x8c\x28\xb2\xfe\x09\xdc\x2d\xe2\x97\xbb\xf9\xe6\x6a\x17\x50\x2f\xf2\xc7\x50\xef\xa2\x59\x78\x7e\xf6\x91\x2d\x49\x9d\x81\xce\xfb\xd5\x8f\x13\xf3\xdd\x44\x79\x2f\xa9\xe3\x9d\x62\xbe\xa9\x49\x5f\x52\xbe\x6b\x0c\x89\xe0\x5f\x54\x79\x1c\x12\x11\x35\xa1\x06\xec\x72\xac\xe7\x4f\xbf\xf0\x6a\xd8\x19\x2b\x6b\xf5\xf7\x88\x61\xe4\xde\x90\x97\x4c\xfb\xc1\x22\x45\x19\xbe\x20\x29\x0c\x5f\x34\xf2\xaf\x9f\x5b\x07\xf4\xac\x77\x47\x27\x2f\x9c\x06\x50\x5e\x81\x43\x94\xa6\xce\x46\xa1\x50\x71\xf2\x02\xd6\x56\xe7\xe6\xc5\xf1\x47\xce\xc0\xd5\xa4\xe9\x0b\x00\x6b\x75\x3c\x21\x2b\xb3\xe3\xa2\x98\x4f\xc6\x69\xe5\x0a\x7c\xee\x04\xdc\xc5\x6b\xb0\x47\x13\x6e\xe0\x4d\x3a\x9a\x67\x1b\x3f\x49\x2b\xa6\x5c\xa9\x6a\x30\xe1\xf9\x40\x4c\x9f\xfd\x9e\x11\x49\xda\x60\xa6\x03\x78\x93\x0b\xde\xb0\xe3\x4f\x5a\x59\xd6\x3c\xb6\x5a\x00\xa6\x1b\xc4\xe9\x19\xb1\xe4\xe2\xa7\x45\x5a\xd3\xa6\x78\x67\xb2\xd9\x97\xd2\x82\xe1\x38\x21\xb0\x17\x44\x6e\x0a\x57\x0e\x4d\x6e\x95\x8c\x71\xf6\x34\x1f\xb2\x37\xc2\x6c\x86\x26\xde\x13\x3b\x55\x83\x8a\xaa\x9f\xaa\x0c\x3b\x5f\xc8\xc6\x96\x24\xb8\xca\x7a\x33\x13\x78\xf9\x09\xe7\x41\x83\x63\x01\xb5\x64\x86\x2b\xd8\xe1\x4a\x1e\x70\x0d\xf7\x4d\x75\xb7\x20\x60\x1b\x15\x15\x47\xa5\xd8\x57\x35\xfc\x29\x91\x51\xf8\xe5\xfd\xce\x00\x14\xc7\x68\x62\x28\x9e\x0e\x9f\xbf\x71\x5a\x68\xd5\xf5\x2b\xa5\x90\x50\x6e\x73\xf6\x9b\xa5\x72\xa7\x5e\x6b\x96\xb5\xfb\xa4\xda\x17\xa5\x44\x01\xfc\x1f\x68\x77\x47\x71\x4a\xbd\x09\xda\x1c\x94\x3b\x5d\xa8\x99\x25\x57\x41\x49\x5a\xcf\xad\x75\x6b\x85\x3a\xe4\xc7\xd2\x6a\x01\xf2\x49\xbd\x2e\x8a\x09\x83\x63\x9b\x9a\xaf\x8b\xf2\x9a\xf1\x98\xf6\x75\x71\xd4\x7b\x81\x9a\x5c\x1e\x93\xe8\xbf\x1d\x61\x2f\x3d\x6e\x99\x2d\x63\xb2\xfe\x29\x99\x15\xb4\x1c\x19\xa9\x8b\x16\xdd\x9a\x99\x1a\x25\x06\x25\x3f\xcf\xb3\x2b\x7c\x1c\x82\x0b\x5a\xed\xdc\x68\x72\x2b\x33\x9d\x0c\x6f\x5b\x10\x38\xc5\xb7\xb7\x74\x53\x12\x56\xb6\x4c\x75\x41\x10\xf7\xd0\xc4\x0d\x94\x16\xfa\x92\x0d\x39\x07\x88\x0f\x87\xdf\xdf\xa0\xcf\x9b\xb1\x1b\xa0\x53\x87\x74\xfd\xac\x2f\x3f\x67\x2a\x13\xde\x68\x57\xd5\xd1\x0e\x28\xca\x8e\xbe\x72\xd1\x8a\xff\x68\x75\x4c\x1d\xf6\x1e\x07\x3d\xe0\x96\xdb\x38\xed\x59\x7e\x2a\xec\x6f\x94\xd3\x40\x73\xb6\xb9\x6a\x5d\x10\xd6\xba\x97\xe8\x70\xd8\x35\x18\x96\xb1\x82\x27\x6b\x76\x88\xad\xb9\x17\x59\x99\x21\xb1\xab\x0d\x6e\x34\x75\x29\xc6\x69\x3a\x46\x22\xf8\xb6\x67\x38\xf0\xb3\x7a\xea\xea\x62\xcf\x7b\x89\x38\xfb\xff\x81\x19\x72\xeb\xe1\x26\xdc\x36\x4f\x34\x32\x24\xc3\x01\x46\x59\x7a\xee\xa1\x0e\x3d\xdd\x01\xea\xc7\x0e\xd6\x56\x5e\xa8\xaf\x37\x78\xe6\x19\x81\x3b\x15\x42\x93\xd0\x87\x77\xd6\x98\xd1\x3b\xab\x4b\x8f\x54\xf7\x55\xce\x4d\x89\x0a\x3e\x08\x0a\x63\xb9\x04\xee\x5a\x36\x7c\xe5\x51\x02\x21\x8e\x68\x28\x13\x13\xbe\x27\x2c\x54\xd2\xcf\xbd\x63\xff\xcc\xd3\x93\x39\xaa\xb1\xd7\x31\xe7\xf0\x17\x46\x54\xad\x73\x96\xf3\xe0\xe1\xb0\xc4\x01\x44\x93\x04\x07\xaa\x72\x9a\x3f\x51\xf2\xe6\x2e\xe4\x10\x18\x53\xe1\xb1\x06\xaf\x61\x61\x7d\x55\xda\x4a\x99\xf6\x7c\x5b\x43\x45\xe2\x34\x8b\x11\x2d\x1a\xb9\xd9\xa5\x9d\x2e\x12\x0a\x9c\xa4\x78\x3c\x75\x7a\x52\xe0\xd6\x19\x87\xb3\x1a\x7c\xdc\xff\x3f\x68\x7c\xff\x27\xc0\x4a\x01\x59\x10\x02\x1c\xb8\xf9\xba\x09\x34\x90\x18\x1f\xe0\xd8\x25\xc3\x1d\xd1\xf6\x3a\xa6\xa7\x81\x62\xb7\x43\x0e\xfe\xdd\x97\x1a\xe2\x0b\x12\xf6\x79\x8d\x7e\x3b\x3f\x1a\x50\x7d\x53\x48\x81\x88\x2d\x6c\xde\x94\x95\xd6\x46\x5f\x56\x3a\x92\xf0\x6a\x13\x04\xfb\x10\x1d\x26\x4e\x51\xdd\x2c\xdd\x0b\xc7\xa0\xa4\x20\x51\x21\xf4\x6c\xd1\x6c\xc7\xa0\x64\x5e\x3b\xe2\xe1\x34\x94\x6a\xfc\x49\xd0\xc7\xb0\x4f\x79\x8f\xa0\xb4\x9f\xed\x31\x04\x3f\x06\xae\x63\x1e\x2e\xe8\x26\x1e\xa1\x57\xe1\xe9\x83\xc5\xc9\x95\x2a\x36\xa3\x05\x34\xc7\x02\x73\x98\x3c\x35\xc1\x4e\x46\x75\x0a\xd9\x43\xaa\xa2\x09\x88\xe6\xeb\x8b\xf4\x55\xfb\x5b\xe5\x3e\x5e\x8e\xc7\x66\x0e\x93\xd0\x01\x94\x2d\x88\xaa\x9b\x32\x67\xa8\x6b\x5c\x19\x33\x6f\x81\x05\x95\xe5\x18\x34\x68\xa8\x9c\x0f\x76\xa4\x91\x76\x4e\x7c\xf9\xcd\x21\x84\x20\xfa\xe5\xed\x3f\x17\x74\x2c\xee\x65\x0e\x81\x06\xaf\x02\x47\x35\x01\x87\xd0\x63\x35\x11\xff\xea\xee\x3e\x77\xec\x75\xd8\x00\x05\xe6\x78\x3e\x92\x67\x3c\xd0\xd6\x35\xbc\x5a\x59\x61\xb1\x1f\x3f\xd4\x08\x4d\x0c\x2e\xeb\x6d\x51\xca\xa1\xc0\x14\xff\x34\xfb\x96\x9d\xaa\xa3\x0f\xbe\xd4\x4c\xca\x90\x6a\xba\x05\x0e\xab\xb5\xe4\x12\xd6\x9c\x38\xda\xc5\xf0\x3f\xac\x49\x2b\xda\xb8\x3b\x0f\x08\x3d\xd9\x6c\x59\x91\x09\x85\x71\x2b\x6f\xc1\x4e\x41\xcf\x57\x23\x5a\x98\xf9\x55\x38\xb0\xb6\x7a\xd1\x56\x9c\xfe\x5c\xef\xd8\x29\x23\x40\x4b\xb6\x12\x6a\x7f\xaf\xc9\x52\x0d\xfa\xf7\x14\xfc\x4d\x0b\x08\xbf\xba\x0a\xc0\x1b\xb9\x95\x29\x5e\xfc\x5e\xfd\x12\xcb\x9e\x77\x89\xbb\x47\xb5\x04\xa2\x6a\x11\xf9\x1d\x89\xa4\x6b\x67\x97\xe0\x58\xe7\x0c\x43\x8b\xbf\x06\x70\x04\xc5\x50\x11\x8c\x6e\x59\x20\x1a\x01\xa3\x6b\x66\x28\xd7\x05\x8a\x21\x7a\xaf\x5a\x8d\xa8\x6b\xee\x55\xc6\xc4\xd7\xa7\x5a\x5c\xd1\xd9\xfc\x7a\x5a\x87\x07\x81\xdf\xce\xdb\x8b\x81\xa8\x45\x42\xce\xee\x55\x00\x88\xbb\x07\x94\x6c\x9d\x7d\x87\x91\x57\x43\x5f\x51\x32\xdd\x7b\xbc\xc0\x7c\xdb\xd6\x65\x8c\x47\x3c\x95\xc1\xb3\xee\x1b\x4e\x01\xda\xb1\x54\x18\x87\x3b\xc4\x9f\x8b\x0a\xb2\xe0\x36\x1b\x7d\xfe\x06\x0f\xf5\x51\x96\x07\x75\xe7\xd7\x68\x07\x17\xc4\x6b\x30\xcb\x7f\x53\xc6\xd7\xbb\x8a\x5e\xbe\xf8\x96\x9b\x65\x30\xef\x88\x29\x18\xf1\xa7\x29\x90\xf5\x6f\xbf\xc2\x5a\x0b\xe0\xc6\x3e\x29\x8d\xec\xd1\xca\xb9\x86\xfb\x1e\xc1\xdf\xfe\x86\x5a\x82\x68\xef\xc1\x5f\xa3\x50\xee\xb7\xe0\xad\xee\x8f\xfa\x68\xb9\x13\xf2\x1b\xc2\x3d\xe5\x01\x96\xdc\x1d\x3c\x03\x58\x3d\xd5\x3d\xfa\x7a\xff\xf4\x57\x1f\xa7\x29\x18\x9f\xe0\x5f\xe9\xd5\xfa\x36\xf8\xe3\xae\x54\xfe\xea\xbf\x65\x4e\xfe\x91\x0d\xff\xe6\xbf\xe1\xf9\x8f\x6a\xd8\xf7\x89\x0e\x6e\x18\x87\x3b\x81\xd7\xe1\x9f\x11\x0e\xfc\x58\x42\x74\x47\x42\xcf\xf1\x0d\xd3\x15\x64\xa1\xd5\x9b\xfd\x4c\xff\xb1\xfb\x97\x69\x77\xf5\x10\x73\x8c\xb5\xb6\x5e\x71\xcb\x45\x0f\x2d\xc2\x64\xe5\xf4\xd0\x22\xec\x37\x56\x57\xac\x0c\x5b\x24\xd6\x92\xc0\x5a\x68\xb7\xa5\x90\x26\x3e\xba\x34\x7d\xf5\x5e\xb3\x8c\x7b\x58\x6e\x8c\x53\x17\xb5\x33\x91\x6d\x51\xd4\x1d\x54\x38\x25\xde\xb4\xed\xfc\x53\xa9\xaf\x11\xc1\x41\xbe\x3d\x5f\xc7\x43\x4d\xf3\x68\x01\xee\x63\x3a\xc5\xd2\x86\x81\xf4\x8a\x63\x81\x03\xb1\x5f\xbb\x28\x42\x58\xef\xf3\x2a\x20\xa8\x41\xb6\x57\xf4\x01\xde\xce\x52\x2e\x8e\x72\x25\xad\x1c\xcb\x43\x7c\x39\x57\xe5\xcd\xe5\x21\xb1\xe0\xa6\x4f\x22\xc1\x05\xa9\x7b\xb0\xeb\x79\xc8\xd3\xbc\x3f\xdd\x85\xc6\x27\x8d\x38\x96\x07\x8e\x50\x4f\x41\x84\x7a\xea\xca\x95\x45\x80\x9a\x28\x28\xaa\x1d\x52\xb6\x48\x79\x07\x35\xd9\x99\xc1\xf1\x0e\x2b\x89\xe0\xa6\xcd\x43\xf5\x20\x42\xc0\x7e\xfd\xb9\xb0\xcb\x59\xb9\xea\xf9\x18\x48\x28\xd8\x63\xaa\x1b\x29\x79\x49\xa3\x5a\xb8\x27\x47\xcd\x7f\x11\xc6\xde\x7d\xf1\x51\xc1\xb9\x48\x1d\xc0\x4b\xd9\x37\x13\xea\x42\x62\x49\x18\xe7\x89\xcb\xfd\xdb\xef\x7e\x54\x43\x87\x65\x66\x6c\x9e\xf7\x7f\x26\xeb\x1c\x5a\xc0\x8a\xb3\x4f\xe7\xea\x86\xe0\x73\xd8\xf1\x09\xf8\xf2\xb6\xad\x19\xf5\x50\xc8\x2d\xf6\xc0\x94\xdd\x59\xef\x5c\xe8\x2e\x43\xf0\x99\xd3\x42\x3d\x6f\x62\x1d\xae\xe6\x22\x01\xaa\x81\xd4\xdf\xad\xa8\x95\x43\x72\xd4\xfa\x10\xbc\xd2\xaa\xcb\xd8\xbe\xcc\xbc\xa9\x2e\xc7\xcc\xb0\x69\xc7\xbb\xf2\x1e\xce\xb9\x6c\x75\xc9\xd7\x4f\xe7\x2b\xfe\x1d\x4c\x69\xd7\xc0\x15\x35\x10\x9e\xc7\x8f\x1d\x06\x94\x17\x7c\x00\x49\x5f\xe8\x44\xdb\x68\x3b\xd3\xb7\xd4\x6f\x0c\x12\x4a\xf9\x29\x66\x5e\xce\x76\x78\x6c\x92\x46\xf5\x94\x73\x37\x7c\xbc\xae\xcc\xa1\xd6\x0c\xaa\xfd\xfe\xed\x1b\x9e\x55\xdf\xbe\x7d\x0c\xbc\x7c\xfd\x1e\x60\x79\xb4\x15\xf2\x13\x08\xaa\x91\x17\x45\xfa\x08\x8d\x79\x6f\x61\x68\x32\xaa\x20\x4c\x91\x35\xbf\x9b\xb1\x72\xdd\xfd\xc8\xfe\xc1\xf2\x90\x0d\x81\x76\xa4\xd6\x9e\xd7\x4e\x06\xc5\x30\xb1\x0e\xbf\x88\x5f\xf1\x0e\x22\x7e\x35\xec\xbd\x1e\xa1\x75\x9d\xc2\x6a\x18\xce\x70\x5d\x03\x43\x4e\x04\xd4\x7f\x71\x3b\x91\x05\xd1\x1e\x26\xd4\x1c\xa7\x94\x21\x8d\x80\x07\x5d\x6d\x5d\x2a\xc2\x93\x76\x63\x45\x21\x3b\x59\x39\x21\xec\x32\x22\xc4\x9a\x8a\x8b\x7a\xea\x0a\x1f\x60\xb1\x36\x5f\x46\xea\x04\xfa\x31\x67\xa7\x31\x4a\x09\x20\x20\x86\x72\xea\x56\x15\x8a\x29\x7e\xf7\xc5\x50\x3c\xa6\x58\x25\xbb\xd8\x77\xfd\xcd\x4c\x14\x56\x45\x45\x43\x0a\xc5\xd2\x94\xe2\x04\xdf\x10\xb7\x77\x81\x10\x98\x07\xc3\xef\x73\x01\xf4\xd4\xea\xe8\x87\xb5\x90\x50\xbe\x9b\xce\x69\xf1\x11\xed\x99\x73\xd4\xad\xfb\x3d\x0c\xed\xb3\x1c\xd2\x3c\x1e\x49\x4e\x36\x75\x12\xca\x66\x42\x73\x1c\x39\x21\xc2\x71\xce\xd0\x1f\x8e\xcc\x8e\xb7\xfa\xe4\x41\x1f\xc6\xf8\x84\x0b\xe8\x89\x93\x46\x69\x16\xb8\x21\xfe\x68\x60\x27\xb0\xe3\xe2\x6f\x2c\xcf\x6a\x61\x4f\x24\x10\x24\xf8\x1b\x86\x87\x68\x2d\xe4\xef\x6f\x2b\x34\x08\xea\x41\x27\x77\xa3\x31\x7f\x70\xfb\xc5\xe1\xc3\x77\x54\x06\x29\xab\x72\x43\x40\x0c\x04\x9c\xe9\x41\x38\x58\x72\xa6\x84\x72\xe8\x09\x78\x47\x33\xd5\xc2\xcf\xa2\xd4\x9b\xe9\xbc\xc7\xe9\x28\x0b\x24\x63\xcc\x93\xdd\x82\xaf\xe7\x59\xa0\xca\x87\xec\x64\x24\x9c\x8c\x50\xac\x27\xb2\x2b\x15\xde\xf9\xd8\xbb\xda\xc1\xed\x37\x81\x87\x9a\x21\xd9\x16\x95\x84\x00\x5f\x01\x39\x1a\x48\x14\x7e\x1b\xec\x86\x5a\xdd\xbb\x01\xa0\xf7\x3b\x1a\x30\xfa\x3e\x74\x6e\xa0\xbf\x7e\x07\x78\xdd\x51\xa3\xa3\x6c\x61\x1c\x56\x24\x90\x2b\x11\x82\xce\x5c\xbb\xc1\x91\x89\xb5\xf9\xa4\xbf\xf1\x20\x83\x09\x17\x47\x42\x41\xc4\x45\x56\xc1\x2b\xd7\x51\x36\x86\x33\xb6\x2a\x2e\x96\x28\x40\x98\x06\x53\x44\xa5\xa0\x2b\xd9\xc3\x6e\x5d\x22\xab\xc8\xc7\x45\x22\x15\x75\xfe\x8d\x98\xdb\x83\x17\xbd\x07\x6d\xb4\x5d\x17\x54\x56\x72\xf6\x72\x99\xc6\x74\xd4\x9b\x1f\xd6\xe1\x31\xb6\xe2\xb2\x97\x18\x37\xa3\x20\xda\x8a\xb5\xda\x36\x2d\x7b\xce\x20\x75\xd9\x9d\x9a\xd6\x35\xaf\xf0\x2e\x72\xe3\x4c\x83\xda\xb5\x1e\x49\x3b\x15\x2a\x3b\x1d\x49\xab\x51\x4e\x43\x1f\xf0\x2d\x08\x08\x89\x4c\xe4\x04\xb4\xb7\xe0\x33\x01\xf8\x03\x65\x60\xa5\x94\x7c\x88\x7d\xb5\x07\x2d\xe2\x91\x64\x2b\xc2\xd4\xf8\x88\x1f\x3b\xa3\xdd\x7f\xc6\xce\xb7\xda\x97\x5f\x8c\x17\x31\xfc\x90\x4c\x93\xf7\x21\x6c\xcb\x9f\x8d\x66\x58\x01\xd6\xc8\xa5\x50\xd0\xe9\x65\x91\x49\xa3\xff\xa4\x10\x4f\xd7\xb7\xc8\xf1\x36\x8b\x8f\xc2\x9d\x02\x45\x19\x6f\x05\x8a\x34\x2f\x71\xb4\x7a\xe7\x03\x3c\xd6\xf6\x04\x22\x59\x84\x4c\x0b\x0e\xce\x43\xa1\x39\x1c\xd4\xf6\x0a\xab\xcc\x20\x5c\x85\x3d\xce\x18\x05\x15\xc3\x08\xdc\x47\x10\xf0\x51\x2d\xb1\x30\xc4\x94\xe0\x56\xde\xb1\x95\x6c\xed\x0b\x8a\x38\x74\x1e\x75\x09\xb0\x74\xd1\xa1\xa5\x1b\x56\x7a\x12\x69\x7c\x4b\xe4\x73\x80\x7d\x61\x0d\xf1\x95\xbf\x82\x65\x91\xc6\x51\xce\x30\x91\x68\xbf\x0e\xd0\xcc\xd3\xf8\xd2\x8e\xc9\x84\xb0\x17\xf4\x8d\x68\xeb\x76\x4b\xd0\x4f\xeb\xa4\x16\xb3\x6e\x45\xaf\x43\x3e\x62\xd1\x1a\xbd\xdb\x42\xd4\x23\x08\x5a\xa8\x39\x69\x37\xdb\xea\x6e\x5e\x05\x96\x0f\xf1\x08\xe3\x46\xf7\x09\x5f\x12\x02\xdb\x41\xf0\x8b\xf8\x85\x07\x93\x6e\xf0\x0b\x1f\x7c\x1f\x63\x30\x72\x6c\x33\xcf\xf5\xb1\xe1\x90\xd5\xeb\xb6\x93\x92\x8d\xb0\x61\xdd\x30\x24\xad\x84\x7a\xbd\xc4\x75\x0b\xf1\x1a\xeb\xcb\xad\x05\x7a\xa8\x7a\x50\x04\x1c\xcd\x08\xd8\x78\x7c\xfb\x8b\xeb\x92\x85\x33\x7d\x49\x11\x8d\x89\x30\x4c\x93\x13\x6d\x1a\xee\x02\x69\x97\x87\x68\xf7\x2b\x17\xf8\x76\xe3\x0b\xed\xc0\x7b\x04\x98\x7a\x1c\x3e\xdf\x26\x68\x71\x10\xd8\x82\xa0\xfc\x1b\x4c\x4a\xf4\xa7\x74\x7d\x0d\xcc\x4b\x4d\xf2\xd7\xd7\x5e\xf6\x6b\xd5\x22\x86\x0f\x36\x9d\x3d\x5f\xba\xc6\xbd\x56\x0d\x56\xe0\x41\x95\x16\x27\x8b\x50\xf4\x8b\x14\xf9\x4b\xd4\xc5\x34\xa9\x04\x17\x25\xc7\xe6\x0b\xa3\x95\x73\x81\x8d\x50\xa0\x95\xfd\x8a\x4d\x64\x1a\xe7\x3e\x7f\x7d\xdf\x18\x85\x0c\x9b\xda\xdc\xa6\x89\xe3\x95\x23\x47\x6f\xa0\x1e\x42\xfc\x4f\xb9\xa4\xe8\xbd\x5f\x6a\xb1\xa1\xbe\xd9\x57\x02\x59\x9f\x58\x2b\x62\x25\x34\xef\xb7\x07\xec\xe4\x57\x77\x13\x61\xdc\xac\x0d\x51\x05\x71\x26\x39\x63\x40\x8a\x96\x64\x10\x81\xec\xd5\xdc\xd7\x9d\x13\x45\x3d\xaf\x8e\x7a\x5d\x23\x3b\x7f\xe2\xa1\x1c\x60\x9c\x93\x1b\x5c\x8e\x37\xbc\x04\x09\x8f\xa3\x0f\xb3\xac\x0a\x55\xf2\x1c\xe7\x9b\xe9\xb1\x8a\x78\x89\xea\xe4\xb1\x6a\x6a\x74\x39\x73\x9e\xb1\x11\xa5\xcf\x8f\x64\x4c\x9a\x53\x94\x44\xc3\xc5\xb6\xf3\x6d\xcc\x19\xa2\x4b\x13\xde\x59\x14\x04\x71\x2a\xf9\x5b\x23\xf8\x8a\x17\xa9\x10\x62\x9c\x54\x05\xf5\x9b\xc1\x0e\xe0\x62\x20\x77\x38\xad\x70\x90\x3f\xd4\x95\x80\x36\x6d\xe0\x70\xb0\xe7\xab\xbf\xf5\xb8\xbf\xd6\x53\x17\xf9\x6c\xcf\xb1\x36\xf3\xff\xda\x3b\xd6\xe6\xb6\x6d\xe4\xf7\xfe\x0a\x56\x33\x37\x25\x6b\x3d\x9d\xe4\xae\x95\xeb\x78\x12\xdb\x6d\x73\xd7\xd7\xe4\x9c\x26\x1e\x45\xe3\xa1\x24\xc4\x62\x2b\x0b\x1a\x92\x4a\xea\xe4\xf2\xdf\x0f\xbb\x78\x10\x20\x00\x12\x92\x93\xb6\x1f\xd2\x99\x34\x0a\x09\x80\xc0\x62\xb1\xd8\xf7\x8a\xdc\x36\x4c\x70\xa9\xab\x98\x03\x96\x2f\xd3\xb2\x5f\x50\xa9\x3a\x76\xc2\x23\x01\xb2\xfd\x99\x23\xb2\xdc\x69\xad\xf9\xd2\x86\x9e\x50\x05\xbb\xa1\x82\x75\xc0\x71\xe3\x81\x88\xe6\xa2\x46\x30\x78\x17\xca\xb4\x0f\x51\xef\x98\x6b\xf3\x74\xd2\xab\x2c\x52\xec\x6a\xec\x3d\x80\x3b\x0f\xff\xc7\x5a\x0f\x8e\xe1\x67\x34\xe0\x6d\xd3\x19\xe4\x3b\x67\x9c\x28\xfe\x2b\x67\x70\x5a\xc4\x23\x48\x92\x5b\x26\x4d\x08\xac\x2e\xf6\x66\xa0\x0e\x45\xa5\xcd\xd9\xb6\x2c\x29\x77\x94\x07\x5b\x00\x81\xad\x39\xe3\x56\xed\x8a\x65\x80\x0a\xbf\xb5\xfd\xaa\x52\x79\x88\x4d\x3d\x85\x82\xba\xa7\xf0\x36\x26\xbc\x8e\x17\x54\xd6\xfb\x0f\xb9\x55\xae\x7e\x8c\x5e\xc0\x23\x6c\x08\x13\x1a\x47\x23\x2d\xf8\x6e\xae\xfa\xeb\x1d\xb0\x42\xaa\xd6\xe3\xb0\xad\xc7\x19\xdd\xce\x8c\x1e\xf7\xdc\x3d\x94\xbe\x1b\x8f\xf0\xa6\xea\x22\xd7\xc5\xa4\xee\x00\x1e\x89\x1d\x19\xff\x66\x38\x87\xb8\x93\x4a\xcb\xd0\x0b\x98\xd1\x41\x1e\x2d\x01\xf0\x7a\x1d\xaf\xd2\xca\xad\x70\x08\x1a\x78\xbb\xe9\x78\x34\x49\x0e\xb4\x79\xb2\x66\x47\xed\x75\xba\x02\xdd\x21\xd6\xfb\x24\xa5\x7c\x14\x76\x19\x13\x7d\xb4\xd8\xe2\xad\xd9\xb1\x69\xb8\x34\xdd\xfc\x6e\xc8\x2d\xea\x87\x8a\x5f\x9d\x74\x77\x88\xfb\xc7\x6e\x04\x3a\x32\xfc\x0a\xac\x8d\x5b\x10\xb0\x45\xc0\x29\x34\x90\x99\xea\x28\x87\xdf\x96\x85\xc1\x37\x68\xde\xd6\xb5\xd7\x8c\x41\x50\x24\xda\x75\x5b\xc6\xa4\x91\x02\x6a\x44\x63\xdf\xb9\xfd\xc0\x19\x37\x8d\xcc\x35\xb0\x17\x2a\xd6\xbc\x4d\x19\xde\x00\x8b\x96\xe5\x76\xef\x00\x47\xce\xf2\x0d\x35\x32\xa8\x8b\xf1\xde\x61\x41\xbe\x9f\x34\xbc\x1e\x4e\xa7\x42\x10\x6a\x6c\xc4\x44\xae\x86\xad\xd2\xa8\x75\xb8\x98\xe0\x85\x52\x3d\x6c\xa1\x02\x8c\x6f\x67\xc0\x2a\x54\x5d\x64\xf0\xf6\x39\x1b\x0b\xf2\xca\x27\x2d\x4c\xe2\xe6\xaf\x9c\x36\x18\xad\xcc\x69\x83\x08\xfe\x48\x68\x65\xfc\x33\xb7\xef\xea\xb0\xe9\xc7\x60\x1c\x3a\x83\x24\xf8\x80\x34\x40\x21\xe2\xda\xac\x81\xd7\x11\x13\xb2\x8d\x45\x0f\xa3\xfb\xc3\xa1\x34\x78\x31\x9a\x93\x71\x73\xf0\xb7\x39\xbd\xf9\x41\x36\x56\x75\x72\x08\xf8\x4b\x8e\x86\x89\x06\x86\xb9\x3e\xdd\xa1\x3a\x0c\x2e\xab\x54\x69\xbd\xfc\xa5\xaa\x1c\x31\x81\xfa\x14\xd7\xe4\x05\xba\x3d\xb0\x1f\x97\x2a\xea\xa6\xfa\x82\x19\xed\x6b\x40\xaf\x71\xde\xcd\x80\x14\xb7\x98\x62\xfd\x2a\x06\xcf\x3d\x59\x50\x8a\xf6\xc4\x2c\x5f\xb0\x05\xb7\xb6\x1f\x55\xed\x2f\xdb\xd9\x43\xb8\x90\x76\x41\x5c\x07\x5d\x39\x89\x26\xbe\x77\xa8\x67\xf5\xbe\x1c\x4d\xa5\xbe\xf7\xc8\x13\xd9\xb7\xfb\x6d\xd0\x48\x80\x8f\xe5\x89\x51\x5c\xa2\x73\x56\x2a\xf6\xcc\x43\x0c\x9b\x7a\xa3\xf1\x79\x18\x8d\x5b\x5a\x38\x24\x31\xd5\xc7\x3f\x75\x34\x63\x2b\x32\xcb\x47\xbd\xa0\x92\x4e\x79\x3e\xd8\xc4\xba\x9b\xa0\xb4\x84\x31\x0d\x9e\x56\x67\x08\xac\x3c\xd9\x63\x95\x3d\xc6\xea\x8f\x7d\x83\x7e\xc3\xa5\xa8\xb8\x05\xbc\x49\x37\x68\xfb\x66\x7a\x24\x68\x93\x6f\x61\xd8\x5d\xc8\x47\x4f\x9a\x90\xdd\xa1\x34\x44\xf9\x4f\xa8\x37\x9b\x6e\x49\x3e\x80\x46\x73\x6c\xad\x1b\x17\x55\x10\x06\xc7\xc7\x8d\xa0\xc7\x76\xa3\x96\x76\xa3\xa9\x22\xc4\x9a\x0c\xe3\xa7\x79\x6a\xb3\x02\xd8\x65\x6b\x67\x1d\xf1\x74\xa2\x28\x5f\x56\x6c\x62\x77\xa7\x2e\x63\xa8\xf6\x45\x40\xa0\xf1\x1e\xf4\xeb\x3a\xb5\x15\x76\x90\x1d\x68\x02\xa6\x0d\x27\x19\x3e\x31\x74\x0f\x36\xad\xab\x99\x5a\xe9\xf0\xb3\x4d\x08\x83\xea\x57\xd8\x7b\xc7\x9f\xd3\xf5\x6b\x92\x97\xbf\x8a\x64\xc2\xa7\x74\x75\x41\x55\x91\x3e\xac\xfa\x1d\xe6\x45\xac\xce\x0a\xd2\xf4\x8c\x2b\xd5\x11\x19\x1f\x1e\x43\xa8\x58\x06\x1a\x75\xa1\x4a\x98\x64\x92\x21\xcc\xeb\x29\xff\xc5\xf5\x97\x37\x69\x86\x38\x41\xdb\xe5\x56\xb2\xcd\x7c\x79\x90\x35\x4a\xa0\x98\xad\x31\x57\xbd\xdb\xc1\x27\x5d\x57\x28\x37\x37\x60\xa4\x29\xbf\xb4\x29\xb7\x41\x0c\xa5\x0d\xc2\xba\xe4\x64\x79\xb8\x5c\xd6\x30\xa2\x4e\xf7\x60\xe1\xc5\x4d\x65\x30\xbb\xdd\x8d\xfb\x24\x27\x11\x75\x55\x58\x10\xdd\x33\x46\x17\x73\x4d\x0f\x6d\x8d\x92\x61\x72\x38\x36\x4a\x56\xa7\x42\xbe\xd0\xaf\xca\x5c\x02\x38\xb1\x14\xbf\x3c\x33\x10\x62\xc7\x64\x25\x71\x23\xc6\x6c\x42\xab\x5e\x0f\xe2\x83\xc4\xcb\xa5\x7a\x99\xc2\xcb\x25\x43\x2a\x6d\xe1\x22\x8c\x34\x2b\x60\x07\x00\x4b\xfe\x4b\x20\x55\x4c\x49\xf3\xb8\x06\x0b\x58\x86\xfa\x20\x9a\x0d\x1d\x1f\xa5\xa0\xc1\x5c\x35\x82\x0c\xf2\xea\x99\x60\x0b\x99\x03\x87\x24\xcc\x41\xad\x0b\x9e\xd8\x6b\xeb\x02\xac\xf1\x67\xbb\xb1\xe5\x9d\xd0\xc1\x83\xbf\x33\xd0\xb1\x35\x9b\x3b\xce\x6a\xac\x79\xac\xa3\x67\xf5\x41\x04\xe5\xaf\x52\xee\x17\xed\x20\x82\xc9\xfb\x06\xb1\xa0\x92\x7c\x76\x94\x67\x04\x1b\x62\x09\x31\x4d\x92\xee\xa4\x94\x79\x03\xe0\xd8\x4e\xbb\x21\x72\xb8\xb4\xa5\xb4\xac\x41\xf2\x45\x1f\x71\x15\xc6\x35\xc3\xdf\xa5\x79\xc5\xb8\xfd\x0a\x8e\x92\xc5\x53\x1e\x6a\xb9\x60\x17\xe5\x49\x24\x96\x0b\x97\x8a\xf8\x75\xa0\xd6\x23\x60\xe0\x5d\x96\x03\xe3\x82\x24\x8d\x0e\xbb\xa3\x27\xd3\x77\xef\xbf\x78\xd9\xe9\xf4\x33\xa0\x58\x3f\xbf\x42\xd1\xaa\x96\x1f\xc9\x01\x43\x2e\x4a\xee\xad\xd5\xe1\xf7\x23\x09\xdc\x56\x1b\x4f\x8d\xd9\x09\xc7\xcc\xb4\x8f\x5c\xea\xf9\x4d\x56\x96\x2a\x05\x40\xa9\x39\x2b\xa6\x6b\x26\xf8\x00\x64\xb6\x3b\xfa\x40\xdf\xc9\xff\x35\xfb\xb0\x59\x75\xfc\xbe\x55\x8d\x89\x65\x76\x75\x0f\xb0\xf6\x4b\xb3\x09\x3a\x55\x47\xf8\xa6\x51\xf3\xe5\x53\x9e\xb5\x7b\x87\x11\xc3\xd5\xc9\x61\x5d\xdc\xc7\xe1\x49\x4f\x5e\x66\xcf\xfb\x04\x11\xb4\x8a\xf2\x30\xd6\x6c\xd9\x71\x95\x8a\x0d\x1f\xb7\x9d\x74\x6b\xbc\xb1\x6b\xb8\x8f\xe0\x32\xd6\x04\x47\x36\x8f\x8f\x02\xc5\x10\xc3\x22\x4f\x21\x71\xe0\x0b\xa9\x9a\xba\x01\xc4\x46\xff\xdc\x86\xa5\x94\x5b\x5a\x36\x61\xe2\x18\x10\x58\xb3\x03\x3f\xae\x3a\xd1\x58\x68\x2a\xfc\x08\x6e\xe1\x8a\x90\xaf\x94\xfc\xe5\xd2\x21\x4c\x2a\xf3\xdf\x9d\xa7\xc9\xe5\xbe\xa4\xeb\x9c\x86\x1b\xbf\xc7\xa6\x5e\x7f\x1f\x04\xd4\x69\x8f\x7f\x2b\x82\xcd\x5c\xb5\x95\x6a\x5e\x88\x85\xd7\xf3\x50\x52\x41\x00\xe9\x43\x2e\xf4\x4a\xbf\x4c\xe5\x5a\x08\x6e\x19\x28\xa8\xa0\xbc\xe2\x8b\x79\x0a\x31\xf8\xeb\x07\xc1\xa7\x7a\xb7\xb7\xba\x77\x1c\x11\xd7\xd6\x38\xfa\x08\xbc\x69\xea\xe1\xec\xf0\x0d\x0a\xc3\xb1\xc7\x6f\x9a\x89\xf5\x68\x8e\x6e\x20\x67\xf6\xac\x0d\x5d\x8c\xfd\x9a\xab\x61\x3e\x1f\x25\x8d\x31\x49\x66\x10\x01\x48\x48\x7f\xbf\x5b\xd9\xef\x99\x26\xf3\x16\xba\x4d\xb0\x8e\x7b\x5b\x96\xec\xa9\xe2\xe5\xa4\x16\xba\x50\xf5\x7b\xb8\x73\x1a\xdf\x29\x23\x48\x43\x86\x9b\x8a\x24\x96\x4f\xe9\x9b\xef\x65\x84\x9b\x54\x33\x80\xb6\xf7\x29\x99\x33\x1e\x90\x2c\x84\x38\x5b\x37\x4f\xe9\x4d\xa4\xd4\x6a\x8d\xa3\xa8\x2f\x78\xa2\xf3\xa9\x29\xff\x23\xa3\xd8\x90\x9e\xc8\xcc\xd5\x37\x27\x45\xf6\x96\x04\xf7\xad\xc1\xc7\x61\x50\x36\x27\xc3\xf0\x0d\xff\x6d\x0e\x55\x70\xc3\x03\xdd\x96\x61\x16\x63\x6a\x55\x50\xaa\x53\xbd\x61\x28\x87\x15\xe2\x76\xa5\xd4\xd7\x8e\x40\x42\x26\xc8\xb6\xfa\x65\x3b\xba\xa9\x3c\x34\x75\xdc\xb0\x45\x57\x52\x1d\x5a\x07\x22\x79\x3e\xe0\xd9\x1e\x11\xec\xc8\x4e\x5e\xdb\x00\x2a\x44\xd1\x44\x11\xc3\xb2\xbd\xf3\x68\x89\xdb\x77\x5c\x75\x6c\x40\x75\x05\x30\x83\xcf\xb0\xc7\x8b\xd1\x81\xb0\xd4\x4c\x4c\x8d\xe7\xc7\x1e\xb2\x11\x72\xcb\xb6\x75\x7e\xe9\x18\xd1\x03\x4a\x47\xac\xaf\xeb\x23\x4d\x13\x49\x2c\x5a\xb4\xe3\x3c\xbd\xe4\x87\x4f\x39\x2c\xb6\xd8\x3c\x8b\xed\x42\x8a\xf7\xa3\xf6\x1e\xf3\xe4\x67\x2a\x57\x77\xdc\x46\x33\xfd\xbd\x2d\xfd\xad\x64\xa1\x76\xc6\x39\x29\x0b\xa8\xa1\xe4\x48\xbb\x1c\x73\xdb\xa7\xf0\xc8\xcf\x45\xd5\xf4\xda\x5f\x86\x50\x0e\x37\xee\xa8\xf2\x12\x30\x31\x8d\xb0\xf8\x1b\x02\xdf\xe2\x67\xb0\x1c\xe6\x03\xaf\x46\x46\x73\x75\x6b\xf9\xe6\xc0\xbd\xc0\x44\x9a\xa1\x4d\x78\x34\x5c\xe4\x86\x45\x02\xb9\x26\xff\x52\x9e\x2f\x09\x69\x59\x09\xdc\x03\x43\x0e\x3a\xc6\xbf\xac\xca\xf4\xd2\x47\xfa\x3d\x95\x8c\x45\x37\x65\x00\xc4\x6f\xe2\x7d\xd9\x3f\xfb\xf9\xc7\xab\xb3\xf3\x1f\x2e\x1e\x5d\x49\x73\x66\xe4\x43\x9d\x71\xd8\x38\xbf\x3c\xfa\xee\xdc\x4c\x6f\x69\x8d\xe4\xa2\x56\xbe\x9b\x5d\x2b\x4d\x52\xad\x1f\x46\xe0\x80\x34\x9d\x0b\xc3\x08\x07\x63\xd0\xe9\x76\xbe\x94\xba\x8a\x36\x9d\x14\x9c\x54\xec\x70\x89\xf1\xb6\x25\xfc\x24\x50\x0e\x8e\x9b\xc6\x5b\xbe\xb2\xa3\x5d\x5c\xfb\x58\xcf\xf1\xb1\xa3\x9d\xa7\x07\x6e\x3a\x48\x0e\x42\x4e\xdd\xc1\xb1\x1b\xae\xcd\xbc\xb9\x24\x5f\x3b\x72\xe5\x56\xc2\xf2\x9a\xc5\x48\xf9\xc4\x9e\x68\xf1\x33\x83\x97\xf9\xc9\xcb\xf5\xe0\xba\x0b\x91\x31\x1d\xa0\x81\x44\xcf\xdb\xad\x86\xa3\xd6\x70\xf2\x0a\xdb\x54\xde\x15\x9d\x57\xd9\x1f\x04\x14\x19\x65\x2d\xa5\x42\xe7\x70\xc8\x6f\xce\xfa\xbd\x56\x7f\x21\xf2\x37\x80\xc6\x2c\x63\x00\x7b\x01\x0a\x88\x61\x75\xf1\x9a\x19\x22\x44\xa3\x4b\x77\xa3\xb7\xd2\x86\xd6\x19\x0d\x87\x43\x7c\xf1\x8a\xce\xb7\xe8\x1f\x1a\xc4\xa8\x3a\x16\x28\x94\x6d\xb5\xd5\x99\x4f\xd5\xda\xcc\xc7\x62\x65\xe6\x43\xbe\x10\xf3\x99\x9a\x77\xdd\xbb\xef\x7e\x72\xc7\x8c\xea\x5c\x95\x0c\x3a\x6a\x02\x31\x0c\xdf\xd2\xfc\xa2\x92\x94\x40\xc8\xe9\xcf\xe9\xe6\x56\x54\x52\x73\x05\x09\x99\xdb\x3f\x03\x32\xc3\xb8\x1d\x08\xb0\x7a\x02\xa4\x4e\xc4\x73\xb0\x5d\xd9\xcc\x68\x9a\x2f\xce\xd2\x32\x85\x58\x56\xf8\x3b\xee\x88\xd0\xc8\xbc\x16\x48\x81\x64\xd0\xd3\xa5\x64\x0d\x06\x0c\x4f\xb3\xb5\xa3\xa3\x97\x5e\x71\x95\x39\x3b\xc5\xc4\xb7\x14\x23\x43\x01\x03\x3b\xdd\x00\x00\xd3\xeb\x94\xcb\xe5\x76\xe2\xfd\xaa\x7b\xee\xd1\x3c\x60\x80\x3f\x24\x30\x32\xe1\x22\x02\xcf\x10\xf4\xbc\xe0\x63\x1e\xf3\xdc\x11\xb0\xb6\x14\x38\x3d\xdc\x20\x59\x92\x45\x44\x7c\xe0\xec\x65\x41\xb9\xfe\x1c\x3c\x9a\x56\x31\x71\xa6\xc0\x08\xdc\x07\xb4\xf9\xc6\xce\x1d\xba\x36\x76\x28\x71\xec\x08\xef\x4c\x7c\xfd\xb4\x6d\x4a\xb4\x2d\x00\x8b\x38\x0c\x09\xdc\xe4\x33\xc8\x80\xc2\xfd\xda\x30\x6d\x25\xb8\xc8\x41\x9b\x1c\xce\x0a\xba\xba\x05\xa2\x9d\xa0\x43\xd0\x57\x02\xae\x86\x18\x78\x92\xf0\x81\xc4\x87\x4f\x39\x57\x3e\xe5\x5c\xf9\x53\x73\xae\x04\xe7\x22\x71\xb1\x2e\xd9\x2e\x59\x48\xb2\xfe\x95\xcc\xd3\xc4\x75\x5a\xec\x01\xcf\xe5\x5d\x69\xba\x40\x83\x16\x92\xe2\x23\x24\xd0\x1d\xaf\xbc\xbb\x5a\x2a\xae\x70\x94\x8f\x1e\x94\xcf\x2f\xe2\x3b\x4f\x96\x0f\xf3\xc1\x22\x85\x6f\x94\x5a\x71\xff\x10\x13\x31\x86\xdc\x62\xe9\x6c\xb5\xa0\x42\x6c\x8d\x2b\xad\x72\xbd\xed\xb1\x6a\xdb\x54\xb1\xa6\xeb\xfc\x90\x83\xe1\x4b\x67\x05\x5d\x6d\x4b\x2d\x98\xc2\xd5\x83\xb3\x39\x9d\x61\x73\x2b\xc1\x21\x75\x7a\x5f\xb3\xff\xc8\x8d\xaf\x31\xdc\x36\xa0\x6a\xe1\xab\xe9\x3c\xf7\x0e\x4a\x4a\xc8\x07\x9d\xcd\xd8\xf4\xe2\x4e\x9a\x67\xa9\xcc\x8f\xcb\x70\x83\xd1\x1d\x52\x2d\xd3\x38\x31\x8e\x44\x71\xe6\xc8\x7b\x28\x3a\x89\xb1\x39\xb6\x92\xb3\xc1\xb9\x8f\xfe\xb8\x23\xc2\x58\x80\x60\x08\xff\x18\xc4\xf5\x6c\x7d\x7d\x8a\x0c\xf3\x53\xbc\x1b\x8f\x6a\xb1\x66\x5c\x1e\xe6\x3c\x2d\x7a\x46\xf2\x07\x4b\xa5\xe7\x88\xb5\xc3\x2b\x22\xd3\x54\x6b\xfd\xa8\x88\x77\xe2\x1f\x55\x18\xb0\x64\x97\x45\xb7\x6e\xad\x53\x54\xd3\x79\x72\x4e\x08\x4b\xac\x67\x6f\x09\xfb\x7b\x7d\xcd\x64\x8b\x5a\x81\x12\xe9\x7d\x00\xa9\xac\xdc\x0e\x08\xa7\x86\x2a\x9f\x7e\xe2\x05\x3e\xf1\x02\x7f\x43\x5e\xc0\x59\xe2\x63\x17\x46\x20\xef\x5f\xa5\x88\x37\x3c\x4b\x18\xe2\x10\xaf\xcb\x85\xd5\xf7\xf2\x52\x4a\x95\x43\x7c\xb4\xd2\xac\x53\xf9\x87\x62\x0e\x6e\xd2\x3f\xb8\x06\xf7\xce\x77\x2e\x2e\xa5\xee\x4e\x26\x8e\x7c\x61\x8e\x45\xbc\x8e\x99\x52\x0f\x54\xc1\x83\x08\xff\xd3\x21\xaf\x63\xa3\xbc\xf3\xa4\x95\x50\x38\xa4\xcb\x12\x36\x02\xf9\xab\x19\x4d\x94\x37\xda\xe9\x2d\x84\xa3\x70\x37\xd3\x3c\x71\x78\x56\x6a\xbd\xf4\x90\x17\x73\x2b\x3e\x3a\x0b\xb4\xfa\x30\xdb\xb1\xff\x46\xf0\x14\xc2\x0f\x8d\x71\x12\x73\x7b\xf4\x57\x47\x11\x56\x06\x62\x7f\x55\x35\x84\x04\xe4\xa7\x0d\x15\x53\xf4\x21\x8c\xb2\x8a\x1f\x0b\xae\x6c\x01\xe7\xe9\x7c\x79\xd7\x4c\x4f\xde\xfc\x56\x2d\x59\xde\x2c\xdf\x6f\xa2\xac\x23\xc2\xff\x1b\x7d\x8a\x85\xef\x77\x19\x63\x41\xc9\x38\x03\xaf\xd6\xd6\xcc\xb3\x77\x60\x6d\xd9\x47\xc2\xbd\x34\x9a\x4e\x14\x49\xa6\x0d\xa9\xd1\x02\x2b\xb3\x35\x8f\x6f\x5e\x99\xe6\x37\x20\x7d\x51\x88\x6f\x65\xc3\x17\x0c\x7c\x9f\xea\x65\xf9\x24\x96\x4a\x8b\x92\x22\x9a\x60\x17\x3b\x38\xb0\xc9\x84\xdd\xb0\xe2\xc5\x4c\x72\x92\x18\x9c\x93\xc8\x63\x37\xaa\x4c\x64\xe2\xdb\xfe\xf0\xb9\x4d\x4b\x65\xb7\xd0\xfd\xd3\xbf\xd6\xeb\xa1\xdf\xb7\x7f\x43\x37\xac\x1b\xd9\x2d\xd4\x61\x22\x02\x1d\x0e\x39\xa2\xa7\xf9\x35\xca\x32\x85\xca\x22\x84\x78\x9f\x4f\xc0\xeb\x1a\x53\x88\xaa\x16\x93\x6c\x1a\x98\xc5\x07\x3f\x88\xb9\xc6\x30\xc9\x98\xb9\x7b\x3d\xa8\x0e\x45\x2d\x1a\xe5\x82\x05\x4d\x82\x2e\x11\x70\x0e\x2f\xfd\x17\x89\xfc\xae\xcd\x1b\xd5\x17\x83\x61\x4d\x4d\xb1\x55\x6a\x61\xb5\x15\x61\x32\xb5\x87\x7c\xbd\xbd\x5e\x12\x36\x65\xf5\xa1\xb0\x45\x26\xd3\x96\xc4\x6e\x43\x0e\xec\x5c\xed\x64\x18\x90\xc1\x7d\x91\x03\x3a\x9f\xd0\x69\x53\x1d\xbe\x95\xb4\x8b\x6b\x85\x08\xcd\xe3\x15\x96\x66\xcd\x1e\xa7\x57\x1b\xa7\xa9\x86\x94\x76\x6e\x0f\x30\x09\x6c\xed\x06\x33\x47\x72\x1d\xeb\xc2\x41\xc8\xf5\x02\xdd\x72\x7e\xc7\x6a\x82\x61\x49\x40\x61\xf8\x00\x43\x5e\xfd\x4e\xaf\xd5\xf2\x13\x48\xd1\x75\x2e\xb7\x4e\x0a\x7b\xd5\x23\x63\x89\xc4\x9f\x73\x08\x12\x1b\x08\xf1\xb6\x68\xd3\x18\x57\x35\x00\x30\x07\x8c\x3b\xd1\x3f\xe7\x53\xc0\xb3\x0e\x9c\x14\xab\x5a\x01\xf2\x18\x95\xcb\x9c\xbe\x41\x4e\xf2\x9c\x17\x79\xe7\x41\x02\x92\xae\x44\x74\xcb\xfe\xbc\x8a\x72\x10\x52\x3b\x9e\x4c\x5f\x80\xa4\x39\x96\x1a\xb0\x87\x3b\x4d\xd7\x6b\x5a\x46\xb8\x30\x59\xc5\xa2\x00\xe1\x6c\x95\x15\x65\x34\x23\xb7\x74\xbd\x88\x30\x46\x20\x1a\xfa\xc6\xcf\x7d\x4e\x4c\xe6\xa9\xd7\x8e\xfa\x50\x3f\xea\x05\xc4\x68\xc1\x39\x82\x69\x8a\xfd\xb8\x96\xcf\x9a\x2a\x07\xa0\xc2\x12\xc8\x17\xae\xaf\x67\x32\x74\xde\x42\x02\x19\x9f\x2d\x2f\xf6\x57\x43\xd9\xec\xc8\xc4\x90\xfa\x11\x55\x55\x30\xe4\x15\x63\x23\x9a\xaa\x87\x5e\xbb\x0c\x7d\xa7\xc6\x45\x85\x0c\x1a\xdf\x02\x9e\xb0\xd3\x55\xa3\x5b\x41\xdc\x92\x7d\xd5\x1f\xb0\xa3\x11\xfd\xa3\x06\x93\x1d\xb5\x22\x59\x3e\xdf\xae\xd2\x1c\xfc\xfb\xf6\x50\x8b\xfc\x55\x21\x19\x42\x78\xaf\x32\x66\xd5\xfe\xbd\xe1\x05\x81\xab\x52\x9d\xd9\xfa\x59\x41\x30\x59\x78\xa0\x1f\xa1\xa8\xa5\xd3\xaa\x6d\xf3\xe5\x9b\x3a\x36\xb2\x78\xc0\x7c\x2a\xb7\x27\xbd\xea\xc4\x4f\xe4\x4d\xe5\x71\xc4\xdb\x31\xee\xab\x4a\xe7\x84\x13\x9f\xa0\xf2\xae\xd2\x62\x92\xfe\xcf\x8f\xff\x7d\x7e\x7a\x71\xf5\xe4\xec\xea\xd1\xc5\xc5\xd3\x27\x8f\x9f\x5d\x9c\xf3\xab\xb7\x96\xd3\xcb\x74\x8d\xc4\x7a\x83\xcd\xc9\xa3\x91\x42\xee\xfe\x69\x17\x29\xa3\xdb\xd5\x22\x02\x6a\x26\x3f\x9c\xae\x25\x49\xc3\xc7\xb7\x8c\x85\x17\x60\x5e\xd4\x69\xd9\x82\x35\x2c\xc9\x1e\x30\xa8\xb2\x44\x10\x55\x22\x29\xae\x4a\xc7\x72\x00\x63\x82\xda\xa4\xa5\xb6\x06\xdb\x99\xf6\xdd\xf7\xd7\xc7\xa8\x10\x53\x28\x1b\x20\x94\x18\x8f\x8b\xc8\x5d\xe1\x46\x1c\x53\x5d\xed\x5c\x24\x28\x6e\x4a\x19\x20\x3b\xc2\x32\x6d\xa5\x7f\x2d\x1a\x1c\x5a\xae\x72\x5e\xac\xed\x27\x9e\x7c\xb0\x83\x85\xe8\x8d\x2a\xf5\x9d\x06\x67\x95\xcc\x35\x51\xe8\xb4\x48\xcb\xb4\xc7\xd6\xdd\xcb\xc0\x1f\x24\x33\x60\x20\xfc\x9d\x9d\x15\xbb\xff\x1c\x4f\x74\xee\xeb\x80\xee\x9a\x45\xa3\xe8\xa1\x28\x84\x8c\xcf\x2b\xb5\xf8\xbc\xf7\x7f\x36\xd5\x64\xf4\x7c\x24\x02\x9a\xd9\xcf\xfb\x89\xaa\xed\x70\x4f\xd5\x76\x18\x1d\x8a\x8c\xbf\x0a\x3d\xd9\xe9\x11\x50\x7e\x7c\xfb\x44\x2b\x20\x29\x8f\x1e\x56\xf5\x01\xe5\x29\xe8\xfb\xd3\xba\x12\x5b\xcb\x00\x02\x7b\xd3\x59\x42\x77\x88\x4f\x8e\xae\x69\x59\xde\x62\xb8\x10\x23\x6d\xa8\x41\xed\x7f\x0f\xff\x8a\x53\xa0\x6b\xf0\x80\xf6\x5f\x88\x07\xa6\x47\x05\x70\xb8\x31\x1b\xa9\xdc\x14\x63\x1c\x4a\xb8\x25\xac\xe8\x1c\x3d\x30\x38\x1e\xcf\x19\x32\x9c\x44\x9d\x37\x45\x31\x1e\x0c\x30\x63\xef\x1b\xfc\x05\xe9\xf6\xea\x1d\x96\x50\xe8\xd7\x7e\xbc\x49\xcb\xe5\x1a\xf0\xfa\x00\x3a\x77\x1c\x2d\x78\x86\x56\x33\xce\x7c\x1e\x1d\xfb\xda\x61\x99\x09\x58\xda\xba\x7f\x4a\xd9\x19\xc1\x9d\xff\x36\x05\xb5\xf5\x2d\xe4\x6b\x2d\xd4\xd4\x8b\x5a\xf0\xfa\x42\xd5\xa9\x7f\x4e\x66\x17\x17\x97\xf1\x8a\x0d\xd6\x8d\xe6\x5d\x01\xc7\x74\x5b\x2e\xaf\x4a\xfa\x3b\x59\x27\x7d\x86\x18\x86\x17\x0a\x28\xc7\x29\x18\xc8\xe8\x75\xec\x9e\x98\xa7\xb1\xfe\x5c\x74\xb4\x1d\xfb\xb7\xeb\x15\x4d\xe1\x90\xfa\x49\xde\x02\xee\x25\xc8\x56\x4c\x0b\xdd\x7c\xf5\x3e\xc4\xbf\x42\x77\x48\x73\x9c\xac\x3c\xa6\x2a\x55\x98\xc3\xe7\xac\x8e\x8e\x98\x63\x72\x42\x34\x11\x4f\xb2\xf6\x18\xd8\xed\x65\xac\xd9\x89\x5b\x44\x37\x78\xc2\xa2\x2f\x00\x11\x10\x27\xbe\xe8\xeb\xf7\x8f\x24\xc5\xfa\x5c\xa4\xd0\x57\xcd\xa2\xd3\x1f\xa4\x65\x99\xce\x97\xe2\xaf\xce\x38\x7a\xd0\xf5\xbe\xed\xff\x56\xf8\x1a\x6c\xd2\xf9\xef\xe9\x35\x61\x2d\xe8\x9a\xb5\xb9\x37\x34\x1a\xbd\xca\x4a\xf8\xc3\x5e\xfc\xd3\xf5\x9c\x8f\x6b\xbf\xaa\x0f\x3a\x32\x5b\xb0\x63\x5e\xb0\xeb\x8a\xac\xb5\x9f\xac\xd9\xbf\xda\x5b\xf5\xe7\x05\x7c\xf1\xde\x61\x40\x53\x9c\x9b\x77\xcc\xfa\x14\xef\x19\x0d\x05\x75\x5a\x50\xab\xdd\x7d\x4f\x3b\xf5\x8b\x35\xfa\xaa\xad\x0d\x9f\xdb\x57\x9f\x69\x76\xac\xac\xff\x3b\xb9\xf5\x67\x1a\x14\x58\x21\x68\x37\x34\x8d\x0b\xe5\xe4\x94\x31\xe6\x8a\x9d\x36\xf4\x48\xa5\x70\x71\x92\x3f\xc0\x71\xb3\xe0\xfe\x74\x59\x3f\x83\x63\x7f\xf8\xb5\x7d\x40\xe4\x17\xf4\x1e\xef\x80\x54\x31\x32\x87\x05\x47\xfb\x02\xbb\xba\xd1\x0d\xbb\xa4\xd8\xd3\x0a\x9f\xba\xd1\x26\xcf\x5e\x33\x96\x03\xaf\x88\x7d\xc6\x06\xc4\x52\x03\x0b\x6c\x0a\x1d\xd5\x3a\x61\xfc\xde\x8a\x36\x69\x0e\x62\x54\x9a\xad\xc8\x62\x1c\x0d\x96\xf4\x86\x0c\x6e\xb7\x8b\x34\x1b\x00\x81\xca\x5e\x93\x01\x23\x8d\x8b\xed\xbc\x2c\x06\x87\xc3\xd1\x83\x01\x92\xbd\x41\x91\xcf\x07\xd7\x59\xb9\xdc\xce\xd8\x7d\x7c\x33\x98\x6d\x37\xe5\xf3\xcb\xd3\x25\x43\x13\xfe\xfe\xb7\x62\xb0\xa6\x0b\x72\xc5\x8f\x6e\x31\xc0\xf9\x0f\x56\xd9\x6c\xc0\x08\x19\xa3\x74\x7e\x64\x8d\x9e\xad\xd9\xf2\xd9\x9e\x91\x45\x84\x74\x35\x8a\x47\xe3\x61\xf2\x72\x7d\x49\xb7\x6c\xe9\xb7\x6c\x09\xec\x0d\x63\x4d\xd3\x0d\x9b\x18\x5b\x3b\x54\x44\x07\x4a\x48\x18\x95\xa1\x11\x77\xd6\xe3\x76\xdf\x57\x19\xfc\x02\xb6\xea\xe5\xfa\x7f\x51\x5f\xc0\x50\x7d\x2d\x7a\x07\x8f\xe1\x3f\xe9\x19\x31\x8e\xd0\x11\xf6\x48\x3e\x2f\xe9\x66\xcc\x84\xca\x4e\xb2\xd7\x66\x55\x27\xb5\xda\x33\xe3\xb0\xdd\x1d\x21\xaa\x33\xa4\x3e\x61\x1c\x99\xfa\x17\xa6\xc9\xd1\xff\x01\xe2\x9f\x93\xe3\x74\xd3\x06\x00")
 
 func staticJsGottyBundleJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -209,7 +209,7 @@ func staticJsGottyBundleJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/js/gotty-bundle.js", size: 332382, mode: os.FileMode(436), modTime: time.Unix(1503734056, 0)}
+	info := bindataFileInfo{name: "static/js/gotty-bundle.js", size: 447348, mode: os.FileMode(436), modTime: time.Unix(1791967847, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
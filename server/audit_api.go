@@ -0,0 +1,93 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// auditAPIPath is the path of the search of the audit index.
+const auditAPIPath = "/api/audit"
+
+// maxAuditSearchLimit is the most events a search of the audit index returns.
+const maxAuditSearchLimit = 1000
+
+// SearchAudit returns the events of the audit index matching query,
+// the latest first, or nil when the audit index is disabled.
+func (server *Server) SearchAudit(query webtty.AuditQuery) []webtty.AuditEvent {
+	if server.auditIndex == nil {
+		return nil
+	}
+	return server.auditIndex.Search(query)
+}
+
+// handleAuditAPI searches the audit index with GET /api/audit, filtering
+// the events with the parameters user, cluster, session, command,
+// tag.<name>, since and until, in RFC 3339, and limit.
+func (server *Server) handleAuditAPI(w http.ResponseWriter, r *http.Request) {
+	identity, _ := webtty.IdentityFromContext(r.Context())
+	if !server.isSessionAdmin(identity) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query, err := parseAuditQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	events := server.SearchAudit(query)
+	if events == nil {
+		events = []webtty.AuditEvent{}
+	}
+	writeJSON(w, http.StatusOK, events)
+}
+
+func parseAuditQuery(r *http.Request) (webtty.AuditQuery, error) {
+	params := r.URL.Query()
+	query := webtty.AuditQuery{
+		User:      params.Get("user"),
+		ClusterID: params.Get("cluster"),
+		SessionID: params.Get("session"),
+		Command:   params.Get("command"),
+	}
+	for key := range params {
+		if !strings.HasPrefix(key, "tag.") {
+			continue
+		}
+		if query.Tags == nil {
+			query.Tags = make(map[string]string)
+		}
+		query.Tags[strings.TrimPrefix(key, "tag.")] = params.Get(key)
+	}
+
+	var err error
+	if since := params.Get("since"); since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return query, errors.New("Invalid since")
+		}
+	}
+	if until := params.Get("until"); until != "" {
+		if query.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return query, errors.New("Invalid until")
+		}
+	}
+	if limit := params.Get("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit < 1 {
+			return query, errors.New("Invalid limit")
+		}
+	}
+	if query.Limit > maxAuditSearchLimit {
+		query.Limit = maxAuditSearchLimit
+	}
+	return query, nil
+}
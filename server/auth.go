@@ -0,0 +1,196 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Authenticator resolves the identity of the user of a request.
+// It returns ErrNoCredentials when the request carries none of the
+// credentials it checks, for another authenticator to be tried.
+type Authenticator interface {
+	Authenticate(r *http.Request) (webtty.Identity, error)
+}
+
+// AuthenticatorFunc is a function used as an Authenticator.
+type AuthenticatorFunc func(r *http.Request) (webtty.Identity, error)
+
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (webtty.Identity, error) {
+	return f(r)
+}
+
+var (
+	// ErrNoCredentials is returned by authenticators
+	// when the request has no credentials for them.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned by authenticators
+	// when the credentials of the request are rejected.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Authentication methods recorded in the identities.
+const (
+	AuthMethodBasic      = "basic"
+	AuthMethodClientCert = "mtls"
+	AuthMethodJWT        = "jwt"
+	AuthMethodOIDC       = "oidc"
+)
+
+// Authenticators tries its authenticators in order until one finds
+// credentials in the request, and returns its result.
+type Authenticators []Authenticator
+
+func (as Authenticators) Authenticate(r *http.Request) (webtty.Identity, error) {
+	for _, authenticator := range as {
+		identity, err := authenticator.Authenticate(r)
+		if err != ErrNoCredentials {
+			return identity, err
+		}
+	}
+	return webtty.Identity{}, ErrNoCredentials
+}
+
+// BasicAuthenticator checks the credentials of HTTP basic authentication,
+// given as user:password.
+type BasicAuthenticator struct {
+	Credential string
+}
+
+func (ba *BasicAuthenticator) Authenticate(r *http.Request) (webtty.Identity, error) {
+	token := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(token) != 2 || strings.ToLower(token[0]) != "basic" {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(token[1])
+	if err != nil || subtle.ConstantTimeCompare(payload, []byte(ba.Credential)) != 1 {
+		return webtty.Identity{}, ErrInvalidCredentials
+	}
+	user := strings.SplitN(string(payload), ":", 2)[0]
+	return webtty.Identity{User: user, AuthMethod: AuthMethodBasic}, nil
+}
+
+// ClientCertAuthenticator takes the user from the common name of the
+// verified TLS client certificate, and the groups from its organizational
+// units. The certificate is verified by the TLS server, see
+// Options.EnableTLSClientAuth.
+type ClientCertAuthenticator struct{}
+
+func (ClientCertAuthenticator) Authenticate(r *http.Request) (webtty.Identity, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+	subject := r.TLS.VerifiedChains[0][0].Subject
+	if subject.CommonName == "" {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "client certificate has no common name")
+	}
+	return webtty.Identity{
+		User:       subject.CommonName,
+		Groups:     append([]string(nil), subject.OrganizationalUnit...),
+		AuthMethod: AuthMethodClientCert,
+	}, nil
+}
+
+// bearerTokenParameter and bearerTokenCookie carry the bearer tokens of
+// browsers, which can't set the Authorization header of WebSocket
+// connections. The token given in the URL of a page is kept in the cookie
+// for the requests of the page.
+const (
+	bearerTokenParameter = "access_token"
+	bearerTokenCookie    = "gotty_access_token"
+)
+
+// bearerToken returns the bearer token of r, from the Authorization
+// header, the URL or the cookie, in this order.
+func bearerToken(r *http.Request) string {
+	token := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(token) == 2 && strings.ToLower(token[0]) == "bearer" {
+		return strings.TrimSpace(token[1])
+	}
+	if token := r.URL.Query().Get(bearerTokenParameter); token != "" {
+		return token
+	}
+	if cookie, err := r.Cookie(bearerTokenCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// unlockScreen checks the credential given to unlock the screen of
+// a session, see Options.ScreenLockTimeout, with the authenticator of the
+// server. A credential as user:password is checked as of HTTP basic
+// authentication, any other as a bearer token. It must authenticate
+// the user of the session.
+func (server *Server) unlockScreen(identity webtty.Identity, credential string) error {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(credential, ":") {
+		r.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credential)))
+	} else {
+		r.Header.Set("Authorization", "Bearer "+credential)
+	}
+
+	unlocker, err := server.authenticator.Authenticate(r)
+	if err != nil {
+		return err
+	}
+	if unlocker.User != identity.User {
+		return errors.Wrapf(ErrInvalidCredentials, "credentials of %s", unlocker.User)
+	}
+	return nil
+}
+
+// newAuthenticator returns the authenticator configured by options,
+// nil when none is, and the challenges of the rejected requests.
+// Any of the configured authenticators accepts a request.
+func newAuthenticator(options *Options) (Authenticator, []string, error) {
+	var authenticators Authenticators
+	var challenges []string
+	if options.Authenticator != nil {
+		authenticators = append(authenticators, options.Authenticator)
+	}
+	// tokens of other issuers are left to the next authenticator
+	if options.OIDCIssuer != "" {
+		authenticator := NewOIDCAuthenticator(options.OIDCIssuer, options.TokenAudience)
+		authenticator.UserClaim = options.TokenUserClaim
+		authenticator.GroupsClaim = options.TokenGroupsClaim
+		authenticators = append(authenticators, authenticator)
+	}
+	if options.JWTKeyFile != "" {
+		authenticator, err := NewJWTAuthenticatorFromFile(options.JWTKeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		authenticator.Issuer = options.JWTIssuer
+		authenticator.AllowNoExpiry = options.JWTAllowNoExpiry
+		authenticator.Audience = options.TokenAudience
+		authenticator.UserClaim = options.TokenUserClaim
+		authenticator.GroupsClaim = options.TokenGroupsClaim
+		authenticators = append(authenticators, authenticator)
+	}
+	if options.JWTKeyFile != "" || options.OIDCIssuer != "" {
+		challenges = append(challenges, `Bearer realm="GoTTY"`)
+	}
+	if options.EnableBasicAuth {
+		authenticators = append(authenticators, &BasicAuthenticator{Credential: options.Credential})
+		challenges = append(challenges, `Basic realm="GoTTY"`)
+	}
+	if options.EnableTLSClientAuth && len(authenticators) == 0 {
+		// the TLS server requires the certificates,
+		// the other authenticators are required on top of them
+		authenticators = append(authenticators, ClientCertAuthenticator{})
+	}
+
+	if len(authenticators) == 0 {
+		return nil, nil, nil
+	}
+	return authenticators, challenges, nil
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256" // hashes of the signatures
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// jwtLeeway tolerates the clock skew with the issuers of the tokens.
+const jwtLeeway = time.Minute
+
+// JWTAuthenticator validates JSON Web Tokens given as bearer tokens,
+// signed with a static key.
+type JWTAuthenticator struct {
+	// Key verifying the signatures, a []byte secret for the HS algorithms,
+	// an *rsa.PublicKey for RS and PS, an *ecdsa.PublicKey for ES
+	Key interface{}
+	// Issuer of the accepted tokens, the tokens of other issuers are left
+	// to the next authenticator, any issuer when empty
+	Issuer string
+	// Audience the tokens must have, any audience when empty
+	Audience string
+	// Claim naming the user, "sub" by default
+	UserClaim string
+	// Claim listing the groups of the user, "groups" by default
+	GroupsClaim string
+	// Accept the tokens without an exp claim, which never expire
+	AllowNoExpiry bool
+}
+
+// NewJWTAuthenticatorFromFile returns an authenticator verifying the
+// tokens with the key of the file at path, a public key or a certificate
+// in PEM, any other content being taken as an HMAC secret.
+func NewJWTAuthenticatorFromFile(path string) (*JWTAuthenticator, error) {
+	data, err := ioutil.ReadFile(homedir.Expand(path))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read JWT key file `%s`", path)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		secret := bytes.TrimSpace(data)
+		if len(secret) == 0 {
+			return nil, errors.Errorf("JWT key file `%s` is empty", path)
+		}
+		return &JWTAuthenticator{Key: secret}, nil
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		err = errors.Errorf("unsupported PEM block `%s`", block.Type)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse JWT key file `%s`", path)
+	}
+	return &JWTAuthenticator{Key: key}, nil
+}
+
+func (ja *JWTAuthenticator) Authenticate(r *http.Request) (webtty.Identity, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+	if ja.Issuer != "" && jwtIssuer(token) != ja.Issuer {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+	claims, err := verifyJWT(token, func(header jwtHeader) (interface{}, error) {
+		return ja.Key, nil
+	})
+	if err != nil {
+		return webtty.Identity{}, err
+	}
+	return claimsIdentity(claims, tokenPolicy{
+		issuer:        ja.Issuer,
+		audience:      ja.Audience,
+		requireExpiry: !ja.AllowNoExpiry,
+		userClaim:     ja.UserClaim,
+		groupsClaim:   ja.GroupsClaim,
+		method:        AuthMethodJWT,
+	})
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verifyJWT checks the signature of token with the key keyFor returns for
+// its header, and returns its claims.
+func verifyJWT(token string, keyFor func(header jwtHeader) (interface{}, error)) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.Wrapf(ErrInvalidCredentials, "malformed token")
+	}
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidCredentials, "malformed token signature")
+	}
+
+	key, err := keyFor(header)
+	if err != nil {
+		return nil, err
+	}
+	err = verifyJWTSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// jwtIssuer returns the issuer claimed by token, before its verification,
+// to tell which authenticator it is for.
+func jwtIssuer(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	decodeJWTPart(parts[1], &claims)
+	return claims.Issuer
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return errors.Wrapf(ErrInvalidCredentials, "malformed token")
+	}
+	return nil
+}
+
+// verifyJWTSignature checks signature of signed with key for alg.
+// The type of key must match the algorithm, so that a public key is never
+// taken as an HMAC secret.
+func verifyJWTSignature(alg string, key interface{}, signed []byte, signature []byte) error {
+	var hash crypto.Hash
+	if len(alg) == 5 {
+		switch alg[2:] {
+		case "256":
+			hash = crypto.SHA256
+		case "384":
+			hash = crypto.SHA384
+		case "512":
+			hash = crypto.SHA512
+		}
+	}
+	if hash == 0 {
+		return errors.Wrapf(ErrInvalidCredentials, "unsupported token algorithm `%s`", alg)
+	}
+	digest := hash.New()
+	digest.Write(signed)
+
+	valid := false
+	switch alg[:2] {
+	case "HS":
+		if secret, ok := key.([]byte); ok {
+			mac := hmac.New(hash.New, secret)
+			mac.Write(signed)
+			valid = hmac.Equal(mac.Sum(nil), signature)
+		}
+	case "RS":
+		if public, ok := key.(*rsa.PublicKey); ok {
+			valid = rsa.VerifyPKCS1v15(public, hash, digest.Sum(nil), signature) == nil
+		}
+	case "PS":
+		if public, ok := key.(*rsa.PublicKey); ok {
+			valid = rsa.VerifyPSS(public, hash, digest.Sum(nil), signature, nil) == nil
+		}
+	case "ES":
+		if public, ok := key.(*ecdsa.PublicKey); ok {
+			size := (public.Curve.Params().BitSize + 7) / 8
+			if len(signature) == 2*size {
+				r := new(big.Int).SetBytes(signature[:size])
+				s := new(big.Int).SetBytes(signature[size:])
+				valid = ecdsa.Verify(public, digest.Sum(nil), r, s)
+			}
+		}
+	}
+	if !valid {
+		return errors.Wrapf(ErrInvalidCredentials, "invalid token signature")
+	}
+	return nil
+}
+
+// tokenPolicy tells which tokens are accepted and how their claims map to
+// identities.
+type tokenPolicy struct {
+	issuer      string
+	audience    string
+	userClaim   string
+	groupsClaim string
+	method      string
+	// whether tokens without expiry are rejected
+	requireExpiry bool
+}
+
+// claimsIdentity checks the claims of a token and returns the identity of
+// its user. It returns ErrNoCredentials for the tokens of other issuers.
+func claimsIdentity(claims map[string]interface{}, policy tokenPolicy) (webtty.Identity, error) {
+	if issuer, _ := claims["iss"].(string); policy.issuer != "" && issuer != policy.issuer {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+
+	now := time.Now()
+	exp, ok := claims["exp"].(float64)
+	if !ok && policy.requireExpiry {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "token has no expiry")
+	}
+	if ok && now.After(time.Unix(int64(exp), 0).Add(jwtLeeway)) {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(jwtLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "token not valid yet")
+	}
+	if policy.audience != "" && !hasString(claims["aud"], policy.audience) {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "token not issued for `%s`", policy.audience)
+	}
+
+	userClaim, groupsClaim := policy.userClaim, policy.groupsClaim
+	if userClaim == "" {
+		userClaim = "sub"
+	}
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	user, _ := claims[userClaim].(string)
+	if user == "" {
+		return webtty.Identity{}, errors.Wrapf(ErrInvalidCredentials, "token has no `%s` claim", userClaim)
+	}
+	identity := webtty.Identity{User: user, AuthMethod: policy.method}
+	switch groups := claims[groupsClaim].(type) {
+	case string:
+		identity.Groups = []string{groups}
+	case []interface{}:
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, group)
+			}
+		}
+	}
+	return identity, nil
+}
+
+// hasString returns whether claim is s or a list containing s.
+func hasString(claim interface{}, s string) bool {
+	switch claim := claim.(type) {
+	case string:
+		return claim == s
+	case []interface{}:
+		for _, value := range claim {
+			if value == s {
+				return true
+			}
+		}
+	}
+	return false
+}
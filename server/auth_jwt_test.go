@@ -0,0 +1,353 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+var (
+	testRSAKey, _   = rsa.GenerateKey(rand.Reader, 2048)
+	testECDSAKey, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+)
+
+// signJWT returns a token of claims with the header of alg and kid,
+// signed with key, a []byte secret or a private key.
+func signJWT(t *testing.T, alg string, kid string, key interface{}, claims map[string]interface{}) string {
+	header := map[string]string{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Unexpected error from Marshal(): %s", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signed := encode(header) + "." + encode(claims)
+
+	digest := sha256.Sum256([]byte(signed))
+	var signature []byte
+	var err error
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest[:])
+		if err == nil {
+			signature = make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+		}
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error signing the token: %s", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// validClaims returns the claims of a token of alice valid for an hour.
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":    "alice",
+		"groups": []string{"ops", "dev"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func bearerRequest(token string) *http.Request {
+	r, _ := http.NewRequest("GET", "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	secret := []byte("secret")
+	rsaPublic, err := x509.MarshalPKIXPublicKey(&testRSAKey.PublicKey)
+	if err != nil {
+		t.Fatalf("Unexpected error from MarshalPKIXPublicKey(): %s", err)
+	}
+	rsaPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaPublic})
+
+	with := func(changes map[string]interface{}) map[string]interface{} {
+		claims := validClaims()
+		for name, value := range changes {
+			if value == nil {
+				delete(claims, name)
+			} else {
+				claims[name] = value
+			}
+		}
+		return claims
+	}
+	now := time.Now()
+
+	for _, tc := range []struct {
+		name          string
+		authenticator JWTAuthenticator
+		token         string
+		user          string
+		err           error
+	}{
+		{
+			name:          "HS256",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, validClaims()),
+			user:          "alice",
+		},
+		{
+			name:          "RS256",
+			authenticator: JWTAuthenticator{Key: &testRSAKey.PublicKey},
+			token:         signJWT(t, "RS256", "", testRSAKey, validClaims()),
+			user:          "alice",
+		},
+		{
+			name:          "ES256",
+			authenticator: JWTAuthenticator{Key: &testECDSAKey.PublicKey},
+			token:         signJWT(t, "ES256", "", testECDSAKey, validClaims()),
+			user:          "alice",
+		},
+		{
+			// the public key must not be taken as an HMAC secret
+			name:          "HS256 signed with the RSA public key",
+			authenticator: JWTAuthenticator{Key: &testRSAKey.PublicKey},
+			token:         signJWT(t, "HS256", "", rsaPEM, validClaims()),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "RS256 with an HMAC secret",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "RS256", "", testRSAKey, validClaims()),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "alg none",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "none", "", nil, validClaims()),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "bad signature",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", []byte("other"), validClaims()),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "malformed",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         "not.a-token",
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "expired",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"exp": now.Add(-2 * jwtLeeway).Unix()})),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "expired within the leeway",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"exp": now.Add(-jwtLeeway / 2).Unix()})),
+			user:          "alice",
+		},
+		{
+			name:          "not valid yet",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"nbf": now.Add(2 * jwtLeeway).Unix()})),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "no expiry",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"exp": nil})),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "no expiry allowed",
+			authenticator: JWTAuthenticator{Key: secret, AllowNoExpiry: true},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"exp": nil})),
+			user:          "alice",
+		},
+		{
+			name:          "audience",
+			authenticator: JWTAuthenticator{Key: secret, Audience: "gotty"},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"aud": "gotty"})),
+			user:          "alice",
+		},
+		{
+			name:          "audience in a list",
+			authenticator: JWTAuthenticator{Key: secret, Audience: "gotty"},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"aud": []string{"other", "gotty"}})),
+			user:          "alice",
+		},
+		{
+			name:          "audience not in the list",
+			authenticator: JWTAuthenticator{Key: secret, Audience: "gotty"},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"aud": []string{"other"}})),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "no audience",
+			authenticator: JWTAuthenticator{Key: secret, Audience: "gotty"},
+			token:         signJWT(t, "HS256", "", secret, validClaims()),
+			err:           ErrInvalidCredentials,
+		},
+		{
+			name:          "issuer",
+			authenticator: JWTAuthenticator{Key: secret, Issuer: "https://issuer.example.com"},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"iss": "https://issuer.example.com"})),
+			user:          "alice",
+		},
+		{
+			// left to the next authenticator, before the signature is checked
+			name:          "other issuer",
+			authenticator: JWTAuthenticator{Key: secret, Issuer: "https://issuer.example.com"},
+			token:         signJWT(t, "HS256", "", []byte("other"), with(map[string]interface{}{"iss": "https://other.example.com"})),
+			err:           ErrNoCredentials,
+		},
+		{
+			name:          "user claim",
+			authenticator: JWTAuthenticator{Key: secret, UserClaim: "email"},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"email": "bob@example.com"})),
+			user:          "bob@example.com",
+		},
+		{
+			name:          "no user",
+			authenticator: JWTAuthenticator{Key: secret},
+			token:         signJWT(t, "HS256", "", secret, with(map[string]interface{}{"sub": nil})),
+			err:           ErrInvalidCredentials,
+		},
+	} {
+		identity, err := tc.authenticator.Authenticate(bearerRequest(tc.token))
+		if errors.Cause(err) != tc.err {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if identity.User != tc.user {
+			t.Errorf("%s: unexpected user: %q", tc.name, identity.User)
+		}
+		if tc.err == nil && (identity.AuthMethod != AuthMethodJWT || !reflect.DeepEqual(identity.Groups, []string{"ops", "dev"})) {
+			t.Errorf("%s: unexpected identity: %+v", tc.name, identity)
+		}
+	}
+}
+
+func TestJWTAuthenticatorNoToken(t *testing.T) {
+	authenticator := JWTAuthenticator{Key: []byte("secret")}
+	r, _ := http.NewRequest("GET", "/", nil)
+	if _, err := authenticator.Authenticate(r); err != ErrNoCredentials {
+		t.Fatalf("Unexpected error without token: %v", err)
+	}
+
+	token := signJWT(t, "HS256", "", []byte("secret"), validClaims())
+	r, _ = http.NewRequest("GET", "/?"+bearerTokenParameter+"="+token, nil)
+	if identity, err := authenticator.Authenticate(r); err != nil || identity.User != "alice" {
+		t.Fatalf("Unexpected result of a token in the URL: %+v, %v", identity, err)
+	}
+}
+
+func TestNewJWTAuthenticatorFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotty-jwt")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rsaPublic, _ := x509.MarshalPKIXPublicKey(&testRSAKey.PublicKey)
+	for _, tc := range []struct {
+		name    string
+		content []byte
+		key     interface{}
+	}{
+		{"secret", []byte("secret\n"), []byte("secret")},
+		{"public", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaPublic}), &testRSAKey.PublicKey},
+		{"pkcs1", pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&testRSAKey.PublicKey)}), &testRSAKey.PublicKey},
+		{"private", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testRSAKey)}), nil},
+		{"empty", []byte("\n"), nil},
+	} {
+		path := filepath.Join(dir, tc.name)
+		if err := ioutil.WriteFile(path, tc.content, 0600); err != nil {
+			t.Fatalf("Unexpected error from WriteFile(): %s", err)
+		}
+		authenticator, err := NewJWTAuthenticatorFromFile(path)
+		if tc.key == nil {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(authenticator.Key, tc.key) {
+			t.Errorf("%s: unexpected key: %v", tc.name, authenticator.Key)
+		}
+	}
+}
+
+func TestAuthenticatorsFallThrough(t *testing.T) {
+	secret := []byte("secret")
+	authenticators := Authenticators{
+		&JWTAuthenticator{Key: []byte("other"), Issuer: "https://other.example.com"},
+		&JWTAuthenticator{Key: secret, Issuer: "https://issuer.example.com"},
+		&BasicAuthenticator{Credential: "bob:password"},
+	}
+
+	claims := validClaims()
+	claims["iss"] = "https://issuer.example.com"
+	identity, err := authenticators.Authenticate(bearerRequest(signJWT(t, "HS256", "", secret, claims)))
+	if err != nil || identity.User != "alice" {
+		t.Fatalf("Unexpected result of the second issuer: %+v, %v", identity, err)
+	}
+
+	// rejected by the authenticator of its issuer, not tried with the others
+	claims["iss"] = "https://other.example.com"
+	_, err = authenticators.Authenticate(bearerRequest(signJWT(t, "HS256", "", secret, claims)))
+	if errors.Cause(err) != ErrInvalidCredentials {
+		t.Fatalf("Unexpected error of a bad signature: %v", err)
+	}
+
+	claims["iss"] = "https://unknown.example.com"
+	_, err = authenticators.Authenticate(bearerRequest(signJWT(t, "HS256", "", secret, claims)))
+	if err != ErrNoCredentials {
+		t.Fatalf("Unexpected error of an unknown issuer: %v", err)
+	}
+
+	r, _ := http.NewRequest("GET", "/", nil)
+	r.SetBasicAuth("bob", "password")
+	identity, err = authenticators.Authenticate(r)
+	if err != nil || !reflect.DeepEqual(identity, webtty.Identity{User: "bob", AuthMethod: AuthMethodBasic}) {
+		t.Fatalf("Unexpected result of basic authentication: %+v, %v", identity, err)
+	}
+	r.SetBasicAuth("bob", "wrong")
+	if _, err := authenticators.Authenticate(r); err != ErrInvalidCredentials {
+		t.Fatalf("Unexpected error of a wrong password: %v", err)
+	}
+}
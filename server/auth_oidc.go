@@ -0,0 +1,195 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// oidcKeysMinRefresh bounds how often the keys of the issuer are fetched
+// again for tokens signed with an unknown key.
+const oidcKeysMinRefresh = time.Minute
+
+// OIDCAuthenticator validates the ID tokens of an OpenID Connect issuer
+// given as bearer tokens. The keys of the issuer are fetched from its
+// discovery document when the first token is validated, and again when
+// a token is signed with an unknown key, for key rotations.
+type OIDCAuthenticator struct {
+	// URL of the issuer, such as https://accounts.example.com
+	Issuer string
+	// Audience the tokens must have, typically the client ID,
+	// any audience when empty
+	Audience string
+	// Claim naming the user, "sub" by default
+	UserClaim string
+	// Claim listing the groups of the user, "groups" by default
+	GroupsClaim string
+
+	Client *http.Client
+
+	mutex     sync.Mutex
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+// NewOIDCAuthenticator returns an authenticator of the tokens of issuer
+// for audience.
+func NewOIDCAuthenticator(issuer string, audience string) *OIDCAuthenticator {
+	return &OIDCAuthenticator{
+		Issuer:   strings.TrimSuffix(issuer, "/"),
+		Audience: audience,
+		Client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (oa *OIDCAuthenticator) Authenticate(r *http.Request) (webtty.Identity, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+	if strings.TrimSuffix(jwtIssuer(token), "/") != oa.Issuer {
+		return webtty.Identity{}, ErrNoCredentials
+	}
+	claims, err := verifyJWT(token, oa.key)
+	if err != nil {
+		return webtty.Identity{}, err
+	}
+	return claimsIdentity(claims, tokenPolicy{
+		// checked above, up to a trailing slash
+		issuer:        "",
+		audience:      oa.Audience,
+		requireExpiry: true,
+		userClaim:     oa.UserClaim,
+		groupsClaim:   oa.GroupsClaim,
+		method:        AuthMethodOIDC,
+	})
+}
+
+// key returns the key of the issuer the token of header is signed with.
+func (oa *OIDCAuthenticator) key(header jwtHeader) (interface{}, error) {
+	if strings.HasPrefix(header.Alg, "HS") {
+		// the issuers sign with their private keys only
+		return nil, errors.Wrapf(ErrInvalidCredentials, "unsupported token algorithm `%s`", header.Alg)
+	}
+
+	oa.mutex.Lock()
+	defer oa.mutex.Unlock()
+
+	if key, ok := oa.keys[header.Kid]; ok {
+		return key, nil
+	}
+	if time.Since(oa.fetchedAt) < oidcKeysMinRefresh {
+		return nil, errors.Wrapf(ErrInvalidCredentials, "unknown token key `%s`", header.Kid)
+	}
+	keys, err := oa.fetchKeys()
+	oa.fetchedAt = time.Now()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch the keys of `%s`", oa.Issuer)
+	}
+	oa.keys = keys
+	if key, ok := oa.keys[header.Kid]; ok {
+		return key, nil
+	}
+	return nil, errors.Wrapf(ErrInvalidCredentials, "unknown token key `%s`", header.Kid)
+}
+
+func (oa *OIDCAuthenticator) fetchKeys() (map[string]interface{}, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	err := oa.getJSON(oa.Issuer+"/.well-known/openid-configuration", &discovery)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != oa.Issuer || discovery.JWKSURI == "" {
+		return nil, errors.New("invalid discovery document")
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err = oa.getJSON(discovery.JWKSURI, &set)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, jwk := range set.Keys {
+		if key, ok := jwk.publicKey(); ok && (jwk.Use == "" || jwk.Use == "sig") {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func (oa *OIDCAuthenticator) getJSON(url string, v interface{}) error {
+	response, err := oa.Client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("%s: %s", url, response.Status)
+	}
+	return json.NewDecoder(response.Body).Decode(v)
+}
+
+// jsonWebKey is a public key of a JWK set.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey returns the key of the RSA and EC keys.
+func (jwk jsonWebKey) publicKey() (interface{}, bool) {
+	switch jwk.Kty {
+	case "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, false
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, true
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, false
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(jwk.X)
+		y, errY := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if errX != nil || errY != nil {
+			return nil, false
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// newOIDCIssuer returns an issuer serving its discovery document and
+// the RSA key kid, and the number of times its keys were fetched.
+func newOIDCIssuer(t *testing.T, kid string) (*httptest.Server, *int32) {
+	var fetches int32
+	var issuer *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": issuer.URL, "jwks_uri": issuer.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		public := testRSAKey.PublicKey
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []jsonWebKey{{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}}})
+	})
+	issuer = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer, &fetches
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	issuer, _ := newOIDCIssuer(t, "key1")
+	authenticator := NewOIDCAuthenticator(issuer.URL+"/", "gotty")
+
+	claims := func(changes map[string]interface{}) map[string]interface{} {
+		claims := validClaims()
+		claims["iss"] = issuer.URL
+		claims["aud"] = "gotty"
+		for name, value := range changes {
+			if value == nil {
+				delete(claims, name)
+			} else {
+				claims[name] = value
+			}
+		}
+		return claims
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		user  string
+		err   error
+	}{
+		{
+			name:  "valid",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(nil)),
+			user:  "alice",
+		},
+		{
+			name:  "issuer with a trailing slash",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"iss": issuer.URL + "/"})),
+			user:  "alice",
+		},
+		{
+			name:  "other issuer",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"iss": "https://other.example.com"})),
+			err:   ErrNoCredentials,
+		},
+		{
+			// the issuers sign with their private keys only
+			name:  "HS256",
+			token: signJWT(t, "HS256", "key1", []byte("secret"), claims(nil)),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "alg none",
+			token: signJWT(t, "none", "key1", nil, claims(nil)),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "bad signature",
+			token: signJWT(t, "ES256", "key1", testECDSAKey, claims(nil)),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "unknown key",
+			token: signJWT(t, "RS256", "key2", testRSAKey, claims(nil)),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "expired",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"exp": time.Now().Add(-2 * jwtLeeway).Unix()})),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "not valid yet",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"nbf": time.Now().Add(2 * jwtLeeway).Unix()})),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "no expiry",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"exp": nil})),
+			err:   ErrInvalidCredentials,
+		},
+		{
+			name:  "audience in a list",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"aud": []string{"other", "gotty"}})),
+			user:  "alice",
+		},
+		{
+			name:  "other audience",
+			token: signJWT(t, "RS256", "key1", testRSAKey, claims(map[string]interface{}{"aud": []string{"other"}})),
+			err:   ErrInvalidCredentials,
+		},
+	} {
+		identity, err := authenticator.Authenticate(bearerRequest(tc.token))
+		if errors.Cause(err) != tc.err {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if identity.User != tc.user {
+			t.Errorf("%s: unexpected user: %q", tc.name, identity.User)
+		}
+		if tc.err == nil && identity.AuthMethod != AuthMethodOIDC {
+			t.Errorf("%s: unexpected identity: %+v", tc.name, identity)
+		}
+	}
+}
+
+func TestOIDCAuthenticatorKeyRefresh(t *testing.T) {
+	issuer, fetches := newOIDCIssuer(t, "key1")
+	authenticator := NewOIDCAuthenticator(issuer.URL, "")
+
+	claims := validClaims()
+	claims["iss"] = issuer.URL
+	for i := 0; i < 2; i++ {
+		if _, err := authenticator.Authenticate(bearerRequest(signJWT(t, "RS256", "key1", testRSAKey, claims))); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	// unknown keys are fetched again at most once a minute
+	for i := 0; i < 2; i++ {
+		if _, err := authenticator.Authenticate(bearerRequest(signJWT(t, "RS256", "key2", testRSAKey, claims))); errors.Cause(err) != ErrInvalidCredentials {
+			t.Fatalf("Unexpected error of an unknown key: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(fetches); n != 1 {
+		t.Fatalf("Unexpected number of key fetches: %d", n)
+	}
+
+	authenticator.fetchedAt = time.Now().Add(-oidcKeysMinRefresh)
+	authenticator.Authenticate(bearerRequest(signJWT(t, "RS256", "key2", testRSAKey, claims)))
+	if n := atomic.LoadInt32(fetches); n != 2 {
+		t.Fatalf("Unexpected number of key fetches: %d", n)
+	}
+}
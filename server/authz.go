@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Authorizer decides whether a user may open a terminal, before the slave
+// of the session is created. It returns nil to allow the request, and an
+// error with ErrForbidden as its cause to deny it. Any other error denies
+// the request as well.
+type Authorizer interface {
+	Authorize(ctx context.Context, request *AuthorizationRequest) error
+}
+
+// AuthorizerFunc is a function used as an Authorizer.
+type AuthorizerFunc func(ctx context.Context, request *AuthorizationRequest) error
+
+func (f AuthorizerFunc) Authorize(ctx context.Context, request *AuthorizationRequest) error {
+	return f(ctx, request)
+}
+
+// ErrForbidden is the cause of the errors of the denied requests.
+var ErrForbidden = errors.New("forbidden")
+
+// AuthorizationRequest describes the terminal a user asks for.
+type AuthorizationRequest struct {
+	Identity webtty.Identity `json:"identity"`
+	// Cluster of the cluster info of the connection, empty without one
+	ClusterID string `json:"clusterId,omitempty"`
+	// Name of the factory of the slave, such as "kubernetes container"
+	Backend string `json:"backend"`
+	// Arguments of the URL, selecting the target of the backends such as
+	// the host or the pod, empty unless permitted
+	Arguments url.Values `json:"arguments,omitempty"`
+}
+
+// Authorizers requires all its authorizers to allow a request.
+type Authorizers []Authorizer
+
+func (as Authorizers) Authorize(ctx context.Context, request *AuthorizationRequest) error {
+	for _, authorizer := range as {
+		if err := authorizer.Authorize(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newAuthorizer returns the authorizer configured by options,
+// nil when none is.
+func newAuthorizer(options *Options) (Authorizer, error) {
+	var authorizers Authorizers
+	if options.Authorizer != nil {
+		authorizers = append(authorizers, options.Authorizer)
+	}
+	if options.AuthzPolicyFile != "" {
+		authorizer, err := LoadPolicyAuthorizer(options.AuthzPolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		authorizers = append(authorizers, authorizer)
+	}
+	if options.AuthzWebhook != "" {
+		authorizers = append(authorizers, NewWebhookAuthorizer(options.AuthzWebhook))
+	}
+
+	if len(authorizers) == 0 {
+		return nil, nil
+	}
+	return authorizers, nil
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+)
+
+// Effects of the rules of the policies.
+const (
+	PolicyAllow = "allow"
+	PolicyDeny  = "deny"
+)
+
+// PolicyRule matches authorization requests. The users, groups and clusters
+// are lists of path.Match patterns, any value matching when empty.
+// A rule matches a user when either the user or one of its groups match.
+type PolicyRule struct {
+	Users    []string
+	Groups   []string
+	Clusters []string
+	// Patterns of the values of the URL arguments, all the values of
+	// an argument must match, an absent argument matching as ""
+	Arguments map[string][]string
+	// PolicyAllow or PolicyDeny
+	Effect string
+}
+
+// PolicyAuthorizer applies the effect of the first rule matching
+// a request, or its default effect when none does.
+type PolicyAuthorizer struct {
+	// PolicyAllow or PolicyDeny, PolicyDeny when empty
+	Default string
+	Rules   []PolicyRule
+}
+
+// LoadPolicyAuthorizer reads a policy in YAML like:
+//
+//	default: deny
+//	rules:
+//	  - groups: [admins]
+//	    effect: allow
+//	  - users: [alice, bob]
+//	    clusters: ["dev-*"]
+//	    arguments:
+//	      namespace: ["team-*"]
+//	    effect: allow
+//
+// Only the block mappings and sequences, the flow sequences and the
+// scalars of YAML are supported.
+func LoadPolicyAuthorizer(path string) (*PolicyAuthorizer, error) {
+	data, err := ioutil.ReadFile(homedir.Expand(path))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read authorization policy `%s`", path)
+	}
+	authorizer, err := parsePolicy(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid authorization policy `%s`", path)
+	}
+	return authorizer, nil
+}
+
+func parsePolicy(data []byte) (*PolicyAuthorizer, error) {
+	document, err := decodeYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("policy is not a mapping")
+	}
+
+	authorizer := &PolicyAuthorizer{}
+	for key, value := range root {
+		switch key {
+		case "default":
+			authorizer.Default, ok = value.(string)
+			if !ok || (authorizer.Default != PolicyAllow && authorizer.Default != PolicyDeny) {
+				return nil, errors.Errorf("default must be %s or %s", PolicyAllow, PolicyDeny)
+			}
+		case "rules":
+			rules, ok := value.([]interface{})
+			if !ok {
+				return nil, errors.New("rules must be a list")
+			}
+			for i, rule := range rules {
+				parsed, err := parsePolicyRule(rule)
+				if err != nil {
+					return nil, errors.Wrapf(err, "rule %d", i+1)
+				}
+				authorizer.Rules = append(authorizer.Rules, parsed)
+			}
+		default:
+			return nil, errors.Errorf("unknown key `%s`", key)
+		}
+	}
+	return authorizer, nil
+}
+
+func parsePolicyRule(value interface{}) (PolicyRule, error) {
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return PolicyRule{}, errors.New("rule is not a mapping")
+	}
+
+	var rule PolicyRule
+	var err error
+	for key, value := range fields {
+		switch key {
+		case "users":
+			rule.Users, err = policyPatterns(key, value)
+		case "groups":
+			rule.Groups, err = policyPatterns(key, value)
+		case "clusters":
+			rule.Clusters, err = policyPatterns(key, value)
+		case "arguments":
+			arguments, ok := value.(map[string]interface{})
+			if !ok {
+				return PolicyRule{}, errors.New("arguments must be a mapping")
+			}
+			rule.Arguments = make(map[string][]string)
+			for name, value := range arguments {
+				if rule.Arguments[name], err = policyPatterns(name, value); err != nil {
+					break
+				}
+			}
+		case "effect":
+			rule.Effect, _ = value.(string)
+			if rule.Effect != PolicyAllow && rule.Effect != PolicyDeny {
+				return PolicyRule{}, errors.Errorf("effect must be %s or %s", PolicyAllow, PolicyDeny)
+			}
+		default:
+			return PolicyRule{}, errors.Errorf("unknown key `%s`", key)
+		}
+		if err != nil {
+			return PolicyRule{}, err
+		}
+	}
+	if rule.Effect == "" {
+		return PolicyRule{}, errors.New("rule has no effect")
+	}
+	return rule, nil
+}
+
+// policyPatterns returns the patterns of value, a pattern or a list of them.
+func policyPatterns(key string, value interface{}) ([]string, error) {
+	var patterns []string
+	switch value := value.(type) {
+	case string:
+		patterns = []string{value}
+	case []interface{}:
+		for _, item := range value {
+			pattern, ok := item.(string)
+			if !ok {
+				return nil, errors.Errorf("%s must be a list of patterns", key)
+			}
+			patterns = append(patterns, pattern)
+		}
+	default:
+		return nil, errors.Errorf("%s must be a list of patterns", key)
+	}
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern `%s` in %s", pattern, key)
+		}
+	}
+	return patterns, nil
+}
+
+func (pa *PolicyAuthorizer) Authorize(ctx context.Context, request *AuthorizationRequest) error {
+	for i, rule := range pa.Rules {
+		if !rule.matches(request) {
+			continue
+		}
+		if rule.Effect == PolicyAllow {
+			return nil
+		}
+		return errors.Wrapf(ErrForbidden, "denied by rule %d", i+1)
+	}
+	if pa.Default == PolicyAllow {
+		return nil
+	}
+	return errors.Wrapf(ErrForbidden, "no rule allows the request")
+}
+
+func (rule *PolicyRule) matches(request *AuthorizationRequest) bool {
+	if len(rule.Users) > 0 || len(rule.Groups) > 0 {
+		matched := matchAny(rule.Users, request.Identity.User)
+		for _, group := range request.Identity.Groups {
+			matched = matched || matchAny(rule.Groups, group)
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(rule.Clusters) > 0 && !matchAny(rule.Clusters, request.ClusterID) {
+		return false
+	}
+	for name, patterns := range rule.Arguments {
+		values := request.Arguments[name]
+		if len(values) == 0 {
+			values = []string{""}
+		}
+		for _, value := range values {
+			if !matchAny(patterns, value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, s); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+const testPolicy = `
+default: deny
+rules:
+  - users: [mallory]
+    effect: deny
+  - groups: [admins]
+    effect: allow
+  - users: [alice, bob]
+    clusters: ["dev-*"]
+    arguments:
+      namespace: ["team-*"]
+    effect: allow
+  - clusters: ["lab"]
+    effect: allow
+`
+
+func TestPolicyAuthorizer(t *testing.T) {
+	authorizer, err := parsePolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("Unexpected error from parsePolicy(): %s", err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		user      string
+		groups    []string
+		cluster   string
+		arguments url.Values
+		allowed   bool
+	}{
+		{"group", "carol", []string{"dev", "admins"}, "prod", nil, true},
+		{"user cluster and argument", "alice", nil, "dev-1", url.Values{"namespace": {"team-a"}}, true},
+		{"other cluster", "alice", nil, "prod", url.Values{"namespace": {"team-a"}}, false},
+		{"other argument", "bob", nil, "dev-1", url.Values{"namespace": {"kube-system"}}, false},
+		{"one of the values not matching", "bob", nil, "dev-1", url.Values{"namespace": {"team-a", "kube-system"}}, false},
+		{"absent argument", "bob", nil, "dev-1", nil, false},
+		{"other user", "carol", nil, "dev-1", url.Values{"namespace": {"team-a"}}, false},
+		{"any user", "carol", nil, "lab", nil, true},
+		// the first matching rule applies
+		{"denied before allowed", "mallory", []string{"admins"}, "lab", nil, false},
+		{"default", "carol", nil, "prod", nil, false},
+	} {
+		err := authorizer.Authorize(context.Background(), &AuthorizationRequest{
+			Identity:  webtty.Identity{User: tc.user, Groups: tc.groups},
+			ClusterID: tc.cluster,
+			Arguments: tc.arguments,
+		})
+		if tc.allowed && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		}
+		if !tc.allowed && errors.Cause(err) != ErrForbidden {
+			t.Errorf("%s: unexpected error of a denied request: %v", tc.name, err)
+		}
+	}
+}
+
+func TestPolicyAuthorizerDefault(t *testing.T) {
+	request := &AuthorizationRequest{Identity: webtty.Identity{User: "alice"}}
+
+	// denied without a default
+	authorizer := &PolicyAuthorizer{}
+	if err := authorizer.Authorize(context.Background(), request); errors.Cause(err) != ErrForbidden {
+		t.Fatalf("Unexpected error with no rule: %v", err)
+	}
+
+	authorizer, err := parsePolicy([]byte("default: allow\nrules:\n  - users: [bob]\n    effect: deny\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error from parsePolicy(): %s", err)
+	}
+	if err := authorizer.Authorize(context.Background(), request); err != nil {
+		t.Fatalf("Unexpected error with the default allowing: %s", err)
+	}
+	request.Identity.User = "bob"
+	if err := authorizer.Authorize(context.Background(), request); errors.Cause(err) != ErrForbidden {
+		t.Fatalf("Unexpected error of a denied user: %v", err)
+	}
+}
+
+func TestParsePolicyErrors(t *testing.T) {
+	for _, policy := range []string{
+		"- allow",
+		"default: maybe",
+		"rules: allow",
+		"rules:\n  - users: [alice]\n",
+		"rules:\n  - users: [alice]\n    effect: permit\n",
+		"rules:\n  - user: [alice]\n    effect: allow\n",
+		"rules:\n  - users: ['[']\n    effect: allow\n",
+		"rules:\n  - arguments: [namespace]\n    effect: allow\n",
+		"other: true",
+	} {
+		if _, err := parsePolicy([]byte(policy)); err == nil {
+			t.Errorf("Expected an error for %q", policy)
+		}
+	}
+}
+
+func TestLoadPolicyAuthorizer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotty-policy")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := LoadPolicyAuthorizer(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Fatalf("Expected an error for a missing policy")
+	}
+	path := filepath.Join(dir, "policy.yaml")
+	if err := ioutil.WriteFile(path, []byte(testPolicy), 0600); err != nil {
+		t.Fatalf("Unexpected error from WriteFile(): %s", err)
+	}
+	authorizer, err := LoadPolicyAuthorizer(path)
+	if err != nil {
+		t.Fatalf("Unexpected error from LoadPolicyAuthorizer(): %s", err)
+	}
+	if authorizer.Default != PolicyDeny || len(authorizer.Rules) != 4 {
+		t.Fatalf("Unexpected policy: %+v", authorizer)
+	}
+}
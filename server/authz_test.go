@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+func TestAuthorizers(t *testing.T) {
+	var calls []string
+	authorizer := func(name string, err error) Authorizer {
+		return AuthorizerFunc(func(ctx context.Context, request *AuthorizationRequest) error {
+			calls = append(calls, name)
+			return err
+		})
+	}
+	request := &AuthorizationRequest{Identity: webtty.Identity{User: "alice"}}
+
+	if err := (Authorizers{authorizer("a", nil), authorizer("b", nil)}).Authorize(context.Background(), request); err != nil {
+		t.Fatalf("Unexpected error when all allow: %s", err)
+	}
+
+	calls = nil
+	denied := errors.Wrap(ErrForbidden, "denied by b")
+	err := (Authorizers{authorizer("a", nil), authorizer("b", denied), authorizer("c", nil)}).Authorize(context.Background(), request)
+	if err != denied {
+		t.Fatalf("Unexpected error when one denies: %v", err)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("Unexpected authorizers called after a denial: %v", calls)
+	}
+
+	if err := (Authorizers{}).Authorize(context.Background(), request); err != nil {
+		t.Fatalf("Unexpected error without authorizers: %s", err)
+	}
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	authorizer, err := newAuthorizer(&Options{})
+	if err != nil || authorizer != nil {
+		t.Fatalf("Unexpected authorizer without options: %v, %v", authorizer, err)
+	}
+
+	if _, err := newAuthorizer(&Options{AuthzPolicyFile: "/nonexistent/policy.yaml"}); err == nil {
+		t.Fatalf("Expected an error for a missing policy")
+	}
+
+	authorizer, err = newAuthorizer(&Options{
+		Authorizer:   AuthorizerFunc(func(ctx context.Context, request *AuthorizationRequest) error { return nil }),
+		AuthzWebhook: "http://127.0.0.1:1/authorize",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error from newAuthorizer(): %s", err)
+	}
+	authorizers, ok := authorizer.(Authorizers)
+	if !ok || len(authorizers) != 2 {
+		t.Fatalf("Unexpected authorizer: %#v", authorizer)
+	}
+	if _, ok := authorizers[1].(*WebhookAuthorizer); !ok {
+		t.Fatalf("Unexpected second authorizer: %#v", authorizers[1])
+	}
+}
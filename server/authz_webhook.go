@@ -0,0 +1,66 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookAuthorizer asks an HTTP endpoint to decide on the requests.
+// The AuthorizationRequest is posted as JSON, and the endpoint answers with
+// a JSON object like {"allowed": true} or {"allowed": false, "reason": "..."}.
+// The requests are denied when the endpoint fails to answer.
+type WebhookAuthorizer struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookAuthorizer returns an authorizer posting the requests to url.
+func NewWebhookAuthorizer(url string) *WebhookAuthorizer {
+	return &WebhookAuthorizer{
+		URL:    url,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type webhookDecision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+func (wa *WebhookAuthorizer) Authorize(ctx context.Context, request *AuthorizationRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequest("POST", wa.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	response, err := wa.Client.Do(httpRequest.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "failed to query the authorization webhook")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("authorization webhook answered %s", response.Status)
+	}
+
+	var decision webhookDecision
+	if err := json.NewDecoder(response.Body).Decode(&decision); err != nil {
+		return errors.Wrapf(err, "invalid answer of the authorization webhook")
+	}
+	if !decision.Allowed {
+		if decision.Reason == "" {
+			decision.Reason = "denied by the authorization webhook"
+		}
+		return errors.Wrap(ErrForbidden, decision.Reason)
+	}
+	return nil
+}
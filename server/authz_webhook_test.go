@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+func TestWebhookAuthorizer(t *testing.T) {
+	var received AuthorizationRequest
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+			return
+		}
+		received = AuthorizationRequest{}
+		json.NewDecoder(r.Body).Decode(&received)
+		switch received.Identity.User {
+		case "alice":
+			w.Write([]byte(`{"allowed": true}`))
+		case "bob":
+			w.Write([]byte(`{"allowed": false, "reason": "not on call"}`))
+		case "carol":
+			w.Write([]byte(`{"allowed": false}`))
+		case "dave":
+			w.Write([]byte(`allowed`))
+		case "erin":
+			time.Sleep(time.Second)
+			w.Write([]byte(`{"allowed": true}`))
+		default:
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+		}
+	}))
+	defer webhook.Close()
+	authorizer := NewWebhookAuthorizer(webhook.URL)
+
+	request := &AuthorizationRequest{
+		Identity:  webtty.Identity{User: "alice", Groups: []string{"ops"}},
+		ClusterID: "prod",
+		Backend:   "local command",
+		Arguments: url.Values{"host": {"db1"}},
+	}
+	if err := authorizer.Authorize(context.Background(), request); err != nil {
+		t.Fatalf("Unexpected error of an allowed request: %s", err)
+	}
+	if !reflect.DeepEqual(received, *request) {
+		t.Fatalf("Unexpected request posted: %+v", received)
+	}
+
+	for _, tc := range []struct {
+		user      string
+		forbidden bool
+		reason    string
+	}{
+		{"bob", true, "not on call"},
+		{"carol", true, "denied by the authorization webhook"},
+		// errors of the webhook deny the requests too
+		{"dave", false, "invalid answer"},
+		{"frank", false, "500 Internal Server Error"},
+	} {
+		request.Identity.User = tc.user
+		err := authorizer.Authorize(context.Background(), request)
+		if err == nil || (errors.Cause(err) == ErrForbidden) != tc.forbidden || !strings.Contains(err.Error(), tc.reason) {
+			t.Errorf("%s: unexpected error: %v", tc.user, err)
+		}
+	}
+
+	request.Identity.User = "erin"
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := authorizer.Authorize(ctx, request); err == nil {
+		t.Fatalf("Expected an error of a canceled request")
+	}
+}
+
+func TestWebhookAuthorizerUnreachable(t *testing.T) {
+	webhook := httptest.NewServer(http.NotFoundHandler())
+	webhook.Close()
+
+	authorizer := NewWebhookAuthorizer(webhook.URL)
+	err := authorizer.Authorize(context.Background(), &AuthorizationRequest{Identity: webtty.Identity{User: "alice"}})
+	if err == nil || errors.Cause(err) == ErrForbidden {
+		t.Fatalf("Unexpected error of an unreachable webhook: %v", err)
+	}
+}
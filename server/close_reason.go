@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Codes of the CloseReason messages sent to the clients whose sessions
+// fail to start, along with the messages displayed to them. The errors
+// themselves are only logged, they may tell more than users should know.
+const (
+	closeUnauthenticated     = "unauthenticated"
+	closeForbidden           = "forbidden"
+	closeSpawnFailed         = "spawn_failed"
+	closeAuditFailed         = "audit_failed"
+	closeSessionNotFound     = "session_not_found"
+	closeTooManySessions     = "too_many_sessions"
+	closeSessionQuota        = "session_quota"
+	closeRateQuota           = "session_rate_quota"
+	closeBandwidthQuota      = "bandwidth_quota"
+	closeSetupFailed         = "setup_failed"
+	closeUnsupportedProtocol = "unsupported_protocol"
+	closeInvalidTags         = "invalid_tags"
+	closeMultiplexDisabled   = "multiplex_disabled"
+	closeNoGrant             = "no_grant"
+)
+
+var closeMessages = map[string]string{
+	closeUnauthenticated:     "authentication failed",
+	closeForbidden:           "not authorized to open this terminal",
+	closeSpawnFailed:         "failed to start the terminal",
+	closeAuditFailed:         "failed to set up the audit trail of the session",
+	closeSessionNotFound:     "session not found",
+	closeTooManySessions:     "too many named sessions",
+	closeSessionQuota:        "too many terminals open, close one to open another",
+	closeRateQuota:           "too many terminals opened in the last hour",
+	closeBandwidthQuota:      "daily traffic quota exhausted",
+	closeSetupFailed:         "failed to set up the session",
+	closeUnsupportedProtocol: "this client is too old for the server, reload the page or upgrade it",
+	closeInvalidTags:         "invalid session tags",
+	closeMultiplexDisabled:   "this server runs a single terminal per connection",
+	closeNoGrant:             "no access grant to this terminal, request one first",
+}
+
+// setupError is an error starting the session of a connection, with the
+// code of the CloseReason message telling its client.
+type setupError struct {
+	code string
+	err  error
+}
+
+func withCloseCode(code string, err error) error {
+	return &setupError{code: code, err: err}
+}
+
+func (se *setupError) Error() string {
+	return se.err.Error()
+}
+
+func (se *setupError) Cause() error {
+	return se.err
+}
+
+// setupCloseCode returns the code of the CloseReason message for err.
+func setupCloseCode(err error) string {
+	if se, ok := err.(*setupError); ok {
+		return se.code
+	}
+	switch errors.Cause(err) {
+	case ErrForbidden, errNamedSessionOwned:
+		return closeForbidden
+	case webtty.ErrSessionNotFound, webtty.ErrBroadcastEnded:
+		return closeSessionNotFound
+	case errTooManyNamedSessions:
+		return closeTooManySessions
+	case errSessionQuota:
+		return closeSessionQuota
+	case errRateQuota:
+		return closeRateQuota
+	case errBandwidthQuota:
+		return closeBandwidthQuota
+	case errNoGrant:
+		return closeNoGrant
+	}
+	return closeSetupFailed
+}
+
+// sendSetupFailure tells the client of master why its session couldn't
+// start with err. Nothing is sent when ctx is done or master was replaced
+// by a reconnection, and errors are ignored as the connection is closed
+// right after.
+func sendSetupFailure(ctx context.Context, master webtty.Master, err error) {
+	if err == nil || ctx.Err() != nil || err == errConnectionReplaced {
+		return
+	}
+	code := setupCloseCode(err)
+	master.Write(webtty.CloseReasonMessage(code, closeMessages[code]))
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// errServerDraining is returned for the sessions starting once the server
+// started draining.
+var errServerDraining = errors.New("server is draining")
+
+// sessionTracker keeps the running sessions, for Drain to notify them
+// and wait for them to end.
+type sessionTracker struct {
+	mutex    sync.Mutex
+	sessions map[*webtty.WebTTY]struct{}
+	draining bool
+	// closed when draining and no session is left
+	idle chan struct{}
+}
+
+func newSessionTracker() *sessionTracker {
+	return &sessionTracker{
+		sessions: make(map[*webtty.WebTTY]struct{}),
+		idle:     make(chan struct{}),
+	}
+}
+
+// register tracks tty until unregister is called, once its session ended.
+// It fails with errServerDraining once the server is draining.
+func (st *sessionTracker) register(tty *webtty.WebTTY) (unregister func(), err error) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	if st.draining {
+		return nil, errServerDraining
+	}
+	st.sessions[tty] = struct{}{}
+
+	return func() {
+		st.mutex.Lock()
+		defer st.mutex.Unlock()
+		if _, ok := st.sessions[tty]; !ok {
+			return
+		}
+		delete(st.sessions, tty)
+		if st.draining && len(st.sessions) == 0 {
+			close(st.idle)
+		}
+	}, nil
+}
+
+// list returns the running sessions.
+func (st *sessionTracker) list() []*webtty.WebTTY {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	sessions := make([]*webtty.WebTTY, 0, len(st.sessions))
+	for tty := range st.sessions {
+		sessions = append(sessions, tty)
+	}
+	return sessions
+}
+
+// isDraining returns whether the server stopped accepting new sessions.
+func (st *sessionTracker) isDraining() bool {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	return st.draining
+}
+
+// drain stops the registrations and returns the running sessions,
+// and a channel closed once they are all unregistered.
+func (st *sessionTracker) drain() ([]*webtty.WebTTY, <-chan struct{}) {
+	st.mutex.Lock()
+	if !st.draining {
+		st.draining = true
+		if len(st.sessions) == 0 {
+			close(st.idle)
+		}
+	}
+	st.mutex.Unlock()
+
+	return st.list(), st.idle
+}
+
+// Drain stops accepting new WebSocket connections, tells the users of the
+// running sessions that the server is shutting down by the deadline of ctx,
+// and waits until their sessions end. It returns the error of ctx when
+// sessions are left once ctx is done; they are closed when Run returns,
+// such as when its context is canceled.
+func (server *Server) Drain(ctx context.Context) error {
+	sessions, idle := server.tracker.drain()
+
+	var left time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		left = time.Until(deadline)
+		if left <= 0 {
+			return ctx.Err()
+		}
+	}
+	log.Printf("Draining %d sessions", len(sessions))
+	for _, tty := range sessions {
+		// a broken master is detected by the session
+		tty.NotifyShutdown(left)
+	}
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
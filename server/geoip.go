@@ -0,0 +1,114 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CountryLookup finds the country of the clients, for the country restrictions
+// of the access control.
+type CountryLookup interface {
+	// Country returns the ISO 3166 code of the country of ip,
+	// or "" when unknown.
+	Country(ip net.IP) string
+}
+
+// CountryLookupFunc is a function used as a CountryLookup.
+type CountryLookupFunc func(ip net.IP) string
+
+func (f CountryLookupFunc) Country(ip net.IP) string {
+	return f(ip)
+}
+
+// geoIPDatabase is a CountryLookup of the networks of a CSV file,
+// whose lines are <CIDR>,<country> such as 203.0.113.0/24,JP.
+type geoIPDatabase struct {
+	// sorted by start, not overlapping
+	ranges []countryRange
+}
+
+type countryRange struct {
+	// 16 byte addresses, the IPv4 ones mapped to IPv6
+	start   net.IP
+	end     net.IP
+	country string
+}
+
+// loadGeoIPDatabase reads the networks of the CSV file at path.
+// Blank lines and lines starting with # are skipped.
+func loadGeoIPDatabase(path string) (*geoIPDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open GeoIP database `%s`", path)
+	}
+	defer file.Close()
+
+	db := &geoIPDatabase{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, ",")
+		if len(fields) < 2 {
+			return nil, errors.Errorf("malformed line %d of GeoIP database `%s`", line, path)
+		}
+		_, network, err := net.ParseCIDR(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed line %d of GeoIP database `%s`", line, path)
+		}
+		db.ranges = append(db.ranges, countryRange{
+			start:   network.IP.To16(),
+			end:     lastAddress(network),
+			country: strings.ToUpper(strings.TrimSpace(fields[1])),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read GeoIP database `%s`", path)
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	for i := 1; i < len(db.ranges); i++ {
+		if bytes.Compare(db.ranges[i].start, db.ranges[i-1].end) <= 0 {
+			return nil, errors.Errorf("overlapping networks %s and %s in GeoIP database `%s`",
+				db.ranges[i-1].start, db.ranges[i].start, path)
+		}
+	}
+	return db, nil
+}
+
+func (db *geoIPDatabase) Country(ip net.IP) string {
+	ip = ip.To16()
+	if ip == nil {
+		return ""
+	}
+	// the first range ending at or after ip
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].end, ip) >= 0
+	})
+	if i < len(db.ranges) && bytes.Compare(db.ranges[i].start, ip) <= 0 {
+		return db.ranges[i].country
+	}
+	return ""
+}
+
+// lastAddress returns the last address of network, as 16 bytes.
+func lastAddress(network *net.IPNet) net.IP {
+	last := make(net.IP, net.IPv6len)
+	copy(last, network.IP.To16())
+	// the mask of an IPv4 network covers the last 4 bytes
+	offset := net.IPv6len - len(network.Mask)
+	for i, b := range network.Mask {
+		last[offset+i] |= ^b
+	}
+	return last
+}
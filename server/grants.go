@@ -0,0 +1,180 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/randomstring"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// grantAPIPath is the path of the grant API, with the ID of a grant
+// appended for the requests on it.
+const grantAPIPath = "/api/grants"
+
+// errNoGrant is returned for the sessions of grant_clusters
+// whose users hold no access grant to their cluster.
+var errNoGrant = errors.New("no access grant")
+
+// accessGrants keeps the access grants issued with the grant API,
+// until they expire or are revoked. They're lost when the server restarts.
+type accessGrants struct {
+	mutex  sync.Mutex
+	grants map[string]webtty.AccessGrant
+}
+
+func newAccessGrants() *accessGrants {
+	return &accessGrants{grants: make(map[string]webtty.AccessGrant)}
+}
+
+// issue adds grant, with a new ID, and returns it.
+func (ag *accessGrants) issue(grant webtty.AccessGrant) webtty.AccessGrant {
+	ag.mutex.Lock()
+	defer ag.mutex.Unlock()
+
+	grant.ID = randomstring.Generate(16)
+	ag.grants[grant.ID] = grant
+	return grant
+}
+
+// find returns the grant of user to target valid at now, the one expiring
+// last when several are.
+func (ag *accessGrants) find(user string, target string, now time.Time) (webtty.AccessGrant, bool) {
+	ag.mutex.Lock()
+	defer ag.mutex.Unlock()
+
+	var found webtty.AccessGrant
+	for _, grant := range ag.grants {
+		if grant.User == user && grant.Target == target && !grant.Expired(now) && grant.Expires.After(found.Expires) {
+			found = grant
+		}
+	}
+	return found, found.ID != ""
+}
+
+// list returns the grants valid at now, the first to expire first,
+// and forgets the expired ones.
+func (ag *accessGrants) list(now time.Time) []webtty.AccessGrant {
+	ag.mutex.Lock()
+	defer ag.mutex.Unlock()
+
+	grants := make([]webtty.AccessGrant, 0, len(ag.grants))
+	for id, grant := range ag.grants {
+		if grant.Expired(now) {
+			delete(ag.grants, id)
+			continue
+		}
+		grants = append(grants, grant)
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].Expires.Before(grants[j].Expires)
+	})
+	return grants
+}
+
+// revoke removes the grant of id, and returns whether it was kept.
+func (ag *accessGrants) revoke(id string) bool {
+	ag.mutex.Lock()
+	defer ag.mutex.Unlock()
+
+	_, ok := ag.grants[id]
+	delete(ag.grants, id)
+	return ok
+}
+
+// IssueGrant issues an access grant of user to the cluster target for
+// duration, on behalf of issuer. Until it expires, the user may open
+// the terminals of target when it matches grant_clusters.
+func (server *Server) IssueGrant(user string, target string, duration time.Duration, issuer string, reason string) (webtty.AccessGrant, error) {
+	max := time.Duration(server.options.MaxGrantDuration) * time.Second
+	if user == "" || target == "" {
+		return webtty.AccessGrant{}, errors.New("access grant requires a user and a target")
+	}
+	if duration <= 0 || duration > max {
+		return webtty.AccessGrant{}, errors.Errorf("access grant duration must be positive and at most %s", max)
+	}
+	return server.grants.issue(webtty.AccessGrant{
+		User:     user,
+		Target:   target,
+		Expires:  time.Now().Add(duration),
+		IssuedBy: issuer,
+		Reason:   reason,
+	}), nil
+}
+
+// Grants returns the access grants not expired yet, the first to expire
+// first.
+func (server *Server) Grants() []webtty.AccessGrant {
+	return server.grants.list(time.Now())
+}
+
+// RevokeGrant revokes the access grant of id on behalf of revoker, closing
+// the sessions running under it, see webtty.RevokeGrant. It returns
+// the number of sessions closed, and false when no grant has id.
+func (server *Server) RevokeGrant(id string, revoker string) (int, bool) {
+	if !server.grants.revoke(id) {
+		return 0, false
+	}
+	closed := 0
+	for _, tty := range server.tracker.list() {
+		if grant, ok := tty.Grant(); ok && grant.ID == id && tty.RevokeGrant(revoker) == nil {
+			closed++
+		}
+	}
+	return closed, true
+}
+
+// grantRequest is the body of the requests issuing grants with the grant API.
+type grantRequest struct {
+	User   string `json:"user"`
+	Target string `json:"target"`
+	// Seconds the grant lasts
+	Duration int    `json:"duration"`
+	Reason   string `json:"reason"`
+}
+
+// handleGrantAPI lists the access grants with GET /api/grants, issues one
+// with POST /api/grants and revokes one with DELETE /api/grants/<ID>.
+func (server *Server) handleGrantAPI(w http.ResponseWriter, r *http.Request) {
+	identity, _ := webtty.IdentityFromContext(r.Context())
+	if !server.isSessionAdmin(identity) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, grantAPIPath), "/")
+	switch {
+	case id == "" && r.Method == "GET":
+		writeJSON(w, http.StatusOK, server.Grants())
+	case id == "" && r.Method == "POST":
+		var request grantRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, "Invalid grant", http.StatusBadRequest)
+			return
+		}
+		grant, err := server.IssueGrant(request.User, request.Target, time.Duration(request.Duration)*time.Second, identity.User, request.Reason)
+		if err != nil {
+			http.Error(w, "Invalid grant: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		log.Printf("Access grant %s of %s to cluster `%s` until %s issued by %s", grant.ID, grant.User, grant.Target, grant.Expires.Format(time.RFC3339), identity.User)
+		writeJSON(w, http.StatusCreated, grant)
+	case id != "" && r.Method == "DELETE":
+		closed, ok := server.RevokeGrant(id, identity.User)
+		if !ok {
+			http.Error(w, "Grant not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Access grant %s revoked by %s, %d sessions closed", id, identity.User, closed)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
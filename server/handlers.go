@@ -4,25 +4,38 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"github.com/buptWYChen/gotty/utils"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/pkg/randomstring"
 	"github.com/buptWYChen/gotty/webtty"
+	"github.com/buptWYChen/gotty/webtty/protocol"
 )
 
 type ClusterInfoData struct {
 	UserAccount string `json:"userAccount"`
 	ClusterId   string `json:"clusterId"`
+	// Groups of the user, for the backends acting on their behalf
+	Groups []string `json:"groups,omitempty"`
 }
 
+// authMethodClusterInfo is the authentication method of the users
+// given by the encrypted cluster info of the connections.
+const authMethodClusterInfo = "cluster-info"
+
 func (server *Server) generateHandleWS(ctx context.Context, cancel context.CancelFunc, counter *counter) http.HandlerFunc {
 	once := new(int64)
 
@@ -76,6 +89,11 @@ func (server *Server) generateHandleWS(ctx context.Context, cancel context.Cance
 		clusterId := clusterInfoData.ClusterId
 		fmt.Println("userAccount:", userAccount, " clusterId:", clusterId)
 
+		if server.tracker.isDraining() {
+			http.Error(w, "Server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+
 		if server.options.Once {
 			success := atomic.CompareAndSwapInt64(once, 0, 1)
 			if !success {
@@ -120,39 +138,187 @@ func (server *Server) generateHandleWS(ctx context.Context, cancel context.Cance
 		}
 		defer conn.Close()
 
-		err = server.processWSConn(ctx, conn, userAccount, clusterId)
-
-		switch err {
-		case ctx.Err():
+		observe := r.FormValue("observe")
+		identity := webtty.Identity{
+			User:       userAccount,
+			Groups:     clusterInfoData.Groups,
+			AuthMethod: authMethodClusterInfo,
+		}
+		if authenticated, ok := webtty.IdentityFromContext(r.Context()); ok {
+			identity = authenticated
+		}
+		identity.SourceIP = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			identity.SourceIP = host
+		}
+		err = server.processWSConn(ctx, conn, identity, clusterId, observe, namedSessionFromContext(r.Context()))
+
+		var exitErr *webtty.SlaveExitError
+		switch {
+		case err == nil && observe != "":
+			closeReason = "end of the observed session"
+		case err == nil && server.broadcast != nil:
+			closeReason = "end of the watched command"
+		case err == webtty.ErrViewerEvicted:
+			closeReason = "slow client"
+		case err == nil:
+			closeReason = "end of the reattached session"
+		case err == errConnectionReplaced:
+			closeReason = "reconnection"
+		case err == ctx.Err():
 			closeReason = "cancelation"
-		case webtty.ErrSlaveClosed:
+		case stderrors.As(err, &exitErr):
+			closeReason = fmt.Sprintf("%s (%s)", server.factory.Name(), exitErr.Status)
+		case stderrors.Is(err, webtty.ErrSlaveClosed):
 			closeReason = server.factory.Name()
-		case webtty.ErrMasterClosed:
+		case stderrors.Is(err, webtty.ErrMasterClosed):
 			closeReason = "client"
+		case err == webtty.ErrSessionExpired:
+			closeReason = "expiry"
+		case err == errServerDraining:
+			closeReason = "shutdown"
+		case err == errSessionQuota, err == errRateQuota, err == errBandwidthQuota:
+			closeReason = fmt.Sprintf("quota (%s)", err)
+		case errors.Cause(err) == ErrForbidden:
+			closeReason = "authorization denied"
+		case err == webtty.ErrSessionTerminated:
+			closeReason = "an administrator"
+		case err == webtty.ErrIdleTimeout:
+			closeReason = "idle"
+		case err == webtty.ErrUnlockFailed:
+			closeReason = "failed unlocks"
+		case err == webtty.ErrGrantExpired:
+			closeReason = "grant expiry"
+		case err == webtty.ErrGrantRevoked:
+			closeReason = "grant revocation"
+		case err == webtty.ErrMasterTimeout:
+			closeReason = "client timeout"
 		default:
 			closeReason = fmt.Sprintf("an error: %s", err)
 		}
 	}
 }
 
-func (server *Server) processWSConn(ctx context.Context, conn *websocket.Conn, userAccount string, clusterId string) error {
+func (server *Server) processWSConn(ctx context.Context, conn *websocket.Conn, identity webtty.Identity, clusterId string, observe string, name string) (err error) {
+	// once running, the session tells its client why it closed
+	running := false
+	defer func() {
+		if !running {
+			sendSetupFailure(ctx, &wsWrapper{Conn: conn}, err)
+		}
+	}()
+
 	typ, initLine, err := conn.ReadMessage()
 	if err != nil {
-		return errors.Wrapf(err, "failed to authenticate websocket connection")
+		return withCloseCode(closeUnauthenticated, errors.Wrapf(err, "failed to authenticate websocket connection"))
 	}
 	if typ != websocket.TextMessage {
-		return errors.New("failed to authenticate websocket connection: invalid message type")
+		return withCloseCode(closeUnauthenticated, errors.New("failed to authenticate websocket connection: invalid message type"))
 	}
 
 	var init InitMessage
 	err = json.Unmarshal(initLine, &init)
 	if err != nil {
-		return errors.Wrapf(err, "failed to authenticate websocket connection")
+		return withCloseCode(closeUnauthenticated, errors.Wrapf(err, "failed to authenticate websocket connection"))
 	}
 	if init.AuthToken != server.options.Credential {
-		return errors.New("failed to authenticate websocket connection")
+		return withCloseCode(closeUnauthenticated, errors.New("failed to authenticate websocket connection"))
+	}
+	version, err := protocol.Negotiate(init.ProtocolVersion)
+	if err != nil {
+		return withCloseCode(closeUnsupportedProtocol, err)
+	}
+	if err := webtty.ValidateSessionTags(init.Tags); err != nil {
+		return withCloseCode(closeInvalidTags, err)
 	}
 
+	if init.Multiplex {
+		if !server.options.EnableMultiplex {
+			return withCloseCode(closeMultiplexDisabled, errors.New("multiplexing is not enabled"))
+		}
+		if !protocol.Supported(protocol.MasterToSlave, protocol.OpenChannel, version) {
+			return withCloseCode(closeUnsupportedProtocol, errors.Errorf("multiplexing requires a newer protocol than version %d", version))
+		}
+		if server.broadcast != nil || observe != "" || name != "" {
+			return errors.New("multiplexed connections can't watch, observe or attach to named sessions")
+		}
+		// each channel tells its client why its session closed
+		running = true
+		return server.serveChannels(ctx, conn, identity, clusterId, init)
+	}
+
+	if server.broadcast != nil {
+		if server.authorizer != nil {
+			err = server.authorizer.Authorize(ctx, &AuthorizationRequest{
+				Identity:  identity,
+				ClusterID: clusterId,
+				Backend:   server.factory.Name(),
+				Arguments: url.Values{},
+			})
+			if err != nil {
+				log.Printf("Terminal of %s to cluster `%s` not authorized: %s", identity.User, clusterId, err)
+				return err
+			}
+		}
+		log.Printf("Client %s watches the command, %d viewers", conn.RemoteAddr(), server.broadcast.Viewers()+1)
+		return server.broadcast.Watch(ctx, &wsWrapper{Conn: conn})
+	}
+
+	if observe != "" {
+		if !server.options.EnableSharing {
+			return errors.New("session sharing is not enabled")
+		}
+		log.Printf("Client %s observes session %s", conn.RemoteAddr(), observe)
+		// observers receive the frames encoded for the owner of the session
+		features, _ := server.sessions.Features(observe)
+		if features.BinaryFrames && !init.Features.BinaryFrames {
+			return errors.New("the observed session sends binary frames, which the client doesn't support")
+		}
+		return server.sessions.Observe(ctx, observe, &wsWrapper{Conn: conn, binary: features.BinaryFrames})
+	}
+
+	if server.options.EnableReattach && init.ReattachToken != "" {
+		reattached, err := server.reattachables.reattach(ctx, init.ReattachToken, conn, init.Features)
+		if reattached {
+			return err
+		}
+		log.Printf("Client %s reconnected after its session ended, starting a new one", conn.RemoteAddr())
+	}
+
+	var named *namedSession
+	if name != "" {
+		session, created, err := server.namedSessions.claim(name, identity.User, server.options.MaxNamedSessions)
+		if err != nil {
+			return err
+		}
+		if !created {
+			log.Printf("Client %s attaches to named session %s", conn.RemoteAddr(), name)
+			return server.attachNamedSession(ctx, session, identity, conn, init.Features)
+		}
+		// removed once the session ends, or fails to start
+		defer server.namedSessions.release(name, session)
+		named = session
+		log.Printf("Client %s starts named session %s", conn.RemoteAddr(), name)
+	}
+
+	binary := server.options.BinaryFrames && init.Features.BinaryFrames
+	running = true
+	return server.runSession(ctx, &wsWrapper{Conn: conn, binary: binary}, conn.RemoteAddr(), identity, clusterId, init, named, server.options.EnableReattach)
+}
+
+// runSession runs the session of master, connected from remoteAddr,
+// started with init. Its client is told why it failed to start, or why
+// it closed once running. The session is the named session named when
+// not nil, it's reattachable when reattach is set.
+func (server *Server) runSession(ctx context.Context, master webtty.Master, remoteAddr net.Addr, identity webtty.Identity, clusterId string, init InitMessage, named *namedSession, reattach bool) (err error) {
+	// once running, the session tells its client why it closed
+	running := false
+	defer func() {
+		if !running {
+			sendSetupFailure(ctx, master, err)
+		}
+	}()
+
 	queryPath := "?"
 	if server.options.PermitArguments && init.Arguments != "" {
 		queryPath = init.Arguments
@@ -163,10 +329,51 @@ func (server *Server) processWSConn(ctx context.Context, conn *websocket.Conn, u
 		return errors.Wrapf(err, "failed to parse arguments")
 	}
 	params := query.Query()
+	// the tags given in the URL of the page aren't arguments of the command
+	for key := range params {
+		if strings.HasPrefix(key, "tag.") {
+			params.Del(key)
+		}
+	}
+	if server.authorizer != nil {
+		err = server.authorizer.Authorize(ctx, &AuthorizationRequest{
+			Identity:  identity,
+			ClusterID: clusterId,
+			Backend:   server.factory.Name(),
+			Arguments: params,
+		})
+		if err != nil {
+			log.Printf("Terminal of %s to cluster `%s` not authorized: %s", identity.User, clusterId, err)
+			return err
+		}
+	}
+	var grant webtty.AccessGrant
+	if matchAny(server.options.GrantClusters, clusterId) {
+		var ok bool
+		grant, ok = server.grants.find(identity.User, clusterId, time.Now())
+		if !ok {
+			log.Printf("Terminal of %s to cluster `%s` refused: %s", identity.User, clusterId, errNoGrant)
+			return errNoGrant
+		}
+	}
+
+	quota, err := server.quotas.acquire(identity.User)
+	if err != nil {
+		log.Printf("Terminal of %s refused: %s", identity.User, err)
+		return err
+	}
+	defer quota.release()
+
 	var slave Slave
-	slave, err = server.factory.New(params)
+	if factory, ok := server.factory.(ClusterFactory); ok {
+		slave, err = factory.NewForCluster(identity, clusterId, params)
+	} else if factory, ok := server.factory.(IdentityFactory); ok {
+		slave, err = factory.NewFor(identity, params)
+	} else {
+		slave, err = server.factory.New(params)
+	}
 	if err != nil {
-		return errors.Wrapf(err, "failed to create backend")
+		return withCloseCode(closeSpawnFailed, errors.Wrapf(err, "failed to create backend"))
 	}
 	defer slave.Close()
 
@@ -175,7 +382,7 @@ func (server *Server) processWSConn(ctx context.Context, conn *websocket.Conn, u
 		map[string]map[string]interface{}{
 			"server": server.options.TitleVariables,
 			"master": map[string]interface{}{
-				"remote_addr": conn.RemoteAddr(),
+				"remote_addr": remoteAddr,
 			},
 			"slave": slave.WindowTitleVariables(),
 		},
@@ -188,30 +395,246 @@ func (server *Server) processWSConn(ctx context.Context, conn *websocket.Conn, u
 	}
 
 	opts := []webtty.Option{
+		webtty.WithCloseReason(),
 		webtty.WithWindowTitle(titleBuf.Bytes()),
+		webtty.WithClientFeatures(init.Features),
+		webtty.WithProtocolVersion(init.ProtocolVersion),
+		webtty.WithClientLocale(init.Locale),
 	}
 	if server.options.PermitWrite {
 		opts = append(opts, webtty.WithPermitWrite())
 	}
+	if server.options.BinaryFrames {
+		opts = append(opts, webtty.WithBinaryFrames())
+	}
+	if server.options.FlowControlWindow > 0 {
+		opts = append(opts, webtty.WithFlowControl(server.options.FlowControlWindow))
+	}
+	if server.options.EnableCompression {
+		opts = append(opts, webtty.WithCompression(server.options.CompressionMinSize))
+	}
+	if server.options.BufferSize > 0 {
+		opts = append(opts, webtty.WithBufferSize(server.options.BufferSize))
+	}
 	if server.options.EnableReconnect {
 		opts = append(opts, webtty.WithReconnect(server.options.ReconnectTime))
 	}
+	if idleTimeout := server.RuntimeConfig().IdleTimeout; idleTimeout > 0 {
+		opts = append(opts, webtty.WithIdleTimeout(time.Duration(idleTimeout)*time.Second))
+	}
+	if matchAny(server.options.ApprovalClusters, clusterId) {
+		opts = append(opts, webtty.WithApproval(time.Duration(server.options.ApprovalTimeout)*time.Second))
+	}
+	if grant.ID != "" {
+		opts = append(opts, webtty.WithAccessGrant(grant, time.Duration(server.options.GrantWarning)*time.Second))
+	}
+	if server.options.ScreenLockTimeout > 0 {
+		opts = append(opts, webtty.WithScreenLock(time.Duration(server.options.ScreenLockTimeout)*time.Second, server.unlockScreen))
+	}
+	if server.options.KeepAliveInterval > 0 {
+		opts = append(opts, webtty.WithKeepAlive(
+			time.Duration(server.options.KeepAliveInterval)*time.Second,
+			time.Duration(server.options.KeepAliveTimeout)*time.Second,
+		))
+	}
+	if server.options.LatencyProbe > 0 {
+		opts = append(opts, webtty.WithLatencyProbe(time.Duration(server.options.LatencyProbe)*time.Second))
+	}
+	if server.options.LocalEcho {
+		opts = append(opts, webtty.WithLocalEcho(time.Duration(server.options.LocalEchoThreshold)*time.Millisecond))
+	}
+	if server.options.MaxSessionDuration > 0 {
+		opts = append(opts, webtty.WithMaxSessionDuration(time.Duration(server.options.MaxSessionDuration)*time.Second))
+	}
 	if server.options.Width > 0 {
 		opts = append(opts, webtty.WithFixedColumns(server.options.Width))
 	}
 	if server.options.Height > 0 {
 		opts = append(opts, webtty.WithFixedRows(server.options.Height))
 	}
+	if server.options.EnableSessionAPI {
+		// the session API admins may unlock their own read-only sessions
+		opts = append(opts, webtty.WithWriteControl(server.isSessionAdmin))
+	}
+	sizeMode, err := webtty.ParseSizeMode(server.options.SizeMode)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, webtty.WithSizeMode(sizeMode))
+	if server.options.ResizeDebounce > 0 {
+		opts = append(opts, webtty.WithResizeDebounce(time.Duration(server.options.ResizeDebounce)*time.Millisecond))
+	}
 	if server.options.Preferences != nil {
 		opts = append(opts, webtty.WithMasterPreferences(server.options.Preferences))
 	}
+	auditJSON := server.options.AuditFormat == "json"
+	if server.options.AuditFile != "" || server.options.AuditURL != "" || server.options.AuditSyslog != "" {
+		opts = append(opts, webtty.WithWorkingDirTracking())
+	}
+	if server.options.AuditFile != "" && auditJSON {
+		opts = append(opts, webtty.WithAuditFileJSON())
+	}
+	if server.auditFile != nil {
+		opts = append(opts, webtty.WithSharedAuditFile(server.auditFile))
+	}
 
-	tty, err := webtty.New(&wsWrapper{conn}, slave, opts...)
+	var auditLoggers []webtty.AuditLogger
+	if server.options.RecordDir != "" || server.recordStorage != nil {
+		sessionID := randomstring.Generate(16)
+		var record io.Writer
+		if server.recordStorage != nil {
+			stored, err := server.spoolRecording(sessionID, identity.User, clusterId, server.options.RecordTranscript)
+			if err != nil {
+				return withCloseCode(closeAuditFailed, err)
+			}
+			defer func() {
+				if err := stored.store(); err != nil {
+					log.Printf("Failed to store the recording of session %s: %s", sessionID, err)
+				}
+			}()
+			if stored.transcript != nil {
+				auditLoggers = append(auditLoggers, stored)
+			}
+			record = stored.record
+		} else {
+			file, err := server.openRecording(sessionID, identity.User, clusterId)
+			if err != nil {
+				return withCloseCode(closeAuditFailed, err)
+			}
+			defer file.Close()
+			record = file
+		}
+		opts = append(opts, webtty.WithSessionID(sessionID), webtty.WithRecorder(record))
+		if server.options.RecordInput {
+			opts = append(opts, webtty.WithRecordInput())
+		}
+	}
+	if server.auditLogger != nil {
+		auditLoggers = append(auditLoggers, server.auditLogger)
+	}
+	if server.auditIndex != nil {
+		auditLoggers = append(auditLoggers, server.auditIndex)
+	}
+	if server.options.AuditSyslog != "" {
+		logger, err := webtty.NewSyslogAuditLogger(server.options.AuditSyslog, "gotty")
+		if err != nil {
+			return withCloseCode(closeAuditFailed, err)
+		}
+		defer logger.Close()
+		logger.JSON = auditJSON
+		auditLoggers = append(auditLoggers, logger)
+	}
+	if len(auditLoggers) > 0 {
+		opts = append(opts, webtty.WithAuditLogger(webtty.MultiAuditLogger(auditLoggers...)))
+	}
+
+	if server.options.EnableSharing {
+		// tells the master the ID to share, the bundled client ignores it
+		opts = append(opts, webtty.WithSessionInfoFrame())
+	}
+	if server.options.DLPMode != "off" {
+		opts = append(opts, webtty.WithOutputInspector(webtty.NewDLPInspector(server.options.DLPMode == "mask")))
+	}
+	if server.options.AuditLifecycle {
+		opts = append(opts, webtty.WithAuditLifecycle())
+	}
+	if server.auditRedact != nil {
+		opts = append(opts, webtty.WithAuditRedaction(server.auditRedact))
+	}
+	if server.runtime.policy() != nil {
+		opts = append(opts, webtty.WithInputFilter(server.checkCommand))
+	}
+	if server.alertNotifier != nil {
+		opts = append(opts, webtty.WithCommandAlerts(server.alertRules, server.alertNotifier))
+	}
+	var metrics webtty.Metrics
+	if server.metrics != nil {
+		metrics = server.metrics.session(identity.User, clusterId)
+	}
+	opts = append(opts, webtty.WithMetrics(quota.metrics(metrics)))
+
+	reattachToken := ""
+	if reattach || named != nil {
+		reattachToken = randomstring.Generate(32)
+		reattachTimeout := server.options.ReattachTimeout
+		if named != nil {
+			// abandoned named sessions are closed after their own timeout
+			reattachTimeout = server.options.NamedSessionTimeout
+		}
+		opts = append(opts,
+			webtty.WithReplayBuffer(server.options.ReplayBufferSize),
+			webtty.WithReattachTimeout(time.Duration(reattachTimeout)*time.Second),
+		)
+		if reattach {
+			opts = append(opts, webtty.WithReattachToken(reattachToken))
+		}
+	}
+
+	clipboardPolicy, err := webtty.ParseClipboardPolicy(server.options.ClipboardPolicy)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, webtty.WithClipboardPolicy(clipboardPolicy))
+	if server.options.SanitizeOutput {
+		classes := make([]webtty.EscapeClass, 0, len(server.options.SanitizeClasses))
+		for _, class := range server.options.SanitizeClasses {
+			classes = append(classes, webtty.EscapeClass(class))
+		}
+		opts = append(opts, webtty.WithOutputSanitizer(classes...))
+	}
+
+	if server.options.PermitUpload || server.options.PermitDownload {
+		dirs := make([]string, 0, len(server.options.FileTransferDirs))
+		for _, dir := range server.options.FileTransferDirs {
+			dirs = append(dirs, homedir.Expand(dir))
+		}
+		opts = append(opts, webtty.WithFileTransfer(webtty.FileTransferConfig{
+			AllowedPaths: dirs,
+			MaxSize:      int64(server.options.FileTransferMaxSize),
+			MaxTransfers: server.options.FileTransferMax,
+			Upload:       server.options.PermitUpload,
+			Download:     server.options.PermitDownload,
+		}))
+	}
+
+	tty, err := webtty.New(master, slave, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create webtty")
 	}
+	quota.run(tty.Terminate)
+
+	untrack, err := server.tracker.register(tty)
+	if err != nil {
+		return err
+	}
+	defer untrack()
 
-	err = tty.Run(ctx, userAccount, clusterId)
+	if server.options.EnableSharing {
+		unregister, err := server.sessions.Register(tty)
+		if err != nil {
+			return err
+		}
+		defer unregister()
+		log.Printf("Session %s shared by %s", tty.Session().SessionID, remoteAddr)
+	}
+	if reattachToken != "" {
+		defer server.reattachables.register(reattachToken, tty)()
+	}
+	if named != nil {
+		server.namedSessions.started(named, tty, reattachToken)
+	}
+
+	ctx = webtty.WithSessionContext(ctx, webtty.SessionInfo{
+		User:       identity.User,
+		ClusterID:  clusterId,
+		RemoteAddr: remoteAddr.String(),
+		Tags:       init.Tags,
+	})
+	running = true
+	err = tty.Run(webtty.WithIdentityContext(ctx, identity))
+	if err == webtty.ErrSessionTerminated && quota.closedByQuota() {
+		return errBandwidthQuota
+	}
 
 	return err
 }
@@ -1,6 +1,28 @@
 package server
 
+import (
+	"github.com/buptWYChen/gotty/webtty"
+)
+
 type InitMessage struct {
 	Arguments string `json:"Arguments,omitempty"`
 	AuthToken string `json:"AuthToken,omitempty"`
+
+	Features webtty.FeatureSet `json:"Features,omitempty"`
+	Locale   string            `json:"Locale,omitempty"`
+
+	// Token of the session to reattach to, given by SetReattachToken
+	ReattachToken string `json:"ReattachToken,omitempty"`
+
+	// Labels of the session recorded with its audit events,
+	// such as its team or the ticket it serves
+	Tags map[string]string `json:"Tags,omitempty"`
+
+	// Latest version of the protocol the client speaks,
+	// 0 for the clients predating the versions
+	ProtocolVersion int `json:"ProtocolVersion,omitempty"`
+
+	// Whether the connection carries several sessions, opened by the
+	// client on their channels, see webtty.Mux
+	Multiplex bool `json:"Multiplex,omitempty"`
 }
@@ -0,0 +1,307 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// latencyBuckets are the upper bounds in seconds of the buckets of the
+// handler latencies, those of the Prometheus clients.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// serverMetrics collects the metrics of the server and its sessions,
+// exposed in the text format of Prometheus.
+type serverMetrics struct {
+	// accessed atomically
+	activeSessions int64
+	sessions       uint64
+	reattaches     uint64
+	auditEvents    uint64
+	auditFailures  uint64
+	// output sent compressed, and its size once compressed
+	compressedBytes uint64
+	compressedSize  uint64
+
+	mutex     sync.Mutex
+	traffic   map[trafficLabels]*trafficCounters
+	latencies map[string]*latencyHistogram
+	// round-trip times to the clients
+	roundTrips *latencyHistogram
+	// sessions rejected by the user quotas, by limit
+	quotaRejections map[string]uint64
+	// requests rejected by the access control, by rule
+	accessRejections map[string]uint64
+}
+
+// trafficLabels are the labels of the traffic of the sessions.
+type trafficLabels struct {
+	user    string
+	cluster string
+}
+
+type trafficCounters struct {
+	// accessed atomically
+	in  uint64
+	out uint64
+}
+
+type latencyHistogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+func newServerMetrics() *serverMetrics {
+	return &serverMetrics{
+		traffic:    make(map[trafficLabels]*trafficCounters),
+		latencies:  make(map[string]*latencyHistogram),
+		roundTrips: newLatencyHistogram(),
+		quotaRejections: map[string]uint64{
+			quotaSessions:  0,
+			quotaRate:      0,
+			quotaBandwidth: 0,
+		},
+		accessRejections: map[string]uint64{
+			accessNetwork: 0,
+			accessCountry: 0,
+			accessRate:    0,
+		},
+	}
+}
+
+// session returns the metrics of a session of user to cluster.
+func (sm *serverMetrics) session(user string, cluster string) webtty.Metrics {
+	labels := trafficLabels{user: user, cluster: cluster}
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	traffic, ok := sm.traffic[labels]
+	if !ok {
+		traffic = &trafficCounters{}
+		sm.traffic[labels] = traffic
+	}
+	return &sessionMetrics{server: sm, traffic: traffic}
+}
+
+// ObserveAuditDelivery counts the audit entries the audit URL failed to
+// receive, as webtty.AuditDeliveryMetrics.
+func (sm *serverMetrics) ObserveAuditDelivery(status int, delivered bool) {
+	if !delivered {
+		atomic.AddUint64(&sm.auditFailures, 1)
+	}
+}
+
+func (sm *serverMetrics) observeQuotaRejection(limit string) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	sm.quotaRejections[limit]++
+}
+
+func (sm *serverMetrics) observeAccessRejection(rule string) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	sm.accessRejections[rule]++
+}
+
+func (sm *serverMetrics) observeLatency(handler string, d time.Duration) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	histogram, ok := sm.latencies[handler]
+	if !ok {
+		histogram = newLatencyHistogram()
+		sm.latencies[handler] = histogram
+	}
+	histogram.observe(d)
+}
+
+func (sm *serverMetrics) observeRoundTrip(rtt time.Duration) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	sm.roundTrips.observe(rtt)
+}
+
+func newLatencyHistogram() *latencyHistogram {
+	return &latencyHistogram{buckets: make([]uint64, len(latencyBuckets))}
+}
+
+func (histogram *latencyHistogram) observe(d time.Duration) {
+	seconds := d.Seconds()
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			histogram.buckets[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += seconds
+}
+
+func (sm *serverMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	out := bufio.NewWriter(w)
+	defer out.Flush()
+
+	writeMetric := func(name string, kind string, help string) {
+		fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+	writeMetric("gotty_sessions_active", "gauge", "Sessions running.")
+	fmt.Fprintf(out, "gotty_sessions_active %d\n", atomic.LoadInt64(&sm.activeSessions))
+	writeMetric("gotty_sessions_total", "counter", "Sessions started.")
+	fmt.Fprintf(out, "gotty_sessions_total %d\n", atomic.LoadUint64(&sm.sessions))
+	writeMetric("gotty_reconnects_total", "counter", "Clients reattached to their sessions.")
+	fmt.Fprintf(out, "gotty_reconnects_total %d\n", atomic.LoadUint64(&sm.reattaches))
+	writeMetric("gotty_audit_events_total", "counter", "Audit events emitted.")
+	fmt.Fprintf(out, "gotty_audit_events_total %d\n", atomic.LoadUint64(&sm.auditEvents))
+	writeMetric("gotty_audit_events_failed_total", "counter", "Audit events the audit loggers failed to take or deliver.")
+	fmt.Fprintf(out, "gotty_audit_events_failed_total %d\n", atomic.LoadUint64(&sm.auditFailures))
+	writeMetric("gotty_output_compressed_bytes_total", "counter", "Bytes of output sent compressed.")
+	fmt.Fprintf(out, "gotty_output_compressed_bytes_total %d\n", atomic.LoadUint64(&sm.compressedBytes))
+	writeMetric("gotty_output_compressed_size_bytes_total", "counter", "Bytes of the compressed output, the compression ratio is gotty_output_compressed_bytes_total divided by it.")
+	fmt.Fprintf(out, "gotty_output_compressed_size_bytes_total %d\n", atomic.LoadUint64(&sm.compressedSize))
+
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	labels := make([]trafficLabels, 0, len(sm.traffic))
+	for label := range sm.traffic {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].user != labels[j].user {
+			return labels[i].user < labels[j].user
+		}
+		return labels[i].cluster < labels[j].cluster
+	})
+	writeMetric("gotty_session_bytes_in_total", "counter", "Bytes of input forwarded to the commands.")
+	for _, label := range labels {
+		fmt.Fprintf(out, "gotty_session_bytes_in_total{user=%s,cluster=%s} %d\n",
+			labelValue(label.user), labelValue(label.cluster), atomic.LoadUint64(&sm.traffic[label].in))
+	}
+	writeMetric("gotty_session_bytes_out_total", "counter", "Bytes of output read from the commands.")
+	for _, label := range labels {
+		fmt.Fprintf(out, "gotty_session_bytes_out_total{user=%s,cluster=%s} %d\n",
+			labelValue(label.user), labelValue(label.cluster), atomic.LoadUint64(&sm.traffic[label].out))
+	}
+
+	limits := make([]string, 0, len(sm.quotaRejections))
+	for limit := range sm.quotaRejections {
+		limits = append(limits, limit)
+	}
+	sort.Strings(limits)
+	writeMetric("gotty_quota_rejections_total", "counter", "Sessions refused as their users reached a quota, by limit.")
+	for _, limit := range limits {
+		fmt.Fprintf(out, "gotty_quota_rejections_total{limit=%s} %d\n", labelValue(limit), sm.quotaRejections[limit])
+	}
+
+	rules := make([]string, 0, len(sm.accessRejections))
+	for rule := range sm.accessRejections {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	writeMetric("gotty_access_rejections_total", "counter", "Requests refused by the access control, by rule.")
+	for _, rule := range rules {
+		fmt.Fprintf(out, "gotty_access_rejections_total{rule=%s} %d\n", labelValue(rule), sm.accessRejections[rule])
+	}
+
+	handlers := make([]string, 0, len(sm.latencies))
+	for handler := range sm.latencies {
+		handlers = append(handlers, handler)
+	}
+	sort.Strings(handlers)
+	writeMetric("gotty_http_request_duration_seconds", "histogram", "Latency of the HTTP handlers, WebSocket connections aside.")
+	for _, handler := range handlers {
+		histogram := sm.latencies[handler]
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(out, "gotty_http_request_duration_seconds_bucket{handler=%s,le=\"%g\"} %d\n",
+				labelValue(handler), bound, histogram.buckets[i])
+		}
+		fmt.Fprintf(out, "gotty_http_request_duration_seconds_bucket{handler=%s,le=\"+Inf\"} %d\n", labelValue(handler), histogram.count)
+		fmt.Fprintf(out, "gotty_http_request_duration_seconds_sum{handler=%s} %g\n", labelValue(handler), histogram.sum)
+		fmt.Fprintf(out, "gotty_http_request_duration_seconds_count{handler=%s} %d\n", labelValue(handler), histogram.count)
+	}
+
+	writeMetric("gotty_client_round_trip_seconds", "histogram", "Round-trip times to the clients, measured with the pings of the server.")
+	for i, bound := range latencyBuckets {
+		fmt.Fprintf(out, "gotty_client_round_trip_seconds_bucket{le=\"%g\"} %d\n", bound, sm.roundTrips.buckets[i])
+	}
+	fmt.Fprintf(out, "gotty_client_round_trip_seconds_bucket{le=\"+Inf\"} %d\n", sm.roundTrips.count)
+	fmt.Fprintf(out, "gotty_client_round_trip_seconds_sum %g\n", sm.roundTrips.sum)
+	fmt.Fprintf(out, "gotty_client_round_trip_seconds_count %d\n", sm.roundTrips.count)
+}
+
+// labelValue quotes a label value of the text format.
+func labelValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// sessionMetrics reports the metrics of a session to the server metrics.
+type sessionMetrics struct {
+	webtty.NopMetrics
+
+	server  *serverMetrics
+	traffic *trafficCounters
+}
+
+func (sm *sessionMetrics) AddBytesToSlave(n int) {
+	atomic.AddUint64(&sm.traffic.in, uint64(n))
+}
+
+func (sm *sessionMetrics) AddBytesToMaster(n int) {
+	atomic.AddUint64(&sm.traffic.out, uint64(n))
+}
+
+func (sm *sessionMetrics) IncSession() {
+	atomic.AddInt64(&sm.server.activeSessions, 1)
+	atomic.AddUint64(&sm.server.sessions, 1)
+}
+
+func (sm *sessionMetrics) ObserveSessionDuration(d time.Duration) {
+	atomic.AddInt64(&sm.server.activeSessions, -1)
+}
+
+func (sm *sessionMetrics) IncReattach() {
+	atomic.AddUint64(&sm.server.reattaches, 1)
+}
+
+func (sm *sessionMetrics) ObserveAuditEvent(failed bool) {
+	atomic.AddUint64(&sm.server.auditEvents, 1)
+	if failed {
+		atomic.AddUint64(&sm.server.auditFailures, 1)
+	}
+}
+
+func (sm *sessionMetrics) ObserveCompression(plain int, compressed int) {
+	atomic.AddUint64(&sm.server.compressedBytes, uint64(plain))
+	atomic.AddUint64(&sm.server.compressedSize, uint64(compressed))
+}
+
+func (sm *sessionMetrics) ObserveRoundTripTime(rtt time.Duration) {
+	sm.server.observeRoundTrip(rtt)
+}
+
+// wrapMetrics measures the latency of the handlers of mux, labeled with
+// their patterns without pathPrefix, for the random URLs to stay secret.
+func (server *Server) wrapMetrics(handler http.Handler, mux *http.ServeMux, pathPrefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		handler.ServeHTTP(w, r)
+
+		_, pattern := mux.Handler(r)
+		label := "other"
+		if strings.HasPrefix(pattern, pathPrefix) {
+			label = "/" + strings.TrimPrefix(pattern, pathPrefix)
+		}
+		server.metrics.observeLatency(label, time.Since(start))
+	})
+}
@@ -1,10 +1,10 @@
 package server
 
 import (
-	"encoding/base64"
 	"log"
 	"net/http"
-	"strings"
+
+	"github.com/buptWYChen/gotty/webtty"
 )
 
 func (server *Server) wrapLogger(handler http.Handler) http.Handler {
@@ -23,29 +23,43 @@ func (server *Server) wrapHeaders(handler http.Handler) http.Handler {
 	})
 }
 
-func (server *Server) wrapBasicAuth(handler http.Handler, credential string) http.Handler {
+// wrapAuth serves the requests the authenticator of the server accepts
+// with the identity of their user in their context, see
+// webtty.IdentityFromContext. Requests without credentials are also served
+// when optional.
+func (server *Server) wrapAuth(handler http.Handler, optional bool) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
-
-		if len(token) != 2 || strings.ToLower(token[0]) != "basic" {
-			w.Header().Set("WWW-Authenticate", `Basic realm="GoTTY"`)
-			http.Error(w, "Bad Request", http.StatusUnauthorized)
+		identity, err := server.authenticator.Authenticate(r)
+		if err == ErrNoCredentials && optional {
+			handler.ServeHTTP(w, r)
 			return
 		}
 
-		payload, err := base64.StdEncoding.DecodeString(token[1])
 		if err != nil {
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-			return
-		}
-
-		if credential != string(payload) {
-			w.Header().Set("WWW-Authenticate", `Basic realm="GoTTY"`)
+			for _, challenge := range server.authChallenges {
+				w.Header().Add("WWW-Authenticate", challenge)
+			}
+			if err == ErrNoCredentials {
+				http.Error(w, "Bad Request", http.StatusUnauthorized)
+				return
+			}
+			log.Printf("Authentication failed: %s: %s", r.RemoteAddr, err)
 			http.Error(w, "authorization failed", http.StatusUnauthorized)
 			return
 		}
 
-		log.Printf("Basic Authentication Succeeded: %s", r.RemoteAddr)
-		handler.ServeHTTP(w, r)
+		if token := r.URL.Query().Get(bearerTokenParameter); token != "" {
+			// for the scripts and the connection of the page
+			http.SetCookie(w, &http.Cookie{
+				Name:     bearerTokenCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   server.options.EnableTLS,
+				SameSite: http.SameSiteStrictMode,
+			})
+		}
+		log.Printf("Authentication Succeeded: %s as %s (%s)", r.RemoteAddr, identity.User, identity.AuthMethod)
+		handler.ServeHTTP(w, r.WithContext(webtty.WithIdentityContext(r.Context(), identity)))
 	})
 }
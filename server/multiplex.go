@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
+// serveChannels runs the sessions the client of conn opens on the channels
+// of its connection, started with init, each as a connection of its own
+// would be, until the connection closes.
+func (server *Server) serveChannels(ctx context.Context, conn *websocket.Conn, identity webtty.Identity, clusterId string, init InitMessage) error {
+	mux, err := webtty.NewMux(&wsWrapper{Conn: conn}, server.options.MaxChannels)
+	if err != nil {
+		return err
+	}
+	log.Printf("Client %s multiplexes up to %d sessions", conn.RemoteAddr(), server.options.MaxChannels)
+
+	return mux.Serve(ctx, func(ctx context.Context, id protocol.ChannelID, payload []byte, master webtty.Master) error {
+		channelInit, err := parseChannelInit(init, payload)
+		if err != nil {
+			sendSetupFailure(ctx, master, err)
+			return err
+		}
+		log.Printf("Client %s opens channel %d, %d open", conn.RemoteAddr(), id, mux.Channels())
+		err = server.runSession(ctx, master, conn.RemoteAddr(), identity, clusterId, channelInit, nil, false)
+		log.Printf("Channel %d of client %s closed: %v", id, conn.RemoteAddr(), err)
+		return err
+	})
+}
+
+// parseChannelInit returns the init of the session of a channel, opened
+// with payload on a connection started with init. The arguments, the
+// features, the locale and the tags of the channel default to those of
+// the connection. The channels speak the protocol of the connection and
+// exchange text frames, they don't negotiate BinaryFrames.
+func parseChannelInit(init InitMessage, payload []byte) (InitMessage, error) {
+	channelInit := init
+	channelInit.Tags = nil
+	if err := json.Unmarshal(payload, &channelInit); err != nil {
+		return InitMessage{}, errors.Wrapf(err, "malformed init of channel")
+	}
+	if channelInit.Tags == nil {
+		channelInit.Tags = init.Tags
+	} else if err := webtty.ValidateSessionTags(channelInit.Tags); err != nil {
+		return InitMessage{}, withCloseCode(closeInvalidTags, err)
+	}
+	channelInit.ProtocolVersion = init.ProtocolVersion
+	channelInit.ReattachToken = ""
+	channelInit.Multiplex = false
+	channelInit.Features.BinaryFrames = false
+	return channelInit, nil
+}
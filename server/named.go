@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// namedSessionPath is the path of the named sessions under the path
+// prefix, followed by the name of a session.
+const namedSessionPath = "t/"
+
+var namedSessionPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+var (
+	// errTooManyNamedSessions is returned for a new named session
+	// once max_named_sessions are running.
+	errTooManyNamedSessions = errors.New("too many named sessions")
+	// errNamedSessionOwned is returned for a user attaching to the named
+	// session of another without session sharing.
+	errNamedSessionOwned = errors.New("named session is owned by another user")
+	// errNamedSessionFailed is returned for the connections waiting for
+	// a named session which failed to start.
+	errNamedSessionFailed = errors.New("named session failed to start")
+)
+
+// namedSessions keeps the running named sessions by their names.
+type namedSessions struct {
+	mutex    sync.Mutex
+	sessions map[string]*namedSession
+}
+
+type namedSession struct {
+	// user who started the session, the only one who can attach to it
+	owner string
+	// closed once tty is set, or left nil when the session failed to start
+	ready chan struct{}
+	tty   *webtty.WebTTY
+	// token of the session in the reattach registry
+	token string
+}
+
+func newNamedSessions() *namedSessions {
+	return &namedSessions{sessions: make(map[string]*namedSession)}
+}
+
+// claim returns the session of name, and whether it was created for owner
+// to start it with started and to remove it with release once it ended.
+// It fails with errTooManyNamedSessions when max sessions are running,
+// unless max is 0.
+func (ns *namedSessions) claim(name string, owner string, max int) (*namedSession, bool, error) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+
+	if session, ok := ns.sessions[name]; ok {
+		return session, false, nil
+	}
+	if max > 0 && len(ns.sessions) >= max {
+		return nil, false, errTooManyNamedSessions
+	}
+	session := &namedSession{owner: owner, ready: make(chan struct{})}
+	ns.sessions[name] = session
+	return session, true, nil
+}
+
+// started makes the claimed session attachable through tty,
+// registered in the reattach registry with token.
+func (ns *namedSessions) started(session *namedSession, tty *webtty.WebTTY, token string) {
+	session.tty, session.token = tty, token
+	close(session.ready)
+}
+
+// release removes the session of name, failing the connections waiting
+// for it when it didn't start.
+func (ns *namedSessions) release(name string, session *namedSession) {
+	ns.mutex.Lock()
+	if ns.sessions[name] == session {
+		delete(ns.sessions, name)
+	}
+	ns.mutex.Unlock()
+
+	select {
+	case <-session.ready:
+	default:
+		close(session.ready)
+	}
+}
+
+type namedSessionContextKey struct{}
+
+// wrapNamedSessions serves the requests to pathPrefix/t/<name>/ with
+// handler as if they were to pathPrefix, the name of the session in their
+// context, so that the page of a named session loads like the others.
+func (server *Server) wrapNamedSessions(handler http.Handler, pathPrefix string) http.Handler {
+	prefix := pathPrefix + namedSessionPath
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		rest := strings.TrimPrefix(r.URL.Path, prefix)
+		name := rest
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			name, rest = rest[:i], rest[i+1:]
+		} else {
+			rest = ""
+		}
+		if !namedSessionPattern.MatchString(name) {
+			http.NotFound(w, r)
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, prefix+name+"/") {
+			// the page loads its scripts and connects relatively to its URL
+			target := prefix + name + "/"
+			if r.URL.RawQuery != "" {
+				target += "?" + r.URL.RawQuery
+			}
+			http.Redirect(w, r, target, http.StatusFound)
+			return
+		}
+
+		named := r.WithContext(context.WithValue(r.Context(), namedSessionContextKey{}, name))
+		named.URL.Path = pathPrefix + rest
+		named.URL.RawPath = ""
+		handler.ServeHTTP(w, named)
+	})
+}
+
+// namedSessionFromContext returns the name of the session requested
+// at pathPrefix/t/<name>/, if any.
+func namedSessionFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(namedSessionContextKey{}).(string)
+	return name
+}
+
+// attachNamedSession attaches conn to the running named session, as its
+// master for its owner, who takes it over from any previous connection,
+// or as an observer for the other users when sharing is enabled.
+// It blocks until the session ends or conn is detached from it.
+func (server *Server) attachNamedSession(ctx context.Context, session *namedSession, identity webtty.Identity, conn *websocket.Conn, clientFeatures webtty.FeatureSet) error {
+	select {
+	case <-session.ready:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if session.tty == nil {
+		return errNamedSessionFailed
+	}
+
+	if identity.User != session.owner {
+		if !server.options.EnableSharing {
+			return errNamedSessionOwned
+		}
+		features := session.tty.NegotiatedFeatures()
+		if features.BinaryFrames && !clientFeatures.BinaryFrames {
+			return errors.New("the named session sends binary frames, which the client doesn't support")
+		}
+		return server.sessions.Observe(ctx, session.tty.Session().SessionID, &wsWrapper{Conn: conn, binary: features.BinaryFrames})
+	}
+
+	reattached, err := server.reattachables.reattach(ctx, session.token, conn, clientFeatures)
+	if !reattached {
+		// the session ended meanwhile
+		return webtty.ErrSessionNotFound
+	}
+	return err
+}
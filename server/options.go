@@ -1,7 +1,13 @@
 package server
 
 import (
+	"path"
+	"time"
+
 	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+	"github.com/buptWYChen/gotty/webtty/storage"
 )
 
 type Options struct {
@@ -10,6 +16,13 @@ type Options struct {
 	PermitWrite         bool             `hcl:"permit_write" flagName:"permit-write" flagSName:"w" flagDescribe:"Permit clients to write to the TTY (BE CAREFUL)" default:"false"`
 	EnableBasicAuth     bool             `hcl:"enable_basic_auth" default:"false"`
 	Credential          string           `hcl:"credential" flagName:"credential" flagSName:"c" flagDescribe:"Credential for Basic Authentication (ex: user:pass, default disabled)" default:""`
+	JWTKeyFile          string           `hcl:"jwt_key_file" flagName:"jwt-key-file" flagDescribe:"Public key in PEM or HMAC secret file to validate the JWT bearer tokens of clients with (default disabled)" default:""`
+	JWTIssuer           string           `hcl:"jwt_issuer" flagName:"jwt-issuer" flagDescribe:"Issuer of the JWT bearer tokens accepted (default any)" default:""`
+	JWTAllowNoExpiry    bool             `hcl:"jwt_allow_no_expiry" flagName:"jwt-allow-no-expiry" flagDescribe:"Accept the JWT bearer tokens without an expiry (BE CAREFUL)" default:"false"`
+	OIDCIssuer          string           `hcl:"oidc_issuer" flagName:"oidc-issuer" flagDescribe:"OpenID Connect issuer URL to validate the bearer tokens of clients with (default disabled)" default:""`
+	TokenAudience       string           `hcl:"token_audience" flagName:"token-audience" flagDescribe:"Audience the bearer tokens must have, such as the OpenID Connect client ID (default any)" default:""`
+	TokenUserClaim      string           `hcl:"token_user_claim" flagName:"token-user-claim" flagDescribe:"Claim of the bearer tokens naming the user" default:"sub"`
+	TokenGroupsClaim    string           `hcl:"token_groups_claim" flagName:"token-groups-claim" flagDescribe:"Claim of the bearer tokens listing the groups of the user" default:"groups"`
 	EnableRandomUrl     bool             `hcl:"enable_random_url" flagName:"random-url" flagSName:"r" flagDescribe:"Add a random string to the URL" default:"false"`
 	RandomUrlLength     int              `hcl:"random_url_length" flagName:"random-url-length" flagDescribe:"Random URL length" default:"8"`
 	EnableTLS           bool             `hcl:"enable_tls" flagName:"tls" flagSName:"t" flagDescribe:"Enable TLS/SSL" default:"false"`
@@ -21,23 +34,273 @@ type Options struct {
 	TitleFormat         string           `hcl:"title_format" flagName:"title-format" flagSName:"" flagDescribe:"Title format of browser window" default:"{{ .command }}@{{ .hostname }}"`
 	EnableReconnect     bool             `hcl:"enable_reconnect" flagName:"reconnect" flagDescribe:"Enable reconnection" default:"false"`
 	ReconnectTime       int              `hcl:"reconnect_time" flagName:"reconnect-time" flagDescribe:"Time to reconnect" default:"10"`
+	EnableReattach      bool             `hcl:"enable_reattach" flagName:"reattach" flagDescribe:"Keep the command of a disconnected client running for it to reconnect to (requires --reconnect)" default:"false"`
+	ReattachTimeout     int              `hcl:"reattach_timeout" flagName:"reattach-timeout" flagDescribe:"Seconds a disconnected session waits for its client to reconnect" default:"60"`
+	ReplayBufferSize    int              `hcl:"replay_buffer_size" flagName:"replay-buffer-size" flagDescribe:"Bytes of output replayed to a client reconnecting to its session" default:"65536"`
+	EnableNamedSessions bool             `hcl:"enable_named_sessions" flagName:"named-sessions" flagDescribe:"Serve persistent sessions by name at <path>/t/<name>/, which the later connections of their users attach to" default:"false"`
+	NamedSessionTimeout int              `hcl:"named_session_timeout" flagName:"named-session-timeout" flagDescribe:"Seconds a named session without connection keeps running before it is closed" default:"3600"`
+	MaxNamedSessions    int              `hcl:"max_named_sessions" flagName:"max-named-sessions" flagDescribe:"Maximum named sessions running at once (0 for no limit)" default:"0"`
+	EnableMultiplex     bool             `hcl:"enable_multiplex" flagName:"multiplex" flagDescribe:"Let a client run several terminals, such as tabs or panes, over one connection" default:"false"`
+	MaxChannels         int              `hcl:"max_channels" flagName:"max-channels" flagDescribe:"Maximum terminals a client runs over one connection with --multiplex" default:"16"`
 	MaxConnection       int              `hcl:"max_connection" flagName:"max-connection" flagDescribe:"Maximum connection to gotty" default:"0"`
+	MaxUserSessions     int              `hcl:"max_user_sessions" flagName:"max-user-sessions" flagDescribe:"Maximum sessions each user may run at once (0 for no limit)" default:"0"`
+	UserSessionsPerHour int              `hcl:"user_sessions_per_hour" flagName:"user-sessions-per-hour" flagDescribe:"Maximum sessions each user may start per hour (0 for no limit)" default:"0"`
+	UserDailyBandwidth  int              `hcl:"user_daily_bandwidth" flagName:"user-daily-bandwidth" flagDescribe:"Bytes of input and output each user may exchange per day, the sessions of the users exceeding it are closed (0 for no limit)" default:"0"`
+	Watch               bool             `hcl:"watch" flagName:"watch" flagDescribe:"Run the command once and broadcast its output to every client, read only" default:"false"`
+	WatchQueueSize      int              `hcl:"watch_queue_size" flagName:"watch-queue-size" flagDescribe:"Frames queued for each client of --watch, the clients falling further behind are disconnected" default:"256"`
 	Once                bool             `hcl:"once" flagName:"once" flagDescribe:"Accept only one client and exit on disconnection" default:"false"`
 	Timeout             int              `hcl:"timeout" flagName:"timeout" flagDescribe:"Timeout seconds for waiting a client(0 to disable)" default:"0"`
+	IdleTimeout         int              `hcl:"idle_timeout" flagName:"idle-timeout" flagDescribe:"Seconds without input to close a session after (0 to disable)" default:"0"`
+	ScreenLockTimeout   int              `hcl:"screen_lock_timeout" flagName:"screen-lock-timeout" flagDescribe:"Seconds without input to lock the screen of a session after, until its user authenticates again (0 to disable)" default:"0"`
+	ApprovalTimeout     int              `hcl:"approval_timeout" flagName:"approval-timeout" flagDescribe:"Seconds the terminals of approval_clusters wait for another user to approve them before they're closed" default:"300"`
+	ApprovalClusters    []string         `hcl:"approval_clusters"`
+	GrantClusters       []string         `hcl:"grant_clusters"`
+	GrantWarning        int              `hcl:"grant_warning" flagName:"grant-warning" flagDescribe:"Seconds before the access grant of a terminal of grant_clusters expires to warn its user" default:"300"`
+	MaxGrantDuration    int              `hcl:"max_grant_duration" flagName:"max-grant-duration" flagDescribe:"Maximum seconds an access grant issued with the grant API lasts" default:"28800"`
+	MaxSessionDuration  int              `hcl:"max_session_duration" flagName:"max-session-duration" flagDescribe:"Seconds to close a session after, whatever its activity (0 to disable)" default:"0"`
+	DrainTimeout        int              `hcl:"drain_timeout" flagName:"drain-timeout" flagDescribe:"Seconds the sessions are given to end on SIGTERM once their users are told the server shuts down (0 to close them at once)" default:"0"`
+	KeepAliveInterval   int              `hcl:"keepalive_interval" flagName:"keepalive-interval" flagDescribe:"Seconds between the pings the server sends to check the client is alive (0 to disable)" default:"0"`
+	KeepAliveTimeout    int              `hcl:"keepalive_timeout" flagName:"keepalive-timeout" flagDescribe:"Seconds to wait for the client to answer a ping before closing its connection" default:"10"`
+	LatencyProbe        int              `hcl:"latency_probe_interval" flagName:"latency-probe-interval" flagDescribe:"Seconds between the pings measuring the round-trip time to the client (0 to disable)" default:"0"`
+	LocalEcho           bool             `hcl:"enable_local_echo" flagName:"local-echo" flagDescribe:"Echo the typed characters at once on high-latency links, before the command echoes them" default:"false"`
+	LocalEchoThreshold  int              `hcl:"local_echo_threshold" flagName:"local-echo-threshold" flagDescribe:"Smoothed round-trip time in milliseconds from which the typed characters are echoed locally (0 to always echo them)" default:"150"`
 	PermitArguments     bool             `hcl:"permit_arguments" flagName:"permit-arguments" flagDescribe:"Permit clients to send command line arguments in URL (e.g. http://example.com:8080/?arg=AAA&arg=BBB)" default:"true"`
 	Preferences         *HtermPrefernces `hcl:"preferences"`
 	Width               int              `hcl:"width" flagName:"width" flagDescribe:"Static width of the screen, 0(default) means dynamically resize" default:"0"`
 	Height              int              `hcl:"height" flagName:"height" flagDescribe:"Static height of the screen, 0(default) means dynamically resize" default:"0"`
+	SizeMode            string           `hcl:"size_mode" flagName:"size-mode" flagDescribe:"How the static width and height apply to the size of the clients: fixed, initial or maximum" default:"fixed"`
+	ResizeDebounce      int              `hcl:"resize_debounce" flagName:"resize-debounce" flagDescribe:"Milliseconds the resizes of a client apply at most once per while its window is dragged (0 to apply each at once)" default:"100"`
 	WSOrigin            string           `hcl:"ws_origin" flagName:"ws-origin" flagDescribe:"A regular expression that matches origin URLs to be accepted by WebSocket. No cross origin requests are acceptable by default" default:""`
 	Term                string           `hcl:"term" flagName:"term" flagDescribe:"Terminal name to use on the browser, one of xterm or hterm." default:"xterm"`
+	AuditFormat         string           `hcl:"audit_format" flagName:"audit-format" flagDescribe:"Format of the audit entries, text or json" default:"text"`
+	AuditFile           string           `hcl:"audit_file" flagName:"audit-file" flagDescribe:"Local file to write the audit trail to (default disabled)" default:""`
+	AuditFileMaxSize    int              `hcl:"audit_file_max_size" flagName:"audit-file-max-size" flagDescribe:"Size in bytes to rotate the audit file at (0 to disable)" default:"0"`
+	AuditFileMaxAge     int              `hcl:"audit_file_max_age" flagName:"audit-file-max-age" flagDescribe:"Age in seconds to rotate the audit file at (0 to disable)" default:"0"`
+	AuditFileCompress   bool             `hcl:"audit_file_compress" flagName:"audit-file-compress" flagDescribe:"Gzip the rotated audit files" default:"false"`
+	AuditFileMaxBackups int              `hcl:"audit_file_max_backups" flagName:"audit-file-max-backups" flagDescribe:"Most rotated audit files to keep, the oldest are removed first (0 to keep them all)" default:"0"`
+	AuditFileRetention  int              `hcl:"audit_file_retention" flagName:"audit-file-retention" flagDescribe:"Seconds to keep the rotated audit files for (0 to keep them)" default:"0"`
+	AuditURL            string           `hcl:"audit_url" flagName:"audit-url" flagDescribe:"HTTP endpoint to send the audit trail to with GET, the escaped entry is appended to it (default disabled)" default:""`
+	AuditMethod         string           `hcl:"audit_method" flagName:"audit-method" flagDescribe:"HTTP method of the audit requests, entries are sent as the body unless GET" default:"GET"`
+	AuditTimeout        int              `hcl:"audit_timeout" flagName:"audit-timeout" flagDescribe:"Seconds the audit URL may take to answer each request" default:"10"`
+	AuditCACrtFile      string           `hcl:"audit_ca_crt_file" flagName:"audit-ca-crt" flagDescribe:"CA certificate file verifying the audit URL over HTTPS (default the CAs of the system)" default:""`
+	AuditClientCrtFile  string           `hcl:"audit_client_crt_file" flagName:"audit-client-crt" flagDescribe:"TLS/SSL certificate file presented to the audit URL (default none)" default:""`
+	AuditClientKeyFile  string           `hcl:"audit_client_key_file" flagName:"audit-client-key" flagDescribe:"TLS/SSL key file of the certificate presented to the audit URL" default:""`
+	AuditProxy          string           `hcl:"audit_proxy" flagName:"audit-proxy" flagDescribe:"Proxy URL of the audit requests (default from HTTPS_PROXY and HTTP_PROXY)" default:""`
+	AuditIndexSize      int              `hcl:"audit_index_size" flagName:"audit-index-size" flagDescribe:"Latest audit events kept in memory to be searched at /api/audit by the session API admins (0 to disable)" default:"0"`
+	AuditQueueSize      int              `hcl:"audit_queue_size" flagName:"audit-queue-size" flagDescribe:"Audit entries waiting to be sent to the audit URL in the background" default:"1024"`
+	AuditSpillFile      string           `hcl:"audit_spill_file" flagName:"audit-spill-file" flagDescribe:"Local file keeping the audit entries the audit URL failed to receive until it's back (default disabled)" default:""`
+	AuditSyslog         string           `hcl:"audit_syslog" flagName:"audit-syslog" flagDescribe:"Syslog daemon to send the audit trail to, local or an address such as udp://host:514 (default disabled)" default:""`
+	AuditRedact         bool             `hcl:"audit_redact" flagName:"audit-redact" flagDescribe:"Mask the passwords, AWS keys, bearer tokens and base64 blobs of the audited commands" default:"false"`
+	AuditRedactPatterns []string         `hcl:"audit_redact_patterns"`
+	AuditLifecycle      bool             `hcl:"audit_lifecycle" flagName:"audit-lifecycle" flagDescribe:"Record the start, disconnections, reconnections, permission changes, terminations and end of the sessions in the audit trail" default:"false"`
+	DLPMode             string           `hcl:"dlp_mode" flagName:"dlp-mode" flagDescribe:"Scan the output of the command for card numbers and private keys: off, flag to record them in the audit trail or mask to also hide them" default:"off"`
+	EnableSharing       bool             `hcl:"enable_sharing" flagName:"enable-sharing" flagDescribe:"Let clients watch the sessions of others read-only by adding observe=<session ID> to the URL" default:"false"`
+	RecordDir           string           `hcl:"record_dir" flagName:"record-dir" flagDescribe:"Directory to record each session to as an asciicast file (default disabled)" default:""`
+	RecordFileName      string           `hcl:"record_file_name" flagName:"record-file-name" flagDescribe:"File name format of the session recordings" default:"{{ .time }}-{{ .session_id }}.cast"`
+	RecordInput         bool             `hcl:"record_input" flagName:"record-input" flagDescribe:"Record the keystrokes of the client, including typed passwords" default:"false"`
+	RecordStorage       string           `hcl:"record_storage" flagName:"record-storage" flagDescribe:"Storage to record each session to instead of record_dir, such as s3://<bucket>/<prefix>, postgres://<host>/<database> or file://<dir> (default disabled)" default:""`
+	RecordTranscript    bool             `hcl:"record_transcript" flagName:"record-transcript" flagDescribe:"Store the audit events of each session as JSON lines next to its recording in record_storage" default:"false"`
+	CommandAllow        []string         `hcl:"command_allow"`
+	CommandDeny         []string         `hcl:"command_deny"`
+	CommandAlertWebhook string           `hcl:"command_alert_webhook" flagName:"command-alert-webhook" flagDescribe:"HTTP endpoint the high-risk commands are posted to as soon as they're typed (default disabled)" default:""`
+	CommandAlertFormat  string           `hcl:"command_alert_format" flagName:"command-alert-format" flagDescribe:"Payload of the command alerts: json, slack or dingtalk" default:"json"`
+	CommandAlert        []string         `hcl:"command_alert"`
+	CommandAlertBody    string           `hcl:"command_alert_body"`
+	BinaryFrames        bool             `hcl:"binary_frames" flagName:"binary-frames" flagDescribe:"Send the output as binary WebSocket messages to clients supporting them, instead of base64 text" default:"false"`
+	FlowControlWindow   int              `hcl:"flow_control_window" flagName:"flow-control-window" flagDescribe:"Bytes of output a client may have unprocessed before the command is paused (0 to disable)" default:"0"`
+	EnableCompression   bool             `hcl:"enable_compression" flagName:"compression" flagDescribe:"Compress the output sent to clients supporting it with gzip" default:"false"`
+	CompressionMinSize  int              `hcl:"compression_min_size" flagName:"compression-min-size" flagDescribe:"Bytes of output up to which it is sent uncompressed" default:"256"`
+	BufferSize          int              `hcl:"buffer_size" flagName:"buffer-size" flagDescribe:"Bytes of output read from the command at once and sent in a single message, larger values suit commands printing fast" default:"1024"`
+	ClipboardPolicy     string           `hcl:"clipboard_policy" flagName:"clipboard-policy" flagDescribe:"How OSC 52 clipboard sequences from the command are handled: strip, forward or passthrough" default:"strip"`
+	SanitizeOutput      bool             `hcl:"sanitize_output" flagName:"sanitize-output" flagDescribe:"Remove the escape sequences exploiting terminals, such as title reports, from the output of the command" default:"false"`
+	SanitizeClasses     []string         `hcl:"sanitize_classes"`
+	PermitUpload        bool             `hcl:"permit_upload" flagName:"permit-upload" flagDescribe:"Permit clients allowed to write to upload files to the file transfer directories" default:"false"`
+	PermitDownload      bool             `hcl:"permit_download" flagName:"permit-download" flagDescribe:"Permit clients to download files from the file transfer directories" default:"false"`
+	FileTransferMaxSize int              `hcl:"file_transfer_max_size" flagName:"file-transfer-max-size" flagDescribe:"Largest file in bytes clients may upload or download" default:"10485760"`
+	FileTransferDirs    []string         `hcl:"file_transfer_dirs"`
+	FileTransferMax     int              `hcl:"file_transfer_max" flagName:"file-transfer-max" flagDescribe:"Most file uploads and downloads in progress at once in a session" default:"4"`
+	ConfigReload        bool             `hcl:"config_reload" flagName:"config-reload" flagDescribe:"Reload the runtime settings, such as the audit URL, the command lists, the rate limits and the idle timeout, when the config file changes or on SIGHUP" default:"false"`
+	EnableSessionAPI    bool             `hcl:"enable_session_api" flagName:"session-api" flagDescribe:"Let the session API admins list and kill the sessions at /api/sessions" default:"false"`
+	APIAdmins           []string         `hcl:"session_api_admins"`
+	APIAdminGroups      []string         `hcl:"session_api_admin_groups"`
+	AuthzPolicyFile     string           `hcl:"authz_policy_file" flagName:"authz-policy-file" flagDescribe:"YAML policy file deciding which users may open terminals to which clusters (default disabled)" default:""`
+	EnableMetrics       bool             `hcl:"enable_metrics" flagName:"metrics" flagDescribe:"Expose Prometheus metrics of the sessions and the traffic at /metrics" default:"false"`
+	AuthzWebhook        string           `hcl:"authz_webhook" flagName:"authz-webhook" flagDescribe:"HTTP endpoint deciding which users may open terminals, the requests are posted to it as JSON (default disabled)" default:""`
+	AllowNetworks       []string         `hcl:"allow_networks"`
+	DenyNetworks        []string         `hcl:"deny_networks"`
+	GeoIPDatabase       string           `hcl:"geoip_database" flagName:"geoip-database" flagDescribe:"CSV file of <CIDR>,<country> lines locating the clients for allow_countries and deny_countries (default disabled)" default:""`
+	AllowCountries      []string         `hcl:"allow_countries"`
+	DenyCountries       []string         `hcl:"deny_countries"`
+	ConnectionRate      int              `hcl:"connection_rate" flagName:"connection-rate" flagDescribe:"WebSocket connections each client address may open per minute (0 for no limit)" default:"0"`
+	// Headers of the audit requests, such as an Authorization header
+	AuditHeaders map[string]string `hcl:"audit_headers"`
 
 	TitleVariables map[string]interface{}
+	// Config file the options were loaded from, reloaded with ConfigReload
+	ConfigFile string
+	// Authenticator of the requests, tried before the configured ones
+	Authenticator Authenticator
+	// Authorizer of the terminals, required to allow them on top of the configured ones
+	Authorizer Authorizer
+	// Country of the clients, tried before the GeoIP database
+	CountryLookup CountryLookup
+	// Storage of the recordings, used instead of RecordStorage
+	Storage storage.Storage
 }
 
 func (options *Options) Validate() error {
 	if options.EnableTLSClientAuth && !options.EnableTLS {
 		return errors.New("TLS client authentication is enabled, but TLS is not enabled")
 	}
+	if options.EnableReattach {
+		if !options.EnableReconnect {
+			return errors.New("reattaching sessions requires reconnection to be enabled")
+		}
+		if options.ReattachTimeout <= 0 || options.ReplayBufferSize <= 0 {
+			return errors.New("reattach timeout and replay buffer size must be positive")
+		}
+	}
+	if options.EnableNamedSessions {
+		if options.NamedSessionTimeout <= 0 || options.ReplayBufferSize <= 0 {
+			return errors.New("named session timeout and replay buffer size must be positive")
+		}
+		if options.MaxNamedSessions < 0 {
+			return errors.New("max named sessions must not be negative")
+		}
+	}
+	if options.EnableMultiplex && options.MaxChannels <= 0 {
+		return errors.New("max channels must be positive")
+	}
+	if options.Watch {
+		if options.WatchQueueSize <= 0 || options.ReplayBufferSize <= 0 {
+			return errors.New("watch queue size and replay buffer size must be positive")
+		}
+		if options.PermitWrite || options.EnableReattach || options.EnableNamedSessions {
+			return errors.New("watch mode is read only, and can't be used with write permission, reattach or named sessions")
+		}
+	}
+	if options.AuditIndexSize < 0 {
+		return errors.New("audit index size must not be negative")
+	}
+	if options.AuditTimeout < 0 {
+		return errors.New("audit timeout must not be negative")
+	}
+	if (options.AuditClientCrtFile == "") != (options.AuditClientKeyFile == "") {
+		return errors.New("audit client certificate and key must be given together")
+	}
+	if options.ConnectionRate < 0 {
+		return errors.New("connection rate must not be negative")
+	}
+	if options.MaxUserSessions < 0 || options.UserSessionsPerHour < 0 || options.UserDailyBandwidth < 0 {
+		return errors.New("user quotas must not be negative")
+	}
+	if options.IdleTimeout < 0 {
+		return errors.New("idle timeout must not be negative")
+	}
+	if options.ScreenLockTimeout < 0 {
+		return errors.New("screen lock timeout must not be negative")
+	}
+	if options.ScreenLockTimeout > 0 && options.IdleTimeout > 0 && options.ScreenLockTimeout >= options.IdleTimeout {
+		return errors.New("screen lock timeout must be shorter than the idle timeout")
+	}
+	if len(options.ApprovalClusters) > 0 {
+		for _, pattern := range options.ApprovalClusters {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return errors.Wrapf(err, "invalid pattern `%s` in approval_clusters", pattern)
+			}
+		}
+		if !options.EnableSessionAPI {
+			return errors.New("approval_clusters requires the session API to be enabled to approve the sessions")
+		}
+		if options.ApprovalTimeout <= 0 {
+			return errors.New("approval timeout must be positive")
+		}
+		if options.IdleTimeout > 0 && options.ApprovalTimeout >= options.IdleTimeout {
+			return errors.New("approval timeout must be shorter than the idle timeout")
+		}
+	}
+	if len(options.GrantClusters) > 0 {
+		for _, pattern := range options.GrantClusters {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return errors.Wrapf(err, "invalid pattern `%s` in grant_clusters", pattern)
+			}
+		}
+		if !options.EnableSessionAPI {
+			return errors.New("grant_clusters requires the session API to be enabled to issue the grants")
+		}
+	}
+	if options.GrantWarning < 0 {
+		return errors.New("grant warning must not be negative")
+	}
+	if options.MaxGrantDuration <= 0 {
+		return errors.New("max grant duration must be positive")
+	}
+	if options.KeepAliveInterval > 0 && options.KeepAliveTimeout <= 0 {
+		return errors.New("keepalive timeout must be positive")
+	}
+	if options.LatencyProbe < 0 {
+		return errors.New("latency probe interval must not be negative")
+	}
+	if options.LocalEchoThreshold < 0 {
+		return errors.New("local echo threshold must not be negative")
+	}
+	if options.LocalEcho && options.LocalEchoThreshold > 0 && options.LatencyProbe == 0 && options.KeepAliveInterval == 0 {
+		return errors.New("local echo threshold requires latency_probe_interval or keepalive_interval to be set")
+	}
+	if options.FlowControlWindow < 0 {
+		return errors.New("flow control window must not be negative")
+	}
+	if options.CompressionMinSize < 0 {
+		return errors.New("compression min size must not be negative")
+	}
+	if options.BufferSize < 0 {
+		return errors.New("buffer size must not be negative")
+	}
+	if options.AuditFileMaxBackups < 0 || options.AuditFileRetention < 0 {
+		return errors.New("audit file retention must not be negative")
+	}
+	if options.AuditFormat != "text" && options.AuditFormat != "json" {
+		return errors.Errorf("unknown audit format `%s`", options.AuditFormat)
+	}
+	if _, err := webtty.ParseClipboardPolicy(options.ClipboardPolicy); err != nil {
+		return err
+	}
+	for _, class := range options.SanitizeClasses {
+		if _, err := webtty.ParseEscapeClass(class); err != nil {
+			return err
+		}
+	}
+	if _, err := webtty.ParseSizeMode(options.SizeMode); err != nil {
+		return err
+	}
+	if options.DLPMode != "off" && options.DLPMode != "flag" && options.DLPMode != "mask" {
+		return errors.Errorf("unknown DLP mode `%s`", options.DLPMode)
+	}
+	if (options.PermitUpload || options.PermitDownload) && len(options.FileTransferDirs) == 0 {
+		return errors.New("file transfers require file_transfer_dirs to be set")
+	}
+	if options.EnableSessionAPI && len(options.APIAdmins) == 0 && len(options.APIAdminGroups) == 0 {
+		return errors.New("the session API requires session_api_admins or session_api_admin_groups to be set")
+	}
+	if options.AuditIndexSize > 0 && len(options.APIAdmins) == 0 && len(options.APIAdminGroups) == 0 {
+		return errors.New("the audit search requires session_api_admins or session_api_admin_groups to be set")
+	}
+	recordStorage := options.RecordStorage != "" || options.Storage != nil
+	if options.RecordDir != "" && recordStorage {
+		return errors.New("record_dir and record_storage are mutually exclusive")
+	}
+	if options.RecordTranscript && !recordStorage {
+		return errors.New("record transcripts require record_storage to be set")
+	}
+	if options.DrainTimeout < 0 {
+		return errors.New("drain timeout must not be negative")
+	}
+	if options.FileTransferMaxSize <= 0 {
+		return errors.New("file transfer max size must be positive")
+	}
+	if options.FileTransferMax <= 0 {
+		return errors.New("file transfer max must be positive")
+	}
 	return nil
 }
 
@@ -97,3 +360,12 @@ type HtermPrefernces struct {
 	ShiftInsertPaste              bool                         `hcl:"shift_insert_paste" json:"shift-insert-paste,omitempty"`
 	UserCss                       string                       `hcl:"user_css" json:"user-css,omitempty"`
 }
+
+// AuditRetention returns what becomes of the rotated audit files.
+func (options *Options) AuditRetention() webtty.AuditFileRetention {
+	return webtty.AuditFileRetention{
+		Compress:   options.AuditFileCompress,
+		MaxBackups: options.AuditFileMaxBackups,
+		MaxAge:     time.Duration(options.AuditFileRetention) * time.Second,
+	}
+}
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Limits of the user quotas, labeling their rejections in the metrics.
+const (
+	quotaSessions  = "sessions"
+	quotaRate      = "rate"
+	quotaBandwidth = "bandwidth"
+)
+
+var (
+	// errSessionQuota is returned for a new session of a user
+	// running max_user_sessions already.
+	errSessionQuota = errors.New("too many sessions of the user")
+	// errRateQuota is returned for a new session of a user who started
+	// user_sessions_per_hour in the last hour.
+	errRateQuota = errors.New("too many sessions of the user in the last hour")
+	// errBandwidthQuota is returned for a new session of a user who
+	// exchanged user_daily_bandwidth today, and for the running sessions
+	// closed once the user exceeds it.
+	errBandwidthQuota = errors.New("daily traffic of the user exhausted")
+)
+
+// userQuotas limits the sessions and the traffic of each user, keyed by
+// the user of their identity. A limit of 0 disables it.
+type userQuotas struct {
+	maxSessions     int
+	sessionsPerHour int
+	dailyBandwidth  uint64
+	// counts the rejections when not nil
+	metrics *serverMetrics
+
+	mutex sync.Mutex
+	users map[string]*userUsage
+}
+
+type userUsage struct {
+	sessions int
+	// start time of the sessions of the last hour, oldest first
+	starts []time.Time
+	// day the bytes were exchanged on, as 2006-01-02, and the sessions
+	// to close once they exceed the bandwidth
+	day     string
+	bytes   uint64
+	running map[*quotaLease]struct{}
+}
+
+func newUserQuotas(options *Options, metrics *serverMetrics) *userQuotas {
+	return &userQuotas{
+		maxSessions:     options.MaxUserSessions,
+		sessionsPerHour: options.UserSessionsPerHour,
+		dailyBandwidth:  uint64(options.UserDailyBandwidth),
+		metrics:         metrics,
+		users:           make(map[string]*userUsage),
+	}
+}
+
+// setLimits changes the limits of the quotas, applying to the running
+// sessions too. Lowering them closes no session but the ones exceeding the
+// daily bandwidth once they exchange more bytes.
+func (uq *userQuotas) setLimits(maxSessions int, sessionsPerHour int, dailyBandwidth int) {
+	uq.mutex.Lock()
+	defer uq.mutex.Unlock()
+
+	uq.maxSessions = maxSessions
+	uq.sessionsPerHour = sessionsPerHour
+	uq.dailyBandwidth = uint64(dailyBandwidth)
+}
+
+// acquire counts a new session of user, to be given back with release
+// once it ended. It fails, without counting it, when the session would
+// exceed a quota of the user.
+func (uq *userQuotas) acquire(user string) (*quotaLease, error) {
+	uq.mutex.Lock()
+	defer uq.mutex.Unlock()
+
+	now := time.Now()
+	usage, ok := uq.users[user]
+	if !ok {
+		usage = &userUsage{running: make(map[*quotaLease]struct{})}
+		uq.users[user] = usage
+	}
+	usage.expire(now)
+
+	var limit string
+	var err error
+	switch {
+	case uq.maxSessions > 0 && usage.sessions >= uq.maxSessions:
+		limit, err = quotaSessions, errSessionQuota
+	case uq.sessionsPerHour > 0 && len(usage.starts) >= uq.sessionsPerHour:
+		limit, err = quotaRate, errRateQuota
+	case uq.dailyBandwidth > 0 && usage.bytes >= uq.dailyBandwidth:
+		limit, err = quotaBandwidth, errBandwidthQuota
+	}
+	if err != nil {
+		uq.forgetLocked(user, usage)
+		if uq.metrics != nil {
+			uq.metrics.observeQuotaRejection(limit)
+		}
+		return nil, err
+	}
+
+	usage.sessions++
+	if uq.sessionsPerHour > 0 {
+		usage.starts = append(usage.starts, now)
+	}
+	lease := &quotaLease{quotas: uq, user: user, usage: usage}
+	usage.running[lease] = struct{}{}
+	return lease, nil
+}
+
+// forgetLocked removes usage of user once it no longer counts anything.
+func (uq *userQuotas) forgetLocked(user string, usage *userUsage) {
+	if usage.sessions == 0 && len(usage.starts) == 0 && usage.bytes == 0 {
+		delete(uq.users, user)
+	}
+}
+
+// expire drops the starts older than an hour,
+// and the bytes of the previous days.
+func (usage *userUsage) expire(now time.Time) {
+	i := 0
+	for i < len(usage.starts) && now.Sub(usage.starts[i]) >= time.Hour {
+		i++
+	}
+	usage.starts = usage.starts[i:]
+
+	if day := now.Format("2006-01-02"); day != usage.day {
+		usage.day, usage.bytes = day, 0
+	}
+}
+
+// quotaLease is a session counted by userQuotas.
+type quotaLease struct {
+	quotas *userQuotas
+	user   string
+	usage  *userUsage
+	// closes the session, set once it runs
+	terminate func()
+	exceeded  bool
+}
+
+// run sets the function closing the session once its user
+// exceeds the daily bandwidth.
+func (lease *quotaLease) run(terminate func()) {
+	lease.quotas.mutex.Lock()
+	defer lease.quotas.mutex.Unlock()
+
+	lease.terminate = terminate
+	if lease.exceeded {
+		terminate()
+	}
+}
+
+// closedByQuota returns whether the session was closed
+// as its user exceeded the daily bandwidth.
+func (lease *quotaLease) closedByQuota() bool {
+	lease.quotas.mutex.Lock()
+	defer lease.quotas.mutex.Unlock()
+
+	return lease.exceeded
+}
+
+// addBytes counts n bytes exchanged by the session, closing the running
+// sessions of its user when they exceed the daily bandwidth.
+func (lease *quotaLease) addBytes(n int) {
+	uq := lease.quotas
+	uq.mutex.Lock()
+	defer uq.mutex.Unlock()
+
+	if uq.dailyBandwidth == 0 {
+		return
+	}
+	usage := lease.usage
+	usage.expire(time.Now())
+	usage.bytes += uint64(n)
+	if usage.bytes <= uq.dailyBandwidth {
+		return
+	}
+	for running := range usage.running {
+		if running.exceeded {
+			continue
+		}
+		running.exceeded = true
+		if running.terminate != nil {
+			running.terminate()
+		}
+	}
+}
+
+// release gives the session back once it ended.
+func (lease *quotaLease) release() {
+	uq := lease.quotas
+	uq.mutex.Lock()
+	defer uq.mutex.Unlock()
+
+	usage := lease.usage
+	delete(usage.running, lease)
+	usage.sessions--
+	usage.expire(time.Now())
+	uq.forgetLocked(lease.user, usage)
+}
+
+// metrics returns the metrics of the session counting its bytes in the
+// quota of its user, reported to next too.
+func (lease *quotaLease) metrics(next webtty.Metrics) webtty.Metrics {
+	if next == nil {
+		next = webtty.NopMetrics{}
+	}
+	return &quotaMetrics{Metrics: next, lease: lease}
+}
+
+type quotaMetrics struct {
+	webtty.Metrics
+
+	lease *quotaLease
+}
+
+func (qm *quotaMetrics) AddBytesToSlave(n int) {
+	qm.lease.addBytes(n)
+	qm.Metrics.AddBytesToSlave(n)
+}
+
+func (qm *quotaMetrics) AddBytesToMaster(n int) {
+	qm.lease.addBytes(n)
+	qm.Metrics.AddBytesToMaster(n)
+}
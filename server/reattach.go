@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// errConnectionReplaced is returned for a connection whose session
+// was reattached to a newer connection.
+var errConnectionReplaced = errors.New("connection replaced by a reconnection")
+
+// reattachRegistry keeps the sessions clients can reconnect to,
+// by the reattach tokens given to their clients.
+type reattachRegistry struct {
+	mutex    sync.Mutex
+	sessions map[string]*reattachableSession
+}
+
+type reattachableSession struct {
+	tty  *webtty.WebTTY
+	done chan struct{}
+}
+
+func newReattachRegistry() *reattachRegistry {
+	return &reattachRegistry{sessions: make(map[string]*reattachableSession)}
+}
+
+// register makes tty reattachable with token until unregister is called.
+func (rr *reattachRegistry) register(token string, tty *webtty.WebTTY) (unregister func()) {
+	session := &reattachableSession{tty: tty, done: make(chan struct{})}
+	rr.mutex.Lock()
+	rr.sessions[token] = session
+	rr.mutex.Unlock()
+
+	return func() {
+		rr.mutex.Lock()
+		delete(rr.sessions, token)
+		rr.mutex.Unlock()
+		close(session.done)
+	}
+}
+
+// reattach attaches conn to the session of token and blocks until the
+// session ends, conn is replaced by another reconnection, or ctx is done.
+// It returns false when no session has token, such as a session that
+// ended meanwhile.
+func (rr *reattachRegistry) reattach(ctx context.Context, token string, conn *websocket.Conn, features webtty.FeatureSet) (bool, error) {
+	rr.mutex.Lock()
+	session, ok := rr.sessions[token]
+	rr.mutex.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	// the reconnected client receives the frames negotiated by the first one
+	negotiated := session.tty.NegotiatedFeatures()
+	if negotiated.BinaryFrames && !features.BinaryFrames {
+		return true, errors.New("the session sends binary frames, which the client doesn't support")
+	}
+	if negotiated.ScreenLock && !features.ScreenLock {
+		return true, errors.New("the session locks its screen, which the client can't unlock")
+	}
+	master := &reattachedConn{
+		wsWrapper: &wsWrapper{Conn: conn, binary: negotiated.BinaryFrames},
+		replaced:  make(chan struct{}),
+	}
+	err := session.tty.Reattach(master)
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to reattach session")
+	}
+
+	select {
+	case <-session.done:
+		return true, nil
+	case <-master.replaced:
+		return true, errConnectionReplaced
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+// reattachedConn is the connection of a reconnected client,
+// closed by Reattach when the client reconnects again.
+type reattachedConn struct {
+	*wsWrapper
+	replaced chan struct{}
+	once     sync.Once
+}
+
+func (rc *reattachedConn) Close() error {
+	rc.once.Do(func() { close(rc.replaced) })
+	return rc.wsWrapper.Close()
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/webtty"
+	"github.com/buptWYChen/gotty/webtty/storage"
+)
+
+// recordStoreTimeout bounds the upload of a recording to the record storage.
+const recordStoreTimeout = time.Minute
+
+// recordName fills the RecordFileName format for a session.
+func (server *Server) recordName(sessionID, user, clusterID string) (string, error) {
+	nameVars := map[string]interface{}{
+		"session_id": sessionID,
+		"user":       user,
+		"cluster_id": clusterID,
+		"time":       time.Now().Format("20060102T150405"),
+	}
+	nameBuf := new(bytes.Buffer)
+	err := server.recordTemplate.Execute(nameBuf, nameVars)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to fill record file name template")
+	}
+	return nameBuf.String(), nil
+}
+
+// openRecording creates the asciicast file of a session in RecordDir,
+// named by the RecordFileName format.
+func (server *Server) openRecording(sessionID, user, clusterID string) (*os.File, error) {
+	dir := homedir.Expand(server.options.RecordDir)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create record directory `%s`", dir)
+	}
+
+	rawName, err := server.recordName(sessionID, user, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// user and cluster_id come from the client, keep them inside dir
+	name := filepath.Base(filepath.Clean("/" + rawName))
+	if name == "/" || name == "." {
+		return nil, errors.Errorf("invalid record file name `%s`", rawName)
+	}
+	path := filepath.Join(dir, name)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create record file `%s`", path)
+	}
+	return file, nil
+}
+
+// storedRecording spools the recording of a session, and its transcript
+// of audit events, to temporary files put in the record storage when
+// the session ends.
+type storedRecording struct {
+	storage    storage.Storage
+	key        string
+	record     *os.File
+	transcript *os.File
+
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// spoolRecording starts the recording of a session to the record storage,
+// keyed by the RecordFileName format, whose "/" group the recordings.
+func (server *Server) spoolRecording(sessionID, user, clusterID string, transcript bool) (*storedRecording, error) {
+	// user and cluster_id come from the client, keep them in their segment
+	escape := strings.NewReplacer("/", "_", "\\", "_").Replace
+	key, err := server.recordName(sessionID, escape(user), escape(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.CheckKey(key); err != nil {
+		return nil, errors.Wrapf(err, "invalid record file name")
+	}
+
+	record, err := ioutil.TempFile("", "gotty-record-")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create record spool file")
+	}
+	sr := &storedRecording{storage: server.recordStorage, key: key, record: record}
+	if transcript {
+		sr.transcript, err = ioutil.TempFile("", "gotty-transcript-")
+		if err != nil {
+			sr.remove()
+			return nil, errors.Wrapf(err, "failed to create transcript spool file")
+		}
+		sr.encoder = json.NewEncoder(sr.transcript)
+	}
+	return sr, nil
+}
+
+// transcriptKey returns the key of the transcript of the recording of key,
+// such as "20240102T150405-3f2a.audit.jsonl".
+func transcriptKey(key string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + ".audit.jsonl"
+}
+
+// Log appends event to the transcript.
+func (sr *storedRecording) Log(ctx context.Context, event webtty.AuditEvent) error {
+	sr.mutex.Lock()
+	defer sr.mutex.Unlock()
+
+	if err := sr.encoder.Encode(event); err != nil {
+		return errors.Wrapf(err, "failed to write transcript")
+	}
+	return nil
+}
+
+// store puts the recording, and the transcript, in the record storage,
+// and removes their spool files.
+func (sr *storedRecording) store() error {
+	sr.mutex.Lock()
+	defer sr.mutex.Unlock()
+	defer sr.remove()
+
+	ctx, cancel := context.WithTimeout(context.Background(), recordStoreTimeout)
+	defer cancel()
+	if err := sr.put(ctx, sr.key, sr.record); err != nil {
+		return err
+	}
+	if sr.transcript != nil {
+		if err := sr.put(ctx, transcriptKey(sr.key), sr.transcript); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sr *storedRecording) put(ctx context.Context, key string, file *os.File) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "failed to rewind spool file of `%s`", key)
+	}
+	if err := sr.storage.Put(ctx, key, file); err != nil {
+		return errors.Wrapf(err, "failed to store `%s`", key)
+	}
+	return nil
+}
+
+func (sr *storedRecording) remove() {
+	for _, file := range []*os.File{sr.record, sr.transcript} {
+		if file != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}
+}
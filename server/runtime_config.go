@@ -0,0 +1,350 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/homedir"
+	"github.com/buptWYChen/gotty/pkg/randomstring"
+	"github.com/buptWYChen/gotty/utils"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// configAPIPath is the path of the config API.
+const configAPIPath = "/api/config"
+
+// configWatchInterval is how often the config file is checked for changes
+// with config_reload.
+var configWatchInterval = 5 * time.Second
+
+// configReloadMarker prefixes the reloads of the runtime config
+// in the audit trail.
+const configReloadMarker = "[config-reload] "
+
+// RuntimeConfig are the settings of a running Server changed by Reload,
+// without restarting it and closing its sessions. Their hcl names are the
+// ones of Options.
+type RuntimeConfig struct {
+	// Applies to the audit events of the running sessions too, the audit
+	// URL can be changed but neither enabled nor disabled
+	AuditURL string `hcl:"audit_url" json:"auditURL"`
+	// Apply to the lines typed in the running sessions started with a
+	// command policy too
+	CommandAllow []string `hcl:"command_allow" json:"commandAllow"`
+	CommandDeny  []string `hcl:"command_deny" json:"commandDeny"`
+	// Apply to the next connections and sessions of all the users
+	ConnectionRate      int `hcl:"connection_rate" json:"connectionRate"`
+	MaxUserSessions     int `hcl:"max_user_sessions" json:"maxUserSessions"`
+	UserSessionsPerHour int `hcl:"user_sessions_per_hour" json:"userSessionsPerHour"`
+	UserDailyBandwidth  int `hcl:"user_daily_bandwidth" json:"userDailyBandwidth"`
+	// Applies to the sessions started after the reload
+	IdleTimeout int `hcl:"idle_timeout" json:"idleTimeout"`
+}
+
+func runtimeConfigOf(options *Options) RuntimeConfig {
+	return RuntimeConfig{
+		AuditURL:            options.AuditURL,
+		CommandAllow:        options.CommandAllow,
+		CommandDeny:         options.CommandDeny,
+		ConnectionRate:      options.ConnectionRate,
+		MaxUserSessions:     options.MaxUserSessions,
+		UserSessionsPerHour: options.UserSessionsPerHour,
+		UserDailyBandwidth:  options.UserDailyBandwidth,
+		IdleTimeout:         options.IdleTimeout,
+	}
+}
+
+// apply sets the settings of the config to options.
+func (config RuntimeConfig) apply(options *Options) {
+	options.AuditURL = config.AuditURL
+	options.CommandAllow = config.CommandAllow
+	options.CommandDeny = config.CommandDeny
+	options.ConnectionRate = config.ConnectionRate
+	options.MaxUserSessions = config.MaxUserSessions
+	options.UserSessionsPerHour = config.UserSessionsPerHour
+	options.UserDailyBandwidth = config.UserDailyBandwidth
+	options.IdleTimeout = config.IdleTimeout
+}
+
+// clone returns a copy of config sharing none of its lists,
+// the empty ones being nil.
+func (config RuntimeConfig) clone() RuntimeConfig {
+	config.CommandAllow = cloneStrings(config.CommandAllow)
+	config.CommandDeny = cloneStrings(config.CommandDeny)
+	return config
+}
+
+func cloneStrings(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	return append([]string{}, list...)
+}
+
+// changes returns the hcl names of the settings of config differing in next.
+func (config RuntimeConfig) changes(next RuntimeConfig) []string {
+	var changed []string
+	current, updated := reflect.ValueOf(config), reflect.ValueOf(next)
+	for i := 0; i < current.NumField(); i++ {
+		if !reflect.DeepEqual(current.Field(i).Interface(), updated.Field(i).Interface()) {
+			changed = append(changed, current.Type().Field(i).Tag.Get("hcl"))
+		}
+	}
+	return changed
+}
+
+// runtimeConfig is the state of the RuntimeConfig of a Server.
+type runtimeConfig struct {
+	// serializes the reloads, held from the read of the config they
+	// start from to their end, so that no reload is lost
+	reloadMutex sync.Mutex
+
+	mutex  sync.RWMutex
+	config RuntimeConfig
+	// the config at start, which the reloads of the config file start from
+	initial RuntimeConfig
+	// nil without command_allow and command_deny
+	commandPolicy *webtty.CommandPolicy
+}
+
+func newRuntimeConfig(options *Options, commandPolicy *webtty.CommandPolicy) *runtimeConfig {
+	config := runtimeConfigOf(options).clone()
+	return &runtimeConfig{config: config, initial: config, commandPolicy: commandPolicy}
+}
+
+func (rc *runtimeConfig) get() RuntimeConfig {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return rc.config.clone()
+}
+
+func (rc *runtimeConfig) policy() *webtty.CommandPolicy {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return rc.commandPolicy
+}
+
+// switchableAuditLogger delivers the audit events to an HTTPAuditLogger
+// replaced when audit_url is reloaded.
+type switchableAuditLogger struct {
+	mutex  sync.RWMutex
+	logger *webtty.HTTPAuditLogger
+}
+
+func (sl *switchableAuditLogger) current() *webtty.HTTPAuditLogger {
+	sl.mutex.RLock()
+	defer sl.mutex.RUnlock()
+
+	return sl.logger
+}
+
+func (sl *switchableAuditLogger) Log(ctx context.Context, event webtty.AuditEvent) error {
+	return sl.current().Log(ctx, event)
+}
+
+func (sl *switchableAuditLogger) LogBatch(ctx context.Context, events []webtty.AuditEvent) error {
+	return sl.current().LogBatch(ctx, events)
+}
+
+// setURL makes the events be delivered to endpoint, the events being sent
+// finish with the previous one.
+func (sl *switchableAuditLogger) setURL(endpoint string) {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	next := *sl.logger
+	next.URL = endpoint
+	sl.logger = &next
+}
+
+// RuntimeConfig returns the settings of the server changed by Reload.
+func (server *Server) RuntimeConfig() RuntimeConfig {
+	return server.runtime.get()
+}
+
+// Reload changes the runtime settings of the server to config, without
+// restarting it and closing its sessions, see RuntimeConfig for which
+// sessions they apply to. source tells where config comes from, such as
+// the config file, in the log and the audit trail. Invalid settings
+// change nothing.
+func (server *Server) Reload(config RuntimeConfig, source string) error {
+	server.runtime.reloadMutex.Lock()
+	defer server.runtime.reloadMutex.Unlock()
+
+	return server.reload(config, source)
+}
+
+// reload is Reload, with the reload mutex held.
+func (server *Server) reload(config RuntimeConfig, source string) error {
+	config = config.clone()
+	checked := *server.options
+	config.apply(&checked)
+	if err := checked.Validate(); err != nil {
+		return err
+	}
+	if (server.auditSink == nil) != (config.AuditURL == "") {
+		return errors.New("audit_url can be changed at runtime but neither enabled nor disabled")
+	}
+	if config.AuditURL != "" {
+		endpoint, err := url.Parse(config.AuditURL)
+		if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			return errors.New("audit_url must be an absolute URL")
+		}
+	}
+	var commandPolicy *webtty.CommandPolicy
+	if len(config.CommandAllow) > 0 || len(config.CommandDeny) > 0 {
+		var err error
+		commandPolicy, err = webtty.NewCommandPolicy(config.CommandAllow, config.CommandDeny)
+		if err != nil {
+			return err
+		}
+	}
+
+	server.runtime.mutex.Lock()
+	changed := server.runtime.config.changes(config)
+	if len(changed) == 0 {
+		server.runtime.mutex.Unlock()
+		return nil
+	}
+	server.runtime.config = config
+	server.runtime.commandPolicy = commandPolicy
+	if server.auditSink != nil {
+		server.auditSink.setURL(config.AuditURL)
+	}
+	if server.access != nil {
+		server.access.setRate(config.ConnectionRate)
+	}
+	server.quotas.setLimits(config.MaxUserSessions, config.UserSessionsPerHour, config.UserDailyBandwidth)
+	server.runtime.mutex.Unlock()
+
+	change := "changed " + strings.Join(changed, ", ") + " from " + source
+	log.Printf("Runtime config %s", change)
+	event := webtty.AuditEvent{
+		Time:      time.Now(),
+		Command:   configReloadMarker + change,
+		RequestID: randomstring.Generate(16),
+	}
+	if server.auditLogger != nil {
+		server.auditLogger.Log(context.Background(), event)
+	}
+	if server.auditIndex != nil {
+		server.auditIndex.Log(context.Background(), event)
+	}
+	return nil
+}
+
+// ReloadConfigFile reloads the runtime settings from the config file the
+// server started with, see Reload. The settings missing from the file get
+// back their value at start, the file takes precedence over the flags.
+func (server *Server) ReloadConfigFile() error {
+	if server.options.ConfigFile == "" {
+		return errors.New("no config file to reload")
+	}
+	server.runtime.reloadMutex.Lock()
+	defer server.runtime.reloadMutex.Unlock()
+
+	// initial is never changed
+	config := server.runtime.initial.clone()
+	// lists are only set by the config file
+	config.CommandAllow, config.CommandDeny = nil, nil
+	if err := utils.ApplyConfigFile(server.options.ConfigFile, &config); err != nil {
+		return errors.Wrapf(err, "failed to load config file `%s`", server.options.ConfigFile)
+	}
+	return server.reload(config, "config file "+server.options.ConfigFile)
+}
+
+// checkCommand checks line with the command policy of the runtime config,
+// which may have been reloaded since the session started.
+func (server *Server) checkCommand(line string) error {
+	policy := server.runtime.policy()
+	if policy == nil {
+		return nil
+	}
+	return policy.Check(line)
+}
+
+// watchConfigFile reloads the config file each time it's modified,
+// until ctx is canceled. The reloads failing are logged, leaving
+// the settings unchanged.
+func (server *Server) watchConfigFile(ctx context.Context) {
+	path := homedir.Expand(server.options.ConfigFile)
+	var modified time.Time
+	if info, err := os.Stat(path); err == nil {
+		modified = info.ModTime()
+	}
+	ticker := time.NewTicker(configWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.ModTime().Equal(modified) {
+			continue
+		}
+		modified = info.ModTime()
+		if err := server.ReloadConfigFile(); err != nil {
+			log.Printf("Failed to reload the runtime config: %s", err)
+		}
+	}
+}
+
+// handleConfigAPI returns the runtime config with GET /api/config, and
+// reloads it with PATCH /api/config, the settings missing from the body
+// keeping their value.
+func (server *Server) handleConfigAPI(w http.ResponseWriter, r *http.Request) {
+	identity, _ := webtty.IdentityFromContext(r.Context())
+	if !server.isSessionAdmin(identity) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, server.RuntimeConfig())
+	case "PATCH":
+		// read before the lock, applied to the config of the latest reload
+		// rather than to one a concurrent reload replaces
+		var patch json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			http.Error(w, "Invalid config", http.StatusBadRequest)
+			return
+		}
+		server.runtime.reloadMutex.Lock()
+		config := server.RuntimeConfig()
+		decodeErr := json.Unmarshal(patch, &config)
+		var reloadErr error
+		if decodeErr == nil {
+			reloadErr = server.reload(config, "config API by "+identity.User)
+		}
+		updated := server.RuntimeConfig()
+		server.runtime.reloadMutex.Unlock()
+
+		if decodeErr != nil {
+			http.Error(w, "Invalid config", http.StatusBadRequest)
+			return
+		}
+		if reloadErr != nil {
+			http.Error(w, "Invalid config: "+reloadErr.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusOK, updated)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,390 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/utils"
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+type testFactory struct{}
+
+func (testFactory) Name() string {
+	return "test"
+}
+
+func (testFactory) New(params map[string][]string) (Slave, error) {
+	return nil, errors.New("no slave in tests")
+}
+
+// newTestServer returns a server with the default options changed by
+// configure.
+func newTestServer(t *testing.T, configure func(options *Options)) *Server {
+	options := &Options{}
+	if err := utils.ApplyDefaultValues(options); err != nil {
+		t.Fatalf("Unexpected error from ApplyDefaultValues(): %s", err)
+	}
+	options.ConfigReload = true
+	if configure != nil {
+		configure(options)
+	}
+	server, err := New(testFactory{}, options)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	return server
+}
+
+// connectionRate returns the connection rate the server applies.
+func connectionRate(server *Server) int {
+	server.access.mutex.Lock()
+	defer server.access.mutex.Unlock()
+
+	return server.access.rate
+}
+
+func TestReload(t *testing.T) {
+	server := newTestServer(t, func(options *Options) {
+		options.CommandDeny = []string{"rm *"}
+	})
+	// as held by the sessions started before the reload
+	filter := server.checkCommand
+	if err := filter("shutdown now"); err != nil {
+		t.Fatalf("Unexpected error before the reload: %s", err)
+	}
+
+	config := server.RuntimeConfig()
+	config.CommandDeny = append(config.CommandDeny, "shutdown *")
+	config.ConnectionRate = 5
+	config.MaxUserSessions = 2
+	config.IdleTimeout = 60
+	if err := server.Reload(config, "test"); err != nil {
+		t.Fatalf("Unexpected error from Reload(): %s", err)
+	}
+	if reloaded := server.RuntimeConfig(); !reflect.DeepEqual(reloaded, config) {
+		t.Fatalf("Unexpected config after the reload: %+v", reloaded)
+	}
+	if err := filter("shutdown now"); err == nil {
+		t.Fatalf("Expected the reloaded policy to apply to the running sessions")
+	}
+	if err := filter("rm -rf /"); err == nil {
+		t.Fatalf("Expected the previous patterns to still apply")
+	}
+	if rate := connectionRate(server); rate != 5 {
+		t.Fatalf("Unexpected connection rate: %d", rate)
+	}
+
+	// without patterns, every command is allowed again
+	config.CommandDeny = nil
+	if err := server.Reload(config, "test"); err != nil {
+		t.Fatalf("Unexpected error from Reload(): %s", err)
+	}
+	if err := filter("rm -rf /"); err != nil {
+		t.Fatalf("Unexpected error without a policy: %s", err)
+	}
+}
+
+func TestReloadInvalid(t *testing.T) {
+	server := newTestServer(t, func(options *Options) {
+		options.CommandDeny = []string{"rm *"}
+		options.ConnectionRate = 3
+	})
+	initial := server.RuntimeConfig()
+
+	for name, change := range map[string]func(config *RuntimeConfig){
+		"negative idle timeout":    func(config *RuntimeConfig) { config.IdleTimeout = -1 },
+		"negative connection rate": func(config *RuntimeConfig) { config.ConnectionRate = -1 },
+		"negative quota":           func(config *RuntimeConfig) { config.UserSessionsPerHour = -1 },
+		"invalid pattern":          func(config *RuntimeConfig) { config.CommandDeny = []string{"re:("} },
+		"enabled audit URL":        func(config *RuntimeConfig) { config.AuditURL = "http://127.0.0.1:1/audit" },
+	} {
+		config := server.RuntimeConfig()
+		change(&config)
+		// along with valid changes, which aren't applied either
+		config.UserDailyBandwidth++
+		config.CommandAllow = []string{"ls"}
+		if err := server.Reload(config, "test"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		if config := server.RuntimeConfig(); !reflect.DeepEqual(config, initial) {
+			t.Errorf("%s: unexpected config after the failed reload: %+v", name, config)
+		}
+	}
+	if err := server.checkCommand("rm -rf /"); err == nil {
+		t.Fatalf("Expected the initial policy to still apply")
+	}
+	if err := server.checkCommand("top"); err != nil {
+		t.Fatalf("Unexpected error of the initial policy: %s", err)
+	}
+	if rate := connectionRate(server); rate != 3 {
+		t.Fatalf("Unexpected connection rate: %d", rate)
+	}
+}
+
+func TestReloadAuditURL(t *testing.T) {
+	var mutex sync.Mutex
+	received := map[string]string{}
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mutex.Lock()
+		received[r.URL.Path] += string(body)
+		mutex.Unlock()
+	}))
+	defer endpoint.Close()
+
+	server := newTestServer(t, func(options *Options) {
+		options.AuditURL = endpoint.URL + "/first"
+		options.AuditMethod = "POST"
+	})
+	defer server.auditLogger.Close()
+
+	for _, auditURL := range []string{"", "/relative", "not a URL"} {
+		config := server.RuntimeConfig()
+		config.AuditURL = auditURL
+		if err := server.Reload(config, "test"); err == nil {
+			t.Errorf("Expected an error reloading audit_url `%s`", auditURL)
+		}
+	}
+
+	config := server.RuntimeConfig()
+	config.AuditURL = endpoint.URL + "/second"
+	if err := server.Reload(config, "test"); err != nil {
+		t.Fatalf("Unexpected error from Reload(): %s", err)
+	}
+	if url := server.auditSink.current().URL; url != config.AuditURL {
+		t.Fatalf("Unexpected audit URL: %s", url)
+	}
+	// the reload is recorded with the new endpoint
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mutex.Lock()
+		second, first := received["/second"], received["/first"]
+		mutex.Unlock()
+		if strings.Contains(second, "changed audit_url from test") {
+			if first != "" {
+				t.Fatalf("Unexpected events sent to the previous endpoint: %s", first)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the reload to be audited, got %q", second)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func writeConfigFile(t *testing.T, path string, content string, modified time.Time) {
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Unexpected error from WriteFile(): %s", err)
+	}
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatalf("Unexpected error from Chtimes(): %s", err)
+	}
+}
+
+func TestReloadConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotty-config")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "gotty.hcl")
+
+	server := newTestServer(t, func(options *Options) {
+		options.ConfigFile = path
+		options.MaxUserSessions = 4
+	})
+	if err := server.ReloadConfigFile(); err == nil {
+		t.Fatalf("Expected an error for a missing config file")
+	}
+
+	writeConfigFile(t, path, "connection_rate = 5\ncommand_deny = [\"rm *\"]\nport = \"9000\"\n", time.Now())
+	if err := server.ReloadConfigFile(); err != nil {
+		t.Fatalf("Unexpected error from ReloadConfigFile(): %s", err)
+	}
+	config := server.RuntimeConfig()
+	if config.ConnectionRate != 5 || config.MaxUserSessions != 4 || !reflect.DeepEqual(config.CommandDeny, []string{"rm *"}) {
+		t.Fatalf("Unexpected config after the reload: %+v", config)
+	}
+	if err := server.checkCommand("rm -rf /"); err == nil {
+		t.Fatalf("Expected the reloaded policy to apply")
+	}
+	if server.options.Port == "9000" {
+		t.Fatalf("Unexpected reload of a setting that isn't reloadable")
+	}
+
+	// invalid files change nothing
+	for _, content := range []string{"connection_rate = ", "idle_timeout = -5\n"} {
+		writeConfigFile(t, path, content, time.Now())
+		if err := server.ReloadConfigFile(); err == nil {
+			t.Errorf("Expected an error for %q", content)
+		}
+		if reloaded := server.RuntimeConfig(); !reflect.DeepEqual(reloaded, config) {
+			t.Errorf("Unexpected config after reloading %q: %+v", content, reloaded)
+		}
+	}
+
+	// the settings removed from the file get back their value at start
+	writeConfigFile(t, path, "max_user_sessions = 1\n", time.Now())
+	if err := server.ReloadConfigFile(); err != nil {
+		t.Fatalf("Unexpected error from ReloadConfigFile(): %s", err)
+	}
+	config = server.RuntimeConfig()
+	if config.ConnectionRate != 0 || config.MaxUserSessions != 1 || config.CommandDeny != nil {
+		t.Fatalf("Unexpected config after the reload: %+v", config)
+	}
+}
+
+func TestWatchConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotty-config")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "gotty.hcl")
+	start := time.Now().Add(-time.Hour)
+	writeConfigFile(t, path, "idle_timeout = 30\n", start)
+
+	interval := configWatchInterval
+	configWatchInterval = 10 * time.Millisecond
+	defer func() { configWatchInterval = interval }()
+
+	server := newTestServer(t, func(options *Options) {
+		options.ConfigFile = path
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		server.watchConfigFile(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// the file as it was at start isn't reloaded
+	time.Sleep(50 * time.Millisecond)
+	if idleTimeout := server.RuntimeConfig().IdleTimeout; idleTimeout != 0 {
+		t.Fatalf("Unexpected reload of the unmodified file: %d", idleTimeout)
+	}
+
+	waitIdleTimeout := func(expected int) {
+		deadline := time.Now().Add(2 * time.Second)
+		for server.RuntimeConfig().IdleTimeout != expected {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected the idle timeout to be reloaded as %d, got %d", expected, server.RuntimeConfig().IdleTimeout)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	writeConfigFile(t, path, "idle_timeout = 60\n", start.Add(time.Minute))
+	waitIdleTimeout(60)
+
+	// an invalid modification is logged and skipped, the next one applies
+	writeConfigFile(t, path, "idle_timeout = -1\n", start.Add(2*time.Minute))
+	time.Sleep(50 * time.Millisecond)
+	waitIdleTimeout(60)
+	writeConfigFile(t, path, "idle_timeout = 90\n", start.Add(3*time.Minute))
+	waitIdleTimeout(90)
+}
+
+// configRequest returns a request of the config API by identity.
+func configRequest(method string, body string, identity webtty.Identity) *http.Request {
+	r := httptest.NewRequest(method, configAPIPath, strings.NewReader(body))
+	return r.WithContext(webtty.WithIdentityContext(r.Context(), identity))
+}
+
+func TestConfigAPI(t *testing.T) {
+	server := newTestServer(t, func(options *Options) {
+		options.APIAdmins = []string{"admin"}
+		options.ConnectionRate = 3
+	})
+	admin := webtty.Identity{User: "admin"}
+
+	serve := func(r *http.Request) (int, RuntimeConfig) {
+		w := httptest.NewRecorder()
+		server.handleConfigAPI(w, r)
+		var config RuntimeConfig
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), &config); err != nil {
+				t.Fatalf("Unexpected body: %s", w.Body.String())
+			}
+		}
+		return w.Code, config
+	}
+
+	if code, _ := serve(configRequest("GET", "", webtty.Identity{User: "alice"})); code != http.StatusForbidden {
+		t.Fatalf("Unexpected status for a user who isn't an admin: %d", code)
+	}
+	if code, _ := serve(configRequest("PATCH", `{"connectionRate": 10}`, webtty.Identity{User: "alice"})); code != http.StatusForbidden {
+		t.Fatalf("Unexpected status of a PATCH by a user who isn't an admin: %d", code)
+	}
+	if code, config := serve(configRequest("GET", "", admin)); code != http.StatusOK || config.ConnectionRate != 3 {
+		t.Fatalf("Unexpected response: %d, %+v", code, config)
+	}
+
+	code, config := serve(configRequest("PATCH", `{"connectionRate": 10, "commandDeny": ["rm *"]}`, admin))
+	if code != http.StatusOK || config.ConnectionRate != 10 || !reflect.DeepEqual(config.CommandDeny, []string{"rm *"}) {
+		t.Fatalf("Unexpected response: %d, %+v", code, config)
+	}
+	// the settings missing from the body keep their value
+	code, config = serve(configRequest("PATCH", `{"idleTimeout": 60}`, admin))
+	if code != http.StatusOK || config.ConnectionRate != 10 || config.IdleTimeout != 60 || len(config.CommandDeny) != 1 {
+		t.Fatalf("Unexpected response: %d, %+v", code, config)
+	}
+
+	for _, body := range []string{`{"idleTimeout": `, `{"idleTimeout": "long"}`, `{"idleTimeout": -1}`, `{"auditURL": "http://127.0.0.1:1/audit"}`} {
+		if code, _ := serve(configRequest("PATCH", body, admin)); code != http.StatusBadRequest {
+			t.Errorf("Unexpected status for %s: %d", body, code)
+		}
+		if current := server.RuntimeConfig(); !reflect.DeepEqual(current, config) {
+			t.Errorf("Unexpected config after %s: %+v", body, current)
+		}
+	}
+	if code, _ := serve(configRequest("DELETE", "", admin)); code != http.StatusMethodNotAllowed {
+		t.Fatalf("Unexpected status for DELETE: %d", code)
+	}
+}
+
+func TestConfigAPIConcurrentPatches(t *testing.T) {
+	server := newTestServer(t, func(options *Options) {
+		options.APIAdmins = []string{"admin"}
+	})
+
+	// each setting is only patched by its own requests,
+	// none of them is to be lost
+	var wg sync.WaitGroup
+	for _, body := range []string{`{"maxUserSessions": 5}`, `{"userSessionsPerHour": 7}`, `{"connectionRate": 9}`} {
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(body string) {
+				defer wg.Done()
+				w := httptest.NewRecorder()
+				server.handleConfigAPI(w, configRequest("PATCH", body, webtty.Identity{User: "admin"}))
+				if w.Code != http.StatusOK {
+					t.Errorf("Unexpected status for %s: %d", body, w.Code)
+				}
+			}(body)
+		}
+	}
+	wg.Wait()
+
+	config := server.RuntimeConfig()
+	if config.MaxUserSessions != 5 || config.UserSessionsPerHour != 7 || config.ConnectionRate != 9 {
+		t.Fatalf("Unexpected config after concurrent patches: %+v", config)
+	}
+}
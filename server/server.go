@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"html/template"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
@@ -21,6 +23,7 @@ import (
 	"github.com/buptWYChen/gotty/pkg/homedir"
 	"github.com/buptWYChen/gotty/pkg/randomstring"
 	"github.com/buptWYChen/gotty/webtty"
+	"github.com/buptWYChen/gotty/webtty/storage"
 )
 
 // Server provides a webtty HTTP endpoint.
@@ -31,6 +34,32 @@ type Server struct {
 	upgrader      *websocket.Upgrader
 	indexTemplate *template.Template
 	titleTemplate *noesctmpl.Template
+
+	recordTemplate *noesctmpl.Template
+	// nil without record_storage
+	recordStorage  storage.Storage
+	runtime        *runtimeConfig
+	alertRules     *webtty.CommandAlertRules
+	alertNotifier  *webtty.WebhookAlertNotifier
+	auditRedact    func(text string) string
+	sessions       *webtty.Registry
+	reattachables  *reattachRegistry
+	namedSessions  *namedSessions
+	grants         *accessGrants
+	auditLogger    *webtty.AsyncAuditLogger
+	auditSink      *switchableAuditLogger // nil without audit_url
+	auditIndex     *webtty.AuditIndex
+	auditFile      *webtty.AuditFile
+	authenticator  Authenticator
+	authChallenges []string
+	authorizer     Authorizer
+	metrics        *serverMetrics
+	quotas         *userQuotas
+	tracker        *sessionTracker
+	// nil without access control
+	access *accessControl
+	// the command of --watch, nil without
+	broadcast *webtty.Broadcast
 }
 
 // New creates a new instance of Server.
@@ -57,6 +86,142 @@ func New(factory Factory, options *Options) (*Server, error) {
 		return nil, errors.Wrapf(err, "failed to parse window title format `%s`", options.TitleFormat)
 	}
 
+	recordTemplate, err := noesctmpl.New("record").Parse(options.RecordFileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse record file name format `%s`", options.RecordFileName)
+	}
+
+	recordStorage := options.Storage
+	if recordStorage == nil && options.RecordStorage != "" {
+		recordStorage, err = storage.Open(homedir.Expand(options.RecordStorage))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open record storage")
+		}
+	}
+
+	var commandPolicy *webtty.CommandPolicy
+	if options.CommandAllow != nil || options.CommandDeny != nil {
+		commandPolicy, err = webtty.NewCommandPolicy(options.CommandAllow, options.CommandDeny)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var alertRules *webtty.CommandAlertRules
+	var alertNotifier *webtty.WebhookAlertNotifier
+	if options.CommandAlertWebhook != "" {
+		patterns := options.CommandAlert
+		if patterns == nil {
+			patterns = webtty.DefaultCommandAlertPatterns
+		}
+		alertRules, err = webtty.NewCommandAlertRules(patterns)
+		if err != nil {
+			return nil, err
+		}
+		alertNotifier, err = webtty.NewWebhookAlertNotifier(options.CommandAlertWebhook, options.CommandAlertFormat, options.CommandAlertBody)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var auditRedact func(text string) string
+	if options.AuditRedact || options.AuditRedactPatterns != nil {
+		patterns := make([]*regexp.Regexp, 0, len(options.AuditRedactPatterns))
+		for _, pattern := range options.AuditRedactPatterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compile audit redaction pattern `%s`", pattern)
+			}
+			patterns = append(patterns, compiled)
+		}
+		auditRedact = webtty.NewRedactor(patterns...)
+	}
+
+	var metrics *serverMetrics
+	if options.EnableMetrics {
+		metrics = newServerMetrics()
+	}
+
+	var auditLogger *webtty.AsyncAuditLogger
+	var auditSink *switchableAuditLogger
+	if options.AuditURL != "" {
+		clientConfig := webtty.AuditClientConfig{
+			Timeout:  time.Duration(options.AuditTimeout) * time.Second,
+			CAFile:   options.AuditCACrtFile,
+			CertFile: options.AuditClientCrtFile,
+			KeyFile:  options.AuditClientKeyFile,
+			Proxy:    options.AuditProxy,
+		}
+		for _, file := range []*string{&clientConfig.CAFile, &clientConfig.CertFile, &clientConfig.KeyFile} {
+			if *file != "" {
+				*file = homedir.Expand(*file)
+			}
+		}
+		client, err := webtty.NewAuditHTTPClient(clientConfig)
+		if err != nil {
+			return nil, err
+		}
+		logger := webtty.NewHTTPAuditLogger(options.AuditURL)
+		logger.Method = options.AuditMethod
+		logger.Headers = options.AuditHeaders
+		logger.Client = client
+		logger.JSON = options.AuditFormat == "json"
+		if metrics != nil {
+			logger.Metrics = metrics
+		}
+		config := webtty.AsyncAuditConfig{QueueSize: options.AuditQueueSize}
+		if options.AuditSpillFile != "" {
+			config.SpillFile = homedir.Expand(options.AuditSpillFile)
+		}
+		auditSink = &switchableAuditLogger{logger: logger}
+		auditLogger = webtty.NewAsyncAuditLogger(auditSink, config)
+	}
+
+	var auditIndex *webtty.AuditIndex
+	if options.AuditIndexSize > 0 {
+		auditIndex = webtty.NewAuditIndex(options.AuditIndexSize)
+	}
+
+	// shared by the sessions, which never rotate it behind each other
+	var auditFile *webtty.AuditFile
+	if options.AuditFile != "" {
+		var err error
+		auditFile, err = webtty.OpenAuditFile(
+			homedir.Expand(options.AuditFile),
+			options.AuditFileMaxSize,
+			time.Duration(options.AuditFileMaxAge)*time.Second,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := auditFile.SetRetention(options.AuditRetention()); err != nil {
+			return nil, err
+		}
+	}
+
+	authenticator, authChallenges, err := newAuthenticator(options)
+	if err != nil {
+		return nil, err
+	}
+	if options.EnableSessionAPI && authenticator == nil {
+		return nil, errors.New("the session API requires an authentication method")
+	}
+	if options.AuditIndexSize > 0 && authenticator == nil {
+		return nil, errors.New("the audit search requires an authentication method")
+	}
+	if options.ScreenLockTimeout > 0 && authenticator == nil {
+		return nil, errors.New("the screen lock requires an authentication method")
+	}
+	authorizer, err := newAuthorizer(options)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := newAccessControl(options)
+	if err != nil {
+		return nil, err
+	}
+
 	var originChekcer func(r *http.Request) bool
 	if options.WSOrigin != "" {
 		matcher, err := regexp.Compile(options.WSOrigin)
@@ -74,12 +239,34 @@ func New(factory Factory, options *Options) (*Server, error) {
 
 		upgrader: &websocket.Upgrader{
 			ReadBufferSize:  1024,
-			WriteBufferSize: 1024,
+			WriteBufferSize: writeBufferSize(options.BufferSize),
 			Subprotocols:    webtty.Protocols,
 			CheckOrigin:     originChekcer,
 		},
 		indexTemplate: indexTemplate,
 		titleTemplate: titleTemplate,
+
+		recordTemplate: recordTemplate,
+		recordStorage:  recordStorage,
+		runtime:        newRuntimeConfig(options, commandPolicy),
+		alertRules:     alertRules,
+		alertNotifier:  alertNotifier,
+		auditRedact:    auditRedact,
+		sessions:       webtty.NewRegistry(),
+		reattachables:  newReattachRegistry(),
+		namedSessions:  newNamedSessions(),
+		grants:         newAccessGrants(),
+		auditLogger:    auditLogger,
+		auditSink:      auditSink,
+		auditIndex:     auditIndex,
+		auditFile:      auditFile,
+		authenticator:  authenticator,
+		authChallenges: authChallenges,
+		authorizer:     authorizer,
+		metrics:        metrics,
+		quotas:         newUserQuotas(options, metrics),
+		tracker:        newSessionTracker(),
+		access:         access,
 	}, nil
 }
 
@@ -102,6 +289,18 @@ func (server *Server) Run(ctx context.Context, options ...RunOption) error {
 	// 修改url
 	path = path + "cluster/"
 
+	if server.options.Watch {
+		if err := server.startBroadcast(cctx, cancel); err != nil {
+			cancel()
+			return err
+		}
+	}
+
+	if server.options.ConfigReload && server.options.ConfigFile != "" {
+		log.Printf("Watching config file %s for runtime config changes", server.options.ConfigFile)
+		go server.watchConfigFile(cctx)
+	}
+
 	handlers := server.setupHandlers(cctx, cancel, path, counter)
 	srv, err := server.setupHTTPServer(handlers)
 	if err != nil {
@@ -141,8 +340,8 @@ func (server *Server) Run(ctx context.Context, options ...RunOption) error {
 		if server.options.EnableTLS {
 			crtFile := homedir.Expand(server.options.TLSCrtFile)
 			keyFile := homedir.Expand(server.options.TLSKeyFile)
-			log.Printf("TLS crt file: " + crtFile)
-			log.Printf("TLS key file: " + keyFile)
+			log.Printf("TLS crt file: %s", crtFile)
+			log.Printf("TLS key file: %s", keyFile)
 
 			err = srv.ServeTLS(listener, crtFile, keyFile)
 		} else {
@@ -178,6 +377,16 @@ func (server *Server) Run(ctx context.Context, options ...RunOption) error {
 		log.Printf("Waiting for %d connections to be closed", conn)
 	}
 	counter.wait()
+	if server.auditLogger != nil {
+		server.auditLogger.Close()
+	}
+	if server.alertNotifier != nil {
+		server.alertNotifier.Close()
+	}
+	// the storage of the options is the application's
+	if closer, ok := server.recordStorage.(io.Closer); ok && server.options.Storage == nil {
+		closer.Close()
+	}
 
 	return err
 }
@@ -203,7 +412,18 @@ func (server *Server) setupHandlers(ctx context.Context, cancel context.CancelFu
 
 	if server.options.EnableBasicAuth {
 		log.Printf("Using Basic Authentication")
-		siteHandler = server.wrapBasicAuth(siteHandler, server.options.Credential)
+	}
+	if server.options.JWTKeyFile != "" {
+		log.Printf("Using JWT Authentication")
+	}
+	if server.options.OIDCIssuer != "" {
+		log.Printf("Using OpenID Connect Authentication with %s", server.options.OIDCIssuer)
+	}
+	if server.authenticator != nil {
+		siteHandler = server.wrapAuth(siteHandler, false)
+	}
+	if server.metrics != nil {
+		siteHandler = server.wrapMetrics(siteHandler, siteMux, pathPrefix)
 	}
 
 	withGz := gziphandler.GzipHandler(server.wrapHeaders(siteHandler))
@@ -211,8 +431,53 @@ func (server *Server) setupHandlers(ctx context.Context, cancel context.CancelFu
 
 	wsMux := http.NewServeMux()
 	wsMux.Handle("/", siteHandler)
-	wsMux.HandleFunc(pathPrefix+"ws", server.generateHandleWS(ctx, cancel, counter))
+	wsHandler := http.Handler(server.generateHandleWS(ctx, cancel, counter))
+	if server.authenticator != nil {
+		// browsers may not give the basic credentials to WebSocket connections,
+		// which are then authenticated by the auth token of their init message
+		wsHandler = server.wrapAuth(wsHandler, server.options.EnableBasicAuth)
+	}
+	if server.access != nil && (server.access.rate > 0 || server.options.ConfigReload) {
+		// counted before the authentication, for the clients guessing credentials
+		wsHandler = server.wrapConnectionRate(wsHandler)
+	}
+	wsMux.Handle(pathPrefix+"ws", wsHandler)
+	if server.metrics != nil {
+		log.Printf("Serving Prometheus metrics at /metrics")
+		metricsHandler := http.Handler(server.metrics)
+		if server.authenticator != nil {
+			metricsHandler = server.wrapAuth(metricsHandler, false)
+		}
+		wsMux.Handle("/metrics", metricsHandler)
+	}
+	if server.options.EnableSessionAPI {
+		log.Printf("Serving the session API at %s", sessionAPIPath)
+		apiHandler := server.wrapAuth(http.HandlerFunc(server.handleSessionAPI), false)
+		wsMux.Handle(sessionAPIPath, apiHandler)
+		wsMux.Handle(sessionAPIPath+"/", apiHandler)
+
+		log.Printf("Serving the grant API at %s", grantAPIPath)
+		grantHandler := server.wrapAuth(http.HandlerFunc(server.handleGrantAPI), false)
+		wsMux.Handle(grantAPIPath, grantHandler)
+		wsMux.Handle(grantAPIPath+"/", grantHandler)
+
+		if server.options.ConfigReload {
+			log.Printf("Serving the config API at %s", configAPIPath)
+			wsMux.Handle(configAPIPath, server.wrapAuth(http.HandlerFunc(server.handleConfigAPI), false))
+		}
+	}
+	if server.auditIndex != nil {
+		log.Printf("Serving the audit search at %s", auditAPIPath)
+		wsMux.Handle(auditAPIPath, server.wrapAuth(http.HandlerFunc(server.handleAuditAPI), false))
+	}
 	siteHandler = http.Handler(wsMux)
+	if server.options.EnableNamedSessions {
+		log.Printf("Serving named sessions at %s%s<name>/", pathPrefix, namedSessionPath)
+		siteHandler = server.wrapNamedSessions(siteHandler, pathPrefix)
+	}
+	if server.access != nil && server.access.filters() {
+		siteHandler = server.wrapAccess(siteHandler)
+	}
 
 	return siteHandler
 }
@@ -249,3 +514,14 @@ func (server *Server) tlsConfig() (*tls.Config, error) {
 	}
 	return tlsConfig, nil
 }
+
+// writeBufferSize returns the size of the WebSocket write buffers, large
+// enough for an output message of bufferSize bytes encoded in base64 to be
+// sent as a single frame.
+func writeBufferSize(bufferSize int) int {
+	size := base64.StdEncoding.EncodedLen(bufferSize) + 1
+	if size < 1024 {
+		return 1024
+	}
+	return size
+}
@@ -0,0 +1,317 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// sessionAPIPath is the path of the session API, with the ID of a session
+// appended for the requests on it.
+const sessionAPIPath = "/api/sessions"
+
+// ActiveSession describes a running session.
+type ActiveSession struct {
+	ID         string    `json:"id"`
+	User       string    `json:"user"`
+	ClusterID  string    `json:"clusterId,omitempty"`
+	RemoteAddr string    `json:"remoteAddr,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	// When the master last sent input or the slave last wrote output
+	LastActivity time.Time `json:"lastActivity"`
+	// Bytes read from and written to the master
+	BytesIn  uint64 `json:"bytesIn"`
+	BytesOut uint64 `json:"bytesOut"`
+	// Whether the master may write to the command
+	PermitWrite bool `json:"permitWrite"`
+	// Tags given by the client
+	Tags map[string]string `json:"tags,omitempty"`
+	// Whether the session waits for another user to approve it
+	AwaitingApproval bool `json:"awaitingApproval,omitempty"`
+	// ID of the access grant the session runs under
+	GrantID string `json:"grantId,omitempty"`
+}
+
+func activeSession(tty *webtty.WebTTY) ActiveSession {
+	info := tty.Session()
+	stats := tty.Stats()
+	grant, _ := tty.Grant()
+	return ActiveSession{
+		ID:           info.SessionID,
+		User:         info.User,
+		ClusterID:    info.ClusterID,
+		RemoteAddr:   info.RemoteAddr,
+		StartedAt:    stats.StartedAt,
+		LastActivity: tty.LastActivity(),
+		BytesIn:      stats.BytesIn,
+		BytesOut:     stats.BytesOut,
+		PermitWrite:  tty.PermitWrite(),
+		Tags:         info.Tags,
+
+		AwaitingApproval: tty.AwaitingApproval(),
+		GrantID:          grant.ID,
+	}
+}
+
+// Sessions returns the running sessions, the oldest first.
+func (server *Server) Sessions() []ActiveSession {
+	ttys := server.tracker.list()
+	sessions := make([]ActiveSession, 0, len(ttys))
+	for _, tty := range ttys {
+		sessions = append(sessions, activeSession(tty))
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartedAt.Before(sessions[j].StartedAt)
+	})
+	return sessions
+}
+
+// Session returns the running session of id.
+func (server *Server) Session(id string) (ActiveSession, bool) {
+	tty := server.findSession(id)
+	if tty == nil {
+		return ActiveSession{}, false
+	}
+	return activeSession(tty), true
+}
+
+// KillSession terminates the running session of id, see webtty.Terminate.
+// It returns webtty.ErrSessionNotFound when no session has id.
+func (server *Server) KillSession(id string) error {
+	tty := server.findSession(id)
+	if tty == nil {
+		return webtty.ErrSessionNotFound
+	}
+	tty.Terminate()
+	return nil
+}
+
+// ResizeSession resizes the terminal of the running session of id,
+// see webtty.SetTerminalSize.
+// It returns webtty.ErrSessionNotFound when no session has id.
+func (server *Server) ResizeSession(id string, columns int, rows int) error {
+	tty := server.findSession(id)
+	if tty == nil {
+		return webtty.ErrSessionNotFound
+	}
+	return tty.SetTerminalSize(columns, rows)
+}
+
+// SetSessionWrite grants or revokes the write permission of the master
+// of the running session of id, see webtty.SetPermitWrite.
+// It returns webtty.ErrSessionNotFound when no session has id.
+func (server *Server) SetSessionWrite(id string, permitWrite bool) error {
+	tty := server.findSession(id)
+	if tty == nil {
+		return webtty.ErrSessionNotFound
+	}
+	tty.SetPermitWrite(permitWrite)
+	return nil
+}
+
+// ApproveSession resumes the running session of id waiting for its
+// approval on behalf of approver, see webtty.Approve, or closes it with
+// reason unless approved.
+// It returns webtty.ErrSessionNotFound when no session has id.
+func (server *Server) ApproveSession(id string, approver string, approved bool, reason string) error {
+	tty := server.findSession(id)
+	if tty == nil {
+		return webtty.ErrSessionNotFound
+	}
+	if approved {
+		return tty.Approve(approver)
+	}
+	return tty.Deny(approver, reason)
+}
+
+// sessionApproval is the body of the approval requests of the session API.
+type sessionApproval struct {
+	Approved *bool  `json:"approved"`
+	Reason   string `json:"reason"`
+}
+
+// sessionWrite is the body of the write permission requests of the session API.
+type sessionWrite struct {
+	PermitWrite *bool `json:"permitWrite"`
+}
+
+// sessionSize is the body of the resize requests of the session API.
+type sessionSize struct {
+	Columns int `json:"columns"`
+	Rows    int `json:"rows"`
+}
+
+func (server *Server) findSession(id string) *webtty.WebTTY {
+	for _, tty := range server.tracker.list() {
+		if tty.Session().SessionID == id {
+			return tty
+		}
+	}
+	return nil
+}
+
+// isSessionAdmin returns whether identity may use the session API.
+func (server *Server) isSessionAdmin(identity webtty.Identity) bool {
+	for _, admin := range server.options.APIAdmins {
+		if identity.User != "" && identity.User == admin {
+			return true
+		}
+	}
+	for _, group := range identity.Groups {
+		for _, admin := range server.options.APIAdminGroups {
+			if group == admin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// handleSessionAPI lists the sessions with GET /api/sessions, describes
+// one with GET /api/sessions/<ID>, kills it with DELETE /api/sessions/<ID>,
+// resizes its terminal with PUT /api/sessions/<ID>/size, grants or
+// revokes the write permission of its master with PUT /api/sessions/<ID>/write
+// and approves or denies it with PUT /api/sessions/<ID>/approval.
+func (server *Server) handleSessionAPI(w http.ResponseWriter, r *http.Request) {
+	identity, _ := webtty.IdentityFromContext(r.Context())
+	if !server.isSessionAdmin(identity) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, sessionAPIPath), "/")
+	if strings.HasSuffix(id, "/size") {
+		server.handleSessionResize(w, r, identity, strings.TrimSuffix(id, "/size"))
+		return
+	}
+	if strings.HasSuffix(id, "/write") {
+		server.handleSessionWrite(w, r, identity, strings.TrimSuffix(id, "/write"))
+		return
+	}
+	if strings.HasSuffix(id, "/approval") {
+		server.handleSessionApproval(w, r, identity, strings.TrimSuffix(id, "/approval"))
+		return
+	}
+	switch {
+	case id == "" && r.Method == "GET":
+		writeJSON(w, http.StatusOK, server.Sessions())
+	case id != "" && r.Method == "GET":
+		session, ok := server.Session(id)
+		if !ok {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, session)
+	case id != "" && r.Method == "DELETE":
+		session, ok := server.Session(id)
+		if !ok || server.KillSession(id) != nil {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Session %s of %s killed by %s", id, session.User, identity.User)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (server *Server) handleSessionResize(w http.ResponseWriter, r *http.Request, identity webtty.Identity, id string) {
+	if r.Method != "PUT" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var size sessionSize
+	if err := json.NewDecoder(r.Body).Decode(&size); err != nil || size.Columns < 1 || size.Rows < 1 {
+		http.Error(w, "Invalid size", http.StatusBadRequest)
+		return
+	}
+	session, ok := server.Session(id)
+	if !ok {
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	}
+	if err := server.ResizeSession(id, size.Columns, size.Rows); err != nil {
+		if err == webtty.ErrSessionNotFound {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to resize session %s: %s", id, err)
+		http.Error(w, "Failed to resize session", http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Session %s of %s resized to %dx%d by %s", id, session.User, size.Columns, size.Rows, identity.User)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) handleSessionWrite(w http.ResponseWriter, r *http.Request, identity webtty.Identity, id string) {
+	if r.Method != "PUT" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var write sessionWrite
+	if err := json.NewDecoder(r.Body).Decode(&write); err != nil || write.PermitWrite == nil {
+		http.Error(w, "Invalid permission", http.StatusBadRequest)
+		return
+	}
+	session, ok := server.Session(id)
+	if !ok || server.SetSessionWrite(id, *write.PermitWrite) != nil {
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	}
+	if *write.PermitWrite {
+		log.Printf("Write permission of session %s of %s granted by %s", id, session.User, identity.User)
+	} else {
+		log.Printf("Write permission of session %s of %s revoked by %s", id, session.User, identity.User)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) handleSessionApproval(w http.ResponseWriter, r *http.Request, identity webtty.Identity, id string) {
+	if r.Method != "PUT" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var approval sessionApproval
+	if err := json.NewDecoder(r.Body).Decode(&approval); err != nil || approval.Approved == nil {
+		http.Error(w, "Invalid approval", http.StatusBadRequest)
+		return
+	}
+	session, ok := server.Session(id)
+	if !ok {
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	}
+	switch err := server.ApproveSession(id, identity.User, *approval.Approved, approval.Reason); err {
+	case nil:
+	case webtty.ErrSessionNotFound:
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	case webtty.ErrSelfApproval:
+		http.Error(w, "Sessions must be approved by another user", http.StatusForbidden)
+		return
+	case webtty.ErrNotAwaitingApproval:
+		http.Error(w, "Session not awaiting approval", http.StatusConflict)
+		return
+	default:
+		log.Printf("Failed to approve session %s: %s", id, err)
+		http.Error(w, "Failed to approve session", http.StatusInternalServerError)
+		return
+	}
+	if *approval.Approved {
+		log.Printf("Session %s of %s approved by %s", id, session.User, identity.User)
+	} else {
+		log.Printf("Session %s of %s denied by %s", id, session.User, identity.User)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -15,3 +15,18 @@ type Factory interface {
 	Name() string
 	New(params map[string][]string) (Slave, error)
 }
+
+// IdentityFactory is implemented by factories creating slaves on behalf
+// of the user of the connection, NewFor is called instead of New.
+type IdentityFactory interface {
+	Factory
+	NewFor(identity webtty.Identity, params map[string][]string) (Slave, error)
+}
+
+// ClusterFactory is implemented by factories creating slaves on behalf
+// of the user of the connection for its cluster, NewForCluster is called
+// instead of NewFor and New.
+type ClusterFactory interface {
+	Factory
+	NewForCluster(identity webtty.Identity, clusterID string, params map[string][]string) (Slave, error)
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// Size of the terminal of the watched command without --width and --height,
+// as the viewers can't resize it.
+const (
+	watchColumns = 80
+	watchRows    = 24
+)
+
+// startBroadcast starts the command of the server once for --watch, its
+// output broadcast to every client, and cancels the server once it exits.
+func (server *Server) startBroadcast(ctx context.Context, cancel context.CancelFunc) error {
+	slave, err := server.factory.New(url.Values{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create backend")
+	}
+
+	titleVars := server.titleVariables(
+		[]string{"server", "slave"},
+		map[string]map[string]interface{}{
+			"server": server.options.TitleVariables,
+			"slave":  slave.WindowTitleVariables(),
+		},
+	)
+	titleBuf := new(bytes.Buffer)
+	err = server.titleTemplate.Execute(titleBuf, titleVars)
+	if err != nil {
+		slave.Close()
+		return errors.Wrapf(err, "failed to fill window title template")
+	}
+
+	opts := []webtty.Option{
+		webtty.WithCloseReason(),
+		webtty.WithWindowTitle(titleBuf.Bytes()),
+		// the viewers joining see the screen drawn so far
+		webtty.WithReplayBuffer(server.options.ReplayBufferSize),
+	}
+	if server.options.Preferences != nil {
+		opts = append(opts, webtty.WithMasterPreferences(server.options.Preferences))
+	}
+	if server.options.MaxSessionDuration > 0 {
+		opts = append(opts, webtty.WithMaxSessionDuration(time.Duration(server.options.MaxSessionDuration)*time.Second))
+	}
+	broadcast, err := webtty.NewBroadcast(slave, server.options.WatchQueueSize, opts...)
+	if err != nil {
+		slave.Close()
+		return errors.Wrapf(err, "failed to create webtty")
+	}
+
+	columns, rows := server.options.Width, server.options.Height
+	if columns <= 0 {
+		columns = watchColumns
+	}
+	if rows <= 0 {
+		rows = watchRows
+	}
+	err = broadcast.TTY().SetTerminalSize(columns, rows)
+	if err != nil {
+		slave.Close()
+		return err
+	}
+
+	server.broadcast = broadcast
+	go func() {
+		defer slave.Close()
+		err := broadcast.Run(ctx)
+		if ctx.Err() == nil {
+			log.Printf("Watched command ended (%s), %d clients evicted for being slow", err, broadcast.Evicted())
+			cancel()
+		}
+	}()
+	log.Printf("Broadcasting the command to every client, read only")
+	return nil
+}
@@ -2,19 +2,31 @@ package server
 
 import (
 	"github.com/gorilla/websocket"
+
+	"github.com/buptWYChen/gotty/webtty"
 )
 
 type wsWrapper struct {
 	*websocket.Conn
+	// frames carrying raw output are written as binary messages
+	binary bool
 }
 
 func (wsw *wsWrapper) Write(p []byte) (n int, err error) {
-	writer, err := wsw.Conn.NextWriter(websocket.TextMessage)
+	msgType := websocket.TextMessage
+	if wsw.binary && len(p) > 0 && (p[0] == webtty.Output || p[0] == webtty.CompressedOutput) {
+		msgType = websocket.BinaryMessage
+	}
+	writer, err := wsw.Conn.NextWriter(msgType)
 	if err != nil {
 		return 0, err
 	}
-	defer writer.Close()
-	return writer.Write(p)
+	n, err = writer.Write(p)
+	// the frame is flushed on close, which fails on a write timeout
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
 }
 
 func (wsw *wsWrapper) Read(p []byte) (n int, err error) {
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// yamlLine is a significant line of a YAML document.
+type yamlLine struct {
+	number  int
+	indent  int
+	content string
+}
+
+// decodeYAML decodes the subset of YAML the policies are written in:
+// block mappings and sequences, flow sequences of scalars, and plain,
+// single-quoted and double-quoted scalars, all decoded as strings.
+// The values are map[string]interface{}, []interface{} and string.
+func decodeYAML(data []byte) (interface{}, error) {
+	var lines []yamlLine
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		if strings.HasPrefix(content, "\t") {
+			return nil, errors.Errorf("line %d: tabs can't indent", i+1)
+		}
+		if content == "" || content[0] == '#' || content == "---" {
+			continue
+		}
+		lines = append(lines, yamlLine{number: i + 1, indent: len(line) - len(content), content: content})
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	decoder := &yamlDecoder{lines: lines}
+	value, err := decoder.node(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if decoder.next < len(lines) {
+		return nil, errors.Errorf("line %d: unexpected indentation", lines[decoder.next].number)
+	}
+	return value, nil
+}
+
+type yamlDecoder struct {
+	lines []yamlLine
+	next  int
+}
+
+func isYAMLItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// node decodes the mapping or the sequence at indent.
+func (decoder *yamlDecoder) node(indent int) (interface{}, error) {
+	if isYAMLItem(decoder.lines[decoder.next].content) {
+		return decoder.sequence(indent)
+	}
+	return decoder.mapping(indent)
+}
+
+func (decoder *yamlDecoder) sequence(indent int) ([]interface{}, error) {
+	sequence := []interface{}{}
+	for decoder.next < len(decoder.lines) {
+		line := decoder.lines[decoder.next]
+		if line.indent < indent || (line.indent == indent && !isYAMLItem(line.content)) {
+			// next key of the mapping the sequence is indented as
+			break
+		}
+		if line.indent > indent {
+			return nil, errors.Errorf("line %d: unexpected indentation", line.number)
+		}
+
+		item := strings.TrimLeft(line.content[1:], " ")
+		var value interface{}
+		var err error
+		switch {
+		case item == "":
+			decoder.next++
+			value, err = decoder.child(indent)
+		case yamlKey(item) >= 0:
+			// the item is a mapping starting on the line of the dash
+			itemIndent := line.indent + len(line.content) - len(item)
+			decoder.lines[decoder.next] = yamlLine{number: line.number, indent: itemIndent, content: item}
+			value, err = decoder.mapping(itemIndent)
+		default:
+			decoder.next++
+			value, err = yamlValue(item, line.number)
+		}
+		if err != nil {
+			return nil, err
+		}
+		sequence = append(sequence, value)
+	}
+	return sequence, nil
+}
+
+func (decoder *yamlDecoder) mapping(indent int) (map[string]interface{}, error) {
+	mapping := make(map[string]interface{})
+	for decoder.next < len(decoder.lines) {
+		line := decoder.lines[decoder.next]
+		if line.indent < indent || (line.indent == indent && isYAMLItem(line.content)) {
+			break
+		}
+		colon := yamlKey(line.content)
+		if line.indent > indent || colon < 0 {
+			return nil, errors.Errorf("line %d: expected a key", line.number)
+		}
+
+		key, err := yamlScalar(strings.TrimSpace(line.content[:colon]), line.number)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := mapping[key]; ok {
+			return nil, errors.Errorf("line %d: duplicate key `%s`", line.number, key)
+		}
+		decoder.next++
+
+		var value interface{}
+		if rest := strings.TrimSpace(line.content[colon+1:]); rest != "" && rest[0] != '#' {
+			value, err = yamlValue(rest, line.number)
+		} else if decoder.next < len(decoder.lines) && decoder.lines[decoder.next].indent == indent &&
+			isYAMLItem(decoder.lines[decoder.next].content) {
+			// sequences may be indented as their key
+			value, err = decoder.sequence(indent)
+		} else {
+			value, err = decoder.child(indent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		mapping[key] = value
+	}
+	return mapping, nil
+}
+
+// child decodes the node indented under the line before, nil without one.
+func (decoder *yamlDecoder) child(indent int) (interface{}, error) {
+	if decoder.next >= len(decoder.lines) || decoder.lines[decoder.next].indent <= indent {
+		return nil, nil
+	}
+	return decoder.node(decoder.lines[decoder.next].indent)
+}
+
+// yamlKey returns the index of the colon ending the key of content,
+// -1 when content is not a key.
+func yamlKey(content string) int {
+	quote := byte(0)
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case i == 0 && (c == '"' || c == '\''):
+			quote = c
+		case c == ':' && (i+1 == len(content) || content[i+1] == ' '):
+			return i
+		case c == '#' && i > 0 && content[i-1] == ' ':
+			return -1
+		}
+	}
+	return -1
+}
+
+// yamlValue decodes a flow sequence or a scalar.
+func yamlValue(s string, number int) (interface{}, error) {
+	if !strings.HasPrefix(s, "[") {
+		return yamlScalar(s, number)
+	}
+
+	sequence := []interface{}{}
+	rest := strings.TrimSpace(s[1:])
+	for {
+		if strings.HasPrefix(rest, "]") {
+			if trailing := strings.TrimSpace(rest[1:]); trailing != "" && trailing[0] != '#' {
+				return nil, errors.Errorf("line %d: unexpected `%s`", number, trailing)
+			}
+			return sequence, nil
+		}
+		end := yamlScalarEnd(rest, ",]")
+		if end < 0 {
+			return nil, errors.Errorf("line %d: unterminated sequence", number)
+		}
+		item, err := yamlScalar(strings.TrimSpace(rest[:end]), number)
+		if err != nil {
+			return nil, err
+		}
+		sequence = append(sequence, item)
+		rest = rest[end:]
+		if rest[0] == ',' {
+			rest = strings.TrimSpace(rest[1:])
+		}
+	}
+}
+
+// yamlScalarEnd returns the index of the first of delimiters in s
+// outside of quotes, -1 when there's none.
+func yamlScalarEnd(s string, delimiters string) int {
+	quote := byte(0)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.IndexByte(delimiters, c) >= 0:
+			return i
+		}
+	}
+	return -1
+}
+
+// yamlScalar decodes a plain or quoted scalar, and its trailing comment.
+func yamlScalar(s string, number int) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	switch s[0] {
+	case '"', '\'':
+		end := 1
+		for ; end < len(s); end++ {
+			if s[0] == '"' && s[end] == '\\' {
+				end++
+			} else if s[end] == s[0] {
+				if s[0] == '\'' && end+1 < len(s) && s[end+1] == '\'' {
+					end++
+					continue
+				}
+				break
+			}
+		}
+		if end >= len(s) {
+			return "", errors.Errorf("line %d: unterminated string", number)
+		}
+		if trailing := strings.TrimSpace(s[end+1:]); trailing != "" && trailing[0] != '#' {
+			return "", errors.Errorf("line %d: unexpected `%s`", number, trailing)
+		}
+		if s[0] == '\'' {
+			return strings.Replace(s[1:end], "''", "'", -1), nil
+		}
+		value, err := strconv.Unquote(s[:end+1])
+		if err != nil {
+			return "", errors.Errorf("line %d: invalid string %s", number, s[:end+1])
+		}
+		return value, nil
+	case '[', '{', '&', '*', '!', '|', '>':
+		return "", errors.Errorf("line %d: unsupported YAML `%s`", number, s)
+	}
+	if comment := strings.Index(s, " #"); comment >= 0 {
+		s = strings.TrimSpace(s[:comment])
+	}
+	return s, nil
+}
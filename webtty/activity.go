@@ -0,0 +1,78 @@
+package webtty
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LastActivity returns when the master last sent input,
+// or when Run started if it didn't yet, and the zero time before Run.
+// It tells whether the user is active, see LastPong for the connection.
+func (wt *WebTTY) LastActivity() time.Time {
+	return loadTime(&wt.lastActivity)
+}
+
+// LastPong returns when a Pong was last sent in response to a Ping
+// of the master, or when Run started if none was, and the zero time
+// before Run.
+// It tells whether the connection is healthy, even while the user is idle.
+func (wt *WebTTY) LastPong() time.Time {
+	return loadTime(&wt.lastPong)
+}
+
+func loadTime(t *int64) time.Time {
+	if nanos := atomic.LoadInt64(t); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
+	return time.Time{}
+}
+
+func storeTime(t *int64, now time.Time) {
+	atomic.StoreInt64(t, now.UnixNano())
+}
+
+// watchIdle calls expired once each time last stays unchanged for timeout,
+// until ctx is done.
+func watchIdle(ctx context.Context, timeout time.Duration, last func() time.Time, expired func()) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var notified time.Time
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		seen := last()
+		if remaining := timeout - time.Since(seen); remaining > 0 {
+			timer.Reset(remaining)
+			continue
+		}
+		if !seen.Equal(notified) {
+			notified = seen
+			expired()
+		}
+		timer.Reset(timeout)
+	}
+}
+
+// startIdleTimeout watches the input of the master for WithIdleTimeout.
+// It returns the channel closed when the master is idle for too long,
+// nil without an idle timeout, and a function stopping the watch.
+func (wt *WebTTY) startIdleTimeout(ctx context.Context) (<-chan struct{}, func()) {
+	if wt.idleTimeout <= 0 {
+		return nil, func() {}
+	}
+
+	idle := make(chan struct{})
+	var once sync.Once
+	idleCtx, stop := context.WithCancel(ctx)
+	go watchIdle(idleCtx, wt.idleTimeout, wt.LastActivity, func() {
+		once.Do(func() { close(idle) })
+	})
+	return idle, stop
+}
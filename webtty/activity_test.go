@@ -0,0 +1,125 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLastActivityAndPong(t *testing.T) {
+	dt, err := New(discardMaster{}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if !dt.LastActivity().IsZero() || !dt.LastPong().IsZero() {
+		t.Fatalf("Unexpected times before Run")
+	}
+
+	before := time.Now()
+	dt.handleMasterReadEvent([]byte("2"))
+	if dt.LastPong().Before(before) || !dt.LastActivity().IsZero() {
+		t.Fatalf("Ping counted as activity: %s, %s", dt.LastPong(), dt.LastActivity())
+	}
+	dt.handleMasterReadEvent([]byte("1ls"))
+	if dt.LastActivity().Before(before) {
+		t.Fatalf("Input not counted as activity: %s", dt.LastActivity())
+	}
+}
+
+func TestWatchIdle(t *testing.T) {
+	var last int64
+	storeTime(&last, time.Now())
+	var expired int32
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watchIdle(ctx, 20*time.Millisecond, func() time.Time { return loadTime(&last) }, func() {
+		atomic.AddInt32(&expired, 1)
+	})
+
+	time.Sleep(70 * time.Millisecond)
+	if n := atomic.LoadInt32(&expired); n != 1 {
+		t.Fatalf("Unexpected number of expirations while idle: %d", n)
+	}
+
+	storeTime(&last, time.Now())
+	time.Sleep(50 * time.Millisecond)
+	if n := atomic.LoadInt32(&expired); n != 2 {
+		t.Fatalf("Unexpected number of expirations after activity: %d", n)
+	}
+}
+
+// pipeMaster reads the frames written to its pipe and discards its output.
+type pipeMaster struct {
+	*io.PipeReader
+}
+
+func (pipeMaster) Write(p []byte) (int, error) { return len(p), nil }
+
+func TestIdleTimeout(t *testing.T) {
+	for _, active := range []bool{false, true} {
+		masterReader, masterWriter := io.Pipe()
+		slaveReader, _ := io.Pipe()
+		inputReader, slaveWriter := io.Pipe()
+		go io.Copy(ioutil.Discard, inputReader)
+		dt, err := New(pipeMaster{masterReader}, &pipeSlave{pipePair{slaveReader, slaveWriter}},
+			WithPermitWrite(), WithIdleTimeout(40*time.Millisecond))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+		go func() {
+			ticker := time.NewTicker(10 * time.Millisecond)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					frame := []byte{Ping}
+					if active {
+						frame = []byte{Input, 'l'}
+					}
+					masterWriter.Write(frame)
+				case <-ctx.Done():
+					masterWriter.Close()
+					return
+				}
+			}
+		}()
+
+		err = dt.Run(ctx, "", "")
+		cancel()
+		if active && err != context.DeadlineExceeded {
+			t.Fatalf("Unexpected error from Run() with an active master: %v", err)
+		}
+		if !active && err != ErrIdleTimeout {
+			t.Fatalf("Unexpected error from Run() with an idle master: %v", err)
+		}
+	}
+}
+
+func TestIdleTimeoutMessage(t *testing.T) {
+	rec := &frameRecorder{}
+	slaveReader, slaveWriter := io.Pipe()
+	defer slaveWriter.Close()
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}},
+		WithIdleTimeout(20*time.Millisecond),
+		WithMessages("en", Messages{IdleTimeout: "[idle for %s]"}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.Run(context.Background()); err != ErrIdleTimeout {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	frames := rec.get()
+	notice := "1" + base64.StdEncoding.EncodeToString([]byte("\r\n[idle for 20ms]\r\n"))
+	if len(frames) < 2 || frames[len(frames)-2] != notice || frames[len(frames)-1] != "Aidle timeout" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+}
@@ -0,0 +1,173 @@
+package webtty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Formats of the requests of a WebhookAlertNotifier.
+const (
+	// The CommandAlert as a JSON object
+	AlertFormatJSON = "json"
+	// A message of a Slack incoming webhook
+	AlertFormatSlack = "slack"
+	// A text message of a DingTalk robot
+	AlertFormatDingTalk = "dingtalk"
+)
+
+var alertTemplates = map[string]string{
+	AlertFormatJSON:     `{{ json . }}`,
+	AlertFormatSlack:    `{"text":{{ json .Summary }}}`,
+	AlertFormatDingTalk: `{"msgtype":"text","text":{"content":{{ json .Summary }}}}`,
+}
+
+// alertQueueSize is the number of alerts waiting to be sent
+// by a WebhookAlertNotifier.
+const alertQueueSize = 256
+
+// defaultAlertHTTPClient sends the alerts of notifiers without Client.
+var defaultAlertHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// WebhookAlertNotifier posts the alerts to an HTTP endpoint as JSON,
+// from a background worker so that a slow endpoint never blocks the
+// sessions. Alerts are dropped when its queue is full.
+// A WebhookAlertNotifier is safe for concurrent use, Close flushes it.
+type WebhookAlertNotifier struct {
+	// first for its 64-bit alignment, accessed atomically
+	dropped uint64
+
+	endpoint string
+	body     *template.Template
+	// defaultAlertHTTPClient, with a timeout of 10 seconds, when nil
+	Client *http.Client
+
+	queue      chan CommandAlert
+	closeMutex sync.RWMutex
+	closed     bool
+	stopped    chan struct{}
+}
+
+// NewWebhookAlertNotifier starts a notifier posting the alerts to endpoint in
+// format, AlertFormatJSON, AlertFormatSlack or AlertFormatDingTalk, or
+// formatted by body when it's not empty. body is a text/template executed
+// with the CommandAlert, whose json function encodes its argument as JSON,
+// such as {"title": "gotty", "text": {{ json .Summary }}}.
+func NewWebhookAlertNotifier(endpoint string, format string, body string) (*WebhookAlertNotifier, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, errors.New("command alert endpoint must be an absolute URL")
+	}
+	if body == "" {
+		var ok bool
+		body, ok = alertTemplates[format]
+		if !ok {
+			return nil, errors.Errorf("unknown command alert format `%s`", format)
+		}
+	}
+	tmpl, err := template.New("alert").Funcs(template.FuncMap{"json": alertJSON}).Parse(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse command alert template")
+	}
+
+	notifier := &WebhookAlertNotifier{
+		endpoint: endpoint,
+		body:     tmpl,
+		queue:    make(chan CommandAlert, alertQueueSize),
+		stopped:  make(chan struct{}),
+	}
+	go notifier.run()
+	return notifier, nil
+}
+
+// Notify queues alert to be sent, it returns ErrAlertQueueFull
+// when the alert is dropped.
+func (n *WebhookAlertNotifier) Notify(ctx context.Context, alert CommandAlert) error {
+	n.closeMutex.RLock()
+	defer n.closeMutex.RUnlock()
+
+	if n.closed {
+		return errors.New("command alert notifier closed")
+	}
+	select {
+	case n.queue <- alert:
+		return nil
+	default:
+		atomic.AddUint64(&n.dropped, 1)
+		return ErrAlertQueueFull
+	}
+}
+
+// Dropped returns the number of alerts dropped as the queue was full.
+func (n *WebhookAlertNotifier) Dropped() uint64 {
+	return atomic.LoadUint64(&n.dropped)
+}
+
+// Close sends the queued alerts and stops the notifier.
+func (n *WebhookAlertNotifier) Close() error {
+	n.closeMutex.Lock()
+	if n.closed {
+		n.closeMutex.Unlock()
+		return nil
+	}
+	n.closed = true
+	close(n.queue)
+	n.closeMutex.Unlock()
+
+	<-n.stopped
+	return nil
+}
+
+func (n *WebhookAlertNotifier) run() {
+	defer close(n.stopped)
+
+	for alert := range n.queue {
+		if err := n.send(alert); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func (n *WebhookAlertNotifier) send(alert CommandAlert) error {
+	var body bytes.Buffer
+	if err := n.body.Execute(&body, alert); err != nil {
+		return errors.Wrapf(err, "failed to format command alert")
+	}
+	req, err := http.NewRequest(http.MethodPost, n.endpoint, &body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create command alert request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := n.Client
+	if client == nil {
+		client = defaultAlertHTTPClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send command alert")
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("command alert endpoint answered %d", res.StatusCode)
+	}
+	return nil
+}
+
+// alertJSON encodes v for the templates, a string with its quotes.
+func alertJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
@@ -0,0 +1,197 @@
+package webtty
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// approvalMarker prefixes the requests, the approvals and the denials
+// of the sessions in the audit trail.
+const approvalMarker = "[approval] "
+
+// approval is the state of the approval of WithApproval.
+type approval struct {
+	timeout time.Duration
+
+	mutex   sync.Mutex // also serializes the output sent once approved
+	pending bool
+	// output of the slave until approved
+	held *replayBuffer
+	// receives ErrApprovalDenied or ErrApprovalTimeout
+	rejected chan error
+}
+
+// WithApproval holds the session until another user approves it with
+// Approve, the two-person rule of the privileged terminals. Until then,
+// the AwaitingApproval message is printed, the input of the master and
+// its other requests changing the session are dropped, and the latest
+// DefaultScreenLockBufferSize bytes of output are held, the slave keeps
+// running. Run returns ErrApprovalDenied once the session is denied with
+// Deny, and ErrApprovalTimeout when it isn't approved within timeout.
+// Requests, approvals and denials are recorded in the audit trail.
+func WithApproval(timeout time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if timeout <= 0 {
+			return errors.New("approval timeout must be positive")
+		}
+		wt.approval = &approval{
+			timeout:  timeout,
+			pending:  true,
+			held:     newReplayBuffer(DefaultScreenLockBufferSize),
+			rejected: make(chan error, 1),
+		}
+		return nil
+	}
+}
+
+// AwaitingApproval returns whether the session waits for its approval,
+// see WithApproval.
+func (wt *WebTTY) AwaitingApproval() bool {
+	ap := wt.approval
+	if ap == nil {
+		return false
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	return ap.pending
+}
+
+// Approve resumes the session awaiting its approval, see WithApproval,
+// on behalf of approver. It returns ErrSelfApproval when approver is
+// the user of the session, and ErrNotAwaitingApproval when the session
+// doesn't wait for its approval.
+func (wt *WebTTY) Approve(approver string) error {
+	ap, err := wt.decideApproval(approver, "approval")
+	if err != nil {
+		return err
+	}
+	defer ap.mutex.Unlock()
+
+	ap.pending = false
+	held := ap.held.contents()
+	ap.held = newReplayBuffer(DefaultScreenLockBufferSize)
+	wt.auditApproval("approved by " + approver)
+
+	// before any new output, which waits for the approval
+	message := strings.Replace(wt.messages.Approved, "%s", approver, 1)
+	if message != "" {
+		held = append([]byte("\r\n"+message+"\r\n"), held...)
+	}
+	if len(held) > 0 {
+		// a broken master is detected by the read loop
+		wt.sendHeldOutput(held)
+	}
+	return nil
+}
+
+// Deny closes the session awaiting its approval, see WithApproval,
+// on behalf of approver, Run returns ErrApprovalDenied. It returns
+// the errors of Approve.
+func (wt *WebTTY) Deny(approver string, reason string) error {
+	ap, err := wt.decideApproval(approver, "denial")
+	if err != nil {
+		return err
+	}
+	defer ap.mutex.Unlock()
+
+	ap.pending = false
+	change := "denied by " + approver
+	if reason != "" {
+		change += ": " + reason
+	}
+	wt.auditApproval(change)
+	ap.rejected <- ErrApprovalDenied
+	return nil
+}
+
+// decideApproval checks approver may decide of the pending approval,
+// and returns it locked when they may.
+func (wt *WebTTY) decideApproval(approver string, decision string) (*approval, error) {
+	ap := wt.approval
+	if ap == nil {
+		return nil, ErrNotAwaitingApproval
+	}
+	user := wt.Session().User
+	if approver == "" || approver == user {
+		wt.auditApproval("self-" + decision + " refused to " + user)
+		return nil, ErrSelfApproval
+	}
+
+	ap.mutex.Lock()
+	if !ap.pending {
+		ap.mutex.Unlock()
+		return nil, ErrNotAwaitingApproval
+	}
+	return ap, nil
+}
+
+// startApproval prints the AwaitingApproval message and arms the timeout
+// of the approval. It returns the channel receiving why the session
+// is rejected, nil without approval, and a function releasing the timer.
+func (wt *WebTTY) startApproval() (<-chan error, func()) {
+	ap := wt.approval
+	if ap == nil || !wt.AwaitingApproval() {
+		return nil, func() {}
+	}
+
+	session := wt.Session()
+	request := "requested by " + session.User
+	if session.ClusterID != "" {
+		request += " for " + session.ClusterID
+	}
+	wt.auditApproval(request + ", waiting up to " + ap.timeout.String())
+	if message := wt.messages.AwaitingApproval; message != "" {
+		// the output is held until approved, not the notice
+		wt.sendHeldOutput([]byte("\r\n" + strings.Replace(message, "%s", ap.timeout.String(), 1) + "\r\n"))
+	}
+
+	timer := time.AfterFunc(ap.timeout, func() {
+		ap.mutex.Lock()
+		defer ap.mutex.Unlock()
+
+		if ap.pending {
+			ap.pending = false
+			wt.auditApproval("expired after " + ap.timeout.String())
+			ap.rejected <- ErrApprovalTimeout
+		}
+	})
+	return ap.rejected, func() { timer.Stop() }
+}
+
+// holdForApproval holds data until the session is approved, it returns
+// false when the session doesn't wait for its approval.
+func (wt *WebTTY) holdForApproval(data []byte) bool {
+	ap := wt.approval
+	if ap == nil {
+		return false
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	if !ap.pending {
+		return false
+	}
+	ap.held.write(data)
+	return true
+}
+
+// holdReplayForApproval replaces the output held for a reattached master
+// with the contents of the replay buffer, which it didn't receive
+// as the session waits for its approval.
+func (wt *WebTTY) holdReplayForApproval(replay []byte) {
+	ap := wt.approval
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	ap.held = newReplayBuffer(DefaultScreenLockBufferSize)
+	ap.held.write(replay)
+}
+
+func (wt *WebTTY) auditApproval(change string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, approvalMarker+change)
+}
@@ -0,0 +1,127 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newApprovalTTY(t *testing.T, rec *frameRecorder, timeout time.Duration) (*WebTTY, func() []string) {
+	return newAuditedTTY(t, rec, WithPermitWrite(),
+		WithMessages("en", Messages{AwaitingApproval: "[waiting %s]", Approved: "[approved by %s]"}),
+		WithApproval(timeout))
+}
+
+func TestApproval(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, recorded := newApprovalTTY(t, rec, time.Minute)
+
+	rejected, stop := dt.startApproval()
+	defer stop()
+	if !dt.AwaitingApproval() {
+		t.Fatalf("Expected the session to await its approval")
+	}
+	if err := dt.handleMasterReadEvent([]byte("1ls")); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if input := dt.CurrentInput(); input != "" {
+		t.Fatalf("Unexpected input while awaiting approval: %q", input)
+	}
+	if err := dt.writeOutput([]byte("held")); err != nil {
+		t.Fatalf("Unexpected error from writeOutput(): %s", err)
+	}
+
+	if err := dt.Approve("alice"); err != ErrSelfApproval {
+		t.Fatalf("Unexpected error from a self-approval: %v", err)
+	}
+	if err := dt.Approve("bob"); err != nil {
+		t.Fatalf("Unexpected error from Approve(): %s", err)
+	}
+	if dt.AwaitingApproval() {
+		t.Fatalf("Expected the session to be approved")
+	}
+	if err := dt.Approve("carol"); err != ErrNotAwaitingApproval {
+		t.Fatalf("Unexpected error from a second approval: %v", err)
+	}
+	if err := dt.Deny("carol", ""); err != ErrNotAwaitingApproval {
+		t.Fatalf("Unexpected error from a denial once approved: %v", err)
+	}
+	select {
+	case err := <-rejected:
+		t.Fatalf("Unexpected rejection: %s", err)
+	default:
+	}
+
+	want := []string{
+		"1" + base64.StdEncoding.EncodeToString([]byte("\r\n[waiting 1m0s]\r\n")),
+		"1" + base64.StdEncoding.EncodeToString([]byte("\r\n[approved by bob]\r\nheld")),
+	}
+	if frames := rec.get(); !reflect.DeepEqual(frames, want) {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	wantEvents := []string{
+		approvalMarker + "requested by alice, waiting up to 1m0s",
+		approvalMarker + "self-approval refused to alice",
+		approvalMarker + "approved by bob",
+	}
+	if events := recorded(); !reflect.DeepEqual(events, wantEvents) {
+		t.Fatalf("Unexpected audit events: %q", events)
+	}
+}
+
+func TestApprovalDenied(t *testing.T) {
+	dt, recorded := newApprovalTTY(t, &frameRecorder{}, time.Minute)
+
+	done := make(chan error, 1)
+	ctx := WithSessionContext(context.Background(), SessionInfo{ClusterID: "prod"})
+	go func() { done <- dt.Run(ctx) }()
+	deadline := time.Now().Add(2 * time.Second)
+	for len(recorded()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the approval to be requested")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := dt.Deny("bob", "no change ticket"); err != nil {
+		t.Fatalf("Unexpected error from Deny(): %s", err)
+	}
+	select {
+	case err := <-done:
+		if err != ErrApprovalDenied {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected the denied session to close")
+	}
+	wantEvents := []string{
+		approvalMarker + "requested by alice for prod, waiting up to 1m0s",
+		approvalMarker + "denied by bob: no change ticket",
+	}
+	if events := recorded(); !reflect.DeepEqual(events[:2], wantEvents) {
+		t.Fatalf("Unexpected audit events: %q", events)
+	}
+}
+
+func TestApprovalTimeout(t *testing.T) {
+	dt, recorded := newApprovalTTY(t, &frameRecorder{}, 20*time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() { done <- dt.Run(context.Background()) }()
+	select {
+	case err := <-done:
+		if err != ErrApprovalTimeout {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected the session to close without approval")
+	}
+	if events := recorded(); events[len(events)-1] != approvalMarker+"expired after 20ms" {
+		t.Fatalf("Unexpected audit events: %q", events)
+	}
+	if err := dt.Approve("bob"); err != ErrNotAwaitingApproval {
+		t.Fatalf("Unexpected error from a late approval: %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package webtty
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AsyncAuditConfig configures an AsyncAuditLogger,
+// zero values select the defaults.
+type AsyncAuditConfig struct {
+	// Events waiting for delivery, 1024 by default
+	QueueSize int
+	// Most events delivered at once, 100 by default
+	BatchSize int
+	// Longest time an event waits for its batch to fill, 1 second by default
+	FlushInterval time.Duration
+	// Retries of a batch whose delivery failed, 5 by default, none when negative
+	MaxRetries int
+	// Delay before the first retry, 500ms by default, doubled for each
+	// following one up to MaxRetryBackoff, 30 seconds by default
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// File the events are appended to, as JSON lines, when the queue is
+	// full or their delivery failed after the retries. Spilled events are
+	// delivered again when the logger starts and once a batch is delivered.
+	// Events are dropped instead when empty.
+	SpillFile string
+}
+
+// AsyncAuditLogger delivers events to another logger from a background
+// worker, so that a slow collector never blocks the session loops.
+// Events are queued, delivered in batches with LogBatch when the logger
+// is a BatchAuditLogger, and retried with an exponential backoff.
+// Delivery is at least once, a batch failing halfway may be sent again.
+// An AsyncAuditLogger is safe for concurrent use, Close flushes it.
+type AsyncAuditLogger struct {
+	logger AuditLogger
+	config AsyncAuditConfig
+	queue  chan AuditEvent
+
+	closeMutex sync.RWMutex
+	closed     bool
+	done       chan struct{}
+	stopped    chan struct{}
+
+	spillMutex sync.Mutex
+	spilled    int32  // accessed atomically, the spill file has events
+	dropped    uint64 // accessed atomically
+}
+
+// NewAsyncAuditLogger starts delivering the events logged to the
+// returned logger to logger.
+func NewAsyncAuditLogger(logger AuditLogger, config AsyncAuditConfig) *AsyncAuditLogger {
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1024
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = time.Second
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 5
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = 500 * time.Millisecond
+	}
+	if config.MaxRetryBackoff <= 0 {
+		config.MaxRetryBackoff = 30 * time.Second
+	}
+
+	al := &AsyncAuditLogger{
+		logger:  logger,
+		config:  config,
+		queue:   make(chan AuditEvent, config.QueueSize),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	if info, err := os.Stat(config.SpillFile); err == nil && info.Size() > 0 {
+		al.spilled = 1
+	}
+	go al.run()
+	return al
+}
+
+// Log queues event for delivery. When the queue is full, the event is
+// spilled to the spill file, or dropped with ErrAuditQueueFull.
+func (al *AsyncAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	al.closeMutex.RLock()
+	if !al.closed {
+		select {
+		case al.queue <- event:
+			al.closeMutex.RUnlock()
+			return nil
+		default:
+		}
+	}
+	al.closeMutex.RUnlock()
+
+	err := al.spill([]AuditEvent{event})
+	if err == errNoSpillFile {
+		return ErrAuditQueueFull
+	}
+	return err
+}
+
+// Dropped returns the number of events lost, neither delivered nor spilled.
+func (al *AsyncAuditLogger) Dropped() uint64 {
+	return atomic.LoadUint64(&al.dropped)
+}
+
+// Close delivers the queued events, without retries, and stops the
+// worker. Events failing are spilled. Events logged once Close is
+// called are spilled directly.
+func (al *AsyncAuditLogger) Close() error {
+	al.closeMutex.Lock()
+	if !al.closed {
+		al.closed = true
+		close(al.done)
+	}
+	al.closeMutex.Unlock()
+
+	<-al.stopped
+	return nil
+}
+
+func (al *AsyncAuditLogger) run() {
+	defer close(al.stopped)
+
+	if atomic.LoadInt32(&al.spilled) != 0 {
+		al.replaySpill()
+	}
+
+	batch := make([]AuditEvent, 0, al.config.BatchSize)
+	var flush <-chan time.Time
+	for {
+		select {
+		case event := <-al.queue:
+			batch = append(batch, event)
+			if len(batch) < al.config.BatchSize {
+				if flush == nil {
+					flush = time.After(al.config.FlushInterval)
+				}
+				continue
+			}
+		case <-flush:
+		case <-al.done:
+		drain:
+			for {
+				select {
+				case event := <-al.queue:
+					batch = append(batch, event)
+				default:
+					break drain
+				}
+			}
+			for len(batch) > 0 {
+				n := len(batch)
+				if n > al.config.BatchSize {
+					n = al.config.BatchSize
+				}
+				al.deliver(batch[:n], 0)
+				batch = batch[n:]
+			}
+			return
+		}
+
+		flush = nil
+		if al.deliver(batch, al.config.MaxRetries) && atomic.LoadInt32(&al.spilled) != 0 {
+			// the collector is back
+			al.replaySpill()
+		}
+		batch = batch[:0]
+	}
+}
+
+// deliver sends batch to the logger, retrying up to retries times,
+// and returns whether it was delivered. The undelivered events are spilled.
+func (al *AsyncAuditLogger) deliver(batch []AuditEvent, retries int) bool {
+	backoff := al.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		n, err := al.send(batch)
+		batch = batch[n:]
+		if err == nil {
+			return true
+		}
+		if attempt >= retries {
+			fmt.Println(errors.Wrapf(err, "failed to deliver %d audit events", len(batch)))
+			al.spill(batch)
+			return false
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-al.done:
+			// Close doesn't wait for the retries
+			timer.Stop()
+			retries = attempt + 1
+		}
+		backoff *= 2
+		if backoff > al.config.MaxRetryBackoff {
+			backoff = al.config.MaxRetryBackoff
+		}
+	}
+}
+
+// send returns the number of events of batch delivered before an error.
+func (al *AsyncAuditLogger) send(batch []AuditEvent) (int, error) {
+	if logger, ok := al.logger.(BatchAuditLogger); ok {
+		err := logger.LogBatch(context.Background(), batch)
+		if err != nil {
+			return 0, err
+		}
+		return len(batch), nil
+	}
+	for i, event := range batch {
+		err := al.logger.Log(context.Background(), event)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(batch), nil
+}
+
+var errNoSpillFile = errors.New("no audit spill file")
+
+// spill appends events to the spill file, they are dropped without one.
+func (al *AsyncAuditLogger) spill(events []AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if al.config.SpillFile == "" {
+		atomic.AddUint64(&al.dropped, uint64(len(events)))
+		return errNoSpillFile
+	}
+
+	al.spillMutex.Lock()
+	defer al.spillMutex.Unlock()
+
+	file, err := os.OpenFile(al.config.SpillFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		atomic.AddUint64(&al.dropped, uint64(len(events)))
+		return errors.Wrapf(err, "failed to open audit spill file")
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, event := range events {
+		encoder.Encode(event)
+	}
+	err = writer.Flush()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		atomic.AddUint64(&al.dropped, uint64(len(events)))
+		return errors.Wrapf(err, "failed to write audit spill file")
+	}
+	atomic.StoreInt32(&al.spilled, 1)
+	return nil
+}
+
+// replaySpill takes the events of the spill file and delivers them
+// without retries, the ones failing are spilled again.
+func (al *AsyncAuditLogger) replaySpill() {
+	al.spillMutex.Lock()
+	data, err := ioutil.ReadFile(al.config.SpillFile)
+	if err == nil {
+		err = os.Truncate(al.config.SpillFile, 0)
+	}
+	if err == nil {
+		atomic.StoreInt32(&al.spilled, 0)
+	}
+	al.spillMutex.Unlock()
+	if err != nil {
+		fmt.Println(errors.Wrapf(err, "failed to read audit spill file"))
+		return
+	}
+
+	var events []AuditEvent
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		var event AuditEvent
+		if json.Unmarshal(scanner.Bytes(), &event) == nil {
+			events = append(events, event)
+		}
+	}
+
+	for len(events) > 0 {
+		n := len(events)
+		if n > al.config.BatchSize {
+			n = al.config.BatchSize
+		}
+		if !al.deliver(events[:n], 0) {
+			al.spill(events[n:])
+			return
+		}
+		events = events[n:]
+	}
+}
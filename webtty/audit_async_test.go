@@ -0,0 +1,160 @@
+package webtty
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// batchRecorder records the commands of the batches it receives,
+// after failing its first failures batches.
+type batchRecorder struct {
+	failures int32 // accessed atomically
+	release  chan struct{}
+
+	mutex   sync.Mutex
+	batches []string
+}
+
+func (br *batchRecorder) Log(ctx context.Context, event AuditEvent) error {
+	return br.LogBatch(ctx, []AuditEvent{event})
+}
+
+func (br *batchRecorder) LogBatch(ctx context.Context, events []AuditEvent) error {
+	if br.release != nil {
+		<-br.release
+	}
+	if atomic.AddInt32(&br.failures, -1) >= 0 {
+		return errors.New("collector down")
+	}
+	commands := make([]string, 0, len(events))
+	for _, event := range events {
+		commands = append(commands, event.Command)
+	}
+	br.mutex.Lock()
+	br.batches = append(br.batches, strings.Join(commands, ","))
+	br.mutex.Unlock()
+	return nil
+}
+
+func (br *batchRecorder) get() []string {
+	br.mutex.Lock()
+	defer br.mutex.Unlock()
+	return append([]string(nil), br.batches...)
+}
+
+func (br *batchRecorder) await(t *testing.T, count int) []string {
+	for deadline := time.Now().Add(2 * time.Second); len(br.get()) < count; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Unexpected batches: %q", br.get())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return br.get()
+}
+
+func logCommands(t *testing.T, logger AuditLogger, commands ...string) {
+	for _, command := range commands {
+		if err := logger.Log(context.Background(), AuditEvent{Command: command}); err != nil {
+			t.Fatalf("Unexpected error from Log(): %s", err)
+		}
+	}
+}
+
+func TestAsyncAuditLoggerBatches(t *testing.T) {
+	recorder := &batchRecorder{}
+	logger := NewAsyncAuditLogger(recorder, AsyncAuditConfig{
+		BatchSize:     3,
+		FlushInterval: 20 * time.Millisecond,
+	})
+	defer logger.Close()
+
+	logCommands(t, logger, "a", "b", "c", "d")
+	batches := recorder.await(t, 2)
+	if len(batches) != 2 || batches[0] != "a,b,c" || batches[1] != "d" {
+		t.Fatalf("Unexpected batches: %q", batches)
+	}
+}
+
+func TestAsyncAuditLoggerSpill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit-spill")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	spillFile := filepath.Join(dir, "spill")
+
+	// the first attempt and its retry fail, the batch is spilled
+	recorder := &batchRecorder{failures: 2}
+	config := AsyncAuditConfig{
+		BatchSize:     1,
+		MaxRetries:    1,
+		RetryBackoff:  time.Millisecond,
+		FlushInterval: time.Millisecond,
+		SpillFile:     spillFile,
+	}
+	logger := NewAsyncAuditLogger(recorder, config)
+	logCommands(t, logger, "lost")
+	for deadline := time.Now().Add(2 * time.Second); ; {
+		if data, _ := ioutil.ReadFile(spillFile); strings.Contains(string(data), `"command":"lost"`) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Event not spilled")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// spilled events follow the next delivered batch
+	logCommands(t, logger, "next")
+	batches := recorder.await(t, 2)
+	if batches[0] != "next" || batches[1] != "lost" {
+		t.Fatalf("Unexpected batches: %q", batches)
+	}
+	logger.Close()
+
+	// spilled events are delivered when the logger starts
+	if err := logger.Log(context.Background(), AuditEvent{Command: "closed"}); err != nil {
+		t.Fatalf("Unexpected error from Log() after Close(): %s", err)
+	}
+	recorder = &batchRecorder{}
+	logger = NewAsyncAuditLogger(recorder, config)
+	defer logger.Close()
+	if batches := recorder.await(t, 1); batches[0] != "closed" {
+		t.Fatalf("Unexpected batches: %q", batches)
+	}
+	if logger.Dropped() != 0 {
+		t.Fatalf("Unexpected dropped events: %d", logger.Dropped())
+	}
+}
+
+func TestAsyncAuditLoggerQueueFull(t *testing.T) {
+	recorder := &batchRecorder{release: make(chan struct{})}
+	logger := NewAsyncAuditLogger(recorder, AsyncAuditConfig{QueueSize: 1, BatchSize: 1})
+
+	// the worker blocks on the first event, the next one fills the queue
+	var err error
+	accepted := -1
+	for ; accepted < 100 && err == nil; accepted++ {
+		err = logger.Log(context.Background(), AuditEvent{Command: "x"})
+		time.Sleep(time.Millisecond)
+	}
+	if err != ErrAuditQueueFull || logger.Dropped() != 1 {
+		t.Fatalf("Unexpected error from Log(): %v with %d dropped", err, logger.Dropped())
+	}
+
+	// Close flushes the queued event
+	close(recorder.release)
+	logger.Close()
+	if batches := recorder.get(); len(batches) != accepted || accepted < 1 {
+		t.Fatalf("Unexpected batches: %q", batches)
+	}
+}
@@ -0,0 +1,80 @@
+package webtty
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultAuditTimeout is the time an audit request may take by default,
+// so that an unresponsive endpoint doesn't hold the senders.
+const DefaultAuditTimeout = 10 * time.Second
+
+// AuditClientConfig configures the HTTP client of NewAuditHTTPClient.
+type AuditClientConfig struct {
+	// Time each request may take, DefaultAuditTimeout when zero
+	Timeout time.Duration
+	// PEM file of the CAs verifying the endpoint,
+	// the CAs of the system when empty
+	CAFile string
+	// PEM files of the certificate and of its key presented to
+	// the endpoint for mutual TLS, none when empty
+	CertFile string
+	KeyFile  string
+	// URL of the proxy of the requests, the one of the HTTPS_PROXY,
+	// HTTP_PROXY and NO_PROXY environment variables when empty
+	Proxy string
+}
+
+// NewAuditHTTPClient returns an HTTP client for HTTPAuditLogger configured
+// by config, such as to reach an endpoint with a certificate of a private CA.
+func NewAuditHTTPClient(config AuditClientConfig) (*http.Client, error) {
+	if config.Timeout < 0 {
+		return nil, errors.New("audit timeout must not be negative")
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultAuditTimeout
+	}
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, errors.New("audit client certificate and key must be given together")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.CAFile != "" {
+		pem, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read audit CA file `%s`", config.CAFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificate in audit CA file `%s`", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load audit client certificate `%s`", config.CertFile)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	transport.TLSClientConfig = tlsConfig
+	if config.Proxy != "" {
+		proxy, err := url.Parse(config.Proxy)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, errors.Errorf("audit proxy `%s` must be an absolute URL", config.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+	return &http.Client{Transport: transport, Timeout: config.Timeout}, nil
+}
@@ -0,0 +1,325 @@
+package webtty
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// auditFileTimeFormat is the format of the suffix of the rotated audit files.
+const auditFileTimeFormat = "20060102T150405.000000000"
+
+// AuditFileRetention sets what becomes of the rotated audit files.
+// The zero value keeps them all, uncompressed.
+type AuditFileRetention struct {
+	// Compress gzips the rotated files, which get a ".gz" suffix
+	Compress bool
+	// MaxBackups is the most rotated files kept, the oldest are removed
+	// first, 0 keeps them all
+	MaxBackups int
+	// MaxAge removes the files rotated longer ago, 0 keeps them
+	MaxAge time.Duration
+}
+
+// AuditFile is a local audit trail file.
+// When the file grows beyond maxSize bytes or gets older than maxAge,
+// it is renamed with a timestamp suffix and a new file is started.
+// A zero maxSize or maxAge disables the corresponding rotation trigger.
+// The rotated files are kept according to SetRetention.
+// Writes are serialized, so one AuditFile can be shared by all sessions,
+// see WithSharedAuditFile.
+type AuditFile struct {
+	path    string
+	maxSize int
+	maxAge  time.Duration
+
+	mutex     sync.Mutex
+	file      *os.File
+	size      int
+	openedAt  time.Time
+	retention AuditFileRetention
+	// whether SetRetention was called
+	retentionSet bool
+
+	// serializes the compression and the removal of the rotated files,
+	// done in the background
+	cleanupMutex sync.Mutex
+	cleanups     sync.WaitGroup
+}
+
+var (
+	auditFiles      = map[string]*AuditFile{}
+	auditFilesMutex sync.Mutex
+)
+
+// OpenAuditFile opens the audit file at path for appending.
+// Calling it again with the same path returns the same AuditFile
+// so that concurrent sessions never rotate the file behind each other,
+// and an error when it is open already with another maxSize or maxAge.
+func OpenAuditFile(path string, maxSize int, maxAge time.Duration) (*AuditFile, error) {
+	auditFilesMutex.Lock()
+	defer auditFilesMutex.Unlock()
+
+	if af, ok := auditFiles[path]; ok {
+		if af.maxSize != maxSize || af.maxAge != maxAge {
+			return nil, errors.Errorf("audit file `%s` is already open with another rotation", path)
+		}
+		return af, nil
+	}
+
+	af := &AuditFile{
+		path:    path,
+		maxSize: maxSize,
+		maxAge:  maxAge,
+	}
+	err := af.open()
+	if err != nil {
+		return nil, err
+	}
+	auditFiles[path] = af
+
+	return af, nil
+}
+
+// Write appends p to the file, rotating it first when required.
+func (af *AuditFile) Write(p []byte) (int, error) {
+	af.mutex.Lock()
+	defer af.mutex.Unlock()
+
+	if af.needsRotation(len(p)) {
+		err := af.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := af.file.Write(p)
+	af.size += n
+	if err != nil {
+		return n, errors.Wrapf(err, "failed to write audit file `%s`", af.path)
+	}
+
+	return n, nil
+}
+
+// Close closes the underlying file,
+// once the rotated files are compressed and removed.
+func (af *AuditFile) Close() error {
+	auditFilesMutex.Lock()
+	delete(auditFiles, af.path)
+	auditFilesMutex.Unlock()
+	af.cleanups.Wait()
+
+	af.mutex.Lock()
+	defer af.mutex.Unlock()
+
+	return af.file.Close()
+}
+
+func (af *AuditFile) open() error {
+	file, err := os.OpenFile(af.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open audit file `%s`", af.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrapf(err, "failed to stat audit file `%s`", af.path)
+	}
+
+	af.file = file
+	af.size = int(info.Size())
+	af.openedAt = time.Now()
+	if af.size > 0 {
+		// a file left by a previous run is as old as its latest write at
+		// least, restarts don't postpone its rotation
+		af.openedAt = info.ModTime()
+	}
+	return nil
+}
+
+func (af *AuditFile) needsRotation(incoming int) bool {
+	if af.size == 0 {
+		return false
+	}
+	if af.maxSize > 0 && af.size+incoming > af.maxSize {
+		return true
+	}
+	if af.maxAge > 0 && time.Since(af.openedAt) > af.maxAge {
+		return true
+	}
+	return false
+}
+
+func (af *AuditFile) rotate() error {
+	err := af.file.Close()
+	if err != nil {
+		return errors.Wrapf(err, "failed to close audit file `%s`", af.path)
+	}
+
+	rotated := af.path + "." + time.Now().Format(auditFileTimeFormat)
+	err = os.Rename(af.path, rotated)
+	if err != nil {
+		return errors.Wrapf(err, "failed to rotate audit file `%s`", af.path)
+	}
+
+	err = af.open()
+	if err != nil {
+		return err
+	}
+	af.startCleanup()
+	return nil
+}
+
+// SetRetention sets what becomes of the rotated files, and applies it
+// to the files rotated already, such as by a previous run.
+// Setting the same retention again does nothing, setting another one
+// returns an error, the file being shared.
+func (af *AuditFile) SetRetention(retention AuditFileRetention) error {
+	af.mutex.Lock()
+	if af.retentionSet {
+		unchanged := af.retention == retention
+		af.mutex.Unlock()
+		if !unchanged {
+			return errors.Errorf("audit file `%s` is already kept with another retention", af.path)
+		}
+		return nil
+	}
+	af.retention = retention
+	af.retentionSet = true
+	af.mutex.Unlock()
+
+	af.startCleanup()
+	return nil
+}
+
+// startCleanup compresses and removes the rotated files in the background,
+// the writes don't wait for it.
+func (af *AuditFile) startCleanup() {
+	af.cleanups.Add(1)
+	go func() {
+		defer af.cleanups.Done()
+		if err := af.cleanup(); err != nil {
+			fmt.Println(err)
+		}
+	}()
+}
+
+// cleanup compresses the rotated files when required and removes the ones
+// past the retention.
+func (af *AuditFile) cleanup() error {
+	af.cleanupMutex.Lock()
+	defer af.cleanupMutex.Unlock()
+
+	af.mutex.Lock()
+	retention := af.retention
+	af.mutex.Unlock()
+
+	rotated, err := af.rotatedFiles()
+	if err != nil {
+		return err
+	}
+	if retention.Compress {
+		for i, file := range rotated {
+			if strings.HasSuffix(file.path, ".gz") {
+				continue
+			}
+			compressed, err := compressAuditFile(file.path)
+			if err != nil {
+				return err
+			}
+			rotated[i].path = compressed
+		}
+	}
+
+	var expired []rotatedAuditFile
+	if retention.MaxBackups > 0 && len(rotated) > retention.MaxBackups {
+		expired = rotated[:len(rotated)-retention.MaxBackups]
+		rotated = rotated[len(rotated)-retention.MaxBackups:]
+	}
+	if retention.MaxAge > 0 {
+		for len(rotated) > 0 && time.Since(rotated[0].rotatedAt) > retention.MaxAge {
+			expired = append(expired, rotated[0])
+			rotated = rotated[1:]
+		}
+	}
+	for _, file := range expired {
+		if err := os.Remove(file.path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove rotated audit file `%s`", file.path)
+		}
+	}
+	return nil
+}
+
+type rotatedAuditFile struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// rotatedFiles returns the rotated files of af, the oldest first.
+func (af *AuditFile) rotatedFiles() ([]rotatedAuditFile, error) {
+	paths, err := filepath.Glob(af.path + ".*")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list rotated audit files of `%s`", af.path)
+	}
+	var rotated []rotatedAuditFile
+	for _, path := range paths {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(path, af.path+"."), ".gz")
+		rotatedAt, err := time.ParseInLocation(auditFileTimeFormat, suffix, time.Local)
+		if err != nil {
+			// not a rotated file, such as a partial compression
+			continue
+		}
+		rotated = append(rotated, rotatedAuditFile{path: path, rotatedAt: rotatedAt})
+	}
+	sort.Slice(rotated, func(i, j int) bool { return rotated[i].rotatedAt.Before(rotated[j].rotatedAt) })
+	return rotated, nil
+}
+
+// compressAuditFile gzips the file at path, replacing it with the
+// compressed file it returns the path of.
+func compressAuditFile(path string) (string, error) {
+	compressed := path + ".gz"
+	// written aside first, so that a partial file is never taken for a rotated one
+	partial := compressed + ".tmp"
+	err := func() error {
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(partial, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		writer := gzip.NewWriter(out)
+		if _, err := io.Copy(writer, in); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		return out.Close()
+	}()
+	if err == nil {
+		err = os.Rename(partial, compressed)
+	}
+	if err != nil {
+		os.Remove(partial)
+		return "", errors.Wrapf(err, "failed to compress rotated audit file `%s`", path)
+	}
+	if err := os.Remove(path); err != nil {
+		return "", errors.Wrapf(err, "failed to remove rotated audit file `%s`", path)
+	}
+	return compressed, nil
+}
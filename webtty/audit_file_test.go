@@ -0,0 +1,228 @@
+package webtty
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuditFileRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webtty")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	af, err := OpenAuditFile(path, 64, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error from OpenAuditFile(): %s", err)
+	}
+	defer af.Close()
+
+	shared, err := OpenAuditFile(path, 64, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error from OpenAuditFile(): %s", err)
+	}
+	if shared != af {
+		t.Fatalf("Expected the same AuditFile for the same path")
+	}
+	if _, err := OpenAuditFile(path, 128, 0); err == nil {
+		t.Fatalf("Expected an error for another rotation of the same path")
+	}
+
+	line := []byte("0123456789abcdef\n") // 17 bytes
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 4; j++ {
+				if _, err := af.Write(line); err != nil {
+					t.Errorf("Unexpected error from Write(): %s", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	files, err := filepath.Glob(path + "*")
+	if err != nil {
+		t.Fatalf("Unexpected error from Glob(): %s", err)
+	}
+	total := 0
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatalf("Unexpected error from Stat(): %s", err)
+		}
+		if info.Size() > 64 {
+			t.Fatalf("File `%s` exceeds max size: %d", file, info.Size())
+		}
+		total += int(info.Size())
+	}
+	if total != 8*4*len(line) {
+		t.Fatalf("Unexpected total size: %d", total)
+	}
+	if len(files) < 2 {
+		t.Fatalf("Expected rotated files, got %v", files)
+	}
+}
+
+func TestAuditFileRequestID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webtty")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var sentID string
+	path := filepath.Join(dir, "audit.log")
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithAuditFile(path), withAuditLines(func(line string, requestID string) {
+		sentID = requestID
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	defer dt.auditFile.Close()
+
+	dt.writeAudit("user", "cluster", "ls")
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error from ReadFile(): %s", err)
+	}
+	if sentID == "" || !strings.HasSuffix(string(content), "[request-id:"+sentID+"]\n") {
+		t.Fatalf("Unexpected audit file content for ID `%s`: %q", sentID, content)
+	}
+}
+
+func TestAuditFileRetention(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webtty")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	// rotated by a previous run, past the retention
+	expired := path + "." + time.Now().Add(-2*time.Hour).Format(auditFileTimeFormat)
+	if err := ioutil.WriteFile(expired, []byte("old\n"), 0600); err != nil {
+		t.Fatalf("Unexpected error from WriteFile(): %s", err)
+	}
+	af, err := OpenAuditFile(path, 8, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error from OpenAuditFile(): %s", err)
+	}
+	retention := AuditFileRetention{Compress: true, MaxBackups: 2, MaxAge: time.Hour}
+	if err := af.SetRetention(retention); err != nil {
+		t.Fatalf("Unexpected error from SetRetention(): %s", err)
+	}
+	if err := af.SetRetention(retention); err != nil {
+		t.Fatalf("Unexpected error setting the same retention again: %s", err)
+	}
+	if err := af.SetRetention(AuditFileRetention{MaxBackups: 5}); err == nil {
+		t.Fatalf("Expected an error for another retention")
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := af.Write([]byte(line)); err != nil {
+			t.Fatalf("Unexpected error from Write(): %s", err)
+		}
+	}
+	af.Close()
+
+	files, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Unexpected error from Glob(): %s", err)
+	}
+	sort.Strings(files)
+	if len(files) != 2 {
+		t.Fatalf("Unexpected rotated files: %v", files)
+	}
+	for i, want := range []string{"second\n", "third\n"} {
+		if !strings.HasSuffix(files[i], ".gz") {
+			t.Fatalf("Rotated file `%s` not compressed", files[i])
+		}
+		file, err := os.Open(files[i])
+		if err != nil {
+			t.Fatalf("Unexpected error from Open(): %s", err)
+		}
+		reader, err := gzip.NewReader(file)
+		if err != nil {
+			t.Fatalf("Unexpected error from NewReader(): %s", err)
+		}
+		content, err := ioutil.ReadAll(reader)
+		file.Close()
+		if err != nil || string(content) != want {
+			t.Fatalf("Unexpected content of `%s`: %q, %v", files[i], content, err)
+		}
+	}
+}
+
+func TestAuditFileAgeOfExistingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webtty")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// left by a previous run, last written two hours ago
+	path := filepath.Join(dir, "audit.log")
+	if err := ioutil.WriteFile(path, []byte("old\n"), 0600); err != nil {
+		t.Fatalf("Unexpected error from WriteFile(): %s", err)
+	}
+	written := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(path, written, written); err != nil {
+		t.Fatalf("Unexpected error from Chtimes(): %s", err)
+	}
+
+	af, err := OpenAuditFile(path, 0, time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error from OpenAuditFile(): %s", err)
+	}
+	if _, err := af.Write([]byte("new\n")); err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+	af.Close()
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil || string(content) != "new\n" {
+		t.Fatalf("Expected the old file to be rotated before the write: %q, %v", content, err)
+	}
+	rotated, _ := filepath.Glob(path + ".*")
+	if len(rotated) != 1 {
+		t.Fatalf("Unexpected rotated files: %v", rotated)
+	}
+}
+
+func TestWithSharedAuditFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webtty")
+	if err != nil {
+		t.Fatalf("Unexpected error from TempDir(): %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	af, err := OpenAuditFile(path, 64, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error from OpenAuditFile(): %s", err)
+	}
+	defer af.Close()
+
+	// the rotation of the path is ignored for the shared file
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithSharedAuditFile(af), WithAuditFile(path), WithAuditFileRotation(128, 0))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if dt.auditFile != af {
+		t.Fatalf("Expected the shared AuditFile")
+	}
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithAuditFile(path), WithAuditFileRotation(128, 0)); err == nil {
+		t.Fatalf("Expected an error for another rotation of the shared file")
+	}
+}
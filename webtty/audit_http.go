@@ -0,0 +1,245 @@
+package webtty
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuditRequestIDHeader is the header carrying the ID generated
+// for each audit request, so that deliveries can be traced on the collector.
+const AuditRequestIDHeader = "X-Request-ID"
+
+const (
+	// DefaultAuditClusterHeader is the default header carrying the cluster
+	// when HTTPAuditLogger sends identity headers
+	DefaultAuditClusterHeader = "X-Cluster-ID"
+	// DefaultAuditUserHeader is the default header carrying the user
+	// when HTTPAuditLogger sends identity headers
+	DefaultAuditUserHeader = "X-User"
+)
+
+// auditRetries is the number of times an audit request
+// answered with a server error is retried.
+const auditRetries = 3
+
+// auditRetryBackoff is the delay before the first retry,
+// it doubles for each following one.
+var auditRetryBackoff = 500 * time.Millisecond
+
+// defaultAuditHTTPClient sends the audit requests of loggers without Client,
+// the timeout keeps an unresponsive endpoint from piling up requests.
+var defaultAuditHTTPClient = &http.Client{Timeout: DefaultAuditTimeout}
+
+// HTTPAuditLogger sends audit events to an HTTP endpoint.
+// The event is formatted with AuditEvent.Line, or AuditEvent.JSON with JSON.
+// With GET or HEAD, it is appended to URL escaped, which typically ends
+// with a query parameter such as "?command=", otherwise it is sent as the
+// body to URL without its query. The request ID of the event is sent in AuditRequestIDHeader.
+// Requests answered with a server error are retried in the background.
+type HTTPAuditLogger struct {
+	URL string
+	// GET when empty
+	Method  string
+	Headers map[string]string
+
+	// When true, the cluster and the user are sent in ClusterHeader and
+	// UserHeader, DefaultAuditClusterHeader and DefaultAuditUserHeader
+	// when empty, and only the command is sent as the content
+	IdentityHeaders bool
+	ClusterHeader   string
+	UserHeader      string
+
+	// When true, events are sent as JSON objects, see AuditEvent.JSON,
+	// batches as a JSON object per line
+	JSON bool
+
+	// defaultAuditHTTPClient, with a timeout of 10 seconds, when nil,
+	// see NewAuditHTTPClient
+	Client *http.Client
+	// Time each request may take whatever the timeout of Client,
+	// only the one of Client applies when zero
+	Timeout time.Duration
+	// Receives the outcome of each event when not nil
+	Metrics AuditDeliveryMetrics
+}
+
+// NewHTTPAuditLogger creates an HTTPAuditLogger sending GET requests to url.
+func NewHTTPAuditLogger(url string) *HTTPAuditLogger {
+	return &HTTPAuditLogger{URL: url}
+}
+
+// Log sends event. Only errors of the first attempt are returned.
+func (l *HTTPAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	status, err := l.send(ctx, event)
+	if err == nil && status >= http.StatusInternalServerError {
+		// the caller's context may end before the retries
+		go l.retry(event)
+		return nil
+	}
+	l.observeDelivery(status, err)
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		return errors.Errorf("audit endpoint answered %d", status)
+	}
+	return nil
+}
+
+func (l *HTTPAuditLogger) retry(event AuditEvent) {
+	var status int
+	var err error
+	backoff := auditRetryBackoff
+	for i := 0; i < auditRetries; i++ {
+		time.Sleep(backoff)
+		backoff *= 2
+
+		status, err = l.send(context.Background(), event)
+		if err != nil || status < http.StatusInternalServerError {
+			break
+		}
+	}
+	if err != nil {
+		fmt.Println(err)
+	}
+	l.observeDelivery(status, err)
+}
+
+// LogBatch sends events synchronously, without the retries of Log,
+// and returns an error unless all of them are accepted.
+// With a method sending a body and without IdentityHeaders, the events
+// are sent in a single request with a line per event and their request
+// IDs comma separated, otherwise they are sent one request each.
+func (l *HTTPAuditLogger) LogBatch(ctx context.Context, events []AuditEvent) error {
+	if len(events) > 1 && (l.IdentityHeaders || !l.sendsBody()) {
+		for _, event := range events {
+			err := l.LogBatch(ctx, []AuditEvent{event})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	status, err := l.send(ctx, events...)
+	for range events {
+		l.observeDelivery(status, err)
+	}
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		return errors.Errorf("audit endpoint answered %d", status)
+	}
+	return nil
+}
+
+func (l *HTTPAuditLogger) method() string {
+	if l.Method == "" {
+		return http.MethodGet
+	}
+	return strings.ToUpper(l.Method)
+}
+
+func (l *HTTPAuditLogger) sendsBody() bool {
+	method := l.method()
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// send returns the status of the response to the audit request
+// carrying events, which hold a single event unless sent as the body.
+func (l *HTTPAuditLogger) send(ctx context.Context, events ...AuditEvent) (int, error) {
+	lines := make([]string, 0, len(events))
+	requestIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		switch {
+		case l.JSON:
+			lines = append(lines, string(event.JSON()))
+		case l.IdentityHeaders:
+			lines = append(lines, event.Command)
+		default:
+			lines = append(lines, event.Line())
+		}
+		requestIDs = append(requestIDs, event.RequestID)
+	}
+	s := strings.Join(lines, "\n")
+
+	endpoint := l.URL + url.QueryEscape(s)
+	var body io.Reader
+	if l.sendsBody() {
+		endpoint = strings.SplitN(l.URL, "?", 2)[0]
+		body = strings.NewReader(s)
+	}
+	if l.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.Timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequest(l.method(), endpoint, body)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	if l.JSON && body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range l.Headers {
+		req.Header.Set(key, value)
+	}
+	if l.IdentityHeaders {
+		req.Header.Set(headerOrDefault(l.ClusterHeader, DefaultAuditClusterHeader), events[0].ClusterID)
+		req.Header.Set(headerOrDefault(l.UserHeader, DefaultAuditUserHeader), events[0].UserAccount)
+	}
+	req.Header.Set(AuditRequestIDHeader, strings.Join(requestIDs, ","))
+
+	client := l.Client
+	if client == nil {
+		client = defaultAuditHTTPClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+	return res.StatusCode, nil
+}
+
+func (l *HTTPAuditLogger) observeDelivery(status int, err error) {
+	if l.Metrics != nil {
+		l.Metrics.ObserveAuditDelivery(status, err == nil && status < http.StatusBadRequest)
+	}
+}
+
+// validate returns the problems of the configuration of the logger.
+func (l *HTTPAuditLogger) validate() []string {
+	var problems []string
+	endpoint, err := url.Parse(l.URL)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		problems = append(problems, "audit endpoint must be an absolute URL")
+	}
+	for key := range l.Headers {
+		if key == "" {
+			problems = append(problems, "audit request header names must not be empty")
+		}
+	}
+	return problems
+}
+
+func headerOrDefault(header string, defaultHeader string) string {
+	if header == "" {
+		return defaultHeader
+	}
+	return header
+}
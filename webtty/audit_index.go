@@ -0,0 +1,117 @@
+package webtty
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAuditSearchLimit is the number of events Search returns
+// for a query without Limit.
+const DefaultAuditSearchLimit = 100
+
+// AuditIndex is an AuditLogger keeping the latest events of the audit trail
+// in memory to search them, such as the commands typed in the sessions of
+// a change request. Once it holds its capacity, the oldest events are
+// dropped for the new ones.
+type AuditIndex struct {
+	mutex sync.RWMutex
+	// ring of the events, the oldest at next once full
+	events []AuditEvent
+	next   int
+	full   bool
+}
+
+// AuditQuery selects events of an AuditIndex, its zero fields match any event.
+type AuditQuery struct {
+	User      string
+	ClusterID string
+	SessionID string
+	// Tags the sessions of the events must all have, with their values
+	Tags map[string]string
+	// Substring of the command, matched regardless of the case
+	Command string
+	// Events from Since until before Until
+	Since time.Time
+	Until time.Time
+	// Most events returned, DefaultAuditSearchLimit when 0
+	Limit int
+}
+
+// NewAuditIndex creates an AuditIndex keeping up to capacity events.
+func NewAuditIndex(capacity int) *AuditIndex {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &AuditIndex{events: make([]AuditEvent, capacity)}
+}
+
+// Log indexes event.
+func (ai *AuditIndex) Log(ctx context.Context, event AuditEvent) error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	ai.events[ai.next] = event
+	ai.next++
+	if ai.next == len(ai.events) {
+		ai.next = 0
+		ai.full = true
+	}
+	return nil
+}
+
+// Len returns the number of events held.
+func (ai *AuditIndex) Len() int {
+	ai.mutex.RLock()
+	defer ai.mutex.RUnlock()
+
+	if ai.full {
+		return len(ai.events)
+	}
+	return ai.next
+}
+
+// Search returns the events matching query, the latest first.
+func (ai *AuditIndex) Search(query AuditQuery) []AuditEvent {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultAuditSearchLimit
+	}
+	command := strings.ToLower(query.Command)
+
+	ai.mutex.RLock()
+	defer ai.mutex.RUnlock()
+
+	held := ai.next
+	if ai.full {
+		held = len(ai.events)
+	}
+	var found []AuditEvent
+	for i := 1; i <= held && len(found) < limit; i++ {
+		event := ai.events[(ai.next-i+len(ai.events))%len(ai.events)]
+		if query.matches(event, command) {
+			found = append(found, event)
+		}
+	}
+	return found
+}
+
+// matches returns whether event matches the query, whose command is lowered.
+func (query AuditQuery) matches(event AuditEvent, command string) bool {
+	switch {
+	case query.User != "" && event.UserAccount != query.User,
+		query.ClusterID != "" && event.ClusterID != query.ClusterID,
+		query.SessionID != "" && event.SessionID != query.SessionID,
+		!query.Since.IsZero() && event.Time.Before(query.Since),
+		!query.Until.IsZero() && !event.Time.Before(query.Until),
+		command != "" && !strings.Contains(strings.ToLower(event.Command), command):
+		return false
+	}
+	for key, value := range query.Tags {
+		if tag, ok := event.Tags[key]; !ok || tag != value {
+			return false
+		}
+	}
+	return true
+}
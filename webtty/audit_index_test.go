@@ -0,0 +1,99 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditIndex(t *testing.T) {
+	index := NewAuditIndex(3)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, command := range []string{"ls", "kubectl apply", "KUBECTL delete", "whoami"} {
+		index.Log(context.Background(), AuditEvent{
+			UserAccount: "alice",
+			ClusterID:   "prod",
+			Command:     command,
+			Time:        start.Add(time.Duration(i) * time.Minute),
+			Tags:        map[string]string{"ticket": "CR-" + string(rune('1'+i%2))},
+		})
+	}
+	if index.Len() != 3 {
+		t.Fatalf("Unexpected length: %d", index.Len())
+	}
+
+	commands := func(events []AuditEvent) string {
+		var found []string
+		for _, event := range events {
+			found = append(found, event.Command)
+		}
+		return strings.Join(found, ",")
+	}
+	for _, c := range []struct {
+		query    AuditQuery
+		expected string
+	}{
+		// the oldest event is dropped, the latest comes first
+		{AuditQuery{}, "whoami,KUBECTL delete,kubectl apply"},
+		{AuditQuery{Command: "kubectl"}, "KUBECTL delete,kubectl apply"},
+		{AuditQuery{Tags: map[string]string{"ticket": "CR-2"}}, "whoami,kubectl apply"},
+		{AuditQuery{Tags: map[string]string{"ticket": "CR-2", "team": "sre"}}, ""},
+		{AuditQuery{User: "bob"}, ""},
+		{AuditQuery{ClusterID: "prod", Limit: 1}, "whoami"},
+		{AuditQuery{Since: start.Add(2 * time.Minute), Until: start.Add(3 * time.Minute)}, "KUBECTL delete"},
+	} {
+		if got := commands(index.Search(c.query)); got != c.expected {
+			t.Errorf("Unexpected events of %+v: %q, expected %q", c.query, got, c.expected)
+		}
+	}
+}
+
+func TestValidateSessionTags(t *testing.T) {
+	if err := ValidateSessionTags(map[string]string{"team": "sre", "change.request": "CR-42"}); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	for _, tags := range []map[string]string{
+		{"": "empty"},
+		{"bad name": "x"},
+		{"team": "line\nbreak"},
+		{"team": strings.Repeat("x", MaxSessionTagLength+1)},
+	} {
+		if ValidateSessionTags(tags) == nil {
+			t.Errorf("Expected an error for %q", tags)
+		}
+	}
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxSessionTags; i++ {
+		tooMany[strings.Repeat("t", i+1)] = "x"
+	}
+	if ValidateSessionTags(tooMany) == nil {
+		t.Errorf("Expected an error for %d tags", len(tooMany))
+	}
+	if _, err := New(recordingMaster{&frameRecorder{}}, &pipeSlave{}, WithSessionTags(tooMany)); err == nil {
+		t.Errorf("Expected an error from New()")
+	}
+}
+
+func TestSessionTagsAudited(t *testing.T) {
+	index := NewAuditIndex(10)
+	slaveReader, slaveWriter := io.Pipe()
+	_, discardWriter := io.Pipe()
+	dt, err := New(recordingMaster{&frameRecorder{}}, &pipeSlave{pipePair{slaveReader, discardWriter}},
+		WithAuditLifecycle(), WithAuditLogger(index))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	slaveWriter.Close()
+	dt.Run(WithSessionContext(context.Background(), SessionInfo{SessionID: "abc", Tags: map[string]string{"ticket": "CR-42"}}))
+	events := index.Search(AuditQuery{Tags: map[string]string{"ticket": "CR-42"}})
+	if len(events) == 0 || !strings.HasPrefix(events[0].Command, sessionEndMarker) {
+		t.Fatalf("Missing tagged session end event: %+v", events)
+	}
+	if got := dt.Session().Tags["ticket"]; got != "CR-42" {
+		t.Errorf("Unexpected session tag: %q", got)
+	}
+}
@@ -0,0 +1,37 @@
+package webtty
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Markers of the lifecycle events recorded with WithAuditLifecycle,
+// the session start, resize and permission ones are shared with the
+// events recorded without it.
+const (
+	sessionEndMarker = "[session-end] "
+	reconnectMarker  = "[reconnect] "
+	terminateMarker  = "[terminate] "
+)
+
+// auditLifecycle records a lifecycle event of the session,
+// when enabled with WithAuditLifecycle.
+func (wt *WebTTY) auditLifecycle(event string) {
+	if !wt.auditLifecycleEvents {
+		return
+	}
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, event)
+}
+
+// auditSessionEnd records the end of the session, Run returning err,
+// with how long it ran.
+func (wt *WebTTY) auditSessionEnd(err error, exitReason string) {
+	if errors.Cause(err) == ErrSessionTerminated {
+		wt.auditLifecycle(terminateMarker + "session " + wt.Session().SessionID + " terminated")
+	}
+	duration := time.Since(wt.startedAt).Round(time.Second)
+	wt.auditLifecycle(sessionEndMarker + "session " + wt.Session().SessionID +
+		" ended after " + duration.String() + ": " + sessionEndReason(err, exitReason))
+}
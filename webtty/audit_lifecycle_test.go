@@ -0,0 +1,62 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestAuditLifecycle(t *testing.T) {
+	audit, recorded := withAuditCommands()
+	slaveReader, slaveWriter := io.Pipe()
+	_, discardWriter := io.Pipe()
+	slave := exitingSlave{&pipeSlave{pipePair{slaveReader, discardWriter}}, "exit status 2"}
+	dt, err := New(recordingMaster{&frameRecorder{}}, slave, WithAuditLifecycle(), audit,
+		WithWriteControl(func(identity Identity) bool { return true }))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	// changes are only recorded while running
+	dt.SetPermitWrite(true)
+	dt.setRunning(true)
+	dt.SetPermitWrite(false)
+	dt.handleMasterReadEvent([]byte(string(SetPermitWrite) + "true"))
+	dt.setRunning(false)
+	expected := []string{permissionMarker + "write revoked", permissionMarker + "write granted by "}
+	if got := recorded(); len(got) != len(expected) || got[0] != expected[0] || got[1] != expected[1] {
+		t.Fatalf("Unexpected permission events: %q", got)
+	}
+
+	slaveWriter.Close()
+	dt.Run(WithSessionContext(context.Background(), SessionInfo{SessionID: "abc"}))
+	found := false
+	for _, command := range recorded() {
+		if strings.HasPrefix(command, sessionEndMarker+"session abc ended after ") {
+			found = strings.HasSuffix(command, ": exit status 2")
+		}
+	}
+	if !found {
+		t.Fatalf("Missing session end event: %q", recorded())
+	}
+}
+
+func TestSessionEndReason(t *testing.T) {
+	for _, c := range []struct {
+		err        error
+		exitReason string
+		reason     string
+	}{
+		{&closedError{ErrSlaveClosed, io.EOF}, "exit status 1", "exit status 1"},
+		{&closedError{ErrSlaveClosed, io.EOF}, "", "slave closed"},
+		{&SlaveExitError{ExitStatus{Code: -1, Signal: "killed"}, io.EOF}, "signal: killed", "signal: killed"},
+		{&closedError{ErrMasterClosed, io.EOF}, "", "master closed"},
+		{ErrSessionTerminated, "", "session terminated"},
+		{context.Canceled, "", "context canceled"},
+	} {
+		if reason := sessionEndReason(c.err, c.exitReason); reason != c.reason {
+			t.Errorf("Unexpected reason for %v: %q", c.err, reason)
+		}
+	}
+}
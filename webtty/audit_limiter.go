@@ -0,0 +1,80 @@
+package webtty
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sessionStartMarker prefixes the session start events in the audit trail.
+const sessionStartMarker = "[session-start] "
+
+// AuditLimiter spaces out audit events shared by many sessions,
+// such as the session start events fired when all clients reconnect
+// after a server restart. It lets burst events through at once,
+// then one every 1/perSecond seconds.
+// An AuditLimiter is safe for concurrent use.
+type AuditLimiter struct {
+	interval time.Duration
+	burst    int
+
+	mutex sync.Mutex
+	next  time.Time
+}
+
+// NewAuditLimiter creates an AuditLimiter allowing perSecond events
+// per second on average and bursts of up to burst events.
+// A perSecond of zero or less doesn't limit events.
+func NewAuditLimiter(perSecond float64, burst int) *AuditLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	al := &AuditLimiter{burst: burst}
+	if perSecond > 0 {
+		al.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return al
+}
+
+// reserve takes the next slot and returns how long to wait for it at now.
+func (al *AuditLimiter) reserve(now time.Time) time.Duration {
+	al.mutex.Lock()
+	defer al.mutex.Unlock()
+
+	earliest := now.Add(-time.Duration(al.burst-1) * al.interval)
+	if al.next.Before(earliest) {
+		al.next = earliest
+	}
+	delay := al.next.Sub(now)
+	if delay < 0 {
+		delay = 0
+	}
+	al.next = al.next.Add(al.interval)
+	return delay
+}
+
+// wait blocks until the next event is allowed or ctx is done.
+func (al *AuditLimiter) wait(ctx context.Context) {
+	delay := al.reserve(time.Now())
+	if delay == 0 {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+// auditSessionStart records the start of the session, after waiting
+// for the limiter when one is set. The event is recorded even when
+// the session ends before the limiter lets it through.
+func (wt *WebTTY) auditSessionStart(ctx context.Context) {
+	if wt.sessionStartLimiter != nil {
+		wt.sessionStartLimiter.wait(ctx)
+	}
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, sessionStartMarker+"session "+session.SessionID)
+}
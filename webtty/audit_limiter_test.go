@@ -0,0 +1,47 @@
+package webtty
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditLimiterReserve(t *testing.T) {
+	al := NewAuditLimiter(10, 2)
+	now := time.Now()
+
+	expected := []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond}
+	for i, delay := range expected {
+		if d := al.reserve(now); d != delay {
+			t.Fatalf("Unexpected delay of event %d: %s", i, d)
+		}
+	}
+
+	// the bucket refills while no event is recorded
+	if d := al.reserve(now.Add(time.Second)); d != 0 {
+		t.Fatalf("Unexpected delay after idling: %s", d)
+	}
+}
+
+func TestAuditSessionStart(t *testing.T) {
+	lines := make(chan string, 2)
+	limiter := NewAuditLimiter(1000, 1)
+	for i := 0; i < 2; i++ {
+		dt, err := New(discardMaster{}, &pipeSlave{},
+			WithAuditSessionStart(limiter),
+			WithSessionID("abc"),
+			withAuditLines(func(line string, requestID string) { lines <- line }),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		dt.auditSessionStart(context.Background())
+	}
+
+	for i := 0; i < 2; i++ {
+		if line := <-lines; !strings.HasSuffix(line, "[LOG:[session-start] session abc]") {
+			t.Fatalf("Unexpected session start event: %s", line)
+		}
+	}
+}
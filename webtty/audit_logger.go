@@ -0,0 +1,91 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// AuditEvent is an entry of the audit trail,
+// such as a command line submitted by the master.
+type AuditEvent struct {
+	UserAccount string    `json:"user"`
+	ClusterID   string    `json:"clusterId"`
+	SessionID   string    `json:"sessionId"`
+	RemoteAddr  string    `json:"remoteAddr,omitempty"`
+	Time        time.Time `json:"timestamp"`
+	// The command line, or the event prefixed by its marker
+	// such as "[resize] 80x24"
+	Command string `json:"command"`
+	// The keys typed for the command line as received, including
+	// editing keys and escape sequences, masked like secret lines
+	Keys string `json:"keys,omitempty"`
+	// The working directory of the shell, when reported,
+	// see WithWorkingDirTracking
+	WorkingDir string `json:"workingDir,omitempty"`
+	// Time since the master submitted the previous command line,
+	// zero for the first one and for other events
+	DurationSinceLastCommand time.Duration `json:"durationSinceLastCommand"`
+	// Identifies the event, it is also recorded in the audit file
+	RequestID string `json:"requestId"`
+	// Tags of the session, see WithSessionTags
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Line formats the event like FormatAuditLine.
+func (event AuditEvent) Line() string {
+	return formatAuditLine(event.UserAccount, event.ClusterID, event.Time, event.Command)
+}
+
+// JSON returns the event as a JSON object.
+func (event AuditEvent) JSON() []byte {
+	// no field of an event can fail to marshal
+	data, _ := json.Marshal(event)
+	return data
+}
+
+// AuditLogger ships the events of the audit trail.
+// Log is called synchronously from the session loops
+// and should return quickly, see AsyncAuditLogger for slow collectors.
+type AuditLogger interface {
+	Log(ctx context.Context, event AuditEvent) error
+}
+
+// BatchAuditLogger is an AuditLogger that can ship several events at once,
+// AsyncAuditLogger delivers its batches with LogBatch.
+type BatchAuditLogger interface {
+	AuditLogger
+	LogBatch(ctx context.Context, events []AuditEvent) error
+}
+
+// AuditLoggerFunc is a function used as an AuditLogger.
+type AuditLoggerFunc func(ctx context.Context, event AuditEvent) error
+
+// Log calls f(ctx, event).
+func (f AuditLoggerFunc) Log(ctx context.Context, event AuditEvent) error {
+	return f(ctx, event)
+}
+
+// MultiAuditLogger returns an AuditLogger sending each event to all loggers.
+// Every logger receives the event, the first error is returned.
+func MultiAuditLogger(loggers ...AuditLogger) AuditLogger {
+	return AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+		var first error
+		for _, logger := range loggers {
+			err := logger.Log(ctx, event)
+			if err != nil && first == nil {
+				first = err
+			}
+		}
+		return first
+	})
+}
+
+// nopAuditLogger discards events, it is used when no logger is set.
+type nopAuditLogger struct{}
+
+func (nopAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	return nil
+}
+
+const auditRequestIDLength = 16
@@ -0,0 +1,246 @@
+package webtty
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// recordingTransport records audit requests instead of sending them.
+type recordingTransport struct {
+	requests chan *http.Request
+	bodies   chan string
+}
+
+func (rt recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, _ := ioutil.ReadAll(req.Body)
+		body = string(data)
+	}
+	rt.requests <- req
+	rt.bodies <- body
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func newRecordingLogger(url string) (*HTTPAuditLogger, recordingTransport) {
+	rt := recordingTransport{requests: make(chan *http.Request, 1), bodies: make(chan string, 1)}
+	logger := NewHTTPAuditLogger(url)
+	logger.Client = &http.Client{Transport: rt}
+	return logger, rt
+}
+
+func TestHTTPAuditLoggerEscaping(t *testing.T) {
+	logger, rt := newRecordingLogger("http://audit.example/log?command=")
+
+	event := AuditEvent{Command: "echo a#b&c", RequestID: "id1"}
+	if err := logger.Log(context.Background(), event); err != nil {
+		t.Fatalf("Unexpected error from Log(): %s", err)
+	}
+	req, body := <-rt.requests, <-rt.bodies
+	if req.Method != http.MethodGet || body != "" {
+		t.Fatalf("Unexpected request: %s with body `%s`", req.Method, body)
+	}
+	if command := req.URL.Query().Get("command"); command != event.Line() {
+		t.Fatalf("Unexpected command in query: `%s`", command)
+	}
+}
+
+func TestHTTPAuditLoggerBody(t *testing.T) {
+	logger, rt := newRecordingLogger("http://audit.example/log?command=")
+	logger.Method = "post"
+	logger.Headers = map[string]string{"Content-Type": "text/plain"}
+
+	event := AuditEvent{Command: "echo a#b", RequestID: "id2"}
+	logger.Log(context.Background(), event)
+	req, body := <-rt.requests, <-rt.bodies
+	if req.Method != http.MethodPost || body != event.Line() {
+		t.Fatalf("Unexpected request: %s with body `%s`", req.Method, body)
+	}
+	if req.URL.RawQuery != "" {
+		t.Fatalf("Unexpected query with body: `%s`", req.URL.RawQuery)
+	}
+	if req.Header.Get("Content-Type") != "text/plain" || req.Header.Get(AuditRequestIDHeader) != "id2" {
+		t.Fatalf("Unexpected headers: %v", req.Header)
+	}
+}
+
+// statusTransport answers audit requests with statuses in turn.
+type statusTransport struct {
+	statuses chan int
+}
+
+func (st statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: <-st.statuses, Body: http.NoBody, Request: req}, nil
+}
+
+type deliveryMetrics struct {
+	deliveries chan [2]interface{}
+}
+
+func (dm deliveryMetrics) ObserveAuditDelivery(status int, delivered bool) {
+	dm.deliveries <- [2]interface{}{status, delivered}
+}
+
+func TestHTTPAuditLoggerRetry(t *testing.T) {
+	backoff := auditRetryBackoff
+	auditRetryBackoff = time.Millisecond
+	t.Cleanup(func() { auditRetryBackoff = backoff })
+
+	st := statusTransport{statuses: make(chan int, 4)}
+	metrics := deliveryMetrics{deliveries: make(chan [2]interface{}, 1)}
+	logger := NewHTTPAuditLogger("http://audit.example/log?command=")
+	logger.Client = &http.Client{Transport: st}
+	logger.Metrics = metrics
+
+	st.statuses <- http.StatusBadRequest
+	if err := logger.Log(context.Background(), AuditEvent{Command: "ls"}); err == nil {
+		t.Fatalf("Expected an error for a rejected event")
+	}
+	if delivery := <-metrics.deliveries; delivery != [2]interface{}{http.StatusBadRequest, false} {
+		t.Fatalf("Unexpected delivery: %v", delivery)
+	}
+
+	st.statuses <- http.StatusServiceUnavailable
+	st.statuses <- http.StatusBadGateway
+	st.statuses <- http.StatusOK
+	if err := logger.Log(context.Background(), AuditEvent{Command: "ls"}); err != nil {
+		t.Fatalf("Unexpected error from Log() before retries: %s", err)
+	}
+	if delivery := <-metrics.deliveries; delivery != [2]interface{}{http.StatusOK, true} {
+		t.Fatalf("Unexpected delivery after retries: %v", delivery)
+	}
+	if len(st.statuses) != 0 {
+		t.Fatalf("Unexpected number of requests")
+	}
+}
+
+func TestHTTPAuditLoggerIdentityHeaders(t *testing.T) {
+	logger, rt := newRecordingLogger("http://audit.example/log")
+	logger.Method = http.MethodPost
+	logger.IdentityHeaders = true
+	logger.UserHeader = "X-Account"
+
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithAuditLogger(logger))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.auditCommand("alice", "cluster-1", "ls", "", 0)
+	req, body := <-rt.requests, <-rt.bodies
+	if body != "ls" {
+		t.Fatalf("Unexpected body: `%s`", body)
+	}
+	if req.Header.Get(DefaultAuditClusterHeader) != "cluster-1" || req.Header.Get("X-Account") != "alice" {
+		t.Fatalf("Unexpected headers: %v", req.Header)
+	}
+}
+
+func TestHTTPAuditLoggerValidation(t *testing.T) {
+	logger := NewHTTPAuditLogger("/relative")
+	logger.Headers = map[string]string{"": "x"}
+	_, err := New(discardMaster{}, &pipeSlave{}, WithAuditLogger(logger))
+	oe, ok := err.(*OptionsError)
+	if !ok || len(oe.Errors) != 2 {
+		t.Fatalf("Unexpected error from New(): %v", err)
+	}
+}
+
+func TestHTTPAuditLoggerBatch(t *testing.T) {
+	logger, rt := newRecordingLogger("http://audit.example/log?command=")
+	logger.Method = "POST"
+
+	events := []AuditEvent{{Command: "ls", RequestID: "id1"}, {Command: "pwd", RequestID: "id2"}}
+	if err := logger.LogBatch(context.Background(), events); err != nil {
+		t.Fatalf("Unexpected error from LogBatch(): %s", err)
+	}
+	req, body := <-rt.requests, <-rt.bodies
+	if body != events[0].Line()+"\n"+events[1].Line() || req.Header.Get(AuditRequestIDHeader) != "id1,id2" {
+		t.Fatalf("Unexpected request with body `%s` and headers %v", body, req.Header)
+	}
+
+	// GET sends a request per event
+	logger.Method = ""
+	go logger.LogBatch(context.Background(), events)
+	for _, event := range events {
+		req := <-rt.requests
+		<-rt.bodies
+		if command := req.URL.Query().Get("command"); command != event.Line() {
+			t.Fatalf("Unexpected command in query: `%s`", command)
+		}
+	}
+}
+
+func TestHTTPAuditLoggerJSON(t *testing.T) {
+	logger, rt := newRecordingLogger("http://audit.example/log")
+	logger.Method = http.MethodPost
+	logger.JSON = true
+
+	event := AuditEvent{UserAccount: "alice", Command: "ls", Keys: "l\x1b[Cs", RequestID: "id1"}
+	if err := logger.Log(context.Background(), event); err != nil {
+		t.Fatalf("Unexpected error from Log(): %s", err)
+	}
+	req, body := <-rt.requests, <-rt.bodies
+	if body != string(event.JSON()) || req.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("Unexpected request with body `%s` and headers %v", body, req.Header)
+	}
+}
+
+func TestNewAuditHTTPClient(t *testing.T) {
+	collector := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer collector.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: collector.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := NewHTTPAuditLogger(collector.URL + "/?command=")
+	logger.Client = &http.Client{}
+	if err := logger.Log(context.Background(), AuditEvent{Command: "ls"}); err == nil {
+		t.Fatalf("Expected the certificate of the private CA to be rejected")
+	}
+	logger.Client, _ = NewAuditHTTPClient(AuditClientConfig{CAFile: caFile})
+	if logger.Client == nil || logger.Client.Timeout != DefaultAuditTimeout {
+		t.Fatalf("Unexpected client: %v", logger.Client)
+	}
+	if err := logger.Log(context.Background(), AuditEvent{Command: "ls"}); err != nil {
+		t.Fatalf("Unexpected error from Log() with the private CA: %s", err)
+	}
+
+	for _, config := range []AuditClientConfig{
+		{CAFile: filepath.Join(t.TempDir(), "missing.crt")},
+		{CertFile: caFile},
+		{Proxy: "proxy:3128"},
+		{Timeout: -time.Second},
+	} {
+		if _, err := NewAuditHTTPClient(config); err == nil {
+			t.Fatalf("Expected an error for %+v", config)
+		}
+	}
+}
+
+func TestHTTPAuditLoggerTimeout(t *testing.T) {
+	release := make(chan struct{})
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer collector.Close()
+	defer close(release)
+
+	logger := NewHTTPAuditLogger(collector.URL + "/?command=")
+	logger.Client = &http.Client{}
+	logger.Timeout = 50 * time.Millisecond
+	start := time.Now()
+	if err := logger.Log(context.Background(), AuditEvent{Command: "ls"}); err == nil {
+		t.Fatalf("Expected the hung collector to time out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Log() held for %s", elapsed)
+	}
+}
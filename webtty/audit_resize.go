@@ -0,0 +1,63 @@
+package webtty
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// resizeMarker prefixes terminal size changes in the audit trail.
+const resizeMarker = "[resize] "
+
+// resizeAuditDelay is how long the size must stay unchanged before it is
+// recorded, dragging a window sends many resizes in a row.
+const resizeAuditDelay = 500 * time.Millisecond
+
+// resizeAudit coalesces the resizes recorded in the audit trail.
+// Only the size remaining after a burst of resizes is recorded,
+// and only when it differs from the last recorded one.
+type resizeAudit struct {
+	mutex          sync.Mutex
+	timer          *time.Timer
+	columns, rows  int
+	audited        bool
+	auditedColumns int
+	auditedRows    int
+}
+
+// auditResize records a terminal size applied to the slave.
+// It never blocks the caller on the audit sinks.
+func (wt *WebTTY) auditResize(columns int, rows int) {
+	ra := &wt.resizeAudit
+	ra.mutex.Lock()
+	defer ra.mutex.Unlock()
+
+	ra.columns, ra.rows = columns, rows
+	if ra.timer != nil {
+		ra.timer.Reset(resizeAuditDelay)
+		return
+	}
+	ra.timer = time.AfterFunc(resizeAuditDelay, wt.flushResizeAudit)
+}
+
+// flushResizeAudit records the pending size, if any.
+func (wt *WebTTY) flushResizeAudit() {
+	ra := &wt.resizeAudit
+	ra.mutex.Lock()
+	if ra.timer == nil {
+		ra.mutex.Unlock()
+		return
+	}
+	ra.timer.Stop()
+	ra.timer = nil
+	columns, rows := ra.columns, ra.rows
+	unchanged := ra.audited && columns == ra.auditedColumns && rows == ra.auditedRows
+	ra.audited, ra.auditedColumns, ra.auditedRows = true, columns, rows
+	ra.mutex.Unlock()
+
+	if unchanged {
+		return
+	}
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, fmt.Sprintf("%s%dx%d", resizeMarker, columns, rows))
+}
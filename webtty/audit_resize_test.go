@@ -0,0 +1,43 @@
+package webtty
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuditResizeCoalesces(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		lines []string
+	)
+	dt, err := New(discardMaster{}, &pipeSlave{}, withAuditLines(func(line string, requestID string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		lines = append(lines, line)
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for columns := 80; columns < 100; columns++ {
+		dt.auditResize(columns, 24)
+	}
+	time.Sleep(2 * resizeAuditDelay)
+	// the same size again is not recorded
+	dt.auditResize(99, 24)
+	dt.flushResizeAudit()
+	dt.auditResize(80, 24)
+	dt.flushResizeAudit()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(lines) != 2 {
+		t.Fatalf("Unexpected audit lines: %q", lines)
+	}
+	for i, size := range []string{"99x24", "80x24"} {
+		if want := "[LOG:" + resizeMarker + size + "]"; lines[i][len(lines[i])-len(want):] != want {
+			t.Errorf("Unexpected audit line: %q", lines[i])
+		}
+	}
+}
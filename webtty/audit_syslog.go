@@ -0,0 +1,55 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package webtty
+
+import (
+	"context"
+	"log/syslog"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SyslogAuditLogger sends audit events to syslog.
+// The event is formatted with AuditEvent.Line, followed by its request ID,
+// or with AuditEvent.JSON, and logged with the info severity.
+type SyslogAuditLogger struct {
+	// When true, events are logged as JSON objects
+	JSON bool
+
+	writer *syslog.Writer
+}
+
+// NewSyslogAuditLogger connects to the syslog daemon at addr, "local" for
+// the daemon of this host or an address such as "udp://host:514".
+// The entries are tagged with tag, the name of the program when empty.
+func NewSyslogAuditLogger(addr string, tag string) (*SyslogAuditLogger, error) {
+	network, raddr := "", ""
+	if addr != "local" {
+		parts := strings.SplitN(addr, "://", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid syslog address `%s`", addr)
+		}
+		network, raddr = parts[0], parts[1]
+	}
+
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to syslog")
+	}
+	return &SyslogAuditLogger{writer: writer}, nil
+}
+
+// Log sends event to syslog.
+func (l *SyslogAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	if l.JSON {
+		return l.writer.Info(string(event.JSON()))
+	}
+	return l.writer.Info(event.Line() + " [request-id:" + event.RequestID + "]")
+}
+
+// Close closes the connection to syslog.
+func (l *SyslogAuditLogger) Close() error {
+	return l.writer.Close()
+}
@@ -0,0 +1,50 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package webtty
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSyslogAuditLogger(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error from ListenPacket(): %s", err)
+	}
+	defer conn.Close()
+
+	logger, err := NewSyslogAuditLogger("udp://"+conn.LocalAddr().String(), "gotty")
+	if err != nil {
+		t.Fatalf("Unexpected error from NewSyslogAuditLogger(): %s", err)
+	}
+	defer logger.Close()
+
+	event := AuditEvent{UserAccount: "alice", ClusterID: "cluster-1", Time: time.Now(), Command: "ls", RequestID: "abc"}
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, 1024)
+		n, _, _ := conn.ReadFrom(buf)
+		received <- string(buf[:n])
+	}()
+	if err := MultiAuditLogger(logger).Log(context.Background(), event); err != nil {
+		t.Fatalf("Unexpected error from Log(): %s", err)
+	}
+
+	select {
+	case message := <-received:
+		if !strings.Contains(message, "gotty") || !strings.HasSuffix(strings.TrimSpace(message), event.Line()+" [request-id:abc]") {
+			t.Fatalf("Unexpected syslog message: %q", message)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("No syslog message received")
+	}
+
+	if _, err := NewSyslogAuditLogger("host:514", ""); err == nil {
+		t.Fatalf("Expected an error for an address without network")
+	}
+}
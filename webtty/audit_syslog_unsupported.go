@@ -0,0 +1,30 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package webtty
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// SyslogAuditLogger sends audit events to syslog,
+// which is not supported on this platform.
+type SyslogAuditLogger struct {
+	// When true, events are logged as JSON objects
+	JSON bool
+}
+
+// NewSyslogAuditLogger fails, syslog is not supported on this platform.
+func NewSyslogAuditLogger(addr string, tag string) (*SyslogAuditLogger, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
+
+func (l *SyslogAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	return errors.New("syslog is not supported on this platform")
+}
+
+func (l *SyslogAuditLogger) Close() error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBroadcastQueueSize is the number of frames queued for each viewer
+// of a Broadcast by default.
+const DefaultBroadcastQueueSize = 256
+
+// Broadcast runs a session whose output is written to any number of
+// viewers, the read-only masters watching it, such as the clients of
+// `gotty --watch top`, which share a single process of the command instead
+// of running one each. Each viewer is written to from its own queue of
+// frames, a viewer too slow to keep up with the output fills its queue and
+// is evicted, so that it doesn't hold the others.
+//
+// The options apply to the session as with New, WithMaxObservers limits
+// the number of viewers. With WithReplayBuffer, the viewers joining
+// receive the latest output of the session first.
+type Broadcast struct {
+	wt     *WebTTY
+	hub    *hubMaster
+	done   chan struct{}
+	viewed int64 // accessed atomically
+}
+
+// NewBroadcast creates a broadcast of slave, each viewer being written to
+// through a queue of queueSize frames, or DefaultBroadcastQueueSize when 0.
+func NewBroadcast(slave Slave, queueSize int, options ...Option) (*Broadcast, error) {
+	if queueSize < 0 {
+		return nil, errors.New("broadcast queue size must not be negative")
+	}
+	if queueSize == 0 {
+		queueSize = DefaultBroadcastQueueSize
+	}
+	hub := &hubMaster{closed: make(chan struct{})}
+	options = append(options, WithObserverQueueSize(queueSize))
+	wt, err := New(hub, slave, options...)
+	if err != nil {
+		return nil, err
+	}
+	wt.observerReplay = true
+
+	return &Broadcast{wt: wt, hub: hub, done: make(chan struct{})}, nil
+}
+
+// TTY returns the session of the broadcast, such as to set its terminal
+// size or to read its stats.
+func (b *Broadcast) TTY() *WebTTY {
+	return b.wt
+}
+
+// Run runs the session until the slave closes or ctx is canceled, as Run
+// of WebTTY does, then ends the Watch of every viewer.
+func (b *Broadcast) Run(ctx context.Context) error {
+	defer close(b.done)
+	defer b.hub.Close()
+
+	return b.wt.Run(ctx)
+}
+
+// Watch attaches master to the broadcast as a viewer, and blocks until
+// the reads of master fail, it's evicted, the broadcast ends or ctx is
+// canceled. It returns nil when the broadcast ended, ErrViewerEvicted when
+// master was too slow, and an error matching ErrMasterClosed when master
+// failed. An evicted master is closed when it implements io.Closer.
+func (b *Broadcast) Watch(ctx context.Context, master Master) error {
+	select {
+	case <-b.done:
+		return ErrBroadcastEnded
+	default:
+	}
+
+	v := &viewer{
+		watchedMaster: watchedMaster{Master: master, failed: make(chan struct{})},
+		evicted:       make(chan struct{}),
+	}
+	err := b.wt.AddObserver(v)
+	if err != nil {
+		return err
+	}
+	atomic.AddInt64(&b.viewed, 1)
+	defer atomic.AddInt64(&b.viewed, -1)
+
+	select {
+	case <-v.evicted:
+		return ErrViewerEvicted
+	case <-v.failed:
+		return &closedError{sentinel: ErrMasterClosed, cause: v.err}
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Viewers returns the number of masters watching the broadcast.
+func (b *Broadcast) Viewers() int {
+	return int(atomic.LoadInt64(&b.viewed))
+}
+
+// Evicted returns the number of viewers evicted for being too slow.
+func (b *Broadcast) Evicted() uint64 {
+	return b.wt.DroppedObservers()
+}
+
+// viewer is a master watching a broadcast, closed once evicted.
+type viewer struct {
+	watchedMaster
+	evicted   chan struct{}
+	evictOnce sync.Once
+}
+
+func (v *viewer) Close() error {
+	v.evictOnce.Do(func() { close(v.evicted) })
+	if closer, ok := v.Master.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// hubMaster is the master of a broadcast, whose output only goes to the
+// viewers. It reads no frames until it's closed.
+type hubMaster struct {
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (hm *hubMaster) Read(p []byte) (int, error) {
+	<-hm.closed
+	return 0, io.EOF
+}
+
+func (hm *hubMaster) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (hm *hubMaster) Close() error {
+	hm.closeOnce.Do(func() { close(hm.closed) })
+	return nil
+}
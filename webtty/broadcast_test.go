@@ -0,0 +1,93 @@
+package webtty
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// stallingMaster blocks its writes once stall is closed, until release is.
+type stallingMaster struct {
+	stall   chan struct{}
+	release chan struct{}
+}
+
+func (stallingMaster) Read(p []byte) (int, error) { select {} }
+func (sm stallingMaster) Write(p []byte) (int, error) {
+	select {
+	case <-sm.stall:
+		<-sm.release
+	default:
+	}
+	return len(p), nil
+}
+
+func waitFor(t *testing.T, what string, ok func() bool) {
+	for i := 0; i < 200 && !ok(); i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !ok() {
+		t.Fatalf("Timed out waiting for %s", what)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	b, err := NewBroadcast(&pipeSlave{pipePair{slaveReader, nil}}, 2, WithReplayBuffer(1024))
+	if err != nil {
+		t.Fatalf("Unexpected error from NewBroadcast(): %s", err)
+	}
+	ran := make(chan error, 1)
+	go func() { ran <- b.Run(context.Background()) }()
+
+	watch := func(master Master) chan error {
+		watched := make(chan error, 1)
+		go func() { watched <- b.Watch(context.Background(), master) }()
+		return watched
+	}
+
+	first := &frameRecorder{}
+	firstWatched := watch(recordingMaster{first})
+	waitFor(t, "the first viewer", func() bool { return b.Viewers() == 1 })
+	slaveWriter.Write([]byte("hello"))
+	waitFor(t, "the output of the first viewer", func() bool { return hasFrame(first.get(), "1aGVsbG8=") })
+
+	// the viewers joining receive the latest output
+	late := &frameRecorder{}
+	watch(recordingMaster{late})
+	waitFor(t, "the replay of the late viewer", func() bool { return hasFrame(late.get(), "1aGVsbG8=") })
+
+	slow := stallingMaster{make(chan struct{}), make(chan struct{})}
+	defer close(slow.release)
+	slowWatched := watch(slow)
+	waitFor(t, "the slow viewer", func() bool { return b.Viewers() == 3 })
+	close(slow.stall)
+	for i := 0; i < 10; i++ {
+		slaveWriter.Write([]byte("tick"))
+		time.Sleep(2 * time.Millisecond)
+	}
+	select {
+	case err := <-slowWatched:
+		if err != ErrViewerEvicted {
+			t.Fatalf("Unexpected error from Watch() of the slow viewer: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected the slow viewer to be evicted")
+	}
+	if n := b.Evicted(); n != 1 {
+		t.Fatalf("Unexpected evicted viewers: %d", n)
+	}
+
+	slaveWriter.Close()
+	if err := <-ran; !stderrors.Is(err, ErrSlaveClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if err := <-firstWatched; err != nil {
+		t.Fatalf("Unexpected error from Watch(): %v", err)
+	}
+	if err := b.Watch(context.Background(), recordingMaster{&frameRecorder{}}); err != ErrBroadcastEnded {
+		t.Fatalf("Unexpected error from Watch() once ended: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package webtty
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// CaptureRecord is a frame recorded by WithSessionCapture.
+// The capture is a stream of records encoded as JSON, one per line,
+// in the order the frames were read from or written to the master.
+type CaptureRecord struct {
+	// Time elapsed since the capture started
+	Offset time.Duration `json:"offset"`
+	// MasterToSlave for frames read from the master,
+	// SlaveToMaster for frames written to it
+	Direction Direction `json:"direction"`
+	// Raw frame, message type included
+	Frame []byte `json:"frame"`
+}
+
+// sessionCapture writes CaptureRecords to a writer.
+// Capturing stops silently when the writer fails
+// or the capture would grow beyond maxBytes.
+type sessionCapture struct {
+	writer   io.Writer
+	maxBytes int
+
+	mutex   sync.Mutex
+	started time.Time
+	written int
+	stopped bool
+}
+
+func newSessionCapture(writer io.Writer, maxBytes int) *sessionCapture {
+	return &sessionCapture{
+		writer:   writer,
+		maxBytes: maxBytes,
+		started:  time.Now(),
+	}
+}
+
+func (sc *sessionCapture) record(direction Direction, frame []byte) {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	if sc.stopped {
+		return
+	}
+
+	line, err := json.Marshal(CaptureRecord{
+		Offset:    time.Since(sc.started),
+		Direction: direction,
+		Frame:     frame,
+	})
+	if err != nil {
+		sc.stopped = true
+		return
+	}
+	line = append(line, '\n')
+
+	if sc.maxBytes > 0 && sc.written+len(line) > sc.maxBytes {
+		sc.stopped = true
+		return
+	}
+	n, err := sc.writer.Write(line)
+	sc.written += n
+	if err != nil {
+		sc.stopped = true
+	}
+}
@@ -0,0 +1,45 @@
+package webtty
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSessionCapture(t *testing.T) {
+	capture := &bytes.Buffer{}
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithSessionCapture(capture, 200))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.capture.record(MasterToSlave, []byte{Input, 'l'})
+	dt.sendOutput([]byte("l"))
+	// exceeds the limit, stops the capture
+	dt.sendOutput(bytes.Repeat([]byte("x"), 200))
+	dt.sendOutput([]byte("s"))
+
+	var records []CaptureRecord
+	scanner := bufio.NewScanner(capture)
+	for scanner.Scan() {
+		var record CaptureRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Unexpected error from Unmarshal(): %s", err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("Unexpected number of records: %d", len(records))
+	}
+	if records[0].Direction != MasterToSlave || string(records[0].Frame) != "1l" {
+		t.Fatalf("Unexpected first record: %+v", records[0])
+	}
+	if records[1].Direction != SlaveToMaster || string(records[1].Frame) != "1bA==" {
+		t.Fatalf("Unexpected second record: %+v", records[1])
+	}
+	if records[1].Offset < records[0].Offset {
+		t.Fatalf("Unexpected record offsets: %v, %v", records[0].Offset, records[1].Offset)
+	}
+}
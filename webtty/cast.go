@@ -0,0 +1,223 @@
+package webtty
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// castRecorder writes a session in the asciicast v2 format of asciinema,
+// a header line followed by one line per output, input or resize event.
+// Events are buffered and flushed when Run returns,
+// recording stops silently when the writer fails.
+type castRecorder struct {
+	mutex   sync.Mutex
+	writer  *bufio.Writer
+	started time.Time
+	// leading bytes of a rune split across outputs or inputs
+	partial      []byte
+	partialInput []byte
+	failed       bool
+	// encoding of the current event, reused across events
+	line []byte
+}
+
+type castHeader struct {
+	Version   int   `json:"version"`
+	Width     int   `json:"width"`
+	Height    int   `json:"height"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// size of the terminal in the header when it's not set with options
+const (
+	defaultCastColumns = 80
+	defaultCastRows    = 24
+)
+
+func newCastRecorder(writer io.Writer) *castRecorder {
+	return &castRecorder{writer: bufio.NewWriter(writer)}
+}
+
+// start writes the header, event times are relative to now.
+func (cr *castRecorder) start(now time.Time, columns int, rows int) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	if columns <= 0 {
+		columns = defaultCastColumns
+	}
+	if rows <= 0 {
+		rows = defaultCastRows
+	}
+	cr.started = now
+	cr.writeLocked(castHeader{
+		Version:   2,
+		Width:     columns,
+		Height:    rows,
+		Timestamp: now.Unix(),
+	})
+}
+
+// output records raw output of the slave.
+func (cr *castRecorder) output(data []byte) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	cr.textEventLocked("o", data, &cr.partial)
+}
+
+// input records keys forwarded to the slave.
+func (cr *castRecorder) input(data []byte) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	cr.textEventLocked("i", data, &cr.partialInput)
+}
+
+// textEventLocked records data, keeping an incomplete rune at its end
+// in partial for the next event of the kind.
+func (cr *castRecorder) textEventLocked(kind string, data []byte, partial *[]byte) {
+	if len(*partial) > 0 {
+		data = append(*partial, data...)
+		*partial = nil
+	}
+	// events are JSON strings, a split rune is recorded once complete
+	if i := incompleteRuneStart(data); i < len(data) {
+		*partial = append([]byte(nil), data[i:]...)
+		data = data[:i]
+	}
+	if len(data) > 0 {
+		cr.eventLocked(kind, data)
+	}
+}
+
+// resize records a terminal size applied to the slave.
+func (cr *castRecorder) resize(columns int, rows int) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	cr.eventLocked("r", []byte(fmt.Sprintf("%dx%d", columns, rows)))
+}
+
+// eventLocked writes an event line, encoded by hand in a reused buffer
+// as the output of busy sessions is recorded for every read.
+func (cr *castRecorder) eventLocked(kind string, data []byte) {
+	if cr.failed {
+		return
+	}
+	elapsed := time.Since(cr.started).Seconds()
+	line := append(cr.line[:0], '[')
+	line = strconv.AppendFloat(line, elapsed, 'f', -1, 64)
+	line = append(line, `,"`...)
+	line = append(line, kind...)
+	line = append(line, `",`...)
+	line = appendJSONString(line, data)
+	line = append(line, "]\n"...)
+	cr.line = line
+	if _, err := cr.writer.Write(line); err != nil {
+		cr.failed = true
+	}
+}
+
+func (cr *castRecorder) writeLocked(v interface{}) {
+	if cr.failed {
+		return
+	}
+	line, err := json.Marshal(v)
+	if err == nil {
+		_, err = cr.writer.Write(append(line, '\n'))
+	}
+	if err != nil {
+		cr.failed = true
+	}
+}
+
+// flush writes the buffered events, with the bytes of an incomplete rune.
+func (cr *castRecorder) flush() {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	if len(cr.partial) > 0 {
+		cr.eventLocked("o", cr.partial)
+		cr.partial = nil
+	}
+	if len(cr.partialInput) > 0 {
+		cr.eventLocked("i", cr.partialInput)
+		cr.partialInput = nil
+	}
+	if !cr.failed && cr.writer.Flush() != nil {
+		cr.failed = true
+	}
+}
+
+// incompleteRuneStart returns the index of the incomplete rune
+// at the end of data, or len(data) when the last rune is complete.
+func incompleteRuneStart(data []byte) int {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if utf8.FullRune(data[i:]) {
+				return len(data)
+			}
+			return i
+		}
+	}
+	return len(data)
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends data encoded as a JSON string to dst,
+// escaping as encoding/json does. Invalid UTF-8 is replaced with U+FFFD.
+func appendJSONString(dst []byte, data []byte) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(data); {
+		if b := data[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, data[start:i]...)
+			switch b {
+			case '"', '\\':
+				dst = append(dst, '\\', b)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[b>>4], hexDigits[b&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, data[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		// line and paragraph separators break JavaScript
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, data[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, data[start:]...)
+	return append(dst, '"')
+}
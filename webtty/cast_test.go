@@ -0,0 +1,144 @@
+package webtty
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRecorder(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	_, discardWriter := io.Pipe()
+	var cast bytes.Buffer
+	master := recordingMaster{&frameRecorder{}}
+	dt, err := New(master, &pipeSlave{pipePair{slaveReader, discardWriter}},
+		WithFixedColumns(100), WithFixedRows(30), WithRecorder(&cast))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	outputs := func() int {
+		var n int
+		for _, frame := range master.get() {
+			if frame[0] == Output {
+				n++
+			}
+		}
+		return n
+	}
+	go func() {
+		slaveWriter.Write([]byte("$ \xe4\xbd"))
+		slaveWriter.Write([]byte("\xa0"))
+		// the output is recorded before it's sent
+		for outputs() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		// the first resize is seeded with the configured size
+		dt.handleMasterReadEvent([]byte(`3{"Columns":110,"Rows":35}`))
+		dt.handleMasterReadEvent([]byte(`3{"Columns":120,"Rows":40}`))
+		slaveWriter.Write([]byte("bye\r\n"))
+		slaveWriter.Close()
+	}()
+	start := time.Now()
+	if err := dt.Run(context.Background(), "", ""); !errors.Is(err, ErrSlaveClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	scanner := bufio.NewScanner(&cast)
+	if !scanner.Scan() {
+		t.Fatalf("Missing header")
+	}
+	var header castHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		t.Fatalf("Unexpected header: %s", err)
+	}
+	if header.Version != 2 || header.Width != 100 || header.Height != 30 || header.Timestamp != start.Unix() {
+		t.Fatalf("Unexpected header: %+v", header)
+	}
+
+	expected := [][2]string{
+		{"o", "$ "},
+		{"o", "你"},
+		{"r", "100x30"},
+		{"r", "120x40"},
+		{"o", "bye\r\n"},
+	}
+	var last float64
+	for i := 0; scanner.Scan(); i++ {
+		var event []interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Unexpected event: %s", err)
+		}
+		if i >= len(expected) || len(event) != 3 || event[1] != expected[i][0] || event[2] != expected[i][1] {
+			t.Fatalf("Unexpected event %d: %q", i, scanner.Text())
+		}
+		if elapsed := event[0].(float64); elapsed < last {
+			t.Fatalf("Unexpected elapsed time %f after %f", elapsed, last)
+		} else {
+			last = elapsed
+		}
+		if i == len(expected)-1 {
+			return
+		}
+	}
+	t.Fatalf("Missing events in %q", cast.String())
+}
+
+func TestRecorderInput(t *testing.T) {
+	var cast bytes.Buffer
+	cr := newCastRecorder(&cast)
+	cr.start(time.Now(), 0, 0)
+	cr.input([]byte("l"))
+	cr.input([]byte("\xe4\xbd"))
+	cr.output([]byte("l"))
+	cr.input([]byte("\xa0\r"))
+	cr.input([]byte("\xe4"))
+	cr.flush()
+
+	scanner := bufio.NewScanner(&cast)
+	scanner.Scan()
+	expected := [][2]string{
+		{"i", "l"},
+		{"o", "l"},
+		{"i", "你\r"},
+		{"i", "�"},
+	}
+	count := 0
+	for ; scanner.Scan(); count++ {
+		var event []interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Unexpected event: %s", err)
+		}
+		if count >= len(expected) || len(event) != 3 || event[1] != expected[count][0] || event[2] != expected[count][1] {
+			t.Fatalf("Unexpected event %d: %q", count, scanner.Text())
+		}
+	}
+	if count != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), count)
+	}
+
+	_, err := New(pipePair{}, &pipeSlave{}, WithRecordInput())
+	if err == nil {
+		t.Fatalf("Expected an error recording input without a recorder")
+	}
+}
+
+func TestAppendJSONString(t *testing.T) {
+	for _, data := range []string{"", "ls -l\r\n", "\x1b[1;31m\"<&>\"\\\t\x00\x7f", "你好  ", "\xff\xe4\xbd"} {
+		encoded := appendJSONString(nil, []byte(data))
+		var decoded, expected string
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("Unexpected encoding of %q: %s", data, encoded)
+		}
+		marshaled, _ := json.Marshal(data)
+		json.Unmarshal(marshaled, &expected)
+		if decoded != expected {
+			t.Fatalf("Unexpected encoding of %q: %s, expected %s", data, encoded, marshaled)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package webtty
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ClipboardPolicy decides how OSC 52 clipboard sequences
+// written by the slave are handled.
+type ClipboardPolicy int
+
+const (
+	// Remove the sequences from the output
+	ClipboardStrip ClipboardPolicy = iota
+	// Remove the sequences from the output and send their content
+	// as ClipboardWrite messages so that the master can ask the user.
+	// The bundled client ignores ClipboardWrite messages.
+	ClipboardForward
+	// Leave the sequences in the output as they are, letting the slave
+	// set the clipboard of the master without asking
+	ClipboardPassthrough
+)
+
+// ParseClipboardPolicy returns the policy named name,
+// one of "strip", "forward" or "passthrough".
+// An empty name is taken as "strip".
+func ParseClipboardPolicy(name string) (ClipboardPolicy, error) {
+	switch name {
+	case "", "strip":
+		return ClipboardStrip, nil
+	case "forward":
+		return ClipboardForward, nil
+	case "passthrough":
+		return ClipboardPassthrough, nil
+	}
+	return ClipboardStrip, errors.Errorf("unknown clipboard policy `%s`", name)
+}
+
+var osc52Prefix = []byte("52;")
+
+// clipboardWrite is the payload of ClipboardWrite messages.
+type clipboardWrite struct {
+	Selection string `json:"selection"`
+	Data      string `json:"data"` // base64 encoded
+}
+
+// handleOSC is called for each OSC sequence in the output of the slave
+// and returns whether the sequence is left in the output.
+func (wt *WebTTY) handleOSC(payload []byte) bool {
+	if wt.workingDirTracking {
+		if dir, ok := parseWorkingDirOSC(payload); ok {
+			wt.setWorkingDir(dir)
+			return true
+		}
+	}
+	if wt.titleTracking {
+		if title, ok := parseTitleOSC(payload); ok {
+			wt.trackTitle(title)
+			return true
+		}
+	}
+	if !bytes.HasPrefix(payload, osc52Prefix) {
+		return true
+	}
+
+	switch wt.clipboardPolicy {
+	case ClipboardPassthrough:
+		return true
+	case ClipboardForward:
+		fields := bytes.SplitN(payload[len(osc52Prefix):], []byte(";"), 2)
+		// `?` asks to read the clipboard, which is never permitted
+		if len(fields) != 2 || string(fields[1]) == "?" {
+			return false
+		}
+		message, err := json.Marshal(clipboardWrite{string(fields[0]), string(fields[1])})
+		if err != nil {
+			return false
+		}
+		// a broken master is detected by the read loop
+		wt.primaryWrite(append([]byte{ClipboardWrite}, message...))
+	}
+	return false
+}
+
+// dropOSC returns whether a sequence too long to be held back is removed
+// from the output, the clipboard sequences are unless passed through.
+// They are not forwarded either.
+func (wt *WebTTY) dropOSC(prefix []byte) bool {
+	return wt.clipboardPolicy != ClipboardPassthrough && bytes.HasPrefix(prefix, osc52Prefix)
+}
@@ -0,0 +1,133 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Codes of the CloseReason messages, telling the master why its session
+// closed. Servers may send their own codes for the sessions which fail
+// to start, see CloseReasonMessage.
+const (
+	// The slave closed, such as the command exited
+	CloseSlaveClosed = "slave_closed"
+	// The session reached the duration of WithMaxSessionDuration
+	CloseSessionExpired = "session_expired"
+	// The master sent no input within the timeout of WithIdleTimeout
+	CloseIdleTimeout = "idle_timeout"
+	// The master failed to unlock the screen of WithScreenLock
+	CloseUnlockFailed = "unlock_failed"
+	// The session waiting for its approval of WithApproval was denied
+	CloseApprovalDenied = "approval_denied"
+	// The session wasn't approved within the timeout of WithApproval
+	CloseApprovalTimeout = "approval_timeout"
+	// The access grant of WithAccessGrant expired
+	CloseGrantExpired = "grant_expired"
+	// The access grant of WithAccessGrant was revoked with RevokeGrant
+	CloseGrantRevoked = "grant_revoked"
+	// The session was closed with Terminate
+	CloseSessionTerminated = "session_terminated"
+	// The slave didn't output within the interval of WithSlaveReadWatchdog
+	CloseSlaveHung = "slave_hung"
+	// The master didn't answer a KeepAlivePing
+	CloseMasterTimeout = "master_timeout"
+	// The master sent a frame larger than WithMaxInboundFrameSize
+	CloseFrameTooLarge = "frame_too_large"
+	// The master sent a frame the protocol can't parse,
+	// with WithStrictProtocol
+	CloseMalformedFrame = "malformed_frame"
+	// Any other error, such as a failure to send to the master
+	CloseInternalError = "internal_error"
+)
+
+// closeReason is the payload of a CloseReason message.
+type closeReason struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// How the process of the slave ended, when it reports it
+	ExitCode *int   `json:"exitCode,omitempty"`
+	Signal   string `json:"signal,omitempty"`
+}
+
+// CloseReasonMessage returns a CloseReason message with code, machine
+// readable, and message, to be displayed to the user. Servers can send it
+// to tell a client why its session couldn't start, before closing
+// the connection.
+func CloseReasonMessage(code string, message string) []byte {
+	payload, _ := json.Marshal(closeReason{Code: code, Message: message})
+	return append([]byte{CloseReason}, payload...)
+}
+
+// closeReasonOf returns the code and the message of the CloseReason
+// message for Run returning err, with the exit reason of the slave when
+// it closed. It returns false when the master is not to be told,
+// as it closed or the context of Run was canceled, or Run didn't fail.
+func closeReasonOf(ctx context.Context, err error, exitReason string) (string, string, bool) {
+	if err == nil || ctx.Err() != nil {
+		return "", "", false
+	}
+	if exit, ok := err.(*SlaveExitError); ok {
+		return CloseSlaveClosed, exit.Status.String(), true
+	}
+	if _, ok := err.(*malformedFrameError); ok {
+		return CloseMalformedFrame, ErrMalformedFrame.Error(), true
+	}
+	if closed, ok := err.(*closedError); ok {
+		if closed.sentinel == ErrMasterClosed {
+			return "", "", false
+		}
+		if exitReason == "" {
+			exitReason = ErrSlaveClosed.Error()
+		}
+		return CloseSlaveClosed, exitReason, true
+	}
+
+	switch errors.Cause(err) {
+	case ErrSessionExpired:
+		return CloseSessionExpired, err.Error(), true
+	case ErrIdleTimeout:
+		return CloseIdleTimeout, err.Error(), true
+	case ErrUnlockFailed:
+		return CloseUnlockFailed, err.Error(), true
+	case ErrSessionTerminated:
+		return CloseSessionTerminated, err.Error(), true
+	case ErrApprovalDenied:
+		return CloseApprovalDenied, err.Error(), true
+	case ErrApprovalTimeout:
+		return CloseApprovalTimeout, err.Error(), true
+	case ErrGrantExpired:
+		return CloseGrantExpired, err.Error(), true
+	case ErrGrantRevoked:
+		return CloseGrantRevoked, err.Error(), true
+	case ErrSlaveHung:
+		return CloseSlaveHung, err.Error(), true
+	case ErrMasterTimeout:
+		return CloseMasterTimeout, err.Error(), true
+	case ErrFrameTooLarge:
+		return CloseFrameTooLarge, err.Error(), true
+	}
+	// the details of other errors are for the logs of the server
+	return CloseInternalError, "internal error", true
+}
+
+// sendCloseReason tells the master why Run returns err, unless it's not
+// to be told or WithCloseReason is not set. The master may be gone
+// already, so errors are ignored.
+func (wt *WebTTY) sendCloseReason(ctx context.Context, err error, exitReason string) {
+	if !wt.closeReasonFrame {
+		return
+	}
+	code, message, ok := closeReasonOf(ctx, err, exitReason)
+	if !ok {
+		return
+	}
+	reason := closeReason{Code: code, Message: message}
+	if exit, ok := err.(*SlaveExitError); ok {
+		reason.ExitCode = &exit.Status.Code
+		reason.Signal = exit.Status.Signal
+	}
+	payload, _ := json.Marshal(reason)
+	wt.masterWrite(append([]byte{CloseReason}, payload...))
+}
@@ -0,0 +1,86 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestCloseReason(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	_, discardWriter := io.Pipe()
+	slave := exitingSlave{&pipeSlave{pipePair{slaveReader, discardWriter}}, "exit status 2"}
+	master := recordingMaster{&frameRecorder{}}
+	dt, err := New(master, slave, WithCloseReason())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	slaveWriter.Close()
+
+	dt.Run(context.Background(), "", "")
+	frames := master.get()
+	if len(frames) < 2 || frames[len(frames)-2] != string(SessionEnd)+"exit status 2" ||
+		frames[len(frames)-1] != string(CloseReason)+`{"code":"slave_closed","message":"exit status 2"}` {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	slaveReader, slaveWriter = io.Pipe()
+	_, discardWriter = io.Pipe()
+	status := statusSlave{&pipeSlave{pipePair{slaveReader, discardWriter}}, ExitStatus{Code: -1, Signal: "killed"}}
+	master = recordingMaster{&frameRecorder{}}
+	dt, err = New(master, status, WithCloseReason())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	slaveWriter.Close()
+
+	dt.Run(context.Background(), "", "")
+	frames = master.get()
+	if last := frames[len(frames)-1]; last != string(CloseReason)+`{"code":"slave_closed","message":"signal: killed","exitCode":-1,"signal":"killed"}` {
+		t.Fatalf("Unexpected last frame: %q", last)
+	}
+
+	slaveReader, slaveWriter = io.Pipe()
+	defer slaveWriter.Close()
+	master = recordingMaster{&frameRecorder{}}
+	dt, err = New(master, &pipeSlave{pipePair{slaveReader, nil}}, WithCloseReason())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx, "", "") }()
+	cancel()
+	<-done
+	for _, frame := range master.get() {
+		if frame[0] == CloseReason {
+			t.Fatalf("Unexpected CloseReason on cancellation: %q", frame)
+		}
+	}
+}
+
+func TestCloseReasonOf(t *testing.T) {
+	ctx := context.Background()
+	for _, c := range []struct {
+		err     error
+		code    string
+		message string
+		ok      bool
+	}{
+		{&closedError{ErrSlaveClosed, io.EOF}, CloseSlaveClosed, "slave closed", true},
+		{&closedError{ErrMasterClosed, io.EOF}, "", "", false},
+		{&SlaveExitError{ExitStatus{Code: 1}, io.EOF}, CloseSlaveClosed, "exit status 1", true},
+		{ErrIdleTimeout, CloseIdleTimeout, "idle timeout", true},
+		{ErrUnlockFailed, CloseUnlockFailed, "unlock failed", true},
+		{errors.Wrapf(ErrFrameTooLarge, "limit is %d bytes", 10), CloseFrameTooLarge, "limit is 10 bytes: inbound frame too large", true},
+		{errors.New("failed to send output: /tmp/secret"), CloseInternalError, "internal error", true},
+		{nil, "", "", false},
+	} {
+		code, message, ok := closeReasonOf(ctx, c.err, "")
+		if code != c.code || message != c.message || ok != c.ok {
+			t.Errorf("Unexpected close reason of %v: %q %q %v", c.err, code, message, ok)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package webtty
+
+import (
+	"sync"
+	"time"
+)
+
+// CoalesceOverflowAction tells what to do when coalesced output
+// would grow beyond the configured maximum size.
+type CoalesceOverflowAction int
+
+const (
+	// Send the buffered output right away
+	CoalesceFlush CoalesceOverflowAction = iota
+	// Hold further output until the flush interval elapses,
+	// which pauses reading from the slave meanwhile
+	CoalesceWait
+)
+
+// outputCoalescer buffers slave output and sends it as a single
+// Output message once the flush interval elapses or the buffer is full.
+type outputCoalescer struct {
+	interval time.Duration
+	maxBytes int
+	overflow func(buffered int) CoalesceOverflowAction
+	send     func(data []byte) error
+
+	mutex   sync.Mutex
+	flushed *sync.Cond
+	buffer  []byte
+	timer   *time.Timer
+	err     error
+	closed  bool
+}
+
+func newOutputCoalescer(
+	interval time.Duration,
+	maxBytes int,
+	overflow func(buffered int) CoalesceOverflowAction,
+	send func(data []byte) error,
+) *outputCoalescer {
+	oc := &outputCoalescer{
+		interval: interval,
+		maxBytes: maxBytes,
+		overflow: overflow,
+		send:     send,
+		buffer:   make([]byte, 0, maxBytes),
+	}
+	oc.flushed = sync.NewCond(&oc.mutex)
+	return oc
+}
+
+// write appends data to the buffer.
+// It returns an error when a previous timed flush has failed.
+func (oc *outputCoalescer) write(data []byte) error {
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+
+	if oc.closed {
+		return oc.send(data)
+	}
+
+	for oc.err == nil && len(oc.buffer) > 0 && len(oc.buffer)+len(data) > oc.maxBytes {
+		action := CoalesceFlush
+		if oc.overflow != nil {
+			action = oc.overflow(len(oc.buffer))
+		}
+		if action == CoalesceWait {
+			oc.flushed.Wait()
+			continue
+		}
+		oc.flushLocked()
+	}
+	if oc.err != nil {
+		return oc.err
+	}
+
+	oc.buffer = append(oc.buffer, data...)
+	if len(oc.buffer) >= oc.maxBytes {
+		oc.flushLocked()
+		return oc.err
+	}
+
+	if oc.timer == nil {
+		oc.timer = time.AfterFunc(oc.interval, oc.flush)
+	}
+	return nil
+}
+
+// flush sends any buffered output immediately.
+func (oc *outputCoalescer) flush() {
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+
+	oc.flushLocked()
+}
+
+// close flushes pending output and returns the last flush error.
+// Output written after close is sent without buffering.
+func (oc *outputCoalescer) close() error {
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+
+	oc.flushLocked()
+	oc.closed = true
+	return oc.err
+}
+
+func (oc *outputCoalescer) flushLocked() {
+	if oc.timer != nil {
+		oc.timer.Stop()
+		oc.timer = nil
+	}
+	defer oc.flushed.Broadcast()
+
+	if len(oc.buffer) == 0 || oc.err != nil {
+		return
+	}
+	err := oc.send(oc.buffer)
+	oc.buffer = oc.buffer[:0]
+	if err != nil {
+		oc.err = err
+	}
+}
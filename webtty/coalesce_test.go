@@ -0,0 +1,96 @@
+package webtty
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type frameRecorder struct {
+	mutex  sync.Mutex
+	frames []string
+}
+
+func (fr *frameRecorder) send(data []byte) error {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	fr.frames = append(fr.frames, string(data))
+	return nil
+}
+
+func (fr *frameRecorder) get() []string {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	return append([]string{}, fr.frames...)
+}
+
+func TestOutputCoalescerFlushOnOverflow(t *testing.T) {
+	fr := &frameRecorder{}
+	oc := newOutputCoalescer(time.Hour, 4, nil, fr.send)
+
+	for _, chunk := range []string{"ab", "cd", "ef", "g"} {
+		if err := oc.write([]byte(chunk)); err != nil {
+			t.Fatalf("Unexpected error from write(): %s", err)
+		}
+	}
+	if err := oc.close(); err != nil {
+		t.Fatalf("Unexpected error from close(): %s", err)
+	}
+
+	frames := fr.get()
+	if len(frames) != 2 || frames[0] != "abcd" || frames[1] != "efg" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+}
+
+func TestOutputCoalescerOverflowHandler(t *testing.T) {
+	fr := &frameRecorder{}
+	called := 0
+	oc := newOutputCoalescer(10*time.Millisecond, 4, func(buffered int) CoalesceOverflowAction {
+		called++
+		return CoalesceWait
+	}, fr.send)
+
+	start := time.Now()
+	oc.write([]byte("abc"))
+	oc.write([]byte("de")) // waits for the timed flush
+	if time.Since(start) < 10*time.Millisecond {
+		t.Fatalf("Expected write() to wait for the flush interval")
+	}
+	oc.close()
+
+	frames := fr.get()
+	if called != 1 || len(frames) != 2 || frames[0] != "abc" || frames[1] != "de" {
+		t.Fatalf("Unexpected frames: %q (handler called %d times)", frames, called)
+	}
+}
+
+// BenchmarkOutputCoalescer reports the Output frames sent for a burst
+// of small slave reads, with and without coalescing.
+func BenchmarkOutputCoalescer(b *testing.B) {
+	chunk := []byte("drwxr-xr-x  2 root root 4096 Jan  1 00:00 bin\r\n")
+	for _, interval := range []time.Duration{0, 10 * time.Millisecond} {
+		b.Run(interval.String(), func(b *testing.B) {
+			master := recordingMaster{&frameRecorder{}}
+			dt, err := New(master, &pipeSlave{}, WithOutputFlushInterval(interval))
+			if err != nil {
+				b.Fatalf("Unexpected error from New(): %s", err)
+			}
+			if interval > 0 {
+				dt.coalescer = newOutputCoalescer(interval, dt.bufferSize, nil, dt.sendOutput)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < 100; j++ {
+					dt.writeOutput(chunk)
+				}
+				if dt.coalescer != nil {
+					dt.coalescer.flush()
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(len(master.get()))/float64(b.N), "frames/burst")
+		})
+	}
+}
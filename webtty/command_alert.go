@@ -0,0 +1,149 @@
+package webtty
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultCommandAlertPatterns are the high-risk commands alerted on
+// when no pattern is configured, see NewCommandAlertRules.
+var DefaultCommandAlertPatterns = []string{
+	"kubectl delete",
+	"helm uninstall",
+	"drop table",
+	"drop database",
+	"truncate table",
+	"rm -rf /",
+	"mkfs",
+	"dd if=* of=/dev/",
+	"shutdown",
+	"reboot",
+}
+
+// CommandAlertRules match the command lines which raise an alert,
+// see WithCommandAlerts.
+type CommandAlertRules struct {
+	patterns []commandPattern
+}
+
+// NewCommandAlertRules compiles the patterns of the command lines raising
+// an alert. Unlike the patterns of NewCommandPolicy, they match anywhere in
+// the line: a pattern prefixed with "re:" is a regular expression, any other
+// pattern is a glob matched regardless of case, where * matches any string,
+// ? any character and spaces any run of spaces, so that "kubectl delete"
+// matches "sudo kubectl  delete ns prod".
+func NewCommandAlertRules(patterns []string) (*CommandAlertRules, error) {
+	rules := &CommandAlertRules{}
+	for _, source := range patterns {
+		var expr string
+		if strings.HasPrefix(source, "re:") {
+			expr = strings.TrimPrefix(source, "re:")
+		} else {
+			fields := strings.Fields(source)
+			for i, field := range fields {
+				fields[i] = globToRegexp(field)
+			}
+			expr = `(?i)` + strings.Join(fields, `\s+`)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile command alert pattern `%s`", source)
+		}
+		rules.patterns = append(rules.patterns, commandPattern{source: source, re: re})
+	}
+	return rules, nil
+}
+
+// Match returns the first pattern matching line, or false.
+func (rules *CommandAlertRules) Match(line string) (string, bool) {
+	for _, pattern := range rules.patterns {
+		if pattern.re.MatchString(line) {
+			return pattern.source, true
+		}
+	}
+	return "", false
+}
+
+// CommandAlert tells a command line matching a CommandAlertRules
+// was submitted, with the context of its session.
+type CommandAlert struct {
+	// The pattern matched
+	Rule        string    `json:"rule"`
+	Command     string    `json:"command"`
+	UserAccount string    `json:"user"`
+	ClusterID   string    `json:"clusterId"`
+	SessionID   string    `json:"sessionId"`
+	RemoteAddr  string    `json:"remoteAddr,omitempty"`
+	WorkingDir  string    `json:"workingDir,omitempty"`
+	Time        time.Time `json:"timestamp"`
+}
+
+// Summary describes the alert in a sentence, for chat messages.
+func (alert CommandAlert) Summary() string {
+	summary := fmt.Sprintf("High-risk command `%s` run by %s", alert.Command, alert.UserAccount)
+	if alert.ClusterID != "" {
+		summary += " on cluster " + alert.ClusterID
+	}
+	summary += " in session " + alert.SessionID
+	if alert.RemoteAddr != "" {
+		summary += " from " + alert.RemoteAddr
+	}
+	return summary + ", matching `" + alert.Rule + "`"
+}
+
+// CommandAlertNotifier delivers the alerts, such as WebhookAlertNotifier.
+// Notify is called synchronously from the session loops
+// and should return quickly.
+type CommandAlertNotifier interface {
+	Notify(ctx context.Context, alert CommandAlert) error
+}
+
+// WithCommandAlerts sends an alert to notifier for each command line
+// submitted by the master matching rules, as soon as it's submitted.
+// Alerts are independent of the audit trail: the lines left out by
+// WithAuditCommandFilter raise them too, and they're sent to notifier
+// only. Lines are matched as typed and sent redacted by WithAuditRedaction.
+func WithCommandAlerts(rules *CommandAlertRules, notifier CommandAlertNotifier) Option {
+	return func(wt *WebTTY) error {
+		if rules == nil || notifier == nil {
+			return errors.New("command alerts require rules and a notifier")
+		}
+		wt.alertRules = rules
+		wt.alertNotifier = notifier
+		return nil
+	}
+}
+
+// alertCommand sends an alert when line matches the alert rules.
+func (wt *WebTTY) alertCommand(line string) {
+	if wt.alertRules == nil {
+		return
+	}
+	rule, ok := wt.alertRules.Match(line)
+	if !ok {
+		return
+	}
+	if wt.auditRedact != nil {
+		line = wt.auditRedact(line)
+	}
+
+	session := wt.Session()
+	err := wt.alertNotifier.Notify(context.Background(), CommandAlert{
+		Rule:        rule,
+		Command:     line,
+		UserAccount: session.User,
+		ClusterID:   session.ClusterID,
+		SessionID:   session.SessionID,
+		RemoteAddr:  session.RemoteAddr,
+		WorkingDir:  wt.WorkingDir(),
+		Time:        time.Now(),
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,142 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCommandAlertRules(t *testing.T) {
+	rules, err := NewCommandAlertRules([]string{"kubectl delete", "drop ?able", "re:^rm -rf /$"})
+	if err != nil {
+		t.Fatalf("Unexpected error from NewCommandAlertRules(): %s", err)
+	}
+	for _, c := range []struct {
+		line string
+		rule string
+	}{
+		{"kubectl delete ns prod", "kubectl delete"},
+		{"sudo kubectl  delete pod x", "kubectl delete"},
+		{`mysql -e "DROP TABLE users"`, "drop ?able"},
+		{"rm -rf /", "re:^rm -rf /$"},
+		{"rm -rf /tmp/x", ""},
+		{"kubectl get pods", ""},
+	} {
+		rule, ok := rules.Match(c.line)
+		if rule != c.rule || ok != (c.rule != "") {
+			t.Errorf("Unexpected rule for %q: %q", c.line, rule)
+		}
+	}
+
+	if _, err := NewCommandAlertRules([]string{"re:("}); err == nil {
+		t.Fatalf("Expected an error for an invalid pattern")
+	}
+}
+
+type recordingNotifier struct {
+	alerts []CommandAlert
+}
+
+func (rn *recordingNotifier) Notify(ctx context.Context, alert CommandAlert) error {
+	rn.alerts = append(rn.alerts, alert)
+	return nil
+}
+
+func TestCommandAlerts(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	defer slaveWriter.Close()
+	go io.Copy(ioutil.Discard, slaveReader)
+
+	rules, _ := NewCommandAlertRules(DefaultCommandAlertPatterns)
+	notifier := &recordingNotifier{}
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{nil, slaveWriter}},
+		WithPermitWrite(),
+		WithCommandAlerts(rules, notifier),
+		WithAuditRedaction(NewRedactor()),
+		// alerts don't depend on the audit trail
+		WithAuditCommandFilter(func(cmd string) bool { return false }),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.forwardInput([]byte("ls\rmysql -psecret -e 'drop table t'\r")); err != nil {
+		t.Fatalf("Unexpected error from forwardInput(): %s", err)
+	}
+	if len(notifier.alerts) != 1 {
+		t.Fatalf("Unexpected alerts: %+v", notifier.alerts)
+	}
+	alert := notifier.alerts[0]
+	if alert.Rule != "drop table" || alert.Command != "mysql -p*** -e 'drop table t'" || alert.SessionID != dt.Session().SessionID {
+		t.Fatalf("Unexpected alert: %+v", alert)
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithCommandAlerts(rules, nil)); err == nil {
+		t.Fatalf("Expected an error without a notifier")
+	}
+}
+
+func TestWebhookAlertNotifier(t *testing.T) {
+	bodies := make(chan map[string]interface{}, 3)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" ||
+			json.NewDecoder(r.Body).Decode(&body) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		bodies <- body
+	}))
+	defer endpoint.Close()
+
+	alert := CommandAlert{Rule: "kubectl delete", Command: `kubectl delete ns "prod"`, UserAccount: "alice", SessionID: "3f2a"}
+	for _, format := range []string{AlertFormatJSON, AlertFormatSlack, AlertFormatDingTalk} {
+		notifier, err := NewWebhookAlertNotifier(endpoint.URL, format, "")
+		if err != nil {
+			t.Fatalf("Unexpected error from NewWebhookAlertNotifier(): %s", err)
+		}
+		if err := notifier.Notify(context.Background(), alert); err != nil {
+			t.Fatalf("Unexpected error from Notify(): %s", err)
+		}
+		notifier.Close()
+
+		body := <-bodies
+		var text interface{}
+		switch format {
+		case AlertFormatJSON:
+			text = body["command"]
+		case AlertFormatSlack:
+			text = body["text"]
+		case AlertFormatDingTalk:
+			if body["msgtype"] == "text" {
+				text = body["text"].(map[string]interface{})["content"]
+			}
+		}
+		expected := alert.Command
+		if format != AlertFormatJSON {
+			expected = alert.Summary()
+		}
+		if text != expected {
+			t.Fatalf("Unexpected %s body: %v", format, body)
+		}
+	}
+
+	if _, err := NewWebhookAlertNotifier(endpoint.URL, "teams", ""); err == nil {
+		t.Fatalf("Expected an error for an unknown format")
+	}
+	notifier, err := NewWebhookAlertNotifier(endpoint.URL, "", `{"user":{{ json .UserAccount }}}`)
+	if err != nil {
+		t.Fatalf("Unexpected error from NewWebhookAlertNotifier(): %s", err)
+	}
+	notifier.Notify(context.Background(), alert)
+	notifier.Close()
+	if body := <-bodies; body["user"] != "alice" {
+		t.Fatalf("Unexpected body from the template: %v", body)
+	}
+	if err := notifier.Notify(context.Background(), alert); err == nil {
+		t.Fatalf("Expected an error once closed")
+	}
+}
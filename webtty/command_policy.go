@@ -0,0 +1,108 @@
+package webtty
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CommandPolicy decides which command lines typed by the master
+// are forwarded to the slave, see WithCommandPolicy.
+type CommandPolicy struct {
+	allow []commandPattern
+	deny  []commandPattern
+}
+
+type commandPattern struct {
+	source string
+	re     *regexp.Regexp
+}
+
+// commandSeparators split a line into the commands run by a shell.
+var commandSeparators = regexp.MustCompile(`&&|\|\||[;&|\n]`)
+
+// NewCommandPolicy compiles the allow and deny patterns of a policy.
+// A pattern prefixed with "re:" is a regular expression, any other pattern
+// is a glob where * matches any string, slashes and spaces included,
+// and ? any character. Patterns are matched against whole commands with
+// their surrounding spaces trimmed, a line chaining commands with ;, &&,
+// || or | is checked command by command.
+// A command matching a deny pattern is rejected. When allow patterns are
+// given, commands matching none of them are rejected too.
+func NewCommandPolicy(allow []string, deny []string) (*CommandPolicy, error) {
+	policy := &CommandPolicy{}
+	var err error
+	policy.allow, err = compileCommandPatterns(allow)
+	if err != nil {
+		return nil, err
+	}
+	policy.deny, err = compileCommandPatterns(deny)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func compileCommandPatterns(sources []string) ([]commandPattern, error) {
+	patterns := make([]commandPattern, 0, len(sources))
+	for _, source := range sources {
+		var expr string
+		if strings.HasPrefix(source, "re:") {
+			expr = "^(?:" + strings.TrimPrefix(source, "re:") + ")$"
+		} else {
+			expr = "^" + globToRegexp(source) + "$"
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile command pattern `%s`", source)
+		}
+		patterns = append(patterns, commandPattern{source: source, re: re})
+	}
+	return patterns, nil
+}
+
+func globToRegexp(glob string) string {
+	var expr strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return expr.String()
+}
+
+// Check returns an error naming the rule rejecting line, or nil
+// when every command of line is permitted. Empty lines are permitted.
+func (policy *CommandPolicy) Check(line string) error {
+	for _, command := range commandSeparators.Split(line, -1) {
+		command = strings.TrimSpace(command)
+		if command == "" {
+			continue
+		}
+		for _, pattern := range policy.deny {
+			if pattern.re.MatchString(command) {
+				return errors.Errorf("`%s` is denied by `%s`", command, pattern.source)
+			}
+		}
+		if len(policy.allow) == 0 {
+			continue
+		}
+		allowed := false
+		for _, pattern := range policy.allow {
+			if pattern.re.MatchString(command) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errors.Errorf("`%s` is not allowed", command)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package webtty
+
+import (
+	"testing"
+)
+
+func TestCommandPolicy(t *testing.T) {
+	policy, err := NewCommandPolicy(
+		[]string{"ls*", "kubectl get *", "re:git (status|log)( .*)?", "rm *"},
+		[]string{"rm -rf *", "re:.*--force.*"},
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from NewCommandPolicy(): %s", err)
+	}
+
+	cases := []struct {
+		line    string
+		allowed bool
+	}{
+		{"", true},
+		{"  ls -l /tmp  ", true},
+		{"kubectl get pods", true},
+		{"kubectl delete pod x", false},
+		{"git status", true},
+		{"git push", false},
+		{"rm x", true},
+		{"rm -rf /var/lib", false},
+		{"rm --force x", false},
+		{"ls; rm -rf /", false},
+		{"ls && git log -1", true},
+		{"ls | kubectl delete -f -", false},
+		{"top", false},
+	}
+	for _, c := range cases {
+		err := policy.Check(c.line)
+		if (err == nil) != c.allowed {
+			t.Errorf("Unexpected result for %q: %v", c.line, err)
+		}
+	}
+
+	denyOnly, err := NewCommandPolicy(nil, []string{"kubectl delete *"})
+	if err != nil {
+		t.Fatalf("Unexpected error from NewCommandPolicy(): %s", err)
+	}
+	if err := denyOnly.Check("top"); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+	if err := denyOnly.Check("kubectl delete ns prod"); err == nil {
+		t.Errorf("Expected kubectl delete to be denied")
+	}
+
+	if _, err := NewCommandPolicy([]string{"re:("}, nil); err == nil {
+		t.Errorf("Expected an error for an invalid regular expression")
+	}
+}
@@ -0,0 +1,81 @@
+package webtty
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"sync/atomic"
+)
+
+// CompressionGzip is the method of the CompressedOutput frames, each one
+// is a gzip stream of its own, see WithCompression.
+const CompressionGzip = "gzip"
+
+// compressionSettings is the payload of a SetCompression message.
+type compressionSettings struct {
+	Method   string `json:"method"`
+	MinBytes int    `json:"minBytes"`
+}
+
+// outputCompressor compresses output into CompressedOutput frames.
+// It is used under the output lock.
+type outputCompressor struct {
+	minBytes int
+	buffer   bytes.Buffer
+	writer   *gzip.Writer
+}
+
+func (oc *outputCompressor) compress(data []byte) []byte {
+	oc.buffer.Reset()
+	if oc.writer == nil {
+		oc.writer = gzip.NewWriter(&oc.buffer)
+	} else {
+		oc.writer.Reset(&oc.buffer)
+	}
+	// writes to a bytes.Buffer don't fail
+	oc.writer.Write(data)
+	oc.writer.Close()
+	return oc.buffer.Bytes()
+}
+
+// appendCompressedFrame appends a CompressedOutput frame carrying data to dst
+// when compression is negotiated, data is longer than the threshold and
+// the frame is smaller than the Output frame, within the max frame size.
+// It returns false when data has to be sent as Output instead.
+func (wt *WebTTY) appendCompressedFrame(dst []byte, data []byte) ([]byte, bool) {
+	if wt.compressor == nil || len(data) <= wt.compressor.minBytes || !wt.NegotiatedFeatures().Compression {
+		return dst, false
+	}
+
+	plainSize := 1 + len(data)
+	if !wt.rawOutput() {
+		plainSize = 1 + base64.StdEncoding.EncodedLen(len(data))
+	}
+
+	compressed := wt.compressor.compress(data)
+	frame := wt.appendFrame(dst, CompressedOutput, compressed)
+	if len(frame) >= plainSize || (wt.maxFrameSize > 0 && len(frame) > wt.maxFrameSize) {
+		return dst, false
+	}
+
+	atomic.AddUint64(&wt.compressedBytes, uint64(len(data)))
+	atomic.AddUint64(&wt.compressedSize, uint64(len(compressed)))
+	if wt.metrics != nil {
+		wt.metrics.ObserveCompression(len(data), len(compressed))
+	}
+	return frame, true
+}
+
+// compressionMessage returns the SetCompression message telling the master
+// how output is compressed, or nil when compression is not negotiated.
+func (wt *WebTTY) compressionMessage() []byte {
+	if wt.compressor == nil || !wt.NegotiatedFeatures().Compression {
+		return nil
+	}
+	payload, _ := json.Marshal(compressionSettings{
+		Method:   CompressionGzip,
+		MinBytes: wt.compressor.minBytes,
+	})
+	return append([]byte{SetCompression}, payload...)
+}
@@ -0,0 +1,106 @@
+package webtty
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCompression(t *testing.T) {
+	output := bytes.Repeat([]byte("drwxr-xr-x 2 root root 4096 Jan  1 00:00 dir\r\n"), 50)
+
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithCompression(64),
+		WithClientFeatures(FeatureSet{Compression: true}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+
+	dt.sendOutput(output)
+	dt.sendOutput([]byte("short"))
+	frames := rec.get()
+	if len(frames) != 2 || frames[0][0] != CompressedOutput || frames[1][0] != Output {
+		t.Fatalf("Unexpected frame types: %q", frames)
+	}
+
+	if plain := 1 + base64.StdEncoding.EncodedLen(len(output)); len(frames[0]) >= plain {
+		t.Fatalf("Compressed frame of %d bytes is not smaller than %d bytes", len(frames[0]), plain)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(frames[0][1:])
+	if err != nil {
+		t.Fatalf("Unexpected error decoding the frame: %s", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("Unexpected error from gzip.NewReader(): %s", err)
+	}
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error decompressing the frame: %s", err)
+	}
+	if !bytes.Equal(decompressed, output) {
+		t.Fatalf("Unexpected decompressed output: %q", decompressed)
+	}
+
+	// without the support of the master, output is not compressed
+	rec = &frameRecorder{}
+	dt, err = New(recordingMaster{rec}, &pipeSlave{}, WithCompression(64))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+	dt.sendOutput(output)
+	if frames := rec.get(); len(frames) != 1 || frames[0][0] != Output {
+		t.Fatalf("Unexpected frames without negotiation: %q", frames)
+	}
+}
+
+func TestSetCompression(t *testing.T) {
+	metrics := &CounterMetrics{}
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithCompression(64),
+		WithClientFeatures(FeatureSet{Compression: true}),
+		WithMetrics(metrics),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+	if err := dt.sendSettings(); err != nil {
+		t.Fatalf("Unexpected error from sendSettings(): %s", err)
+	}
+	frames := rec.get()
+	if last := frames[len(frames)-1]; last != string(SetCompression)+`{"method":"gzip","minBytes":64}` {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	output := bytes.Repeat([]byte("2024-01-01T00:00:00Z INFO request served\r\n"), 100)
+	dt.sendOutput(output)
+	stats := dt.Stats()
+	if stats.CompressedBytes != uint64(len(output)) || stats.CompressedSize == 0 || stats.CompressionRatio() <= 1 {
+		t.Fatalf("Unexpected compression stats: %d bytes to %d", stats.CompressedBytes, stats.CompressedSize)
+	}
+	if metrics.CompressedBytes != stats.CompressedBytes || metrics.CompressedSize != stats.CompressedSize {
+		t.Fatalf("Unexpected compression metrics: %d bytes to %d", metrics.CompressedBytes, metrics.CompressedSize)
+	}
+
+	// nothing is sent when compression is not negotiated
+	rec = &frameRecorder{}
+	dt, err = New(recordingMaster{rec}, &pipeSlave{}, WithCompression(64))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+	dt.sendSettings()
+	for _, frame := range rec.get() {
+		if frame[0] == SetCompression {
+			t.Fatalf("Unexpected SetCompression without negotiation: %q", frame)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package webtty
+
+import (
+	"bytes"
+	"regexp"
+)
+
+var (
+	cardNumberPattern = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+	privateKeyBegin   = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----`)
+	privateKeyEnd     = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+)
+
+// maxPrivateKeyLines bounds the lines masked after the BEGIN line of
+// a private key whose END line doesn't come, such as a truncated one.
+const maxPrivateKeyLines = 200
+
+// dlpInspector finds the card numbers and the private keys in the output.
+type dlpInspector struct {
+	mask bool
+	// lines of the private key being printed, -1 outside of a key
+	keyLines int
+}
+
+// NewDLPInspector returns an inspector finding the card numbers, valid
+// by the Luhn checksum, and the PEM private keys in the output. With mask,
+// the digits of the card numbers but the last 4 and the lines of the keys
+// between their BEGIN and END lines are masked, otherwise they are only
+// recorded in the audit trail. An inspector serves a single session.
+func NewDLPInspector(mask bool) OutputInspector {
+	return &dlpInspector{mask: mask, keyLines: -1}
+}
+
+func (di *dlpInspector) Inspect(line []byte) ([]byte, []string) {
+	var findings []string
+	content := bytes.TrimRight(line, "\r\n")
+	ending := line[len(content):]
+
+	switch {
+	case di.keyLines >= 0:
+		if end := privateKeyEnd.FindIndex(content); end != nil {
+			di.keyLines = -1
+			if di.mask {
+				content = append([]byte(secretMask), content[end[0]:]...)
+			}
+		} else {
+			di.keyLines++
+			if di.keyLines >= maxPrivateKeyLines {
+				di.keyLines = -1
+			}
+			if di.mask {
+				content = []byte(secretMask)
+			}
+		}
+		if di.mask {
+			return append(content, ending...), nil
+		}
+		return line, nil
+
+	case privateKeyBegin.Match(content):
+		findings = append(findings, di.finding("private key"))
+		begin := privateKeyBegin.FindIndex(content)
+		if end := privateKeyEnd.FindIndex(content[begin[1]:]); end != nil {
+			// the whole key on a line, such as in JSON
+			if di.mask {
+				rest := content[begin[1]+end[0]:]
+				content = append(append(append([]byte(nil), content[:begin[1]]...), secretMask...), rest...)
+			}
+		} else {
+			di.keyLines = 0
+		}
+	}
+
+	cards := 0
+	masked := cardNumberPattern.ReplaceAllFunc(content, func(number []byte) []byte {
+		if !luhnValid(number) {
+			return number
+		}
+		cards++
+		if !di.mask {
+			return number
+		}
+		return maskCardNumber(number)
+	})
+	for ; cards > 0; cards-- {
+		findings = append(findings, di.finding("card number"))
+	}
+
+	if !di.mask {
+		return line, findings
+	}
+	return append(masked, ending...), findings
+}
+
+func (di *dlpInspector) finding(kind string) string {
+	if di.mask {
+		return kind + " masked"
+	}
+	return kind + " found"
+}
+
+// luhnValid returns whether the digits of number pass the Luhn checksum.
+func luhnValid(number []byte) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
+
+// maskCardNumber masks the digits of number but the last 4,
+// keeping its separators.
+func maskCardNumber(number []byte) []byte {
+	masked := append([]byte(nil), number...)
+	kept := 0
+	for i := len(masked) - 1; i >= 0; i-- {
+		if masked[i] < '0' || masked[i] > '9' {
+			continue
+		}
+		if kept < 4 {
+			kept++
+			continue
+		}
+		masked[i] = '*'
+	}
+	return masked
+}
@@ -0,0 +1,83 @@
+package webtty
+
+import (
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+)
+
+// Encoding is how the payload of Input or Output frames is encoded.
+type Encoding int
+
+const (
+	// Payload is base64 text, the default for Output
+	EncodingBase64 Encoding = iota
+	// Payload is the data as is, the default for Input
+	EncodingRaw
+)
+
+// appendOutputFrame appends an Output frame carrying data to dst.
+func (wt *WebTTY) appendOutputFrame(dst []byte, data []byte) []byte {
+	return wt.appendFrame(dst, Output, data)
+}
+
+// appendFrame appends a frame of msgType carrying data to dst,
+// encoded with the output encoding.
+func (wt *WebTTY) appendFrame(dst []byte, msgType byte, data []byte) []byte {
+	dst = append(dst, msgType)
+	if wt.rawOutput() {
+		return append(dst, data...)
+	}
+
+	start := len(dst)
+	size := start + base64.StdEncoding.EncodedLen(len(data))
+	if cap(dst) < size {
+		grown := make([]byte, start, size)
+		copy(grown, dst)
+		dst = grown
+	}
+	dst = dst[:size]
+	base64.StdEncoding.Encode(dst[start:], data)
+	return dst
+}
+
+// rawOutput returns whether Output payloads are sent as is,
+// with EncodingRaw or once the BinaryFrames feature is negotiated.
+func (wt *WebTTY) rawOutput() bool {
+	return wt.outputEncoding == EncodingRaw || wt.NegotiatedFeatures().BinaryFrames
+}
+
+// outputChunkSize returns the most bytes of output an Output frame
+// can carry within the max frame size, or zero without a limit.
+// Base64 payloads are cut on 4 characters groups,
+// so that each frame decodes on its own.
+func (wt *WebTTY) outputChunkSize() int {
+	if wt.maxFrameSize <= 0 {
+		return 0
+	}
+	payload := wt.maxFrameSize - 1
+	if wt.rawOutput() {
+		return payload
+	}
+	return payload / 4 * 3
+}
+
+// decodeInputFrame returns the Input frame with a raw payload,
+// decoding it into dst when the input encoding is base64.
+func (wt *WebTTY) decodeInputFrame(dst []byte, frame []byte) ([]byte, error) {
+	if wt.inputEncoding == EncodingRaw || len(frame) == 0 || frame[0] != Input {
+		return frame, nil
+	}
+
+	size := 1 + base64.StdEncoding.DecodedLen(len(frame)-1)
+	if cap(dst) < size {
+		dst = make([]byte, size)
+	}
+	dst = dst[:size]
+	dst[0] = Input
+	n, err := base64.StdEncoding.Decode(dst[1:], frame[1:])
+	if err != nil {
+		return nil, malformedFrame(errors.Wrapf(err, "received malformed base64 input"))
+	}
+	return dst[:1+n], nil
+}
@@ -5,9 +5,181 @@ import (
 )
 
 var (
-	// ErrSlaveClosed indicates the function has exited by the slave
+	// ErrSlaveClosed indicates the function has exited by the slave.
+	// Run wraps the read error of the slave, match it with errors.Is.
 	ErrSlaveClosed = errors.New("slave closed")
 
-	// ErrSlaveClosed is returned when the slave connection is closed.
+	// ErrMasterClosed is returned when the master connection is closed.
+	// Run wraps the error closing it, match it with errors.Is.
 	ErrMasterClosed = errors.New("master closed")
+
+	// ErrFrameTooLarge is returned when the master sends a frame
+	// larger than the configured maximum inbound frame size.
+	ErrFrameTooLarge = errors.New("inbound frame too large")
+
+	// ErrMalformedFrame is matched with errors.Is by the errors of the
+	// frames of the master the protocol can't parse, such as an unknown
+	// message type or an invalid payload. Run drops these frames,
+	// and returns them with WithStrictProtocol only.
+	ErrMalformedFrame = errors.New("malformed frame")
+
+	// ErrInjectionNotPermitted is returned by InjectInput
+	// when the session does not accept injected input.
+	ErrInjectionNotPermitted = errors.New("input injection not permitted")
+
+	// ErrTooManyObservers is returned by AddObserver
+	// when the session already has the maximum number of observers.
+	ErrTooManyObservers = errors.New("too many observers")
+
+	// ErrViewerEvicted is returned by Broadcast.Watch when the viewer
+	// is evicted for being too slow to receive the output.
+	ErrViewerEvicted = errors.New("viewer evicted")
+
+	// ErrBroadcastEnded is returned by Broadcast.Watch
+	// once the broadcast ended.
+	ErrBroadcastEnded = errors.New("broadcast ended")
+
+	// ErrSessionExpired is returned by Run when the session
+	// reaches the duration set with WithMaxSessionDuration.
+	ErrSessionExpired = errors.New("session expired")
+
+	// ErrSessionTerminated is returned by Run when the session
+	// is closed with Terminate.
+	ErrSessionTerminated = errors.New("session terminated")
+
+	// ErrSlaveHung is returned by Run when the slave didn't return any
+	// output within the interval set with WithSlaveReadWatchdog.
+	ErrSlaveHung = errors.New("slave hung")
+
+	// ErrIdleTimeout is returned by Run when the master sent no input
+	// within the timeout set with WithIdleTimeout.
+	ErrIdleTimeout = errors.New("idle timeout")
+
+	// ErrUnlockFailed is returned by Run when the master failed to unlock
+	// the screen locked by WithScreenLock too many times.
+	ErrUnlockFailed = errors.New("unlock failed")
+
+	// ErrApprovalDenied is returned by Run when the session waiting for
+	// its approval, see WithApproval, is denied with Deny.
+	ErrApprovalDenied = errors.New("approval denied")
+
+	// ErrApprovalTimeout is returned by Run when the session isn't
+	// approved within the timeout set with WithApproval.
+	ErrApprovalTimeout = errors.New("approval timeout")
+
+	// ErrNotAwaitingApproval is returned by Approve and Deny
+	// when the session doesn't wait for its approval.
+	ErrNotAwaitingApproval = errors.New("session not awaiting approval")
+
+	// ErrSelfApproval is returned by Approve and Deny when the approver
+	// is the user of the session.
+	ErrSelfApproval = errors.New("session approved by its own user")
+
+	// ErrGrantExpired is returned by Run when the access grant the session
+	// runs under, see WithAccessGrant, expired.
+	ErrGrantExpired = errors.New("access grant expired")
+
+	// ErrGrantRevoked is returned by Run when the access grant the session
+	// runs under is revoked with RevokeGrant.
+	ErrGrantRevoked = errors.New("access grant revoked")
+
+	// ErrNoGrant is returned by RevokeGrant when the session runs
+	// under no access grant.
+	ErrNoGrant = errors.New("session not running under an access grant")
+
+	// ErrGrantEnded is returned by RevokeGrant when the access grant
+	// of the session already expired or was revoked.
+	ErrGrantEnded = errors.New("access grant already ended")
+
+	// ErrMasterTimeout is returned by Run when the master didn't answer
+	// a KeepAlivePing within the timeout set with WithKeepAlive.
+	ErrMasterTimeout = errors.New("master timeout")
+
+	// ErrMasterWriteTimeout is returned when a write to the master doesn't
+	// complete within the timeout set with WithWriteTimeout.
+	// Run returns an error matching ErrMasterClosed wrapping it.
+	ErrMasterWriteTimeout = errors.New("master write timeout")
+
+	// ErrReattachNotEnabled is returned by Reattach
+	// when the session is created without WithReplayBuffer.
+	ErrReattachNotEnabled = errors.New("reattach not enabled")
+
+	// ErrSlaveNotClosable is returned by SwapSlave when the current slave
+	// doesn't implement io.Closer, its pending read can't be ended.
+	ErrSlaveNotClosable = errors.New("slave not closable")
+
+	// ErrSessionNotFound is returned by Registry.Observe
+	// when no session is registered with the ID.
+	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrAuditQueueFull is returned by AsyncAuditLogger.Log when the
+	// event is dropped, its queue being full and no spill file set.
+	ErrAuditQueueFull = errors.New("audit queue full")
+
+	// ErrAlertQueueFull is returned by WebhookAlertNotifier.Notify when
+	// the alert is dropped, its queue being full.
+	ErrAlertQueueFull = errors.New("command alert queue full")
 )
+
+// SlaveExitError is returned by Run when the slave closes and reports how
+// its process ended, see ExitStatusReporter. It matches ErrSlaveClosed with
+// errors.Is and unwraps to the error which ended the reads of the slave.
+type SlaveExitError struct {
+	Status ExitStatus
+	// The read error of the slave, such as io.EOF
+	Err error
+}
+
+func (see *SlaveExitError) Error() string {
+	return ErrSlaveClosed.Error() + ": " + see.Status.String()
+}
+
+func (see *SlaveExitError) Is(target error) bool {
+	return target == ErrSlaveClosed
+}
+
+func (see *SlaveExitError) Unwrap() error {
+	return see.Err
+}
+
+// malformedFrameError is the error of a frame of the master the protocol
+// can't parse, it matches ErrMalformedFrame with errors.Is.
+type malformedFrameError struct {
+	cause error
+}
+
+func malformedFrame(err error) error {
+	return &malformedFrameError{cause: err}
+}
+
+func (mfe *malformedFrameError) Error() string {
+	return mfe.cause.Error()
+}
+
+func (mfe *malformedFrameError) Is(target error) bool {
+	return target == ErrMalformedFrame
+}
+
+func (mfe *malformedFrameError) Unwrap() error {
+	return mfe.cause
+}
+
+// closedError tells one end of the session closed. It matches its sentinel,
+// ErrSlaveClosed or ErrMasterClosed, with errors.Is and unwraps to
+// the error that closed the end, such as io.EOF.
+type closedError struct {
+	sentinel error
+	cause    error
+}
+
+func (ce *closedError) Error() string {
+	return ce.sentinel.Error() + ": " + ce.cause.Error()
+}
+
+func (ce *closedError) Is(target error) bool {
+	return target == ce.sentinel
+}
+
+func (ce *closedError) Unwrap() error {
+	return ce.cause
+}
@@ -0,0 +1,36 @@
+package webtty
+
+import (
+	"strings"
+	"time"
+)
+
+// startExpiry arms the maximum duration of the session and its warning.
+// It returns the channel receiving when the session expires, nil without
+// a maximum duration, and a function releasing the timers.
+func (wt *WebTTY) startExpiry() (<-chan time.Time, func()) {
+	if wt.maxSessionDuration <= 0 {
+		return nil, func() {}
+	}
+
+	expiry := time.NewTimer(wt.maxSessionDuration)
+	var warning *time.Timer
+	if wt.sessionExpiryWarning > 0 && wt.sessionExpiryWarning < wt.maxSessionDuration {
+		message := wt.messages.SessionExpiring
+		if wt.sessionExpiryMessage != "" {
+			message = wt.sessionExpiryMessage
+		}
+		warning = time.AfterFunc(wt.maxSessionDuration-wt.sessionExpiryWarning, func() {
+			remaining := wt.sessionExpiryWarning.String()
+			// a broken master is detected by the read loop
+			wt.printMessage(strings.Replace(message, "%s", remaining, 1))
+		})
+	}
+
+	return expiry.C, func() {
+		expiry.Stop()
+		if warning != nil {
+			warning.Stop()
+		}
+	}
+}
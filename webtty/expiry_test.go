@@ -0,0 +1,80 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSessionExpiry(t *testing.T) {
+	rec := &frameRecorder{}
+	slaveOutPipeReader, slaveOutPipeWriter := io.Pipe()
+	defer slaveOutPipeWriter.Close()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, nil}}
+
+	dt, err := New(recordingMaster{rec}, slave,
+		WithMaxSessionDuration(50*time.Millisecond),
+		WithSessionExpiryWarning(40*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.Run(context.Background(), "", ""); err != ErrSessionExpired {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	warning := "1" + base64.StdEncoding.EncodeToString([]byte("\r\n[this session closes in 40ms]\r\n"))
+	expired := "1" + base64.StdEncoding.EncodeToString([]byte("\r\n[this session reached its maximum duration of 50ms and is closed]\r\n"))
+	frames := rec.get()
+	if len(frames) != 5 || frames[1] != "6true" || frames[2] != warning || frames[3] != expired || frames[4] != "Asession expired" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+}
+
+func TestExpiryWarning(t *testing.T) {
+	rec := &frameRecorder{}
+	slaveOutPipeReader, slaveOutPipeWriter := io.Pipe()
+	defer slaveOutPipeWriter.Close()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, nil}}
+
+	dt, err := New(recordingMaster{rec}, slave,
+		WithMaxSessionDuration(60*time.Millisecond),
+		WithExpiryWarning(30*time.Millisecond, "[closing in %s, save your work]"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	warning := "1" + base64.StdEncoding.EncodeToString([]byte("\r\n[closing in 30ms, save your work]\r\n"))
+	warned := make(chan time.Duration, 1)
+	start := time.Now()
+	go func() {
+		for time.Since(start) < time.Second {
+			for _, frame := range rec.get() {
+				if frame == warning {
+					warned <- time.Since(start)
+					return
+				}
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	if err := dt.Run(context.Background()); err != ErrSessionExpired {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("Session expired after %s", elapsed)
+	}
+	select {
+	case at := <-warned:
+		if at < 30*time.Millisecond {
+			t.Fatalf("Warning printed after %s", at)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Warning not printed: %q", rec.get())
+	}
+}
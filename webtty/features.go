@@ -0,0 +1,62 @@
+package webtty
+
+import (
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
+// FeatureSet is a set of optional protocol features.
+// Both ends advertise what they support and the session uses
+// only the features enabled on both sides.
+type FeatureSet struct {
+	// Output frames may be compressed
+	Compression bool `json:"compression,omitempty"`
+	// Output frames may carry raw bytes instead of base64 text,
+	// see WithBinaryFrames
+	BinaryFrames bool `json:"binaryFrames,omitempty"`
+	// The master acknowledges the output it processed, see WithFlowControl
+	FlowControl bool `json:"flowControl,omitempty"`
+	// The master answers the locks of the screen with Unlock messages,
+	// see WithScreenLock
+	ScreenLock bool `json:"screenLock,omitempty"`
+}
+
+// intersect returns features enabled in both fs and other.
+func (fs FeatureSet) intersect(other FeatureSet) FeatureSet {
+	return FeatureSet{
+		Compression:  fs.Compression && other.Compression,
+		BinaryFrames: fs.BinaryFrames && other.BinaryFrames,
+		FlowControl:  fs.FlowControl && other.FlowControl,
+		ScreenLock:   fs.ScreenLock && other.ScreenLock,
+	}
+}
+
+// NegotiatedFeatures returns the features resolved for the session.
+// The result is the zero value until Run has sent the initializing messages.
+func (wt *WebTTY) NegotiatedFeatures() FeatureSet {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+
+	return wt.negotiatedFeatures
+}
+
+func (wt *WebTTY) negotiateFeatures() {
+	wt.stateMutex.Lock()
+	defer wt.stateMutex.Unlock()
+
+	wt.negotiatedFeatures = wt.serverFeatures.intersect(wt.clientFeatures)
+}
+
+// ProtocolVersion returns the version of the protocol spoken with
+// the master, see WithProtocolVersion.
+func (wt *WebTTY) ProtocolVersion() int {
+	return wt.protocolVersion
+}
+
+// masterSupports returns whether the master understands frame, whose
+// type may have been added in a later version than the one it speaks.
+func (wt *WebTTY) masterSupports(frame []byte) bool {
+	if wt.protocolVersion >= protocol.Version || len(frame) == 0 {
+		return true
+	}
+	return protocol.Supported(SlaveToMaster, MessageType(frame[0]), wt.protocolVersion)
+}
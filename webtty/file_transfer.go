@@ -0,0 +1,496 @@
+package webtty
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// FileTransferConfig configures the transfers of files between the master
+// and the host of the server, see WithFileTransfer.
+type FileTransferConfig struct {
+	// Directories the files are read from and written to,
+	// with their subdirectories
+	AllowedPaths []string
+	// Largest file transferred in bytes, 10 MiB by default
+	MaxSize int64
+	// Most uploads and downloads in progress at once, 4 by default
+	MaxTransfers int
+	// Whether the master may upload files, when it is permitted to write
+	Upload bool
+	// Whether the master may download files
+	Download bool
+}
+
+const defaultFileTransferMaxSize = 10 << 20
+
+const defaultFileTransferMaxTransfers = 4
+
+// fileChunkSize is the size of the chunks of downloaded files.
+const fileChunkSize = 32 << 10
+
+// fileTransferMarker prefixes the transfers in the audit trail.
+const fileTransferMarker = "[file] "
+
+// Operations of FileTransfer messages.
+const (
+	fileOpUpload   = "upload"
+	fileOpDownload = "download"
+	fileOpFinish   = "finish"
+	fileOpCancel   = "cancel"
+)
+
+// Statuses of FileTransferStatus messages.
+const (
+	fileStatusReady = "ready"
+	fileStatusDone  = "done"
+	fileStatusError = "error"
+)
+
+// fileTransferRequest is the payload of FileTransfer messages.
+type fileTransferRequest struct {
+	// Chosen by the master to tell its transfers apart
+	ID int    `json:"id"`
+	Op string `json:"op"`
+	// Absolute path of the file on the host, with upload and download
+	Path string `json:"path,omitempty"`
+	// Size of the uploaded file, with upload
+	Size int64 `json:"size,omitempty"`
+	// Hex encoded SHA-256 of the uploaded file, with finish
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// fileChunk is the payload of UploadChunk and DownloadChunk messages.
+type fileChunk struct {
+	ID   int    `json:"id"`
+	Data []byte `json:"data"` // base64 encoded
+}
+
+// fileTransferStatus is the payload of FileTransferStatus messages.
+type fileTransferStatus struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	// Size of the file, when downloads are ready and transfers done
+	Size int64 `json:"size,omitempty"`
+	// Hex encoded SHA-256 of the file, when transfers are done
+	SHA256 string `json:"sha256,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// fileTransfers keeps the transfers in progress of a session.
+type fileTransfers struct {
+	config FileTransferConfig
+	roots  []string
+
+	mutex     sync.Mutex
+	uploads   map[int]*fileUpload
+	downloads map[int]chan struct{} // closed to cancel
+	stopped   bool
+	running   sync.WaitGroup
+}
+
+// fileUpload is a file being uploaded to a temporary file,
+// renamed to its path once complete.
+type fileUpload struct {
+	path    string
+	file    *os.File
+	size    int64
+	written int64
+	hash    hash.Hash
+}
+
+func newFileTransfers(config FileTransferConfig) (*fileTransfers, error) {
+	if len(config.AllowedPaths) == 0 {
+		return nil, errors.New("file transfer requires allowed paths")
+	}
+	if config.MaxSize <= 0 {
+		config.MaxSize = defaultFileTransferMaxSize
+	}
+	if config.MaxTransfers <= 0 {
+		config.MaxTransfers = defaultFileTransferMaxTransfers
+	}
+	roots := make([]string, 0, len(config.AllowedPaths))
+	for _, path := range config.AllowedPaths {
+		if !filepath.IsAbs(path) {
+			return nil, errors.Errorf("allowed path `%s` of file transfers is not absolute", path)
+		}
+		root, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve allowed path `%s` of file transfers", path)
+		}
+		roots = append(roots, root)
+	}
+	return &fileTransfers{
+		config:    config,
+		roots:     roots,
+		uploads:   make(map[int]*fileUpload),
+		downloads: make(map[int]chan struct{}),
+	}, nil
+}
+
+// resolve returns the path on the host of the file requested at path,
+// which must be within the allowed paths once the symbolic links of its
+// directory are resolved, and the ones of the file itself with follow.
+func (ft *fileTransfers) resolve(path string, follow bool) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", errors.Errorf("path `%s` is not absolute", path)
+	}
+	path = filepath.Clean(path)
+	dir, err := filepath.EvalSymlinks(filepath.Dir(path))
+	if err != nil {
+		return "", errors.Errorf("directory of `%s` doesn't exist", path)
+	}
+	resolved := filepath.Join(dir, filepath.Base(path))
+	if follow {
+		resolved, err = filepath.EvalSymlinks(resolved)
+		if err != nil {
+			return "", errors.Errorf("file `%s` doesn't exist", path)
+		}
+	}
+	for _, root := range ft.roots {
+		rel, err := filepath.Rel(root, resolved)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return resolved, nil
+		}
+	}
+	return "", errors.Errorf("path `%s` is not allowed", path)
+}
+
+// checkStartLocked returns an error when the transfer id can't start.
+// It must be called with the mutex held.
+func (ft *fileTransfers) checkStartLocked(id int, inProgress bool) error {
+	switch {
+	case ft.stopped:
+		return errors.New("session is ending")
+	case inProgress:
+		return errors.Errorf("file transfer %d is already in progress", id)
+	case len(ft.uploads)+len(ft.downloads) >= ft.config.MaxTransfers:
+		return errors.Errorf("too many file transfers in progress, at most %d", ft.config.MaxTransfers)
+	}
+	return nil
+}
+
+func (wt *WebTTY) handleFileTransfer(payload []byte) error {
+	var request fileTransferRequest
+	err := json.Unmarshal(payload, &request)
+	if err != nil {
+		return malformedFrame(errors.Wrapf(err, "received malformed file transfer request"))
+	}
+
+	ft := wt.fileTransfers
+	if ft == nil {
+		return wt.sendFileTransferStatus(fileTransferStatus{
+			ID:     request.ID,
+			Status: fileStatusError,
+			Error:  "file transfer is not enabled",
+		})
+	}
+
+	switch request.Op {
+	case fileOpUpload:
+		err = wt.startUpload(ft, request)
+	case fileOpFinish:
+		err = wt.finishUpload(ft, request)
+	case fileOpDownload:
+		err = wt.startDownload(ft, request)
+	case fileOpCancel:
+		ft.cancel(request.ID)
+		return nil
+	default:
+		err = errors.Errorf("unknown file transfer operation `%s`", request.Op)
+	}
+	if err != nil {
+		return wt.failFileTransfer(request.ID, request.Op, request.Path, err)
+	}
+	return nil
+}
+
+// failFileTransfer records the failure of the transfer id of path
+// and reports it to the master.
+func (wt *WebTTY) failFileTransfer(id int, op string, path string, err error) error {
+	wt.auditFileTransfer(op, path, "failed: "+err.Error())
+	return wt.sendFileTransferStatus(fileTransferStatus{ID: id, Status: fileStatusError, Error: err.Error()})
+}
+
+func (wt *WebTTY) startUpload(ft *fileTransfers, request fileTransferRequest) error {
+	if !ft.config.Upload || !wt.PermitWrite() {
+		return errors.New("file upload is not permitted")
+	}
+	if request.Size < 0 || request.Size > ft.config.MaxSize {
+		return errors.Errorf("file of %d bytes exceeds the limit of %d bytes", request.Size, ft.config.MaxSize)
+	}
+	path, err := ft.resolve(request.Path, false)
+	if err != nil {
+		return err
+	}
+
+	ft.mutex.Lock()
+	_, inProgress := ft.uploads[request.ID]
+	err = ft.checkStartLocked(request.ID, inProgress)
+	if err == nil {
+		var file *os.File
+		file, err = ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".upload")
+		if err != nil {
+			err = errors.Errorf("failed to create `%s`", request.Path)
+		} else {
+			ft.uploads[request.ID] = &fileUpload{
+				path: path,
+				file: file,
+				size: request.Size,
+				hash: sha256.New(),
+			}
+		}
+	}
+	ft.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	return wt.sendFileTransferStatus(fileTransferStatus{ID: request.ID, Status: fileStatusReady})
+}
+
+func (wt *WebTTY) handleUploadChunk(payload []byte) error {
+	var chunk fileChunk
+	err := json.Unmarshal(payload, &chunk)
+	if err != nil {
+		return malformedFrame(errors.Wrapf(err, "received malformed upload chunk"))
+	}
+	ft := wt.fileTransfers
+	if ft == nil {
+		return nil
+	}
+
+	// the permission may have been revoked since the upload started
+	permitWrite := wt.PermitWrite()
+	ft.mutex.Lock()
+	upload, ok := ft.uploads[chunk.ID]
+	if !ok {
+		// canceled or failed, the master may still be sending
+		ft.mutex.Unlock()
+		return nil
+	}
+	if !permitWrite {
+		err = errors.New("file upload is not permitted")
+	} else if upload.written+int64(len(chunk.Data)) > upload.size {
+		err = errors.Errorf("received more than the %d bytes announced", upload.size)
+	} else if _, err = upload.file.Write(chunk.Data); err != nil {
+		err = errors.New("failed to write the file")
+	}
+	if err == nil {
+		upload.written += int64(len(chunk.Data))
+		upload.hash.Write(chunk.Data)
+		ft.mutex.Unlock()
+		return nil
+	}
+	ft.abortUploadLocked(chunk.ID)
+	ft.mutex.Unlock()
+
+	return wt.failFileTransfer(chunk.ID, fileOpUpload, upload.path, err)
+}
+
+func (wt *WebTTY) finishUpload(ft *fileTransfers, request fileTransferRequest) error {
+	ft.mutex.Lock()
+	upload, ok := ft.uploads[request.ID]
+	if !ok {
+		ft.mutex.Unlock()
+		return errors.Errorf("no file transfer %d in progress", request.ID)
+	}
+	delete(ft.uploads, request.ID)
+	ft.mutex.Unlock()
+
+	sum := hex.EncodeToString(upload.hash.Sum(nil))
+	err := upload.file.Close()
+	switch {
+	case !wt.PermitWrite():
+		err = errors.New("file upload is not permitted")
+	case err != nil:
+		err = errors.New("failed to write the file")
+	case upload.written != upload.size:
+		err = errors.Errorf("received %d bytes of %d", upload.written, upload.size)
+	case !strings.EqualFold(request.SHA256, sum):
+		err = errors.New("checksum mismatch")
+	}
+	if err == nil {
+		mode := os.FileMode(0644)
+		if info, statErr := os.Stat(upload.path); statErr == nil {
+			mode = info.Mode().Perm()
+		}
+		os.Chmod(upload.file.Name(), mode)
+		if os.Rename(upload.file.Name(), upload.path) != nil {
+			err = errors.New("failed to replace the file")
+		}
+	}
+	if err != nil {
+		os.Remove(upload.file.Name())
+		return wt.failFileTransfer(request.ID, fileOpUpload, upload.path, err)
+	}
+
+	wt.auditFileTransfer(fileOpUpload, upload.path, fmt.Sprintf("%d bytes sha256:%s", upload.size, sum))
+	return wt.sendFileTransferStatus(fileTransferStatus{
+		ID:     request.ID,
+		Status: fileStatusDone,
+		Size:   upload.size,
+		SHA256: sum,
+	})
+}
+
+func (wt *WebTTY) startDownload(ft *fileTransfers, request fileTransferRequest) error {
+	if !ft.config.Download {
+		return errors.New("file download is not permitted")
+	}
+	path, err := ft.resolve(request.Path, true)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Errorf("failed to open `%s`", request.Path)
+	}
+	info, err := file.Stat()
+	switch {
+	case err != nil:
+		err = errors.Errorf("failed to open `%s`", request.Path)
+	case !info.Mode().IsRegular():
+		err = errors.Errorf("`%s` is not a regular file", request.Path)
+	case info.Size() > ft.config.MaxSize:
+		err = errors.Errorf("file of %d bytes exceeds the limit of %d bytes", info.Size(), ft.config.MaxSize)
+	}
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	canceled := make(chan struct{})
+	ft.mutex.Lock()
+	_, inProgress := ft.downloads[request.ID]
+	err = ft.checkStartLocked(request.ID, inProgress)
+	if err == nil {
+		ft.downloads[request.ID] = canceled
+		ft.running.Add(1)
+	}
+	ft.mutex.Unlock()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	err = wt.sendFileTransferStatus(fileTransferStatus{ID: request.ID, Status: fileStatusReady, Size: info.Size()})
+	go wt.sendFile(ft, request.ID, path, file, canceled)
+	return err
+}
+
+// sendFile sends the content of file as DownloadChunk messages,
+// until its end or canceled is closed.
+func (wt *WebTTY) sendFile(ft *fileTransfers, id int, path string, file *os.File, canceled chan struct{}) {
+	defer ft.running.Done()
+	defer file.Close()
+	defer func() {
+		ft.mutex.Lock()
+		if ft.downloads[id] == canceled {
+			delete(ft.downloads, id)
+		}
+		ft.mutex.Unlock()
+	}()
+
+	hash := sha256.New()
+	buf := make([]byte, fileChunkSize)
+	var size int64
+	var err error
+	for err == nil {
+		select {
+		case <-canceled:
+			wt.auditFileTransfer(fileOpDownload, path, "canceled")
+			return
+		default:
+		}
+
+		var n int
+		n, err = file.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			size += int64(n)
+			message, _ := json.Marshal(fileChunk{ID: id, Data: buf[:n]})
+			if writeErr := wt.primaryWrite(append([]byte{DownloadChunk}, message...)); writeErr != nil {
+				// a broken master is detected by the read loop
+				wt.auditFileTransfer(fileOpDownload, path, "failed: "+writeErr.Error())
+				return
+			}
+		}
+	}
+	if err != io.EOF {
+		wt.failFileTransfer(id, fileOpDownload, path, errors.New("failed to read the file"))
+		return
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	wt.auditFileTransfer(fileOpDownload, path, fmt.Sprintf("%d bytes sha256:%s", size, sum))
+	wt.sendFileTransferStatus(fileTransferStatus{ID: id, Status: fileStatusDone, Size: size, SHA256: sum})
+}
+
+// cancel stops the transfer id, if in progress.
+func (ft *fileTransfers) cancel(id int) {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	if canceled, ok := ft.downloads[id]; ok {
+		close(canceled)
+		delete(ft.downloads, id)
+	}
+	ft.abortUploadLocked(id)
+}
+
+// abortUploadLocked discards the upload id, if in progress.
+// It must be called with the mutex held.
+func (ft *fileTransfers) abortUploadLocked(id int) {
+	upload, ok := ft.uploads[id]
+	if !ok {
+		return
+	}
+	delete(ft.uploads, id)
+	upload.file.Close()
+	os.Remove(upload.file.Name())
+}
+
+// stopFileTransfers cancels the transfers in progress when the session ends.
+func (wt *WebTTY) stopFileTransfers() {
+	ft := wt.fileTransfers
+	ft.mutex.Lock()
+	ft.stopped = true
+	var aborted []string
+	for id, upload := range ft.uploads {
+		aborted = append(aborted, upload.path)
+		ft.abortUploadLocked(id)
+	}
+	for id, canceled := range ft.downloads {
+		close(canceled)
+		delete(ft.downloads, id)
+	}
+	ft.mutex.Unlock()
+	ft.running.Wait()
+
+	for _, path := range aborted {
+		wt.auditFileTransfer(fileOpUpload, path, "aborted")
+	}
+}
+
+func (wt *WebTTY) auditFileTransfer(op string, path string, result string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, fileTransferMarker+op+" "+path+" "+result)
+}
+
+func (wt *WebTTY) sendFileTransferStatus(status fileTransferStatus) error {
+	message, err := json.Marshal(status)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal file transfer status")
+	}
+	return wt.primaryWrite(append([]byte{FileTransferStatus}, message...))
+}
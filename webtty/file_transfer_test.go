@@ -0,0 +1,268 @@
+package webtty
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func fileTransferMessage(t *testing.T, messageType byte, payload interface{}) []byte {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return append([]byte{messageType}, data...)
+}
+
+// lastFileTransferStatus returns the last FileTransferStatus sent.
+func lastFileTransferStatus(t *testing.T, frames []string) fileTransferStatus {
+	for i := len(frames) - 1; i >= 0; i-- {
+		if frames[i][0] == FileTransferStatus {
+			var status fileTransferStatus
+			if err := json.Unmarshal([]byte(frames[i][1:]), &status); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			return status
+		}
+	}
+	t.Fatalf("No file transfer status in %q", frames)
+	return fileTransferStatus{}
+}
+
+func TestFileTransfer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-transfer")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rec := &frameRecorder{}
+	var mutex sync.Mutex
+	var audit []string
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithPermitWrite(),
+		WithFileTransfer(FileTransferConfig{AllowedPaths: []string{dir}, MaxSize: 8, Upload: true, Download: true}),
+		withAuditLines(func(line string, requestID string) {
+			mutex.Lock()
+			audit = append(audit, line)
+			mutex.Unlock()
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	send := func(messageType byte, payload interface{}) fileTransferStatus {
+		if err := dt.handleMasterReadEvent(fileTransferMessage(t, messageType, payload)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return lastFileTransferStatus(t, rec.get())
+	}
+
+	// upload in two chunks
+	path := filepath.Join(dir, "config")
+	sum := sha256.Sum256([]byte("abcdef"))
+	if status := send(FileTransfer, fileTransferRequest{ID: 1, Op: "upload", Path: path, Size: 6}); status.Status != "ready" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+	send(UploadChunk, fileChunk{ID: 1, Data: []byte("abc")})
+	send(UploadChunk, fileChunk{ID: 1, Data: []byte("def")})
+	status := send(FileTransfer, fileTransferRequest{ID: 1, Op: "finish", SHA256: hex.EncodeToString(sum[:])})
+	if status.Status != "done" || status.Size != 6 {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+	if data, _ := ioutil.ReadFile(path); string(data) != "abcdef" {
+		t.Fatalf("Unexpected uploaded file: %q", data)
+	}
+
+	// a corrupted upload leaves the file intact
+	send(FileTransfer, fileTransferRequest{ID: 2, Op: "upload", Path: path, Size: 3})
+	send(UploadChunk, fileChunk{ID: 2, Data: []byte("xyz")})
+	status = send(FileTransfer, fileTransferRequest{ID: 2, Op: "finish", SHA256: hex.EncodeToString(sum[:])})
+	if status.Status != "error" || status.Error != "checksum mismatch" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+	if data, _ := ioutil.ReadFile(path); string(data) != "abcdef" {
+		t.Fatalf("Unexpected file after a failed upload: %q", data)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("Temporary file left: %v", files)
+	}
+
+	// limits
+	for _, request := range []fileTransferRequest{
+		{ID: 3, Op: "upload", Path: path, Size: 9},
+		{ID: 4, Op: "upload", Path: filepath.Join(dir, "..", "escaped"), Size: 1},
+		{ID: 5, Op: "download", Path: "/etc/passwd"},
+		{ID: 6, Op: "upload", Path: "relative", Size: 1},
+	} {
+		if status := send(FileTransfer, request); status.Status != "error" || status.ID != request.ID {
+			t.Fatalf("Unexpected status for %+v: %+v", request, status)
+		}
+	}
+
+	// download
+	before := len(rec.get())
+	send(FileTransfer, fileTransferRequest{ID: 7, Op: "download", Path: path})
+	var frames []string
+	for deadline := time.Now().Add(time.Second); ; {
+		frames = rec.get()[before:]
+		if status := lastFileTransferStatus(t, frames); status.Status == "done" {
+			if status.Size != 6 || status.SHA256 != hex.EncodeToString(sum[:]) {
+				t.Fatalf("Unexpected status: %+v", status)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Download not done: %q", frames)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	var chunk fileChunk
+	if frames[1][0] != DownloadChunk || json.Unmarshal([]byte(frames[1][1:]), &chunk) != nil || string(chunk.Data) != "abcdef" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	// pending uploads are discarded when the session ends
+	send(FileTransfer, fileTransferRequest{ID: 8, Op: "upload", Path: filepath.Join(dir, "pending"), Size: 1})
+	dt.stopFileTransfers()
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("Temporary file left: %v", files)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	expected := []string{
+		"[file] upload " + path + " 6 bytes sha256:",
+		"[file] upload " + path + " failed: checksum mismatch",
+		"[file] download " + path + " 6 bytes sha256:",
+		"[file] upload " + filepath.Join(dir, "pending") + " aborted",
+	}
+	for _, entry := range expected {
+		found := false
+		for _, line := range audit {
+			found = found || strings.Contains(line, entry)
+		}
+		if !found {
+			t.Fatalf("No audit entry %q in %q", entry, audit)
+		}
+	}
+}
+
+func TestFileTransferDisabled(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	err = dt.handleMasterReadEvent(fileTransferMessage(t, FileTransfer, fileTransferRequest{ID: 1, Op: "download", Path: "/etc/passwd"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if status := lastFileTransferStatus(t, rec.get()); status.Status != "error" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithFileTransfer(FileTransferConfig{})); err == nil {
+		t.Fatalf("Expected an error without allowed paths")
+	}
+}
+
+func TestFileTransferLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-transfer")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte("abc"), 0644); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithPermitWrite(),
+		WithFileTransfer(FileTransferConfig{AllowedPaths: []string{dir}, MaxTransfers: 2, Upload: true, Download: true}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	defer dt.stopFileTransfers()
+	send := func(messageType byte, payload interface{}) fileTransferStatus {
+		if err := dt.handleMasterReadEvent(fileTransferMessage(t, messageType, payload)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return lastFileTransferStatus(t, rec.get())
+	}
+
+	send(FileTransfer, fileTransferRequest{ID: 1, Op: "upload", Path: path, Size: 3})
+	send(FileTransfer, fileTransferRequest{ID: 2, Op: "upload", Path: path, Size: 3})
+	for _, request := range []fileTransferRequest{
+		{ID: 3, Op: "upload", Path: path, Size: 3},
+		{ID: 4, Op: "download", Path: path},
+	} {
+		status := send(FileTransfer, request)
+		if status.Status != "error" || status.ID != request.ID || !strings.Contains(status.Error, "too many file transfers") {
+			t.Fatalf("Unexpected status for %+v: %+v", request, status)
+		}
+	}
+	// the transfers ending free their place
+	send(FileTransfer, fileTransferRequest{ID: 1, Op: "cancel"})
+	if status := send(FileTransfer, fileTransferRequest{ID: 3, Op: "upload", Path: path, Size: 3}); status.Status != "ready" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+}
+
+func TestFileTransferWriteRevoked(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-transfer")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config")
+	sum := sha256.Sum256([]byte("abc"))
+
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithPermitWrite(),
+		WithFileTransfer(FileTransferConfig{AllowedPaths: []string{dir}, Upload: true}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	send := func(messageType byte, payload interface{}) fileTransferStatus {
+		if err := dt.handleMasterReadEvent(fileTransferMessage(t, messageType, payload)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return lastFileTransferStatus(t, rec.get())
+	}
+
+	// revoked between the chunks
+	send(FileTransfer, fileTransferRequest{ID: 1, Op: "upload", Path: path, Size: 3})
+	send(UploadChunk, fileChunk{ID: 1, Data: []byte("a")})
+	dt.SetPermitWrite(false)
+	status := send(UploadChunk, fileChunk{ID: 1, Data: []byte("bc")})
+	if status.Status != "error" || status.ID != 1 || status.Error != "file upload is not permitted" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+
+	// revoked before the finish
+	dt.SetPermitWrite(true)
+	send(FileTransfer, fileTransferRequest{ID: 2, Op: "upload", Path: path, Size: 3})
+	send(UploadChunk, fileChunk{ID: 2, Data: []byte("abc")})
+	dt.SetPermitWrite(false)
+	status = send(FileTransfer, fileTransferRequest{ID: 2, Op: "finish", SHA256: hex.EncodeToString(sum[:])})
+	if status.Status != "error" || status.ID != 2 || status.Error != "file upload is not permitted" {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Fatalf("Unexpected files after the revoked uploads: %v", files)
+	}
+}
@@ -0,0 +1,99 @@
+package webtty
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// flowWindow counts the output sent to the master and not acknowledged
+// yet, so that the slave reader pauses while it exceeds the window size.
+type flowWindow struct {
+	size int64
+
+	mutex   sync.Mutex
+	pending int64
+	// closed and replaced when pending decreases
+	changed chan struct{}
+}
+
+func newFlowWindow(size int) *flowWindow {
+	return &flowWindow{size: int64(size), changed: make(chan struct{})}
+}
+
+// sent counts n bytes of output sent to the master.
+func (fw *flowWindow) sent(n int) {
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+
+	fw.pending += int64(n)
+}
+
+// acknowledge releases n bytes processed by the master.
+func (fw *flowWindow) acknowledge(n int64) {
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+
+	fw.pending -= n
+	if fw.pending < 0 {
+		// acknowledgments of output sent before a reset
+		fw.pending = 0
+	}
+	close(fw.changed)
+	fw.changed = make(chan struct{})
+}
+
+// reset starts counting again from n bytes, for a reattached master.
+func (fw *flowWindow) reset(n int) {
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+
+	fw.pending = int64(n)
+	close(fw.changed)
+	fw.changed = make(chan struct{})
+}
+
+// unacknowledged returns the bytes of output the master didn't acknowledge.
+func (fw *flowWindow) unacknowledged() int64 {
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+
+	return fw.pending
+}
+
+// wait blocks while the window is full, until ctx is done or stopped
+// is closed.
+func (fw *flowWindow) wait(ctx context.Context, stopped <-chan struct{}) error {
+	for {
+		fw.mutex.Lock()
+		if fw.pending < fw.size {
+			fw.mutex.Unlock()
+			return nil
+		}
+		changed := fw.changed
+		fw.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-stopped:
+			return ctx.Err()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// handleAcknowledgeOutput releases the output acknowledged by the master.
+// Acknowledgments are ignored without flow control.
+func (wt *WebTTY) handleAcknowledgeOutput(payload []byte) error {
+	n, err := strconv.ParseInt(string(payload), 10, 64)
+	if err != nil || n < 0 {
+		return malformedFrame(errors.Errorf("received malformed output acknowledgment `%s`", payload))
+	}
+	if wt.flowWindow != nil {
+		wt.flowWindow.acknowledge(n)
+	}
+	return nil
+}
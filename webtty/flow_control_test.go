@@ -0,0 +1,112 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+)
+
+// flowMaster records its frames and reads the frames written to input.
+type flowMaster struct {
+	recordingMaster
+	input *io.PipeReader
+}
+
+func (fm flowMaster) Read(p []byte) (int, error) { return fm.input.Read(p) }
+
+func hasFrame(frames []string, frame string) bool {
+	for _, f := range frames {
+		if f == frame {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFlowControl(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	masterReader, masterWriter := io.Pipe()
+	rec := &frameRecorder{}
+	dt, err := New(flowMaster{recordingMaster{rec}, masterReader}, &pipeSlave{pipePair{slaveReader, nil}},
+		WithFlowControl(4),
+		WithClientFeatures(FeatureSet{FlowControl: true}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- dt.Run(ctx) }()
+
+	// the window is full once abcd is sent
+	slaveWriter.Write([]byte("abcd"))
+	go slaveWriter.Write([]byte("efgh"))
+	for deadline := time.Now().Add(time.Second); !hasFrame(rec.get(), "1YWJjZA=="); {
+		if time.Now().After(deadline) {
+			t.Fatalf("Unexpected frames: %q", rec.get())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if frames := rec.get(); hasFrame(frames, "1ZWZnaA==") {
+		t.Fatalf("Output sent beyond the window: %q", frames)
+	}
+	if pending := dt.Stats().UnacknowledgedOutput; pending != 4 {
+		t.Fatalf("Unexpected unacknowledged output: %d", pending)
+	}
+
+	// acknowledging a part of the output opens the window
+	masterWriter.Write([]byte{AcknowledgeOutput, '3'})
+	for deadline := time.Now().Add(time.Second); !hasFrame(rec.get(), "1ZWZnaA=="); {
+		if time.Now().After(deadline) {
+			t.Fatalf("Output not resumed: %q", rec.get())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// a malformed acknowledgment is dropped
+	masterWriter.Write([]byte{AcknowledgeOutput, '-', '1'})
+	for deadline := time.Now().Add(time.Second); dt.Stats().MalformedFrames != 1; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Malformed acknowledgment not dropped")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if pending := dt.Stats().UnacknowledgedOutput; pending != 5 {
+		t.Fatalf("Unexpected unacknowledged output: %d", pending)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}
+
+func TestFlowControlNotNegotiated(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, WithFlowControl(1))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dt.Run(ctx)
+
+	// the master doesn't acknowledge, output is not paused
+	slaveWriter.Write([]byte("abcd"))
+	slaveWriter.Write([]byte("efgh"))
+	for deadline := time.Now().Add(time.Second); !hasFrame(rec.get(), "1ZWZnaA=="); {
+		if time.Now().After(deadline) {
+			t.Fatalf("Unexpected frames: %q", rec.get())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithFlowControl(0)); err == nil {
+		t.Fatalf("Expected an error for an empty window")
+	}
+}
@@ -0,0 +1,76 @@
+//go:build go1.18
+// +build go1.18
+
+package webtty
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+)
+
+// fuzzSlave takes any input.
+type fuzzSlave struct{}
+
+func (fuzzSlave) Read(p []byte) (int, error)                   { select {} }
+func (fuzzSlave) Write(p []byte) (int, error)                  { return len(p), nil }
+func (fuzzSlave) WindowTitleVariables() map[string]interface{} { return map[string]interface{}{} }
+func (fuzzSlave) ResizeTerminal(columns int, rows int) error   { return nil }
+func (fuzzSlave) ControlReplay(control ReplayControl) error    { return nil }
+
+func newFuzzTTY(t *testing.T) *WebTTY {
+	dt, err := New(discardMaster{}, fuzzSlave{},
+		WithPermitWrite(),
+		WithFlowControl(1<<20),
+		WithAuditLogger(AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error { return nil })),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	return dt
+}
+
+// FuzzHandleMasterReadEvent checks the frames of the master either succeed
+// or fail as malformed, the same way each time.
+func FuzzHandleMasterReadEvent(f *testing.F) {
+	for _, seed := range []string{
+		"", "0", "1ls\r", "2", `3{"Columns":80,"Rows":24}`, "442.5", "5", "610",
+		`7{"id":1,"op":"download","path":"/tmp/file"}`, `8{"id":1,"offset":0,"data":""}`,
+		"9true", `A{"action":"speed","speed":2}`,
+		`B{"credential":"secret"}`, "Z",
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, frame []byte) {
+		first := newFuzzTTY(t).handleMasterReadEvent(frame)
+		if first != nil && !stderrors.Is(first, ErrMalformedFrame) {
+			t.Fatalf("Unexpected error for %q: %s", frame, first)
+		}
+		second := newFuzzTTY(t).handleMasterReadEvent(frame)
+		if (first == nil) != (second == nil) || (first != nil && first.Error() != second.Error()) {
+			t.Fatalf("Frame %q handled as %v then as %v", frame, first, second)
+		}
+	})
+}
+
+// FuzzResizeTerminal checks the sizes of the master are kept
+// within the maximum size of the terminal.
+func FuzzResizeTerminal(f *testing.F) {
+	for _, seed := range []string{
+		`{"Columns":80,"Rows":24}`, `{"Columns":80.9,"Rows":24.2}`, `{"Columns":-1,"Rows":1e300}`,
+		`{"Columns":"80"}`, `{}`, `null`, `[]`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		dt := newFuzzTTY(t)
+		err := dt.handleMasterReadEvent(append([]byte{ResizeTerminal}, payload...))
+		if err != nil && !stderrors.Is(err, ErrMalformedFrame) {
+			t.Fatalf("Unexpected error for %q: %s", payload, err)
+		}
+		columns, rows := dt.slaveSize()
+		if columns < 0 || columns > DefaultMaxTerminalColumns || rows < 0 || rows > DefaultMaxTerminalRows {
+			t.Fatalf("Unexpected size for %q: %dx%d", payload, columns, rows)
+		}
+	})
+}
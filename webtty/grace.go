@@ -0,0 +1,111 @@
+package webtty
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// InputGraceAction tells what to do with input received
+// during the grace period set with WithInputGracePeriod.
+type InputGraceAction int
+
+const (
+	// Hold input and forward it to the slave when the grace period ends
+	InputGraceBuffer InputGraceAction = iota
+	// Discard input
+	InputGraceDrop
+)
+
+// promptSuffixes are the last characters of common shell prompts,
+// seeing one at the end of the output ends the grace period early.
+var promptSuffixes = []byte("$#>%")
+
+// inputGrace holds input from the master until the slave is ready.
+type inputGrace struct {
+	action   InputGraceAction
+	maxBytes int
+	forward  func(keys []byte) error
+
+	mutex    sync.Mutex
+	buffered []byte
+	timer    *time.Timer
+	done     bool
+}
+
+func newInputGrace(action InputGraceAction, maxBytes int, forward func(keys []byte) error) *inputGrace {
+	return &inputGrace{
+		action:   action,
+		maxBytes: maxBytes,
+		forward:  forward,
+	}
+}
+
+// start ends the grace period after d.
+func (ig *inputGrace) start(d time.Duration) {
+	ig.mutex.Lock()
+	defer ig.mutex.Unlock()
+
+	if !ig.done {
+		ig.timer = time.AfterFunc(d, func() { ig.end() })
+	}
+}
+
+// hold returns true when keys are taken by the grace period.
+// Input held beyond maxBytes is dropped.
+func (ig *inputGrace) hold(keys []byte) bool {
+	ig.mutex.Lock()
+	defer ig.mutex.Unlock()
+
+	if ig.done {
+		return false
+	}
+	if ig.action == InputGraceBuffer && len(ig.buffered)+len(keys) <= ig.maxBytes {
+		ig.buffered = append(ig.buffered, keys...)
+	}
+	return true
+}
+
+// observeOutput ends the grace period when data looks like a prompt.
+func (ig *inputGrace) observeOutput(data []byte) error {
+	data = bytes.TrimRight(data, " ")
+	if len(data) == 0 || bytes.IndexByte(promptSuffixes, data[len(data)-1]) < 0 {
+		return nil
+	}
+	return ig.end()
+}
+
+// end forwards the held input and lets further input through.
+func (ig *inputGrace) end() error {
+	ig.mutex.Lock()
+	defer ig.mutex.Unlock()
+
+	if ig.done {
+		return nil
+	}
+	ig.stopLocked()
+
+	buffered := ig.buffered
+	ig.buffered = nil
+	if len(buffered) == 0 {
+		return nil
+	}
+	return ig.forward(buffered)
+}
+
+// stop ends the grace period discarding the held input.
+func (ig *inputGrace) stop() {
+	ig.mutex.Lock()
+	defer ig.mutex.Unlock()
+
+	ig.stopLocked()
+	ig.buffered = nil
+}
+
+func (ig *inputGrace) stopLocked() {
+	ig.done = true
+	if ig.timer != nil {
+		ig.timer.Stop()
+		ig.timer = nil
+	}
+}
@@ -0,0 +1,104 @@
+package webtty
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestInputGrace(t *testing.T) {
+	var forwarded []byte
+	ig := newInputGrace(InputGraceBuffer, 4, func(keys []byte) error {
+		forwarded = append(forwarded, keys...)
+		return nil
+	})
+	ig.start(time.Hour)
+
+	if !ig.hold([]byte("ls")) || !ig.hold([]byte("\r")) || !ig.hold([]byte("toolong")) {
+		t.Fatalf("Input not held during grace period")
+	}
+	if err := ig.observeOutput([]byte("Last login: today\r\n")); err != nil || forwarded != nil {
+		t.Fatalf("Grace period ended without prompt: %q, %v", forwarded, err)
+	}
+	if err := ig.observeOutput([]byte("user@host:~$ ")); err != nil {
+		t.Fatalf("Unexpected error from observeOutput(): %s", err)
+	}
+	if string(forwarded) != "ls\r" {
+		t.Fatalf("Unexpected forwarded input: %q", forwarded)
+	}
+	if ig.hold([]byte("pwd")) {
+		t.Fatalf("Input held after grace period")
+	}
+}
+
+func TestInputGraceDrop(t *testing.T) {
+	forwarded := make(chan []byte, 1)
+	ig := newInputGrace(InputGraceDrop, 1024, func(keys []byte) error {
+		forwarded <- keys
+		return nil
+	})
+	ig.start(10 * time.Millisecond)
+
+	if !ig.hold([]byte("ls")) {
+		t.Fatalf("Input not held during grace period")
+	}
+	time.Sleep(50 * time.Millisecond)
+	if ig.hold([]byte("pwd")) {
+		t.Fatalf("Input held after grace period")
+	}
+	select {
+	case keys := <-forwarded:
+		t.Fatalf("Unexpected forwarded input: %q", keys)
+	default:
+	}
+}
+
+func TestInputGraceAudit(t *testing.T) {
+	for _, action := range []InputGraceAction{InputGraceDrop, InputGraceBuffer} {
+		slaveReader, slaveWriter := io.Pipe()
+		go io.Copy(ioutil.Discard, slaveReader)
+
+		var mutex sync.Mutex
+		var audited []string
+		dt, err := New(discardMaster{}, &pipeSlave{pipePair{nil, slaveWriter}},
+			WithPermitWrite(),
+			WithInputGracePeriod(time.Hour),
+			WithInputGraceAction(action),
+			withAuditLines(func(line string, requestID string) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				audited = append(audited, line)
+			}),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		if err := dt.handleMasterReadEvent([]byte("1ls\r")); err != nil {
+			t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+		}
+		if len(audited) != 0 {
+			t.Fatalf("Input held during grace period was audited: %q", audited)
+		}
+		if err := dt.handleSlaveReadEvent([]byte("$ ")); err != nil {
+			t.Fatalf("Unexpected error from handleSlaveReadEvent(): %s", err)
+		}
+
+		mutex.Lock()
+		switch action {
+		case InputGraceDrop:
+			if len(audited) != 0 {
+				t.Fatalf("Dropped input was audited: %q", audited)
+			}
+		case InputGraceBuffer:
+			if len(audited) != 1 || !strings.HasSuffix(audited[0], "[LOG:ls]") {
+				t.Fatalf("Unexpected audit of forwarded input: %q", audited)
+			}
+		}
+		mutex.Unlock()
+		slaveWriter.Close()
+	}
+}
@@ -0,0 +1,138 @@
+package webtty
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// grantMarker prefixes the events of the access grants of the sessions
+// in the audit trail.
+const grantMarker = "[grant] "
+
+// AccessGrant is a time-boxed access of a user to a target, such as
+// a cluster, issued for a just-in-time privilege, see WithAccessGrant.
+type AccessGrant struct {
+	ID      string    `json:"id"`
+	User    string    `json:"user"`
+	Target  string    `json:"target"`
+	Expires time.Time `json:"expires"`
+	// The user who issued the grant, and why
+	IssuedBy string `json:"issuedBy,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Expired returns whether the grant expired at now.
+func (ag AccessGrant) Expired(now time.Time) bool {
+	return !now.Before(ag.Expires)
+}
+
+// grant is the state of the access grant of WithAccessGrant.
+type grant struct {
+	AccessGrant
+	warning time.Duration
+
+	endOnce sync.Once
+	// receives ErrGrantExpired or ErrGrantRevoked
+	ended chan error
+}
+
+// end ends the session with err, ErrGrantExpired or ErrGrantRevoked,
+// and returns false when it already ended.
+func (g *grant) end(err error) bool {
+	ended := false
+	g.endOnce.Do(func() {
+		g.ended <- err
+		ended = true
+	})
+	return ended
+}
+
+// WithAccessGrant runs the session under the access grant of its user to
+// its target, until the grant expires. warning before it expires, the
+// GrantExpiring message is printed, then Run returns ErrGrantExpired once
+// it expired, after printing the GrantExpired message. Run returns
+// ErrGrantRevoked once the grant is revoked with RevokeGrant. The opening
+// of the session under the grant, its expiry and its revocation are
+// recorded in the audit trail.
+func WithAccessGrant(access AccessGrant, warning time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if access.ID == "" || access.Expires.IsZero() {
+			return errors.New("access grant requires an ID and an expiry")
+		}
+		if warning < 0 {
+			return errors.New("access grant warning must not be negative")
+		}
+		wt.grant = &grant{AccessGrant: access, warning: warning, ended: make(chan error, 1)}
+		return nil
+	}
+}
+
+// Grant returns the access grant the session runs under, see
+// WithAccessGrant, false when it runs under none.
+func (wt *WebTTY) Grant() (AccessGrant, bool) {
+	if wt.grant == nil {
+		return AccessGrant{}, false
+	}
+	return wt.grant.AccessGrant, true
+}
+
+// RevokeGrant closes the session running under an access grant, see
+// WithAccessGrant, on behalf of revoker, Run returns ErrGrantRevoked.
+// It returns ErrNoGrant when the session runs under no grant, and
+// ErrGrantEnded when its grant already expired or was revoked.
+func (wt *WebTTY) RevokeGrant(revoker string) error {
+	g := wt.grant
+	if g == nil {
+		return ErrNoGrant
+	}
+	if !g.end(ErrGrantRevoked) {
+		return ErrGrantEnded
+	}
+	wt.auditGrant(g, "revoked by "+revoker)
+	return nil
+}
+
+// startGrant arms the expiry of the access grant and its warning. It
+// returns the channel receiving why the grant ended, nil without grant,
+// and a function releasing the timers.
+func (wt *WebTTY) startGrant() (<-chan error, func()) {
+	g := wt.grant
+	if g == nil {
+		return nil, func() {}
+	}
+
+	wt.auditGrant(g, "opened under grant "+g.ID+" until "+g.Expires.UTC().Format(time.RFC3339))
+	remaining := time.Until(g.Expires)
+	expiry := time.AfterFunc(remaining, func() {
+		if g.end(ErrGrantExpired) {
+			wt.auditGrant(g, "grant "+g.ID+" expired")
+		}
+	})
+	var warning *time.Timer
+	if message := wt.messages.GrantExpiring; g.warning > 0 && remaining > 0 && message != "" {
+		lead := g.warning
+		if lead > remaining {
+			// opened shortly before the expiry
+			lead = remaining.Round(time.Second)
+		}
+		warning = time.AfterFunc(remaining-lead, func() {
+			// a broken master is detected by the read loop
+			wt.printMessage(strings.Replace(message, "%s", lead.String(), 1))
+		})
+	}
+
+	return g.ended, func() {
+		expiry.Stop()
+		if warning != nil {
+			warning.Stop()
+		}
+	}
+}
+
+func (wt *WebTTY) auditGrant(g *grant, change string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, grantMarker+change)
+}
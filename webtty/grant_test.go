@@ -0,0 +1,106 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newGrantTTY(t *testing.T, rec *frameRecorder, grant AccessGrant, warning time.Duration) (*WebTTY, func() []string) {
+	return newAuditedTTY(t, rec,
+		WithMessages("en", Messages{GrantExpiring: "[expiring in %s]", GrantExpired: "[expired]", GrantRevoked: "[revoked]"}),
+		WithAccessGrant(grant, warning))
+}
+
+// runUntil runs dt until it returns, failing after 2 seconds.
+func runUntil(t *testing.T, dt *WebTTY, started func()) error {
+	done := make(chan error, 1)
+	go func() { done <- dt.Run(context.Background()) }()
+	if started != nil {
+		started()
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected the session to close")
+		return nil
+	}
+}
+
+// printedMessages returns the messages printed on the terminal in frames.
+func printedMessages(frames []string) []string {
+	var printed []string
+	for _, frame := range frames {
+		if frame[0] != Output {
+			continue
+		}
+		decoded, _ := base64.StdEncoding.DecodeString(frame[1:])
+		printed = append(printed, strings.TrimSpace(string(decoded)))
+	}
+	return printed
+}
+
+func TestAccessGrantExpired(t *testing.T) {
+	rec := &frameRecorder{}
+	expires := time.Now().Add(100 * time.Millisecond)
+	dt, recorded := newGrantTTY(t, rec, AccessGrant{ID: "g1", User: "alice", Target: "prod", Expires: expires}, 50*time.Millisecond)
+	if grant, ok := dt.Grant(); !ok || grant.ID != "g1" {
+		t.Fatalf("Unexpected grant: %v %v", grant, ok)
+	}
+
+	if err := runUntil(t, dt, nil); err != ErrGrantExpired {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if printed := printedMessages(rec.get()); strings.Join(printed, ",") != "[expiring in 50ms],[expired]" {
+		t.Fatalf("Unexpected messages: %q", printed)
+	}
+	events := recorded()
+	if len(events) < 2 || events[0] != grantMarker+"opened under grant g1 until "+expires.UTC().Format(time.RFC3339) ||
+		events[1] != grantMarker+"grant g1 expired" {
+		t.Fatalf("Unexpected audit events: %q", events)
+	}
+	if err := dt.RevokeGrant("bob"); err != ErrGrantEnded {
+		t.Fatalf("Unexpected error from RevokeGrant() of an expired grant: %v", err)
+	}
+}
+
+func TestAccessGrantRevoked(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, recorded := newGrantTTY(t, rec, AccessGrant{ID: "g1", Expires: time.Now().Add(time.Hour)}, time.Minute)
+
+	err := runUntil(t, dt, func() {
+		deadline := time.Now().Add(2 * time.Second)
+		for len(recorded()) == 0 {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected the grant to be audited")
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		if err := dt.RevokeGrant("bob"); err != nil {
+			t.Fatalf("Unexpected error from RevokeGrant(): %s", err)
+		}
+	})
+	if err != ErrGrantRevoked {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if printed := printedMessages(rec.get()); strings.Join(printed, ",") != "[revoked]" {
+		t.Fatalf("Unexpected messages: %q", printed)
+	}
+	if events := recorded(); events[1] != grantMarker+"revoked by bob" {
+		t.Fatalf("Unexpected audit events: %q", events)
+	}
+
+	dt, err = New(discardMaster{}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.RevokeGrant("bob"); err != ErrNoGrant {
+		t.Fatalf("Unexpected error from RevokeGrant() without grant: %v", err)
+	}
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithAccessGrant(AccessGrant{ID: "g1"}, 0)); err == nil {
+		t.Fatalf("Expected an error for a grant without expiry")
+	}
+}
@@ -0,0 +1,30 @@
+package webtty
+
+import (
+	"context"
+	"time"
+)
+
+// heartbeatMarker prefixes the heartbeat events in the audit trail.
+const heartbeatMarker = "[heartbeat] "
+
+// auditHeartbeat records that the session is still alive.
+func (wt *WebTTY) auditHeartbeat() {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, heartbeatMarker+"session "+session.SessionID+" active")
+}
+
+// runHeartbeat calls beat every interval until ctx is done.
+func runHeartbeat(ctx context.Context, interval time.Duration, beat func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			beat()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package webtty
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunHeartbeat(t *testing.T) {
+	var beats int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runHeartbeat(ctx, 10*time.Millisecond, func() { atomic.AddInt32(&beats, 1) })
+	}()
+
+	time.Sleep(55 * time.Millisecond)
+	cancel()
+	<-done
+
+	n := atomic.LoadInt32(&beats)
+	if n < 2 {
+		t.Fatalf("Unexpected number of heartbeats: %d", n)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if atomic.LoadInt32(&beats) != n {
+		t.Fatalf("Heartbeat continued after cancel")
+	}
+}
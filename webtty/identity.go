@@ -0,0 +1,52 @@
+package webtty
+
+import (
+	"context"
+)
+
+// Identity describes the user on the master of a session, as authenticated
+// by the server, for slaves to act on their behalf, such as running the
+// command as a matching OS user or impersonating them to Kubernetes.
+type Identity struct {
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+	// How the user was authenticated, such as "basic"
+	AuthMethod string `json:"authMethod,omitempty"`
+	// Address the master connected from, without the port
+	SourceIP string `json:"sourceIp,omitempty"`
+	// User the session acts as, the user itself when empty
+	Impersonate string `json:"impersonate,omitempty"`
+}
+
+// Target returns the user the session acts as.
+func (identity Identity) Target() string {
+	if identity.Impersonate != "" {
+		return identity.Impersonate
+	}
+	return identity.User
+}
+
+type identityContextKey struct{}
+
+// WithIdentityContext returns a copy of ctx carrying identity,
+// which Run takes over the one set with WithIdentity.
+func WithIdentityContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity stored in ctx
+// with WithIdentityContext, if any.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(Identity)
+	return identity, ok
+}
+
+// Identity returns the identity of the user on the master.
+func (wt *WebTTY) Identity() Identity {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+
+	identity := wt.identity
+	identity.Groups = append([]string(nil), identity.Groups...)
+	return identity
+}
@@ -0,0 +1,34 @@
+package webtty
+
+import (
+	"github.com/pkg/errors"
+)
+
+// InjectInput writes data to the slave as if it was typed on the master.
+// Injection requires WithPermitInjection and is independent of the write
+// permission of the master. Each injection is recorded in the audit trail
+// with a marker so that it can be told apart from the user's commands.
+func (wt *WebTTY) InjectInput(data []byte) error {
+	wt.stateMutex.RLock()
+	permitted := wt.permitInjection
+	session := wt.session
+	wt.stateMutex.RUnlock()
+
+	if !permitted {
+		return ErrInjectionNotPermitted
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	_, err := wt.slaveWrite(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write injected input to slave")
+	}
+
+	wt.auditCommand(session.User, session.ClusterID, injectedMarker+string(data), "", 0)
+	return nil
+}
+
+// injectedMarker prefixes injected input in the audit trail.
+const injectedMarker = "[server-injected] "
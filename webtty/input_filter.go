@@ -0,0 +1,40 @@
+package webtty
+
+// escapeStringIntroducers are the final bytes of the escape sequences
+// introducing control strings: DCS, SOS, OSC, PM and APC.
+// Those are never sent by keys and can carry commands for terminals.
+var escapeStringIntroducers = []byte("PX]^_")
+
+// stripInputEscapes appends input to dst without control strings.
+// Control strings run until ST, BEL for OSC, or the end of input.
+// Other escape sequences, such as the ones sent by cursor keys, are kept.
+func stripInputEscapes(dst []byte, input []byte) []byte {
+	for i := 0; i < len(input); i++ {
+		if input[i] != 0x1b || i+1 >= len(input) || !isEscapeStringIntroducer(input[i+1]) {
+			dst = append(dst, input[i])
+			continue
+		}
+
+		osc := input[i+1] == ']'
+		i += 2
+		for ; i < len(input); i++ {
+			if osc && input[i] == 0x07 {
+				break
+			}
+			if input[i] == 0x1b && i+1 < len(input) && input[i+1] == '\\' {
+				i++
+				break
+			}
+		}
+	}
+	return dst
+}
+
+func isEscapeStringIntroducer(b byte) bool {
+	for _, introducer := range escapeStringIntroducers {
+		if b == introducer {
+			return true
+		}
+	}
+	return false
+}
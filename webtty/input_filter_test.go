@@ -0,0 +1,28 @@
+package webtty
+
+import (
+	"testing"
+)
+
+func TestStripInputEscapes(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"ls -l", "ls -l"},
+		{"\x1b[A", "\x1b[A"},
+		{"\x1bOB", "\x1bOB"},
+		{"a\x1b]0;title\x07b", "ab"},
+		{"a\x1b]52;c;Zm9v\x1b\\b", "ab"},
+		{"a\x1bPq#0;2;0;0;0\x1b\\b", "ab"},
+		{"a\x1b_unterminated", "a"},
+		{"\x1b", "\x1b"},
+	}
+
+	for _, c := range cases {
+		stripped := string(stripInputEscapes(nil, []byte(c.input)))
+		if stripped != c.expected {
+			t.Errorf("Unexpected result for %q: %q", c.input, stripped)
+		}
+	}
+}
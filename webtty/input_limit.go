@@ -0,0 +1,68 @@
+package webtty
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket throttling the input written to the slave,
+// or the output of the slave.
+// It holds up to burst bytes and refills at perSecond bytes per second.
+// Data larger than the bucket is let through once the debt it leaves
+// would have been refilled.
+type rateLimiter struct {
+	perSecond float64
+	burst     float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(perSecond int, burst int, now time.Time) *rateLimiter {
+	return &rateLimiter{
+		perSecond: float64(perSecond),
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		last:      now,
+	}
+}
+
+// reserve takes n bytes at now and returns how long to wait
+// before writing them.
+func (il *rateLimiter) reserve(now time.Time, n int) time.Duration {
+	il.mutex.Lock()
+	defer il.mutex.Unlock()
+
+	if elapsed := now.Sub(il.last); elapsed > 0 {
+		il.tokens += elapsed.Seconds() * il.perSecond
+		if il.tokens > il.burst {
+			il.tokens = il.burst
+		}
+		il.last = now
+	}
+	il.tokens -= float64(n)
+	if il.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-il.tokens / il.perSecond * float64(time.Second))
+}
+
+// wait blocks until n bytes may be written, or returns the error of ctx
+// when it's done first.
+func (il *rateLimiter) wait(ctx context.Context, n int) error {
+	delay := il.reserve(time.Now(), n)
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
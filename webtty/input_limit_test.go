@@ -0,0 +1,71 @@
+package webtty
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestInputLimiterReserve(t *testing.T) {
+	now := time.Now()
+	il := newRateLimiter(1000, 100, now)
+
+	if delay := il.reserve(now, 100); delay != 0 {
+		t.Fatalf("Unexpected delay within burst: %s", delay)
+	}
+	if delay := il.reserve(now, 50); delay != 50*time.Millisecond {
+		t.Fatalf("Unexpected delay beyond burst: %s", delay)
+	}
+	// the debt is refilled first
+	if delay := il.reserve(now.Add(100*time.Millisecond), 1); delay != 0 {
+		t.Fatalf("Unexpected delay after refill: %s", delay)
+	}
+}
+
+func TestInputRateLimit(t *testing.T) {
+	masterReader, masterWriter := io.Pipe()
+	slaveReader, _ := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	dt, err := New(pipeMaster{masterReader}, &pipeSlave{pipePair{slaveReader, inputWriter}},
+		WithPermitWrite(), WithInputRateLimit(20000, 1000))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx, "", "") }()
+
+	paste := bytes.Repeat([]byte("x"), 4000)
+	received := make(chan time.Duration)
+	start := time.Now()
+	go func() {
+		io.CopyN(ioutil.Discard, inputReader, int64(len(paste)))
+		received <- time.Since(start)
+	}()
+	for i := 0; i < len(paste); i += 1000 {
+		masterWriter.Write(append([]byte{Input}, paste[i:i+1000]...))
+	}
+	// 3000 bytes beyond the burst at 20000 bytes per second
+	if elapsed := <-received; elapsed < 150*time.Millisecond {
+		t.Fatalf("Paste written too fast: %s", elapsed)
+	}
+
+	// a throttled session ends promptly when canceled
+	go masterWriter.Write(append([]byte{Input}, bytes.Repeat([]byte("x"), 1000)...))
+	go masterWriter.Write(append([]byte{Input}, bytes.Repeat([]byte("x"), 1000)...))
+	time.Sleep(10 * time.Millisecond)
+	cancelStart := time.Now()
+	cancel()
+	defer masterWriter.Close()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if elapsed := time.Since(cancelStart); elapsed > 100*time.Millisecond {
+		t.Fatalf("Run returned %s after cancel", elapsed)
+	}
+}
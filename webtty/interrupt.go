@@ -0,0 +1,74 @@
+package webtty
+
+import (
+	"io"
+	"time"
+)
+
+// interruptWaitTimeout bounds the time interruptReads waits
+// for the read loops to return.
+var interruptWaitTimeout = time.Second
+
+// interruptReads ends the pending reads of the master and the slave
+// and waits for both read loops to return their errors to errs,
+// at most for interruptWaitTimeout.
+// When one of them can't be interrupted, it doesn't wait.
+func (wt *WebTTY) interruptReads(errs <-chan error) {
+	masterInterrupted := interruptRead(wt.currentMaster())
+	slaveInterrupted := interruptRead(wt.currentSlave())
+	if !masterInterrupted || !slaveInterrupted {
+		return
+	}
+
+	timeout := time.After(interruptWaitTimeout)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-errs:
+		case <-timeout:
+			return
+		}
+	}
+}
+
+// drainReads ends the pending read of the master and lets the slave read loop
+// forward its buffered output for up to the drain timeout,
+// then waits for both read loops to return their errors to errs.
+// When one of them can't be interrupted, it waits for the drain timeout only.
+func (wt *WebTTY) drainReads(errs <-chan error) {
+	masterInterrupted := interruptRead(wt.currentMaster())
+	slaveInterrupted := false
+	if deadliner, ok := wt.currentSlave().(readDeadliner); ok {
+		slaveInterrupted = deadliner.SetReadDeadline(time.Now().Add(wt.drainTimeout)) == nil
+	}
+
+	window := wt.drainTimeout
+	if masterInterrupted && slaveInterrupted {
+		window += interruptWaitTimeout
+	}
+	timeout := time.After(window)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-errs:
+		case <-timeout:
+			return
+		}
+	}
+}
+
+// isStopped reports whether stopped is closed.
+func isStopped(stopped <-chan struct{}) bool {
+	select {
+	case <-stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// interruptRead sets a past read deadline on rw.
+// Closing is not used as a fallback since Close of some slaves,
+// such as LocalCommand, waits for their process to exit.
+func interruptRead(rw io.ReadWriter) bool {
+	deadliner, ok := rw.(readDeadliner)
+	return ok && deadliner.SetReadDeadline(time.Now()) == nil
+}
@@ -0,0 +1,173 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type connSlave struct {
+	net.Conn
+}
+
+func (cs connSlave) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (cs connSlave) ResizeTerminal(columns int, rows int) error {
+	return nil
+}
+
+// stuckSlave accepts read deadlines but ignores them.
+type stuckSlave struct {
+	*pipeSlave
+}
+
+func (stuckSlave) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+func TestInterruptReadsOnCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	conn, connPeer := net.Pipe()
+	go io.Copy(ioutil.Discard, connPeer)
+	slaveConn, slavePeer := net.Pipe()
+	defer slavePeer.Close()
+
+	dt, err := New(conn, connSlave{slaveConn}, WithInterruptReadsOnCancel())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- dt.Run(ctx, "", "")
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	// the copy of the master output is the only goroutine left
+	connPeer.Close()
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Goroutines leaked: %d before, %d after", before, runtime.NumGoroutine())
+}
+
+func TestInterruptReadsTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { interruptWaitTimeout = timeout }(interruptWaitTimeout)
+	interruptWaitTimeout = 50 * time.Millisecond
+
+	conn, connPeer := net.Pipe()
+	go io.Copy(ioutil.Discard, connPeer)
+	defer connPeer.Close()
+	slaveOutPipeReader, slaveOutPipeWriter := io.Pipe()
+	defer slaveOutPipeWriter.Close()
+	_, slaveInPipeWriter := io.Pipe()
+	slave := stuckSlave{&pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}}
+
+	dt, err := New(conn, slave, WithInterruptReadsOnCancel())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- dt.Run(ctx, "", "")
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Run() kept waiting for a read loop that was not interrupted")
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	conn, connPeer := net.Pipe()
+	var mutex sync.Mutex
+	var received []byte
+	go func() {
+		buffer := make([]byte, 1024)
+		for {
+			n, err := connPeer.Read(buffer)
+			mutex.Lock()
+			received = append(received, buffer[:n]...)
+			mutex.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}()
+	slaveConn, slavePeer := net.Pipe()
+	defer slavePeer.Close()
+
+	dt, err := New(conn, connSlave{slaveConn}, WithDrainTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- dt.Run(ctx, "", "")
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	slavePeer.Write([]byte("bye"))
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	// the frame is read by the peer before the write of the master returns
+	expected := string(Output) + base64.StdEncoding.EncodeToString([]byte("bye"))
+	for i := 0; i < 100; i++ {
+		mutex.Lock()
+		drained := strings.Contains(string(received), expected)
+		mutex.Unlock()
+		if drained {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	mutex.Lock()
+	if !strings.Contains(string(received), expected) {
+		t.Fatalf("Buffered output was not drained: %q", received)
+	}
+	mutex.Unlock()
+
+	// the reader of the master output is the only goroutine left
+	connPeer.Close()
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Goroutines leaked: %d before, %d after", before, runtime.NumGoroutine())
+}
@@ -0,0 +1,51 @@
+package webtty
+
+import (
+	"context"
+	"time"
+)
+
+// keepAliveMarker prefixes the keepalive events in the audit trail.
+const keepAliveMarker = "[keepalive] "
+
+// keepAlive pings the master every keepAliveInterval and closes dead
+// when the master didn't answer within keepAliveTimeout, until ctx is done.
+func (wt *WebTTY) keepAlive(ctx context.Context, dead chan struct{}) {
+	timer := time.NewTimer(wt.keepAliveInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		sent := time.Now()
+		if err := wt.sendKeepAlivePing(); err != nil {
+			// a broken master is detected by the read loop
+			return
+		}
+
+		timer.Reset(wt.keepAliveTimeout)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+		if loadTime(&wt.lastKeepAlive).Before(sent) {
+			// recorded before Run returns
+			wt.auditMasterTimeout()
+			close(dead)
+			return
+		}
+
+		timer.Reset(wt.keepAliveInterval - time.Since(sent))
+	}
+}
+
+// auditMasterTimeout records that the master stopped answering the pings.
+func (wt *WebTTY) auditMasterTimeout() {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, keepAliveMarker+"master timed out after "+wt.keepAliveTimeout.String()+" without answer")
+}
@@ -0,0 +1,58 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// answeringMaster answers each KeepAlivePing with answer, if any.
+type answeringMaster struct {
+	*io.PipeReader
+	writer *io.PipeWriter
+	answer []byte
+}
+
+func (am answeringMaster) Write(p []byte) (int, error) {
+	if len(p) == 1 && p[0] == KeepAlivePing && am.answer != nil {
+		go am.writer.Write(am.answer)
+	}
+	return len(p), nil
+}
+
+func TestKeepAlive(t *testing.T) {
+	for _, c := range []struct {
+		answer   []byte
+		expected error
+	}{
+		{nil, ErrMasterTimeout},
+		{[]byte{KeepAlivePong}, context.DeadlineExceeded},
+		{[]byte{Ping}, context.DeadlineExceeded},
+	} {
+		masterReader, masterWriter := io.Pipe()
+		master := answeringMaster{masterReader, masterWriter, c.answer}
+		slaveReader, _ := io.Pipe()
+		var audited []string
+		dt, err := New(master, &pipeSlave{pipePair{slaveReader, nil}},
+			WithKeepAlive(10*time.Millisecond, 20*time.Millisecond),
+			withAuditLines(func(line string, requestID string) { audited = append(audited, line) }),
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		err = dt.Run(ctx, "", "")
+		cancel()
+		masterWriter.Close()
+		if err != c.expected {
+			t.Fatalf("Unexpected error from Run() answering %q: %v", c.answer, err)
+		}
+		timedOut := len(audited) == 1 && strings.Contains(audited[0], keepAliveMarker+"master timed out after 20ms")
+		if timedOut != (c.expected == ErrMasterTimeout) {
+			t.Fatalf("Unexpected audit trail answering %q: %q", c.answer, audited)
+		}
+	}
+}
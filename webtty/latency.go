@@ -0,0 +1,102 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxPingAge is how long a KeepAlivePing is waited for to be answered
+// before a new one is timed instead.
+const maxPingAge = time.Minute
+
+// sendKeepAlivePing sends a KeepAlivePing to the master, timed to measure
+// the round-trip time of the connection when its KeepAlivePong comes back.
+// A single ping is timed at once, so that the late answer of a ping
+// doesn't time the next one.
+func (wt *WebTTY) sendKeepAlivePing() error {
+	now := time.Now().UnixNano()
+	if sent := atomic.LoadInt64(&wt.pingSent); sent == 0 || now-sent > int64(maxPingAge) {
+		atomic.CompareAndSwapInt64(&wt.pingSent, sent, now)
+	}
+	return wt.primaryWrite([]byte{KeepAlivePing})
+}
+
+// handleKeepAlivePong measures the round-trip time of the timed ping.
+func (wt *WebTTY) handleKeepAlivePong() error {
+	now := time.Now()
+	storeTime(&wt.lastKeepAlive, now)
+	sent := atomic.SwapInt64(&wt.pingSent, 0)
+	if sent == 0 {
+		return nil
+	}
+	return wt.observeRoundTrip(time.Duration(now.UnixNano() - sent))
+}
+
+// observeRoundTrip records a round-trip time to the master, smoothed
+// like the SRTT of TCP, each measurement weighing 1/8.
+func (wt *WebTTY) observeRoundTrip(rtt time.Duration) error {
+	if rtt < 0 {
+		rtt = 0
+	}
+	atomic.StoreInt64(&wt.latency, int64(rtt))
+	smoothed := atomic.LoadInt64(&wt.smoothedLatency)
+	if smoothed == 0 {
+		smoothed = int64(rtt)
+	} else {
+		smoothed += (int64(rtt) - smoothed) / 8
+	}
+	atomic.StoreInt64(&wt.smoothedLatency, smoothed)
+	if wt.metrics != nil {
+		wt.metrics.ObserveRoundTripTime(rtt)
+	}
+
+	if wt.latencyProbeInterval > 0 {
+		return wt.sendLatencyReport()
+	}
+	return nil
+}
+
+// Latency returns the latest round-trip time to the master measured with
+// a KeepAlivePing and its smoothed value, zero until measured.
+func (wt *WebTTY) Latency() (latest time.Duration, smoothed time.Duration) {
+	return time.Duration(atomic.LoadInt64(&wt.latency)), time.Duration(atomic.LoadInt64(&wt.smoothedLatency))
+}
+
+// sendLatencyReport tells the master the round-trip times measured by
+// the server, along with whether its typed characters are echoed locally.
+func (wt *WebTTY) sendLatencyReport() error {
+	latest, smoothed := wt.Latency()
+	frame, err := json.Marshal(struct {
+		RTTMs         int64 `json:"rttMs"`
+		SmoothedRTTMs int64 `json:"smoothedRttMs"`
+		LocalEcho     bool  `json:"localEcho"`
+	}{
+		int64(latest / time.Millisecond),
+		int64(smoothed / time.Millisecond),
+		wt.localEchoActive(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal latency report")
+	}
+
+	err = wt.primaryWrite(append([]byte{LatencyReport}, frame...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send latency report to master")
+	}
+	return nil
+}
+
+// probeLatency pings the master at once, then every interval, until ctx
+// is done. A broken master is detected by the read loop.
+func (wt *WebTTY) probeLatency(ctx context.Context, interval time.Duration) {
+	if wt.sendKeepAlivePing() != nil {
+		return
+	}
+	runHeartbeat(ctx, interval, func() {
+		wt.sendKeepAlivePing()
+	})
+}
@@ -0,0 +1,124 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowMaster records the frames written to it and answers each
+// KeepAlivePing after delay.
+type slowMaster struct {
+	*io.PipeReader
+	*frameRecorder
+	writer *io.PipeWriter
+	delay  time.Duration
+}
+
+func (sm slowMaster) Write(p []byte) (int, error) {
+	sm.send(p)
+	if len(p) == 1 && p[0] == KeepAlivePing {
+		go func() {
+			time.Sleep(sm.delay)
+			sm.writer.Write([]byte{KeepAlivePong})
+		}()
+	}
+	return len(p), nil
+}
+
+type roundTripMetrics struct {
+	NopMetrics
+	mutex sync.Mutex
+	rtts  []time.Duration
+}
+
+func (rm *roundTripMetrics) ObserveRoundTripTime(rtt time.Duration) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	rm.rtts = append(rm.rtts, rtt)
+}
+
+func TestLatencyProbe(t *testing.T) {
+	for _, version := range []int{1, 2} {
+		masterReader, masterWriter := io.Pipe()
+		master := slowMaster{masterReader, &frameRecorder{}, masterWriter, 20 * time.Millisecond}
+		slaveReader, _ := io.Pipe()
+		metrics := &roundTripMetrics{}
+		dt, err := New(master, &pipeSlave{pipePair{slaveReader, nil}},
+			WithLatencyProbe(50*time.Millisecond), WithMetrics(metrics), WithProtocolVersion(version))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 130*time.Millisecond)
+		dt.Run(ctx)
+		cancel()
+		masterWriter.Close()
+
+		latest, smoothed := dt.Latency()
+		if latest < 20*time.Millisecond || smoothed < 20*time.Millisecond {
+			t.Fatalf("Unexpected latency: %s, smoothed %s", latest, smoothed)
+		}
+		if stats := dt.Stats(); stats.Latency != latest || stats.SmoothedLatency != smoothed {
+			t.Fatalf("Unexpected latency in stats: %+v", stats)
+		}
+		metrics.mutex.Lock()
+		measured := len(metrics.rtts)
+		metrics.mutex.Unlock()
+		if measured < 2 {
+			t.Fatalf("Unexpected round-trip times measured: %d", measured)
+		}
+
+		var reports []string
+		for _, frame := range master.get() {
+			if frame[0] == LatencyReport {
+				reports = append(reports, frame[1:])
+			}
+		}
+		if version == 1 {
+			if len(reports) > 0 {
+				t.Fatalf("Unexpected latency reports to a version 1 master: %q", reports)
+			}
+			continue
+		}
+		if len(reports) != measured {
+			t.Fatalf("Unexpected latency reports: %q", reports)
+		}
+		var report struct {
+			RTTMs         int64 `json:"rttMs"`
+			SmoothedRTTMs int64 `json:"smoothedRttMs"`
+			LocalEcho     bool  `json:"localEcho"`
+		}
+		if err := json.Unmarshal([]byte(reports[0]), &report); err != nil || report.RTTMs < 20 || report.LocalEcho {
+			t.Fatalf("Unexpected latency report: %s", reports[0])
+		}
+	}
+}
+
+func TestRoundTripSmoothing(t *testing.T) {
+	dt, err := New(discardMaster{}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.observeRoundTrip(80 * time.Millisecond)
+	dt.observeRoundTrip(160 * time.Millisecond)
+	if latest, smoothed := dt.Latency(); latest != 160*time.Millisecond || smoothed != 90*time.Millisecond {
+		t.Fatalf("Unexpected latency: %s, smoothed %s", latest, smoothed)
+	}
+
+	// an unanswered ping is timed once
+	dt.sendKeepAlivePing()
+	sent := dt.pingSent
+	dt.sendKeepAlivePing()
+	if dt.pingSent != sent {
+		t.Fatalf("Unexpected ping timed again")
+	}
+	dt.handleKeepAlivePong()
+	dt.handleKeepAlivePong()
+	if latest, _ := dt.Latency(); latest >= 80*time.Millisecond {
+		t.Fatalf("Unexpected latency of the pong: %s", latest)
+	}
+}
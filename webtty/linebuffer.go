@@ -0,0 +1,293 @@
+package webtty
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// lineBuffer implements the line-buffered input mode.
+// Typed characters are held until Enter so that the whole command line
+// can be rewritten before it reaches the slave. They are echoed to the
+// master locally and erased again when the line is forwarded,
+// leaving the echo of the slave in place.
+//
+// Only shell command lines are buffered: a line is buffered when its first
+// key is typed right after the slave printed something that looks like a
+// shell prompt, and outside of the alternate screen used by full-screen
+// programs. Other input, such as passwords typed at a `sudo` prompt or keys
+// typed in vim, goes straight to the slave and is neither echoed nor
+// rewritten. The prompt detection is a heuristic, a program printing a
+// line ending like a prompt can still have its input buffered.
+//
+// Once a key that can't be buffered is typed (control keys, escape
+// sequences such as arrows), the pending input is flushed to the slave
+// and the rest of the line is passed through without being rewritten.
+//
+// With a filter, lines it rejects are dropped instead of being forwarded,
+// on Enter and when the pending input is flushed.
+type lineBuffer struct {
+	eraseKeys []byte
+	rewrite   func(line string) string
+	filter    func(line string) error
+	// printed when the filter rejects a line, %s is replaced with the error
+	blockedMessage string
+
+	mutex sync.Mutex
+	// whether the last output of the slave ends with a prompt
+	atPrompt  bool
+	altScreen bool
+
+	pending     []rune
+	buffering   bool
+	passthrough bool
+
+	// the line executed by the last Enter, when it was rewritten
+	executed    string
+	hasExecuted bool
+}
+
+var (
+	altScreenEnter = [][]byte{[]byte("\x1b[?1049h"), []byte("\x1b[?1047h"), []byte("\x1b[?47h")}
+	altScreenLeave = [][]byte{[]byte("\x1b[?1049l"), []byte("\x1b[?1047l"), []byte("\x1b[?47l")}
+)
+
+func newLineBuffer(eraseKeys []byte, rewrite func(line string) string) *lineBuffer {
+	return &lineBuffer{
+		eraseKeys: eraseKeys,
+		rewrite:   rewrite,
+	}
+}
+
+// observeOutput tracks whether the slave is waiting at a shell prompt.
+func (lb *lineBuffer) observeOutput(data []byte) {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	enter, leave := lastIndexOfAny(data, altScreenEnter), lastIndexOfAny(data, altScreenLeave)
+	if enter > leave {
+		lb.altScreen = true
+	} else if leave > enter {
+		lb.altScreen = false
+	}
+
+	lb.atPrompt = !lb.altScreen && endsWithPrompt(data)
+}
+
+// input processes keys typed on the master.
+// It returns the bytes to write to the slave and the bytes
+// to echo to the master.
+func (lb *lineBuffer) input(keys []byte) ([]byte, []byte) {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	if !lb.buffering && !lb.passthrough {
+		if lb.atPrompt {
+			lb.buffering = true
+		} else {
+			lb.passthrough = true
+		}
+	}
+
+	if len(keys) == 1 {
+		switch key := keys[0]; {
+		case key == '\r':
+			return lb.submit()
+		case bytes.IndexByte(lb.eraseKeys, key) >= 0:
+			if lb.passthrough {
+				return keys, nil
+			}
+			if len(lb.pending) > 0 {
+				width := runeWidth(lb.pending[len(lb.pending)-1])
+				lb.pending = lb.pending[:len(lb.pending)-1]
+				return nil, bytes.Repeat([]byte("\b \b"), width)
+			}
+			return nil, nil
+		}
+	}
+
+	if lb.passthrough {
+		return keys, nil
+	}
+
+	if printable(keys) {
+		lb.pending = append(lb.pending, []rune(string(keys))...)
+		return nil, keys
+	}
+
+	if blocked := lb.block(string(lb.pending)); blocked != nil {
+		return nil, blocked
+	}
+	echo := lb.eraseEcho()
+	forward := append([]byte(string(lb.pending)), keys...)
+	lb.pending = lb.pending[:0]
+	lb.buffering = false
+	lb.passthrough = true
+	return forward, echo
+}
+
+func (lb *lineBuffer) submit() ([]byte, []byte) {
+	if lb.passthrough {
+		lb.passthrough = false
+		return []byte{'\r'}, nil
+	}
+
+	line := string(lb.pending)
+	if lb.rewrite != nil {
+		line = lb.rewrite(line)
+	}
+	if blocked := lb.block(line); blocked != nil {
+		return nil, blocked
+	}
+	echo := lb.eraseEcho()
+	lb.pending = lb.pending[:0]
+	lb.buffering = false
+	lb.atPrompt = false
+	lb.executed = line
+	lb.hasExecuted = true
+
+	return append([]byte(line), '\r'), echo
+}
+
+// block drops the pending input when the filter rejects line and returns
+// the echo ending it with the blocked message, or nil when line is allowed.
+// The slave received nothing, so its prompt is still waiting.
+func (lb *lineBuffer) block(line string) []byte {
+	if lb.filter == nil {
+		return nil
+	}
+	err := lb.filter(line)
+	if err == nil {
+		return nil
+	}
+
+	lb.pending = lb.pending[:0]
+	lb.buffering = false
+	echo := []byte("\r\n")
+	if lb.blockedMessage != "" {
+		echo = append(echo, strings.Replace(lb.blockedMessage, "%s", err.Error(), 1)+"\r\n"...)
+	}
+	return echo
+}
+
+// executedLine returns the line actually executed for a command
+// reconstructed as line, consuming the result of the last Enter.
+func (lb *lineBuffer) executedLine(line string) string {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	if lb.hasExecuted {
+		line = lb.executed
+		lb.executed = ""
+		lb.hasExecuted = false
+	}
+	return line
+}
+
+// eraseEcho returns the bytes erasing the local echo of the pending input.
+func (lb *lineBuffer) eraseEcho() []byte {
+	if len(lb.pending) == 0 {
+		return nil
+	}
+	width := 0
+	for _, r := range lb.pending {
+		width += runeWidth(r)
+	}
+	return append(bytes.Repeat([]byte{'\b'}, width), "\x1b[K"...)
+}
+
+func (wt *WebTTY) handleBufferedInput(keys []byte) error {
+	forward, echo := wt.lineBuffer.input(keys)
+
+	if len(echo) > 0 {
+		err := wt.sendEcho(echo)
+		if err != nil {
+			return errors.Wrapf(err, "failed to echo input")
+		}
+	}
+
+	if len(forward) > 0 {
+		_, err := wt.slaveWrite(forward)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write received data to slave")
+		}
+	}
+
+	return nil
+}
+
+// sendEcho sends the local echo of input as Output to the master only.
+// Observers and the session capture only see the echo of the slave.
+func (wt *WebTTY) sendEcho(echo []byte) error {
+	if wt.coalescer != nil {
+		// keep the echo after the output already buffered
+		wt.coalescer.flush()
+	}
+
+	frame := wt.appendOutputFrame(nil, echo)
+	if wt.flowWindow != nil {
+		wt.flowWindow.sent(len(echo))
+	}
+
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	n, err := wt.writeMasterLocked(frame)
+	atomic.AddUint64(&wt.bytesOut, uint64(n))
+	return err
+}
+
+func printable(keys []byte) bool {
+	if !utf8.Valid(keys) {
+		return false
+	}
+	for _, r := range string(keys) {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// endsWithPrompt returns whether data ends like a shell prompt,
+// with one of promptSuffixes followed by a space.
+func endsWithPrompt(data []byte) bool {
+	return len(data) >= 2 && data[len(data)-1] == ' ' &&
+		bytes.IndexByte(promptSuffixes, data[len(data)-2]) >= 0
+}
+
+func lastIndexOfAny(data []byte, sequences [][]byte) int {
+	last := -1
+	for _, sequence := range sequences {
+		if i := bytes.LastIndex(data, sequence); i > last {
+			last = i
+		}
+	}
+	return last
+}
+
+// runeWidth returns the number of cells r takes on a terminal,
+// 2 for East Asian wide and fullwidth characters, 1 otherwise.
+func runeWidth(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115f, // Hangul Jamo
+		r >= 0x2e80 && r <= 0x303e, // CJK radicals, punctuation
+		r >= 0x3041 && r <= 0x33ff, // kana, CJK symbols
+		r >= 0x3400 && r <= 0x4dbf, // CJK extension A
+		r >= 0x4e00 && r <= 0x9fff, // CJK unified ideographs
+		r >= 0xa000 && r <= 0xa4cf, // Yi
+		r >= 0xac00 && r <= 0xd7a3, // Hangul syllables
+		r >= 0xf900 && r <= 0xfaff, // CJK compatibility ideographs
+		r >= 0xfe30 && r <= 0xfe4f, // CJK compatibility forms
+		r >= 0xff00 && r <= 0xff60, // fullwidth forms
+		r >= 0xffe0 && r <= 0xffe6,
+		r >= 0x1f300 && r <= 0x1f64f, // emoji
+		r >= 0x20000 && r <= 0x3fffd: // CJK extensions
+		return 2
+	}
+	return 1
+}
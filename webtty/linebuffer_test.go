@@ -0,0 +1,188 @@
+package webtty
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestLineBuffer(t *testing.T) {
+	lb := newLineBuffer(DefaultEraseKeys, func(line string) string {
+		return "trace " + line
+	})
+	lb.observeOutput([]byte("user@host:~$ "))
+
+	var echo []byte
+	for _, key := range []byte("lsx\x7f") {
+		forward, e := lb.input([]byte{key})
+		if len(forward) != 0 {
+			t.Fatalf("Unexpected forwarded input: %q", forward)
+		}
+		echo = append(echo, e...)
+	}
+	if string(echo) != "lsx\b \b" {
+		t.Fatalf("Unexpected echo: %q", echo)
+	}
+
+	forward, echo := lb.input([]byte{'\r'})
+	if string(forward) != "trace ls\r" {
+		t.Fatalf("Unexpected forwarded line: %q", forward)
+	}
+	if string(echo) != "\b\b\x1b[K" {
+		t.Fatalf("Unexpected echo: %q", echo)
+	}
+	if line := lb.executedLine("ls"); line != "trace ls" {
+		t.Fatalf("Unexpected executed line: %q", line)
+	}
+	if line := lb.executedLine("pwd"); line != "pwd" {
+		t.Fatalf("Unexpected executed line after consumption: %q", line)
+	}
+
+	// a history key flushes the pending input and disables rewriting
+	lb.observeOutput([]byte("$ "))
+	lb.input([]byte("c"))
+	forward, _ = lb.input([]byte("\x1b[A"))
+	if string(forward) != "c\x1b[A" {
+		t.Fatalf("Unexpected forwarded input: %q", forward)
+	}
+	forward, _ = lb.input([]byte("d"))
+	if string(forward) != "d" {
+		t.Fatalf("Unexpected forwarded input in passthrough: %q", forward)
+	}
+	forward, _ = lb.input([]byte{'\r'})
+	if string(forward) != "\r" {
+		t.Fatalf("Unexpected forwarded line in passthrough: %q", forward)
+	}
+
+	// multibyte characters are buffered as one rune and erased by width
+	lb.observeOutput([]byte("$ "))
+	lb.input([]byte("日本"))
+	_, echo = lb.input([]byte{0x7f})
+	if string(echo) != "\b \b\b \b" {
+		t.Fatalf("Unexpected echo erasing a wide rune: %q", echo)
+	}
+	forward, echo = lb.input([]byte{'\r'})
+	if string(forward) != "trace 日\r" || strings.Count(string(echo), "\b") != 2 {
+		t.Fatalf("Unexpected forwarded line: %q, echo: %q", forward, echo)
+	}
+}
+
+func TestLineBufferOutsidePrompt(t *testing.T) {
+	lb := newLineBuffer(DefaultEraseKeys, func(line string) string {
+		return "trace " + line
+	})
+
+	// a password typed at a prompt which is not a shell prompt
+	lb.observeOutput([]byte("[sudo] password for user: "))
+	for _, key := range []byte("hunter2") {
+		forward, echo := lb.input([]byte{key})
+		if string(forward) != string(key) || len(echo) != 0 {
+			t.Fatalf("Unexpected input handling at password prompt: %q, echo: %q", forward, echo)
+		}
+	}
+	forward, echo := lb.input([]byte{'\r'})
+	if string(forward) != "\r" || len(echo) != 0 {
+		t.Fatalf("Unexpected forwarded line at password prompt: %q, echo: %q", forward, echo)
+	}
+	if line := lb.executedLine("hunter2"); line != "hunter2" {
+		t.Fatalf("Unexpected executed line: %q", line)
+	}
+
+	// keys typed in a full-screen program
+	lb.observeOutput([]byte("\x1b[?1049h~ $ "))
+	forward, echo = lb.input([]byte("j"))
+	if string(forward) != "j" || len(echo) != 0 {
+		t.Fatalf("Unexpected input handling on alternate screen: %q, echo: %q", forward, echo)
+	}
+	lb.input([]byte{'\r'})
+
+	lb.observeOutput([]byte("\x1b[?1049l$ "))
+	forward, _ = lb.input([]byte("l"))
+	if len(forward) != 0 {
+		t.Fatalf("Unexpected forwarded input after leaving alternate screen: %q", forward)
+	}
+}
+
+func TestLineBufferEchoSkipsObservers(t *testing.T) {
+	master := &frameRecorder{}
+	observer := &frameRecorder{}
+	var capture bytes.Buffer
+	dt, err := New(recordingMaster{master}, &pipeSlave{},
+		WithCommandRewriter(func(line string) string { return line }),
+		WithSessionCapture(&capture, 0),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.AddObserver(recordingMaster{observer}); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+
+	observed := len(observer.get())
+
+	dt.lineBuffer.observeOutput([]byte("$ "))
+	if err := dt.forwardInput([]byte("l")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if frames := master.get(); len(frames) != 1 || frames[0] != "1bA==" {
+		t.Fatalf("Unexpected frames to master: %q", frames)
+	}
+	if len(observer.get()) != observed || capture.Len() != 0 {
+		t.Fatalf("Echo reached observers or capture: %q, %q", observer.get(), capture.String())
+	}
+}
+
+func TestInputFilter(t *testing.T) {
+	master := &frameRecorder{}
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	received := make(chan []byte)
+	go func() {
+		data, _ := ioutil.ReadAll(slaveInPipeReader)
+		received <- data
+	}()
+	dt, err := New(recordingMaster{master}, &pipeSlave{pipePair{nil, slaveInPipeWriter}},
+		WithInputFilter(func(line string) error {
+			if strings.HasPrefix(line, "rm ") {
+				return errors.New("rm is not allowed")
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.lineBuffer.observeOutput([]byte("$ "))
+	for _, keys := range []string{"rm -rf /", "\r", "ls", "\r"} {
+		if err := dt.forwardInput([]byte(keys)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	// a blocked line flushed by a control key is dropped with the key
+	dt.lineBuffer.observeOutput([]byte("$ "))
+	for _, keys := range []string{"rm x", "\t"} {
+		if err := dt.forwardInput([]byte(keys)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	slaveInPipeWriter.Close()
+
+	if data := <-received; string(data) != "ls\r" {
+		t.Fatalf("Unexpected input to slave: %q", data)
+	}
+
+	blocked := 0
+	for _, frame := range master.get() {
+		echo, _ := base64.StdEncoding.DecodeString(frame[1:])
+		if string(echo) == "\r\n[command blocked: rm is not allowed]\r\n" {
+			blocked++
+		}
+	}
+	if blocked != 2 {
+		t.Fatalf("Unexpected frames to master: %q", master.get())
+	}
+}
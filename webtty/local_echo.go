@@ -0,0 +1,204 @@
+package webtty
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// minLocalEchoTimeout is the least time the slave is given to echo the
+// characters echoed locally before they're erased.
+const minLocalEchoTimeout = 500 * time.Millisecond
+
+// maxUnconfirmedKeys bounds the keys typed while the slave hasn't echoed
+// any of a line yet.
+const maxUnconfirmedKeys = 256
+
+// localEcho echoes the printable keys typed on the master before the slave
+// does, on the links the echo of the slave takes long to come back over.
+// The echo of the slave is reconciled with the predictions: the echoed
+// predictions are dropped from the output, and once the slave writes
+// something else, the predictions it didn't echo are erased.
+//
+// The keys are only predicted once the slave echoed what was typed since
+// the latest line or control key, so that a password typed at a prompt
+// without echo isn't shown.
+type localEcho struct {
+	mutex sync.Mutex
+	// writes the predictions and the reconciled output to the master
+	write func(data []byte) error
+	// whether the keys are to be predicted, depending on the latency
+	active func() bool
+	// how long the slave is given to echo the predictions
+	timeout func() time.Duration
+
+	// whether the slave echoed the keys typed since the latest line
+	confident bool
+	// keys typed while not confident, awaiting their echo
+	typed []byte
+	// keys echoed locally, awaiting the echo of the slave
+	pending []byte
+	timer   *time.Timer
+	closed  bool
+}
+
+func newLocalEcho(write func(data []byte) error, active func() bool, timeout func() time.Duration) *localEcho {
+	return &localEcho{write: write, active: active, timeout: timeout}
+}
+
+// predict echoes keys about to be written to the slave when they're
+// printable and the slave echoed the keys typed before them.
+func (le *localEcho) predict(keys []byte) error {
+	le.mutex.Lock()
+	defer le.mutex.Unlock()
+
+	if !printableKeys(keys) {
+		// the line is submitted or edited, the slave may stop echoing
+		le.confident = false
+		le.typed = le.typed[:0]
+		return nil
+	}
+	if !le.confident || !le.active() {
+		if len(le.typed)+len(keys) <= maxUnconfirmedKeys {
+			le.typed = append(le.typed, keys...)
+		}
+		return nil
+	}
+
+	if len(le.pending) == 0 {
+		le.armTimer()
+	}
+	le.pending = append(le.pending, keys...)
+	return le.write(keys)
+}
+
+// reconcile writes the output of the slave, without the echo of the
+// predictions already written.
+func (le *localEcho) reconcile(output []byte) error {
+	le.mutex.Lock()
+	defer le.mutex.Unlock()
+
+	if len(le.typed) > 0 {
+		if commonPrefix(output, le.typed) > 0 {
+			le.confident = true
+		}
+		le.typed = le.typed[:0]
+	}
+	if len(le.pending) == 0 {
+		return le.write(output)
+	}
+
+	n := commonPrefix(output, le.pending)
+	switch {
+	case n == len(output):
+		le.pending = le.pending[n:]
+		if len(le.pending) == 0 {
+			le.stopTimer()
+		} else {
+			le.armTimer()
+		}
+		return nil
+	case n == len(le.pending):
+		le.pending = le.pending[:0]
+		le.stopTimer()
+		return le.write(output[n:])
+	default:
+		erase := eraseKeys(len(le.pending) - n)
+		le.pending = le.pending[:0]
+		le.confident = false
+		le.stopTimer()
+		return le.write(append(erase, output[n:]...))
+	}
+}
+
+// expire erases the predictions the slave didn't echo in time.
+func (le *localEcho) expire() {
+	le.mutex.Lock()
+	defer le.mutex.Unlock()
+
+	if le.closed || len(le.pending) == 0 {
+		return
+	}
+	erase := eraseKeys(len(le.pending))
+	le.pending = le.pending[:0]
+	le.confident = false
+	le.write(erase)
+}
+
+// reset forgets the predictions, for a new master which didn't see them.
+func (le *localEcho) reset() {
+	le.mutex.Lock()
+	defer le.mutex.Unlock()
+
+	le.pending = le.pending[:0]
+	le.typed = le.typed[:0]
+	le.confident = false
+	le.stopTimer()
+}
+
+func (le *localEcho) close() {
+	le.mutex.Lock()
+	defer le.mutex.Unlock()
+
+	le.closed = true
+	le.stopTimer()
+}
+
+func (le *localEcho) armTimer() {
+	if le.timer == nil {
+		le.timer = time.AfterFunc(le.timeout(), le.expire)
+		return
+	}
+	le.timer.Reset(le.timeout())
+}
+
+func (le *localEcho) stopTimer() {
+	if le.timer != nil {
+		le.timer.Stop()
+	}
+}
+
+// localEchoActive returns whether the keys typed on the master are echoed
+// locally, with WithLocalEcho when the smoothed round-trip time reaches
+// the threshold.
+func (wt *WebTTY) localEchoActive() bool {
+	if wt.localEcho == nil {
+		return false
+	}
+	_, smoothed := wt.Latency()
+	return wt.localEchoThreshold == 0 || smoothed >= wt.localEchoThreshold
+}
+
+// localEchoTimeout returns how long the slave is given to echo the keys,
+// twice the smoothed round-trip time.
+func (wt *WebTTY) localEchoTimeout() time.Duration {
+	_, smoothed := wt.Latency()
+	if timeout := 2 * smoothed; timeout > minLocalEchoTimeout {
+		return timeout
+	}
+	return minLocalEchoTimeout
+}
+
+// printableKeys returns whether keys are all printable ASCII characters,
+// which a terminal echoes as they are.
+func printableKeys(keys []byte) bool {
+	for _, key := range keys {
+		if key < 0x20 || key > 0x7e {
+			return false
+		}
+	}
+	return len(keys) > 0
+}
+
+func commonPrefix(a []byte, b []byte) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// eraseKeys returns the output erasing the n characters before the cursor.
+func eraseKeys(n int) []byte {
+	return bytes.Repeat([]byte("\b \b"), n)
+}
@@ -0,0 +1,138 @@
+package webtty
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalEcho(t *testing.T) {
+	fr := &frameRecorder{}
+	active := true
+	le := newLocalEcho(fr.send, func() bool { return active }, func() time.Duration { return time.Hour })
+	defer le.close()
+
+	written := func() string {
+		frames := fr.get()
+		fr.mutex.Lock()
+		fr.frames = nil
+		fr.mutex.Unlock()
+		return strings.Join(frames, "|")
+	}
+	for i, step := range []struct {
+		input    string
+		output   string
+		expected string
+	}{
+		// nothing is predicted until the slave echoes the line
+		{input: "l", expected: ""},
+		{output: "l", expected: "l"},
+		{input: "s", expected: "s"},
+		{output: "s", expected: ""},
+		// a control key ends the predictions
+		{input: "\r", expected: ""},
+		{output: "\r\nfile\r\n$ ", expected: "\r\nfile\r\n$ "},
+		// a prompt without echo shows nothing
+		{input: "secret", expected: ""},
+		{input: "\r", expected: ""},
+		{output: "\r\n$ ", expected: "\r\n$ "},
+		// echoes split over the output
+		{input: "e", expected: ""},
+		{output: "e", expected: "e"},
+		{input: "cho", expected: "cho"},
+		{output: "c", expected: ""},
+		{output: "ho", expected: ""},
+		{input: " x", expected: " x"},
+		{output: " x!", expected: "!"},
+		// predictions the slave doesn't echo are erased
+		{input: "ab", expected: "ab"},
+		{output: "aZ", expected: "\b \bZ"},
+		{input: "c", expected: ""},
+	} {
+		if step.input != "" {
+			if err := le.predict([]byte(step.input)); err != nil {
+				t.Fatalf("Unexpected error from predict(): %s", err)
+			}
+		}
+		if step.output != "" {
+			if err := le.reconcile([]byte(step.output)); err != nil {
+				t.Fatalf("Unexpected error from reconcile(): %s", err)
+			}
+		}
+		if got := written(); got != step.expected {
+			t.Fatalf("Unexpected output at step %d: %q, expected %q", i, got, step.expected)
+		}
+	}
+
+	// not predicted while the latency is low
+	le.reconcile([]byte("c"))
+	written()
+	active = false
+	le.predict([]byte("d"))
+	if got := written(); got != "" {
+		t.Fatalf("Unexpected prediction while inactive: %q", got)
+	}
+}
+
+func TestLocalEchoExpiry(t *testing.T) {
+	fr := &frameRecorder{}
+	le := newLocalEcho(fr.send, func() bool { return true }, func() time.Duration { return 10 * time.Millisecond })
+	defer le.close()
+
+	le.predict([]byte("a"))
+	le.reconcile([]byte("a"))
+	le.predict([]byte("bc"))
+	time.Sleep(50 * time.Millisecond)
+	le.reconcile([]byte("$ "))
+
+	frames := fr.get()
+	expected := []string{"a", "bc", "\b \b\b \b", "$ "}
+	if strings.Join(frames, "|") != strings.Join(expected, "|") {
+		t.Fatalf("Unexpected output: %q", frames)
+	}
+}
+
+func TestLocalEchoSession(t *testing.T) {
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithLocalEcho(100*time.Millisecond)); err == nil {
+		t.Fatalf("Expected an error from New() without latency probes")
+	}
+
+	rec := &frameRecorder{}
+	slaveReader, slaveWriter := io.Pipe()
+	typedReader, typedWriter := io.Pipe()
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, typedWriter}},
+		WithLocalEcho(0), WithPermitWrite(), WithOutputEncoding(EncodingRaw))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	go func() {
+		buffer := make([]byte, 16)
+		for {
+			if _, err := typedReader.Read(buffer); err != nil {
+				return
+			}
+		}
+	}()
+	defer slaveWriter.Close()
+	defer typedReader.Close()
+
+	// the slave echoes the first key, the second one is echoed at once
+	for _, step := range []func() error{
+		func() error { return dt.handleMasterReadEvent([]byte{Input, 'a'}) },
+		func() error { return dt.handleSlaveReadEvent([]byte("a")) },
+		func() error { return dt.handleMasterReadEvent([]byte{Input, 'b'}) },
+		func() error { return dt.handleSlaveReadEvent([]byte("b")) },
+	} {
+		if err := step(); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	frames := rec.get()
+	if len(frames) != 2 || frames[0] != string(Output)+"a" || frames[1] != string(Output)+"b" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	if dt.Stats().BytesToMaster != 2 {
+		t.Fatalf("Unexpected output of the slave: %d", dt.Stats().BytesToMaster)
+	}
+}
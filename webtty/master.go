@@ -2,7 +2,26 @@ package webtty
 
 import (
 	"io"
+	"time"
 )
 
 // Master represents a PTY master, usually it's a websocket connection.
 type Master io.ReadWriter
+
+// readLimiter is implemented by masters that can enforce
+// a maximum message size on their own, such as websocket connections.
+type readLimiter interface {
+	SetReadLimit(limit int64)
+}
+
+// readDeadliner is implemented by masters and slaves
+// whose pending reads can be interrupted with a deadline.
+type readDeadliner interface {
+	SetReadDeadline(t time.Time) error
+}
+
+// writeDeadliner is implemented by masters whose writes can be bounded
+// with a deadline, for WithWriteTimeout.
+type writeDeadliner interface {
+	SetWriteDeadline(t time.Time) error
+}
@@ -0,0 +1,85 @@
+package webtty
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// menu handles the lines typed on the master without a slave,
+// as set with WithLineHandler. Typed characters are echoed locally
+// and each line is passed to the handler on Enter, whose output
+// is printed followed by the prompt.
+type menu struct {
+	eraseKeys []byte
+	handle    func(line string) string
+	prompt    string
+
+	mutex sync.Mutex
+	line  []rune
+}
+
+func newMenu(eraseKeys []byte, handle func(line string) string, prompt string) *menu {
+	return &menu{
+		eraseKeys: eraseKeys,
+		handle:    handle,
+		prompt:    prompt,
+	}
+}
+
+// input processes keys typed on the master
+// and returns the output to send to it.
+func (m *menu) input(keys []byte) []byte {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var output []byte
+	for len(keys) > 0 {
+		if keys[0] == 0x1b {
+			// cursor keys and the like are not supported by menus
+			keys = keys[escapeLength(keys):]
+			continue
+		}
+
+		r, size := utf8.DecodeRune(keys)
+		keys = keys[size:]
+		switch {
+		case r == '\r':
+			line := string(m.line)
+			m.line = m.line[:0]
+			output = append(output, "\r\n"...)
+			if result := m.handle(line); result != "" {
+				result = strings.Replace(strings.TrimRight(result, "\r\n"), "\n", "\r\n", -1)
+				output = append(output, result...)
+				output = append(output, "\r\n"...)
+			}
+			output = append(output, m.prompt...)
+		case size == 1 && bytes.IndexByte(m.eraseKeys, byte(r)) >= 0:
+			if len(m.line) > 0 {
+				width := runeWidth(m.line[len(m.line)-1])
+				m.line = m.line[:len(m.line)-1]
+				output = append(output, bytes.Repeat([]byte("\b \b"), width)...)
+			}
+		case r >= 0x20 && r != 0x7f && r != utf8.RuneError:
+			m.line = append(m.line, r)
+			output = append(output, string(r)...)
+		}
+	}
+	return output
+}
+
+func (wt *WebTTY) handleMenuInput(keys []byte) error {
+	output := wt.menu.input(keys)
+	if len(output) == 0 {
+		return nil
+	}
+
+	err := wt.writeOutput(output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send menu output")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package webtty
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMenu(t *testing.T) {
+	var handled []string
+	m := newMenu(DefaultEraseKeys, func(line string) string {
+		handled = append(handled, line)
+		return "1) status\n2) logs\n"
+	}, "menu> ")
+
+	if output := m.input([]byte("lx\x7f")); string(output) != "lx\b \b" {
+		t.Fatalf("Unexpected echo: %q", output)
+	}
+	output := m.input([]byte("\x1b[As\r"))
+	if string(output) != "s\r\n1) status\r\n2) logs\r\nmenu> " {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+	if len(handled) != 1 || handled[0] != "ls" {
+		t.Fatalf("Unexpected handled lines: %q", handled)
+	}
+}
+
+func TestLineHandler(t *testing.T) {
+	rec := &frameRecorder{}
+	var audited []string
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithPermitWrite(),
+		WithLineHandler(strings.ToUpper),
+		WithMenuPrompt("> "),
+		withAuditLines(func(line string, requestID string) { audited = append(audited, line) }),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	// the slave is never written to
+	if err := dt.handleMasterReadEvent([]byte("1ls\r")); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	frames := rec.get()
+	// base64 of "ls\r\nLS\r\n> "
+	if len(frames) != 1 || frames[0] != "1bHMNCkxTDQo+IA==" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	if len(audited) != 1 || !strings.HasSuffix(audited[0], "[LOG:ls]") {
+		t.Fatalf("Unexpected audit: %q", audited)
+	}
+}
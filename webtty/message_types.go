@@ -1,31 +1,106 @@
 package webtty
 
+import (
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
 // Protocols defines the name of this protocol,
 // which is supposed to be used to the subprotocol of Websockt streams.
 var Protocols = []string{"webtty"}
 
+// The message types of the protocol as bytes, the leading byte of the
+// frames, see the protocol package for their payloads. Message types from
+// '0' to '9' and from 'A' to 'Z' are reserved for the protocol, in both
+// directions. Other bytes, such as lowercase letters, are free for custom
+// messages, see RegisterHandler.
+
+// Messages sent by the master.
 const (
-	// Unknown message type, maybe sent by a bug
-	UnknownInput = '0'
-	// User input typically from a keyboard
-	Input = '1'
-	// Ping to the server
-	Ping = '2'
-	// Notify that the browser size has been changed
-	ResizeTerminal = '3'
+	UnknownInput      = byte(protocol.UnknownInput)
+	Input             = byte(protocol.Input)
+	Ping              = byte(protocol.Ping)
+	ResizeTerminal    = byte(protocol.ResizeTerminal)
+	RequestStats      = byte(protocol.RequestStats)
+	KeepAlivePong     = byte(protocol.KeepAlivePong)
+	AcknowledgeOutput = byte(protocol.AcknowledgeOutput)
+	FileTransfer      = byte(protocol.FileTransfer)
+	UploadChunk       = byte(protocol.UploadChunk)
+	SetPermitWrite    = byte(protocol.SetPermitWrite)
+	ControlReplay     = byte(protocol.ControlReplay)
+	Unlock            = byte(protocol.Unlock)
+	ChannelFrame      = byte(protocol.ChannelFrame)
+	OpenChannel       = byte(protocol.OpenChannel)
+	CloseChannel      = byte(protocol.CloseChannel)
 )
 
+// Messages sent by the server.
 const (
-	// Unknown message type, maybe set by a bug
-	UnknownOutput = '0'
-	// Normal output to the terminal
-	Output = '1'
-	// Pong to the browser
-	Pong = '2'
-	// Set window title of the terminal
-	SetWindowTitle = '3'
-	// Set terminal preference
-	SetPreferences = '4'
-	// Make terminal to reconnect
-	SetReconnect = '5'
+	UnknownOutput      = byte(protocol.UnknownOutput)
+	Output             = byte(protocol.Output)
+	Pong               = byte(protocol.Pong)
+	SetWindowTitle     = byte(protocol.SetWindowTitle)
+	SetPreferences     = byte(protocol.SetPreferences)
+	SetReconnect       = byte(protocol.SetReconnect)
+	SetReadOnly        = byte(protocol.SetReadOnly)
+	SetSessionInfo     = byte(protocol.SetSessionInfo)
+	ClipboardWrite     = byte(protocol.ClipboardWrite)
+	StatsReport        = byte(protocol.StatsReport)
+	SessionEnd         = byte(protocol.SessionEnd)
+	KeepAlivePing      = byte(protocol.KeepAlivePing)
+	CompressedOutput   = byte(protocol.CompressedOutput)
+	SetReattachToken   = byte(protocol.SetReattachToken)
+	FileTransferStatus = byte(protocol.FileTransferStatus)
+	DownloadChunk      = byte(protocol.DownloadChunk)
+	ShutdownNotice     = byte(protocol.ShutdownNotice)
+	SetTerminalSize    = byte(protocol.SetTerminalSize)
+	CloseReason        = byte(protocol.CloseReason)
+	SetCompression     = byte(protocol.SetCompression)
+	ScreenLock         = byte(protocol.ScreenLock)
+	SetProtocolVersion = byte(protocol.SetProtocolVersion)
+	LatencyReport      = byte(protocol.LatencyReport)
+	ChannelOutput      = byte(protocol.ChannelOutput)
+	ChannelClosed      = byte(protocol.ChannelClosed)
 )
+
+// MessageType is the leading byte of a message, such as Input or Output.
+type MessageType = protocol.MessageType
+
+// Direction tells which end of a session sends a message.
+type Direction = protocol.Direction
+
+const (
+	MasterToSlave = protocol.MasterToSlave
+	SlaveToMaster = protocol.SlaveToMaster
+)
+
+// MessageTypeInfo describes a message type of the protocol.
+type MessageTypeInfo struct {
+	// The leading byte of the message
+	Type      byte
+	Name      string
+	Direction Direction
+	// Whether the type byte is followed by a payload
+	HasPayload bool
+	// Version of the protocol the type was added in
+	Since int
+}
+
+// reservedMessageType returns whether t is reserved for the protocol.
+func reservedMessageType(t byte) bool {
+	return protocol.Reserved(MessageType(t))
+}
+
+// MessageTypes returns all message types supported by this implementation.
+func MessageTypes() []MessageTypeInfo {
+	var types []MessageTypeInfo
+	for _, info := range protocol.Types() {
+		types = append(types, MessageTypeInfo{
+			Type:       byte(info.Type),
+			Name:       info.Name,
+			Direction:  info.Direction,
+			HasPayload: info.HasPayload,
+			Since:      info.Since,
+		})
+	}
+	return types
+}
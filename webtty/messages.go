@@ -0,0 +1,110 @@
+package webtty
+
+import (
+	"strings"
+)
+
+// Messages are the notices WebTTY prints on the terminal of the master.
+// An empty message is not printed.
+type Messages struct {
+	// Printed the first time input is ignored because the session is read only
+	ReadOnly string
+	// Printed when write permission is granted while the session runs
+	WriteGranted string
+	// Printed when write permission is revoked while the session runs
+	WriteRevoked string
+	// Printed before the session reaches its maximum duration,
+	// %s is replaced with the remaining time
+	SessionExpiring string
+	// Printed when the session is closed at its maximum duration,
+	// %s is replaced with the duration
+	SessionExpired string
+	// Printed when the session is closed for lack of input,
+	// %s is replaced with the idle timeout
+	IdleTimeout string
+	// Printed when the screen is locked, see WithScreenLock,
+	// %s is replaced with the idle time
+	ScreenLocked string
+	// Printed when the session is closed with Terminate
+	Terminated string
+	// Printed when the session waits for its approval, see WithApproval,
+	// %s is replaced with the approval timeout
+	AwaitingApproval string
+	// Printed when the session is approved, %s is replaced with the approver
+	Approved string
+	// Printed when the session is denied
+	ApprovalDenied string
+	// Printed when the session isn't approved in time,
+	// %s is replaced with the approval timeout
+	ApprovalTimeout string
+	// Printed before the access grant of the session expires, see
+	// WithAccessGrant, %s is replaced with the remaining time
+	GrantExpiring string
+	// Printed when the session is closed as its access grant expired
+	GrantExpired string
+	// Printed when the session is closed as its access grant was revoked
+	GrantRevoked string
+	// Printed when a command line is blocked by the input filter,
+	// %s is replaced with the error of the filter
+	CommandBlocked string
+	// Printed when the output of a command is dropped, see WithOutputTruncation
+	OutputTruncated string
+	// Printed when the server starts shutting down, see NotifyShutdown,
+	// %s is replaced with the time left before the session is closed
+	ServerShutdown string
+	// Printed when the server starts shutting down without a deadline
+	ServerDraining string
+}
+
+// DefaultLocale is the locale of DefaultMessages.
+const DefaultLocale = "en"
+
+// DefaultMessages are used when no message set matches the locale of the master.
+var DefaultMessages = Messages{
+	ReadOnly:         "[this session is read only]",
+	WriteGranted:     "[write access granted]",
+	WriteRevoked:     "[write access revoked]",
+	SessionExpiring:  "[this session closes in %s]",
+	SessionExpired:   "[this session reached its maximum duration of %s and is closed]",
+	IdleTimeout:      "[this session is closed after %s without input]",
+	ScreenLocked:     "[this session is locked after %s without input, authenticate again to resume]",
+	Terminated:       "[this session was terminated by an administrator]",
+	AwaitingApproval: "[this session waits for the approval of another user for up to %s]",
+	Approved:         "[this session was approved by %s]",
+	ApprovalDenied:   "[this session was denied]",
+	ApprovalTimeout:  "[this session was not approved within %s and is closed]",
+	GrantExpiring:    "[your access grant expires in %s, this session closes then]",
+	GrantExpired:     "[your access grant expired, this session is closed]",
+	GrantRevoked:     "[your access grant was revoked, this session is closed]",
+	CommandBlocked:   "[command blocked: %s]",
+	OutputTruncated:  "[output truncated]",
+	ServerShutdown:   "[the server is shutting down, this session closes in %s]",
+	ServerDraining:   "[the server is shutting down once the sessions end]",
+}
+
+// selectMessages returns the message set for locale.
+// A locale like "zh-CN" matches a set registered as "zh-CN", then "zh".
+// When none matches, the set of fallback is used, then DefaultMessages.
+func selectMessages(sets map[string]Messages, locale string, fallback string) Messages {
+	locale = strings.Replace(strings.ToLower(locale), "_", "-", -1)
+	candidates := []string{locale}
+	if i := strings.Index(locale, "-"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+	candidates = append(candidates, strings.ToLower(fallback))
+
+	for _, candidate := range candidates {
+		if messages, ok := sets[candidate]; ok {
+			return messages
+		}
+	}
+	return DefaultMessages
+}
+
+// printMessage prints message on the terminal of the master on its own line.
+func (wt *WebTTY) printMessage(message string) error {
+	if message == "" {
+		return nil
+	}
+	return wt.writeOutput([]byte("\r\n" + message + "\r\n"))
+}
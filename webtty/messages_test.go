@@ -0,0 +1,48 @@
+package webtty
+
+import (
+	"testing"
+)
+
+func TestSelectMessages(t *testing.T) {
+	zh := Messages{ReadOnly: "[只读会话]"}
+	zhTW := Messages{ReadOnly: "[唯讀會話]"}
+	fr := Messages{ReadOnly: "[session en lecture seule]"}
+	sets := map[string]Messages{"zh": zh, "zh-tw": zhTW, "fr": fr}
+
+	cases := []struct {
+		locale   string
+		fallback string
+		expected Messages
+	}{
+		{"zh-CN", DefaultLocale, zh},
+		{"zh_TW", DefaultLocale, zhTW},
+		{"ZH", DefaultLocale, zh},
+		{"de-DE", "fr", fr},
+		{"", DefaultLocale, DefaultMessages},
+	}
+	for _, c := range cases {
+		if messages := selectMessages(sets, c.locale, c.fallback); messages != c.expected {
+			t.Errorf("Unexpected messages for `%s`: %+v", c.locale, messages)
+		}
+	}
+}
+
+func TestReadOnlyMessage(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithMessages("zh", Messages{ReadOnly: "只读"}),
+		WithClientLocale("zh-CN"),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.handleMasterReadEvent([]byte("1ls"))
+	dt.handleMasterReadEvent([]byte("1ls"))
+
+	frames := rec.get()
+	if len(frames) != 1 || frames[0] != "1DQrlj6ror7sNCg==" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+}
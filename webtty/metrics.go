@@ -0,0 +1,170 @@
+package webtty
+
+import (
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// Metrics receives measurements about a session.
+// Methods are called synchronously from the session loops,
+// so implementations should return quickly.
+// Embed NopMetrics to implement only some of them.
+type Metrics interface {
+	// ObserveInputBufferSize is called with the length in characters
+	// of the command line being reconstructed after each input frame.
+	ObserveInputBufferSize(size int)
+	// ObserveCommandRate is called with the number of commands
+	// seen during the last minute each time a command completes.
+	ObserveCommandRate(perMinute float64)
+	// ObserveCommandLength is called with the average length in characters
+	// of all commands of the session each time a command completes.
+	ObserveCommandLength(average float64)
+	// AddBytesToSlave is called with the number of bytes of input
+	// forwarded to the slave.
+	AddBytesToSlave(n int)
+	// AddBytesToMaster is called with the number of bytes of output
+	// read from the slave, before they are encoded for the master.
+	AddBytesToMaster(n int)
+	// IncResize is called each time the slave is resized by the master.
+	IncResize()
+	// IncSession is called when Run starts.
+	IncSession()
+	// ObserveSessionDuration is called with the duration of Run when it returns.
+	ObserveSessionDuration(d time.Duration)
+	// IncReattach is called each time a master reattaches to the session.
+	IncReattach()
+	// ObserveAuditEvent is called for each audit event of the session,
+	// with whether the audit logger failed to take it.
+	ObserveAuditEvent(failed bool)
+	// ObserveCompression is called for each CompressedOutput frame with
+	// the bytes of output it carries and their size once compressed.
+	ObserveCompression(plain int, compressed int)
+	// ObserveRoundTripTime is called with each round-trip time to the
+	// master measured with a KeepAlivePing.
+	ObserveRoundTripTime(rtt time.Duration)
+}
+
+// NopMetrics is a Metrics ignoring all measurements.
+type NopMetrics struct{}
+
+func (NopMetrics) ObserveInputBufferSize(size int)              {}
+func (NopMetrics) ObserveCommandRate(perMinute float64)         {}
+func (NopMetrics) ObserveCommandLength(average float64)         {}
+func (NopMetrics) AddBytesToSlave(n int)                        {}
+func (NopMetrics) AddBytesToMaster(n int)                       {}
+func (NopMetrics) IncResize()                                   {}
+func (NopMetrics) IncSession()                                  {}
+func (NopMetrics) ObserveSessionDuration(d time.Duration)       {}
+func (NopMetrics) IncReattach()                                 {}
+func (NopMetrics) ObserveAuditEvent(failed bool)                {}
+func (NopMetrics) ObserveCompression(plain int, compressed int) {}
+func (NopMetrics) ObserveRoundTripTime(rtt time.Duration)       {}
+
+// CounterMetrics is a Metrics counting bytes, resizes and sessions,
+// it is safe for concurrent use and can be shared by sessions.
+type CounterMetrics struct {
+	NopMetrics
+
+	// accessed atomically
+	BytesToSlave  uint64
+	BytesToMaster uint64
+	Resizes       uint64
+	Reattaches    uint64
+	AuditEvents   uint64
+	AuditFailures uint64
+	// output bytes sent compressed, and their size once compressed
+	CompressedBytes uint64
+	CompressedSize  uint64
+	// sessions ended
+	Sessions uint64
+	// sessions running
+	ActiveSessions int64
+	// total duration of the sessions, in nanoseconds
+	SessionsDuration int64
+}
+
+func (cm *CounterMetrics) AddBytesToSlave(n int) {
+	atomic.AddUint64(&cm.BytesToSlave, uint64(n))
+}
+
+func (cm *CounterMetrics) AddBytesToMaster(n int) {
+	atomic.AddUint64(&cm.BytesToMaster, uint64(n))
+}
+
+func (cm *CounterMetrics) IncResize() {
+	atomic.AddUint64(&cm.Resizes, 1)
+}
+
+func (cm *CounterMetrics) IncSession() {
+	atomic.AddInt64(&cm.ActiveSessions, 1)
+}
+
+func (cm *CounterMetrics) ObserveSessionDuration(d time.Duration) {
+	atomic.AddInt64(&cm.ActiveSessions, -1)
+	atomic.AddUint64(&cm.Sessions, 1)
+	atomic.AddInt64(&cm.SessionsDuration, int64(d))
+}
+
+func (cm *CounterMetrics) IncReattach() {
+	atomic.AddUint64(&cm.Reattaches, 1)
+}
+
+func (cm *CounterMetrics) ObserveAuditEvent(failed bool) {
+	atomic.AddUint64(&cm.AuditEvents, 1)
+	if failed {
+		atomic.AddUint64(&cm.AuditFailures, 1)
+	}
+}
+
+func (cm *CounterMetrics) ObserveCompression(plain int, compressed int) {
+	atomic.AddUint64(&cm.CompressedBytes, uint64(plain))
+	atomic.AddUint64(&cm.CompressedSize, uint64(compressed))
+}
+
+// AuditDeliveryMetrics measures the delivery of audit events
+// by an HTTPAuditLogger.
+type AuditDeliveryMetrics interface {
+	// ObserveAuditDelivery is called once per audit entry, after retries,
+	// with the status of the last response, zero when no response was
+	// received, and whether the entry was accepted by the collector.
+	ObserveAuditDelivery(status int, delivered bool)
+}
+
+// commandStats computes command metrics incrementally.
+// It is only used from forwardInput, whose calls never overlap.
+type commandStats struct {
+	recent      []time.Time
+	count       int
+	totalLength int
+}
+
+// observe records a command completed at now and returns
+// the commands per minute and the average command length.
+func (cs *commandStats) observe(command string, now time.Time) (float64, float64) {
+	cs.count++
+	cs.totalLength += utf8.RuneCountInString(command)
+
+	cutoff := now.Add(-time.Minute)
+	expired := 0
+	for expired < len(cs.recent) && !cs.recent[expired].After(cutoff) {
+		expired++
+	}
+	cs.recent = append(cs.recent[:0], cs.recent[expired:]...)
+	cs.recent = append(cs.recent, now)
+
+	return float64(len(cs.recent)), float64(cs.totalLength) / float64(cs.count)
+}
+
+func (wt *WebTTY) observeInput(commands []string) {
+	if wt.metrics == nil {
+		return
+	}
+
+	for _, command := range commands {
+		perMinute, average := wt.commandStats.observe(command, time.Now())
+		wt.metrics.ObserveCommandRate(perMinute)
+		wt.metrics.ObserveCommandLength(average)
+	}
+	wt.metrics.ObserveInputBufferSize(utf8.RuneCountInString(wt.reconstructor.current()))
+}
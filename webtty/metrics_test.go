@@ -0,0 +1,66 @@
+package webtty
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestCommandStats(t *testing.T) {
+	cs := &commandStats{}
+	start := time.Now()
+
+	rate, average := cs.observe("ls", start)
+	if rate != 1 || average != 2 {
+		t.Fatalf("Unexpected stats: %v, %v", rate, average)
+	}
+
+	rate, average = cs.observe("ls -l", start.Add(30*time.Second))
+	if rate != 2 || average != 3.5 {
+		t.Fatalf("Unexpected stats: %v, %v", rate, average)
+	}
+
+	// the first command is out of the one minute window
+	rate, average = cs.observe("pwd", start.Add(70*time.Second))
+	if rate != 2 || average != float64(10)/3 {
+		t.Fatalf("Unexpected stats: %v, %v", rate, average)
+	}
+}
+
+func TestCounterMetrics(t *testing.T) {
+	metrics := &CounterMetrics{}
+	slaveReader, slaveWriter := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, inputReader)
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{slaveReader, inputWriter}},
+		WithPermitWrite(), WithMetrics(metrics))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- dt.Run(context.Background(), "", "") }()
+	slaveWriter.Write([]byte("hello"))
+	dt.handleMasterReadEvent([]byte("1ls\r"))
+	dt.handleMasterReadEvent([]byte(`3{"Columns":80,"Rows":24}`))
+	dt.handleMasterReadEvent([]byte{Ping})
+	time.Sleep(10 * time.Millisecond)
+	slaveWriter.Close()
+	if err := <-done; !errors.Is(err, ErrSlaveClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	if metrics.BytesToMaster != 5 || metrics.BytesToSlave != 3 || metrics.Resizes != 1 {
+		t.Fatalf("Unexpected counters: %+v", metrics)
+	}
+	if metrics.Sessions != 1 || metrics.SessionsDuration < int64(10*time.Millisecond) {
+		t.Fatalf("Unexpected session counters: %+v", metrics)
+	}
+	// the command and the resize are audited
+	if metrics.ActiveSessions != 0 || metrics.AuditEvents != 2 || metrics.AuditFailures != 0 {
+		t.Fatalf("Unexpected session counters: %+v", metrics)
+	}
+}
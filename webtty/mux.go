@@ -0,0 +1,282 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
+const (
+	// DefaultMaxChannels is the number of channels a Mux carries at most
+	// by default.
+	DefaultMaxChannels = 16
+
+	// muxQueueSize is the number of frames queued for each channel.
+	muxQueueSize = 64
+	// muxFrameSize is the largest frame of the master, the largest
+	// inbound frame of a session along with its channel header.
+	muxFrameSize = DefaultMaxInboundFrameSize + 16
+)
+
+// ChannelOpener runs the session of the channel id of a Mux, opened by the
+// master with init, the JSON object of its OpenChannel message. The session
+// exchanges its frames through master, typically running a WebTTY created
+// with it, and the channel closes once the opener returns.
+type ChannelOpener func(ctx context.Context, id protocol.ChannelID, init []byte, master Master) error
+
+// Mux carries several sessions over a single master, such as the tabs and
+// the panes of a browser over one WebSocket connection, each session on
+// its own channel with its own slave, terminal size and permissions.
+//
+// The master opens a channel with an OpenChannel message, exchanges the
+// frames of its session in ChannelFrame and ChannelOutput messages, and
+// closes it with CloseChannel, the server telling it the channel closed
+// with ChannelClosed, also sent when the channel is refused. The channel
+// messages are defined by version 3 of the protocol. The Ping messages of
+// the master outside of the channels are answered with a Pong, its other
+// messages are dropped.
+//
+// Each channel queues the frames of its session, a session that doesn't
+// read them holds the other channels once its queue is full.
+type Mux struct {
+	master      Master
+	maxChannels int
+
+	writeMutex sync.Mutex
+
+	mutex    sync.Mutex
+	channels map[protocol.ChannelID]*muxChannel
+	sessions sync.WaitGroup
+}
+
+// NewMux creates a Mux carrying at most maxChannels channels over master,
+// or DefaultMaxChannels when 0.
+func NewMux(master Master, maxChannels int) (*Mux, error) {
+	if maxChannels < 0 {
+		return nil, errors.New("max channels must not be negative")
+	}
+	if maxChannels == 0 {
+		maxChannels = DefaultMaxChannels
+	}
+	return &Mux{
+		master:      master,
+		maxChannels: maxChannels,
+		channels:    make(map[protocol.ChannelID]*muxChannel),
+	}, nil
+}
+
+// Serve reads the frames of the master, running the session of each channel
+// it opens with open, until the reads of the master fail or ctx is canceled,
+// then waits for the sessions to end. The sessions are canceled with ctx,
+// the reads of their masters fail with the master.
+//
+// Serve returns an error matching ErrMasterClosed when the reads of the
+// master failed, ErrFrameTooLarge when it sent a frame larger than the
+// maximum inbound frame size of the sessions, and the error of ctx once
+// canceled.
+func (m *Mux) Serve(ctx context.Context, open ChannelOpener) error {
+	if limiter, ok := m.master.(readLimiter); ok {
+		limiter.SetReadLimit(muxFrameSize)
+	}
+
+	frames := make(chan []byte)
+	failed := make(chan error, 1)
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		for {
+			// queued frames are retained by their channel
+			buffer := make([]byte, muxFrameSize+1)
+			n, err := m.master.Read(buffer)
+			if err == nil && n > muxFrameSize {
+				err = ErrFrameTooLarge
+			}
+			if err != nil {
+				failed <- err
+				return
+			}
+			select {
+			case frames <- buffer[:n]:
+			case <-stopped:
+				return
+			}
+		}
+	}()
+
+	var err error
+	for err == nil {
+		select {
+		case frame := <-frames:
+			m.dispatch(ctx, frame, open)
+		case readErr := <-failed:
+			m.closeChannels(readErr)
+			if readErr == websocket.ErrReadLimit || readErr == ErrFrameTooLarge {
+				err = errors.Wrapf(ErrFrameTooLarge, "limit is %d bytes", muxFrameSize)
+			} else {
+				err = &closedError{ErrMasterClosed, readErr}
+			}
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	m.sessions.Wait()
+	return err
+}
+
+// Channels returns the number of open channels.
+func (m *Mux) Channels() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return len(m.channels)
+}
+
+func (m *Mux) dispatch(ctx context.Context, frame []byte, open ChannelOpener) {
+	if len(frame) == 0 {
+		return
+	}
+	switch frame[0] {
+	case Ping:
+		// a broken master is detected by the read loop
+		m.write(protocol.Encode(protocol.Pong, nil))
+		return
+	case ChannelFrame, OpenChannel, CloseChannel:
+	default:
+		return
+	}
+	id, payload, err := protocol.DecodeChannel(frame[1:])
+	if err != nil {
+		return
+	}
+
+	if frame[0] == OpenChannel {
+		m.openChannel(ctx, id, payload, open)
+		return
+	}
+	m.mutex.Lock()
+	channel := m.channels[id]
+	m.mutex.Unlock()
+	if channel == nil {
+		return
+	}
+	if frame[0] == CloseChannel {
+		channel.close(io.EOF)
+		return
+	}
+	select {
+	case channel.frames <- payload:
+	case <-channel.closed:
+	case <-ctx.Done():
+	}
+}
+
+func (m *Mux) openChannel(ctx context.Context, id protocol.ChannelID, init []byte, open ChannelOpener) {
+	m.mutex.Lock()
+	refusal := ""
+	if _, ok := m.channels[id]; ok {
+		refusal = "channel already open"
+	} else if len(m.channels) >= m.maxChannels {
+		refusal = "too many channels"
+	}
+	if refusal != "" {
+		m.mutex.Unlock()
+		m.write(protocol.EncodeChannel(protocol.ChannelClosed, id, []byte(refusal)))
+		return
+	}
+	channel := &muxChannel{
+		mux:    m,
+		id:     id,
+		frames: make(chan []byte, muxQueueSize),
+		closed: make(chan struct{}),
+	}
+	m.channels[id] = channel
+	m.sessions.Add(1)
+	m.mutex.Unlock()
+
+	go func() {
+		defer m.sessions.Done()
+
+		open(ctx, id, init, channel)
+		channel.close(io.EOF)
+		// removed first, the master may reopen the channel once told
+		m.mutex.Lock()
+		delete(m.channels, id)
+		m.mutex.Unlock()
+		m.write(protocol.EncodeChannel(protocol.ChannelClosed, id, nil))
+	}()
+}
+
+func (m *Mux) closeChannels(err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, channel := range m.channels {
+		channel.close(err)
+	}
+}
+
+func (m *Mux) write(frame []byte) error {
+	m.writeMutex.Lock()
+	defer m.writeMutex.Unlock()
+
+	_, err := m.master.Write(frame)
+	return err
+}
+
+// muxChannel is the master of the session of a channel of a Mux.
+type muxChannel struct {
+	mux       *Mux
+	id        protocol.ChannelID
+	frames    chan []byte
+	readLimit int64 // accessed atomically
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	err       error // of the reads once closed
+}
+
+// Read reads the next frame of the channel into p, the frames queued
+// before the channel closed first.
+func (mc *muxChannel) Read(p []byte) (int, error) {
+	var frame []byte
+	select {
+	case frame = <-mc.frames:
+	case <-mc.closed:
+		select {
+		case frame = <-mc.frames:
+		default:
+			return 0, mc.err
+		}
+	}
+	if limit := atomic.LoadInt64(&mc.readLimit); len(frame) > len(p) || (limit > 0 && int64(len(frame)) > limit) {
+		return 0, ErrFrameTooLarge
+	}
+	return copy(p, frame), nil
+}
+
+// Write sends p as the frame of a ChannelOutput message.
+func (mc *muxChannel) Write(p []byte) (int, error) {
+	if err := mc.mux.write(protocol.EncodeChannel(protocol.ChannelOutput, mc.id, p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// SetReadLimit sets the maximum size of the frames of the channel,
+// called by the session with its maximum inbound frame size.
+func (mc *muxChannel) SetReadLimit(limit int64) {
+	atomic.StoreInt64(&mc.readLimit, limit)
+}
+
+func (mc *muxChannel) close(err error) {
+	mc.closeOnce.Do(func() {
+		mc.err = err
+		close(mc.closed)
+	})
+}
@@ -0,0 +1,199 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	stderrors "errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
+// muxMaster is the master of a Mux, whose frames are sent on in
+// and whose writes are received from out.
+type muxMaster struct {
+	in  chan string
+	out chan string
+}
+
+func newMuxMaster() *muxMaster {
+	return &muxMaster{in: make(chan string), out: make(chan string, 64)}
+}
+
+func (mm *muxMaster) Read(p []byte) (int, error) {
+	frame, ok := <-mm.in
+	if !ok {
+		return 0, io.EOF
+	}
+	return copy(p, frame), nil
+}
+
+func (mm *muxMaster) Write(p []byte) (int, error) {
+	mm.out <- string(p)
+	return len(p), nil
+}
+
+// next returns the next frame written starting with prefix.
+func (mm *muxMaster) next(t *testing.T, prefix string) string {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case frame := <-mm.out:
+			if strings.HasPrefix(frame, prefix) {
+				return frame
+			}
+		case <-timeout:
+			t.Fatalf("No frame starting with %q", prefix)
+		}
+	}
+}
+
+func TestMux(t *testing.T) {
+	master := newMuxMaster()
+	mux, err := NewMux(master, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error from NewMux(): %s", err)
+	}
+	closed := make(chan error, 4)
+	echo := func(ctx context.Context, id protocol.ChannelID, init []byte, master Master) error {
+		if string(init) != `{"arguments":"?"}` {
+			t.Errorf("Unexpected init of channel %d: %q", id, init)
+		}
+		buffer := make([]byte, 1024)
+		for {
+			n, err := master.Read(buffer)
+			if err != nil {
+				closed <- err
+				return err
+			}
+			master.Write(append([]byte("echo "), buffer[:n]...))
+		}
+	}
+	served := make(chan error, 1)
+	go func() { served <- mux.Serve(context.Background(), echo) }()
+
+	master.in <- `D1:{"arguments":"?"}`
+	master.in <- `D2:{"arguments":"?"}`
+	master.in <- "C1:1ls"
+	if frame := master.next(t, "N1:"); frame != "N1:echo 1ls" {
+		t.Fatalf("Unexpected frame of channel 1: %q", frame)
+	}
+	master.in <- "C2:1pwd"
+	if frame := master.next(t, "N2:"); frame != "N2:echo 1pwd" {
+		t.Fatalf("Unexpected frame of channel 2: %q", frame)
+	}
+	if mux.Channels() != 2 {
+		t.Fatalf("Unexpected number of channels: %d", mux.Channels())
+	}
+
+	master.in <- `D1:{"arguments":"?"}`
+	if frame := master.next(t, "O"); frame != "O1:channel already open" {
+		t.Fatalf("Unexpected refusal of an open channel: %q", frame)
+	}
+	master.in <- `D3:{"arguments":"?"}`
+	if frame := master.next(t, "O"); frame != "O3:too many channels" {
+		t.Fatalf("Unexpected refusal of a channel too many: %q", frame)
+	}
+	// dropped
+	master.in <- "C7:1ls"
+	master.in <- "Cx:1ls"
+	master.in <- "1ls"
+	master.in <- "2"
+	if frame := master.next(t, ""); frame != "2" {
+		t.Fatalf("Unexpected answer to a Ping: %q", frame)
+	}
+
+	master.in <- "E1:"
+	if frame := master.next(t, "O"); frame != "O1:" {
+		t.Fatalf("Unexpected frame of a closed channel: %q", frame)
+	}
+	if err := <-closed; err != io.EOF {
+		t.Fatalf("Unexpected read error of a closed channel: %v", err)
+	}
+
+	close(master.in)
+	select {
+	case err := <-served:
+		if !stderrors.Is(err, ErrMasterClosed) {
+			t.Fatalf("Unexpected error from Serve(): %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected Serve() to return once the master closed")
+	}
+	if err := <-closed; err != io.EOF {
+		t.Fatalf("Unexpected read error of a channel once the master closed: %v", err)
+	}
+	if mux.Channels() != 0 {
+		t.Fatalf("Unexpected open channels: %d", mux.Channels())
+	}
+}
+
+func TestMuxSessions(t *testing.T) {
+	master := newMuxMaster()
+	mux, err := NewMux(master, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error from NewMux(): %s", err)
+	}
+	type channelSlave struct {
+		input  *io.PipeReader
+		output *io.PipeWriter
+		slave  *pipeSlave
+	}
+	slaves := make(map[protocol.ChannelID]channelSlave)
+	for _, id := range []protocol.ChannelID{1, 2} {
+		slaveInReader, slaveInWriter := io.Pipe()
+		slaveOutReader, slaveOutWriter := io.Pipe()
+		defer slaveOutWriter.Close()
+		slaves[id] = channelSlave{slaveInReader, slaveOutWriter, &pipeSlave{pipePair{slaveOutReader, slaveInWriter}}}
+	}
+	open := func(ctx context.Context, id protocol.ChannelID, init []byte, master Master) error {
+		options := []Option{}
+		if id == 1 {
+			options = append(options, WithPermitWrite())
+		}
+		dt, err := New(master, slaves[id].slave, options...)
+		if err != nil {
+			return err
+		}
+		return dt.Run(ctx)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- mux.Serve(ctx, open) }()
+
+	master.in <- "D1:{}"
+	master.in <- "D2:{}"
+	master.in <- "C1:1ls"
+	buffer := make([]byte, 16)
+	if n, err := slaves[1].input.Read(buffer); err != nil || string(buffer[:n]) != "ls" {
+		t.Fatalf("Unexpected input of channel 1: %q %v", buffer[:n], err)
+	}
+	slaves[2].output.Write([]byte("hi"))
+	if frame := master.next(t, "N2:1"); frame != "N2:1"+base64.StdEncoding.EncodeToString([]byte("hi")) {
+		t.Fatalf("Unexpected output of channel 2: %q", frame)
+	}
+	// read-only
+	master.in <- "C2:1rm"
+	master.in <- "E2:"
+	if frame := master.next(t, "O"); frame != "O2:" {
+		t.Fatalf("Unexpected frame of a closed channel: %q", frame)
+	}
+	slaves[2].slave.PipeWriter.Close()
+	if n, _ := slaves[2].input.Read(buffer); n != 0 {
+		t.Fatalf("Unexpected input of a read-only channel: %q", buffer[:n])
+	}
+
+	cancel()
+	select {
+	case err := <-served:
+		if err != context.Canceled {
+			t.Fatalf("Unexpected error from Serve(): %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Expected Serve() to return once canceled")
+	}
+}
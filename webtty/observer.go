@@ -0,0 +1,128 @@
+package webtty
+
+import (
+	"io"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// AddObserver attaches a read-only master to the session.
+// Observers receive the same output as the master but can never write
+// to the slave, the frames they send are read and ignored whatever their
+// type. An observer whose write or read fails is detached silently.
+// With WithObserverQueueSize, an observer whose queue is full is detached,
+// closed when it implements io.Closer, and recorded in the audit trail.
+func (wt *WebTTY) AddObserver(master Master) error {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	if wt.maxObservers > 0 && len(wt.observers) >= wt.maxObservers {
+		atomic.AddUint64(&wt.rejectedObservers, 1)
+		return ErrTooManyObservers
+	}
+
+	for _, message := range wt.observerInitializeMessages() {
+		_, err := master.Write(message)
+		if err != nil {
+			return errors.Wrapf(err, "failed to initialize observer")
+		}
+	}
+	closer, _ := master.(io.Closer)
+	conn := &observerConn{Master: master}
+	go conn.discardInput(wt.bufferSize)
+	master = conn
+	if wt.observerQueueSize > 0 {
+		qo := newQueuedObserver(master, wt.observerQueueSize)
+		qo.closer = closer
+		master = qo
+	}
+	wt.observers = append(wt.observers, master)
+
+	return nil
+}
+
+// DroppedObservers returns the number of observers detached
+// for being slow, see WithObserverQueueSize.
+func (wt *WebTTY) DroppedObservers() uint64 {
+	return atomic.LoadUint64(&wt.droppedObservers)
+}
+
+// RejectedObservers returns the number of observers
+// rejected by the limit set with WithMaxObservers.
+func (wt *WebTTY) RejectedObservers() uint64 {
+	return atomic.LoadUint64(&wt.rejectedObservers)
+}
+
+func (wt *WebTTY) observerInitializeMessages() [][]byte {
+	messages := [][]byte{
+		append([]byte{SetWindowTitle}, wt.windowTitle...),
+		append([]byte{SetReadOnly}, "true"...),
+	}
+	if wt.masterPrefs != nil {
+		messages = append(messages, append([]byte{SetPreferences}, wt.masterPrefs...))
+	}
+	if message := wt.compressionMessage(); message != nil {
+		messages = append(messages, message)
+	}
+	if wt.observerReplay && wt.replay != nil {
+		messages = append(messages, wt.replayMessages(wt.replay.contents())...)
+	}
+	return messages
+}
+
+func (wt *WebTTY) observersWriteLocked(data []byte) {
+	if len(wt.observers) == 0 {
+		return
+	}
+
+	alive := wt.observers[:0]
+	for _, observer := range wt.observers {
+		_, err := observer.Write(data)
+		if err != nil {
+			if qo, ok := observer.(*queuedObserver); ok {
+				qo.stop()
+			}
+			if err == errObserverQueueFull {
+				// the audit sinks must not block the fan-out
+				go wt.auditDroppedObserver()
+				atomic.AddUint64(&wt.droppedObservers, 1)
+				if qo, ok := observer.(*queuedObserver); ok && qo.closer != nil {
+					go qo.closer.Close()
+				}
+			}
+			continue
+		}
+		alive = append(alive, observer)
+	}
+	for i := len(alive); i < len(wt.observers); i++ {
+		wt.observers[i] = nil
+	}
+	wt.observers = alive
+}
+
+// observerConn is the connection of an observer,
+// whose writes fail once its reads failed.
+type observerConn struct {
+	Master
+	closed int32 // accessed atomically
+}
+
+func (oc *observerConn) Write(data []byte) (int, error) {
+	if atomic.LoadInt32(&oc.closed) != 0 {
+		return 0, errors.New("observer closed")
+	}
+	return oc.Master.Write(data)
+}
+
+// discardInput reads the frames of the observer until its connection fails,
+// so that a closed connection is noticed.
+func (oc *observerConn) discardInput(bufferSize int) {
+	buffer := make([]byte, bufferSize)
+	for {
+		if _, err := oc.Master.Read(buffer); err != nil {
+			atomic.StoreInt32(&oc.closed, 1)
+			return
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package webtty
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// observerDroppedMarker prefixes the observers dropped for being slow
+// in the audit trail.
+const observerDroppedMarker = "[observer-dropped] "
+
+var errObserverQueueFull = errors.New("observer queue full")
+
+// queuedFramePool holds the copies of the frames queued for observers,
+// reused once written so busy sessions don't allocate one per frame.
+var queuedFramePool = sync.Pool{
+	New: func() interface{} { return new([]byte) },
+}
+
+// queuedObserver writes to an observer from its own goroutine,
+// so that a slow observer doesn't block the others nor the slave.
+type queuedObserver struct {
+	master Master
+	queue  chan *[]byte
+	// closes the observer once detached for being slow, if set
+	closer io.Closer
+
+	failed   int32 // accessed atomically
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newQueuedObserver(master Master, size int) *queuedObserver {
+	qo := &queuedObserver{
+		master: master,
+		queue:  make(chan *[]byte, size),
+		done:   make(chan struct{}),
+	}
+	go qo.run()
+	return qo
+}
+
+func (qo *queuedObserver) run() {
+	for {
+		select {
+		case frame := <-qo.queue:
+			_, err := qo.master.Write(*frame)
+			queuedFramePool.Put(frame)
+			if err != nil {
+				atomic.StoreInt32(&qo.failed, 1)
+				return
+			}
+		case <-qo.done:
+			return
+		}
+	}
+}
+
+// Write queues a copy of data, or fails when the queue is full
+// or a previous write failed.
+func (qo *queuedObserver) Write(data []byte) (int, error) {
+	if atomic.LoadInt32(&qo.failed) != 0 {
+		return 0, errors.New("failed to write to observer")
+	}
+
+	select {
+	case <-qo.done:
+		return 0, errors.New("observer stopped")
+	default:
+	}
+
+	frame := queuedFramePool.Get().(*[]byte)
+	*frame = append((*frame)[:0], data...)
+	select {
+	case qo.queue <- frame:
+		return len(data), nil
+	default:
+		queuedFramePool.Put(frame)
+		return 0, errObserverQueueFull
+	}
+}
+
+func (qo *queuedObserver) Read(p []byte) (int, error) {
+	return qo.master.Read(p)
+}
+
+func (qo *queuedObserver) stop() {
+	qo.stopOnce.Do(func() { close(qo.done) })
+}
+
+// auditDroppedObserver records an observer dropped for being slow.
+func (wt *WebTTY) auditDroppedObserver() {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, observerDroppedMarker+"queue full")
+}
+
+// stopObserverQueues stops the goroutines writing to queued observers.
+func (wt *WebTTY) stopObserverQueues() {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	for _, observer := range wt.observers {
+		if qo, ok := observer.(*queuedObserver); ok {
+			qo.stop()
+		}
+	}
+}
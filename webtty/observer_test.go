@@ -0,0 +1,194 @@
+package webtty
+
+import (
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestMaxObservers(t *testing.T) {
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithMaxObservers(1))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	first := &frameRecorder{}
+	if err := dt.AddObserver(recordingMaster{first}); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+	if err := dt.AddObserver(recordingMaster{&frameRecorder{}}); err != ErrTooManyObservers {
+		t.Fatalf("Unexpected error from AddObserver(): %v", err)
+	}
+	if n := dt.RejectedObservers(); n != 1 {
+		t.Fatalf("Unexpected rejected observer count: %d", n)
+	}
+
+	dt.sendOutput([]byte("foo"))
+	dt.primaryWrite([]byte{Pong})
+
+	frames := first.get()
+	if frames[len(frames)-1] != "1Zm9v" {
+		t.Fatalf("Unexpected frames for observer: %q", frames)
+	}
+}
+
+// blockingMaster blocks writes until release is closed.
+type blockingMaster struct {
+	release chan struct{}
+}
+
+func (blockingMaster) Read(p []byte) (int, error) { select {} }
+func (bm blockingMaster) Write(p []byte) (int, error) {
+	<-bm.release
+	return len(p), nil
+}
+
+func TestObserverQueueSize(t *testing.T) {
+	audited := make(chan string, 1)
+	dt, err := New(discardMaster{}, &pipeSlave{},
+		WithObserverQueueSize(2),
+		withAuditLines(func(line string, requestID string) { audited <- line }),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	fast := &frameRecorder{}
+	if err := dt.AddObserver(recordingMaster{fast}); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+	slow := blockingMaster{make(chan struct{})}
+	defer close(slow.release)
+	// initialized synchronously before being blocked
+	go func() { slow.release <- struct{}{}; slow.release <- struct{}{} }()
+	if err := dt.AddObserver(slow); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		dt.sendOutput([]byte("foo"))
+		// let the fast observer drain its queue
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if line := <-audited; !strings.HasSuffix(line, "[LOG:[observer-dropped] queue full]") {
+		t.Fatalf("Unexpected audit: %s", line)
+	}
+	dt.writeMutex.Lock()
+	observers := len(dt.observers)
+	dt.writeMutex.Unlock()
+	if observers != 1 {
+		t.Fatalf("Unexpected number of observers: %d", observers)
+	}
+
+	for i := 0; i < 100 && len(fast.get()) < 7; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if frames := fast.get(); len(frames) != 7 {
+		t.Fatalf("Unexpected frames for fast observer: %q", frames)
+	}
+	dt.stopObserverQueues()
+}
+
+// chattyMaster sends the given frames, then fails reads.
+type chattyMaster struct {
+	recordingMaster
+	frames chan []byte
+}
+
+func (cm chattyMaster) Read(p []byte) (int, error) {
+	frame, ok := <-cm.frames
+	if !ok {
+		return 0, io.EOF
+	}
+	return copy(p, frame), nil
+}
+
+func TestObserverInputIgnored(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{pipePair{nil, slaveWriter}}}
+	dt, err := New(discardMaster{}, slave, WithPermitWrite())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	written := make(chan []byte, 1)
+	go func() {
+		buf := make([]byte, 1024)
+		n, _ := slaveReader.Read(buf)
+		written <- buf[:n]
+	}()
+
+	observer := chattyMaster{recordingMaster{&frameRecorder{}}, make(chan []byte)}
+	if err := dt.AddObserver(observer); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+	observer.frames <- []byte("1ls\r")
+	observer.frames <- []byte(`3{"Columns":10,"Rows":10}`)
+	close(observer.frames)
+
+	select {
+	case data := <-written:
+		t.Fatalf("Unexpected write to slave: %q", data)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if slave.columns != 0 || slave.rows != 0 {
+		t.Fatalf("Unexpected resize: %dx%d", slave.columns, slave.rows)
+	}
+
+	// the observer is detached on the next output once its reads failed
+	dt.sendOutput([]byte("foo"))
+	dt.writeMutex.Lock()
+	observers := len(dt.observers)
+	dt.writeMutex.Unlock()
+	if observers != 0 {
+		t.Fatalf("Unexpected number of observers: %d", observers)
+	}
+}
+
+// toggleMaster fails writes once fail is called.
+type toggleMaster struct {
+	failed int32
+}
+
+func (tm *toggleMaster) fail()                      { atomic.StoreInt32(&tm.failed, 1) }
+func (tm *toggleMaster) Read(p []byte) (int, error) { select {} }
+func (tm *toggleMaster) Write(p []byte) (int, error) {
+	if atomic.LoadInt32(&tm.failed) != 0 {
+		return 0, errors.New("broken pipe")
+	}
+	return len(p), nil
+}
+
+func TestObserverWriteFailure(t *testing.T) {
+	master := recordingMaster{&frameRecorder{}}
+	dt, err := New(master, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.AddObserver(recordingMaster{&frameRecorder{}}); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+	failing := &toggleMaster{}
+	if err := dt.AddObserver(failing); err != nil {
+		t.Fatalf("Unexpected error from AddObserver(): %s", err)
+	}
+	failing.fail()
+
+	if err := dt.sendOutput([]byte("foo")); err != nil {
+		t.Fatalf("Unexpected error from sendOutput(): %s", err)
+	}
+	if frames := master.get(); len(frames) != 1 || frames[0] != "1Zm9v" {
+		t.Fatalf("Unexpected frames for master: %q", frames)
+	}
+	dt.writeMutex.Lock()
+	observers := len(dt.observers)
+	dt.writeMutex.Unlock()
+	if observers != 1 {
+		t.Fatalf("Unexpected number of observers: %d", observers)
+	}
+}
@@ -2,22 +2,39 @@ package webtty
 
 import (
 	"encoding/json"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty/protocol"
 )
 
 // Option is an option for WebTTY.
 type Option func(*WebTTY) error
 
 // WithPermitWrite sets a WebTTY to accept input from slaves.
-func WithPermitWrite() Option {
+// It can be given false to keep the session read only,
+// the last value is used when more are given.
+func WithPermitWrite(permitWrite ...bool) Option {
+	return func(wt *WebTTY) error {
+		wt.permitWrite = len(permitWrite) == 0 || permitWrite[len(permitWrite)-1]
+		return nil
+	}
+}
+
+// WithPermitInjection allows the server to inject input
+// into the session with InjectInput.
+func WithPermitInjection() Option {
 	return func(wt *WebTTY) error {
-		wt.permitWrite = true
+		wt.permitInjection = true
 		return nil
 	}
 }
 
-// WithFixedColumns sets a fixed width to TTY master.
+// WithFixedColumns sets the width of TTY master.
+// It applies to the resizes of the master according to WithSizeMode.
 func WithFixedColumns(columns int) Option {
 	return func(wt *WebTTY) error {
 		wt.columns = columns
@@ -25,7 +42,8 @@ func WithFixedColumns(columns int) Option {
 	}
 }
 
-// WithFixedRows sets a fixed height to TTY master.
+// WithFixedRows sets the height of TTY master.
+// It applies to the resizes of the master according to WithSizeMode.
 func WithFixedRows(rows int) Option {
 	return func(wt *WebTTY) error {
 		wt.rows = rows
@@ -60,3 +78,1131 @@ func WithMasterPreferences(preferences interface{}) Option {
 		return nil
 	}
 }
+
+// WithServerFeatures sets the features supported by this end.
+// Only the features also advertised by the master are used by the session.
+func WithServerFeatures(features FeatureSet) Option {
+	return func(wt *WebTTY) error {
+		wt.serverFeatures = features
+		return nil
+	}
+}
+
+// WithClientFeatures sets the features advertised by the master
+// in its handshake request.
+func WithClientFeatures(features FeatureSet) Option {
+	return func(wt *WebTTY) error {
+		wt.clientFeatures = features
+		return nil
+	}
+}
+
+// WithProtocolVersion sets the version of the protocol announced by the
+// master in its handshake, 0 when it announced none. The session speaks
+// the latest version both ends support, see protocol.Negotiate, the master
+// is told it with a SetProtocolVersion message first and isn't sent the
+// message types of later versions. A master reattached to the session
+// speaks its version too.
+func WithProtocolVersion(announced int) Option {
+	return func(wt *WebTTY) error {
+		version, err := protocol.Negotiate(announced)
+		if err != nil {
+			return err
+		}
+		wt.protocolVersion = version
+		wt.protocolAnnounced = announced != 0
+		return nil
+	}
+}
+
+// WithMaxInboundFrameSize sets the maximum size in bytes of a frame
+// accepted from the master. A larger frame closes the session.
+// The default is DefaultMaxInboundFrameSize.
+func WithMaxInboundFrameSize(size int) Option {
+	return func(wt *WebTTY) error {
+		if size <= 0 {
+			return errors.New("max inbound frame size must be positive")
+		}
+		wt.maxInboundFrameSize = size
+		return nil
+	}
+}
+
+// WithWritablePermissionHook sets a function called each time
+// the write permission of the session changes.
+func WithWritablePermissionHook(hook func(writable bool)) Option {
+	return func(wt *WebTTY) error {
+		wt.writableHook = hook
+		return nil
+	}
+}
+
+// WithAuditFile additionally writes the audit trail to a local file at path.
+func WithAuditFile(path string) Option {
+	return func(wt *WebTTY) error {
+		wt.auditFilePath = path
+		return nil
+	}
+}
+
+// WithSharedAuditFile additionally writes the audit trail to file, opened
+// once with OpenAuditFile and shared by all the sessions, instead of the
+// file at the path of WithAuditFile opened by each session. Its rotation
+// and retention are the ones it was opened with, the options of
+// WithAuditFileRotation and WithAuditFileRetention are ignored.
+func WithSharedAuditFile(file *AuditFile) Option {
+	return func(wt *WebTTY) error {
+		if file == nil {
+			return errors.New("shared audit file must not be nil")
+		}
+		wt.auditFile = file
+		return nil
+	}
+}
+
+// WithAuditFileRotation rotates the audit file given by WithAuditFile
+// once it exceeds maxSize bytes or becomes older than maxAge.
+// Zero disables the corresponding limit.
+func WithAuditFileRotation(maxSize int, maxAge time.Duration) Option {
+	return func(wt *WebTTY) error {
+		wt.auditFileMaxSize = maxSize
+		wt.auditFileMaxAge = maxAge
+		return nil
+	}
+}
+
+// WithAuditFileRetention sets what becomes of the files rotated
+// by WithAuditFileRotation, see AuditFileRetention.
+func WithAuditFileRetention(retention AuditFileRetention) Option {
+	return func(wt *WebTTY) error {
+		if retention.MaxBackups < 0 || retention.MaxAge < 0 {
+			return errors.New("audit file retention must not be negative")
+		}
+		wt.auditRetention = &retention
+		return nil
+	}
+}
+
+// WithAuditFileJSON writes the entries of the audit file given by
+// WithAuditFile as AuditEvent JSON objects, one per line.
+func WithAuditFileJSON() Option {
+	return func(wt *WebTTY) error {
+		wt.auditFileJSON = true
+		return nil
+	}
+}
+
+// WithEraseKeys sets the bytes treated as backspace when reconstructing
+// commands for the audit trail. The default is DefaultEraseKeys.
+func WithEraseKeys(keys ...byte) Option {
+	return func(wt *WebTTY) error {
+		wt.eraseKeys = keys
+		return nil
+	}
+}
+
+// WithOutputFlushInterval buffers output from the slave and sends it
+// to the master at most once per interval.
+// Zero, the default, sends every read of the slave immediately.
+func WithOutputFlushInterval(interval time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if interval < 0 {
+			return errors.New("output flush interval must not be negative")
+		}
+		wt.flushInterval = interval
+		return nil
+	}
+}
+
+// WithCoalesceMaxBytes caps the size of output buffered by
+// WithOutputFlushInterval. The default is the buffer size.
+func WithCoalesceMaxBytes(maxBytes int) Option {
+	return func(wt *WebTTY) error {
+		if maxBytes <= 0 {
+			return errors.New("coalesce max bytes must be positive")
+		}
+		wt.coalesceMaxBytes = maxBytes
+		return nil
+	}
+}
+
+// WithCoalesceOverflowHandler sets a function deciding what to do
+// when buffered output would exceed the cap set by WithCoalesceMaxBytes.
+// Without a handler the buffer is flushed immediately.
+func WithCoalesceOverflowHandler(handler func(buffered int) CoalesceOverflowAction) Option {
+	return func(wt *WebTTY) error {
+		wt.coalesceOverflow = handler
+		return nil
+	}
+}
+
+// WithOutputInspector passes the output of the slave through inspector
+// line by line, see OutputInspector. The findings of inspector are recorded
+// in the audit trail. The end of the output is held for the rest of its line
+// for the delay set by WithOutputInspectionDelay.
+func WithOutputInspector(inspector OutputInspector) Option {
+	return func(wt *WebTTY) error {
+		wt.outputInspector = inspector
+		return nil
+	}
+}
+
+// WithOutputInspectionDelay sets how long the end of the output, not
+// terminated by a line break, is held by WithOutputInspector for the rest
+// of its line. A longer delay reassembles the lines of slow commands at
+// the cost of the latency of the echo. The default is
+// DefaultOutputInspectionDelay.
+func WithOutputInspectionDelay(delay time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if delay <= 0 {
+			return errors.New("output inspection delay must be positive")
+		}
+		wt.inspectionDelay = delay
+		return nil
+	}
+}
+
+// WithWriteGrantDuration revokes the write permission of the master
+// once it has been writable for the duration.
+// Each new grant through SetPermitWrite restarts the period.
+func WithWriteGrantDuration(duration time.Duration) Option {
+	return func(wt *WebTTY) error {
+		wt.writeGrantDuration = duration
+		return nil
+	}
+}
+
+// WithAuditCommandFilter records only commands for which filter returns true
+// in the audit trail. Other commands are counted by FilteredAuditCommands.
+func WithAuditCommandFilter(filter func(cmd string) bool) Option {
+	return func(wt *WebTTY) error {
+		wt.auditFilter = filter
+		return nil
+	}
+}
+
+// WithLineHandler serves the session with handle instead of the slave,
+// for example to offer a restricted menu of commands.
+// Input is not written to the slave, typed characters are echoed locally
+// and each line is passed to handle on Enter. The returned text is printed
+// on the terminal, followed by the prompt set with WithMenuPrompt.
+// Lines are still recorded in the audit trail.
+func WithLineHandler(handle func(line string) string) Option {
+	return func(wt *WebTTY) error {
+		wt.lineHandler = handle
+		return nil
+	}
+}
+
+// WithMenuPrompt sets the prompt printed when the session starts and
+// after the output of each line handled by the handler of WithLineHandler.
+func WithMenuPrompt(prompt string) Option {
+	return func(wt *WebTTY) error {
+		wt.menuPrompt = prompt
+		return nil
+	}
+}
+
+// WithMaxSessionDuration makes Run return ErrSessionExpired
+// once it has been running for d, after printing the SessionExpired
+// message and sending SessionEnd to the master.
+func WithMaxSessionDuration(d time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if d < 0 {
+			return errors.New("max session duration must not be negative")
+		}
+		wt.maxSessionDuration = d
+		return nil
+	}
+}
+
+// WithSessionExpiryWarning prints the SessionExpiring message on the
+// terminal d before the session reaches its maximum duration.
+func WithSessionExpiryWarning(d time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if d < 0 {
+			return errors.New("session expiry warning must not be negative")
+		}
+		wt.sessionExpiryWarning = d
+		return nil
+	}
+}
+
+// WithExpiryWarning prints message on the terminal lead before the session
+// reaches its maximum duration, like WithSessionExpiryWarning with another
+// message than SessionExpiring. %s in message is replaced with lead,
+// an empty message falls back to SessionExpiring.
+func WithExpiryWarning(lead time.Duration, message string) Option {
+	return func(wt *WebTTY) error {
+		if lead < 0 {
+			return errors.New("session expiry warning must not be negative")
+		}
+		wt.sessionExpiryWarning = lead
+		wt.sessionExpiryMessage = message
+		return nil
+	}
+}
+
+// WithSlaveReadWatchdog warns when input was written to the slave
+// but no read from it completed within d, which happens when the slave
+// is hung. When endSession is true, Run also returns ErrSlaveHung, the
+// goroutine blocked in Read of the slave is left behind until it returns.
+// Keys typed at a password prompt are not echoed, d should be well above
+// the time a user takes to type a password.
+func WithSlaveReadWatchdog(d time.Duration, endSession bool) Option {
+	return func(wt *WebTTY) error {
+		if d < 0 {
+			return errors.New("slave read watchdog interval must not be negative")
+		}
+		wt.slaveReadWatchdog = d
+		wt.slaveReadWatchdogEnd = endSession
+		return nil
+	}
+}
+
+// WithActivityTimeout calls expired when the master sends no input
+// for timeout while Run is active, see LastActivity.
+// It is called again only after new input is followed by another timeout.
+func WithActivityTimeout(timeout time.Duration, expired func()) Option {
+	return func(wt *WebTTY) error {
+		if timeout < 0 {
+			return errors.New("activity timeout must not be negative")
+		}
+		wt.activityTimeout = timeout
+		wt.activityExpired = expired
+		return nil
+	}
+}
+
+// WithPongTimeout calls expired when the master sends no Ping
+// for timeout while Run is active, see LastPong.
+// It is called again only after a new Ping is followed by another timeout.
+func WithPongTimeout(timeout time.Duration, expired func()) Option {
+	return func(wt *WebTTY) error {
+		if timeout < 0 {
+			return errors.New("pong timeout must not be negative")
+		}
+		wt.pongTimeout = timeout
+		wt.pongExpired = expired
+		return nil
+	}
+}
+
+// WithInputEncoding sets how the master encodes the payload of Input frames.
+// The default is EncodingRaw, keys are sent as is.
+func WithInputEncoding(encoding Encoding) Option {
+	return func(wt *WebTTY) error {
+		wt.inputEncoding = encoding
+		return nil
+	}
+}
+
+// WithOutputEncoding sets how the payload of Output frames is encoded
+// for the master. The default is EncodingBase64.
+func WithOutputEncoding(encoding Encoding) Option {
+	return func(wt *WebTTY) error {
+		wt.outputEncoding = encoding
+		return nil
+	}
+}
+
+// WithKeystrokeTimingHook calls hook with the time elapsed between
+// each Input frame received from the master and the previous one,
+// without their content. It is called from the master read loop
+// and must return quickly.
+func WithKeystrokeTimingHook(hook func(delta time.Duration)) Option {
+	return func(wt *WebTTY) error {
+		wt.keystrokeTimingHook = hook
+		return nil
+	}
+}
+
+// WithTimestampedPong makes Pong messages carry the time of the server
+// in milliseconds since the Unix epoch, so that the master can estimate
+// the clock skew and the one-way delay.
+func WithTimestampedPong() Option {
+	return func(wt *WebTTY) error {
+		wt.timestampedPong = true
+		return nil
+	}
+}
+
+// WithFrameTypeObserver sets a function called with the type of each frame
+// received from the master, before it is handled.
+// It is called from the master read loop and must return quickly.
+func WithFrameTypeObserver(observe func(mt MessageType)) Option {
+	return func(wt *WebTTY) error {
+		wt.frameTypeObserver = observe
+		return nil
+	}
+}
+
+// WithAuditTrim sets whether trailing whitespace and control characters
+// are trimmed from commands before they are filtered and recorded
+// in the audit trail. Commands are recorded as typed by default.
+func WithAuditTrim(trim bool) Option {
+	return func(wt *WebTTY) error {
+		wt.auditTrim = trim
+		return nil
+	}
+}
+
+// WithAuditRedaction masks the secrets found by redact, such as
+// NewRedactor(), in the commands and the keys recorded in the audit trail,
+// before they are filtered. Unlike WithSecretMask, it finds the secrets
+// typed on the command lines themselves, but a secret edited while typed
+// may escape it in the keys.
+func WithAuditRedaction(redact func(text string) string) Option {
+	return func(wt *WebTTY) error {
+		wt.auditRedact = redact
+		return nil
+	}
+}
+
+// WithSessionID sets the ID of the session, a random ID is used by default.
+func WithSessionID(id string) Option {
+	return func(wt *WebTTY) error {
+		wt.session.SessionID = id
+		return nil
+	}
+}
+
+// WithSessionTags sets the tags of the session, recorded with each of its
+// audit events, see ValidateSessionTags. The tags of the session context
+// given to Run replace them.
+func WithSessionTags(tags map[string]string) Option {
+	return func(wt *WebTTY) error {
+		if err := ValidateSessionTags(tags); err != nil {
+			return err
+		}
+		wt.session.Tags = copyTags(tags)
+		return nil
+	}
+}
+
+// WithSessionInfoFrame sends a SetSessionInfo message describing
+// the user, cluster, session ID and read-only status on initialization.
+func WithSessionInfoFrame() Option {
+	return func(wt *WebTTY) error {
+		wt.sessionInfoFrame = true
+		return nil
+	}
+}
+
+// WithCloseReason sends a CloseReason message telling why the session
+// closed when Run returns, unless its context is canceled or the master
+// closed, so that the master can display it.
+func WithCloseReason() Option {
+	return func(wt *WebTTY) error {
+		wt.closeReasonFrame = true
+		return nil
+	}
+}
+
+// WithClipboardPolicy sets how OSC 52 clipboard sequences written by
+// the slave are handled. The default is ClipboardStrip. Unless
+// passed through, the sequences are removed from the output even when
+// written with C1 controls or too long to be parsed, the last ones are
+// not forwarded.
+func WithClipboardPolicy(policy ClipboardPolicy) Option {
+	return func(wt *WebTTY) error {
+		wt.clipboardPolicy = policy
+		return nil
+	}
+}
+
+// WithMaxObservers limits the number of observers attached with AddObserver.
+// Zero, the default, means no limit.
+func WithMaxObservers(max int) Option {
+	return func(wt *WebTTY) error {
+		wt.maxObservers = max
+		return nil
+	}
+}
+
+// WithObserverQueueSize writes to each observer from its own goroutine
+// through a queue of size frames, so that a slow observer doesn't block the
+// master nor other observers. An observer whose queue is full is detached,
+// and closed when it implements io.Closer.
+// By default, observers are written to in turn with the master.
+func WithObserverQueueSize(size int) Option {
+	return func(wt *WebTTY) error {
+		if size < 0 {
+			return errors.New("observer queue size must not be negative")
+		}
+		wt.observerQueueSize = size
+		return nil
+	}
+}
+
+// WithMetrics sets the receiver of the session metrics.
+func WithMetrics(metrics Metrics) Option {
+	return func(wt *WebTTY) error {
+		wt.metrics = metrics
+		return nil
+	}
+}
+
+// WithCommandRewriter sets a function rewriting each command line typed
+// on the master before it is executed. The rewritten line is also the one
+// recorded in the audit trail.
+// It enables the line-buffered input mode, in which characters typed at a
+// shell prompt are held and echoed locally until Enter is pressed.
+// Input typed elsewhere, at password prompts or in full-screen programs,
+// is forwarded as is and never rewritten.
+func WithCommandRewriter(rewrite func(line string) string) Option {
+	return func(wt *WebTTY) error {
+		wt.commandRewriter = rewrite
+		return nil
+	}
+}
+
+// WithMemoryPressureSignal makes WebTTY stop reading from the slave while
+// memory pressure is signaled, so that the PTY applies backpressure to the
+// process instead of output piling up in memory.
+// A value sent on signal raises the pressure and the next one lowers it.
+// The signal is checked before each read from the slave.
+// Each session needs its own channel.
+func WithMemoryPressureSignal(signal <-chan struct{}) Option {
+	return func(wt *WebTTY) error {
+		wt.memoryPressure = signal
+		return nil
+	}
+}
+
+// WithInputGracePeriod holds input received during d after the session
+// starts, so that keys typed before the shell is ready are not lost.
+// The grace period ends early when the slave outputs something that
+// looks like a prompt.
+func WithInputGracePeriod(d time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if d < 0 {
+			return errors.New("input grace period must not be negative")
+		}
+		wt.inputGracePeriod = d
+		return nil
+	}
+}
+
+// WithInputGraceAction sets whether input received during the grace period
+// is forwarded once it ends or dropped. The default is InputGraceBuffer.
+func WithInputGraceAction(action InputGraceAction) Option {
+	return func(wt *WebTTY) error {
+		wt.inputGraceAction = action
+		return nil
+	}
+}
+
+// WithSessionCapture records every frame exchanged with the master to w,
+// so that the session can be replayed offline. See CaptureRecord for the format.
+// Capturing stops when the capture would grow beyond maxBytes,
+// zero means no limit, for example when w streams to a file.
+func WithSessionCapture(w io.Writer, maxBytes int) Option {
+	return func(wt *WebTTY) error {
+		if maxBytes < 0 {
+			return errors.New("session capture size must not be negative")
+		}
+		wt.captureWriter = w
+		wt.captureMaxBytes = maxBytes
+		return nil
+	}
+}
+
+// WithOutputSanitizer removes the escape sequences of classes, or of all
+// the EscapeClasses when none is given, from the output of the slave before
+// it reaches the master, the observers and the recordings. They're the
+// sequences exploiting the terminals of the browsers, such as the title
+// reports answering with injected input. The OSC sequences handled by
+// WithClipboardPolicy, WithTitleTracking and WithWorkingDirTracking are
+// seen by them first.
+func WithOutputSanitizer(classes ...EscapeClass) Option {
+	return func(wt *WebTTY) error {
+		for _, class := range classes {
+			if _, err := ParseEscapeClass(string(class)); err != nil {
+				return err
+			}
+		}
+		if len(classes) == 0 {
+			classes = EscapeClasses
+		}
+		wt.outputSanitizer = newOutputSanitizer(classes)
+		return nil
+	}
+}
+
+// WithInputEscapeFilter strips control strings, such as OSC or DCS
+// sequences, from the input of the master before it is forwarded
+// to the slave and recorded in the audit trail.
+func WithInputEscapeFilter() Option {
+	return func(wt *WebTTY) error {
+		wt.inputEscapeFilter = true
+		return nil
+	}
+}
+
+// WithAuditHeartbeat records an event in the audit trail every interval
+// while the session runs, even when no command is typed.
+func WithAuditHeartbeat(interval time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if interval < 0 {
+			return errors.New("audit heartbeat interval must not be negative")
+		}
+		wt.auditHeartbeatInterval = interval
+		return nil
+	}
+}
+
+// WithAuditSessionStart records an event in the audit trail when Run starts.
+// When limiter is not nil, the event waits for it without delaying the
+// session, share one limiter between sessions to smooth bursts of them.
+func WithAuditSessionStart(limiter *AuditLimiter) Option {
+	return func(wt *WebTTY) error {
+		wt.auditSessionStartEvent = true
+		wt.sessionStartLimiter = limiter
+		return nil
+	}
+}
+
+// WithAuditLifecycle records the lifecycle of the session in the audit
+// trail along with the commands, to tell who was connected when: its start
+// like WithAuditSessionStart, the master disconnecting and reattaching,
+// the write permission changed with SetPermitWrite or expiring, Terminate,
+// and its end with the exit reason of the slave or why it was closed.
+// Resizes and the permission changes requested by the master are recorded
+// without it.
+func WithAuditLifecycle() Option {
+	return func(wt *WebTTY) error {
+		wt.auditLifecycleEvents = true
+		return nil
+	}
+}
+
+// WithInterruptReadsOnCancel makes Run end the pending reads of the master
+// and the slave when its context is canceled, so that the read loops exit
+// before Run returns instead of leaking until the caller closes them.
+// Reads are interrupted with SetReadDeadline, Run doesn't wait for the read
+// loops when the master or the slave doesn't implement it, and waits at most
+// one second otherwise.
+func WithInterruptReadsOnCancel() Option {
+	return func(wt *WebTTY) error {
+		wt.interruptReadsOnCancel = true
+		return nil
+	}
+}
+
+// WithAuditLogger sets the logger receiving the events of the audit trail.
+// Events are discarded by default, see HTTPAuditLogger to send them
+// to an HTTP endpoint.
+func WithAuditLogger(logger AuditLogger) Option {
+	return func(wt *WebTTY) error {
+		wt.auditLogger = logger
+		return nil
+	}
+}
+
+// WithMessages registers the notices printed on the terminal for locale.
+// The set used by a session is selected with the locale of the master.
+func WithMessages(locale string, messages Messages) Option {
+	return func(wt *WebTTY) error {
+		if wt.messageSets == nil {
+			wt.messageSets = map[string]Messages{}
+		}
+		wt.messageSets[strings.ToLower(locale)] = messages
+		return nil
+	}
+}
+
+// WithDefaultLocale sets the locale whose messages are used
+// when none matches the locale of the master. The default is DefaultLocale.
+func WithDefaultLocale(locale string) Option {
+	return func(wt *WebTTY) error {
+		wt.defaultLocale = locale
+		return nil
+	}
+}
+
+// WithClientLocale sets the locale advertised by the master,
+// such as "en-US" or "zh-CN".
+func WithClientLocale(locale string) Option {
+	return func(wt *WebTTY) error {
+		wt.clientLocale = locale
+		return nil
+	}
+}
+
+// WithFullCapture records the output of the slave in the audit trail
+// along with the commands, for a complete record of the session.
+// Output is stripped of escape sequences and sampled, see
+// WithFullCaptureSampling for the defaults.
+func WithFullCapture() Option {
+	return func(wt *WebTTY) error {
+		wt.fullCapture = true
+		return nil
+	}
+}
+
+// WithFullCaptureSampling sets how often the output recorded by
+// WithFullCapture is written to the audit trail, and the maximum size
+// of each entry. Output beyond maxBytes within an interval is dropped.
+// The defaults are DefaultFullCaptureInterval and DefaultFullCaptureMaxBytes.
+func WithFullCaptureSampling(interval time.Duration, maxBytes int) Option {
+	return func(wt *WebTTY) error {
+		if interval <= 0 {
+			return errors.New("full capture interval must be positive")
+		}
+		if maxBytes <= 0 {
+			return errors.New("full capture max bytes must be positive")
+		}
+		wt.fullCaptureInterval = interval
+		wt.fullCaptureMaxBytes = maxBytes
+		return nil
+	}
+}
+
+// WithFixedSize sets whether the size set by WithFixedColumns and
+// WithFixedRows is locked. When it is not, the size of the master is
+// applied to the slave from the second resize on.
+// It is a shorthand of WithSizeMode with SizeFixed or SizeInitial.
+func WithFixedSize(fixed bool) Option {
+	if fixed {
+		return WithSizeMode(SizeFixed)
+	}
+	return WithSizeMode(SizeInitial)
+}
+
+// WithSizeMode sets how the size set by WithFixedColumns and
+// WithFixedRows applies to the resizes of the master.
+// The default is SizeInitial.
+func WithSizeMode(mode SizeMode) Option {
+	return func(wt *WebTTY) error {
+		wt.sizeMode = mode
+		return nil
+	}
+}
+
+// WithResizeDebounce coalesces the bursts of resizes of the master, such as
+// while a window is dragged: the first resize applies at once, the next ones
+// at most once per debounce, the latest one last. The default is 0, each
+// resize applies at once.
+func WithResizeDebounce(debounce time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if debounce < 0 {
+			return errors.New("resize debounce must not be negative")
+		}
+		wt.resize.debounce = debounce
+		return nil
+	}
+}
+
+// WithBufferSize sets the size in bytes of the buffers used to read
+// from the slave and the master. A larger buffer sends fewer and larger
+// Output frames for commands printing a lot. The default is 1024.
+func WithBufferSize(size int) Option {
+	return func(wt *WebTTY) error {
+		if size <= 0 {
+			return errors.New("buffer size must be positive")
+		}
+		wt.bufferSize = size
+		return nil
+	}
+}
+
+// WithIdleTimeout makes Run return ErrIdleTimeout when the master sends
+// no input for timeout, Ping doesn't count as input. The IdleTimeout
+// message is printed and SessionEnd is sent to the master before.
+// A zero timeout, the default, disables it.
+func WithIdleTimeout(timeout time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if timeout < 0 {
+			return errors.New("idle timeout must not be negative")
+		}
+		wt.idleTimeout = timeout
+		return nil
+	}
+}
+
+// WithLatencyProbe makes Run send a KeepAlivePing to the master every
+// interval to measure the round-trip time of the connection, see Latency.
+// The measurements are given to the Metrics, and sent to the masters
+// speaking version 2 of the protocol in LatencyReport messages. The pings
+// of WithKeepAlive are measured as well, without reports.
+func WithLatencyProbe(interval time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if interval <= 0 {
+			return errors.New("latency probe interval must be positive")
+		}
+		wt.latencyProbeInterval = interval
+		return nil
+	}
+}
+
+// WithLocalEcho echoes the printable characters typed on the master at
+// once, instead of waiting for the slave to echo them, while the smoothed
+// round-trip time to the master is at least threshold, or regardless of it
+// when threshold is 0. The echo of the slave is then dropped from the
+// output, and the characters it doesn't echo in time, or replaces with
+// other output, are erased. Characters are only echoed on a line once the
+// slave echoed its first ones, so that passwords typed at a prompt without
+// echo aren't shown. A threshold requires WithLatencyProbe or WithKeepAlive
+// to measure the round-trip time. The input of WithLineHandler,
+// WithCommandRewriter and WithInputFilter isn't echoed.
+func WithLocalEcho(threshold time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if threshold < 0 {
+			return errors.New("local echo threshold must not be negative")
+		}
+		wt.localEchoEnabled = true
+		wt.localEchoThreshold = threshold
+		return nil
+	}
+}
+
+// WithKeepAlive makes Run send a KeepAlivePing to the master every interval
+// and return ErrMasterTimeout when neither a KeepAlivePong nor a Ping
+// is received within timeout, for half-open connections. The timeout
+// is recorded in the audit trail.
+func WithKeepAlive(interval time.Duration, timeout time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if interval <= 0 || timeout <= 0 {
+			return errors.New("keepalive interval and timeout must be positive")
+		}
+		wt.keepAliveInterval = interval
+		wt.keepAliveTimeout = timeout
+		return nil
+	}
+}
+
+// WithRecorder records the session to w in the asciicast v2 format,
+// which can be replayed with asciinema. The output of the slave and the
+// resizes are recorded, with times relative to the start of Run.
+func WithRecorder(w io.Writer) Option {
+	return func(wt *WebTTY) error {
+		wt.recorder = newCastRecorder(w)
+		return nil
+	}
+}
+
+// WithRecordInput also records the keys forwarded to the slave
+// with WithRecorder, as input events. Keys typed at password prompts
+// are recorded as well.
+func WithRecordInput() Option {
+	return func(wt *WebTTY) error {
+		wt.recordInput = true
+		return nil
+	}
+}
+
+// WithInitialCommand writes command to the slave when Run starts, followed
+// by a newline if it has none. It is written even when the master is not
+// permitted to write, since it doesn't come from the master.
+func WithInitialCommand(command string) Option {
+	return func(wt *WebTTY) error {
+		wt.initialCommand = command
+		return nil
+	}
+}
+
+// WithTitleTracking sends the window titles set by the slave with
+// OSC 0 and OSC 2 sequences to the master as SetWindowTitle messages.
+func WithTitleTracking() Option {
+	return func(wt *WebTTY) error {
+		wt.titleTracking = true
+		return nil
+	}
+}
+
+// WithInputRateLimit throttles the input written to the slave to
+// bytesPerSec bytes per second on average, with bursts of up to burst bytes
+// written at once, so that typing is unaffected while large pastes are
+// smoothed out. Ping and resize messages are not throttled.
+func WithInputRateLimit(bytesPerSec int, burst int) Option {
+	return func(wt *WebTTY) error {
+		if bytesPerSec <= 0 || burst <= 0 {
+			return errors.New("input rate limit and burst must be positive")
+		}
+		wt.inputLimiter = newRateLimiter(bytesPerSec, burst, time.Now())
+		return nil
+	}
+}
+
+// WithWriteTimeout bounds each write to the master, such as a browser
+// that stops reading, Run then returns ErrMasterClosed. Masters with a
+// SetWriteDeadline method, such as websocket connections, are given a
+// deadline. Writes to other masters are abandoned when they time out,
+// and the master is no longer written afterwards.
+func WithWriteTimeout(timeout time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if timeout < 0 {
+			return errors.New("write timeout must not be negative")
+		}
+		wt.writeTimeout = timeout
+		return nil
+	}
+}
+
+// WithReplayBuffer keeps the latest size bytes of output of the slave
+// and enables Reattach, which replays them to the new master.
+func WithReplayBuffer(size int) Option {
+	return func(wt *WebTTY) error {
+		if size <= 0 {
+			return errors.New("replay buffer size must be positive")
+		}
+		wt.replay = newReplayBuffer(size)
+		return nil
+	}
+}
+
+// WithBinaryOutput sends Output payloads and expects Input payloads as raw
+// bytes, for masters carrying binary frames such as binary websockets.
+// It is the same as EncodingRaw for both directions and is not compatible
+// with the bundled client, which expects base64 output.
+func WithBinaryOutput() Option {
+	return func(wt *WebTTY) error {
+		wt.inputEncoding = EncodingRaw
+		wt.outputEncoding = EncodingRaw
+		return nil
+	}
+}
+
+// WithSecretMask records the lines typed at prompts asking for a secret
+// as "***" in the audit trail and CurrentInput. matcher is called with
+// the latest output of the slave when a line starts, see
+// DefaultSecretMatcher. The line is still written to the slave as typed.
+func WithSecretMask(matcher func(recentOutput []byte) bool) Option {
+	return func(wt *WebTTY) error {
+		wt.secretMatcher = matcher
+		return nil
+	}
+}
+
+// WithMaxTerminalSize caps the size of the terminal requested by the master,
+// larger sizes are reduced to it. The default is DefaultMaxTerminalColumns
+// by DefaultMaxTerminalRows.
+func WithMaxTerminalSize(columns int, rows int) Option {
+	return func(wt *WebTTY) error {
+		if columns <= 0 || rows <= 0 {
+			return errors.New("max terminal size must be positive")
+		}
+		wt.maxColumns = columns
+		wt.maxRows = rows
+		return nil
+	}
+}
+
+// WithMaxFrameSize splits output into Output frames of at most size bytes,
+// type byte included, for proxies dropping larger frames. Each frame can be
+// decoded on its own. Zero, the default, sends each read of the slave
+// in a single frame.
+func WithMaxFrameSize(size int) Option {
+	return func(wt *WebTTY) error {
+		if size < 0 {
+			return errors.New("max frame size must not be negative")
+		}
+		wt.maxFrameSize = size
+		return nil
+	}
+}
+
+// WithDrainTimeout makes Run forward the output the slave has already
+// buffered for up to d when its context is canceled, before returning.
+// The pending read of the master is interrupted right away and the read of
+// the slave at the end of the drain, as with WithInterruptReadsOnCancel.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if d < 0 {
+			return errors.New("drain timeout must not be negative")
+		}
+		wt.drainTimeout = d
+		return nil
+	}
+}
+
+// WithInputFilter blocks the command lines for which filter returns an error,
+// for example to keep dangerous commands from reaching the slave.
+// It enables the line-buffered input mode of WithCommandRewriter, so that
+// nothing of a line is forwarded before filter accepts it, and filter sees
+// the line after it has been rewritten. A blocked line is dropped and the
+// CommandBlocked message is printed on the master with the error.
+// Input typed outside of a shell prompt is not filtered, nor is the rest
+// of a line once pending input is flushed by control keys such as arrows,
+// filter only sees the part typed before them.
+// Blocked lines are still recorded in the audit trail.
+func WithInputFilter(filter func(line string) error) Option {
+	return func(wt *WebTTY) error {
+		wt.inputFilter = filter
+		return nil
+	}
+}
+
+// RegisterHandler handles the messages of type msgType sent by the master
+// with handle, which receives the payload following the type byte.
+// An error returned by handle ends Run like a broken master.
+// Types reserved for the protocol can't be registered, and messages
+// of types without a handler are still rejected with an error.
+// handle runs on the read loop of the master, whether the master
+// is allowed to write or not.
+func RegisterHandler(msgType byte, handle func(wt *WebTTY, payload []byte) error) Option {
+	return func(wt *WebTTY) error {
+		if reservedMessageType(msgType) {
+			return errors.Errorf("message type `%c` is reserved", msgType)
+		}
+		if handle == nil {
+			return errors.New("message handler must not be nil")
+		}
+		if wt.messageHandlers == nil {
+			wt.messageHandlers = make(map[byte]func(wt *WebTTY, payload []byte) error)
+		}
+		wt.messageHandlers[msgType] = handle
+		return nil
+	}
+}
+
+// WithCompression sends output longer than minBytes compressed with gzip
+// as CompressedOutput, when the frame gets smaller than as Output.
+// It enables the Compression feature on this end, the master has to
+// advertise it too, see WithClientFeatures. Once negotiated, the master is
+// told with a SetCompression message before any compressed output.
+// Observers and masters attached with Reattach receive the same frames and
+// must support it as well. The compression ratio is reported in Stats and
+// to the Metrics of the session.
+func WithCompression(minBytes int) Option {
+	return func(wt *WebTTY) error {
+		if minBytes < 0 {
+			return errors.New("compression threshold must not be negative")
+		}
+		wt.compressor = &outputCompressor{minBytes: minBytes}
+		wt.serverFeatures.Compression = true
+		return nil
+	}
+}
+
+// WithCommandPolicy rejects the command lines typed by the master that
+// policy doesn't permit, like WithInputFilter with policy.Check.
+// The policy guards against mistakes, it can be bypassed by scripts,
+// aliases or input typed outside of a shell prompt.
+func WithCommandPolicy(policy *CommandPolicy) Option {
+	return WithInputFilter(policy.Check)
+}
+
+// WithWorkingDirTracking tracks the working directory reported by the
+// shell with OSC 7 sequences, `ESC ] 7 ; file://host/path BEL`, and records
+// it in the audit events. Shells such as bash need to be configured to
+// print them from their prompt, the sequences are left in the output.
+func WithWorkingDirTracking() Option {
+	return func(wt *WebTTY) error {
+		wt.workingDirTracking = true
+		return nil
+	}
+}
+
+// WithBinaryFrames sends the payload of Output and CompressedOutput frames
+// as raw bytes instead of base64 text to masters advertising the
+// BinaryFrames feature, see WithClientFeatures. Masters still get base64
+// otherwise. Masters carrying text messages, such as websockets, should
+// write these frames as binary messages. Observers and masters attached
+// with Reattach receive the same frames and must support it as well.
+func WithBinaryFrames() Option {
+	return func(wt *WebTTY) error {
+		wt.serverFeatures.BinaryFrames = true
+		return nil
+	}
+}
+
+// WithFlowControl pauses the reads of the slave while the master has
+// more than window bytes of output it didn't acknowledge with
+// AcknowledgeOutput messages, so that a command printing faster than the
+// master can process blocks on the PTY instead of filling the buffers of
+// the connection. It enables the FlowControl feature on this end, output
+// is not paused for masters not advertising it, see WithClientFeatures.
+// Observers are not waited for.
+func WithFlowControl(window int) Option {
+	return func(wt *WebTTY) error {
+		if window <= 0 {
+			return errors.New("flow control window must be positive")
+		}
+		wt.flowWindow = newFlowWindow(window)
+		wt.serverFeatures.FlowControl = true
+		return nil
+	}
+}
+
+// WithOutputRateLimit throttles the output of the slave forwarded to the
+// master to bytesPerSec bytes per second on average, with bursts of up to
+// burst bytes sent at once. The slave is not read meanwhile, so that the
+// PTY slows the command down.
+func WithOutputRateLimit(bytesPerSec int, burst int) Option {
+	return func(wt *WebTTY) error {
+		if bytesPerSec <= 0 || burst <= 0 {
+			return errors.New("output rate limit and burst must be positive")
+		}
+		wt.outputLimiter = newRateLimiter(bytesPerSec, burst, time.Now())
+		return nil
+	}
+}
+
+// WithOutputTruncation drops the output of the slave beyond threshold
+// bytes within a burst, output read without the slave pausing for a
+// second, and prints the OutputTruncated message once the burst is cut.
+// The command keeps running, its output is not recorded, audited or
+// replayed either. It protects the master from accidental dumps.
+func WithOutputTruncation(threshold int) Option {
+	return func(wt *WebTTY) error {
+		if threshold <= 0 {
+			return errors.New("output truncation threshold must be positive")
+		}
+		wt.outputTruncator = &outputTruncation{threshold: threshold}
+		return nil
+	}
+}
+
+// WithReattachTimeout sets how long Run waits for Reattach once the reads
+// of the master fail, keeping the slave alive meanwhile. The default is
+// the reconnect time set with WithReconnect and a few more seconds.
+// It requires WithReplayBuffer.
+func WithReattachTimeout(timeout time.Duration) Option {
+	return func(wt *WebTTY) error {
+		if timeout <= 0 {
+			return errors.New("reattach timeout must be positive")
+		}
+		wt.reattachTimeout = timeout
+		return nil
+	}
+}
+
+// WithReattachToken sends token to the master in a SetReattachToken
+// message on initialization, for the master to prove it owns the session
+// when it reconnects. Checking the token and calling Reattach is up to
+// the caller. It requires WithReplayBuffer.
+func WithReattachToken(token string) Option {
+	return func(wt *WebTTY) error {
+		wt.reattachToken = token
+		return nil
+	}
+}
+
+// WithIdentity sets the identity of the user on the master, which also
+// becomes the user of the session unless the context given to Run sets one.
+func WithIdentity(identity Identity) Option {
+	return func(wt *WebTTY) error {
+		wt.identity = identity
+		wt.session.User = identity.User
+		return nil
+	}
+}
+
+// WithFileTransfer lets the master upload files to and download files
+// from the host with FileTransfer messages, within the directories
+// allowed by config. Files are sent in chunks and checked with their
+// SHA-256, each transfer is recorded in the audit trail. Uploads are
+// written to a temporary file renamed once complete, and also require
+// the permission to write.
+func WithFileTransfer(config FileTransferConfig) Option {
+	return func(wt *WebTTY) error {
+		ft, err := newFileTransfers(config)
+		if err != nil {
+			return err
+		}
+		wt.fileTransfers = ft
+		return nil
+	}
+}
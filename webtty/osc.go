@@ -0,0 +1,147 @@
+package webtty
+
+// maxPendingOSC bounds the bytes held back while waiting for the end of
+// an OSC sequence split across reads, longer sequences are passed through
+// unless removed by the drop function of the scanner.
+const maxPendingOSC = 4096
+
+// C1 controls encoded in UTF-8, which terminals also take for
+// the introducer and the terminator of OSC sequences.
+const (
+	c1Lead = 0xc2
+	c1OSC  = 0x9d
+	c1ST   = 0x9c
+)
+
+// oscScanner finds OSC (Operating System Command) sequences,
+// `ESC ] payload BEL` or `ESC ] payload ESC \`, in the output of the slave.
+// The introducer and the terminator may also be their C1 forms in UTF-8.
+// Sequences split across reads are held back until they are complete.
+type oscScanner struct {
+	// handle is called with the payload of each sequence,
+	// the sequence is removed from the output when it returns false
+	handle func(payload []byte) bool
+	// drop is called with the beginning of the payload of sequences
+	// longer than maxPendingOSC, which are removed up to their end
+	// when it returns true, nil to pass them through
+	drop func(prefix []byte) bool
+
+	pending []byte
+	output  []byte
+	// a sequence is being removed, lead is its last byte when it may
+	// start the terminator
+	discarding bool
+	lead       byte
+}
+
+func newOSCScanner(handle func(payload []byte) bool) *oscScanner {
+	return &oscScanner{handle: handle}
+}
+
+// scan returns data without the removed sequences.
+// The returned slice is only valid until the next call.
+func (scanner *oscScanner) scan(data []byte) []byte {
+	if len(scanner.pending) > 0 {
+		data = append(scanner.pending, data...)
+		scanner.pending = nil
+	}
+
+	scanner.output = scanner.output[:0]
+	if scanner.discarding {
+		data = scanner.skip(data)
+	}
+	for {
+		start := indexEscape(data)
+		if start < 0 {
+			scanner.output = append(scanner.output, data...)
+			return scanner.output
+		}
+		scanner.output = append(scanner.output, data[:start]...)
+		data = data[start:]
+
+		if len(data) < 2 {
+			scanner.hold(data)
+			return scanner.output
+		}
+		if !(data[0] == 0x1b && data[1] == ']') && !(data[0] == c1Lead && data[1] == c1OSC) {
+			scanner.output = append(scanner.output, data[:1]...)
+			data = data[1:]
+			continue
+		}
+
+		payload, length, ok := parseOSC(data)
+		if !ok {
+			scanner.hold(data)
+			return scanner.output
+		}
+		if scanner.handle(payload) {
+			scanner.output = append(scanner.output, data[:length]...)
+		}
+		data = data[length:]
+	}
+}
+
+// indexEscape returns the index of the first byte of data that may start
+// an OSC sequence, -1 when none.
+func indexEscape(data []byte) int {
+	for i, b := range data {
+		if b == 0x1b || b == c1Lead {
+			return i
+		}
+	}
+	return -1
+}
+
+// hold keeps an incomplete sequence for the next read. When it gets too
+// long, it gives up and passes it through, or removes it up to its end.
+func (scanner *oscScanner) hold(data []byte) {
+	if len(data) <= maxPendingOSC {
+		scanner.pending = append([]byte{}, data...)
+		return
+	}
+	if scanner.drop == nil || !scanner.drop(data[2:]) {
+		scanner.output = append(scanner.output, data...)
+		return
+	}
+	scanner.discarding = true
+	scanner.lead = 0
+	if last := data[len(data)-1]; last == 0x1b || last == c1Lead {
+		scanner.lead = last
+	}
+}
+
+// skip returns the rest of data after the end of the sequence being
+// removed, nothing when its end is not in data.
+func (scanner *oscScanner) skip(data []byte) []byte {
+	for i, b := range data {
+		if (scanner.lead == 0x1b && b == '\\') || (scanner.lead == c1Lead && b == c1ST) || b == 0x07 {
+			scanner.discarding = false
+			scanner.lead = 0
+			return data[i+1:]
+		}
+		scanner.lead = 0
+		if b == 0x1b || b == c1Lead {
+			scanner.lead = b
+		}
+	}
+	return nil
+}
+
+// parseOSC parses an OSC sequence at the beginning of data.
+// It returns the payload and the length of the whole sequence.
+func parseOSC(data []byte) ([]byte, int, bool) {
+	for i := 2; i < len(data); i++ {
+		switch data[i] {
+		case 0x07:
+			return data[2:i], i + 1, true
+		case 0x1b, c1Lead:
+			if i+1 >= len(data) {
+				return nil, 0, false
+			}
+			if (data[i] == 0x1b && data[i+1] == '\\') || (data[i] == c1Lead && data[i+1] == c1ST) {
+				return data[2:i], i + 2, true
+			}
+		}
+	}
+	return nil, 0, false
+}
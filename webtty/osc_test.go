@@ -0,0 +1,120 @@
+package webtty
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOSCScannerSplitSequence(t *testing.T) {
+	var payloads []string
+	scanner := newOSCScanner(func(payload []byte) bool {
+		payloads = append(payloads, string(payload))
+		return false
+	})
+
+	output := string(scanner.scan([]byte("foo\x1b]52;c;aGVs")))
+	output += string(scanner.scan([]byte("bG8=\x1b\\bar\x1b[1m\x1b]0;title\x07")))
+
+	if output != "foobar\x1b[1m" {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+	if len(payloads) != 2 || payloads[0] != "52;c;aGVsbG8=" || payloads[1] != "0;title" {
+		t.Fatalf("Unexpected payloads: %q", payloads)
+	}
+}
+
+func TestOSCScannerC1Sequence(t *testing.T) {
+	var payloads []string
+	scanner := newOSCScanner(func(payload []byte) bool {
+		payloads = append(payloads, string(payload))
+		return false
+	})
+
+	output := string(scanner.scan([]byte("£\xc2\x9d52;c;aGVs")))
+	output += string(scanner.scan([]byte("bG8=\xc2\x9c£\x1b]0;title\xc2")))
+	output += string(scanner.scan([]byte("\x9c")))
+
+	if output != "££" {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+	if len(payloads) != 2 || payloads[0] != "52;c;aGVsbG8=" || payloads[1] != "0;title" {
+		t.Fatalf("Unexpected payloads: %q", payloads)
+	}
+}
+
+func TestOSCScannerLongSequence(t *testing.T) {
+	scanner := newOSCScanner(func(payload []byte) bool { return false })
+	scanner.drop = func(prefix []byte) bool { return strings.HasPrefix(string(prefix), "52;") }
+
+	long := strings.Repeat("A", maxPendingOSC)
+	output := string(scanner.scan([]byte("foo\x1b]52;c;" + long)))
+	output += string(scanner.scan([]byte(long + "\x1b")))
+	output += string(scanner.scan([]byte("\\bar\x1b]2;" + long)))
+	output += string(scanner.scan([]byte("\x07")))
+
+	// long sequences not dropped are passed through
+	if output != "foobar\x1b]2;"+long+"\x07" {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+}
+
+func TestClipboardPolicy(t *testing.T) {
+	// stripped by default
+	dt, _ := New(discardMaster{}, &pipeSlave{})
+	if dt.oscScanner == nil || dt.handleOSC([]byte("52;c;aGVsbG8=")) {
+		t.Fatalf("Expected OSC 52 to be stripped")
+	}
+	if !dt.handleOSC([]byte("0;title")) {
+		t.Fatalf("Expected other OSC sequences to be kept")
+	}
+
+	dt, _ = New(discardMaster{}, &pipeSlave{}, WithClipboardPolicy(ClipboardPassthrough))
+	if dt.oscScanner != nil || !dt.handleOSC([]byte("52;c;aGVsbG8=")) {
+		t.Fatalf("Expected OSC 52 to be passed through")
+	}
+
+	frames := &frameRecorder{}
+	dt, _ = New(recordingMaster{frames}, &pipeSlave{}, WithClipboardPolicy(ClipboardForward))
+	if dt.handleOSC([]byte("52;c;?")) || dt.handleOSC([]byte("52;c;aGVsbG8=")) {
+		t.Fatalf("Expected OSC 52 to be removed from output")
+	}
+	got := frames.get()
+	if len(got) != 1 || got[0] != `8{"selection":"c","data":"aGVsbG8="}` {
+		t.Fatalf("Unexpected frames: %q", got)
+	}
+}
+
+func TestTitleTracking(t *testing.T) {
+	frames := &frameRecorder{}
+	dt, err := New(recordingMaster{frames}, &pipeSlave{}, WithTitleTracking())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	reads := []string{
+		"$ \x1b]0;user@ho", "st: ~\x07ls\r\n",
+		"\x1b]2;vim\x1b", "\\\x1b]52;c;aGVsbG8=\x07\x1b]2;vim\x07",
+	}
+	for _, read := range reads {
+		if err := dt.handleSlaveReadEvent([]byte(read)); err != nil {
+			t.Fatalf("Unexpected error from handleSlaveReadEvent(): %s", err)
+		}
+	}
+
+	var titles []string
+	for _, frame := range frames.get() {
+		if frame[0] == SetWindowTitle {
+			titles = append(titles, frame[1:])
+		}
+	}
+	// the same title is sent once
+	if len(titles) != 2 || titles[0] != "user@host: ~" || titles[1] != "vim" {
+		t.Fatalf("Unexpected titles: %q", titles)
+	}
+
+	observer := &frameRecorder{}
+	dt.AddObserver(recordingMaster{observer})
+	if first := observer.get()[0]; first != string(SetWindowTitle)+"vim" {
+		t.Fatalf("Unexpected title for observer: %q", first)
+	}
+}
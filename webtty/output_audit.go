@@ -0,0 +1,116 @@
+package webtty
+
+import (
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// outputMarker prefixes the output of the slave in the audit trail.
+const outputMarker = "[output] "
+
+const (
+	// DefaultFullCaptureInterval is the default interval between
+	// output entries recorded by WithFullCapture.
+	DefaultFullCaptureInterval = time.Second
+	// DefaultFullCaptureMaxBytes is the default maximum size
+	// of each output entry recorded by WithFullCapture.
+	DefaultFullCaptureMaxBytes = 1024
+)
+
+// outputAudit samples the output of the slave into the audit trail.
+// Output is stripped of escape sequences and accumulated, then recorded
+// at most once per interval and truncated to maxBytes, the rest of
+// the output of the interval is counted but not recorded.
+type outputAudit struct {
+	interval time.Duration
+	maxBytes int
+	record   func(log string)
+
+	mutex     sync.Mutex
+	text      []byte
+	truncated int
+	timer     *time.Timer
+}
+
+func newOutputAudit(interval time.Duration, maxBytes int, record func(log string)) *outputAudit {
+	return &outputAudit{
+		interval: interval,
+		maxBytes: maxBytes,
+		record:   record,
+	}
+}
+
+// observe accumulates output of the slave.
+func (oa *outputAudit) observe(data []byte) {
+	oa.mutex.Lock()
+	defer oa.mutex.Unlock()
+
+	text := summarizeOutput(data)
+	if len(text) == 0 {
+		return
+	}
+	if room := oa.maxBytes - len(oa.text); room < len(text) {
+		if room < 0 {
+			room = 0
+		}
+		// cut on a character boundary
+		for room > 0 && !utf8.RuneStart(text[room]) {
+			room--
+		}
+		oa.truncated += len(text) - room
+		text = text[:room]
+	}
+	oa.text = append(oa.text, text...)
+
+	if oa.timer == nil {
+		oa.timer = time.AfterFunc(oa.interval, oa.flush)
+	}
+}
+
+// flush records the accumulated output, if any.
+func (oa *outputAudit) flush() {
+	oa.mutex.Lock()
+	if oa.timer != nil {
+		oa.timer.Stop()
+		oa.timer = nil
+	}
+	text, truncated := string(oa.text), oa.truncated
+	oa.text, oa.truncated = oa.text[:0], 0
+	oa.mutex.Unlock()
+
+	if text == "" && truncated == 0 {
+		return
+	}
+	log := outputMarker + text
+	if truncated > 0 {
+		log += " [" + strconv.Itoa(truncated) + " bytes truncated]"
+	}
+	oa.record(log)
+}
+
+// summarizeOutput returns the text of data on one line,
+// without escape sequences and control characters.
+func summarizeOutput(data []byte) []byte {
+	data = stripInputEscapes(nil, data)
+
+	text := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		switch b := data[i]; {
+		case b == 0x1b:
+			i += escapeLength(data[i:]) - 1
+		case b == '\n':
+			text = append(text, `\n`...)
+		case b == '\t' || b >= 0x20 && b != 0x7f:
+			text = append(text, b)
+		}
+	}
+	return text
+}
+
+// auditOutput records sampled output with the identity of the session.
+func (wt *WebTTY) auditOutput(log string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, log)
+}
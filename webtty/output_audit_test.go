@@ -0,0 +1,36 @@
+package webtty
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeOutput(t *testing.T) {
+	output := "\x1b]0;title\x07\x1b[1;32muser@host\x1b[0m:~$ ls\r\nbin\tetc\r\n"
+	if text := string(summarizeOutput([]byte(output))); text != `user@host:~$ ls\nbin	etc\n` {
+		t.Fatalf("Unexpected summary: %q", text)
+	}
+}
+
+func TestOutputAudit(t *testing.T) {
+	logs := make(chan string, 2)
+	oa := newOutputAudit(20*time.Millisecond, 8, func(log string) { logs <- log })
+
+	oa.observe([]byte("hello "))
+	oa.observe([]byte("wörld\r\n"))
+	if log := <-logs; log != "[output] hello w [7 bytes truncated]" {
+		t.Fatalf("Unexpected output entry: %q", log)
+	}
+
+	oa.observe([]byte("bye"))
+	oa.flush()
+	if log := <-logs; log != "[output] bye" {
+		t.Fatalf("Unexpected output entry: %q", log)
+	}
+	oa.flush()
+	select {
+	case log := <-logs:
+		t.Fatalf("Unexpected entry without output: %q", log)
+	default:
+	}
+}
@@ -0,0 +1,170 @@
+package webtty
+
+import (
+	"bytes"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// inspectionMarker prefixes the findings of the output inspector
+// in the audit trail.
+const inspectionMarker = "[output-inspection] "
+
+const (
+	// DefaultOutputInspectionDelay is the default time the end of
+	// the output, not terminated by a line break, is held for the rest
+	// of its line before it is inspected and sent anyway.
+	DefaultOutputInspectionDelay = 20 * time.Millisecond
+	// MaxOutputInspectionLine is the size of the longest line given to
+	// an output inspector, longer lines are inspected in several parts.
+	MaxOutputInspectionLine = 4096
+)
+
+// OutputInspector scans the output of the slave before it reaches the
+// master, the observers, the recordings and the audit trail, such as to
+// prevent the leak of sensitive data.
+type OutputInspector interface {
+	// Inspect is called with each line of output, its line break
+	// included when there's one. It returns the line to send, masked
+	// or as is, and the findings to record in the audit trail, such as
+	// "card number masked". The line may contain escape sequences.
+	Inspect(line []byte) (inspected []byte, findings []string)
+}
+
+// OutputInspectorFunc is an adapter to use a function as an OutputInspector.
+type OutputInspectorFunc func(line []byte) ([]byte, []string)
+
+func (f OutputInspectorFunc) Inspect(line []byte) ([]byte, []string) {
+	return f(line)
+}
+
+// outputInspection reassembles the output of the slave into lines for the
+// inspector, and forwards the inspected lines. A line is held until its
+// line break, or until no more output comes for delay.
+type outputInspection struct {
+	inspector OutputInspector
+	delay     time.Duration
+	forward   func(data []byte) error
+	audit     func(finding string)
+
+	mutex   sync.Mutex
+	pending []byte
+	timer   *time.Timer
+	err     error
+	closed  bool
+}
+
+func newOutputInspection(
+	inspector OutputInspector,
+	delay time.Duration,
+	forward func(data []byte) error,
+	audit func(finding string),
+) *outputInspection {
+	return &outputInspection{
+		inspector: inspector,
+		delay:     delay,
+		forward:   forward,
+		audit:     audit,
+	}
+}
+
+// write inspects and forwards the complete lines of data, and holds the
+// rest of the output. It returns an error when a previous timed flush
+// has failed.
+func (oi *outputInspection) write(data []byte) error {
+	oi.mutex.Lock()
+	defer oi.mutex.Unlock()
+
+	if oi.err != nil {
+		return oi.err
+	}
+	oi.pending = append(oi.pending, data...)
+
+	var inspected []byte
+	for {
+		end := bytes.IndexByte(oi.pending, '\n') + 1
+		if end == 0 {
+			if len(oi.pending) < MaxOutputInspectionLine {
+				break
+			}
+			// cut on a character boundary
+			end = MaxOutputInspectionLine
+			for end > 0 && !utf8.RuneStart(oi.pending[end]) {
+				end--
+			}
+			if end == 0 {
+				end = MaxOutputInspectionLine
+			}
+		}
+		inspected = append(inspected, oi.inspect(oi.pending[:end])...)
+		oi.pending = oi.pending[end:]
+	}
+	oi.pending = append([]byte(nil), oi.pending...)
+
+	if oi.timer != nil {
+		oi.timer.Stop()
+		oi.timer = nil
+	}
+	if len(oi.pending) > 0 {
+		if oi.closed {
+			inspected = append(inspected, oi.inspect(oi.pending)...)
+			oi.pending = nil
+		} else {
+			oi.timer = time.AfterFunc(oi.delay, oi.flush)
+		}
+	}
+
+	if len(inspected) == 0 {
+		return nil
+	}
+	oi.err = oi.forward(inspected)
+	return oi.err
+}
+
+// flush inspects and forwards the output held, if any.
+func (oi *outputInspection) flush() {
+	oi.mutex.Lock()
+	defer oi.mutex.Unlock()
+
+	oi.flushLocked()
+}
+
+// close flushes the output held and returns the last forward error.
+// Output written after close is not held.
+func (oi *outputInspection) close() error {
+	oi.mutex.Lock()
+	defer oi.mutex.Unlock()
+
+	oi.flushLocked()
+	oi.closed = true
+	return oi.err
+}
+
+func (oi *outputInspection) flushLocked() {
+	if oi.timer != nil {
+		oi.timer.Stop()
+		oi.timer = nil
+	}
+	if len(oi.pending) == 0 || oi.err != nil {
+		return
+	}
+	inspected := oi.inspect(oi.pending)
+	oi.pending = nil
+	oi.err = oi.forward(inspected)
+}
+
+func (oi *outputInspection) inspect(line []byte) []byte {
+	inspected, findings := oi.inspector.Inspect(line)
+	for _, finding := range findings {
+		oi.audit(finding)
+	}
+	return inspected
+}
+
+// auditInspection records a finding of the output inspector
+// with the identity of the session.
+func (wt *WebTTY) auditInspection(finding string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, inspectionMarker+finding)
+}
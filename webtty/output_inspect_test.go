@@ -0,0 +1,80 @@
+package webtty
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOutputInspection(t *testing.T) {
+	var mutex sync.Mutex
+	var lines, findings []string
+	inspector := OutputInspectorFunc(func(line []byte) ([]byte, []string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		lines = append(lines, string(line))
+		if bytes.Contains(line, []byte("secret")) {
+			return bytes.Replace(line, []byte("secret"), []byte("***"), -1), []string{"secret masked"}
+		}
+		return line, nil
+	})
+	fr := &frameRecorder{}
+	audit := func(finding string) { findings = append(findings, finding) }
+	oi := newOutputInspection(inspector, 10*time.Millisecond, fr.send, audit)
+
+	for _, chunk := range []string{"the sec", "ret is\r\nhere\r", "\n$ "} {
+		if err := oi.write([]byte(chunk)); err != nil {
+			t.Fatalf("Unexpected error from write(): %s", err)
+		}
+	}
+	if frames := fr.get(); strings.Join(frames, "") != "the *** is\r\nhere\r\n" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	// the prompt is sent once no more output comes
+	time.Sleep(50 * time.Millisecond)
+	if frames := fr.get(); len(frames) != 3 || frames[2] != "$ " {
+		t.Fatalf("Unexpected frames after delay: %q", frames)
+	}
+
+	if err := oi.write([]byte(strings.Repeat("x", MaxOutputInspectionLine+10))); err != nil {
+		t.Fatalf("Unexpected error from write(): %s", err)
+	}
+	if err := oi.close(); err != nil {
+		t.Fatalf("Unexpected error from close(): %s", err)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(lines) != 5 || len(lines[3]) != MaxOutputInspectionLine || len(lines[4]) != 10 {
+		t.Fatalf("Unexpected inspected lines: %d", len(lines))
+	}
+	if len(findings) != 1 || findings[0] != "secret masked" {
+		t.Fatalf("Unexpected findings: %q", findings)
+	}
+}
+
+func TestWithOutputInspector(t *testing.T) {
+	var findings []string
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithOutputInspector(NewDLPInspector(true)),
+		withAuditLines(func(line string, requestID string) { findings = append(findings, line) }))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.outputInspection = newOutputInspection(dt.outputInspector, dt.inspectionDelay, dt.forwardOutput, dt.auditInspection)
+
+	if err := dt.handleSlaveReadEvent([]byte("4111 1111 1111 1111\r\n")); err != nil {
+		t.Fatalf("Unexpected error from handleSlaveReadEvent(): %s", err)
+	}
+	expected := string(Output) + base64.StdEncoding.EncodeToString([]byte("**** **** **** 1111\r\n"))
+	if frames := rec.get(); len(frames) != 1 || frames[0] != expected {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	if len(findings) != 1 || !strings.Contains(findings[0], inspectionMarker+"card number masked") {
+		t.Fatalf("Unexpected audit lines: %q", findings)
+	}
+}
@@ -0,0 +1,45 @@
+package webtty
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// outputBurstPause is how long the slave has to stay quiet for its next
+// output to start a new burst, see WithOutputTruncation.
+const outputBurstPause = time.Second
+
+// outputTruncation drops the output of a burst beyond its threshold.
+// It is used by the slave read loop only.
+type outputTruncation struct {
+	threshold int
+
+	burst   int
+	dropped bool
+}
+
+// admit returns the part of data forwarded to the master, and whether
+// the burst got truncated by data. blocked is how long the read of data
+// waited for the slave.
+func (ot *outputTruncation) admit(data []byte, blocked time.Duration) ([]byte, bool) {
+	if blocked >= outputBurstPause {
+		ot.burst = 0
+		ot.dropped = false
+	}
+	if ot.dropped {
+		return nil, false
+	}
+	if ot.burst+len(data) <= ot.threshold {
+		ot.burst += len(data)
+		return data, false
+	}
+
+	keep := ot.threshold - ot.burst
+	// don't cut a rune in the middle
+	for keep > 0 && !utf8.RuneStart(data[keep]) {
+		keep--
+	}
+	ot.burst = ot.threshold
+	ot.dropped = true
+	return data[:keep], true
+}
@@ -0,0 +1,95 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOutputTruncationAdmit(t *testing.T) {
+	ot := &outputTruncation{threshold: 10}
+	cases := []struct {
+		data      string
+		blocked   time.Duration
+		expected  string
+		truncated bool
+	}{
+		{"12345", 0, "12345", false},
+		{"678901234", 0, "67890", true},
+		{"x", time.Millisecond, "", false},
+		// a pause starts a new burst
+		{"abc", 2 * time.Second, "abc", false},
+		{"defgh€", 0, "defgh", true},
+	}
+	for i, c := range cases {
+		kept, truncated := ot.admit([]byte(c.data), c.blocked)
+		if string(kept) != c.expected || truncated != c.truncated {
+			t.Fatalf("Unexpected result for case %d: %q, %v", i, kept, truncated)
+		}
+	}
+}
+
+func runOutputLimited(t *testing.T, output string, options ...Option) (*frameRecorder, func()) {
+	slaveReader, slaveWriter := io.Pipe()
+	rec := &frameRecorder{}
+	options = append(options, WithOutputEncoding(EncodingRaw))
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, options...)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go dt.Run(ctx)
+	go slaveWriter.Write([]byte(output))
+	return rec, cancel
+}
+
+func outputOf(frames []string) string {
+	output := ""
+	for _, frame := range frames {
+		if frame[0] == Output {
+			output += frame[1:]
+		}
+	}
+	return output
+}
+
+func TestOutputRateLimit(t *testing.T) {
+	output := strings.Repeat("x", 3000)
+	start := time.Now()
+	rec, cancel := runOutputLimited(t, output, WithOutputRateLimit(20000, 1000), WithBufferSize(500))
+	defer cancel()
+
+	for deadline := time.Now().Add(2 * time.Second); outputOf(rec.get()) != output; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Unexpected output: %d bytes", len(outputOf(rec.get())))
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// 2000 bytes beyond the burst at 20000 bytes per second
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("Output sent too fast: %s", elapsed)
+	}
+}
+
+func TestOutputTruncation(t *testing.T) {
+	rec, cancel := runOutputLimited(t, "abcdefgh", WithOutputTruncation(4))
+	defer cancel()
+
+	expected := "abcd\r\n" + DefaultMessages.OutputTruncated + "\r\n"
+	for deadline := time.Now().Add(time.Second); outputOf(rec.get()) != expected; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Unexpected output: %q", outputOf(rec.get()))
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithOutputTruncation(0)); err == nil {
+		t.Fatalf("Expected an error for a zero threshold")
+	}
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithOutputRateLimit(0, 1)); err == nil {
+		t.Fatalf("Expected an error for a zero rate")
+	}
+}
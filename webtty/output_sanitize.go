@@ -0,0 +1,285 @@
+package webtty
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// EscapeClass is a class of escape sequences removed from the output of
+// the slave by WithOutputSanitizer.
+type EscapeClass string
+
+const (
+	// EscapeTitles are the OSC 0, 1 and 2 sequences setting the window
+	// title and the icon name, which terminals may report back as input.
+	EscapeTitles EscapeClass = "titles"
+	// EscapeReports are the sequences making terminals answer with data
+	// taken from the output: the reports of the window title and the icon
+	// name, CSI 20 t and CSI 21 t, the DECRQSS and XTGETTCAP requests,
+	// DCS $ q and DCS + q, and the OSC queries of colors, fonts or
+	// the clipboard, ending with `?`.
+	EscapeReports EscapeClass = "reports"
+	// EscapeFiles are the OSC sequences transferring files to and from
+	// the terminal, 1337 File= of iTerm2 and 5113 of kitty.
+	EscapeFiles EscapeClass = "files"
+	// EscapeWindow are the window manipulations and reports,
+	// CSI 1 t to CSI 19 t, moving, resizing or iconifying the window.
+	EscapeWindow EscapeClass = "window"
+)
+
+// EscapeClasses are all the classes of escape sequences, removed by
+// WithOutputSanitizer when it is given none.
+var EscapeClasses = []EscapeClass{EscapeTitles, EscapeReports, EscapeFiles, EscapeWindow}
+
+// ParseEscapeClass returns the class of escape sequences named name,
+// one of "titles", "reports", "files" or "window".
+func ParseEscapeClass(name string) (EscapeClass, error) {
+	for _, class := range EscapeClasses {
+		if name == string(class) {
+			return class, nil
+		}
+	}
+	return "", errors.Errorf("unknown escape sequence class `%s`", name)
+}
+
+// C1 controls encoded in UTF-8 introducing CSI and DCS sequences,
+// see c1OSC and c1ST.
+const (
+	c1CSI = 0x9b
+	c1DCS = 0x90
+)
+
+var (
+	iterm2FilePrefixes = [][]byte{[]byte("File="), []byte("MultipartFile="), []byte("FilePart=")}
+	// the parameters of the OSC queries
+	oscQuery = []byte("?")
+)
+
+// outputSanitizer removes the escape sequences of its classes from the
+// output of the slave. Their introducers and terminators may also be
+// their C1 forms in UTF-8. Sequences split across reads are held back
+// until they are complete, the control strings longer than maxPendingOSC
+// are decided with their beginning.
+type outputSanitizer struct {
+	classes map[EscapeClass]bool
+
+	pending []byte
+	output  []byte
+	// a control string is being removed, lead is its last byte when it may
+	// start the terminator, bell whether BEL terminates it
+	discarding bool
+	lead       byte
+	bell       bool
+}
+
+func newOutputSanitizer(classes []EscapeClass) *outputSanitizer {
+	sanitizer := &outputSanitizer{classes: make(map[EscapeClass]bool)}
+	for _, class := range classes {
+		sanitizer.classes[class] = true
+	}
+	return sanitizer
+}
+
+// scan returns data without the removed sequences.
+// The returned slice is only valid until the next call.
+func (sanitizer *outputSanitizer) scan(data []byte) []byte {
+	if len(sanitizer.pending) > 0 {
+		data = append(sanitizer.pending, data...)
+		sanitizer.pending = nil
+	}
+
+	sanitizer.output = sanitizer.output[:0]
+	if sanitizer.discarding {
+		data = sanitizer.skip(data)
+	}
+	for {
+		start := indexEscape(data)
+		if start < 0 {
+			sanitizer.output = append(sanitizer.output, data...)
+			return sanitizer.output
+		}
+		sanitizer.output = append(sanitizer.output, data[:start]...)
+		data = data[start:]
+
+		if len(data) < 2 {
+			sanitizer.hold(data, 0)
+			return sanitizer.output
+		}
+		kind := escapeIntroducer(data)
+		if kind == 0 {
+			sanitizer.output = append(sanitizer.output, data[:1]...)
+			data = data[1:]
+			continue
+		}
+
+		var length int
+		var remove, ok bool
+		if kind == '[' {
+			length, remove, ok = sanitizer.parseCSI(data)
+		} else {
+			var body []byte
+			body, length, ok = parseControlString(data, kind == ']')
+			remove = ok && sanitizer.removeString(kind, body, true)
+		}
+		if !ok {
+			sanitizer.hold(data, kind)
+			return sanitizer.output
+		}
+		if !remove {
+			sanitizer.output = append(sanitizer.output, data[:length]...)
+		}
+		data = data[length:]
+	}
+}
+
+// escapeIntroducer returns the final byte of the introducer of the CSI,
+// OSC or DCS sequence at the beginning of data, `[`, `]` or `P`, either in
+// its 7-bit or its C1 form, and 0 for other sequences.
+func escapeIntroducer(data []byte) byte {
+	if data[0] == 0x1b {
+		switch data[1] {
+		case '[', ']', 'P':
+			return data[1]
+		}
+		return 0
+	}
+	switch data[1] {
+	case c1CSI:
+		return '['
+	case c1OSC:
+		return ']'
+	case c1DCS:
+		return 'P'
+	}
+	return 0
+}
+
+// parseCSI parses the CSI sequence at the beginning of data. It returns
+// its length and whether it is removed, false when it is incomplete.
+// Malformed sequences are passed through up to the unexpected byte.
+func (sanitizer *outputSanitizer) parseCSI(data []byte) (int, bool, bool) {
+	for i := 2; i < len(data); i++ {
+		switch b := data[i]; {
+		case b >= 0x20 && b <= 0x3f:
+			// parameters and intermediates
+		case b >= 0x40 && b <= 0x7e:
+			return i + 1, sanitizer.removeCSI(data[2:i], b), true
+		default:
+			return i, false, true
+		}
+	}
+	return 0, false, false
+}
+
+func (sanitizer *outputSanitizer) removeCSI(params []byte, final byte) bool {
+	if final != 't' || len(params) == 0 || params[0] < '0' || params[0] > '9' {
+		return false
+	}
+	operation := 0
+	for _, b := range params {
+		if b < '0' || b > '9' {
+			break
+		}
+		operation = operation*10 + int(b-'0')
+		if operation > 100 {
+			return false
+		}
+	}
+	switch {
+	case operation == 20 || operation == 21:
+		return sanitizer.classes[EscapeReports]
+	case operation >= 1 && operation <= 19:
+		return sanitizer.classes[EscapeWindow]
+	}
+	return false
+}
+
+// parseControlString parses the OSC or DCS sequence at the beginning of
+// data, terminated by ST, or also BEL when bell is true. It returns its body
+// and the length of the whole sequence.
+func parseControlString(data []byte, bell bool) ([]byte, int, bool) {
+	for i := 2; i < len(data); i++ {
+		switch data[i] {
+		case 0x07:
+			if bell {
+				return data[2:i], i + 1, true
+			}
+		case 0x1b, c1Lead:
+			if i+1 >= len(data) {
+				return nil, 0, false
+			}
+			if (data[i] == 0x1b && data[i+1] == '\\') || (data[i] == c1Lead && data[i+1] == c1ST) {
+				return data[2:i], i + 2, true
+			}
+		}
+	}
+	return nil, 0, false
+}
+
+// removeString returns whether the OSC or DCS sequence of body is removed,
+// complete is false when body is only the beginning of a sequence too long
+// to be held back.
+func (sanitizer *outputSanitizer) removeString(kind byte, body []byte, complete bool) bool {
+	if kind == 'P' {
+		return sanitizer.classes[EscapeReports] && (bytes.HasPrefix(body, []byte("$q")) || bytes.HasPrefix(body, []byte("+q")))
+	}
+
+	fields := bytes.Split(body, []byte(";"))
+	switch string(fields[0]) {
+	case "0", "1", "2":
+		if sanitizer.classes[EscapeTitles] {
+			return true
+		}
+	case "5113":
+		if sanitizer.classes[EscapeFiles] {
+			return true
+		}
+	case "1337":
+		if sanitizer.classes[EscapeFiles] && len(fields) > 1 {
+			for _, prefix := range iterm2FilePrefixes {
+				if bytes.HasPrefix(fields[1], prefix) {
+					return true
+				}
+			}
+		}
+	}
+	return complete && sanitizer.classes[EscapeReports] && len(fields) > 1 && bytes.Equal(fields[len(fields)-1], oscQuery)
+}
+
+// hold keeps an incomplete sequence for the next read. When it gets too
+// long, it gives up and passes it through, or removes the control strings
+// up to their end.
+func (sanitizer *outputSanitizer) hold(data []byte, kind byte) {
+	if len(data) <= maxPendingOSC {
+		sanitizer.pending = append([]byte{}, data...)
+		return
+	}
+	if kind == 0 || kind == '[' || !sanitizer.removeString(kind, data[2:], false) {
+		sanitizer.output = append(sanitizer.output, data...)
+		return
+	}
+	sanitizer.discarding = true
+	sanitizer.bell = kind == ']'
+	sanitizer.lead = 0
+	if last := data[len(data)-1]; last == 0x1b || last == c1Lead {
+		sanitizer.lead = last
+	}
+}
+
+// skip returns the rest of data after the end of the control string being
+// removed, nothing when its end is not in data.
+func (sanitizer *outputSanitizer) skip(data []byte) []byte {
+	for i, b := range data {
+		if (sanitizer.lead == 0x1b && b == '\\') || (sanitizer.lead == c1Lead && b == c1ST) || (sanitizer.bell && b == 0x07) {
+			sanitizer.discarding = false
+			sanitizer.lead = 0
+			return data[i+1:]
+		}
+		sanitizer.lead = 0
+		if b == 0x1b || b == c1Lead {
+			sanitizer.lead = b
+		}
+	}
+	return nil
+}
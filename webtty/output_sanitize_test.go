@@ -0,0 +1,76 @@
+package webtty
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOutputSanitizer(t *testing.T) {
+	sanitizer := newOutputSanitizer(EscapeClasses)
+
+	for _, tc := range []struct {
+		input  string
+		output string
+	}{
+		{"a\x1b]0;pwned\x07b", "ab"},
+		{"a\x1b]2;pwned\x1b\\b", "ab"},
+		{"a\x1b[21tb\x1b[20;0t", "ab"},
+		{"a\x1b[8;100;100tb\x1b[22;0t\x1b[23;0t", "ab\x1b[22;0t\x1b[23;0t"},
+		{"a\x1bP$qm\x1b\\b\x1bP+q544e\x1b\\", "ab"},
+		{"a\x1b]11;?\x07\x1b]11;#ffffff\x07b", "a\x1b]11;#ffffff\x07b"},
+		{"a\x1b]1337;File=name=eA==:aGk=\x07\x1b]1337;SetMark\x07b", "a\x1b]1337;SetMark\x07b"},
+		{"\x1b[1;31mred\x1b[0m \x1b]7;file:///tmp\x07\x1b]8;;http://example.com\x07", "\x1b[1;31mred\x1b[0m \x1b]7;file:///tmp\x07\x1b]8;;http://example.com\x07"},
+		// C1 forms in UTF-8
+		{"£\xc2\x9d0;pwned\xc2\x9c£\xc2\x9b21t\xc2\x90$qm\xc2\x9c", "££"},
+		// malformed
+		{"\x1b[2\x01t", "\x1b[2\x01t"},
+	} {
+		if output := string(sanitizer.scan([]byte(tc.input))); output != tc.output {
+			t.Errorf("Unexpected output of %q: %q", tc.input, output)
+		}
+	}
+}
+
+func TestOutputSanitizerSplitSequence(t *testing.T) {
+	sanitizer := newOutputSanitizer([]EscapeClass{EscapeReports})
+
+	output := string(sanitizer.scan([]byte("foo\x1b[2")))
+	output += string(sanitizer.scan([]byte("1t\x1b]0;title\x07\x1bP$")))
+	output += string(sanitizer.scan([]byte("q\"p\x1b")))
+	output += string(sanitizer.scan([]byte("\\bar\x1b[3;0;0t")))
+
+	// titles and window manipulations are not of the classes
+	if output != "foo\x1b]0;title\x07bar\x1b[3;0;0t" {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+}
+
+func TestOutputSanitizerLongSequence(t *testing.T) {
+	sanitizer := newOutputSanitizer(EscapeClasses)
+
+	long := strings.Repeat("A", maxPendingOSC)
+	output := string(sanitizer.scan([]byte("foo\x1b]1337;File=name=eA==:" + long)))
+	output += string(sanitizer.scan([]byte(long + "\x07")))
+	output += string(sanitizer.scan([]byte("bar\x1bP$q" + long)))
+	output += string(sanitizer.scan([]byte(long + "\x07\x1b")))
+	output += string(sanitizer.scan([]byte("\\baz\x1b]8;;" + long)))
+	output += string(sanitizer.scan([]byte("\x07")))
+
+	// BEL doesn't terminate DCS, long sequences not removed are passed through
+	if output != "foobarbaz\x1b]8;;"+long+"\x07" {
+		t.Fatalf("Unexpected output: %q", output)
+	}
+}
+
+func TestWithOutputSanitizer(t *testing.T) {
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithOutputSanitizer("bells")); err == nil {
+		t.Fatalf("Expected an error for an unknown class")
+	}
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithOutputSanitizer())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if len(dt.outputSanitizer.classes) != len(EscapeClasses) {
+		t.Fatalf("Unexpected classes: %v", dt.outputSanitizer.classes)
+	}
+}
@@ -0,0 +1,139 @@
+package webtty
+
+import (
+	"time"
+)
+
+// SetPermitWrite grants or revokes write permission of the master.
+// It takes effect immediately, even while Run is active,
+// in which case the master is notified with a SetReadOnly message.
+// The master also receives the permission when the session starts.
+// The change is recorded in the audit trail with WithAuditLifecycle.
+func (wt *WebTTY) SetPermitWrite(permitWrite bool) {
+	if !wt.setPermitWrite(permitWrite) {
+		return
+	}
+	if permitWrite {
+		wt.auditLifecycle(permissionMarker + "write granted")
+	} else {
+		wt.auditLifecycle(permissionMarker + "write revoked")
+	}
+}
+
+// setPermitWrite is SetPermitWrite without the audit, it returns true
+// when the permission changed while the session is running.
+func (wt *WebTTY) setPermitWrite(permitWrite bool) bool {
+	wt.stateMutex.Lock()
+	changed := wt.setPermitWriteLocked(permitWrite)
+	running := wt.running
+	wt.stateMutex.Unlock()
+
+	if changed {
+		wt.notifyPermitWrite()
+	}
+	return changed && running
+}
+
+// PermitWrite returns whether the master is currently allowed to write.
+func (wt *WebTTY) PermitWrite() bool {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+
+	return wt.permitWrite
+}
+
+// setPermitWriteLocked returns true when the permission changed,
+// the caller then notifies the master with notifyPermitWrite
+// once the lock is released.
+func (wt *WebTTY) setPermitWriteLocked(permitWrite bool) bool {
+	if wt.permitWrite == permitWrite {
+		return false
+	}
+	wt.permitWrite = permitWrite
+	wt.armWriteGrantLocked()
+
+	// fired under the lock so that transitions are observed in order,
+	// the hook must not call back into SetPermitWrite or PermitWrite.
+	if wt.writableHook != nil {
+		wt.writableHook(permitWrite)
+	}
+
+	return true
+}
+
+// notifyPermitWrite sends the current permission to the master.
+// Notifications are serialized and always carry the latest state,
+// so the master ends up with the right one even when they race.
+func (wt *WebTTY) notifyPermitWrite() {
+	wt.notifyMutex.Lock()
+	defer wt.notifyMutex.Unlock()
+
+	wt.stateMutex.RLock()
+	running, permitWrite := wt.running, wt.permitWrite
+	wt.stateMutex.RUnlock()
+
+	if running {
+		// a broken master is detected by the read loop
+		wt.sendReadOnly(!permitWrite)
+		if permitWrite {
+			wt.printMessage(wt.messages.WriteGranted)
+		} else {
+			wt.printMessage(wt.messages.WriteRevoked)
+		}
+	}
+}
+
+// sendPermitWrite sends the current permission to the master
+// when the session starts. It is serialized with notifyPermitWrite,
+// so a permission changed meanwhile is not lost.
+func (wt *WebTTY) sendPermitWrite() error {
+	wt.notifyMutex.Lock()
+	defer wt.notifyMutex.Unlock()
+
+	return wt.sendReadOnly(!wt.PermitWrite())
+}
+
+// armWriteGrantLocked (re)starts the timer revoking write permission
+// after the grant duration while the session is running.
+func (wt *WebTTY) armWriteGrantLocked() {
+	if wt.writeGrantTimer != nil {
+		wt.writeGrantTimer.Stop()
+		wt.writeGrantTimer = nil
+	}
+	wt.writeGrantGeneration++
+
+	if !wt.permitWrite || !wt.running || wt.writeGrantDuration <= 0 {
+		return
+	}
+
+	generation := wt.writeGrantGeneration
+	wt.writeGrantTimer = time.AfterFunc(wt.writeGrantDuration, func() {
+		wt.stateMutex.Lock()
+		// the grant may have been renewed or revoked meanwhile
+		changed := generation == wt.writeGrantGeneration && wt.setPermitWriteLocked(false)
+		wt.stateMutex.Unlock()
+
+		if changed {
+			wt.notifyPermitWrite()
+			wt.auditLifecycle(permissionMarker + "write grant expired")
+		}
+	})
+}
+
+func (wt *WebTTY) sendReadOnly(readOnly bool) error {
+	payload := []byte("false")
+	if readOnly {
+		payload = []byte("true")
+	}
+	return wt.primaryWrite(append([]byte{SetReadOnly}, payload...))
+}
+
+// setRunning marks the session as running or stopped
+// and starts or cancels the write grant accordingly.
+func (wt *WebTTY) setRunning(running bool) {
+	wt.stateMutex.Lock()
+	defer wt.stateMutex.Unlock()
+
+	wt.running = running
+	wt.armWriteGrantLocked()
+}
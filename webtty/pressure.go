@@ -0,0 +1,34 @@
+package webtty
+
+import (
+	"context"
+)
+
+// checkMemoryPressure pauses the caller while the memory pressure
+// signal is raised. A value received on the signal raises it and the
+// next one lowers it again. It returns the signal to use for the next
+// check, which is nil once the channel has been closed.
+func checkMemoryPressure(ctx context.Context, signal <-chan struct{}) (<-chan struct{}, error) {
+	if signal == nil {
+		return nil, nil
+	}
+
+	select {
+	case _, ok := <-signal:
+		if !ok {
+			return nil, nil
+		}
+	default:
+		return signal, nil
+	}
+
+	select {
+	case _, ok := <-signal:
+		if !ok {
+			return nil, nil
+		}
+		return signal, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,50 @@
+package webtty
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCheckMemoryPressure(t *testing.T) {
+	signal := make(chan struct{})
+	ctx := context.Background()
+
+	next, err := checkMemoryPressure(ctx, signal)
+	if err != nil || next == nil {
+		t.Fatalf("Unexpected result without pressure: %v, %v", next, err)
+	}
+
+	released := make(chan struct{})
+	go func() {
+		defer close(released)
+		next, err := checkMemoryPressure(ctx, signal)
+		if err != nil || next == nil {
+			t.Errorf("Unexpected result after pressure: %v, %v", next, err)
+		}
+	}()
+
+	signal <- struct{}{}
+	select {
+	case <-released:
+		t.Fatalf("Returned while under pressure")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	signal <- struct{}{}
+	<-released
+
+	close(signal)
+	next, err = checkMemoryPressure(ctx, signal)
+	if err != nil || next != nil {
+		t.Fatalf("Unexpected result after close: %v, %v", next, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	paused := make(chan struct{}, 1)
+	paused <- struct{}{}
+	cancel()
+	if _, err := checkMemoryPressure(ctx, paused); err != context.Canceled {
+		t.Fatalf("Unexpected error after cancel: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ChannelID identifies a channel of a multiplexed connection, each channel
+// carrying the frames of its own session. The master picks the IDs of the
+// channels it opens, an ID may be reused once its channel closed.
+type ChannelID uint32
+
+// ErrMalformedChannel is returned by DecodeChannel for a payload without
+// a valid channel ID.
+var ErrMalformedChannel = errors.New("malformed channel frame")
+
+// EncodeChannel returns the frame of a message of type t, such as
+// ChannelFrame or ChannelOutput, carrying payload on channel id.
+func EncodeChannel(t MessageType, id ChannelID, payload []byte) []byte {
+	frame := make([]byte, 0, 12+len(payload))
+	frame = append(frame, byte(t))
+	frame = strconv.AppendUint(frame, uint64(id), 10)
+	frame = append(frame, ':')
+	return append(frame, payload...)
+}
+
+// DecodeChannel splits the payload of a channel message into the ID of its
+// channel and the payload of the channel, which shares the memory of
+// payload.
+func DecodeChannel(payload []byte) (ChannelID, []byte, error) {
+	colon := bytes.IndexByte(payload, ':')
+	if colon <= 0 {
+		return 0, nil, ErrMalformedChannel
+	}
+	id, err := strconv.ParseUint(string(payload[:colon]), 10, 32)
+	if err != nil || (colon > 1 && payload[0] == '0') {
+		return 0, nil, ErrMalformedChannel
+	}
+	return ChannelID(id), payload[colon+1:], nil
+}
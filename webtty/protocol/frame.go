@@ -0,0 +1,54 @@
+package protocol
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ErrEmptyFrame is returned by Decode for a frame without a type.
+var ErrEmptyFrame = errors.New("empty frame")
+
+// Encode returns the frame of a message of type t carrying payload.
+func Encode(t MessageType, payload []byte) []byte {
+	return AppendFrame(make([]byte, 0, 1+len(payload)), t, payload)
+}
+
+// AppendFrame appends the frame of a message of type t carrying payload
+// to dst.
+func AppendFrame(dst []byte, t MessageType, payload []byte) []byte {
+	dst = append(dst, byte(t))
+	return append(dst, payload...)
+}
+
+// EncodeJSON returns the frame of a message of type t whose payload is v
+// as JSON.
+func EncodeJSON(t MessageType, v interface{}) ([]byte, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal the payload of `%c`", t)
+	}
+	return Encode(t, payload), nil
+}
+
+// Decode splits frame into the type of its message and its payload,
+// which shares the memory of frame.
+func Decode(frame []byte) (MessageType, []byte, error) {
+	if len(frame) == 0 {
+		return 0, nil, ErrEmptyFrame
+	}
+	return MessageType(frame[0]), frame[1:], nil
+}
+
+// DecodeJSON decodes the JSON payload of frame into v, and returns the
+// type of its message.
+func DecodeJSON(frame []byte, v interface{}) (MessageType, error) {
+	t, payload, err := Decode(frame)
+	if err != nil {
+		return 0, err
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return t, errors.Wrapf(err, "malformed payload of `%c`", t)
+	}
+	return t, nil
+}
@@ -0,0 +1,225 @@
+// Package protocol defines the messages webtty exchanges between a master,
+// such as a browser, and the server running a slave: their types, the
+// framing of a message as its type byte followed by its payload, and the
+// version of the protocol the two ends negotiate.
+//
+// Message types from '0' to '9' and from 'A' to 'Z' are reserved for the
+// protocol, in both directions. Other bytes, such as lowercase letters,
+// are free for custom messages. The types added to the protocol come with
+// a new Version, and aren't sent to the masters speaking an older one.
+package protocol
+
+// MessageType is the leading byte of a message, such as Input or Output.
+type MessageType byte
+
+// Messages sent by the master.
+const (
+	// Unknown message type, maybe sent by a bug
+	UnknownInput MessageType = '0'
+	// User input typically from a keyboard
+	Input MessageType = '1'
+	// Ping to the server
+	Ping MessageType = '2'
+	// Notify that the browser size has been changed
+	ResizeTerminal MessageType = '3'
+	// Request a StatsReport, payload is the optional round-trip time
+	// measured by the master in milliseconds
+	RequestStats MessageType = '4'
+	// Answer a KeepAlivePing of the server
+	KeepAlivePong MessageType = '5'
+	// Acknowledge processed output when the FlowControl feature is
+	// negotiated, payload is the number of bytes of output payloads,
+	// decoded and decompressed, processed since the previous one
+	AcknowledgeOutput MessageType = '6'
+	// Start, finish or cancel a file transfer, payload is a JSON object
+	FileTransfer MessageType = '7'
+	// Chunk of a file being uploaded, payload is a JSON object
+	UploadChunk MessageType = '8'
+	// Grant or revoke the write permission of the master, payload is
+	// a JSON boolean
+	SetPermitWrite MessageType = '9'
+	// Pause, resume, speed up or seek the playback of a slave replaying
+	// a recording, payload is a JSON object
+	ControlReplay MessageType = 'A'
+	// Unlock the locked screen, payload is a JSON object with the
+	// credential of the user
+	Unlock MessageType = 'B'
+	// Frame of a channel of a multiplexed connection, payload is the
+	// channel ID in decimal, a colon and the frame of the channel
+	ChannelFrame MessageType = 'C'
+	// Open a channel of a multiplexed connection, payload is the channel
+	// ID in decimal, a colon and the JSON object initializing its session
+	OpenChannel MessageType = 'D'
+	// Close a channel of a multiplexed connection, payload is the channel
+	// ID in decimal followed by a colon
+	CloseChannel MessageType = 'E'
+)
+
+// Messages sent by the server.
+const (
+	// Unknown message type, maybe set by a bug
+	UnknownOutput MessageType = '0'
+	// Normal output to the terminal, payload is base64 text,
+	// or the raw bytes when the BinaryFrames feature is negotiated
+	Output MessageType = '1'
+	// Pong to the browser, payload is the optional time of the server
+	// in Unix milliseconds
+	Pong MessageType = '2'
+	// Set window title of the terminal
+	SetWindowTitle MessageType = '3'
+	// Set terminal preference
+	SetPreferences MessageType = '4'
+	// Make terminal to reconnect
+	SetReconnect MessageType = '5'
+	// Tell whether the terminal accepts input, payload is a JSON boolean
+	SetReadOnly MessageType = '6'
+	// Describe the session, payload is a JSON object
+	SetSessionInfo MessageType = '7'
+	// Request to write the clipboard of the master, payload is a JSON object
+	ClipboardWrite MessageType = '8'
+	// Report the stats of the session, payload is a JSON object
+	StatsReport MessageType = '9'
+	// Tell the session ended because the slave closed, payload is the
+	// optional exit reason given by the slave, or because the server
+	// closed it, payload is then "session expired" or "idle timeout"
+	SessionEnd MessageType = 'A'
+	// Check the master is alive, to be answered with a KeepAlivePong
+	KeepAlivePing MessageType = 'B'
+	// Output compressed with gzip, encoded like Output,
+	// sent when the Compression feature is negotiated
+	CompressedOutput MessageType = 'C'
+	// Tell the master the token to give the server when it reconnects,
+	// to be reattached to the session
+	SetReattachToken MessageType = 'D'
+	// Tell the state of a file transfer, payload is a JSON object
+	FileTransferStatus MessageType = 'E'
+	// Chunk of a file being downloaded, payload is a JSON object
+	DownloadChunk MessageType = 'F'
+	// Tell the server is shutting down, payload is a JSON object
+	ShutdownNotice MessageType = 'G'
+	// Tell the server resized the terminal, payload is a JSON object
+	SetTerminalSize MessageType = 'H'
+	// Tell why the session closed, sent last unless the master closed,
+	// payload is a JSON object with a code, such as "idle_timeout",
+	// and a message to display, along with the exitCode and the signal
+	// of the process of a slave reporting them
+	CloseReason MessageType = 'I'
+	// Tell the master the output may be sent as CompressedOutput, payload
+	// is a JSON object with the method, "gzip", and the minimum size of
+	// the compressed output in bytes
+	SetCompression MessageType = 'J'
+	// Tell whether the screen is locked, payload is a JSON object with
+	// locked, a boolean, and the error of the latest Unlock when it failed
+	ScreenLock MessageType = 'K'
+	// Tell the version of the protocol the session speaks, sent first
+	// to the masters announcing theirs, payload is the version in decimal
+	SetProtocolVersion MessageType = 'L'
+	// Report the round-trip time to the master measured by the server,
+	// payload is a JSON object with the latest and the smoothed times in
+	// milliseconds, and whether the typed characters are echoed locally
+	LatencyReport MessageType = 'M'
+	// Frame of a channel of a multiplexed connection, payload is the
+	// channel ID in decimal, a colon and the frame of the session
+	ChannelOutput MessageType = 'N'
+	// Tell a channel of a multiplexed connection closed, payload is the
+	// channel ID in decimal, a colon and the optional reason it was
+	// refused or closed by the server
+	ChannelClosed MessageType = 'O'
+)
+
+// Direction tells which end of a session sends a message.
+type Direction int
+
+const (
+	// Sent by the master, e.g. a browser, to the server
+	MasterToSlave Direction = iota
+	// Sent by the server to the master
+	SlaveToMaster
+)
+
+func (d Direction) String() string {
+	switch d {
+	case MasterToSlave:
+		return "master->slave"
+	case SlaveToMaster:
+		return "slave->master"
+	default:
+		return "unknown"
+	}
+}
+
+// MessageTypeInfo describes a message type of the protocol.
+type MessageTypeInfo struct {
+	// The leading byte of the message
+	Type      MessageType
+	Name      string
+	Direction Direction
+	// Whether the type byte is followed by a payload
+	HasPayload bool
+	// Version of the protocol the type was added in
+	Since int
+}
+
+var messageTypes = []MessageTypeInfo{
+	{Input, "Input", MasterToSlave, true, 1},
+	{Ping, "Ping", MasterToSlave, false, 1},
+	{ResizeTerminal, "ResizeTerminal", MasterToSlave, true, 1},
+	{RequestStats, "RequestStats", MasterToSlave, true, 1},
+	{KeepAlivePong, "KeepAlivePong", MasterToSlave, false, 1},
+	{AcknowledgeOutput, "AcknowledgeOutput", MasterToSlave, true, 1},
+	{FileTransfer, "FileTransfer", MasterToSlave, true, 1},
+	{UploadChunk, "UploadChunk", MasterToSlave, true, 1},
+	{SetPermitWrite, "SetPermitWrite", MasterToSlave, true, 1},
+	{ControlReplay, "ControlReplay", MasterToSlave, true, 1},
+	{Unlock, "Unlock", MasterToSlave, true, 1},
+	{ChannelFrame, "ChannelFrame", MasterToSlave, true, 3},
+	{OpenChannel, "OpenChannel", MasterToSlave, true, 3},
+	{CloseChannel, "CloseChannel", MasterToSlave, true, 3},
+
+	{Output, "Output", SlaveToMaster, true, 1},
+	{Pong, "Pong", SlaveToMaster, false, 1},
+	{SetWindowTitle, "SetWindowTitle", SlaveToMaster, true, 1},
+	{SetPreferences, "SetPreferences", SlaveToMaster, true, 1},
+	{SetReconnect, "SetReconnect", SlaveToMaster, true, 1},
+	{SetReadOnly, "SetReadOnly", SlaveToMaster, true, 1},
+	{SetSessionInfo, "SetSessionInfo", SlaveToMaster, true, 1},
+	{ClipboardWrite, "ClipboardWrite", SlaveToMaster, true, 1},
+	{StatsReport, "StatsReport", SlaveToMaster, true, 1},
+	{SessionEnd, "SessionEnd", SlaveToMaster, true, 1},
+	{KeepAlivePing, "KeepAlivePing", SlaveToMaster, false, 1},
+	{CompressedOutput, "CompressedOutput", SlaveToMaster, true, 1},
+	{SetReattachToken, "SetReattachToken", SlaveToMaster, true, 1},
+	{FileTransferStatus, "FileTransferStatus", SlaveToMaster, true, 1},
+	{DownloadChunk, "DownloadChunk", SlaveToMaster, true, 1},
+	{ShutdownNotice, "ShutdownNotice", SlaveToMaster, true, 1},
+	{SetTerminalSize, "SetTerminalSize", SlaveToMaster, true, 1},
+	{CloseReason, "CloseReason", SlaveToMaster, true, 1},
+	{SetCompression, "SetCompression", SlaveToMaster, true, 1},
+	{ScreenLock, "ScreenLock", SlaveToMaster, true, 1},
+	{SetProtocolVersion, "SetProtocolVersion", SlaveToMaster, true, 1},
+	{LatencyReport, "LatencyReport", SlaveToMaster, true, 2},
+	{ChannelOutput, "ChannelOutput", SlaveToMaster, true, 3},
+	{ChannelClosed, "ChannelClosed", SlaveToMaster, true, 3},
+}
+
+// Types returns all message types of the protocol.
+func Types() []MessageTypeInfo {
+	types := make([]MessageTypeInfo, len(messageTypes))
+	copy(types, messageTypes)
+	return types
+}
+
+// Lookup returns the message type t sent in direction d.
+func Lookup(d Direction, t MessageType) (MessageTypeInfo, bool) {
+	for _, info := range messageTypes {
+		if info.Direction == d && info.Type == t {
+			return info, true
+		}
+	}
+	return MessageTypeInfo{}, false
+}
+
+// Reserved returns whether t is reserved for the protocol.
+func Reserved(t MessageType) bool {
+	return (t >= '0' && t <= '9') || (t >= 'A' && t <= 'Z')
+}
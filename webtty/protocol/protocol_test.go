@@ -0,0 +1,84 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestFraming(t *testing.T) {
+	frame := Encode(Input, []byte("ls"))
+	if string(frame) != "1ls" {
+		t.Fatalf("Unexpected frame: %q", frame)
+	}
+	typ, payload, err := Decode(frame)
+	if err != nil || typ != Input || string(payload) != "ls" {
+		t.Fatalf("Unexpected decoded frame: %c %q %v", typ, payload, err)
+	}
+	if _, _, err := Decode(nil); err != ErrEmptyFrame {
+		t.Fatalf("Unexpected error from Decode() of an empty frame: %v", err)
+	}
+
+	frame, err = EncodeJSON(ResizeTerminal, struct{ Columns, Rows int }{80, 24})
+	if err != nil || string(frame) != `3{"Columns":80,"Rows":24}` {
+		t.Fatalf("Unexpected JSON frame: %q %v", frame, err)
+	}
+	var size struct{ Columns, Rows int }
+	if typ, err := DecodeJSON(frame, &size); err != nil || typ != ResizeTerminal || size.Columns != 80 {
+		t.Fatalf("Unexpected decoded JSON frame: %c %v %v", typ, size, err)
+	}
+	if _, err := DecodeJSON([]byte("3{"), &size); err == nil {
+		t.Fatalf("Expected an error from DecodeJSON() of a malformed payload")
+	}
+}
+
+func TestTypes(t *testing.T) {
+	for _, info := range Types() {
+		if !Reserved(info.Type) {
+			t.Fatalf("Message type `%c` is not reserved", info.Type)
+		}
+		if info.Since < MinVersion || info.Since > Version {
+			t.Fatalf("Message type %s added in unknown version %d", info.Name, info.Since)
+		}
+		if found, ok := Lookup(info.Direction, info.Type); !ok || found.Name != info.Name {
+			t.Fatalf("Unexpected lookup of %s: %v", info.Name, found)
+		}
+	}
+	if _, ok := Lookup(MasterToSlave, 'x'); ok {
+		t.Fatalf("Unexpected custom message type")
+	}
+	if !Supported(SlaveToMaster, 'x', LegacyVersion) {
+		t.Fatalf("Expected custom message types to be supported")
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	for announced, want := range map[int]int{0: LegacyVersion, 1: 1, Version + 1: Version} {
+		version, err := Negotiate(announced)
+		if err != nil || version != want {
+			t.Fatalf("Unexpected version for %d: %d %v", announced, version, err)
+		}
+	}
+	if _, err := Negotiate(-1); errors.Cause(err) != ErrUnsupportedVersion {
+		t.Fatalf("Unexpected error from Negotiate() of an old version: %v", err)
+	}
+}
+
+func TestChannelFraming(t *testing.T) {
+	frame := EncodeChannel(ChannelFrame, 12, []byte("1ls"))
+	if string(frame) != "C12:1ls" {
+		t.Fatalf("Unexpected frame: %q", frame)
+	}
+	id, payload, err := DecodeChannel(frame[1:])
+	if err != nil || id != 12 || string(payload) != "1ls" {
+		t.Fatalf("Unexpected decoded frame: %d %q %v", id, payload, err)
+	}
+	if id, payload, err := DecodeChannel([]byte("0:")); err != nil || id != 0 || len(payload) != 0 {
+		t.Fatalf("Unexpected decoded empty frame: %d %q %v", id, payload, err)
+	}
+	for _, malformed := range []string{"", "1", ":1ls", "a:1ls", "-1:", "+1:", "01:", "4294967296:"} {
+		if _, _, err := DecodeChannel([]byte(malformed)); err != ErrMalformedChannel {
+			t.Errorf("Unexpected error from DecodeChannel(%q): %v", malformed, err)
+		}
+	}
+}
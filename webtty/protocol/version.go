@@ -0,0 +1,46 @@
+package protocol
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// Version is the latest version of the protocol, announced by the
+	// masters in their handshake.
+	Version = 3
+
+	// LegacyVersion is the version of the masters announcing none,
+	// which predate the versioning of the protocol.
+	LegacyVersion = 1
+
+	// MinVersion is the oldest version still spoken.
+	MinVersion = 1
+)
+
+// ErrUnsupportedVersion is returned by Negotiate for a master speaking
+// a version older than MinVersion.
+var ErrUnsupportedVersion = errors.New("unsupported protocol version")
+
+// Negotiate returns the version spoken with a master announcing announced,
+// the latest both ends support, or LegacyVersion when it announced none,
+// as 0.
+func Negotiate(announced int) (int, error) {
+	switch {
+	case announced == 0:
+		return LegacyVersion, nil
+	case announced < MinVersion:
+		return 0, errors.Wrapf(ErrUnsupportedVersion, "version %d, oldest supported is %d", announced, MinVersion)
+	case announced > Version:
+		return Version, nil
+	default:
+		return announced, nil
+	}
+}
+
+// Supported returns whether the masters speaking version understand the
+// messages of type t sent in direction d. The types the protocol doesn't
+// define, such as the custom ones, are left to the applications.
+func Supported(d Direction, t MessageType, version int) bool {
+	info, ok := Lookup(d, t)
+	return !ok || info.Since <= version
+}
@@ -0,0 +1,239 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// reattachMargin is added to the reconnect time of the master
+// when waiting for it to reattach.
+const reattachMargin = 10 * time.Second
+
+// replayBuffer keeps the latest output of the slave, up to size bytes,
+// to be replayed to a reattached master.
+type replayBuffer struct {
+	size int
+
+	mutex sync.Mutex
+	data  []byte
+}
+
+func newReplayBuffer(size int) *replayBuffer {
+	return &replayBuffer{size: size}
+}
+
+// write appends data, dropping the oldest output only once the buffer
+// holds twice its size so busy sessions don't move it on every write.
+func (rb *replayBuffer) write(data []byte) {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+
+	if len(data) >= rb.size {
+		rb.data = append(rb.data[:0], data[len(data)-rb.size:]...)
+		return
+	}
+	if len(rb.data)+len(data) > 2*rb.size {
+		rb.data = append(rb.data[:0], rb.data[len(rb.data)-rb.size:]...)
+	}
+	rb.data = append(rb.data, data...)
+}
+
+// contents returns a copy of the latest size bytes of output.
+func (rb *replayBuffer) contents() []byte {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+
+	start := 0
+	if len(rb.data) > rb.size {
+		start = len(rb.data) - rb.size
+	}
+	// don't start in the middle of a rune
+	for start < len(rb.data) && !utf8.RuneStart(rb.data[start]) {
+		start++
+	}
+	return append([]byte{}, rb.data[start:]...)
+}
+
+// Reattach replaces the master of the session, typically with a new
+// connection of a client reconnecting. The session must be created with
+// WithReplayBuffer. The new master receives the window title, the reconnect
+// time and the preferences, then the output kept by the replay buffer,
+// before any new output. Reads resume from the new master, and the slave
+// is resized to the latest size requested by a master.
+//
+// The current master is closed in the background when it implements
+// io.Closer. Once the reads of the current master fail, Run waits for
+// a new master for the reconnect time set with WithReconnect
+// and a few more seconds, or the time set with WithReattachTimeout,
+// then returns as when the master closes.
+func (wt *WebTTY) Reattach(master Master) error {
+	if master == nil {
+		return errors.New("master must not be nil")
+	}
+	if wt.replay == nil {
+		return ErrReattachNotEnabled
+	}
+
+	// the locked screen holds the replay until it's unlocked,
+	// and the session awaiting approval until it's approved
+	awaiting := wt.AwaitingApproval()
+	locked := wt.ScreenLocked()
+	if awaiting {
+		wt.holdReplayForApproval(wt.replay.contents())
+	} else if locked {
+		wt.holdReplay(wt.replay.contents())
+	}
+
+	// the new master didn't see the keys echoed locally, nor the timed ping
+	atomic.StoreInt64(&wt.pingSent, 0)
+	if wt.localEcho != nil {
+		wt.localEcho.reset()
+	}
+
+	wt.writeMutex.Lock()
+	wt.masterMutex.Lock()
+	old := wt.masterConn
+	wt.masterConn = master
+	wt.masterMutex.Unlock()
+	wt.writeTimedOut = false
+
+	for _, message := range wt.reattachMessages(locked, awaiting) {
+		n, err := wt.writeMasterLocked(message)
+		atomic.AddUint64(&wt.bytesOut, uint64(n))
+		if err != nil {
+			wt.writeMutex.Unlock()
+			return errors.Wrapf(err, "failed to initialize reattached master")
+		}
+	}
+	wt.writeMutex.Unlock()
+
+	if limiter, ok := master.(readLimiter); ok {
+		limiter.SetReadLimit(int64(wt.maxInboundFrameSize))
+	}
+	select {
+	case wt.reattached <- struct{}{}:
+	default:
+	}
+	if closer, ok := old.(io.Closer); ok {
+		go closer.Close()
+	}
+	// until the new master resizes, the size may have changed since the
+	// latest resize, such as with SetTerminalSize
+	wt.applyRequestedSize()
+	wt.auditLifecycle(reconnectMarker + "master reattached")
+	if wt.metrics != nil {
+		wt.metrics.IncReattach()
+	}
+
+	return nil
+}
+
+// reattachMessages returns the messages initializing a reattached master,
+// telling it the screen is locked, or the session awaits its approval,
+// instead of replaying the output when it is.
+func (wt *WebTTY) reattachMessages(locked bool, awaiting bool) [][]byte {
+	messages := [][]byte{
+		append([]byte{SetWindowTitle}, wt.windowTitle...),
+		append([]byte{SetReadOnly}, strconv.FormatBool(!wt.PermitWrite())...),
+	}
+	if wt.reconnect > 0 {
+		reconnect, _ := json.Marshal(wt.reconnect)
+		messages = append(messages, append([]byte{SetReconnect}, reconnect...))
+	}
+	if wt.masterPrefs != nil {
+		messages = append(messages, append([]byte{SetPreferences}, wt.masterPrefs...))
+	}
+	if message := wt.compressionMessage(); message != nil {
+		messages = append(messages, message)
+	}
+
+	if awaiting {
+		var notice []byte
+		if message := wt.messages.AwaitingApproval; message != "" {
+			notice = []byte("\r\n" + strings.Replace(message, "%s", wt.approval.timeout.String(), 1) + "\r\n")
+		}
+		if wt.flowWindow != nil {
+			wt.flowWindow.reset(len(notice))
+		}
+		return append(messages, wt.replayMessages(notice)...)
+	}
+	if locked {
+		if wt.flowWindow != nil {
+			wt.flowWindow.reset(0)
+		}
+		return append(messages, screenLockMessage())
+	}
+
+	replay := wt.replay.contents()
+	if wt.flowWindow != nil {
+		// the previous master won't acknowledge its output
+		wt.flowWindow.reset(len(replay))
+	}
+	return append(messages, wt.replayMessages(replay)...)
+}
+
+// replayMessages returns the Output messages replaying replay.
+func (wt *WebTTY) replayMessages(replay []byte) [][]byte {
+	chunkSize := wt.bufferSize
+	if size := wt.outputChunkSize(); size > 0 && size < chunkSize {
+		chunkSize = size
+	}
+	var messages [][]byte
+	for len(replay) > 0 {
+		n := chunkSize
+		if n > len(replay) {
+			n = len(replay)
+		}
+		messages = append(messages, wt.appendOutputFrame(nil, replay[:n]))
+		replay = replay[n:]
+	}
+	return messages
+}
+
+// currentMaster returns the master to read from.
+func (wt *WebTTY) currentMaster() Master {
+	wt.masterMutex.RLock()
+	defer wt.masterMutex.RUnlock()
+
+	return wt.masterConn
+}
+
+// awaitReattach tells whether reads should resume after master failed,
+// because a new master is attached or gets attached in time.
+func (wt *WebTTY) awaitReattach(ctx context.Context, master Master) bool {
+	if wt.replay == nil {
+		return false
+	}
+	if wt.currentMaster() != master {
+		return true
+	}
+
+	timeout := wt.reattachTimeout
+	if timeout == 0 {
+		timeout = time.Duration(wt.reconnect)*time.Second + reattachMargin
+	}
+	wt.auditLifecycle(reconnectMarker + "master disconnected, waiting " + timeout.String() + " for it to reattach")
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-wt.reattached:
+			if wt.currentMaster() != master {
+				return true
+			}
+		case <-timer.C:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
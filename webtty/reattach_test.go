@@ -0,0 +1,167 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	stderrors "errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReplayBuffer(t *testing.T) {
+	rb := newReplayBuffer(4)
+	rb.write([]byte("ab"))
+	rb.write([]byte("cdef"))
+	if data := string(rb.contents()); data != "cdef" {
+		t.Fatalf("Unexpected contents: %q", data)
+	}
+
+	// a rune cut by the trimming is dropped
+	rb.write([]byte("你"))
+	if data := string(rb.contents()); data != "f你" {
+		t.Fatalf("Unexpected contents: %q", data)
+	}
+	rb.write([]byte("x"))
+	if data := string(rb.contents()); data != "你x" {
+		t.Fatalf("Unexpected contents: %q", data)
+	}
+
+	// writes larger than the buffer keep their end
+	rb.write([]byte("0123456789"))
+	if data := string(rb.contents()); data != "6789" {
+		t.Fatalf("Unexpected contents: %q", data)
+	}
+}
+
+// closingMaster is a master reading frames from a pipe and recording
+// its output, reads fail once it's closed.
+type closingMaster struct {
+	recordingMaster
+	*io.PipeReader
+	writer *io.PipeWriter
+}
+
+func newClosingMaster() closingMaster {
+	reader, writer := io.Pipe()
+	return closingMaster{recordingMaster{&frameRecorder{}}, reader, writer}
+}
+
+func (cm closingMaster) Read(p []byte) (int, error)  { return cm.PipeReader.Read(p) }
+func (cm closingMaster) Write(p []byte) (int, error) { return cm.recordingMaster.Write(p) }
+func (cm closingMaster) Close() error                { return cm.writer.Close() }
+
+func TestReattach(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	first := newClosingMaster()
+	metrics := &CounterMetrics{}
+	dt, err := New(first, &pipeSlave{pipePair{slaveReader, inputWriter}},
+		WithPermitWrite(), WithReplayBuffer(1024), WithWindowTitle([]byte("title")), WithMetrics(metrics))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx, "", "") }()
+
+	slaveWriter.Write([]byte("hello "))
+	slaveWriter.Write([]byte("world"))
+	for len(first.get()) < 4 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the first master closes before the second one is attached
+	first.Close()
+	time.Sleep(10 * time.Millisecond)
+	second := newClosingMaster()
+	if err := dt.Reattach(second); err != nil {
+		t.Fatalf("Unexpected error from Reattach(): %s", err)
+	}
+	if reattaches := atomic.LoadUint64(&metrics.Reattaches); reattaches != 1 {
+		t.Fatalf("Unexpected reattaches: %d", reattaches)
+	}
+
+	frames := second.get()
+	var replayed []byte
+	for _, frame := range frames[2:] {
+		decoded, _ := base64.StdEncoding.DecodeString(frame[1:])
+		replayed = append(replayed, decoded...)
+	}
+	if frames[0] != string(SetWindowTitle)+"title" || frames[1] != string(SetReadOnly)+"false" || string(replayed) != "hello world" {
+		t.Fatalf("Unexpected frames for reattached master: %q", frames)
+	}
+
+	// input is read from the new master
+	input := make(chan string)
+	go func() {
+		buf := make([]byte, 16)
+		n, _ := inputReader.Read(buf)
+		input <- string(buf[:n])
+	}()
+	second.writer.Write([]byte("1ls\r"))
+	if data := <-input; data != "ls\r" {
+		t.Fatalf("Unexpected input: %q", data)
+	}
+
+	// new output goes to the new master
+	slaveWriter.Write([]byte("!"))
+	expected := string(Output) + base64.StdEncoding.EncodeToString([]byte("!"))
+	for i := 0; i < 100; i++ {
+		if frames = second.get(); frames[len(frames)-1] == expected {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if last := frames[len(frames)-1]; last != expected {
+		t.Fatalf("Unexpected last frame: %q", last)
+	}
+	cancel()
+	<-done
+}
+
+func TestReattachNotEnabled(t *testing.T) {
+	dt, err := New(discardMaster{}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.Reattach(discardMaster{}); err != ErrReattachNotEnabled {
+		t.Fatalf("Unexpected error from Reattach(): %v", err)
+	}
+}
+
+func TestReattachTokenAndTimeout(t *testing.T) {
+	slaveReader, _ := io.Pipe()
+	master := newClosingMaster()
+	dt, err := New(master, &pipeSlave{pipePair{slaveReader, nil}},
+		WithReplayBuffer(1024), WithReattachToken("secret"), WithReattachTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- dt.Run(context.Background()) }()
+	for len(master.get()) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	if frames := master.get(); frames[1] != string(SetReattachToken)+"secret" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	// the session ends once nobody reattached within the timeout
+	start := time.Now()
+	master.Close()
+	if err := <-done; !stderrors.Is(err, ErrMasterClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("Unexpected wait for a new master: %s", elapsed)
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithReattachToken("secret")); err == nil {
+		t.Fatalf("Expected an error without a replay buffer")
+	}
+}
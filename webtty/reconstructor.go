@@ -0,0 +1,716 @@
+package webtty
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// inputReconstructor rebuilds the command line typed on the master
+// from Input frames so that it can be recorded in the audit trail.
+type inputReconstructor struct {
+	mutex sync.Mutex
+	line  []byte
+	// byte offset of the cursor in line, always at a rune boundary
+	cursor int
+
+	// leading bytes of a rune split across frames
+	partial []byte
+	// keys typed for the line, as received
+	raw []byte
+	// the previous key was CR, so a following LF does not submit again
+	afterCR bool
+
+	// bytes treated as erasing the previous character
+	eraseKeys []byte
+
+	// echo of the slave is applied to the line after tab completion
+	// and history navigation, since those edits happen on the slave side
+	echo         echoCapture
+	echoApplied  bool
+	escape       escapeState
+	escapeParams []byte
+	// leading bytes of an echoed rune split across writes
+	echoPartial []byte
+	// the terminal cursor is on the second column of the wide rune
+	// before the cursor, after a single backspace into it
+	echoHalf bool
+
+	// keys between the bracketed paste markers are inserted as they are,
+	// line breaks as LF
+	pasting bool
+	// the line was recalled with a reverse history search
+	searched bool
+
+	// secretMatcher tells from the latest output whether the line
+	// about to be typed is a secret, which is recorded as secretMask
+	secretMatcher func(recentOutput []byte) bool
+	recentOutput  []byte
+	secret        bool
+
+	// when the previous line was submitted, zero before the first
+	lastLineAt time.Time
+}
+
+// stampLine records now as the time a line was submitted and returns
+// the time since the previous line, zero for the first one.
+func (ir *inputReconstructor) stampLine(now time.Time) time.Duration {
+	ir.mutex.Lock()
+	defer ir.mutex.Unlock()
+
+	var since time.Duration
+	if !ir.lastLineAt.IsZero() {
+		since = now.Sub(ir.lastLineAt)
+	}
+	ir.lastLineAt = now
+	return since
+}
+
+type echoCapture int
+
+const (
+	echoNone echoCapture = iota
+	echoTabComplete
+	echoHistory
+)
+
+type escapeState int
+
+const (
+	escapeNone escapeState = iota
+	escapeStart
+	escapeCSI
+)
+
+// tabCompleteMarker is recorded when a tab completion was requested
+// but no completion was echoed back by the slave.
+const tabCompleteMarker = "[tab-complete]"
+
+// historySearchMarker prefixes lines submitted after a reverse history
+// search, the line then holds the search string typed, not the command
+// found by the shell.
+const historySearchMarker = "[history-search]"
+
+var historyKeys = [][]byte{
+	[]byte("\x1b[A"), []byte("\x1b[B"), // 上下
+	[]byte("\x1bOA"), []byte("\x1bOB"),
+}
+
+// Control keys of the readline line editor, in its default emacs mode.
+const (
+	keyLineStart     = 0x01 // Ctrl-A
+	keyCharBack      = 0x02 // Ctrl-B
+	keyInterrupt     = 0x03 // Ctrl-C
+	keyDeleteChar    = 0x04 // Ctrl-D
+	keyLineEnd       = 0x05 // Ctrl-E
+	keyCharForward   = 0x06 // Ctrl-F
+	keyKillLineEnd   = 0x0b // Ctrl-K
+	keyHistoryNext   = 0x0e // Ctrl-N
+	keyHistoryPrev   = 0x10 // Ctrl-P
+	keyHistorySearch = 0x12 // Ctrl-R
+	keyKillLineStart = 0x15 // Ctrl-U
+	keyKillWordBack  = 0x17 // Ctrl-W
+)
+
+// Escape sequences sent by editing keys, with Alt as an ESC prefix.
+var (
+	leftKeys      = []string{"\x1b[D", "\x1bOD"}
+	rightKeys     = []string{"\x1b[C", "\x1bOC"}
+	homeKeys      = []string{"\x1b[H", "\x1bOH", "\x1b[1~", "\x1b[7~"}
+	endKeys       = []string{"\x1b[F", "\x1bOF", "\x1b[4~", "\x1b[8~"}
+	deleteKeys    = []string{"\x1b[3~"}
+	wordLeftKeys  = []string{"\x1bb", "\x1b[1;5D", "\x1b[1;3D"}
+	wordRightKeys = []string{"\x1bf", "\x1b[1;5C", "\x1b[1;3C"}
+	killWordKeys  = []string{"\x1bd"}
+	rubWordKeys   = []string{"\x1b\x7f", "\x1b\x08"}
+)
+
+const (
+	pasteStart = "\x1b[200~"
+	pasteEnd   = "\x1b[201~"
+)
+
+// DefaultEraseKeys are the bytes sent by terminals for the backspace key,
+// DEL is the common default and BS is sent by some clients instead.
+var DefaultEraseKeys = []byte{127, 8}
+
+func newInputReconstructor(eraseKeys []byte) *inputReconstructor {
+	return &inputReconstructor{
+		eraseKeys: eraseKeys,
+	}
+}
+
+// secretMask replaces lines typed at a prompt matched by the secret matcher.
+const secretMask = "***"
+
+// recentOutputSize is how much of the latest output is kept for the
+// secret matcher, enough for a prompt.
+const recentOutputSize = 256
+
+func (ir *inputReconstructor) isErase(key byte) bool {
+	for _, erase := range ir.eraseKeys {
+		if key == erase {
+			return true
+		}
+	}
+	return false
+}
+
+// feed processes a raw frame read from the master.
+// It returns the lines submitted by the frame, in order.
+// Frames may carry any number of keys, as sent when typing fast or
+// pasting, and each of them is applied to the line in turn, following
+// the default key bindings of readline: the cursor keys, Home and End,
+// Ctrl-A/B/E/F and Alt-B/F move the cursor, Ctrl-U/K/W, Alt-D and
+// Alt-Backspace kill text around it, Delete and Ctrl-D delete the
+// character under it and Ctrl-C abandons the line.
+// CR, LF and CRLF submit the line; within a bracketed paste they are part
+// of the line instead, which is submitted as one line per pasted line;
+// a rune or an escape sequence split across frames is kept until its
+// remaining bytes arrive.
+func (ir *inputReconstructor) feed(frame []byte) []string {
+	submitted := ir.feedLines(frame)
+	if len(submitted) == 0 {
+		return nil
+	}
+	lines := make([]string, 0, len(submitted))
+	for _, line := range submitted {
+		lines = append(lines, line.line)
+	}
+	return lines
+}
+
+// submittedLine is a line submitted by the master.
+type submittedLine struct {
+	line string
+	// the keys typed for the line, without the one submitting it
+	keys string
+}
+
+// feedLines is feed returning the keys typed for each line as well.
+// The keys of secret lines are masked like the lines.
+func (ir *inputReconstructor) feedLines(frame []byte) []submittedLine {
+	ir.mutex.Lock()
+	defer ir.mutex.Unlock()
+
+	// 审计日志
+	// （ 操作 - frame ）
+	// 退格 - [49 127] 或 [49 8]
+	// 空 - [50]
+	// 空格	- [49 32]
+	// 正常内容 - [49 ascii]
+	// 上下左右 四个字符
+	if len(frame) < 2 || frame[0] != Input {
+		return nil
+	}
+	ir.endEcho()
+
+	var lines []submittedLine
+	keys := frame[1:]
+	if len(ir.partial) > 0 {
+		keys = append(ir.partial, keys...)
+		ir.partial = nil
+	}
+	// the keys of the frame from start are typed for the current line
+	all, start := keys, 0
+	defer func() {
+		ir.raw = append(ir.raw, all[start:len(all)-len(ir.partial)]...)
+	}()
+	for len(keys) > 0 {
+		afterCR := ir.afterCR
+		ir.afterCR = false
+		pos := len(all) - len(keys)
+		if keys[0] == 0x1b {
+			n := escapeLength(keys)
+			if !escapeComplete(keys[:n]) {
+				// the rest of the sequence comes with the next frame
+				ir.partial = append([]byte(nil), keys...)
+				return lines
+			}
+			ir.applyEscape(keys[:n])
+			keys = keys[n:]
+			continue
+		}
+
+		if len(ir.line) == 0 && !ir.secret && ir.secretMatcher != nil {
+			ir.secret = ir.secretMatcher(ir.recentOutput)
+		}
+
+		switch key := keys[0]; {
+		case ir.pasting && key < 0x20:
+			ir.afterCR = key == '\r'
+			if key == '\n' && afterCR {
+				break
+			}
+			if key == '\r' {
+				key = '\n'
+			}
+			ir.insert([]byte{key})
+		case key == '\r' || key == '\n': // 判断内容为回车
+			ir.afterCR = key == '\r'
+			if key == '\n' && afterCR {
+				start = pos + 1
+				break
+			}
+			ir.endEcho()
+			lines = append(lines, ir.submitLocked(string(append(ir.raw, all[start:pos]...)))...)
+			ir.raw = ir.raw[:0]
+			start = pos + 1
+			ir.reset()
+			// the output before the next line comes after this one
+			ir.recentOutput = ir.recentOutput[:0]
+		case key == '\t': // 判断内容为补全
+			ir.startEcho(echoTabComplete)
+		case ir.isErase(key): // 判断内容为退格
+			ir.kill(ir.runeBack(ir.cursor), ir.cursor)
+		case key == keyLineStart:
+			ir.cursor = 0
+		case key == keyLineEnd:
+			ir.cursor = len(ir.line)
+		case key == keyCharBack:
+			ir.cursor = ir.runeBack(ir.cursor)
+		case key == keyCharForward:
+			ir.cursor = ir.runeForward(ir.cursor)
+		case key == keyDeleteChar:
+			// on an empty line Ctrl-D ends the shell instead
+			ir.kill(ir.cursor, ir.runeForward(ir.cursor))
+		case key == keyKillLineStart:
+			ir.kill(0, ir.cursor)
+		case key == keyKillLineEnd:
+			ir.kill(ir.cursor, len(ir.line))
+		case key == keyKillWordBack:
+			ir.kill(ir.spaceWordBack(ir.cursor), ir.cursor)
+		case key == keyInterrupt:
+			ir.raw = ir.raw[:0]
+			start = pos + 1
+			ir.reset()
+		case key == keyHistoryPrev || key == keyHistoryNext:
+			ir.startEcho(echoHistory)
+		case key == keyHistorySearch:
+			ir.searched = true
+		default: // 判断内容为正常输入
+			if key >= utf8.RuneSelf && !utf8.FullRune(keys) {
+				// the rest of the rune comes with the next frame
+				ir.partial = append([]byte(nil), keys...)
+				return lines
+			}
+			r, size := utf8.DecodeRune(keys)
+			if r == utf8.RuneError && size == 1 {
+				// a lone byte is taken as the code point it encodes
+				var encoded [utf8.UTFMax]byte
+				n := utf8.EncodeRune(encoded[:], rune(key))
+				ir.insert(encoded[:n])
+			} else {
+				ir.insert(keys[:size])
+			}
+			keys = keys[size:]
+			continue
+		}
+		keys = keys[1:]
+	}
+
+	return lines
+}
+
+// submitLocked returns the lines run for the line submitted with keys.
+// A line holding a multi-line paste is split into its lines, which the
+// shell runs one by one, blank ones left out, and the keys of the whole
+// line are given with the first one.
+func (ir *inputReconstructor) submitLocked(keys string) []submittedLine {
+	if ir.secret {
+		return []submittedLine{{line: secretMask, keys: secretMask}}
+	}
+	line := string(ir.line)
+	if ir.searched {
+		line = historySearchMarker + line
+	}
+	if strings.IndexByte(line, '\n') < 0 {
+		return []submittedLine{{line: line, keys: keys}}
+	}
+
+	var lines []submittedLine
+	for _, pasted := range strings.Split(line, "\n") {
+		if strings.TrimSpace(pasted) != "" {
+			lines = append(lines, submittedLine{line: pasted})
+		}
+	}
+	if len(lines) == 0 {
+		return []submittedLine{{keys: keys}}
+	}
+	lines[0].keys = keys
+	return lines
+}
+
+// applyEscape applies the key sent as the escape sequence.
+// Unknown sequences are ignored.
+func (ir *inputReconstructor) applyEscape(sequence []byte) {
+	switch key := string(sequence); {
+	case key == pasteStart:
+		ir.pasting = true
+	case key == pasteEnd:
+		ir.pasting = false
+	case isHistoryKey(sequence):
+		ir.startEcho(echoHistory)
+	case isKey(key, leftKeys):
+		ir.cursor = ir.runeBack(ir.cursor)
+	case isKey(key, rightKeys):
+		ir.cursor = ir.runeForward(ir.cursor)
+	case isKey(key, homeKeys):
+		ir.cursor = 0
+	case isKey(key, endKeys):
+		ir.cursor = len(ir.line)
+	case isKey(key, deleteKeys):
+		ir.kill(ir.cursor, ir.runeForward(ir.cursor))
+	case isKey(key, wordLeftKeys):
+		ir.cursor = ir.wordBack(ir.cursor)
+	case isKey(key, wordRightKeys):
+		ir.cursor = ir.wordForward(ir.cursor)
+	case isKey(key, killWordKeys):
+		ir.kill(ir.cursor, ir.wordForward(ir.cursor))
+	case isKey(key, rubWordKeys):
+		ir.kill(ir.wordBack(ir.cursor), ir.cursor)
+	}
+}
+
+func isKey(key string, sequences []string) bool {
+	for _, sequence := range sequences {
+		if key == sequence {
+			return true
+		}
+	}
+	return false
+}
+
+// reset starts a new empty line.
+func (ir *inputReconstructor) reset() {
+	ir.line = ir.line[:0]
+	ir.cursor = 0
+	ir.secret = false
+	ir.searched = false
+}
+
+// insert inserts text at the cursor and moves the cursor after it.
+func (ir *inputReconstructor) insert(text []byte) {
+	if ir.cursor == len(ir.line) {
+		ir.line = append(ir.line, text...)
+	} else {
+		ir.line = append(ir.line[:ir.cursor], append(append([]byte(nil), text...), ir.line[ir.cursor:]...)...)
+	}
+	ir.cursor += len(text)
+}
+
+// kill removes line[from:to] and moves the cursor to from
+// when it was after it.
+func (ir *inputReconstructor) kill(from int, to int) {
+	if from >= to {
+		return
+	}
+	ir.line = append(ir.line[:from], ir.line[to:]...)
+	switch {
+	case ir.cursor >= to:
+		ir.cursor -= to - from
+	case ir.cursor > from:
+		ir.cursor = from
+	}
+}
+
+func (ir *inputReconstructor) runeBack(pos int) int {
+	_, size := utf8.DecodeLastRune(ir.line[:pos])
+	return pos - size
+}
+
+func (ir *inputReconstructor) runeForward(pos int) int {
+	_, size := utf8.DecodeRune(ir.line[pos:])
+	return pos + size
+}
+
+// wordBack returns the start of the word before pos,
+// words are made of letters and digits as for readline.
+func (ir *inputReconstructor) wordBack(pos int) int {
+	return ir.skipBack(ir.skipBack(pos, isNotWordRune), isWordRune)
+}
+
+// wordForward returns the end of the word after pos.
+func (ir *inputReconstructor) wordForward(pos int) int {
+	return ir.skipForward(ir.skipForward(pos, isNotWordRune), isWordRune)
+}
+
+// spaceWordBack returns the start of the word before pos,
+// words being delimited by spaces as for Ctrl-W.
+func (ir *inputReconstructor) spaceWordBack(pos int) int {
+	return ir.skipBack(ir.skipBack(pos, unicode.IsSpace), isNotSpace)
+}
+
+func (ir *inputReconstructor) skipBack(pos int, skip func(rune) bool) int {
+	for pos > 0 {
+		r, size := utf8.DecodeLastRune(ir.line[:pos])
+		if !skip(r) {
+			break
+		}
+		pos -= size
+	}
+	return pos
+}
+
+func (ir *inputReconstructor) skipForward(pos int, skip func(rune) bool) int {
+	for pos < len(ir.line) {
+		r, size := utf8.DecodeRune(ir.line[pos:])
+		if !skip(r) {
+			break
+		}
+		pos += size
+	}
+	return pos
+}
+
+func isWordRune(r rune) bool    { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+func isNotWordRune(r rune) bool { return !isWordRune(r) }
+func isNotSpace(r rune) bool    { return !unicode.IsSpace(r) }
+
+// escapeLength returns the length of the escape sequence at the start of keys,
+// or the length of keys when the sequence is incomplete.
+func escapeLength(keys []byte) int {
+	if len(keys) < 2 {
+		return len(keys)
+	}
+	switch keys[1] {
+	case '[':
+		for i := 2; i < len(keys); i++ {
+			if keys[i] >= 0x40 && keys[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(keys)
+	case 'O':
+		if len(keys) < 3 {
+			return len(keys)
+		}
+		return 3
+	}
+	return 2
+}
+
+// escapeComplete tells whether sequence, as cut by escapeLength,
+// holds all of its bytes.
+func escapeComplete(sequence []byte) bool {
+	if len(sequence) < 2 {
+		return false
+	}
+	switch sequence[1] {
+	case '[':
+		last := sequence[len(sequence)-1]
+		return len(sequence) > 2 && last >= 0x40 && last <= 0x7e
+	case 'O':
+		return len(sequence) == 3
+	}
+	return true
+}
+
+func isHistoryKey(sequence []byte) bool {
+	for _, key := range historyKeys {
+		if string(sequence) == string(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ir *inputReconstructor) startEcho(capture echoCapture) {
+	ir.echo = capture
+	ir.echoApplied = false
+	ir.escape = escapeNone
+	ir.echoPartial = ir.echoPartial[:0]
+	ir.echoHalf = false
+}
+
+// endEcho stops applying the slave echo to the line.
+func (ir *inputReconstructor) endEcho() {
+	if ir.echo == echoTabComplete && !ir.echoApplied {
+		ir.insert([]byte(tabCompleteMarker))
+	}
+	ir.echo = echoNone
+}
+
+// observeOutput processes output of the slave.
+// While capturing an echo, the output is applied to the line as the
+// terminal displays it from the cursor: characters overwrite the line,
+// backspaces and CSI C and D move the cursor by columns, wide runes such
+// as CJK ones taking two, CSI K erases the end of
+// the line and CSI @ and P insert and delete characters, so that the
+// line redrawn by readline after a completion or a history recall is
+// read back. Other escape sequences are skipped.
+// A line break ends the capture because the slave is not echoing the line.
+func (ir *inputReconstructor) observeOutput(data []byte) {
+	ir.mutex.Lock()
+	defer ir.mutex.Unlock()
+
+	if ir.secretMatcher != nil {
+		ir.recentOutput = append(ir.recentOutput, data...)
+		if over := len(ir.recentOutput) - recentOutputSize; over > 0 {
+			ir.recentOutput = append(ir.recentOutput[:0], ir.recentOutput[over:]...)
+		}
+	}
+
+	if ir.echo == echoNone {
+		return
+	}
+
+	for _, b := range data {
+		switch ir.escape {
+		case escapeStart:
+			if b == '[' {
+				ir.escape = escapeCSI
+				ir.escapeParams = ir.escapeParams[:0]
+			} else {
+				ir.escape = escapeNone
+			}
+			continue
+		case escapeCSI:
+			if b >= 0x40 && b <= 0x7e {
+				ir.escape = escapeNone
+				ir.applyEchoCSI(b)
+			} else {
+				ir.escapeParams = append(ir.escapeParams, b)
+			}
+			continue
+		}
+
+		switch {
+		case b == 0x1b:
+			ir.escape = escapeStart
+		case b == '\r' || b == '\n':
+			ir.endEcho()
+			return
+		case b == '\b':
+			ir.columnsBack(1)
+			ir.echoApplied = true
+		case b >= 0x20 && b != 0x7f:
+			ir.echoPartial = append(ir.echoPartial, b)
+			if b >= utf8.RuneSelf && !utf8.FullRune(ir.echoPartial) {
+				continue
+			}
+			for len(ir.echoPartial) > 0 && utf8.FullRune(ir.echoPartial) {
+				r, size := utf8.DecodeRune(ir.echoPartial)
+				if r == utf8.RuneError && size == 1 {
+					// a rune cut short by the next byte
+					ir.echoPartial = ir.echoPartial[1:]
+					continue
+				}
+				ir.echoRune(ir.echoPartial[:size])
+				ir.echoPartial = ir.echoPartial[size:]
+			}
+			ir.echoApplied = true
+		}
+	}
+}
+
+// applyEchoCSI applies the echoed CSI sequence ending with final.
+func (ir *inputReconstructor) applyEchoCSI(final byte) {
+	n, err := strconv.Atoi(string(ir.escapeParams))
+	if err != nil || n < 1 {
+		n = 1
+	}
+	switch final {
+	case 'C':
+		ir.columnsForward(n)
+	case 'D':
+		ir.columnsBack(n)
+	case 'K':
+		if len(ir.escapeParams) == 0 || string(ir.escapeParams) == "0" {
+			ir.settleHalf()
+			ir.line = ir.line[:ir.cursor]
+		}
+	case 'P':
+		ir.settleHalf()
+		ir.kill(ir.cursor, ir.columnsEnd(ir.cursor, n))
+	case '@':
+		ir.settleHalf()
+		cursor := ir.cursor
+		ir.insert(bytes.Repeat([]byte{' '}, n))
+		ir.cursor = cursor
+	default:
+		return
+	}
+	ir.echoApplied = true
+}
+
+// echoRune writes the echoed rune encoded at the cursor,
+// overwriting the runes on the columns it takes.
+func (ir *inputReconstructor) echoRune(encoded []byte) {
+	ir.settleHalf()
+	r, _ := utf8.DecodeRune(encoded)
+	ir.kill(ir.cursor, ir.columnsEnd(ir.cursor, runeWidth(r)))
+	ir.insert(encoded)
+}
+
+// columnsBack moves the cursor n columns back as the terminal does,
+// wide runes taking two columns.
+func (ir *inputReconstructor) columnsBack(n int) {
+	for ; n > 0 && ir.cursor > 0; n-- {
+		r, size := utf8.DecodeLastRune(ir.line[:ir.cursor])
+		if runeWidth(r) == 2 && !ir.echoHalf {
+			ir.echoHalf = true
+			continue
+		}
+		ir.echoHalf = false
+		ir.cursor -= size
+	}
+}
+
+// columnsForward moves the cursor n columns forward as the terminal does.
+func (ir *inputReconstructor) columnsForward(n int) {
+	if n > 0 && ir.echoHalf {
+		ir.echoHalf = false
+		n--
+	}
+	for ; n > 0 && ir.cursor < len(ir.line); n-- {
+		r, size := utf8.DecodeRune(ir.line[ir.cursor:])
+		ir.cursor += size
+		if runeWidth(r) == 2 {
+			if n == 1 {
+				ir.echoHalf = true
+				return
+			}
+			n--
+		}
+	}
+}
+
+// columnsEnd returns the end of the runes on the n columns from pos.
+func (ir *inputReconstructor) columnsEnd(pos int, n int) int {
+	for n > 0 && pos < len(ir.line) {
+		r, size := utf8.DecodeRune(ir.line[pos:])
+		pos += size
+		n -= runeWidth(r)
+	}
+	return pos
+}
+
+// settleHalf moves the cursor to the start of the wide rune its terminal
+// cursor is on, which the terminal erases whole when it's overwritten.
+func (ir *inputReconstructor) settleHalf() {
+	if ir.echoHalf {
+		ir.cursor = ir.runeBack(ir.cursor)
+		ir.echoHalf = false
+	}
+}
+
+// current returns the line typed so far, not submitted yet.
+func (ir *inputReconstructor) current() string {
+	ir.mutex.Lock()
+	defer ir.mutex.Unlock()
+
+	return ir.lineLocked()
+}
+
+// lineLocked returns the line, masked when it's a secret.
+func (ir *inputReconstructor) lineLocked() string {
+	if ir.secret {
+		return secretMask
+	}
+	return string(ir.line)
+}
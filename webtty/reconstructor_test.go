@@ -0,0 +1,420 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func feedString(ir *inputReconstructor, keys string) (string, bool) {
+	var (
+		line string
+		ok   bool
+	)
+	for i := 0; i < len(keys); i++ {
+		if lines := ir.feed([]byte{Input, keys[i]}); len(lines) > 0 {
+			line, ok = lines[len(lines)-1], true
+		}
+	}
+	return line, ok
+}
+
+func TestInputReconstructor(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	line, ok := feedString(ir, "ls -l\r")
+	if !ok || line != "ls -l" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	line, ok = feedString(ir, "cat\x7fd\r")
+	if !ok || line != "cad" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	line, ok = feedString(ir, "cat\x08d\r")
+	if !ok || line != "cad" {
+		t.Fatalf("Unexpected line with BS: `%s` (%t)", line, ok)
+	}
+
+	// non Input frames are ignored
+	if lines := ir.feed([]byte{Ping}); len(lines) != 0 {
+		t.Fatalf("Unexpected line from Ping")
+	}
+	if lines := ir.feed([]byte{ResizeTerminal, '\r'}); len(lines) != 0 {
+		t.Fatalf("Unexpected line from ResizeTerminal")
+	}
+}
+
+func TestInputReconstructorEraseKeys(t *testing.T) {
+	ir := newInputReconstructor([]byte{127})
+
+	line, ok := feedString(ir, "ab\x08\r")
+	if !ok || line != "ab\x08" {
+		t.Fatalf("Unexpected line: `%q` (%t)", line, ok)
+	}
+
+	line, ok = feedString(ir, "cat\x7fd\r")
+	if !ok || line != "cad" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+}
+
+// legacyFeed is the string based reconstruction used before
+// inputReconstructor, kept to compare performance.
+func legacyFeed(log string, frame []byte) (string, string, bool) {
+	if len(frame) == 2 {
+		if string(frame) == string([]byte{49, 13}) {
+			return "", log, true
+		} else if string(frame) == string([]byte{49, 127}) {
+			if len(log) >= 2 {
+				log = log[:len(log)-2]
+			} else if len(log) == 1 {
+				log = ""
+			}
+		} else if string(frame[0]) == string([]byte{49}) {
+			log = log + string(rune(frame[1]))
+		}
+	}
+	return log, "", false
+}
+
+var benchmarkFrames = func() [][]byte {
+	frames := [][]byte{}
+	for _, key := range []byte("kubectl get pods --all-namespaces\x7f\x7fs\r") {
+		frames = append(frames, []byte{Input, key})
+	}
+	return frames
+}()
+
+func BenchmarkInputReconstructor(b *testing.B) {
+	b.ReportAllocs()
+	ir := newInputReconstructor(DefaultEraseKeys)
+	for i := 0; i < b.N; i++ {
+		for _, frame := range benchmarkFrames {
+			ir.feed(frame)
+		}
+	}
+}
+
+func BenchmarkLegacyReconstruction(b *testing.B) {
+	b.ReportAllocs()
+	log := ""
+	for i := 0; i < b.N; i++ {
+		for _, frame := range benchmarkFrames {
+			log, _, _ = legacyFeed(log, frame)
+		}
+	}
+}
+
+func TestCurrentInput(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, connOutPipeWriter := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	slaveOutPipeReader, _ := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave, WithPermitWrite())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dt.Run(ctx, "", "")
+
+	readBuf := make([]byte, 1024)
+	connInPipeReader.Read(readBuf) // window title
+	connInPipeReader.Read(readBuf) // read only
+
+	for _, key := range []byte("ls") {
+		connOutPipeWriter.Write([]byte{Input, key})
+		slaveInPipeReader.Read(readBuf)
+	}
+
+	if input := dt.CurrentInput(); input != "ls" {
+		t.Fatalf("Unexpected current input: `%s`", input)
+	}
+}
+
+func TestInputReconstructorTabComplete(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	feedString(ir, "cat /et\t")
+	ir.observeOutput([]byte("c/"))
+	line, ok := feedString(ir, "hosts\r")
+	if !ok || line != "cat /etc/hosts" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	// no completion echoed, e.g. ambiguous candidates
+	feedString(ir, "ls /u\t")
+	ir.observeOutput([]byte("\a\r\nusr/ users/\r\n$ ls /u"))
+	line, ok = feedString(ir, "\r")
+	if !ok || line != "ls /u"+tabCompleteMarker {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+}
+
+func TestInputReconstructorHistory(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	feedString(ir, "ls")
+	ir.feed([]byte("1\x1b[A"))
+	// readline moves back over the current line and prints the recalled one
+	ir.observeOutput([]byte("\b\bkubectl get po\x1b[K"))
+	line, ok := feedString(ir, "ds\r")
+	if !ok || line != "kubectl get pods" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+}
+
+func TestInputReconstructorRapidEdits(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+	random := rand.New(rand.NewSource(1))
+	alphabet := []rune("abcxyz -/日本")
+
+	for round := 0; round < 100; round++ {
+		var (
+			expected []rune
+			pending  []byte
+		)
+		for edit := 0; edit < 200; edit++ {
+			if random.Intn(3) == 0 {
+				pending = append(pending, DefaultEraseKeys[random.Intn(len(DefaultEraseKeys))])
+				if len(expected) > 0 {
+					expected = expected[:len(expected)-1]
+				}
+			} else {
+				r := alphabet[random.Intn(len(alphabet))]
+				pending = append(pending, string(r)...)
+				expected = append(expected, r)
+			}
+			if random.Intn(2) == 0 {
+				pending = append(pending, "\x1b[D\x1b[C"...)
+			}
+
+			// keys are batched into frames of random sizes
+			if random.Intn(4) == 0 {
+				if lines := ir.feed(append([]byte{Input}, pending...)); len(lines) != 0 {
+					t.Fatalf("Unexpected lines before Enter: %q", lines)
+				}
+				pending = pending[:0]
+			}
+		}
+
+		lines := ir.feed(append(append([]byte{Input}, pending...), '\r'))
+		if len(lines) != 1 || lines[0] != string(expected) {
+			t.Fatalf("Unexpected lines in round %d: %q, expected %q", round, lines, string(expected))
+		}
+	}
+}
+
+func TestInputReconstructorMultipleLines(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	lines := ir.feed([]byte("1cd /tmp\rls\x7fs -l\r"))
+	if len(lines) != 2 || lines[0] != "cd /tmp" || lines[1] != "ls -l" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+}
+
+func TestInputReconstructorPaste(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	lines := ir.feed([]byte("1cd /tmp\r\nls -l\nuname\r"))
+	if len(lines) != 3 || lines[0] != "cd /tmp" || lines[1] != "ls -l" || lines[2] != "uname" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+
+	// CRLF split across frames submits once
+	if lines := ir.feed([]byte("1pwd\r")); len(lines) != 1 || lines[0] != "pwd" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+	if lines := ir.feed([]byte("1\n")); len(lines) != 0 {
+		t.Fatalf("Unexpected lines from LF after CR: %q", lines)
+	}
+}
+
+func TestInputReconstructorBracketedPaste(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	// pasted lines are run one by one on Enter
+	keys := "for f in *; do\x1b[200~\r\n  wc -l $f\r\n\rdone\n\x1b[201~\r"
+	lines := ir.feedLines(append([]byte{Input}, keys...))
+	if len(lines) != 3 || lines[0].line != "for f in *; do" || lines[1].line != "  wc -l $f" || lines[2].line != "done" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+	if lines[0].keys != keys[:len(keys)-1] || lines[1].keys != "" || lines[2].keys != "" {
+		t.Fatalf("Unexpected keys: %q", lines)
+	}
+
+	// the paste is inserted at the cursor
+	lines = ir.feedLines([]byte("1echo \x1b[200~a\nb\x1b[201~c\x1b[D\x7f\r"))
+	if len(lines) != 2 || lines[0].line != "echo a" || lines[1].line != "c" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+
+	// a paste split across frames
+	var got []string
+	for _, frame := range []string{"1\x1b[200~ls\r", "1\npwd\x1b[2", "101~\r"} {
+		got = append(got, ir.feed([]byte(frame))...)
+	}
+	if len(got) != 2 || got[0] != "ls" || got[1] != "pwd" {
+		t.Fatalf("Unexpected lines: %q", got)
+	}
+
+	// a paste of blank lines submits an empty line
+	if lines := ir.feed([]byte("1\x1b[200~\n \n\x1b[201~\r")); len(lines) != 1 || lines[0] != "" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+}
+
+func TestInputReconstructorUTF8(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	lines := ir.feed(append([]byte{Input}, "echo 你好\r"...))
+	if len(lines) != 1 || lines[0] != "echo 你好" {
+		t.Fatalf("Unexpected lines: %q", lines)
+	}
+
+	// runes split across frames
+	keys := []byte("echo 中文\x7f字\r")
+	var got []string
+	for i := 0; i < len(keys); i++ {
+		got = append(got, ir.feed([]byte{Input, keys[i]})...)
+	}
+	if len(got) != 1 || got[0] != "echo 中字" {
+		t.Fatalf("Unexpected lines: %q", got)
+	}
+}
+
+func TestInputReconstructorLineEditing(t *testing.T) {
+	cases := []struct {
+		keys     string
+		expected string
+	}{
+		{"ls -l\x1b[D\x1b[D-a \r", "ls -a -l"},
+		{"cat fle\x02\x02i\r", "cat file"},
+		{"echo b\x01sudo \x05c\r", "sudo echo bc"},
+		{"echo b\x1b[Hsudo \x1b[Fc\r", "sudo echo bc"},
+		{"echo 你好\x1b[D\x1b[D世界\r", "echo 世界你好"},
+		{"rm -rf /tmp\x15ls\r", "ls"},
+		{"cat /etc/hosts\x1b[D\x1b[D\x1b[D\x1b[D\x1b[D\x0b\r", "cat /etc/"},
+		{"git commit -m wip\x17\x17\x7f\r", "git commit"},
+		{"kubectl get pods\x1bb\x1bbdelete \x1bd\x7f\r", "kubectl delete pods"},
+		{"echo foo-bar\x1b\x7fbaz\r", "echo foo-baz"},
+		{"ssh hots\x1b[D\x1b[D\x1b[3~\x04st\r", "ssh host"},
+		{"ls /tmp\x03pwd\r", "pwd"},
+		{"\x12kube\r", historySearchMarker + "kube"},
+	}
+	for _, c := range cases {
+		ir := newInputReconstructor(DefaultEraseKeys)
+		lines := ir.feed(append([]byte{Input}, c.keys...))
+		if len(lines) != 1 || lines[0] != c.expected {
+			t.Errorf("Unexpected lines for %q: %q, expected %q", c.keys, lines, c.expected)
+		}
+	}
+
+	// escape sequences split across frames
+	ir := newInputReconstructor(DefaultEraseKeys)
+	var got []string
+	for _, key := range []byte("ab\x1b[Dc\x1b[3~\r") {
+		got = append(got, ir.feed([]byte{Input, key})...)
+	}
+	if len(got) != 1 || got[0] != "ac" {
+		t.Fatalf("Unexpected lines: %q", got)
+	}
+}
+
+func TestInputReconstructorEchoRedraw(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	// completion in the middle of the line, readline prints the completion
+	// with the rest of the line and moves back
+	feedString(ir, "cat /et -n\x1b[D\x1b[D\x1b[D\t")
+	ir.observeOutput([]byte("c/ -n\b\b\b"))
+	line, ok := feedString(ir, "hosts\r")
+	if !ok || line != "cat /etc/hosts -n" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	// the same with the terminal inserting and deleting characters
+	feedString(ir, "cat /et -n\x1b[D\x1b[D\x1b[D\t")
+	ir.observeOutput([]byte("\x1b[3@c/x\b\x1b[P"))
+	line, ok = feedString(ir, "hosts\r")
+	if !ok || line != "cat /etc/hosts -n" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	// a shorter line recalled from the history with the cursor in the middle
+	feedString(ir, "kubectl get pods\x1b[D\x1b[D\x1b[D\x1b[D")
+	ir.feed([]byte("1\x1b[A"))
+	ir.observeOutput([]byte("\x1b[4Dls -l\x1b[K"))
+	line, ok = feedString(ir, "a\r")
+	if !ok || line != "kubectl ls -la" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	// recall with Ctrl-P, characters redrawn in place overwrite the line
+	feedString(ir, "ls")
+	ir.feed([]byte{Input, 0x10})
+	ir.observeOutput([]byte("\b\bgit status\x1b[2Dus"))
+	line, ok = feedString(ir, "\r")
+	if !ok || line != "git status" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+}
+
+func TestInputReconstructorEchoWide(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	// the terminal moves back two columns over wide runes
+	feedString(ir, "echo 中文件")
+	ir.feed([]byte{Input, 0x10})
+	ir.observeOutput([]byte("\b\b\b\bab\x1b[K"))
+	line, ok := feedString(ir, "\r")
+	if !ok || line != "echo 中ab" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	feedString(ir, "cat 中文 x")
+	ir.feed([]byte{Input, 0x10})
+	ir.observeOutput([]byte("\x1b[6D到"))
+	line, ok = feedString(ir, "\r")
+	if !ok || line != "cat 到文 x" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+
+	// echoed runes split across writes, and cut short
+	feedString(ir, "ls 中")
+	ir.feed([]byte{Input, 0x10})
+	ir.observeOutput([]byte("\b\b\xe4\xbd"))
+	ir.observeOutput([]byte("\xa0\xe5好"))
+	line, ok = feedString(ir, "\r")
+	if !ok || line != "ls 你好" {
+		t.Fatalf("Unexpected line: `%s` (%t)", line, ok)
+	}
+}
+
+func TestInputReconstructorKeys(t *testing.T) {
+	ir := newInputReconstructor(DefaultEraseKeys)
+
+	// keys split across frames, up to the key submitting each line
+	var lines []submittedLine
+	for _, frame := range []string{"1l", "1s\x1b", "1[D\x7f", "1x\r\ncd", "1 /\x03pwd\r"} {
+		lines = append(lines, ir.feedLines([]byte(frame))...)
+	}
+	if len(lines) != 2 || lines[0].line != "xs" || lines[0].keys != "ls\x1b[D\x7fx" || lines[1].line != "pwd" || lines[1].keys != "pwd" {
+		t.Fatalf("Unexpected lines: %+v", lines)
+	}
+
+	ir.secretMatcher = func(recentOutput []byte) bool { return true }
+	lines = ir.feedLines([]byte("1hunter2\r"))
+	if len(lines) != 1 || lines[0].line != secretMask || lines[0].keys != secretMask {
+		t.Fatalf("Unexpected secret lines: %+v", lines)
+	}
+}
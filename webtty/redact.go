@@ -0,0 +1,78 @@
+package webtty
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// redactionRule masks the first capturing group of the matches of pattern,
+// or the whole matches without one, when accept is nil or returns true.
+type redactionRule struct {
+	pattern *regexp.Regexp
+	accept  func(secret string) bool
+}
+
+var defaultRedactionRules = []redactionRule{
+	// the password is attached to -p, as in mysql -psecret
+	{pattern: regexp.MustCompile(`\b(?:mysql|mysqldump|mysqladmin|mariadb)\b.*?\s-p(\S+)`)},
+	{pattern: regexp.MustCompile(`\bsshpass\b.*?\s-p\s*(\S+)`)},
+	{pattern: regexp.MustCompile(`(?i)--password(?:=|\s+)(\S+)`)},
+	// AWS access key IDs and secret access keys
+	{pattern: regexp.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`)},
+	{pattern: regexp.MustCompile(`(?i)\baws_secret_access_key\b\s*[=:]?\s*(\S+)`)},
+	{pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9._~+/-]+=*)`)},
+	// base64 blobs of 30 bytes or more, also found in unprefixed tokens
+	{pattern: regexp.MustCompile(`[A-Za-z0-9+/_-]{40,}={0,2}`), accept: isMixedCase},
+}
+
+// isMixedCase tells blobs from hex digests and long words:
+// s must have upper and lower case letters, and digits.
+func isMixedCase(s string) bool {
+	return strings.IndexFunc(s, unicode.IsUpper) >= 0 &&
+		strings.IndexFunc(s, unicode.IsLower) >= 0 &&
+		strings.IndexFunc(s, unicode.IsDigit) >= 0
+}
+
+// NewRedactor returns a function masking as "***" the passwords given
+// to mysql with -p, to sshpass with -p or to any command with --password,
+// the AWS access keys, the bearer tokens and the base64 blobs in a text,
+// then the matches of patterns. Only the first capturing group of
+// a pattern is masked when it has one, such as `token=(\S+)`.
+func NewRedactor(patterns ...*regexp.Regexp) func(text string) string {
+	rules := append([]redactionRule{}, defaultRedactionRules...)
+	for _, pattern := range patterns {
+		rules = append(rules, redactionRule{pattern: pattern})
+	}
+
+	return func(text string) string {
+		for _, rule := range rules {
+			text = rule.redact(text)
+		}
+		return text
+	}
+}
+
+func (rule *redactionRule) redact(text string) string {
+	matches := rule.pattern.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+
+	var redacted strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if len(match) >= 4 {
+			start, end = match[2], match[3]
+		}
+		if start < 0 || start == end || (rule.accept != nil && !rule.accept(text[start:end])) {
+			continue
+		}
+		redacted.WriteString(text[last:start])
+		redacted.WriteString(secretMask)
+		last = end
+	}
+	redacted.WriteString(text[last:])
+	return redacted.String()
+}
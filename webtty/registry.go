@@ -0,0 +1,121 @@
+package webtty
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Registry keeps the sessions that can be shared by their IDs,
+// so that other masters can watch them with Observe.
+type Registry struct {
+	mutex    sync.Mutex
+	sessions map[string]*registeredSession
+}
+
+type registeredSession struct {
+	wt   *WebTTY
+	done chan struct{}
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{sessions: make(map[string]*registeredSession)}
+}
+
+// Register makes wt observable by its session ID until unregister is
+// called, typically once Run returns. The ID is read when Register is
+// called, so it must not be changed by the context given to Run.
+func (r *Registry) Register(wt *WebTTY) (unregister func(), err error) {
+	id := wt.Session().SessionID
+	session := &registeredSession{wt: wt, done: make(chan struct{})}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.sessions[id]; ok {
+		return nil, errors.Errorf("session `%s` is already registered", id)
+	}
+	r.sessions[id] = session
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mutex.Lock()
+			delete(r.sessions, id)
+			r.mutex.Unlock()
+			close(session.done)
+		})
+	}, nil
+}
+
+// Sessions returns the identity of the registered sessions.
+func (r *Registry) Sessions() []SessionInfo {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	infos := make([]SessionInfo, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		infos = append(infos, session.wt.Session())
+	}
+	return infos
+}
+
+// Features returns the features negotiated by the session registered as id,
+// which observers have to support.
+func (r *Registry) Features(id string) (FeatureSet, bool) {
+	r.mutex.Lock()
+	session, ok := r.sessions[id]
+	r.mutex.Unlock()
+	if !ok {
+		return FeatureSet{}, false
+	}
+	return session.wt.NegotiatedFeatures(), true
+}
+
+// Observe attaches master to the session registered as id with
+// AddObserver, and blocks until the reads of master fail, the session
+// is unregistered, or ctx is canceled. It returns nil when the session
+// ended, and an error matching ErrMasterClosed when master failed.
+func (r *Registry) Observe(ctx context.Context, id string, master Master) error {
+	r.mutex.Lock()
+	session, ok := r.sessions[id]
+	r.mutex.Unlock()
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	watched := &watchedMaster{Master: master, failed: make(chan struct{})}
+	err := session.wt.AddObserver(watched)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-watched.failed:
+		return &closedError{sentinel: ErrMasterClosed, cause: watched.err}
+	case <-session.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// watchedMaster closes failed when a read of its master fails.
+type watchedMaster struct {
+	Master
+	err    error
+	failed chan struct{}
+	once   sync.Once
+}
+
+func (wm *watchedMaster) Read(p []byte) (int, error) {
+	n, err := wm.Master.Read(p)
+	if err != nil {
+		wm.once.Do(func() {
+			wm.err = err
+			close(wm.failed)
+		})
+	}
+	return n, err
+}
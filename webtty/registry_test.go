@@ -0,0 +1,75 @@
+package webtty
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithSessionID("shared"))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	unregister, err := registry.Register(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error from Register(): %s", err)
+	}
+	if _, err := registry.Register(dt); err == nil {
+		t.Fatalf("Expected an error registering a session twice")
+	}
+	if sessions := registry.Sessions(); len(sessions) != 1 || sessions[0].SessionID != "shared" {
+		t.Fatalf("Unexpected sessions: %v", sessions)
+	}
+
+	if err := registry.Observe(context.Background(), "unknown", discardMaster{}); err != ErrSessionNotFound {
+		t.Fatalf("Unexpected error from Observe(): %v", err)
+	}
+
+	// the session ends while observed
+	observer := &frameRecorder{}
+	observed := make(chan error, 1)
+	go func() {
+		observed <- registry.Observe(context.Background(), "shared", recordingMaster{observer})
+	}()
+	for deadline := time.Now().Add(time.Second); len(observer.get()) == 0; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Observer not attached")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	dt.sendOutput([]byte("foo"))
+	frames := observer.get()
+	if frames[len(frames)-1] != "1Zm9v" {
+		t.Fatalf("Unexpected frames for observer: %q", frames)
+	}
+	unregister()
+	if err := <-observed; err != nil {
+		t.Fatalf("Unexpected error from Observe(): %s", err)
+	}
+	if sessions := registry.Sessions(); len(sessions) != 0 {
+		t.Fatalf("Unexpected sessions: %v", sessions)
+	}
+	unregister()
+
+	// the observer disconnects
+	unregister, err = registry.Register(dt)
+	if err != nil {
+		t.Fatalf("Unexpected error from Register(): %s", err)
+	}
+	defer unregister()
+	closing := newClosingMaster()
+	go closing.Close()
+	err = registry.Observe(context.Background(), "shared", closing)
+	if !stderrors.Is(err, ErrMasterClosed) {
+		t.Fatalf("Unexpected error from Observe(): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := registry.Observe(ctx, "shared", recordingMaster{&frameRecorder{}}); err != context.Canceled {
+		t.Fatalf("Unexpected error from Observe(): %v", err)
+	}
+}
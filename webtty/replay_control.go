@@ -0,0 +1,53 @@
+package webtty
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Actions of a ReplayControl.
+const (
+	ReplayPause  = "pause"
+	ReplayResume = "resume"
+	ReplaySpeed  = "speed"
+	ReplaySeek   = "seek"
+)
+
+// ReplayControl changes the playback of a slave replaying a recording,
+// it is the payload of a ControlReplay message.
+type ReplayControl struct {
+	// ReplayPause, ReplayResume, ReplaySpeed or ReplaySeek
+	Action string `json:"action"`
+	// Playback rate set by ReplaySpeed, 2 to play twice as fast
+	Speed float64 `json:"speed,omitempty"`
+	// Position to go to with ReplaySeek, in seconds from the start
+	Offset float64 `json:"offset,omitempty"`
+}
+
+// ReplayController is implemented by slaves replaying a recording, such as
+// the ones of the backend/replay package, to be controlled by the master
+// with ControlReplay messages. The messages are applied whatever the write
+// permission of the master, since they don't reach a process.
+type ReplayController interface {
+	ControlReplay(control ReplayControl) error
+}
+
+// handleControlReplay applies a ControlReplay message to the slave,
+// it's ignored when the slave doesn't replay a recording.
+func (wt *WebTTY) handleControlReplay(payload []byte) error {
+	controller, ok := wt.currentSlave().(ReplayController)
+	if !ok {
+		return nil
+	}
+
+	var control ReplayControl
+	if err := json.Unmarshal(payload, &control); err != nil {
+		return malformedFrame(errors.Wrapf(err, "received malformed data for replay control"))
+	}
+	if err := controller.ControlReplay(control); err != nil {
+		// such as an unknown action or a speed out of range
+		return malformedFrame(errors.Wrapf(err, "failed to control replay"))
+	}
+	return nil
+}
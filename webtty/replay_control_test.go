@@ -0,0 +1,52 @@
+package webtty
+
+import (
+	"testing"
+)
+
+type controlledSlave struct {
+	*pipeSlave
+	controls []ReplayControl
+}
+
+func (cs *controlledSlave) ControlReplay(control ReplayControl) error {
+	cs.controls = append(cs.controls, control)
+	return nil
+}
+
+func TestControlReplay(t *testing.T) {
+	slave := &controlledSlave{pipeSlave: &pipeSlave{}}
+	// the master is read-only
+	dt, err := New(pipePair{}, slave)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for _, payload := range []string{`{"action":"pause"}`, `{"action":"speed","speed":2}`, `{"action":"seek","offset":12.5}`} {
+		if err := dt.handleMasterReadEvent([]byte(string(ControlReplay) + payload)); err != nil {
+			t.Fatalf("Unexpected error for %s: %s", payload, err)
+		}
+	}
+	expected := []ReplayControl{{Action: ReplayPause}, {Action: ReplaySpeed, Speed: 2}, {Action: ReplaySeek, Offset: 12.5}}
+	if len(slave.controls) != len(expected) {
+		t.Fatalf("Unexpected controls: %v", slave.controls)
+	}
+	for i, control := range slave.controls {
+		if control != expected[i] {
+			t.Fatalf("Unexpected control %d: %v", i, control)
+		}
+	}
+
+	if err := dt.handleMasterReadEvent([]byte(string(ControlReplay) + "pause")); err == nil {
+		t.Fatalf("Expected an error for a malformed control")
+	}
+
+	// other slaves ignore the controls
+	dt, err = New(pipePair{}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.handleMasterReadEvent([]byte(string(ControlReplay) + `{"action":"pause"}`)); err != nil {
+		t.Fatalf("Unexpected error without a replay: %s", err)
+	}
+}
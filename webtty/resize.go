@@ -0,0 +1,107 @@
+package webtty
+
+import (
+	"sync"
+	"time"
+)
+
+// resizeState tracks the size requested by the master. The size is kept
+// whether or not it applies, so that it's honored once it does again,
+// such as after SetTerminalSize or for a reattached master.
+//
+// With a debounce, the first resize of a burst applies at once and the
+// next ones at most once per debounce, the latest one last, so dragging
+// a window doesn't resize the slave on every step.
+type resizeState struct {
+	mutex    sync.Mutex
+	debounce time.Duration
+	// latest size requested by the master, 0 until it requests one
+	columns float64
+	rows    float64
+	// ends the burst, nil outside of one
+	timer   *time.Timer
+	pending bool
+
+	// serializes the resizes of the slave so an older size never
+	// applies after the latest one
+	applyMutex sync.Mutex
+}
+
+// requestResize records the size requested by the master and applies it,
+// unless a burst of resizes is in progress.
+func (wt *WebTTY) requestResize(columns float64, rows float64) error {
+	rs := &wt.resize
+	rs.mutex.Lock()
+	rs.columns, rs.rows = columns, rows
+	if rs.debounce > 0 {
+		if rs.timer != nil {
+			rs.pending = true
+			rs.mutex.Unlock()
+			return nil
+		}
+		rs.timer = time.AfterFunc(rs.debounce, wt.endResizeBurst)
+	}
+	rs.mutex.Unlock()
+
+	return wt.applyRequestedSize()
+}
+
+// endResizeBurst applies the size requested during the debounce, if any,
+// waiting another debounce for the next resizes.
+func (wt *WebTTY) endResizeBurst() {
+	rs := &wt.resize
+	rs.mutex.Lock()
+	if rs.timer == nil {
+		rs.mutex.Unlock()
+		return
+	}
+	pending := rs.pending
+	rs.pending = false
+	if pending {
+		rs.timer.Reset(rs.debounce)
+	} else {
+		rs.timer = nil
+	}
+	rs.mutex.Unlock()
+
+	if pending {
+		wt.applyRequestedSize()
+	}
+}
+
+// stopResizes drops the resize of the burst in progress, if any.
+func (wt *WebTTY) stopResizes() {
+	rs := &wt.resize
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if rs.timer != nil {
+		rs.timer.Stop()
+		rs.timer = nil
+	}
+	rs.pending = false
+}
+
+// requestedSize returns the latest size requested by the master,
+// false when it hasn't requested any.
+func (wt *WebTTY) requestedSize() (float64, float64, bool) {
+	rs := &wt.resize
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return rs.columns, rs.rows, rs.columns >= 1 && rs.rows >= 1
+}
+
+// applyRequestedSize resizes the slave to the latest size requested by
+// the master, according to the size mode.
+func (wt *WebTTY) applyRequestedSize() error {
+	rs := &wt.resize
+	rs.applyMutex.Lock()
+	defer rs.applyMutex.Unlock()
+
+	columns, rows, ok := wt.requestedSize()
+	if !ok || wt.sizeLocked() {
+		return nil
+	}
+	return wt.applySize(wt.fitSize(columns, rows))
+}
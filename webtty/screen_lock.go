@@ -0,0 +1,224 @@
+package webtty
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// screenLockMarker prefixes the locks and the unlocks of the screen
+// in the audit trail.
+const screenLockMarker = "[screen-lock] "
+
+const (
+	// DefaultScreenLockBufferSize is the number of bytes of output held
+	// while the screen is locked, the latest ones are sent once unlocked.
+	DefaultScreenLockBufferSize = 64 * 1024
+
+	// maxUnlockAttempts is the number of failed unlocks
+	// after which Run returns ErrUnlockFailed.
+	maxUnlockAttempts = 5
+)
+
+// screenLock is the state of the screen lock of WithScreenLock.
+type screenLock struct {
+	idle   time.Duration
+	unlock func(identity Identity, credential string) error
+
+	mutex    sync.Mutex // also serializes the output sent once unlocked
+	locked   bool
+	failures int
+	// output of the slave while locked
+	held *replayBuffer
+}
+
+// screenLockRequest is the payload of an Unlock message.
+type screenLockRequest struct {
+	Credential string `json:"credential"`
+}
+
+// screenLockStatus is the payload of a ScreenLock message.
+type screenLockStatus struct {
+	Locked bool `json:"locked"`
+	// Why the latest unlock failed
+	Error string `json:"error,omitempty"`
+}
+
+// WithScreenLock locks the screen once the master sends no input for idle,
+// for the terminals left open. While locked, the input of the master and
+// its other requests changing the session are dropped, and the latest
+// DefaultScreenLockBufferSize bytes of output are held, the slave keeps
+// running. The ScreenLocked message is printed and the master is sent
+// a ScreenLock message, to be answered with an Unlock message carrying
+// a credential. The session resumes once unlock accepts the credential
+// for the identity of the master, see WithIdentityContext, and Run returns
+// ErrUnlockFailed after 5 failed attempts. Locks and unlocks are recorded
+// in the audit trail. It enables the ScreenLock feature on this end, the
+// screen of masters not advertising it, which couldn't unlock it, is not
+// locked, see WithClientFeatures.
+func WithScreenLock(idle time.Duration, unlock func(identity Identity, credential string) error) Option {
+	return func(wt *WebTTY) error {
+		if idle <= 0 {
+			return errors.New("screen lock idle time must be positive")
+		}
+		if unlock == nil {
+			return errors.New("screen lock requires an unlock function")
+		}
+		wt.screenLock = &screenLock{
+			idle:   idle,
+			unlock: unlock,
+			held:   newReplayBuffer(DefaultScreenLockBufferSize),
+		}
+		wt.serverFeatures.ScreenLock = true
+		return nil
+	}
+}
+
+// ScreenLocked returns whether the screen is locked, see WithScreenLock.
+func (wt *WebTTY) ScreenLocked() bool {
+	sl := wt.screenLock
+	if sl == nil {
+		return false
+	}
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	return sl.locked
+}
+
+// lockScreen locks the screen the master left idle.
+func (wt *WebTTY) lockScreen() {
+	sl := wt.screenLock
+	if wt.ScreenLocked() {
+		return
+	}
+	// printed before the output is held
+	wt.printMessage(strings.Replace(wt.messages.ScreenLocked, "%s", sl.idle.String(), 1))
+
+	sl.mutex.Lock()
+	sl.locked = true
+	sl.failures = 0
+	sl.mutex.Unlock()
+
+	identity := wt.Identity()
+	wt.auditScreenLock("locked after " + sl.idle.String() + " idle for " + identity.User)
+	wt.sendScreenLock(screenLockStatus{Locked: true})
+}
+
+// holdOutput holds data while the screen is locked, it returns false
+// when the screen isn't and data is to be sent.
+func (wt *WebTTY) holdOutput(data []byte) bool {
+	sl := wt.screenLock
+	if sl == nil {
+		return false
+	}
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	if !sl.locked {
+		return false
+	}
+	sl.held.write(data)
+	return true
+}
+
+// holdReplay replaces the output held for a reattached master
+// with the contents of the replay buffer, which it didn't receive
+// as the screen is locked.
+func (wt *WebTTY) holdReplay(replay []byte) {
+	sl := wt.screenLock
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	sl.held = newReplayBuffer(DefaultScreenLockBufferSize)
+	sl.held.write(replay)
+}
+
+// lockedFrame returns whether the frames of type t are handled while
+// the screen is locked, such as the frames keeping the connection alive.
+func lockedFrame(t byte) bool {
+	switch t {
+	case Ping, KeepAlivePong, AcknowledgeOutput, RequestStats, ResizeTerminal, Unlock:
+		return true
+	}
+	return false
+}
+
+// handleUnlock checks the credential of an Unlock message, whose payload
+// is a JSON object, and resumes the session once accepted.
+// An unlock of a screen that isn't locked is answered with its state.
+func (wt *WebTTY) handleUnlock(payload []byte) error {
+	var request screenLockRequest
+	if err := json.Unmarshal(payload, &request); err != nil {
+		return malformedFrame(errors.Wrapf(err, "received malformed data for unlock"))
+	}
+	if !wt.ScreenLocked() {
+		return wt.sendScreenLock(screenLockStatus{Locked: false})
+	}
+
+	sl := wt.screenLock
+	identity := wt.Identity()
+	if err := sl.unlock(identity, request.Credential); err != nil {
+		sl.mutex.Lock()
+		sl.failures++
+		failures := sl.failures
+		sl.mutex.Unlock()
+
+		wt.auditScreenLock("unlock denied to " + identity.User + ": " + err.Error())
+		if failures >= maxUnlockAttempts {
+			return ErrUnlockFailed
+		}
+		return wt.sendScreenLock(screenLockStatus{Locked: true, Error: "invalid credential"})
+	}
+
+	// the input resumes from now, not from the lock
+	storeTime(&wt.lastActivity, time.Now())
+	sl.mutex.Lock()
+	sl.locked = false
+	held := sl.held.contents()
+	sl.held = newReplayBuffer(DefaultScreenLockBufferSize)
+	wt.auditScreenLock("unlocked by " + identity.User)
+	err := wt.sendScreenLock(screenLockStatus{Locked: false})
+	if err == nil && len(held) > 0 {
+		// before any new output, which waits for the lock
+		err = wt.sendHeldOutput(held)
+	}
+	sl.mutex.Unlock()
+	return err
+}
+
+// sendHeldOutput sends the output held while the screen was locked.
+func (wt *WebTTY) sendHeldOutput(held []byte) error {
+	if wt.coalescer != nil {
+		return wt.coalescer.write(held)
+	}
+	return wt.sendOutput(held)
+}
+
+// sendScreenLock sends status in a ScreenLock message.
+func (wt *WebTTY) sendScreenLock(status screenLockStatus) error {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal screen lock")
+	}
+	err = wt.primaryWrite(append([]byte{ScreenLock}, payload...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send screen lock")
+	}
+	return nil
+}
+
+// screenLockMessage returns the ScreenLock message telling a reattached
+// master the screen is locked.
+func screenLockMessage() []byte {
+	payload, _ := json.Marshal(screenLockStatus{Locked: true})
+	return append([]byte{ScreenLock}, payload...)
+}
+
+func (wt *WebTTY) auditScreenLock(change string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, screenLockMarker+change)
+}
@@ -0,0 +1,139 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func newScreenLockTTY(t *testing.T, rec *frameRecorder, idle time.Duration, opts ...Option) *WebTTY {
+	slaveReader, slaveWriter := io.Pipe()
+	t.Cleanup(func() { slaveWriter.Close() })
+	opts = append([]Option{WithPermitWrite(),
+		WithIdentity(Identity{User: "alice"}),
+		WithMessages("en", Messages{ScreenLocked: "[locked after %s]"}),
+		WithScreenLock(idle, func(identity Identity, credential string) error {
+			if identity.User != "alice" || credential != "secret" {
+				return errors.New("invalid credentials")
+			}
+			return nil
+		})}, opts...)
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, opts...)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	return dt
+}
+
+func TestScreenLock(t *testing.T) {
+	rec := &frameRecorder{}
+	dt := newScreenLockTTY(t, rec, time.Minute)
+
+	dt.lockScreen()
+	if !dt.ScreenLocked() {
+		t.Fatalf("Expected the screen to be locked")
+	}
+	if err := dt.handleMasterReadEvent([]byte("1ls")); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if input := dt.CurrentInput(); input != "" {
+		t.Fatalf("Unexpected input while locked: %q", input)
+	}
+	if !dt.LastActivity().IsZero() {
+		t.Fatalf("Unexpected activity while locked: %s", dt.LastActivity())
+	}
+	if err := dt.writeOutput([]byte("held")); err != nil {
+		t.Fatalf("Unexpected error from writeOutput(): %s", err)
+	}
+
+	unlock := func(credential string) error {
+		return dt.handleMasterReadEvent([]byte(string(Unlock) + `{"credential":"` + credential + `"}`))
+	}
+	if err := unlock("wrong"); err != nil {
+		t.Fatalf("Unexpected error from a failed unlock: %s", err)
+	}
+	if !dt.ScreenLocked() {
+		t.Fatalf("Expected the screen to stay locked")
+	}
+	if err := unlock("secret"); err != nil {
+		t.Fatalf("Unexpected error from unlock: %s", err)
+	}
+	if dt.ScreenLocked() {
+		t.Fatalf("Expected the screen to be unlocked")
+	}
+
+	want := []string{
+		"1" + base64.StdEncoding.EncodeToString([]byte("\r\n[locked after 1m0s]\r\n")),
+		`K{"locked":true}`,
+		`K{"locked":true,"error":"invalid credential"}`,
+		`K{"locked":false}`,
+		"1" + base64.StdEncoding.EncodeToString([]byte("held")),
+	}
+	if frames := rec.get(); !reflect.DeepEqual(frames, want) {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	if dt.LastActivity().IsZero() {
+		t.Fatalf("Expected the unlock to count as activity")
+	}
+}
+
+func TestScreenLockAttempts(t *testing.T) {
+	dt := newScreenLockTTY(t, &frameRecorder{}, time.Minute)
+
+	dt.lockScreen()
+	for i := 1; i <= maxUnlockAttempts; i++ {
+		err := dt.handleMasterReadEvent([]byte(string(Unlock) + `{"credential":"wrong"}`))
+		if i < maxUnlockAttempts && err != nil {
+			t.Fatalf("Unexpected error from attempt %d: %s", i, err)
+		}
+		if i == maxUnlockAttempts && err != ErrUnlockFailed {
+			t.Fatalf("Unexpected error from the last attempt: %v", err)
+		}
+	}
+}
+
+func TestScreenLockIdle(t *testing.T) {
+	dt := newScreenLockTTY(t, &frameRecorder{}, 20*time.Millisecond, WithClientFeatures(FeatureSet{ScreenLock: true}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- dt.Run(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !dt.ScreenLocked() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the idle screen to be locked")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestScreenLockUnsupported(t *testing.T) {
+	// the master doesn't advertise the ScreenLock feature
+	dt := newScreenLockTTY(t, &frameRecorder{}, 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- dt.Run(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if dt.ScreenLocked() {
+		t.Fatalf("Unexpected lock of a screen the master can't unlock")
+	}
+	if dt.NegotiatedFeatures().ScreenLock {
+		t.Fatalf("Unexpected ScreenLock feature negotiated")
+	}
+}
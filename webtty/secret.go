@@ -0,0 +1,37 @@
+package webtty
+
+import (
+	"bytes"
+)
+
+var secretPromptWords = [][]byte{
+	[]byte("password"),
+	[]byte("passphrase"),
+	[]byte("passcode"),
+	[]byte("pin"),
+}
+
+// DefaultSecretMatcher matches the prompts of sudo, ssh, su and similar
+// commands asking for a secret, such as "[sudo] password for user: ".
+// The last line of output, escape sequences removed, must end with a colon
+// and name a password, passphrase, passcode or PIN.
+//
+// Commands are matched by their prompt rather than by the missing echo,
+// since fast typing and pastes are often submitted before any echo.
+// Lines typed at prompts it doesn't know are recorded as typed.
+func DefaultSecretMatcher(recentOutput []byte) bool {
+	output := summarizeOutput(recentOutput)
+	if i := bytes.LastIndex(output, []byte(`\n`)); i >= 0 {
+		output = output[i+2:]
+	}
+	prompt := bytes.ToLower(bytes.TrimSpace(output))
+	if !bytes.HasSuffix(prompt, []byte(":")) {
+		return false
+	}
+	for _, word := range secretPromptWords {
+		if bytes.Contains(prompt, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package webtty
+
+import (
+	"testing"
+)
+
+func TestDefaultSecretMatcher(t *testing.T) {
+	for output, expected := range map[string]bool{
+		"$ sudo ls\r\n[sudo] password for user: ":       true,
+		"Enter passphrase for key '/root/.ssh/id_rsa':": true,
+		"\x1b[1mPassword:\x1b[0m ":                      true,
+		"Enter PIN:":                                    true,
+		"$ ":                                            false,
+		"Password: \r\nSorry, try again.\r\n$ ":         false,
+		"$ echo password":                               false,
+	} {
+		if matched := DefaultSecretMatcher([]byte(output)); matched != expected {
+			t.Fatalf("Unexpected match for %q: %t", output, matched)
+		}
+	}
+}
+
+func TestSecretMask(t *testing.T) {
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithSecretMask(DefaultSecretMatcher))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	// the slave echoes the command, then turns echo off for the password
+	ir := dt.reconstructor
+	ir.observeOutput([]byte("$ "))
+	feedString(ir, "sudo ls")
+	ir.observeOutput([]byte("sudo ls"))
+	if line, _ := feedString(ir, "\r"); line != "sudo ls" {
+		t.Fatalf("Unexpected command: %q", line)
+	}
+	ir.observeOutput([]byte("\r\n[sudo] password for user: "))
+	feedString(ir, "hunter2")
+	if current := dt.CurrentInput(); current != secretMask {
+		t.Fatalf("Unexpected current input: %q", current)
+	}
+	if line, _ := feedString(ir, "\r"); line != secretMask {
+		t.Fatalf("Unexpected password line: %q", line)
+	}
+
+	ir.observeOutput([]byte("\r\nbin\r\n$ "))
+	if line, _ := feedString(ir, "pwd\r"); line != "pwd" {
+		t.Fatalf("Unexpected line after the password: %q", line)
+	}
+}
@@ -0,0 +1,117 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// SessionInfo identifies a session and the user on its master.
+type SessionInfo struct {
+	User      string `json:"user"`
+	ClusterID string `json:"clusterId"`
+	SessionID string `json:"sessionId"`
+	// Address of the master, such as "192.0.2.1:41234", when known
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Labels of the session, such as its team or the ticket it serves,
+	// recorded with its audit events, see WithSessionTags
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// sessionIDLength is the length of generated session IDs.
+const sessionIDLength = 16
+
+const (
+	// MaxSessionTags is the most tags a session may have.
+	MaxSessionTags = 16
+	// MaxSessionTagLength is the longest value of a tag, in bytes.
+	MaxSessionTagLength = 256
+)
+
+// ValidateSessionTags returns an error unless tags are at most
+// MaxSessionTags, named with up to 64 letters, digits, '-', '_' and '.',
+// with values of up to MaxSessionTagLength printable bytes.
+func ValidateSessionTags(tags map[string]string) error {
+	if len(tags) > MaxSessionTags {
+		return errors.Errorf("too many session tags, at most %d", MaxSessionTags)
+	}
+	for key, value := range tags {
+		if !validTagName(key) {
+			return errors.Errorf("invalid session tag name `%s`", key)
+		}
+		if len(value) > MaxSessionTagLength {
+			return errors.Errorf("session tag `%s` longer than %d bytes", key, MaxSessionTagLength)
+		}
+		for _, r := range value {
+			if !unicode.IsPrint(r) {
+				return errors.Errorf("session tag `%s` with a control character", key)
+			}
+		}
+	}
+	return nil
+}
+
+func copyTags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(tags))
+	for key, value := range tags {
+		copied[key] = value
+	}
+	return copied
+}
+
+func validTagName(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type sessionContextKey struct{}
+
+// WithSessionContext returns a copy of ctx carrying info,
+// from which Run takes the identity of the session.
+func WithSessionContext(ctx context.Context, info SessionInfo) context.Context {
+	return context.WithValue(ctx, sessionContextKey{}, info)
+}
+
+// SessionFromContext returns the session identity stored in ctx
+// with WithSessionContext, if any.
+func SessionFromContext(ctx context.Context) (SessionInfo, bool) {
+	info, ok := ctx.Value(sessionContextKey{}).(SessionInfo)
+	return info, ok
+}
+
+// Session returns the identity of the session.
+func (wt *WebTTY) Session() SessionInfo {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+
+	return wt.session
+}
+
+func (wt *WebTTY) sendSessionInfo() error {
+	wt.stateMutex.RLock()
+	info := struct {
+		SessionInfo
+		ReadOnly bool `json:"readOnly"`
+	}{wt.session, !wt.permitWrite}
+	wt.stateMutex.RUnlock()
+
+	payload, err := json.Marshal(info)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal session info")
+	}
+	return wt.primaryWrite(append([]byte{SetSessionInfo}, payload...))
+}
@@ -0,0 +1,72 @@
+package webtty
+
+import (
+	"strings"
+	"time"
+)
+
+// slaveExit returns why the slave closed, with the exit status of its
+// process when it reports one, see ExitStatusReporter.
+func (wt *WebTTY) slaveExit() (string, *ExitStatus) {
+	slave := wt.currentSlave()
+	if reporter, ok := slave.(ExitStatusReporter); ok {
+		if status, ok := reporter.ExitStatus(); ok {
+			return status.String(), &status
+		}
+	}
+	if reasoner, ok := slave.(exitReasoner); ok {
+		return reasoner.ExitReason(), nil
+	}
+	return "", nil
+}
+
+// sendSessionEnd tells the master and the observers that the slave closed
+// for reason, after the pending output. The master may be gone already,
+// so errors are ignored.
+func (wt *WebTTY) sendSessionEnd(reason string) {
+	if wt.coalescer != nil {
+		wt.coalescer.flush()
+	}
+	wt.masterWrite(append([]byte{SessionEnd}, reason...))
+}
+
+// sendSessionClosed prints message on the terminal, with %s replaced by d,
+// and tells the master and the observers that the session was closed by
+// WebTTY for closed, so that the master doesn't reconnect.
+// The master may be gone already, so errors are ignored.
+func (wt *WebTTY) sendSessionClosed(closed error, message string, d time.Duration) {
+	if message != "" {
+		wt.printMessage(strings.Replace(message, "%s", d.String(), 1))
+	}
+	if wt.coalescer != nil {
+		wt.coalescer.flush()
+	}
+	wt.masterWrite(append([]byte{SessionEnd}, closed.Error()...))
+}
+
+// sessionEndReason returns why Run returns err, for the audit trail,
+// with the exit reason of the slave when it closed.
+func sessionEndReason(err error, exitReason string) string {
+	switch err := err.(type) {
+	case nil:
+		return "ended"
+	case *SlaveExitError:
+		return err.Status.String()
+	case *closedError:
+		if err.sentinel == ErrSlaveClosed && exitReason != "" {
+			return exitReason
+		}
+		return err.sentinel.Error()
+	}
+	return err.Error()
+}
+
+// Terminate closes the session, such as for an administrator to end
+// a stuck or abusive one. The Terminated message is printed on the
+// terminal and a SessionEnd message is sent, then Run returns
+// ErrSessionTerminated. The termination is recorded in the audit trail
+// with WithAuditLifecycle.
+// It can be called several times and from any goroutine.
+func (wt *WebTTY) Terminate() {
+	wt.terminateOnce.Do(func() { close(wt.terminated) })
+}
@@ -0,0 +1,106 @@
+package webtty
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+)
+
+type exitingSlave struct {
+	*pipeSlave
+	reason string
+}
+
+func (es exitingSlave) ExitReason() string {
+	return es.reason
+}
+
+type statusSlave struct {
+	*pipeSlave
+	status ExitStatus
+}
+
+func (ss statusSlave) ExitStatus() (ExitStatus, bool) {
+	return ss.status, true
+}
+
+func TestSessionEnd(t *testing.T) {
+	for _, reason := range []string{"exit status 1", ""} {
+		slaveReader, slaveWriter := io.Pipe()
+		_, discardWriter := io.Pipe()
+		var slave Slave = &pipeSlave{pipePair{slaveReader, discardWriter}}
+		if reason != "" {
+			slave = exitingSlave{slave.(*pipeSlave), reason}
+		}
+		master := recordingMaster{&frameRecorder{}}
+		dt, err := New(master, slave)
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		slaveWriter.Close()
+
+		err = dt.Run(context.Background(), "", "")
+		if !errors.Is(err, ErrSlaveClosed) {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+
+		frames := master.get()
+		if last := frames[len(frames)-1]; last != string(SessionEnd)+reason {
+			t.Fatalf("Unexpected last frame: `%s`", last)
+		}
+	}
+}
+
+func TestSlaveExitError(t *testing.T) {
+	for _, status := range []ExitStatus{{Code: 0}, {Code: 1}, {Code: -1, Signal: "killed"}} {
+		slaveReader, slaveWriter := io.Pipe()
+		_, discardWriter := io.Pipe()
+		master := recordingMaster{&frameRecorder{}}
+		dt, err := New(master, statusSlave{&pipeSlave{pipePair{slaveReader, discardWriter}}, status})
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		slaveWriter.Close()
+
+		err = dt.Run(context.Background(), "", "")
+		var exitErr *SlaveExitError
+		if !errors.Is(err, ErrSlaveClosed) || !errors.As(err, &exitErr) || exitErr.Status != status || !errors.Is(err, io.EOF) {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+		if exitErr.Status.Success() != (status.Code == 0) {
+			t.Fatalf("Unexpected success of %s", exitErr.Status)
+		}
+
+		frames := master.get()
+		if last := frames[len(frames)-1]; last != string(SessionEnd)+status.String() {
+			t.Fatalf("Unexpected last frame: `%s`", last)
+		}
+	}
+}
+
+func TestTerminate(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	defer slaveWriter.Close()
+	master := recordingMaster{&frameRecorder{}}
+	dt, err := New(master, &pipeSlave{pipePair{slaveReader, nil}},
+		WithMessages("en", Messages{Terminated: "[terminated]"}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- dt.Run(context.Background(), "", "") }()
+	dt.Terminate()
+	dt.Terminate()
+	if err := <-done; err != ErrSessionTerminated {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+
+	frames := master.get()
+	notice := string(Output) + base64.StdEncoding.EncodeToString([]byte("\r\n[terminated]\r\n"))
+	if len(frames) < 2 || frames[len(frames)-2] != notice || frames[len(frames)-1] != string(SessionEnd)+"session terminated" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+}
@@ -0,0 +1,98 @@
+package webtty
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// SetWindowTitle sets the window title of the session.
+// While Run is active, the master and the observers are notified
+// with a SetWindowTitle message, otherwise the title is sent
+// when the session is initialized.
+func (wt *WebTTY) SetWindowTitle(title []byte) error {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	wt.windowTitle = append([]byte{}, title...)
+	if !wt.settingsSent {
+		return nil
+	}
+	err := wt.masterWriteLocked(append([]byte{SetWindowTitle}, wt.windowTitle...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send window title")
+	}
+	return nil
+}
+
+// SetPreferences sets the configuration of master, encoded in JSON,
+// like WithMasterPreferences.
+// While Run is active, the master and the observers are notified
+// with a SetPreferences message, otherwise the preferences are sent
+// when the session is initialized.
+func (wt *WebTTY) SetPreferences(prefs []byte) error {
+	if !json.Valid(prefs) {
+		return errors.New("preferences are not valid JSON")
+	}
+
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	wt.masterPrefs = append([]byte{}, prefs...)
+	if !wt.settingsSent {
+		return nil
+	}
+	err := wt.masterWriteLocked(append([]byte{SetPreferences}, wt.masterPrefs...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to set preferences")
+	}
+	return nil
+}
+
+// sendSettings sends the window title, reconnect and preferences
+// when the session is initialized.
+// They are sent under the write lock so that a concurrent setter
+// either changes them before or notifies the master after.
+func (wt *WebTTY) sendSettings() error {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	err := wt.masterWriteLocked(append([]byte{SetWindowTitle}, wt.windowTitle...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send window title")
+	}
+
+	if wt.reconnect > 0 {
+		reconnect, _ := json.Marshal(wt.reconnect)
+		err := wt.masterWriteLocked(append([]byte{SetReconnect}, reconnect...))
+		if err != nil {
+			return errors.Wrapf(err, "failed to set reconnect")
+		}
+	}
+
+	if wt.masterPrefs != nil {
+		err := wt.masterWriteLocked(append([]byte{SetPreferences}, wt.masterPrefs...))
+		if err != nil {
+			return errors.Wrapf(err, "failed to set preferences")
+		}
+	}
+
+	if message := wt.compressionMessage(); message != nil {
+		err := wt.masterWriteLocked(message)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set compression")
+		}
+	}
+
+	wt.settingsSent = true
+	return nil
+}
+
+// resetSettings makes the setters only update the settings
+// for the next initialization once Run returns.
+func (wt *WebTTY) resetSettings() {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	wt.settingsSent = false
+}
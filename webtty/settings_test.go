@@ -0,0 +1,75 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSettings(t *testing.T) {
+	rec := &frameRecorder{}
+	slaveReader, _ := io.Pipe()
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, WithWindowTitle([]byte("old")))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	// before Run, the settings are sent on initialization
+	if err := dt.SetWindowTitle([]byte("before")); err != nil {
+		t.Fatalf("Unexpected error from SetWindowTitle(): %s", err)
+	}
+	if err := dt.SetPreferences([]byte(`{"theme":"dark"}`)); err != nil {
+		t.Fatalf("Unexpected error from SetPreferences(): %s", err)
+	}
+	if err := dt.SetPreferences([]byte(`{`)); err == nil {
+		t.Fatalf("Expected an error for invalid preferences")
+	}
+	if frames := rec.get(); len(frames) != 0 {
+		t.Fatalf("Unexpected frames before Run(): %q", frames)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx) }()
+	for i := 0; i < 100 && len(rec.get()) < 3; i++ {
+		time.Sleep(time.Millisecond)
+	}
+
+	expected := []string{
+		string(SetWindowTitle) + "before",
+		string(SetPreferences) + `{"theme":"dark"}`,
+		string(SetReadOnly) + "true",
+	}
+	if frames := rec.get(); len(frames) != 3 || frames[0] != expected[0] || frames[1] != expected[1] || frames[2] != expected[2] {
+		t.Fatalf("Unexpected initialization frames: %q", frames)
+	}
+
+	// while running, the master is notified immediately
+	if err := dt.SetWindowTitle([]byte("during")); err != nil {
+		t.Fatalf("Unexpected error from SetWindowTitle(): %s", err)
+	}
+	if err := dt.SetPreferences([]byte(`{"theme":"light"}`)); err != nil {
+		t.Fatalf("Unexpected error from SetPreferences(): %s", err)
+	}
+	expected = append(expected,
+		string(SetWindowTitle)+"during",
+		string(SetPreferences)+`{"theme":"light"}`,
+	)
+
+	cancel()
+	<-done
+
+	if err := dt.SetWindowTitle([]byte("after")); err != nil {
+		t.Fatalf("Unexpected error from SetWindowTitle(): %s", err)
+	}
+	frames := rec.get()
+	if len(frames) != len(expected) {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	for i := range expected {
+		if frames[i] != expected[i] {
+			t.Fatalf("Unexpected frames: %q", frames)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package webtty
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// shutdownNotice is the payload of the ShutdownNotice messages.
+type shutdownNotice struct {
+	// Seconds left before the session is closed, absent without a deadline
+	Seconds int    `json:"seconds,omitempty"`
+	Message string `json:"message"`
+}
+
+// NotifyShutdown tells the master and the observers that the server is
+// shutting down and closes the session in d, or once it ends when d is
+// zero. The notice is printed on the terminal and sent as a ShutdownNotice
+// message, which the bundled client ignores.
+func (wt *WebTTY) NotifyShutdown(d time.Duration) error {
+	notice := shutdownNotice{Message: wt.messages.ServerDraining}
+	if d > 0 {
+		notice.Seconds = int((d + time.Second - 1) / time.Second)
+		left := time.Duration(notice.Seconds) * time.Second
+		notice.Message = strings.Replace(wt.messages.ServerShutdown, "%s", left.String(), 1)
+	}
+
+	err := wt.printMessage(notice.Message)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(notice)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal shutdown notice")
+	}
+	return wt.masterWrite(append([]byte{ShutdownNotice}, payload...))
+}
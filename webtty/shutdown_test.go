@@ -0,0 +1,39 @@
+package webtty
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestNotifyShutdown(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithMessages("en", Messages{ServerShutdown: "[shutdown in %s]", ServerDraining: "[shutdown]"}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.NotifyShutdown(2500 * time.Millisecond); err != nil {
+		t.Fatalf("Unexpected error from NotifyShutdown(): %s", err)
+	}
+	if err := dt.NotifyShutdown(0); err != nil {
+		t.Fatalf("Unexpected error from NotifyShutdown(): %s", err)
+	}
+
+	expected := []string{
+		"1" + base64.StdEncoding.EncodeToString([]byte("\r\n[shutdown in 3s]\r\n")),
+		string(ShutdownNotice) + `{"seconds":3,"message":"[shutdown in 3s]"}`,
+		"1" + base64.StdEncoding.EncodeToString([]byte("\r\n[shutdown]\r\n")),
+		string(ShutdownNotice) + `{"message":"[shutdown]"}`,
+	}
+	frames := rec.get()
+	if len(frames) != len(expected) {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	for i, frame := range frames {
+		if frame != expected[i] {
+			t.Fatalf("Unexpected frame %d: %q, expected %q", i, frame, expected[i])
+		}
+	}
+}
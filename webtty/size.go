@@ -0,0 +1,134 @@
+package webtty
+
+import (
+	"encoding/json"
+	"math"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// SizeMode tells how the size set by WithFixedColumns and WithFixedRows
+// applies to the resizes of the master.
+type SizeMode int
+
+const (
+	// The size is used for the first resize only,
+	// the size of the master is applied from the second on
+	SizeInitial SizeMode = iota
+	// The size is locked for the whole session
+	SizeFixed
+	// The size is the maximum of the size of the master
+	SizeMaximum
+)
+
+// ParseSizeMode returns the mode named name,
+// one of "initial", "fixed" or "maximum".
+// An empty name is taken as "initial".
+func ParseSizeMode(name string) (SizeMode, error) {
+	switch name {
+	case "", "initial":
+		return SizeInitial, nil
+	case "fixed":
+		return SizeFixed, nil
+	case "maximum":
+		return SizeMaximum, nil
+	}
+	return SizeInitial, errors.Errorf("unknown size mode `%s`", name)
+}
+
+// setTerminalSize is the payload of SetTerminalSize messages,
+// encoded like the one of ResizeTerminal.
+type setTerminalSize struct {
+	Columns int
+	Rows    int
+}
+
+// sizeLocked returns whether the resizes of the master are ignored.
+func (wt *WebTTY) sizeLocked() bool {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+	return wt.sizeMode == SizeFixed && wt.columns != 0 && wt.rows != 0
+}
+
+// fitSize returns the size of the slave for the master resized to
+// masterColumns by masterRows, according to the size mode.
+func (wt *WebTTY) fitSize(masterColumns float64, masterRows float64) (int, int) {
+	wt.stateMutex.Lock()
+	defer wt.stateMutex.Unlock()
+
+	columns := int(math.Min(masterColumns, float64(wt.maxColumns)))
+	rows := int(math.Min(masterRows, float64(wt.maxRows)))
+	switch {
+	case wt.sizeMode == SizeFixed || (wt.sizeMode == SizeInitial && !wt.sizeSeeded):
+		if wt.columns != 0 {
+			columns = wt.columns
+		}
+		if wt.rows != 0 {
+			rows = wt.rows
+		}
+	case wt.sizeMode == SizeMaximum:
+		if wt.columns != 0 && columns > wt.columns {
+			columns = wt.columns
+		}
+		if wt.rows != 0 && rows > wt.rows {
+			rows = wt.rows
+		}
+	}
+	wt.sizeSeeded = true
+	return columns, rows
+}
+
+// applySize resizes the slave to columns by rows unless it has this size.
+func (wt *WebTTY) applySize(columns int, rows int) error {
+	if currentColumns, currentRows := wt.slaveSize(); columns == currentColumns && rows == currentRows {
+		return nil
+	}
+
+	err := wt.resizeSlave(columns, rows)
+	if err != nil {
+		return err
+	}
+	wt.auditResize(columns, rows)
+	if wt.recorder != nil {
+		wt.recorder.resize(columns, rows)
+	}
+	atomic.AddUint64(&wt.resizeCount, 1)
+	if wt.metrics != nil {
+		wt.metrics.IncResize()
+	}
+	return nil
+}
+
+// SetTerminalSize resizes the terminal to columns by rows, such as to
+// record every session at 80x24, and tells the master and the observers
+// with a SetTerminalSize message, which the bundled client ignores.
+// The size replaces the one set by WithFixedColumns and WithFixedRows,
+// the latest size of the master and its next resizes apply to it according
+// to the size mode.
+func (wt *WebTTY) SetTerminalSize(columns int, rows int) error {
+	if columns < 1 || rows < 1 {
+		return errors.Errorf("invalid terminal size %dx%d", columns, rows)
+	}
+
+	wt.resize.applyMutex.Lock()
+	wt.stateMutex.Lock()
+	wt.columns, wt.rows = columns, rows
+	wt.sizeSeeded = true
+	wt.stateMutex.Unlock()
+
+	if masterColumns, masterRows, ok := wt.requestedSize(); ok && wt.sizeMode == SizeMaximum {
+		// the master may be smaller than the new maximum
+		columns, rows = wt.fitSize(masterColumns, masterRows)
+	}
+	err := wt.applySize(columns, rows)
+	wt.resize.applyMutex.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "failed to resize terminal")
+	}
+	payload, err := json.Marshal(setTerminalSize{Columns: columns, Rows: rows})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal terminal size")
+	}
+	return wt.masterWrite(append([]byte{SetTerminalSize}, payload...))
+}
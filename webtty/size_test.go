@@ -0,0 +1,143 @@
+package webtty
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSizeMaximum(t *testing.T) {
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(discardMaster{}, slave, WithFixedColumns(80), WithFixedRows(24), WithSizeMode(SizeMaximum))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for _, c := range []struct {
+		columns, rows       int
+		expColumns, expRows int
+	}{
+		{100, 30, 80, 24},
+		{60, 40, 60, 24},
+		{50, 20, 50, 20},
+	} {
+		frame := []byte(string(ResizeTerminal) + `{"Columns":` + strconv.Itoa(c.columns) + `,"Rows":` + strconv.Itoa(c.rows) + `}`)
+		if err := dt.handleMasterReadEvent(frame); err != nil {
+			t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+		}
+		if slave.columns != c.expColumns || slave.rows != c.expRows {
+			t.Fatalf("Unexpected size for %dx%d: %dx%d", c.columns, c.rows, slave.columns, slave.rows)
+		}
+	}
+}
+
+func TestSetTerminalSize(t *testing.T) {
+	rec := &frameRecorder{}
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(recordingMaster{rec}, slave, WithSizeMode(SizeFixed))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.SetTerminalSize(0, 24); err == nil {
+		t.Fatalf("Expected an error for an empty size")
+	}
+	if err := dt.SetTerminalSize(80, 24); err != nil {
+		t.Fatalf("Unexpected error from SetTerminalSize(): %s", err)
+	}
+	if slave.columns != 80 || slave.rows != 24 {
+		t.Fatalf("Unexpected size: %dx%d", slave.columns, slave.rows)
+	}
+	frames := rec.get()
+	if len(frames) != 1 || frames[0] != string(SetTerminalSize)+`{"Columns":80,"Rows":24}` {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	// the size is now locked
+	err = dt.handleMasterReadEvent([]byte(string(ResizeTerminal) + `{"Columns":120,"Rows":40}`))
+	if err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if slave.columns != 80 || slave.rows != 24 {
+		t.Fatalf("Unexpected size after resize: %dx%d", slave.columns, slave.rows)
+	}
+}
+
+func TestResizeDebounce(t *testing.T) {
+	dt, err := New(discardMaster{}, &sizeRecordingSlave{pipeSlave: &pipeSlave{}}, WithResizeDebounce(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for columns := 100; columns < 110; columns++ {
+		frame := []byte(string(ResizeTerminal) + `{"Columns":` + strconv.Itoa(columns) + `,"Rows":30}`)
+		if err := dt.handleMasterReadEvent(frame); err != nil {
+			t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+		}
+	}
+	// the first resize of the burst applies at once
+	if columns, rows := dt.slaveSize(); columns != 100 || rows != 30 {
+		t.Fatalf("Unexpected size: %dx%d", columns, rows)
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if columns, rows := dt.slaveSize(); columns == 109 && rows == 30 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Latest size not applied")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if count := dt.Stats().ResizeCount; count != 2 {
+		t.Fatalf("Unexpected resizes: %d", count)
+	}
+}
+
+func TestSetTerminalSizeMaximum(t *testing.T) {
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(discardMaster{}, slave, WithFixedColumns(80), WithFixedRows(24), WithSizeMode(SizeMaximum))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.handleMasterReadEvent([]byte(string(ResizeTerminal) + `{"Columns":100,"Rows":20}`)); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if slave.columns != 80 || slave.rows != 20 {
+		t.Fatalf("Unexpected size: %dx%d", slave.columns, slave.rows)
+	}
+	// a larger maximum is capped by the master
+	if err := dt.SetTerminalSize(120, 40); err != nil {
+		t.Fatalf("Unexpected error from SetTerminalSize(): %s", err)
+	}
+	if slave.columns != 100 || slave.rows != 20 {
+		t.Fatalf("Unexpected size: %dx%d", slave.columns, slave.rows)
+	}
+}
+
+func TestReattachResize(t *testing.T) {
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(discardMaster{}, slave, WithReplayBuffer(1024))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.handleMasterReadEvent([]byte(string(ResizeTerminal) + `{"Columns":120,"Rows":40}`)); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if err := dt.SetTerminalSize(80, 24); err != nil {
+		t.Fatalf("Unexpected error from SetTerminalSize(): %s", err)
+	}
+	if slave.columns != 80 || slave.rows != 24 {
+		t.Fatalf("Unexpected size: %dx%d", slave.columns, slave.rows)
+	}
+
+	// the latest resize of the master applies again
+	if err := dt.Reattach(discardMaster{}); err != nil {
+		t.Fatalf("Unexpected error from Reattach(): %s", err)
+	}
+	if slave.columns != 120 || slave.rows != 40 {
+		t.Fatalf("Unexpected size after reattach: %dx%d", slave.columns, slave.rows)
+	}
+}
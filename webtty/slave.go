@@ -2,6 +2,7 @@ package webtty
 
 import (
 	"io"
+	"strconv"
 )
 
 // Slave represents a PTY slave, typically it's a local command.
@@ -15,3 +16,42 @@ type Slave interface {
 	// ResizeTerminal sets a new size of the terminal.
 	ResizeTerminal(columns int, rows int) error
 }
+
+// ExitStatus tells how the process of a slave ended.
+type ExitStatus struct {
+	// Exit code of the process, -1 when a signal ended it
+	Code int
+	// Signal which ended the process as named by the slave, such as
+	// "killed" or "KILL", empty when the process exited
+	Signal string
+}
+
+// String describes the status like os.ProcessState, such as
+// "exit status 1" or "signal: killed".
+func (status ExitStatus) String() string {
+	if status.Signal != "" {
+		return "signal: " + status.Signal
+	}
+	return "exit status " + strconv.Itoa(status.Code)
+}
+
+// Success reports whether the process exited with code 0.
+func (status ExitStatus) Success() bool {
+	return status.Signal == "" && status.Code == 0
+}
+
+// ExitStatusReporter is implemented by slaves running a process which can
+// tell how it ended, such as a local command. When the slave closes, Run
+// returns a *SlaveExitError carrying the status, and the master is told
+// with SessionEnd and CloseReason messages.
+type ExitStatusReporter interface {
+	// ExitStatus returns how the process ended, or false while it is
+	// still running or when its status is unknown.
+	ExitStatus() (ExitStatus, bool)
+}
+
+// exitReasoner is implemented by slaves that can tell why they closed,
+// such as the exit status of a command.
+type exitReasoner interface {
+	ExitReason() string
+}
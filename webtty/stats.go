@@ -0,0 +1,131 @@
+package webtty
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Stats is a snapshot of the traffic of a session.
+type Stats struct {
+	// Bytes read from the master
+	BytesIn uint64
+	// Bytes written to the master, observers excluded
+	BytesOut uint64
+	// Time since Run started, zero before
+	Uptime time.Duration
+	// Round-trip time last reported by the master in a RequestStats message,
+	// zero until reported
+	RTT time.Duration
+	// Latest round-trip time to the master measured by the server with
+	// a KeepAlivePing and its smoothed value, zero until measured
+	Latency         time.Duration
+	SmoothedLatency time.Duration
+
+	// Input bytes forwarded to the slave
+	BytesToSlave uint64
+	// Output bytes of the slave forwarded to the master, before encoding
+	BytesToMaster uint64
+	// Number of times the slave was resized
+	ResizeCount uint64
+	// Size of the slave, zero until resized
+	CurrentColumns int
+	CurrentRows    int
+	// When Run started, zero before
+	StartedAt time.Time
+	// When the master last sent input, zero until then
+	LastInputAt time.Time
+	// Output bytes sent to the master and not acknowledged yet,
+	// zero without WithFlowControl
+	UnacknowledgedOutput int64
+	// Output bytes of the slave sent compressed, and their size once
+	// compressed, zero without WithCompression
+	CompressedBytes uint64
+	CompressedSize  uint64
+	// Frames of the master dropped as the protocol can't parse them
+	MalformedFrames uint64
+}
+
+// CompressionRatio returns the ratio of the output sent compressed to its
+// compressed size, such as 4 for output compressed to a quarter,
+// or zero when no output was compressed.
+func (stats Stats) CompressionRatio() float64 {
+	if stats.CompressedSize == 0 {
+		return 0
+	}
+	return float64(stats.CompressedBytes) / float64(stats.CompressedSize)
+}
+
+// Stats returns a snapshot of the traffic of the session.
+func (wt *WebTTY) Stats() Stats {
+	wt.stateMutex.RLock()
+	startedAt := wt.startedAt
+	wt.stateMutex.RUnlock()
+
+	stats := Stats{
+		BytesIn:  atomic.LoadUint64(&wt.bytesIn),
+		BytesOut: atomic.LoadUint64(&wt.bytesOut),
+		RTT:      time.Duration(atomic.LoadInt64(&wt.reportedRTT)),
+
+		BytesToSlave:  atomic.LoadUint64(&wt.bytesToSlave),
+		BytesToMaster: atomic.LoadUint64(&wt.bytesToMaster),
+		ResizeCount:   atomic.LoadUint64(&wt.resizeCount),
+		StartedAt:     startedAt,
+		LastInputAt:   loadTime(&wt.lastInput),
+
+		CompressedBytes: atomic.LoadUint64(&wt.compressedBytes),
+		CompressedSize:  atomic.LoadUint64(&wt.compressedSize),
+		MalformedFrames: atomic.LoadUint64(&wt.malformedFrames),
+	}
+	stats.Latency, stats.SmoothedLatency = wt.Latency()
+	stats.CurrentColumns, stats.CurrentRows = wt.slaveSize()
+	if wt.flowWindow != nil {
+		stats.UnacknowledgedOutput = wt.flowWindow.unacknowledged()
+	}
+	if !startedAt.IsZero() {
+		stats.Uptime = time.Since(startedAt)
+	}
+	return stats
+}
+
+// handleRequestStats replies to a RequestStats message with a Stats frame.
+// payload optionally carries the round-trip time measured by the master
+// in milliseconds.
+func (wt *WebTTY) handleRequestStats(payload []byte) error {
+	if len(payload) > 0 {
+		rtt, err := strconv.ParseFloat(string(payload), 64)
+		if err != nil || !(rtt >= 0) || math.IsInf(rtt, 1) {
+			return malformedFrame(errors.Errorf("received malformed round-trip time `%s`", payload))
+		}
+		atomic.StoreInt64(&wt.reportedRTT, int64(rtt*float64(time.Millisecond)))
+	}
+
+	stats := wt.Stats()
+	frame, err := json.Marshal(struct {
+		BytesIn  uint64 `json:"bytesIn"`
+		BytesOut uint64 `json:"bytesOut"`
+		UptimeMs int64  `json:"uptimeMs"`
+		RTTMs    int64  `json:"rttMs"`
+		// omitted until output is compressed
+		CompressionRatio float64 `json:"compressionRatio,omitempty"`
+	}{
+		stats.BytesIn,
+		stats.BytesOut,
+		int64(stats.Uptime / time.Millisecond),
+		int64(stats.RTT / time.Millisecond),
+		stats.CompressionRatio(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal stats")
+	}
+
+	err = wt.primaryWrite(append([]byte{StatsReport}, frame...))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send stats to master")
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package webtty
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestRequestStats(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{})
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.handleMasterReadEvent([]byte("2")); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+	if err := dt.handleMasterReadEvent([]byte("42.5")); err != nil {
+		t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+	}
+
+	frames := rec.get()
+	if len(frames) != 2 || frames[1][0] != StatsReport {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	var stats struct {
+		BytesOut uint64 `json:"bytesOut"`
+		RTTMs    int64  `json:"rttMs"`
+	}
+	if err := json.Unmarshal([]byte(frames[1][1:]), &stats); err != nil {
+		t.Fatalf("Unexpected error from Unmarshal(): %s", err)
+	}
+	// the Pong sent before
+	if stats.BytesOut != 1 || stats.RTTMs != 2 {
+		t.Fatalf("Unexpected stats: %+v", stats)
+	}
+
+	if err := dt.handleMasterReadEvent([]byte("4slow")); err == nil {
+		t.Fatalf("Expected an error for a malformed round-trip time")
+	}
+}
+
+func TestStatsWhileRunning(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, inputReader)
+	master := newClosingMaster()
+	dt, err := New(master, &pipeSlave{pipePair{slaveReader, inputWriter}}, WithPermitWrite())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if stats := dt.Stats(); !stats.StartedAt.IsZero() || !stats.LastInputAt.IsZero() {
+		t.Fatalf("Unexpected stats before Run(): %+v", stats)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx) }()
+
+	polled := make(chan struct{})
+	stop := make(chan struct{})
+	go func() {
+		defer close(polled)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				dt.Stats()
+			}
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		slaveWriter.Write([]byte("output"))
+		master.writer.Write([]byte("1in"))
+	}
+	master.writer.Write([]byte(`3{"columns":100,"rows":30}`))
+	master.writer.Write([]byte(`3{"columns":120,"rows":40}`))
+
+	expected := Stats{BytesToSlave: 20, BytesToMaster: 60, ResizeCount: 2, CurrentColumns: 120, CurrentRows: 40}
+	var stats Stats
+	for i := 0; i < 100; i++ {
+		stats = dt.Stats()
+		if stats.BytesToSlave == expected.BytesToSlave && stats.BytesToMaster == expected.BytesToMaster && stats.ResizeCount == expected.ResizeCount {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(stop)
+	<-polled
+	cancel()
+	<-done
+
+	if stats.BytesToSlave != expected.BytesToSlave || stats.BytesToMaster != expected.BytesToMaster ||
+		stats.ResizeCount != expected.ResizeCount || stats.CurrentColumns != expected.CurrentColumns ||
+		stats.CurrentRows != expected.CurrentRows {
+		t.Fatalf("Unexpected stats: %+v", stats)
+	}
+	if stats.StartedAt.IsZero() || stats.LastInputAt.Before(stats.StartedAt) {
+		t.Fatalf("Unexpected times: %+v", stats)
+	}
+}
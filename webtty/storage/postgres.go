@@ -0,0 +1,7 @@
+//go:build postgres
+// +build postgres
+
+package storage
+
+// the driver of the postgres URLs
+import _ "github.com/lib/pq"
@@ -0,0 +1,335 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultS3Region is the region of the S3Config without one.
+const DefaultS3Region = "us-east-1"
+
+// S3Config configures an S3Storage.
+type S3Config struct {
+	Bucket string
+	// Prefix of the keys of the objects in the bucket, such as "gotty/"
+	Prefix string
+	// DefaultS3Region when empty
+	Region string
+	// URL of an S3-compatible service, such as http://minio:9000,
+	// the endpoint of the region of AWS when empty
+	Endpoint string
+	// Whether the bucket is addressed in the path instead of the host
+	PathStyle bool
+
+	AccessKeyID     string
+	SecretAccessKey string
+	// Token of temporary credentials
+	SessionToken string
+
+	// http.DefaultClient when nil
+	Client *http.Client
+}
+
+// S3Storage is a Storage of the objects of an S3 bucket, or of a service
+// compatible with S3 such as MinIO, whose requests are signed with
+// the version 4 of the AWS signature.
+type S3Storage struct {
+	config   S3Config
+	endpoint *url.URL
+	// for the tests, time.Now when nil
+	now func() time.Time
+}
+
+// NewS3Storage returns the storage of the bucket of config.
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("S3 storage without a bucket")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("S3 storage without credentials")
+	}
+	if config.Region == "" {
+		config.Region = DefaultS3Region
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://s3." + config.Region + ".amazonaws.com"
+	}
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, errors.Errorf("invalid S3 endpoint `%s`", config.Endpoint)
+	}
+	if !config.PathStyle {
+		endpoint.Host = config.Bucket + "." + endpoint.Host
+	}
+	return &S3Storage{config: config, endpoint: endpoint}, nil
+}
+
+// objectURL returns the URL of the object of key, or of the bucket for "".
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	path := "/"
+	if s.config.PathStyle {
+		path += s.config.Bucket + "/"
+	}
+	if key != "" {
+		path += s.config.Prefix + key
+	}
+	u.Path = path
+	u.RawPath = escapePath(path)
+	return &u
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader) error {
+	if err := CheckKey(key); err != nil {
+		return err
+	}
+
+	// S3 takes the length and the digest of the object before its content
+	hash := sha256.New()
+	var body io.Reader
+	var size int64
+	if seeker, ok := r.(io.ReadSeeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return errors.Wrapf(err, "failed to measure `%s`", key)
+		}
+		if size, err = io.Copy(hash, seeker); err != nil {
+			return errors.Wrapf(err, "failed to read `%s`", key)
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return errors.Wrapf(err, "failed to read `%s`", key)
+		}
+		body = seeker
+	} else {
+		content, err := ioutil.ReadAll(r)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read `%s`", key)
+		}
+		hash.Write(content)
+		body, size = bytes.NewReader(content), int64(len(content))
+	}
+
+	req, err := http.NewRequest("PUT", s.objectURL(key).String(), ioutil.NopCloser(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request")
+	}
+	req.ContentLength = size
+	resp, err := s.do(ctx, req, hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return errors.Wrapf(err, "failed to put `%s`", key)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := CheckKey(key); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create request")
+	}
+	resp, err := s.do(ctx, req, emptySHA256)
+	if err != nil {
+		if errors.Cause(err) == ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get `%s`", key)
+	}
+	return resp.Body, nil
+}
+
+// listBucketResult is the answer of ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key          string
+		Size         int64
+		LastModified time.Time
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	token := ""
+	for {
+		u := s.objectURL("")
+		query := url.Values{"list-type": {"2"}, "prefix": {s.config.Prefix + prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		u.RawQuery = query.Encode()
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create request")
+		}
+		resp, err := s.do(ctx, req, emptySHA256)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list bucket `%s`", s.config.Bucket)
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode listing of bucket `%s`", s.config.Bucket)
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, Object{
+				Key:      strings.TrimPrefix(content.Key, s.config.Prefix),
+				Size:     content.Size,
+				Modified: content.LastModified,
+			})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+// emptySHA256 is the digest of the requests without body.
+const emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// do signs and sends req, whose body has the digest payloadHash. It returns
+// ErrNotFound for a missing object, and an error for the other failures.
+func (s *S3Storage) do(ctx context.Context, req *http.Request, payloadHash string) (*http.Response, error) {
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.config.SessionToken)
+	}
+	s.sign(req, payloadHash, now().UTC())
+
+	resp, err := s.config.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound && req.Method == "GET" {
+		return nil, ErrNotFound
+	}
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return nil, errors.Errorf("S3 answered %s: %s", resp.Status, bytes.TrimSpace(message))
+}
+
+// sign sets the X-Amz-Date and the Authorization headers of req,
+// signed with the version 4 of the AWS signature at t.
+func (s *S3Storage) sign(req *http.Request, payloadHash string, t time.Time) {
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256(canonicalRequest)
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the query of a signed request, sorted and
+// escaped as AWS does.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, awsEscape(key, true)+"="+awsEscape(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// escapePath escapes the segments of path as AWS does.
+func escapePath(path string) string {
+	return awsEscape(path, false)
+}
+
+// awsEscape escapes s but its unreserved characters, and "/" unless
+// slash is set.
+func awsEscape(s string, slash bool) string {
+	var escaped strings.Builder
+	for _, b := range []byte(s) {
+		switch {
+		case b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z', b >= '0' && b <= '9',
+			b == '-', b == '_', b == '.', b == '~', b == '/' && !slash:
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
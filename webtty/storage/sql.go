@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var sqlIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,62}$`)
+
+// SQLStorage is a Storage of the rows of a PostgreSQL table, created when
+// missing, with the key, the content, the size and the modification time
+// of each object.
+type SQLStorage struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLStorage returns the storage of table in db, opened with
+// a PostgreSQL driver, and creates the table if needed.
+func NewSQLStorage(db *sql.DB, table string) (*SQLStorage, error) {
+	if !sqlIdentifier.MatchString(table) {
+		return nil, errors.Errorf("invalid table name `%s`", table)
+	}
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS ` + table + ` (
+	key TEXT PRIMARY KEY,
+	content BYTEA NOT NULL,
+	size BIGINT NOT NULL,
+	modified TIMESTAMPTZ NOT NULL
+)`)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create table `%s`", table)
+	}
+	return &SQLStorage{db: db, table: table}, nil
+}
+
+func (s *SQLStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	if err := CheckKey(key); err != nil {
+		return err
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read `%s`", key)
+	}
+	_, err = s.db.ExecContext(ctx, `INSERT INTO `+s.table+` (key, content, size, modified) VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO UPDATE SET content = EXCLUDED.content, size = EXCLUDED.size, modified = EXCLUDED.modified`,
+		key, content, int64(len(content)), time.Now().UTC())
+	if err != nil {
+		return errors.Wrapf(err, "failed to store `%s`", key)
+	}
+	return nil
+}
+
+func (s *SQLStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := CheckKey(key); err != nil {
+		return nil, err
+	}
+	var content []byte
+	err := s.db.QueryRowContext(ctx, `SELECT content FROM `+s.table+` WHERE key = $1`, key).Scan(&content)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get `%s`", key)
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *SQLStorage) List(ctx context.Context, prefix string) ([]Object, error) {
+	pattern := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+	rows, err := s.db.QueryContext(ctx, `SELECT key, size, modified FROM `+s.table+` WHERE key LIKE $1 ESCAPE '\' ORDER BY key`, pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list table `%s`", s.table)
+	}
+	defer rows.Close()
+
+	var objects []Object
+	for rows.Next() {
+		var object Object
+		if err := rows.Scan(&object.Key, &object.Size, &object.Modified); err != nil {
+			return nil, errors.Wrapf(err, "failed to list table `%s`", s.table)
+		}
+		objects = append(objects, object)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to list table `%s`", s.table)
+	}
+	return objects, nil
+}
+
+// Close closes the database.
+func (s *SQLStorage) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,135 @@
+// Package storage keeps the session recordings and the audit transcripts
+// away from the gotty host, in durable storage such as an S3 bucket or a
+// PostgreSQL database, or in a local directory.
+//
+// The objects are named by keys such as "20240102T150405-3f2a.cast",
+// whose "/" separated segments may group them, such as by user.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// MaxKeyLength is the longest key of an object.
+const MaxKeyLength = 1024
+
+// ErrNotFound is returned by Get for a key without object.
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores the objects of the sessions, such as recordings, by key.
+// Implementations are safe for concurrent use.
+type Storage interface {
+	// Put stores the content read from r under key, replacing the object
+	// of key if any. r is read to the end, and seeked back to remeasure it
+	// when it's an io.ReadSeeker.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get returns the content of the object of key, to be closed by the
+	// caller, or ErrNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// List returns the objects whose keys start with prefix, sorted by key.
+	List(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// Object describes a stored object.
+type Object struct {
+	Key      string    `json:"key"`
+	Size     int64     `json:"size"`
+	Modified time.Time `json:"modified"`
+}
+
+// CheckKey returns an error when key can't name an object, such as when
+// it's empty, absolute, or has "." or ".." segments.
+func CheckKey(key string) error {
+	if key == "" || len(key) > MaxKeyLength {
+		return errors.Errorf("invalid key length %d", len(key))
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errors.Errorf("invalid key `%s`", key)
+		}
+	}
+	for _, r := range key {
+		if r == '\\' || !unicode.IsPrint(r) {
+			return errors.Errorf("invalid key `%s`", key)
+		}
+	}
+	return nil
+}
+
+// SQLDriver is the name of the database/sql driver of the postgres URLs,
+// which is linked in by building with the postgres tag.
+var SQLDriver = "postgres"
+
+// DefaultSQLTable is the table of the postgres URLs without a table parameter.
+const DefaultSQLTable = "gotty_objects"
+
+// Open returns the storage of rawURL:
+//
+//	file:///var/lib/gotty/records          a local directory, as a bare path
+//	s3://bucket/prefix?region=eu-west-1    an S3 bucket, with the endpoint
+//	                                       parameter for S3-compatible services
+//	postgres://user@host/db?table=records  a PostgreSQL database
+//
+// The credentials of S3 are read from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func Open(rawURL string) (Storage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse storage URL")
+	}
+
+	switch u.Scheme {
+	case "", "file":
+		if u.Path == "" {
+			return nil, errors.New("storage URL without a directory")
+		}
+		return NewLocalStorage(u.Path)
+
+	case "s3":
+		query := u.Query()
+		config := S3Config{
+			Bucket:          u.Host,
+			Prefix:          strings.TrimPrefix(u.Path, "/"),
+			Region:          query.Get("region"),
+			Endpoint:        query.Get("endpoint"),
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+		// the S3-compatible services are usually addressed by path
+		config.PathStyle = config.Endpoint != "" && query.Get("path_style") != "false"
+		return NewS3Storage(config)
+
+	case "postgres", "postgresql":
+		query := u.Query()
+		table := query.Get("table")
+		if table == "" {
+			table = DefaultSQLTable
+		}
+		query.Del("table")
+		u.RawQuery = query.Encode()
+
+		db, err := sql.Open(SQLDriver, u.String())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open database, gotty may be built without the postgres tag")
+		}
+		s, err := NewSQLStorage(db, table)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		return s, nil
+
+	default:
+		return nil, errors.Errorf("unknown storage scheme `%s`", u.Scheme)
+	}
+}
@@ -0,0 +1,68 @@
+package webtty
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// FrameStream is a bidirectional stream of messages each carrying one frame
+// of the protocol, such as the Attach stream of the Terminal gRPC service
+// defined by proto/gotty.proto, for the clients that don't speak WebSocket.
+type FrameStream interface {
+	// SendFrame sends frame in a message. It must not retain frame.
+	SendFrame(frame []byte) error
+	// RecvFrame returns the frame of the next message,
+	// io.EOF once the client closed its end of the stream.
+	RecvFrame() ([]byte, error)
+}
+
+// StreamMaster is a Master exchanging the frames with a FrameStream,
+// one frame per message like the WebSocket connections. A frame of the
+// client larger than the read buffer of the session or than the maximum
+// inbound frame size ends it with ErrFrameTooLarge.
+//
+// The stream is closed with the master when it implements io.Closer,
+// such as when a reattached master replaces it.
+type StreamMaster struct {
+	stream    FrameStream
+	readLimit int64 // accessed atomically
+}
+
+// NewStreamMaster returns a master exchanging the frames with stream.
+func NewStreamMaster(stream FrameStream) *StreamMaster {
+	return &StreamMaster{stream: stream}
+}
+
+// Read reads the frame of the next message into p.
+func (sm *StreamMaster) Read(p []byte) (int, error) {
+	frame, err := sm.stream.RecvFrame()
+	if err != nil {
+		return 0, err
+	}
+	if limit := atomic.LoadInt64(&sm.readLimit); len(frame) > len(p) || (limit > 0 && int64(len(frame)) > limit) {
+		return 0, ErrFrameTooLarge
+	}
+	return copy(p, frame), nil
+}
+
+// Write sends p as the frame of a message.
+func (sm *StreamMaster) Write(p []byte) (int, error) {
+	if err := sm.stream.SendFrame(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// SetReadLimit sets the maximum size of the frames of the client,
+// called by the session with its maximum inbound frame size.
+func (sm *StreamMaster) SetReadLimit(limit int64) {
+	atomic.StoreInt64(&sm.readLimit, limit)
+}
+
+// Close closes the stream when it implements io.Closer.
+func (sm *StreamMaster) Close() error {
+	if closer, ok := sm.stream.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
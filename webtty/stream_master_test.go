@@ -0,0 +1,61 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// chanStream is a FrameStream exchanging the frames with channels,
+// RecvFrame returns io.EOF once in is closed.
+type chanStream struct {
+	in  chan []byte
+	out chan []byte
+}
+
+func (cs chanStream) SendFrame(frame []byte) error {
+	cs.out <- append([]byte(nil), frame...)
+	return nil
+}
+
+func (cs chanStream) RecvFrame() ([]byte, error) {
+	frame, ok := <-cs.in
+	if !ok {
+		return nil, io.EOF
+	}
+	return frame, nil
+}
+
+func TestStreamMaster(t *testing.T) {
+	stream := chanStream{in: make(chan []byte, 4), out: make(chan []byte, 64)}
+	slaveReader, _ := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	dt, err := New(NewStreamMaster(stream), &pipeSlave{pipePair{slaveReader, inputWriter}},
+		WithPermitWrite(), WithMaxInboundFrameSize(16))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- dt.Run(context.Background()) }()
+
+	stream.in <- []byte("1ls\r")
+	buf := make([]byte, 16)
+	n, err := inputReader.Read(buf)
+	if err != nil || string(buf[:n]) != "ls\r" {
+		t.Fatalf("Unexpected input: %q, %v", buf[:n], err)
+	}
+	stream.in <- []byte("2")
+	for frame := range stream.out {
+		if string(frame) == string(Pong) {
+			break
+		}
+	}
+
+	stream.in <- []byte("1this frame is too large")
+	if err := <-done; errors.Cause(err) != ErrFrameTooLarge {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package webtty
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// WithStrictProtocol makes Run return the error of the first frame of the
+// master the protocol can't parse, matching ErrMalformedFrame, and tell the
+// master with the malformed_frame close reason. By default these frames,
+// such as an unknown message type or an invalid resize, are dropped and
+// counted in Stats, so that a buggy client doesn't lose its session.
+func WithStrictProtocol() Option {
+	return func(wt *WebTTY) error {
+		wt.strictProtocol = true
+		return nil
+	}
+}
+
+// dropMalformedFrame counts the frame of err and returns true when err is
+// the error of a malformed frame to be dropped. The first one is logged.
+func (wt *WebTTY) dropMalformedFrame(err error) bool {
+	if wt.strictProtocol {
+		return false
+	}
+	if _, ok := err.(*malformedFrameError); !ok {
+		return false
+	}
+	if atomic.AddUint64(&wt.malformedFrames, 1) == 1 {
+		fmt.Println("dropped malformed frame of session", wt.Session().SessionID+":", err, "(further ones are only counted)")
+	}
+	return true
+}
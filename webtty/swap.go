@@ -0,0 +1,73 @@
+package webtty
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// SwapSlave replaces the slave of the session, for example to reconnect
+// to a restarted backend without dropping the master connection.
+//
+// The new slave is resized to the last size requested by the master.
+// The current slave must implement io.Closer, otherwise ErrSlaveNotClosable
+// is returned and the slave is kept. It is closed in the background, which
+// ends its pending read without waiting for its process to exit, and
+// errors from closing it are ignored; output it returned before that
+// is still forwarded. Reads then resume from the new slave.
+//
+// The master connection, the session information, write permission and
+// observers are preserved. The state of the old slave, such as the running
+// process or its screen contents, is not, and the window title is not
+// sent again.
+func (wt *WebTTY) SwapSlave(slave Slave) error {
+	if slave == nil {
+		return errors.New("slave must not be nil")
+	}
+
+	wt.slaveWriteMutex.Lock()
+	closer, ok := wt.slave.(io.Closer)
+	if !ok {
+		wt.slaveWriteMutex.Unlock()
+		return ErrSlaveNotClosable
+	}
+	columns, rows := wt.slaveColumns, wt.slaveRows
+	if columns > 0 && rows > 0 {
+		err := slave.ResizeTerminal(columns, rows)
+		if err != nil {
+			wt.slaveWriteMutex.Unlock()
+			return errors.Wrapf(err, "failed to resize new slave")
+		}
+	}
+	wt.slave = slave
+	wt.slaveWriteMutex.Unlock()
+
+	go closer.Close()
+
+	return nil
+}
+
+// currentSlave returns the slave to read from.
+func (wt *WebTTY) currentSlave() Slave {
+	wt.slaveWriteMutex.Lock()
+	defer wt.slaveWriteMutex.Unlock()
+
+	return wt.slave
+}
+
+// slaveSize returns the last size the slave was resized to.
+func (wt *WebTTY) slaveSize() (int, int) {
+	wt.slaveWriteMutex.Lock()
+	defer wt.slaveWriteMutex.Unlock()
+
+	return wt.slaveColumns, wt.slaveRows
+}
+
+// resizeSlave resizes the slave and remembers the size for SwapSlave.
+func (wt *WebTTY) resizeSlave(columns int, rows int) error {
+	wt.slaveWriteMutex.Lock()
+	defer wt.slaveWriteMutex.Unlock()
+
+	wt.slaveColumns, wt.slaveRows = columns, rows
+	return wt.slave.ResizeTerminal(columns, rows)
+}
@@ -0,0 +1,157 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+type closingSlave struct {
+	*pipeSlave
+}
+
+func (cs closingSlave) Close() error {
+	return cs.pipeSlave.PipeReader.Close()
+}
+
+type sizeRecordingSlave struct {
+	*pipeSlave
+	columns, rows int
+}
+
+func (ss *sizeRecordingSlave) ResizeTerminal(columns int, rows int) error {
+	ss.columns, ss.rows = columns, rows
+	return nil
+}
+
+func TestSwapSlave(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, connOutPipeWriter := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	oldOutReader, _ := io.Pipe()
+	_, oldInWriter := io.Pipe()
+	oldSlave := closingSlave{&pipeSlave{pipePair{oldOutReader, oldInWriter}}}
+
+	newOutReader, newOutWriter := io.Pipe()
+	newInReader, newInWriter := io.Pipe()
+	newSlave := &sizeRecordingSlave{pipeSlave: &pipeSlave{pipePair{newOutReader, newInWriter}}}
+
+	dt, err := New(conn, oldSlave, WithPermitWrite())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := dt.Run(ctx, "", "")
+		if err != nil && err != context.Canceled {
+			t.Errorf("Unexpected error from Run(): %s", err)
+		}
+	}()
+
+	buf := make([]byte, 1024)
+	// window title and read only
+	for i := 0; i < 2; i++ {
+		if _, err := connInPipeReader.Read(buf); err != nil {
+			t.Fatalf("Unexpected error from Read(): %s", err)
+		}
+	}
+
+	if _, err := connOutPipeWriter.Write([]byte(`3{"columns":80,"rows":24}`)); err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+	// wait for the resize to be processed
+	if _, err := connOutPipeWriter.Write([]byte{Ping}); err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+	if _, err := connInPipeReader.Read(buf); err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+
+	if err := dt.SwapSlave(newSlave); err != nil {
+		t.Fatalf("Unexpected error from SwapSlave(): %s", err)
+	}
+	if newSlave.columns != 80 || newSlave.rows != 24 {
+		t.Fatalf("Unexpected size of new slave: %dx%d", newSlave.columns, newSlave.rows)
+	}
+
+	// output of the new slave reaches the master
+	if _, err := newOutWriter.Write([]byte("foo")); err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+	n, err := connInPipeReader.Read(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(buf[:n]) != "1Zm9v" {
+		t.Fatalf("Unexpected message received: `%s`", buf[:n])
+	}
+
+	// input goes to the new slave
+	if _, err := connOutPipeWriter.Write([]byte("1ls")); err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+	n, err = newInReader.Read(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(buf[:n]) != "ls" {
+		t.Fatalf("Unexpected input received: `%s`", buf[:n])
+	}
+
+	cancel()
+	wg.Wait()
+}
+
+type blockingCloseSlave struct {
+	*pipeSlave
+	release chan struct{}
+}
+
+func (bs blockingCloseSlave) Close() error {
+	<-bs.release
+	return nil
+}
+
+func TestSwapSlaveNotClosable(t *testing.T) {
+	oldSlave := &pipeSlave{}
+	dt, err := New(discardMaster{}, oldSlave)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.SwapSlave(&pipeSlave{}); err != ErrSlaveNotClosable {
+		t.Fatalf("Unexpected error from SwapSlave(): %v", err)
+	}
+	if dt.currentSlave() != oldSlave {
+		t.Fatalf("Slave swapped although it can't be closed")
+	}
+}
+
+func TestSwapSlaveDoesNotWaitForClose(t *testing.T) {
+	oldSlave := blockingCloseSlave{&pipeSlave{}, make(chan struct{})}
+	defer close(oldSlave.release)
+	dt, err := New(discardMaster{}, oldSlave)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- dt.SwapSlave(&pipeSlave{})
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error from SwapSlave(): %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("SwapSlave() waited for the old slave to close")
+	}
+}
@@ -0,0 +1,7 @@
+// Package testing provides a fake Master and a fake Slave to run webtty
+// sessions in memory, for the conformance tests of the protocol and of the
+// applications embedding webtty.
+//
+// The frames sent by the master are processed in order: a Ping sent after
+// a frame is answered once the frame was handled, see Pair.Sync.
+package testing
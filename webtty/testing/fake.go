@@ -0,0 +1,290 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty"
+)
+
+// ErrTimeout is returned when an expected frame or the end of a session
+// doesn't come in time.
+var ErrTimeout = errors.New("timed out")
+
+// Master is a fake webtty.Master. The frames given to Send are read by the
+// session one per read, like WebSocket messages, and the frames the session
+// writes are kept in order. It's safe for concurrent use.
+type Master struct {
+	incoming chan []byte
+
+	mutex   sync.Mutex
+	frames  [][]byte
+	next    int
+	written chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewMaster returns a master without frames.
+func NewMaster() *Master {
+	return &Master{
+		incoming: make(chan []byte, 64),
+		written:  make(chan struct{}, 1),
+		closed:   make(chan struct{}),
+	}
+}
+
+// Send queues frame to be read by the session.
+func (m *Master) Send(frame []byte) {
+	select {
+	case m.incoming <- append([]byte(nil), frame...):
+	case <-m.closed:
+	}
+}
+
+// SendMessage queues a frame of messageType with payload.
+func (m *Master) SendMessage(messageType byte, payload string) {
+	m.Send(append([]byte{messageType}, payload...))
+}
+
+// Read returns the next frame sent, io.EOF once the master is closed.
+// A frame larger than p is truncated.
+func (m *Master) Read(p []byte) (int, error) {
+	select {
+	case frame := <-m.incoming:
+		return copy(p, frame), nil
+	case <-m.closed:
+		return 0, io.EOF
+	}
+}
+
+// Write keeps a copy of the frame p.
+func (m *Master) Write(p []byte) (int, error) {
+	select {
+	case <-m.closed:
+		return 0, io.ErrClosedPipe
+	default:
+	}
+
+	m.mutex.Lock()
+	m.frames = append(m.frames, append([]byte(nil), p...))
+	m.mutex.Unlock()
+
+	select {
+	case m.written <- struct{}{}:
+	default:
+	}
+	return len(p), nil
+}
+
+// Frames returns all the frames written by the session.
+func (m *Master) Frames() [][]byte {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return append([][]byte(nil), m.frames...)
+}
+
+// Next returns the first frame written by the session not returned yet,
+// waiting up to timeout for it.
+func (m *Master) Next(timeout time.Duration) ([]byte, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		m.mutex.Lock()
+		if m.next < len(m.frames) {
+			frame := m.frames[m.next]
+			m.next++
+			m.mutex.Unlock()
+			return frame, nil
+		}
+		m.mutex.Unlock()
+
+		select {
+		case <-m.written:
+		case <-timer.C:
+			return nil, ErrTimeout
+		}
+	}
+}
+
+// NextOf returns the next frame of messageType, skipping the others.
+func (m *Master) NextOf(messageType byte, timeout time.Duration) ([]byte, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		frame, err := m.Next(time.Until(deadline))
+		if err != nil {
+			return nil, errors.Wrapf(err, "no `%c` frame", messageType)
+		}
+		if len(frame) > 0 && frame[0] == messageType {
+			return frame, nil
+		}
+	}
+}
+
+// Close ends the reads of the session, as a client disconnecting.
+func (m *Master) Close() error {
+	m.closeOnce.Do(func() { close(m.closed) })
+	return nil
+}
+
+// Size is a size the slave was resized to.
+type Size struct {
+	Columns int
+	Rows    int
+}
+
+// Slave is a fake webtty.Slave. The output given to Output is read by the
+// session, and the input and the resizes it receives are kept.
+// It's safe for concurrent use.
+type Slave struct {
+	reader *io.PipeReader
+	writer *io.PipeWriter
+
+	mutex sync.Mutex
+	input bytes.Buffer
+	sizes []Size
+}
+
+// NewSlave returns a slave without output.
+func NewSlave() *Slave {
+	reader, writer := io.Pipe()
+	return &Slave{reader: reader, writer: writer}
+}
+
+// Output makes the session read data, it blocks until it's read.
+func (s *Slave) Output(data string) error {
+	_, err := io.WriteString(s.writer, data)
+	return err
+}
+
+func (s *Slave) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+// Write keeps the input p.
+func (s *Slave) Write(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.input.Write(p)
+}
+
+// Input returns all the input received.
+func (s *Slave) Input() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.input.String()
+}
+
+// Sizes returns the sizes the slave was resized to, in order.
+func (s *Slave) Sizes() []Size {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]Size(nil), s.sizes...)
+}
+
+func (s *Slave) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{"command": "fake"}
+}
+
+func (s *Slave) ResizeTerminal(columns int, rows int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sizes = append(s.sizes, Size{Columns: columns, Rows: rows})
+	return nil
+}
+
+// Close ends the output, as the command exiting.
+func (s *Slave) Close() error {
+	return s.writer.Close()
+}
+
+// Pair is a session running between a fake Master and a fake Slave.
+type Pair struct {
+	Master *Master
+	Slave  *Slave
+	TTY    *webtty.WebTTY
+
+	cancel context.CancelFunc
+	done   chan error
+}
+
+// Start runs a session with options between a new Master and a new Slave,
+// until the master or the slave is closed or Stop is called. The session
+// drops its audit events unless options set an audit logger, so that it
+// never reaches the audit endpoint.
+func Start(options ...webtty.Option) (*Pair, error) {
+	master, slave := NewMaster(), NewSlave()
+	quiet := webtty.WithAuditLogger(webtty.AuditLoggerFunc(func(ctx context.Context, event webtty.AuditEvent) error {
+		return nil
+	}))
+	tty, err := webtty.New(master, slave, append([]webtty.Option{quiet}, options...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pair := &Pair{
+		Master: master,
+		Slave:  slave,
+		TTY:    tty,
+		cancel: cancel,
+		done:   make(chan error, 1),
+	}
+	go func() { pair.done <- tty.Run(ctx) }()
+	return pair, nil
+}
+
+// Sync sends a Ping and waits for its Pong, the frames sent before are
+// handled by then. It returns the frames written in between, without
+// the Pong.
+func (pair *Pair) Sync(timeout time.Duration) ([][]byte, error) {
+	pair.Master.SendMessage(webtty.Ping, "")
+
+	deadline := time.Now().Add(timeout)
+	var frames [][]byte
+	for {
+		frame, err := pair.Master.Next(time.Until(deadline))
+		if err != nil {
+			return frames, errors.Wrapf(err, "no Pong")
+		}
+		if len(frame) > 0 && frame[0] == webtty.Pong {
+			return frames, nil
+		}
+		frames = append(frames, frame)
+	}
+}
+
+// Wait returns the error Run returned, waiting up to timeout for it.
+func (pair *Pair) Wait(timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-pair.done:
+		pair.done <- err
+		return err
+	case <-timer.C:
+		return ErrTimeout
+	}
+}
+
+// Stop cancels the session and waits for Run to return.
+func (pair *Pair) Stop() {
+	pair.cancel()
+	err := <-pair.done
+	pair.done <- err
+	pair.Master.Close()
+	pair.Slave.Close()
+}
@@ -0,0 +1,193 @@
+package testing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/buptWYChen/gotty/webtty"
+	wttesting "github.com/buptWYChen/gotty/webtty/testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of the protocol")
+
+const syncTimeout = 2 * time.Second
+
+// goldenFrames are the frames sent to a session for each message type
+// of the master, valid and malformed ones.
+var goldenFrames = map[byte][]string{
+	webtty.Input:             {"1ls\r", "1", "1\x1b[A"},
+	webtty.Ping:              {"2", "2ignored"},
+	webtty.ResizeTerminal:    {`3{"Columns":100,"Rows":30}`, `3{"Columns":0,"Rows":30}`, `3{"Columns":"100"}`, "3", "3not json"},
+	webtty.RequestStats:      {"4", "4", "4slow", "4-1", "4NaN"},
+	webtty.KeepAlivePong:     {"5"},
+	webtty.AcknowledgeOutput: {"610", "6-1", "6"},
+	webtty.FileTransfer:      {`7{"id":1,"op":"download","path":"/etc/passwd"}`, "7{"},
+	webtty.UploadChunk:       {`8{"id":1,"offset":0,"data":""}`, "8"},
+	webtty.SetPermitWrite:    {"9false", "9true", "9yes"},
+	webtty.ControlReplay:     {`A{"action":"pause"}`, "Anot json"},
+	webtty.Unlock:            {`B{"credential":"secret"}`, "Bnot json"},
+	// handled by a Mux, not by the sessions
+	webtty.ChannelFrame: {"C1:1ls"},
+	webtty.OpenChannel:  {"D1:{}"},
+	webtty.CloseChannel: {"E1:"},
+}
+
+// unknownFrames are frames the protocol doesn't define.
+var unknownFrames = []string{"", "0", "Z", "zcustom", "\xff"}
+
+func TestGoldenMessages(t *testing.T) {
+	for _, info := range webtty.MessageTypes() {
+		if info.Direction != webtty.MasterToSlave {
+			continue
+		}
+		frames, ok := goldenFrames[info.Type]
+		if !ok {
+			t.Errorf("No golden frames for %s", info.Name)
+			continue
+		}
+		checkGolden(t, info.Name, frames)
+	}
+	checkGolden(t, "Unknown", unknownFrames)
+}
+
+// checkGolden sends frames to a session in turn and compares what each
+// of them did with the golden file of name.
+func checkGolden(t *testing.T, name string, frames []string) {
+	pair, err := wttesting.Start(webtty.WithPermitWrite())
+	if err != nil {
+		t.Fatalf("Unexpected error from Start(): %s", err)
+	}
+	defer pair.Stop()
+	if _, err := pair.Sync(syncTimeout); err != nil {
+		t.Fatalf("Session of %s not started: %s", name, err)
+	}
+
+	var got bytes.Buffer
+	input, sizes, malformed := "", 0, uint64(0)
+	for _, frame := range frames {
+		pair.Master.Send([]byte(frame))
+		fmt.Fprintf(&got, "> %q\n", frame)
+		if len(frame) > 0 && frame[0] == webtty.Ping {
+			// answered before the Pong of Sync
+			pong, err := pair.Master.NextOf(webtty.Pong, syncTimeout)
+			if err != nil {
+				t.Fatalf("Session of %s stopped after %q: %s", name, frame, err)
+			}
+			fmt.Fprintf(&got, "< %s\n", describeFrame(pong))
+		}
+		written, err := pair.Sync(syncTimeout)
+		if err != nil {
+			t.Fatalf("Session of %s stopped after %q: %s", name, frame, err)
+		}
+
+		for _, w := range written {
+			fmt.Fprintf(&got, "< %s\n", describeFrame(w))
+		}
+		if current := pair.Slave.Input(); current != input {
+			fmt.Fprintf(&got, "slave input %q\n", current[len(input):])
+			input = current
+		}
+		if current := pair.Slave.Sizes(); len(current) != sizes {
+			for _, size := range current[sizes:] {
+				fmt.Fprintf(&got, "slave resized to %dx%d\n", size.Columns, size.Rows)
+			}
+			sizes = len(current)
+		}
+		if current := pair.TTY.Stats().MalformedFrames; current != malformed {
+			fmt.Fprintf(&got, "dropped as malformed\n")
+			malformed = current
+		}
+	}
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(path, got.Bytes(), 0644); err != nil {
+			t.Fatalf("Unexpected error from WriteFile(): %s", err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error from ReadFile(): %s", err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("Unexpected frames for %s, want:\n%s\ngot:\n%s", name, want, got.Bytes())
+	}
+}
+
+// describeFrame names the type of frame followed by its payload, the
+// fields of a StatsReport only as its values change with time.
+func describeFrame(frame []byte) string {
+	if len(frame) == 0 {
+		return "empty"
+	}
+	name := fmt.Sprintf("`%c`", frame[0])
+	for _, info := range webtty.MessageTypes() {
+		if info.Direction == webtty.SlaveToMaster && info.Type == frame[0] {
+			name = info.Name
+		}
+	}
+	payload := frame[1:]
+	if frame[0] == webtty.StatsReport {
+		var stats map[string]interface{}
+		if err := json.Unmarshal(payload, &stats); err != nil {
+			return name + " " + err.Error()
+		}
+		fields := make([]string, 0, len(stats))
+		for field := range stats {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		return fmt.Sprintf("%s %v", name, fields)
+	}
+	return fmt.Sprintf("%s %q", name, payload)
+}
+
+func TestMalformedFrameStrict(t *testing.T) {
+	pair, err := wttesting.Start(webtty.WithStrictProtocol(), webtty.WithCloseReason())
+	if err != nil {
+		t.Fatalf("Unexpected error from Start(): %s", err)
+	}
+	defer pair.Stop()
+
+	pair.Master.SendMessage(webtty.ResizeTerminal, "not json")
+	if err := pair.Wait(syncTimeout); !errors.Is(err, webtty.ErrMalformedFrame) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	frame, err := pair.Master.NextOf(webtty.CloseReason, syncTimeout)
+	if err != nil {
+		t.Fatalf("Unexpected error from NextOf(): %s", err)
+	}
+	if want := `I{"code":"malformed_frame","message":"malformed frame"}`; string(frame) != want {
+		t.Fatalf("Unexpected close reason: %s", frame)
+	}
+}
+
+func TestSlaveClosed(t *testing.T) {
+	pair, err := wttesting.Start()
+	if err != nil {
+		t.Fatalf("Unexpected error from Start(): %s", err)
+	}
+	defer pair.Stop()
+
+	go pair.Slave.Output("bye\r\n")
+	frame, err := pair.Master.NextOf(webtty.Output, syncTimeout)
+	if err != nil {
+		t.Fatalf("Unexpected error from NextOf(): %s", err)
+	}
+	if want := "1YnllDQo="; string(frame) != want {
+		t.Fatalf("Unexpected output: %s", frame)
+	}
+	pair.Slave.Close()
+	if err := pair.Wait(syncTimeout); !errors.Is(err, webtty.ErrSlaveClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}
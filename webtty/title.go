@@ -0,0 +1,29 @@
+package webtty
+
+import (
+	"bytes"
+)
+
+// parseTitleOSC returns the title set by an OSC 0 or OSC 2 payload,
+// which set the window title together with the icon name or alone.
+func parseTitleOSC(payload []byte) ([]byte, bool) {
+	if len(payload) < 2 || payload[1] != ';' || (payload[0] != '0' && payload[0] != '2') {
+		return nil, false
+	}
+	return payload[2:], true
+}
+
+// trackTitle sends a window title set by the slave to the master
+// and the observers, and keeps it for observers attached later.
+func (wt *WebTTY) trackTitle(title []byte) {
+	wt.writeMutex.Lock()
+	if bytes.Equal(wt.windowTitle, title) {
+		wt.writeMutex.Unlock()
+		return
+	}
+	wt.windowTitle = append([]byte{}, title...)
+	wt.writeMutex.Unlock()
+
+	// a broken master is detected by the read loop
+	wt.masterWrite(append([]byte{SetWindowTitle}, title...))
+}
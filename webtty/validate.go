@@ -0,0 +1,59 @@
+package webtty
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// OptionsError is returned by New when options are invalid.
+// It lists all the problems found, not only the first one.
+type OptionsError struct {
+	Errors []error
+}
+
+func (oe *OptionsError) Error() string {
+	messages := make([]string, len(oe.Errors))
+	for i, err := range oe.Errors {
+		messages[i] = err.Error()
+	}
+	return "invalid options: " + strings.Join(messages, "; ")
+}
+
+// validate checks the configuration once all options are applied,
+// for values and combinations single options can't check.
+func (wt *WebTTY) validate() []error {
+	var errs []error
+	check := func(ok bool, message string) {
+		if !ok {
+			errs = append(errs, errors.New(message))
+		}
+	}
+
+	check(wt.bufferSize > 0, "buffer size must be positive")
+	check(wt.maxInboundFrameSize > 0, "max inbound frame size must be positive")
+	check(wt.maxFrameSize == 0 || wt.outputChunkSize() > 0, "max frame size is too small for a payload")
+	check(wt.inputEncoding == EncodingBase64 || wt.inputEncoding == EncodingRaw, "unknown input encoding")
+	check(wt.outputEncoding == EncodingBase64 || wt.outputEncoding == EncodingRaw, "unknown output encoding")
+	check(wt.inputGraceAction == InputGraceBuffer || wt.inputGraceAction == InputGraceDrop, "unknown input grace action")
+	check(wt.clipboardPolicy >= ClipboardStrip && wt.clipboardPolicy <= ClipboardPassthrough, "unknown clipboard policy")
+	check(wt.lineHandler == nil || wt.commandRewriter == nil, "line handler and command rewriter can't be used together")
+	check(wt.lineHandler == nil || wt.inputFilter == nil, "line handler and input filter can't be used together")
+	check(wt.sessionExpiryWarning == 0 || wt.maxSessionDuration > 0, "session expiry warning requires a max session duration")
+	check(wt.maxSessionDuration == 0 || wt.sessionExpiryWarning < wt.maxSessionDuration, "session expiry warning must be shorter than the max session duration")
+	check(!wt.recordInput || wt.recorder != nil, "recording input requires a recorder")
+	check(wt.screenLock == nil || wt.idleTimeout == 0 || wt.screenLock.idle < wt.idleTimeout, "screen lock idle time must be shorter than the idle timeout")
+	check(wt.approval == nil || wt.idleTimeout == 0 || wt.approval.timeout < wt.idleTimeout, "approval timeout must be shorter than the idle timeout")
+	check(wt.activityTimeout == 0 || wt.activityExpired != nil, "activity timeout requires a callback")
+	check(wt.pongTimeout == 0 || wt.pongExpired != nil, "pong timeout requires a callback")
+	check(wt.localEchoThreshold == 0 || wt.latencyProbeInterval > 0 || wt.keepAliveInterval > 0, "local echo threshold requires latency probes or keepalive")
+	check(wt.reattachTimeout == 0 || wt.replay != nil, "reattach timeout requires a replay buffer")
+	check(wt.reattachToken == "" || wt.replay != nil, "reattach token requires a replay buffer")
+	if logger, ok := wt.auditLogger.(*HTTPAuditLogger); ok {
+		for _, problem := range logger.validate() {
+			check(false, problem)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,68 @@
+package webtty
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		options  []Option
+		expected []string
+	}{
+		{"valid", []Option{WithPermitWrite()}, nil},
+		{"option error", []Option{WithMaxInboundFrameSize(0)}, []string{"max inbound frame size must be positive"}},
+		{"encoding", []Option{WithInputEncoding(Encoding(7))}, []string{"unknown input encoding"}},
+		{"grace action", []Option{WithInputGraceAction(InputGraceAction(7))}, []string{"unknown input grace action"}},
+		{"clipboard", []Option{WithClipboardPolicy(ClipboardPolicy(7))}, []string{"unknown clipboard policy"}},
+		{
+			"line handler with rewriter",
+			[]Option{WithLineHandler(strings.ToUpper), WithCommandRewriter(strings.ToLower)},
+			[]string{"line handler and command rewriter can't be used together"},
+		},
+		{
+			"warning without duration",
+			[]Option{WithSessionExpiryWarning(time.Second)},
+			[]string{"session expiry warning requires a max session duration"},
+		},
+		{
+			"warning too long",
+			[]Option{WithMaxSessionDuration(time.Second), WithSessionExpiryWarning(time.Minute)},
+			[]string{"session expiry warning must be shorter than the max session duration"},
+		},
+		{"activity timeout", []Option{WithActivityTimeout(time.Second, nil)}, []string{"activity timeout requires a callback"}},
+		{"pong timeout", []Option{WithPongTimeout(time.Second, nil)}, []string{"pong timeout requires a callback"}},
+		{
+			"aggregated",
+			[]Option{WithMaxInboundFrameSize(-1), WithPongTimeout(-time.Second, nil)},
+			[]string{"max inbound frame size must be positive", "pong timeout must not be negative"},
+		},
+	}
+
+	for _, c := range cases {
+		dt, err := New(discardMaster{}, &pipeSlave{}, c.options...)
+		if c.expected == nil {
+			if err != nil || dt == nil {
+				t.Errorf("%s: unexpected error from New(): %v", c.name, err)
+			}
+			continue
+		}
+
+		oe, ok := err.(*OptionsError)
+		if !ok {
+			t.Errorf("%s: unexpected error from New(): %v", c.name, err)
+			continue
+		}
+		if len(oe.Errors) != len(c.expected) {
+			t.Errorf("%s: unexpected errors: %s", c.name, oe)
+			continue
+		}
+		for i, message := range c.expected {
+			if oe.Errors[i].Error() != message {
+				t.Errorf("%s: unexpected error %d: %s", c.name, i, oe.Errors[i])
+			}
+		}
+	}
+}
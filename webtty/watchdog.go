@@ -0,0 +1,43 @@
+package webtty
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// watchSlaveReads checks every interval/2 whether input written to the
+// slave has been waiting for a read for over interval. It warns once per
+// hang and, when the session must end, closes hung and returns.
+func (wt *WebTTY) watchSlaveReads(ctx context.Context, interval time.Duration, hung chan<- struct{}) {
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+
+	warned := false
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		written := atomic.LoadInt64(&wt.lastSlaveWrite)
+		read := atomic.LoadInt64(&wt.lastSlaveRead)
+		if written <= read || time.Since(time.Unix(0, written)) < interval {
+			warned = false
+			continue
+		}
+		if warned {
+			continue
+		}
+		warned = true
+
+		session := wt.Session()
+		fmt.Println("slave of session", session.SessionID, "returned no output", interval, "after input")
+		if wt.slaveReadWatchdogEnd {
+			close(hung)
+			return
+		}
+	}
+}
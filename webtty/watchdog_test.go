@@ -0,0 +1,47 @@
+package webtty
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestSlaveReadWatchdog(t *testing.T) {
+	// the output of the slave is never written
+	slaveOutPipeReader, slaveOutPipeWriter := io.Pipe()
+	defer slaveOutPipeWriter.Close()
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, slaveInPipeReader)
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(discardMaster{}, slave, WithPermitWrite(), WithSlaveReadWatchdog(20*time.Millisecond, true))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- dt.Run(context.Background(), "", "")
+	}()
+
+	// no input is pending yet
+	select {
+	case err := <-done:
+		t.Fatalf("Unexpected end of Run() without input: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := dt.forwardInput([]byte("ls")); err != nil {
+		t.Fatalf("Unexpected error from forwardInput(): %s", err)
+	}
+	select {
+	case err := <-done:
+		if err != ErrSlaveHung {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Hung slave not detected")
+	}
+}
@@ -2,23 +2,61 @@ package webtty
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"io"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unicode"
 
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/pkg/randomstring"
+	"github.com/buptWYChen/gotty/webtty/protocol"
+)
+
+const (
+	DefaultMaxInboundFrameSize = 1024 * 1024
+
+	// Largest terminal size accepted from the master by default
+	DefaultMaxTerminalColumns = 1000
+	DefaultMaxTerminalRows    = 1000
 )
 
 // WebTTY bridges a PTY slave and its PTY master.
 // To support text-based streams and side channel commands such as
 // terminal resizing, WebTTY uses an original protocol.
 type WebTTY struct {
+	// accessed atomically, kept first for 64-bit alignment on 32-bit platforms
+	auditFilteredCounter uint64
+	rejectedObservers    uint64
+	droppedObservers     uint64
+	bytesIn              uint64
+	bytesOut             uint64
+	bytesToSlave         uint64
+	bytesToMaster        uint64
+	resizeCount          uint64
+	compressedBytes      uint64
+	compressedSize       uint64
+	malformedFrames      uint64
+	reportedRTT          int64 // in nanoseconds
+	latency              int64 // in nanoseconds
+	smoothedLatency      int64 // in nanoseconds
+	pingSent             int64 // in Unix nanoseconds, 0 unless a ping is timed
+	lastActivity         int64 // in Unix nanoseconds
+	lastInput            int64 // in Unix nanoseconds
+	lastPong             int64 // in Unix nanoseconds
+	lastKeepAlive        int64 // in Unix nanoseconds
+	lastSlaveRead        int64 // in Unix nanoseconds
+	lastSlaveWrite       int64 // in Unix nanoseconds
+
 	// PTY Master, which probably a connection to browser
-	masterConn Master
+	masterConn  Master
+	masterMutex sync.RWMutex // guards masterConn along with writeMutex
 	// PTY Slave
 	slave Slave
 
@@ -26,17 +64,195 @@ type WebTTY struct {
 	permitWrite bool
 	columns     int
 	rows        int
+	sizeMode    SizeMode
+	sizeSeeded  bool
+	maxColumns  int
+	maxRows     int
 	reconnect   int // in seconds
 	masterPrefs []byte
 
-	bufferSize int
-	writeMutex sync.Mutex
+	messageHandlers map[byte]func(wt *WebTTY, payload []byte) error
+
+	// whether windowTitle, reconnect and masterPrefs were sent to the master
+	// of the running session
+	settingsSent bool
+
+	initialCommand string
+
+	bufferSize          int
+	writeTimeout        time.Duration
+	writeTimedOut       bool // guarded by writeMutex
+	inputEncoding       Encoding
+	outputEncoding      Encoding
+	maxInboundFrameSize int
+	maxFrameSize        int
+	writeMutex          sync.Mutex // also guards observers, windowTitle and masterPrefs
+	outputMutex         sync.Mutex
+	outputBuffer        []byte
+	compressor          *outputCompressor
+	slaveWriteMutex     sync.Mutex // also guards slave and its last size
+	slaveColumns        int
+	slaveRows           int
+
+	permitInjection  bool
+	session          SessionInfo
+	sessionInfoFrame bool
+	closeReasonFrame bool
+	identity         Identity
+	fileTransfers    *fileTransfers
+
+	// features advertised by this end and by the master
+	serverFeatures     FeatureSet
+	clientFeatures     FeatureSet
+	negotiatedFeatures FeatureSet
+	// version of the protocol spoken with the master,
+	// and whether it announced one
+	protocolVersion   int
+	protocolAnnounced bool
+	stateMutex        sync.RWMutex
+	notifyMutex       sync.Mutex
+
+	writableHook         func(writable bool)
+	writeControl         func(identity Identity) bool
+	running              bool
+	writeGrantDuration   time.Duration
+	writeGrantTimer      *time.Timer
+	writeGrantGeneration int
+	startedAt            time.Time
+
+	auditFilePath    string
+	auditFileMaxSize int
+	auditFileMaxAge  time.Duration
+	auditFile        *AuditFile
+	auditFileJSON    bool
+	auditLogger      AuditLogger
+	auditRetention   *AuditFileRetention
+
+	auditHeartbeatInterval time.Duration
+	auditSessionStartEvent bool
+	auditLifecycleEvents   bool
+	sessionStartLimiter    *AuditLimiter
+
+	maxSessionDuration   time.Duration
+	sessionExpiryWarning time.Duration
+	sessionExpiryMessage string
+	slaveReadWatchdog    time.Duration
+	slaveReadWatchdogEnd bool
+
+	activityTimeout time.Duration
+	activityExpired func()
+	idleTimeout     time.Duration
+	screenLock      *screenLock
+	approval        *approval
+	grant           *grant
+	inputLimiter    *rateLimiter
+	outputLimiter   *rateLimiter
+	outputTruncator *outputTruncation
+	pongTimeout     time.Duration
+	pongExpired     func()
+
+	keepAliveInterval time.Duration
+	keepAliveTimeout  time.Duration
+
+	latencyProbeInterval time.Duration
+	localEchoEnabled     bool
+	localEchoThreshold   time.Duration
+	localEcho            *localEcho
+
+	eraseKeys     []byte
+	secretMatcher func(recentOutput []byte) bool
+	reconstructor *inputReconstructor
+
+	auditFilter func(cmd string) bool
+	auditTrim   bool
+	auditRedact func(text string) string
+
+	alertRules    *CommandAlertRules
+	alertNotifier CommandAlertNotifier
+
+	frameTypeObserver   func(mt MessageType)
+	keystrokeTimingHook func(delta time.Duration)
+	timestampedPong     bool
+	lastKeystroke       time.Time
+
+	observers         []Master
+	maxObservers      int
+	observerQueueSize int
+	observerReplay    bool // set by NewBroadcast
+
+	inputEscapeFilter bool
+	// return the malformed frames of the master instead of dropping them
+	strictProtocol bool
+
+	interruptReadsOnCancel bool
+	drainTimeout           time.Duration
+	commandRewriter        func(line string) string
+	inputFilter            func(line string) error
+	lineBuffer             *lineBuffer
+	lineHandler            func(line string) string
+	menuPrompt             string
+	menu                   *menu
+
+	metrics      Metrics
+	commandStats commandStats
+
+	resize      resizeState
+	resizeAudit resizeAudit
+
+	messageSets      map[string]Messages
+	clientLocale     string
+	defaultLocale    string
+	messages         Messages
+	readOnlyNotified bool
+
+	clipboardPolicy ClipboardPolicy
+	oscScanner      *oscScanner
+	outputSanitizer *outputSanitizer
+	titleTracking   bool
+
+	workingDirTracking bool
+	workingDir         string // guarded by stateMutex
+
+	memoryPressure <-chan struct{}
+	flowWindow     *flowWindow
+
+	fullCapture         bool
+	fullCaptureInterval time.Duration
+	fullCaptureMaxBytes int
+	outputAudit         *outputAudit
+
+	captureWriter   io.Writer
+	captureMaxBytes int
+	capture         *sessionCapture
+	recorder        *castRecorder
+	recordInput     bool
+	replay          *replayBuffer
+	reattached      chan struct{}
+	reattachTimeout time.Duration
+	reattachToken   string
+
+	inputGracePeriod time.Duration
+	inputGraceAction InputGraceAction
+	inputGrace       *inputGrace
+
+	flushInterval    time.Duration
+	coalesceMaxBytes int
+	coalesceOverflow func(buffered int) CoalesceOverflowAction
+	coalescer        *outputCoalescer
+
+	outputInspector  OutputInspector
+	inspectionDelay  time.Duration
+	outputInspection *outputInspection
+
+	terminated    chan struct{}
+	terminateOnce sync.Once
 }
 
 // New creates a new instance of WebTTY.
 // masterConn is a connection to the PTY master,
 // typically it's a websocket connection to a client.
 // slave is a PTY slave such as a local command with a PTY.
+// Invalid options are reported together in an OptionsError.
 func New(masterConn Master, slave Slave, options ...Option) (*WebTTY, error) {
 	wt := &WebTTY{
 		masterConn: masterConn,
@@ -46,11 +262,88 @@ func New(masterConn Master, slave Slave, options ...Option) (*WebTTY, error) {
 		columns:     0,
 		rows:        0,
 
-		bufferSize: 1024,
+		bufferSize:          1024,
+		inputEncoding:       EncodingRaw,
+		outputEncoding:      EncodingBase64,
+		maxInboundFrameSize: DefaultMaxInboundFrameSize,
+
+		eraseKeys: DefaultEraseKeys,
+
+		maxColumns: DefaultMaxTerminalColumns,
+		maxRows:    DefaultMaxTerminalRows,
+
+		fullCaptureInterval: DefaultFullCaptureInterval,
+		fullCaptureMaxBytes: DefaultFullCaptureMaxBytes,
+
+		inspectionDelay: DefaultOutputInspectionDelay,
+
+		defaultLocale: DefaultLocale,
+
+		protocolVersion: protocol.LegacyVersion,
+
+		terminated: make(chan struct{}),
 	}
 
+	var errs []error
 	for _, option := range options {
-		option(wt)
+		err := option(wt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, wt.validate()...)
+	if len(errs) > 0 {
+		return nil, &OptionsError{Errors: errs}
+	}
+
+	wt.reconstructor = newInputReconstructor(wt.eraseKeys)
+	wt.reconstructor.secretMatcher = wt.secretMatcher
+	wt.messages = selectMessages(wt.messageSets, wt.clientLocale, wt.defaultLocale)
+	if wt.captureWriter != nil {
+		wt.capture = newSessionCapture(wt.captureWriter, wt.captureMaxBytes)
+	}
+	if wt.inputGracePeriod > 0 {
+		wt.inputGrace = newInputGrace(wt.inputGraceAction, wt.maxInboundFrameSize, wt.forwardInput)
+	}
+	if wt.commandRewriter != nil || wt.inputFilter != nil {
+		wt.lineBuffer = newLineBuffer(wt.eraseKeys, wt.commandRewriter)
+		wt.lineBuffer.filter = wt.inputFilter
+		wt.lineBuffer.blockedMessage = wt.messages.CommandBlocked
+	}
+	if wt.auditLogger == nil {
+		wt.auditLogger = nopAuditLogger{}
+	}
+	if wt.fullCapture {
+		wt.outputAudit = newOutputAudit(wt.fullCaptureInterval, wt.fullCaptureMaxBytes, wt.auditOutput)
+	}
+	if wt.lineHandler != nil {
+		wt.menu = newMenu(wt.eraseKeys, wt.lineHandler, wt.menuPrompt)
+	}
+	if wt.replay != nil {
+		wt.reattached = make(chan struct{}, 1)
+	}
+	if wt.clipboardPolicy != ClipboardPassthrough || wt.titleTracking || wt.workingDirTracking {
+		wt.oscScanner = newOSCScanner(wt.handleOSC)
+		wt.oscScanner.drop = wt.dropOSC
+	}
+	if wt.localEchoEnabled {
+		wt.localEcho = newLocalEcho(wt.writeOutput, wt.localEchoActive, wt.localEchoTimeout)
+	}
+	if wt.session.SessionID == "" {
+		wt.session.SessionID = randomstring.Generate(sessionIDLength)
+	}
+
+	if wt.auditFilePath != "" && wt.auditFile == nil {
+		auditFile, err := OpenAuditFile(wt.auditFilePath, wt.auditFileMaxSize, wt.auditFileMaxAge)
+		if err != nil {
+			return nil, err
+		}
+		if wt.auditRetention != nil {
+			if err := auditFile.SetRetention(*wt.auditRetention); err != nil {
+				return nil, err
+			}
+		}
+		wt.auditFile = auditFile
 	}
 
 	return wt, nil
@@ -69,27 +362,249 @@ type Metadatalog struct {
 // Note that the master and slave are left intact even
 // after the context is canceled. Closing them is caller's
 // responsibility.
-// If the connection to one end gets closed, returns ErrSlaveClosed or ErrMasterClosed.
-func (wt *WebTTY) Run(ctx context.Context, userAccount string, clusterId string) error {
-	err := wt.sendInitializeMessage()
+// If the connection to one end gets closed, returns an error matching
+// ErrSlaveClosed or ErrMasterClosed with errors.Is, which unwraps to the cause.
+// It is a *SlaveExitError when the slave reports the exit status of its
+// process, see ExitStatusReporter.
+// The user and cluster of the session are taken from ctx,
+// see WithSessionContext, as well as the identity of the user,
+// see WithIdentityContext.
+//
+// Deprecated: the user and cluster given as the positional parameters
+// after ctx are kept for compatibility, use WithSessionContext instead.
+func (wt *WebTTY) Run(ctx context.Context, userAndCluster ...string) (err error) {
+	wt.stateMutex.Lock()
+	if identity, ok := IdentityFromContext(ctx); ok {
+		wt.identity = identity
+		wt.session.User = identity.User
+	}
+	if info, ok := SessionFromContext(ctx); ok {
+		if info.User != "" {
+			wt.session.User = info.User
+		}
+		wt.session.ClusterID = info.ClusterID
+		wt.session.RemoteAddr = info.RemoteAddr
+		if info.SessionID != "" {
+			wt.session.SessionID = info.SessionID
+		}
+		if info.Tags != nil {
+			wt.session.Tags = copyTags(info.Tags)
+		}
+	}
+	if len(userAndCluster) > 0 {
+		wt.session.User = userAndCluster[0]
+	}
+	if len(userAndCluster) > 1 {
+		wt.session.ClusterID = userAndCluster[1]
+	}
+	wt.startedAt = time.Now()
+	wt.stateMutex.Unlock()
+	storeTime(&wt.lastActivity, wt.startedAt)
+	storeTime(&wt.lastPong, wt.startedAt)
+
+	// sent last, once every pending output is
+	exitReason := ""
+	defer func() { wt.sendCloseReason(ctx, err, exitReason) }()
+
+	err = wt.sendInitializeMessage()
 	if err != nil {
 		return errors.Wrapf(err, "failed to send initializing message")
 	}
 
+	if wt.initialCommand != "" {
+		command := wt.initialCommand
+		if !strings.HasSuffix(command, "\n") {
+			command += "\n"
+		}
+		_, err := wt.slaveWrite([]byte(command))
+		if err != nil {
+			return errors.Wrapf(err, "failed to send initial command")
+		}
+	}
+
+	wt.setRunning(true)
+	defer wt.setRunning(false)
+	defer wt.resetSettings()
+	// recorded once the pending resize is
+	defer func() { wt.auditSessionEnd(err, exitReason) }()
+
+	// sent once running, so that any later change is notified
+	err = wt.sendPermitWrite()
+	if err != nil {
+		return errors.Wrapf(err, "failed to send read only")
+	}
+	if wt.metrics != nil {
+		wt.metrics.IncSession()
+		defer func() { wt.metrics.ObserveSessionDuration(time.Since(wt.startedAt)) }()
+	}
+	defer wt.stopObserverQueues()
+	if wt.fileTransfers != nil {
+		defer wt.stopFileTransfers()
+	}
+	defer wt.flushResizeAudit()
+	defer wt.stopResizes()
+	if wt.outputAudit != nil {
+		defer wt.outputAudit.flush()
+	}
+	if wt.recorder != nil {
+		wt.recorder.start(wt.startedAt, wt.columns, wt.rows)
+		defer wt.recorder.flush()
+	}
+
+	if wt.flushInterval > 0 {
+		maxBytes := wt.coalesceMaxBytes
+		if maxBytes <= 0 {
+			maxBytes = wt.bufferSize
+		}
+		wt.coalescer = newOutputCoalescer(wt.flushInterval, maxBytes, wt.coalesceOverflow, wt.sendOutput)
+		defer wt.coalescer.close()
+	}
+	// flushed before the coalescer, which sends its output
+	if wt.outputInspector != nil {
+		wt.outputInspection = newOutputInspection(wt.outputInspector, wt.inspectionDelay, wt.forwardOutput, wt.auditInspection)
+		defer wt.outputInspection.close()
+	}
+
+	if wt.menu != nil && wt.menuPrompt != "" {
+		err := wt.writeOutput([]byte(wt.menuPrompt))
+		if err != nil {
+			return errors.Wrapf(err, "failed to send menu prompt")
+		}
+	}
+
+	expired, stopExpiry := wt.startExpiry()
+	defer stopExpiry()
+
+	idle, stopIdle := wt.startIdleTimeout(ctx)
+	defer stopIdle()
+
+	rejected, stopApproval := wt.startApproval()
+	defer stopApproval()
+
+	grantEnded, stopGrant := wt.startGrant()
+	defer stopGrant()
+
+	var dead chan struct{}
+	if wt.keepAliveInterval > 0 {
+		dead = make(chan struct{})
+		keepAliveCtx, stopKeepAlive := context.WithCancel(ctx)
+		defer stopKeepAlive()
+		go wt.keepAlive(keepAliveCtx, dead)
+	}
+	if wt.latencyProbeInterval > 0 {
+		probeCtx, stopProbes := context.WithCancel(ctx)
+		defer stopProbes()
+		go wt.probeLatency(probeCtx, wt.latencyProbeInterval)
+	}
+	if wt.localEcho != nil {
+		defer wt.localEcho.close()
+	}
+
+	var hung chan struct{}
+	if wt.slaveReadWatchdog > 0 {
+		hung = make(chan struct{})
+		watchdogCtx, stopWatchdog := context.WithCancel(ctx)
+		defer stopWatchdog()
+		go wt.watchSlaveReads(watchdogCtx, wt.slaveReadWatchdog, hung)
+	}
+
+	if wt.auditSessionStartEvent || wt.auditLifecycleEvents {
+		go wt.auditSessionStart(ctx)
+	}
+
+	if wt.activityTimeout > 0 && wt.activityExpired != nil {
+		go watchIdle(ctx, wt.activityTimeout, wt.LastActivity, wt.activityExpired)
+	}
+	if wt.screenLock != nil && wt.NegotiatedFeatures().ScreenLock {
+		go watchIdle(ctx, wt.screenLock.idle, wt.LastActivity, wt.lockScreen)
+	}
+	if wt.pongTimeout > 0 && wt.pongExpired != nil {
+		go watchIdle(ctx, wt.pongTimeout, wt.LastPong, wt.pongExpired)
+	}
+
+	if wt.auditHeartbeatInterval > 0 {
+		heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+		defer stopHeartbeat()
+		go runHeartbeat(heartbeatCtx, wt.auditHeartbeatInterval, wt.auditHeartbeat)
+	}
+
+	if wt.inputGrace != nil {
+		wt.inputGrace.start(wt.inputGracePeriod)
+		defer wt.inputGrace.stop()
+	}
+
+	if limiter, ok := wt.currentMaster().(readLimiter); ok {
+		limiter.SetReadLimit(int64(wt.maxInboundFrameSize))
+	}
+
 	errs := make(chan error, 2)
+	// tells the read loops still blocked when Run returns to exit
+	// without handling what they read
+	stopped := make(chan struct{})
+	defer close(stopped)
 
 	go func() {
 		errs <- func() error {
 			buffer := make([]byte, wt.bufferSize)
+			pressure := wt.memoryPressure
+			var flow *flowWindow
+			if wt.NegotiatedFeatures().FlowControl {
+				flow = wt.flowWindow
+			}
 			for {
-				n, err := wt.slave.Read(buffer)
+				var err error
+				pressure, err = checkMemoryPressure(ctx, pressure)
 				if err != nil {
-					return ErrSlaveClosed
+					return err
+				}
+				if flow != nil {
+					err = flow.wait(ctx, stopped)
+					if err != nil {
+						return err
+					}
 				}
 
-				err = wt.handleSlaveReadEvent(buffer[:n])
+				slave := wt.currentSlave()
+				readStart := time.Now()
+				n, err := slave.Read(buffer)
+				readEnd := time.Now()
+				storeTime(&wt.lastSlaveRead, readEnd)
+				if isStopped(stopped) {
+					return ctx.Err()
+				}
 				if err != nil {
-					return err
+					if wt.currentSlave() != slave {
+						continue
+					}
+					return &closedError{ErrSlaveClosed, err}
+				}
+
+				data := buffer[:n]
+				truncated := false
+				if wt.outputTruncator != nil {
+					data, truncated = wt.outputTruncator.admit(data, readEnd.Sub(readStart))
+				}
+				if wt.outputLimiter != nil && len(data) > 0 {
+					err = wt.outputLimiter.wait(ctx, len(data))
+					if err != nil {
+						return err
+					}
+					if isStopped(stopped) {
+						return ctx.Err()
+					}
+				}
+
+				if len(data) > 0 {
+					err = wt.handleSlaveReadEvent(data)
+					if err != nil {
+						return err
+					}
+				}
+				if truncated {
+					err = wt.printMessage(wt.messages.OutputTruncated)
+					if err != nil {
+						return err
+					}
 				}
 			}
 		}()
@@ -98,59 +613,55 @@ func (wt *WebTTY) Run(ctx context.Context, userAccount string, clusterId string)
 	go func() {
 		errs <- func() error {
 			buffer := make([]byte, wt.bufferSize)
-			var log string
+			var filtered, decoded []byte
+			if wt.inputEncoding == EncodingBase64 {
+				// decoded input is never longer than its frame
+				decoded = make([]byte, wt.bufferSize)
+			}
 			for {
-				n, err := wt.masterConn.Read(buffer)
+				master := wt.currentMaster()
+				n, err := master.Read(buffer)
+				if isStopped(stopped) {
+					return ctx.Err()
+				}
 				if err != nil {
-					return ErrMasterClosed
+					if wt.awaitReattach(ctx, master) {
+						continue
+					}
+					if err == websocket.ErrReadLimit || err == ErrFrameTooLarge {
+						return errors.Wrapf(ErrFrameTooLarge, "limit is %d bytes", wt.maxInboundFrameSize)
+					}
+					return &closedError{ErrMasterClosed, err}
+				}
+				atomic.AddUint64(&wt.bytesIn, uint64(n))
+				// frames larger than the buffer are only detected by a readLimiter
+				if n > wt.maxInboundFrameSize {
+					return errors.Wrapf(ErrFrameTooLarge, "received %d bytes, limit is %d bytes", n, wt.maxInboundFrameSize)
+				}
+				if wt.capture != nil {
+					wt.capture.record(MasterToSlave, buffer[:n])
 				}
 
-				// 审计日志
-				// （ 操作 - buffer[:n] ）
-				// 退格 - [49 127]
-				// 空 - [50]
-				// 空格	- [49 32]
-				// 正常内容 - [49 ascii]
-				// 上下左右 四个字符
-
-				// 调试用的日志
-				//fmt.Println("[集群:", clusterId, "]-[用户:", userAccount, "]-[时间:", time.Now().Format("2006-01-02 15:04:05"), "]-[LOG:", string(buffer[:n]), "]", buffer[:n])
-
-				if len(buffer[:n]) == 2 {
-					if string(buffer[:n]) == string([]byte{49, 13}) { // 判断内容为回车
-
-						var metadatalog Metadatalog
-						metadatalog.ClusterId = clusterId
-						metadatalog.UserAccount = userAccount
-						metadatalog.Time = time.Now().Format("2006-01-02 15:04:05")
-						metadatalog.Log = log
-						jsonBytes, err := json.Marshal(metadatalog)
-						if err != nil {
-							fmt.Println(err)
-						}
-						fmt.Println("metadatalog: ", string(jsonBytes))
-
-						// 审计日志输出
-						LogOutpu("[集群:" + clusterId + "]-[用户:" + userAccount + "]-[时间:" + time.Now().Format("2006-01-02 15:04:05") + "]-[LOG:" + log + "]")
-						fmt.Println("[集群:", clusterId, "]-[用户:", userAccount, "]-[时间:", time.Now().Format("2006-01-02 15:04:05"), "]-[LOG:", log, "]")
-
-						log = ""
-					} else if string(buffer[:n]) == string([]byte{49, 127}) { // 判断内容为退格
-						if len(log) >= 2 {
-							log = log[:len(log)-2]
-						} else if len(log) == 1 {
-							log = ""
-						}
-					} else if string(buffer[0]) == string([]byte{49}) { // 判断内容为正常输入
-						log = log + string(buffer[1])
+				frame, err := wt.decodeInputFrame(decoded, buffer[:n])
+				if err != nil {
+					if wt.dropMalformedFrame(err) {
+						continue
+					}
+					return err
+				}
+				if wt.inputEscapeFilter && n > 0 && frame[0] == Input {
+					filtered = stripInputEscapes(append(filtered[:0], Input), frame[1:])
+					frame = filtered
+				}
+				if wt.inputLimiter != nil && len(frame) > 1 && frame[0] == Input {
+					err = wt.inputLimiter.wait(ctx, len(frame)-1)
+					if err != nil {
+						return err
 					}
 				}
 
-				// 调试用的日志
-				//fmt.Println("log: ", log)
-
-				err = wt.handleMasterReadEvent(buffer[:n])
-				if err != nil {
+				err = wt.handleMasterReadEvent(frame)
+				if err != nil && !wt.dropMalformedFrame(err) {
 					return err
 				}
 			}
@@ -160,50 +671,176 @@ func (wt *WebTTY) Run(ctx context.Context, userAccount string, clusterId string)
 	select {
 	case <-ctx.Done():
 		err = ctx.Err()
+		if wt.drainTimeout > 0 {
+			wt.drainReads(errs)
+		} else if wt.interruptReadsOnCancel {
+			wt.interruptReads(errs)
+		}
+	case <-expired:
+		err = ErrSessionExpired
+	case <-hung:
+		err = ErrSlaveHung
+	case <-idle:
+		err = ErrIdleTimeout
+	case <-dead:
+		err = ErrMasterTimeout
+	case <-wt.terminated:
+		err = ErrSessionTerminated
+	case err = <-rejected:
+	case err = <-grantEnded:
 	case err = <-errs:
 	}
 
+	if errors.Cause(err) == ErrMasterWriteTimeout {
+		err = &closedError{ErrMasterClosed, err}
+	}
+
+	if closed, ok := err.(*closedError); ok && closed.sentinel == ErrSlaveClosed {
+		var status *ExitStatus
+		exitReason, status = wt.slaveExit()
+		wt.sendSessionEnd(exitReason)
+		if status != nil {
+			err = &SlaveExitError{Status: *status, Err: closed.cause}
+		}
+	}
+	switch err {
+	case ErrSessionExpired:
+		wt.sendSessionClosed(err, wt.messages.SessionExpired, wt.maxSessionDuration)
+	case ErrIdleTimeout:
+		wt.sendSessionClosed(err, wt.messages.IdleTimeout, wt.idleTimeout)
+	case ErrSessionTerminated:
+		wt.sendSessionClosed(err, wt.messages.Terminated, 0)
+	case ErrUnlockFailed:
+		// printed on the locked screen, the message wouldn't show
+		wt.sendSessionClosed(err, "", 0)
+	case ErrApprovalDenied:
+		wt.sendSessionClosed(err, wt.messages.ApprovalDenied, 0)
+	case ErrApprovalTimeout:
+		wt.sendSessionClosed(err, wt.messages.ApprovalTimeout, wt.approval.timeout)
+	case ErrGrantExpired:
+		wt.sendSessionClosed(err, wt.messages.GrantExpired, 0)
+	case ErrGrantRevoked:
+		wt.sendSessionClosed(err, wt.messages.GrantRevoked, 0)
+	}
+
 	return err
 }
 
-// 审计日志输出
-const LogUrl = "http://10.209.31.19:32654/cluster/info/1/kafka?command="
+// CurrentInput returns the command line the master is typing,
+// which has not been submitted yet.
+func (wt *WebTTY) CurrentInput() string {
+	return wt.reconstructor.current()
+}
+
+// FilteredAuditCommands returns the number of commands
+// left out of the audit trail by the filter set with WithAuditCommandFilter.
+func (wt *WebTTY) FilteredAuditCommands() uint64 {
+	return atomic.LoadUint64(&wt.auditFilteredCounter)
+}
+
+// auditCommand records a command line submitted by the master
+// sinceLast after the previous one.
+func (wt *WebTTY) auditCommand(userAccount string, clusterId string, log string, keys string, sinceLast time.Duration) {
+	if wt.auditTrim {
+		log = strings.TrimRightFunc(log, func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsControl(r)
+		})
+	}
+	if wt.auditRedact != nil {
+		log, keys = wt.auditRedact(log), wt.auditRedact(keys)
+	}
+	if wt.auditFilter != nil && !wt.auditFilter(log) {
+		atomic.AddUint64(&wt.auditFilteredCounter, 1)
+		return
+	}
+
+	wt.writeAuditEvent(userAccount, clusterId, log, keys, sinceLast)
+}
 
-func LogOutpu(s string) {
-	Get(LogUrl + s)
-	//fmt.Println(LogUrl + s)
+func (wt *WebTTY) writeAudit(userAccount string, clusterId string, log string) {
+	wt.writeAuditEvent(userAccount, clusterId, log, "", 0)
 }
-func Get(url string) string {
-	res, err := http.Get(url)
+
+func (wt *WebTTY) writeAuditEvent(userAccount string, clusterId string, log string, keys string, sinceLast time.Duration) {
+	var metadatalog Metadatalog
+	metadatalog.ClusterId = clusterId
+	metadatalog.UserAccount = userAccount
+	metadatalog.Time = time.Now().Format("2006-01-02 15:04:05")
+	metadatalog.Log = log
+	jsonBytes, err := json.Marshal(metadatalog)
 	if err != nil {
-		return fmt.Sprintln(err)
+		fmt.Println(err)
+	}
+	fmt.Println("metadatalog: ", string(jsonBytes))
+
+	// 审计日志输出
+	session := wt.Session()
+	event := AuditEvent{
+		UserAccount:              userAccount,
+		ClusterID:                clusterId,
+		SessionID:                session.SessionID,
+		RemoteAddr:               session.RemoteAddr,
+		Time:                     time.Now(),
+		Command:                  log,
+		Keys:                     keys,
+		WorkingDir:               wt.WorkingDir(),
+		DurationSinceLastCommand: sinceLast,
+		RequestID:                randomstring.Generate(auditRequestIDLength),
+		Tags:                     session.Tags,
 	}
-	robots, err := ioutil.ReadAll(res.Body)
-	res.Body.Close()
+	err = wt.auditLogger.Log(context.Background(), event)
 	if err != nil {
-		return fmt.Sprintln(err)
+		fmt.Println(err)
 	}
-	return string(robots)
+	if wt.metrics != nil {
+		wt.metrics.ObserveAuditEvent(err != nil)
+	}
+	if wt.auditFile != nil {
+		if wt.auditFileJSON {
+			wt.auditFile.Write(append(event.JSON(), '\n'))
+		} else {
+			// the request ID traces the entry to the audit logger
+			wt.auditFile.Write([]byte(event.Line() + " [request-id:" + event.RequestID + "]\n"))
+		}
+	}
+	fmt.Println("[集群:", clusterId, "]-[用户:", userAccount, "]-[时间:", time.Now().Format("2006-01-02 15:04:05"), "]-[LOG:", log, "]")
+}
+
+// FormatAuditLine formats an entry of the audit trail.
+func FormatAuditLine(userAccount string, clusterId string, log string) string {
+	return formatAuditLine(userAccount, clusterId, time.Now(), log)
+}
+
+func formatAuditLine(userAccount string, clusterId string, t time.Time, log string) string {
+	return "[集群:" + clusterId + "]-[用户:" + userAccount + "]-[时间:" + t.Format("2006-01-02 15:04:05") + "]-[LOG:" + log + "]"
 }
 
 func (wt *WebTTY) sendInitializeMessage() error {
-	err := wt.masterWrite(append([]byte{SetWindowTitle}, wt.windowTitle...))
+	wt.negotiateFeatures()
+
+	if wt.protocolAnnounced {
+		err := wt.primaryWrite(protocol.Encode(protocol.SetProtocolVersion, []byte(strconv.Itoa(wt.protocolVersion))))
+		if err != nil {
+			return errors.Wrapf(err, "failed to send protocol version")
+		}
+	}
+
+	err := wt.sendSettings()
 	if err != nil {
-		return errors.Wrapf(err, "failed to send window title")
+		return err
 	}
 
-	if wt.reconnect > 0 {
-		reconnect, _ := json.Marshal(wt.reconnect)
-		err := wt.masterWrite(append([]byte{SetReconnect}, reconnect...))
+	if wt.sessionInfoFrame {
+		err := wt.sendSessionInfo()
 		if err != nil {
-			return errors.Wrapf(err, "failed to set reconnect")
+			return errors.Wrapf(err, "failed to send session info")
 		}
 	}
 
-	if wt.masterPrefs != nil {
-		err := wt.masterWrite(append([]byte{SetPreferences}, wt.masterPrefs...))
+	if wt.reattachToken != "" {
+		err := wt.primaryWrite(append([]byte{SetReattachToken}, wt.reattachToken...))
 		if err != nil {
-			return errors.Wrapf(err, "failed to set preferences")
+			return errors.Wrapf(err, "failed to send reattach token")
 		}
 	}
 
@@ -211,20 +848,213 @@ func (wt *WebTTY) sendInitializeMessage() error {
 }
 
 func (wt *WebTTY) handleSlaveReadEvent(data []byte) error {
-	safeMessage := base64.StdEncoding.EncodeToString(data)
-	err := wt.masterWrite(append([]byte{Output}, []byte(safeMessage)...))
-	if err != nil {
-		return errors.Wrapf(err, "failed to send message to master")
+	if wt.oscScanner != nil {
+		data = wt.oscScanner.scan(data)
+		if len(data) == 0 {
+			return nil
+		}
+	}
+	if wt.outputSanitizer != nil {
+		data = wt.outputSanitizer.scan(data)
+		if len(data) == 0 {
+			return nil
+		}
+	}
+	if wt.outputInspection != nil {
+		return wt.outputInspection.write(data)
+	}
+	return wt.forwardOutput(data)
+}
+
+// forwardOutput sends the output of the slave to the master, once inspected
+// when WithOutputInspector is set.
+func (wt *WebTTY) forwardOutput(data []byte) error {
+	wt.reconstructor.observeOutput(data)
+	if wt.outputAudit != nil {
+		wt.outputAudit.observe(data)
+	}
+	if wt.lineBuffer != nil {
+		wt.lineBuffer.observeOutput(data)
+	}
+	if wt.inputGrace != nil {
+		err := wt.inputGrace.observeOutput(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to forward input held during grace period")
+		}
+	}
+	if wt.recorder != nil {
+		wt.recorder.output(data)
+	}
+	if wt.replay != nil {
+		wt.replay.write(data)
+	}
+	atomic.AddUint64(&wt.bytesToMaster, uint64(len(data)))
+	if wt.metrics != nil {
+		wt.metrics.AddBytesToMaster(len(data))
+	}
+
+	if wt.localEcho != nil {
+		return wt.localEcho.reconcile(data)
+	}
+	return wt.writeOutput(data)
+}
+
+// forwardInput writes keys typed on the master to the slave.
+// Only forwarded keys are reconstructed into the audited command lines,
+// input held by the grace period is recorded when it is forwarded.
+func (wt *WebTTY) forwardInput(keys []byte) error {
+	atomic.AddUint64(&wt.bytesToSlave, uint64(len(keys)))
+	if wt.metrics != nil {
+		wt.metrics.AddBytesToSlave(len(keys))
+	}
+	submitted := wt.reconstructor.feedLines(append([]byte{Input}, keys...))
+	lines := make([]string, 0, len(submitted))
+	for _, line := range submitted {
+		lines = append(lines, line.line)
+	}
+	wt.observeInput(lines)
+	if wt.recorder != nil && wt.recordInput {
+		wt.recorder.input(keys)
+	}
+
+	if wt.menu != nil {
+		err := wt.handleMenuInput(keys)
+		if err != nil {
+			return err
+		}
+	} else if wt.lineBuffer != nil {
+		err := wt.handleBufferedInput(keys)
+		if err != nil {
+			return err
+		}
+	} else {
+		if wt.localEcho != nil {
+			// predicted before the slave may echo the keys
+			err := wt.localEcho.predict(keys)
+			if err != nil {
+				return errors.Wrapf(err, "failed to echo input")
+			}
+		}
+		_, err := wt.slaveWrite(keys)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write received data to slave")
+		}
+	}
+
+	for _, line := range submitted {
+		log := line.line
+		if wt.lineBuffer != nil {
+			log = wt.lineBuffer.executedLine(log)
+		}
+		wt.alertCommand(log)
+		sinceLast := wt.reconstructor.stampLine(time.Now())
+		wt.auditCommand(wt.session.User, wt.session.ClusterID, log, line.keys, sinceLast)
 	}
 
 	return nil
 }
 
+// writeOutput sends data as Output, through the coalescer when enabled.
+// It's held while the screen is locked or the session awaits its approval.
+func (wt *WebTTY) writeOutput(data []byte) error {
+	if wt.holdForApproval(data) || wt.holdOutput(data) {
+		return nil
+	}
+	if wt.coalescer != nil {
+		return wt.coalescer.write(data)
+	}
+
+	return wt.sendOutput(data)
+}
+
+func (wt *WebTTY) sendOutput(data []byte) error {
+	wt.outputMutex.Lock()
+	defer wt.outputMutex.Unlock()
+
+	if wt.flowWindow != nil {
+		wt.flowWindow.sent(len(data))
+	}
+
+	if frame, ok := wt.appendCompressedFrame(wt.outputBuffer[:0], data); ok {
+		wt.outputBuffer = frame
+		err := wt.masterWrite(frame)
+		if err != nil {
+			return errors.Wrapf(err, "failed to send message to master")
+		}
+		return nil
+	}
+
+	chunkSize := wt.outputChunkSize()
+	for len(data) > 0 {
+		chunk := data
+		if chunkSize > 0 && len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		data = data[len(chunk):]
+
+		// encode into a reused buffer, masters must not retain written data
+		wt.outputBuffer = wt.appendOutputFrame(wt.outputBuffer[:0], chunk)
+		frame := wt.outputBuffer
+
+		err := wt.masterWrite(frame)
+		if err != nil {
+			return errors.Wrapf(err, "failed to send message to master")
+		}
+	}
+
+	return nil
+}
+
+func (wt *WebTTY) slaveWrite(data []byte) (int, error) {
+	wt.slaveWriteMutex.Lock()
+	defer wt.slaveWriteMutex.Unlock()
+
+	storeTime(&wt.lastSlaveWrite, time.Now())
+	return wt.slave.Write(data)
+}
+
+// masterWrite writes data to the master and all observers.
 func (wt *WebTTY) masterWrite(data []byte) error {
 	wt.writeMutex.Lock()
 	defer wt.writeMutex.Unlock()
 
-	_, err := wt.masterConn.Write(data)
+	return wt.masterWriteLocked(data)
+}
+
+func (wt *WebTTY) masterWriteLocked(data []byte) error {
+	wt.observersWriteLocked(data)
+
+	if !wt.masterSupports(data) {
+		return nil
+	}
+	if wt.capture != nil {
+		wt.capture.record(SlaveToMaster, data)
+	}
+
+	n, err := wt.writeMasterLocked(data)
+	atomic.AddUint64(&wt.bytesOut, uint64(n))
+	if err != nil {
+		return errors.Wrapf(err, "failed to write to master")
+	}
+
+	return nil
+}
+
+// primaryWrite writes data to the master only, for messages
+// concerning the writing master such as Pong or SetReadOnly.
+func (wt *WebTTY) primaryWrite(data []byte) error {
+	wt.writeMutex.Lock()
+	defer wt.writeMutex.Unlock()
+
+	if !wt.masterSupports(data) {
+		return nil
+	}
+	if wt.capture != nil {
+		wt.capture.record(SlaveToMaster, data)
+	}
+
+	n, err := wt.writeMasterLocked(data)
+	atomic.AddUint64(&wt.bytesOut, uint64(n))
 	if err != nil {
 		return errors.Wrapf(err, "failed to write to master")
 	}
@@ -234,12 +1064,36 @@ func (wt *WebTTY) masterWrite(data []byte) error {
 
 func (wt *WebTTY) handleMasterReadEvent(data []byte) error {
 	if len(data) == 0 {
-		return errors.New("unexpected zero length read from master")
+		return malformedFrame(errors.New("unexpected zero length read from master"))
+	}
+
+	if wt.frameTypeObserver != nil {
+		wt.frameTypeObserver(MessageType(data[0]))
+	}
+	if wt.screenLock != nil && !lockedFrame(data[0]) && wt.ScreenLocked() {
+		// the input doesn't count as activity either
+		return nil
+	}
+	if wt.approval != nil && !lockedFrame(data[0]) && wt.AwaitingApproval() {
+		return nil
 	}
 
 	switch data[0] {
 	case Input:
-		if !wt.permitWrite {
+		now := time.Now()
+		storeTime(&wt.lastActivity, now)
+		storeTime(&wt.lastInput, now)
+		if wt.keystrokeTimingHook != nil {
+			if !wt.lastKeystroke.IsZero() {
+				wt.keystrokeTimingHook(now.Sub(wt.lastKeystroke))
+			}
+			wt.lastKeystroke = now
+		}
+		if !wt.PermitWrite() {
+			if !wt.readOnlyNotified {
+				wt.readOnlyNotified = true
+				return wt.printMessage(wt.messages.ReadOnly)
+			}
 			return nil
 		}
 
@@ -247,44 +1101,69 @@ func (wt *WebTTY) handleMasterReadEvent(data []byte) error {
 			return nil
 		}
 
-		_, err := wt.slave.Write(data[1:])
-		if err != nil {
-			return errors.Wrapf(err, "failed to write received data to slave")
+		if wt.inputGrace != nil && wt.inputGrace.hold(data[1:]) {
+			return nil
 		}
 
+		return wt.forwardInput(data[1:])
+
 	case Ping:
-		err := wt.masterWrite([]byte{Pong})
+		pong := []byte{Pong}
+		if wt.timestampedPong {
+			pong = strconv.AppendInt(pong, time.Now().UnixNano()/int64(time.Millisecond), 10)
+		}
+		err := wt.primaryWrite(pong)
 		if err != nil {
 			return errors.Wrapf(err, "failed to return Pong message to master")
 		}
+		storeTime(&wt.lastPong, time.Now())
+		storeTime(&wt.lastKeepAlive, time.Now())
 
-	case ResizeTerminal:
-		if wt.columns != 0 && wt.rows != 0 {
-			break
-		}
+	case KeepAlivePong:
+		return wt.handleKeepAlivePong()
+
+	case AcknowledgeOutput:
+		return wt.handleAcknowledgeOutput(data[1:])
+
+	case FileTransfer:
+		return wt.handleFileTransfer(data[1:])
+
+	case UploadChunk:
+		return wt.handleUploadChunk(data[1:])
+
+	case RequestStats:
+		return wt.handleRequestStats(data[1:])
+
+	case SetPermitWrite:
+		return wt.handleSetPermitWrite(data[1:])
 
+	case ControlReplay:
+		return wt.handleControlReplay(data[1:])
+
+	case Unlock:
+		return wt.handleUnlock(data[1:])
+
+	case ResizeTerminal:
 		if len(data) <= 1 {
-			return errors.New("received malformed remote command for terminal resize: empty payload")
+			return malformedFrame(errors.New("received malformed remote command for terminal resize: empty payload"))
 		}
 
 		var args argResizeTerminal
 		err := json.Unmarshal(data[1:], &args)
 		if err != nil {
-			return errors.Wrapf(err, "received malformed data for terminal resize")
-		}
-		rows := wt.rows
-		if rows == 0 {
-			rows = int(args.Rows)
+			return malformedFrame(errors.Wrapf(err, "received malformed data for terminal resize"))
 		}
-
-		columns := wt.columns
-		if columns == 0 {
-			columns = int(args.Columns)
+		if args.Columns < 1 || args.Rows < 1 {
+			// such as a hidden terminal, the slave keeps its size
+			break
 		}
-
-		wt.slave.ResizeTerminal(columns, rows)
+		wt.requestResize(args.Columns, args.Rows)
 	default:
-		return errors.Errorf("unknown message type `%c`", data[0])
+		handler, ok := wt.messageHandlers[data[0]]
+		if !ok {
+			return malformedFrame(errors.Errorf("unknown message type `%c`", data[0]))
+		}
+		return handler(wt, data[1:])
 	}
 
 	return nil
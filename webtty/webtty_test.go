@@ -4,16 +4,109 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	stderrors "errors"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/buptWYChen/gotty/webtty/protocol"
 )
 
+// offlineTransport keeps the tests from reaching the audit endpoint.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("audit endpoint is offline in tests")
+}
+
+func TestMain(m *testing.M) {
+	defaultAuditHTTPClient = &http.Client{Transport: offlineTransport{}}
+	os.Exit(m.Run())
+}
+
+// withAuditLines records the line and the request ID of each audit event.
+func withAuditLines(record func(line string, requestID string)) Option {
+	return WithAuditLogger(AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+		record(event.Line(), event.RequestID)
+		return nil
+	}))
+}
+
+// withAuditEvents records the audit events, returned by the function
+// returned with the option.
+func withAuditEvents() (Option, func() []AuditEvent) {
+	var mutex sync.Mutex
+	var events []AuditEvent
+	option := WithAuditLogger(AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		events = append(events, event)
+		return nil
+	}))
+	recorded := func() []AuditEvent {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]AuditEvent(nil), events...)
+	}
+	return option, recorded
+}
+
+// withAuditCommands records the commands of the audit events, see
+// withAuditEvents.
+func withAuditCommands() (Option, func() []string) {
+	option, events := withAuditEvents()
+	recorded := func() []string {
+		var commands []string
+		for _, event := range events() {
+			commands = append(commands, event.Command)
+		}
+		return commands
+	}
+	return option, recorded
+}
+
+// newAuditedTTY returns a WebTTY of alice recording its frames in rec
+// and the commands of its audit events, whose slave never outputs.
+func newAuditedTTY(t *testing.T, rec *frameRecorder, opts ...Option) (*WebTTY, func() []string) {
+	audit, recorded := withAuditCommands()
+	slaveReader, slaveWriter := io.Pipe()
+	t.Cleanup(func() { slaveWriter.Close() })
+	opts = append([]Option{WithIdentity(Identity{User: "alice"}), audit}, opts...)
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, opts...)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	return dt, recorded
+}
+
 type pipePair struct {
 	*io.PipeReader
 	*io.PipeWriter
 }
 
+type pipeSlave struct {
+	pipePair
+}
+
+func (ps *pipeSlave) WindowTitleVariables() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (ps *pipeSlave) ResizeTerminal(columns int, rows int) error {
+	return nil
+}
+
 func TestWriteFromPTY(t *testing.T) {
 	connInPipeReader, connInPipeWriter := io.Pipe() // in to conn
 	connOutPipeReader, _ := io.Pipe()               // out from conn
@@ -22,7 +115,12 @@ func TestWriteFromPTY(t *testing.T) {
 		connOutPipeReader,
 		connInPipeWriter,
 	}
-	dt, err := New(conn)
+
+	_, slaveInPipeWriter := io.Pipe()                   // in to slave
+	slaveOutPipeReader, slaveOutPipeWriter := io.Pipe() // out from slave
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave)
 	if err != nil {
 		t.Fatalf("Unexpected error from New(): %s", err)
 	}
@@ -31,15 +129,32 @@ func TestWriteFromPTY(t *testing.T) {
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
-		wg.Done()
-		err := dt.Run(ctx)
-		if err != nil {
-			t.Fatalf("Unexpected error from Run(): %s", err)
+		defer wg.Done()
+		err := dt.Run(ctx, "", "")
+		if err != nil && err != context.Canceled {
+			t.Errorf("Unexpected error from Run(): %s", err)
 		}
 	}()
 
+	buf := make([]byte, 1024)
+	n, err := connInPipeReader.Read(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if buf[0] != SetWindowTitle {
+		t.Fatalf("Unexpected message type `%c`", buf[0])
+	}
+
+	n, err = connInPipeReader.Read(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(buf[:n]) != string(SetReadOnly)+"true" {
+		t.Fatalf("Unexpected read only message: `%s`", buf[:n])
+	}
+
 	message := []byte("foobar")
-	n, err := dt.TTY().Write(message)
+	n, err = slaveOutPipeWriter.Write(message)
 	if err != nil {
 		t.Fatalf("Unexpected error from Write(): %s", err)
 	}
@@ -47,7 +162,6 @@ func TestWriteFromPTY(t *testing.T) {
 		t.Fatalf("Write() accepted `%d` for message `%s`", n, message)
 	}
 
-	buf := make([]byte, 1024)
 	n, err = connInPipeReader.Read(buf)
 	if err != nil {
 		t.Fatalf("Unexpected error from Read(): %s", err)
@@ -77,7 +191,11 @@ func TestWriteFromConn(t *testing.T) {
 		connInPipeWriter,
 	}
 
-	dt, err := New(conn)
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe() // in to slave
+	slaveOutPipeReader, _ := io.Pipe()                // out from slave
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave, WithPermitWrite())
 	if err != nil {
 		t.Fatalf("Unexpected error from New(): %s", err)
 	}
@@ -86,10 +204,10 @@ func TestWriteFromConn(t *testing.T) {
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
-		wg.Done()
-		err := dt.Run(ctx)
-		if err != nil {
-			t.Fatalf("Unexpected error from Run(): %s", err)
+		defer wg.Done()
+		err := dt.Run(ctx, "", "")
+		if err != nil && err != context.Canceled {
+			t.Errorf("Unexpected error from Run(): %s", err)
 		}
 	}()
 
@@ -99,8 +217,23 @@ func TestWriteFromConn(t *testing.T) {
 	)
 	readBuf := make([]byte, 1024)
 
+	// window title
+	_, err = connInPipeReader.Read(readBuf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+
+	// read only
+	n, err = connInPipeReader.Read(readBuf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(readBuf[:n]) != string(SetReadOnly)+"false" {
+		t.Fatalf("Unexpected read only message: `%s`", readBuf[:n])
+	}
+
 	// input
-	message = []byte("0hello\n") // line buffered canonical mode
+	message = []byte("1hello\n") // line buffered canonical mode
 	n, err = connOutPipeWriter.Write(message)
 	if err != nil {
 		t.Fatalf("Unexpected error from Write(): %s", err)
@@ -109,16 +242,16 @@ func TestWriteFromConn(t *testing.T) {
 		t.Fatalf("Write() accepted `%d` for message `%s`", n, message)
 	}
 
-	n, err = dt.TTY().Read(readBuf)
+	n, err = slaveInPipeReader.Read(readBuf)
 	if err != nil {
-		t.Fatalf("Unexpected error from Write(): %s", err)
+		t.Fatalf("Unexpected error from Read(): %s", err)
 	}
 	if !bytes.Equal(readBuf[:n], message[1:]) {
 		t.Fatalf("Unexpected message received: `%s`", readBuf[:n])
 	}
 
 	// ping
-	message = []byte("1\n") // line buffered canonical mode
+	message = []byte("2\n") // line buffered canonical mode
 	n, err = connOutPipeWriter.Write(message)
 	if n != len(message) {
 		t.Fatalf("Write() accepted `%d` for message `%s`", n, message)
@@ -128,7 +261,7 @@ func TestWriteFromConn(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected error from Read(): %s", err)
 	}
-	if !bytes.Equal(readBuf[:n], []byte{'1'}) {
+	if !bytes.Equal(readBuf[:n], []byte{Pong}) {
 		t.Fatalf("Unexpected message received: `%s`", readBuf[:n])
 	}
 
@@ -137,3 +270,1066 @@ func TestWriteFromConn(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestNegotiatedFeatures(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, _ := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	slaveOutPipeReader, slaveInPipeWriter := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave,
+		WithServerFeatures(FeatureSet{Compression: true}),
+		WithClientFeatures(FeatureSet{Compression: true, BinaryFrames: true}),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if dt.NegotiatedFeatures() != (FeatureSet{}) {
+		t.Fatalf("Unexpected features before Run(): %+v", dt.NegotiatedFeatures())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dt.Run(ctx, "", "")
+	}()
+
+	readBuf := make([]byte, 1024)
+	// window title and read only
+	for i := 0; i < 2; i++ {
+		_, err = connInPipeReader.Read(readBuf)
+		if err != nil {
+			t.Fatalf("Unexpected error from Read(): %s", err)
+		}
+	}
+
+	expected := FeatureSet{Compression: true}
+	if dt.NegotiatedFeatures() != expected {
+		t.Fatalf("Unexpected negotiated features: %+v", dt.NegotiatedFeatures())
+	}
+
+	cancel()
+	wg.Wait()
+}
+
+func TestBinaryFrames(t *testing.T) {
+	output := []byte("\x1b[1mbold\xff")
+
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{},
+		WithBinaryFrames(),
+		WithClientFeatures(FeatureSet{BinaryFrames: true}),
+		WithMaxFrameSize(8),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+	dt.sendOutput(output)
+	frames := rec.get()
+	if len(frames) != 2 || frames[0] != "1\x1b[1mbol" || frames[1] != "1d\xff" {
+		t.Fatalf("Unexpected binary frames: %q", frames)
+	}
+
+	// clients without the feature get base64
+	rec = &frameRecorder{}
+	dt, err = New(recordingMaster{rec}, &pipeSlave{}, WithBinaryFrames())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.negotiateFeatures()
+	dt.sendOutput(output)
+	expected := "1" + base64.StdEncoding.EncodeToString(output)
+	if frames := rec.get(); len(frames) != 1 || frames[0] != expected {
+		t.Fatalf("Unexpected frames without negotiation: %q", frames)
+	}
+}
+
+func TestMaxInboundFrameSize(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, connOutPipeWriter := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	slaveOutPipeReader, slaveInPipeWriter := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave, WithMaxInboundFrameSize(4))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- dt.Run(context.Background(), "", "")
+	}()
+
+	readBuf := make([]byte, 1024)
+	// window title and read only
+	for i := 0; i < 2; i++ {
+		_, err = connInPipeReader.Read(readBuf)
+		if err != nil {
+			t.Fatalf("Unexpected error from Read(): %s", err)
+		}
+	}
+
+	_, err = connOutPipeWriter.Write([]byte("1hello"))
+	if err != nil {
+		t.Fatalf("Unexpected error from Write(): %s", err)
+	}
+
+	err = <-errs
+	if errors.Cause(err) != ErrFrameTooLarge {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}
+
+// limitedMaster behaves like a websocket connection with a read limit,
+// it fails reading a frame larger than the limit.
+type limitedMaster struct {
+	frames chan []byte
+	limit  int64
+}
+
+func (lm *limitedMaster) SetReadLimit(limit int64)    { lm.limit = limit }
+func (lm *limitedMaster) Write(p []byte) (int, error) { return len(p), nil }
+func (lm *limitedMaster) Read(p []byte) (int, error) {
+	frame := <-lm.frames
+	if int64(len(frame)) > lm.limit {
+		return 0, websocket.ErrReadLimit
+	}
+	return copy(p, frame), nil
+}
+
+func TestMaxInboundFrameSizeReadLimiter(t *testing.T) {
+	master := &limitedMaster{frames: make(chan []byte, 1)}
+	slaveOutPipeReader, slaveInPipeWriter := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(master, slave, WithMaxInboundFrameSize(2048))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- dt.Run(context.Background(), "", "")
+	}()
+
+	// larger than the read buffer, so only the limiter can catch it
+	master.frames <- append([]byte{Input}, bytes.Repeat([]byte("a"), 4096)...)
+
+	err = <-errs
+	if errors.Cause(err) != ErrFrameTooLarge {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	if master.limit != 2048 {
+		t.Fatalf("Unexpected read limit: %d", master.limit)
+	}
+}
+
+func TestWritablePermissionHook(t *testing.T) {
+	var transitions []bool
+	dt, err := New(pipePair{}, &pipeSlave{}, WithWritablePermissionHook(func(writable bool) {
+		transitions = append(transitions, writable)
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.SetPermitWrite(true)
+	dt.SetPermitWrite(true)
+	dt.SetPermitWrite(false)
+
+	if len(transitions) != 2 || transitions[0] != true || transitions[1] != false {
+		t.Fatalf("Unexpected transitions: %v", transitions)
+	}
+	if dt.PermitWrite() {
+		t.Fatalf("Expected write permission to be revoked")
+	}
+}
+
+func TestMessageTypes(t *testing.T) {
+	seen := map[Direction]map[byte]bool{
+		MasterToSlave: {},
+		SlaveToMaster: {},
+	}
+	for _, info := range MessageTypes() {
+		if seen[info.Direction][info.Type] {
+			t.Fatalf("Duplicated message type `%c` for %s", info.Type, info.Direction)
+		}
+		seen[info.Direction][info.Type] = true
+		if !reservedMessageType(info.Type) {
+			t.Fatalf("Message type `%c` is not reserved", info.Type)
+		}
+	}
+	if !seen[MasterToSlave][Input] || !seen[SlaveToMaster][Output] {
+		t.Fatalf("Missing message types: %v", seen)
+	}
+}
+
+func TestProtocolVersion(t *testing.T) {
+	for announced, want := range map[int]string{0: "", protocol.Version + 1: "L" + strconv.Itoa(protocol.Version)} {
+		rec := &frameRecorder{}
+		dt, err := New(recordingMaster{rec}, &pipeSlave{}, WithProtocolVersion(announced))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		if err := dt.sendInitializeMessage(); err != nil {
+			t.Fatalf("Unexpected error from sendInitializeMessage(): %s", err)
+		}
+		frames := rec.get()
+		if announced == 0 && len(frames) > 0 && frames[0][0] == SetProtocolVersion {
+			t.Fatalf("Unexpected protocol version sent to a legacy master: %q", frames)
+		}
+		if announced != 0 && (len(frames) == 0 || frames[0] != want) {
+			t.Fatalf("Expected the protocol version first: %q", frames)
+		}
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithProtocolVersion(-1)); err == nil {
+		t.Fatalf("Expected an error for an unsupported version")
+	}
+}
+
+func TestRegisterHandler(t *testing.T) {
+	var received []string
+	dt, err := New(pipePair{}, &pipeSlave{}, RegisterHandler('n', func(wt *WebTTY, payload []byte) error {
+		received = append(received, string(payload))
+		if len(payload) == 0 {
+			return errors.New("empty notification")
+		}
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	if err := dt.handleMasterReadEvent([]byte("nhello")); err != nil {
+		t.Fatalf("Unexpected error from a registered handler: %s", err)
+	}
+	if err := dt.handleMasterReadEvent([]byte("n")); err == nil {
+		t.Fatalf("Expected the error of the handler")
+	}
+	if len(received) != 2 || received[0] != "hello" || received[1] != "" {
+		t.Fatalf("Unexpected payloads: %q", received)
+	}
+	if err := dt.handleMasterReadEvent([]byte("xhello")); err == nil {
+		t.Fatalf("Expected an error for an unknown message type")
+	}
+
+	handler := func(wt *WebTTY, payload []byte) error { return nil }
+	for _, msgType := range []byte{Input, Ping, 'Z'} {
+		if _, err := New(pipePair{}, &pipeSlave{}, RegisterHandler(msgType, handler)); err == nil {
+			t.Fatalf("Expected an error registering reserved type `%c`", msgType)
+		}
+	}
+}
+
+func TestInjectInput(t *testing.T) {
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	slaveOutPipeReader, _ := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(pipePair{}, slave)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.InjectInput([]byte("ls\n")); err != ErrInjectionNotPermitted {
+		t.Fatalf("Unexpected error from InjectInput(): %v", err)
+	}
+
+	audited := make(chan string, 1)
+	dt, err = New(pipePair{}, slave, WithPermitInjection(), withAuditLines(func(line string, requestID string) {
+		audited <- line
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- dt.InjectInput([]byte("ls\n"))
+	}()
+
+	readBuf := make([]byte, 1024)
+	n, err := slaveInPipeReader.Read(readBuf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(readBuf[:n]) != "ls\n" {
+		t.Fatalf("Unexpected injected input: `%s`", readBuf[:n])
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatalf("Unexpected error from InjectInput(): %s", err)
+	}
+	if line := <-audited; !strings.Contains(line, injectedMarker+"ls\n") {
+		t.Fatalf("Unexpected audit line: `%s`", line)
+	}
+}
+
+func TestWriteGrantDuration(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, _ := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	slaveOutPipeReader, slaveInPipeWriter := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave, WithPermitWrite(), WithWriteGrantDuration(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dt.Run(ctx, "", "")
+
+	readBuf := make([]byte, 1024)
+	connInPipeReader.Read(readBuf) // window title
+	connInPipeReader.Read(readBuf) // read only when starting
+
+	n, err := connInPipeReader.Read(readBuf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	if string(readBuf[:n]) != string(SetReadOnly)+"true" {
+		t.Fatalf("Unexpected message: `%s`", readBuf[:n])
+	}
+	if dt.PermitWrite() {
+		t.Fatalf("Expected write permission to be revoked")
+	}
+}
+
+func TestAuditCommandFilter(t *testing.T) {
+	dt, err := New(pipePair{}, &pipeSlave{}, WithAuditCommandFilter(func(cmd string) bool {
+		return false
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.auditCommand("user", "cluster", "ls", "", 0)
+	dt.auditCommand("user", "cluster", "pwd", "", 0)
+
+	if n := dt.FilteredAuditCommands(); n != 2 {
+		t.Fatalf("Unexpected filtered command count: %d", n)
+	}
+}
+
+func TestAuditTrim(t *testing.T) {
+	var audited []string
+	sender := withAuditLines(func(line string, requestID string) {
+		audited = append(audited, line)
+	})
+
+	for _, trim := range []bool{true, false} {
+		audited = nil
+		dt, err := New(pipePair{}, &pipeSlave{}, sender, WithAuditTrim(trim))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		dt.auditCommand("user", "cluster", "ls -l \t\x1b", "", 0)
+		expected := "[LOG:ls -l]"
+		if !trim {
+			expected = "[LOG:ls -l \t\x1b]"
+		}
+		if len(audited) != 1 || !strings.HasSuffix(audited[0], expected) {
+			t.Fatalf("Unexpected audit with trimming %v: %q", trim, audited)
+		}
+	}
+}
+
+func TestFrameTypeObserver(t *testing.T) {
+	counts := map[MessageType]int{}
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithFrameTypeObserver(func(mt MessageType) {
+		counts[mt]++
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for _, frame := range []string{"1ls", "2", "2", "x"} {
+		dt.handleMasterReadEvent([]byte(frame))
+	}
+	if counts[protocol.Input] != 1 || counts[protocol.Ping] != 2 || counts['x'] != 1 {
+		t.Fatalf("Unexpected frame type counts: %v", counts)
+	}
+
+	// input is ignored without allocating in read only sessions
+	input := []byte("1ls")
+	allocs := testing.AllocsPerRun(100, func() {
+		dt.handleMasterReadEvent(input)
+	})
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations per frame: %v", allocs)
+	}
+}
+
+func TestKeystrokeTimingHook(t *testing.T) {
+	var deltas []time.Duration
+	dt, err := New(discardMaster{}, &pipeSlave{}, WithKeystrokeTimingHook(func(delta time.Duration) {
+		deltas = append(deltas, delta)
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	dt.handleMasterReadEvent([]byte("1l"))
+	dt.handleMasterReadEvent([]byte("2"))
+	time.Sleep(20 * time.Millisecond)
+	dt.handleMasterReadEvent([]byte("1s"))
+
+	if len(deltas) != 1 || deltas[0] < 20*time.Millisecond {
+		t.Fatalf("Unexpected keystroke timings: %v", deltas)
+	}
+}
+
+func TestTimestampedPong(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{}, WithTimestampedPong())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	before := time.Now().UnixNano() / int64(time.Millisecond)
+	dt.handleMasterReadEvent([]byte{Ping})
+	after := time.Now().UnixNano() / int64(time.Millisecond)
+
+	frames := rec.get()
+	if len(frames) != 1 || frames[0][0] != Pong {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+	timestamp, err := strconv.ParseInt(frames[0][1:], 10, 64)
+	if err != nil || timestamp < before || timestamp > after {
+		t.Fatalf("Unexpected timestamp: %s, %v", frames[0][1:], err)
+	}
+}
+
+type discardMaster struct{}
+
+func (discardMaster) Read(p []byte) (int, error)  { select {} }
+func (discardMaster) Write(p []byte) (int, error) { return len(p), nil }
+
+var benchmarkOutput = bytes.Repeat([]byte("drwxr-xr-x  2 root root 4096 Jan  1 00:00 bin\r\n"), 20)
+
+func BenchmarkSendOutput(b *testing.B) {
+	b.ReportAllocs()
+	dt, _ := New(discardMaster{}, &pipeSlave{})
+	for i := 0; i < b.N; i++ {
+		dt.sendOutput(benchmarkOutput)
+	}
+}
+
+func BenchmarkSlaveReadRecorded(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(benchmarkOutput)))
+	dt, _ := New(discardMaster{}, &pipeSlave{}, WithRecorder(ioutil.Discard), WithReplayBuffer(64*1024))
+	dt.recorder.start(time.Now(), 80, 24)
+	for i := 0; i < b.N; i++ {
+		dt.handleSlaveReadEvent(benchmarkOutput)
+	}
+}
+
+func BenchmarkSendOutputEncodeToString(b *testing.B) {
+	b.ReportAllocs()
+	dt, _ := New(discardMaster{}, &pipeSlave{})
+	for i := 0; i < b.N; i++ {
+		safeMessage := base64.StdEncoding.EncodeToString(benchmarkOutput)
+		dt.masterWrite(append([]byte{Output}, []byte(safeMessage)...))
+	}
+}
+
+func TestSessionInfoFrame(t *testing.T) {
+	connInPipeReader, connInPipeWriter := io.Pipe()
+	connOutPipeReader, _ := io.Pipe()
+	conn := pipePair{connOutPipeReader, connInPipeWriter}
+
+	slaveOutPipeReader, slaveInPipeWriter := io.Pipe()
+	slave := &pipeSlave{pipePair{slaveOutPipeReader, slaveInPipeWriter}}
+
+	dt, err := New(conn, slave, WithSessionInfoFrame(), WithSessionID("abc"))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dt.Run(ctx, "alice", "cluster-1")
+
+	readBuf := make([]byte, 1024)
+	connInPipeReader.Read(readBuf) // window title
+
+	n, err := connInPipeReader.Read(readBuf)
+	if err != nil {
+		t.Fatalf("Unexpected error from Read(): %s", err)
+	}
+	expected := `7{"user":"alice","clusterId":"cluster-1","sessionId":"abc","readOnly":true}`
+	if string(readBuf[:n]) != expected {
+		t.Fatalf("Unexpected session info: `%s`", readBuf[:n])
+	}
+}
+
+func TestSessionContext(t *testing.T) {
+	rec := &frameRecorder{}
+	slaveReader, _ := io.Pipe()
+	dt, err := New(recordingMaster{rec}, &pipeSlave{pipePair{slaveReader, nil}}, WithSessionInfoFrame())
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	info := SessionInfo{User: "alice", ClusterID: "cluster-1", SessionID: "abc"}
+	ctx, cancel := context.WithCancel(WithSessionContext(context.Background(), info))
+	if got, ok := SessionFromContext(ctx); !ok || !reflect.DeepEqual(got, info) {
+		t.Fatalf("Unexpected session from context: %+v", got)
+	}
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx) }()
+
+	for i := 0; i < 100 && len(rec.get()) < 2; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if session := dt.Session(); !reflect.DeepEqual(session, info) {
+		t.Fatalf("Unexpected session: %+v", session)
+	}
+	expected := `7{"user":"alice","clusterId":"cluster-1","sessionId":"abc","readOnly":true}`
+	if frames := rec.get(); len(frames) < 2 || frames[1] != expected {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	if _, ok := SessionFromContext(context.Background()); ok {
+		t.Fatalf("Unexpected session in an empty context")
+	}
+}
+
+func TestIdentityContext(t *testing.T) {
+	slaveReader, _ := io.Pipe()
+	option := Identity{User: "bob", AuthMethod: "basic"}
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{slaveReader, nil}}, WithIdentity(option))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if identity := dt.Identity(); identity.User != "bob" || dt.Session().User != "bob" {
+		t.Fatalf("Unexpected identity: %+v", identity)
+	}
+
+	// the context overrides the option, the session user is kept on the identity
+	identity := Identity{User: "alice", Groups: []string{"ops"}, SourceIP: "192.0.2.1", Impersonate: "root"}
+	ctx, cancel := context.WithCancel(WithIdentityContext(context.Background(), identity))
+	ctx = WithSessionContext(ctx, SessionInfo{ClusterID: "cluster-1"})
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx) }()
+	for deadline := time.Now().Add(time.Second); dt.Identity().User != "alice"; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Identity not taken from the context")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	got := dt.Identity()
+	if got.SourceIP != "192.0.2.1" || len(got.Groups) != 1 || got.Groups[0] != "ops" || got.Target() != "root" {
+		t.Fatalf("Unexpected identity: %+v", got)
+	}
+	if session := dt.Session(); session.User != "alice" || session.ClusterID != "cluster-1" {
+		t.Fatalf("Unexpected session: %+v", session)
+	}
+	if (Identity{User: "alice"}).Target() != "alice" {
+		t.Fatalf("Unexpected target without impersonation")
+	}
+}
+
+type recordingMaster struct {
+	*frameRecorder
+}
+
+func (recordingMaster) Read(p []byte) (int, error) { select {} }
+func (rm recordingMaster) Write(p []byte) (int, error) {
+	rm.send(p)
+	return len(p), nil
+}
+
+func TestEncodings(t *testing.T) {
+	rec := &frameRecorder{}
+	dt, err := New(recordingMaster{rec}, &pipeSlave{}, WithOutputEncoding(EncodingRaw))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.sendOutput([]byte("foo"))
+	if frames := rec.get(); len(frames) != 1 || frames[0] != "1foo" {
+		t.Fatalf("Unexpected raw output frames: %q", frames)
+	}
+
+	dt, err = New(discardMaster{}, &pipeSlave{}, WithInputEncoding(EncodingBase64))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	frame, err := dt.decodeInputFrame(nil, []byte("1bHM="))
+	if err != nil || string(frame) != "1ls" {
+		t.Fatalf("Unexpected decoded input: %q, %v", frame, err)
+	}
+	if _, err := dt.decodeInputFrame(nil, []byte("1ls")); err == nil {
+		t.Fatalf("Expected an error for malformed base64 input")
+	}
+	if frame, _ := dt.decodeInputFrame(nil, []byte("2")); string(frame) != "2" {
+		t.Fatalf("Unexpected decoded ping: %q", frame)
+	}
+}
+
+func TestFixedSize(t *testing.T) {
+	resize := func(dt *WebTTY, columns, rows int) {
+		frame := []byte(`4{"Columns":` + strconv.Itoa(columns) + `,"Rows":` + strconv.Itoa(rows) + `}`)
+		frame[0] = ResizeTerminal
+		if err := dt.handleMasterReadEvent(frame); err != nil {
+			t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+		}
+	}
+
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(discardMaster{}, slave, WithFixedColumns(80), WithFixedRows(24))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	resize(dt, 100, 30)
+	if slave.columns != 80 || slave.rows != 24 {
+		t.Fatalf("Unexpected first size: %dx%d", slave.columns, slave.rows)
+	}
+	resize(dt, 120, 40)
+	if slave.columns != 120 || slave.rows != 40 {
+		t.Fatalf("Unexpected size after resize: %dx%d", slave.columns, slave.rows)
+	}
+
+	slave = &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err = New(discardMaster{}, slave, WithFixedColumns(80), WithFixedSize(true))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	resize(dt, 100, 30)
+	resize(dt, 120, 40)
+	if slave.columns != 80 || slave.rows != 40 {
+		t.Fatalf("Unexpected locked size: %dx%d", slave.columns, slave.rows)
+	}
+}
+
+func TestBufferSize(t *testing.T) {
+	output := bytes.Repeat([]byte("x"), 4096)
+	for _, c := range []struct {
+		size   int
+		frames int
+	}{
+		{1024, 4},
+		{4096, 1},
+	} {
+		slaveReader, slaveWriter := io.Pipe()
+		_, discardWriter := io.Pipe()
+		master := recordingMaster{&frameRecorder{}}
+		dt, err := New(master, &pipeSlave{pipePair{slaveReader, discardWriter}}, WithBufferSize(c.size))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		go func() {
+			slaveWriter.Write(output)
+			slaveWriter.Close()
+		}()
+		if err := dt.Run(context.Background(), "", ""); !stderrors.Is(err, ErrSlaveClosed) {
+			t.Fatalf("Unexpected error from Run(): %v", err)
+		}
+
+		var frames int
+		for _, frame := range master.get() {
+			if frame[0] == Output {
+				frames++
+			}
+		}
+		if frames != c.frames {
+			t.Fatalf("Unexpected number of Output frames with %d bytes buffer: %d", c.size, frames)
+		}
+	}
+
+	if _, err := New(discardMaster{}, &pipeSlave{}, WithBufferSize(0)); err == nil {
+		t.Fatalf("Expected an error for a zero buffer size")
+	}
+}
+
+func TestInitialCommand(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	inputReader, inputWriter := io.Pipe()
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{slaveReader, inputWriter}},
+		WithInitialCommand("kubectl get pods && clear"))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	input := make(chan []byte)
+	go func() {
+		data, _ := ioutil.ReadAll(inputReader)
+		input <- data
+	}()
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		slaveWriter.Close()
+	}()
+	if err := dt.Run(context.Background(), "", ""); !stderrors.Is(err, ErrSlaveClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+	inputWriter.Close()
+
+	if data := string(<-input); data != "kubectl get pods && clear\n" {
+		t.Fatalf("Unexpected input to slave: %q", data)
+	}
+
+	// a failed write ends Run
+	brokenReader, brokenWriter := io.Pipe()
+	brokenReader.Close()
+	dt, err = New(discardMaster{}, &pipeSlave{pipePair{slaveReader, brokenWriter}}, WithInitialCommand("ls"))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	if err := dt.Run(context.Background(), "", ""); err == nil || !strings.Contains(err.Error(), "failed to send initial command") {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}
+
+// failingSlave fails reads with err.
+type failingSlave struct {
+	pipeSlave
+	err error
+}
+
+func (fs *failingSlave) Read(p []byte) (int, error) { return 0, fs.err }
+
+// failingMaster fails reads with err.
+type failingMaster struct {
+	discardMaster
+	err error
+}
+
+func (fm failingMaster) Read(p []byte) (int, error) { return 0, fm.err }
+
+func TestClosedErrors(t *testing.T) {
+	ioErr := errors.New("input/output error")
+	for _, cause := range []error{io.EOF, ioErr} {
+		dt, err := New(discardMaster{}, &failingSlave{err: cause})
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		err = dt.Run(context.Background(), "", "")
+		if !stderrors.Is(err, ErrSlaveClosed) || stderrors.Is(err, ErrMasterClosed) || stderrors.Unwrap(err) != cause {
+			t.Fatalf("Unexpected error from Run() for slave %v: %v", cause, err)
+		}
+
+		slaveReader, _ := io.Pipe()
+		dt, err = New(failingMaster{err: cause}, &pipeSlave{pipePair{slaveReader, nil}})
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+		err = dt.Run(context.Background(), "", "")
+		if !stderrors.Is(err, ErrMasterClosed) || stderrors.Unwrap(err) != cause {
+			t.Fatalf("Unexpected error from Run() for master %v: %v", cause, err)
+		}
+	}
+}
+
+func TestBinaryOutput(t *testing.T) {
+	output := make([]byte, 3000)
+	for i := range output {
+		output[i] = byte(i)
+	}
+
+	sizes := map[bool]int{}
+	for _, binary := range []bool{false, true} {
+		slaveReader, slaveWriter := io.Pipe()
+		inputReader, inputWriter := io.Pipe()
+		master := newClosingMaster()
+		options := []Option{WithPermitWrite(), WithBufferSize(len(output))}
+		if binary {
+			options = append(options, WithBinaryOutput())
+		}
+		dt, err := New(master, &pipeSlave{pipePair{slaveReader, inputWriter}}, options...)
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- dt.Run(ctx, "", "") }()
+
+		slaveWriter.Write(output)
+		input := []byte{Input, 0xff, 0x00, '\r'}
+		go master.writer.Write(input)
+		received := make([]byte, 3)
+		io.ReadFull(inputReader, received)
+		if !bytes.Equal(received, input[1:]) {
+			t.Fatalf("Unexpected input with binary %t: %q", binary, received)
+		}
+		// wait for the output frame after the window title and read only
+		for i := 0; i < 100 && len(master.get()) < 3; i++ {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+		<-done
+
+		var payload []byte
+		for _, frame := range master.get() {
+			if frame[0] == Output {
+				sizes[binary] += len(frame)
+				payload = append(payload, frame[1:]...)
+			}
+		}
+		if !binary {
+			payload, _ = base64.StdEncoding.DecodeString(string(payload))
+		}
+		if !bytes.Equal(payload, output) {
+			t.Fatalf("Unexpected output with binary %t", binary)
+		}
+	}
+
+	if sizes[true] != len(output)+1 || sizes[false] != base64.StdEncoding.EncodedLen(len(output))+1 {
+		t.Fatalf("Unexpected frame sizes: %v", sizes)
+	}
+}
+
+func TestResizeValidation(t *testing.T) {
+	slave := &sizeRecordingSlave{pipeSlave: &pipeSlave{}}
+	dt, err := New(discardMaster{}, slave, WithMaxTerminalSize(300, 100))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	for _, c := range []struct {
+		payload       string
+		columns, rows int
+		resized       bool
+		malformed     bool
+	}{
+		{`{"Columns":80,"Rows":24}`, 80, 24, true, false},
+		// the current size is not applied again
+		{`{"Columns":80,"Rows":24}`, 80, 24, false, false},
+		{`{"Columns":80.9,"Rows":24.2}`, 80, 24, false, false},
+		{`{"Columns":0,"Rows":24}`, 80, 24, false, false},
+		{`{"Columns":81,"Rows":-1}`, 80, 24, false, false},
+		{`{}`, 80, 24, false, false},
+		{`{"Columns":1,"Rows":1}`, 1, 1, true, false},
+		{`{"Columns":301,"Rows":1e300}`, 300, 100, true, false},
+		{`{"Columns":"80"}`, 300, 100, false, true},
+		{`not json`, 300, 100, false, true},
+	} {
+		slave.columns, slave.rows = 0, 0
+		err := dt.handleMasterReadEvent(append([]byte{ResizeTerminal}, c.payload...))
+		if (err != nil) != c.malformed {
+			t.Fatalf("Unexpected error for %s: %v", c.payload, err)
+		}
+		resized := slave.columns != 0
+		if columns, rows := dt.slaveSize(); resized != c.resized || columns != c.columns || rows != c.rows {
+			t.Fatalf("Unexpected size for %s: %dx%d (resized %t)", c.payload, columns, rows, resized)
+		}
+	}
+}
+
+func TestMaxFrameSize(t *testing.T) {
+	output := make([]byte, 1000)
+	for i := range output {
+		output[i] = byte(i)
+	}
+
+	slaveReader, slaveWriter := io.Pipe()
+	master := newClosingMaster()
+	dt, err := New(master, &pipeSlave{pipePair{slaveReader, nil}}, WithBufferSize(len(output)), WithMaxFrameSize(103))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	outputFrames := func() []string {
+		var frames []string
+		for _, frame := range master.get() {
+			if frame[0] == Output {
+				frames = append(frames, frame)
+			}
+		}
+		return frames
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- dt.Run(ctx, "", "") }()
+
+	slaveWriter.Write(output)
+	for i := 0; i < 100 && len(outputFrames()) < 14; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	var payload []byte
+	for _, frame := range outputFrames() {
+		if len(frame) > 103 {
+			t.Fatalf("Unexpected frame size: %d", len(frame))
+		}
+		decoded, err := base64.StdEncoding.DecodeString(frame[1:])
+		if err != nil {
+			t.Fatalf("Unexpected error decoding a frame: %s", err)
+		}
+		payload = append(payload, decoded...)
+	}
+	if !bytes.Equal(payload, output) {
+		t.Fatalf("Unexpected output of %d bytes", len(payload))
+	}
+
+	_, err = New(master, &pipeSlave{}, WithMaxFrameSize(4))
+	if err == nil {
+		t.Fatalf("Expected an error for a frame size too small for a payload")
+	}
+}
+
+func TestAuditEvents(t *testing.T) {
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, slaveInPipeReader)
+	var events []AuditEvent
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{nil, slaveInPipeWriter}},
+		WithSessionID("abc"),
+		WithAuditLogger(AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+			events = append(events, event)
+			return nil
+		})),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.session.User, dt.session.ClusterID = "alice", "cluster-1"
+
+	start := time.Now()
+	for _, keys := range []string{"ls\r", "pwd\r", "id\r"} {
+		if err := dt.forwardInput([]byte(keys)); err != nil {
+			t.Fatalf("Unexpected error from forwardInput(): %s", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	elapsed := time.Since(start)
+
+	if len(events) != 3 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+	var total time.Duration
+	for i, command := range []string{"ls", "pwd", "id"} {
+		event := events[i]
+		if event.Command != command || event.UserAccount != "alice" || event.ClusterID != "cluster-1" || event.SessionID != "abc" {
+			t.Fatalf("Unexpected event: %+v", event)
+		}
+		if event.Time.Before(start) || event.RequestID == "" {
+			t.Fatalf("Unexpected event: %+v", event)
+		}
+		if i == 0 && event.DurationSinceLastCommand != 0 {
+			t.Fatalf("Unexpected duration for the first command: %s", event.DurationSinceLastCommand)
+		}
+		if i > 0 && event.DurationSinceLastCommand < 20*time.Millisecond {
+			t.Fatalf("Unexpected duration since the previous command: %s", event.DurationSinceLastCommand)
+		}
+		total += event.DurationSinceLastCommand
+	}
+	if total > elapsed {
+		t.Fatalf("Durations add up to %s in %s", total, elapsed)
+	}
+
+	data, err := json.Marshal(events[1])
+	if err != nil {
+		t.Fatalf("Unexpected error from Marshal(): %s", err)
+	}
+	var decoded map[string]interface{}
+	json.Unmarshal(data, &decoded)
+	for _, key := range []string{"user", "clusterId", "sessionId", "timestamp", "command", "durationSinceLastCommand", "requestId"} {
+		if _, ok := decoded[key]; !ok {
+			t.Fatalf("Missing `%s` in %s", key, data)
+		}
+	}
+}
+
+func TestStructuredAuditEvents(t *testing.T) {
+	file, err := ioutil.TempFile("", "audit-json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	file.Close()
+	defer os.Remove(file.Name())
+
+	slaveInPipeReader, slaveInPipeWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, slaveInPipeReader)
+	var events []AuditEvent
+	dt, err := New(discardMaster{}, &pipeSlave{pipePair{nil, slaveInPipeWriter}},
+		WithSessionID("abc"),
+		WithWorkingDirTracking(),
+		WithAuditFile(file.Name()),
+		WithAuditFileJSON(),
+		WithAuditLogger(AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+			events = append(events, event)
+			return nil
+		})),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+	dt.session.User, dt.session.RemoteAddr = "alice", "192.0.2.1:41234"
+
+	dt.handleSlaveReadEvent([]byte("\x1b]7;file://host/home/alice/my%20dir\x07$ "))
+	if err := dt.forwardInput([]byte("lx\x7fs -a\x1b[Dl\r")); err != nil {
+		t.Fatalf("Unexpected error from forwardInput(): %s", err)
+	}
+
+	expected := AuditEvent{
+		UserAccount: "alice",
+		SessionID:   "abc",
+		RemoteAddr:  "192.0.2.1:41234",
+		Command:     "ls -la",
+		Keys:        "lx\x7fs -a\x1b[Dl",
+		WorkingDir:  "/home/alice/my dir",
+	}
+	if len(events) != 1 {
+		t.Fatalf("Unexpected events: %+v", events)
+	}
+	event := events[0]
+	event.Time, event.RequestID = time.Time{}, ""
+	if !reflect.DeepEqual(event, expected) {
+		t.Fatalf("Unexpected event: %+v", events[0])
+	}
+
+	data, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	var recorded AuditEvent
+	if err := json.Unmarshal(bytes.TrimSuffix(data, []byte("\n")), &recorded); err != nil {
+		t.Fatalf("Unexpected audit file `%s`: %s", data, err)
+	}
+	if recorded.RequestID != events[0].RequestID || recorded.Keys != expected.Keys || recorded.WorkingDir != expected.WorkingDir {
+		t.Fatalf("Unexpected audit file entry: %s", data)
+	}
+}
+
+func TestMultiAuditLogger(t *testing.T) {
+	var logged []string
+	logger := func(name string, err error) AuditLogger {
+		return AuditLoggerFunc(func(ctx context.Context, event AuditEvent) error {
+			logged = append(logged, name+":"+event.Command)
+			return err
+		})
+	}
+	failure := stderrors.New("unreachable")
+
+	multi := MultiAuditLogger(logger("a", nil), logger("b", failure), logger("c", stderrors.New("later")))
+	if err := multi.Log(context.Background(), AuditEvent{Command: "ls"}); err != failure {
+		t.Fatalf("Unexpected error from Log(): %v", err)
+	}
+	if strings.Join(logged, ",") != "a:ls,b:ls,c:ls" {
+		t.Fatalf("Unexpected events: %q", logged)
+	}
+}
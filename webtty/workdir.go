@@ -0,0 +1,34 @@
+package webtty
+
+import (
+	"net/url"
+)
+
+// parseWorkingDirOSC returns the directory reported by an OSC 7 payload,
+// `7;file://host/path` with the path percent-encoded.
+func parseWorkingDirOSC(payload []byte) (string, bool) {
+	if len(payload) < 2 || payload[0] != '7' || payload[1] != ';' {
+		return "", false
+	}
+	location, err := url.Parse(string(payload[2:]))
+	if err != nil || location.Scheme != "file" || location.Path == "" {
+		return "", false
+	}
+	return location.Path, true
+}
+
+func (wt *WebTTY) setWorkingDir(dir string) {
+	wt.stateMutex.Lock()
+	defer wt.stateMutex.Unlock()
+
+	wt.workingDir = dir
+}
+
+// WorkingDir returns the latest working directory reported by the shell,
+// empty when none was, see WithWorkingDirTracking.
+func (wt *WebTTY) WorkingDir() string {
+	wt.stateMutex.RLock()
+	defer wt.stateMutex.RUnlock()
+
+	return wt.workingDir
+}
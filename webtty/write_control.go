@@ -0,0 +1,53 @@
+package webtty
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// permissionMarker prefixes the write permission changes requested by the
+// master in the audit trail.
+const permissionMarker = "[permission] "
+
+// WithWriteControl lets the master grant or revoke its own write permission
+// with SetPermitWrite messages, such as a supervisor console unlocking the
+// input of a read-only session. The master may always revoke the permission,
+// granting it requires authorize to return true for the identity of the
+// master. Without this option, the SetPermitWrite messages only revoke it.
+func WithWriteControl(authorize func(identity Identity) bool) Option {
+	return func(wt *WebTTY) error {
+		wt.writeControl = authorize
+		return nil
+	}
+}
+
+// handleSetPermitWrite applies a SetPermitWrite message, whose payload is
+// a JSON boolean. A denied grant is answered with the current permission.
+func (wt *WebTTY) handleSetPermitWrite(payload []byte) error {
+	var permitWrite bool
+	if err := json.Unmarshal(payload, &permitWrite); err != nil {
+		return malformedFrame(errors.Wrapf(err, "received malformed data for write permission"))
+	}
+
+	identity := wt.Identity()
+	if permitWrite && (wt.writeControl == nil || !wt.writeControl(identity)) {
+		wt.auditPermission("write grant denied to " + identity.User)
+		wt.notifyMutex.Lock()
+		defer wt.notifyMutex.Unlock()
+		return wt.sendReadOnly(!wt.PermitWrite())
+	}
+
+	if permitWrite {
+		wt.auditPermission("write granted by " + identity.User)
+	} else {
+		wt.auditPermission("write revoked by " + identity.User)
+	}
+	wt.setPermitWrite(permitWrite)
+	return nil
+}
+
+func (wt *WebTTY) auditPermission(change string) {
+	session := wt.Session()
+	wt.writeAudit(session.User, session.ClusterID, permissionMarker+change)
+}
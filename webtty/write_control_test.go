@@ -0,0 +1,45 @@
+package webtty
+
+import (
+	"testing"
+)
+
+func TestWriteControl(t *testing.T) {
+	rec := &frameRecorder{}
+	supervisor := ""
+	dt, err := New(recordingMaster{rec}, &pipeSlave{}, WithPermitWrite(),
+		WithIdentity(Identity{User: "alice"}),
+		WithWriteControl(func(identity Identity) bool { return identity.User == supervisor }))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	send := func(payload string) {
+		if err := dt.handleMasterReadEvent([]byte(string(SetPermitWrite) + payload)); err != nil {
+			t.Fatalf("Unexpected error from handleMasterReadEvent(): %s", err)
+		}
+	}
+
+	send("false")
+	if dt.PermitWrite() {
+		t.Fatalf("Expected write permission to be revoked")
+	}
+	send("true")
+	if dt.PermitWrite() {
+		t.Fatalf("Expected write grant to be denied")
+	}
+	frames := rec.get()
+	if len(frames) != 1 || frames[0] != string(SetReadOnly)+"true" {
+		t.Fatalf("Unexpected frames: %q", frames)
+	}
+
+	supervisor = "alice"
+	send("true")
+	if !dt.PermitWrite() {
+		t.Fatalf("Expected write permission to be granted")
+	}
+
+	if err := dt.handleMasterReadEvent([]byte(string(SetPermitWrite) + "yes")); err == nil {
+		t.Fatalf("Expected an error for a malformed payload")
+	}
+}
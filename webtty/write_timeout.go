@@ -0,0 +1,51 @@
+package webtty
+
+import (
+	"net"
+	"time"
+)
+
+// writeMasterLocked writes data to the master within the write timeout.
+// The caller must hold writeMutex.
+func (wt *WebTTY) writeMasterLocked(data []byte) (int, error) {
+	if wt.writeTimeout <= 0 {
+		return wt.masterConn.Write(data)
+	}
+	if wt.writeTimedOut {
+		return 0, ErrMasterWriteTimeout
+	}
+
+	if deadliner, ok := wt.masterConn.(writeDeadliner); ok {
+		err := deadliner.SetWriteDeadline(time.Now().Add(wt.writeTimeout))
+		if err != nil {
+			return 0, err
+		}
+		n, err := wt.masterConn.Write(data)
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			wt.writeTimedOut = true
+			return n, ErrMasterWriteTimeout
+		}
+		return n, err
+	}
+
+	type result struct {
+		n   int
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		n, err := wt.masterConn.Write(data)
+		done <- result{n, err}
+	}()
+
+	timer := time.NewTimer(wt.writeTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.n, r.err
+	case <-timer.C:
+		// the write may still be pending, the master can't be written anymore
+		wt.writeTimedOut = true
+		return 0, ErrMasterWriteTimeout
+	}
+}
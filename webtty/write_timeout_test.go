@@ -0,0 +1,59 @@
+package webtty
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestWriteTimeout(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	for _, master := range []Master{blockingMaster{make(chan struct{})}, server} {
+		dt, err := New(master, &pipeSlave{}, WithWriteTimeout(20*time.Millisecond))
+		if err != nil {
+			t.Fatalf("Unexpected error from New(): %s", err)
+		}
+
+		start := time.Now()
+		if err := dt.masterWrite([]byte{Pong}); errors.Cause(err) != ErrMasterWriteTimeout {
+			t.Fatalf("Unexpected error from masterWrite() to %T: %v", master, err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Fatalf("Write to %T timed out after %s", master, elapsed)
+		}
+		// the master is not written anymore
+		if err := dt.primaryWrite([]byte{Pong}); errors.Cause(err) != ErrMasterWriteTimeout {
+			t.Fatalf("Unexpected error from primaryWrite() to %T: %v", master, err)
+		}
+	}
+}
+
+// outputBlockingMaster blocks writes of Output frames.
+type outputBlockingMaster struct{}
+
+func (outputBlockingMaster) Read(p []byte) (int, error) { select {} }
+func (outputBlockingMaster) Write(p []byte) (int, error) {
+	if len(p) > 0 && p[0] == Output {
+		select {}
+	}
+	return len(p), nil
+}
+
+func TestWriteTimeoutClosesMaster(t *testing.T) {
+	slaveReader, slaveWriter := io.Pipe()
+	dt, err := New(outputBlockingMaster{}, &pipeSlave{pipePair{slaveReader, nil}}, WithWriteTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error from New(): %s", err)
+	}
+
+	go slaveWriter.Write([]byte("hello"))
+	if err := dt.Run(context.Background(), "", ""); !stderrors.Is(err, ErrMasterClosed) {
+		t.Fatalf("Unexpected error from Run(): %v", err)
+	}
+}